@@ -2,6 +2,7 @@ package main
 
 import (
 	"context"
+	"encoding/json"
 	"flag"
 	"fmt"
 	"io"
@@ -22,6 +23,7 @@ import (
 	"github.com/labstack/echo/v4"
 
 	"github.com/slipstream/slipstream/internal/api"
+	"github.com/slipstream/slipstream/internal/backup"
 	"github.com/slipstream/slipstream/internal/config"
 	"github.com/slipstream/slipstream/internal/database"
 	"github.com/slipstream/slipstream/internal/database/sqlc"
@@ -137,9 +139,16 @@ func main() {
 
 	configPath := flag.String("config", "", "Path to config file")
 	noTray := flag.Bool("no-tray", false, "Run without system tray (console mode)")
+	serviceMode := flag.Bool("service", false, "Run headless under the service manager (implies --no-tray)")
+	installService := flag.Bool("install-service", false, "Install SlipStream as a background service (Windows service or macOS launch agent) and exit")
+	uninstallService := flag.Bool("uninstall-service", false, "Remove the background service and exit")
 	flag.Parse()
 
-	bootstrapLog(fmt.Sprintf("Flags parsed: config=%q, no-tray=%v", *configPath, *noTray))
+	bootstrapLog(fmt.Sprintf("Flags parsed: config=%q, no-tray=%v, service=%v", *configPath, *noTray, *serviceMode))
+
+	if *installService || *uninstallService {
+		os.Exit(runServiceCommand(*installService, *configPath))
+	}
 	bootstrapLog("Loading configuration...")
 
 	cfg, err := config.Load(*configPath)
@@ -147,11 +156,14 @@ func main() {
 		bootstrapLog(fmt.Sprintf("FATAL: failed to load config: %v", err))
 		panic("failed to load config: " + err.Error())
 	}
+	// Keep the file values so config reloads can tell what changed on disk
+	fileCfg := *cfg
 	bootstrapLog(fmt.Sprintf("Config loaded: port=%d, db=%s, logPath=%s", cfg.Server.Port, cfg.Database.Path, cfg.Logging.Path))
 
 	bootstrapLog("Initializing logger...")
 	appLogger := logger.New(&logger.Config{
 		Level:           cfg.Logging.Level,
+		Components:      cfg.Logging.Components,
 		Format:          cfg.Logging.Format,
 		Path:            cfg.Logging.Path,
 		MaxSizeMB:       cfg.Logging.MaxSizeMB,
@@ -173,6 +185,26 @@ func main() {
 
 	devDBPath := cfg.Database.Path[:len(cfg.Database.Path)-3] + "_dev.db"
 
+	if restored, err := backup.ApplyStagedRestore(cfg.Database.Path, cfg.File); err != nil {
+		bootstrapLog(fmt.Sprintf("FATAL: failed to apply staged database restore: %v", err))
+		appLogger.Close()
+		panic("failed to apply staged database restore: " + err.Error())
+	} else if restored {
+		bootstrapLog("Applied staged database restore")
+		appLogger.Info().Msg("applied staged database restore")
+
+		// The restore may have replaced the config file too; the database
+		// stays where the staged copy was swapped in.
+		dbPath := cfg.Database.Path
+		if cfg, err = config.Load(*configPath); err != nil {
+			bootstrapLog(fmt.Sprintf("FATAL: failed to reload restored config: %v", err))
+			appLogger.Close()
+			panic("failed to reload restored config: " + err.Error())
+		}
+		fileCfg = *cfg
+		cfg.Database.Path = dbPath
+	}
+
 	bootstrapLog("Initializing database manager...")
 	dbManager, err := database.NewManager(cfg.Database.Path, devDBPath, &appLogger.Logger)
 	if err != nil {
@@ -184,6 +216,22 @@ func main() {
 	defer appLogger.Close()
 	bootstrapLog("Database manager initialized")
 
+	if pending, err := dbManager.HasPendingMigrations(); err != nil {
+		appLogger.Warn().Err(err).Msg("failed to check for pending migrations")
+	} else if pending {
+		bootstrapLog("Backing up database before migrations...")
+		backupService := backup.NewService(dbManager.ProdConn(), backup.Config{
+			Dir:        cfg.Backup.Dir,
+			DBPath:     cfg.Database.Path,
+			ConfigPath: cfg.File,
+			Keep:       cfg.Backup.Keep,
+		}, &appLogger.Logger)
+		if _, err := backupService.Create(context.Background(), backup.ReasonMigration); err != nil {
+			bootstrapLog(fmt.Sprintf("WARNING: failed to back up database before migrations: %v", err))
+			appLogger.Error().Err(err).Msg("failed to back up database before migrations, continuing")
+		}
+	}
+
 	bootstrapLog("Running database migrations...")
 	appLogger.Info().Msg("running database migrations")
 	if err := dbManager.Migrate(); err != nil {
@@ -201,8 +249,16 @@ func main() {
 	}
 	if setting, err := queries.GetSetting(context.Background(), "log_level"); err == nil && setting.Value != "" {
 		cfg.Logging.Level = setting.Value
+		appLogger.SetLevel(setting.Value)
 		appLogger.Info().Str("level", setting.Value).Msg("loaded log level from database")
 	}
+	if setting, err := queries.GetSetting(context.Background(), "log_component_levels"); err == nil && setting.Value != "" {
+		var components map[string]string
+		if err := json.Unmarshal([]byte(setting.Value), &components); err == nil {
+			appLogger.SetComponentLevels(components)
+			appLogger.Info().Interface("components", components).Msg("loaded component log levels from database")
+		}
+	}
 	if setting, err := queries.GetSetting(context.Background(), "external_access_enabled"); err == nil && setting.Value == "true" {
 		cfg.Server.Host = "0.0.0.0"
 		appLogger.Info().Msg("external access enabled, binding to all interfaces")
@@ -234,10 +290,22 @@ func main() {
 	quitChan := make(chan struct{}, 1)
 	var shouldRestart bool
 
+	var serviceHost platform.ServiceHost
+	var serviceStopped <-chan struct{}
+	if *serviceMode {
+		serviceHost, err = platform.StartServiceHost()
+		if err != nil {
+			bootstrapLog(fmt.Sprintf("FATAL: failed to start service host: %v", err))
+			appLogger.Fatal().Err(err).Msg("failed to start service host")
+		}
+		serviceStopped = serviceHost.Stopped()
+	}
+
 	bootstrapLog("Creating API server...")
 	server := api.NewServer(dbManager, hub, cfg, &appLogger.Logger, restartChan)
 	server.SetConfiguredPort(configuredPort)
 	server.SetLogsProvider(appLogger)
+	server.SetConfigBaseline(&fileCfg)
 
 	if err := server.EnsureDefaults(context.Background()); err != nil {
 		appLogger.Warn().Err(err).Msg("failed to ensure defaults")
@@ -263,6 +331,8 @@ func main() {
 	}
 
 	server.Echo().GET("/ws", hub.HandleWebSocket)
+	server.Echo().GET("/events", hub.HandleSSE)
+	server.Echo().POST("/events/:id", hub.HandleSSEMessage)
 
 	if distFS, err := web.DistFS(); err == nil {
 		registerFrontendHandler(server.Echo(), distFS)
@@ -271,6 +341,10 @@ func main() {
 		bootstrapLog(fmt.Sprintf("Warning: failed to get frontend dist FS: %v", err))
 	}
 
+	reloadCtx, stopReload := context.WithCancel(context.Background())
+	defer stopReload()
+	watchConfig(reloadCtx, server, cfg.File, appLogger)
+
 	bootstrapLog("Starting HTTP server...")
 	go func() {
 		addr := cfg.Server.Address()
@@ -290,12 +364,12 @@ func main() {
 		ServerURL: serverURL,
 		DataPath:  cfg.Database.Path,
 		Port:      cfg.Server.Port,
-		NoTray:    *noTray || runtime.GOOS == osLinux,
+		NoTray:    *noTray || *serviceMode || runtime.GOOS == osLinux,
 		OnQuit: func() {
 			close(quitChan)
 		},
 	})
-	bootstrapLog(fmt.Sprintf("Platform app created (NoTray=%v)", *noTray || runtime.GOOS == osLinux))
+	bootstrapLog(fmt.Sprintf("Platform app created (NoTray=%v)", *noTray || *serviceMode || runtime.GOOS == osLinux))
 
 	if isFirstRun {
 		go func() {
@@ -315,6 +389,10 @@ func main() {
 			bootstrapLog("Received shutdown signal")
 			appLogger.Info().Msg("received shutdown signal")
 			app.Stop()
+		case <-serviceStopped:
+			bootstrapLog("Service manager requested stop")
+			appLogger.Info().Msg("service manager requested stop")
+			app.Stop()
 		case spawnNew := <-restartChan:
 			bootstrapLog("Restart requested")
 			appLogger.Info().Msg("restarting server...")
@@ -339,13 +417,80 @@ func main() {
 		appLogger.Error().Err(err).Msg("server shutdown error")
 	}
 
-	if shouldRestart {
+	var exitCode uint32
+	switch {
+	case shouldRestart && serviceHost != nil:
+		// Let the service manager relaunch us rather than spawning an
+		// orphan it does not know about
+		exitCode = 1
+	case shouldRestart:
 		if err := spawnNewProcess(); err != nil {
 			appLogger.Error().Err(err).Msg("failed to spawn new process")
 		}
 	}
 
 	appLogger.Info().Msg("server stopped")
+
+	if serviceHost != nil {
+		serviceHost.Exit(exitCode)
+	}
+}
+
+// watchConfig reloads the settings that are safe to change at runtime on
+// SIGHUP and whenever the config file is saved.
+func watchConfig(ctx context.Context, server *api.Server, cfgFile string, appLogger *logger.Logger) {
+	reload := func(trigger string) {
+		if _, err := server.ReloadConfig(ctx); err != nil {
+			appLogger.Error().Err(err).Str("trigger", trigger).Msg("failed to reload configuration")
+		}
+	}
+
+	hup := make(chan os.Signal, 1)
+	signal.Notify(hup, syscall.SIGHUP)
+	go func() {
+		defer signal.Stop(hup)
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-hup:
+				reload("signal")
+			}
+		}
+	}()
+
+	if cfgFile == "" {
+		return
+	}
+	if err := config.Watch(ctx, cfgFile, func() { reload("file") }); err != nil {
+		appLogger.Warn().Err(err).Str("file", cfgFile).Msg("failed to watch config file, reload with SIGHUP instead")
+	}
+}
+
+// runServiceCommand installs or removes the background service and returns
+// the process exit code.
+func runServiceCommand(install bool, configPath string) int {
+	// The service manager starts us from a different working directory
+	if configPath != "" {
+		if abs, err := filepath.Abs(configPath); err == nil {
+			configPath = abs
+		}
+	}
+
+	action, run := "uninstall", platform.UninstallService
+	if install {
+		action = "install"
+		run = func() error { return platform.InstallService(configPath) }
+	}
+
+	if err := run(); err != nil {
+		bootstrapLog(fmt.Sprintf("Service %s failed: %v", action, err))
+		fmt.Fprintf(os.Stderr, "SlipStream: service %s failed: %v\n", action, err)
+		return 1
+	}
+	bootstrapLog(fmt.Sprintf("Service %s complete", action))
+	fmt.Printf("SlipStream: service %s complete\n", action)
+	return 0
 }
 
 func spawnNewProcess() error {
@@ -621,7 +766,7 @@ func registerFrontendHandler(e *echo.Echo, distFS fs.FS) {
 	e.GET("/*", func(c echo.Context) error {
 		path := c.Request().URL.Path
 
-		if strings.HasPrefix(path, "/api/") || path == "/ws" {
+		if strings.HasPrefix(path, "/api/") || path == "/ws" || path == "/events" {
 			return echo.ErrNotFound
 		}
 
@@ -82,7 +82,7 @@ func bootstrap(userBinary string) error {
 	}
 
 	// Make it executable
-	if err := os.Chmod(userBinary, 0o600); err != nil {
+	if err := os.Chmod(userBinary, 0o700); err != nil {
 		return fmt.Errorf("chmod: %w", err)
 	}
 
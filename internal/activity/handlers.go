@@ -0,0 +1,60 @@
+package activity
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+)
+
+// Handlers provides HTTP handlers for the activity feed.
+type Handlers struct {
+	service *Service
+}
+
+// NewHandlers creates new activity handlers.
+func NewHandlers(service *Service) *Handlers {
+	return &Handlers{service: service}
+}
+
+// RegisterRoutes registers the activity routes.
+func (h *Handlers) RegisterRoutes(g *echo.Group) {
+	g.GET("", h.List)
+}
+
+// List returns a page of in-progress activity.
+// GET /api/v1/activity?kind=download,import&page=1&pageSize=50
+func (h *Handlers) List(c echo.Context) error {
+	opts := ListOptions{Page: 1, PageSize: 50}
+	if p := c.QueryParam("page"); p != "" {
+		if v, err := strconv.Atoi(p); err == nil && v > 0 {
+			opts.Page = v
+		}
+	}
+	if ps := c.QueryParam("pageSize"); ps != "" {
+		if v, err := strconv.Atoi(ps); err == nil && v > 0 {
+			opts.PageSize = min(v, 500)
+		}
+	}
+
+	if raw := c.QueryParam("kind"); raw != "" {
+		valid := make(map[Kind]bool)
+		for _, k := range AllKinds() {
+			valid[k] = true
+		}
+		for _, k := range strings.Split(raw, ",") {
+			kind := Kind(strings.TrimSpace(k))
+			if !valid[kind] {
+				return echo.NewHTTPError(http.StatusBadRequest, "invalid activity kind: "+string(kind))
+			}
+			opts.Kinds = append(opts.Kinds, kind)
+		}
+	}
+
+	result, err := h.service.List(c.Request().Context(), &opts)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	return c.JSON(http.StatusOK, result)
+}
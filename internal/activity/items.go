@@ -0,0 +1,183 @@
+package activity
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/slipstream/slipstream/internal/autosearch"
+	"github.com/slipstream/slipstream/internal/downloader"
+	importer "github.com/slipstream/slipstream/internal/import"
+	"github.com/slipstream/slipstream/internal/progress"
+	"github.com/slipstream/slipstream/internal/scheduler"
+)
+
+const indeterminate = -1
+
+func percent(done, total int64) float64 {
+	if total <= 0 {
+		return indeterminate
+	}
+	return float64(done) * 100 / float64(total)
+}
+
+func timePtr(t time.Time) *time.Time {
+	if t.IsZero() {
+		return nil
+	}
+	return &t
+}
+
+func importItems(jobs []importer.JobProgress) []Item {
+	items := make([]Item, 0, len(jobs))
+	for i := range jobs {
+		j := &jobs[i]
+		p := float64(indeterminate)
+		if j.Stage != importer.JobStageImporting {
+			p = percent(j.BytesCopied, j.TotalBytes)
+		}
+		items = append(items, Item{
+			ID:        "import:" + j.JobID,
+			Kind:      KindImport,
+			Title:     j.FileName,
+			Subtitle:  string(j.Stage),
+			Status:    string(j.Stage),
+			Progress:  p,
+			StartedAt: timePtr(j.StartedAt),
+			Metadata: map[string]any{
+				"jobId":          j.JobID,
+				"sourcePath":     j.SourcePath,
+				"bytesPerSecond": j.BytesPerSecond,
+			},
+		})
+	}
+	return items
+}
+
+func pendingImportItems(jobs []importer.PendingJob) []Item {
+	items := make([]Item, 0, len(jobs))
+	for _, j := range jobs {
+		items = append(items, Item{
+			ID:       "pending-import:" + j.JobID,
+			Kind:     KindPendingImport,
+			Title:    j.FileName,
+			Subtitle: fmt.Sprintf("Queued (position %d)", j.Position),
+			Status:   "queued",
+			Progress: 0,
+			Metadata: map[string]any{
+				"jobId":      j.JobID,
+				"sourcePath": j.SourcePath,
+				"manual":     j.Manual,
+				"position":   j.Position,
+			},
+		})
+	}
+	return items
+}
+
+func renameItems(ops []importer.RenameProgress) []Item {
+	items := make([]Item, 0, len(ops))
+	for _, op := range ops {
+		items = append(items, Item{
+			ID:        "rename:" + op.ID,
+			Kind:      KindRename,
+			Title:     fmt.Sprintf("Renaming %d %s files", op.Total, op.MediaType),
+			Subtitle:  fmt.Sprintf("%d of %d", op.Done, op.Total),
+			Status:    "running",
+			Progress:  percent(int64(op.Done), int64(op.Total)),
+			StartedAt: timePtr(op.StartedAt),
+			Metadata:  map[string]any{"mediaType": op.MediaType},
+		})
+	}
+	return items
+}
+
+func searchItems(searches []autosearch.ActiveSearch) []Item {
+	items := make([]Item, 0, len(searches))
+	for _, a := range searches {
+		items = append(items, Item{
+			ID:        fmt.Sprintf("search:%s:%d", a.MediaType, a.MediaID),
+			Kind:      KindSearch,
+			Title:     a.Title,
+			Subtitle:  fmt.Sprintf("Searching (%s)", a.Source),
+			Status:    "running",
+			Progress:  indeterminate,
+			StartedAt: timePtr(a.StartedAt),
+			Metadata: map[string]any{
+				"mediaType": a.MediaType,
+				"mediaId":   a.MediaID,
+			},
+		})
+	}
+	return items
+}
+
+func taskItems(tasks []scheduler.TaskInfo) []Item {
+	items := make([]Item, 0)
+	for i := range tasks {
+		t := &tasks[i]
+		if !t.Running {
+			continue
+		}
+		items = append(items, Item{
+			ID:        "task:" + t.ID,
+			Kind:      KindTask,
+			Title:     t.Name,
+			Subtitle:  t.Description,
+			Status:    "running",
+			Progress:  indeterminate,
+			StartedAt: t.LastRun, // set when the current run started
+			Metadata:  map[string]any{"taskId": t.ID},
+		})
+	}
+	sortByStart(items)
+	return items
+}
+
+func operationItems(activities []*progress.Activity) []Item {
+	items := make([]Item, 0, len(activities))
+	for _, a := range activities {
+		if a.Status != progress.StatusInProgress && a.Status != progress.StatusPending {
+			continue
+		}
+		p := float64(a.Progress)
+		if a.Progress < 0 {
+			p = indeterminate
+		}
+		items = append(items, Item{
+			ID:        "operation:" + a.ID,
+			Kind:      KindOperation,
+			Title:     a.Title,
+			Subtitle:  a.Subtitle,
+			Status:    string(a.Status),
+			Progress:  p,
+			StartedAt: timePtr(a.StartedAt),
+			Metadata:  map[string]any{"type": a.Type},
+		})
+	}
+	sortByStart(items)
+	return items
+}
+
+func downloadItems(queue []downloader.QueueItem) []Item {
+	items := make([]Item, 0, len(queue))
+	for i := range queue {
+		q := &queue[i]
+		items = append(items, Item{
+			ID:       fmt.Sprintf("download:%d:%s", q.ClientID, q.ID),
+			Kind:     KindDownload,
+			Title:    q.Title,
+			Subtitle: q.ClientName,
+			Status:   q.Status,
+			Progress: q.Progress,
+			Metadata: map[string]any{
+				"clientId":      q.ClientID,
+				"downloadId":    q.ID,
+				"mediaType":     q.MediaType,
+				"size":          q.Size,
+				"downloadSpeed": q.DownloadSpeed,
+				"eta":           q.ETA,
+			},
+		})
+	}
+	return items
+}
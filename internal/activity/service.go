@@ -0,0 +1,176 @@
+package activity
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/rs/zerolog"
+
+	"github.com/slipstream/slipstream/internal/autosearch"
+	"github.com/slipstream/slipstream/internal/downloader"
+	importer "github.com/slipstream/slipstream/internal/import"
+	"github.com/slipstream/slipstream/internal/progress"
+	"github.com/slipstream/slipstream/internal/scheduler"
+)
+
+// QueueSource provides the download client queue.
+type QueueSource interface {
+	GetQueue(ctx context.Context) (*downloader.QueueResponse, error)
+}
+
+// ImportSource provides running and queued imports and mass renames.
+type ImportSource interface {
+	ActiveJobs() []importer.JobProgress
+	PendingJobs() []importer.PendingJob
+	ActiveRenames() []importer.RenameProgress
+}
+
+// SearchSource provides running searches.
+type SearchSource interface {
+	ActiveSearches() []autosearch.ActiveSearch
+}
+
+// TaskSource provides scheduled task state.
+type TaskSource interface {
+	ListTasks() []scheduler.TaskInfo
+}
+
+// ProgressSource provides progress-tracked activities such as library scans.
+type ProgressSource interface {
+	GetAllActivities() []*progress.Activity
+}
+
+// Service combines every source of in-progress work into a single feed.
+// Sources are optional; a nil source contributes nothing.
+type Service struct {
+	queue    QueueSource
+	imports  ImportSource
+	searches SearchSource
+	tasks    TaskSource
+	progress ProgressSource
+	logger   *zerolog.Logger
+}
+
+// NewService creates a new activity service.
+func NewService(logger *zerolog.Logger) *Service {
+	subLogger := logger.With().Str("component", "activity").Logger()
+	return &Service{logger: &subLogger}
+}
+
+// SetQueueSource sets the download queue source.
+func (s *Service) SetQueueSource(source QueueSource) { s.queue = source }
+
+// SetImportSource sets the import and rename source.
+func (s *Service) SetImportSource(source ImportSource) { s.imports = source }
+
+// SetSearchSource sets the running search source.
+func (s *Service) SetSearchSource(source SearchSource) { s.searches = source }
+
+// SetTaskSource sets the scheduled task source.
+func (s *Service) SetTaskSource(source TaskSource) { s.tasks = source }
+
+// SetProgressSource sets the progress-tracked activity source.
+func (s *Service) SetProgressSource(source ProgressSource) { s.progress = source }
+
+// List returns one page of in-progress activity.
+func (s *Service) List(ctx context.Context, opts *ListOptions) (*ListResponse, error) {
+	kinds := opts.Kinds
+	if len(kinds) == 0 {
+		kinds = AllKinds()
+	}
+	wanted := make(map[Kind]bool, len(kinds))
+	for _, k := range kinds {
+		wanted[k] = true
+	}
+
+	resp := &ListResponse{Counts: make(map[Kind]int)}
+	var all []Item
+	for _, kind := range AllKinds() {
+		if !wanted[kind] {
+			continue
+		}
+		items, err := s.collect(ctx, kind, resp)
+		if err != nil {
+			return nil, err
+		}
+		resp.Counts[kind] = len(items)
+		all = append(all, items...)
+	}
+
+	paginate(resp, all, opts.Page, opts.PageSize)
+	return resp, nil
+}
+
+func (s *Service) collect(ctx context.Context, kind Kind, resp *ListResponse) ([]Item, error) {
+	switch kind {
+	case KindImport:
+		if s.imports != nil {
+			return importItems(s.imports.ActiveJobs()), nil
+		}
+	case KindRename:
+		if s.imports != nil {
+			return renameItems(s.imports.ActiveRenames()), nil
+		}
+	case KindSearch:
+		if s.searches != nil {
+			return searchItems(s.searches.ActiveSearches()), nil
+		}
+	case KindTask:
+		if s.tasks != nil {
+			return taskItems(s.tasks.ListTasks()), nil
+		}
+	case KindOperation:
+		if s.progress != nil {
+			return operationItems(s.progress.GetAllActivities()), nil
+		}
+	case KindDownload:
+		if s.queue != nil {
+			queue, err := s.queue.GetQueue(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get download queue: %w", err)
+			}
+			resp.Errors = queue.Errors
+			return downloadItems(queue.Items), nil
+		}
+	case KindPendingImport:
+		if s.imports != nil {
+			return pendingImportItems(s.imports.PendingJobs()), nil
+		}
+	}
+	return nil, nil
+}
+
+// paginate fills in the requested page. Items stay in feed order.
+func paginate(resp *ListResponse, all []Item, page, pageSize int) {
+	if page < 1 {
+		page = 1
+	}
+	if pageSize < 1 {
+		pageSize = 50
+	}
+
+	resp.Page = page
+	resp.PageSize = pageSize
+	resp.TotalCount = len(all)
+	resp.TotalPages = (len(all) + pageSize - 1) / pageSize
+
+	start := (page - 1) * pageSize
+	if start >= len(all) {
+		resp.Items = []Item{}
+		return
+	}
+	end := min(start+pageSize, len(all))
+	resp.Items = all[start:end]
+}
+
+// sortByStart orders items oldest first, keeping items without a start time last.
+func sortByStart(items []Item) {
+	sort.SliceStable(items, func(i, j int) bool {
+		a, b := items[i].StartedAt, items[j].StartedAt
+		if a == nil || b == nil {
+			return a != nil
+		}
+		return a.Before(*b)
+	})
+}
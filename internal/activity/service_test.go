@@ -0,0 +1,99 @@
+package activity
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+
+	"github.com/slipstream/slipstream/internal/autosearch"
+	"github.com/slipstream/slipstream/internal/downloader"
+	importer "github.com/slipstream/slipstream/internal/import"
+	"github.com/slipstream/slipstream/internal/scheduler"
+)
+
+type fakeQueue struct{ items []downloader.QueueItem }
+
+func (f *fakeQueue) GetQueue(context.Context) (*downloader.QueueResponse, error) {
+	return &downloader.QueueResponse{Items: f.items}, nil
+}
+
+type fakeImports struct{}
+
+func (fakeImports) ActiveJobs() []importer.JobProgress {
+	return []importer.JobProgress{{JobID: "j1", FileName: "a.mkv", Stage: importer.JobStageCopying, BytesCopied: 50, TotalBytes: 200, StartedAt: time.Now()}}
+}
+
+func (fakeImports) PendingJobs() []importer.PendingJob {
+	return []importer.PendingJob{{JobID: "j2", FileName: "b.mkv", Position: 1}}
+}
+
+func (fakeImports) ActiveRenames() []importer.RenameProgress { return nil }
+
+type fakeSearches struct{}
+
+func (fakeSearches) ActiveSearches() []autosearch.ActiveSearch {
+	return []autosearch.ActiveSearch{{MediaType: "movie", MediaID: 7, Title: "Movie", StartedAt: time.Now()}}
+}
+
+type fakeTasks struct{}
+
+func (fakeTasks) ListTasks() []scheduler.TaskInfo {
+	return []scheduler.TaskInfo{{ID: "idle"}, {ID: "busy", Name: "Busy", Running: true}}
+}
+
+func newTestService() *Service {
+	logger := zerolog.Nop()
+	svc := NewService(&logger)
+	svc.SetQueueSource(&fakeQueue{items: []downloader.QueueItem{
+		{ID: "d1", ClientID: 1, Title: "Download", Progress: 40},
+	}})
+	svc.SetImportSource(fakeImports{})
+	svc.SetSearchSource(fakeSearches{})
+	svc.SetTaskSource(fakeTasks{})
+	return svc
+}
+
+func TestList_CombinesSourcesInFeedOrder(t *testing.T) {
+	resp, err := newTestService().List(context.Background(), &ListOptions{})
+	if err != nil {
+		t.Fatalf("List: %v", err)
+	}
+
+	want := []string{"import:j1", "search:movie:7", "task:busy", "download:1:d1", "pending-import:j2"}
+	if len(resp.Items) != len(want) {
+		t.Fatalf("got %d items, want %d: %+v", len(resp.Items), len(want), resp.Items)
+	}
+	for i, id := range want {
+		if resp.Items[i].ID != id {
+			t.Errorf("item %d = %s, want %s", i, resp.Items[i].ID, id)
+		}
+	}
+	if got := resp.Items[0].Progress; got != 25 {
+		t.Errorf("import progress = %v, want 25", got)
+	}
+	if resp.Counts[KindTask] != 1 {
+		t.Errorf("task count = %d, want 1", resp.Counts[KindTask])
+	}
+}
+
+func TestList_FiltersAndPaginates(t *testing.T) {
+	svc := newTestService()
+
+	resp, err := svc.List(context.Background(), &ListOptions{Kinds: []Kind{KindDownload, KindPendingImport}})
+	if err != nil {
+		t.Fatalf("List: %v", err)
+	}
+	if resp.TotalCount != 2 || resp.Items[0].Kind != KindDownload {
+		t.Fatalf("unexpected filtered feed: %+v", resp)
+	}
+
+	resp, err = svc.List(context.Background(), &ListOptions{Page: 2, PageSize: 2})
+	if err != nil {
+		t.Fatalf("List: %v", err)
+	}
+	if resp.TotalPages != 3 || len(resp.Items) != 2 || resp.Items[0].ID != "task:busy" {
+		t.Fatalf("unexpected page: %+v", resp)
+	}
+}
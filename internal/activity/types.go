@@ -0,0 +1,56 @@
+package activity
+
+import (
+	"time"
+
+	"github.com/slipstream/slipstream/internal/downloader"
+)
+
+// Kind identifies where an activity item comes from.
+type Kind string
+
+const (
+	KindImport        Kind = "import"
+	KindRename        Kind = "rename"
+	KindSearch        Kind = "search"
+	KindTask          Kind = "task"
+	KindOperation     Kind = "operation" // scans, metadata refreshes and other progress-tracked work
+	KindDownload      Kind = "download"
+	KindPendingImport Kind = "pending-import"
+)
+
+// AllKinds returns all kinds in feed order: work SlipStream is doing right
+// now first, then downloads, then imports still waiting for a worker.
+func AllKinds() []Kind {
+	return []Kind{KindImport, KindRename, KindSearch, KindTask, KindOperation, KindDownload, KindPendingImport}
+}
+
+// Item is a single in-progress operation in the activity feed.
+type Item struct {
+	ID        string         `json:"id"` // kind-prefixed, stable while the operation runs
+	Kind      Kind           `json:"kind"`
+	Title     string         `json:"title"`
+	Subtitle  string         `json:"subtitle,omitempty"`
+	Status    string         `json:"status"`
+	Progress  float64        `json:"progress"` // 0-100, -1 when indeterminate
+	StartedAt *time.Time     `json:"startedAt,omitempty"`
+	Metadata  map[string]any `json:"metadata,omitempty"`
+}
+
+// ListOptions filters and paginates the feed.
+type ListOptions struct {
+	Kinds    []Kind
+	Page     int
+	PageSize int
+}
+
+// ListResponse is a page of the activity feed.
+type ListResponse struct {
+	Items      []Item                   `json:"items"`
+	Counts     map[Kind]int             `json:"counts"` // per-kind totals before pagination
+	Page       int                      `json:"page"`
+	PageSize   int                      `json:"pageSize"`
+	TotalCount int                      `json:"totalCount"`
+	TotalPages int                      `json:"totalPages"`
+	Errors     []downloader.ClientError `json:"errors,omitempty"` // download clients that could not be polled
+}
@@ -2,10 +2,13 @@
 package api
 
 import (
+	"crypto/subtle"
 	"net/http"
 	"strings"
 
 	"github.com/labstack/echo/v4"
+
+	"github.com/slipstream/slipstream/internal/database/sqlc"
 	portalmw "github.com/slipstream/slipstream/internal/portal/middleware"
 )
 
@@ -104,6 +107,30 @@ func (s *Server) adminAuthMiddleware() echo.MiddlewareFunc {
 	}
 }
 
+// apiKeyAuthMiddleware protects integration routes. It accepts the API key via the
+// X-Api-Key header or apikey query parameter, and falls back to admin JWT authentication.
+func (s *Server) apiKeyAuthMiddleware() echo.MiddlewareFunc {
+	adminAuth := s.adminAuthMiddleware()
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		jwtNext := adminAuth(next)
+		return func(c echo.Context) error {
+			provided := c.Request().Header.Get("X-Api-Key")
+			if provided == "" {
+				provided = c.QueryParam("apikey")
+			}
+			if provided == "" {
+				return jwtNext(c)
+			}
+
+			apiKey := s.getAPIKey(c.Request().Context(), sqlc.New(s.dbManager.Conn()))
+			if apiKey == "" || subtle.ConstantTimeCompare([]byte(provided), []byte(apiKey)) != 1 {
+				return echo.NewHTTPError(http.StatusUnauthorized, "invalid API key")
+			}
+			return next(c)
+		}
+	}
+}
+
 func extractBearerToken(c echo.Context) string {
 	authHeader := c.Request().Header.Get("Authorization")
 	if authHeader == "" {
@@ -70,6 +70,7 @@ func (d *DevModeManager) OnToggle(enabled bool) error {
 		d.copySettingsToDevDB()
 	}
 	d.updateServicesDB()
+	d.reloadQualities()
 	d.switchMetadataClients(enabled)
 	d.switchIndexer(enabled)
 	d.switchDownloadClient(enabled)
@@ -147,6 +148,13 @@ func (d *DevModeManager) updateServicesDB() {
 	d.logger.Info().Msg("Updated all services with new database connection")
 }
 
+// reloadQualities loads the quality definitions of the newly active database.
+func (d *DevModeManager) reloadQualities() {
+	if err := d.library.Quality.LoadQualities(context.Background()); err != nil {
+		d.logger.Error().Err(err).Msg("Failed to load quality definitions")
+	}
+}
+
 // switchDownloadClient creates or removes the mock download client based on dev mode.
 func (d *DevModeManager) switchDownloadClient(devMode bool) {
 	ctx := context.Background()
@@ -537,6 +545,8 @@ func (d *DevModeManager) copyQualityProfilesToDevDB() map[int64]int64 {
 			AllowAutoApprove:        row.AllowAutoApprove,
 			UpgradeStrategy:         row.UpgradeStrategy,
 			CutoffOverridesStrategy: row.CutoffOverridesStrategy,
+			ReleaseGroups:           row.ReleaseGroups,
+			IndexerFlags:            row.IndexerFlags,
 		})
 		if err != nil {
 			d.logger.Error().Err(err).Str("name", row.Name).Msg("Failed to copy quality profile")
@@ -33,9 +33,7 @@ func (h *SchedulerHandler) GetTask(c echo.Context) error {
 	taskID := c.Param("id")
 	task, err := h.scheduler.GetTask(taskID)
 	if err != nil {
-		return c.JSON(http.StatusNotFound, map[string]string{
-			"error": err.Error(),
-		})
+		return echo.NewHTTPError(http.StatusNotFound, err.Error())
 	}
 	return c.JSON(http.StatusOK, task)
 }
@@ -45,9 +43,7 @@ func (h *SchedulerHandler) GetTask(c echo.Context) error {
 func (h *SchedulerHandler) RunTask(c echo.Context) error {
 	taskID := c.Param("id")
 	if err := h.scheduler.RunNow(taskID); err != nil {
-		return c.JSON(http.StatusBadRequest, map[string]string{
-			"error": err.Error(),
-		})
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
 	}
 	return c.JSON(http.StatusAccepted, map[string]string{
 		"message": "Task started",
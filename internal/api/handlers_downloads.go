@@ -9,6 +9,7 @@ import (
 	"github.com/labstack/echo/v4"
 
 	"github.com/slipstream/slipstream/internal/downloader"
+	"github.com/slipstream/slipstream/internal/tags"
 )
 
 const redactedSentinel = "********"
@@ -110,6 +111,9 @@ func (s *Server) updateDownloadClient(c echo.Context) error {
 		if errors.Is(err, downloader.ErrClientNotFound) {
 			return echo.NewHTTPError(http.StatusNotFound, "client not found")
 		}
+		if errors.Is(err, tags.ErrTagNotFound) {
+			return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+		}
 		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
 	}
 
@@ -256,6 +260,36 @@ func (s *Server) fastForwardDownload(c echo.Context) error {
 	return c.JSON(http.StatusOK, map[string]string{"status": "completed"})
 }
 
+// remapDownload points an active download at a different movie, episode, or
+// season before it is imported.
+func (s *Server) remapDownload(c echo.Context) error {
+	ctx := c.Request().Context()
+	downloadID := c.Param("id")
+
+	var input downloader.RemapDownloadInput
+	if err := c.Bind(&input); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid request body")
+	}
+
+	_, err := s.download.Service.RemapDownload(ctx, downloadID, &input)
+	switch {
+	case errors.Is(err, downloader.ErrDownloadNotMapped):
+		return echo.NewHTTPError(http.StatusNotFound, err.Error())
+	case errors.Is(err, downloader.ErrDownloadImporting):
+		return echo.NewHTTPError(http.StatusConflict, err.Error())
+	case errors.Is(err, downloader.ErrInvalidRemapTarget):
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	case err != nil:
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	if s.download.QueueBroadcaster != nil {
+		s.download.QueueBroadcaster.Trigger()
+	}
+
+	return c.JSON(http.StatusOK, map[string]string{"status": "remapped"})
+}
+
 func (s *Server) removeFromQueue(c echo.Context) error {
 	ctx := c.Request().Context()
 	torrentID := c.Param("id")
@@ -279,6 +313,44 @@ func (s *Server) removeFromQueue(c echo.Context) error {
 	return c.NoContent(http.StatusNoContent)
 }
 
+func (s *Server) getStalledDownloadSettings(c echo.Context) error {
+	settings, err := s.download.Service.StalledDownloadSettings(c.Request().Context())
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	return c.JSON(http.StatusOK, settings)
+}
+
+func (s *Server) updateStalledDownloadSettings(c echo.Context) error {
+	var input downloader.StalledDownloadSettings
+	if err := c.Bind(&input); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid request body")
+	}
+
+	settings, err := s.download.Service.SetStalledDownloadSettings(c.Request().Context(), &input)
+	if err != nil {
+		if errors.Is(err, downloader.ErrInvalidStalledSettings) {
+			return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+		}
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	return c.JSON(http.StatusOK, settings)
+}
+
+// checkStalledDownloads runs the stalled download check now.
+func (s *Server) checkStalledDownloads(c echo.Context) error {
+	result, err := s.download.Service.CheckStalledDownloads(c.Request().Context())
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	if result.Failed > 0 && s.download.QueueBroadcaster != nil {
+		s.download.QueueBroadcaster.Trigger()
+	}
+
+	return c.JSON(http.StatusOK, result)
+}
+
 // getIndexerHistory returns indexer search and grab history.
 func (s *Server) getIndexerHistory(c echo.Context) error {
 	limit := 50
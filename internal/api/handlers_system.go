@@ -4,6 +4,7 @@ import (
 	"context"
 	"crypto/rand"
 	"encoding/hex"
+	"encoding/json"
 	"net/http"
 	"path/filepath"
 	"strconv"
@@ -271,19 +272,72 @@ func (s *Server) updateServerPort(ctx context.Context, queries *sqlc.Queries, se
 	return err
 }
 
+var validLogLevels = map[string]bool{"trace": true, "debug": true, "info": true, "warn": true, "error": true}
+
 func (s *Server) updateLogLevel(ctx context.Context, queries *sqlc.Queries, logLevel *string) error {
 	if logLevel == nil {
 		return nil
 	}
-	validLevels := map[string]bool{"trace": true, "debug": true, "info": true, "warn": true, "error": true}
-	if !validLevels[*logLevel] {
+	if !validLogLevels[*logLevel] {
 		return echo.NewHTTPError(http.StatusBadRequest, "invalid log level")
 	}
-	_, err := queries.SetSetting(ctx, sqlc.SetSettingParams{
+	if _, err := queries.SetSetting(ctx, sqlc.SetSettingParams{
 		Key:   "log_level",
 		Value: *logLevel,
-	})
-	return err
+	}); err != nil {
+		return err
+	}
+	if s.system.Logs != nil {
+		s.system.Logs.SetLevel(*logLevel)
+	}
+	return nil
+}
+
+// settingLogComponentLevels stores per-component level overrides as JSON.
+const settingLogComponentLevels = "log_component_levels"
+
+// getLogLevels handles GET /api/v1/log/levels
+func (s *Server) getLogLevels(c echo.Context) error {
+	return c.JSON(http.StatusOK, s.system.Logs.Levels())
+}
+
+// updateLogLevels handles PUT /api/v1/log/levels, changing the default
+// level and per-component overrides without a restart.
+func (s *Server) updateLogLevels(c echo.Context) error {
+	var input logger.LevelConfig
+	if err := c.Bind(&input); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+	for component, level := range input.Components {
+		if strings.TrimSpace(component) == "" || !validLogLevels[level] {
+			return echo.NewHTTPError(http.StatusBadRequest, "invalid level for component "+component)
+		}
+	}
+
+	ctx := c.Request().Context()
+	queries := sqlc.New(s.dbManager.Conn())
+	if input.Level != "" {
+		if err := s.updateLogLevel(ctx, queries, &input.Level); err != nil {
+			return err
+		}
+	}
+
+	if input.Components == nil {
+		input.Components = map[string]string{}
+	}
+	data, err := json.Marshal(input.Components)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	if _, err := queries.SetSetting(ctx, sqlc.SetSettingParams{
+		Key:   settingLogComponentLevels,
+		Value: string(data),
+	}); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	s.system.Logs.SetComponentLevels(input.Components)
+
+	return c.JSON(http.StatusOK, s.system.Logs.Levels())
 }
 
 func (s *Server) updateLogMaxSizeMB(ctx context.Context, queries *sqlc.Queries, logMaxSizeMB *int) error {
@@ -419,13 +473,22 @@ func (s *Server) regenerateAPIKey(c echo.Context) error {
 func (s *Server) restart(c echo.Context) error {
 	s.logger.Info().Msg("Restart requested via API")
 
+	if !s.triggerRestart() {
+		return echo.NewHTTPError(http.StatusConflict, "Restart already in progress")
+	}
+	return c.JSON(http.StatusOK, map[string]string{
+		"message": "Restart initiated",
+	})
+}
+
+// triggerRestart asks the main loop to restart into a new process. It reports
+// false when a restart is already in progress.
+func (s *Server) triggerRestart() bool {
 	select {
 	case s.restartChan <- true: // true = spawn new process after shutdown
-		return c.JSON(http.StatusOK, map[string]string{
-			"message": "Restart initiated",
-		})
+		return true
 	default:
-		return echo.NewHTTPError(http.StatusConflict, "Restart already in progress")
+		return false
 	}
 }
 
@@ -0,0 +1,154 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/slipstream/slipstream/internal/config"
+	"github.com/slipstream/slipstream/internal/database/sqlc"
+	"github.com/slipstream/slipstream/internal/metadata/fanarttv"
+	"github.com/slipstream/slipstream/internal/metadata/omdb"
+	"github.com/slipstream/slipstream/internal/metadata/tmdb"
+	"github.com/slipstream/slipstream/internal/metadata/tvdb"
+	"github.com/slipstream/slipstream/internal/metadata/tvmaze"
+)
+
+// hostSettingsResponse is the body of GET/PUT /settings/host.
+type hostSettingsResponse struct {
+	File     string               `json:"file"`
+	Settings []config.HostSetting `json:"settings"`
+	Reload   *config.ReloadResult `json:"reload,omitempty"`
+}
+
+// SetConfigBaseline records the config as loaded from file, before any
+// database overrides, so reloads only report values that changed on disk.
+func (s *Server) SetConfigBaseline(cfg *config.Config) {
+	s.configBaseline = cfg
+}
+
+// ReloadConfig re-reads the config file and applies the settings that are
+// safe to change while running. Settings that need a restart are reported
+// but keep their running values.
+func (s *Server) ReloadConfig(ctx context.Context) (*config.ReloadResult, error) {
+	s.reloadMu.Lock()
+	defer s.reloadMu.Unlock()
+
+	next, err := config.Load(s.cfg.File)
+	if err != nil {
+		return nil, err
+	}
+
+	baseline := s.configBaseline
+	if baseline == nil {
+		baseline = s.cfg
+	}
+	result := baseline.Diff(next)
+	s.cfg.ApplyReloadable(next)
+	s.configBaseline = next
+
+	for _, key := range result.Applied {
+		if err := s.applyConfigChange(ctx, key); err != nil {
+			return nil, fmt.Errorf("failed to apply %s: %w", key, err)
+		}
+	}
+
+	if result.Changed() {
+		s.logger.Info().
+			Strs("applied", result.Applied).
+			Strs("restartRequired", result.RestartRequired).
+			Msg("configuration reloaded")
+	}
+	return result, nil
+}
+
+func (s *Server) applyConfigChange(ctx context.Context, key string) error {
+	switch key {
+	case config.KeyLoggingLevel:
+		if s.system.Logs != nil {
+			// A level saved from the settings page still takes precedence
+			s.system.Logs.SetLevel(s.getLogLevel(ctx, sqlc.New(s.dbManager.Conn())))
+		}
+	case "metadata.tmdb", "metadata.tvdb", "metadata.omdb", "metadata.tvmaze", "metadata.fanart",
+		config.KeySeriesProviders, config.KeyArtworkSource:
+		s.reloadMetadataClients()
+	case config.KeyPortalJWTSecret:
+		if s.portal.Auth != nil {
+			return s.portal.Auth.SetJWTSecret(s.cfg.Portal.JWTSecret)
+		}
+	}
+	return nil
+}
+
+// reloadMetadataClients rebuilds the real metadata clients from the current
+// config. In developer mode the mock clients stay active until it is turned off.
+func (s *Server) reloadMetadataClients() {
+	s.metadata.RealTMDBClient = tmdb.NewClient(s.cfg.Metadata.TMDB, s.logger)
+	s.metadata.RealTVDBClient = tvdb.NewClient(s.cfg.Metadata.TVDB, s.logger)
+	s.metadata.RealOMDBClient = omdb.NewClient(s.cfg.Metadata.OMDB, s.logger)
+
+	if s.dbManager.IsDevMode() {
+		return
+	}
+	s.metadata.Service.SetClients(s.metadata.RealTMDBClient, s.metadata.RealTVDBClient, s.metadata.RealOMDBClient)
+	s.metadata.Service.SetTVMazeClient(tvmaze.NewClient(s.cfg.Metadata.TVMaze, s.logger))
+	s.metadata.Service.SetFanartClient(fanarttv.NewClient(s.cfg.Metadata.Fanart, s.logger))
+	s.metadata.Service.SetSeriesProviders(s.cfg.Metadata.SeriesProviders)
+	s.metadata.Service.SetArtworkSource(s.cfg.Metadata.ArtworkSource)
+	s.metadata.Service.RegisterMetadataProviders()
+}
+
+// getHostSettings handles GET /api/v1/settings/host
+func (s *Server) getHostSettings(c echo.Context) error {
+	return c.JSON(http.StatusOK, hostSettingsResponse{
+		File:     s.hostConfigFile(),
+		Settings: s.cfg.HostSettings(),
+	})
+}
+
+// updateHostSettings handles PUT /api/v1/settings/host
+func (s *Server) updateHostSettings(c echo.Context) error {
+	var input map[string]any
+	if err := c.Bind(&input); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	values, err := config.ValidateHostValues(input)
+	if err != nil {
+		if errors.Is(err, config.ErrUnknownHostKey) || errors.Is(err, config.ErrInvalidHostValue) {
+			return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+		}
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	path := s.hostConfigFile()
+	if len(values) > 0 {
+		if err := config.WriteHostValues(path, values); err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+		}
+		s.cfg.File = path
+	}
+
+	result, err := s.ReloadConfig(c.Request().Context())
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, hostSettingsResponse{
+		File:     path,
+		Settings: s.cfg.HostSettings(),
+		Reload:   result,
+	})
+}
+
+// hostConfigFile returns the config file edits are written to, creating
+// one in the platform config directory when running without a file.
+func (s *Server) hostConfigFile() string {
+	if s.cfg.File != "" {
+		return s.cfg.File
+	}
+	return config.DefaultFile()
+}
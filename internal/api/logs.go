@@ -3,25 +3,37 @@ package api
 import (
 	"net/http"
 	"os"
+	"strconv"
 
 	"github.com/labstack/echo/v4"
+
 	"github.com/slipstream/slipstream/internal/logger"
+	"github.com/slipstream/slipstream/internal/websocket"
 )
 
+// tailBufferSize is how many entries a tail client may fall behind before
+// entries are dropped for it.
+const tailBufferSize = 256
+
 // LogsProvider provides access to log data.
 type LogsProvider interface {
 	GetRecentLogs() []logger.LogEntry
 	GetLogFilePath() string
+	SetLevel(level string)
+	Levels() logger.LevelConfig
+	SetComponentLevels(components map[string]string)
+	SubscribeLogs(buffer int) (entries <-chan logger.LogEntry, unsubscribe func())
 }
 
 // LogsHandlers handles log-related HTTP endpoints.
 type LogsHandlers struct {
 	provider LogsProvider
+	hub      *websocket.Hub
 }
 
 // NewLogsHandlers creates a new logs handlers instance.
-func NewLogsHandlers(provider LogsProvider) *LogsHandlers {
-	return &LogsHandlers{provider: provider}
+func NewLogsHandlers(provider LogsProvider, hub *websocket.Hub) *LogsHandlers {
+	return &LogsHandlers{provider: provider, hub: hub}
 }
 
 // RegisterRoutes registers log routes on the given group.
@@ -30,6 +42,76 @@ func (h *LogsHandlers) RegisterRoutes(g *echo.Group) {
 	g.GET("/download", h.DownloadLogFile)
 }
 
+// RegisterLogRoutes registers the filterable log API on the given group.
+func (h *LogsHandlers) RegisterLogRoutes(g *echo.Group) {
+	g.GET("", h.QueryLogs)
+}
+
+// QueryLogs handles GET /api/v1/log. Recent entries can be filtered with
+// ?level= (minimum), ?component=, ?q= (message text) and ?limit= (newest N).
+func (h *LogsHandlers) QueryLogs(c echo.Context) error {
+	filter, err := parseLogFilter(c)
+	if err != nil {
+		return err
+	}
+	return c.JSON(http.StatusOK, filter.Apply(h.provider.GetRecentLogs()))
+}
+
+// TailLogs handles GET /api/v1/log/tail, streaming new entries matching
+// the same filters as QueryLogs over a WebSocket.
+func (h *LogsHandlers) TailLogs(c echo.Context) error {
+	if h.hub == nil {
+		return echo.NewHTTPError(http.StatusServiceUnavailable, "log streaming unavailable")
+	}
+	filter, err := parseLogFilter(c)
+	if err != nil {
+		return err
+	}
+	entries, unsubscribe := h.provider.SubscribeLogs(tailBufferSize)
+	if entries == nil {
+		return echo.NewHTTPError(http.StatusServiceUnavailable, "log streaming disabled")
+	}
+	defer unsubscribe()
+
+	done := make(chan struct{})
+	defer close(done)
+	out := make(chan any, tailBufferSize)
+	go func() {
+		defer close(out)
+		for entry := range entries {
+			if !filter.Matches(&entry) {
+				continue
+			}
+			select {
+			case out <- entry:
+			case <-done:
+				return
+			}
+		}
+	}()
+
+	return h.hub.ServeStream(c, "logs:entry", out)
+}
+
+func parseLogFilter(c echo.Context) (*logger.Filter, error) {
+	filter := &logger.Filter{
+		Level:     c.QueryParam("level"),
+		Component: c.QueryParam("component"),
+		Search:    c.QueryParam("q"),
+	}
+	if filter.Level != "" && !validLogLevels[filter.Level] {
+		return nil, echo.NewHTTPError(http.StatusBadRequest, "invalid log level")
+	}
+	if raw := c.QueryParam("limit"); raw != "" {
+		limit, err := strconv.Atoi(raw)
+		if err != nil || limit < 0 {
+			return nil, echo.NewHTTPError(http.StatusBadRequest, "invalid limit")
+		}
+		filter.Limit = limit
+	}
+	return filter, nil
+}
+
 // GetRecentLogs returns recent log entries from the ring buffer.
 func (h *LogsHandlers) GetRecentLogs(c echo.Context) error {
 	logs := h.provider.GetRecentLogs()
@@ -0,0 +1,43 @@
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+	"sync"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/slipstream/slipstream/internal/config"
+	"github.com/slipstream/slipstream/internal/openapi"
+)
+
+// openAPIDoc caches the rendered OpenAPI document. It is built on first
+// request because some routes, such as the log API, are registered after
+// NewServer returns.
+type openAPIDoc struct {
+	once sync.Once
+	body []byte
+	err  error
+}
+
+// getOpenAPI serves the OpenAPI 3 description of every registered route.
+func (s *Server) getOpenAPI(c echo.Context) error {
+	s.openAPI.once.Do(func() {
+		cat, err := openapi.LoadCatalog()
+		if err != nil {
+			s.openAPI.err = err
+			return
+		}
+		doc := openapi.Build(s.echo.Routes(), cat, openapi.Info{
+			Title:       "SlipStream API",
+			Description: "REST API for the SlipStream media manager.",
+			Version:     config.Version,
+		})
+		s.openAPI.body, s.openAPI.err = json.Marshal(doc)
+	})
+	if s.openAPI.err != nil {
+		s.logger.Error().Err(s.openAPI.err).Msg("Failed to build OpenAPI document")
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to build OpenAPI document")
+	}
+	return c.JSONBlob(http.StatusOK, s.openAPI.body)
+}
@@ -0,0 +1,80 @@
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/slipstream/slipstream/internal/openapi"
+)
+
+func TestOpenAPI_CatalogCoversRoutes(t *testing.T) {
+	ts, cleanup := setupTestServer(t)
+	defer cleanup()
+
+	cat, err := openapi.LoadCatalog()
+	if err != nil {
+		t.Fatalf("LoadCatalog() error = %v", err)
+	}
+
+	for _, r := range ts.echo.Routes() {
+		if !openapi.Documented(r) {
+			continue
+		}
+		if _, ok := cat.Handlers[r.Name]; !ok {
+			t.Errorf("%s %s: handler %s is missing from the OpenAPI catalog; run make openapi", r.Method, r.Path, r.Name)
+		}
+	}
+}
+
+func TestOpenAPI_Document(t *testing.T) {
+	ts, cleanup := setupTestServer(t)
+	defer cleanup()
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/openapi.json", http.NoBody)
+	rec := httptest.NewRecorder()
+
+	ts.echo.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("GetOpenAPI status = %d, want %d", rec.Code, http.StatusOK)
+	}
+
+	var doc openapi.Document
+	if err := json.Unmarshal(rec.Body.Bytes(), &doc); err != nil {
+		t.Fatalf("Failed to parse response: %v", err)
+	}
+	if doc.OpenAPI != openapi.Version {
+		t.Errorf("openapi = %q, want %q", doc.OpenAPI, openapi.Version)
+	}
+
+	movies, ok := doc.Paths["/api/v1/movies/{id}"]
+	if !ok {
+		t.Fatal("document is missing /api/v1/movies/{id}")
+	}
+	get := movies["get"]
+	if get == nil || get.Responses["200"] == nil {
+		t.Fatal("GET /api/v1/movies/{id} has no 200 response")
+	}
+	if ref := get.Responses["200"].Content[openapi.ContentJSON].Schema.Ref; ref != openapi.ComponentRef("library.movies.Movie") {
+		t.Errorf("GET /api/v1/movies/{id} schema = %q, want the movie component", ref)
+	}
+
+	ids := make(map[string]string)
+	for path, item := range doc.Paths {
+		for method, op := range item {
+			if prev, ok := ids[op.OperationID]; ok {
+				t.Errorf("operationId %q used by %s and %s %s", op.OperationID, prev, method, path)
+			}
+			ids[op.OperationID] = method + " " + path
+			for _, resp := range op.Responses {
+				for _, media := range resp.Content {
+					if ref := media.Schema.Ref; ref != "" && doc.Components.Schemas[ref[len(openapi.ComponentRef("")):]] == nil {
+						t.Errorf("%s %s references unknown schema %s", method, path, ref)
+					}
+				}
+			}
+		}
+	}
+}
@@ -8,6 +8,7 @@ import (
 
 	"github.com/slipstream/slipstream/internal/database/sqlc"
 	"github.com/slipstream/slipstream/internal/downloader"
+	"github.com/slipstream/slipstream/internal/metadata"
 	"github.com/slipstream/slipstream/internal/module"
 	"github.com/slipstream/slipstream/internal/portal/autoapprove"
 	"github.com/slipstream/slipstream/internal/portal/requests"
@@ -115,6 +116,25 @@ func (a *portalMediaLookupAdapter) GetSeriesTvdbID(ctx context.Context, seriesID
 	return &series.TvdbID.Int64, nil
 }
 
+// portalSeasonCounterAdapter adapts metadata.Service to requests.SeasonCounter.
+type portalSeasonCounterAdapter struct {
+	metadata *metadata.Service
+}
+
+func (a *portalSeasonCounterAdapter) CountSeasons(ctx context.Context, tmdbID, tvdbID int64) (int64, error) {
+	seasons, err := a.metadata.GetSeriesSeasons(ctx, int(tmdbID), int(tvdbID))
+	if err != nil {
+		return 0, err
+	}
+	var count int64
+	for i := range seasons {
+		if seasons[i].SeasonNumber > 0 {
+			count++
+		}
+	}
+	return count, nil
+}
+
 // portalEnabledChecker checks if the external requests portal is enabled.
 type portalEnabledChecker struct {
 	queries *sqlc.Queries
@@ -23,6 +23,7 @@ import (
 	"github.com/slipstream/slipstream/internal/library/scanner"
 	"github.com/slipstream/slipstream/internal/mediainfo"
 	"github.com/slipstream/slipstream/internal/metadata"
+	"github.com/slipstream/slipstream/internal/metadata/imagecache"
 	"github.com/slipstream/slipstream/internal/module"
 	moviemod "github.com/slipstream/slipstream/internal/modules/movie"
 	tvmod "github.com/slipstream/slipstream/internal/modules/tv"
@@ -60,6 +61,14 @@ func provideArtworkConfig(cfg *config.Config) metadata.ArtworkConfig {
 	}
 }
 
+func provideImageCacheConfig(cfg *config.Config) imagecache.Config {
+	dataDir := filepath.Dir(cfg.Database.Path)
+	return imagecache.Config{
+		Dir:     filepath.Join(dataDir, "imagecache"),
+		Timeout: 30 * time.Second,
+	}
+}
+
 func provideAutoSearchConfig(cfg *config.Config) *config.AutoSearchConfig {
 	return &cfg.AutoSearch
 }
@@ -76,8 +85,15 @@ func provideMediainfoConfig() mediainfo.Config {
 	return mediainfo.DefaultConfig()
 }
 
-func provideImporterConfig() importer.Config {
-	return importer.DefaultConfig()
+func provideImporterConfig(cfg *config.Config) importer.Config {
+	importCfg := importer.DefaultConfig()
+	if cfg.Import.Workers > 0 {
+		importCfg.WorkerCount = cfg.Import.Workers
+	}
+	if cfg.Import.MaxCopiesPerVolume >= 0 {
+		importCfg.MaxCopiesPerVolume = cfg.Import.MaxCopiesPerVolume
+	}
+	return importCfg
 }
 
 // --- Error-swallowing providers for services with non-fatal failures ---
@@ -2,33 +2,47 @@ package api
 
 import (
 	"context"
+	"strings"
 
 	"github.com/labstack/echo/v4"
 	"github.com/labstack/echo/v4/middleware"
 
+	"github.com/slipstream/slipstream/internal/activity"
 	"github.com/slipstream/slipstream/internal/api/handlers"
 	apimw "github.com/slipstream/slipstream/internal/api/middleware"
+	"github.com/slipstream/slipstream/internal/apierror"
+	"github.com/slipstream/slipstream/internal/arrcompat"
 	"github.com/slipstream/slipstream/internal/arrimport"
 	"github.com/slipstream/slipstream/internal/auth"
 	"github.com/slipstream/slipstream/internal/autosearch"
+	"github.com/slipstream/slipstream/internal/backup"
 	"github.com/slipstream/slipstream/internal/calendar"
 	"github.com/slipstream/slipstream/internal/database/sqlc"
 	"github.com/slipstream/slipstream/internal/defaults"
 	"github.com/slipstream/slipstream/internal/filesystem"
+	"github.com/slipstream/slipstream/internal/graphql"
 	"github.com/slipstream/slipstream/internal/health"
 	"github.com/slipstream/slipstream/internal/history"
+	"github.com/slipstream/slipstream/internal/homeassistant"
 	importer "github.com/slipstream/slipstream/internal/import"
 	"github.com/slipstream/slipstream/internal/indexer"
 	"github.com/slipstream/slipstream/internal/indexer/grab"
 	"github.com/slipstream/slipstream/internal/indexer/search"
+	"github.com/slipstream/slipstream/internal/indexer/torznab"
+	"github.com/slipstream/slipstream/internal/library/exclusion"
+	"github.com/slipstream/slipstream/internal/library/language"
 	"github.com/slipstream/slipstream/internal/library/librarymanager"
 	"github.com/slipstream/slipstream/internal/library/movies"
+	"github.com/slipstream/slipstream/internal/library/people"
 	"github.com/slipstream/slipstream/internal/library/quality"
 	"github.com/slipstream/slipstream/internal/library/rootfolder"
 	"github.com/slipstream/slipstream/internal/library/scanner"
 	"github.com/slipstream/slipstream/internal/library/slots"
+	"github.com/slipstream/slipstream/internal/library/smartlist"
 	"github.com/slipstream/slipstream/internal/library/tv"
+	"github.com/slipstream/slipstream/internal/logger"
 	"github.com/slipstream/slipstream/internal/metadata"
+	"github.com/slipstream/slipstream/internal/metadata/imagecache"
 	"github.com/slipstream/slipstream/internal/missing"
 	"github.com/slipstream/slipstream/internal/notification"
 	"github.com/slipstream/slipstream/internal/portal/admin"
@@ -36,9 +50,13 @@ import (
 	portalnotifs "github.com/slipstream/slipstream/internal/portal/notifications"
 	"github.com/slipstream/slipstream/internal/portal/requests"
 	portalsearch "github.com/slipstream/slipstream/internal/portal/search"
+	"github.com/slipstream/slipstream/internal/portal/widget"
 	"github.com/slipstream/slipstream/internal/preferences"
 	"github.com/slipstream/slipstream/internal/prowlarr"
 	"github.com/slipstream/slipstream/internal/rsssync"
+	"github.com/slipstream/slipstream/internal/stats"
+	"github.com/slipstream/slipstream/internal/stream"
+	"github.com/slipstream/slipstream/internal/tags"
 	"github.com/slipstream/slipstream/internal/update"
 )
 
@@ -46,8 +64,16 @@ func (s *Server) setupMiddleware() {
 	// Recovery middleware
 	s.echo.Use(middleware.Recover())
 
-	// Request ID
-	s.echo.Use(middleware.RequestID())
+	// Errors are returned in a JSON envelope carrying the request's correlation ID
+	s.echo.HTTPErrorHandler = apierror.Handler
+
+	// Request ID, doubling as the correlation ID. Handlers and services log
+	// through logger.FromContext(ctx, ...) to have it attached to their lines.
+	s.echo.Use(middleware.RequestIDWithConfig(middleware.RequestIDConfig{
+		RequestIDHandler: func(c echo.Context, id string) {
+			c.SetRequest(c.Request().WithContext(logger.WithCorrelationID(c.Request().Context(), id)))
+		},
+	}))
 
 	// Security headers
 	s.echo.Use(apimw.SecurityHeaders())
@@ -60,15 +86,17 @@ func (s *Server) setupMiddleware() {
 
 	// Request logging
 	s.echo.Use(middleware.RequestLoggerWithConfig(middleware.RequestLoggerConfig{
-		LogURI:      true,
-		LogStatus:   true,
-		LogLatency:  true,
-		LogMethod:   true,
-		LogError:    true,
-		HandleError: true,
+		LogURI:       true,
+		LogStatus:    true,
+		LogLatency:   true,
+		LogMethod:    true,
+		LogError:     true,
+		LogRequestID: true,
+		HandleError:  true,
 		LogValuesFunc: func(c echo.Context, v middleware.RequestLoggerValues) error {
 			if v.Error != nil {
 				s.logger.Error().
+					Str("correlationId", v.RequestID).
 					Str("method", v.Method).
 					Str("uri", v.URI).
 					Int("status", v.Status).
@@ -77,6 +105,7 @@ func (s *Server) setupMiddleware() {
 					Msg("request error")
 			} else {
 				s.logger.Info().
+					Str("correlationId", v.RequestID).
 					Str("method", v.Method).
 					Str("uri", v.URI).
 					Int("status", v.Status).
@@ -94,8 +123,10 @@ func (s *Server) setupMiddleware() {
 	s.echo.Use(middleware.GzipWithConfig(middleware.GzipConfig{
 		Level: 5,
 		Skipper: func(c echo.Context) bool {
-			// Skip compression for WebSocket
-			return c.Request().Header.Get("Upgrade") == "websocket"
+			// Skip compression for WebSocket, SSE and ranged file streams
+			return c.Request().Header.Get("Upgrade") == "websocket" ||
+				c.Request().URL.Path == "/events" ||
+				strings.HasPrefix(c.Request().URL.Path, "/api/v1/stream/")
 		},
 	}))
 }
@@ -106,6 +137,7 @@ func (s *Server) setupRoutes() {
 
 	api := s.echo.Group("/api/v1")
 	api.GET("/status", s.getStatus)
+	api.GET("/openapi.json", s.getOpenAPI)
 
 	s.setupAuthRoutes(api)
 
@@ -117,6 +149,8 @@ func (s *Server) setupRoutes() {
 	settings.POST("/apikey", s.regenerateAPIKey)
 	settings.GET("/modules", s.getModuleEnabled)
 	settings.PUT("/modules", s.updateModuleEnabled)
+	settings.GET("/host", s.getHostSettings)
+	settings.PUT("/host", s.updateHostSettings)
 
 	s.setupSystemRoutes(protected)
 	s.setupLibraryRoutes(api, protected)
@@ -126,6 +160,7 @@ func (s *Server) setupRoutes() {
 	s.setupAutomationRoutes(protected, settings)
 	s.setupNotificationRoutes(api, protected)
 	s.setupSchedulerRoutes(protected)
+	s.setupIntegrationRoutes(api, settings)
 	s.setupPortalRoutes(api)
 }
 
@@ -167,6 +202,9 @@ func (s *Server) setupSystemRoutes(protected *echo.Group) {
 	healthHandlers.RegisterRoutes(protected.Group("/system/health"))
 
 	protected.POST("/system/restart", s.restart)
+
+	backupHandlers := backup.NewHandlers(s.system.Backup, s.triggerRestart)
+	backupHandlers.RegisterRoutes(protected.Group("/system/backup"))
 	protected.GET("/system/firewall", s.checkFirewall)
 
 	updateHandlers := update.NewHandlers(s.system.Update)
@@ -180,19 +218,49 @@ func (s *Server) setupLibraryRoutes(api, protected *echo.Group) {
 	tvHandlers := tv.NewHandlers(s.library.TV)
 	tvHandlers.RegisterRoutes(protected.Group("/series"))
 
+	// Read-only GraphQL over the library, queue and history for dashboards
+	graphqlHandlers := graphql.NewHandlers(s.library.GraphQL)
+	graphqlHandlers.RegisterRoutes(protected.Group("/graphql"))
+
 	libraryManagerHandlers := librarymanager.NewHandlers(s.library.LibraryManager)
 	protected.POST("/movies/refresh", libraryManagerHandlers.RefreshAllMovies)
 	protected.POST("/series/refresh", libraryManagerHandlers.RefreshAllSeries)
 	protected.POST("/movies/:id/refresh", libraryManagerHandlers.RefreshMovie)
 	protected.POST("/series/:id/refresh", libraryManagerHandlers.RefreshSeries)
+	protected.POST("/movies/:id/move", libraryManagerHandlers.MoveMovie)
+	protected.POST("/series/:id/move", libraryManagerHandlers.MoveSeries)
 
 	libraryGroup := protected.Group("/library")
 	libraryGroup.POST("/movies", libraryManagerHandlers.AddMovie)
 	libraryGroup.POST("/series", libraryManagerHandlers.AddSeries)
+	libraryGroup.GET("/add/:mediaType/lookup", libraryManagerHandlers.AddLookup)
+	libraryGroup.POST("/add/:mediaType/options", libraryManagerHandlers.AddOptions)
+	libraryGroup.POST("/add/:mediaType", libraryManagerHandlers.AddFromWizard)
+	libraryGroup.GET("/orphans", libraryManagerHandlers.GetOrphanReport)
+	libraryGroup.POST("/orphans", libraryManagerHandlers.BuildOrphanReport)
+	libraryGroup.POST("/orphans/fix", libraryManagerHandlers.FixOrphan)
+	libraryGroup.GET("/quality-audit", libraryManagerHandlers.GetQualityAuditReport)
+	libraryGroup.POST("/quality-audit", libraryManagerHandlers.BuildQualityAuditReport)
+	libraryGroup.POST("/quality-audit/search", libraryManagerHandlers.SearchAuditedFile)
 
 	qualityHandlers := quality.NewHandlers(s.library.Quality)
 	qualityHandlers.RegisterRoutes(protected.Group("/qualityprofiles"))
 
+	languageHandlers := language.NewHandlers(s.library.Language)
+	languageHandlers.RegisterRoutes(protected.Group("/languageprofiles"))
+
+	smartListHandlers := smartlist.NewHandlers(s.library.SmartList)
+	smartListHandlers.RegisterRoutes(protected.Group("/smartlists"))
+
+	exclusionHandlers := exclusion.NewHandlers(s.library.Exclusion)
+	exclusionHandlers.RegisterRoutes(protected.Group("/exclusions"))
+
+	peopleHandlers := people.NewHandlers(s.library.People)
+	peopleHandlers.RegisterRoutes(protected.Group("/people"))
+
+	tagHandlers := tags.NewHandlers(s.library.Tags)
+	tagHandlers.RegisterRoutes(protected.Group("/tags"))
+
 	slotsHandlers := slots.NewHandlers(s.library.Slots)
 	slotsHandlers.RegisterRoutes(protected.Group("/slots"))
 
@@ -213,6 +281,8 @@ func (s *Server) setupLibraryRoutes(api, protected *echo.Group) {
 	rootFoldersGroup.POST("/:id/scan", libraryManagerHandlers.ScanRootFolder)
 	rootFoldersGroup.GET("/:id/scan", libraryManagerHandlers.GetScanStatus)
 	rootFoldersGroup.DELETE("/:id/scan", libraryManagerHandlers.CancelScan)
+	rootFoldersGroup.GET("/:id/import", libraryManagerHandlers.PreviewImport)
+	rootFoldersGroup.POST("/:id/import", libraryManagerHandlers.Import)
 	protected.GET("/scans", libraryManagerHandlers.GetAllScanStatuses)
 	protected.POST("/scans", libraryManagerHandlers.ScanAllRootFolders)
 
@@ -220,6 +290,9 @@ func (s *Server) setupLibraryRoutes(api, protected *echo.Group) {
 	metadataHandlers := metadata.NewHandlers(s.metadata.Service, s.metadata.ArtworkDownloader)
 	metadataHandlers.RegisterArtworkRoutes(api.Group("/metadata"))
 
+	// Cached and resized images (public, like artwork)
+	imagecache.NewHandlers(s.metadata.ImageCache, s.metadata.ArtworkDownloader).RegisterRoutes(api.Group("/media"))
+
 	metadataGroup := api.Group("/metadata")
 	metadataGroup.Use(s.portal.AuthMiddleware.AnyAuth())
 	metadataHandlers.RegisterRoutes(metadataGroup)
@@ -255,7 +328,7 @@ func (s *Server) setupIndexerRoutes(protected *echo.Group) {
 	indexerHandlers.SetStatusService(s.search.Status)
 	indexerHandlers.RegisterRoutes(indexersGroup)
 
-	prowlarrHandlers := prowlarr.NewHandlers(s.search.Prowlarr, s.search.ProwlarrMode)
+	prowlarrHandlers := prowlarr.NewHandlers(s.search.Prowlarr, s.search.ProwlarrMode, s.search.ProwlarrSync)
 	prowlarrHandlers.RegisterRoutes(indexersGroup)
 }
 
@@ -273,12 +346,14 @@ func (s *Server) setupDownloadRoutes(protected *echo.Group) {
 	protected.POST("/queue/:id/pause", s.pauseDownload)
 	protected.POST("/queue/:id/resume", s.resumeDownload)
 	protected.POST("/queue/:id/fastforward", s.fastForwardDownload)
+	protected.POST("/queue/:id/remap", s.remapDownload)
 	protected.DELETE("/queue/:id", s.removeFromQueue)
+	protected.GET("/queue/stalled/settings", s.getStalledDownloadSettings)
+	protected.PUT("/queue/stalled/settings", s.updateStalledDownloadSettings)
+	protected.POST("/queue/stalled/check", s.checkStalledDownloads)
 }
 
 func (s *Server) setupMediaRoutes(api, protected *echo.Group) {
-	_ = api // reserved for future shared media routes
-
 	historyHandlers := history.NewHandlers(s.system.History)
 	historyHandlers.RegisterRoutes(protected.Group("/history"))
 	protected.GET("/history/indexer", s.getIndexerHistory)
@@ -295,11 +370,29 @@ func (s *Server) setupMediaRoutes(api, protected *echo.Group) {
 	preferencesHandlers := preferences.NewHandlers(s.system.Preferences)
 	preferencesHandlers.RegisterRoutes(protected.Group("/preferences"))
 
+	userPreferences := api.Group("/preferences/user")
+	userPreferences.Use(s.portal.AuthMiddleware.AnyAuth())
+	preferencesHandlers.RegisterUserRoutes(userPreferences)
+
 	calendarHandlers := calendar.NewHandlers(s.system.Calendar)
 	calendarHandlers.RegisterRoutes(protected.Group("/calendar"))
 
+	activityHandlers := activity.NewHandlers(s.system.Activity)
+	activityHandlers.RegisterRoutes(protected.Group("/activity"))
+
 	missingHandlers := missing.NewHandlers(s.system.Missing)
 	missingHandlers.RegisterRoutes(protected.Group("/missing"))
+	missingHandlers.RegisterWantedRoutes(protected.Group("/wanted"))
+
+	statsHandlers := stats.NewHandlers(s.system.Stats)
+	statsHandlers.RegisterRoutes(protected.Group("/stats"))
+
+	// Direct file streaming for external players; portal users need can_stream
+	streamHandlers := stream.NewHandlers(s.system.Stream)
+	streamHandlers.RegisterAdminRoutes(protected.Group("/stream"))
+	streamGroup := api.Group("/stream")
+	streamGroup.Use(s.portal.AuthMiddleware.MediaAuth())
+	streamHandlers.RegisterRoutes(streamGroup)
 }
 
 func (s *Server) setupAutomationRoutes(protected, settings *echo.Group) {
@@ -347,6 +440,34 @@ func (s *Server) setupSchedulerRoutes(protected *echo.Group) {
 	schedulerGroup.POST("/tasks/:id/run", schedulerHandler.RunTask)
 }
 
+// setupIntegrationRoutes configures routes for external integrations that authenticate with the API key.
+func (s *Server) setupIntegrationRoutes(api, settings *echo.Group) {
+	integrations := api.Group("/integrations")
+	integrations.Use(s.apiKeyAuthMiddleware())
+
+	homeAssistantHandlers := homeassistant.NewHandlers(s.system.HomeAssistant)
+	homeAssistantHandlers.RegisterRoutes(integrations.Group("/homeassistant"))
+	homeAssistantHandlers.RegisterSettingsRoutes(settings)
+
+	torznabGroup := api.Group("/torznab")
+	torznabGroup.Use(s.apiKeyAuthMiddleware())
+	torznab.NewHandlers(s.search.Torznab).RegisterRoutes(torznabGroup)
+
+	// Sonarr/Radarr v3 shim for request and notification tools, mounted where
+	// those tools expect an *arr instance's API to live
+	arrHandlers := arrcompat.NewHandlers(arrcompat.NewService(
+		s.library.Movies, s.library.TV, s.library.LibraryManager, s.library.Quality,
+		s.library.RootFolder, s.library.Tags, s.library.Language, s.download.Service,
+		s.system.History, s.automation.Autosearch, s.logger,
+	))
+	radarrGroup := s.echo.Group("/radarr/api/v3")
+	radarrGroup.Use(s.apiKeyAuthMiddleware())
+	arrHandlers.RegisterRadarrRoutes(radarrGroup)
+	sonarrGroup := s.echo.Group("/sonarr/api/v3")
+	sonarrGroup.Use(s.apiKeyAuthMiddleware())
+	arrHandlers.RegisterSonarrRoutes(sonarrGroup)
+}
+
 // setupPortalRoutes configures External Requests portal routes.
 func (s *Server) setupPortalRoutes(api *echo.Group) {
 	// Portal auth routes (login, signup, profile) - require portal to be enabled
@@ -390,6 +511,11 @@ func (s *Server) setupPortalRoutes(api *echo.Group) {
 	libraryGroup.Use(s.portal.AuthMiddleware.AnyAuth())
 	libraryHandlers.RegisterRoutes(libraryGroup)
 
+	// Portal landing page coming-soon feed
+	comingSoonGroup := requestsGroup.Group("/coming-soon")
+	comingSoonGroup.Use(s.portal.AuthMiddleware.AnyAuth())
+	calendar.NewHandlers(s.system.Calendar).RegisterComingSoonRoutes(comingSoonGroup)
+
 	// Portal request handlers
 	requestHandlers := requests.NewHandlers(
 		s.portal.Requests,
@@ -402,6 +528,12 @@ func (s *Server) setupPortalRoutes(api *echo.Group) {
 	)
 	requestHandlers.RegisterRoutes(requestsGroup, s.portal.AuthMiddleware)
 
+	// Embeddable request widget: key-authenticated, CORS-enabled subset of the portal API
+	widgetGroup := api.Group("/widget")
+	widgetGroup.Use(s.portal.AuthMiddleware.PortalEnabled())
+	widgetHandlers := widget.NewHandlers(s.portal.Widget, s.portal.WidgetLimiter, s.portal.Requests)
+	widgetHandlers.RegisterRoutes(widgetGroup, searchHandlers, requestHandlers)
+
 	// Portal user notifications
 	portalNotifHandlers := portalnotifs.NewHandlers(s.portal.Notifications)
 	portalNotifHandlers.RegisterRoutes(requestsGroup.Group("/notifications"), s.portal.AuthMiddleware)
@@ -432,6 +564,10 @@ func (s *Server) setupPortalAdminRoutes(api *echo.Group) {
 	adminRequestHandlers.SetLibraryChecker(s.portal.AdminLibraryChecker)
 	adminRequestHandlers.RegisterRoutes(adminGroup, s.portal.AuthMiddleware)
 
+	// Admin widget keys
+	adminWidgetKeyHandlers := admin.NewWidgetKeysHandlers(s.portal.Widget)
+	adminWidgetKeyHandlers.RegisterRoutes(adminGroup.Group("/widget-keys"), s.portal.AuthMiddleware)
+
 	// Admin settings
 	s.portal.AdminSettings.RegisterRoutes(adminGroup.Group("/settings"), s.portal.AuthMiddleware)
 }
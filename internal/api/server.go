@@ -3,6 +3,7 @@ package api
 import (
 	"context"
 	"database/sql"
+	"sync"
 
 	"github.com/labstack/echo/v4"
 	"github.com/rs/zerolog"
@@ -46,6 +47,9 @@ type Server struct {
 
 	restartChan    chan<- bool
 	configuredPort int
+	reloadMu       sync.Mutex
+	configBaseline *config.Config
+	openAPI        openAPIDoc
 }
 
 // SetConfiguredPort sets the original configured port before any conflict resolution.
@@ -61,8 +65,17 @@ func (s *Server) SetLogsProvider(provider LogsProvider) {
 	// Register logs routes (called after NewServer, so we register here)
 	logsGroup := s.echo.Group("/api/v1/system/logs")
 	logsGroup.Use(s.adminAuthMiddleware())
-	logsHandlers := NewLogsHandlers(provider)
+	logsHandlers := NewLogsHandlers(provider, s.hub)
 	logsHandlers.RegisterRoutes(logsGroup)
+
+	logGroup := s.echo.Group("/api/v1/log")
+	// The tail WebSocket authenticates with its subprotocol token, as
+	// browsers cannot send an Authorization header on upgrade
+	logGroup.GET("/tail", logsHandlers.TailLogs)
+	logAPI := logGroup.Group("", s.adminAuthMiddleware())
+	logsHandlers.RegisterLogRoutes(logAPI)
+	logAPI.GET("/levels", s.getLogLevels)
+	logAPI.PUT("/levels", s.updateLogLevels)
 }
 
 // NewServer creates a new API server instance.
@@ -151,9 +164,24 @@ func (s *Server) registerLibraryDependentTasks(cfg *config.Config, logger *zerol
 	if err := tasks.RegisterLibraryScanTask(s.automation.Scheduler, s.library.LibraryManager, s.library.RootFolder, logger); err != nil {
 		logger.Error().Err(err).Msg("Failed to register library scan task")
 	}
+	if err := tasks.RegisterDiskRescanTask(s.automation.Scheduler, s.library.LibraryManager); err != nil {
+		logger.Error().Err(err).Msg("Failed to register disk rescan task")
+	}
+	if err := tasks.RegisterSlotReconcileTask(s.automation.Scheduler, s.library.Slots); err != nil {
+		logger.Error().Err(err).Msg("Failed to register slot reconcile task")
+	}
+	if err := tasks.RegisterOrphanReportTask(s.automation.Scheduler, s.library.LibraryManager); err != nil {
+		logger.Error().Err(err).Msg("Failed to register orphan report task")
+	}
+	if err := tasks.RegisterQualityAuditTask(s.automation.Scheduler, s.library.LibraryManager); err != nil {
+		logger.Error().Err(err).Msg("Failed to register quality audit task")
+	}
 	if err := tasks.RegisterUnreleasedRefreshTask(s.automation.Scheduler, s.library.LibraryManager); err != nil {
 		logger.Error().Err(err).Msg("Failed to register unreleased refresh task")
 	}
+	if err := tasks.RegisterArtworkGCTask(s.automation.Scheduler, s.library.LibraryManager, s.metadata.ArtworkDownloader, s.metadata.ImageCache, s.dbManager.IsDevMode); err != nil {
+		logger.Error().Err(err).Msg("Failed to register artwork cleanup task")
+	}
 	if err := tasks.RegisterDownloadClientHealthTask(s.automation.Scheduler, s.download.Service, s.system.Health, &cfg.Health, logger); err != nil {
 		logger.Error().Err(err).Msg("Failed to register download client health task")
 	}
@@ -163,17 +191,42 @@ func (s *Server) registerLibraryDependentTasks(cfg *config.Config, logger *zerol
 	if err := tasks.RegisterProwlarrHealthTask(s.automation.Scheduler, s.search.Prowlarr, s.search.ProwlarrMode, s.system.Health, logger); err != nil {
 		logger.Error().Err(err).Msg("Failed to register Prowlarr health task")
 	}
+	if err := tasks.RegisterProwlarrSyncTask(s.automation.Scheduler, s.search.ProwlarrSync); err != nil {
+		logger.Error().Err(err).Msg("Failed to register Prowlarr sync task")
+	}
 	storageAdapter := health.NewStorageServiceAdapter(s.filesystem.Storage)
 	storageChecker := health.NewStorageChecker(s.system.Health, storageAdapter, &cfg.Health, logger)
 	if err := tasks.RegisterStorageHealthTask(s.automation.Scheduler, storageChecker, &cfg.Health, logger); err != nil {
 		logger.Error().Err(err).Msg("Failed to register storage health task")
 	}
+	if err := tasks.RegisterRootFolderHealthTask(s.automation.Scheduler, s.library.RootFolder, &cfg.Health); err != nil {
+		logger.Error().Err(err).Msg("Failed to register root folder health task")
+	}
+	importFolders := health.NewDownloaderFolderAdapter(s.download.Service)
+	s.system.Health.RegisterCheck(health.NewImportFolderCheck(importFolders))
+	s.system.Health.RegisterCheck(health.NewHardlinkCheck(importFolders, health.NewRootFolderAdapter(s.library.RootFolder)))
+	s.system.Health.RegisterCheck(health.NewStalledQueueCheck(health.NewDownloaderQueueAdapter(s.download.Service), cfg.Health.QueueStallThreshold))
+	if manager := s.search.Indexer.GetManager(); manager != nil {
+		s.system.Health.RegisterCheck(health.NewDefinitionsCheck(manager))
+	}
+	if err := tasks.RegisterHealthChecksTask(s.automation.Scheduler, s.system.Health, &cfg.Health); err != nil {
+		logger.Error().Err(err).Msg("Failed to register health checks task")
+	}
 	if err := tasks.RegisterImportScanTask(s.automation.Scheduler, s.automation.Import, logger); err != nil {
 		logger.Error().Err(err).Msg("Failed to register import scan task")
 	}
 	if err := tasks.RegisterHistoryCleanupTask(s.automation.Scheduler, s.system.History); err != nil {
 		logger.Error().Err(err).Msg("Failed to register history cleanup task")
 	}
+	if err := tasks.RegisterIndexerHistoryCleanupTask(s.automation.Scheduler, s.search.Status); err != nil {
+		logger.Error().Err(err).Msg("Failed to register indexer history cleanup task")
+	}
+	if err := tasks.RegisterSeedCleanupTask(s.automation.Scheduler, s.download.Service); err != nil {
+		logger.Error().Err(err).Msg("Failed to register seed cleanup task")
+	}
+	if err := tasks.RegisterStalledDownloadsTask(s.automation.Scheduler, s.download.Service); err != nil {
+		logger.Error().Err(err).Msg("Failed to register stalled downloads task")
+	}
 }
 
 // Start begins listening for HTTP requests.
@@ -247,8 +300,11 @@ func (s *Server) Echo() *echo.Echo {
 	return s.echo
 }
 
-// EnsureDefaults creates default data like quality profiles.
+// EnsureDefaults loads quality definitions and creates default data like quality profiles.
 func (s *Server) EnsureDefaults(ctx context.Context) error {
+	if err := s.library.Quality.LoadQualities(ctx); err != nil {
+		s.logger.Error().Err(err).Msg("Failed to load quality definitions")
+	}
 	for _, mt := range []string{"movie", "tv"} {
 		if err := s.library.Quality.EnsureDefaults(ctx, mt); err != nil {
 			s.logger.Warn().Err(err).Str("moduleType", mt).Msg("Failed to ensure default quality profiles")
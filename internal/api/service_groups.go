@@ -1,35 +1,45 @@
 package api
 
 import (
+	"github.com/slipstream/slipstream/internal/activity"
 	authratelimit "github.com/slipstream/slipstream/internal/api/ratelimit"
 	"github.com/slipstream/slipstream/internal/arrimport"
 	"github.com/slipstream/slipstream/internal/auth"
 	"github.com/slipstream/slipstream/internal/autosearch"
 	"github.com/slipstream/slipstream/internal/availability"
+	"github.com/slipstream/slipstream/internal/backup"
 	"github.com/slipstream/slipstream/internal/calendar"
 	"github.com/slipstream/slipstream/internal/decisioning"
 	"github.com/slipstream/slipstream/internal/defaults"
 	"github.com/slipstream/slipstream/internal/downloader"
 	"github.com/slipstream/slipstream/internal/filesystem"
 	"github.com/slipstream/slipstream/internal/firewall"
+	"github.com/slipstream/slipstream/internal/graphql"
 	"github.com/slipstream/slipstream/internal/health"
 	"github.com/slipstream/slipstream/internal/history"
+	"github.com/slipstream/slipstream/internal/homeassistant"
 	importer "github.com/slipstream/slipstream/internal/import"
 	"github.com/slipstream/slipstream/internal/indexer"
 	"github.com/slipstream/slipstream/internal/indexer/grab"
 	"github.com/slipstream/slipstream/internal/indexer/ratelimit"
 	"github.com/slipstream/slipstream/internal/indexer/search"
 	"github.com/slipstream/slipstream/internal/indexer/status"
+	"github.com/slipstream/slipstream/internal/indexer/torznab"
+	"github.com/slipstream/slipstream/internal/library/exclusion"
+	"github.com/slipstream/slipstream/internal/library/language"
 	"github.com/slipstream/slipstream/internal/library/librarymanager"
 	"github.com/slipstream/slipstream/internal/library/movies"
 	"github.com/slipstream/slipstream/internal/library/organizer"
+	"github.com/slipstream/slipstream/internal/library/people"
 	"github.com/slipstream/slipstream/internal/library/quality"
 	"github.com/slipstream/slipstream/internal/library/rootfolder"
 	"github.com/slipstream/slipstream/internal/library/scanner"
 	"github.com/slipstream/slipstream/internal/library/slots"
+	"github.com/slipstream/slipstream/internal/library/smartlist"
 	"github.com/slipstream/slipstream/internal/library/tv"
 	"github.com/slipstream/slipstream/internal/mediainfo"
 	"github.com/slipstream/slipstream/internal/metadata"
+	"github.com/slipstream/slipstream/internal/metadata/imagecache"
 	"github.com/slipstream/slipstream/internal/missing"
 	"github.com/slipstream/slipstream/internal/module"
 	"github.com/slipstream/slipstream/internal/notification"
@@ -43,11 +53,15 @@ import (
 	portalratelimit "github.com/slipstream/slipstream/internal/portal/ratelimit"
 	"github.com/slipstream/slipstream/internal/portal/requests"
 	"github.com/slipstream/slipstream/internal/portal/users"
+	"github.com/slipstream/slipstream/internal/portal/widget"
 	"github.com/slipstream/slipstream/internal/preferences"
 	"github.com/slipstream/slipstream/internal/progress"
 	"github.com/slipstream/slipstream/internal/prowlarr"
 	"github.com/slipstream/slipstream/internal/rsssync"
 	"github.com/slipstream/slipstream/internal/scheduler"
+	"github.com/slipstream/slipstream/internal/stats"
+	"github.com/slipstream/slipstream/internal/stream"
+	"github.com/slipstream/slipstream/internal/tags"
 	"github.com/slipstream/slipstream/internal/update"
 )
 
@@ -57,17 +71,24 @@ type LibraryGroup struct {
 	Movies         *movies.Service
 	TV             *tv.Service
 	Quality        *quality.Service
+	Language       *language.Service
+	SmartList      *smartlist.Service
+	Exclusion      *exclusion.Service
+	People         *people.Service
+	Tags           *tags.Service
 	Slots          *slots.Service
 	RootFolder     *rootfolder.Service
 	LibraryManager *librarymanager.Service
 	Organizer      *organizer.Service
 	Mediainfo      *mediainfo.Service
+	GraphQL        *graphql.Service
 }
 
 // MetadataGroup holds metadata and artwork services.
 type MetadataGroup struct {
 	Service           *metadata.Service
 	ArtworkDownloader *metadata.ArtworkDownloader
+	ImageCache        *imagecache.Cache
 	NetworkLogoStore  *metadata.SQLNetworkLogoStore
 	RealTMDBClient    metadata.TMDBClient
 	RealTVDBClient    metadata.TVDBClient
@@ -99,6 +120,8 @@ type SearchGroup struct {
 	ProwlarrMode   *prowlarr.ModeManager
 	ProwlarrSearch *prowlarr.SearchAdapter
 	ProwlarrGrab   *prowlarr.GrabProvider
+	ProwlarrSync   *prowlarr.IndexerSync
+	Torznab        *torznab.Service
 }
 
 // AutomationGroup holds automation and scheduled task services.
@@ -117,17 +140,22 @@ type AutomationGroup struct {
 
 // SystemGroup holds system-level services.
 type SystemGroup struct {
-	Health       *health.Service
-	Defaults     *defaults.Service
-	Calendar     *calendar.Service
-	Availability *availability.Service
-	Missing      *missing.Service
-	Preferences  *preferences.Service
-	History      *history.Service
-	Progress     *progress.Manager
-	Update       *update.Service
-	Firewall     *firewall.Checker
-	Logs         LogsProvider
+	Health        *health.Service
+	Defaults      *defaults.Service
+	Calendar      *calendar.Service
+	Activity      *activity.Service
+	Availability  *availability.Service
+	Missing       *missing.Service
+	Preferences   *preferences.Service
+	History       *history.Service
+	HomeAssistant *homeassistant.Service
+	Progress      *progress.Manager
+	Stream        *stream.Service
+	Stats         *stats.Service
+	Update        *update.Service
+	Backup        *backup.Service
+	Firewall      *firewall.Checker
+	Logs          LogsProvider
 }
 
 // NotificationGroup holds notification services.
@@ -156,6 +184,8 @@ type PortalGroup struct {
 	LibraryChecker      *requests.LibraryChecker
 	AdminLibraryChecker *adminRequestLibraryCheckerAdapter
 	AdminSettings       *admin.SettingsHandlers
+	Widget              *widget.Service
+	WidgetLimiter       *widget.Limiter
 }
 
 // SecurityGroup holds security services.
@@ -5,14 +5,20 @@ import (
 	"time"
 
 	"github.com/slipstream/slipstream/internal/autosearch"
+	"github.com/slipstream/slipstream/internal/backup"
 	"github.com/slipstream/slipstream/internal/database/sqlc"
 	"github.com/slipstream/slipstream/internal/firewall"
 	"github.com/slipstream/slipstream/internal/indexer"
+	"github.com/slipstream/slipstream/internal/indexer/grab"
+	"github.com/slipstream/slipstream/internal/indexer/ratelimit"
+	"github.com/slipstream/slipstream/internal/library/librarymanager"
+	"github.com/slipstream/slipstream/internal/library/people"
 	"github.com/slipstream/slipstream/internal/library/quality"
 	"github.com/slipstream/slipstream/internal/metadata/omdb"
 	"github.com/slipstream/slipstream/internal/metadata/tmdb"
 	"github.com/slipstream/slipstream/internal/metadata/tvdb"
 	"github.com/slipstream/slipstream/internal/module"
+	tvmod "github.com/slipstream/slipstream/internal/modules/tv"
 	"github.com/slipstream/slipstream/internal/rsssync"
 	"github.com/slipstream/slipstream/internal/scheduler/tasks"
 	"github.com/slipstream/slipstream/internal/update"
@@ -32,6 +38,13 @@ func wireCircularDeps(s *Server) {
 	// Circular: Quality ↔ Import
 	s.library.Quality.SetImportDecisionCleaner(s.automation.Import)
 
+	// Circular: Movies/TV ↔ Import (deleted items' files go to the import recycle bin)
+	s.library.Movies.SetFileRecycler(s.automation.Import)
+	s.library.TV.SetFileRecycler(s.automation.Import)
+
+	// Download remapping restores released items' status from their quality profile
+	s.download.Service.SetQualityStatusResolver(s.library.Quality)
+
 	// Module registry → services
 	s.library.LibraryManager.SetRegistry(s.registry)
 	s.library.Movies.SetRegistry(s.registry)
@@ -43,11 +56,70 @@ func wireCircularDeps(s *Server) {
 	s.download.Service.SetRegistry(s.registry)
 	s.automation.Import.SetRegistry(s.registry)
 
+	// Quality definitions → search scoring and history backfill
+	s.search.Search.SetQualities(s.library.Quality.Registry())
+	s.system.History.SetQualities(s.library.Quality.Registry())
+
+	// Language profiles → search and import
+	s.automation.Autosearch.SetLanguageService(s.library.Language)
+	s.automation.RssSync.SetLanguageService(s.library.Language)
+	s.automation.Import.SetLanguageService(s.library.Language)
+
+	// Tags → library items, indexers, download clients, search, grab and notifications
+	s.library.Movies.SetTagStore(s.library.Tags)
+	s.library.TV.SetTagStore(s.library.Tags)
+	s.search.Indexer.SetTagStore(s.library.Tags)
+	s.download.Service.SetTagStore(s.library.Tags)
+	s.automation.Autosearch.SetTagStore(s.library.Tags)
+	s.search.Grab.SetTagStore(s.library.Tags)
+	s.notification.Service.SetTagStore(s.library.Tags)
+
+	// Smart lists → paced batch search scope
+	s.automation.ScheduledSearcher.SetSmartListResolver(s.library.SmartList)
+	s.automation.ScheduledSearcher.SetTagStore(s.library.Tags)
+
+	// Library manager → add-all-by-person actions
+	s.library.People.SetMovieAdder(func(ctx context.Context, tmdbID int, input *people.AddMoviesInput) (int64, error) {
+		movie, err := s.library.LibraryManager.AddMovieByTmdbID(ctx, &librarymanager.AddMovieInput{
+			TmdbID:           tmdbID,
+			RootFolderID:     input.RootFolderID,
+			QualityProfileID: input.QualityProfileID,
+			Monitored:        input.Monitored,
+			SearchOnAdd:      input.SearchOnAdd,
+		})
+		if err != nil {
+			return 0, err
+		}
+		return movie.ID, nil
+	})
+
+	// Portal request votes → scheduled search ordering
+	s.automation.ScheduledSearcher.SetRequestVoteSource(s.portal.Requests)
+
+	// Download queue → calendar coming-soon feed
+	s.system.Calendar.SetQueueSource(s.download.Service)
+
+	// In-progress work → unified activity feed
+	s.system.Activity.SetQueueSource(s.download.Service)
+	s.system.Activity.SetImportSource(s.automation.Import)
+	s.system.Activity.SetSearchSource(s.automation.Autosearch)
+	s.system.Activity.SetTaskSource(s.automation.Scheduler)
+	s.system.Activity.SetProgressSource(s.system.Progress)
+
 	// Register module quality items with the quality service
 	registerModuleQualities(s.registry, s.library.Quality)
 
+	// MediaInfo → disk rescan re-probing
+	s.library.LibraryManager.SetMediaInfoService(s.library.Mediainfo)
+	s.library.LibraryManager.SetFileOrganizer(s.library.Organizer)
+
+	// Add wizard → default profiles and free space checks
+	s.library.LibraryManager.SetDefaultsService(s.system.Defaults)
+	s.library.LibraryManager.SetFreeSpaceCheck(s.cfg.AutoSearch.PauseGrabsBelowFreeSpaceBytes, s.filesystem.Storage)
+
 	// Circular: LibraryManager ↔ Autosearch
 	s.library.LibraryManager.SetAutosearchService(s.automation.Autosearch)
+	s.library.Slots.SetSlotSearcher(&slotSearcherAdapter{autosearchSvc: s.automation.Autosearch})
 	s.automation.ScheduledSearcher.SetSeriesRefresher(s.library.LibraryManager)
 
 	// Circular: Notification → many consumers
@@ -82,6 +154,8 @@ func wireCircularDeps(s *Server) {
 	s.portal.AutoApprove.SetRequestSearcher(s.portal.RequestSearcher)
 	s.portal.AutoApprove.SetRegistry(s.registry)
 	s.portal.Quota.SetRegistry(s.registry)
+	s.portal.Requests.SetQuotaEnforcer(s.portal.Quota)
+	s.portal.Requests.SetSeasonCounter(&portalSeasonCounterAdapter{metadata: s.metadata.Service})
 }
 
 // wireLateBindings wires dependencies that are unavailable at construction time:
@@ -102,10 +176,11 @@ func wireLateBindings(s *Server) {
 		})
 	}
 
-	// Cardigann cookie store (depends on status service created by Wire)
+	// Cardigann cookie and quarantine stores (depend on status service created by Wire)
 	if manager := s.search.Indexer.GetManager(); manager != nil {
 		cookieStore := indexer.NewCookieStore(s.search.Status)
 		manager.SetCookieStore(cookieStore)
+		manager.SetQuarantineStore(indexer.NewQuarantineStore(s.search.Status))
 
 		// Mode check func for Cardigann definition updates
 		modeManager := s.search.ProwlarrMode
@@ -123,10 +198,50 @@ func wireLateBindings(s *Server) {
 	s.metadata.RealTVDBClient = tvdb.NewClient(s.cfg.Metadata.TVDB, s.logger)
 	s.metadata.RealOMDBClient = omdb.NewClient(s.cfg.Metadata.OMDB, s.logger)
 
+	// Fanart.tv supplies clearart, banners and disc art, and alternative
+	// posters, backdrops and logos when it is the preferred artwork source
+	s.metadata.ArtworkDownloader.SetFanartSource(s.metadata.Service)
+
+	// Download clients must keep the error threshold free after a grab
+	s.search.Grab.SetMinFreeSpace(s.cfg.Health.DownloadClientFreeSpaceErrorBytes())
+
+	// Automatic grab caps: global from autosearch settings, per indexer from the database
+	s.search.Grab.SetAutoGrabCaps(func() ratelimit.GrabCaps {
+		return ratelimit.GrabCaps{PerHour: s.cfg.AutoSearch.MaxGrabsPerHour, PerDay: s.cfg.AutoSearch.MaxGrabsPerDay}
+	}, s.search.Indexer)
+	s.search.Grab.SetFreeSpaceGuard(s.cfg.AutoSearch.PauseGrabsBelowFreeSpaceBytes, s.filesystem.Storage)
+	s.search.Grab.SetFreeSpaceBuffer(s.cfg.AutoSearch.GrabFreeSpaceBufferBytes)
+	s.search.Grab.SetApprovalPolicy(func() grab.ApprovalPolicy {
+		return grab.ApprovalPolicy{Mode: s.cfg.AutoSearch.GrabApprovalMode, TagIDs: s.cfg.AutoSearch.GrabApprovalTagIDs}
+	})
+	s.automation.Autosearch.SetDefaultMinimumAvailability(func() string { return s.cfg.AutoSearch.MinimumAvailability })
+	s.system.Missing.SetDefaultMinimumAvailability(func() string { return s.cfg.AutoSearch.MinimumAvailability })
+	seasonPackPercent := func() int { return s.cfg.AutoSearch.SeasonPackMissingPercent }
+	s.automation.Autosearch.SetSeasonPackMissingPercent(seasonPackPercent)
+	if tvMod, ok := s.registry.Get(module.TypeTV).(*tvmod.Module); ok {
+		tvMod.SetSeasonPackMissingPercent(seasonPackPercent)
+	}
+	s.search.Grab.SetHealthService(s.system.Health)
+
+	// Cross-seed torrents on the other indexers after they are imported
+	s.search.Grab.SetCrossSeedSearcher(s.search.Search)
+	s.automation.Import.SetCrossSeeder(s.search.Grab)
+	s.automation.RssSync.SetGrabDeferrer(s.automation.Autosearch)
+	s.automation.RssSync.SetAutoReplaceProper(func() bool { return s.cfg.RssSync.AutoReplaceProper })
+
+	// Initialize backup service (always backs up the production database)
+	s.system.Backup = backup.NewService(s.dbManager.ProdConn(), backup.Config{
+		Dir:        s.cfg.Backup.Dir,
+		DBPath:     s.cfg.Database.Path,
+		ConfigPath: s.cfg.File,
+		Keep:       s.cfg.Backup.Keep,
+	}, s.logger)
+
 	// Initialize update service (depends on scheduler)
 	s.system.Update = update.NewService(s.dbManager.Conn(), s.logger, s.restartChan)
 	s.system.Update.SetBroadcaster(s.hub)
 	s.system.Update.SetPort(s.cfg.Server.Port)
+	s.system.Update.SetDatabaseBackuper(s.system.Backup)
 
 	// Initialize firewall checker
 	s.system.Firewall = firewall.NewChecker()
@@ -139,12 +254,13 @@ func wireLateBindings(s *Server) {
 
 	// Settings handler scheduler bindings
 	if s.automation.Scheduler != nil {
-		s.automation.AutosearchSettings.SetScheduler(s.automation.Scheduler, s.automation.ScheduledSearcher, tasks.UpdateAutoSearchTask)
+		s.automation.AutosearchSettings.SetScheduler(s.automation.Scheduler, s.automation.ScheduledSearcher, tasks.UpdateAutoSearchTasks)
 		s.automation.RssSyncSettings.SetScheduler(s.automation.Scheduler, s.automation.RssSync, tasks.UpdateRssSyncTask)
 	}
 
 	// Start cleanup goroutines
 	s.portal.SearchLimiter.StartCleanup(5 * time.Minute)
+	s.portal.WidgetLimiter.StartCleanup(5 * time.Minute)
 	s.security.AuthLimiter.StartCleanup(5 * time.Minute)
 }
 
@@ -177,6 +293,12 @@ func (s *Server) registerSchedulerTasks() {
 	if err := tasks.RegisterAutoSearchTask(sched, s.automation.ScheduledSearcher, &cfg.AutoSearch); err != nil {
 		logger.Error().Err(err).Msg("Failed to register autosearch task")
 	}
+	if err := tasks.RegisterBatchSearchTasks(sched, s.automation.ScheduledSearcher, &cfg.AutoSearch); err != nil {
+		logger.Error().Err(err).Msg("Failed to register batch search tasks")
+	}
+	if err := tasks.RegisterDeferredGrabsTask(sched, s.automation.ScheduledSearcher); err != nil {
+		logger.Error().Err(err).Msg("Failed to register deferred grabs task")
+	}
 	if err := tasks.RegisterRssSyncTask(sched, s.automation.RssSync, &cfg.RssSync); err != nil {
 		logger.Error().Err(err).Msg("Failed to register RSS sync task")
 	}
@@ -185,6 +307,13 @@ func (s *Server) registerSchedulerTasks() {
 	if err := tasks.RegisterUpdateCheckTask(sched, s.system.Update, logger); err != nil {
 		logger.Error().Err(err).Msg("Failed to register update check task")
 	}
+	if err := tasks.RegisterDatabaseBackupTask(sched, s.system.Backup); err != nil {
+		logger.Error().Err(err).Msg("Failed to register database backup task")
+	}
+
+	if err := tasks.RegisterHomeAssistantMQTTTask(sched, s.system.HomeAssistant); err != nil {
+		logger.Error().Err(err).Msg("Failed to register Home Assistant MQTT task")
+	}
 
 	queries := sqlc.New(s.dbManager.Conn())
 	if err := tasks.RegisterPlexRefreshTask(sched, queries, s.notification.Service, s.notification.PlexClient, logger); err != nil {
@@ -3,6 +3,7 @@ package api
 import (
 	"context"
 
+	"github.com/slipstream/slipstream/internal/autosearch"
 	"github.com/slipstream/slipstream/internal/library/movies"
 	"github.com/slipstream/slipstream/internal/library/tv"
 )
@@ -25,3 +26,21 @@ func (a *slotFileDeleterAdapter) DeleteFile(ctx context.Context, mediaType strin
 		return nil
 	}
 }
+
+// slotSearcherAdapter adapts the autosearch service to slots.SlotSearcher so
+// slot reconciliation can refill cleared slots.
+type slotSearcherAdapter struct {
+	autosearchSvc *autosearch.Service
+}
+
+// SearchMovieSlot implements slots.SlotSearcher.
+func (a *slotSearcherAdapter) SearchMovieSlot(ctx context.Context, movieID, slotID int64) error {
+	_, err := a.autosearchSvc.SearchMovieSlot(ctx, movieID, slotID, autosearch.SearchSourceScheduled)
+	return err
+}
+
+// SearchEpisodeSlot implements slots.SlotSearcher.
+func (a *slotSearcherAdapter) SearchEpisodeSlot(ctx context.Context, episodeID, slotID int64) error {
+	_, err := a.autosearchSvc.SearchEpisodeSlot(ctx, episodeID, slotID, autosearch.SearchSourceScheduled)
+	return err
+}
@@ -12,17 +12,24 @@ import (
 	"github.com/slipstream/slipstream/internal/database/sqlc"
 	"github.com/slipstream/slipstream/internal/defaults"
 	"github.com/slipstream/slipstream/internal/downloader"
+	"github.com/slipstream/slipstream/internal/graphql"
+	"github.com/slipstream/slipstream/internal/health"
 	"github.com/slipstream/slipstream/internal/history"
+	"github.com/slipstream/slipstream/internal/homeassistant"
 	importer "github.com/slipstream/slipstream/internal/import"
 	"github.com/slipstream/slipstream/internal/indexer"
 	"github.com/slipstream/slipstream/internal/indexer/grab"
 	"github.com/slipstream/slipstream/internal/indexer/ratelimit"
 	"github.com/slipstream/slipstream/internal/indexer/status"
+	"github.com/slipstream/slipstream/internal/library/exclusion"
+	"github.com/slipstream/slipstream/internal/library/language"
 	"github.com/slipstream/slipstream/internal/library/librarymanager"
 	"github.com/slipstream/slipstream/internal/library/movies"
+	"github.com/slipstream/slipstream/internal/library/people"
 	"github.com/slipstream/slipstream/internal/library/quality"
 	"github.com/slipstream/slipstream/internal/library/rootfolder"
 	"github.com/slipstream/slipstream/internal/library/slots"
+	"github.com/slipstream/slipstream/internal/library/smartlist"
 	"github.com/slipstream/slipstream/internal/library/tv"
 	"github.com/slipstream/slipstream/internal/metadata"
 	"github.com/slipstream/slipstream/internal/missing"
@@ -34,9 +41,13 @@ import (
 	"github.com/slipstream/slipstream/internal/portal/quota"
 	"github.com/slipstream/slipstream/internal/portal/requests"
 	"github.com/slipstream/slipstream/internal/portal/users"
+	"github.com/slipstream/slipstream/internal/portal/widget"
 	"github.com/slipstream/slipstream/internal/preferences"
 	"github.com/slipstream/slipstream/internal/prowlarr"
 	"github.com/slipstream/slipstream/internal/rsssync"
+	"github.com/slipstream/slipstream/internal/stats"
+	"github.com/slipstream/slipstream/internal/stream"
+	"github.com/slipstream/slipstream/internal/tags"
 )
 
 // SwitchableServices tracks all services that need database switching for dev mode toggle.
@@ -48,11 +59,20 @@ type SwitchableServices struct {
 	Defaults            *defaults.Service                  `switchable:"db"`
 	Availability        *availability.Service              `switchable:"db"`
 	Missing             *missing.Service                   `switchable:"db"`
+	Stats               *stats.Service                     `switchable:"db"`
+	Health              *health.Service                    `switchable:"db"`
 	History             *history.Service                   `switchable:"db"`
+	HomeAssistant       *homeassistant.Service             `switchable:"db"`
 	Preferences         *preferences.Service               `switchable:"db"`
+	Stream              *stream.Service                    `switchable:"db"`
 	Movies              *movies.Service                    `switchable:"db"`
 	TV                  *tv.Service                        `switchable:"db"`
 	Quality             *quality.Service                   `switchable:"db"`
+	Language            *language.Service                  `switchable:"db"`
+	SmartList           *smartlist.Service                 `switchable:"db"`
+	Exclusion           *exclusion.Service                 `switchable:"db"`
+	People              *people.Service                    `switchable:"db"`
+	Tags                *tags.Service                      `switchable:"db"`
 	Slots               *slots.Service                     `switchable:"db"`
 	RootFolder          *rootfolder.Service                `switchable:"db"`
 	NetworkLogoStore    *metadata.SQLNetworkLogoStore      `switchable:"db"`
@@ -62,6 +82,7 @@ type SwitchableServices struct {
 	RateLimiter         *ratelimit.Limiter                 `switchable:"db"`
 	Grab                *grab.Service                      `switchable:"db"`
 	Prowlarr            *prowlarr.Service                  `switchable:"db"`
+	ProwlarrSync        *prowlarr.IndexerSync              `switchable:"db"`
 	Autosearch          *autosearch.Service                `switchable:"db"`
 	Import              *importer.Service                  `switchable:"db"`
 	ImportSettings      *importer.SettingsHandlers         `switchable:"db"`
@@ -72,6 +93,7 @@ type SwitchableServices struct {
 	AdminLibraryChecker *adminRequestLibraryCheckerAdapter `switchable:"db"`
 	Watchers            *requests.WatchersService          `switchable:"db"`
 	RequestSearcher     *requests.RequestSearcher          `switchable:"db"`
+	GraphQL             *graphql.Service                   `switchable:"db"`
 
 	// Services that accept *sqlc.Queries
 	RssSync         *rsssync.Service         `switchable:"queries"`
@@ -85,6 +107,7 @@ type SwitchableServices struct {
 	Auth            *auth.Service
 	AdminSettings   *admin.SettingsHandlers `switchable:"queries"`
 	Passkey         *auth.PasskeyService    `switchable:"queries,optional"`
+	Widget          *widget.Service         `switchable:"queries"`
 }
 
 // UpdateAll switches all registered services to use the given database.
@@ -6,6 +6,7 @@ import (
 	"github.com/google/wire"
 	"github.com/rs/zerolog"
 
+	"github.com/slipstream/slipstream/internal/activity"
 	authratelimit "github.com/slipstream/slipstream/internal/api/ratelimit"
 	"github.com/slipstream/slipstream/internal/auth"
 	"github.com/slipstream/slipstream/internal/autosearch"
@@ -18,8 +19,10 @@ import (
 	"github.com/slipstream/slipstream/internal/domain/contracts"
 	"github.com/slipstream/slipstream/internal/downloader"
 	"github.com/slipstream/slipstream/internal/filesystem"
+	"github.com/slipstream/slipstream/internal/graphql"
 	"github.com/slipstream/slipstream/internal/health"
 	"github.com/slipstream/slipstream/internal/history"
+	"github.com/slipstream/slipstream/internal/homeassistant"
 	importer "github.com/slipstream/slipstream/internal/import"
 	"github.com/slipstream/slipstream/internal/arrimport"
 	"github.com/slipstream/slipstream/internal/indexer"
@@ -27,19 +30,25 @@ import (
 	"github.com/slipstream/slipstream/internal/indexer/ratelimit"
 	"github.com/slipstream/slipstream/internal/indexer/search"
 	"github.com/slipstream/slipstream/internal/indexer/status"
+	"github.com/slipstream/slipstream/internal/indexer/torznab"
+	"github.com/slipstream/slipstream/internal/library/exclusion"
+	"github.com/slipstream/slipstream/internal/library/language"
 	"github.com/slipstream/slipstream/internal/library/librarymanager"
 	"github.com/slipstream/slipstream/internal/library/movies"
 	"github.com/slipstream/slipstream/internal/module"
 	moviemod "github.com/slipstream/slipstream/internal/modules/movie"
 	tvmod "github.com/slipstream/slipstream/internal/modules/tv"
 	"github.com/slipstream/slipstream/internal/library/organizer"
+	"github.com/slipstream/slipstream/internal/library/people"
 	"github.com/slipstream/slipstream/internal/library/quality"
 	"github.com/slipstream/slipstream/internal/library/rootfolder"
 	"github.com/slipstream/slipstream/internal/library/scanner"
 	"github.com/slipstream/slipstream/internal/library/slots"
+	"github.com/slipstream/slipstream/internal/library/smartlist"
 	"github.com/slipstream/slipstream/internal/library/tv"
 	"github.com/slipstream/slipstream/internal/mediainfo"
 	"github.com/slipstream/slipstream/internal/metadata"
+	"github.com/slipstream/slipstream/internal/metadata/imagecache"
 	"github.com/slipstream/slipstream/internal/missing"
 	"github.com/slipstream/slipstream/internal/notification"
 	"github.com/slipstream/slipstream/internal/notification/plex"
@@ -51,10 +60,14 @@ import (
 	"github.com/slipstream/slipstream/internal/portal/quota"
 	"github.com/slipstream/slipstream/internal/portal/requests"
 	"github.com/slipstream/slipstream/internal/portal/users"
+	"github.com/slipstream/slipstream/internal/portal/widget"
 	"github.com/slipstream/slipstream/internal/preferences"
 	"github.com/slipstream/slipstream/internal/progress"
 	"github.com/slipstream/slipstream/internal/prowlarr"
 	"github.com/slipstream/slipstream/internal/rsssync"
+	"github.com/slipstream/slipstream/internal/stats"
+	"github.com/slipstream/slipstream/internal/stream"
+	"github.com/slipstream/slipstream/internal/tags"
 	"github.com/slipstream/slipstream/internal/websocket"
 )
 
@@ -74,6 +87,7 @@ func BuildServices(
 		// --- Config extraction providers ---
 		provideMetadataConfig,
 		provideArtworkConfig,
+		provideImageCacheConfig,
 		provideAutoSearchConfig,
 		provideRssSyncConfig,
 		provideRateLimitConfig,
@@ -103,22 +117,32 @@ func BuildServices(
 		health.NewService,
 		defaults.NewService,
 		calendar.NewService,    // requires *module.Registry
+		activity.NewService,
 		availability.NewService, // requires *module.Registry
 		missing.NewService,
+		stats.NewService,
 		preferences.NewService,
 		history.NewService,
+		homeassistant.NewService,
 		progress.NewManager,
+		stream.NewService,
 
 		// --- Library service constructors ---
 		scanner.NewService,
 		movies.NewService,
 		tv.NewService,
 		quality.NewService,
+		language.NewService,
+		smartlist.NewService,
+		exclusion.NewService,
+		people.NewService,
+		tags.NewService,
 		slots.NewService,
 		rootfolder.NewService,
 		librarymanager.NewService,
 		organizer.NewService,
 		mediainfo.NewService,
+		graphql.NewService,
 
 		// --- Module constructors ---
 		moviemod.NewModule,
@@ -128,6 +152,7 @@ func BuildServices(
 		// --- Metadata service constructors ---
 		metadata.NewService,
 		metadata.NewArtworkDownloader,
+		imagecache.New,
 		metadata.NewSQLNetworkLogoStore,
 
 		// --- Filesystem service constructors ---
@@ -147,6 +172,8 @@ func BuildServices(
 		grab.NewService,
 		prowlarr.NewService,
 		prowlarr.NewSearchAdapter,
+		prowlarr.NewIndexerSync,
+		torznab.NewService,
 		prowlarr.NewGrabProvider,
 		decisioning.NewGrabLock,
 
@@ -167,6 +194,8 @@ func BuildServices(
 		// --- Portal service constructors ---
 		users.NewService,
 		invitations.NewService,
+		widget.NewService,
+		widget.NewLimiter,
 		quota.NewService,
 		requests.NewService,
 		requests.NewWatchersService,
@@ -187,6 +216,9 @@ func BuildServices(
 		wire.Bind(new(contracts.Broadcaster), new(*websocket.Hub)),
 		wire.Bind(new(contracts.HealthService), new(*health.Service)),
 		wire.Bind(new(contracts.QueueTrigger), new(*downloader.QueueBroadcaster)),
+		wire.Bind(new(graphql.QueueSource), new(*downloader.Service)),
+		wire.Bind(new(graphql.HistorySource), new(*history.Service)),
+		wire.Bind(new(people.MetadataSource), new(*metadata.Service)),
 
 		// Search interfaces
 		wire.Bind(new(search.SearchService), new(*search.Router)),
@@ -219,9 +251,9 @@ func BuildServices(
 
 		// --- Group struct assembly ---
 		wire.Struct(new(ServiceContainer), "*"),
-		wire.Struct(new(SystemGroup), "Health", "Defaults", "Calendar", "Availability", "Missing", "Preferences", "History", "Progress"),
+		wire.Struct(new(SystemGroup), "Health", "Defaults", "Calendar", "Activity", "Availability", "Missing", "Preferences", "History", "HomeAssistant", "Progress", "Stream", "Stats"),
 		wire.Struct(new(LibraryGroup), "*"),
-		wire.Struct(new(MetadataGroup), "Service", "ArtworkDownloader", "NetworkLogoStore"),
+		wire.Struct(new(MetadataGroup), "Service", "ArtworkDownloader", "ImageCache", "NetworkLogoStore"),
 		wire.Struct(new(FilesystemGroup), "*"),
 		wire.Struct(new(DownloadGroup), "*"),
 		wire.Struct(new(SearchGroup), "*"),
@@ -8,6 +8,7 @@ package api
 
 import (
 	"github.com/rs/zerolog"
+	"github.com/slipstream/slipstream/internal/activity"
 	ratelimit2 "github.com/slipstream/slipstream/internal/api/ratelimit"
 	"github.com/slipstream/slipstream/internal/arrimport"
 	"github.com/slipstream/slipstream/internal/autosearch"
@@ -19,24 +20,32 @@ import (
 	"github.com/slipstream/slipstream/internal/defaults"
 	"github.com/slipstream/slipstream/internal/downloader"
 	"github.com/slipstream/slipstream/internal/filesystem"
+	"github.com/slipstream/slipstream/internal/graphql"
 	"github.com/slipstream/slipstream/internal/health"
 	"github.com/slipstream/slipstream/internal/history"
+	"github.com/slipstream/slipstream/internal/homeassistant"
 	"github.com/slipstream/slipstream/internal/import"
 	"github.com/slipstream/slipstream/internal/indexer"
 	"github.com/slipstream/slipstream/internal/indexer/grab"
 	"github.com/slipstream/slipstream/internal/indexer/ratelimit"
 	"github.com/slipstream/slipstream/internal/indexer/search"
 	"github.com/slipstream/slipstream/internal/indexer/status"
+	"github.com/slipstream/slipstream/internal/indexer/torznab"
+	"github.com/slipstream/slipstream/internal/library/exclusion"
+	"github.com/slipstream/slipstream/internal/library/language"
 	"github.com/slipstream/slipstream/internal/library/librarymanager"
 	"github.com/slipstream/slipstream/internal/library/movies"
 	"github.com/slipstream/slipstream/internal/library/organizer"
+	"github.com/slipstream/slipstream/internal/library/people"
 	"github.com/slipstream/slipstream/internal/library/quality"
 	"github.com/slipstream/slipstream/internal/library/rootfolder"
 	"github.com/slipstream/slipstream/internal/library/scanner"
 	"github.com/slipstream/slipstream/internal/library/slots"
+	"github.com/slipstream/slipstream/internal/library/smartlist"
 	"github.com/slipstream/slipstream/internal/library/tv"
 	"github.com/slipstream/slipstream/internal/mediainfo"
 	"github.com/slipstream/slipstream/internal/metadata"
+	"github.com/slipstream/slipstream/internal/metadata/imagecache"
 	"github.com/slipstream/slipstream/internal/missing"
 	"github.com/slipstream/slipstream/internal/modules/movie"
 	tv2 "github.com/slipstream/slipstream/internal/modules/tv"
@@ -50,10 +59,14 @@ import (
 	"github.com/slipstream/slipstream/internal/portal/quota"
 	"github.com/slipstream/slipstream/internal/portal/requests"
 	"github.com/slipstream/slipstream/internal/portal/users"
+	"github.com/slipstream/slipstream/internal/portal/widget"
 	"github.com/slipstream/slipstream/internal/preferences"
 	"github.com/slipstream/slipstream/internal/progress"
 	"github.com/slipstream/slipstream/internal/prowlarr"
 	"github.com/slipstream/slipstream/internal/rsssync"
+	"github.com/slipstream/slipstream/internal/stats"
+	"github.com/slipstream/slipstream/internal/stream"
+	"github.com/slipstream/slipstream/internal/tags"
 	"github.com/slipstream/slipstream/internal/websocket"
 )
 
@@ -62,8 +75,8 @@ import (
 // BuildServices constructs all service dependencies via Wire.
 // The generated code in wire_gen.go calls constructors in dependency order.
 func BuildServices(dbManager *database.Manager, hub *websocket.Hub, cfg *config.Config, logger *zerolog.Logger) (*ServiceContainer, error) {
-	service := health.NewService(logger, hub)
 	db := provideDB(dbManager)
+	service := health.NewService(db, logger, hub)
 	queries := provideQueries(db)
 	defaultsService := defaults.NewService(queries)
 	metadataConfig := provideMetadataConfig(cfg)
@@ -76,45 +89,76 @@ func BuildServices(dbManager *database.Manager, hub *websocket.Hub, cfg *config.
 	rootfolderService := rootfolder.NewService(db, logger, defaultsService, service)
 	artworkConfig := provideArtworkConfig(cfg)
 	artworkDownloader := metadata.NewArtworkDownloader(artworkConfig, logger, hub)
+	imagecacheConfig := provideImageCacheConfig(cfg)
+	imagecacheCache := imagecache.New(imagecacheConfig, logger)
 	module := movie.NewModule(db, metadataService, moviesService, rootfolderService, artworkDownloader, logger)
 	tvService := tv.NewService(db, hub, logger, qualityService, statusChangeLogger)
 	tvModule := tv2.NewModule(db, metadataService, tvService, rootfolderService, artworkDownloader, qualityService, logger)
 	registry := provideRegistry(db, module, tvModule)
 	calendarService := calendar.NewService(registry, logger)
+	activityService := activity.NewService(logger)
 	availabilityService := availability.NewService(db, registry, logger)
 	missingService := missing.NewService(db, logger)
 	preferencesService := preferences.NewService(queries)
+	eventBroadcaster := requests.NewEventBroadcaster(hub)
+	notificationService := notification.NewService(db, logger)
+	notificationsService := notifications.NewService(queries, notificationService, hub, logger)
+	watchersService := requests.NewWatchersService(queries, logger)
+	requestsService := requests.NewService(queries, logger, eventBroadcaster, notificationsService, watchersService)
+	moduleProvisionerLookup := provideModuleProvisionerLookup(registry)
+	statusTracker := requests.NewStatusTracker(queries, requestsService, watchersService, logger, moduleProvisionerLookup, notificationsService)
+	downloaderService := downloader.NewService(db, logger, service, hub, statusChangeLogger, statusTracker)
+	homeassistantService := homeassistant.NewService(db, downloaderService, service, logger)
 	manager := progress.NewManager(hub, logger)
+	streamService := stream.NewService(db, logger)
+	statsService := stats.NewService(db, logger)
 	systemGroup := SystemGroup{
-		Health:       service,
-		Defaults:     defaultsService,
-		Calendar:     calendarService,
-		Availability: availabilityService,
-		Missing:      missingService,
-		Preferences:  preferencesService,
-		History:      historyService,
-		Progress:     manager,
+		Health:        service,
+		Defaults:      defaultsService,
+		Calendar:      calendarService,
+		Activity:      activityService,
+		Availability:  availabilityService,
+		Missing:       missingService,
+		Preferences:   preferencesService,
+		History:       historyService,
+		HomeAssistant: homeassistantService,
+		Progress:      manager,
+		Stream:        streamService,
+		Stats:         statsService,
 	}
 	scannerService := scanner.NewService(logger)
+	languageService := language.NewService(db, logger)
+	smartlistService := smartlist.NewService(db, moviesService, tvService, logger)
+	exclusionService := exclusion.NewService(db, logger)
+	peopleService := people.NewService(db, metadataService, logger)
+	tagsService := tags.NewService(db, logger)
 	slotsService := slots.NewService(db, qualityService, logger, rootfolderService)
 	librarymanagerService := librarymanager.NewService(db, scannerService, moviesService, tvService, metadataService, artworkDownloader, rootfolderService, qualityService, manager, logger, preferencesService, slotsService, service)
 	organizerService := organizer.NewService(logger)
 	mediainfoConfig := provideMediainfoConfig()
 	mediainfoService := mediainfo.NewService(mediainfoConfig, logger)
+	graphqlService := graphql.NewService(db, downloaderService, historyService, logger)
 	libraryGroup := LibraryGroup{
 		Scanner:        scannerService,
 		Movies:         moviesService,
 		TV:             tvService,
 		Quality:        qualityService,
+		Language:       languageService,
+		SmartList:      smartlistService,
+		Exclusion:      exclusionService,
+		People:         peopleService,
+		Tags:           tagsService,
 		Slots:          slotsService,
 		RootFolder:     rootfolderService,
 		LibraryManager: librarymanagerService,
 		Organizer:      organizerService,
 		Mediainfo:      mediainfoService,
+		GraphQL:        graphqlService,
 	}
 	metadataGroup := MetadataGroup{
 		Service:           metadataService,
 		ArtworkDownloader: artworkDownloader,
+		ImageCache:        imagecacheCache,
 		NetworkLogoStore:  sqlNetworkLogoStore,
 	}
 	filesystemService := filesystem.NewService(logger)
@@ -123,14 +167,6 @@ func BuildServices(dbManager *database.Manager, hub *websocket.Hub, cfg *config.
 		Service: filesystemService,
 		Storage: storageService,
 	}
-	eventBroadcaster := requests.NewEventBroadcaster(hub)
-	notificationService := notification.NewService(db, logger)
-	notificationsService := notifications.NewService(queries, notificationService, hub, logger)
-	watchersService := requests.NewWatchersService(queries, logger)
-	requestsService := requests.NewService(queries, logger, eventBroadcaster, notificationsService, watchersService)
-	moduleProvisionerLookup := provideModuleProvisionerLookup(registry)
-	statusTracker := requests.NewStatusTracker(queries, requestsService, watchersService, logger, moduleProvisionerLookup, notificationsService)
-	downloaderService := downloader.NewService(db, logger, service, hub, statusChangeLogger, statusTracker)
 	queueBroadcaster := downloader.NewQueueBroadcaster(downloaderService, hub, logger)
 	downloadGroup := DownloadGroup{
 		Service:          downloaderService,
@@ -149,6 +185,8 @@ func BuildServices(dbManager *database.Manager, hub *websocket.Hub, cfg *config.
 	grabLock := decisioning.NewGrabLock()
 	searchAdapter := prowlarr.NewSearchAdapter(prowlarrService)
 	router := search.NewRouter(searchService, logger, searchAdapter, modeManager)
+	indexerSync := prowlarr.NewIndexerSync(db, prowlarrService, indexerService, logger)
+	torznabService := torznab.NewService(indexerService, searchService, logger)
 	searchGroup := SearchGroup{
 		Indexer:        indexerService,
 		Search:         searchService,
@@ -161,6 +199,8 @@ func BuildServices(dbManager *database.Manager, hub *websocket.Hub, cfg *config.
 		ProwlarrMode:   modeManager,
 		ProwlarrSearch: searchAdapter,
 		ProwlarrGrab:   grabProvider,
+		ProwlarrSync:   indexerSync,
+		Torznab:        torznabService,
 	}
 	autosearchService := autosearch.NewService(db, router, grabService, qualityService, logger, historyService, grabLock, hub)
 	autoSearchConfig := provideAutoSearchConfig(cfg)
@@ -170,7 +210,7 @@ func BuildServices(dbManager *database.Manager, hub *websocket.Hub, cfg *config.
 	rsssyncService := rsssync.NewService(queries, feedFetcher, grabService, qualityService, historyService, grabLock, service, hub, logger)
 	rssSyncConfig := provideRssSyncConfig(cfg)
 	rsssyncSettingsHandler := rsssync.NewSettingsHandler(queries, rssSyncConfig)
-	importerConfig := provideImporterConfig()
+	importerConfig := provideImporterConfig(cfg)
 	importerHistoryService := provideImportHistoryService(historyService)
 	importerService := importer.NewService(db, downloaderService, moviesService, tvService, rootfolderService, organizerService, mediainfoService, hub, importerConfig, logger, service, importerHistoryService, qualityService, slotsService, statusTracker)
 	settingsHandlers := importer.NewSettingsHandlers(db, importerService, registry)
@@ -197,6 +237,7 @@ func BuildServices(dbManager *database.Manager, hub *websocket.Hub, cfg *config.
 	}
 	usersService := users.NewService(queries, logger)
 	invitationsService := invitations.NewService(queries, logger)
+	widgetService := widget.NewService(queries, logger)
 	quotaService := quota.NewService(queries, logger)
 	autoapproveService := autoapprove.NewService(queries, usersService, qualityService, quotaService, requestsService, logger)
 	authService, err := provideAuthService(queries, logger, cfg)
@@ -212,6 +253,7 @@ func BuildServices(dbManager *database.Manager, hub *websocket.Hub, cfg *config.
 	libraryChecker := requests.NewLibraryChecker(queries, logger)
 	apiAdminRequestLibraryCheckerAdapter := provideAdminLibraryChecker(queries)
 	adminSettingsHandlers := admin.NewSettingsHandlers(quotaService, queries)
+	widgetLimiter := widget.NewLimiter()
 	portalGroup := PortalGroup{
 		Users:               usersService,
 		Invitations:         invitationsService,
@@ -230,6 +272,8 @@ func BuildServices(dbManager *database.Manager, hub *websocket.Hub, cfg *config.
 		LibraryChecker:      libraryChecker,
 		AdminLibraryChecker: apiAdminRequestLibraryCheckerAdapter,
 		AdminSettings:       adminSettingsHandlers,
+		Widget:              widgetService,
+		WidgetLimiter:       widgetLimiter,
 	}
 	authLimiter := ratelimit2.NewAuthLimiter()
 	securityGroup := SecurityGroup{
@@ -239,11 +283,20 @@ func BuildServices(dbManager *database.Manager, hub *websocket.Hub, cfg *config.
 		Defaults:            defaultsService,
 		Availability:        availabilityService,
 		Missing:             missingService,
+		Stats:               statsService,
+		Health:              service,
 		History:             historyService,
+		HomeAssistant:       homeassistantService,
 		Preferences:         preferencesService,
+		Stream:              streamService,
 		Movies:              moviesService,
 		TV:                  tvService,
 		Quality:             qualityService,
+		Language:            languageService,
+		SmartList:           smartlistService,
+		Exclusion:           exclusionService,
+		People:              peopleService,
+		Tags:                tagsService,
 		Slots:               slotsService,
 		RootFolder:          rootfolderService,
 		NetworkLogoStore:    sqlNetworkLogoStore,
@@ -253,6 +306,7 @@ func BuildServices(dbManager *database.Manager, hub *websocket.Hub, cfg *config.
 		RateLimiter:         limiter,
 		Grab:                grabService,
 		Prowlarr:            prowlarrService,
+		ProwlarrSync:        indexerSync,
 		Autosearch:          autosearchService,
 		Import:              importerService,
 		ImportSettings:      settingsHandlers,
@@ -263,6 +317,7 @@ func BuildServices(dbManager *database.Manager, hub *websocket.Hub, cfg *config.
 		AdminLibraryChecker: apiAdminRequestLibraryCheckerAdapter,
 		Watchers:            watchersService,
 		RequestSearcher:     requestSearcher,
+		GraphQL:             graphqlService,
 		RssSync:             rsssyncService,
 		RssSyncSettings:     rsssyncSettingsHandler,
 		Users:               usersService,
@@ -274,6 +329,7 @@ func BuildServices(dbManager *database.Manager, hub *websocket.Hub, cfg *config.
 		Auth:                authService,
 		AdminSettings:       adminSettingsHandlers,
 		Passkey:             passkeyService,
+		Widget:              widgetService,
 	}
 	serviceContainer := &ServiceContainer{
 		System:       systemGroup,
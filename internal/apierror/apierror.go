@@ -0,0 +1,183 @@
+// Package apierror defines the JSON envelope every API error is returned in.
+//
+// Handlers keep returning echo.NewHTTPError for plain failures; the error
+// handler installed by the API server maps those onto the envelope with a code
+// derived from the status. Return an *Error when the client needs a more
+// specific code or structured details.
+package apierror
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+)
+
+// Code is a stable, machine-readable error identifier.
+type Code string
+
+// Codes derived from HTTP statuses.
+const (
+	CodeBadRequest         Code = "bad_request"
+	CodeUnauthorized       Code = "unauthorized"
+	CodeForbidden          Code = "forbidden"
+	CodeNotFound           Code = "not_found"
+	CodeMethodNotAllowed   Code = "method_not_allowed"
+	CodeConflict           Code = "conflict"
+	CodePayloadTooLarge    Code = "payload_too_large"
+	CodeUnprocessable      Code = "unprocessable_entity"
+	CodeRateLimited        Code = "rate_limited"
+	CodeInternal           Code = "internal_error"
+	CodeBadGateway         Code = "bad_gateway"
+	CodeServiceUnavailable Code = "service_unavailable"
+	CodeGatewayTimeout     Code = "gateway_timeout"
+)
+
+// Domain-specific codes.
+const (
+	CodePathConflict   Code = "path_conflict"
+	CodeScanInProgress Code = "scan_in_progress"
+)
+
+var statusCodes = map[int]Code{
+	http.StatusBadRequest:            CodeBadRequest,
+	http.StatusUnauthorized:          CodeUnauthorized,
+	http.StatusForbidden:             CodeForbidden,
+	http.StatusNotFound:              CodeNotFound,
+	http.StatusMethodNotAllowed:      CodeMethodNotAllowed,
+	http.StatusConflict:              CodeConflict,
+	http.StatusRequestEntityTooLarge: CodePayloadTooLarge,
+	http.StatusUnprocessableEntity:   CodeUnprocessable,
+	http.StatusTooManyRequests:       CodeRateLimited,
+	http.StatusInternalServerError:   CodeInternal,
+	http.StatusBadGateway:            CodeBadGateway,
+	http.StatusServiceUnavailable:    CodeServiceUnavailable,
+	http.StatusGatewayTimeout:        CodeGatewayTimeout,
+}
+
+// CodeForStatus returns the generic code for an HTTP status.
+func CodeForStatus(status int) Code {
+	if code, ok := statusCodes[status]; ok {
+		return code
+	}
+	text := http.StatusText(status)
+	if text == "" {
+		if status >= http.StatusInternalServerError {
+			return CodeInternal
+		}
+		return CodeBadRequest
+	}
+	return Code(strings.ReplaceAll(strings.ToLower(text), " ", "_"))
+}
+
+// Envelope is the response body of every failed API request.
+type Envelope struct {
+	Code          Code   `json:"code"`
+	Message       string `json:"message"`
+	Details       any    `json:"details,omitempty"`
+	CorrelationID string `json:"correlationId,omitempty"`
+}
+
+// Error is an API error with an explicit code and optional details.
+type Error struct {
+	Status  int
+	Code    Code
+	Message string
+	Details any
+	Err     error // Underlying cause, logged but never sent to the client
+}
+
+// New creates an API error.
+func New(status int, code Code, message string) *Error {
+	return &Error{Status: status, Code: code, Message: message}
+}
+
+// WithDetails attaches structured details for the client.
+func (e *Error) WithDetails(details any) *Error {
+	e.Details = details
+	return e
+}
+
+// WithInternal attaches the underlying cause.
+func (e *Error) WithInternal(err error) *Error {
+	e.Err = err
+	return e
+}
+
+func (e *Error) Error() string {
+	if e.Err != nil {
+		return fmt.Sprintf("%s: %s: %v", e.Code, e.Message, e.Err)
+	}
+	return fmt.Sprintf("%s: %s", e.Code, e.Message)
+}
+
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+// FromError converts any handler error into a status and envelope. Messages
+// of unexpected errors are not exposed.
+func FromError(err error) (int, Envelope) {
+	var apiErr *Error
+	if errors.As(err, &apiErr) {
+		code := apiErr.Code
+		if code == "" {
+			code = CodeForStatus(apiErr.Status)
+		}
+		return apiErr.Status, Envelope{Code: code, Message: apiErr.Message, Details: apiErr.Details}
+	}
+
+	var he *echo.HTTPError
+	if errors.As(err, &he) {
+		env := Envelope{Code: CodeForStatus(he.Code)}
+		switch msg := he.Message.(type) {
+		case string:
+			env.Message = msg
+		case error:
+			env.Message = msg.Error()
+		case nil:
+		default:
+			env.Details = msg
+		}
+		if env.Message == "" {
+			env.Message = http.StatusText(he.Code)
+		}
+		return he.Code, env
+	}
+
+	return http.StatusInternalServerError, Envelope{
+		Code:    CodeInternal,
+		Message: http.StatusText(http.StatusInternalServerError),
+	}
+}
+
+// CorrelationID returns the ID assigned to the request by the request ID
+// middleware.
+func CorrelationID(c echo.Context) string {
+	if id := c.Response().Header().Get(echo.HeaderXRequestID); id != "" {
+		return id
+	}
+	return c.Request().Header.Get(echo.HeaderXRequestID)
+}
+
+// Handler is an echo.HTTPErrorHandler that writes errors as an Envelope.
+func Handler(err error, c echo.Context) {
+	if c.Response().Committed {
+		return
+	}
+
+	status, env := FromError(err)
+	env.CorrelationID = CorrelationID(c)
+
+	var writeErr error
+	if c.Request().Method == http.MethodHead {
+		writeErr = c.NoContent(status)
+	} else {
+		writeErr = c.JSON(status, env)
+	}
+	if writeErr != nil {
+		c.Logger().Error(writeErr)
+	}
+}
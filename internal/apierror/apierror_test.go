@@ -0,0 +1,115 @@
+package apierror
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+)
+
+func TestFromError(t *testing.T) {
+	tests := []struct {
+		name        string
+		err         error
+		wantStatus  int
+		wantCode    Code
+		wantMessage string
+		wantDetails bool
+	}{
+		{
+			name:        "echo http error",
+			err:         echo.NewHTTPError(http.StatusNotFound, "movie not found"),
+			wantStatus:  http.StatusNotFound,
+			wantCode:    CodeNotFound,
+			wantMessage: "movie not found",
+		},
+		{
+			name:        "echo http error without message",
+			err:         echo.ErrMethodNotAllowed,
+			wantStatus:  http.StatusMethodNotAllowed,
+			wantCode:    CodeMethodNotAllowed,
+			wantMessage: "Method Not Allowed",
+		},
+		{
+			name:        "echo http error with structured message",
+			err:         echo.NewHTTPError(http.StatusBadRequest, map[string]string{"field": "name"}),
+			wantStatus:  http.StatusBadRequest,
+			wantCode:    CodeBadRequest,
+			wantMessage: "Bad Request",
+			wantDetails: true,
+		},
+		{
+			name:        "unmapped status",
+			err:         echo.NewHTTPError(http.StatusTeapot, "short and stout"),
+			wantStatus:  http.StatusTeapot,
+			wantCode:    "i'm_a_teapot",
+			wantMessage: "short and stout",
+		},
+		{
+			name:        "api error",
+			err:         New(http.StatusConflict, CodePathConflict, "path in use").WithDetails(map[string]string{"path": "/movies/x"}),
+			wantStatus:  http.StatusConflict,
+			wantCode:    CodePathConflict,
+			wantMessage: "path in use",
+			wantDetails: true,
+		},
+		{
+			name:        "wrapped api error",
+			err:         fmt.Errorf("handler: %w", New(http.StatusServiceUnavailable, "", "no provider")),
+			wantStatus:  http.StatusServiceUnavailable,
+			wantCode:    CodeServiceUnavailable,
+			wantMessage: "no provider",
+		},
+		{
+			name:        "plain error is hidden",
+			err:         errors.New("sql: database is locked"),
+			wantStatus:  http.StatusInternalServerError,
+			wantCode:    CodeInternal,
+			wantMessage: "Internal Server Error",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			status, env := FromError(tt.err)
+			if status != tt.wantStatus {
+				t.Errorf("status = %d, want %d", status, tt.wantStatus)
+			}
+			if env.Code != tt.wantCode {
+				t.Errorf("code = %q, want %q", env.Code, tt.wantCode)
+			}
+			if env.Message != tt.wantMessage {
+				t.Errorf("message = %q, want %q", env.Message, tt.wantMessage)
+			}
+			if (env.Details != nil) != tt.wantDetails {
+				t.Errorf("details = %v, want present=%v", env.Details, tt.wantDetails)
+			}
+		})
+	}
+}
+
+func TestHandler(t *testing.T) {
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/movies/1", http.NoBody)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.Response().Header().Set(echo.HeaderXRequestID, "abc123")
+
+	Handler(echo.NewHTTPError(http.StatusNotFound, "movie not found"), c)
+
+	if rec.Code != http.StatusNotFound {
+		t.Fatalf("status = %d, want %d", rec.Code, http.StatusNotFound)
+	}
+	var env Envelope
+	if err := json.Unmarshal(rec.Body.Bytes(), &env); err != nil {
+		t.Fatalf("Unmarshal error = %v", err)
+	}
+	want := Envelope{Code: CodeNotFound, Message: "movie not found", CorrelationID: "abc123"}
+	if env != want {
+		t.Errorf("envelope = %+v, want %+v", env, want)
+	}
+}
@@ -0,0 +1,160 @@
+package arrcompat
+
+import (
+	"testing"
+	"time"
+
+	"github.com/slipstream/slipstream/internal/downloader"
+	"github.com/slipstream/slipstream/internal/history"
+	"github.com/slipstream/slipstream/internal/library/movies"
+	"github.com/slipstream/slipstream/internal/library/status"
+	"github.com/slipstream/slipstream/internal/library/tv"
+)
+
+func TestParseLookupTerm(t *testing.T) {
+	tests := []struct {
+		term      string
+		wantQuery string
+		wantID    int
+	}{
+		{"tmdb:603", "", 603},
+		{" TMDB: 603 ", "", 603},
+		{"The Matrix", "The Matrix", 0},
+		{"tmdb:abc", "tmdb:abc", 0},
+		{"tvdb:81189", "tvdb:81189", 0},
+	}
+	for _, tt := range tests {
+		query, id := parseLookupTerm(tt.term, "tmdb")
+		if query != tt.wantQuery || id != tt.wantID {
+			t.Errorf("parseLookupTerm(%q) = (%q, %d), want (%q, %d)", tt.term, query, id, tt.wantQuery, tt.wantID)
+		}
+	}
+}
+
+func TestToMovie(t *testing.T) {
+	m := &movies.Movie{
+		ID:                  7,
+		Title:               "Arrival",
+		TmdbID:              329865,
+		RootFolderID:        2,
+		Status:              status.Unreleased,
+		MinimumAvailability: status.AvailabilityInCinemas,
+	}
+	got := toMovie(m, rootPaths{2: "/movies"})
+	if got.RootFolderPath != "/movies" || got.Status != "announced" || got.IsAvailable || got.HasFile {
+		t.Errorf("toMovie() = %+v", got)
+	}
+	if got.MinimumAvailability != "inCinemas" {
+		t.Errorf("MinimumAvailability = %q, want inCinemas", got.MinimumAvailability)
+	}
+	if got.Tags == nil || got.Images == nil {
+		t.Error("Tags and Images must encode as empty arrays, not null")
+	}
+
+	m.Status = status.Available
+	if got := toMovie(m, nil); got.Status != "released" || !got.HasFile || !got.IsAvailable {
+		t.Errorf("available movie = %+v", got)
+	}
+}
+
+func TestToSeries(t *testing.T) {
+	s := &tv.Series{
+		ID:         3,
+		Title:      "Frieren",
+		FormatType: tv.FormatTypeAnime,
+		Seasons: []tv.Season{
+			{SeasonNumber: 1, Monitored: true, StatusCounts: tv.StatusCounts{Available: 3, Upgradable: 1, Unreleased: 2, Total: 10}},
+		},
+	}
+	got := toSeries(s, nil)
+	if got.SeriesType != "anime" {
+		t.Errorf("SeriesType = %q, want anime", got.SeriesType)
+	}
+	stats := got.Seasons[0].Statistics
+	if stats.EpisodeFileCount != 4 || stats.EpisodeCount != 8 || stats.TotalEpisodeCount != 10 || stats.PercentOfEpisodes != 50 {
+		t.Errorf("season statistics = %+v", stats)
+	}
+}
+
+func TestToQueueRecord(t *testing.T) {
+	movieID := int64(5)
+	item := &downloader.QueueItem{
+		ID:             "abc",
+		ClientID:       1,
+		ClientName:     "qBittorrent",
+		ClientType:     "qbittorrent",
+		Title:          "Arrival",
+		ReleaseName:    "Arrival.2016.1080p.BluRay",
+		Status:         "downloading",
+		Size:           1000,
+		DownloadedSize: 400,
+		ETA:            90061,
+		MovieID:        &movieID,
+	}
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	got := toQueueRecord(item, now)
+	if got.Title != item.ReleaseName || got.Sizeleft != 600 || got.Protocol != "torrent" || got.DownloadID != "abc" {
+		t.Errorf("toQueueRecord() = %+v", got)
+	}
+	if got.Timeleft != "1.01:01:01" {
+		t.Errorf("Timeleft = %q, want 1.01:01:01", got.Timeleft)
+	}
+	if got.EstimatedCompletionTime == nil || !got.EstimatedCompletionTime.Equal(now.Add(90061*time.Second)) {
+		t.Errorf("EstimatedCompletionTime = %v", got.EstimatedCompletionTime)
+	}
+	if got.ID != toQueueRecord(item, now).ID {
+		t.Error("queue record ID is not stable")
+	}
+
+	item.Status = "error"
+	if got := toQueueRecord(item, now); got.Status != "failed" || got.TrackedDownloadStatus != "error" || got.EstimatedCompletionTime != nil {
+		t.Errorf("failed item = %+v", got)
+	}
+}
+
+func TestToHistoryRecord(t *testing.T) {
+	seriesID := int64(9)
+	e := &history.Entry{
+		ID:         1,
+		EventType:  history.EventTypeAutoSearchDownload,
+		MediaType:  history.MediaTypeEpisode,
+		MediaID:    42,
+		SeriesID:   &seriesID,
+		MediaTitle: "Show S01E01",
+		Data:       map[string]any{"releaseName": "Show.S01E01.720p", "downloadId": "xyz"},
+	}
+	got := toHistoryRecord(e)
+	if got.EventType != "grabbed" || got.EpisodeID != 42 || got.SeriesID != 9 {
+		t.Errorf("toHistoryRecord() = %+v", got)
+	}
+	if got.SourceTitle != "Show.S01E01.720p" || got.DownloadID != "xyz" {
+		t.Errorf("SourceTitle = %q, DownloadID = %q", got.SourceTitle, got.DownloadID)
+	}
+
+	if got := historyEventType(history.EventTypeDeleted, "movie"); got != "movieFileDeleted" {
+		t.Errorf("deleted event = %q, want movieFileDeleted", got)
+	}
+}
+
+func TestRunCommandValidation(t *testing.T) {
+	s := &Service{}
+	for _, cmd := range []Command{
+		{Name: CommandMoviesSearch},
+		{Name: CommandSeriesSearch},
+		{Name: CommandSeasonSearch, SeriesID: 1},
+		{Name: "RefreshMonitoredDownloads"},
+	} {
+		if _, err := s.RunCommand(&cmd); err == nil {
+			t.Errorf("RunCommand(%+v) succeeded, want error", cmd)
+		}
+	}
+}
+
+func TestNormalizeRootPath(t *testing.T) {
+	if got := normalizeRootPath(`D:\Media\Movies\`); got != "D:/Media/Movies" {
+		t.Errorf("normalizeRootPath() = %q", got)
+	}
+	if got := normalizeRootPath("/"); got != "/" {
+		t.Errorf("normalizeRootPath(/) = %q", got)
+	}
+}
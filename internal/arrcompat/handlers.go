@@ -0,0 +1,252 @@
+package arrcompat
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/slipstream/slipstream/internal/library/librarymanager"
+)
+
+// Handlers provides the Radarr and Sonarr v3 HTTP endpoints.
+type Handlers struct {
+	service *Service
+}
+
+// NewHandlers creates new v3 compatibility handlers.
+func NewHandlers(service *Service) *Handlers {
+	return &Handlers{service: service}
+}
+
+// RegisterRadarrRoutes registers the Radarr v3 routes. These are intended to
+// be mounted at /radarr/api/v3 on a group that accepts API key authentication.
+func (h *Handlers) RegisterRadarrRoutes(g *echo.Group) {
+	h.registerCommonRoutes(g, AppRadarr)
+	g.GET("/movie", h.ListMovies)
+	g.POST("/movie", h.AddMovie)
+	g.GET("/movie/lookup", h.LookupMovies)
+	g.GET("/movie/lookup/tmdb", h.LookupMovieByTmdbID)
+	g.GET("/movie/:id", h.GetMovie)
+}
+
+// RegisterSonarrRoutes registers the Sonarr v3 routes. These are intended to
+// be mounted at /sonarr/api/v3 on a group that accepts API key authentication.
+func (h *Handlers) RegisterSonarrRoutes(g *echo.Group) {
+	h.registerCommonRoutes(g, AppSonarr)
+	g.GET("/series", h.ListSeries)
+	g.POST("/series", h.AddSeries)
+	g.PUT("/series", h.UpdateSeries)
+	g.GET("/series/lookup", h.LookupSeries)
+	g.GET("/series/:id", h.GetSeries)
+	g.PUT("/series/:id", h.UpdateSeries)
+	g.GET("/languageprofile", h.ListLanguageProfiles)
+}
+
+func (h *Handlers) registerCommonRoutes(g *echo.Group, app App) {
+	g.GET("/system/status", func(c echo.Context) error {
+		return c.JSON(http.StatusOK, h.service.Status(app))
+	})
+	g.GET("/qualityprofile", func(c echo.Context) error {
+		profiles, err := h.service.QualityProfiles(c.Request().Context(), app)
+		return respond(c, http.StatusOK, profiles, err)
+	})
+	g.GET("/rootfolder", func(c echo.Context) error {
+		folders, err := h.service.RootFolders(c.Request().Context(), app)
+		return respond(c, http.StatusOK, folders, err)
+	})
+	g.GET("/tag", h.ListTags)
+	g.GET("/queue", func(c echo.Context) error {
+		page, pageSize := pagingParams(c)
+		queue, err := h.service.Queue(c.Request().Context(), app, page, pageSize)
+		return respond(c, http.StatusOK, queue, err)
+	})
+	g.GET("/history", func(c echo.Context) error {
+		page, pageSize := pagingParams(c)
+		hist, err := h.service.History(c.Request().Context(), app, page, pageSize)
+		return respond(c, http.StatusOK, hist, err)
+	})
+	g.POST("/command", h.RunCommand)
+}
+
+// ListTags returns all tags.
+// GET /{app}/api/v3/tag
+func (h *Handlers) ListTags(c echo.Context) error {
+	list, err := h.service.Tags(c.Request().Context())
+	return respond(c, http.StatusOK, list, err)
+}
+
+// RunCommand queues a search command.
+// POST /{app}/api/v3/command
+func (h *Handlers) RunCommand(c echo.Context) error {
+	var cmd Command
+	if err := c.Bind(&cmd); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+	queued, err := h.service.RunCommand(&cmd)
+	return respond(c, http.StatusCreated, queued, err)
+}
+
+// ListMovies returns library movies, filtered by ?tmdbId= when given.
+// GET /radarr/api/v3/movie
+func (h *Handlers) ListMovies(c echo.Context) error {
+	tmdbID, _ := strconv.Atoi(c.QueryParam("tmdbId"))
+	list, err := h.service.Movies(c.Request().Context(), tmdbID)
+	return respond(c, http.StatusOK, list, err)
+}
+
+// GetMovie returns a library movie.
+// GET /radarr/api/v3/movie/:id
+func (h *Handlers) GetMovie(c echo.Context) error {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid movie ID")
+	}
+	movie, err := h.service.Movie(c.Request().Context(), id)
+	return respond(c, http.StatusOK, movie, err)
+}
+
+// LookupMovies searches metadata by ?term=, which may be "tmdb:<id>".
+// GET /radarr/api/v3/movie/lookup
+func (h *Handlers) LookupMovies(c echo.Context) error {
+	list, err := h.service.LookupMovies(c.Request().Context(), c.QueryParam("term"), 0)
+	return respond(c, http.StatusOK, list, err)
+}
+
+// LookupMovieByTmdbID returns the metadata of a single movie.
+// GET /radarr/api/v3/movie/lookup/tmdb?tmdbId=
+func (h *Handlers) LookupMovieByTmdbID(c echo.Context) error {
+	tmdbID, err := strconv.Atoi(c.QueryParam("tmdbId"))
+	if err != nil || tmdbID <= 0 {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid tmdbId")
+	}
+	list, err := h.service.LookupMovies(c.Request().Context(), "", tmdbID)
+	if err != nil {
+		return serviceError(c, err)
+	}
+	if len(list) == 0 {
+		return echo.NewHTTPError(http.StatusNotFound, "movie not found")
+	}
+	return c.JSON(http.StatusOK, list[0])
+}
+
+// AddMovie adds a movie to the library.
+// POST /radarr/api/v3/movie
+func (h *Handlers) AddMovie(c echo.Context) error {
+	var input Movie
+	if err := c.Bind(&input); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+	movie, err := h.service.AddMovie(c.Request().Context(), &input)
+	return respond(c, http.StatusCreated, movie, err)
+}
+
+// ListSeries returns library series, filtered by ?tvdbId= when given.
+// GET /sonarr/api/v3/series
+func (h *Handlers) ListSeries(c echo.Context) error {
+	tvdbID, _ := strconv.Atoi(c.QueryParam("tvdbId"))
+	list, err := h.service.SeriesList(c.Request().Context(), tvdbID)
+	return respond(c, http.StatusOK, list, err)
+}
+
+// GetSeries returns a library series.
+// GET /sonarr/api/v3/series/:id
+func (h *Handlers) GetSeries(c echo.Context) error {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid series ID")
+	}
+	series, err := h.service.Series(c.Request().Context(), id)
+	return respond(c, http.StatusOK, series, err)
+}
+
+// LookupSeries searches metadata by ?term=, which may be "tvdb:<id>".
+// GET /sonarr/api/v3/series/lookup
+func (h *Handlers) LookupSeries(c echo.Context) error {
+	list, err := h.service.LookupSeries(c.Request().Context(), c.QueryParam("term"))
+	return respond(c, http.StatusOK, list, err)
+}
+
+// AddSeries adds a series to the library.
+// POST /sonarr/api/v3/series
+func (h *Handlers) AddSeries(c echo.Context) error {
+	var input Series
+	if err := c.Bind(&input); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+	series, err := h.service.AddSeries(c.Request().Context(), &input)
+	return respond(c, http.StatusCreated, series, err)
+}
+
+// UpdateSeries updates series and season monitoring. The ID comes from the
+// path or, as older clients send it, the body.
+// PUT /sonarr/api/v3/series[/:id]
+func (h *Handlers) UpdateSeries(c echo.Context) error {
+	var input Series
+	if err := c.Bind(&input); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+	id := input.ID
+	if param := c.Param("id"); param != "" {
+		parsed, err := strconv.ParseInt(param, 10, 64)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, "invalid series ID")
+		}
+		id = parsed
+	}
+	if id <= 0 {
+		return echo.NewHTTPError(http.StatusBadRequest, "series ID is required")
+	}
+	series, err := h.service.UpdateSeries(c.Request().Context(), id, &input)
+	return respond(c, http.StatusAccepted, series, err)
+}
+
+// ListLanguageProfiles returns the language profiles.
+// GET /sonarr/api/v3/languageprofile
+func (h *Handlers) ListLanguageProfiles(c echo.Context) error {
+	profiles, err := h.service.LanguageProfiles(c.Request().Context())
+	return respond(c, http.StatusOK, profiles, err)
+}
+
+func pagingParams(c echo.Context) (page, pageSize int) {
+	page, _ = strconv.Atoi(c.QueryParam("page"))
+	pageSize, _ = strconv.Atoi(c.QueryParam("pageSize"))
+	return page, pageSize
+}
+
+func respond(c echo.Context, status int, body any, err error) error {
+	if err != nil {
+		return serviceError(c, err)
+	}
+	return c.JSON(status, body)
+}
+
+// serviceError maps service errors to v3 responses. Failed add checks use
+// the v3 validation failure list, which clients show to the user.
+func serviceError(c echo.Context, err error) error {
+	var invalid *librarymanager.AddValidationError
+	if errors.As(err, &invalid) {
+		failures := make([]ValidationFailure, 0, len(invalid.Checks))
+		for _, check := range invalid.Checks {
+			if check.Severity != librarymanager.AddCheckError {
+				continue
+			}
+			failures = append(failures, ValidationFailure{PropertyName: check.Field, ErrorMessage: check.Message, Severity: "error"})
+		}
+		return c.JSON(http.StatusBadRequest, failures)
+	}
+
+	var conflict *librarymanager.PathConflictError
+	switch {
+	case errors.Is(err, ErrNotFound):
+		return echo.NewHTTPError(http.StatusNotFound, err.Error())
+	case errors.Is(err, ErrInvalidRequest), errors.Is(err, ErrUnknownCommand), errors.Is(err, librarymanager.ErrPathOutsideRoot):
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	case errors.As(err, &conflict):
+		return echo.NewHTTPError(http.StatusConflict, conflict.Error())
+	case errors.Is(err, librarymanager.ErrNoMetadataProvider):
+		return echo.NewHTTPError(http.StatusServiceUnavailable, "no metadata provider configured")
+	}
+	return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+}
@@ -0,0 +1,350 @@
+package arrcompat
+
+import (
+	"fmt"
+	"hash/fnv"
+	"strings"
+	"time"
+
+	"github.com/slipstream/slipstream/internal/downloader"
+	"github.com/slipstream/slipstream/internal/downloader/types"
+	"github.com/slipstream/slipstream/internal/history"
+	"github.com/slipstream/slipstream/internal/library/movies"
+	"github.com/slipstream/slipstream/internal/library/quality"
+	"github.com/slipstream/slipstream/internal/library/status"
+	"github.com/slipstream/slipstream/internal/library/tv"
+	"github.com/slipstream/slipstream/internal/metadata"
+)
+
+// rootPaths maps root folder IDs to their paths.
+type rootPaths map[int64]string
+
+func posterImages(posterURL, backdropURL string) []Image {
+	images := []Image{}
+	if posterURL != "" {
+		images = append(images, Image{CoverType: "poster", URL: posterURL, RemoteURL: posterURL})
+	}
+	if backdropURL != "" {
+		images = append(images, Image{CoverType: "fanart", URL: backdropURL, RemoteURL: backdropURL})
+	}
+	return images
+}
+
+func nonNilTags(tags []int64) []int64 {
+	if tags == nil {
+		return []int64{}
+	}
+	return tags
+}
+
+func timePtr(t time.Time) *time.Time {
+	if t.IsZero() {
+		return nil
+	}
+	return &t
+}
+
+// movieStatus maps a SlipStream movie status to Radarr's release status.
+func movieStatus(s string) string {
+	if s == status.Unreleased {
+		return "announced"
+	}
+	return "released"
+}
+
+// minimumAvailability maps a SlipStream availability level to Radarr's.
+func minimumAvailability(level string) string {
+	switch level {
+	case status.AvailabilityAnnounced:
+		return "announced"
+	case status.AvailabilityInCinemas:
+		return "inCinemas"
+	default:
+		return "released"
+	}
+}
+
+func toMovie(m *movies.Movie, roots rootPaths) Movie {
+	hasFile := len(m.MovieFiles) > 0 || m.Status == status.Available || m.Status == status.Upgradable
+	return Movie{
+		ID:                  m.ID,
+		Title:               m.Title,
+		SortTitle:           m.SortTitle,
+		Year:                m.Year,
+		TmdbID:              m.TmdbID,
+		ImdbID:              m.ImdbID,
+		Overview:            m.Overview,
+		Runtime:             m.Runtime,
+		Studio:              m.Studio,
+		Certification:       m.ContentRating,
+		Path:                m.Path,
+		RootFolderPath:      roots[m.RootFolderID],
+		QualityProfileID:    m.QualityProfileID,
+		Monitored:           m.Monitored,
+		MinimumAvailability: minimumAvailability(m.MinimumAvailability),
+		Status:              movieStatus(m.Status),
+		IsAvailable:         m.Status != status.Unreleased,
+		HasFile:             hasFile,
+		SizeOnDisk:          m.SizeOnDisk,
+		InCinemas:           m.TheatricalReleaseDate,
+		PhysicalRelease:     m.PhysicalReleaseDate,
+		DigitalRelease:      m.ReleaseDate,
+		Added:               timePtr(m.AddedAt),
+		Images:              []Image{},
+		Tags:                nonNilTags(m.Tags),
+	}
+}
+
+// movieFromMetadata builds a lookup result for a movie not in the library.
+func movieFromMetadata(r *metadata.MovieResult) Movie {
+	return Movie{
+		Title:               r.Title,
+		Year:                r.Year,
+		TmdbID:              r.ID,
+		ImdbID:              r.ImdbID,
+		Overview:            r.Overview,
+		Runtime:             r.Runtime,
+		Studio:              r.Studio,
+		MinimumAvailability: "released",
+		Images:              posterImages(r.PosterURL, r.BackdropURL),
+		Tags:                []int64{},
+	}
+}
+
+func seasonStatistics(counts tv.StatusCounts, size int64) *SeasonStatistics {
+	stats := &SeasonStatistics{
+		EpisodeFileCount:  counts.Available + counts.Upgradable,
+		EpisodeCount:      counts.Total - counts.Unreleased,
+		TotalEpisodeCount: counts.Total,
+		SizeOnDisk:        size,
+	}
+	if stats.EpisodeCount > 0 {
+		stats.PercentOfEpisodes = float64(stats.EpisodeFileCount) / float64(stats.EpisodeCount) * 100
+	}
+	return stats
+}
+
+func seriesType(s *tv.Series) string {
+	if s.IsAnime() {
+		return "anime"
+	}
+	return "standard"
+}
+
+func toSeries(s *tv.Series, roots rootPaths) Series {
+	seasons := make([]Season, 0, len(s.Seasons))
+	for i := range s.Seasons {
+		season := &s.Seasons[i]
+		seasons = append(seasons, Season{
+			SeasonNumber: season.SeasonNumber,
+			Monitored:    season.Monitored,
+			Statistics:   seasonStatistics(season.StatusCounts, season.SizeOnDisk),
+		})
+	}
+	return Series{
+		ID:                s.ID,
+		Title:             s.Title,
+		SortTitle:         s.SortTitle,
+		Year:              s.Year,
+		TvdbID:            s.TvdbID,
+		TmdbID:            s.TmdbID,
+		ImdbID:            s.ImdbID,
+		Overview:          s.Overview,
+		Runtime:           s.Runtime,
+		Network:           s.Network,
+		Status:            s.ProductionStatus,
+		SeriesType:        seriesType(s),
+		Path:              s.Path,
+		RootFolderPath:    roots[s.RootFolderID],
+		QualityProfileID:  s.QualityProfileID,
+		LanguageProfileID: s.LanguageProfileID,
+		Monitored:         s.Monitored,
+		SeasonFolder:      s.SeasonFolder,
+		FirstAired:        s.FirstAired,
+		Added:             timePtr(s.AddedAt),
+		Images:            []Image{},
+		Seasons:           seasons,
+		Statistics:        seasonStatistics(s.StatusCounts, s.SizeOnDisk),
+		Tags:              nonNilTags(s.Tags),
+	}
+}
+
+// seriesFromMetadata builds a lookup result for a series not in the library.
+func seriesFromMetadata(r *metadata.SeriesResult) Series {
+	return Series{
+		Title:      r.Title,
+		Year:       r.Year,
+		TvdbID:     r.TvdbID,
+		TmdbID:     r.TmdbID,
+		ImdbID:     r.ImdbID,
+		Overview:   r.Overview,
+		Runtime:    r.Runtime,
+		Network:    r.Network,
+		Status:     r.Status,
+		SeriesType: "standard",
+		Images:     posterImages(r.PosterURL, r.BackdropURL),
+		Seasons:    []Season{},
+		Tags:       []int64{},
+	}
+}
+
+func qualityModel(name string) QualityModel {
+	q, _ := quality.PredefinedQualityByName(name)
+	if name == "" {
+		name = "Unknown"
+	}
+	return QualityModel{Quality: Quality{ID: q.ID, Name: name}}
+}
+
+// queueRecordID derives a stable numeric ID for a queue item, which v3
+// clients expect but download clients identify by string.
+func queueRecordID(item *downloader.QueueItem) int64 {
+	h := fnv.New32a()
+	fmt.Fprintf(h, "%d:%s", item.ClientID, item.ID)
+	return int64(h.Sum32())
+}
+
+// queueState maps a download status to the v3 status, tracked download
+// status and tracked download state.
+func queueState(s string) (queueStatus, trackedStatus, trackedState string) {
+	switch types.Status(s) {
+	case types.StatusQueued:
+		return "queued", "ok", "downloading"
+	case types.StatusPaused:
+		return "paused", "ok", "downloading"
+	case types.StatusCompleted, types.StatusSeeding:
+		return "completed", "ok", "importPending"
+	case types.StatusWarning:
+		return "warning", "warning", "downloading"
+	case types.StatusError:
+		return "failed", "error", "failedPending"
+	case types.StatusUnknown:
+		return "unknown", "ok", "downloading"
+	default:
+		return "downloading", "ok", "downloading"
+	}
+}
+
+// formatTimeleft renders seconds as the .NET TimeSpan string v3 clients parse.
+func formatTimeleft(seconds int64) string {
+	if seconds < 0 {
+		return ""
+	}
+	days := seconds / 86400
+	clock := fmt.Sprintf("%02d:%02d:%02d", seconds%86400/3600, seconds%3600/60, seconds%60)
+	if days > 0 {
+		return fmt.Sprintf("%d.%s", days, clock)
+	}
+	return clock
+}
+
+func toQueueRecord(item *downloader.QueueItem, now time.Time) QueueRecord {
+	queueStatus, trackedStatus, trackedState := queueState(item.Status)
+	sizeleft := item.Size - item.DownloadedSize
+	if sizeleft < 0 {
+		sizeleft = 0
+	}
+	title := item.ReleaseName
+	if title == "" {
+		title = item.Title
+	}
+	record := QueueRecord{
+		ID:                    queueRecordID(item),
+		MovieID:               item.MovieID,
+		SeriesID:              item.SeriesID,
+		EpisodeID:             item.EpisodeID,
+		SeasonNumber:          item.SeasonNumber,
+		Title:                 title,
+		Quality:               qualityModel(item.Quality),
+		Size:                  float64(item.Size),
+		Sizeleft:              float64(sizeleft),
+		Timeleft:              formatTimeleft(item.ETA),
+		Status:                queueStatus,
+		TrackedDownloadStatus: trackedStatus,
+		TrackedDownloadState:  trackedState,
+		DownloadID:            item.ID,
+		Protocol:              string(types.ProtocolForClient(types.ClientType(item.ClientType))),
+		DownloadClient:        item.ClientName,
+	}
+	if item.ETA >= 0 && queueStatus == "downloading" {
+		eta := now.Add(time.Duration(item.ETA) * time.Second).UTC()
+		record.EstimatedCompletionTime = &eta
+	}
+	return record
+}
+
+// historyEventType maps a SlipStream history event to the v3 event type.
+// fileKind is "movie" or "episode" and prefixes file events.
+func historyEventType(eventType history.EventType, fileKind string) string {
+	switch eventType {
+	case history.EventTypeGrabbed, history.EventTypeAutoSearchDownload:
+		return "grabbed"
+	case history.EventTypeImported:
+		return "downloadFolderImported"
+	case history.EventTypeFailed, history.EventTypeAutoSearchFailed, history.EventTypeImportFailed:
+		return "downloadFailed"
+	case history.EventTypeDeleted:
+		return fileKind + "FileDeleted"
+	case history.EventTypeRenamed:
+		return fileKind + "FileRenamed"
+	default:
+		return "unknown"
+	}
+}
+
+func toHistoryRecord(e *history.Entry) HistoryRecord {
+	data := make(map[string]string, len(e.Data))
+	for k, v := range e.Data {
+		if v != nil {
+			data[k] = fmt.Sprint(v)
+		}
+	}
+
+	record := HistoryRecord{
+		ID:          e.ID,
+		SourceTitle: data["releaseName"],
+		Quality:     qualityModel(e.Quality),
+		Date:        e.CreatedAt,
+		DownloadID:  data["downloadId"],
+		Data:        data,
+	}
+	if record.SourceTitle == "" {
+		record.SourceTitle = e.MediaTitle
+	}
+
+	if e.MediaType == history.MediaTypeMovie {
+		record.MovieID = e.MediaID
+		record.EventType = historyEventType(e.EventType, "movie")
+	} else {
+		record.EpisodeID = e.MediaID
+		if e.SeriesID != nil {
+			record.SeriesID = *e.SeriesID
+		}
+		record.EventType = historyEventType(e.EventType, "episode")
+	}
+	return record
+}
+
+// parseLookupTerm splits a v3 lookup term like "tmdb:603" into a provider
+// ID. Plain terms are returned as a search query.
+func parseLookupTerm(term, prefix string) (query string, id int) {
+	term = strings.TrimSpace(term)
+	rest, ok := strings.CutPrefix(strings.ToLower(term), prefix+":")
+	if !ok {
+		return term, 0
+	}
+	if _, err := fmt.Sscanf(strings.TrimSpace(rest), "%d", &id); err != nil || id <= 0 {
+		return term, 0
+	}
+	return "", id
+}
+
+// normalizeRootPath trims trailing separators so client-supplied root folder
+// paths match the stored ones.
+func normalizeRootPath(path string) string {
+	path = strings.ReplaceAll(path, "\\", "/")
+	if len(path) > 1 {
+		path = strings.TrimRight(path, "/")
+	}
+	return path
+}
@@ -0,0 +1,747 @@
+// Package arrcompat exposes the most-used Radarr and Sonarr v3 API endpoints
+// on top of SlipStream's services, so tools like Overseerr, Notifiarr and
+// Maintainerr can talk to SlipStream without modification.
+package arrcompat
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/rs/zerolog"
+
+	"github.com/slipstream/slipstream/internal/autosearch"
+	"github.com/slipstream/slipstream/internal/config"
+	"github.com/slipstream/slipstream/internal/downloader"
+	"github.com/slipstream/slipstream/internal/history"
+	"github.com/slipstream/slipstream/internal/library/language"
+	"github.com/slipstream/slipstream/internal/library/librarymanager"
+	"github.com/slipstream/slipstream/internal/library/movies"
+	"github.com/slipstream/slipstream/internal/library/quality"
+	"github.com/slipstream/slipstream/internal/library/rootfolder"
+	"github.com/slipstream/slipstream/internal/library/tv"
+	"github.com/slipstream/slipstream/internal/metadata"
+	"github.com/slipstream/slipstream/internal/tags"
+)
+
+// App is the *arr application a route group emulates.
+type App string
+
+const (
+	AppRadarr App = "radarr"
+	AppSonarr App = "sonarr"
+)
+
+// Command names accepted by POST /command.
+const (
+	CommandMoviesSearch  = "MoviesSearch"
+	CommandSeriesSearch  = "SeriesSearch"
+	CommandSeasonSearch  = "SeasonSearch"
+	CommandEpisodeSearch = "EpisodeSearch"
+)
+
+const (
+	mediaTypeMovie  = "movie"
+	mediaTypeSeries = "series"
+	moduleTypeMovie = "movie"
+	moduleTypeTV    = "tv"
+
+	defaultPageSize = 20
+	maxPageSize     = 1000
+)
+
+var (
+	ErrNotFound       = errors.New("not found")
+	ErrInvalidRequest = errors.New("invalid request")
+	ErrUnknownCommand = errors.New("unknown command")
+)
+
+// Service maps v3 requests onto SlipStream's library, queue and history.
+type Service struct {
+	movies     *movies.Service
+	tv         *tv.Service
+	library    *librarymanager.Service
+	quality    *quality.Service
+	rootFolder *rootfolder.Service
+	tags       *tags.Service
+	language   *language.Service
+	downloader *downloader.Service
+	history    *history.Service
+	autosearch *autosearch.Service
+	logger     *zerolog.Logger
+
+	commandID atomic.Int64
+}
+
+// NewService creates a new v3 compatibility service.
+func NewService(
+	movieService *movies.Service,
+	tvService *tv.Service,
+	libraryManager *librarymanager.Service,
+	qualityService *quality.Service,
+	rootFolderService *rootfolder.Service,
+	tagsService *tags.Service,
+	languageService *language.Service,
+	downloaderService *downloader.Service,
+	historyService *history.Service,
+	autosearchService *autosearch.Service,
+	logger *zerolog.Logger,
+) *Service {
+	subLogger := logger.With().Str("component", "arrcompat").Logger()
+	return &Service{
+		movies:     movieService,
+		tv:         tvService,
+		library:    libraryManager,
+		quality:    qualityService,
+		rootFolder: rootFolderService,
+		tags:       tagsService,
+		language:   languageService,
+		downloader: downloaderService,
+		history:    historyService,
+		autosearch: autosearchService,
+		logger:     &subLogger,
+	}
+}
+
+// Status returns the system status reported as app.
+func (s *Service) Status(app App) *SystemStatus {
+	name := "Radarr"
+	if app == AppSonarr {
+		name = "Sonarr"
+	}
+	return &SystemStatus{
+		AppName:        name,
+		InstanceName:   "SlipStream",
+		Version:        config.Version,
+		Authentication: "apiKey",
+		IsProduction:   true,
+	}
+}
+
+func moduleType(app App) string {
+	if app == AppSonarr {
+		return moduleTypeTV
+	}
+	return moduleTypeMovie
+}
+
+// QualityProfiles lists the quality profiles of the app's module.
+func (s *Service) QualityProfiles(ctx context.Context, app App) ([]QualityProfile, error) {
+	profiles, err := s.quality.ListByModule(ctx, moduleType(app))
+	if err != nil {
+		return nil, err
+	}
+	out := make([]QualityProfile, 0, len(profiles))
+	for _, p := range profiles {
+		out = append(out, QualityProfile{ID: p.ID, Name: p.Name, UpgradeAllowed: p.UpgradesEnabled, Cutoff: p.Cutoff})
+	}
+	return out, nil
+}
+
+// LanguageProfiles lists the language profiles.
+func (s *Service) LanguageProfiles(ctx context.Context) ([]LanguageProfile, error) {
+	profiles, err := s.language.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]LanguageProfile, 0, len(profiles))
+	for _, p := range profiles {
+		out = append(out, LanguageProfile{ID: p.ID, Name: p.Name})
+	}
+	return out, nil
+}
+
+// RootFolders lists the root folders of the app's module.
+func (s *Service) RootFolders(ctx context.Context, app App) ([]RootFolder, error) {
+	folders, err := s.rootFolder.ListByType(ctx, moduleType(app))
+	if err != nil {
+		return nil, err
+	}
+	out := make([]RootFolder, 0, len(folders))
+	for _, rf := range folders {
+		out = append(out, RootFolder{
+			ID:              rf.ID,
+			Path:            rf.Path,
+			Accessible:      !rf.Unreachable,
+			FreeSpace:       rf.FreeSpace,
+			TotalSpace:      rf.TotalSpace,
+			UnmappedFolders: []string{},
+		})
+	}
+	return out, nil
+}
+
+// Tags lists all tags.
+func (s *Service) Tags(ctx context.Context) ([]Tag, error) {
+	list, err := s.tags.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]Tag, 0, len(list))
+	for _, t := range list {
+		out = append(out, Tag{ID: t.ID, Label: t.Label})
+	}
+	return out, nil
+}
+
+func (s *Service) rootPaths(ctx context.Context, module string) (rootPaths, error) {
+	folders, err := s.rootFolder.ListByType(ctx, module)
+	if err != nil {
+		return nil, err
+	}
+	roots := make(rootPaths, len(folders))
+	for _, rf := range folders {
+		roots[rf.ID] = rf.Path
+	}
+	return roots, nil
+}
+
+// resolveRootFolder finds the root folder a v3 add request targets, by its
+// rootFolderPath or else the folder containing its path.
+func (s *Service) resolveRootFolder(ctx context.Context, module, rootFolderPath, path string) (*rootfolder.RootFolder, error) {
+	folders, err := s.rootFolder.ListByType(ctx, module)
+	if err != nil {
+		return nil, err
+	}
+	want := normalizeRootPath(rootFolderPath)
+	item := normalizeRootPath(path)
+	for _, rf := range folders {
+		root := normalizeRootPath(rf.Path)
+		if want != "" && strings.EqualFold(root, want) {
+			return rf, nil
+		}
+		if want == "" && item != "" && strings.HasPrefix(strings.ToLower(item), strings.ToLower(root)+"/") {
+			return rf, nil
+		}
+	}
+	return nil, fmt.Errorf("%w: root folder %q not found", ErrInvalidRequest, rootFolderPath)
+}
+
+// Movies lists library movies, optionally only the one with tmdbID.
+func (s *Service) Movies(ctx context.Context, tmdbID int) ([]Movie, error) {
+	roots, err := s.rootPaths(ctx, moduleTypeMovie)
+	if err != nil {
+		return nil, err
+	}
+
+	if tmdbID > 0 {
+		movie, err := s.movies.GetByTmdbID(ctx, tmdbID)
+		if errors.Is(err, movies.ErrMovieNotFound) {
+			return []Movie{}, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		return []Movie{toMovie(movie, roots)}, nil
+	}
+
+	list, err := s.movies.List(ctx, movies.ListMoviesOptions{})
+	if err != nil {
+		return nil, err
+	}
+	out := make([]Movie, 0, len(list))
+	for _, m := range list {
+		out = append(out, toMovie(m, roots))
+	}
+	return out, nil
+}
+
+// Movie returns a library movie.
+func (s *Service) Movie(ctx context.Context, id int64) (*Movie, error) {
+	movie, err := s.movies.Get(ctx, id)
+	if errors.Is(err, movies.ErrMovieNotFound) {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	roots, err := s.rootPaths(ctx, moduleTypeMovie)
+	if err != nil {
+		return nil, err
+	}
+	out := toMovie(movie, roots)
+	return &out, nil
+}
+
+// LookupMovies searches metadata for movies. A "tmdb:<id>" term looks up
+// that movie directly. Movies already in the library carry their library ID.
+func (s *Service) LookupMovies(ctx context.Context, term string, tmdbID int) ([]Movie, error) {
+	query, id := parseLookupTerm(term, "tmdb")
+	if tmdbID == 0 {
+		tmdbID = id
+	}
+	if query == "" && tmdbID == 0 {
+		return []Movie{}, nil
+	}
+
+	results, err := s.library.LookupForAdd(ctx, mediaTypeMovie, query, 0, tmdbID, 0)
+	if err != nil {
+		return nil, err
+	}
+	roots, err := s.rootPaths(ctx, moduleTypeMovie)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]Movie, 0, len(results))
+	for i := range results {
+		r := &results[i]
+		meta, ok := r.Metadata.(*metadata.MovieResult)
+		if !ok {
+			continue
+		}
+		movie := movieFromMetadata(meta)
+		if r.InLibrary {
+			if existing, err := s.movies.Get(ctx, r.LibraryID); err == nil {
+				images := movie.Images
+				movie = toMovie(existing, roots)
+				movie.Images = images
+			}
+		}
+		out = append(out, movie)
+	}
+	return out, nil
+}
+
+// AddMovie adds a movie from a Radarr POST /movie body.
+func (s *Service) AddMovie(ctx context.Context, in *Movie) (*Movie, error) {
+	if in.TmdbID <= 0 {
+		return nil, fmt.Errorf("%w: tmdbId is required", ErrInvalidRequest)
+	}
+	root, err := s.resolveRootFolder(ctx, moduleTypeMovie, in.RootFolderPath, in.Path)
+	if err != nil {
+		return nil, err
+	}
+
+	input := &librarymanager.AddMovieInput{
+		Title:            in.Title,
+		Year:             in.Year,
+		TmdbID:           in.TmdbID,
+		ImdbID:           in.ImdbID,
+		Overview:         in.Overview,
+		Runtime:          in.Runtime,
+		Studio:           in.Studio,
+		RootFolderID:     root.ID,
+		QualityProfileID: in.QualityProfileID,
+		Monitored:        in.Monitored,
+		Tags:             in.Tags,
+	}
+	if meta := s.movieMetadata(ctx, in.TmdbID); meta != nil {
+		input.Title, input.Year, input.ImdbID = meta.Title, meta.Year, meta.ImdbID
+		input.Overview, input.Runtime, input.Studio = meta.Overview, meta.Runtime, meta.Studio
+		input.PosterURL, input.BackdropURL = meta.PosterURL, meta.BackdropURL
+	}
+	if input.Title == "" {
+		return nil, fmt.Errorf("%w: title is required", ErrInvalidRequest)
+	}
+	input.Path = in.Path
+	if input.Path == "" {
+		input.Path = movies.GenerateMoviePath(root.Path, input.Title, input.Year)
+	}
+	search := in.AddOptions != nil && in.AddOptions.SearchForMovie
+	input.SearchOnAdd = &search
+
+	req := &librarymanager.AddOptionsRequest{
+		Title: input.Title, Year: input.Year, TmdbID: input.TmdbID, Path: input.Path,
+		RootFolderID: input.RootFolderID, QualityProfileID: input.QualityProfileID,
+	}
+	if err := s.library.ValidateAdd(ctx, mediaTypeMovie, req); err != nil {
+		return nil, err
+	}
+	movie, err := s.library.AddMovie(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+
+	out := toMovie(movie, rootPaths{root.ID: root.Path})
+	out.Images = posterImages(input.PosterURL, input.BackdropURL)
+	return &out, nil
+}
+
+func (s *Service) movieMetadata(ctx context.Context, tmdbID int) *metadata.MovieResult {
+	results, err := s.library.LookupForAdd(ctx, mediaTypeMovie, "", 0, tmdbID, 0)
+	if err != nil || len(results) == 0 {
+		if err != nil {
+			s.logger.Warn().Err(err).Int("tmdbId", tmdbID).Msg("Failed to look up movie metadata, using request fields")
+		}
+		return nil
+	}
+	meta, _ := results[0].Metadata.(*metadata.MovieResult)
+	return meta
+}
+
+// SeriesList lists library series, optionally only the one with tvdbID.
+func (s *Service) SeriesList(ctx context.Context, tvdbID int) ([]Series, error) {
+	roots, err := s.rootPaths(ctx, moduleTypeTV)
+	if err != nil {
+		return nil, err
+	}
+
+	if tvdbID > 0 {
+		series, err := s.tv.GetSeriesByTvdbID(ctx, tvdbID)
+		if errors.Is(err, tv.ErrSeriesNotFound) {
+			return []Series{}, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		return []Series{toSeries(series, roots)}, nil
+	}
+
+	list, err := s.tv.ListSeries(ctx, tv.ListSeriesOptions{})
+	if err != nil {
+		return nil, err
+	}
+	out := make([]Series, 0, len(list))
+	for _, series := range list {
+		out = append(out, toSeries(series, roots))
+	}
+	return out, nil
+}
+
+// Series returns a library series.
+func (s *Service) Series(ctx context.Context, id int64) (*Series, error) {
+	series, err := s.tv.GetSeries(ctx, id)
+	if errors.Is(err, tv.ErrSeriesNotFound) {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	roots, err := s.rootPaths(ctx, moduleTypeTV)
+	if err != nil {
+		return nil, err
+	}
+	out := toSeries(series, roots)
+	return &out, nil
+}
+
+// LookupSeries searches metadata for series. A "tvdb:<id>" term looks up
+// that series directly. Series already in the library carry their library ID.
+func (s *Service) LookupSeries(ctx context.Context, term string) ([]Series, error) {
+	query, tvdbID := parseLookupTerm(term, "tvdb")
+	if query == "" && tvdbID == 0 {
+		return []Series{}, nil
+	}
+
+	results, err := s.library.LookupForAdd(ctx, mediaTypeSeries, query, 0, 0, tvdbID)
+	if err != nil {
+		return nil, err
+	}
+	roots, err := s.rootPaths(ctx, moduleTypeTV)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]Series, 0, len(results))
+	for i := range results {
+		r := &results[i]
+		meta, ok := r.Metadata.(*metadata.SeriesResult)
+		if !ok {
+			continue
+		}
+		series := seriesFromMetadata(meta)
+		if r.InLibrary {
+			if existing, err := s.tv.GetSeries(ctx, r.LibraryID); err == nil {
+				images := series.Images
+				series = toSeries(existing, roots)
+				series.Images = images
+			}
+		}
+		out = append(out, series)
+	}
+	return out, nil
+}
+
+// AddSeries adds a series from a Sonarr POST /series body. Season
+// monitoring follows the body's seasons, and searchForMissingEpisodes
+// searches the monitored episodes once they exist.
+func (s *Service) AddSeries(ctx context.Context, in *Series) (*Series, error) {
+	if in.TvdbID <= 0 {
+		return nil, fmt.Errorf("%w: tvdbId is required", ErrInvalidRequest)
+	}
+	root, err := s.resolveRootFolder(ctx, moduleTypeTV, in.RootFolderPath, in.Path)
+	if err != nil {
+		return nil, err
+	}
+
+	input := &librarymanager.AddSeriesInput{
+		Title:            in.Title,
+		Year:             in.Year,
+		TvdbID:           in.TvdbID,
+		TmdbID:           in.TmdbID,
+		ImdbID:           in.ImdbID,
+		Overview:         in.Overview,
+		Runtime:          in.Runtime,
+		Network:          in.Network,
+		RootFolderID:     root.ID,
+		QualityProfileID: in.QualityProfileID,
+		Monitored:        in.Monitored,
+		SeasonFolder:     in.SeasonFolder,
+		Tags:             in.Tags,
+	}
+	if meta := s.seriesMetadata(ctx, in.TvdbID); meta != nil {
+		input.Title, input.Year, input.TmdbID, input.ImdbID = meta.Title, meta.Year, meta.TmdbID, meta.ImdbID
+		input.Overview, input.Runtime, input.Network = meta.Overview, meta.Runtime, meta.Network
+		input.ProductionStatus, input.NetworkLogoURL = meta.Status, meta.NetworkLogoURL
+		input.PosterURL, input.BackdropURL = meta.PosterURL, meta.BackdropURL
+	}
+	if input.Title == "" {
+		return nil, fmt.Errorf("%w: title is required", ErrInvalidRequest)
+	}
+	input.Path = in.Path
+	if input.Path == "" {
+		input.Path = tv.GenerateSeriesPath(root.Path, input.Title)
+	}
+	for _, season := range in.Seasons {
+		input.Seasons = append(input.Seasons, tv.SeasonInput{SeasonNumber: season.SeasonNumber, Monitored: season.Monitored})
+	}
+	noSearch := "no"
+	input.SearchOnAdd = &noSearch
+
+	req := &librarymanager.AddOptionsRequest{
+		Title: input.Title, Year: input.Year, TmdbID: input.TmdbID, TvdbID: input.TvdbID, Path: input.Path,
+		RootFolderID: input.RootFolderID, QualityProfileID: input.QualityProfileID,
+	}
+	if err := s.library.ValidateAdd(ctx, mediaTypeSeries, req); err != nil {
+		return nil, err
+	}
+	series, err := s.library.AddSeries(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+
+	// Episodes are created from metadata during the add, so season
+	// monitoring has to be applied afterwards to reach them
+	for _, season := range in.Seasons {
+		if season.Monitored {
+			continue
+		}
+		if _, err := s.tv.UpdateSeasonMonitored(ctx, series.ID, season.SeasonNumber, false); err != nil && !errors.Is(err, tv.ErrSeasonNotFound) {
+			s.logger.Warn().Err(err).Int64("seriesId", series.ID).Int("season", season.SeasonNumber).Msg("Failed to unmonitor season")
+		}
+	}
+	if in.AddOptions != nil && in.AddOptions.SearchForMissingEpisodes {
+		s.searchInBackground(CommandSeriesSearch, func(ctx context.Context) error {
+			_, err := s.autosearch.SearchSeries(ctx, series.ID, autosearch.SearchSourceRequest)
+			return err
+		})
+	}
+
+	if refreshed, err := s.tv.GetSeries(ctx, series.ID); err == nil {
+		series = refreshed
+	}
+	out := toSeries(series, rootPaths{root.ID: root.Path})
+	out.Images = posterImages(input.PosterURL, input.BackdropURL)
+	return &out, nil
+}
+
+func (s *Service) seriesMetadata(ctx context.Context, tvdbID int) *metadata.SeriesResult {
+	results, err := s.library.LookupForAdd(ctx, mediaTypeSeries, "", 0, 0, tvdbID)
+	if err != nil || len(results) == 0 {
+		if err != nil {
+			s.logger.Warn().Err(err).Int("tvdbId", tvdbID).Msg("Failed to look up series metadata, using request fields")
+		}
+		return nil
+	}
+	meta, _ := results[0].Metadata.(*metadata.SeriesResult)
+	return meta
+}
+
+// UpdateSeries applies the monitoring, quality profile and tags of a Sonarr
+// PUT /series body. Request tools use it to monitor additional seasons.
+func (s *Service) UpdateSeries(ctx context.Context, id int64, in *Series) (*Series, error) {
+	current, err := s.tv.GetSeries(ctx, id)
+	if errors.Is(err, tv.ErrSeriesNotFound) {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	monitored := make(map[int]bool, len(current.Seasons))
+	for _, season := range current.Seasons {
+		monitored[season.SeasonNumber] = season.Monitored
+	}
+	for _, season := range in.Seasons {
+		if was, ok := monitored[season.SeasonNumber]; !ok || was == season.Monitored {
+			continue
+		}
+		if _, err := s.tv.UpdateSeasonMonitored(ctx, id, season.SeasonNumber, season.Monitored); err != nil {
+			return nil, err
+		}
+	}
+
+	update := &tv.UpdateSeriesInput{}
+	changed := false
+	if in.Monitored != current.Monitored {
+		update.Monitored = &in.Monitored
+		changed = true
+	}
+	if in.QualityProfileID > 0 && in.QualityProfileID != current.QualityProfileID {
+		update.QualityProfileID = &in.QualityProfileID
+		changed = true
+	}
+	if in.Tags != nil {
+		update.Tags = &in.Tags
+		changed = true
+	}
+	if changed {
+		if _, err := s.tv.UpdateSeries(ctx, id, update); err != nil {
+			return nil, err
+		}
+	}
+
+	return s.Series(ctx, id)
+}
+
+// Queue returns a page of the download queue for the app's media type.
+func (s *Service) Queue(ctx context.Context, app App, page, pageSize int) (*Page[QueueRecord], error) {
+	page, pageSize = normalizePaging(page, pageSize)
+	queue, err := s.downloader.GetQueue(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	want := mediaTypeMovie
+	if app == AppSonarr {
+		want = mediaTypeSeries
+	}
+	now := time.Now()
+	records := []QueueRecord{}
+	for i := range queue.Items {
+		item := &queue.Items[i]
+		if item.MediaType != want {
+			continue
+		}
+		records = append(records, toQueueRecord(item, now))
+	}
+
+	total := len(records)
+	start := min((page-1)*pageSize, total)
+	end := min(start+pageSize, total)
+	return &Page[QueueRecord]{
+		Page:          page,
+		PageSize:      pageSize,
+		SortKey:       "timeleft",
+		SortDirection: "ascending",
+		TotalRecords:  int64(total),
+		Records:       records[start:end],
+	}, nil
+}
+
+// History returns a page of history for the app's media type.
+func (s *Service) History(ctx context.Context, app App, page, pageSize int) (*Page[HistoryRecord], error) {
+	page, pageSize = normalizePaging(page, pageSize)
+	mediaType := history.MediaTypeMovie
+	if app == AppSonarr {
+		mediaType = history.MediaTypeEpisode
+	}
+
+	resp, err := s.history.List(ctx, &history.ListOptions{
+		MediaType: string(mediaType),
+		Page:      page,
+		PageSize:  pageSize,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	records := make([]HistoryRecord, 0, len(resp.Items))
+	for _, e := range resp.Items {
+		records = append(records, toHistoryRecord(e))
+	}
+	return &Page[HistoryRecord]{
+		Page:          resp.Page,
+		PageSize:      resp.PageSize,
+		SortKey:       "date",
+		SortDirection: "descending",
+		TotalRecords:  resp.TotalCount,
+		Records:       records,
+	}, nil
+}
+
+func normalizePaging(page, pageSize int) (normalizedPage, normalizedSize int) {
+	if page < 1 {
+		page = 1
+	}
+	if pageSize < 1 {
+		pageSize = defaultPageSize
+	}
+	return page, min(pageSize, maxPageSize)
+}
+
+// RunCommand starts a search command in the background and returns it as
+// queued, the way v3 reports commands.
+func (s *Service) RunCommand(cmd *Command) (*Command, error) {
+	var run func(ctx context.Context) error
+	switch cmd.Name {
+	case CommandMoviesSearch:
+		if len(cmd.MovieIDs) == 0 {
+			return nil, fmt.Errorf("%w: movieIds is required", ErrInvalidRequest)
+		}
+		ids := cmd.MovieIDs
+		run = func(ctx context.Context) error {
+			var errs []error
+			for _, id := range ids {
+				if _, err := s.autosearch.SearchMovie(ctx, id, autosearch.SearchSourceRequest); err != nil {
+					errs = append(errs, err)
+				}
+			}
+			return errors.Join(errs...)
+		}
+	case CommandSeriesSearch:
+		if cmd.SeriesID <= 0 {
+			return nil, fmt.Errorf("%w: seriesId is required", ErrInvalidRequest)
+		}
+		seriesID := cmd.SeriesID
+		run = func(ctx context.Context) error {
+			_, err := s.autosearch.SearchSeries(ctx, seriesID, autosearch.SearchSourceRequest)
+			return err
+		}
+	case CommandSeasonSearch:
+		if cmd.SeriesID <= 0 || cmd.SeasonNumber == nil {
+			return nil, fmt.Errorf("%w: seriesId and seasonNumber are required", ErrInvalidRequest)
+		}
+		seriesID, season := cmd.SeriesID, *cmd.SeasonNumber
+		run = func(ctx context.Context) error {
+			_, err := s.autosearch.SearchSeason(ctx, seriesID, season, autosearch.SearchSourceRequest)
+			return err
+		}
+	case CommandEpisodeSearch:
+		if len(cmd.EpisodeIDs) == 0 {
+			return nil, fmt.Errorf("%w: episodeIds is required", ErrInvalidRequest)
+		}
+		ids := cmd.EpisodeIDs
+		run = func(ctx context.Context) error {
+			var errs []error
+			for _, id := range ids {
+				if _, err := s.autosearch.SearchEpisode(ctx, id, autosearch.SearchSourceRequest); err != nil {
+					errs = append(errs, err)
+				}
+			}
+			return errors.Join(errs...)
+		}
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrUnknownCommand, cmd.Name)
+	}
+
+	s.searchInBackground(cmd.Name, run)
+
+	queued := *cmd
+	queued.ID = s.commandID.Add(1)
+	queued.CommandName = cmd.Name
+	queued.Status = "queued"
+	return &queued, nil
+}
+
+func (s *Service) searchInBackground(name string, run func(ctx context.Context) error) {
+	go func() {
+		if err := run(context.Background()); err != nil {
+			s.logger.Warn().Err(err).Str("command", name).Msg("Search command failed")
+		}
+	}()
+}
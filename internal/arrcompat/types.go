@@ -0,0 +1,213 @@
+package arrcompat
+
+import "time"
+
+// SystemStatus is the v3 /system/status resource. Clients use it to detect
+// the app and test the connection.
+type SystemStatus struct {
+	AppName        string `json:"appName"`
+	InstanceName   string `json:"instanceName"`
+	Version        string `json:"version"`
+	Authentication string `json:"authentication"`
+	URLBase        string `json:"urlBase"`
+	IsProduction   bool   `json:"isProduction"`
+}
+
+// Image is a poster, fanart or banner reference.
+type Image struct {
+	CoverType string `json:"coverType"`
+	URL       string `json:"url,omitempty"`
+	RemoteURL string `json:"remoteUrl"`
+}
+
+// MovieAddOptions controls what happens after POST /movie.
+type MovieAddOptions struct {
+	SearchForMovie bool   `json:"searchForMovie"`
+	Monitor        string `json:"monitor,omitempty"`
+}
+
+// Movie is the Radarr v3 movie resource.
+type Movie struct {
+	ID                  int64            `json:"id,omitempty"`
+	Title               string           `json:"title"`
+	SortTitle           string           `json:"sortTitle,omitempty"`
+	Year                int              `json:"year"`
+	TmdbID              int              `json:"tmdbId"`
+	ImdbID              string           `json:"imdbId,omitempty"`
+	Overview            string           `json:"overview,omitempty"`
+	Runtime             int              `json:"runtime"`
+	Studio              string           `json:"studio,omitempty"`
+	Certification       string           `json:"certification,omitempty"`
+	Path                string           `json:"path,omitempty"`
+	RootFolderPath      string           `json:"rootFolderPath,omitempty"`
+	QualityProfileID    int64            `json:"qualityProfileId"`
+	Monitored           bool             `json:"monitored"`
+	MinimumAvailability string           `json:"minimumAvailability,omitempty"`
+	Status              string           `json:"status,omitempty"`
+	IsAvailable         bool             `json:"isAvailable"`
+	HasFile             bool             `json:"hasFile"`
+	SizeOnDisk          int64            `json:"sizeOnDisk"`
+	InCinemas           *time.Time       `json:"inCinemas,omitempty"`
+	PhysicalRelease     *time.Time       `json:"physicalRelease,omitempty"`
+	DigitalRelease      *time.Time       `json:"digitalRelease,omitempty"`
+	Added               *time.Time       `json:"added,omitempty"`
+	Images              []Image          `json:"images"`
+	Tags                []int64          `json:"tags"`
+	AddOptions          *MovieAddOptions `json:"addOptions,omitempty"`
+}
+
+// SeriesAddOptions controls what happens after POST /series.
+type SeriesAddOptions struct {
+	SearchForMissingEpisodes bool   `json:"searchForMissingEpisodes"`
+	Monitor                  string `json:"monitor,omitempty"`
+}
+
+// SeasonStatistics counts the episodes of a season or series.
+type SeasonStatistics struct {
+	EpisodeFileCount  int     `json:"episodeFileCount"`
+	EpisodeCount      int     `json:"episodeCount"`
+	TotalEpisodeCount int     `json:"totalEpisodeCount"`
+	SizeOnDisk        int64   `json:"sizeOnDisk"`
+	PercentOfEpisodes float64 `json:"percentOfEpisodes"`
+}
+
+// Season is a season within a Sonarr v3 series resource.
+type Season struct {
+	SeasonNumber int               `json:"seasonNumber"`
+	Monitored    bool              `json:"monitored"`
+	Statistics   *SeasonStatistics `json:"statistics,omitempty"`
+}
+
+// Series is the Sonarr v3 series resource.
+type Series struct {
+	ID                int64             `json:"id,omitempty"`
+	Title             string            `json:"title"`
+	SortTitle         string            `json:"sortTitle,omitempty"`
+	Year              int               `json:"year"`
+	TvdbID            int               `json:"tvdbId"`
+	TmdbID            int               `json:"tmdbId,omitempty"`
+	ImdbID            string            `json:"imdbId,omitempty"`
+	Overview          string            `json:"overview,omitempty"`
+	Runtime           int               `json:"runtime"`
+	Network           string            `json:"network,omitempty"`
+	Status            string            `json:"status,omitempty"`
+	SeriesType        string            `json:"seriesType"`
+	Path              string            `json:"path,omitempty"`
+	RootFolderPath    string            `json:"rootFolderPath,omitempty"`
+	QualityProfileID  int64             `json:"qualityProfileId"`
+	LanguageProfileID int64             `json:"languageProfileId"`
+	Monitored         bool              `json:"monitored"`
+	SeasonFolder      bool              `json:"seasonFolder"`
+	FirstAired        *time.Time        `json:"firstAired,omitempty"`
+	Added             *time.Time        `json:"added,omitempty"`
+	Images            []Image           `json:"images"`
+	Seasons           []Season          `json:"seasons"`
+	Statistics        *SeasonStatistics `json:"statistics,omitempty"`
+	Tags              []int64           `json:"tags"`
+	AddOptions        *SeriesAddOptions `json:"addOptions,omitempty"`
+}
+
+// QualityProfile is the v3 quality profile resource.
+type QualityProfile struct {
+	ID             int64  `json:"id"`
+	Name           string `json:"name"`
+	UpgradeAllowed bool   `json:"upgradeAllowed"`
+	Cutoff         int    `json:"cutoff"`
+}
+
+// LanguageProfile is the Sonarr v3 language profile resource.
+type LanguageProfile struct {
+	ID   int64  `json:"id"`
+	Name string `json:"name"`
+}
+
+// RootFolder is the v3 root folder resource.
+type RootFolder struct {
+	ID              int64    `json:"id"`
+	Path            string   `json:"path"`
+	Accessible      bool     `json:"accessible"`
+	FreeSpace       int64    `json:"freeSpace"`
+	TotalSpace      int64    `json:"totalSpace"`
+	UnmappedFolders []string `json:"unmappedFolders"`
+}
+
+// Tag is the v3 tag resource.
+type Tag struct {
+	ID    int64  `json:"id"`
+	Label string `json:"label"`
+}
+
+// Quality names the quality of a release.
+type Quality struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+}
+
+// QualityModel wraps a quality the way v3 resources nest it.
+type QualityModel struct {
+	Quality Quality `json:"quality"`
+}
+
+// QueueRecord is an item of the v3 download queue.
+type QueueRecord struct {
+	ID                      int64        `json:"id"`
+	MovieID                 *int64       `json:"movieId,omitempty"`
+	SeriesID                *int64       `json:"seriesId,omitempty"`
+	EpisodeID               *int64       `json:"episodeId,omitempty"`
+	SeasonNumber            *int         `json:"seasonNumber,omitempty"`
+	Title                   string       `json:"title"`
+	Quality                 QualityModel `json:"quality"`
+	Size                    float64      `json:"size"`
+	Sizeleft                float64      `json:"sizeleft"`
+	Timeleft                string       `json:"timeleft,omitempty"`
+	EstimatedCompletionTime *time.Time   `json:"estimatedCompletionTime,omitempty"`
+	Status                  string       `json:"status"`
+	TrackedDownloadStatus   string       `json:"trackedDownloadStatus"`
+	TrackedDownloadState    string       `json:"trackedDownloadState"`
+	DownloadID              string       `json:"downloadId"`
+	Protocol                string       `json:"protocol,omitempty"`
+	DownloadClient          string       `json:"downloadClient"`
+}
+
+// HistoryRecord is an entry of the v3 history.
+type HistoryRecord struct {
+	ID          int64             `json:"id"`
+	MovieID     int64             `json:"movieId,omitempty"`
+	SeriesID    int64             `json:"seriesId,omitempty"`
+	EpisodeID   int64             `json:"episodeId,omitempty"`
+	SourceTitle string            `json:"sourceTitle"`
+	Quality     QualityModel      `json:"quality"`
+	Date        string            `json:"date"`
+	EventType   string            `json:"eventType"`
+	DownloadID  string            `json:"downloadId,omitempty"`
+	Data        map[string]string `json:"data"`
+}
+
+// Page is the v3 paging envelope used by /queue and /history.
+type Page[T any] struct {
+	Page          int    `json:"page"`
+	PageSize      int    `json:"pageSize"`
+	SortKey       string `json:"sortKey,omitempty"`
+	SortDirection string `json:"sortDirection,omitempty"`
+	TotalRecords  int64  `json:"totalRecords"`
+	Records       []T    `json:"records"`
+}
+
+// Command is a v3 command request and its queued response.
+type Command struct {
+	ID           int64   `json:"id,omitempty"`
+	Name         string  `json:"name"`
+	CommandName  string  `json:"commandName,omitempty"`
+	Status       string  `json:"status,omitempty"`
+	MovieIDs     []int64 `json:"movieIds,omitempty"`
+	SeriesID     int64   `json:"seriesId,omitempty"`
+	SeasonNumber *int    `json:"seasonNumber,omitempty"`
+	EpisodeIDs   []int64 `json:"episodeIds,omitempty"`
+}
+
+// ValidationFailure is one entry of a v3 validation error response.
+type ValidationFailure struct {
+	PropertyName string `json:"propertyName"`
+	ErrorMessage string `json:"errorMessage"`
+	Severity     string `json:"severity"`
+}
@@ -316,9 +316,9 @@ func TestConfigImportEndToEnd(t *testing.T) {
 	if !profiles[0].UpgradesEnabled {
 		t.Error("quality profile should have upgrades enabled")
 	}
-	// Verify all 17 items present
-	if len(profiles[0].Items) != 17 {
-		t.Errorf("expected 17 quality items, got %d", len(profiles[0].Items))
+	// Verify the 17 imported items plus the CAM tier added on load
+	if len(profiles[0].Items) != 18 {
+		t.Errorf("expected 18 quality items, got %d", len(profiles[0].Items))
 	}
 
 	// Naming config (now stored in module_naming_settings)
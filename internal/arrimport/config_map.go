@@ -223,7 +223,7 @@ func walkProfileItems(items []sourceProfileItem, parentAllowed bool, result map[
 func buildFullQualityItems(allowedSSIDs map[int]bool) []quality.QualityItem {
 	items := make([]quality.QualityItem, 0, 17)
 	for id := 1; id <= 17; id++ {
-		q, ok := quality.GetQualityByID(id)
+		q, ok := quality.PredefinedQualityByID(id)
 		if !ok {
 			continue
 		}
@@ -0,0 +1,23 @@
+package arrimport
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/slipstream/slipstream/internal/database/sqlc"
+)
+
+const skipReasonExcluded = "excluded from the library when it was deleted"
+
+// isExcluded reports whether a movie (by TMDB ID) or series (by TVDB ID) was
+// deleted from the library with an exclusion, so imports must not add it back.
+func isExcluded(ctx context.Context, db *sql.DB, mediaType string, externalID int) bool {
+	if db == nil || externalID == 0 {
+		return false
+	}
+	_, err := sqlc.New(db).GetLibraryExclusion(ctx, sqlc.GetLibraryExclusionParams{
+		MediaType:  mediaType,
+		ExternalID: int64(externalID),
+	})
+	return err == nil
+}
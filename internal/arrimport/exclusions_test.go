@@ -0,0 +1,34 @@
+package arrimport
+
+import (
+	"context"
+	"testing"
+
+	"github.com/slipstream/slipstream/internal/database/sqlc"
+	"github.com/slipstream/slipstream/internal/testutil"
+)
+
+func TestIsExcluded(t *testing.T) {
+	tdb := testutil.NewTestDB(t)
+	defer tdb.Close()
+	ctx := context.Background()
+
+	if _, err := sqlc.New(tdb.Conn).CreateLibraryExclusion(ctx, sqlc.CreateLibraryExclusionParams{
+		MediaType:  "series",
+		ExternalID: 81189,
+		Title:      "Breaking Bad",
+		Year:       2008,
+	}); err != nil {
+		t.Fatalf("CreateLibraryExclusion error = %v", err)
+	}
+
+	if !isExcluded(ctx, tdb.Conn, "series", 81189) {
+		t.Error("excluded series should be skipped")
+	}
+	if isExcluded(ctx, tdb.Conn, "movie", 81189) {
+		t.Error("exclusions must not match across media types")
+	}
+	if isExcluded(ctx, tdb.Conn, "series", 0) {
+		t.Error("items without an external ID are never excluded")
+	}
+}
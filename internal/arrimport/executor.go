@@ -96,6 +96,11 @@ func (e *Executor) importMovies(ctx context.Context, mappings ImportMappings, re
 		}
 		e.progressManager.UpdateActivity("arrimport", fmt.Sprintf("Importing: %s", sourceMovies[i].Title), pct)
 
+		if isExcluded(ctx, e.db, "movie", sourceMovies[i].TmdbID) {
+			report.MoviesSkipped++
+			continue
+		}
+
 		entity, err := adapter.ImportMovie(ctx, sourceMovies[i], moduleMappings)
 		if err != nil {
 			report.MoviesErrored++
@@ -138,6 +143,11 @@ func (e *Executor) importAllSeries(ctx context.Context, mappings ImportMappings,
 		}
 		e.progressManager.UpdateActivity("arrimport", fmt.Sprintf("Importing: %s", seriesList[i].Title), pct)
 
+		if isExcluded(ctx, e.db, "series", seriesList[i].TvdbID) {
+			report.SeriesSkipped++
+			continue
+		}
+
 		entity, err := adapter.ImportSeries(ctx, seriesList[i], adapted, moduleMappings)
 		if err != nil {
 			report.SeriesErrored++
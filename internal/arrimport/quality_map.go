@@ -94,7 +94,7 @@ func MapQualityID(sourceType SourceType, sourceQualityID int, sourceQualityName
 		return result
 	}
 	// Fallback: try matching by name
-	if q, ok := quality.GetQualityByName(sourceQualityName); ok {
+	if q, ok := quality.PredefinedQualityByName(sourceQualityName); ok {
 		id := int64(q.ID)
 		return &id
 	}
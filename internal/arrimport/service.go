@@ -222,6 +222,9 @@ func (s *Service) previewMovies(ctx context.Context, reader module.ArrReader, pr
 		return fmt.Errorf("failed to preview movies: %w", err)
 	}
 	for i := range items {
+		if items[i].Status == previewStatusNew && isExcluded(ctx, s.db, "movie", items[i].TmdbID) {
+			items[i].Status, items[i].SkipReason = previewStatusSkip, skipReasonExcluded
+		}
 		preview.Movies = append(preview.Movies, MoviePreview{
 			Title:            items[i].Title,
 			Year:             items[i].Year,
@@ -260,6 +263,9 @@ func (s *Service) previewSeries(ctx context.Context, reader module.ArrReader, pr
 		return fmt.Errorf("failed to preview series: %w", err)
 	}
 	for i := range items {
+		if items[i].Status == previewStatusNew && isExcluded(ctx, s.db, "series", items[i].TvdbID) {
+			items[i].Status, items[i].SkipReason = previewStatusSkip, skipReasonExcluded
+		}
 		preview.Series = append(preview.Series, SeriesPreview{
 			Title:            items[i].Title,
 			Year:             items[i].Year,
@@ -100,6 +100,22 @@ func (s *Service) SetDB(queries *sqlc.Queries) error {
 	return nil
 }
 
+// SetJWTSecret replaces the portal token signing secret, falling back to
+// the generated one stored in the database when secret is empty. Tokens
+// signed with the previous secret stop validating.
+func (s *Service) SetJWTSecret(secret string) error {
+	if secret != "" {
+		s.jwtSecret = []byte(secret)
+		return nil
+	}
+	stored, err := loadOrGenerateSecret(s.queries)
+	if err != nil {
+		return fmt.Errorf("auth: failed to load JWT secret: %w", err)
+	}
+	s.jwtSecret = stored
+	return nil
+}
+
 func (s *Service) GeneratePortalToken(user *sqlc.PortalUser) (string, error) {
 	claims := &portal.Claims{
 		UserID:   user.ID,
@@ -0,0 +1,393 @@
+package autosearch
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/slipstream/slipstream/internal/database/sqlc"
+	"github.com/slipstream/slipstream/internal/module"
+)
+
+// BatchTask identifies a paced library-wide search.
+type BatchTask string
+
+const (
+	BatchTaskMissing     BatchTask = "missing"
+	BatchTaskCutoffUnmet BatchTask = "cutoff-unmet"
+)
+
+// BatchStatus describes the state reported in batch progress events.
+type BatchStatus string
+
+const (
+	BatchStatusRunning   BatchStatus = "running"
+	BatchStatusPaused    BatchStatus = "paused"  // waiting between batches
+	BatchStatusBackoff   BatchStatus = "backoff" // every indexer is temporarily disabled
+	BatchStatusCompleted BatchStatus = "completed"
+	BatchStatusStopped   BatchStatus = "stopped" // cancelled; the next run resumes from the cursor
+)
+
+// maxBackoffWait bounds how long a batch search waits for indexers to leave backoff.
+// Longer backoffs end the run; the next run resumes from the saved cursor.
+const maxBackoffWait = 30 * time.Minute
+
+const batchCursorKeyPrefix = "autosearch_batch_cursor_"
+
+// batchKey orders searchable items so a pass over the library is deterministic
+// and can resume after a restart even if items were added or removed meanwhile.
+type batchKey struct {
+	MediaType    string `json:"mediaType"`
+	EntityID     int64  `json:"entityId"`
+	SeasonNumber int    `json:"seasonNumber"`
+}
+
+func keyForItem(item SearchableItem) batchKey {
+	return batchKey{
+		MediaType:    item.GetMediaType(),
+		EntityID:     item.GetEntityID(),
+		SeasonNumber: module.ItemSeasonNumber(item),
+	}
+}
+
+func (k batchKey) less(o batchKey) bool {
+	if k.MediaType != o.MediaType {
+		return k.MediaType < o.MediaType
+	}
+	if k.EntityID != o.EntityID {
+		return k.EntityID < o.EntityID
+	}
+	return k.SeasonNumber < o.SeasonNumber
+}
+
+// batchCursor is the persisted position of an in-progress pass.
+type batchCursor struct {
+	Last      *batchKey `json:"last,omitempty"`
+	Processed int       `json:"processed"`
+}
+
+// RunMissingBatch searches every missing monitored item in paced batches.
+func (s *ScheduledSearcher) RunMissingBatch(ctx context.Context) error {
+	return s.runBatch(ctx, BatchTaskMissing)
+}
+
+// RunCutoffUnmetBatch searches every item below its quality cutoff in paced batches.
+// It only searches inside the upgrade window and, when upgrade tags are set,
+// only items sharing one of them; a pass cut short by the window resumes on
+// the next run.
+func (s *ScheduledSearcher) RunCutoffUnmetBatch(ctx context.Context) error {
+	return s.runBatch(ctx, BatchTaskCutoffUnmet)
+}
+
+func (s *ScheduledSearcher) runBatch(ctx context.Context, task BatchTask) error {
+	if !s.config.Enabled {
+		s.logger.Debug().Str("task", string(task)).Msg("Automatic search disabled, skipping batch search")
+		return nil
+	}
+	if !s.batchRunning.CompareAndSwap(false, true) {
+		s.logger.Debug().Str("task", string(task)).Msg("Batch search already running, skipping")
+		return nil
+	}
+	defer s.batchRunning.Store(false)
+
+	if s.outsideTaskWindow(task) {
+		s.logger.Info().Str("task", string(task)).Msg("Outside the upgrade window, skipping batch search")
+		return nil
+	}
+
+	items, err := s.collectBatchItems(ctx, task)
+	if err != nil {
+		return fmt.Errorf("failed to collect items for %s search: %w", task, err)
+	}
+	cursor, err := s.loadBatchCursor(ctx, task)
+	if err != nil {
+		return err
+	}
+	items = itemsAfterCursor(items, cursor.Last)
+
+	progress := &AutoSearchBatchProgressPayload{
+		Task:      task,
+		Status:    BatchStatusRunning,
+		Processed: cursor.Processed,
+		Total:     cursor.Processed + len(items),
+	}
+	s.logger.Info().
+		Str("task", string(task)).
+		Int("remaining", len(items)).
+		Int("alreadyProcessed", cursor.Processed).
+		Msg("Starting batch search")
+
+	completed := s.processBatches(ctx, task, items, &cursor, progress)
+
+	switch {
+	case completed:
+		s.clearBatchCursor(ctx, task)
+		progress.Status = BatchStatusCompleted
+	case progress.Status != BatchStatusBackoff:
+		progress.Status = BatchStatusStopped
+	}
+	progress.CurrentTitle = ""
+	s.broadcastBatchProgress(progress)
+
+	s.logger.Info().
+		Str("task", string(task)).
+		Str("status", string(progress.Status)).
+		Int("processed", progress.Processed).
+		Int("total", progress.Total).
+		Int("found", progress.Found).
+		Int("downloaded", progress.Downloaded).
+		Int("failed", progress.Failed).
+		Msg("Batch search finished")
+	return nil
+}
+
+// processBatches searches items in batches and reports whether the pass completed.
+func (s *ScheduledSearcher) processBatches(ctx context.Context, task BatchTask, items []SearchableItem, cursor *batchCursor, progress *AutoSearchBatchProgressPayload) bool {
+	batchSize := max(s.config.BatchSize, 1)
+	for start := 0; start < len(items); start += batchSize {
+		if start > 0 && !s.pauseBetweenBatches(ctx, progress) {
+			return false
+		}
+		if !s.waitForIndexers(ctx, progress) {
+			return false
+		}
+		progress.Batch++
+		end := min(start+batchSize, len(items))
+		if !s.processBatch(ctx, task, items[start:end], cursor, progress) {
+			return false
+		}
+	}
+	return true
+}
+
+func (s *ScheduledSearcher) processBatch(ctx context.Context, task BatchTask, batch []SearchableItem, cursor *batchCursor, progress *AutoSearchBatchProgressPayload) bool {
+	for i, item := range batch {
+		if ctx.Err() != nil || s.grabsPaused(ctx) || s.outsideTaskWindow(task) {
+			return false
+		}
+		if delay := s.rateLimiter.GetDelay(); delay > 0 && i > 0 {
+			if !sleepContext(ctx, delay) {
+				return false
+			}
+		}
+
+		progress.Status = BatchStatusRunning
+		progress.CurrentTitle = item.GetTitle()
+		s.broadcastBatchProgress(progress)
+
+		var outcome BatchSearchResult
+		s.searchAndRecord(ctx, item, &outcome)
+		progress.Processed++
+		progress.Found += outcome.Found
+		progress.Downloaded += outcome.Downloaded
+		progress.Failed += outcome.Failed
+
+		key := keyForItem(item)
+		cursor.Last = &key
+		cursor.Processed = progress.Processed
+		s.saveBatchCursor(ctx, task, cursor)
+	}
+	return true
+}
+
+func (s *ScheduledSearcher) pauseBetweenBatches(ctx context.Context, progress *AutoSearchBatchProgressPayload) bool {
+	pause := s.config.BatchPauseDuration()
+	if pause <= 0 {
+		return true
+	}
+	resumeAt := time.Now().Add(pause)
+	progress.Status = BatchStatusPaused
+	progress.CurrentTitle = ""
+	progress.ResumeAt = &resumeAt
+	s.broadcastBatchProgress(progress)
+
+	ok := sleepContext(ctx, pause)
+	progress.ResumeAt = nil
+	return ok
+}
+
+// waitForIndexers waits while every enabled indexer is in failure backoff.
+// Returns false when the backoff outlasts maxBackoffWait or the context ends.
+func (s *ScheduledSearcher) waitForIndexers(ctx context.Context, progress *AutoSearchBatchProgressPayload) bool {
+	inBackoff, until := s.indexersInBackoff(ctx)
+	if !inBackoff {
+		return true
+	}
+
+	progress.Status = BatchStatusBackoff
+	progress.CurrentTitle = ""
+	progress.ResumeAt = &until
+	s.broadcastBatchProgress(progress)
+
+	wait := time.Until(until)
+	if wait > maxBackoffWait {
+		s.logger.Info().Time("disabledTill", until).Msg("All indexers in backoff, stopping batch search until next run")
+		return false
+	}
+	s.logger.Info().Dur("wait", wait).Msg("All indexers in backoff, pausing batch search")
+	if !sleepContext(ctx, wait) {
+		return false
+	}
+	progress.ResumeAt = nil
+	return true
+}
+
+// indexersInBackoff reports whether every enabled indexer is temporarily disabled,
+// along with the earliest time one becomes available again.
+func (s *ScheduledSearcher) indexersInBackoff(ctx context.Context) (inBackoff bool, until time.Time) {
+	enabled, err := s.service.queries.ListEnabledIndexers(ctx)
+	if err != nil || len(enabled) == 0 {
+		return false, time.Time{}
+	}
+	disabled, err := s.service.queries.ListDisabledIndexers(ctx)
+	if err != nil {
+		return false, time.Time{}
+	}
+
+	disabledTill := make(map[int64]time.Time, len(disabled))
+	for _, row := range disabled {
+		disabledTill[row.ID] = row.DisabledTill.Time
+	}
+	for _, idx := range enabled {
+		till, ok := disabledTill[idx.ID]
+		if !ok {
+			return false, time.Time{}
+		}
+		if until.IsZero() || till.Before(until) {
+			until = till
+		}
+	}
+	return true, until
+}
+
+func (s *ScheduledSearcher) collectBatchItems(ctx context.Context, task BatchTask) ([]SearchableItem, error) {
+	collectMovies, collectEpisodes := s.collectMissingMovies, s.collectMissingEpisodes
+	if task == BatchTaskCutoffUnmet {
+		collectMovies, collectEpisodes = s.collectUpgradeMovies, s.collectUpgradeEpisodes
+	}
+
+	movies, err := collectMovies(ctx)
+	if err != nil {
+		return nil, err
+	}
+	episodes, err := collectEpisodes(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]SearchableItem, 0, len(movies)+len(episodes))
+	for i := range movies {
+		items = append(items, movies[i].item)
+	}
+	for i := range episodes {
+		items = append(items, episodes[i].item)
+	}
+
+	if task == BatchTaskCutoffUnmet {
+		if items, err = s.itemsWithUpgradeTags(ctx, items); err != nil {
+			return nil, err
+		}
+	}
+
+	if s.config.BatchSmartListID == 0 || s.smartLists == nil {
+		return items, nil
+	}
+	mediaType, ids, err := s.smartLists.MemberIDs(ctx, s.config.BatchSmartListID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve smart list %d: %w", s.config.BatchSmartListID, err)
+	}
+	return itemsInSmartList(items, mediaType, ids), nil
+}
+
+// itemsInSmartList keeps movies that are list members, or episodes and seasons
+// whose series is a member, depending on the list's media type.
+func itemsInSmartList(items []SearchableItem, mediaType string, ids []int64) []SearchableItem {
+	members := make(map[int64]bool, len(ids))
+	for _, id := range ids {
+		members[id] = true
+	}
+
+	filtered := make([]SearchableItem, 0, len(items))
+	for _, item := range items {
+		isMovie := item.GetMediaType() == string(MediaTypeMovie)
+		switch {
+		case mediaType == "movie" && isMovie && members[item.GetEntityID()]:
+			filtered = append(filtered, item)
+		case mediaType == "series" && !isMovie && members[module.ItemSeriesID(item)]:
+			filtered = append(filtered, item)
+		}
+	}
+	return filtered
+}
+
+// itemsAfterCursor sorts items in pass order and drops those at or before the cursor.
+func itemsAfterCursor(items []SearchableItem, last *batchKey) []SearchableItem {
+	sort.Slice(items, func(i, j int) bool {
+		return keyForItem(items[i]).less(keyForItem(items[j]))
+	})
+	if last == nil {
+		return items
+	}
+	start := sort.Search(len(items), func(i int) bool {
+		return last.less(keyForItem(items[i]))
+	})
+	return items[start:]
+}
+
+func (s *ScheduledSearcher) loadBatchCursor(ctx context.Context, task BatchTask) (batchCursor, error) {
+	var cursor batchCursor
+	row, err := s.service.queries.GetSetting(ctx, batchCursorKeyPrefix+string(task))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return cursor, nil
+		}
+		return cursor, fmt.Errorf("failed to load batch cursor: %w", err)
+	}
+	if err := json.Unmarshal([]byte(row.Value), &cursor); err != nil {
+		return cursor, fmt.Errorf("failed to unmarshal batch cursor: %w", err)
+	}
+	return cursor, nil
+}
+
+func (s *ScheduledSearcher) saveBatchCursor(ctx context.Context, task BatchTask, cursor *batchCursor) {
+	data, err := json.Marshal(cursor)
+	if err != nil {
+		s.logger.Warn().Err(err).Str("task", string(task)).Msg("Failed to marshal batch cursor")
+		return
+	}
+	if _, err := s.service.queries.SetSetting(ctx, sqlc.SetSettingParams{
+		Key:   batchCursorKeyPrefix + string(task),
+		Value: string(data),
+	}); err != nil {
+		s.logger.Warn().Err(err).Str("task", string(task)).Msg("Failed to save batch cursor")
+	}
+}
+
+func (s *ScheduledSearcher) clearBatchCursor(ctx context.Context, task BatchTask) {
+	if err := s.service.queries.DeleteSetting(ctx, batchCursorKeyPrefix+string(task)); err != nil {
+		s.logger.Warn().Err(err).Str("task", string(task)).Msg("Failed to clear batch cursor")
+	}
+}
+
+func (s *ScheduledSearcher) broadcastBatchProgress(progress *AutoSearchBatchProgressPayload) {
+	if s.service.broadcaster == nil {
+		return
+	}
+	s.service.broadcaster.Broadcast(EventAutoSearchBatchProgress, *progress)
+}
+
+// sleepContext waits for d or until ctx is done. Returns false if ctx ended first.
+func sleepContext(ctx context.Context, d time.Duration) bool {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return false
+	case <-timer.C:
+		return true
+	}
+}
@@ -0,0 +1,105 @@
+package autosearch
+
+import (
+	"context"
+	"testing"
+
+	"github.com/slipstream/slipstream/internal/module"
+)
+
+func TestItemsAfterCursor(t *testing.T) {
+	items := func() []SearchableItem {
+		return []SearchableItem{
+			testItem("movie", 7),
+			testItem("episode", 3),
+			testItem("movie", 2),
+			testItem("episode", 9),
+		}
+	}
+
+	got := itemsAfterCursor(items(), nil)
+	want := []batchKey{{MediaType: "episode", EntityID: 3}, {MediaType: "episode", EntityID: 9}, {MediaType: "movie", EntityID: 2}, {MediaType: "movie", EntityID: 7}}
+	if len(got) != len(want) {
+		t.Fatalf("itemsAfterCursor() returned %d items, want %d", len(got), len(want))
+	}
+	for i := range want {
+		if k := keyForItem(got[i]); k != want[i] {
+			t.Errorf("item %d = %+v, want %+v", i, k, want[i])
+		}
+	}
+
+	// The cursor item may have left the library since the last run
+	got = itemsAfterCursor(items(), &batchKey{MediaType: "episode", EntityID: 5})
+	if len(got) != 3 || keyForItem(got[0]) != want[1] {
+		t.Errorf("itemsAfterCursor() after episode 5 = %d items starting at %+v", len(got), keyForItem(got[0]))
+	}
+
+	got = itemsAfterCursor(items(), &batchKey{MediaType: "movie", EntityID: 7})
+	if len(got) != 0 {
+		t.Errorf("itemsAfterCursor() after last item = %d items, want 0", len(got))
+	}
+}
+
+func TestBatchCursorPersistence(t *testing.T) {
+	searcher, _ := newTestSearcher(t)
+	ctx := context.Background()
+
+	cursor, err := searcher.loadBatchCursor(ctx, BatchTaskMissing)
+	if err != nil {
+		t.Fatalf("loadBatchCursor() error = %v", err)
+	}
+	if cursor.Last != nil || cursor.Processed != 0 {
+		t.Fatalf("loadBatchCursor() without saved cursor = %+v, want empty", cursor)
+	}
+
+	saved := batchCursor{Last: &batchKey{MediaType: "movie", EntityID: 42}, Processed: 10}
+	searcher.saveBatchCursor(ctx, BatchTaskMissing, &saved)
+
+	cursor, err = searcher.loadBatchCursor(ctx, BatchTaskMissing)
+	if err != nil {
+		t.Fatalf("loadBatchCursor() error = %v", err)
+	}
+	if cursor.Last == nil || *cursor.Last != *saved.Last || cursor.Processed != 10 {
+		t.Errorf("loadBatchCursor() = %+v, want %+v", cursor, saved)
+	}
+
+	other, err := searcher.loadBatchCursor(ctx, BatchTaskCutoffUnmet)
+	if err != nil {
+		t.Fatalf("loadBatchCursor() error = %v", err)
+	}
+	if other.Last != nil {
+		t.Errorf("cutoff-unmet cursor = %+v, want empty", other)
+	}
+
+	searcher.clearBatchCursor(ctx, BatchTaskMissing)
+	cursor, err = searcher.loadBatchCursor(ctx, BatchTaskMissing)
+	if err != nil {
+		t.Fatalf("loadBatchCursor() error = %v", err)
+	}
+	if cursor.Last != nil {
+		t.Errorf("loadBatchCursor() after clear = %+v, want empty", cursor)
+	}
+}
+
+func TestItemsInSmartList(t *testing.T) {
+	episode := func(id, seriesID int64) SearchableItem {
+		return module.NewWantedItem(module.TypeTV, "episode", id, "", nil, 0, nil,
+			module.SearchParams{Extra: map[string]any{"seriesId": seriesID}})
+	}
+	items := []SearchableItem{
+		testItem("movie", 1),
+		testItem("movie", 2),
+		episode(10, 100),
+		episode(11, 200),
+	}
+
+	movies := itemsInSmartList(items, "movie", []int64{2})
+	if len(movies) != 1 || movies[0].GetEntityID() != 2 {
+		t.Errorf("movie list kept %d items, want movie 2", len(movies))
+	}
+
+	series := itemsInSmartList(items, "series", []int64{200})
+	if len(series) != 1 || series[0].GetEntityID() != 11 {
+		t.Errorf("series list kept %d items, want episode 11", len(series))
+	}
+}
@@ -10,13 +10,14 @@ import (
 // convertModuleCriteria converts module.SearchCriteria to indexer types.SearchCriteria.
 func convertModuleCriteria(mc *module.SearchCriteria) types.SearchCriteria {
 	ic := types.SearchCriteria{
-		ModuleType: string(mc.ModuleType),
-		Query:      mc.Query,
-		Type:       mc.SearchType,
-		Categories: mc.Categories,
-		Year:       mc.Year,
-		Season:     mc.Season,
-		Episode:    mc.Episode,
+		ModuleType:      string(mc.ModuleType),
+		Query:           mc.Query,
+		Type:            mc.SearchType,
+		Categories:      mc.Categories,
+		Year:            mc.Year,
+		Season:          mc.Season,
+		Episode:         mc.Episode,
+		AbsoluteEpisode: mc.AbsoluteEpisode,
 	}
 	if v, ok := mc.ExternalIDs["imdbId"]; ok {
 		ic.ImdbID = v
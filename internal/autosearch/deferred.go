@@ -0,0 +1,79 @@
+package autosearch
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// deferredGrabs holds items whose automatic grab was deferred by a grab cap,
+// keyed by media type and ID so each item is queued once.
+type deferredGrabs struct {
+	mu    sync.Mutex
+	items map[string]SearchableItem
+}
+
+func newDeferredGrabs() *deferredGrabs {
+	return &deferredGrabs{items: make(map[string]SearchableItem)}
+}
+
+// DeferGrab queues an item to be searched again once grab caps allow it.
+// RSS sync uses this so capped feed matches are not lost.
+func (s *Service) DeferGrab(item SearchableItem) {
+	key := fmt.Sprintf("%s:%d", item.GetMediaType(), item.GetEntityID())
+
+	s.deferred.mu.Lock()
+	defer s.deferred.mu.Unlock()
+	if _, exists := s.deferred.items[key]; !exists {
+		s.logger.Info().Str("key", key).Str("title", item.GetTitle()).Msg("Queued deferred grab")
+	}
+	s.deferred.items[key] = item
+}
+
+// DeferredCount returns the number of items waiting on a grab cap.
+func (s *Service) DeferredCount() int {
+	s.deferred.mu.Lock()
+	defer s.deferred.mu.Unlock()
+	return len(s.deferred.items)
+}
+
+// takeDeferred removes and returns every queued item.
+func (s *Service) takeDeferred() []SearchableItem {
+	s.deferred.mu.Lock()
+	defer s.deferred.mu.Unlock()
+
+	items := make([]SearchableItem, 0, len(s.deferred.items))
+	for _, item := range s.deferred.items {
+		items = append(items, item)
+	}
+	s.deferred.items = make(map[string]SearchableItem)
+	return items
+}
+
+// RunDeferred searches again for items whose grabs were deferred by a grab
+// cap. Nothing runs while the global cap still blocks automatic grabs; items
+// deferred again are re-queued by the search itself.
+func (s *ScheduledSearcher) RunDeferred(ctx context.Context) error {
+	if s.service.DeferredCount() == 0 {
+		return nil
+	}
+	if blocked, until := s.service.grabService.AutoGrabsBlocked(); blocked {
+		s.logger.Debug().Time("until", until).Msg("Grab cap still reached, keeping deferred grabs queued")
+		return nil
+	}
+
+	items := s.service.takeDeferred()
+	s.logger.Info().Int("itemCount", len(items)).Msg("Retrying deferred grabs")
+
+	startTime := time.Now()
+	result := s.processItems(ctx, items)
+
+	s.logger.Info().
+		Int("searched", result.TotalSearched).
+		Int("downloaded", result.Downloaded).
+		Int("stillDeferred", s.service.DeferredCount()).
+		Dur("elapsed", time.Since(startTime)).
+		Msg("Deferred grab retry completed")
+	return nil
+}
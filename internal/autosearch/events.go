@@ -1,5 +1,7 @@
 package autosearch
 
+import "time"
+
 // WebSocket event types for automatic search operations.
 const (
 	// Per-item search events
@@ -11,6 +13,12 @@ const (
 	EventAutoSearchTaskStarted   = "autosearch:task:started"
 	EventAutoSearchTaskProgress  = "autosearch:task:progress"
 	EventAutoSearchTaskCompleted = "autosearch:task:completed"
+
+	// Paced library-wide search events
+	EventAutoSearchBatchProgress = "autosearch:batch:progress"
+
+	// Series and season search events
+	EventAutoSearchSeriesProgress = "autosearch:series:progress"
 )
 
 // AutoSearchStartedPayload is sent when an automatic search begins.
@@ -61,3 +69,33 @@ type AutoSearchTaskCompletedPayload struct {
 	Failed        int   `json:"failed"`
 	ElapsedMs     int64 `json:"elapsedMs"`
 }
+
+// AutoSearchBatchProgressPayload reports the state of a paced missing or cutoff-unmet search.
+type AutoSearchBatchProgressPayload struct {
+	Task         BatchTask   `json:"task"`
+	Status       BatchStatus `json:"status"`
+	Processed    int         `json:"processed"`
+	Total        int         `json:"total"`
+	Batch        int         `json:"batch"`
+	Found        int         `json:"found"`
+	Downloaded   int         `json:"downloaded"`
+	Failed       int         `json:"failed"`
+	CurrentTitle string      `json:"currentTitle,omitempty"`
+	ResumeAt     *time.Time  `json:"resumeAt,omitempty"`
+}
+
+// AutoSearchSeriesProgressPayload reports the progress of a series search, or
+// of a season search when SeasonNumber is set. It is sent before each item is
+// searched and once more when the search completes or is cancelled.
+type AutoSearchSeriesProgressPayload struct {
+	SeriesID     int64              `json:"seriesId"`
+	SeasonNumber *int               `json:"seasonNumber,omitempty"`
+	Title        string             `json:"title"`
+	Status       SeriesSearchStatus `json:"status"`
+	Searched     int                `json:"searched"`
+	Total        int                `json:"total"`
+	Found        int                `json:"found"`
+	Downloaded   int                `json:"downloaded"`
+	Failed       int                `json:"failed"`
+	CurrentTitle string             `json:"currentTitle,omitempty"`
+}
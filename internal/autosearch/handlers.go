@@ -34,6 +34,13 @@ func (h *Handlers) RegisterRoutes(g *echo.Group) {
 	g.POST("/season/:seriesId/:seasonNumber", h.SearchSeason)
 	g.POST("/series/:id", h.SearchSeries)
 	g.GET("/status/:mediaType/:id", h.GetStatus)
+	g.POST("/cancel/:mediaType/:id", h.CancelSearch)
+
+	// Interactive search endpoints (return all releases, grab by GUID)
+	g.GET("/interactive/movie/:id", h.InteractiveSearchMovie)
+	g.GET("/interactive/episode/:id", h.InteractiveSearchEpisode)
+	g.GET("/interactive/season/:seriesId/:seasonNumber", h.InteractiveSearchSeason)
+	g.POST("/interactive/grab", h.GrabInteractive)
 
 	// Retry endpoints (reset failed → missing/upgradable)
 	g.POST("/retry/movie/:id", h.RetryMovie)
@@ -192,6 +199,99 @@ func (h *Handlers) SearchSeries(c echo.Context) error {
 	return c.JSON(http.StatusOK, result)
 }
 
+// InteractiveSearchMovie returns every scored release for a movie with rejection reasons.
+// GET /api/v1/autosearch/interactive/movie/:id
+func (h *Handlers) InteractiveSearchMovie(c echo.Context) error {
+	id, err := parseIDParam(c, "id")
+	if err != nil {
+		return err
+	}
+
+	result, err := h.service.InteractiveSearchMovie(c.Request().Context(), id)
+	if err != nil {
+		if errors.Is(err, ErrItemNotFound) {
+			return echo.NewHTTPError(http.StatusNotFound, "movie not found")
+		}
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, result)
+}
+
+// InteractiveSearchEpisode returns every scored release for an episode with rejection reasons.
+// GET /api/v1/autosearch/interactive/episode/:id
+func (h *Handlers) InteractiveSearchEpisode(c echo.Context) error {
+	id, err := parseIDParam(c, "id")
+	if err != nil {
+		return err
+	}
+
+	result, err := h.service.InteractiveSearchEpisode(c.Request().Context(), id)
+	if err != nil {
+		if errors.Is(err, ErrItemNotFound) {
+			return echo.NewHTTPError(http.StatusNotFound, "episode not found")
+		}
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, result)
+}
+
+// InteractiveSearchSeason returns every scored season pack release with rejection reasons.
+// GET /api/v1/autosearch/interactive/season/:seriesId/:seasonNumber
+func (h *Handlers) InteractiveSearchSeason(c echo.Context) error {
+	seriesID, err := parseIDParam(c, "seriesId")
+	if err != nil {
+		return err
+	}
+
+	seasonNumber, err := strconv.Atoi(c.Param("seasonNumber"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid season number")
+	}
+
+	result, err := h.service.InteractiveSearchSeason(c.Request().Context(), seriesID, seasonNumber)
+	if err != nil {
+		if errors.Is(err, ErrItemNotFound) {
+			return echo.NewHTTPError(http.StatusNotFound, "series not found")
+		}
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, result)
+}
+
+// InteractiveGrabRequest identifies a release from a recent interactive search.
+type InteractiveGrabRequest struct {
+	GUID     string `json:"guid"`
+	ClientID int64  `json:"clientId,omitempty"`
+}
+
+// GrabInteractive grabs a release chosen from interactive search results.
+// POST /api/v1/autosearch/interactive/grab
+func (h *Handlers) GrabInteractive(c echo.Context) error {
+	var req InteractiveGrabRequest
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid request body")
+	}
+	if req.GUID == "" {
+		return echo.NewHTTPError(http.StatusBadRequest, "guid is required")
+	}
+
+	result, err := h.service.GrabInteractiveRelease(c.Request().Context(), req.GUID, req.ClientID)
+	if err != nil {
+		if errors.Is(err, ErrReleaseNotFound) {
+			return echo.NewHTTPError(http.StatusNotFound, err.Error())
+		}
+		if errors.Is(err, ErrAlreadyInQueue) {
+			return echo.NewHTTPError(http.StatusConflict, "a grab is already in progress for this item")
+		}
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, result)
+}
+
 // GetStatus returns the current search status for an item.
 // GET /api/v1/autosearch/status/:mediaType/:id
 func (h *Handlers) GetStatus(c echo.Context) error {
@@ -221,6 +321,38 @@ func (h *Handlers) GetStatus(c echo.Context) error {
 	return c.JSON(http.StatusOK, status)
 }
 
+// CancelSearch cancels a running search. Series and season searches stop
+// after cancelling the item they are searching; a season search is selected
+// with the seasonNumber query parameter and the series ID.
+// POST /api/v1/autosearch/cancel/:mediaType/:id
+func (h *Handlers) CancelSearch(c echo.Context) error {
+	id, err := parseIDParam(c, "id")
+	if err != nil {
+		return err
+	}
+
+	var cancelled bool
+	switch mediaType := MediaType(c.Param("mediaType")); mediaType {
+	case MediaTypeMovie, MediaTypeEpisode:
+		cancelled = h.service.CancelSearch(mediaType, id)
+	case MediaTypeSeries:
+		cancelled = h.service.CancelSeriesSearch(id, nil)
+	case MediaTypeSeason:
+		seasonNumber, err := strconv.Atoi(c.QueryParam("seasonNumber"))
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, "invalid season number")
+		}
+		cancelled = h.service.CancelSeriesSearch(id, &seasonNumber)
+	default:
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid media type")
+	}
+
+	if !cancelled {
+		return echo.NewHTTPError(http.StatusNotFound, "no search running")
+	}
+	return c.NoContent(http.StatusNoContent)
+}
+
 // SearchAllMissing triggers automatic search for all missing items (movies and series).
 // POST /api/v1/autosearch/missing/all
 func (h *Handlers) SearchAllMissing(c echo.Context) error {
@@ -0,0 +1,197 @@
+package autosearch
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/slipstream/slipstream/internal/decisioning"
+	"github.com/slipstream/slipstream/internal/indexer/search"
+	"github.com/slipstream/slipstream/internal/indexer/types"
+	"github.com/slipstream/slipstream/internal/library/quality"
+	"github.com/slipstream/slipstream/internal/module"
+)
+
+// interactiveResultTTL is how long interactive search results remain grabbable by GUID.
+const interactiveResultTTL = 30 * time.Minute
+
+var ErrReleaseNotFound = errors.New("release not found in recent interactive search results")
+
+// InteractiveSearchResult contains every scored release for an item along with
+// the automatic selection decision for each.
+type InteractiveSearchResult struct {
+	MediaType     MediaType                     `json:"mediaType"`
+	MediaID       int64                         `json:"mediaId"`
+	Releases      []decisioning.ReleaseDecision `json:"releases"`
+	IndexerErrors []search.SearchIndexerError   `json:"errors"`
+}
+
+type interactiveEntry struct {
+	item      SearchableItem
+	release   types.TorrentInfo
+	expiresAt time.Time
+}
+
+// interactiveCache remembers releases returned by interactive searches so a
+// later grab request only needs to reference the release GUID.
+type interactiveCache struct {
+	mu      sync.Mutex
+	entries map[string]interactiveEntry
+}
+
+func newInteractiveCache() *interactiveCache {
+	return &interactiveCache{entries: make(map[string]interactiveEntry)}
+}
+
+func (c *interactiveCache) store(item SearchableItem, decisions []decisioning.ReleaseDecision, now time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for guid, entry := range c.entries {
+		if now.After(entry.expiresAt) {
+			delete(c.entries, guid)
+		}
+	}
+	expiresAt := now.Add(interactiveResultTTL)
+	for i := range decisions {
+		c.entries[decisions[i].GUID] = interactiveEntry{
+			item:      item,
+			release:   decisions[i].TorrentInfo,
+			expiresAt: expiresAt,
+		}
+	}
+}
+
+func (c *interactiveCache) get(guid string, now time.Time) (interactiveEntry, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, ok := c.entries[guid]
+	if !ok || now.After(entry.expiresAt) {
+		return interactiveEntry{}, false
+	}
+	return entry, true
+}
+
+// InteractiveSearchMovie searches for a movie and returns all scored releases without grabbing.
+func (s *Service) InteractiveSearchMovie(ctx context.Context, movieID int64) (*InteractiveSearchResult, error) {
+	movie, err := s.queries.GetMovie(ctx, movieID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrItemNotFound
+		}
+		return nil, fmt.Errorf("failed to get movie: %w", err)
+	}
+	return s.interactiveSearch(ctx, s.movieToSearchableItem(ctx, movie))
+}
+
+// InteractiveSearchEpisode searches for an episode and returns all scored releases without grabbing.
+func (s *Service) InteractiveSearchEpisode(ctx context.Context, episodeID int64) (*InteractiveSearchResult, error) {
+	episode, err := s.queries.GetEpisode(ctx, episodeID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrItemNotFound
+		}
+		return nil, fmt.Errorf("failed to get episode: %w", err)
+	}
+	series, err := s.queries.GetSeries(ctx, episode.SeriesID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get series: %w", err)
+	}
+	return s.interactiveSearch(ctx, s.episodeToSearchableItem(ctx, episode, series))
+}
+
+// InteractiveSearchSeason searches for season packs and returns all scored releases without grabbing.
+func (s *Service) InteractiveSearchSeason(ctx context.Context, seriesID int64, seasonNumber int) (*InteractiveSearchResult, error) {
+	series, err := s.getSeriesForSearch(ctx, seriesID)
+	if err != nil {
+		return nil, err
+	}
+	return s.interactiveSearch(ctx, s.seriesToSeasonPackItem(series, seasonNumber))
+}
+
+func (s *Service) interactiveSearch(ctx context.Context, item SearchableItem) (*InteractiveSearchResult, error) {
+	profile, err := s.qualityService.Get(ctx, item.GetQualityProfileID())
+	if err != nil {
+		s.logger.Warn().Err(err).Int64("profileId", item.GetQualityProfileID()).
+			Msg("Failed to get quality profile, using default")
+		defaultProfile := quality.DefaultProfile()
+		profile = &defaultProfile
+	}
+
+	criteria := s.buildSearchCriteria(ctx, item)
+	languageProfile := s.languageProfileForItem(ctx, item)
+	scoringParams := search.ScoredSearchParams{
+		QualityProfile:    profile,
+		SearchYear:        module.ItemYear(item),
+		SearchSeason:      module.ItemSeasonNumber(item),
+		SearchEpisode:     module.ItemEpisodeNumber(item),
+		SearchAbsolute:    criteria.AbsoluteEpisode,
+		PreferredLanguage: languageProfile.PreferredLanguage(),
+	}
+
+	searchResult, err := s.searchService.SearchTorrents(ctx, &criteria, &scoringParams)
+	if err != nil {
+		return nil, fmt.Errorf("search failed: %w", err)
+	}
+
+	decisions := decisioning.EvaluateReleases(searchResult.Releases, profile, item, decisioning.WithLanguageProfile(s.strategyForItem(item), languageProfile), s.releaseParser())
+	s.interactive.store(item, decisions, time.Now())
+
+	indexerErrors := searchResult.IndexerErrors
+	if indexerErrors == nil {
+		indexerErrors = []search.SearchIndexerError{}
+	}
+
+	s.logger.Info().
+		Str("mediaType", item.GetMediaType()).
+		Int64("mediaId", item.GetEntityID()).
+		Int("releases", len(decisions)).
+		Msg("Interactive search completed")
+
+	return &InteractiveSearchResult{
+		MediaType:     MediaType(item.GetMediaType()),
+		MediaID:       item.GetEntityID(),
+		Releases:      decisions,
+		IndexerErrors: indexerErrors,
+	}, nil
+}
+
+// GrabInteractiveRelease grabs a release from a recent interactive search by its GUID.
+// Rejected releases may be grabbed; the user's choice overrides automatic selection.
+func (s *Service) GrabInteractiveRelease(ctx context.Context, guid string, clientID int64) (*SearchResult, error) {
+	entry, ok := s.interactive.get(guid, time.Now())
+	if !ok {
+		return nil, ErrReleaseNotFound
+	}
+
+	if s.grabLock != nil {
+		lockKey := decisioning.Key(decisioning.MediaType(entry.item.GetMediaType()), entry.item.GetEntityID())
+		if !s.grabLock.TryAcquire(lockKey) {
+			return nil, ErrAlreadyInQueue
+		}
+		defer s.grabLock.Release(lockKey)
+	}
+
+	grabReq := s.buildGrabRequest(entry.item, &entry.release)
+	grabReq.ClientID = clientID
+	grabReq.Source = "manual-search"
+
+	grabResult, err := s.grabService.Grab(ctx, grabReq)
+	if err != nil {
+		return nil, fmt.Errorf("grab failed: %w", err)
+	}
+
+	return &SearchResult{
+		Found:      true,
+		Downloaded: grabResult.Success,
+		Release:    &entry.release,
+		Error:      grabResult.Error,
+		Upgraded:   module.ItemHasFile(entry.item) && module.ItemCurrentQualityID(entry.item) > 0,
+		ClientName: grabResult.ClientName,
+		DownloadID: grabResult.DownloadID,
+	}, nil
+}
@@ -0,0 +1,155 @@
+package autosearch
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/slipstream/slipstream/internal/database/sqlc"
+	"github.com/slipstream/slipstream/internal/module"
+)
+
+// SeriesSearchStatus is the state of a series or season search.
+type SeriesSearchStatus string
+
+const (
+	SeriesSearchRunning   SeriesSearchStatus = "running"
+	SeriesSearchCompleted SeriesSearchStatus = "completed"
+	SeriesSearchCancelled SeriesSearchStatus = "cancelled"
+)
+
+// seriesSearchRun tracks a series or season search that covers several
+// items. It is registered among the active searches, so it can be listed and
+// cancelled, and broadcasts its progress after every item.
+type seriesSearchRun struct {
+	s        *Service
+	ctx      context.Context
+	cancel   context.CancelFunc
+	key      string
+	progress AutoSearchSeriesProgressPayload
+}
+
+// seriesSearchKey is the active search key of a whole-series search, or of a
+// season search when seasonNumber is set. Season keys carry the season number
+// so they never collide with the "season:<seriesId>" key of a pack search.
+func seriesSearchKey(seriesID int64, seasonNumber *int) string {
+	if seasonNumber == nil {
+		return fmt.Sprintf("%s:%d", MediaTypeSeries, seriesID)
+	}
+	return fmt.Sprintf("%s:%d:%d", MediaTypeSeason, seriesID, *seasonNumber)
+}
+
+// startSeriesSearch registers a series search, or a season search when
+// seasonNumber is set. The caller must call finish when it is done.
+func (s *Service) startSeriesSearch(series *sqlc.Series, seasonNumber *int, source SearchSource) *seriesSearchRun {
+	mediaType := MediaTypeSeries
+	if seasonNumber != nil {
+		mediaType = MediaTypeSeason
+	}
+	key := seriesSearchKey(series.ID, seasonNumber)
+	ctx, cancel := s.registerActive(key, ActiveSearch{
+		MediaType: string(mediaType),
+		MediaID:   series.ID,
+		Title:     series.Title,
+		Source:    source,
+		StartedAt: time.Now(),
+	})
+	return &seriesSearchRun{
+		s:      s,
+		ctx:    ctx,
+		cancel: cancel,
+		key:    key,
+		progress: AutoSearchSeriesProgressPayload{
+			SeriesID:     series.ID,
+			SeasonNumber: seasonNumber,
+			Title:        series.Title,
+			Status:       SeriesSearchRunning,
+		},
+	}
+}
+
+// setTotal sets how many searches the run expects to make.
+func (r *seriesSearchRun) setTotal(total int) {
+	r.progress.Total = total
+}
+
+// cancelled reports whether the run was cancelled.
+func (r *seriesSearchRun) cancelled() bool {
+	return r.ctx.Err() != nil
+}
+
+// search runs one item's search as part of the run. Cancelling the run also
+// cancels the item's own search, which does not share the run's context.
+func (r *seriesSearchRun) search(mediaType MediaType, mediaID int64, label string, fn func() (*SearchResult, error)) (*SearchResult, error) {
+	r.progress.CurrentTitle = label
+	r.broadcast()
+
+	stop := context.AfterFunc(r.ctx, func() { r.s.CancelSearch(mediaType, mediaID) })
+	result, err := fn()
+	stop()
+
+	r.progress.Searched++
+	switch {
+	case err != nil:
+		r.progress.Failed++
+	case result != nil:
+		if result.Found {
+			r.progress.Found++
+		}
+		if result.Downloaded {
+			r.progress.Downloaded++
+		}
+	}
+	return result, err
+}
+
+// finish broadcasts the final progress and unregisters the run.
+func (r *seriesSearchRun) finish() {
+	r.progress.Status = SeriesSearchCompleted
+	if r.cancelled() {
+		r.progress.Status = SeriesSearchCancelled
+	}
+	r.progress.CurrentTitle = ""
+	r.broadcast()
+	r.s.unregisterSearch(r.key, r.cancel)
+	r.cancel()
+}
+
+func (r *seriesSearchRun) broadcast() {
+	if r.s.broadcaster == nil {
+		return
+	}
+	r.s.broadcaster.Broadcast(EventAutoSearchSeriesProgress, r.progress)
+}
+
+func episodeLabel(seriesTitle string, ep *sqlc.Episode) string {
+	return fmt.Sprintf("%s S%02dE%02d", seriesTitle, ep.SeasonNumber, ep.EpisodeNumber)
+}
+
+func seasonLabel(seriesTitle string, seasonNumber int) string {
+	return fmt.Sprintf("%s Season %d", seriesTitle, seasonNumber)
+}
+
+// itemLabel names a season pack or episode item for progress reports.
+func itemLabel(item SearchableItem) string {
+	if item.GetMediaType() == string(MediaTypeSeason) {
+		return seasonLabel(item.GetTitle(), module.ItemSeasonNumber(item))
+	}
+	return fmt.Sprintf("%s S%02dE%02d", item.GetTitle(), module.ItemSeasonNumber(item), module.ItemEpisodeNumber(item))
+}
+
+// CancelSeriesSearch cancels a running series search, or a season search when
+// seasonNumber is set, along with the item it is currently searching.
+func (s *Service) CancelSeriesSearch(seriesID int64, seasonNumber *int) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	key := seriesSearchKey(seriesID, seasonNumber)
+	existing, exists := s.activeSearches[key]
+	if !exists {
+		return false
+	}
+	existing.cancel()
+	delete(s.activeSearches, key)
+	return true
+}
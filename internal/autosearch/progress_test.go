@@ -0,0 +1,105 @@
+package autosearch
+
+import (
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/rs/zerolog"
+
+	"github.com/slipstream/slipstream/internal/database/sqlc"
+)
+
+type recordingBroadcaster struct {
+	mu       sync.Mutex
+	progress []AutoSearchSeriesProgressPayload
+}
+
+func (b *recordingBroadcaster) Broadcast(msgType string, payload any) {
+	if msgType != EventAutoSearchSeriesProgress {
+		return
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.progress = append(b.progress, payload.(AutoSearchSeriesProgressPayload))
+}
+
+func (b *recordingBroadcaster) BroadcastEntity(string, string, int64, string, any) {}
+
+func TestSeriesSearchKey(t *testing.T) {
+	season := 2
+	if got := seriesSearchKey(7, nil); got != "series:7" {
+		t.Errorf("series key = %q, want series:7", got)
+	}
+	if got := seriesSearchKey(7, &season); got != "season:7:2" {
+		t.Errorf("season key = %q, want season:7:2", got)
+	}
+}
+
+func TestSeriesSearchRun_Progress(t *testing.T) {
+	logger := zerolog.Nop()
+	b := &recordingBroadcaster{}
+	svc := NewService(nil, nil, nil, nil, &logger, nil, nil, b)
+
+	series := &sqlc.Series{ID: 7, Title: "Severance"}
+	run := svc.startSeriesSearch(series, nil, SearchSourceManual)
+	run.setTotal(3)
+
+	if !svc.IsSearching(MediaTypeSeries, 7) {
+		t.Fatal("expected the series search to be registered")
+	}
+
+	_, _ = run.search(MediaTypeEpisode, 1, "Severance S01E01", func() (*SearchResult, error) {
+		return &SearchResult{Found: true, Downloaded: true}, nil
+	})
+	_, _ = run.search(MediaTypeEpisode, 2, "Severance S01E02", func() (*SearchResult, error) {
+		return nil, errors.New("indexer down")
+	})
+	_, _ = run.search(MediaTypeEpisode, 3, "Severance S01E03", func() (*SearchResult, error) {
+		return &SearchResult{Found: true}, nil
+	})
+	run.finish()
+
+	if svc.IsSearching(MediaTypeSeries, 7) {
+		t.Error("expected the series search to be unregistered after finish")
+	}
+	if len(b.progress) != 4 {
+		t.Fatalf("broadcast %d progress events, want 4", len(b.progress))
+	}
+	if got := b.progress[1].CurrentTitle; got != "Severance S01E02" {
+		t.Errorf("second event title = %q, want Severance S01E02", got)
+	}
+
+	final := b.progress[3]
+	if final.Status != SeriesSearchCompleted {
+		t.Errorf("final status = %q, want %q", final.Status, SeriesSearchCompleted)
+	}
+	if final.Searched != 3 || final.Total != 3 || final.Found != 2 || final.Downloaded != 1 || final.Failed != 1 {
+		t.Errorf("final progress = %+v", final)
+	}
+}
+
+func TestSeriesSearchRun_Cancel(t *testing.T) {
+	logger := zerolog.Nop()
+	b := &recordingBroadcaster{}
+	svc := NewService(nil, nil, nil, nil, &logger, nil, nil, b)
+
+	season := 1
+	series := &sqlc.Series{ID: 7, Title: "Severance"}
+	run := svc.startSeriesSearch(series, &season, SearchSourceManual)
+
+	if svc.CancelSeriesSearch(7, nil) {
+		t.Error("cancelling the whole series must not match a season search")
+	}
+	if !svc.CancelSeriesSearch(7, &season) {
+		t.Fatal("expected the season search to be cancelled")
+	}
+	if !run.cancelled() {
+		t.Error("expected the run to observe the cancellation")
+	}
+
+	run.finish()
+	if got := b.progress[len(b.progress)-1].Status; got != SeriesSearchCancelled {
+		t.Errorf("final status = %q, want %q", got, SeriesSearchCancelled)
+	}
+}
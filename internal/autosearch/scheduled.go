@@ -8,11 +8,13 @@ import (
 	"sort"
 	"strconv"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/rs/zerolog"
 	"github.com/slipstream/slipstream/internal/config"
 	"github.com/slipstream/slipstream/internal/database/sqlc"
+	"github.com/slipstream/slipstream/internal/domain/contracts"
 	"github.com/slipstream/slipstream/internal/module"
 )
 
@@ -30,6 +32,19 @@ type SeriesRefresher interface {
 	RefreshMonitoredSeriesMetadata(ctx context.Context) (int, error)
 }
 
+// RequestVoteSource reports how many portal users are waiting on library items.
+type RequestVoteSource interface {
+	// VoteCounts returns vote counts keyed by module type and library ID
+	// (the movie ID, or the series ID for TV).
+	VoteCounts(ctx context.Context) (map[string]map[int64]int64, error)
+}
+
+// SmartListResolver resolves a saved smart list to its current members.
+type SmartListResolver interface {
+	// MemberIDs returns the list's media type ("movie" or "series") and member IDs.
+	MemberIDs(ctx context.Context, id int64) (mediaType string, ids []int64, err error)
+}
+
 // ScheduledSearcher handles scheduled automatic searches for missing items.
 type ScheduledSearcher struct {
 	service *Service
@@ -45,9 +60,21 @@ type ScheduledSearcher struct {
 	// Module registry for module-aware item collection
 	registry *module.Registry
 
+	// Optional smart list resolver for scoping paced batch searches
+	smartLists SmartListResolver
+
+	// Optional tag store for scoping the cutoff unmet search to tagged items
+	tagStore contracts.TagStore
+
+	// Optional portal request votes used to search popular requests first
+	requestVotes RequestVoteSource
+
 	// Task state
 	mu      sync.Mutex
 	running bool
+
+	// Paced missing / cutoff-unmet searches run one at a time, independently of Run
+	batchRunning atomic.Bool
 }
 
 // NewScheduledSearcher creates a new scheduled searcher.
@@ -73,6 +100,16 @@ func (s *ScheduledSearcher) SetRegistry(r *module.Registry) {
 	s.registry = r
 }
 
+// SetSmartListResolver sets the resolver used to scope batch searches to a smart list.
+func (s *ScheduledSearcher) SetSmartListResolver(r SmartListResolver) {
+	s.smartLists = r
+}
+
+// SetRequestVoteSource sets the source of portal request votes used to order searches.
+func (s *ScheduledSearcher) SetRequestVoteSource(src RequestVoteSource) {
+	s.requestVotes = src
+}
+
 // IsRunning returns true if the scheduled search is currently running.
 func (s *ScheduledSearcher) IsRunning() bool {
 	s.mu.Lock()
@@ -174,10 +211,8 @@ func (s *ScheduledSearcher) RunMoviesOnly(ctx context.Context) error {
 		return err
 	}
 
-	// Sort by release date (newest first)
-	sort.Slice(movieItems, func(i, j int) bool {
-		return movieItems[i].releaseDate.After(movieItems[j].releaseDate)
-	})
+	// Sort by request votes, then release date (newest first)
+	s.sortByPriority(ctx, movieItems)
 
 	items := make([]SearchableItem, len(movieItems))
 	for i := range movieItems {
@@ -234,10 +269,8 @@ func (s *ScheduledSearcher) RunSeriesOnly(ctx context.Context) error {
 		return err
 	}
 
-	// Sort by release date (newest first)
-	sort.Slice(episodeItems, func(i, j int) bool {
-		return episodeItems[i].releaseDate.After(episodeItems[j].releaseDate)
-	})
+	// Sort by request votes, then release date (newest first)
+	s.sortByPriority(ctx, episodeItems)
 
 	items := make([]SearchableItem, len(episodeItems))
 	for i := range episodeItems {
@@ -293,9 +326,7 @@ func (s *ScheduledSearcher) RunUpgradeMoviesOnly(ctx context.Context) error {
 		return err
 	}
 
-	sort.Slice(movieItems, func(i, j int) bool {
-		return movieItems[i].releaseDate.After(movieItems[j].releaseDate)
-	})
+	s.sortByPriority(ctx, movieItems)
 
 	items := make([]SearchableItem, len(movieItems))
 	for i := range movieItems {
@@ -351,9 +382,7 @@ func (s *ScheduledSearcher) RunUpgradeSeriesOnly(ctx context.Context) error {
 		return err
 	}
 
-	sort.Slice(episodeItems, func(i, j int) bool {
-		return episodeItems[i].releaseDate.After(episodeItems[j].releaseDate)
-	})
+	s.sortByPriority(ctx, episodeItems)
 
 	items := make([]SearchableItem, len(episodeItems))
 	for i := range episodeItems {
@@ -390,15 +419,51 @@ type searchableItemWithPriority struct {
 	isSeasonPack bool
 }
 
+// sortByPriority orders items so titles requested by more portal users are
+// searched first, falling back to release date (newest first).
+func (s *ScheduledSearcher) sortByPriority(ctx context.Context, items []searchableItemWithPriority) {
+	var votes map[string]map[int64]int64
+	if s.requestVotes != nil {
+		counts, err := s.requestVotes.VoteCounts(ctx)
+		if err != nil {
+			s.logger.Warn().Err(err).Msg("Failed to load request votes, ordering by release date only")
+		}
+		votes = counts
+	}
+
+	voteCount := func(item SearchableItem) int64 {
+		if len(votes) == 0 {
+			return 0
+		}
+		id := item.GetEntityID()
+		if item.GetModuleType() == string(module.TypeTV) {
+			id = module.ItemSeriesID(item)
+		}
+		return votes[item.GetModuleType()][id]
+	}
+
+	sort.SliceStable(items, func(i, j int) bool {
+		vi, vj := voteCount(items[i].item), voteCount(items[j].item)
+		if vi != vj {
+			return vi > vj
+		}
+		return items[i].releaseDate.After(items[j].releaseDate)
+	})
+}
+
 type seasonKey struct {
 	seriesID     int64
 	seasonNumber int64
 }
 
 // collectSearchableItems gathers all missing and upgrade-eligible movies and episodes, ordered by release date.
-// When a module registry is available, collection is delegated to module WantedCollectors;
+// In slot auto-fill mode each version slot needing a file is collected separately. Otherwise, when a
+// module registry is available, collection is delegated to module WantedCollectors;
 // otherwise the legacy sqlc-based collection path is used.
 func (s *ScheduledSearcher) collectSearchableItems(ctx context.Context) ([]SearchableItem, error) {
+	if s.slotAutoFill(ctx) {
+		return s.collectSlotFillItems(ctx)
+	}
 	if s.registry != nil {
 		return s.collectFromModules(ctx)
 	}
@@ -445,10 +510,8 @@ func (s *ScheduledSearcher) collectFromModules(ctx context.Context) ([]Searchabl
 		allItems = append(allItems, upgradeConverted...)
 	}
 
-	// Sort by release date (newest first)
-	sort.Slice(allItems, func(i, j int) bool {
-		return allItems[i].releaseDate.After(allItems[j].releaseDate)
-	})
+	// Sort by request votes, then release date (newest first)
+	s.sortByPriority(ctx, allItems)
 
 	result := make([]SearchableItem, len(allItems))
 	for i := range allItems {
@@ -630,10 +693,8 @@ func (s *ScheduledSearcher) collectLegacy(ctx context.Context) ([]SearchableItem
 	}
 	items = append(items, upgradeEpisodes...)
 
-	// Sort by release date (newest first)
-	sort.Slice(items, func(i, j int) bool {
-		return items[i].releaseDate.After(items[j].releaseDate)
-	})
+	// Sort by request votes, then release date (newest first)
+	s.sortByPriority(ctx, items)
 
 	// Extract just the searchable items
 	result := make([]SearchableItem, len(items))
@@ -749,6 +810,9 @@ func buildMissingEpisodeItem(ep *sqlc.ListMissingEpisodesRow) SearchableItem {
 	if ep.SeriesYear.Valid {
 		extra["year"] = int(ep.SeriesYear.Int64)
 	}
+	if ep.SeriesRuntime.Valid {
+		extra["runtime"] = int(ep.SeriesRuntime.Int64)
+	}
 
 	var profileID int64
 	if ep.SeriesQualityProfileID.Valid {
@@ -1051,13 +1115,8 @@ func (s *ScheduledSearcher) processItems(ctx context.Context, items []Searchable
 			return result
 		default:
 		}
-
-		hasFile := module.ItemHasFile(item)
-
-		// Determine search type based on whether item has a file (upgrade vs missing)
-		searchType := statusMissing
-		if hasFile {
-			searchType = searchTypeUpgrade
+		if s.grabsPaused(ctx) {
+			return result
 		}
 
 		// Broadcast progress
@@ -1069,48 +1128,76 @@ func (s *ScheduledSearcher) processItems(ctx context.Context, items []Searchable
 			time.Sleep(delay)
 		}
 
-		// Execute search
-		startTime := time.Now()
-		searchResult, err := s.searchItem(ctx, item)
-		responseTime := time.Since(startTime)
+		s.searchAndRecord(ctx, item, result)
+	}
 
-		// Record response time for adaptive rate limiting
-		s.rateLimiter.RecordResponse(responseTime, nil)
+	return result
+}
 
-		result.TotalSearched++
+// grabsPaused reports whether automatic grabs are paused by the library free
+// space guard, in which case searching would only find releases it cannot grab.
+func (s *ScheduledSearcher) grabsPaused(ctx context.Context) bool {
+	if s.service.grabService == nil {
+		return false
+	}
+	paused, reason := s.service.grabService.AutoGrabsPaused(ctx)
+	if paused {
+		s.logger.Warn().Str("reason", reason).Msg("Stopping automatic search: library free space below threshold")
+	}
+	return paused
+}
 
-		if err != nil {
-			s.logger.Warn().Err(err).
-				Str("title", item.GetTitle()).
-				Str("mediaType", item.GetMediaType()).
-				Msg("Search failed")
-			result.Failed++
-			result.Results = append(result.Results, &SearchResult{Error: err.Error()})
-
-			// Increment failure count for backoff
-			s.incrementFailureCount(ctx, item, searchType)
-			continue
-		}
+// searchAndRecord searches a single item, tallies the outcome into result, and
+// updates the item's failure count used for backoff.
+func (s *ScheduledSearcher) searchAndRecord(ctx context.Context, item SearchableItem, result *BatchSearchResult) {
+	// Determine search type based on whether item has a file (upgrade vs missing)
+	searchType := statusMissing
+	if module.ItemHasFile(item) {
+		searchType = searchTypeUpgrade
+	}
 
-		result.Results = append(result.Results, searchResult)
-		if searchResult.Found {
-			result.Found++
-			// Reset failure count on success
-			s.resetFailureCount(ctx, item, searchType)
-		} else {
-			// No results found - increment failure count
-			s.incrementFailureCount(ctx, item, searchType)
-		}
-		if searchResult.Downloaded {
-			result.Downloaded++
-		}
+	// Execute search
+	startTime := time.Now()
+	searchResult, err := s.searchItem(ctx, item)
+	responseTime := time.Since(startTime)
+
+	// Record response time for adaptive rate limiting
+	s.rateLimiter.RecordResponse(responseTime, nil)
+
+	result.TotalSearched++
+
+	if err != nil {
+		s.logger.Warn().Err(err).
+			Str("title", item.GetTitle()).
+			Str("mediaType", item.GetMediaType()).
+			Msg("Search failed")
+		result.Failed++
+		result.Results = append(result.Results, &SearchResult{Error: err.Error()})
+
+		// Increment failure count for backoff
+		s.incrementFailureCount(ctx, item, searchType)
+		return
 	}
 
-	return result
+	result.Results = append(result.Results, searchResult)
+	if searchResult.Found {
+		result.Found++
+		// Reset failure count on success
+		s.resetFailureCount(ctx, item, searchType)
+	} else {
+		// No results found - increment failure count
+		s.incrementFailureCount(ctx, item, searchType)
+	}
+	if searchResult.Downloaded {
+		result.Downloaded++
+	}
 }
 
 // searchItem searches for a single item based on its type.
 func (s *ScheduledSearcher) searchItem(ctx context.Context, item SearchableItem) (*SearchResult, error) {
+	if slotID := module.ItemTargetSlotID(item); slotID != nil {
+		return s.searchSlotItem(ctx, item, *slotID)
+	}
 	mediaType := item.GetMediaType()
 	switch mediaType {
 	case string(MediaTypeMovie):
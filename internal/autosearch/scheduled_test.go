@@ -3,6 +3,7 @@ package autosearch
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/slipstream/slipstream/internal/config"
 	"github.com/slipstream/slipstream/internal/database/sqlc"
@@ -350,3 +351,36 @@ func TestBackoffIDConsistency(t *testing.T) {
 		}
 	})
 }
+
+type stubVoteSource map[string]map[int64]int64
+
+func (s stubVoteSource) VoteCounts(context.Context) (map[string]map[int64]int64, error) {
+	return s, nil
+}
+
+func TestSortByPriority_RequestVotesFirst(t *testing.T) {
+	searcher, _ := newTestSearcher(t)
+	searcher.SetRequestVoteSource(stubVoteSource{
+		"movie": {2: 3},
+		"tv":    {7: 2},
+	})
+
+	now := time.Now()
+	episode := module.NewWantedItem(module.TypeTV, "episode", 70, "", nil, 0, nil,
+		module.SearchParams{Extra: map[string]any{"seriesId": int64(7)}})
+	items := []searchableItemWithPriority{
+		{item: testItem("movie", 1), releaseDate: now},
+		{item: testItem("movie", 2), releaseDate: now.Add(-48 * time.Hour)},
+		{item: episode, releaseDate: now.Add(-24 * time.Hour)},
+		{item: testItem("movie", 3), releaseDate: now.Add(-time.Hour)},
+	}
+
+	searcher.sortByPriority(context.Background(), items)
+
+	want := []int64{2, 70, 1, 3}
+	for i, id := range want {
+		if got := items[i].item.GetEntityID(); got != id {
+			t.Errorf("position %d: got entity %d, want %d", i, got, id)
+		}
+	}
+}
@@ -5,7 +5,9 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"sort"
 	"sync"
+	"time"
 
 	"github.com/rs/zerolog"
 	"github.com/slipstream/slipstream/internal/database/sqlc"
@@ -15,16 +17,15 @@ import (
 	"github.com/slipstream/slipstream/internal/indexer/grab"
 	"github.com/slipstream/slipstream/internal/indexer/search"
 	"github.com/slipstream/slipstream/internal/indexer/types"
+	"github.com/slipstream/slipstream/internal/library/language"
 	"github.com/slipstream/slipstream/internal/library/quality"
 	"github.com/slipstream/slipstream/internal/library/scanner"
 	"github.com/slipstream/slipstream/internal/library/slots"
+	"github.com/slipstream/slipstream/internal/library/status"
 	"github.com/slipstream/slipstream/internal/module"
 )
 
-const (
-	statusUpgradable = "upgradable"
-	statusUnreleased = "unreleased"
-)
+const statusUpgradable = "upgradable"
 
 var (
 	ErrNoResults       = errors.New("no suitable releases found")
@@ -35,23 +36,37 @@ var (
 
 // Service provides automatic release searching and grabbing functionality.
 type Service struct {
-	db             *sql.DB
-	queries        *sqlc.Queries
-	searchService  search.SearchService
-	grabService    *grab.Service
-	qualityService *quality.Service
-	historyService *history.Service
-	slotsService   *slots.Service
-	grabLock       *decisioning.GrabLock
-	broadcaster    contracts.Broadcaster
-	logger         *zerolog.Logger
+	db              *sql.DB
+	queries         *sqlc.Queries
+	searchService   search.SearchService
+	grabService     *grab.Service
+	qualityService  *quality.Service
+	languageService *language.Service
+	historyService  *history.Service
+	slotsService    *slots.Service
+	grabLock        *decisioning.GrabLock
+	broadcaster     contracts.Broadcaster
+	logger          *zerolog.Logger
 
 	// Module registry for module-aware criteria building
 	registry *module.Registry
 
+	// Tag store for restricting searches to indexers sharing the item's tags
+	tagStore contracts.TagStore
+
 	// Track currently running searches for cancellation
 	mu             sync.RWMutex
-	activeSearches map[string]context.CancelFunc // key: "movie:123" or "episode:456"
+	activeSearches map[string]*activeSearch // key: "movie:123" or "episode:456"
+
+	// Releases from interactive searches, grabbable by GUID
+	interactive *interactiveCache
+
+	// Items whose automatic grab was deferred by a grab cap
+	deferred *deferredGrabs
+
+	// Minimum availability for items without their own setting
+	defaultAvailability func() string
+	seasonPackPercent   func() int
 }
 
 // NewService creates a new automatic search service.
@@ -76,7 +91,9 @@ func NewService(
 		historyService: historyService,
 		grabLock:       grabLock,
 		broadcaster:    broadcaster,
-		activeSearches: make(map[string]context.CancelFunc),
+		activeSearches: make(map[string]*activeSearch),
+		interactive:    newInteractiveCache(),
+		deferred:       newDeferredGrabs(),
 	}
 }
 
@@ -92,6 +109,50 @@ func (s *Service) SetRegistry(r *module.Registry) {
 	s.registry = r
 }
 
+// SetTagStore sets the store searched items' tags are read from.
+func (s *Service) SetTagStore(store contracts.TagStore) {
+	s.tagStore = store
+}
+
+// SetLanguageService sets the language profile service used to filter and score releases.
+func (s *Service) SetLanguageService(languageService *language.Service) {
+	s.languageService = languageService
+}
+
+// SetDefaultMinimumAvailability sets the source of the global minimum
+// availability applied to items that do not override it.
+func (s *Service) SetDefaultMinimumAvailability(fn func() string) {
+	s.defaultAvailability = fn
+}
+
+// SetSeasonPackMissingPercent sets the source of the share of a season's
+// monitored episodes that must be missing before it is searched as a pack.
+func (s *Service) SetSeasonPackMissingPercent(fn func() int) {
+	s.seasonPackPercent = fn
+}
+
+// seasonPackMissingPercent returns the configured season pack threshold.
+func (s *Service) seasonPackMissingPercent() int {
+	if s.seasonPackPercent == nil {
+		return decisioning.DefaultSeasonPackMissingPercent
+	}
+	return s.seasonPackPercent()
+}
+
+// minimumAvailability resolves an item's minimum availability, falling back to
+// the global default.
+func (s *Service) minimumAvailability(override sql.NullString) string {
+	if override.Valid && override.String != "" {
+		return override.String
+	}
+	if s.defaultAvailability != nil {
+		if level := s.defaultAvailability(); level != "" {
+			return level
+		}
+	}
+	return status.DefaultAvailability
+}
+
 // buildSearchCriteriaFromModule constructs criteria using the module's SearchStrategy.
 func (s *Service) buildSearchCriteriaFromModule(item module.SearchableItem) types.SearchCriteria {
 	moduleType := module.Type(item.GetModuleType())
@@ -120,11 +181,13 @@ func (s *Service) SearchMovie(ctx context.Context, movieID int64, source SearchS
 		return nil, fmt.Errorf("failed to get movie: %w", err)
 	}
 
-	if movie.Status == statusUnreleased {
+	level := s.minimumAvailability(movie.MinimumAvailability)
+	if !status.MovieAvailable(level, movie.TheatricalReleaseDate, movie.ReleaseDate, movie.PhysicalReleaseDate, time.Now()) {
 		s.logger.Info().
 			Int64("movieId", movieID).
 			Str("title", movie.Title).
-			Msg("Skipping search: movie is unreleased")
+			Str("minimumAvailability", level).
+			Msg("Skipping search: movie has not reached its minimum availability")
 		return &SearchResult{Found: false}, nil
 	}
 
@@ -145,20 +208,22 @@ func (s *Service) SearchEpisode(ctx context.Context, episodeID int64, source Sea
 		return nil, fmt.Errorf("failed to get episode: %w", err)
 	}
 
-	if episode.Status == statusUnreleased {
+	// Get series for its minimum availability and additional metadata
+	series, err := s.queries.GetSeries(ctx, episode.SeriesID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get series: %w", err)
+	}
+
+	level := s.minimumAvailability(series.MinimumAvailability)
+	if !status.EpisodeAvailable(level, episode.Status) {
 		s.logger.Info().
 			Int64("episodeId", episodeID).
 			Int64("seriesId", episode.SeriesID).
-			Msg("Skipping search: episode is unreleased")
+			Str("minimumAvailability", level).
+			Msg("Skipping search: episode has not reached its minimum availability")
 		return &SearchResult{Found: false}, nil
 	}
 
-	// Get series for additional metadata
-	series, err := s.queries.GetSeries(ctx, episode.SeriesID)
-	if err != nil {
-		return nil, fmt.Errorf("failed to get series: %w", err)
-	}
-
 	item := s.episodeToSearchableItem(ctx, episode, series)
 	result, err := s.searchAndGrab(ctx, item, source)
 	if err != nil {
@@ -220,14 +285,15 @@ func (s *Service) getSeriesForSearch(ctx context.Context, seriesID int64) (*sqlc
 }
 
 // tryDirectSeasonPackSearch attempts season pack search when episode data is unavailable.
-func (s *Service) tryDirectSeasonPackSearch(ctx context.Context, series *sqlc.Series, seasonNumber int, source SearchSource, originalErr error) (*BatchSearchResult, error) {
+func (s *Service) tryDirectSeasonPackSearch(ctx context.Context, run *seriesSearchRun, series *sqlc.Series, seasonNumber int, source SearchSource, originalErr error) (*BatchSearchResult, error) {
 	s.logger.Debug().
 		Err(originalErr).
 		Int64("seriesId", series.ID).
 		Int("seasonNumber", seasonNumber).
 		Msg("Failed to get missing episodes, attempting direct season pack search")
 
-	packResult, searchErr := s.searchSeasonPack(ctx, series, seasonNumber, source)
+	run.setTotal(1)
+	packResult, searchErr := s.searchSeasonPackInRun(ctx, run, series, seasonNumber, source)
 	result := &BatchSearchResult{
 		TotalSearched: 1,
 		Results:       []*SearchResult{packResult},
@@ -246,8 +312,8 @@ func (s *Service) tryDirectSeasonPackSearch(ctx context.Context, series *sqlc.Se
 }
 
 // trySeasonPackFirst attempts season pack search and returns result if successful.
-func (s *Service) trySeasonPackFirst(ctx context.Context, series *sqlc.Series, seasonNumber int, source SearchSource) (*BatchSearchResult, bool) {
-	packResult, err := s.searchSeasonPack(ctx, series, seasonNumber, source)
+func (s *Service) trySeasonPackFirst(ctx context.Context, run *seriesSearchRun, series *sqlc.Series, seasonNumber int, source SearchSource) (*BatchSearchResult, bool) {
+	packResult, err := s.searchSeasonPackInRun(ctx, run, series, seasonNumber, source)
 	if err != nil || !packResult.Found {
 		return nil, false
 	}
@@ -264,15 +330,21 @@ func (s *Service) trySeasonPackFirst(ctx context.Context, series *sqlc.Series, s
 }
 
 // searchEpisodesIndividually searches for episodes one by one.
-func (s *Service) searchEpisodesIndividually(ctx context.Context, episodes []*sqlc.Episode, series *sqlc.Series, source SearchSource) *BatchSearchResult {
+func (s *Service) searchEpisodesIndividually(ctx context.Context, run *seriesSearchRun, episodes []*sqlc.Episode, series *sqlc.Series, source SearchSource) *BatchSearchResult {
 	result := &BatchSearchResult{
 		TotalSearched: len(episodes),
 		Results:       make([]*SearchResult, 0, len(episodes)),
 	}
 
 	for _, ep := range episodes {
+		if run.cancelled() {
+			result.markCancelled()
+			break
+		}
 		item := s.episodeToSearchableItem(ctx, ep, series)
-		searchResult, err := s.searchAndGrab(ctx, item, source)
+		searchResult, err := run.search(MediaTypeEpisode, ep.ID, episodeLabel(series.Title, ep), func() (*SearchResult, error) {
+			return s.searchAndGrab(ctx, item, source)
+		})
 		if err != nil {
 			s.logger.Warn().Err(err).Int64("episodeId", ep.ID).Msg("Failed to search episode")
 			result.Failed++
@@ -298,9 +370,12 @@ func (s *Service) SearchSeason(ctx context.Context, seriesID int64, seasonNumber
 		return nil, err
 	}
 
+	run := s.startSeriesSearch(series, &seasonNumber, source)
+	defer run.finish()
+
 	episodes, err := s.getMissingEpisodesForSeason(ctx, seriesID, seasonNumber)
 	if err != nil {
-		return s.tryDirectSeasonPackSearch(ctx, series, seasonNumber, source, err)
+		return s.tryDirectSeasonPackSearch(ctx, run, series, seasonNumber, source, err)
 	}
 
 	if len(episodes) == 0 {
@@ -311,8 +386,10 @@ func (s *Service) SearchSeason(ctx context.Context, seriesID int64, seasonNumber
 		return &BatchSearchResult{}, nil
 	}
 
+	run.setTotal(len(episodes))
 	if s.isSeasonPackEligible(ctx, seriesID, seasonNumber) {
-		if result, ok := s.trySeasonPackFirst(ctx, series, seasonNumber, source); ok {
+		run.setTotal(len(episodes) + 1)
+		if result, ok := s.trySeasonPackFirst(ctx, run, series, seasonNumber, source); ok {
 			return result, nil
 		}
 		s.logger.Info().
@@ -322,7 +399,7 @@ func (s *Service) SearchSeason(ctx context.Context, seriesID int64, seasonNumber
 			Msg("No season pack found, falling back to individual episode search")
 	}
 
-	return s.searchEpisodesIndividually(ctx, episodes, series, source), nil
+	return s.searchEpisodesIndividually(ctx, run, episodes, series, source), nil
 }
 
 // searchSeasonPack searches for a season pack release (internal method).
@@ -331,6 +408,13 @@ func (s *Service) searchSeasonPack(ctx context.Context, series *sqlc.Series, sea
 	return s.searchAndGrab(ctx, item, source)
 }
 
+// searchSeasonPackInRun searches for a season pack as one item of a season search.
+func (s *Service) searchSeasonPackInRun(ctx context.Context, run *seriesSearchRun, series *sqlc.Series, seasonNumber int, source SearchSource) (*SearchResult, error) {
+	return run.search(MediaTypeSeason, series.ID, seasonLabel(series.Title, seasonNumber), func() (*SearchResult, error) {
+		return s.searchSeasonPack(ctx, series, seasonNumber, source)
+	})
+}
+
 // SearchSeasonUpgrade searches for a season pack upgrade, falling back to individual episodes.
 func (s *Service) SearchSeasonUpgrade(ctx context.Context, seriesID int64, seasonNumber, currentQualityID int, source SearchSource) (*BatchSearchResult, error) {
 	series, err := s.getSeriesForSearch(ctx, seriesID)
@@ -338,6 +422,10 @@ func (s *Service) SearchSeasonUpgrade(ctx context.Context, seriesID int64, seaso
 		return nil, err
 	}
 
+	run := s.startSeriesSearch(series, &seasonNumber, source)
+	defer run.finish()
+	run.setTotal(1)
+
 	baseItem := s.seriesToSeasonPackItem(series, seasonNumber)
 	// Wrap with upgrade quality info
 	qid := int64(currentQualityID)
@@ -352,7 +440,9 @@ func (s *Service) SearchSeasonUpgrade(ctx context.Context, seriesID int64, seaso
 		baseItem.GetSearchParams(),
 	)
 
-	packResult, err := s.searchAndGrab(ctx, item, source)
+	packResult, err := run.search(MediaTypeSeason, series.ID, seasonLabel(series.Title, seasonNumber), func() (*SearchResult, error) {
+		return s.searchAndGrab(ctx, item, source)
+	})
 	if err == nil && packResult.Downloaded {
 		return &BatchSearchResult{
 			TotalSearched: 1,
@@ -368,7 +458,7 @@ func (s *Service) SearchSeasonUpgrade(ctx context.Context, seriesID int64, seaso
 		Msg("No season pack found for upgrade, falling back to individual episodes")
 
 	episodes, err := s.getUpgradableEpisodesForSeason(ctx, seriesID, seasonNumber)
-	if err != nil || len(episodes) == 0 {
+	if err != nil || len(episodes) == 0 || run.cancelled() {
 		result := &BatchSearchResult{TotalSearched: 1, Results: []*SearchResult{}}
 		if packResult != nil {
 			result.Results = append(result.Results, packResult)
@@ -376,18 +466,25 @@ func (s *Service) SearchSeasonUpgrade(ctx context.Context, seriesID int64, seaso
 		return result, nil
 	}
 
-	return s.searchUpgradableEpisodesIndividually(ctx, episodes, source), nil
+	run.setTotal(1 + len(episodes))
+	return s.searchUpgradableEpisodesIndividually(ctx, run, episodes, series.Title, source), nil
 }
 
 // searchUpgradableEpisodesIndividually searches upgradable episodes individually.
-func (s *Service) searchUpgradableEpisodesIndividually(ctx context.Context, episodes []*sqlc.Episode, source SearchSource) *BatchSearchResult {
+func (s *Service) searchUpgradableEpisodesIndividually(ctx context.Context, run *seriesSearchRun, episodes []*sqlc.Episode, seriesTitle string, source SearchSource) *BatchSearchResult {
 	result := &BatchSearchResult{
 		TotalSearched: len(episodes),
 		Results:       make([]*SearchResult, 0, len(episodes)),
 	}
 
 	for _, ep := range episodes {
-		epResult, epErr := s.SearchEpisode(ctx, ep.ID, source)
+		if run.cancelled() {
+			result.markCancelled()
+			break
+		}
+		epResult, epErr := run.search(MediaTypeEpisode, ep.ID, episodeLabel(seriesTitle, ep), func() (*SearchResult, error) {
+			return s.SearchEpisode(ctx, ep.ID, source)
+		})
 		if epErr != nil {
 			result.Failed++
 			result.Results = append(result.Results, &SearchResult{Error: epErr.Error()})
@@ -464,14 +561,21 @@ func (s *Service) buildSeriesSearchItems(ctx context.Context, series *sqlc.Serie
 }
 
 // executeSeriesSearch searches all items and collects results.
-func (s *Service) executeSeriesSearch(ctx context.Context, items []SearchableItem, source SearchSource) *BatchSearchResult {
+func (s *Service) executeSeriesSearch(ctx context.Context, run *seriesSearchRun, items []SearchableItem, source SearchSource) *BatchSearchResult {
 	result := &BatchSearchResult{
 		TotalSearched: len(items),
 		Results:       make([]*SearchResult, 0, len(items)),
 	}
 
+	run.setTotal(len(items))
 	for _, item := range items {
-		searchResult, err := s.searchAndGrab(ctx, item, source)
+		if run.cancelled() {
+			result.markCancelled()
+			break
+		}
+		searchResult, err := run.search(MediaType(item.GetMediaType()), item.GetEntityID(), itemLabel(item), func() (*SearchResult, error) {
+			return s.searchAndGrab(ctx, item, source)
+		})
 		if err != nil {
 			s.logger.Warn().Err(err).
 				Str("mediaType", item.GetMediaType()).
@@ -505,10 +609,13 @@ func (s *Service) SearchSeries(ctx context.Context, seriesID int64, source Searc
 		return nil, fmt.Errorf("failed to get missing episodes: %w", err)
 	}
 
+	run := s.startSeriesSearch(series, nil, source)
+	defer run.finish()
+
 	seasonEpisodes := groupEpisodesBySeason(episodes)
 	items := s.buildSeriesSearchItems(ctx, series, seasonEpisodes)
 
-	return s.executeSeriesSearch(ctx, items, source), nil
+	return s.executeSeriesSearch(ctx, run, items, source), nil
 }
 
 // searchAndGrab is the core function that searches for a release and grabs the best one.
@@ -519,7 +626,7 @@ func (s *Service) searchAndGrab(_ctx context.Context, item SearchableItem, sourc
 	searchKey := fmt.Sprintf("%s:%d", mediaType, mediaID)
 
 	// Register this search and get a cancellable context
-	searchCtx, cancel := s.registerSearch(searchKey)
+	searchCtx, cancel := s.registerSearch(searchKey, item, source)
 	defer s.unregisterSearch(searchKey, cancel)
 
 	// Broadcast search started
@@ -541,14 +648,16 @@ func (s *Service) searchAndGrab(_ctx context.Context, item SearchableItem, sourc
 	}
 
 	// Build search criteria
-	criteria := s.buildSearchCriteria(item)
+	criteria := s.buildSearchCriteria(searchCtx, item)
 
 	// Build scoring parameters
 	scoringParams := search.ScoredSearchParams{
-		QualityProfile: profile,
-		SearchYear:     module.ItemYear(item),
-		SearchSeason:   module.ItemSeasonNumber(item),
-		SearchEpisode:  module.ItemEpisodeNumber(item),
+		QualityProfile:    profile,
+		SearchYear:        module.ItemYear(item),
+		SearchSeason:      module.ItemSeasonNumber(item),
+		SearchEpisode:     module.ItemEpisodeNumber(item),
+		SearchAbsolute:    criteria.AbsoluteEpisode,
+		PreferredLanguage: s.languageProfileForItem(searchCtx, item).PreferredLanguage(),
 	}
 
 	// Execute search and select best release
@@ -594,7 +703,7 @@ func (s *Service) findBestRelease(ctx context.Context, item SearchableItem, crit
 		return nil, result, nil
 	}
 
-	bestRelease := s.selectBestRelease(searchResult.Releases, profile, item)
+	bestRelease := s.selectBestRelease(ctx, searchResult.Releases, profile, item)
 	if bestRelease == nil {
 		s.logger.Debug().Str("title", item.GetTitle()).Msg("No acceptable releases found")
 		result := &SearchResult{Found: false}
@@ -615,6 +724,23 @@ func (s *Service) findBestRelease(ctx context.Context, item SearchableItem, crit
 func (s *Service) grabAndReport(ctx context.Context, item SearchableItem, bestRelease *types.TorrentInfo, source SearchSource, isUpgrade bool) (*SearchResult, error) {
 	grabReq := s.buildGrabRequest(item, bestRelease)
 	grabResult, err := s.grabService.Grab(ctx, grabReq)
+	if errors.Is(err, grab.ErrAutoGrabCapReached) {
+		s.DeferGrab(item)
+		result := &SearchResult{Found: true, Deferred: true, Release: bestRelease, Error: grabResult.Error}
+		s.broadcastCompleted(item, result)
+		return result, nil
+	}
+	if errors.Is(err, grab.ErrLowFreeSpace) {
+		// Not a search failure: the item is searched again once space is freed
+		result := &SearchResult{Found: true, Release: bestRelease, Error: grabResult.Error}
+		s.broadcastCompleted(item, result)
+		return result, nil
+	}
+	if errors.Is(err, grab.ErrPendingApproval) {
+		result := &SearchResult{Found: true, PendingApproval: true, Release: bestRelease}
+		s.broadcastCompleted(item, result)
+		return result, nil
+	}
 	if err != nil {
 		s.broadcastFailed(item, err.Error())
 		s.logAutoSearchFailed(ctx, item, source, err.Error())
@@ -660,6 +786,7 @@ func (s *Service) buildGrabRequest(item SearchableItem, bestRelease *types.Torre
 		TargetSlotID: module.ItemTargetSlotID(item),
 		Source:       "auto-search",
 	}
+	req.SetScore(bestRelease)
 	if mediaType == string(MediaTypeSeason) {
 		req.IsSeasonPack = true
 		parsed := scanner.ParseFilename(bestRelease.Title)
@@ -670,21 +797,64 @@ func (s *Service) buildGrabRequest(item SearchableItem, bestRelease *types.Torre
 	return req
 }
 
+// activeSearch tracks a running search so it can be cancelled and listed.
+type activeSearch struct {
+	cancel context.CancelFunc
+	info   ActiveSearch
+}
+
+// ActiveSearch describes a search that is currently running.
+type ActiveSearch struct {
+	MediaType string       `json:"mediaType"`
+	MediaID   int64        `json:"mediaId"`
+	Title     string       `json:"title"`
+	Source    SearchSource `json:"source"`
+	StartedAt time.Time    `json:"startedAt"`
+}
+
 // registerSearch registers an active search and returns a cancellable context.
-func (s *Service) registerSearch(key string) (context.Context, context.CancelFunc) {
+func (s *Service) registerSearch(key string, item SearchableItem, source SearchSource) (context.Context, context.CancelFunc) {
+	return s.registerActive(key, ActiveSearch{
+		MediaType: item.GetMediaType(),
+		MediaID:   item.GetEntityID(),
+		Title:     item.GetTitle(),
+		Source:    source,
+		StartedAt: time.Now(),
+	})
+}
+
+// registerActive tracks a search under key, cancelling any search already
+// running under it, and returns a cancellable context for it.
+func (s *Service) registerActive(key string, info ActiveSearch) (context.Context, context.CancelFunc) {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
 	// Cancel any existing search for this item
-	if existingCancel, exists := s.activeSearches[key]; exists {
-		existingCancel()
+	if existing, exists := s.activeSearches[key]; exists {
+		existing.cancel()
 	}
 
 	ctx, cancel := context.WithCancel(context.Background())
-	s.activeSearches[key] = cancel
+	s.activeSearches[key] = &activeSearch{
+		cancel: cancel,
+		info:   info,
+	}
 	return ctx, cancel
 }
 
+// ActiveSearches returns the searches currently running, oldest first.
+func (s *Service) ActiveSearches() []ActiveSearch {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	searches := make([]ActiveSearch, 0, len(s.activeSearches))
+	for _, a := range s.activeSearches {
+		searches = append(searches, a.info)
+	}
+	sort.Slice(searches, func(i, j int) bool { return searches[i].StartedAt.Before(searches[j].StartedAt) })
+	return searches
+}
+
 // unregisterSearch removes a search from active tracking.
 func (s *Service) unregisterSearch(key string, _ context.CancelFunc) {
 	s.mu.Lock()
@@ -700,8 +870,8 @@ func (s *Service) CancelSearch(mediaType MediaType, mediaID int64) bool {
 	defer s.mu.Unlock()
 
 	key := fmt.Sprintf("%s:%d", mediaType, mediaID)
-	if cancel, exists := s.activeSearches[key]; exists {
-		cancel()
+	if existing, exists := s.activeSearches[key]; exists {
+		existing.cancel()
 		delete(s.activeSearches, key)
 		return true
 	}
@@ -765,17 +935,28 @@ func (s *Service) moduleTypeForEntity(entityType string) string {
 }
 
 // buildSearchCriteria creates search criteria from a searchable item.
-func (s *Service) buildSearchCriteria(item SearchableItem) types.SearchCriteria {
+func (s *Service) buildSearchCriteria(ctx context.Context, item SearchableItem) types.SearchCriteria {
+	criteria := types.SearchCriteria{Query: item.GetTitle()}
 	if s.registry != nil {
-		return s.buildSearchCriteriaFromModule(item)
+		criteria = s.buildSearchCriteriaFromModule(item)
+	}
+	criteria.Tags = s.itemTags(ctx, item.GetMediaType(), item.GetEntityID(), module.ItemSeriesID(item))
+	return criteria
+}
+
+// itemTags returns the tags of the item being searched for, or nil if no tag
+// store is configured.
+func (s *Service) itemTags(ctx context.Context, mediaType string, mediaID, seriesID int64) []int64 {
+	if s.tagStore == nil {
+		return nil
 	}
-	return types.SearchCriteria{Query: item.GetTitle()}
+	return s.tagStore.ItemTags(ctx, mediaType, mediaID, seriesID)
 }
 
 // selectBestRelease selects the best release from scored results.
 // Delegates to the shared decisioning.SelectBestRelease.
-func (s *Service) selectBestRelease(releases []types.TorrentInfo, profile *quality.Profile, item SearchableItem) *types.TorrentInfo {
-	strategy := s.strategyForItem(item)
+func (s *Service) selectBestRelease(ctx context.Context, releases []types.TorrentInfo, profile *quality.Profile, item SearchableItem) *types.TorrentInfo {
+	strategy := decisioning.WithLanguageProfile(s.strategyForItem(item), s.languageProfileForItem(ctx, item))
 	parser := s.releaseParser()
 	return decisioning.SelectBestRelease(releases, profile, item, strategy, parser, s.logger)
 }
@@ -790,6 +971,28 @@ func (s *Service) strategyForItem(item SearchableItem) module.SearchStrategy {
 	return decisioning.DefaultStrategy{}
 }
 
+// languageProfileForItem returns the language profile of the item's movie or series,
+// or nil when none is assigned or the lookup fails.
+func (s *Service) languageProfileForItem(ctx context.Context, item SearchableItem) *language.Profile {
+	if s.languageService == nil {
+		return nil
+	}
+
+	var profile *language.Profile
+	var err error
+	if item.GetMediaType() == string(MediaTypeMovie) {
+		profile, err = s.languageService.ForMovie(ctx, item.GetEntityID())
+	} else {
+		profile, err = s.languageService.ForSeries(ctx, module.ItemSeriesID(item))
+	}
+	if err != nil {
+		s.logger.Warn().Err(err).Str("mediaType", item.GetMediaType()).Int64("mediaId", item.GetEntityID()).
+			Msg("Failed to get language profile")
+		return nil
+	}
+	return profile
+}
+
 // releaseParser returns a function that converts release titles to ReleaseForFilter.
 func (s *Service) releaseParser() decisioning.ReleaseParser {
 	if s.registry != nil {
@@ -902,7 +1105,7 @@ func (s *Service) getMissingEpisodesForSeries(ctx context.Context, seriesID int6
 
 // isSeasonPackEligible checks if a season pack search should be used.
 func (s *Service) isSeasonPackEligible(ctx context.Context, seriesID int64, seasonNumber int) bool {
-	return decisioning.IsSeasonPackEligible(ctx, s.queries, s.logger, seriesID, seasonNumber)
+	return decisioning.IsSeasonPackEligible(ctx, s.queries, s.logger, seriesID, seasonNumber, s.seasonPackMissingPercent())
 }
 
 func (s *Service) isSeasonPackUpgradeEligible(ctx context.Context, seriesID int64, seasonNumber int) bool {
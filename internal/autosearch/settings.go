@@ -12,6 +12,9 @@ import (
 
 	"github.com/slipstream/slipstream/internal/config"
 	"github.com/slipstream/slipstream/internal/database/sqlc"
+	"github.com/slipstream/slipstream/internal/decisioning"
+	"github.com/slipstream/slipstream/internal/indexer/grab"
+	"github.com/slipstream/slipstream/internal/library/status"
 	"github.com/slipstream/slipstream/internal/scheduler"
 )
 
@@ -19,9 +22,67 @@ const settingsKey = "autosearch_settings"
 
 // Settings represents user-configurable autosearch settings.
 type Settings struct {
-	Enabled          bool `json:"enabled"`
-	IntervalHours    int  `json:"intervalHours"`
-	BackoffThreshold int  `json:"backoffThreshold"`
+	Enabled           bool  `json:"enabled"`
+	IntervalHours     int   `json:"intervalHours"`
+	BackoffThreshold  int   `json:"backoffThreshold"`
+	BatchSize         int   `json:"batchSize"`
+	BatchPauseSeconds int   `json:"batchPauseSeconds"`
+	BatchSmartListID  int64 `json:"batchSmartListId"` // 0 searches the whole library
+	MaxGrabsPerHour   int   `json:"maxGrabsPerHour"`  // 0 disables the cap
+	MaxGrabsPerDay    int   `json:"maxGrabsPerDay"`   // 0 disables the cap
+
+	PauseGrabsBelowFreeSpaceGB float64 `json:"pauseGrabsBelowFreeSpaceGb"` // 0 disables the guard
+	GrabFreeSpaceBufferGB      float64 `json:"grabFreeSpaceBufferGb"`      // Kept free in the target root folder
+
+	GrabApprovalMode   string  `json:"grabApprovalMode"`   // off, all, or tagged
+	GrabApprovalTagIDs []int64 `json:"grabApprovalTagIds"` // Items held in tagged mode
+
+	MinimumAvailability string `json:"minimumAvailability"` // Default for items without their own setting
+
+	SlotAutoFill bool `json:"slotAutoFill"` // Search each version slot with its own profile
+
+	SeasonPackMissingPercent int `json:"seasonPackMissingPercent"` // Missing share of a season that prefers a pack
+
+	UpgradeWindowStartHour int     `json:"upgradeWindowStartHour"` // Cutoff unmet search window, local hour
+	UpgradeWindowEndHour   int     `json:"upgradeWindowEndHour"`   // Equal to the start hour for no window
+	UpgradeTagIDs          []int64 `json:"upgradeTagIds"`          // Empty searches every item
+}
+
+const (
+	maxBatchSize                = 500
+	maxBatchPauseSeconds        = 3600
+	minSeasonPackMissingPercent = 50
+)
+
+// settingsFromConfig returns the settings currently held in config. Saved settings are
+// unmarshalled over these so fields added after they were saved keep their defaults.
+func settingsFromConfig(cfg *config.AutoSearchConfig) Settings {
+	return Settings{
+		Enabled:           cfg.Enabled,
+		IntervalHours:     cfg.IntervalHours,
+		BackoffThreshold:  cfg.BackoffThreshold,
+		BatchSize:         cfg.BatchSize,
+		BatchPauseSeconds: cfg.BatchPauseSeconds,
+		BatchSmartListID:  cfg.BatchSmartListID,
+		MaxGrabsPerHour:   cfg.MaxGrabsPerHour,
+		MaxGrabsPerDay:    cfg.MaxGrabsPerDay,
+
+		PauseGrabsBelowFreeSpaceGB: cfg.PauseGrabsBelowFreeSpaceGB,
+		GrabFreeSpaceBufferGB:      cfg.GrabFreeSpaceBufferGB,
+
+		GrabApprovalMode:   cfg.GrabApprovalMode,
+		GrabApprovalTagIDs: cfg.GrabApprovalTagIDs,
+
+		MinimumAvailability: cfg.MinimumAvailability,
+
+		SlotAutoFill: cfg.SlotAutoFill,
+
+		SeasonPackMissingPercent: cfg.SeasonPackMissingPercent,
+
+		UpgradeWindowStartHour: cfg.UpgradeWindowStartHour,
+		UpgradeWindowEndHour:   cfg.UpgradeWindowEndHour,
+		UpgradeTagIDs:          cfg.UpgradeTagIDs,
+	}
 }
 
 // ScheduleUpdater is a function that updates the autosearch task schedule.
@@ -84,6 +145,51 @@ func (h *SettingsHandler) UpdateSettings(c echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, "backoffThreshold must be at least 1")
 	}
 
+	if input.BatchSize < 1 || input.BatchSize > maxBatchSize {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("batchSize must be between 1 and %d", maxBatchSize))
+	}
+	if input.BatchPauseSeconds < 0 || input.BatchPauseSeconds > maxBatchPauseSeconds {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("batchPauseSeconds must be between 0 and %d", maxBatchPauseSeconds))
+	}
+	if input.BatchSmartListID < 0 {
+		return echo.NewHTTPError(http.StatusBadRequest, "batchSmartListId must not be negative")
+	}
+	if input.MaxGrabsPerHour < 0 || input.MaxGrabsPerDay < 0 {
+		return echo.NewHTTPError(http.StatusBadRequest, "grab caps must not be negative")
+	}
+	if input.PauseGrabsBelowFreeSpaceGB < 0 {
+		return echo.NewHTTPError(http.StatusBadRequest, "pauseGrabsBelowFreeSpaceGb must not be negative")
+	}
+	if input.GrabFreeSpaceBufferGB < 0 {
+		return echo.NewHTTPError(http.StatusBadRequest, "grabFreeSpaceBufferGb must not be negative")
+	}
+	switch input.GrabApprovalMode {
+	case "":
+		input.GrabApprovalMode = grab.ApprovalOff
+	case grab.ApprovalOff, grab.ApprovalAll, grab.ApprovalTagged:
+	default:
+		return echo.NewHTTPError(http.StatusBadRequest, "grabApprovalMode must be off, all, or tagged")
+	}
+	if input.GrabApprovalMode == grab.ApprovalTagged && len(input.GrabApprovalTagIDs) == 0 {
+		return echo.NewHTTPError(http.StatusBadRequest, "grabApprovalTagIds is required in tagged mode")
+	}
+	if input.MinimumAvailability == "" {
+		input.MinimumAvailability = status.DefaultAvailability
+	} else if !status.ValidAvailability(input.MinimumAvailability) {
+		return echo.NewHTTPError(http.StatusBadRequest, "minimumAvailability must be announced, in_cinemas, released, or physical")
+	}
+
+	if input.SeasonPackMissingPercent == 0 {
+		input.SeasonPackMissingPercent = decisioning.DefaultSeasonPackMissingPercent
+	} else if input.SeasonPackMissingPercent < minSeasonPackMissingPercent || input.SeasonPackMissingPercent > decisioning.DefaultSeasonPackMissingPercent {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("seasonPackMissingPercent must be between %d and %d", minSeasonPackMissingPercent, decisioning.DefaultSeasonPackMissingPercent))
+	}
+
+	if input.UpgradeWindowStartHour < 0 || input.UpgradeWindowStartHour > 23 ||
+		input.UpgradeWindowEndHour < 0 || input.UpgradeWindowEndHour > 23 {
+		return echo.NewHTTPError(http.StatusBadRequest, "upgrade window hours must be between 0 and 23")
+	}
+
 	// Save to database
 	if err := h.saveSettings(ctx, &input); err != nil {
 		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
@@ -93,6 +199,21 @@ func (h *SettingsHandler) UpdateSettings(c echo.Context) error {
 	h.config.Enabled = input.Enabled
 	h.config.IntervalHours = input.IntervalHours
 	h.config.BackoffThreshold = input.BackoffThreshold
+	h.config.BatchSize = input.BatchSize
+	h.config.BatchPauseSeconds = input.BatchPauseSeconds
+	h.config.BatchSmartListID = input.BatchSmartListID
+	h.config.MaxGrabsPerHour = input.MaxGrabsPerHour
+	h.config.MaxGrabsPerDay = input.MaxGrabsPerDay
+	h.config.PauseGrabsBelowFreeSpaceGB = input.PauseGrabsBelowFreeSpaceGB
+	h.config.GrabFreeSpaceBufferGB = input.GrabFreeSpaceBufferGB
+	h.config.GrabApprovalMode = input.GrabApprovalMode
+	h.config.GrabApprovalTagIDs = input.GrabApprovalTagIDs
+	h.config.MinimumAvailability = input.MinimumAvailability
+	h.config.SlotAutoFill = input.SlotAutoFill
+	h.config.SeasonPackMissingPercent = input.SeasonPackMissingPercent
+	h.config.UpgradeWindowStartHour = input.UpgradeWindowStartHour
+	h.config.UpgradeWindowEndHour = input.UpgradeWindowEndHour
+	h.config.UpgradeTagIDs = input.UpgradeTagIDs
 
 	// Update the scheduler task dynamically
 	if h.scheduler != nil && h.scheduleUpdater != nil {
@@ -112,14 +233,11 @@ func (h *SettingsHandler) loadSettings(ctx context.Context) (*Settings, error) {
 			return nil, err
 		}
 		// No saved settings, return config defaults
-		return &Settings{
-			Enabled:          h.config.Enabled,
-			IntervalHours:    h.config.IntervalHours,
-			BackoffThreshold: h.config.BackoffThreshold,
-		}, nil
+		settings := settingsFromConfig(h.config)
+		return &settings, nil
 	}
 
-	var settings Settings
+	settings := settingsFromConfig(h.config)
 	if err := json.Unmarshal([]byte(row.Value), &settings); err != nil {
 		return nil, fmt.Errorf("failed to unmarshal settings: %w", err)
 	}
@@ -152,7 +270,7 @@ func LoadSettingsIntoConfig(ctx context.Context, queries *sqlc.Queries, cfg *con
 		return nil
 	}
 
-	var settings Settings
+	settings := settingsFromConfig(cfg)
 	if err := json.Unmarshal([]byte(row.Value), &settings); err != nil {
 		return fmt.Errorf("failed to unmarshal settings: %w", err)
 	}
@@ -161,6 +279,21 @@ func LoadSettingsIntoConfig(ctx context.Context, queries *sqlc.Queries, cfg *con
 	cfg.Enabled = settings.Enabled
 	cfg.IntervalHours = settings.IntervalHours
 	cfg.BackoffThreshold = settings.BackoffThreshold
+	cfg.BatchSize = settings.BatchSize
+	cfg.BatchPauseSeconds = settings.BatchPauseSeconds
+	cfg.BatchSmartListID = settings.BatchSmartListID
+	cfg.MaxGrabsPerHour = settings.MaxGrabsPerHour
+	cfg.MaxGrabsPerDay = settings.MaxGrabsPerDay
+	cfg.PauseGrabsBelowFreeSpaceGB = settings.PauseGrabsBelowFreeSpaceGB
+	cfg.GrabFreeSpaceBufferGB = settings.GrabFreeSpaceBufferGB
+	cfg.GrabApprovalMode = settings.GrabApprovalMode
+	cfg.GrabApprovalTagIDs = settings.GrabApprovalTagIDs
+	cfg.MinimumAvailability = settings.MinimumAvailability
+	cfg.SlotAutoFill = settings.SlotAutoFill
+	cfg.SeasonPackMissingPercent = settings.SeasonPackMissingPercent
+	cfg.UpgradeWindowStartHour = settings.UpgradeWindowStartHour
+	cfg.UpgradeWindowEndHour = settings.UpgradeWindowEndHour
+	cfg.UpgradeTagIDs = settings.UpgradeTagIDs
 
 	return nil
 }
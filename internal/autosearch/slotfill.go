@@ -0,0 +1,98 @@
+package autosearch
+
+import (
+	"context"
+
+	"github.com/slipstream/slipstream/internal/module"
+)
+
+// slotAutoFill reports whether scheduled searches fill each version slot with
+// its own quality profile instead of searching once per item.
+func (s *ScheduledSearcher) slotAutoFill(ctx context.Context) bool {
+	return s.config.SlotAutoFill && s.service.slotsService != nil && s.service.slotsService.IsMultiVersionEnabled(ctx)
+}
+
+// collectSlotFillItems returns one searchable item per monitored slot that is
+// empty or below its profile's cutoff. Each item targets its slot, so the
+// search uses the slot's profile and the grab is imported into that slot.
+func (s *ScheduledSearcher) collectSlotFillItems(ctx context.Context) ([]SearchableItem, error) {
+	var items []searchableItemWithPriority
+
+	movieSlots, err := s.service.queries.ListMovieSlotsToFill(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, row := range movieSlots {
+		items = s.appendSlotFillItem(ctx, items, module.TypeMovie, string(MediaTypeMovie), row.MovieID, row.Title, row.SlotID, row.HasFile != 0)
+	}
+
+	episodeSlots, err := s.service.queries.ListEpisodeSlotsToFill(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, row := range episodeSlots {
+		items = s.appendSlotFillItem(ctx, items, module.TypeTV, string(MediaTypeEpisode), row.EpisodeID, row.Title, row.SlotID, row.HasFile != 0)
+	}
+
+	// Rows arrive newest first; the stable sort only moves requested titles ahead
+	s.sortByPriority(ctx, items)
+
+	result := make([]SearchableItem, len(items))
+	for i := range items {
+		result[i] = items[i].item
+	}
+	return result, nil
+}
+
+func (s *ScheduledSearcher) appendSlotFillItem(
+	ctx context.Context,
+	items []searchableItemWithPriority,
+	moduleType module.Type,
+	mediaType string,
+	entityID int64,
+	title string,
+	slotID int64,
+	hasFile bool,
+) []searchableItemWithPriority {
+	searchType := statusMissing
+	var currentQID *int64
+	if hasFile {
+		searchType = searchTypeUpgrade
+		qid := int64(0) // The slot search looks up the slot's own file quality
+		currentQID = &qid
+	}
+
+	shouldSkip, err := s.shouldSkipItem(ctx, mediaType, entityID, searchType)
+	if err != nil {
+		s.logger.Warn().Err(err).
+			Str("entityType", mediaType).
+			Int64("entityId", entityID).
+			Msg("Failed to check backoff status for slot item")
+	}
+	if shouldSkip {
+		return items
+	}
+
+	item := module.NewWantedItem(moduleType, mediaType, entityID, title, nil, 0, currentQID,
+		module.SearchParams{Extra: map[string]any{"targetSlotId": &slotID}})
+	return append(items, searchableItemWithPriority{item: item})
+}
+
+// searchSlotItem runs the slot-specific search for an item collected by
+// collectSlotFillItems.
+func (s *ScheduledSearcher) searchSlotItem(ctx context.Context, item SearchableItem, slotID int64) (*SearchResult, error) {
+	var result *SlotSearchResult
+	var err error
+	switch item.GetMediaType() {
+	case string(MediaTypeMovie):
+		result, err = s.service.SearchMovieSlot(ctx, item.GetEntityID(), slotID, SearchSourceScheduled)
+	case string(MediaTypeEpisode):
+		result, err = s.service.SearchEpisodeSlot(ctx, item.GetEntityID(), slotID, SearchSourceScheduled)
+	default:
+		return &SearchResult{Error: "unsupported media type"}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &result.SearchResult, nil
+}
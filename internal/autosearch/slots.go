@@ -2,6 +2,7 @@ package autosearch
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"sync"
 
@@ -61,7 +62,7 @@ func (s *Service) SearchMovieSlot(ctx context.Context, movieID, slotID int64, so
 
 	// Build searchable item with slot's quality profile
 	baseItem := s.movieToSearchableItem(ctx, movie)
-	item := module.CloneWithSlotOverrides(baseItem, slotInfo.QualityProfileID, &slotID)
+	item := module.CloneWithSlotOverrides(baseItem, slotInfo.QualityProfileID, &slotID, slotCurrentQualityID(slotInfo))
 
 	// Use existing search infrastructure
 	result, err := s.searchAndGrab(ctx, item, source)
@@ -104,7 +105,7 @@ func (s *Service) SearchEpisodeSlot(ctx context.Context, episodeID, slotID int64
 
 	// Build searchable item with slot's quality profile
 	baseItem := s.episodeToSearchableItem(ctx, episode, series)
-	item := module.CloneWithSlotOverrides(baseItem, slotInfo.QualityProfileID, &slotID)
+	item := module.CloneWithSlotOverrides(baseItem, slotInfo.QualityProfileID, &slotID, slotCurrentQualityID(slotInfo))
 
 	// Use existing search infrastructure
 	result, err := s.searchAndGrab(ctx, item, source)
@@ -121,6 +122,19 @@ func (s *Service) SearchEpisodeSlot(ctx context.Context, episodeID, slotID int64
 	}, nil
 }
 
+// slotCurrentQualityID returns the quality of the slot's own file, or nil when
+// the slot is empty. A filled slot whose quality is unknown reports 0.
+func slotCurrentQualityID(slot *slots.SlotSearchInfo) *int64 {
+	if !slot.HasFile() {
+		return nil
+	}
+	if slot.CurrentQualityID != nil {
+		return slot.CurrentQualityID
+	}
+	qid := int64(0)
+	return &qid
+}
+
 // SearchMovieSlots searches for all monitored slots that need content for a movie.
 // Req 7.1.1: Search in parallel for multiple empty monitored slots
 // Req 7.1.2: May grab multiple releases simultaneously for different slots
@@ -235,7 +249,7 @@ func (s *Service) searchForSlot(ctx context.Context, movie *sqlc.Movie, slot *sl
 }
 
 func (s *Service) findBestReleaseForMovieSlot(ctx context.Context, movie *sqlc.Movie, profile *quality.Profile, slot *slots.SlotSearchInfo, result *SlotSearchResult) *types.TorrentInfo {
-	criteria := s.buildMovieSearchCriteria(movie)
+	criteria := s.buildMovieSearchCriteria(ctx, movie)
 	searchYear := 0
 	if movie.Year.Valid {
 		searchYear = int(movie.Year.Int64)
@@ -275,10 +289,11 @@ func (s *Service) findBestReleaseForMovieSlot(ctx context.Context, movie *sqlc.M
 	return bestRelease
 }
 
-func (s *Service) buildMovieSearchCriteria(movie *sqlc.Movie) types.SearchCriteria {
+func (s *Service) buildMovieSearchCriteria(ctx context.Context, movie *sqlc.Movie) types.SearchCriteria {
 	criteria := types.SearchCriteria{
 		Query: movie.Title,
 		Type:  "movie",
+		Tags:  s.itemTags(ctx, string(MediaTypeMovie), movie.ID, 0),
 	}
 	if movie.ImdbID.Valid {
 		criteria.ImdbID = movie.ImdbID.String
@@ -300,8 +315,13 @@ func (s *Service) grabReleaseForMovieSlot(ctx context.Context, movie *sqlc.Movie
 		TargetSlotID: &slot.SlotID,
 		Source:       "auto-search",
 	}
+	grabReq.SetScore(bestRelease)
 
 	grabResult, err := s.grabService.Grab(ctx, grabReq)
+	if errors.Is(err, grab.ErrAutoGrabCapReached) || errors.Is(err, grab.ErrLowFreeSpace) || errors.Is(err, grab.ErrPendingApproval) {
+		result.Error = grabResult.Error
+		return
+	}
 	if err != nil {
 		result.Error = fmt.Sprintf("grab failed: %v", err)
 		s.logAutoSearchFailed(ctx, module.NewWantedItem(
@@ -472,6 +492,7 @@ func (s *Service) findBestEpisodeRelease(ctx context.Context, episode *sqlc.Epis
 		Type:    "tvsearch",
 		Season:  int(episode.SeasonNumber),
 		Episode: int(episode.EpisodeNumber),
+		Tags:    s.itemTags(ctx, string(MediaTypeEpisode), episode.ID, series.ID),
 	}
 	if series.TvdbID.Valid {
 		criteria.TvdbID = int(series.TvdbID.Int64)
@@ -501,8 +522,13 @@ func (s *Service) grabEpisodeForSlot(ctx context.Context, result *SlotSearchResu
 		TargetSlotID: &slot.SlotID,
 		Source:       "auto-search",
 	}
+	grabReq.SetScore(bestRelease)
 
 	grabResult, err := s.grabService.Grab(ctx, grabReq)
+	if errors.Is(err, grab.ErrPendingApproval) {
+		result.Error = grabResult.Error
+		return
+	}
 	if err != nil {
 		result.Error = fmt.Sprintf("grab failed: %v", err)
 		return
@@ -48,6 +48,9 @@ type SearchResult struct {
 	Upgraded   bool               `json:"upgraded"`             // Was this a quality upgrade?
 	ClientName string             `json:"clientName,omitempty"` // Name of download client used
 	DownloadID string             `json:"downloadId,omitempty"` // Download client's ID for the download
+	Deferred   bool               `json:"deferred,omitempty"`   // Grab postponed by a grab cap; retried later
+
+	PendingApproval bool `json:"pendingApproval,omitempty"` // Grab held until an admin approves it
 }
 
 // BatchSearchResult contains results from searching multiple items.
@@ -57,6 +60,14 @@ type BatchSearchResult struct {
 	Downloaded    int             `json:"downloaded"`
 	Failed        int             `json:"failed"`
 	Results       []*SearchResult `json:"results,omitempty"`
+	Cancelled     bool            `json:"cancelled,omitempty"` // Stopped before every item was searched
+}
+
+// markCancelled records that the batch stopped early, so TotalSearched counts
+// only the items actually searched.
+func (r *BatchSearchResult) markCancelled() {
+	r.Cancelled = true
+	r.TotalSearched = len(r.Results)
 }
 
 // RetryResult contains the outcome of a manual retry operation.
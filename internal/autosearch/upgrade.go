@@ -0,0 +1,81 @@
+package autosearch
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/slipstream/slipstream/internal/domain/contracts"
+	"github.com/slipstream/slipstream/internal/module"
+	"github.com/slipstream/slipstream/internal/tags"
+)
+
+// SetTagStore sets the tag store used to scope the cutoff unmet search to tagged items.
+func (s *ScheduledSearcher) SetTagStore(store contracts.TagStore) {
+	s.tagStore = store
+}
+
+// inHourWindow reports whether now falls in the daily window from startHour up
+// to endHour, local time. The window may wrap past midnight; equal hours mean
+// the window is always open.
+func inHourWindow(now time.Time, startHour, endHour int) bool {
+	if startHour == endHour {
+		return true
+	}
+	hour := now.Hour()
+	if startHour < endHour {
+		return hour >= startHour && hour < endHour
+	}
+	return hour >= startHour || hour < endHour
+}
+
+// inUpgradeWindow reports whether the cutoff unmet search may run now.
+func (s *ScheduledSearcher) inUpgradeWindow(now time.Time) bool {
+	return inHourWindow(now, s.config.UpgradeWindowStartHour, s.config.UpgradeWindowEndHour)
+}
+
+// outsideTaskWindow reports whether a batch task has to stop because its
+// time window has closed. Only the cutoff unmet search has a window.
+func (s *ScheduledSearcher) outsideTaskWindow(task BatchTask) bool {
+	return task == BatchTaskCutoffUnmet && !s.inUpgradeWindow(time.Now())
+}
+
+// itemsWithUpgradeTags keeps items sharing one of the configured upgrade tags.
+// Movies use their own tags; episodes and seasons use their series' tags.
+func (s *ScheduledSearcher) itemsWithUpgradeTags(ctx context.Context, items []SearchableItem) ([]SearchableItem, error) {
+	if len(s.config.UpgradeTagIDs) == 0 || s.tagStore == nil {
+		return items, nil
+	}
+
+	movieTags, err := s.tagStore.EntityTagMap(ctx, tags.EntityMovie)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load movie tags: %w", err)
+	}
+	seriesTags, err := s.tagStore.EntityTagMap(ctx, tags.EntitySeries)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load series tags: %w", err)
+	}
+
+	filtered := make([]SearchableItem, 0, len(items))
+	for _, item := range items {
+		itemTags := seriesTags[module.ItemSeriesID(item)]
+		if item.GetMediaType() == string(MediaTypeMovie) {
+			itemTags = movieTags[item.GetEntityID()]
+		}
+		if sharesAnyTag(s.config.UpgradeTagIDs, itemTags) {
+			filtered = append(filtered, item)
+		}
+	}
+	return filtered, nil
+}
+
+func sharesAnyTag(wanted, have []int64) bool {
+	for _, w := range wanted {
+		for _, h := range have {
+			if w == h {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,84 @@
+package autosearch
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+
+	"github.com/slipstream/slipstream/internal/config"
+	"github.com/slipstream/slipstream/internal/domain/contracts"
+	"github.com/slipstream/slipstream/internal/module"
+	"github.com/slipstream/slipstream/internal/tags"
+)
+
+func TestInHourWindow(t *testing.T) {
+	at := func(hour int) time.Time {
+		return time.Date(2026, 1, 1, hour, 30, 0, 0, time.Local)
+	}
+	tests := []struct {
+		name       string
+		start, end int
+		hour       int
+		want       bool
+	}{
+		{"no window", 3, 3, 14, true},
+		{"inside", 1, 6, 3, true},
+		{"at end", 1, 6, 6, false},
+		{"before start", 1, 6, 0, false},
+		{"wraps, late evening", 22, 5, 23, true},
+		{"wraps, early morning", 22, 5, 4, true},
+		{"wraps, daytime", 22, 5, 12, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := inHourWindow(at(tt.hour), tt.start, tt.end); got != tt.want {
+				t.Errorf("inHourWindow(%d:30, %d, %d) = %v, want %v", tt.hour, tt.start, tt.end, got, tt.want)
+			}
+		})
+	}
+}
+
+type stubTagStore struct {
+	contracts.TagStore
+	byType map[string]map[int64][]int64
+}
+
+func (s stubTagStore) EntityTagMap(_ context.Context, entityType string) (map[int64][]int64, error) {
+	return s.byType[entityType], nil
+}
+
+func TestItemsWithUpgradeTags(t *testing.T) {
+	episode := func(id, seriesID int64) SearchableItem {
+		return module.NewWantedItem(module.TypeTV, "episode", id, "", nil, 0, nil,
+			module.SearchParams{Extra: map[string]any{"seriesId": seriesID}})
+	}
+	items := []SearchableItem{
+		testItem("movie", 1),
+		testItem("movie", 2),
+		episode(10, 100),
+		episode(11, 200),
+	}
+
+	logger := zerolog.Nop()
+	cfg := &config.AutoSearchConfig{UpgradeTagIDs: []int64{7}}
+	s := NewScheduledSearcher(NewService(nil, nil, nil, nil, &logger, nil, nil, nil), cfg, &logger)
+
+	unfiltered, err := s.itemsWithUpgradeTags(context.Background(), items)
+	if err != nil || len(unfiltered) != len(items) {
+		t.Fatalf("without a tag store kept %d items (err %v), want all %d", len(unfiltered), err, len(items))
+	}
+
+	s.SetTagStore(stubTagStore{byType: map[string]map[int64][]int64{
+		tags.EntityMovie:  {2: {7}, 1: {8}},
+		tags.EntitySeries: {200: {3, 7}},
+	}})
+	filtered, err := s.itemsWithUpgradeTags(context.Background(), items)
+	if err != nil {
+		t.Fatalf("itemsWithUpgradeTags() error = %v", err)
+	}
+	if len(filtered) != 2 || filtered[0].GetEntityID() != 2 || filtered[1].GetEntityID() != 11 {
+		t.Errorf("kept %d items, want movie 2 and episode 11", len(filtered))
+	}
+}
@@ -0,0 +1,97 @@
+package backup
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+)
+
+// Handlers provides HTTP handlers for database backups.
+type Handlers struct {
+	service *Service
+	restart func() bool
+}
+
+// NewHandlers creates new backup handlers. restart is called after a restore
+// is staged and reports whether a restart was initiated.
+func NewHandlers(service *Service, restart func() bool) *Handlers {
+	return &Handlers{service: service, restart: restart}
+}
+
+// RegisterRoutes registers the backup routes.
+func (h *Handlers) RegisterRoutes(g *echo.Group) {
+	g.GET("", h.List)
+	g.POST("", h.Create)
+	g.GET("/:name", h.Download)
+	g.DELETE("/:name", h.Delete)
+	g.POST("/:name/restore", h.Restore)
+}
+
+// List returns all backups, newest first.
+// GET /api/v1/system/backup
+func (h *Handlers) List(c echo.Context) error {
+	backups, err := h.service.List()
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	return c.JSON(http.StatusOK, backups)
+}
+
+// Create takes a manual backup.
+// POST /api/v1/system/backup
+func (h *Handlers) Create(c echo.Context) error {
+	b, err := h.service.Create(c.Request().Context(), ReasonManual)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	return c.JSON(http.StatusCreated, b)
+}
+
+// Download streams a backup archive.
+// GET /api/v1/system/backup/:name
+func (h *Handlers) Download(c echo.Context) error {
+	name := c.Param("name")
+	path, err := h.service.Path(name)
+	if err != nil {
+		return backupError(err)
+	}
+	return c.Attachment(path, name)
+}
+
+// Delete removes a backup.
+// DELETE /api/v1/system/backup/:name
+func (h *Handlers) Delete(c echo.Context) error {
+	if err := h.service.Delete(c.Param("name")); err != nil {
+		return backupError(err)
+	}
+	return c.NoContent(http.StatusNoContent)
+}
+
+// Restore validates a backup, stages it and restarts the application to apply it.
+// POST /api/v1/system/backup/:name/restore
+func (h *Handlers) Restore(c echo.Context) error {
+	if err := h.service.Restore(c.Request().Context(), c.Param("name")); err != nil {
+		return backupError(err)
+	}
+
+	if h.restart == nil || !h.restart() {
+		return c.JSON(http.StatusAccepted, map[string]string{
+			"message": "Restore staged, restart SlipStream to apply it",
+		})
+	}
+	return c.JSON(http.StatusOK, map[string]string{
+		"message": "Restore staged, restarting",
+	})
+}
+
+func backupError(err error) error {
+	switch {
+	case errors.Is(err, ErrBackupNotFound):
+		return echo.NewHTTPError(http.StatusNotFound, err.Error())
+	case errors.Is(err, ErrInvalidBackup):
+		return echo.NewHTTPError(http.StatusUnprocessableEntity, err.Error())
+	default:
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+}
@@ -0,0 +1,252 @@
+package backup
+
+import (
+	"archive/zip"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/slipstream/slipstream/internal/database"
+)
+
+const (
+	stagedRestoreSuffix = ".restore"
+	stagedConfigSuffix  = ".restore-config"
+	preRestoreSuffix    = ".pre-restore"
+)
+
+// Restore validates a backup and stages it to replace the live database on
+// the next start, since SQLite cannot be swapped out from under open
+// connections. The backup's config file is staged alongside so both are
+// applied together. The current database is backed up first so the restore
+// can itself be undone. Callers are expected to restart the application.
+func (s *Service) Restore(ctx context.Context, name string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	path, err := s.Path(name)
+	if err != nil {
+		return err
+	}
+
+	// Extract next to the database so the staged file can be renamed into place.
+	tmpDir, err := os.MkdirTemp(filepath.Dir(s.cfg.DBPath), ".restore-")
+	if err != nil {
+		return fmt.Errorf("failed to create restore directory: %w", err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	dbFile, configFile, err := extractArchive(path, tmpDir)
+	if err != nil {
+		return err
+	}
+	if err := ValidateDatabase(ctx, dbFile); err != nil {
+		return err
+	}
+
+	if _, err := s.create(ctx, ReasonRestore); err != nil {
+		return fmt.Errorf("failed to back up current database: %w", err)
+	}
+
+	// The staged database marks the restore as complete, so it goes last.
+	stagedConfig := s.cfg.DBPath + stagedConfigSuffix
+	if configFile != "" && s.cfg.ConfigPath != "" {
+		if err := os.Rename(configFile, stagedConfig); err != nil {
+			return fmt.Errorf("failed to stage config file: %w", err)
+		}
+	} else if err := os.Remove(stagedConfig); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("failed to clear staged config file: %w", err)
+	}
+	if err := os.Rename(dbFile, s.cfg.DBPath+stagedRestoreSuffix); err != nil {
+		return fmt.Errorf("failed to stage restore: %w", err)
+	}
+
+	s.logger.Info().Str("name", name).Msg("Staged database restore, it will be applied on restart")
+	return nil
+}
+
+// ValidateDatabase checks that path is an intact SlipStream database this
+// version can run: it must pass SQLite's integrity check and must not have
+// been migrated past the newest migration this build knows about.
+func ValidateDatabase(ctx context.Context, path string) error {
+	conn, err := sql.Open("sqlite", path)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidBackup, err)
+	}
+	defer conn.Close()
+
+	rows, err := conn.QueryContext(ctx, "PRAGMA integrity_check")
+	if err != nil {
+		return fmt.Errorf("%w: integrity check failed: %w", ErrInvalidBackup, err)
+	}
+	var problems []string
+	for rows.Next() {
+		var result string
+		if err := rows.Scan(&result); err != nil {
+			rows.Close()
+			return fmt.Errorf("%w: integrity check failed: %w", ErrInvalidBackup, err)
+		}
+		if result != "ok" {
+			problems = append(problems, result)
+		}
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("%w: integrity check failed: %w", ErrInvalidBackup, err)
+	}
+	if len(problems) > 0 {
+		return fmt.Errorf("%w: database is corrupt: %s", ErrInvalidBackup, strings.Join(problems, "; "))
+	}
+
+	version, err := database.MigrationVersion(ctx, conn)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidBackup, err)
+	}
+	if version == 0 {
+		return fmt.Errorf("%w: not a SlipStream database", ErrInvalidBackup)
+	}
+	latest, err := database.LatestMigrationVersion()
+	if err != nil {
+		return err
+	}
+	if version > latest {
+		return fmt.Errorf("%w: backup is from a newer version (schema %d, supported %d)", ErrInvalidBackup, version, latest)
+	}
+	return nil
+}
+
+// extractArchive unpacks the database and optional config file from a backup
+// archive into dir.
+func extractArchive(path, dir string) (dbFile, configFile string, err error) {
+	zr, err := zip.OpenReader(path)
+	if err != nil {
+		return "", "", fmt.Errorf("%w: %w", ErrInvalidBackup, err)
+	}
+	defer zr.Close()
+
+	for _, f := range zr.File {
+		// Entries are always written flat; anything else did not come from us.
+		if f.Name != filepath.Base(f.Name) || strings.Contains(f.Name, "..") {
+			return "", "", fmt.Errorf("%w: unexpected entry %q", ErrInvalidBackup, f.Name)
+		}
+
+		dst := filepath.Join(dir, f.Name)
+		if err := extractFile(f, dst); err != nil {
+			return "", "", err
+		}
+		if f.Name == databaseEntry {
+			dbFile = dst
+		} else {
+			configFile = dst
+		}
+	}
+
+	if dbFile == "" {
+		return "", "", fmt.Errorf("%w: archive has no database", ErrInvalidBackup)
+	}
+	return dbFile, configFile, nil
+}
+
+func extractFile(f *zip.File, dst string) error {
+	src, err := f.Open()
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidBackup, err)
+	}
+	defer src.Close()
+
+	out, err := os.OpenFile(dst, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0o600)
+	if err != nil {
+		return fmt.Errorf("failed to extract %s: %w", f.Name, err)
+	}
+	defer out.Close()
+
+	if _, err := io.Copy(out, src); err != nil {
+		return fmt.Errorf("failed to extract %s: %w", f.Name, err)
+	}
+	return nil
+}
+
+// replaceFile atomically replaces dst with the contents of src.
+func replaceFile(src, dst string) error {
+	data, err := os.ReadFile(src)
+	if err != nil {
+		return err
+	}
+	tmp := dst + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o600); err != nil {
+		return err
+	}
+	return os.Rename(tmp, dst)
+}
+
+// ApplyStagedRestore swaps a staged restore into place before the database is
+// opened, replacing the config file at configPath too when the backup had
+// one. The replaced database, its WAL files and the replaced config file are
+// kept alongside with a .pre-restore suffix. It reports whether a restore was
+// applied, in which case the config file must be reloaded.
+func ApplyStagedRestore(dbPath, configPath string) (bool, error) {
+	staged := dbPath + stagedRestoreSuffix
+	stagedConfig := dbPath + stagedConfigSuffix
+	if _, err := os.Stat(staged); err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			// A config staged without its database is left over from a
+			// restore that failed part way.
+			if err := os.Remove(stagedConfig); err != nil && !errors.Is(err, os.ErrNotExist) {
+				return false, err
+			}
+			return false, nil
+		}
+		return false, err
+	}
+
+	if err := applyStagedConfig(stagedConfig, configPath); err != nil {
+		return false, err
+	}
+
+	for _, suffix := range []string{"", "-wal", "-shm"} {
+		current := dbPath + suffix
+		if err := os.Rename(current, dbPath+preRestoreSuffix+suffix); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return false, fmt.Errorf("failed to set aside current database: %w", err)
+		}
+	}
+
+	if err := os.Rename(staged, dbPath); err != nil {
+		return false, fmt.Errorf("failed to apply staged restore: %w", err)
+	}
+	return true, nil
+}
+
+// applyStagedConfig replaces the config file with a staged one, if any. The
+// config file may live on another filesystem, so it is copied rather than
+// renamed into place.
+func applyStagedConfig(stagedConfig, configPath string) error {
+	if _, err := os.Stat(stagedConfig); err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil
+		}
+		return err
+	}
+	if configPath != "" {
+		if err := copyFile(configPath, configPath+preRestoreSuffix); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return fmt.Errorf("failed to set aside current config file: %w", err)
+		}
+		if err := replaceFile(stagedConfig, configPath); err != nil {
+			return fmt.Errorf("failed to apply staged config file: %w", err)
+		}
+	}
+	return os.Remove(stagedConfig)
+}
+
+// copyFile copies src to dst, replacing dst.
+func copyFile(src, dst string) error {
+	data, err := os.ReadFile(src)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(dst, data, 0o600)
+}
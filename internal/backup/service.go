@@ -0,0 +1,317 @@
+package backup
+
+import (
+	"archive/zip"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog"
+)
+
+// Reason records why a backup was taken. Rotation is applied per reason so a
+// burst of manual backups never pushes out the scheduled ones.
+type Reason string
+
+const (
+	ReasonScheduled Reason = "scheduled"
+	ReasonManual    Reason = "manual"
+	ReasonMigration Reason = "migration"
+	ReasonUpdate    Reason = "update"
+	ReasonRestore   Reason = "restore" // taken just before a restore is staged
+)
+
+const (
+	databaseEntry   = "slipstream.db"
+	timestampLayout = "20060102_150405"
+	defaultKeep     = 7
+)
+
+var (
+	ErrBackupNotFound = errors.New("backup not found")
+	ErrInvalidBackup  = errors.New("invalid backup")
+
+	backupNamePattern = regexp.MustCompile(`^slipstream_([a-z]+)_(\d{8}_\d{6})(?:_(\d+))?\.zip$`)
+)
+
+// Config holds the paths and retention used by the backup service.
+type Config struct {
+	Dir        string // Directory backups are written to
+	DBPath     string // Live SQLite database file
+	ConfigPath string // Config file included in each backup, optional
+	Keep       int    // Backups kept per reason
+}
+
+// Backup describes a backup archive on disk.
+type Backup struct {
+	Name      string    `json:"name"`
+	Reason    Reason    `json:"reason"`
+	Size      int64     `json:"size"`
+	CreatedAt time.Time `json:"createdAt"`
+
+	seq int // orders backups taken within the same second
+}
+
+// Service creates, rotates and restores database backups.
+type Service struct {
+	db     *sql.DB
+	cfg    Config
+	logger *zerolog.Logger
+	now    func() time.Time
+
+	// mu serializes backup creation and restore staging.
+	mu sync.Mutex
+}
+
+// NewService creates a new backup service for the database behind db.
+func NewService(db *sql.DB, cfg Config, logger *zerolog.Logger) *Service {
+	if cfg.Keep <= 0 {
+		cfg.Keep = defaultKeep
+	}
+	subLogger := logger.With().Str("component", "backup").Logger()
+	return &Service{
+		db:     db,
+		cfg:    cfg,
+		logger: &subLogger,
+		now:    time.Now,
+	}
+}
+
+// Create snapshots the database and config file into a new backup archive and
+// prunes old backups taken for the same reason.
+func (s *Service) Create(ctx context.Context, reason Reason) (*Backup, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.create(ctx, reason)
+}
+
+// RunScheduledBackup creates a scheduled backup. It is the scheduler task entry point.
+func (s *Service) RunScheduledBackup(ctx context.Context) error {
+	_, err := s.Create(ctx, ReasonScheduled)
+	return err
+}
+
+// BackupBeforeUpdate creates a backup ahead of installing an application update.
+func (s *Service) BackupBeforeUpdate(ctx context.Context) error {
+	_, err := s.Create(ctx, ReasonUpdate)
+	return err
+}
+
+func (s *Service) create(ctx context.Context, reason Reason) (*Backup, error) {
+	if err := os.MkdirAll(s.cfg.Dir, 0o750); err != nil {
+		return nil, fmt.Errorf("failed to create backup directory: %w", err)
+	}
+
+	createdAt := s.now()
+	name, err := s.nextBackupName(reason, createdAt)
+	if err != nil {
+		return nil, err
+	}
+	path := filepath.Join(s.cfg.Dir, name)
+
+	// VACUUM INTO writes a consistent, compacted copy without blocking readers,
+	// which a plain file copy of a WAL-mode database cannot guarantee.
+	snapshot := filepath.Join(s.cfg.Dir, "."+name+".db")
+	defer os.Remove(snapshot)
+	if _, err := s.db.ExecContext(ctx, "VACUUM INTO ?", snapshot); err != nil {
+		return nil, fmt.Errorf("failed to snapshot database: %w", err)
+	}
+
+	if err := s.writeArchive(path, snapshot); err != nil {
+		os.Remove(path)
+		return nil, err
+	}
+
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to stat backup: %w", err)
+	}
+
+	s.logger.Info().Str("name", name).Str("reason", string(reason)).Int64("size", info.Size()).Msg("Created database backup")
+
+	if err := s.prune(reason); err != nil {
+		s.logger.Warn().Err(err).Str("reason", string(reason)).Msg("Failed to prune old backups")
+	}
+
+	return &Backup{
+		Name:      name,
+		Reason:    reason,
+		Size:      info.Size(),
+		CreatedAt: createdAt,
+	}, nil
+}
+
+// nextBackupName returns an unused archive name for a backup taken at
+// createdAt. Names only resolve to the second, so later backups within the
+// same second get a _2, _3, ... suffix.
+func (s *Service) nextBackupName(reason Reason, createdAt time.Time) (string, error) {
+	base := fmt.Sprintf("slipstream_%s_%s", reason, createdAt.Format(timestampLayout))
+	name := base + ".zip"
+	for seq := 2; ; seq++ {
+		_, err := os.Lstat(filepath.Join(s.cfg.Dir, name))
+		if errors.Is(err, os.ErrNotExist) {
+			return name, nil
+		}
+		if err != nil {
+			return "", fmt.Errorf("failed to check backup name: %w", err)
+		}
+		name = fmt.Sprintf("%s_%d.zip", base, seq)
+	}
+}
+
+func (s *Service) writeArchive(path, snapshot string) error {
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0o600)
+	if err != nil {
+		return fmt.Errorf("failed to create backup archive: %w", err)
+	}
+	defer f.Close()
+
+	zw := zip.NewWriter(f)
+	if err := addFile(zw, databaseEntry, snapshot); err != nil {
+		return err
+	}
+	if s.cfg.ConfigPath != "" {
+		if err := addFile(zw, filepath.Base(s.cfg.ConfigPath), s.cfg.ConfigPath); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return err
+		}
+	}
+	if err := zw.Close(); err != nil {
+		return fmt.Errorf("failed to finalize backup archive: %w", err)
+	}
+	return f.Sync()
+}
+
+func addFile(zw *zip.Writer, name, path string) error {
+	src, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("failed to open %s: %w", name, err)
+	}
+	defer src.Close()
+
+	dst, err := zw.Create(name)
+	if err != nil {
+		return fmt.Errorf("failed to add %s to backup: %w", name, err)
+	}
+	if _, err := io.Copy(dst, src); err != nil {
+		return fmt.Errorf("failed to write %s to backup: %w", name, err)
+	}
+	return nil
+}
+
+// List returns all backups, newest first.
+func (s *Service) List() ([]Backup, error) {
+	entries, err := os.ReadDir(s.cfg.Dir)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return []Backup{}, nil
+		}
+		return nil, fmt.Errorf("failed to read backup directory: %w", err)
+	}
+
+	backups := make([]Backup, 0, len(entries))
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+		b, ok := parseBackupName(entry.Name())
+		if !ok {
+			continue
+		}
+		info, err := entry.Info()
+		if err != nil {
+			continue
+		}
+		b.Size = info.Size()
+		backups = append(backups, b)
+	}
+
+	sort.Slice(backups, func(i, j int) bool {
+		if !backups[i].CreatedAt.Equal(backups[j].CreatedAt) {
+			return backups[i].CreatedAt.After(backups[j].CreatedAt)
+		}
+		return backups[i].seq > backups[j].seq
+	})
+	return backups, nil
+}
+
+// Path returns the on-disk path of a backup, rejecting names that do not
+// belong to the backup directory.
+func (s *Service) Path(name string) (string, error) {
+	if _, ok := parseBackupName(name); !ok {
+		return "", ErrBackupNotFound
+	}
+	path := filepath.Join(s.cfg.Dir, name)
+	if _, err := os.Stat(path); err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return "", ErrBackupNotFound
+		}
+		return "", err
+	}
+	return path, nil
+}
+
+// Delete removes a backup.
+func (s *Service) Delete(name string) error {
+	path, err := s.Path(name)
+	if err != nil {
+		return err
+	}
+	return os.Remove(path)
+}
+
+// prune removes the oldest backups for reason beyond the configured keep count.
+func (s *Service) prune(reason Reason) error {
+	backups, err := s.List()
+	if err != nil {
+		return err
+	}
+
+	kept := 0
+	for _, b := range backups {
+		if b.Reason != reason {
+			continue
+		}
+		kept++
+		if kept <= s.cfg.Keep {
+			continue
+		}
+		if err := os.Remove(filepath.Join(s.cfg.Dir, b.Name)); err != nil {
+			return err
+		}
+		s.logger.Debug().Str("name", b.Name).Msg("Pruned old backup")
+	}
+	return nil
+}
+
+func parseBackupName(name string) (Backup, bool) {
+	m := backupNamePattern.FindStringSubmatch(name)
+	if m == nil {
+		return Backup{}, false
+	}
+	createdAt, err := time.ParseInLocation(timestampLayout, m[2], time.Local)
+	if err != nil {
+		return Backup{}, false
+	}
+	seq := 1
+	if m[3] != "" {
+		if seq, err = strconv.Atoi(m[3]); err != nil {
+			return Backup{}, false
+		}
+	}
+	return Backup{
+		Name:      name,
+		Reason:    Reason(m[1]),
+		CreatedAt: createdAt,
+		seq:       seq,
+	}, true
+}
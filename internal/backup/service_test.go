@@ -0,0 +1,212 @@
+package backup
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/slipstream/slipstream/internal/database/sqlc"
+	"github.com/slipstream/slipstream/internal/testutil"
+)
+
+func newTestService(t *testing.T, tdb *testutil.TestDB, keep int) *Service {
+	t.Helper()
+
+	configPath := filepath.Join(tdb.Path, "config.yaml")
+	if err := os.WriteFile(configPath, []byte("server:\n  port: 8080\n"), 0o600); err != nil {
+		t.Fatalf("WriteFile error = %v", err)
+	}
+
+	svc := NewService(tdb.Conn, Config{
+		Dir:        filepath.Join(tdb.Path, "backups"),
+		DBPath:     filepath.Join(tdb.Path, "test.db"),
+		ConfigPath: configPath,
+		Keep:       keep,
+	}, &tdb.Logger)
+
+	clock := time.Date(2024, time.January, 1, 3, 0, 0, 0, time.Local)
+	svc.now = func() time.Time {
+		clock = clock.Add(time.Minute)
+		return clock
+	}
+	return svc
+}
+
+func TestService_Create_RotatesPerReason(t *testing.T) {
+	tdb := testutil.NewTestDB(t)
+	defer tdb.Close()
+
+	svc := newTestService(t, tdb, 2)
+	ctx := context.Background()
+
+	for range 3 {
+		if _, err := svc.Create(ctx, ReasonScheduled); err != nil {
+			t.Fatalf("Create(scheduled) error = %v", err)
+		}
+	}
+	manual, err := svc.Create(ctx, ReasonManual)
+	if err != nil {
+		t.Fatalf("Create(manual) error = %v", err)
+	}
+
+	backups, err := svc.List()
+	if err != nil {
+		t.Fatalf("List error = %v", err)
+	}
+	if len(backups) != 3 {
+		t.Fatalf("expected 3 backups after rotation, got %d", len(backups))
+	}
+	if backups[0].Name != manual.Name {
+		t.Errorf("expected newest backup %s first, got %s", manual.Name, backups[0].Name)
+	}
+	for _, b := range backups[1:] {
+		if b.Reason != ReasonScheduled {
+			t.Errorf("unexpected backup %s", b.Name)
+		}
+	}
+}
+
+func TestService_Create_SameSecond(t *testing.T) {
+	tdb := testutil.NewTestDB(t)
+	defer tdb.Close()
+
+	svc := newTestService(t, tdb, 5)
+	at := time.Date(2024, time.January, 1, 3, 0, 0, 0, time.Local)
+	svc.now = func() time.Time { return at }
+	ctx := context.Background()
+
+	first, err := svc.Create(ctx, ReasonManual)
+	if err != nil {
+		t.Fatalf("Create error = %v", err)
+	}
+	second, err := svc.Create(ctx, ReasonManual)
+	if err != nil {
+		t.Fatalf("second Create in the same second error = %v", err)
+	}
+	if first.Name == second.Name {
+		t.Fatalf("both backups named %s", first.Name)
+	}
+
+	backups, err := svc.List()
+	if err != nil {
+		t.Fatalf("List error = %v", err)
+	}
+	if len(backups) != 2 || backups[0].Name != second.Name {
+		t.Errorf("expected %s listed first, got %+v", second.Name, backups)
+	}
+}
+
+func TestService_Restore(t *testing.T) {
+	tdb := testutil.NewTestDB(t)
+	defer tdb.Close()
+
+	svc := newTestService(t, tdb, 5)
+	ctx := context.Background()
+	queries := sqlc.New(tdb.Conn)
+
+	if _, err := queries.SetSetting(ctx, sqlc.SetSettingParams{Key: "marker", Value: "before"}); err != nil {
+		t.Fatalf("SetSetting error = %v", err)
+	}
+	b, err := svc.Create(ctx, ReasonManual)
+	if err != nil {
+		t.Fatalf("Create error = %v", err)
+	}
+
+	if err := svc.Restore(ctx, "../test.db"); !errors.Is(err, ErrBackupNotFound) {
+		t.Errorf("Restore with bad name: err = %v, want ErrBackupNotFound", err)
+	}
+
+	if err := os.WriteFile(svc.cfg.ConfigPath, []byte("server:\n  port: 9090\n"), 0o600); err != nil {
+		t.Fatalf("WriteFile error = %v", err)
+	}
+	if err := svc.Restore(ctx, b.Name); err != nil {
+		t.Fatalf("Restore error = %v", err)
+	}
+
+	staged := svc.cfg.DBPath + stagedRestoreSuffix
+	if err := ValidateDatabase(ctx, staged); err != nil {
+		t.Errorf("staged database failed validation: %v", err)
+	}
+	if got, _ := os.ReadFile(svc.cfg.ConfigPath); string(got) != "server:\n  port: 9090\n" {
+		t.Errorf("config file changed before restart: %q", got)
+	}
+	if got, _ := os.ReadFile(svc.cfg.DBPath + stagedConfigSuffix); string(got) != "server:\n  port: 8080\n" {
+		t.Errorf("staged config = %q, want the backed up config", got)
+	}
+
+	backups, err := svc.List()
+	if err != nil {
+		t.Fatalf("List error = %v", err)
+	}
+	if len(backups) != 2 || backups[0].Reason != ReasonRestore {
+		t.Errorf("expected a restore safety backup, got %+v", backups)
+	}
+}
+
+func TestValidateDatabase_RejectsNonDatabase(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "bogus.db")
+	if err := os.WriteFile(path, []byte("definitely not sqlite"), 0o600); err != nil {
+		t.Fatalf("WriteFile error = %v", err)
+	}
+
+	if err := ValidateDatabase(context.Background(), path); !errors.Is(err, ErrInvalidBackup) {
+		t.Errorf("ValidateDatabase error = %v, want ErrInvalidBackup", err)
+	}
+}
+
+func TestApplyStagedRestore(t *testing.T) {
+	dir := t.TempDir()
+	dbPath := filepath.Join(dir, "slipstream.db")
+	configPath := filepath.Join(dir, "config.yaml")
+
+	// A config staged without its database is cleared, not applied
+	if err := os.WriteFile(dbPath+stagedConfigSuffix, []byte("orphan"), 0o600); err != nil {
+		t.Fatalf("WriteFile error = %v", err)
+	}
+	applied, err := ApplyStagedRestore(dbPath, configPath)
+	if err != nil || applied {
+		t.Fatalf("ApplyStagedRestore with nothing staged = %v, %v", applied, err)
+	}
+	if _, err := os.Stat(dbPath + stagedConfigSuffix); !errors.Is(err, os.ErrNotExist) {
+		t.Errorf("expected orphaned staged config to be removed, stat err = %v", err)
+	}
+
+	for path, content := range map[string]string{
+		dbPath:                       "current",
+		dbPath + "-wal":              "current wal",
+		dbPath + stagedRestoreSuffix: "restored",
+		configPath:                   "current config",
+		dbPath + stagedConfigSuffix:  "restored config",
+	} {
+		if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+			t.Fatalf("WriteFile error = %v", err)
+		}
+	}
+
+	applied, err = ApplyStagedRestore(dbPath, configPath)
+	if err != nil || !applied {
+		t.Fatalf("ApplyStagedRestore = %v, %v", applied, err)
+	}
+
+	if got, _ := os.ReadFile(dbPath); string(got) != "restored" {
+		t.Errorf("database content = %q, want restored", got)
+	}
+	if got, _ := os.ReadFile(dbPath + preRestoreSuffix); string(got) != "current" {
+		t.Errorf("pre-restore content = %q, want current", got)
+	}
+	if _, err := os.Stat(dbPath + "-wal"); !errors.Is(err, os.ErrNotExist) {
+		t.Errorf("expected stale WAL to be moved aside, stat err = %v", err)
+	}
+	if got, _ := os.ReadFile(configPath); string(got) != "restored config" {
+		t.Errorf("config content = %q, want restored config", got)
+	}
+	if got, _ := os.ReadFile(configPath + preRestoreSuffix); string(got) != "current config" {
+		t.Errorf("pre-restore config = %q, want current config", got)
+	}
+	if _, err := os.Stat(dbPath + stagedConfigSuffix); !errors.Is(err, os.ErrNotExist) {
+		t.Errorf("expected staged config to be consumed, stat err = %v", err)
+	}
+}
@@ -0,0 +1,161 @@
+package calendar
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/slipstream/slipstream/internal/downloader"
+	"github.com/slipstream/slipstream/internal/module"
+)
+
+const (
+	// comingSoonEpisodeDays is how far ahead airing episodes are included.
+	comingSoonEpisodeDays = 7
+
+	artworkURLBase = "/api/v1/metadata/artwork"
+)
+
+// QueueSource provides the active download queue so upcoming items that are
+// already being fetched can show their progress.
+type QueueSource interface {
+	GetQueue(ctx context.Context) (*downloader.QueueResponse, error)
+}
+
+// ComingSoonDownload describes an active download for a coming-soon item.
+type ComingSoonDownload struct {
+	Status   string  `json:"status"`
+	Progress float64 `json:"progress"` // 0-100
+	ETA      int64   `json:"eta"`      // seconds, -1 if unavailable
+}
+
+// ComingSoonItem is a monitored release arriving in the library soon.
+type ComingSoonItem struct {
+	CalendarEvent
+	PosterURL   string              `json:"posterUrl,omitempty"`
+	BackdropURL string              `json:"backdropUrl,omitempty"`
+	Download    *ComingSoonDownload `json:"download,omitempty"`
+}
+
+// SetQueueSource sets the download queue used to annotate coming-soon items.
+func (s *Service) SetQueueSource(source QueueSource) {
+	s.queue = source
+}
+
+// GetComingSoon returns monitored episodes airing in the next week and
+// monitored movies releasing digitally this month, soonest first. Items
+// already in the library whose release date has passed are left out.
+func (s *Service) GetComingSoon(ctx context.Context, now time.Time) ([]ComingSoonItem, error) {
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+	monthStart := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, now.Location())
+	monthEnd := monthStart.AddDate(0, 1, -1)
+	weekEnd := today.AddDate(0, 0, comingSoonEpisodeDays-1)
+
+	end := monthEnd
+	if weekEnd.After(end) {
+		end = weekEnd
+	}
+
+	items := s.collectItems(ctx, monthStart, end)
+
+	var queue []downloader.QueueItem
+	if s.queue != nil {
+		resp, err := s.queue.GetQueue(ctx)
+		if err != nil {
+			s.logger.Warn().Err(err).Msg("Failed to get download queue for coming soon feed")
+		} else {
+			queue = resp.Items
+		}
+	}
+
+	return selectComingSoon(items, queue, today, weekEnd, monthStart, monthEnd), nil
+}
+
+func selectComingSoon(items []module.CalendarItem, queue []downloader.QueueItem, today, weekEnd, monthStart, monthEnd time.Time) []ComingSoonItem {
+	result := make([]ComingSoonItem, 0)
+	for i := range items {
+		item := &items[i]
+		if !item.Monitored || !inComingSoonWindow(item, today, weekEnd, monthStart, monthEnd) {
+			continue
+		}
+		if item.Status == "available" && item.Date.Before(today) {
+			continue
+		}
+
+		entry := ComingSoonItem{CalendarEvent: calendarItemToEvent(item)}
+		entry.PosterURL, entry.BackdropURL = artworkURLs(item)
+		if dl := findDownload(item, queue); dl != nil {
+			entry.Download = &ComingSoonDownload{
+				Status:   dl.Status,
+				Progress: dl.Progress,
+				ETA:      dl.ETA,
+			}
+			if entry.Status != "available" {
+				entry.Status = "downloading"
+			}
+		}
+		result = append(result, entry)
+	}
+
+	sort.SliceStable(result, func(i, j int) bool {
+		if result[i].Date != result[j].Date {
+			return result[i].Date < result[j].Date
+		}
+		return result[i].Title < result[j].Title
+	})
+	return result
+}
+
+func inComingSoonWindow(item *module.CalendarItem, today, weekEnd, monthStart, monthEnd time.Time) bool {
+	switch item.EntityType {
+	case module.EntityEpisode:
+		return item.EventType == "airDate" && !item.Date.Before(today) && !item.Date.After(weekEnd)
+	case module.EntityMovie:
+		return item.EventType == "digital" && !item.Date.Before(monthStart) && !item.Date.After(monthEnd)
+	default:
+		return false
+	}
+}
+
+// artworkURLs returns local artwork URLs for an item. Episodes use their
+// series' artwork. Artwork is stored by TMDB ID, so items without one get none.
+func artworkURLs(item *module.CalendarItem) (poster, backdrop string) {
+	tmdbID, ok := item.ExternalIDs["tmdb"]
+	if !ok || tmdbID == "" {
+		return "", ""
+	}
+
+	mediaType := "movie"
+	if item.EntityType == module.EntityEpisode {
+		mediaType = "series"
+	}
+	base := fmt.Sprintf("%s/%s/%s", artworkURLBase, mediaType, tmdbID)
+	return base + "/poster", base + "/backdrop"
+}
+
+// findDownload returns the queue entry fetching an item, if any. Season packs
+// match every episode of their season.
+func findDownload(item *module.CalendarItem, queue []downloader.QueueItem) *downloader.QueueItem {
+	for i := range queue {
+		q := &queue[i]
+		switch item.EntityType {
+		case module.EntityMovie:
+			if q.MovieID != nil && *q.MovieID == item.ID {
+				return q
+			}
+		case module.EntityEpisode:
+			if q.EpisodeID != nil && *q.EpisodeID == item.ID {
+				return q
+			}
+			if q.SeriesID == nil || *q.SeriesID != item.ParentID || q.SeasonNumber == nil {
+				continue
+			}
+			season, _ := item.Extra["seasonNumber"].(int)
+			if *q.SeasonNumber == season && q.IsSeasonPack {
+				return q
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,64 @@
+package calendar
+
+import (
+	"testing"
+	"time"
+
+	"github.com/slipstream/slipstream/internal/downloader"
+	"github.com/slipstream/slipstream/internal/module"
+)
+
+func day(d int) time.Time {
+	return time.Date(2024, time.March, d, 0, 0, 0, 0, time.UTC)
+}
+
+func TestSelectComingSoon(t *testing.T) {
+	today := day(10)
+	weekEnd := day(16)
+	monthStart := day(1)
+	monthEnd := day(31)
+
+	items := []module.CalendarItem{
+		{ID: 1, Title: "Later Movie", EntityType: module.EntityMovie, EventType: "digital", Date: day(28), Status: "missing", Monitored: true, ExternalIDs: map[string]string{"tmdb": "550"}},
+		{ID: 2, Title: "Unmonitored Movie", EntityType: module.EntityMovie, EventType: "digital", Date: day(12), Status: "missing"},
+		{ID: 3, Title: "Physical Release", EntityType: module.EntityMovie, EventType: "physical", Date: day(12), Status: "missing", Monitored: true},
+		{ID: 4, Title: "Already Here", EntityType: module.EntityMovie, EventType: "digital", Date: day(2), Status: "available", Monitored: true},
+		{ID: 5, Title: "Pilot", EntityType: module.EntityEpisode, EventType: "airDate", Date: day(11), Status: "missing", Monitored: true, ParentID: 9, Extra: map[string]any{"seasonNumber": 1}, ExternalIDs: map[string]string{"tmdb": "1399"}},
+		{ID: 6, Title: "Next Month Episode", EntityType: module.EntityEpisode, EventType: "airDate", Date: day(20), Status: "missing", Monitored: true, ParentID: 9},
+		{ID: 7, Title: "Aired Yesterday", EntityType: module.EntityEpisode, EventType: "airDate", Date: day(9), Status: "missing", Monitored: true, ParentID: 9},
+	}
+
+	seriesID := int64(9)
+	season := 1
+	queue := []downloader.QueueItem{
+		{ID: "abc", Status: "downloading", Progress: 42, ETA: 60, SeriesID: &seriesID, SeasonNumber: &season, IsSeasonPack: true},
+	}
+
+	got := selectComingSoon(items, queue, today, weekEnd, monthStart, monthEnd)
+
+	if len(got) != 2 {
+		t.Fatalf("expected 2 items, got %d: %+v", len(got), got)
+	}
+	if got[0].ID != 5 || got[1].ID != 1 {
+		t.Errorf("expected items [5 1] in date order, got [%d %d]", got[0].ID, got[1].ID)
+	}
+
+	episode := got[0]
+	if episode.Download == nil || episode.Download.Progress != 42 {
+		t.Errorf("expected season pack download on episode, got %+v", episode.Download)
+	}
+	if episode.Status != "downloading" {
+		t.Errorf("episode status = %q, want downloading", episode.Status)
+	}
+	if episode.PosterURL != "/api/v1/metadata/artwork/series/1399/poster" {
+		t.Errorf("episode poster = %q", episode.PosterURL)
+	}
+
+	movie := got[1]
+	if movie.Download != nil {
+		t.Errorf("expected no download for movie, got %+v", movie.Download)
+	}
+	if movie.BackdropURL != "/api/v1/metadata/artwork/movie/550/backdrop" {
+		t.Errorf("movie backdrop = %q", movie.BackdropURL)
+	}
+}
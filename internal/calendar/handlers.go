@@ -20,6 +20,13 @@ func NewHandlers(service *Service) *Handlers {
 // RegisterRoutes registers the calendar routes.
 func (h *Handlers) RegisterRoutes(g *echo.Group) {
 	g.GET("", h.GetEvents)
+	g.GET("/coming-soon", h.GetComingSoon)
+}
+
+// RegisterComingSoonRoutes registers the coming-soon feed for the portal
+// landing page.
+func (h *Handlers) RegisterComingSoonRoutes(g *echo.Group) {
+	g.GET("", h.GetComingSoon)
 }
 
 // GetEventsRequest represents the query parameters for getting calendar events.
@@ -72,3 +79,14 @@ func (h *Handlers) GetEvents(c echo.Context) error {
 
 	return c.JSON(http.StatusOK, events)
 }
+
+// GetComingSoon returns monitored releases arriving in the library soon.
+// GET /api/v1/calendar/coming-soon
+// GET /api/v1/requests/coming-soon
+func (h *Handlers) GetComingSoon(c echo.Context) error {
+	items, err := h.service.GetComingSoon(c.Request().Context(), time.Now())
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	return c.JSON(http.StatusOK, items)
+}
@@ -30,6 +30,7 @@ type CalendarEvent struct {
 type Service struct {
 	logger   *zerolog.Logger
 	registry *module.Registry
+	queue    QueueSource
 }
 
 // NewService creates a new calendar service.
@@ -45,21 +46,30 @@ func NewService(registry *module.Registry, logger *zerolog.Logger) *Service {
 // Events are fetched via module CalendarProvider implementations.
 func (s *Service) GetEvents(ctx context.Context, start, end time.Time) ([]CalendarEvent, error) {
 	var events []CalendarEvent
+	items := s.collectItems(ctx, start, end)
+	for i := range items {
+		events = append(events, calendarItemToEvent(&items[i]))
+	}
+	return events, nil
+}
+
+// collectItems gathers calendar items from every enabled module. Modules that
+// fail are logged and skipped so one broken module doesn't empty the calendar.
+func (s *Service) collectItems(ctx context.Context, start, end time.Time) []module.CalendarItem {
+	var items []module.CalendarItem
 	for _, mod := range s.registry.Enabled() {
 		provider, ok := mod.(module.CalendarProvider)
 		if !ok {
 			continue
 		}
-		items, err := provider.GetItemsInDateRange(ctx, start, end)
+		modItems, err := provider.GetItemsInDateRange(ctx, start, end)
 		if err != nil {
 			s.logger.Error().Err(err).Str("module", string(mod.ID())).Msg("Failed to get calendar items")
 			continue
 		}
-		for i := range items {
-			events = append(events, calendarItemToEvent(&items[i]))
-		}
+		items = append(items, modItems...)
 	}
-	return events, nil
+	return items
 }
 
 func calendarItemToEvent(item *module.CalendarItem) CalendarEvent {
@@ -19,6 +19,8 @@ const (
 	osWindows = "windows"
 	osDarwin  = "darwin"
 	osLinux   = "linux"
+
+	bytesPerGB = 1 << 30
 )
 
 // Config holds all application configuration.
@@ -31,8 +33,14 @@ type Config struct {
 	Indexer    IndexerConfig    `mapstructure:"indexer"`
 	AutoSearch AutoSearchConfig `mapstructure:"autosearch"`
 	RssSync    RssSyncConfig    `mapstructure:"rsssync"`
+	Import     ImportConfig     `mapstructure:"import"`
 	Health     HealthConfig     `mapstructure:"health"`
 	Portal     PortalConfig     `mapstructure:"portal"`
+	Backup     BackupConfig     `mapstructure:"backup"`
+
+	// File is the config file that was loaded, empty when running on
+	// defaults and environment variables alone.
+	File string `mapstructure:"-"`
 }
 
 // PortalConfig holds external requests portal configuration.
@@ -59,6 +67,12 @@ type DatabaseConfig struct {
 	Path string `mapstructure:"path"`
 }
 
+// BackupConfig holds database backup configuration.
+type BackupConfig struct {
+	Dir  string `mapstructure:"dir"`  // Directory backups are written to
+	Keep int    `mapstructure:"keep"` // Backups kept per reason (default: 7)
+}
+
 // LoggingConfig holds logging configuration.
 type LoggingConfig struct {
 	Level      string `mapstructure:"level"`
@@ -68,6 +82,9 @@ type LoggingConfig struct {
 	MaxBackups int    `mapstructure:"max_backups"`  // Max number of old log files to keep (default: 5)
 	MaxAgeDays int    `mapstructure:"max_age_days"` // Max age in days to keep old files (default: 30)
 	Compress   bool   `mapstructure:"compress"`     // Compress rotated files (default: true)
+
+	// Components overrides the level per component, e.g. {indexer: debug}
+	Components map[string]string `mapstructure:"components"`
 }
 
 // AuthConfig holds authentication configuration.
@@ -77,9 +94,20 @@ type AuthConfig struct {
 
 // MetadataConfig holds metadata provider configuration.
 type MetadataConfig struct {
-	TMDB TMDBConfig `mapstructure:"tmdb"`
-	TVDB TVDBConfig `mapstructure:"tvdb"`
-	OMDB OMDBConfig `mapstructure:"omdb"`
+	TMDB   TMDBConfig   `mapstructure:"tmdb"`
+	TVDB   TVDBConfig   `mapstructure:"tvdb"`
+	OMDB   OMDBConfig   `mapstructure:"omdb"`
+	TVMaze TVMazeConfig `mapstructure:"tvmaze"`
+	Fanart FanartConfig `mapstructure:"fanart"`
+
+	// SeriesProviders orders the providers tried for series search and
+	// episode lists; a provider that fails or is unconfigured is skipped.
+	// Valid names are tvdb, tmdb and tvmaze.
+	SeriesProviders []string `mapstructure:"series_providers"`
+
+	// ArtworkSource picks where posters, backdrops and logos come from:
+	// "tmdb" or "fanart". Fanart.tv falls back to TMDB for missing images.
+	ArtworkSource string `mapstructure:"artwork_source"`
 }
 
 // TMDBConfig holds TMDB API configuration.
@@ -98,6 +126,20 @@ type TVDBConfig struct {
 	Timeout int    `mapstructure:"timeout_seconds"`
 }
 
+// TVMazeConfig holds TVMaze API configuration. TVMaze needs no API key.
+type TVMazeConfig struct {
+	Enabled bool   `mapstructure:"enabled"`
+	BaseURL string `mapstructure:"base_url"`
+	Timeout int    `mapstructure:"timeout_seconds"`
+}
+
+// FanartConfig holds Fanart.tv API configuration.
+type FanartConfig struct {
+	APIKey  string `mapstructure:"api_key"`
+	BaseURL string `mapstructure:"base_url"`
+	Timeout int    `mapstructure:"timeout_seconds"`
+}
+
 // OMDBConfig holds OMDb API configuration.
 type OMDBConfig struct {
 	APIKey  string `mapstructure:"api_key"`
@@ -148,12 +190,52 @@ type AutoSearchConfig struct {
 	IntervalHours    int  `mapstructure:"interval_hours"`    // Default: 8 (range: 1-24)
 	BackoffThreshold int  `mapstructure:"backoff_threshold"` // Default: 12
 	BaseDelayMs      int  `mapstructure:"base_delay_ms"`     // Default: 1000
+
+	// Paced library-wide missing / cutoff-unmet searches
+	BatchSize         int   `mapstructure:"batch_size"`          // Default: 25 items per batch
+	BatchPauseSeconds int   `mapstructure:"batch_pause_seconds"` // Default: 300 between batches
+	BatchSmartListID  int64 `mapstructure:"batch_smart_list_id"` // Default: 0 searches the whole library
+
+	// Caps on automatic grabs (autosearch and RSS sync) across all indexers
+	MaxGrabsPerHour int `mapstructure:"max_grabs_per_hour"` // Default: 0 (no cap)
+	MaxGrabsPerDay  int `mapstructure:"max_grabs_per_day"`  // Default: 0 (no cap)
+
+	// Pause all automatic grabs while any library volume has less free space than this
+	PauseGrabsBelowFreeSpaceGB float64 `mapstructure:"pause_grabs_below_free_space_gb"` // Default: 0 (disabled)
+
+	// Space that must remain free in a grab's target root folder after the release lands
+	GrabFreeSpaceBufferGB float64 `mapstructure:"grab_free_space_buffer_gb"` // Default: 5
+
+	// Hold automatic grabs for admin approval: "off", "all", or "tagged" (items sharing GrabApprovalTagIDs)
+	GrabApprovalMode   string  `mapstructure:"grab_approval_mode"`    // Default: "off"
+	GrabApprovalTagIDs []int64 `mapstructure:"grab_approval_tag_ids"` // Default: none
+
+	// Release stage an item must reach before automatic searches, unless the item overrides it:
+	// "announced", "in_cinemas", "released", or "physical"
+	MinimumAvailability string `mapstructure:"minimum_availability"` // Default: "released"
+
+	// With multi-version enabled, search once per monitored slot that is empty or
+	// below cutoff, using that slot's quality profile
+	SlotAutoFill bool `mapstructure:"slot_auto_fill"` // Default: false
+
+	// Search a season as a pack once this share of its monitored episodes is missing
+	SeasonPackMissingPercent int `mapstructure:"season_pack_missing_percent"` // Default: 100 (range: 50-100)
+
+	// Nightly window (local hours, may wrap past midnight) for the cutoff unmet search;
+	// equal hours allow it at any time. Tags limit it to items sharing one of them.
+	UpgradeWindowStartHour int     `mapstructure:"upgrade_window_start_hour"` // Default: 3
+	UpgradeWindowEndHour   int     `mapstructure:"upgrade_window_end_hour"`   // Default: 3 (no window)
+	UpgradeTagIDs          []int64 `mapstructure:"upgrade_tag_ids"`           // Default: none (all items)
 }
 
 // RssSyncConfig holds RSS sync scheduling configuration.
 type RssSyncConfig struct {
 	Enabled     bool `mapstructure:"enabled"`      // Default: true
 	IntervalMin int  `mapstructure:"interval_min"` // Default: 15 (range: 10-60)
+
+	// AutoReplaceProper watches recently imported files that met their cutoff
+	// and replaces them when a PROPER or REPACK of their quality appears.
+	AutoReplaceProper bool `mapstructure:"auto_replace_proper"` // Default: false
 }
 
 // IntervalDuration returns the RSS sync interval as a time.Duration.
@@ -161,6 +243,12 @@ func (c *RssSyncConfig) IntervalDuration() time.Duration {
 	return time.Duration(c.IntervalMin) * time.Minute
 }
 
+// ImportConfig holds import worker configuration.
+type ImportConfig struct {
+	Workers            int `mapstructure:"workers"`               // Default: 2
+	MaxCopiesPerVolume int `mapstructure:"max_copies_per_volume"` // Default: 1 (0 = unlimited)
+}
+
 // HealthConfig holds system health monitoring configuration.
 type HealthConfig struct {
 	DownloadClientCheckInterval time.Duration `mapstructure:"download_client_check_interval"` // Default: 6h
@@ -168,6 +256,12 @@ type HealthConfig struct {
 	StorageCheckInterval        time.Duration `mapstructure:"storage_check_interval"`         // Default: 1h
 	StorageWarningThreshold     float64       `mapstructure:"storage_warning_threshold"`      // Default: 0.20 (20%)
 	StorageErrorThreshold       float64       `mapstructure:"storage_error_threshold"`        // Default: 0.05 (5%)
+
+	DownloadClientFreeSpaceWarningGB float64 `mapstructure:"download_client_free_space_warning_gb"` // Default: 50
+	DownloadClientFreeSpaceErrorGB   float64 `mapstructure:"download_client_free_space_error_gb"`   // Default: 5, also the reserve kept free at grab time
+
+	ChecksInterval      time.Duration `mapstructure:"checks_interval"`       // Default: 15m
+	QueueStallThreshold time.Duration `mapstructure:"queue_stall_threshold"` // Default: 6h
 }
 
 // IntervalDuration returns the search interval as a time.Duration.
@@ -180,6 +274,31 @@ func (c *AutoSearchConfig) BaseDelayDuration() time.Duration {
 	return time.Duration(c.BaseDelayMs) * time.Millisecond
 }
 
+// BatchPauseDuration returns the pause between batches of a paced library search.
+func (c *AutoSearchConfig) BatchPauseDuration() time.Duration {
+	return time.Duration(c.BatchPauseSeconds) * time.Second
+}
+
+// PauseGrabsBelowFreeSpaceBytes returns the library free space below which automatic grabs pause.
+func (c *AutoSearchConfig) PauseGrabsBelowFreeSpaceBytes() int64 {
+	return int64(c.PauseGrabsBelowFreeSpaceGB * bytesPerGB)
+}
+
+// GrabFreeSpaceBufferBytes returns the space a grab must leave free in its target root folder.
+func (c *AutoSearchConfig) GrabFreeSpaceBufferBytes() int64 {
+	return int64(c.GrabFreeSpaceBufferGB * bytesPerGB)
+}
+
+// DownloadClientFreeSpaceWarningBytes returns the download client free space warning threshold in bytes.
+func (c *HealthConfig) DownloadClientFreeSpaceWarningBytes() int64 {
+	return int64(c.DownloadClientFreeSpaceWarningGB * bytesPerGB)
+}
+
+// DownloadClientFreeSpaceErrorBytes returns the download client free space error threshold in bytes.
+func (c *HealthConfig) DownloadClientFreeSpaceErrorBytes() int64 {
+	return int64(c.DownloadClientFreeSpaceErrorGB * bytesPerGB)
+}
+
 // QueryPeriodDuration returns the query period as a time.Duration.
 func (r *RateLimitConfig) QueryPeriodDuration() time.Duration {
 	return time.Duration(r.QueryPeriod) * time.Minute
@@ -224,6 +343,7 @@ func Load(configPath string) (*Config, error) {
 	if err := v.Unmarshal(cfg); err != nil {
 		return nil, fmt.Errorf("failed to unmarshal config: %w", err)
 	}
+	cfg.File = v.ConfigFileUsed()
 
 	return cfg, nil
 }
@@ -291,6 +411,10 @@ func setDefaults(v *viper.Viper) {
 	// Database defaults
 	v.SetDefault("database.path", filepath.Join(dataDir, "slipstream.db"))
 
+	// Backup defaults
+	v.SetDefault("backup.dir", filepath.Join(dataDir, "backups"))
+	v.SetDefault("backup.keep", 7)
+
 	// Logging defaults
 	v.SetDefault("logging.level", "info")
 	v.SetDefault("logging.format", "console")
@@ -315,6 +439,14 @@ func setDefaults(v *viper.Viper) {
 	v.SetDefault("metadata.omdb.api_key", omdbKey)
 	v.SetDefault("metadata.omdb.base_url", "https://www.omdbapi.com")
 	v.SetDefault("metadata.omdb.timeout_seconds", 15)
+	v.SetDefault("metadata.tvmaze.enabled", true)
+	v.SetDefault("metadata.tvmaze.base_url", "https://api.tvmaze.com")
+	v.SetDefault("metadata.tvmaze.timeout_seconds", 15)
+	v.SetDefault("metadata.series_providers", []string{"tvdb", "tmdb", "tvmaze"})
+	v.SetDefault("metadata.fanart.api_key", "")
+	v.SetDefault("metadata.fanart.base_url", "https://webservice.fanart.tv/v3")
+	v.SetDefault("metadata.fanart.timeout_seconds", 15)
+	v.SetDefault("metadata.artwork_source", "tmdb")
 
 	// Indexer defaults
 	// Cardigann definition system
@@ -343,13 +475,33 @@ func setDefaults(v *viper.Viper) {
 	v.SetDefault("autosearch.interval_hours", 1)
 	v.SetDefault("autosearch.backoff_threshold", 12)
 	v.SetDefault("autosearch.base_delay_ms", 1000)
+	v.SetDefault("autosearch.batch_size", 25)
+	v.SetDefault("autosearch.batch_pause_seconds", 300)
+	v.SetDefault("autosearch.max_grabs_per_hour", 0)
+	v.SetDefault("autosearch.max_grabs_per_day", 0)
+	v.SetDefault("autosearch.pause_grabs_below_free_space_gb", 0)
+	v.SetDefault("autosearch.grab_free_space_buffer_gb", 5)
+	v.SetDefault("autosearch.grab_approval_mode", "off")
+	v.SetDefault("autosearch.minimum_availability", "released")
+	v.SetDefault("autosearch.slot_auto_fill", false)
+	v.SetDefault("autosearch.season_pack_missing_percent", 100)
+	v.SetDefault("autosearch.upgrade_window_start_hour", 3)
+	v.SetDefault("autosearch.upgrade_window_end_hour", 3)
+
+	// Import defaults
+	v.SetDefault("import.workers", 2)
+	v.SetDefault("import.max_copies_per_volume", 1)
 
 	// Health check defaults
 	v.SetDefault("health.download_client_check_interval", 6*time.Hour)
 	v.SetDefault("health.indexer_check_interval", 6*time.Hour)
 	v.SetDefault("health.storage_check_interval", 1*time.Hour)
+	v.SetDefault("health.checks_interval", 15*time.Minute)
+	v.SetDefault("health.queue_stall_threshold", 6*time.Hour)
 	v.SetDefault("health.storage_warning_threshold", 0.20)
 	v.SetDefault("health.storage_error_threshold", 0.05)
+	v.SetDefault("health.download_client_free_space_warning_gb", 50.0)
+	v.SetDefault("health.download_client_free_space_error_gb", 5.0)
 
 	// Portal defaults
 	v.SetDefault("portal.jwt_secret", "")
@@ -9,7 +9,8 @@ package config
 //	go build -ldflags "-X 'github.com/slipstream/slipstream/internal/config.Version=1.2.3' \
 //	                   -X 'github.com/slipstream/slipstream/internal/config.EmbeddedTMDBKey=xxx' \
 //	                   -X 'github.com/slipstream/slipstream/internal/config.EmbeddedTVDBKey=yyy' \
-//	                   -X 'github.com/slipstream/slipstream/internal/config.EmbeddedOMDBKey=zzz'"
+//	                   -X 'github.com/slipstream/slipstream/internal/config.EmbeddedOMDBKey=zzz' \
+//	                   -X 'github.com/slipstream/slipstream/internal/config.EmbeddedUpdateKey=base64'"
 var (
 	// Version is the application version, injected at build time.
 	// Defaults to "dev" if not set.
@@ -18,4 +19,8 @@ var (
 	EmbeddedTMDBKey string
 	EmbeddedTVDBKey string
 	EmbeddedOMDBKey string
+
+	// EmbeddedUpdateKey is the base64 ed25519 public key used to verify
+	// release checksum signatures. Unsigned builds skip signature checks.
+	EmbeddedUpdateKey string
 )
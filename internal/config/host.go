@@ -0,0 +1,259 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// SecretMask replaces secret values in API responses. Sending it back
+// unchanged leaves the stored secret alone.
+const SecretMask = "********"
+
+var (
+	ErrUnknownHostKey   = errors.New("unknown host setting")
+	ErrInvalidHostValue = errors.New("invalid host setting value")
+)
+
+// HostSetting is a single editable config file value.
+type HostSetting struct {
+	Key             string `json:"key"`
+	Value           any    `json:"value"`
+	Secret          bool   `json:"secret"`
+	RestartRequired bool   `json:"restartRequired"`
+}
+
+type hostKey struct {
+	key     string
+	secret  bool
+	restart bool
+	get     func(c *Config) any
+	parse   func(raw any) (any, error)
+}
+
+// hostKeys lists the config values that can be edited over the API.
+var hostKeys = []hostKey{
+	{key: KeyServerHost, restart: true, get: func(c *Config) any { return c.Server.Host }, parse: parseHost},
+	{key: KeyServerPort, restart: true, get: func(c *Config) any { return c.Server.Port }, parse: parsePort},
+	{key: KeyLoggingLevel, get: func(c *Config) any { return c.Logging.Level }, parse: oneOf("trace", "debug", "info", "warn", "error")},
+	{key: KeyLoggingFormat, restart: true, get: func(c *Config) any { return c.Logging.Format }, parse: oneOf("console", "json")},
+	{key: KeyTMDBAPIKey, secret: true, get: func(c *Config) any { return c.Metadata.TMDB.APIKey }, parse: parseString},
+	{key: KeyTVDBAPIKey, secret: true, get: func(c *Config) any { return c.Metadata.TVDB.APIKey }, parse: parseString},
+	{key: KeyOMDBAPIKey, secret: true, get: func(c *Config) any { return c.Metadata.OMDB.APIKey }, parse: parseString},
+	{key: KeyFanartAPIKey, secret: true, get: func(c *Config) any { return c.Metadata.Fanart.APIKey }, parse: parseString},
+	{key: KeyArtworkSource, get: func(c *Config) any { return c.Metadata.ArtworkSource }, parse: oneOf("tmdb", "fanart")},
+	{key: KeyPortalJWTSecret, secret: true, get: func(c *Config) any { return c.Portal.JWTSecret }, parse: parseJWTSecret},
+}
+
+// HostSettings returns the editable config values with secrets masked.
+func (c *Config) HostSettings() []HostSetting {
+	settings := make([]HostSetting, 0, len(hostKeys))
+	for _, hk := range hostKeys {
+		value := hk.get(c)
+		if hk.secret && value != "" {
+			value = SecretMask
+		}
+		settings = append(settings, HostSetting{
+			Key:             hk.key,
+			Value:           value,
+			Secret:          hk.secret,
+			RestartRequired: hk.restart,
+		})
+	}
+	return settings
+}
+
+// ValidateHostValues checks a set of edits and returns the normalized
+// values to write. Masked secrets are dropped so they stay unchanged.
+func ValidateHostValues(values map[string]any) (map[string]any, error) {
+	valid := make(map[string]any, len(values))
+	for key, raw := range values {
+		hk, ok := findHostKey(key)
+		if !ok {
+			return nil, fmt.Errorf("%w: %s", ErrUnknownHostKey, key)
+		}
+		if hk.secret && raw == SecretMask {
+			continue
+		}
+		value, err := hk.parse(raw)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s: %w", ErrInvalidHostValue, key, err)
+		}
+		valid[key] = value
+	}
+	return valid, nil
+}
+
+func findHostKey(key string) (hostKey, bool) {
+	for _, hk := range hostKeys {
+		if hk.key == key {
+			return hk, true
+		}
+	}
+	return hostKey{}, false
+}
+
+// WriteHostValues merges dotted-key values into the YAML config file at
+// path, creating it if needed. Other keys and comments are preserved.
+func WriteHostValues(path string, values map[string]any) error {
+	var doc yaml.Node
+	data, err := os.ReadFile(path)
+	switch {
+	case err == nil:
+		if err := yaml.Unmarshal(data, &doc); err != nil {
+			return fmt.Errorf("failed to parse config file: %w", err)
+		}
+	case !errors.Is(err, os.ErrNotExist):
+		return fmt.Errorf("failed to read config file: %w", err)
+	}
+	if len(doc.Content) == 0 {
+		doc = yaml.Node{Kind: yaml.DocumentNode, Content: []*yaml.Node{{Kind: yaml.MappingNode}}}
+	}
+	root := doc.Content[0]
+	if root.Kind != yaml.MappingNode {
+		return errors.New("config file is not a YAML mapping")
+	}
+
+	for key, value := range values {
+		var valueNode yaml.Node
+		if err := valueNode.Encode(value); err != nil {
+			return fmt.Errorf("failed to encode %s: %w", key, err)
+		}
+		setNested(root, strings.Split(key, "."), &valueNode)
+	}
+
+	out, err := yaml.Marshal(&doc)
+	if err != nil {
+		return fmt.Errorf("failed to encode config file: %w", err)
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o750); err != nil {
+		return fmt.Errorf("failed to create config directory: %w", err)
+	}
+
+	// Write to a temp file and rename so a crash never leaves a half-written config
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, out, 0o600); err != nil {
+		return fmt.Errorf("failed to write config file: %w", err)
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		_ = os.Remove(tmp)
+		return fmt.Errorf("failed to replace config file: %w", err)
+	}
+	return nil
+}
+
+// setNested sets parts (a dotted key split on ".") to value inside a YAML
+// mapping node, adding intermediate mappings as needed.
+func setNested(mapping *yaml.Node, parts []string, value *yaml.Node) {
+	for i, part := range parts {
+		last := i == len(parts)-1
+		child := mappingValue(mapping, part)
+		switch {
+		case last && child != nil:
+			// Keep any comments the user wrote next to the value
+			value.HeadComment, value.LineComment = child.HeadComment, child.LineComment
+			*child = *value
+			return
+		case last:
+			mapping.Content = append(mapping.Content, &yaml.Node{Kind: yaml.ScalarNode, Value: part}, value)
+			return
+		case child == nil:
+			child = &yaml.Node{Kind: yaml.MappingNode}
+			mapping.Content = append(mapping.Content, &yaml.Node{Kind: yaml.ScalarNode, Value: part}, child)
+		case child.Kind != yaml.MappingNode:
+			*child = yaml.Node{Kind: yaml.MappingNode}
+		}
+		mapping = child
+	}
+}
+
+// mappingValue returns the value node for key in a mapping node, or nil.
+func mappingValue(mapping *yaml.Node, key string) *yaml.Node {
+	for i := 0; i+1 < len(mapping.Content); i += 2 {
+		if mapping.Content[i].Value == key {
+			return mapping.Content[i+1]
+		}
+	}
+	return nil
+}
+
+func parseString(raw any) (any, error) {
+	s, ok := raw.(string)
+	if !ok {
+		return nil, errors.New("must be a string")
+	}
+	return strings.TrimSpace(s), nil
+}
+
+func oneOf(allowed ...string) func(raw any) (any, error) {
+	return func(raw any) (any, error) {
+		v, err := parseString(raw)
+		if err != nil {
+			return nil, err
+		}
+		for _, a := range allowed {
+			if v == a {
+				return v, nil
+			}
+		}
+		return nil, fmt.Errorf("must be one of %s", strings.Join(allowed, ", "))
+	}
+}
+
+func parseHost(raw any) (any, error) {
+	v, err := parseString(raw)
+	if err != nil {
+		return nil, err
+	}
+	host, _ := v.(string)
+	if host == "localhost" || net.ParseIP(host) != nil {
+		return host, nil
+	}
+	return nil, errors.New("must be an IP address or localhost")
+}
+
+func parsePort(raw any) (any, error) {
+	var port int
+	switch v := raw.(type) {
+	case float64:
+		port = int(v)
+		if float64(port) != v {
+			return nil, errors.New("must be a whole number")
+		}
+	case int:
+		port = v
+	case string:
+		p, err := strconv.Atoi(strings.TrimSpace(v))
+		if err != nil {
+			return nil, errors.New("must be a number")
+		}
+		port = p
+	default:
+		return nil, errors.New("must be a number")
+	}
+	if port < 1 || port > 65535 {
+		return nil, errors.New("must be between 1 and 65535")
+	}
+	return port, nil
+}
+
+// minJWTSecretLength keeps hand-picked portal secrets from being guessable.
+const minJWTSecretLength = 32
+
+func parseJWTSecret(raw any) (any, error) {
+	v, err := parseString(raw)
+	if err != nil {
+		return nil, err
+	}
+	// Empty falls back to the generated secret stored in the database
+	if secret, _ := v.(string); secret != "" && len(secret) < minJWTSecretLength {
+		return nil, fmt.Errorf("must be at least %d characters", minJWTSecretLength)
+	}
+	return v, nil
+}
@@ -0,0 +1,146 @@
+package config
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestConfig_Diff(t *testing.T) {
+	base := &Config{}
+	base.Server.Port = 8080
+	base.Logging.Level = "info"
+	base.Metadata.TMDB.APIKey = "old"
+
+	next := *base
+	next.Server.Port = 9090
+	next.Logging.Level = "debug"
+	next.Metadata.TMDB.APIKey = "new"
+
+	result := base.Diff(&next)
+	if !slices.Equal(result.Applied, []string{KeyLoggingLevel, "metadata.tmdb"}) {
+		t.Errorf("Applied = %v", result.Applied)
+	}
+	if !slices.Equal(result.RestartRequired, []string{KeyServerPort}) {
+		t.Errorf("RestartRequired = %v", result.RestartRequired)
+	}
+
+	running := *base
+	running.ApplyReloadable(&next)
+	if running.Server.Port != 8080 {
+		t.Errorf("ApplyReloadable changed restart-only port to %d", running.Server.Port)
+	}
+	if running.Logging.Level != "debug" || running.Metadata.TMDB.APIKey != "new" {
+		t.Errorf("ApplyReloadable did not apply live settings: %+v", running.Logging)
+	}
+
+	if base.Diff(base).Changed() {
+		t.Error("Diff of identical configs reported changes")
+	}
+}
+
+func TestConfig_HostSettingsMasksSecrets(t *testing.T) {
+	cfg := &Config{}
+	cfg.Metadata.TMDB.APIKey = "secret-key"
+
+	for _, setting := range cfg.HostSettings() {
+		switch setting.Key {
+		case KeyTMDBAPIKey:
+			if setting.Value != SecretMask {
+				t.Errorf("tmdb api key = %v, want mask", setting.Value)
+			}
+		case KeyTVDBAPIKey:
+			if setting.Value != "" {
+				t.Errorf("unset tvdb api key = %v, want empty", setting.Value)
+			}
+		}
+	}
+}
+
+func TestValidateHostValues(t *testing.T) {
+	values, err := ValidateHostValues(map[string]any{
+		KeyServerPort:    float64(9090),
+		KeyLoggingLevel:  "debug",
+		KeyTMDBAPIKey:    SecretMask,
+		KeyOMDBAPIKey:    " new-key ",
+		KeyServerHost:    "0.0.0.0",
+		KeyLoggingFormat: "json",
+	})
+	if err != nil {
+		t.Fatalf("ValidateHostValues error = %v", err)
+	}
+	if _, ok := values[KeyTMDBAPIKey]; ok {
+		t.Error("masked secret should be left unchanged")
+	}
+	if values[KeyServerPort] != 9090 || values[KeyOMDBAPIKey] != "new-key" {
+		t.Errorf("values = %v", values)
+	}
+
+	invalid := []map[string]any{
+		{KeyServerPort: float64(70000)},
+		{KeyServerPort: "abc"},
+		{KeyLoggingLevel: "verbose"},
+		{KeyServerHost: "not a host"},
+		{KeyPortalJWTSecret: "short"},
+	}
+	for _, input := range invalid {
+		if _, err := ValidateHostValues(input); !errors.Is(err, ErrInvalidHostValue) {
+			t.Errorf("ValidateHostValues(%v) error = %v, want ErrInvalidHostValue", input, err)
+		}
+	}
+
+	if _, err := ValidateHostValues(map[string]any{"database.path": "/tmp/x.db"}); !errors.Is(err, ErrUnknownHostKey) {
+		t.Errorf("unknown key error = %v, want ErrUnknownHostKey", err)
+	}
+}
+
+func TestWriteHostValues(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	original := "# SlipStream config\nserver:\n  host: 127.0.0.1\n  port: 8080 # web UI port\nautosearch:\n  enabled: true\n"
+	if err := os.WriteFile(path, []byte(original), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	err := WriteHostValues(path, map[string]any{
+		KeyServerPort: 9090,
+		KeyTMDBAPIKey: "abc",
+	})
+	if err != nil {
+		t.Fatalf("WriteHostValues error = %v", err)
+	}
+
+	cfg, err := Load(path)
+	if err != nil {
+		t.Fatalf("Load error = %v", err)
+	}
+	if cfg.Server.Port != 9090 || cfg.Server.Host != "127.0.0.1" || cfg.Metadata.TMDB.APIKey != "abc" || !cfg.AutoSearch.Enabled {
+		t.Errorf("reloaded config = %+v / %+v", cfg.Server, cfg.Metadata.TMDB)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, comment := range []string{"# SlipStream config", "# web UI port"} {
+		if !strings.Contains(string(data), comment) {
+			t.Errorf("comment %q was not preserved:\n%s", comment, data)
+		}
+	}
+}
+
+func TestWriteHostValues_CreatesFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "nested", "config.yaml")
+	if err := WriteHostValues(path, map[string]any{KeyLoggingLevel: "warn"}); err != nil {
+		t.Fatalf("WriteHostValues error = %v", err)
+	}
+	cfg, err := Load(path)
+	if err != nil {
+		t.Fatalf("Load error = %v", err)
+	}
+	if cfg.Logging.Level != "warn" {
+		t.Errorf("logging level = %q, want warn", cfg.Logging.Level)
+	}
+}
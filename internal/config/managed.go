@@ -0,0 +1,49 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"runtime"
+)
+
+// ManagedBinDir returns the user-writable directory that managed installs
+// (the Linux package stub, the Windows service and the macOS launchd agent)
+// run the real binary from, so the self-updater can replace it without
+// elevated privileges. Returns "" on unsupported platforms.
+// Windows: %LOCALAPPDATA%\SlipStream\bin
+// macOS: ~/Library/Application Support/SlipStream/bin
+// Linux: ~/.local/share/slipstream/bin
+func ManagedBinDir() string {
+	switch runtime.GOOS {
+	case osWindows:
+		if localAppData := os.Getenv("LOCALAPPDATA"); localAppData != "" {
+			return filepath.Join(localAppData, "SlipStream", "bin")
+		}
+	case osDarwin:
+		if home, err := os.UserHomeDir(); err == nil {
+			return filepath.Join(home, "Library", "Application Support", "SlipStream", "bin")
+		}
+	case osLinux:
+		if home, err := os.UserHomeDir(); err == nil {
+			return filepath.Join(home, ".local", "share", "slipstream", "bin")
+		}
+	}
+	return ""
+}
+
+// IsManagedInstall reports whether the running binary lives in ManagedBinDir.
+func IsManagedInstall() bool {
+	binDir := ManagedBinDir()
+	if binDir == "" {
+		return false
+	}
+	exe, err := os.Executable()
+	if err != nil {
+		return false
+	}
+	exe, err = filepath.EvalSymlinks(exe)
+	if err != nil {
+		return false
+	}
+	return filepath.Dir(exe) == binDir
+}
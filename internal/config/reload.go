@@ -0,0 +1,184 @@
+package config
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"runtime"
+	"slices"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+)
+
+// Config keys that can change while the server is running. Any setting
+// under metadata.tmdb, metadata.tvdb, metadata.omdb, metadata.tvmaze or
+// metadata.fanart, and metadata.series_providers, are also applied live.
+const (
+	KeyLoggingLevel    = "logging.level"
+	KeyTMDBAPIKey      = "metadata.tmdb.api_key"
+	KeyTVDBAPIKey      = "metadata.tvdb.api_key"
+	KeyOMDBAPIKey      = "metadata.omdb.api_key"
+	KeyFanartAPIKey    = "metadata.fanart.api_key"
+	KeySeriesProviders = "metadata.series_providers"
+	KeyArtworkSource   = "metadata.artwork_source"
+	KeyPortalJWTSecret = "portal.jwt_secret"
+)
+
+// Config keys that only take effect after a restart.
+const (
+	KeyServerHost    = "server.host"
+	KeyServerPort    = "server.port"
+	KeyDatabasePath  = "database.path"
+	KeyLoggingFormat = "logging.format"
+	KeyLoggingPath   = "logging.path"
+	KeyBackupDir     = "backup.dir"
+)
+
+// ReloadResult describes which changed keys were applied live and which
+// need a restart to take effect.
+type ReloadResult struct {
+	Applied         []string `json:"applied"`
+	RestartRequired []string `json:"restartRequired"`
+}
+
+// Changed reports whether the reload found any differences.
+func (r *ReloadResult) Changed() bool {
+	return len(r.Applied) > 0 || len(r.RestartRequired) > 0
+}
+
+// Diff compares c with next and sorts the changed keys into those that are
+// safe to apply live and those that need a restart.
+func (c *Config) Diff(next *Config) *ReloadResult {
+	result := &ReloadResult{Applied: []string{}, RestartRequired: []string{}}
+
+	live := []struct {
+		key     string
+		changed bool
+	}{
+		{KeyLoggingLevel, c.Logging.Level != next.Logging.Level},
+		{"metadata.tmdb", c.Metadata.TMDB != next.Metadata.TMDB},
+		{"metadata.tvdb", c.Metadata.TVDB != next.Metadata.TVDB},
+		{"metadata.omdb", c.Metadata.OMDB != next.Metadata.OMDB},
+		{"metadata.tvmaze", c.Metadata.TVMaze != next.Metadata.TVMaze},
+		{"metadata.fanart", c.Metadata.Fanart != next.Metadata.Fanart},
+		{KeyArtworkSource, c.Metadata.ArtworkSource != next.Metadata.ArtworkSource},
+		{KeySeriesProviders, !slices.Equal(c.Metadata.SeriesProviders, next.Metadata.SeriesProviders)},
+		{KeyPortalJWTSecret, c.Portal.JWTSecret != next.Portal.JWTSecret},
+	}
+	for _, item := range live {
+		if item.changed {
+			result.Applied = append(result.Applied, item.key)
+		}
+	}
+
+	restart := []struct {
+		key     string
+		changed bool
+	}{
+		{KeyServerHost, c.Server.Host != next.Server.Host},
+		{KeyServerPort, c.Server.Port != next.Server.Port},
+		{KeyDatabasePath, c.Database.Path != next.Database.Path},
+		{KeyLoggingFormat, c.Logging.Format != next.Logging.Format},
+		{KeyLoggingPath, c.Logging.Path != next.Logging.Path},
+		{KeyBackupDir, c.Backup.Dir != next.Backup.Dir},
+	}
+	for _, item := range restart {
+		if item.changed {
+			result.RestartRequired = append(result.RestartRequired, item.key)
+		}
+	}
+
+	return result
+}
+
+// ApplyReloadable copies the settings that are safe to change at runtime
+// from next into c. Restart-only settings keep their running values.
+func (c *Config) ApplyReloadable(next *Config) {
+	c.Logging.Level = next.Logging.Level
+	c.Metadata = next.Metadata
+	c.Portal.JWTSecret = next.Portal.JWTSecret
+	if next.File != "" {
+		c.File = next.File
+	}
+}
+
+// DefaultFile returns where a config file is created when none was found,
+// using the first platform directory Load searches.
+func DefaultFile() string {
+	switch runtime.GOOS {
+	case osWindows:
+		if localAppData := os.Getenv("LOCALAPPDATA"); localAppData != "" {
+			return filepath.Join(localAppData, "SlipStream", "config.yaml")
+		}
+	case osDarwin:
+		if home, err := os.UserHomeDir(); err == nil {
+			return filepath.Join(home, "Library", "Application Support", "SlipStream", "config.yaml")
+		}
+	case osLinux:
+		if configHome := linuxConfigHome(); configHome != "" {
+			return filepath.Join(configHome, "slipstream", "config.yaml")
+		}
+	}
+	return filepath.Join("configs", "config.yaml")
+}
+
+// watchDebounce absorbs the burst of events editors produce when saving.
+const watchDebounce = 500 * time.Millisecond
+
+// Watch calls onChange whenever the config file at path is written,
+// created or replaced, until ctx is cancelled. The parent directory is
+// watched so atomic saves (write to temp, rename over) are picked up.
+func Watch(ctx context.Context, path string, onChange func()) error {
+	abs, err := filepath.Abs(path)
+	if err != nil {
+		return err
+	}
+
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return err
+	}
+	if err := watcher.Add(filepath.Dir(abs)); err != nil {
+		watcher.Close()
+		return err
+	}
+
+	go func() {
+		defer watcher.Close()
+
+		var timer *time.Timer
+		var fire <-chan time.Time
+		for {
+			select {
+			case <-ctx.Done():
+				if timer != nil {
+					timer.Stop()
+				}
+				return
+			case event, ok := <-watcher.Events:
+				if !ok {
+					return
+				}
+				if filepath.Clean(event.Name) != abs || event.Op&(fsnotify.Write|fsnotify.Create) == 0 {
+					continue
+				}
+				if timer == nil {
+					timer = time.NewTimer(watchDebounce)
+				} else {
+					timer.Reset(watchDebounce)
+				}
+				fire = timer.C
+			case <-fire:
+				fire = nil
+				onChange()
+			case _, ok := <-watcher.Errors:
+				if !ok {
+					return
+				}
+			}
+		}
+	}()
+
+	return nil
+}
@@ -100,3 +100,54 @@ func (db *DB) Migrate() error {
 
 	return nil
 }
+
+// LatestMigrationVersion returns the version of the newest embedded migration.
+func LatestMigrationVersion() (int64, error) {
+	goose.SetBaseFS(embedMigrations)
+
+	migrations, err := goose.CollectMigrations("migrations", 0, goose.MaxVersion)
+	if err != nil {
+		return 0, fmt.Errorf("failed to collect migrations: %w", err)
+	}
+	last, err := migrations.Last()
+	if err != nil {
+		return 0, fmt.Errorf("failed to find latest migration: %w", err)
+	}
+	return last.Version, nil
+}
+
+// MigrationVersion returns the newest migration applied to conn. A database
+// that has never been migrated reports version 0.
+func MigrationVersion(ctx context.Context, conn *sql.DB) (int64, error) {
+	var tables int
+	if err := conn.QueryRowContext(ctx,
+		"SELECT COUNT(*) FROM sqlite_master WHERE type = 'table' AND name = 'goose_db_version'",
+	).Scan(&tables); err != nil {
+		return 0, fmt.Errorf("failed to look up migration table: %w", err)
+	}
+	if tables == 0 {
+		return 0, nil
+	}
+
+	var version sql.NullInt64
+	if err := conn.QueryRowContext(ctx,
+		"SELECT MAX(version_id) FROM goose_db_version WHERE is_applied = 1",
+	).Scan(&version); err != nil {
+		return 0, fmt.Errorf("failed to read migration version: %w", err)
+	}
+	return version.Int64, nil
+}
+
+// HasPendingMigrations reports whether an existing database has migrations
+// left to apply. A database that has never been migrated reports false.
+func (db *DB) HasPendingMigrations() (bool, error) {
+	current, err := MigrationVersion(context.Background(), db.conn)
+	if err != nil || current == 0 {
+		return false, err
+	}
+	latest, err := LatestMigrationVersion()
+	if err != nil {
+		return false, err
+	}
+	return current < latest, nil
+}
@@ -108,6 +108,12 @@ func (m *Manager) Migrate() error {
 	return m.prodDB.Migrate()
 }
 
+// HasPendingMigrations reports whether the production database has
+// migrations left to apply.
+func (m *Manager) HasPendingMigrations() (bool, error) {
+	return m.prodDB.HasPendingMigrations()
+}
+
 // Close closes both database connections.
 func (m *Manager) Close() error {
 	m.mu.Lock()
@@ -0,0 +1,16 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE user_preferences (
+    user_id INTEGER PRIMARY KEY REFERENCES portal_users(id) ON DELETE CASCADE,
+    locale TEXT NOT NULL DEFAULT 'en-US',
+    time_format TEXT NOT NULL DEFAULT '12h',        -- '12h' or '24h'
+    week_start TEXT NOT NULL DEFAULT 'sunday',      -- 'sunday' or 'monday'
+    default_library_view TEXT NOT NULL DEFAULT 'grid', -- 'grid' or 'table'
+    updated_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS user_preferences;
+-- +goose StatementEnd
@@ -0,0 +1,20 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE language_profiles (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    name TEXT NOT NULL UNIQUE,
+    languages TEXT NOT NULL DEFAULT '[]',      -- JSON array of audio languages, most preferred first
+    required INTEGER NOT NULL DEFAULT 0,       -- reject releases without an accepted audio language
+    accept_multi INTEGER NOT NULL DEFAULT 1,   -- MULTI / DUAL releases satisfy any language
+    created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+    updated_at DATETIME DEFAULT CURRENT_TIMESTAMP
+);
+-- +goose StatementEnd
+
+ALTER TABLE movies ADD COLUMN language_profile_id INTEGER REFERENCES language_profiles(id) ON DELETE SET NULL;
+ALTER TABLE series ADD COLUMN language_profile_id INTEGER REFERENCES language_profiles(id) ON DELETE SET NULL;
+
+-- +goose Down
+ALTER TABLE series DROP COLUMN language_profile_id;
+ALTER TABLE movies DROP COLUMN language_profile_id;
+DROP TABLE IF EXISTS language_profiles;
@@ -0,0 +1,11 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE prowlarr_indexer_links (
+    prowlarr_indexer_id INTEGER PRIMARY KEY,
+    indexer_id INTEGER NOT NULL UNIQUE REFERENCES indexers(id) ON DELETE CASCADE,
+    synced_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+-- +goose StatementEnd
+
+-- +goose Down
+DROP TABLE IF EXISTS prowlarr_indexer_links;
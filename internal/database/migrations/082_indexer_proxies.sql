@@ -0,0 +1,13 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Per-indexer outbound routing: an HTTP/SOCKS proxy for all requests and a
+-- FlareSolverr endpoint used when a Cloudflare challenge is detected.
+ALTER TABLE indexers ADD COLUMN proxy_url TEXT NOT NULL DEFAULT '';
+ALTER TABLE indexers ADD COLUMN flaresolverr_url TEXT NOT NULL DEFAULT '';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE indexers DROP COLUMN flaresolverr_url;
+ALTER TABLE indexers DROP COLUMN proxy_url;
+-- +goose StatementEnd
@@ -0,0 +1,51 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Quality definitions move from code into the database. Built-in tiers keep
+-- their historical IDs (1-18); admin-defined qualities are numbered from 100
+-- so future built-ins can be added without renumbering.
+CREATE TABLE qualities (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    name TEXT NOT NULL UNIQUE,
+    source TEXT NOT NULL,
+    resolution INTEGER NOT NULL DEFAULT 0,
+    weight INTEGER NOT NULL,
+    match_pattern TEXT NOT NULL DEFAULT '',
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+INSERT INTO qualities (id, name, source, resolution, weight) VALUES
+    (1, 'SDTV', 'tv', 480, 1),
+    (2, 'DVD', 'dvd', 480, 2),
+    (3, 'WEBRip-480p', 'webrip', 480, 3),
+    (4, 'HDTV-720p', 'tv', 720, 4),
+    (5, 'WEBRip-720p', 'webrip', 720, 5),
+    (6, 'WEBDL-720p', 'webdl', 720, 6),
+    (7, 'Bluray-720p', 'bluray', 720, 7),
+    (8, 'HDTV-1080p', 'tv', 1080, 8),
+    (9, 'WEBRip-1080p', 'webrip', 1080, 9),
+    (10, 'WEBDL-1080p', 'webdl', 1080, 10),
+    (11, 'Bluray-1080p', 'bluray', 1080, 11),
+    (12, 'Remux-1080p', 'remux', 1080, 12),
+    (13, 'HDTV-2160p', 'tv', 2160, 13),
+    (14, 'WEBRip-2160p', 'webrip', 2160, 14),
+    (15, 'WEBDL-2160p', 'webdl', 2160, 15),
+    (16, 'Bluray-2160p', 'bluray', 2160, 16),
+    (17, 'Remux-2160p', 'remux', 2160, 17),
+    (18, 'CAM', 'cam', 0, 0);
+
+UPDATE sqlite_sequence SET seq = 99 WHERE name = 'qualities';
+
+-- Profile item order now ranks qualities within a profile. Existing profiles
+-- stored CAM after Remux-2160p, so sort their items by weight to keep the
+-- previous ranking.
+UPDATE quality_profiles SET items = (
+    SELECT json_group_array(json(value)) FROM (
+        SELECT value FROM json_each(quality_profiles.items)
+        ORDER BY json_extract(value, '$.quality.weight'), json_extract(value, '$.quality.id')
+    )
+);
+-- +goose StatementEnd
+
+-- +goose Down
+DROP TABLE IF EXISTS qualities;
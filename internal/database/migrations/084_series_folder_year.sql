@@ -0,0 +1,22 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Include the year in series folders by default so remakes sharing a title
+-- ("Doctor Who (1963)" / "Doctor Who (2005)") never land in the same folder.
+-- Only libraries without any series adopt the new default; existing folder
+-- layouts are left alone.
+UPDATE module_naming_settings
+SET setting_value = '{Series TitleYear}'
+WHERE module_type = 'tv'
+  AND setting_key = 'series-folder'
+  AND setting_value = '{Series Title}'
+  AND NOT EXISTS (SELECT 1 FROM series);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+UPDATE module_naming_settings
+SET setting_value = '{Series Title}'
+WHERE module_type = 'tv'
+  AND setting_key = 'series-folder'
+  AND setting_value = '{Series TitleYear}';
+-- +goose StatementEnd
@@ -0,0 +1,29 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Direct file streaming for external players. Portal users need can_stream;
+-- admins may always stream. Every served response is logged with the bytes
+-- actually written so bandwidth can be accounted per user.
+ALTER TABLE portal_users ADD COLUMN can_stream INTEGER NOT NULL DEFAULT 0;
+
+CREATE TABLE stream_sessions (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    user_id INTEGER REFERENCES portal_users(id) ON DELETE SET NULL,
+    media_type TEXT NOT NULL,
+    file_id INTEGER NOT NULL,
+    bytes_sent INTEGER NOT NULL DEFAULT 0,
+    remote_addr TEXT NOT NULL DEFAULT '',
+    started_at DATETIME NOT NULL,
+    ended_at DATETIME NOT NULL
+);
+
+CREATE INDEX idx_stream_sessions_started_at ON stream_sessions(started_at);
+CREATE INDEX idx_stream_sessions_user_id ON stream_sessions(user_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_stream_sessions_user_id;
+DROP INDEX IF EXISTS idx_stream_sessions_started_at;
+DROP TABLE IF EXISTS stream_sessions;
+ALTER TABLE portal_users DROP COLUMN can_stream;
+-- +goose StatementEnd
@@ -0,0 +1,11 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Remote-to-local path mappings for download clients running on another host
+-- or in a container. Stored as a JSON array of {"remote","local"} pairs.
+ALTER TABLE download_clients ADD COLUMN path_mappings TEXT NOT NULL DEFAULT '[]';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE download_clients DROP COLUMN path_mappings;
+-- +goose StatementEnd
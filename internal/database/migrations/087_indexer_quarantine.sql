@@ -0,0 +1,20 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Malformed search result rows set aside by Cardigann parsing, kept with the
+-- raw HTML/JSON fragment so broken definitions can be inspected.
+CREATE TABLE IF NOT EXISTS indexer_quarantined_rows (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    indexer_id INTEGER NOT NULL REFERENCES indexers(id) ON DELETE CASCADE,
+    query TEXT,
+    reason TEXT NOT NULL,
+    raw_fragment TEXT NOT NULL,
+    created_at DATETIME DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_indexer_quarantined_rows_indexer ON indexer_quarantined_rows(indexer_id, created_at);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS indexer_quarantined_rows;
+-- +goose StatementEnd
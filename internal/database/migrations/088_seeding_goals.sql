@@ -0,0 +1,35 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Seed time goal alongside the existing seed_ratio_target on download clients.
+ALTER TABLE download_clients ADD COLUMN seed_time_target_minutes INTEGER DEFAULT NULL;
+
+-- Remember which indexer a download came from so per-indexer goals apply.
+ALTER TABLE download_mappings ADD COLUMN indexer_id INTEGER DEFAULT NULL;
+
+-- Per-indexer seeding goals. Not a foreign key so that Prowlarr indexer IDs,
+-- which have no local indexers row, can carry goals too.
+CREATE TABLE IF NOT EXISTS indexer_seed_goals (
+    indexer_id INTEGER PRIMARY KEY,
+    seed_ratio_target REAL DEFAULT NULL,
+    seed_time_target_minutes INTEGER DEFAULT NULL,
+    updated_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Imported torrents that are still seeding and waiting to meet their goals.
+CREATE TABLE IF NOT EXISTS seeding_downloads (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    client_id INTEGER NOT NULL REFERENCES download_clients(id) ON DELETE CASCADE,
+    download_id TEXT NOT NULL,
+    indexer_id INTEGER DEFAULT NULL,
+    imported_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE(client_id, download_id)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS seeding_downloads;
+DROP TABLE IF EXISTS indexer_seed_goals;
+ALTER TABLE download_mappings DROP COLUMN indexer_id;
+ALTER TABLE download_clients DROP COLUMN seed_time_target_minutes;
+-- +goose StatementEnd
@@ -0,0 +1,19 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Additional portal users who asked for an already-requested title. The
+-- request's original requester is not stored here; vote count is 1 + rows.
+CREATE TABLE IF NOT EXISTS request_votes (
+    request_id INTEGER NOT NULL REFERENCES requests(id) ON DELETE CASCADE,
+    user_id INTEGER NOT NULL REFERENCES portal_users(id) ON DELETE CASCADE,
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (request_id, user_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_request_votes_user_id ON request_votes(user_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_request_votes_user_id;
+DROP TABLE IF EXISTS request_votes;
+-- +goose StatementEnd
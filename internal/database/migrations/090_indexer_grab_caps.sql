@@ -0,0 +1,16 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Per-indexer caps on automatic grabs. Not a foreign key so that Prowlarr
+-- indexer IDs, which have no local indexers row, can carry caps too.
+CREATE TABLE IF NOT EXISTS indexer_grab_caps (
+    indexer_id INTEGER PRIMARY KEY,
+    max_grabs_per_hour INTEGER NOT NULL DEFAULT 0,
+    max_grabs_per_day INTEGER NOT NULL DEFAULT 0,
+    updated_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS indexer_grab_caps;
+-- +goose StatementEnd
@@ -0,0 +1,15 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Per-category import delay overrides, stored as a JSON array of
+-- {"category","delaySeconds"} pairs, and how torrents that are still seeding
+-- are imported: 'delay' honours the import delay, 'hardlink' imports at once
+-- via hardlink (or copy) and leaves the torrent seeding.
+ALTER TABLE download_clients ADD COLUMN import_delay_overrides TEXT NOT NULL DEFAULT '[]';
+ALTER TABLE download_clients ADD COLUMN seeding_import_mode TEXT NOT NULL DEFAULT 'delay';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE download_clients DROP COLUMN seeding_import_mode;
+ALTER TABLE download_clients DROP COLUMN import_delay_overrides;
+-- +goose StatementEnd
@@ -0,0 +1,11 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Episode still image URL from the metadata provider, alongside the season
+-- poster_url already stored on seasons.
+ALTER TABLE episodes ADD COLUMN still_url TEXT;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE episodes DROP COLUMN still_url;
+-- +goose StatementEnd
@@ -0,0 +1,48 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE tags (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    label TEXT NOT NULL UNIQUE COLLATE NOCASE,
+    created_at DATETIME DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Tag assignments for movies, series, indexers and download clients.
+-- Notifications keep their tag IDs in notifications.tags.
+CREATE TABLE entity_tags (
+    tag_id INTEGER NOT NULL REFERENCES tags(id) ON DELETE CASCADE,
+    entity_type TEXT NOT NULL CHECK (entity_type IN ('movie', 'series', 'indexer', 'download_client')),
+    entity_id INTEGER NOT NULL,
+    PRIMARY KEY (tag_id, entity_type, entity_id)
+);
+CREATE INDEX idx_entity_tags_entity ON entity_tags(entity_type, entity_id);
+
+CREATE TRIGGER movies_delete_tags AFTER DELETE ON movies
+BEGIN
+    DELETE FROM entity_tags WHERE entity_type = 'movie' AND entity_id = OLD.id;
+END;
+
+CREATE TRIGGER series_delete_tags AFTER DELETE ON series
+BEGIN
+    DELETE FROM entity_tags WHERE entity_type = 'series' AND entity_id = OLD.id;
+END;
+
+CREATE TRIGGER indexers_delete_tags AFTER DELETE ON indexers
+BEGIN
+    DELETE FROM entity_tags WHERE entity_type = 'indexer' AND entity_id = OLD.id;
+END;
+
+CREATE TRIGGER download_clients_delete_tags AFTER DELETE ON download_clients
+BEGIN
+    DELETE FROM entity_tags WHERE entity_type = 'download_client' AND entity_id = OLD.id;
+END;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TRIGGER IF EXISTS download_clients_delete_tags;
+DROP TRIGGER IF EXISTS indexers_delete_tags;
+DROP TRIGGER IF EXISTS series_delete_tags;
+DROP TRIGGER IF EXISTS movies_delete_tags;
+DROP TABLE IF EXISTS entity_tags;
+DROP TABLE IF EXISTS tags;
+-- +goose StatementEnd
@@ -0,0 +1,24 @@
+-- +goose Up
+-- +goose StatementBegin
+-- One row per automatic match decision, marked corrected when a manual import
+-- later sends the same file somewhere else.
+CREATE TABLE match_outcomes (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    source_path TEXT NOT NULL,
+    match_source TEXT NOT NULL,
+    media_type TEXT NOT NULL,
+    media_id INTEGER NOT NULL,
+    outcome TEXT NOT NULL CHECK (outcome IN ('accepted', 'corrected')),
+    corrected_media_type TEXT,
+    corrected_media_id INTEGER,
+    created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+    corrected_at DATETIME
+);
+CREATE INDEX idx_match_outcomes_source_path ON match_outcomes(source_path);
+CREATE INDEX idx_match_outcomes_created_at ON match_outcomes(created_at);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS match_outcomes;
+-- +goose StatementEnd
@@ -0,0 +1,24 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Releases selected by automatic search but held for admin approval. One
+-- pending grab per item and slot (slot_id 0 when not in multi-version mode);
+-- a newer selection replaces the held one.
+CREATE TABLE IF NOT EXISTS pending_grabs (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    media_type TEXT NOT NULL,
+    media_id INTEGER NOT NULL,
+    slot_id INTEGER NOT NULL DEFAULT 0,
+    release_title TEXT NOT NULL,
+    indexer_name TEXT NOT NULL DEFAULT '',
+    source TEXT NOT NULL,
+    score REAL NOT NULL DEFAULT 0,
+    request TEXT NOT NULL,
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (media_type, media_id, slot_id)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS pending_grabs;
+-- +goose StatementEnd
@@ -0,0 +1,20 @@
+-- +goose Up
+-- +goose StatementBegin
+-- A file covering several episodes (S01E01-E02) has one episode_files row per
+-- episode. The row of the first episode is the primary; the others point at it
+-- so the group is renamed, upgraded and removed together. Not a foreign key so
+-- the column can be dropped again; the tv service removes linked rows.
+ALTER TABLE episode_files ADD COLUMN primary_file_id INTEGER DEFAULT NULL;
+CREATE INDEX IF NOT EXISTS idx_episode_files_primary_file_id ON episode_files(primary_file_id);
+
+-- Link rows already sharing a path, keeping the oldest row as the primary.
+UPDATE episode_files
+SET primary_file_id = (SELECT MIN(ef.id) FROM episode_files ef WHERE ef.path = episode_files.path)
+WHERE id > (SELECT MIN(ef.id) FROM episode_files ef WHERE ef.path = episode_files.path);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_episode_files_primary_file_id;
+ALTER TABLE episode_files DROP COLUMN primary_file_id;
+-- +goose StatementEnd
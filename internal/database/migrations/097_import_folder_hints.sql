@@ -0,0 +1,24 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Hints that guide video file discovery in completed downloads, keyed by the
+-- indexer ID or the lowercased release group. content_path is the folder,
+-- relative to the download, that holds the main files; skip_patterns is a JSON
+-- array of globs for junk. Learned rows are refreshed from successful imports;
+-- configured rows (learned = 0) are never overwritten by learning.
+CREATE TABLE IF NOT EXISTS import_folder_hints (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    scope TEXT NOT NULL CHECK (scope IN ('indexer', 'release_group')),
+    scope_key TEXT NOT NULL,
+    content_path TEXT NOT NULL DEFAULT '',
+    skip_patterns TEXT NOT NULL DEFAULT '[]',
+    learned BOOLEAN NOT NULL DEFAULT 0,
+    hits INTEGER NOT NULL DEFAULT 0,
+    updated_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (scope, scope_key)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS import_folder_hints;
+-- +goose StatementEnd
@@ -0,0 +1,25 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Restricted API keys for the embeddable request widget. Only a SHA-256 hash
+-- of the key is stored; key_prefix identifies it in the admin UI. Requests
+-- made through a key are filed as user_id, so that user's quotas and
+-- auto-approve settings apply. allowed_origins is a JSON array of origins
+-- allowed to call the widget API from a browser.
+CREATE TABLE IF NOT EXISTS portal_widget_keys (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    name TEXT NOT NULL,
+    key_hash TEXT NOT NULL UNIQUE,
+    key_prefix TEXT NOT NULL,
+    user_id INTEGER NOT NULL REFERENCES portal_users(id) ON DELETE CASCADE,
+    allowed_origins TEXT NOT NULL DEFAULT '[]',
+    rate_limit_per_minute INTEGER NOT NULL DEFAULT 10,
+    enabled BOOLEAN NOT NULL DEFAULT 1,
+    last_used_at DATETIME,
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS portal_widget_keys;
+-- +goose StatementEnd
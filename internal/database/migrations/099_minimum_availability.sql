@@ -0,0 +1,9 @@
+-- +goose Up
+-- How far along its release an item must be before automatic searches run:
+-- announced, in_cinemas, released, or physical. NULL uses the global default.
+ALTER TABLE movies ADD COLUMN minimum_availability TEXT;
+ALTER TABLE series ADD COLUMN minimum_availability TEXT;
+
+-- +goose Down
+ALTER TABLE series DROP COLUMN minimum_availability;
+ALTER TABLE movies DROP COLUMN minimum_availability;
@@ -0,0 +1,9 @@
+-- +goose Up
+-- Total capacity of the volume holding each root folder, and whether the
+-- folder could be reached at the last check.
+ALTER TABLE root_folders ADD COLUMN total_space INTEGER;
+ALTER TABLE root_folders ADD COLUMN unreachable BOOLEAN NOT NULL DEFAULT 0;
+
+-- +goose Down
+ALTER TABLE root_folders DROP COLUMN unreachable;
+ALTER TABLE root_folders DROP COLUMN total_space;
@@ -0,0 +1,26 @@
+-- +goose Up
+-- Indexes behind the filtered, sorted and paged library and history lists.
+CREATE INDEX IF NOT EXISTS idx_movies_sort_title ON movies(sort_title);
+CREATE INDEX IF NOT EXISTS idx_movies_added_at ON movies(added_at);
+CREATE INDEX IF NOT EXISTS idx_movies_root_folder ON movies(root_folder_id);
+CREATE INDEX IF NOT EXISTS idx_movies_quality_profile ON movies(quality_profile_id);
+CREATE INDEX IF NOT EXISTS idx_series_sort_title ON series(sort_title);
+CREATE INDEX IF NOT EXISTS idx_series_added_at ON series(added_at);
+CREATE INDEX IF NOT EXISTS idx_series_root_folder ON series(root_folder_id);
+CREATE INDEX IF NOT EXISTS idx_series_quality_profile ON series(quality_profile_id);
+CREATE INDEX IF NOT EXISTS idx_episodes_series_status ON episodes(series_id, status);
+CREATE INDEX IF NOT EXISTS idx_history_event_type_created ON history(event_type, created_at);
+CREATE INDEX IF NOT EXISTS idx_history_entity_type_created ON history(entity_type, created_at);
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_history_entity_type_created;
+DROP INDEX IF EXISTS idx_history_event_type_created;
+DROP INDEX IF EXISTS idx_episodes_series_status;
+DROP INDEX IF EXISTS idx_series_quality_profile;
+DROP INDEX IF EXISTS idx_series_root_folder;
+DROP INDEX IF EXISTS idx_series_added_at;
+DROP INDEX IF EXISTS idx_series_sort_title;
+DROP INDEX IF EXISTS idx_movies_quality_profile;
+DROP INDEX IF EXISTS idx_movies_root_folder;
+DROP INDEX IF EXISTS idx_movies_added_at;
+DROP INDEX IF EXISTS idx_movies_sort_title;
@@ -0,0 +1,35 @@
+-- +goose Up
+-- +goose StatementBegin
+-- AKA titles and translations from the metadata providers. clean_title is the
+-- accent-folded, punctuation-free lookup key used by the release and import
+-- matchers.
+CREATE TABLE alternate_titles (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    media_type TEXT NOT NULL CHECK (media_type IN ('movie', 'series')),
+    media_id INTEGER NOT NULL,
+    title TEXT NOT NULL,
+    clean_title TEXT NOT NULL,
+    locale TEXT NOT NULL DEFAULT '',
+    kind TEXT NOT NULL DEFAULT 'alternative',
+    source TEXT NOT NULL DEFAULT '',
+    UNIQUE (media_type, media_id, clean_title)
+);
+CREATE INDEX idx_alternate_titles_clean_title ON alternate_titles(media_type, clean_title);
+
+CREATE TRIGGER movies_delete_alternate_titles AFTER DELETE ON movies
+BEGIN
+    DELETE FROM alternate_titles WHERE media_type = 'movie' AND media_id = OLD.id;
+END;
+
+CREATE TRIGGER series_delete_alternate_titles AFTER DELETE ON series
+BEGIN
+    DELETE FROM alternate_titles WHERE media_type = 'series' AND media_id = OLD.id;
+END;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TRIGGER IF EXISTS series_delete_alternate_titles;
+DROP TRIGGER IF EXISTS movies_delete_alternate_titles;
+DROP TABLE IF EXISTS alternate_titles;
+-- +goose StatementEnd
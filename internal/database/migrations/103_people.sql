@@ -0,0 +1,45 @@
+-- +goose Up
+-- +goose StatementBegin
+-- People credited on library items, keyed by TMDB person ID. Rows are shared
+-- across items and dropped once no credit references them.
+CREATE TABLE people (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    tmdb_id INTEGER NOT NULL UNIQUE,
+    name TEXT NOT NULL,
+    photo_url TEXT NOT NULL DEFAULT '',
+    updated_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+CREATE INDEX idx_people_name ON people(name);
+
+-- One person's credit on a movie or series. department is cast, director,
+-- writer or creator; role is the character or job.
+CREATE TABLE media_credits (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    person_id INTEGER NOT NULL REFERENCES people(id) ON DELETE CASCADE,
+    media_type TEXT NOT NULL CHECK (media_type IN ('movie', 'series')),
+    media_id INTEGER NOT NULL,
+    department TEXT NOT NULL CHECK (department IN ('cast', 'director', 'writer', 'creator')),
+    role TEXT NOT NULL DEFAULT '',
+    sort_order INTEGER NOT NULL DEFAULT 0,
+    UNIQUE (person_id, media_type, media_id, department)
+);
+CREATE INDEX idx_media_credits_media ON media_credits(media_type, media_id);
+
+CREATE TRIGGER movies_delete_media_credits AFTER DELETE ON movies
+BEGIN
+    DELETE FROM media_credits WHERE media_type = 'movie' AND media_id = OLD.id;
+END;
+
+CREATE TRIGGER series_delete_media_credits AFTER DELETE ON series
+BEGIN
+    DELETE FROM media_credits WHERE media_type = 'series' AND media_id = OLD.id;
+END;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TRIGGER IF EXISTS series_delete_media_credits;
+DROP TRIGGER IF EXISTS movies_delete_media_credits;
+DROP TABLE IF EXISTS media_credits;
+DROP TABLE IF EXISTS people;
+-- +goose StatementEnd
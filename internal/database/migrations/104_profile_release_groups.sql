@@ -0,0 +1,7 @@
+-- +goose Up
+-- Preferred and forbidden release groups per quality profile, stored as JSON
+-- ({"preferred": [...], "forbidden": [...]}).
+ALTER TABLE quality_profiles ADD COLUMN release_groups TEXT NOT NULL DEFAULT '';
+
+-- +goose Down
+ALTER TABLE quality_profiles DROP COLUMN release_groups;
@@ -0,0 +1,9 @@
+-- +goose Up
+-- Edition cut of each movie file (Director's Cut, Extended, IMAX, ...) and the
+-- cut a movie should be searched for. NULL preference accepts any edition.
+ALTER TABLE movies ADD COLUMN edition_preference TEXT;
+ALTER TABLE movie_files ADD COLUMN edition TEXT NOT NULL DEFAULT '';
+
+-- +goose Down
+ALTER TABLE movie_files DROP COLUMN edition;
+ALTER TABLE movies DROP COLUMN edition_preference;
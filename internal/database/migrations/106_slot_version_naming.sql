@@ -0,0 +1,9 @@
+-- +goose Up
+-- How files of different version slots are kept apart on disk: 'none' relies on
+-- the naming format alone, 'suffix' appends " - [Slot Name]" to the filename and
+-- 'subfolder' places each slot's file in a folder named after the slot.
+ALTER TABLE multi_version_settings ADD COLUMN version_naming TEXT NOT NULL DEFAULT 'none'
+    CHECK (version_naming IN ('none', 'suffix', 'subfolder'));
+
+-- +goose Down
+ALTER TABLE multi_version_settings DROP COLUMN version_naming;
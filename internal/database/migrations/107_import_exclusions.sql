@@ -0,0 +1,21 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Things the importer must never pick up on its own. kind 'path' excludes a
+-- file or everything below a directory, 'folder' excludes any path containing a
+-- folder with that name and 'title' excludes files whose parsed title (and year,
+-- when non-zero) match. value is stored normalized for comparison.
+CREATE TABLE IF NOT EXISTS import_exclusions (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    kind TEXT NOT NULL CHECK (kind IN ('path', 'folder', 'title')),
+    value TEXT NOT NULL,
+    year INTEGER NOT NULL DEFAULT 0,
+    reason TEXT NOT NULL DEFAULT '',
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (kind, value, year)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS import_exclusions;
+-- +goose StatementEnd
@@ -0,0 +1,13 @@
+-- +goose Up
+-- Probe details used by the renamer: the Dolby Vision profile ("5", "8.1") and
+-- every audio track as a JSON array of {codec, channels, language}.
+ALTER TABLE movie_files ADD COLUMN dolby_vision_profile TEXT NOT NULL DEFAULT '';
+ALTER TABLE movie_files ADD COLUMN audio_tracks TEXT NOT NULL DEFAULT '[]';
+ALTER TABLE episode_files ADD COLUMN dolby_vision_profile TEXT NOT NULL DEFAULT '';
+ALTER TABLE episode_files ADD COLUMN audio_tracks TEXT NOT NULL DEFAULT '[]';
+
+-- +goose Down
+ALTER TABLE episode_files DROP COLUMN audio_tracks;
+ALTER TABLE episode_files DROP COLUMN dolby_vision_profile;
+ALTER TABLE movie_files DROP COLUMN audio_tracks;
+ALTER TABLE movie_files DROP COLUMN dolby_vision_profile;
@@ -0,0 +1,7 @@
+-- +goose Up
+-- Freeleech and internal flag preferences per quality profile, stored as JSON
+-- ({"freeleech": "prefer", "internal": "require"}).
+ALTER TABLE quality_profiles ADD COLUMN indexer_flags TEXT NOT NULL DEFAULT '';
+
+-- +goose Down
+ALTER TABLE quality_profiles DROP COLUMN indexer_flags;
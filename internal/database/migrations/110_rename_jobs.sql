@@ -0,0 +1,23 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Mass renames in progress. file_ids is the JSON list of files to rename in
+-- order and next_index the first one not yet processed, so a rename
+-- interrupted by a restart picks up where it stopped. Rows are removed when a
+-- rename finishes or is cancelled.
+CREATE TABLE IF NOT EXISTS rename_jobs (
+    id TEXT PRIMARY KEY,
+    media_type TEXT NOT NULL CHECK (media_type IN ('episode', 'movie')),
+    file_ids TEXT NOT NULL,
+    next_index INTEGER NOT NULL DEFAULT 0,
+    succeeded INTEGER NOT NULL DEFAULT 0,
+    failed INTEGER NOT NULL DEFAULT 0,
+    skipped INTEGER NOT NULL DEFAULT 0,
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS rename_jobs;
+-- +goose StatementEnd
@@ -0,0 +1,21 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Upgrade imports in flight. The new file is written to temp_path and renamed
+-- over dest_path once complete; the row is removed after the replaced file is
+-- cleaned up, so an upgrade interrupted by a crash can be rolled back or
+-- finished on startup.
+CREATE TABLE IF NOT EXISTS pending_upgrades (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    media_type TEXT NOT NULL CHECK (media_type IN ('episode', 'movie')),
+    old_file_id INTEGER,
+    previous_path TEXT NOT NULL,
+    temp_path TEXT NOT NULL,
+    dest_path TEXT NOT NULL,
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS pending_upgrades;
+-- +goose StatementEnd
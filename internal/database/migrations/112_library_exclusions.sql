@@ -0,0 +1,20 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Movies and series removed from the library that automated additions such
+-- as *arr imports must not add back. external_id is the TMDB ID for movies
+-- and the TVDB ID for series.
+CREATE TABLE IF NOT EXISTS library_exclusions (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    media_type TEXT NOT NULL CHECK (media_type IN ('movie', 'series')),
+    external_id INTEGER NOT NULL,
+    title TEXT NOT NULL,
+    year INTEGER NOT NULL DEFAULT 0,
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (media_type, external_id)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS library_exclusions;
+-- +goose StatementEnd
@@ -0,0 +1,19 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Dismissals and mutes of health issues, kept across restarts.
+-- dismissed_signature is the status and message the issue had when it was
+-- dismissed; the dismissal lapses once either changes.
+CREATE TABLE IF NOT EXISTS health_issue_states (
+    category TEXT NOT NULL,
+    item_id TEXT NOT NULL,
+    dismissed_signature TEXT,
+    muted_until DATETIME,
+    updated_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (category, item_id)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS health_issue_states;
+-- +goose StatementEnd
@@ -0,0 +1,21 @@
+-- name: ListAlternateTitles :many
+SELECT * FROM alternate_titles
+WHERE media_type = ? AND media_id = ?
+ORDER BY kind, locale, title;
+
+-- name: ListAlternateTitlesByMediaType :many
+SELECT media_id, clean_title FROM alternate_titles
+WHERE media_type = ?
+ORDER BY media_id;
+
+-- name: ListAlternateTitleMediaIDs :many
+SELECT DISTINCT media_id FROM alternate_titles
+WHERE media_type = ? AND clean_title = ?
+ORDER BY media_id;
+
+-- name: CreateAlternateTitle :exec
+INSERT OR IGNORE INTO alternate_titles (media_type, media_id, title, clean_title, locale, kind, source)
+VALUES (?, ?, ?, ?, ?, ?, ?);
+
+-- name: DeleteAlternateTitles :exec
+DELETE FROM alternate_titles WHERE media_type = ? AND media_id = ?;
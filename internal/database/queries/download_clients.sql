@@ -10,8 +10,9 @@ SELECT * FROM download_clients WHERE enabled = 1 ORDER BY priority, name;
 -- name: CreateDownloadClient :one
 INSERT INTO download_clients (
     name, type, host, port, username, password, use_ssl, api_key, category, url_base,
-    priority, enabled, import_delay_seconds, cleanup_mode, seed_ratio_target
-) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+    priority, enabled, import_delay_seconds, cleanup_mode, seed_ratio_target, path_mappings,
+    seed_time_target_minutes, import_delay_overrides, seeding_import_mode
+) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
 RETURNING *;
 
 -- name: UpdateDownloadClient :one
@@ -31,6 +32,10 @@ UPDATE download_clients SET
     import_delay_seconds = ?,
     cleanup_mode = ?,
     seed_ratio_target = ?,
+    path_mappings = ?,
+    seed_time_target_minutes = ?,
+    import_delay_overrides = ?,
+    seeding_import_mode = ?,
     updated_at = CURRENT_TIMESTAMP
 WHERE id = ?
 RETURNING *;
@@ -1,9 +1,9 @@
 -- name: CreateDownloadMapping :one
 INSERT INTO download_mappings (
     client_id, download_id, module_type, entity_type, entity_id, season_number,
-    is_season_pack, is_complete_series, target_slot_id, source
+    is_season_pack, is_complete_series, target_slot_id, source, indexer_id
 ) VALUES (
-    ?, ?, ?, ?, ?, ?, ?, ?, ?, ?
+    ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?
 )
 ON CONFLICT (client_id, download_id) DO UPDATE SET
     module_type = excluded.module_type,
@@ -14,6 +14,7 @@ ON CONFLICT (client_id, download_id) DO UPDATE SET
     is_complete_series = excluded.is_complete_series,
     target_slot_id = excluded.target_slot_id,
     source = excluded.source,
+    indexer_id = excluded.indexer_id,
     import_attempts = 0,
     last_import_error = NULL,
     next_import_retry_at = NULL
@@ -77,3 +78,19 @@ SET import_attempts = import_attempts + 1,
     next_import_retry_at = ?
 WHERE client_id = ? AND download_id = ?
 RETURNING import_attempts;
+
+-- name: UpdateDownloadMappingTarget :one
+-- Point an active download at a different library item before it is imported
+UPDATE download_mappings SET
+    module_type = ?,
+    entity_type = ?,
+    entity_id = ?,
+    season_number = ?,
+    is_season_pack = ?,
+    is_complete_series = 0,
+    target_slot_id = NULL,
+    import_attempts = 0,
+    last_import_error = NULL,
+    next_import_retry_at = NULL
+WHERE client_id = ? AND download_id = ?
+RETURNING *;
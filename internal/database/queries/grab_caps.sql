@@ -0,0 +1,14 @@
+-- name: GetIndexerGrabCap :one
+SELECT * FROM indexer_grab_caps WHERE indexer_id = ? LIMIT 1;
+
+-- name: UpsertIndexerGrabCap :one
+INSERT INTO indexer_grab_caps (indexer_id, max_grabs_per_hour, max_grabs_per_day)
+VALUES (?, ?, ?)
+ON CONFLICT(indexer_id) DO UPDATE SET
+    max_grabs_per_hour = excluded.max_grabs_per_hour,
+    max_grabs_per_day = excluded.max_grabs_per_day,
+    updated_at = CURRENT_TIMESTAMP
+RETURNING *;
+
+-- name: DeleteIndexerGrabCap :exec
+DELETE FROM indexer_grab_caps WHERE indexer_id = ?;
@@ -0,0 +1,31 @@
+-- name: ListHealthIssueStates :many
+SELECT * FROM health_issue_states;
+
+-- name: SetHealthIssueDismissal :exec
+INSERT INTO health_issue_states (category, item_id, dismissed_signature)
+VALUES (?, ?, ?)
+ON CONFLICT (category, item_id) DO UPDATE SET
+    dismissed_signature = excluded.dismissed_signature,
+    updated_at = CURRENT_TIMESTAMP;
+
+-- name: SetHealthIssueMute :exec
+INSERT INTO health_issue_states (category, item_id, muted_until)
+VALUES (?, ?, ?)
+ON CONFLICT (category, item_id) DO UPDATE SET
+    muted_until = excluded.muted_until,
+    updated_at = CURRENT_TIMESTAMP;
+
+-- name: ClearHealthIssueDismissal :exec
+UPDATE health_issue_states SET dismissed_signature = NULL, updated_at = CURRENT_TIMESTAMP
+WHERE category = ? AND item_id = ?;
+
+-- name: ClearHealthIssueMute :exec
+UPDATE health_issue_states SET muted_until = NULL, updated_at = CURRENT_TIMESTAMP
+WHERE category = ? AND item_id = ?;
+
+-- name: DeleteHealthIssueState :exec
+DELETE FROM health_issue_states WHERE category = ? AND item_id = ?;
+
+-- name: DeleteClearedHealthIssueStates :exec
+DELETE FROM health_issue_states
+WHERE dismissed_signature IS NULL AND muted_until IS NULL;
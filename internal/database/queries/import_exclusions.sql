@@ -0,0 +1,15 @@
+-- name: ListImportExclusions :many
+SELECT * FROM import_exclusions ORDER BY kind, value, year;
+
+-- name: GetImportExclusion :one
+SELECT * FROM import_exclusions WHERE id = ? LIMIT 1;
+
+-- name: CreateImportExclusion :one
+INSERT INTO import_exclusions (kind, value, year, reason)
+VALUES (?, ?, ?, ?)
+ON CONFLICT(kind, value, year) DO UPDATE SET
+    reason = excluded.reason
+RETURNING *;
+
+-- name: DeleteImportExclusion :exec
+DELETE FROM import_exclusions WHERE id = ?;
@@ -0,0 +1,31 @@
+-- name: ListImportFolderHints :many
+SELECT * FROM import_folder_hints ORDER BY scope, scope_key;
+
+-- name: GetImportFolderHint :one
+SELECT * FROM import_folder_hints WHERE id = ? LIMIT 1;
+
+-- name: GetImportFolderHintByScope :one
+SELECT * FROM import_folder_hints WHERE scope = ? AND scope_key = ? LIMIT 1;
+
+-- name: UpsertImportFolderHint :one
+INSERT INTO import_folder_hints (scope, scope_key, content_path, skip_patterns, learned, hits)
+VALUES (?, ?, ?, ?, 0, 0)
+ON CONFLICT(scope, scope_key) DO UPDATE SET
+    content_path = excluded.content_path,
+    skip_patterns = excluded.skip_patterns,
+    learned = 0,
+    updated_at = CURRENT_TIMESTAMP
+RETURNING *;
+
+-- name: LearnImportFolderHint :exec
+INSERT INTO import_folder_hints (scope, scope_key, content_path, learned, hits)
+VALUES (?, ?, ?, 1, 1)
+ON CONFLICT(scope, scope_key) DO UPDATE SET
+    hits = CASE WHEN import_folder_hints.content_path = excluded.content_path
+        THEN import_folder_hints.hits + 1 ELSE 1 END,
+    content_path = excluded.content_path,
+    updated_at = CURRENT_TIMESTAMP
+WHERE import_folder_hints.learned = 1;
+
+-- name: DeleteImportFolderHint :exec
+DELETE FROM import_folder_hints WHERE id = ?;
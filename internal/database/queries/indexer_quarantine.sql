@@ -0,0 +1,21 @@
+-- name: CreateIndexerQuarantinedRow :exec
+INSERT INTO indexer_quarantined_rows (indexer_id, query, reason, raw_fragment)
+VALUES (?, ?, ?, ?);
+
+-- name: ListIndexerQuarantinedRows :many
+SELECT * FROM indexer_quarantined_rows
+WHERE indexer_id = ?
+ORDER BY created_at DESC, id DESC
+LIMIT ?;
+
+-- name: CountIndexerQuarantinedRows :many
+SELECT indexer_id, COUNT(*) AS quarantined
+FROM indexer_quarantined_rows
+WHERE created_at > ?
+GROUP BY indexer_id;
+
+-- name: DeleteIndexerQuarantinedRows :exec
+DELETE FROM indexer_quarantined_rows WHERE indexer_id = ?;
+
+-- name: DeleteOldIndexerQuarantinedRows :exec
+DELETE FROM indexer_quarantined_rows WHERE created_at < ?;
@@ -21,8 +21,8 @@ SELECT * FROM indexers WHERE definition_id = ? ORDER BY priority, name;
 
 -- name: CreateIndexer :one
 INSERT INTO indexers (
-    name, definition_id, settings, categories, supports_movies, supports_tv, priority, enabled, auto_search_enabled, rss_enabled
-) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+    name, definition_id, settings, categories, supports_movies, supports_tv, priority, enabled, auto_search_enabled, rss_enabled, proxy_url, flaresolverr_url
+) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
 RETURNING *;
 
 -- name: UpdateIndexer :one
@@ -37,6 +37,8 @@ UPDATE indexers SET
     enabled = ?,
     auto_search_enabled = ?,
     rss_enabled = ?,
+    proxy_url = ?,
+    flaresolverr_url = ?,
     updated_at = CURRENT_TIMESTAMP
 WHERE id = ?
 RETURNING *;
@@ -101,6 +103,7 @@ LIMIT ? OFFSET ?;
 
 -- name: ListRecentIndexerHistory :many
 SELECT * FROM indexer_history
+WHERE event_type = ?
 ORDER BY created_at DESC
 LIMIT ? OFFSET ?;
 
@@ -125,6 +128,30 @@ WHERE indexer_id = ?;
 -- name: DeleteOldIndexerHistory :exec
 DELETE FROM indexer_history WHERE created_at < ?;
 
+-- name: CountIndexerQueryResults :one
+SELECT
+    COUNT(*) AS total,
+    CAST(COALESCE(SUM(CASE WHEN successful = 0 THEN 1 ELSE 0 END), 0) AS INTEGER) AS failed
+FROM indexer_history
+WHERE indexer_id = ? AND event_type = 'query' AND created_at > ?;
+
+-- name: ListIndexerStats :many
+SELECT
+    i.id AS indexer_id,
+    i.name AS indexer_name,
+    CAST(COALESCE(SUM(CASE WHEN h.event_type = 'query' THEN 1 ELSE 0 END), 0) AS INTEGER) AS total_queries,
+    CAST(COALESCE(SUM(CASE WHEN h.event_type = 'query' AND h.successful = 0 THEN 1 ELSE 0 END), 0) AS INTEGER) AS failed_queries,
+    CAST(COALESCE(AVG(CASE WHEN h.event_type = 'query' AND h.successful = 1 THEN h.elapsed_ms END), 0) AS REAL) AS avg_response_ms,
+    CAST(COALESCE(SUM(CASE WHEN h.event_type = 'grab' THEN 1 ELSE 0 END), 0) AS INTEGER) AS total_grabs,
+    CAST(COALESCE(SUM(CASE WHEN h.event_type = 'grab' AND h.successful = 0 THEN 1 ELSE 0 END), 0) AS INTEGER) AS failed_grabs,
+    s.escalation_level,
+    s.disabled_till
+FROM indexers i
+LEFT JOIN indexer_history h ON h.indexer_id = i.id AND h.created_at > ?
+LEFT JOIN indexer_status s ON s.indexer_id = i.id
+GROUP BY i.id
+ORDER BY i.name;
+
 -- Definition Metadata queries
 
 -- name: GetDefinitionMetadata :one
@@ -0,0 +1,33 @@
+-- name: GetLanguageProfile :one
+SELECT * FROM language_profiles WHERE id = ? LIMIT 1;
+
+-- name: ListLanguageProfiles :many
+SELECT * FROM language_profiles ORDER BY name;
+
+-- name: CreateLanguageProfile :one
+INSERT INTO language_profiles (name, languages, required, accept_multi)
+VALUES (?, ?, ?, ?)
+RETURNING *;
+
+-- name: UpdateLanguageProfile :one
+UPDATE language_profiles SET
+    name = ?,
+    languages = ?,
+    required = ?,
+    accept_multi = ?,
+    updated_at = CURRENT_TIMESTAMP
+WHERE id = ?
+RETURNING *;
+
+-- name: DeleteLanguageProfile :exec
+DELETE FROM language_profiles WHERE id = ?;
+
+-- name: GetLanguageProfileForMovie :one
+SELECT lp.* FROM language_profiles lp
+JOIN movies m ON m.language_profile_id = lp.id
+WHERE m.id = ?;
+
+-- name: GetLanguageProfileForSeries :one
+SELECT lp.* FROM language_profiles lp
+JOIN series s ON s.language_profile_id = lp.id
+WHERE s.id = ?;
@@ -0,0 +1,14 @@
+-- name: ListLibraryExclusions :many
+SELECT * FROM library_exclusions ORDER BY title;
+
+-- name: GetLibraryExclusion :one
+SELECT * FROM library_exclusions WHERE media_type = ? AND external_id = ?;
+
+-- name: CreateLibraryExclusion :one
+INSERT INTO library_exclusions (media_type, external_id, title, year)
+VALUES (?, ?, ?, ?)
+ON CONFLICT (media_type, external_id) DO UPDATE SET title = excluded.title, year = excluded.year
+RETURNING *;
+
+-- name: DeleteLibraryExclusion :execrows
+DELETE FROM library_exclusions WHERE id = ?;
@@ -0,0 +1,25 @@
+-- name: CreateMatchOutcome :one
+INSERT INTO match_outcomes (source_path, match_source, media_type, media_id, outcome)
+VALUES (?, ?, ?, ?, ?)
+RETURNING *;
+
+-- name: GetLatestMatchOutcome :one
+SELECT * FROM match_outcomes WHERE source_path = ? ORDER BY id DESC LIMIT 1;
+
+-- name: MarkMatchOutcomeCorrected :exec
+UPDATE match_outcomes SET
+    outcome = 'corrected',
+    corrected_media_type = ?,
+    corrected_media_id = ?,
+    corrected_at = CURRENT_TIMESTAMP
+WHERE id = ?;
+
+-- name: GetMatchAccuracyBySource :many
+SELECT
+    match_source,
+    COUNT(*) AS total,
+    CAST(COALESCE(SUM(CASE WHEN outcome = 'corrected' THEN 1 ELSE 0 END), 0) AS INTEGER) AS corrected
+FROM match_outcomes
+WHERE created_at >= datetime('now', ?)
+GROUP BY match_source
+ORDER BY match_source;
@@ -12,6 +12,9 @@ SELECT * FROM movies WHERE tmdb_id = ? LIMIT 1;
 -- name: ListMovies :many
 SELECT * FROM movies ORDER BY sort_title;
 
+-- name: ListMoviesByIDs :many
+SELECT * FROM movies WHERE id IN (sqlc.slice('ids'));
+
 -- name: ListMonitoredMovies :many
 SELECT * FROM movies WHERE monitored = 1 ORDER BY sort_title;
 
@@ -20,8 +23,8 @@ INSERT INTO movies (
     title, sort_title, year, tmdb_id, imdb_id, overview, runtime,
     path, root_folder_id, quality_profile_id, monitored, status,
     release_date, physical_release_date, theatrical_release_date,
-    studio, tvdb_id, content_rating, added_by
-) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+    studio, tvdb_id, content_rating, added_by, language_profile_id
+) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
 RETURNING *;
 
 -- name: UpdateMovie :one
@@ -36,6 +39,8 @@ UPDATE movies SET
     path = ?,
     root_folder_id = ?,
     quality_profile_id = ?,
+    language_profile_id = ?,
+    minimum_availability = ?,
     monitored = ?,
     status = ?,
     release_date = ?,
@@ -44,6 +49,7 @@ UPDATE movies SET
     studio = ?,
     tvdb_id = ?,
     content_rating = ?,
+    edition_preference = ?,
     updated_at = CURRENT_TIMESTAMP
 WHERE id = ?
 RETURNING *;
@@ -63,6 +69,9 @@ SELECT COUNT(*) FROM movies;
 -- name: CountMonitoredMovies :one
 SELECT COUNT(*) FROM movies WHERE monitored = 1;
 
+-- name: CountMoviesByStatus :one
+SELECT COUNT(*) FROM movies WHERE status = ?;
+
 -- name: SearchMovies :many
 SELECT * FROM movies
 WHERE title LIKE sqlc.arg(search_term) OR sort_title LIKE sqlc.arg(search_term)
@@ -76,6 +85,67 @@ SELECT * FROM movies
 ORDER BY sort_title
 LIMIT ? OFFSET ?;
 
+-- name: ListMoviesFiltered :many
+-- Filters, sorts and pages the library in SQL. Empty or NULL arguments
+-- disable their filter; a limit of -1 returns every match. The options CTE
+-- binds the arguments once so the WHERE and ORDER BY can refer to them.
+WITH o AS (
+    SELECT CAST(sqlc.arg(search_term) AS TEXT) AS search_term,
+           CAST(sqlc.arg(statuses) AS TEXT) AS statuses,
+           CAST(sqlc.narg(monitored) AS BOOLEAN) AS monitored,
+           CAST(sqlc.narg(root_folder_id) AS INTEGER) AS root_folder_id,
+           CAST(sqlc.narg(quality_profile_id) AS INTEGER) AS quality_profile_id,
+           CAST(sqlc.narg(tag_id) AS INTEGER) AS tag_id,
+           CAST(sqlc.arg(sort_key) AS TEXT) AS sort_key,
+           CAST(sqlc.arg(descending) AS BOOLEAN) AS descending
+)
+SELECT m.* FROM movies m, o
+WHERE (o.search_term = ''
+       OR m.title LIKE '%' || o.search_term || '%' OR m.sort_title LIKE '%' || o.search_term || '%'
+       OR REPLACE(m.title, '''', '') LIKE '%' || o.search_term || '%'
+       OR REPLACE(m.sort_title, '''', '') LIKE '%' || o.search_term || '%')
+  AND (o.monitored IS NULL OR m.monitored = o.monitored)
+  AND (o.root_folder_id IS NULL OR m.root_folder_id = o.root_folder_id)
+  AND (o.quality_profile_id IS NULL OR m.quality_profile_id = o.quality_profile_id)
+  AND (o.tag_id IS NULL OR EXISTS (
+       SELECT 1 FROM entity_tags et
+       WHERE et.entity_type = 'movie' AND et.entity_id = m.id AND et.tag_id = o.tag_id))
+  AND (o.statuses = '' OR instr(',' || o.statuses || ',', ',' || m.status || ',') > 0)
+ORDER BY
+    CASE WHEN o.sort_key = 'year' AND NOT o.descending THEN m.year END ASC,
+    CASE WHEN o.sort_key = 'year' AND o.descending THEN m.year END DESC,
+    CASE WHEN o.sort_key = 'added' AND NOT o.descending THEN m.added_at END ASC,
+    CASE WHEN o.sort_key = 'added' AND o.descending THEN m.added_at END DESC,
+    CASE WHEN o.sort_key = 'releaseDate' AND NOT o.descending THEN m.release_date END ASC,
+    CASE WHEN o.sort_key = 'releaseDate' AND o.descending THEN m.release_date END DESC,
+    CASE WHEN o.sort_key = 'status' AND NOT o.descending THEN m.status END ASC,
+    CASE WHEN o.sort_key = 'status' AND o.descending THEN m.status END DESC,
+    CASE WHEN o.sort_key = 'title' AND o.descending THEN m.sort_title END DESC,
+    m.sort_title, m.id
+LIMIT sqlc.arg(lim) OFFSET sqlc.arg(off);
+
+-- name: CountMoviesFiltered :one
+WITH o AS (
+    SELECT CAST(sqlc.arg(search_term) AS TEXT) AS search_term,
+           CAST(sqlc.arg(statuses) AS TEXT) AS statuses,
+           CAST(sqlc.narg(monitored) AS BOOLEAN) AS monitored,
+           CAST(sqlc.narg(root_folder_id) AS INTEGER) AS root_folder_id,
+           CAST(sqlc.narg(quality_profile_id) AS INTEGER) AS quality_profile_id,
+           CAST(sqlc.narg(tag_id) AS INTEGER) AS tag_id
+)
+SELECT COUNT(*) FROM movies m, o
+WHERE (o.search_term = ''
+       OR m.title LIKE '%' || o.search_term || '%' OR m.sort_title LIKE '%' || o.search_term || '%'
+       OR REPLACE(m.title, '''', '') LIKE '%' || o.search_term || '%'
+       OR REPLACE(m.sort_title, '''', '') LIKE '%' || o.search_term || '%')
+  AND (o.monitored IS NULL OR m.monitored = o.monitored)
+  AND (o.root_folder_id IS NULL OR m.root_folder_id = o.root_folder_id)
+  AND (o.quality_profile_id IS NULL OR m.quality_profile_id = o.quality_profile_id)
+  AND (o.tag_id IS NULL OR EXISTS (
+       SELECT 1 FROM entity_tags et
+       WHERE et.entity_type = 'movie' AND et.entity_id = m.id AND et.tag_id = o.tag_id))
+  AND (o.statuses = '' OR instr(',' || o.statuses || ',', ',' || m.status || ',') > 0);
+
 -- name: GetMovieByPath :one
 SELECT * FROM movies WHERE path = ? LIMIT 1;
 
@@ -103,9 +173,12 @@ SELECT * FROM movie_files WHERE id = ? LIMIT 1;
 -- name: ListMovieFiles :many
 SELECT * FROM movie_files WHERE movie_id = ? ORDER BY COALESCE(quality_id, 0) DESC, id DESC;
 
+-- name: ListMovieFilesByMovieIDs :many
+SELECT * FROM movie_files WHERE movie_id IN (sqlc.slice('movieIds')) ORDER BY movie_id, COALESCE(quality_id, 0) DESC, id DESC;
+
 -- name: CreateMovieFile :one
-INSERT INTO movie_files (movie_id, path, size, quality, quality_id, video_codec, audio_codec, resolution, audio_channels, dynamic_range)
-VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+INSERT INTO movie_files (movie_id, path, size, quality, quality_id, video_codec, audio_codec, resolution, audio_channels, dynamic_range, edition)
+VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
 RETURNING *;
 
 -- name: DeleteMovieFile :exec
@@ -177,7 +250,9 @@ WHERE m.status IN ('missing', 'failed')
 
 -- Upgrade candidate queries (status-based)
 -- name: ListMovieUpgradeCandidates :many
-SELECT m.*, mf.quality_id as current_quality_id FROM movies m
+SELECT m.*, mf.quality_id as current_quality_id,
+       mf.path as current_file_path, mf.original_filename as current_original_filename
+FROM movies m
 JOIN movie_files mf ON mf.id = (
     SELECT id FROM movie_files WHERE movie_id = m.id ORDER BY id DESC LIMIT 1
 )
@@ -204,8 +279,8 @@ UPDATE movie_files SET quality_id = ? WHERE id = ?;
 -- name: CreateMovieFileWithImportInfo :one
 INSERT INTO movie_files (
     movie_id, path, size, quality, quality_id, video_codec, audio_codec, resolution,
-    audio_channels, dynamic_range, original_path, original_filename, imported_at
-) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+    audio_channels, dynamic_range, original_path, original_filename, imported_at, edition
+) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
 RETURNING *;
 
 -- name: UpdateMovieFileImportInfo :one
@@ -219,14 +294,31 @@ RETURNING *;
 -- name: GetMovieFilesWithImportInfo :many
 SELECT * FROM movie_files WHERE movie_id = ? ORDER BY imported_at DESC;
 
+-- name: UpdateMovieFolder :exec
+UPDATE movies SET path = ?, root_folder_id = ?, updated_at = CURRENT_TIMESTAMP WHERE id = ?;
+
 -- name: UpdateMovieFilePath :exec
 UPDATE movie_files SET path = ? WHERE id = ?;
 
+-- name: UpdateMovieFileDiskInfo :exec
+UPDATE movie_files SET
+    size = ?,
+    video_codec = ?,
+    audio_codec = ?,
+    resolution = ?,
+    audio_channels = ?,
+    dynamic_range = ?
+WHERE id = ?;
+
 -- name: UpdateMovieFileMediaInfo :exec
 UPDATE movie_files SET
     video_codec = ?,
     audio_codec = ?,
-    resolution = ?
+    resolution = ?,
+    audio_channels = ?,
+    dynamic_range = ?,
+    dolby_vision_profile = ?,
+    audio_tracks = ?
 WHERE movie_id = ?;
 
 -- name: GetMovieFileByOriginalPath :one
@@ -249,9 +341,18 @@ JOIN movies m ON mf.movie_id = m.id
 WHERE m.root_folder_id = ?
   AND m.status IN ('available', 'upgradable');
 
+-- name: ListMovieFilesForQualityAudit :many
+SELECT mf.id as file_id, mf.path, mf.size, mf.quality_id, mf.video_codec, mf.resolution,
+       m.id as movie_id, m.title, m.runtime
+FROM movie_files mf
+JOIN movies m ON mf.movie_id = m.id
+WHERE mf.quality_id IS NOT NULL
+ORDER BY m.sort_title, mf.id;
+
 -- Upgradable movies with current file quality
 -- name: ListUpgradableMoviesWithQuality :many
-SELECT m.*, mf.quality_id as current_quality_id
+SELECT m.*, mf.quality_id as current_quality_id,
+       mf.path as current_file_path, mf.original_filename as current_original_filename
 FROM movies m
 JOIN movie_files mf ON mf.id = (
     SELECT id FROM movie_files WHERE movie_id = m.id ORDER BY id DESC LIMIT 1
@@ -259,6 +360,19 @@ JOIN movie_files mf ON mf.id = (
 WHERE m.status = 'upgradable' AND m.monitored = 1
 ORDER BY m.release_date DESC;
 
+-- Movies whose file met the cutoff, watched by RSS sync for PROPER/REPACK replacements
+-- name: ListProperCandidateMovies :many
+SELECT m.id, m.title, m.year, m.tmdb_id, m.imdb_id, m.runtime, m.quality_profile_id, m.edition_preference,
+       mf.quality_id as current_quality_id,
+       mf.path as current_file_path, mf.original_filename as current_original_filename
+FROM movies m
+JOIN movie_files mf ON mf.id = (
+    SELECT id FROM movie_files WHERE movie_id = m.id ORDER BY id DESC LIMIT 1
+)
+WHERE m.status = 'available' AND m.monitored = 1
+  AND mf.quality_id IS NOT NULL
+  AND mf.imported_at >= sqlc.arg(imported_since);
+
 -- name: UpdateMoviesMonitoredByIDs :exec
 UPDATE movies SET monitored = ?, updated_at = CURRENT_TIMESTAMP WHERE id IN (sqlc.slice('ids'));
 
@@ -0,0 +1,20 @@
+-- name: UpsertPendingGrab :one
+INSERT INTO pending_grabs (media_type, media_id, slot_id, release_title, indexer_name, source, score, request)
+VALUES (?, ?, ?, ?, ?, ?, ?, ?)
+ON CONFLICT(media_type, media_id, slot_id) DO UPDATE SET
+    release_title = excluded.release_title,
+    indexer_name = excluded.indexer_name,
+    source = excluded.source,
+    score = excluded.score,
+    request = excluded.request,
+    created_at = CURRENT_TIMESTAMP
+RETURNING *;
+
+-- name: GetPendingGrab :one
+SELECT * FROM pending_grabs WHERE id = ? LIMIT 1;
+
+-- name: ListPendingGrabs :many
+SELECT * FROM pending_grabs ORDER BY created_at DESC, id DESC;
+
+-- name: DeletePendingGrab :exec
+DELETE FROM pending_grabs WHERE id = ?;
@@ -0,0 +1,10 @@
+-- name: ListPendingUpgrades :many
+SELECT * FROM pending_upgrades ORDER BY id;
+
+-- name: CreatePendingUpgrade :one
+INSERT INTO pending_upgrades (media_type, old_file_id, previous_path, temp_path, dest_path)
+VALUES (?, ?, ?, ?, ?)
+RETURNING *;
+
+-- name: DeletePendingUpgrade :exec
+DELETE FROM pending_upgrades WHERE id = ?;
@@ -0,0 +1,46 @@
+-- name: UpsertPerson :one
+INSERT INTO people (tmdb_id, name, photo_url)
+VALUES (?, ?, ?)
+ON CONFLICT (tmdb_id) DO UPDATE SET
+    name = excluded.name,
+    photo_url = CASE WHEN excluded.photo_url != '' THEN excluded.photo_url ELSE people.photo_url END,
+    updated_at = CURRENT_TIMESTAMP
+RETURNING *;
+
+-- name: GetPersonByTmdbID :one
+SELECT * FROM people WHERE tmdb_id = ?;
+
+-- name: SearchPeople :many
+SELECT p.id, p.tmdb_id, p.name, p.photo_url,
+    COUNT(DISTINCT c.media_type || ':' || c.media_id) AS work_count
+FROM people p
+JOIN media_credits c ON c.person_id = p.id
+WHERE p.name LIKE sqlc.arg(name_pattern)
+  AND (CAST(sqlc.arg(department) AS TEXT) = '' OR c.department = sqlc.arg(department))
+GROUP BY p.id
+ORDER BY work_count DESC, p.name
+LIMIT sqlc.arg(row_limit);
+
+-- name: CreateMediaCredit :exec
+INSERT OR IGNORE INTO media_credits (person_id, media_type, media_id, department, role, sort_order)
+VALUES (?, ?, ?, ?, ?, ?);
+
+-- name: DeleteMediaCredits :exec
+DELETE FROM media_credits WHERE media_type = ? AND media_id = ?;
+
+-- name: ListPersonMovieCredits :many
+SELECT c.department, c.role, m.id AS movie_id, m.title, m.year, m.tmdb_id
+FROM media_credits c
+JOIN movies m ON m.id = c.media_id
+WHERE c.media_type = 'movie' AND c.person_id = ?
+ORDER BY m.year DESC, m.title, c.department;
+
+-- name: ListPersonSeriesCredits :many
+SELECT c.department, c.role, s.id AS series_id, s.title, s.year, s.tmdb_id, s.tvdb_id
+FROM media_credits c
+JOIN series s ON s.id = c.media_id
+WHERE c.media_type = 'series' AND c.person_id = ?
+ORDER BY s.year DESC, s.title, c.department;
+
+-- name: DeleteOrphanedPeople :execrows
+DELETE FROM people WHERE id NOT IN (SELECT DISTINCT person_id FROM media_credits);
@@ -35,11 +35,18 @@ RETURNING *;
 
 -- name: IncrementUserModuleQuota :one
 UPDATE portal_user_module_settings SET
-    quota_used = quota_used + 1,
+    quota_used = quota_used + sqlc.arg(amount),
     updated_at = CURRENT_TIMESTAMP
 WHERE user_id = ? AND module_type = ?
 RETURNING *;
 
+-- name: DecrementUserModuleQuota :one
+UPDATE portal_user_module_settings SET
+    quota_used = MAX(quota_used - sqlc.arg(amount), 0),
+    updated_at = CURRENT_TIMESTAMP
+WHERE user_id = sqlc.arg(user_id) AND module_type = sqlc.arg(module_type)
+RETURNING *;
+
 -- name: ResetUserModuleQuota :one
 UPDATE portal_user_module_settings SET
     quota_used = 0,
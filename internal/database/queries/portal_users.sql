@@ -36,6 +36,13 @@ UPDATE portal_users SET
 WHERE id = ?
 RETURNING *;
 
+-- name: UpdatePortalUserCanStream :one
+UPDATE portal_users SET
+    can_stream = ?,
+    updated_at = CURRENT_TIMESTAMP
+WHERE id = ?
+RETURNING *;
+
 -- name: UpdatePortalUserEnabled :one
 UPDATE portal_users SET
     enabled = ?,
@@ -0,0 +1,32 @@
+-- name: ListPortalWidgetKeys :many
+SELECT * FROM portal_widget_keys ORDER BY name, id;
+
+-- name: ListEnabledPortalWidgetKeys :many
+SELECT * FROM portal_widget_keys WHERE enabled = 1;
+
+-- name: GetPortalWidgetKey :one
+SELECT * FROM portal_widget_keys WHERE id = ? LIMIT 1;
+
+-- name: GetPortalWidgetKeyByHash :one
+SELECT * FROM portal_widget_keys WHERE key_hash = ? LIMIT 1;
+
+-- name: CreatePortalWidgetKey :one
+INSERT INTO portal_widget_keys (name, key_hash, key_prefix, user_id, allowed_origins, rate_limit_per_minute, enabled)
+VALUES (?, ?, ?, ?, ?, ?, ?)
+RETURNING *;
+
+-- name: UpdatePortalWidgetKey :one
+UPDATE portal_widget_keys SET
+    name = ?,
+    user_id = ?,
+    allowed_origins = ?,
+    rate_limit_per_minute = ?,
+    enabled = ?
+WHERE id = ?
+RETURNING *;
+
+-- name: TouchPortalWidgetKey :exec
+UPDATE portal_widget_keys SET last_used_at = CURRENT_TIMESTAMP WHERE id = ?;
+
+-- name: DeletePortalWidgetKey :exec
+DELETE FROM portal_widget_keys WHERE id = ?;
@@ -0,0 +1,12 @@
+-- name: ListProwlarrIndexerLinks :many
+SELECT * FROM prowlarr_indexer_links ORDER BY prowlarr_indexer_id;
+
+-- name: UpsertProwlarrIndexerLink :exec
+INSERT INTO prowlarr_indexer_links (prowlarr_indexer_id, indexer_id, synced_at)
+VALUES (?, ?, CURRENT_TIMESTAMP)
+ON CONFLICT(prowlarr_indexer_id) DO UPDATE SET
+    indexer_id = excluded.indexer_id,
+    synced_at = CURRENT_TIMESTAMP;
+
+-- name: DeleteProwlarrIndexerLink :exec
+DELETE FROM prowlarr_indexer_links WHERE prowlarr_indexer_id = ?;
@@ -0,0 +1,35 @@
+-- name: ListQualities :many
+SELECT * FROM qualities ORDER BY weight, id;
+
+-- name: GetQuality :one
+SELECT * FROM qualities WHERE id = ? LIMIT 1;
+
+-- name: GetQualityByName :one
+SELECT * FROM qualities WHERE name = ? LIMIT 1;
+
+-- name: CreateQuality :one
+INSERT INTO qualities (name, source, resolution, weight, match_pattern)
+VALUES (?, ?, ?, ?, ?)
+RETURNING *;
+
+-- name: UpdateQuality :one
+UPDATE qualities SET
+    name = ?,
+    source = ?,
+    resolution = ?,
+    weight = ?,
+    match_pattern = ?,
+    updated_at = CURRENT_TIMESTAMP
+WHERE id = ?
+RETURNING *;
+
+-- name: DeleteQuality :exec
+DELETE FROM qualities WHERE id = ?;
+
+-- name: CountFilesUsingQuality :one
+SELECT
+    (SELECT COUNT(*) FROM movie_files WHERE movie_files.quality_id = ?) +
+    (SELECT COUNT(*) FROM episode_files WHERE episode_files.quality_id = ?) AS count;
+
+-- name: CountQualityProfilesWithCutoff :one
+SELECT COUNT(*) FROM quality_profiles WHERE cutoff = ?;
@@ -0,0 +1,19 @@
+-- name: ListRenameJobs :many
+SELECT * FROM rename_jobs ORDER BY created_at;
+
+-- name: CreateRenameJob :one
+INSERT INTO rename_jobs (id, media_type, file_ids)
+VALUES (?, ?, ?)
+RETURNING *;
+
+-- name: UpdateRenameJobProgress :exec
+UPDATE rename_jobs SET
+    next_index = ?,
+    succeeded = ?,
+    failed = ?,
+    skipped = ?,
+    updated_at = CURRENT_TIMESTAMP
+WHERE id = ?;
+
+-- name: DeleteRenameJob :exec
+DELETE FROM rename_jobs WHERE id = ?;
@@ -0,0 +1,34 @@
+-- name: CreateRequestVote :exec
+INSERT INTO request_votes (request_id, user_id)
+VALUES (?, ?)
+ON CONFLICT(request_id, user_id) DO NOTHING;
+
+-- name: DeleteRequestVote :exec
+DELETE FROM request_votes
+WHERE request_id = ? AND user_id = ?;
+
+-- name: ListRequestVotes :many
+SELECT * FROM request_votes
+WHERE request_id = ?
+ORDER BY created_at, user_id;
+
+-- name: HasVotedForRequest :one
+SELECT EXISTS(
+    SELECT 1 FROM request_votes
+    WHERE request_id = ? AND user_id = ?
+) AS has_voted;
+
+-- name: ListActiveRequestVoteCounts :many
+-- Vote totals (original requester plus additional votes) for requests that
+-- are linked to library media and still waiting on a download.
+SELECT r.module_type, r.media_id, 1 + COUNT(v.user_id) AS votes
+FROM requests r
+LEFT JOIN request_votes v ON v.request_id = r.id
+WHERE r.media_id IS NOT NULL AND r.status IN ('approved', 'searching', 'downloading')
+GROUP BY r.id;
+
+-- name: ListUserVotedRequests :many
+SELECT r.* FROM requests r
+JOIN request_votes rv ON r.id = rv.request_id
+WHERE rv.user_id = ?
+ORDER BY r.created_at DESC;
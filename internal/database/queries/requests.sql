@@ -3,22 +3,22 @@ SELECT * FROM requests WHERE id = ? LIMIT 1;
 
 -- name: GetRequestByTmdbID :one
 SELECT * FROM requests
-WHERE tmdb_id = ? AND entity_type = ? AND status NOT IN ('denied', 'available')
+WHERE tmdb_id = ? AND entity_type = ? AND status NOT IN ('denied', 'available') AND target_slot_id IS ?
 LIMIT 1;
 
 -- name: GetRequestByTvdbID :one
 SELECT * FROM requests
-WHERE tvdb_id = ? AND entity_type = ? AND status NOT IN ('denied', 'available')
+WHERE tvdb_id = ? AND entity_type = ? AND status NOT IN ('denied', 'available') AND target_slot_id IS ?
 LIMIT 1;
 
 -- name: GetRequestByTvdbIDAndSeason :one
 SELECT * FROM requests
-WHERE tvdb_id = ? AND entity_type = 'season' AND season_number = ? AND status NOT IN ('denied', 'available')
+WHERE tvdb_id = ? AND entity_type = 'season' AND season_number = ? AND status NOT IN ('denied', 'available') AND target_slot_id IS ?
 LIMIT 1;
 
 -- name: GetRequestByTvdbIDAndEpisode :one
 SELECT * FROM requests
-WHERE tvdb_id = ? AND entity_type = 'episode' AND season_number = ? AND episode_number = ? AND status NOT IN ('denied', 'available')
+WHERE tvdb_id = ? AND entity_type = 'episode' AND season_number = ? AND episode_number = ? AND status NOT IN ('denied', 'available') AND target_slot_id IS ?
 LIMIT 1;
 
 -- name: ListRequests :many
@@ -63,13 +63,15 @@ UPDATE requests SET
 WHERE id = ?
 RETURNING *;
 
+-- Approve and deny only apply while the request is still in from_status, so
+-- two admins acting at once cannot both move it.
 -- name: ApproveRequest :one
 UPDATE requests SET
     status = 'approved',
     approved_at = CURRENT_TIMESTAMP,
     approved_by = ?,
     updated_at = CURRENT_TIMESTAMP
-WHERE id = ?
+WHERE id = ? AND status = sqlc.arg(from_status)
 RETURNING *;
 
 -- name: DenyRequest :one
@@ -77,7 +79,7 @@ UPDATE requests SET
     status = 'denied',
     denied_reason = ?,
     updated_at = CURRENT_TIMESTAMP
-WHERE id = ?
+WHERE id = ? AND status = sqlc.arg(from_status)
 RETURNING *;
 
 -- name: LinkRequestToMedia :one
@@ -152,3 +154,10 @@ WHERE tvdb_id = ?
   AND entity_type IN ('series', 'season')
   AND status NOT IN ('denied', 'available')
 ORDER BY created_at DESC;
+
+-- name: UpdateRequestOwner :one
+UPDATE requests SET
+    user_id = ?,
+    updated_at = CURRENT_TIMESTAMP
+WHERE id = ?
+RETURNING *;
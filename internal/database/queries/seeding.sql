@@ -0,0 +1,29 @@
+-- name: GetIndexerSeedGoal :one
+SELECT * FROM indexer_seed_goals WHERE indexer_id = ? LIMIT 1;
+
+-- name: ListIndexerSeedGoals :many
+SELECT * FROM indexer_seed_goals ORDER BY indexer_id;
+
+-- name: UpsertIndexerSeedGoal :one
+INSERT INTO indexer_seed_goals (indexer_id, seed_ratio_target, seed_time_target_minutes)
+VALUES (?, ?, ?)
+ON CONFLICT(indexer_id) DO UPDATE SET
+    seed_ratio_target = excluded.seed_ratio_target,
+    seed_time_target_minutes = excluded.seed_time_target_minutes,
+    updated_at = CURRENT_TIMESTAMP
+RETURNING *;
+
+-- name: DeleteIndexerSeedGoal :exec
+DELETE FROM indexer_seed_goals WHERE indexer_id = ?;
+
+-- name: CreateSeedingDownload :exec
+INSERT INTO seeding_downloads (client_id, download_id, indexer_id)
+VALUES (?, ?, ?)
+ON CONFLICT(client_id, download_id) DO UPDATE SET
+    indexer_id = excluded.indexer_id;
+
+-- name: ListSeedingDownloads :many
+SELECT * FROM seeding_downloads ORDER BY imported_at, id;
+
+-- name: DeleteSeedingDownload :exec
+DELETE FROM seeding_downloads WHERE client_id = ? AND download_id = ?;
@@ -12,6 +12,9 @@ SELECT * FROM series WHERE tvdb_id = ? LIMIT 1;
 -- name: ListSeries :many
 SELECT * FROM series ORDER BY sort_title;
 
+-- name: ListSeriesByIDs :many
+SELECT * FROM series WHERE id IN (sqlc.slice('ids'));
+
 -- name: ListMonitoredSeries :many
 SELECT * FROM series WHERE monitored = 1 ORDER BY sort_title;
 
@@ -19,8 +22,8 @@ SELECT * FROM series WHERE monitored = 1 ORDER BY sort_title;
 INSERT INTO series (
     title, sort_title, year, tvdb_id, tmdb_id, imdb_id, overview, runtime,
     path, root_folder_id, quality_profile_id, monitored, season_folder, production_status, network, format_type,
-    network_logo_url, added_by
-) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+    network_logo_url, added_by, language_profile_id
+) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
 RETURNING *;
 
 -- name: UpdateSeries :one
@@ -36,6 +39,8 @@ UPDATE series SET
     path = ?,
     root_folder_id = ?,
     quality_profile_id = ?,
+    language_profile_id = ?,
+    minimum_availability = ?,
     monitored = ?,
     season_folder = ?,
     production_status = ?,
@@ -62,6 +67,9 @@ SELECT * FROM episodes WHERE id = ? LIMIT 1;
 -- name: ListEpisodesBySeries :many
 SELECT * FROM episodes WHERE series_id = ? ORDER BY season_number, episode_number;
 
+-- name: ListEpisodesBySeriesIDs :many
+SELECT * FROM episodes WHERE series_id IN (sqlc.slice('seriesIds')) ORDER BY series_id, season_number, episode_number;
+
 -- name: ListEpisodesBySeason :many
 SELECT * FROM episodes WHERE series_id = ? AND season_number = ? ORDER BY episode_number;
 
@@ -103,6 +111,9 @@ SELECT * FROM seasons WHERE id = ? LIMIT 1;
 -- name: ListSeasonsBySeries :many
 SELECT * FROM seasons WHERE series_id = ? ORDER BY season_number;
 
+-- name: ListSeasonsBySeriesIDs :many
+SELECT * FROM seasons WHERE series_id IN (sqlc.slice('seriesIds')) ORDER BY series_id, season_number;
+
 -- name: CreateSeason :one
 INSERT INTO seasons (series_id, season_number, monitored)
 VALUES (?, ?, ?)
@@ -124,6 +135,73 @@ SELECT * FROM series
 ORDER BY sort_title
 LIMIT ? OFFSET ?;
 
+-- name: ListSeriesFiltered :many
+-- Filters, sorts and pages the library in SQL. Empty or NULL arguments
+-- disable their filter; a limit of -1 returns every match. The options CTE
+-- binds the arguments once so the WHERE and ORDER BY can refer to them.
+WITH o AS (
+    SELECT CAST(sqlc.arg(search_term) AS TEXT) AS search_term,
+           CAST(sqlc.arg(production_status) AS TEXT) AS production_status,
+           CAST(sqlc.narg(monitored) AS BOOLEAN) AS monitored,
+           CAST(sqlc.narg(root_folder_id) AS INTEGER) AS root_folder_id,
+           CAST(sqlc.narg(quality_profile_id) AS INTEGER) AS quality_profile_id,
+           CAST(sqlc.narg(missing) AS BOOLEAN) AS missing,
+           CAST(sqlc.narg(tag_id) AS INTEGER) AS tag_id,
+           CAST(sqlc.arg(sort_key) AS TEXT) AS sort_key,
+           CAST(sqlc.arg(descending) AS BOOLEAN) AS descending
+)
+SELECT s.* FROM series s, o
+WHERE (o.search_term = ''
+       OR s.title LIKE '%' || o.search_term || '%' OR s.sort_title LIKE '%' || o.search_term || '%'
+       OR REPLACE(s.title, '''', '') LIKE '%' || o.search_term || '%'
+       OR REPLACE(s.sort_title, '''', '') LIKE '%' || o.search_term || '%')
+  AND (o.monitored IS NULL OR s.monitored = o.monitored)
+  AND (o.root_folder_id IS NULL OR s.root_folder_id = o.root_folder_id)
+  AND (o.quality_profile_id IS NULL OR s.quality_profile_id = o.quality_profile_id)
+  AND (o.production_status = '' OR lower(s.production_status) = lower(o.production_status))
+  AND (o.missing IS NULL OR o.missing = EXISTS (
+       SELECT 1 FROM episodes e
+       WHERE e.series_id = s.id AND e.season_number > 0 AND e.status = 'missing'))
+  AND (o.tag_id IS NULL OR EXISTS (
+       SELECT 1 FROM entity_tags et
+       WHERE et.entity_type = 'series' AND et.entity_id = s.id AND et.tag_id = o.tag_id))
+ORDER BY
+    CASE WHEN o.sort_key = 'year' AND NOT o.descending THEN s.year END ASC,
+    CASE WHEN o.sort_key = 'year' AND o.descending THEN s.year END DESC,
+    CASE WHEN o.sort_key = 'added' AND NOT o.descending THEN s.added_at END ASC,
+    CASE WHEN o.sort_key = 'added' AND o.descending THEN s.added_at END DESC,
+    CASE WHEN o.sort_key = 'network' AND NOT o.descending THEN s.network END ASC,
+    CASE WHEN o.sort_key = 'network' AND o.descending THEN s.network END DESC,
+    CASE WHEN o.sort_key = 'title' AND o.descending THEN s.sort_title END DESC,
+    s.sort_title, s.id
+LIMIT sqlc.arg(lim) OFFSET sqlc.arg(off);
+
+-- name: CountSeriesFiltered :one
+WITH o AS (
+    SELECT CAST(sqlc.arg(search_term) AS TEXT) AS search_term,
+           CAST(sqlc.arg(production_status) AS TEXT) AS production_status,
+           CAST(sqlc.narg(monitored) AS BOOLEAN) AS monitored,
+           CAST(sqlc.narg(root_folder_id) AS INTEGER) AS root_folder_id,
+           CAST(sqlc.narg(quality_profile_id) AS INTEGER) AS quality_profile_id,
+           CAST(sqlc.narg(missing) AS BOOLEAN) AS missing,
+           CAST(sqlc.narg(tag_id) AS INTEGER) AS tag_id
+)
+SELECT COUNT(*) FROM series s, o
+WHERE (o.search_term = ''
+       OR s.title LIKE '%' || o.search_term || '%' OR s.sort_title LIKE '%' || o.search_term || '%'
+       OR REPLACE(s.title, '''', '') LIKE '%' || o.search_term || '%'
+       OR REPLACE(s.sort_title, '''', '') LIKE '%' || o.search_term || '%')
+  AND (o.monitored IS NULL OR s.monitored = o.monitored)
+  AND (o.root_folder_id IS NULL OR s.root_folder_id = o.root_folder_id)
+  AND (o.quality_profile_id IS NULL OR s.quality_profile_id = o.quality_profile_id)
+  AND (o.production_status = '' OR lower(s.production_status) = lower(o.production_status))
+  AND (o.missing IS NULL OR o.missing = EXISTS (
+       SELECT 1 FROM episodes e
+       WHERE e.series_id = s.id AND e.season_number > 0 AND e.status = 'missing'))
+  AND (o.tag_id IS NULL OR EXISTS (
+       SELECT 1 FROM entity_tags et
+       WHERE et.entity_type = 'series' AND et.entity_id = s.id AND et.tag_id = o.tag_id));
+
 -- name: GetSeriesByPath :one
 SELECT * FROM series WHERE path = ? LIMIT 1;
 
@@ -147,6 +225,21 @@ SELECT * FROM episodes
 WHERE series_id = ? AND season_number = ? AND episode_number = ?
 LIMIT 1;
 
+-- name: GetEpisodeByAbsoluteNumber :one
+-- Absolute numbering counts regular-season episodes in order, skipping specials.
+SELECT * FROM episodes
+WHERE series_id = ? AND season_number > 0
+ORDER BY season_number, episode_number
+LIMIT 1 OFFSET ?;
+
+-- name: GetEpisodeAbsoluteNumber :one
+SELECT COUNT(*) FROM episodes
+WHERE series_id = sqlc.arg(series_id) AND season_number > 0
+  AND (season_number < sqlc.arg(season_number) OR (season_number = sqlc.arg(season_number) AND episode_number <= sqlc.arg(episode_number)));
+
+-- name: CountEpisodesByStatus :one
+SELECT COUNT(*) FROM episodes WHERE status = ?;
+
 -- name: CountEpisodesBySeries :one
 SELECT COUNT(*) FROM episodes WHERE series_id = ? AND season_number > 0;
 
@@ -175,6 +268,9 @@ ORDER BY e.episode_number;
 -- name: ListEpisodeFilesByEpisode :many
 SELECT * FROM episode_files WHERE episode_id = ? ORDER BY COALESCE(quality_id, 0) DESC, id DESC;
 
+-- name: ListEpisodeFilesByEpisodeIDs :many
+SELECT * FROM episode_files WHERE episode_id IN (sqlc.slice('episodeIds')) ORDER BY episode_id, COALESCE(quality_id, 0) DESC, id DESC;
+
 -- name: CreateEpisodeFile :one
 INSERT INTO episode_files (episode_id, path, size, quality, quality_id, video_codec, audio_codec, resolution, audio_channels, dynamic_range)
 VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
@@ -218,12 +314,13 @@ ON CONFLICT(series_id, season_number) DO UPDATE SET
 RETURNING *;
 
 -- name: UpsertEpisode :one
-INSERT INTO episodes (series_id, season_number, episode_number, title, overview, air_date, monitored, status)
-VALUES (?, ?, ?, ?, ?, ?, ?, ?)
+INSERT INTO episodes (series_id, season_number, episode_number, title, overview, air_date, monitored, status, still_url)
+VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)
 ON CONFLICT(series_id, season_number, episode_number) DO UPDATE SET
     title = COALESCE(excluded.title, episodes.title),
     overview = COALESCE(excluded.overview, episodes.overview),
-    air_date = COALESCE(excluded.air_date, episodes.air_date)
+    air_date = COALESCE(excluded.air_date, episodes.air_date),
+    still_url = COALESCE(excluded.still_url, episodes.still_url)
 RETURNING *;
 
 -- name: UpdateSeasonMetadata :one
@@ -315,6 +412,7 @@ SELECT
     s.tmdb_id as series_tmdb_id,
     s.imdb_id as series_imdb_id,
     s.year as series_year,
+    s.runtime as series_runtime,
     s.quality_profile_id as series_quality_profile_id
 FROM episodes e
 JOIN series s ON e.series_id = s.id
@@ -370,8 +468,11 @@ SELECT
     s.tmdb_id as series_tmdb_id,
     s.imdb_id as series_imdb_id,
     s.year as series_year,
+    s.runtime as series_runtime,
     s.quality_profile_id as series_quality_profile_id,
-    ef.quality_id as current_quality_id
+    ef.quality_id as current_quality_id,
+    ef.path as current_file_path,
+    ef.original_filename as current_original_filename
 FROM episodes e
 JOIN series s ON e.series_id = s.id
 JOIN seasons sea ON e.series_id = sea.series_id AND e.season_number = sea.season_number
@@ -397,8 +498,10 @@ WHERE e.status = 'upgradable'
 -- name: ListUpgradableEpisodesWithQuality :many
 SELECT e.*, s.title as series_title, s.tvdb_id as series_tvdb_id,
        s.tmdb_id as series_tmdb_id, s.imdb_id as series_imdb_id,
-       s.year as series_year, s.quality_profile_id as series_quality_profile_id,
-       ef.quality_id as current_quality_id
+       s.year as series_year, s.runtime as series_runtime,
+       s.quality_profile_id as series_quality_profile_id,
+       ef.quality_id as current_quality_id,
+       ef.path as current_file_path, ef.original_filename as current_original_filename
 FROM episodes e
 JOIN series s ON e.series_id = s.id
 JOIN seasons sea ON e.series_id = sea.series_id AND e.season_number = sea.season_number
@@ -408,6 +511,25 @@ JOIN episode_files ef ON ef.id = (
 WHERE e.status = 'upgradable' AND e.monitored = 1 AND s.monitored = 1 AND sea.monitored = 1
 ORDER BY e.air_date DESC;
 
+-- Episodes whose file met the cutoff, watched by RSS sync for PROPER/REPACK replacements
+-- name: ListProperCandidateEpisodes :many
+SELECT e.id, e.series_id, e.season_number, e.episode_number,
+       s.title as series_title, s.tvdb_id as series_tvdb_id,
+       s.tmdb_id as series_tmdb_id, s.imdb_id as series_imdb_id,
+       s.year as series_year, s.runtime as series_runtime,
+       s.quality_profile_id as series_quality_profile_id,
+       ef.quality_id as current_quality_id,
+       ef.path as current_file_path, ef.original_filename as current_original_filename
+FROM episodes e
+JOIN series s ON e.series_id = s.id
+JOIN seasons sea ON e.series_id = sea.series_id AND e.season_number = sea.season_number
+JOIN episode_files ef ON ef.id = (
+    SELECT id FROM episode_files WHERE episode_id = e.id ORDER BY id DESC LIMIT 1
+)
+WHERE e.status = 'available' AND e.monitored = 1 AND s.monitored = 1 AND sea.monitored = 1
+  AND ef.quality_id IS NOT NULL
+  AND ef.imported_at >= sqlc.arg(imported_since);
+
 -- name: ListEpisodeUpgradeCandidatesBySeries :many
 SELECT
     e.*
@@ -528,16 +650,53 @@ UPDATE series SET format_type = ?, updated_at = CURRENT_TIMESTAMP WHERE id = ? R
 -- name: GetSeriesFormatType :one
 SELECT format_type FROM series WHERE id = ?;
 
+-- name: UpdateSeriesFolder :exec
+UPDATE series SET path = ?, root_folder_id = ?, updated_at = CURRENT_TIMESTAMP WHERE id = ?;
+
 -- name: UpdateEpisodeFilePath :exec
 UPDATE episode_files SET path = ? WHERE id = ?;
 
+-- name: UpdateEpisodeFileDiskInfo :exec
+UPDATE episode_files SET
+    size = ?,
+    video_codec = ?,
+    audio_codec = ?,
+    resolution = ?,
+    audio_channels = ?,
+    dynamic_range = ?
+WHERE id = ?;
+
 -- name: UpdateEpisodeFileMediaInfo :exec
 UPDATE episode_files SET
     video_codec = ?,
     audio_codec = ?,
-    resolution = ?
+    resolution = ?,
+    audio_channels = ?,
+    dynamic_range = ?,
+    dolby_vision_profile = ?,
+    audio_tracks = ?
 WHERE episode_id = ?;
 
+-- name: LinkEpisodeFile :exec
+UPDATE episode_files SET primary_file_id = ? WHERE id = ?;
+
+-- name: ListLinkedEpisodeFiles :many
+SELECT * FROM episode_files WHERE primary_file_id = ? ORDER BY id;
+
+-- name: UpdateLinkedEpisodeFilesPath :exec
+UPDATE episode_files SET path = ? WHERE primary_file_id = ?;
+
+-- name: UpdateLinkedEpisodeFilesMediaInfo :exec
+UPDATE episode_files SET
+    video_codec = ?,
+    audio_codec = ?,
+    resolution = ?,
+    audio_channels = ?,
+    dynamic_range = ?,
+    dolby_vision_profile = ?,
+    audio_tracks = ?
+WHERE primary_file_id IN (SELECT ef.id FROM episode_files ef WHERE ef.episode_id = ?);
+
 -- name: GetEpisodeFileByOriginalPath :one
 SELECT * FROM episode_files WHERE original_path = ? LIMIT 1;
 
@@ -565,6 +724,17 @@ JOIN series s ON e.series_id = s.id
 WHERE s.root_folder_id = ?
   AND e.status IN ('available', 'upgradable');
 
+-- name: ListEpisodeFilesForQualityAudit :many
+SELECT ef.id as file_id, ef.path, ef.size, ef.quality_id, ef.video_codec, ef.resolution,
+       e.id as episode_id, e.season_number, e.episode_number,
+       s.id as series_id, s.title, s.runtime
+FROM episode_files ef
+JOIN episodes e ON ef.episode_id = e.id
+JOIN series s ON e.series_id = s.id
+WHERE ef.quality_id IS NOT NULL
+  AND ef.primary_file_id IS NULL
+ORDER BY s.sort_title, e.season_number, e.episode_number;
+
 -- Bulk monitoring by series IDs (for bulk edit mode)
 -- name: UpdateSeriesMonitoredByIDs :exec
 UPDATE series SET monitored = ?, updated_at = CURRENT_TIMESTAMP WHERE id IN (sqlc.slice('ids'));
@@ -629,3 +799,35 @@ SELECT
 FROM episodes e
 WHERE e.series_id = ? AND e.season_number = ?
 ORDER BY e.episode_number;
+
+-- name: ListSeriesCompletenessEpisodes :many
+SELECT
+    e.id, e.season_number, e.episode_number, e.title, e.air_date, e.monitored, e.status,
+    ef.id AS file_id, ef.quality, ef.quality_id, ef.resolution, ef.size
+FROM episodes e
+LEFT JOIN episode_files ef ON ef.id = (
+    SELECT ef2.id FROM episode_files ef2
+    WHERE ef2.episode_id = e.id
+    ORDER BY COALESCE(ef2.quality_id, 0) DESC, ef2.id DESC
+    LIMIT 1
+)
+WHERE e.series_id = ?
+ORDER BY e.season_number, e.episode_number;
+
+-- name: ListSeasonGapEpisodes :many
+SELECT
+    s.id AS series_id, s.title AS series_title, e.season_number, e.id AS episode_id, e.episode_number,
+    (SELECT COUNT(*) FROM episodes e3
+     WHERE e3.series_id = e.series_id AND e3.season_number = e.season_number
+       AND e3.air_date IS NOT NULL AND substr(e3.air_date, 1, 10) <= date('now')) AS aired_episodes
+FROM episodes e
+JOIN series s ON s.id = e.series_id
+WHERE e.season_number > 0
+  AND e.air_date IS NOT NULL AND substr(e.air_date, 1, 10) <= date('now')
+  AND NOT EXISTS (SELECT 1 FROM episode_files ef WHERE ef.episode_id = e.id)
+  AND EXISTS (
+      SELECT 1 FROM episodes e2
+      JOIN episode_files ef2 ON ef2.episode_id = e2.id
+      WHERE e2.series_id = e.series_id AND e2.season_number = e.season_number
+  )
+ORDER BY s.sort_title, e.season_number, e.episode_number;
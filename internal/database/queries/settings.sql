@@ -30,8 +30,9 @@ SELECT * FROM quality_profiles WHERE module_type = ? ORDER BY name;
 INSERT INTO quality_profiles (
     name, module_type, cutoff, items, hdr_settings, video_codec_settings,
     audio_codec_settings, audio_channel_settings, upgrades_enabled,
-    allow_auto_approve, upgrade_strategy, cutoff_overrides_strategy
-) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+    allow_auto_approve, upgrade_strategy, cutoff_overrides_strategy, release_groups,
+    indexer_flags
+) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
 RETURNING *;
 
 -- name: UpdateQualityProfile :one
@@ -47,6 +48,8 @@ UPDATE quality_profiles SET
     allow_auto_approve = ?,
     upgrade_strategy = ?,
     cutoff_overrides_strategy = ?,
+    release_groups = ?,
+    indexer_flags = ?,
     updated_at = CURRENT_TIMESTAMP
 WHERE id = ?
 RETURNING *;
@@ -77,8 +80,8 @@ SELECT * FROM root_folders ORDER BY name;
 SELECT * FROM root_folders WHERE module_type = ? ORDER BY name;
 
 -- name: CreateRootFolder :one
-INSERT INTO root_folders (path, name, module_type, free_space)
-VALUES (?, ?, ?, ?)
+INSERT INTO root_folders (path, name, module_type, free_space, total_space)
+VALUES (?, ?, ?, ?, ?)
 RETURNING *;
 
 -- name: UpdateRootFolder :one
@@ -98,8 +101,8 @@ SELECT * FROM root_folders WHERE path = ? LIMIT 1;
 -- name: ListRootFoldersByType :many
 SELECT * FROM root_folders WHERE module_type = ? ORDER BY name;
 
--- name: UpdateRootFolderFreeSpace :exec
-UPDATE root_folders SET free_space = ? WHERE id = ?;
+-- name: UpdateRootFolderSpace :exec
+UPDATE root_folders SET free_space = ?, total_space = ?, unreachable = ? WHERE id = ?;
 
 -- Downloads Queue
 -- name: GetDownload :one
@@ -150,13 +153,21 @@ ORDER BY created_at DESC
 LIMIT ? OFFSET ?;
 
 -- name: ListHistoryFiltered :many
-SELECT * FROM history
-WHERE (? = '' OR instr(',' || ? || ',', ',' || event_type || ',') > 0)
-  AND (? = '' OR entity_type = ?)
-  AND (? = '' OR created_at >= ?)
-  AND (? = '' OR created_at <= ?)
-ORDER BY created_at DESC
-LIMIT ? OFFSET ?;
+WITH o AS (
+    SELECT CAST(sqlc.arg(event_types) AS TEXT) AS event_types,
+           CAST(sqlc.arg(entity_type) AS TEXT) AS entity_type,
+           CAST(sqlc.arg(ascending) AS BOOLEAN) AS ascending
+)
+SELECT h.* FROM history h, o
+WHERE (o.event_types = '' OR instr(',' || o.event_types || ',', ',' || h.event_type || ',') > 0)
+  AND (o.entity_type = '' OR h.entity_type = o.entity_type)
+  AND (h.created_at >= sqlc.narg(created_after) OR sqlc.narg(created_after) IS NULL)
+  AND (h.created_at <= sqlc.narg(created_before) OR sqlc.narg(created_before) IS NULL)
+ORDER BY
+    CASE WHEN o.ascending THEN h.created_at END ASC,
+    CASE WHEN o.ascending THEN h.id END ASC,
+    h.created_at DESC, h.id DESC
+LIMIT sqlc.arg(lim) OFFSET sqlc.arg(off);
 
 -- name: CountHistory :one
 SELECT COUNT(*) FROM history;
@@ -168,15 +179,22 @@ SELECT COUNT(*) FROM history WHERE event_type = ?;
 SELECT COUNT(*) FROM history WHERE entity_type = ?;
 
 -- name: CountHistoryFiltered :one
-SELECT COUNT(*) FROM history
-WHERE (? = '' OR instr(',' || ? || ',', ',' || event_type || ',') > 0)
-  AND (? = '' OR entity_type = ?)
-  AND (? = '' OR created_at >= ?)
-  AND (? = '' OR created_at <= ?);
+WITH o AS (
+    SELECT CAST(sqlc.arg(event_types) AS TEXT) AS event_types,
+           CAST(sqlc.arg(entity_type) AS TEXT) AS entity_type
+)
+SELECT COUNT(*) FROM history h, o
+WHERE (o.event_types = '' OR instr(',' || o.event_types || ',', ',' || h.event_type || ',') > 0)
+  AND (o.entity_type = '' OR h.entity_type = o.entity_type)
+  AND (h.created_at >= sqlc.narg(created_after) OR sqlc.narg(created_after) IS NULL)
+  AND (h.created_at <= sqlc.narg(created_before) OR sqlc.narg(created_before) IS NULL);
 
 -- name: ListHistoryByMedia :many
 SELECT * FROM history WHERE module_type = ? AND entity_type = ? AND entity_id = ? ORDER BY created_at DESC;
 
+-- name: GetHistoryEntry :one
+SELECT * FROM history WHERE id = ? LIMIT 1;
+
 -- name: CreateHistoryEntry :one
 INSERT INTO history (event_type, module_type, entity_type, entity_id, source, quality, data)
 VALUES (?, ?, ?, ?, ?, ?, ?)
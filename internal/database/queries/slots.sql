@@ -22,6 +22,13 @@ UPDATE multi_version_settings SET
 WHERE id = 1
 RETURNING *;
 
+-- name: SetMultiVersionNaming :one
+UPDATE multi_version_settings SET
+    version_naming = ?,
+    updated_at = CURRENT_TIMESTAMP
+WHERE id = 1
+RETURNING *;
+
 -- name: SetDryRunCompleted :one
 UPDATE multi_version_settings SET
     dry_run_completed = ?,
@@ -125,6 +132,45 @@ WHERE vs.enabled = 1
   AND (msa.monitored = 1 OR msa.monitored IS NULL)
   AND (msa.file_id IS NULL OR msa.id IS NULL);
 
+-- Slot auto-fill: monitored slots of monitored, released items that are empty
+-- or hold a file below the slot profile's cutoff and have no download in
+-- flight. Items without an assignment row have never filled the slot.
+-- name: ListMovieSlotsToFill :many
+SELECT m.id AS movie_id, m.title, vs.id AS slot_id,
+       CAST(msa.file_id IS NOT NULL AS INTEGER) AS has_file
+FROM movies m
+CROSS JOIN version_slots vs
+LEFT JOIN movie_slot_assignments msa ON msa.movie_id = m.id AND msa.slot_id = vs.id
+WHERE vs.enabled = 1
+  AND vs.quality_profile_id IS NOT NULL
+  AND m.monitored = 1
+  AND m.status != 'unreleased'
+  AND (msa.id IS NULL OR (msa.monitored = 1 AND (
+      (msa.file_id IS NULL AND msa.status != 'downloading') OR msa.status = 'upgradable')))
+  AND NOT EXISTS (
+      SELECT 1 FROM download_mappings dm
+      WHERE dm.entity_type = 'movie' AND dm.entity_id = m.id AND dm.target_slot_id = vs.id)
+ORDER BY COALESCE(m.release_date, m.physical_release_date, m.theatrical_release_date) DESC, m.id, vs.slot_number;
+
+-- name: ListEpisodeSlotsToFill :many
+SELECT e.id AS episode_id, s.title, vs.id AS slot_id,
+       CAST(esa.file_id IS NOT NULL AS INTEGER) AS has_file
+FROM episodes e
+JOIN series s ON s.id = e.series_id
+CROSS JOIN version_slots vs
+LEFT JOIN episode_slot_assignments esa ON esa.episode_id = e.id AND esa.slot_id = vs.id
+WHERE vs.enabled = 1
+  AND vs.quality_profile_id IS NOT NULL
+  AND s.monitored = 1
+  AND e.monitored = 1
+  AND e.status != 'unreleased'
+  AND (esa.id IS NULL OR (esa.monitored = 1 AND (
+      (esa.file_id IS NULL AND esa.status != 'downloading') OR esa.status = 'upgradable')))
+  AND NOT EXISTS (
+      SELECT 1 FROM download_mappings dm
+      WHERE dm.entity_type = 'episode' AND dm.entity_id = e.id AND dm.target_slot_id = vs.id)
+ORDER BY e.air_date DESC, e.id, vs.slot_number;
+
 -- Slot Disable Queries (combined UNION ALL)
 -- name: ListFilesAssignedToSlot :many
 SELECT
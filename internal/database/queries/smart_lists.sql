@@ -0,0 +1,22 @@
+-- name: GetSmartList :one
+SELECT * FROM smart_lists WHERE id = ? LIMIT 1;
+
+-- name: ListSmartLists :many
+SELECT * FROM smart_lists ORDER BY name;
+
+-- name: CreateSmartList :one
+INSERT INTO smart_lists (name, media_type, filter)
+VALUES (?, ?, ?)
+RETURNING *;
+
+-- name: UpdateSmartList :one
+UPDATE smart_lists SET
+    name = ?,
+    media_type = ?,
+    filter = ?,
+    updated_at = CURRENT_TIMESTAMP
+WHERE id = ?
+RETURNING *;
+
+-- name: DeleteSmartList :exec
+DELETE FROM smart_lists WHERE id = ?;
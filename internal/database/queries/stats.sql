@@ -0,0 +1,76 @@
+-- name: GetLibraryTotals :one
+SELECT
+    (SELECT COUNT(*) FROM movies) AS movies,
+    (SELECT COUNT(*) FROM series) AS series,
+    (SELECT COUNT(*) FROM episodes) AS episodes,
+    (SELECT COUNT(*) FROM movie_files) AS movie_files,
+    (SELECT COUNT(*) FROM episode_files) AS episode_files,
+    (SELECT CAST(COALESCE(SUM(size), 0) AS INTEGER) FROM movie_files) AS movie_bytes,
+    (SELECT CAST(COALESCE(SUM(size), 0) AS INTEGER) FROM episode_files) AS episode_bytes;
+
+-- name: GetDiskUsageByRootFolder :many
+SELECT
+    rf.id AS root_folder_id,
+    rf.name,
+    rf.path,
+    rf.free_space,
+    rf.total_space,
+    CAST(COALESCE((
+        SELECT SUM(mf.size) FROM movie_files mf
+        JOIN movies m ON m.id = mf.movie_id
+        WHERE m.root_folder_id = rf.id
+    ), 0) + COALESCE((
+        SELECT SUM(ef.size) FROM episode_files ef
+        JOIN episodes e ON e.id = ef.episode_id
+        JOIN series s ON s.id = e.series_id
+        WHERE s.root_folder_id = rf.id
+    ), 0) AS INTEGER) AS used_bytes,
+    CAST((
+        SELECT COUNT(*) FROM movie_files mf
+        JOIN movies m ON m.id = mf.movie_id
+        WHERE m.root_folder_id = rf.id
+    ) + (
+        SELECT COUNT(*) FROM episode_files ef
+        JOIN episodes e ON e.id = ef.episode_id
+        JOIN series s ON s.id = e.series_id
+        WHERE s.root_folder_id = rf.id
+    ) AS INTEGER) AS files
+FROM root_folders rf
+ORDER BY rf.name;
+
+-- name: GetDiskUsageByQuality :many
+SELECT
+    CAST(COALESCE(NULLIF(quality, ''), 'Unknown') AS TEXT) AS quality,
+    COUNT(*) AS files,
+    CAST(COALESCE(SUM(size), 0) AS INTEGER) AS bytes
+FROM (
+    SELECT quality, size FROM movie_files
+    UNION ALL
+    SELECT quality, size FROM episode_files
+)
+GROUP BY 1
+ORDER BY bytes DESC;
+
+-- name: GetHistoryCountsByDay :many
+SELECT
+    CAST(date(created_at) AS TEXT) AS day,
+    event_type,
+    COUNT(*) AS count
+FROM history
+WHERE created_at >= sqlc.arg(since)
+  AND event_type IN ('grabbed', 'autosearch_download', 'imported')
+GROUP BY day, event_type
+ORDER BY day;
+
+-- name: GetTopGrabIndexers :many
+SELECT
+    CAST(json_extract(data, '$.indexer') AS TEXT) AS indexer,
+    COUNT(*) AS grabs
+FROM history
+WHERE created_at >= sqlc.arg(since)
+  AND event_type IN ('grabbed', 'autosearch_download')
+  AND json_extract(data, '$.indexer') IS NOT NULL
+  AND json_extract(data, '$.indexer') != ''
+GROUP BY 1
+ORDER BY grabs DESC
+LIMIT sqlc.arg(row_limit);
@@ -0,0 +1,16 @@
+-- name: CreateStreamSession :exec
+INSERT INTO stream_sessions (user_id, media_type, file_id, bytes_sent, remote_addr, started_at, ended_at)
+VALUES (?, ?, ?, ?, ?, ?, ?);
+
+-- name: ListStreamBandwidthByUser :many
+SELECT
+    s.user_id,
+    COALESCE(u.username, '') AS username,
+    COUNT(*) AS sessions,
+    CAST(COALESCE(SUM(s.bytes_sent), 0) AS INTEGER) AS bytes_sent
+FROM stream_sessions s
+LEFT JOIN portal_users u ON u.id = s.user_id
+WHERE s.started_at >= ?
+GROUP BY s.user_id
+ORDER BY bytes_sent DESC;
+
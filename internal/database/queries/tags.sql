@@ -0,0 +1,40 @@
+-- name: GetTag :one
+SELECT * FROM tags WHERE id = ? LIMIT 1;
+
+-- name: GetTagByLabel :one
+SELECT * FROM tags WHERE label = ? LIMIT 1;
+
+-- name: ListTags :many
+SELECT * FROM tags ORDER BY label;
+
+-- name: CreateTag :one
+INSERT INTO tags (label) VALUES (?)
+RETURNING *;
+
+-- name: UpdateTag :one
+UPDATE tags SET label = ? WHERE id = ?
+RETURNING *;
+
+-- name: DeleteTag :exec
+DELETE FROM tags WHERE id = ?;
+
+-- name: ListEntityTags :many
+SELECT tag_id FROM entity_tags
+WHERE entity_type = ? AND entity_id = ?
+ORDER BY tag_id;
+
+-- name: ListEntityTagsByType :many
+SELECT entity_id, tag_id FROM entity_tags
+WHERE entity_type = ?
+ORDER BY entity_id, tag_id;
+
+-- name: ListAllEntityTags :many
+SELECT tag_id, entity_type, entity_id FROM entity_tags
+ORDER BY tag_id, entity_type, entity_id;
+
+-- name: AddEntityTag :exec
+INSERT OR IGNORE INTO entity_tags (tag_id, entity_type, entity_id)
+VALUES (?, ?, ?);
+
+-- name: DeleteEntityTags :exec
+DELETE FROM entity_tags WHERE entity_type = ? AND entity_id = ?;
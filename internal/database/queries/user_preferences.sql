@@ -0,0 +1,13 @@
+-- name: GetUserPreferences :one
+SELECT * FROM user_preferences WHERE user_id = ? LIMIT 1;
+
+-- name: UpsertUserPreferences :one
+INSERT INTO user_preferences (user_id, locale, time_format, week_start, default_library_view, updated_at)
+VALUES (?, ?, ?, ?, ?, CURRENT_TIMESTAMP)
+ON CONFLICT(user_id) DO UPDATE SET
+    locale = excluded.locale,
+    time_format = excluded.time_format,
+    week_start = excluded.week_start,
+    default_library_view = excluded.default_library_view,
+    updated_at = CURRENT_TIMESTAMP
+RETURNING *;
@@ -24,27 +24,32 @@ func (q *Queries) CountDownloadClients(ctx context.Context) (int64, error) {
 const createDownloadClient = `-- name: CreateDownloadClient :one
 INSERT INTO download_clients (
     name, type, host, port, username, password, use_ssl, api_key, category, url_base,
-    priority, enabled, import_delay_seconds, cleanup_mode, seed_ratio_target
-) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
-RETURNING id, name, type, host, port, username, password, use_ssl, api_key, category, url_base, priority, enabled, created_at, updated_at, import_delay_seconds, cleanup_mode, seed_ratio_target
+    priority, enabled, import_delay_seconds, cleanup_mode, seed_ratio_target, path_mappings,
+    seed_time_target_minutes, import_delay_overrides, seeding_import_mode
+) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+RETURNING id, name, type, host, port, username, password, use_ssl, api_key, category, url_base, priority, enabled, created_at, updated_at, import_delay_seconds, cleanup_mode, seed_ratio_target, path_mappings, seed_time_target_minutes, import_delay_overrides, seeding_import_mode
 `
 
 type CreateDownloadClientParams struct {
-	Name               string          `json:"name"`
-	Type               string          `json:"type"`
-	Host               string          `json:"host"`
-	Port               int64           `json:"port"`
-	Username           sql.NullString  `json:"username"`
-	Password           sql.NullString  `json:"password"`
-	UseSsl             bool            `json:"use_ssl"`
-	ApiKey             sql.NullString  `json:"api_key"`
-	Category           sql.NullString  `json:"category"`
-	UrlBase            string          `json:"url_base"`
-	Priority           int64           `json:"priority"`
-	Enabled            bool            `json:"enabled"`
-	ImportDelaySeconds int64           `json:"import_delay_seconds"`
-	CleanupMode        string          `json:"cleanup_mode"`
-	SeedRatioTarget    sql.NullFloat64 `json:"seed_ratio_target"`
+	Name                  string          `json:"name"`
+	Type                  string          `json:"type"`
+	Host                  string          `json:"host"`
+	Port                  int64           `json:"port"`
+	Username              sql.NullString  `json:"username"`
+	Password              sql.NullString  `json:"password"`
+	UseSsl                bool            `json:"use_ssl"`
+	ApiKey                sql.NullString  `json:"api_key"`
+	Category              sql.NullString  `json:"category"`
+	UrlBase               string          `json:"url_base"`
+	Priority              int64           `json:"priority"`
+	Enabled               bool            `json:"enabled"`
+	ImportDelaySeconds    int64           `json:"import_delay_seconds"`
+	CleanupMode           string          `json:"cleanup_mode"`
+	SeedRatioTarget       sql.NullFloat64 `json:"seed_ratio_target"`
+	PathMappings          string          `json:"path_mappings"`
+	SeedTimeTargetMinutes sql.NullInt64   `json:"seed_time_target_minutes"`
+	ImportDelayOverrides  string          `json:"import_delay_overrides"`
+	SeedingImportMode     string          `json:"seeding_import_mode"`
 }
 
 func (q *Queries) CreateDownloadClient(ctx context.Context, arg CreateDownloadClientParams) (*DownloadClient, error) {
@@ -64,6 +69,10 @@ func (q *Queries) CreateDownloadClient(ctx context.Context, arg CreateDownloadCl
 		arg.ImportDelaySeconds,
 		arg.CleanupMode,
 		arg.SeedRatioTarget,
+		arg.PathMappings,
+		arg.SeedTimeTargetMinutes,
+		arg.ImportDelayOverrides,
+		arg.SeedingImportMode,
 	)
 	var i DownloadClient
 	err := row.Scan(
@@ -85,6 +94,10 @@ func (q *Queries) CreateDownloadClient(ctx context.Context, arg CreateDownloadCl
 		&i.ImportDelaySeconds,
 		&i.CleanupMode,
 		&i.SeedRatioTarget,
+		&i.PathMappings,
+		&i.SeedTimeTargetMinutes,
+		&i.ImportDelayOverrides,
+		&i.SeedingImportMode,
 	)
 	return &i, err
 }
@@ -99,7 +112,7 @@ func (q *Queries) DeleteDownloadClient(ctx context.Context, id int64) error {
 }
 
 const getDownloadClient = `-- name: GetDownloadClient :one
-SELECT id, name, type, host, port, username, password, use_ssl, api_key, category, url_base, priority, enabled, created_at, updated_at, import_delay_seconds, cleanup_mode, seed_ratio_target FROM download_clients WHERE id = ? LIMIT 1
+SELECT id, name, type, host, port, username, password, use_ssl, api_key, category, url_base, priority, enabled, created_at, updated_at, import_delay_seconds, cleanup_mode, seed_ratio_target, path_mappings, seed_time_target_minutes, import_delay_overrides, seeding_import_mode FROM download_clients WHERE id = ? LIMIT 1
 `
 
 func (q *Queries) GetDownloadClient(ctx context.Context, id int64) (*DownloadClient, error) {
@@ -124,12 +137,16 @@ func (q *Queries) GetDownloadClient(ctx context.Context, id int64) (*DownloadCli
 		&i.ImportDelaySeconds,
 		&i.CleanupMode,
 		&i.SeedRatioTarget,
+		&i.PathMappings,
+		&i.SeedTimeTargetMinutes,
+		&i.ImportDelayOverrides,
+		&i.SeedingImportMode,
 	)
 	return &i, err
 }
 
 const listDownloadClients = `-- name: ListDownloadClients :many
-SELECT id, name, type, host, port, username, password, use_ssl, api_key, category, url_base, priority, enabled, created_at, updated_at, import_delay_seconds, cleanup_mode, seed_ratio_target FROM download_clients ORDER BY priority, name
+SELECT id, name, type, host, port, username, password, use_ssl, api_key, category, url_base, priority, enabled, created_at, updated_at, import_delay_seconds, cleanup_mode, seed_ratio_target, path_mappings, seed_time_target_minutes, import_delay_overrides, seeding_import_mode FROM download_clients ORDER BY priority, name
 `
 
 func (q *Queries) ListDownloadClients(ctx context.Context) ([]*DownloadClient, error) {
@@ -160,6 +177,10 @@ func (q *Queries) ListDownloadClients(ctx context.Context) ([]*DownloadClient, e
 			&i.ImportDelaySeconds,
 			&i.CleanupMode,
 			&i.SeedRatioTarget,
+			&i.PathMappings,
+			&i.SeedTimeTargetMinutes,
+			&i.ImportDelayOverrides,
+			&i.SeedingImportMode,
 		); err != nil {
 			return nil, err
 		}
@@ -175,7 +196,7 @@ func (q *Queries) ListDownloadClients(ctx context.Context) ([]*DownloadClient, e
 }
 
 const listEnabledDownloadClients = `-- name: ListEnabledDownloadClients :many
-SELECT id, name, type, host, port, username, password, use_ssl, api_key, category, url_base, priority, enabled, created_at, updated_at, import_delay_seconds, cleanup_mode, seed_ratio_target FROM download_clients WHERE enabled = 1 ORDER BY priority, name
+SELECT id, name, type, host, port, username, password, use_ssl, api_key, category, url_base, priority, enabled, created_at, updated_at, import_delay_seconds, cleanup_mode, seed_ratio_target, path_mappings, seed_time_target_minutes, import_delay_overrides, seeding_import_mode FROM download_clients WHERE enabled = 1 ORDER BY priority, name
 `
 
 func (q *Queries) ListEnabledDownloadClients(ctx context.Context) ([]*DownloadClient, error) {
@@ -206,6 +227,10 @@ func (q *Queries) ListEnabledDownloadClients(ctx context.Context) ([]*DownloadCl
 			&i.ImportDelaySeconds,
 			&i.CleanupMode,
 			&i.SeedRatioTarget,
+			&i.PathMappings,
+			&i.SeedTimeTargetMinutes,
+			&i.ImportDelayOverrides,
+			&i.SeedingImportMode,
 		); err != nil {
 			return nil, err
 		}
@@ -237,28 +262,36 @@ UPDATE download_clients SET
     import_delay_seconds = ?,
     cleanup_mode = ?,
     seed_ratio_target = ?,
+    path_mappings = ?,
+    seed_time_target_minutes = ?,
+    import_delay_overrides = ?,
+    seeding_import_mode = ?,
     updated_at = CURRENT_TIMESTAMP
 WHERE id = ?
-RETURNING id, name, type, host, port, username, password, use_ssl, api_key, category, url_base, priority, enabled, created_at, updated_at, import_delay_seconds, cleanup_mode, seed_ratio_target
+RETURNING id, name, type, host, port, username, password, use_ssl, api_key, category, url_base, priority, enabled, created_at, updated_at, import_delay_seconds, cleanup_mode, seed_ratio_target, path_mappings, seed_time_target_minutes, import_delay_overrides, seeding_import_mode
 `
 
 type UpdateDownloadClientParams struct {
-	Name               string          `json:"name"`
-	Type               string          `json:"type"`
-	Host               string          `json:"host"`
-	Port               int64           `json:"port"`
-	Username           sql.NullString  `json:"username"`
-	Password           sql.NullString  `json:"password"`
-	UseSsl             bool            `json:"use_ssl"`
-	ApiKey             sql.NullString  `json:"api_key"`
-	Category           sql.NullString  `json:"category"`
-	UrlBase            string          `json:"url_base"`
-	Priority           int64           `json:"priority"`
-	Enabled            bool            `json:"enabled"`
-	ImportDelaySeconds int64           `json:"import_delay_seconds"`
-	CleanupMode        string          `json:"cleanup_mode"`
-	SeedRatioTarget    sql.NullFloat64 `json:"seed_ratio_target"`
-	ID                 int64           `json:"id"`
+	Name                  string          `json:"name"`
+	Type                  string          `json:"type"`
+	Host                  string          `json:"host"`
+	Port                  int64           `json:"port"`
+	Username              sql.NullString  `json:"username"`
+	Password              sql.NullString  `json:"password"`
+	UseSsl                bool            `json:"use_ssl"`
+	ApiKey                sql.NullString  `json:"api_key"`
+	Category              sql.NullString  `json:"category"`
+	UrlBase               string          `json:"url_base"`
+	Priority              int64           `json:"priority"`
+	Enabled               bool            `json:"enabled"`
+	ImportDelaySeconds    int64           `json:"import_delay_seconds"`
+	CleanupMode           string          `json:"cleanup_mode"`
+	SeedRatioTarget       sql.NullFloat64 `json:"seed_ratio_target"`
+	PathMappings          string          `json:"path_mappings"`
+	SeedTimeTargetMinutes sql.NullInt64   `json:"seed_time_target_minutes"`
+	ImportDelayOverrides  string          `json:"import_delay_overrides"`
+	SeedingImportMode     string          `json:"seeding_import_mode"`
+	ID                    int64           `json:"id"`
 }
 
 func (q *Queries) UpdateDownloadClient(ctx context.Context, arg UpdateDownloadClientParams) (*DownloadClient, error) {
@@ -278,6 +311,10 @@ func (q *Queries) UpdateDownloadClient(ctx context.Context, arg UpdateDownloadCl
 		arg.ImportDelaySeconds,
 		arg.CleanupMode,
 		arg.SeedRatioTarget,
+		arg.PathMappings,
+		arg.SeedTimeTargetMinutes,
+		arg.ImportDelayOverrides,
+		arg.SeedingImportMode,
 		arg.ID,
 	)
 	var i DownloadClient
@@ -300,6 +337,10 @@ func (q *Queries) UpdateDownloadClient(ctx context.Context, arg UpdateDownloadCl
 		&i.ImportDelaySeconds,
 		&i.CleanupMode,
 		&i.SeedRatioTarget,
+		&i.PathMappings,
+		&i.SeedTimeTargetMinutes,
+		&i.ImportDelayOverrides,
+		&i.SeedingImportMode,
 	)
 	return &i, err
 }
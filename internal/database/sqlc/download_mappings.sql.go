@@ -29,9 +29,9 @@ func (q *Queries) ClearDownloadMappingSlot(ctx context.Context, arg ClearDownloa
 const createDownloadMapping = `-- name: CreateDownloadMapping :one
 INSERT INTO download_mappings (
     client_id, download_id, module_type, entity_type, entity_id, season_number,
-    is_season_pack, is_complete_series, target_slot_id, source
+    is_season_pack, is_complete_series, target_slot_id, source, indexer_id
 ) VALUES (
-    ?, ?, ?, ?, ?, ?, ?, ?, ?, ?
+    ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?
 )
 ON CONFLICT (client_id, download_id) DO UPDATE SET
     module_type = excluded.module_type,
@@ -42,10 +42,11 @@ ON CONFLICT (client_id, download_id) DO UPDATE SET
     is_complete_series = excluded.is_complete_series,
     target_slot_id = excluded.target_slot_id,
     source = excluded.source,
+    indexer_id = excluded.indexer_id,
     import_attempts = 0,
     last_import_error = NULL,
     next_import_retry_at = NULL
-RETURNING id, client_id, download_id, module_type, entity_type, entity_id, season_number, is_season_pack, is_complete_series, target_slot_id, source, import_attempts, last_import_error, next_import_retry_at, created_at
+RETURNING id, client_id, download_id, module_type, entity_type, entity_id, season_number, is_season_pack, is_complete_series, target_slot_id, source, import_attempts, last_import_error, next_import_retry_at, created_at, indexer_id
 `
 
 type CreateDownloadMappingParams struct {
@@ -59,6 +60,7 @@ type CreateDownloadMappingParams struct {
 	IsCompleteSeries bool          `json:"is_complete_series"`
 	TargetSlotID     sql.NullInt64 `json:"target_slot_id"`
 	Source           string        `json:"source"`
+	IndexerID        sql.NullInt64 `json:"indexer_id"`
 }
 
 func (q *Queries) CreateDownloadMapping(ctx context.Context, arg CreateDownloadMappingParams) (*DownloadMapping, error) {
@@ -73,6 +75,7 @@ func (q *Queries) CreateDownloadMapping(ctx context.Context, arg CreateDownloadM
 		arg.IsCompleteSeries,
 		arg.TargetSlotID,
 		arg.Source,
+		arg.IndexerID,
 	)
 	var i DownloadMapping
 	err := row.Scan(
@@ -91,6 +94,7 @@ func (q *Queries) CreateDownloadMapping(ctx context.Context, arg CreateDownloadM
 		&i.LastImportError,
 		&i.NextImportRetryAt,
 		&i.CreatedAt,
+		&i.IndexerID,
 	)
 	return &i, err
 }
@@ -138,7 +142,7 @@ func (q *Queries) DeleteOldDownloadMappings(ctx context.Context) error {
 }
 
 const getDownloadMapping = `-- name: GetDownloadMapping :one
-SELECT id, client_id, download_id, module_type, entity_type, entity_id, season_number, is_season_pack, is_complete_series, target_slot_id, source, import_attempts, last_import_error, next_import_retry_at, created_at FROM download_mappings
+SELECT id, client_id, download_id, module_type, entity_type, entity_id, season_number, is_season_pack, is_complete_series, target_slot_id, source, import_attempts, last_import_error, next_import_retry_at, created_at, indexer_id FROM download_mappings
 WHERE client_id = ? AND download_id = ?
 `
 
@@ -166,12 +170,13 @@ func (q *Queries) GetDownloadMapping(ctx context.Context, arg GetDownloadMapping
 		&i.LastImportError,
 		&i.NextImportRetryAt,
 		&i.CreatedAt,
+		&i.IndexerID,
 	)
 	return &i, err
 }
 
 const getDownloadMappingsByClientDownloadIDs = `-- name: GetDownloadMappingsByClientDownloadIDs :many
-SELECT id, client_id, download_id, module_type, entity_type, entity_id, season_number, is_season_pack, is_complete_series, target_slot_id, source, import_attempts, last_import_error, next_import_retry_at, created_at FROM download_mappings
+SELECT id, client_id, download_id, module_type, entity_type, entity_id, season_number, is_season_pack, is_complete_series, target_slot_id, source, import_attempts, last_import_error, next_import_retry_at, created_at, indexer_id FROM download_mappings
 WHERE (client_id, download_id) IN (/*SLICE:client_download_ids*//*SLICE:client_download_ids*/?)
 `
 
@@ -200,6 +205,7 @@ func (q *Queries) GetDownloadMappingsByClientDownloadIDs(ctx context.Context) ([
 			&i.LastImportError,
 			&i.NextImportRetryAt,
 			&i.CreatedAt,
+			&i.IndexerID,
 		); err != nil {
 			return nil, err
 		}
@@ -215,7 +221,7 @@ func (q *Queries) GetDownloadMappingsByClientDownloadIDs(ctx context.Context) ([
 }
 
 const getDownloadMappingsBySlot = `-- name: GetDownloadMappingsBySlot :many
-SELECT id, client_id, download_id, module_type, entity_type, entity_id, season_number, is_season_pack, is_complete_series, target_slot_id, source, import_attempts, last_import_error, next_import_retry_at, created_at FROM download_mappings
+SELECT id, client_id, download_id, module_type, entity_type, entity_id, season_number, is_season_pack, is_complete_series, target_slot_id, source, import_attempts, last_import_error, next_import_retry_at, created_at, indexer_id FROM download_mappings
 WHERE target_slot_id = ?
 ORDER BY created_at DESC
 `
@@ -246,6 +252,7 @@ func (q *Queries) GetDownloadMappingsBySlot(ctx context.Context, targetSlotID sq
 			&i.LastImportError,
 			&i.NextImportRetryAt,
 			&i.CreatedAt,
+			&i.IndexerID,
 		); err != nil {
 			return nil, err
 		}
@@ -385,7 +392,7 @@ func (q *Queries) IsEntityDownloading(ctx context.Context, arg IsEntityDownloadi
 }
 
 const listActiveDownloadMappings = `-- name: ListActiveDownloadMappings :many
-SELECT id, client_id, download_id, module_type, entity_type, entity_id, season_number, is_season_pack, is_complete_series, target_slot_id, source, import_attempts, last_import_error, next_import_retry_at, created_at FROM download_mappings
+SELECT id, client_id, download_id, module_type, entity_type, entity_id, season_number, is_season_pack, is_complete_series, target_slot_id, source, import_attempts, last_import_error, next_import_retry_at, created_at, indexer_id FROM download_mappings
 ORDER BY created_at DESC
 `
 
@@ -414,6 +421,7 @@ func (q *Queries) ListActiveDownloadMappings(ctx context.Context) ([]*DownloadMa
 			&i.LastImportError,
 			&i.NextImportRetryAt,
 			&i.CreatedAt,
+			&i.IndexerID,
 		); err != nil {
 			return nil, err
 		}
@@ -427,3 +435,62 @@ func (q *Queries) ListActiveDownloadMappings(ctx context.Context) ([]*DownloadMa
 	}
 	return items, nil
 }
+
+const updateDownloadMappingTarget = `-- name: UpdateDownloadMappingTarget :one
+UPDATE download_mappings SET
+    module_type = ?,
+    entity_type = ?,
+    entity_id = ?,
+    season_number = ?,
+    is_season_pack = ?,
+    is_complete_series = 0,
+    target_slot_id = NULL,
+    import_attempts = 0,
+    last_import_error = NULL,
+    next_import_retry_at = NULL
+WHERE client_id = ? AND download_id = ?
+RETURNING id, client_id, download_id, module_type, entity_type, entity_id, season_number, is_season_pack, is_complete_series, target_slot_id, source, import_attempts, last_import_error, next_import_retry_at, created_at, indexer_id
+`
+
+type UpdateDownloadMappingTargetParams struct {
+	ModuleType   string        `json:"module_type"`
+	EntityType   string        `json:"entity_type"`
+	EntityID     int64         `json:"entity_id"`
+	SeasonNumber sql.NullInt64 `json:"season_number"`
+	IsSeasonPack bool          `json:"is_season_pack"`
+	ClientID     int64         `json:"client_id"`
+	DownloadID   string        `json:"download_id"`
+}
+
+// Point an active download at a different library item before it is imported
+func (q *Queries) UpdateDownloadMappingTarget(ctx context.Context, arg UpdateDownloadMappingTargetParams) (*DownloadMapping, error) {
+	row := q.db.QueryRowContext(ctx, updateDownloadMappingTarget,
+		arg.ModuleType,
+		arg.EntityType,
+		arg.EntityID,
+		arg.SeasonNumber,
+		arg.IsSeasonPack,
+		arg.ClientID,
+		arg.DownloadID,
+	)
+	var i DownloadMapping
+	err := row.Scan(
+		&i.ID,
+		&i.ClientID,
+		&i.DownloadID,
+		&i.ModuleType,
+		&i.EntityType,
+		&i.EntityID,
+		&i.SeasonNumber,
+		&i.IsSeasonPack,
+		&i.IsCompleteSeries,
+		&i.TargetSlotID,
+		&i.Source,
+		&i.ImportAttempts,
+		&i.LastImportError,
+		&i.NextImportRetryAt,
+		&i.CreatedAt,
+		&i.IndexerID,
+	)
+	return &i, err
+}
@@ -98,6 +98,34 @@ func (q *Queries) CountIndexerQueries(ctx context.Context, arg CountIndexerQueri
 	return count, err
 }
 
+const countIndexerQueryResults = `-- name: CountIndexerQueryResults :one
+SELECT
+    COUNT(*) AS total,
+    CAST(COALESCE(SUM(CASE WHEN successful = 0 THEN 1 ELSE 0 END), 0) AS INTEGER) AS failed
+FROM indexer_history
+WHERE indexer_id = ? AND event_type = 'query' AND created_at > ?
+`
+
+type CountIndexerQueryResultsParams struct {
+	IndexerID int64        `json:"indexer_id"`
+	CreatedAt sql.NullTime `json:"created_at"`
+}
+
+type CountIndexerQueryResultsRow struct {
+	Total  int64 `json:"total"`
+	Failed int64 `json:"failed"`
+}
+
+func (q *Queries) CountIndexerQueryResults(ctx context.Context, arg CountIndexerQueryResultsParams) (*CountIndexerQueryResultsRow, error) {
+	row := q.db.QueryRowContext(ctx, countIndexerQueryResults, arg.IndexerID, arg.CreatedAt)
+	var i CountIndexerQueryResultsRow
+	err := row.Scan(
+		&i.Total,
+		&i.Failed,
+	)
+	return &i, err
+}
+
 const countIndexers = `-- name: CountIndexers :one
 SELECT COUNT(*) FROM indexers
 `
@@ -111,9 +139,9 @@ func (q *Queries) CountIndexers(ctx context.Context) (int64, error) {
 
 const createIndexer = `-- name: CreateIndexer :one
 INSERT INTO indexers (
-    name, definition_id, settings, categories, supports_movies, supports_tv, priority, enabled, auto_search_enabled, rss_enabled
-) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
-RETURNING id, name, definition_id, settings, categories, supports_movies, supports_tv, priority, enabled, created_at, updated_at, auto_search_enabled, rss_enabled
+    name, definition_id, settings, categories, supports_movies, supports_tv, priority, enabled, auto_search_enabled, rss_enabled, proxy_url, flaresolverr_url
+) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+RETURNING id, name, definition_id, settings, categories, supports_movies, supports_tv, priority, enabled, created_at, updated_at, auto_search_enabled, rss_enabled, proxy_url, flaresolverr_url
 `
 
 type CreateIndexerParams struct {
@@ -127,6 +155,8 @@ type CreateIndexerParams struct {
 	Enabled           bool           `json:"enabled"`
 	AutoSearchEnabled bool           `json:"auto_search_enabled"`
 	RssEnabled        bool           `json:"rss_enabled"`
+	ProxyUrl          string         `json:"proxy_url"`
+	FlaresolverrUrl   string         `json:"flaresolverr_url"`
 }
 
 func (q *Queries) CreateIndexer(ctx context.Context, arg CreateIndexerParams) (*Indexer, error) {
@@ -141,6 +171,8 @@ func (q *Queries) CreateIndexer(ctx context.Context, arg CreateIndexerParams) (*
 		arg.Enabled,
 		arg.AutoSearchEnabled,
 		arg.RssEnabled,
+		arg.ProxyUrl,
+		arg.FlaresolverrUrl,
 	)
 	var i Indexer
 	err := row.Scan(
@@ -157,6 +189,8 @@ func (q *Queries) CreateIndexer(ctx context.Context, arg CreateIndexerParams) (*
 		&i.UpdatedAt,
 		&i.AutoSearchEnabled,
 		&i.RssEnabled,
+		&i.ProxyUrl,
+		&i.FlaresolverrUrl,
 	)
 	return &i, err
 }
@@ -258,7 +292,7 @@ func (q *Queries) GetDefinitionMetadata(ctx context.Context, id string) (*Defini
 }
 
 const getIndexer = `-- name: GetIndexer :one
-SELECT id, name, definition_id, settings, categories, supports_movies, supports_tv, priority, enabled, created_at, updated_at, auto_search_enabled, rss_enabled FROM indexers WHERE id = ? LIMIT 1
+SELECT id, name, definition_id, settings, categories, supports_movies, supports_tv, priority, enabled, created_at, updated_at, auto_search_enabled, rss_enabled, proxy_url, flaresolverr_url FROM indexers WHERE id = ? LIMIT 1
 `
 
 func (q *Queries) GetIndexer(ctx context.Context, id int64) (*Indexer, error) {
@@ -278,12 +312,14 @@ func (q *Queries) GetIndexer(ctx context.Context, id int64) (*Indexer, error) {
 		&i.UpdatedAt,
 		&i.AutoSearchEnabled,
 		&i.RssEnabled,
+		&i.ProxyUrl,
+		&i.FlaresolverrUrl,
 	)
 	return &i, err
 }
 
 const getIndexerByDefinitionID = `-- name: GetIndexerByDefinitionID :one
-SELECT id, name, definition_id, settings, categories, supports_movies, supports_tv, priority, enabled, created_at, updated_at, auto_search_enabled, rss_enabled FROM indexers WHERE definition_id = ? LIMIT 1
+SELECT id, name, definition_id, settings, categories, supports_movies, supports_tv, priority, enabled, created_at, updated_at, auto_search_enabled, rss_enabled, proxy_url, flaresolverr_url FROM indexers WHERE definition_id = ? LIMIT 1
 `
 
 func (q *Queries) GetIndexerByDefinitionID(ctx context.Context, definitionID string) (*Indexer, error) {
@@ -303,6 +339,8 @@ func (q *Queries) GetIndexerByDefinitionID(ctx context.Context, definitionID str
 		&i.UpdatedAt,
 		&i.AutoSearchEnabled,
 		&i.RssEnabled,
+		&i.ProxyUrl,
+		&i.FlaresolverrUrl,
 	)
 	return &i, err
 }
@@ -401,7 +439,7 @@ func (q *Queries) GetIndexerStatus(ctx context.Context, indexerID int64) (*Index
 }
 
 const listAutoSearchEnabledIndexers = `-- name: ListAutoSearchEnabledIndexers :many
-SELECT id, name, definition_id, settings, categories, supports_movies, supports_tv, priority, enabled, created_at, updated_at, auto_search_enabled, rss_enabled FROM indexers WHERE enabled = 1 AND auto_search_enabled = 1 ORDER BY priority, name
+SELECT id, name, definition_id, settings, categories, supports_movies, supports_tv, priority, enabled, created_at, updated_at, auto_search_enabled, rss_enabled, proxy_url, flaresolverr_url FROM indexers WHERE enabled = 1 AND auto_search_enabled = 1 ORDER BY priority, name
 `
 
 func (q *Queries) ListAutoSearchEnabledIndexers(ctx context.Context) ([]*Indexer, error) {
@@ -427,6 +465,8 @@ func (q *Queries) ListAutoSearchEnabledIndexers(ctx context.Context) ([]*Indexer
 			&i.UpdatedAt,
 			&i.AutoSearchEnabled,
 			&i.RssEnabled,
+			&i.ProxyUrl,
+			&i.FlaresolverrUrl,
 		); err != nil {
 			return nil, err
 		}
@@ -443,7 +483,7 @@ func (q *Queries) ListAutoSearchEnabledIndexers(ctx context.Context) ([]*Indexer
 
 const listAutoSearchEnabledMovieIndexers = `-- name: ListAutoSearchEnabledMovieIndexers :many
 
-SELECT id, name, definition_id, settings, categories, supports_movies, supports_tv, priority, enabled, created_at, updated_at, auto_search_enabled, rss_enabled FROM indexers WHERE enabled = 1 AND auto_search_enabled = 1 AND supports_movies = 1 ORDER BY priority, name
+SELECT id, name, definition_id, settings, categories, supports_movies, supports_tv, priority, enabled, created_at, updated_at, auto_search_enabled, rss_enabled, proxy_url, flaresolverr_url FROM indexers WHERE enabled = 1 AND auto_search_enabled = 1 AND supports_movies = 1 ORDER BY priority, name
 `
 
 // Auto-search enabled indexer queries
@@ -470,6 +510,8 @@ func (q *Queries) ListAutoSearchEnabledMovieIndexers(ctx context.Context) ([]*In
 			&i.UpdatedAt,
 			&i.AutoSearchEnabled,
 			&i.RssEnabled,
+			&i.ProxyUrl,
+			&i.FlaresolverrUrl,
 		); err != nil {
 			return nil, err
 		}
@@ -485,7 +527,7 @@ func (q *Queries) ListAutoSearchEnabledMovieIndexers(ctx context.Context) ([]*In
 }
 
 const listAutoSearchEnabledTVIndexers = `-- name: ListAutoSearchEnabledTVIndexers :many
-SELECT id, name, definition_id, settings, categories, supports_movies, supports_tv, priority, enabled, created_at, updated_at, auto_search_enabled, rss_enabled FROM indexers WHERE enabled = 1 AND auto_search_enabled = 1 AND supports_tv = 1 ORDER BY priority, name
+SELECT id, name, definition_id, settings, categories, supports_movies, supports_tv, priority, enabled, created_at, updated_at, auto_search_enabled, rss_enabled, proxy_url, flaresolverr_url FROM indexers WHERE enabled = 1 AND auto_search_enabled = 1 AND supports_tv = 1 ORDER BY priority, name
 `
 
 func (q *Queries) ListAutoSearchEnabledTVIndexers(ctx context.Context) ([]*Indexer, error) {
@@ -511,6 +553,8 @@ func (q *Queries) ListAutoSearchEnabledTVIndexers(ctx context.Context) ([]*Index
 			&i.UpdatedAt,
 			&i.AutoSearchEnabled,
 			&i.RssEnabled,
+			&i.ProxyUrl,
+			&i.FlaresolverrUrl,
 		); err != nil {
 			return nil, err
 		}
@@ -634,7 +678,7 @@ func (q *Queries) ListDefinitionMetadataByProtocol(ctx context.Context, protocol
 }
 
 const listDisabledIndexers = `-- name: ListDisabledIndexers :many
-SELECT i.id, i.name, i.definition_id, i.settings, i.categories, i.supports_movies, i.supports_tv, i.priority, i.enabled, i.created_at, i.updated_at, i.auto_search_enabled, i.rss_enabled, s.disabled_till FROM indexers i
+SELECT i.id, i.name, i.definition_id, i.settings, i.categories, i.supports_movies, i.supports_tv, i.priority, i.enabled, i.created_at, i.updated_at, i.auto_search_enabled, i.rss_enabled, i.proxy_url, i.flaresolverr_url, s.disabled_till FROM indexers i
 JOIN indexer_status s ON i.id = s.indexer_id
 WHERE s.disabled_till IS NOT NULL AND s.disabled_till > CURRENT_TIMESTAMP
 `
@@ -653,6 +697,8 @@ type ListDisabledIndexersRow struct {
 	UpdatedAt         sql.NullTime   `json:"updated_at"`
 	AutoSearchEnabled bool           `json:"auto_search_enabled"`
 	RssEnabled        bool           `json:"rss_enabled"`
+	ProxyUrl          string         `json:"proxy_url"`
+	FlaresolverrUrl   string         `json:"flaresolverr_url"`
 	DisabledTill      sql.NullTime   `json:"disabled_till"`
 }
 
@@ -679,6 +725,8 @@ func (q *Queries) ListDisabledIndexers(ctx context.Context) ([]*ListDisabledInde
 			&i.UpdatedAt,
 			&i.AutoSearchEnabled,
 			&i.RssEnabled,
+			&i.ProxyUrl,
+			&i.FlaresolverrUrl,
 			&i.DisabledTill,
 		); err != nil {
 			return nil, err
@@ -695,7 +743,7 @@ func (q *Queries) ListDisabledIndexers(ctx context.Context) ([]*ListDisabledInde
 }
 
 const listEnabledIndexers = `-- name: ListEnabledIndexers :many
-SELECT id, name, definition_id, settings, categories, supports_movies, supports_tv, priority, enabled, created_at, updated_at, auto_search_enabled, rss_enabled FROM indexers WHERE enabled = 1 ORDER BY priority, name
+SELECT id, name, definition_id, settings, categories, supports_movies, supports_tv, priority, enabled, created_at, updated_at, auto_search_enabled, rss_enabled, proxy_url, flaresolverr_url FROM indexers WHERE enabled = 1 ORDER BY priority, name
 `
 
 func (q *Queries) ListEnabledIndexers(ctx context.Context) ([]*Indexer, error) {
@@ -721,6 +769,8 @@ func (q *Queries) ListEnabledIndexers(ctx context.Context) ([]*Indexer, error) {
 			&i.UpdatedAt,
 			&i.AutoSearchEnabled,
 			&i.RssEnabled,
+			&i.ProxyUrl,
+			&i.FlaresolverrUrl,
 		); err != nil {
 			return nil, err
 		}
@@ -736,7 +786,7 @@ func (q *Queries) ListEnabledIndexers(ctx context.Context) ([]*Indexer, error) {
 }
 
 const listEnabledMovieIndexers = `-- name: ListEnabledMovieIndexers :many
-SELECT id, name, definition_id, settings, categories, supports_movies, supports_tv, priority, enabled, created_at, updated_at, auto_search_enabled, rss_enabled FROM indexers WHERE enabled = 1 AND supports_movies = 1 ORDER BY priority, name
+SELECT id, name, definition_id, settings, categories, supports_movies, supports_tv, priority, enabled, created_at, updated_at, auto_search_enabled, rss_enabled, proxy_url, flaresolverr_url FROM indexers WHERE enabled = 1 AND supports_movies = 1 ORDER BY priority, name
 `
 
 func (q *Queries) ListEnabledMovieIndexers(ctx context.Context) ([]*Indexer, error) {
@@ -762,6 +812,8 @@ func (q *Queries) ListEnabledMovieIndexers(ctx context.Context) ([]*Indexer, err
 			&i.UpdatedAt,
 			&i.AutoSearchEnabled,
 			&i.RssEnabled,
+			&i.ProxyUrl,
+			&i.FlaresolverrUrl,
 		); err != nil {
 			return nil, err
 		}
@@ -777,7 +829,7 @@ func (q *Queries) ListEnabledMovieIndexers(ctx context.Context) ([]*Indexer, err
 }
 
 const listEnabledTVIndexers = `-- name: ListEnabledTVIndexers :many
-SELECT id, name, definition_id, settings, categories, supports_movies, supports_tv, priority, enabled, created_at, updated_at, auto_search_enabled, rss_enabled FROM indexers WHERE enabled = 1 AND supports_tv = 1 ORDER BY priority, name
+SELECT id, name, definition_id, settings, categories, supports_movies, supports_tv, priority, enabled, created_at, updated_at, auto_search_enabled, rss_enabled, proxy_url, flaresolverr_url FROM indexers WHERE enabled = 1 AND supports_tv = 1 ORDER BY priority, name
 `
 
 func (q *Queries) ListEnabledTVIndexers(ctx context.Context) ([]*Indexer, error) {
@@ -803,6 +855,8 @@ func (q *Queries) ListEnabledTVIndexers(ctx context.Context) ([]*Indexer, error)
 			&i.UpdatedAt,
 			&i.AutoSearchEnabled,
 			&i.RssEnabled,
+			&i.ProxyUrl,
+			&i.FlaresolverrUrl,
 		); err != nil {
 			return nil, err
 		}
@@ -864,8 +918,71 @@ func (q *Queries) ListIndexerHistory(ctx context.Context, arg ListIndexerHistory
 	return items, nil
 }
 
+const listIndexerStats = `-- name: ListIndexerStats :many
+SELECT
+    i.id AS indexer_id,
+    i.name AS indexer_name,
+    CAST(COALESCE(SUM(CASE WHEN h.event_type = 'query' THEN 1 ELSE 0 END), 0) AS INTEGER) AS total_queries,
+    CAST(COALESCE(SUM(CASE WHEN h.event_type = 'query' AND h.successful = 0 THEN 1 ELSE 0 END), 0) AS INTEGER) AS failed_queries,
+    CAST(COALESCE(AVG(CASE WHEN h.event_type = 'query' AND h.successful = 1 THEN h.elapsed_ms END), 0) AS REAL) AS avg_response_ms,
+    CAST(COALESCE(SUM(CASE WHEN h.event_type = 'grab' THEN 1 ELSE 0 END), 0) AS INTEGER) AS total_grabs,
+    CAST(COALESCE(SUM(CASE WHEN h.event_type = 'grab' AND h.successful = 0 THEN 1 ELSE 0 END), 0) AS INTEGER) AS failed_grabs,
+    s.escalation_level,
+    s.disabled_till
+FROM indexers i
+LEFT JOIN indexer_history h ON h.indexer_id = i.id AND h.created_at > ?
+LEFT JOIN indexer_status s ON s.indexer_id = i.id
+GROUP BY i.id
+ORDER BY i.name
+`
+
+type ListIndexerStatsRow struct {
+	IndexerID       int64         `json:"indexer_id"`
+	IndexerName     string        `json:"indexer_name"`
+	TotalQueries    int64         `json:"total_queries"`
+	FailedQueries   int64         `json:"failed_queries"`
+	AvgResponseMs   float64       `json:"avg_response_ms"`
+	TotalGrabs      int64         `json:"total_grabs"`
+	FailedGrabs     int64         `json:"failed_grabs"`
+	EscalationLevel sql.NullInt64 `json:"escalation_level"`
+	DisabledTill    sql.NullTime  `json:"disabled_till"`
+}
+
+func (q *Queries) ListIndexerStats(ctx context.Context, createdAt sql.NullTime) ([]*ListIndexerStatsRow, error) {
+	rows, err := q.db.QueryContext(ctx, listIndexerStats, createdAt)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []*ListIndexerStatsRow{}
+	for rows.Next() {
+		var i ListIndexerStatsRow
+		if err := rows.Scan(
+			&i.IndexerID,
+			&i.IndexerName,
+			&i.TotalQueries,
+			&i.FailedQueries,
+			&i.AvgResponseMs,
+			&i.TotalGrabs,
+			&i.FailedGrabs,
+			&i.EscalationLevel,
+			&i.DisabledTill,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listIndexers = `-- name: ListIndexers :many
-SELECT id, name, definition_id, settings, categories, supports_movies, supports_tv, priority, enabled, created_at, updated_at, auto_search_enabled, rss_enabled FROM indexers ORDER BY priority, name
+SELECT id, name, definition_id, settings, categories, supports_movies, supports_tv, priority, enabled, created_at, updated_at, auto_search_enabled, rss_enabled, proxy_url, flaresolverr_url FROM indexers ORDER BY priority, name
 `
 
 func (q *Queries) ListIndexers(ctx context.Context) ([]*Indexer, error) {
@@ -891,6 +1008,8 @@ func (q *Queries) ListIndexers(ctx context.Context) ([]*Indexer, error) {
 			&i.UpdatedAt,
 			&i.AutoSearchEnabled,
 			&i.RssEnabled,
+			&i.ProxyUrl,
+			&i.FlaresolverrUrl,
 		); err != nil {
 			return nil, err
 		}
@@ -906,7 +1025,7 @@ func (q *Queries) ListIndexers(ctx context.Context) ([]*Indexer, error) {
 }
 
 const listIndexersByDefinition = `-- name: ListIndexersByDefinition :many
-SELECT id, name, definition_id, settings, categories, supports_movies, supports_tv, priority, enabled, created_at, updated_at, auto_search_enabled, rss_enabled FROM indexers WHERE definition_id = ? ORDER BY priority, name
+SELECT id, name, definition_id, settings, categories, supports_movies, supports_tv, priority, enabled, created_at, updated_at, auto_search_enabled, rss_enabled, proxy_url, flaresolverr_url FROM indexers WHERE definition_id = ? ORDER BY priority, name
 `
 
 func (q *Queries) ListIndexersByDefinition(ctx context.Context, definitionID string) ([]*Indexer, error) {
@@ -932,6 +1051,8 @@ func (q *Queries) ListIndexersByDefinition(ctx context.Context, definitionID str
 			&i.UpdatedAt,
 			&i.AutoSearchEnabled,
 			&i.RssEnabled,
+			&i.ProxyUrl,
+			&i.FlaresolverrUrl,
 		); err != nil {
 			return nil, err
 		}
@@ -948,17 +1069,19 @@ func (q *Queries) ListIndexersByDefinition(ctx context.Context, definitionID str
 
 const listRecentIndexerHistory = `-- name: ListRecentIndexerHistory :many
 SELECT id, indexer_id, event_type, successful, "query", categories, results_count, elapsed_ms, data, created_at FROM indexer_history
+WHERE event_type = ?
 ORDER BY created_at DESC
 LIMIT ? OFFSET ?
 `
 
 type ListRecentIndexerHistoryParams struct {
-	Limit  int64 `json:"limit"`
-	Offset int64 `json:"offset"`
+	EventType string `json:"event_type"`
+	Limit     int64  `json:"limit"`
+	Offset    int64  `json:"offset"`
 }
 
 func (q *Queries) ListRecentIndexerHistory(ctx context.Context, arg ListRecentIndexerHistoryParams) ([]*IndexerHistory, error) {
-	rows, err := q.db.QueryContext(ctx, listRecentIndexerHistory, arg.Limit, arg.Offset)
+	rows, err := q.db.QueryContext(ctx, listRecentIndexerHistory, arg.EventType, arg.Limit, arg.Offset)
 	if err != nil {
 		return nil, err
 	}
@@ -993,7 +1116,7 @@ func (q *Queries) ListRecentIndexerHistory(ctx context.Context, arg ListRecentIn
 
 const listRssEnabledIndexers = `-- name: ListRssEnabledIndexers :many
 
-SELECT id, name, definition_id, settings, categories, supports_movies, supports_tv, priority, enabled, created_at, updated_at, auto_search_enabled, rss_enabled FROM indexers WHERE enabled = 1 AND rss_enabled = 1 ORDER BY priority, name
+SELECT id, name, definition_id, settings, categories, supports_movies, supports_tv, priority, enabled, created_at, updated_at, auto_search_enabled, rss_enabled, proxy_url, flaresolverr_url FROM indexers WHERE enabled = 1 AND rss_enabled = 1 ORDER BY priority, name
 `
 
 // RSS Sync enabled indexer queries
@@ -1020,6 +1143,8 @@ func (q *Queries) ListRssEnabledIndexers(ctx context.Context) ([]*Indexer, error
 			&i.UpdatedAt,
 			&i.AutoSearchEnabled,
 			&i.RssEnabled,
+			&i.ProxyUrl,
+			&i.FlaresolverrUrl,
 		); err != nil {
 			return nil, err
 		}
@@ -1035,7 +1160,7 @@ func (q *Queries) ListRssEnabledIndexers(ctx context.Context) ([]*Indexer, error
 }
 
 const listRssEnabledMovieIndexers = `-- name: ListRssEnabledMovieIndexers :many
-SELECT id, name, definition_id, settings, categories, supports_movies, supports_tv, priority, enabled, created_at, updated_at, auto_search_enabled, rss_enabled FROM indexers WHERE enabled = 1 AND rss_enabled = 1 AND supports_movies = 1 ORDER BY priority, name
+SELECT id, name, definition_id, settings, categories, supports_movies, supports_tv, priority, enabled, created_at, updated_at, auto_search_enabled, rss_enabled, proxy_url, flaresolverr_url FROM indexers WHERE enabled = 1 AND rss_enabled = 1 AND supports_movies = 1 ORDER BY priority, name
 `
 
 func (q *Queries) ListRssEnabledMovieIndexers(ctx context.Context) ([]*Indexer, error) {
@@ -1061,6 +1186,8 @@ func (q *Queries) ListRssEnabledMovieIndexers(ctx context.Context) ([]*Indexer,
 			&i.UpdatedAt,
 			&i.AutoSearchEnabled,
 			&i.RssEnabled,
+			&i.ProxyUrl,
+			&i.FlaresolverrUrl,
 		); err != nil {
 			return nil, err
 		}
@@ -1076,7 +1203,7 @@ func (q *Queries) ListRssEnabledMovieIndexers(ctx context.Context) ([]*Indexer,
 }
 
 const listRssEnabledTVIndexers = `-- name: ListRssEnabledTVIndexers :many
-SELECT id, name, definition_id, settings, categories, supports_movies, supports_tv, priority, enabled, created_at, updated_at, auto_search_enabled, rss_enabled FROM indexers WHERE enabled = 1 AND rss_enabled = 1 AND supports_tv = 1 ORDER BY priority, name
+SELECT id, name, definition_id, settings, categories, supports_movies, supports_tv, priority, enabled, created_at, updated_at, auto_search_enabled, rss_enabled, proxy_url, flaresolverr_url FROM indexers WHERE enabled = 1 AND rss_enabled = 1 AND supports_tv = 1 ORDER BY priority, name
 `
 
 func (q *Queries) ListRssEnabledTVIndexers(ctx context.Context) ([]*Indexer, error) {
@@ -1102,6 +1229,8 @@ func (q *Queries) ListRssEnabledTVIndexers(ctx context.Context) ([]*Indexer, err
 			&i.UpdatedAt,
 			&i.AutoSearchEnabled,
 			&i.RssEnabled,
+			&i.ProxyUrl,
+			&i.FlaresolverrUrl,
 		); err != nil {
 			return nil, err
 		}
@@ -1128,9 +1257,11 @@ UPDATE indexers SET
     enabled = ?,
     auto_search_enabled = ?,
     rss_enabled = ?,
+    proxy_url = ?,
+    flaresolverr_url = ?,
     updated_at = CURRENT_TIMESTAMP
 WHERE id = ?
-RETURNING id, name, definition_id, settings, categories, supports_movies, supports_tv, priority, enabled, created_at, updated_at, auto_search_enabled, rss_enabled
+RETURNING id, name, definition_id, settings, categories, supports_movies, supports_tv, priority, enabled, created_at, updated_at, auto_search_enabled, rss_enabled, proxy_url, flaresolverr_url
 `
 
 type UpdateIndexerParams struct {
@@ -1144,6 +1275,8 @@ type UpdateIndexerParams struct {
 	Enabled           bool           `json:"enabled"`
 	AutoSearchEnabled bool           `json:"auto_search_enabled"`
 	RssEnabled        bool           `json:"rss_enabled"`
+	ProxyUrl          string         `json:"proxy_url"`
+	FlaresolverrUrl   string         `json:"flaresolverr_url"`
 	ID                int64          `json:"id"`
 }
 
@@ -1159,6 +1292,8 @@ func (q *Queries) UpdateIndexer(ctx context.Context, arg UpdateIndexerParams) (*
 		arg.Enabled,
 		arg.AutoSearchEnabled,
 		arg.RssEnabled,
+		arg.ProxyUrl,
+		arg.FlaresolverrUrl,
 		arg.ID,
 	)
 	var i Indexer
@@ -1176,6 +1311,8 @@ func (q *Queries) UpdateIndexer(ctx context.Context, arg UpdateIndexerParams) (*
 		&i.UpdatedAt,
 		&i.AutoSearchEnabled,
 		&i.RssEnabled,
+		&i.ProxyUrl,
+		&i.FlaresolverrUrl,
 	)
 	return &i, err
 }
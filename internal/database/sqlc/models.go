@@ -9,6 +9,17 @@ import (
 	"time"
 )
 
+type AlternateTitle struct {
+	ID         int64  `json:"id"`
+	MediaType  string `json:"media_type"`
+	MediaID    int64  `json:"media_id"`
+	Title      string `json:"title"`
+	CleanTitle string `json:"clean_title"`
+	Locale     string `json:"locale"`
+	Kind       string `json:"kind"`
+	Source     string `json:"source"`
+}
+
 type Auth struct {
 	ID           int64        `json:"id"`
 	PasswordHash string       `json:"password_hash"`
@@ -56,24 +67,28 @@ type Download struct {
 }
 
 type DownloadClient struct {
-	ID                 int64           `json:"id"`
-	Name               string          `json:"name"`
-	Type               string          `json:"type"`
-	Host               string          `json:"host"`
-	Port               int64           `json:"port"`
-	Username           sql.NullString  `json:"username"`
-	Password           sql.NullString  `json:"password"`
-	UseSsl             bool            `json:"use_ssl"`
-	ApiKey             sql.NullString  `json:"api_key"`
-	Category           sql.NullString  `json:"category"`
-	UrlBase            string          `json:"url_base"`
-	Priority           int64           `json:"priority"`
-	Enabled            bool            `json:"enabled"`
-	CreatedAt          time.Time       `json:"created_at"`
-	UpdatedAt          time.Time       `json:"updated_at"`
-	ImportDelaySeconds int64           `json:"import_delay_seconds"`
-	CleanupMode        string          `json:"cleanup_mode"`
-	SeedRatioTarget    sql.NullFloat64 `json:"seed_ratio_target"`
+	ID                    int64           `json:"id"`
+	Name                  string          `json:"name"`
+	Type                  string          `json:"type"`
+	Host                  string          `json:"host"`
+	Port                  int64           `json:"port"`
+	Username              sql.NullString  `json:"username"`
+	Password              sql.NullString  `json:"password"`
+	UseSsl                bool            `json:"use_ssl"`
+	ApiKey                sql.NullString  `json:"api_key"`
+	Category              sql.NullString  `json:"category"`
+	UrlBase               string          `json:"url_base"`
+	Priority              int64           `json:"priority"`
+	Enabled               bool            `json:"enabled"`
+	CreatedAt             time.Time       `json:"created_at"`
+	UpdatedAt             time.Time       `json:"updated_at"`
+	ImportDelaySeconds    int64           `json:"import_delay_seconds"`
+	CleanupMode           string          `json:"cleanup_mode"`
+	SeedRatioTarget       sql.NullFloat64 `json:"seed_ratio_target"`
+	PathMappings          string          `json:"path_mappings"`
+	SeedTimeTargetMinutes sql.NullInt64   `json:"seed_time_target_minutes"`
+	ImportDelayOverrides  string          `json:"import_delay_overrides"`
+	SeedingImportMode     string          `json:"seeding_import_mode"`
 }
 
 type DownloadMapping struct {
@@ -92,6 +107,13 @@ type DownloadMapping struct {
 	LastImportError   sql.NullString `json:"last_import_error"`
 	NextImportRetryAt sql.NullTime   `json:"next_import_retry_at"`
 	CreatedAt         time.Time      `json:"created_at"`
+	IndexerID         sql.NullInt64  `json:"indexer_id"`
+}
+
+type EntityTag struct {
+	TagID      int64  `json:"tag_id"`
+	EntityType string `json:"entity_type"`
+	EntityID   int64  `json:"entity_id"`
 }
 
 type Episode struct {
@@ -106,25 +128,29 @@ type Episode struct {
 	Status           string         `json:"status"`
 	ActiveDownloadID sql.NullString `json:"active_download_id"`
 	StatusMessage    sql.NullString `json:"status_message"`
+	StillUrl         sql.NullString `json:"still_url"`
 }
 
 type EpisodeFile struct {
-	ID               int64          `json:"id"`
-	EpisodeID        int64          `json:"episode_id"`
-	Path             string         `json:"path"`
-	Size             int64          `json:"size"`
-	Quality          sql.NullString `json:"quality"`
-	VideoCodec       sql.NullString `json:"video_codec"`
-	AudioCodec       sql.NullString `json:"audio_codec"`
-	Resolution       sql.NullString `json:"resolution"`
-	CreatedAt        sql.NullTime   `json:"created_at"`
-	QualityID        sql.NullInt64  `json:"quality_id"`
-	OriginalPath     sql.NullString `json:"original_path"`
-	OriginalFilename sql.NullString `json:"original_filename"`
-	ImportedAt       sql.NullTime   `json:"imported_at"`
-	SlotID           sql.NullInt64  `json:"slot_id"`
-	AudioChannels    sql.NullString `json:"audio_channels"`
-	DynamicRange     sql.NullString `json:"dynamic_range"`
+	ID                 int64          `json:"id"`
+	EpisodeID          int64          `json:"episode_id"`
+	Path               string         `json:"path"`
+	Size               int64          `json:"size"`
+	Quality            sql.NullString `json:"quality"`
+	VideoCodec         sql.NullString `json:"video_codec"`
+	AudioCodec         sql.NullString `json:"audio_codec"`
+	Resolution         sql.NullString `json:"resolution"`
+	CreatedAt          sql.NullTime   `json:"created_at"`
+	QualityID          sql.NullInt64  `json:"quality_id"`
+	OriginalPath       sql.NullString `json:"original_path"`
+	OriginalFilename   sql.NullString `json:"original_filename"`
+	ImportedAt         sql.NullTime   `json:"imported_at"`
+	SlotID             sql.NullInt64  `json:"slot_id"`
+	AudioChannels      sql.NullString `json:"audio_channels"`
+	DynamicRange       sql.NullString `json:"dynamic_range"`
+	PrimaryFileID      sql.NullInt64  `json:"primary_file_id"`
+	DolbyVisionProfile string         `json:"dolby_vision_profile"`
+	AudioTracks        string         `json:"audio_tracks"`
 }
 
 type EpisodeSlotAssignment struct {
@@ -140,6 +166,14 @@ type EpisodeSlotAssignment struct {
 	StatusMessage    sql.NullString `json:"status_message"`
 }
 
+type HealthIssueState struct {
+	Category           string         `json:"category"`
+	ItemID             string         `json:"item_id"`
+	DismissedSignature sql.NullString `json:"dismissed_signature"`
+	MutedUntil         sql.NullTime   `json:"muted_until"`
+	UpdatedAt          time.Time      `json:"updated_at"`
+}
+
 type History struct {
 	ID         int64          `json:"id"`
 	EventType  string         `json:"event_type"`
@@ -168,6 +202,26 @@ type ImportDecision struct {
 	EvaluatedAt        time.Time      `json:"evaluated_at"`
 }
 
+type ImportExclusion struct {
+	ID        int64     `json:"id"`
+	Kind      string    `json:"kind"`
+	Value     string    `json:"value"`
+	Year      int64     `json:"year"`
+	Reason    string    `json:"reason"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type ImportFolderHint struct {
+	ID           int64     `json:"id"`
+	Scope        string    `json:"scope"`
+	ScopeKey     string    `json:"scope_key"`
+	ContentPath  string    `json:"content_path"`
+	SkipPatterns string    `json:"skip_patterns"`
+	Learned      bool      `json:"learned"`
+	Hits         int64     `json:"hits"`
+	UpdatedAt    time.Time `json:"updated_at"`
+}
+
 type ImportSetting struct {
 	ID                    int64     `json:"id"`
 	ValidationLevel       string    `json:"validation_level"`
@@ -192,6 +246,15 @@ type Indexer struct {
 	UpdatedAt         sql.NullTime   `json:"updated_at"`
 	AutoSearchEnabled bool           `json:"auto_search_enabled"`
 	RssEnabled        bool           `json:"rss_enabled"`
+	ProxyUrl          string         `json:"proxy_url"`
+	FlaresolverrUrl   string         `json:"flaresolverr_url"`
+}
+
+type IndexerGrabCap struct {
+	IndexerID       int64     `json:"indexer_id"`
+	MaxGrabsPerHour int64     `json:"max_grabs_per_hour"`
+	MaxGrabsPerDay  int64     `json:"max_grabs_per_day"`
+	UpdatedAt       time.Time `json:"updated_at"`
 }
 
 type IndexerHistory struct {
@@ -207,6 +270,22 @@ type IndexerHistory struct {
 	CreatedAt    sql.NullTime   `json:"created_at"`
 }
 
+type IndexerQuarantinedRow struct {
+	ID          int64          `json:"id"`
+	IndexerID   int64          `json:"indexer_id"`
+	Query       sql.NullString `json:"query"`
+	Reason      string         `json:"reason"`
+	RawFragment string         `json:"raw_fragment"`
+	CreatedAt   sql.NullTime   `json:"created_at"`
+}
+
+type IndexerSeedGoal struct {
+	IndexerID             int64           `json:"indexer_id"`
+	SeedRatioTarget       sql.NullFloat64 `json:"seed_ratio_target"`
+	SeedTimeTargetMinutes sql.NullInt64   `json:"seed_time_target_minutes"`
+	UpdatedAt             time.Time       `json:"updated_at"`
+}
+
 type IndexerStatus struct {
 	ID                 int64          `json:"id"`
 	IndexerID          int64          `json:"indexer_id"`
@@ -230,6 +309,48 @@ type Job struct {
 	Enabled         int64        `json:"enabled"`
 }
 
+type LanguageProfile struct {
+	ID          int64        `json:"id"`
+	Name        string       `json:"name"`
+	Languages   string       `json:"languages"`
+	Required    bool         `json:"required"`
+	AcceptMulti bool         `json:"accept_multi"`
+	CreatedAt   sql.NullTime `json:"created_at"`
+	UpdatedAt   sql.NullTime `json:"updated_at"`
+}
+
+type LibraryExclusion struct {
+	ID         int64     `json:"id"`
+	MediaType  string    `json:"media_type"`
+	ExternalID int64     `json:"external_id"`
+	Title      string    `json:"title"`
+	Year       int64     `json:"year"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+type MatchOutcome struct {
+	ID                 int64          `json:"id"`
+	SourcePath         string         `json:"source_path"`
+	MatchSource        string         `json:"match_source"`
+	MediaType          string         `json:"media_type"`
+	MediaID            int64          `json:"media_id"`
+	Outcome            string         `json:"outcome"`
+	CorrectedMediaType sql.NullString `json:"corrected_media_type"`
+	CorrectedMediaID   sql.NullInt64  `json:"corrected_media_id"`
+	CreatedAt          sql.NullTime   `json:"created_at"`
+	CorrectedAt        sql.NullTime   `json:"corrected_at"`
+}
+
+type MediaCredit struct {
+	ID         int64  `json:"id"`
+	PersonID   int64  `json:"person_id"`
+	MediaType  string `json:"media_type"`
+	MediaID    int64  `json:"media_id"`
+	Department string `json:"department"`
+	Role       string `json:"role"`
+	SortOrder  int64  `json:"sort_order"`
+}
+
 type ModuleNamingSetting struct {
 	ID           int64  `json:"id"`
 	ModuleType   string `json:"module_type"`
@@ -262,25 +383,31 @@ type Movie struct {
 	TvdbID                sql.NullInt64  `json:"tvdb_id"`
 	ContentRating         sql.NullString `json:"content_rating"`
 	AddedBy               sql.NullInt64  `json:"added_by"`
+	LanguageProfileID     sql.NullInt64  `json:"language_profile_id"`
+	MinimumAvailability   sql.NullString `json:"minimum_availability"`
+	EditionPreference     sql.NullString `json:"edition_preference"`
 }
 
 type MovieFile struct {
-	ID               int64          `json:"id"`
-	MovieID          int64          `json:"movie_id"`
-	Path             string         `json:"path"`
-	Size             int64          `json:"size"`
-	Quality          sql.NullString `json:"quality"`
-	VideoCodec       sql.NullString `json:"video_codec"`
-	AudioCodec       sql.NullString `json:"audio_codec"`
-	Resolution       sql.NullString `json:"resolution"`
-	CreatedAt        sql.NullTime   `json:"created_at"`
-	QualityID        sql.NullInt64  `json:"quality_id"`
-	OriginalPath     sql.NullString `json:"original_path"`
-	OriginalFilename sql.NullString `json:"original_filename"`
-	ImportedAt       sql.NullTime   `json:"imported_at"`
-	SlotID           sql.NullInt64  `json:"slot_id"`
-	AudioChannels    sql.NullString `json:"audio_channels"`
-	DynamicRange     sql.NullString `json:"dynamic_range"`
+	ID                 int64          `json:"id"`
+	MovieID            int64          `json:"movie_id"`
+	Path               string         `json:"path"`
+	Size               int64          `json:"size"`
+	Quality            sql.NullString `json:"quality"`
+	VideoCodec         sql.NullString `json:"video_codec"`
+	AudioCodec         sql.NullString `json:"audio_codec"`
+	Resolution         sql.NullString `json:"resolution"`
+	CreatedAt          sql.NullTime   `json:"created_at"`
+	QualityID          sql.NullInt64  `json:"quality_id"`
+	OriginalPath       sql.NullString `json:"original_path"`
+	OriginalFilename   sql.NullString `json:"original_filename"`
+	ImportedAt         sql.NullTime   `json:"imported_at"`
+	SlotID             sql.NullInt64  `json:"slot_id"`
+	AudioChannels      sql.NullString `json:"audio_channels"`
+	DynamicRange       sql.NullString `json:"dynamic_range"`
+	Edition            string         `json:"edition"`
+	DolbyVisionProfile string         `json:"dolby_vision_profile"`
+	AudioTracks        string         `json:"audio_tracks"`
 }
 
 type MovieSlotAssignment struct {
@@ -303,6 +430,7 @@ type MultiVersionSetting struct {
 	LastMigrationAt sql.NullTime `json:"last_migration_at"`
 	CreatedAt       sql.NullTime `json:"created_at"`
 	UpdatedAt       sql.NullTime `json:"updated_at"`
+	VersionNaming   string       `json:"version_naming"`
 }
 
 type NetworkLogo struct {
@@ -349,6 +477,37 @@ type PasskeyCredential struct {
 	LastUsedAt          sql.NullTime   `json:"last_used_at"`
 }
 
+type PendingGrab struct {
+	ID           int64     `json:"id"`
+	MediaType    string    `json:"media_type"`
+	MediaID      int64     `json:"media_id"`
+	SlotID       int64     `json:"slot_id"`
+	ReleaseTitle string    `json:"release_title"`
+	IndexerName  string    `json:"indexer_name"`
+	Source       string    `json:"source"`
+	Score        float64   `json:"score"`
+	Request      string    `json:"request"`
+	CreatedAt    time.Time `json:"created_at"`
+}
+
+type PendingUpgrade struct {
+	ID           int64         `json:"id"`
+	MediaType    string        `json:"media_type"`
+	OldFileID    sql.NullInt64 `json:"old_file_id"`
+	PreviousPath string        `json:"previous_path"`
+	TempPath     string        `json:"temp_path"`
+	DestPath     string        `json:"dest_path"`
+	CreatedAt    time.Time     `json:"created_at"`
+}
+
+type Person struct {
+	ID        int64     `json:"id"`
+	TmdbID    int64     `json:"tmdb_id"`
+	Name      string    `json:"name"`
+	PhotoUrl  string    `json:"photo_url"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
 type PlexRefreshQueue struct {
 	ID             int64          `json:"id"`
 	NotificationID int64          `json:"notification_id"`
@@ -394,6 +553,7 @@ type PortalUser struct {
 	IsAdmin      bool      `json:"is_admin"`
 	CreatedAt    time.Time `json:"created_at"`
 	UpdatedAt    time.Time `json:"updated_at"`
+	CanStream    bool      `json:"can_stream"`
 }
 
 type PortalUserModuleSetting struct {
@@ -407,6 +567,19 @@ type PortalUserModuleSetting struct {
 	UpdatedAt        time.Time     `json:"updated_at"`
 }
 
+type PortalWidgetKey struct {
+	ID                 int64        `json:"id"`
+	Name               string       `json:"name"`
+	KeyHash            string       `json:"key_hash"`
+	KeyPrefix          string       `json:"key_prefix"`
+	UserID             int64        `json:"user_id"`
+	AllowedOrigins     string       `json:"allowed_origins"`
+	RateLimitPerMinute int64        `json:"rate_limit_per_minute"`
+	Enabled            bool         `json:"enabled"`
+	LastUsedAt         sql.NullTime `json:"last_used_at"`
+	CreatedAt          time.Time    `json:"created_at"`
+}
+
 type ProwlarrConfig struct {
 	ID                    int64          `json:"id"`
 	Enabled               bool           `json:"enabled"`
@@ -422,6 +595,12 @@ type ProwlarrConfig struct {
 	UpdatedAt             time.Time      `json:"updated_at"`
 }
 
+type ProwlarrIndexerLink struct {
+	ProwlarrIndexerID int64     `json:"prowlarr_indexer_id"`
+	IndexerID         int64     `json:"indexer_id"`
+	SyncedAt          time.Time `json:"synced_at"`
+}
+
 type ProwlarrIndexerSetting struct {
 	ProwlarrIndexerID int64          `json:"prowlarr_indexer_id"`
 	Priority          int64          `json:"priority"`
@@ -436,6 +615,17 @@ type ProwlarrIndexerSetting struct {
 	UpdatedAt         time.Time      `json:"updated_at"`
 }
 
+type Quality struct {
+	ID           int64     `json:"id"`
+	Name         string    `json:"name"`
+	Source       string    `json:"source"`
+	Resolution   int64     `json:"resolution"`
+	Weight       int64     `json:"weight"`
+	MatchPattern string    `json:"match_pattern"`
+	CreatedAt    time.Time `json:"created_at"`
+	UpdatedAt    time.Time `json:"updated_at"`
+}
+
 type QualityProfile struct {
 	ID                      int64          `json:"id"`
 	Name                    string         `json:"name"`
@@ -452,6 +642,8 @@ type QualityProfile struct {
 	CutoffOverridesStrategy int64          `json:"cutoff_overrides_strategy"`
 	CreatedAt               sql.NullTime   `json:"created_at"`
 	UpdatedAt               sql.NullTime   `json:"updated_at"`
+	ReleaseGroups           string         `json:"release_groups"`
+	IndexerFlags            string         `json:"indexer_flags"`
 }
 
 type QueueMedium struct {
@@ -469,6 +661,18 @@ type QueueMedium struct {
 	UpdatedAt         time.Time      `json:"updated_at"`
 }
 
+type RenameJob struct {
+	ID        string    `json:"id"`
+	MediaType string    `json:"media_type"`
+	FileIds   string    `json:"file_ids"`
+	NextIndex int64     `json:"next_index"`
+	Succeeded int64     `json:"succeeded"`
+	Failed    int64     `json:"failed"`
+	Skipped   int64     `json:"skipped"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
 type Request struct {
 	ID               int64          `json:"id"`
 	UserID           int64          `json:"user_id"`
@@ -493,6 +697,12 @@ type Request struct {
 	UpdatedAt        time.Time      `json:"updated_at"`
 }
 
+type RequestVote struct {
+	RequestID int64     `json:"request_id"`
+	UserID    int64     `json:"user_id"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
 type RequestWatcher struct {
 	RequestID int64     `json:"request_id"`
 	UserID    int64     `json:"user_id"`
@@ -500,12 +710,14 @@ type RequestWatcher struct {
 }
 
 type RootFolder struct {
-	ID         int64         `json:"id"`
-	Path       string        `json:"path"`
-	Name       string        `json:"name"`
-	ModuleType string        `json:"module_type"`
-	FreeSpace  sql.NullInt64 `json:"free_space"`
-	CreatedAt  sql.NullTime  `json:"created_at"`
+	ID          int64         `json:"id"`
+	Path        string        `json:"path"`
+	Name        string        `json:"name"`
+	ModuleType  string        `json:"module_type"`
+	FreeSpace   sql.NullInt64 `json:"free_space"`
+	CreatedAt   sql.NullTime  `json:"created_at"`
+	TotalSpace  sql.NullInt64 `json:"total_space"`
+	Unreachable bool          `json:"unreachable"`
 }
 
 type Season struct {
@@ -517,28 +729,38 @@ type Season struct {
 	PosterUrl    sql.NullString `json:"poster_url"`
 }
 
+type SeedingDownload struct {
+	ID         int64         `json:"id"`
+	ClientID   int64         `json:"client_id"`
+	DownloadID string        `json:"download_id"`
+	IndexerID  sql.NullInt64 `json:"indexer_id"`
+	ImportedAt time.Time     `json:"imported_at"`
+}
+
 type Series struct {
-	ID               int64          `json:"id"`
-	Title            string         `json:"title"`
-	SortTitle        string         `json:"sort_title"`
-	Year             sql.NullInt64  `json:"year"`
-	TvdbID           sql.NullInt64  `json:"tvdb_id"`
-	TmdbID           sql.NullInt64  `json:"tmdb_id"`
-	ImdbID           sql.NullString `json:"imdb_id"`
-	Overview         sql.NullString `json:"overview"`
-	Runtime          sql.NullInt64  `json:"runtime"`
-	Path             sql.NullString `json:"path"`
-	RootFolderID     sql.NullInt64  `json:"root_folder_id"`
-	QualityProfileID sql.NullInt64  `json:"quality_profile_id"`
-	Monitored        bool           `json:"monitored"`
-	SeasonFolder     bool           `json:"season_folder"`
-	ProductionStatus string         `json:"production_status"`
-	Network          sql.NullString `json:"network"`
-	FormatType       sql.NullString `json:"format_type"`
-	AddedAt          sql.NullTime   `json:"added_at"`
-	UpdatedAt        sql.NullTime   `json:"updated_at"`
-	NetworkLogoUrl   sql.NullString `json:"network_logo_url"`
-	AddedBy          sql.NullInt64  `json:"added_by"`
+	ID                  int64          `json:"id"`
+	Title               string         `json:"title"`
+	SortTitle           string         `json:"sort_title"`
+	Year                sql.NullInt64  `json:"year"`
+	TvdbID              sql.NullInt64  `json:"tvdb_id"`
+	TmdbID              sql.NullInt64  `json:"tmdb_id"`
+	ImdbID              sql.NullString `json:"imdb_id"`
+	Overview            sql.NullString `json:"overview"`
+	Runtime             sql.NullInt64  `json:"runtime"`
+	Path                sql.NullString `json:"path"`
+	RootFolderID        sql.NullInt64  `json:"root_folder_id"`
+	QualityProfileID    sql.NullInt64  `json:"quality_profile_id"`
+	Monitored           bool           `json:"monitored"`
+	SeasonFolder        bool           `json:"season_folder"`
+	ProductionStatus    string         `json:"production_status"`
+	Network             sql.NullString `json:"network"`
+	FormatType          sql.NullString `json:"format_type"`
+	AddedAt             sql.NullTime   `json:"added_at"`
+	UpdatedAt           sql.NullTime   `json:"updated_at"`
+	NetworkLogoUrl      sql.NullString `json:"network_logo_url"`
+	AddedBy             sql.NullInt64  `json:"added_by"`
+	LanguageProfileID   sql.NullInt64  `json:"language_profile_id"`
+	MinimumAvailability sql.NullString `json:"minimum_availability"`
 }
 
 type Setting struct {
@@ -553,6 +775,32 @@ type SlotRootFolder struct {
 	RootFolderID int64  `json:"root_folder_id"`
 }
 
+type SmartList struct {
+	ID        int64        `json:"id"`
+	Name      string       `json:"name"`
+	MediaType string       `json:"media_type"`
+	Filter    string       `json:"filter"`
+	CreatedAt sql.NullTime `json:"created_at"`
+	UpdatedAt sql.NullTime `json:"updated_at"`
+}
+
+type StreamSession struct {
+	ID         int64         `json:"id"`
+	UserID     sql.NullInt64 `json:"user_id"`
+	MediaType  string        `json:"media_type"`
+	FileID     int64         `json:"file_id"`
+	BytesSent  int64         `json:"bytes_sent"`
+	RemoteAddr string        `json:"remote_addr"`
+	StartedAt  time.Time     `json:"started_at"`
+	EndedAt    time.Time     `json:"ended_at"`
+}
+
+type Tag struct {
+	ID        int64        `json:"id"`
+	Label     string       `json:"label"`
+	CreatedAt sql.NullTime `json:"created_at"`
+}
+
 type UserNotification struct {
 	ID          int64     `json:"id"`
 	UserID      int64     `json:"user_id"`
@@ -567,6 +815,15 @@ type UserNotification struct {
 	OnDenied    bool      `json:"on_denied"`
 }
 
+type UserPreference struct {
+	UserID             int64     `json:"user_id"`
+	Locale             string    `json:"locale"`
+	TimeFormat         string    `json:"time_format"`
+	WeekStart          string    `json:"week_start"`
+	DefaultLibraryView string    `json:"default_library_view"`
+	UpdatedAt          time.Time `json:"updated_at"`
+}
+
 type VersionSlot struct {
 	ID               int64         `json:"id"`
 	SlotNumber       int64         `json:"slot_number"`
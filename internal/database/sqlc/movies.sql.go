@@ -46,6 +46,52 @@ func (q *Queries) CountMovieFiles(ctx context.Context, movieID int64) (int64, er
 	return count, err
 }
 
+const countMoviesFiltered = `-- name: CountMoviesFiltered :one
+WITH o AS (
+    SELECT CAST(?1 AS TEXT) AS search_term,
+           CAST(?2 AS TEXT) AS statuses,
+           CAST(?3 AS BOOLEAN) AS monitored,
+           CAST(?4 AS INTEGER) AS root_folder_id,
+           CAST(?5 AS INTEGER) AS quality_profile_id,
+           CAST(?6 AS INTEGER) AS tag_id
+)
+SELECT COUNT(*) FROM movies m, o
+WHERE (o.search_term = ''
+       OR m.title LIKE '%' || o.search_term || '%' OR m.sort_title LIKE '%' || o.search_term || '%'
+       OR REPLACE(m.title, '''', '') LIKE '%' || o.search_term || '%'
+       OR REPLACE(m.sort_title, '''', '') LIKE '%' || o.search_term || '%')
+  AND (o.monitored IS NULL OR m.monitored = o.monitored)
+  AND (o.root_folder_id IS NULL OR m.root_folder_id = o.root_folder_id)
+  AND (o.quality_profile_id IS NULL OR m.quality_profile_id = o.quality_profile_id)
+  AND (o.tag_id IS NULL OR EXISTS (
+       SELECT 1 FROM entity_tags et
+       WHERE et.entity_type = 'movie' AND et.entity_id = m.id AND et.tag_id = o.tag_id))
+  AND (o.statuses = '' OR instr(',' || o.statuses || ',', ',' || m.status || ',') > 0)
+`
+
+type CountMoviesFilteredParams struct {
+	SearchTerm       string        `json:"search_term"`
+	Statuses         string        `json:"statuses"`
+	Monitored        sql.NullBool  `json:"monitored"`
+	RootFolderID     sql.NullInt64 `json:"root_folder_id"`
+	QualityProfileID sql.NullInt64 `json:"quality_profile_id"`
+	TagID            sql.NullInt64 `json:"tag_id"`
+}
+
+func (q *Queries) CountMoviesFiltered(ctx context.Context, arg CountMoviesFilteredParams) (int64, error) {
+	row := q.db.QueryRowContext(ctx, countMoviesFiltered,
+		arg.SearchTerm,
+		arg.Statuses,
+		arg.Monitored,
+		arg.RootFolderID,
+		arg.QualityProfileID,
+		arg.TagID,
+	)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
 const countMovieUpgradeCandidates = `-- name: CountMovieUpgradeCandidates :one
 SELECT COUNT(*) FROM movies m
 WHERE m.status = 'upgradable'
@@ -70,14 +116,25 @@ func (q *Queries) CountMovies(ctx context.Context) (int64, error) {
 	return count, err
 }
 
+const countMoviesByStatus = `-- name: CountMoviesByStatus :one
+SELECT COUNT(*) FROM movies WHERE status = ?
+`
+
+func (q *Queries) CountMoviesByStatus(ctx context.Context, status string) (int64, error) {
+	row := q.db.QueryRowContext(ctx, countMoviesByStatus, status)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
 const createMovie = `-- name: CreateMovie :one
 INSERT INTO movies (
     title, sort_title, year, tmdb_id, imdb_id, overview, runtime,
     path, root_folder_id, quality_profile_id, monitored, status,
     release_date, physical_release_date, theatrical_release_date,
-    studio, tvdb_id, content_rating, added_by
-) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
-RETURNING id, title, sort_title, year, tmdb_id, imdb_id, overview, runtime, path, root_folder_id, quality_profile_id, monitored, status, active_download_id, status_message, release_date, physical_release_date, added_at, updated_at, theatrical_release_date, studio, tvdb_id, content_rating, added_by
+    studio, tvdb_id, content_rating, added_by, language_profile_id
+) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+RETURNING id, title, sort_title, year, tmdb_id, imdb_id, overview, runtime, path, root_folder_id, quality_profile_id, monitored, status, active_download_id, status_message, release_date, physical_release_date, added_at, updated_at, theatrical_release_date, studio, tvdb_id, content_rating, added_by, language_profile_id, minimum_availability, edition_preference
 `
 
 type CreateMovieParams struct {
@@ -100,6 +157,7 @@ type CreateMovieParams struct {
 	TvdbID                sql.NullInt64  `json:"tvdb_id"`
 	ContentRating         sql.NullString `json:"content_rating"`
 	AddedBy               sql.NullInt64  `json:"added_by"`
+	LanguageProfileID     sql.NullInt64  `json:"language_profile_id"`
 }
 
 func (q *Queries) CreateMovie(ctx context.Context, arg CreateMovieParams) (*Movie, error) {
@@ -123,6 +181,7 @@ func (q *Queries) CreateMovie(ctx context.Context, arg CreateMovieParams) (*Movi
 		arg.TvdbID,
 		arg.ContentRating,
 		arg.AddedBy,
+		arg.LanguageProfileID,
 	)
 	var i Movie
 	err := row.Scan(
@@ -150,14 +209,17 @@ func (q *Queries) CreateMovie(ctx context.Context, arg CreateMovieParams) (*Movi
 		&i.TvdbID,
 		&i.ContentRating,
 		&i.AddedBy,
+		&i.LanguageProfileID,
+		&i.MinimumAvailability,
+		&i.EditionPreference,
 	)
 	return &i, err
 }
 
 const createMovieFile = `-- name: CreateMovieFile :one
-INSERT INTO movie_files (movie_id, path, size, quality, quality_id, video_codec, audio_codec, resolution, audio_channels, dynamic_range)
-VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
-RETURNING id, movie_id, path, size, quality, video_codec, audio_codec, resolution, created_at, quality_id, original_path, original_filename, imported_at, slot_id, audio_channels, dynamic_range
+INSERT INTO movie_files (movie_id, path, size, quality, quality_id, video_codec, audio_codec, resolution, audio_channels, dynamic_range, edition)
+VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+RETURNING id, movie_id, path, size, quality, video_codec, audio_codec, resolution, created_at, quality_id, original_path, original_filename, imported_at, slot_id, audio_channels, dynamic_range, edition, dolby_vision_profile, audio_tracks
 `
 
 type CreateMovieFileParams struct {
@@ -171,6 +233,7 @@ type CreateMovieFileParams struct {
 	Resolution    sql.NullString `json:"resolution"`
 	AudioChannels sql.NullString `json:"audio_channels"`
 	DynamicRange  sql.NullString `json:"dynamic_range"`
+	Edition       string         `json:"edition"`
 }
 
 func (q *Queries) CreateMovieFile(ctx context.Context, arg CreateMovieFileParams) (*MovieFile, error) {
@@ -185,6 +248,7 @@ func (q *Queries) CreateMovieFile(ctx context.Context, arg CreateMovieFileParams
 		arg.Resolution,
 		arg.AudioChannels,
 		arg.DynamicRange,
+		arg.Edition,
 	)
 	var i MovieFile
 	err := row.Scan(
@@ -204,6 +268,9 @@ func (q *Queries) CreateMovieFile(ctx context.Context, arg CreateMovieFileParams
 		&i.SlotID,
 		&i.AudioChannels,
 		&i.DynamicRange,
+		&i.Edition,
+		&i.DolbyVisionProfile,
+		&i.AudioTracks,
 	)
 	return &i, err
 }
@@ -211,9 +278,9 @@ func (q *Queries) CreateMovieFile(ctx context.Context, arg CreateMovieFileParams
 const createMovieFileWithImportInfo = `-- name: CreateMovieFileWithImportInfo :one
 INSERT INTO movie_files (
     movie_id, path, size, quality, quality_id, video_codec, audio_codec, resolution,
-    audio_channels, dynamic_range, original_path, original_filename, imported_at
-) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
-RETURNING id, movie_id, path, size, quality, video_codec, audio_codec, resolution, created_at, quality_id, original_path, original_filename, imported_at, slot_id, audio_channels, dynamic_range
+    audio_channels, dynamic_range, original_path, original_filename, imported_at, edition
+) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+RETURNING id, movie_id, path, size, quality, video_codec, audio_codec, resolution, created_at, quality_id, original_path, original_filename, imported_at, slot_id, audio_channels, dynamic_range, edition, dolby_vision_profile, audio_tracks
 `
 
 type CreateMovieFileWithImportInfoParams struct {
@@ -230,6 +297,7 @@ type CreateMovieFileWithImportInfoParams struct {
 	OriginalPath     sql.NullString `json:"original_path"`
 	OriginalFilename sql.NullString `json:"original_filename"`
 	ImportedAt       sql.NullTime   `json:"imported_at"`
+	Edition          string         `json:"edition"`
 }
 
 // Import-related movie file operations
@@ -248,6 +316,7 @@ func (q *Queries) CreateMovieFileWithImportInfo(ctx context.Context, arg CreateM
 		arg.OriginalPath,
 		arg.OriginalFilename,
 		arg.ImportedAt,
+		arg.Edition,
 	)
 	var i MovieFile
 	err := row.Scan(
@@ -267,6 +336,9 @@ func (q *Queries) CreateMovieFileWithImportInfo(ctx context.Context, arg CreateM
 		&i.SlotID,
 		&i.AudioChannels,
 		&i.DynamicRange,
+		&i.Edition,
+		&i.DolbyVisionProfile,
+		&i.AudioTracks,
 	)
 	return &i, err
 }
@@ -308,7 +380,7 @@ func (q *Queries) DeleteMoviesByRootFolder(ctx context.Context, rootFolderID sql
 }
 
 const getMovie = `-- name: GetMovie :one
-SELECT id, title, sort_title, year, tmdb_id, imdb_id, overview, runtime, path, root_folder_id, quality_profile_id, monitored, status, active_download_id, status_message, release_date, physical_release_date, added_at, updated_at, theatrical_release_date, studio, tvdb_id, content_rating, added_by FROM movies WHERE id = ? LIMIT 1
+SELECT id, title, sort_title, year, tmdb_id, imdb_id, overview, runtime, path, root_folder_id, quality_profile_id, monitored, status, active_download_id, status_message, release_date, physical_release_date, added_at, updated_at, theatrical_release_date, studio, tvdb_id, content_rating, added_by, language_profile_id, minimum_availability, edition_preference FROM movies WHERE id = ? LIMIT 1
 `
 
 func (q *Queries) GetMovie(ctx context.Context, id int64) (*Movie, error) {
@@ -339,12 +411,15 @@ func (q *Queries) GetMovie(ctx context.Context, id int64) (*Movie, error) {
 		&i.TvdbID,
 		&i.ContentRating,
 		&i.AddedBy,
+		&i.LanguageProfileID,
+		&i.MinimumAvailability,
+		&i.EditionPreference,
 	)
 	return &i, err
 }
 
 const getMovieByPath = `-- name: GetMovieByPath :one
-SELECT id, title, sort_title, year, tmdb_id, imdb_id, overview, runtime, path, root_folder_id, quality_profile_id, monitored, status, active_download_id, status_message, release_date, physical_release_date, added_at, updated_at, theatrical_release_date, studio, tvdb_id, content_rating, added_by FROM movies WHERE path = ? LIMIT 1
+SELECT id, title, sort_title, year, tmdb_id, imdb_id, overview, runtime, path, root_folder_id, quality_profile_id, monitored, status, active_download_id, status_message, release_date, physical_release_date, added_at, updated_at, theatrical_release_date, studio, tvdb_id, content_rating, added_by, language_profile_id, minimum_availability, edition_preference FROM movies WHERE path = ? LIMIT 1
 `
 
 func (q *Queries) GetMovieByPath(ctx context.Context, path sql.NullString) (*Movie, error) {
@@ -375,12 +450,15 @@ func (q *Queries) GetMovieByPath(ctx context.Context, path sql.NullString) (*Mov
 		&i.TvdbID,
 		&i.ContentRating,
 		&i.AddedBy,
+		&i.LanguageProfileID,
+		&i.MinimumAvailability,
+		&i.EditionPreference,
 	)
 	return &i, err
 }
 
 const getMovieByTmdbID = `-- name: GetMovieByTmdbID :one
-SELECT id, title, sort_title, year, tmdb_id, imdb_id, overview, runtime, path, root_folder_id, quality_profile_id, monitored, status, active_download_id, status_message, release_date, physical_release_date, added_at, updated_at, theatrical_release_date, studio, tvdb_id, content_rating, added_by FROM movies WHERE tmdb_id = ? LIMIT 1
+SELECT id, title, sort_title, year, tmdb_id, imdb_id, overview, runtime, path, root_folder_id, quality_profile_id, monitored, status, active_download_id, status_message, release_date, physical_release_date, added_at, updated_at, theatrical_release_date, studio, tvdb_id, content_rating, added_by, language_profile_id, minimum_availability, edition_preference FROM movies WHERE tmdb_id = ? LIMIT 1
 `
 
 func (q *Queries) GetMovieByTmdbID(ctx context.Context, tmdbID sql.NullInt64) (*Movie, error) {
@@ -411,12 +489,15 @@ func (q *Queries) GetMovieByTmdbID(ctx context.Context, tmdbID sql.NullInt64) (*
 		&i.TvdbID,
 		&i.ContentRating,
 		&i.AddedBy,
+		&i.LanguageProfileID,
+		&i.MinimumAvailability,
+		&i.EditionPreference,
 	)
 	return &i, err
 }
 
 const getMovieByTvdbID = `-- name: GetMovieByTvdbID :one
-SELECT id, title, sort_title, year, tmdb_id, imdb_id, overview, runtime, path, root_folder_id, quality_profile_id, monitored, status, active_download_id, status_message, release_date, physical_release_date, added_at, updated_at, theatrical_release_date, studio, tvdb_id, content_rating, added_by FROM movies WHERE tvdb_id = ? LIMIT 1
+SELECT id, title, sort_title, year, tmdb_id, imdb_id, overview, runtime, path, root_folder_id, quality_profile_id, monitored, status, active_download_id, status_message, release_date, physical_release_date, added_at, updated_at, theatrical_release_date, studio, tvdb_id, content_rating, added_by, language_profile_id, minimum_availability, edition_preference FROM movies WHERE tvdb_id = ? LIMIT 1
 `
 
 func (q *Queries) GetMovieByTvdbID(ctx context.Context, tvdbID sql.NullInt64) (*Movie, error) {
@@ -447,12 +528,15 @@ func (q *Queries) GetMovieByTvdbID(ctx context.Context, tvdbID sql.NullInt64) (*
 		&i.TvdbID,
 		&i.ContentRating,
 		&i.AddedBy,
+		&i.LanguageProfileID,
+		&i.MinimumAvailability,
+		&i.EditionPreference,
 	)
 	return &i, err
 }
 
 const getMovieFile = `-- name: GetMovieFile :one
-SELECT id, movie_id, path, size, quality, video_codec, audio_codec, resolution, created_at, quality_id, original_path, original_filename, imported_at, slot_id, audio_channels, dynamic_range FROM movie_files WHERE id = ? LIMIT 1
+SELECT id, movie_id, path, size, quality, video_codec, audio_codec, resolution, created_at, quality_id, original_path, original_filename, imported_at, slot_id, audio_channels, dynamic_range, edition, dolby_vision_profile, audio_tracks FROM movie_files WHERE id = ? LIMIT 1
 `
 
 // Movie Files
@@ -476,12 +560,15 @@ func (q *Queries) GetMovieFile(ctx context.Context, id int64) (*MovieFile, error
 		&i.SlotID,
 		&i.AudioChannels,
 		&i.DynamicRange,
+		&i.Edition,
+		&i.DolbyVisionProfile,
+		&i.AudioTracks,
 	)
 	return &i, err
 }
 
 const getMovieFileByOriginalPath = `-- name: GetMovieFileByOriginalPath :one
-SELECT id, movie_id, path, size, quality, video_codec, audio_codec, resolution, created_at, quality_id, original_path, original_filename, imported_at, slot_id, audio_channels, dynamic_range FROM movie_files WHERE original_path = ? LIMIT 1
+SELECT id, movie_id, path, size, quality, video_codec, audio_codec, resolution, created_at, quality_id, original_path, original_filename, imported_at, slot_id, audio_channels, dynamic_range, edition, dolby_vision_profile, audio_tracks FROM movie_files WHERE original_path = ? LIMIT 1
 `
 
 func (q *Queries) GetMovieFileByOriginalPath(ctx context.Context, originalPath sql.NullString) (*MovieFile, error) {
@@ -504,12 +591,15 @@ func (q *Queries) GetMovieFileByOriginalPath(ctx context.Context, originalPath s
 		&i.SlotID,
 		&i.AudioChannels,
 		&i.DynamicRange,
+		&i.Edition,
+		&i.DolbyVisionProfile,
+		&i.AudioTracks,
 	)
 	return &i, err
 }
 
 const getMovieFileByPath = `-- name: GetMovieFileByPath :one
-SELECT id, movie_id, path, size, quality, video_codec, audio_codec, resolution, created_at, quality_id, original_path, original_filename, imported_at, slot_id, audio_channels, dynamic_range FROM movie_files WHERE path = ? LIMIT 1
+SELECT id, movie_id, path, size, quality, video_codec, audio_codec, resolution, created_at, quality_id, original_path, original_filename, imported_at, slot_id, audio_channels, dynamic_range, edition, dolby_vision_profile, audio_tracks FROM movie_files WHERE path = ? LIMIT 1
 `
 
 func (q *Queries) GetMovieFileByPath(ctx context.Context, path string) (*MovieFile, error) {
@@ -532,12 +622,15 @@ func (q *Queries) GetMovieFileByPath(ctx context.Context, path string) (*MovieFi
 		&i.SlotID,
 		&i.AudioChannels,
 		&i.DynamicRange,
+		&i.Edition,
+		&i.DolbyVisionProfile,
+		&i.AudioTracks,
 	)
 	return &i, err
 }
 
 const getMovieFilesWithImportInfo = `-- name: GetMovieFilesWithImportInfo :many
-SELECT id, movie_id, path, size, quality, video_codec, audio_codec, resolution, created_at, quality_id, original_path, original_filename, imported_at, slot_id, audio_channels, dynamic_range FROM movie_files WHERE movie_id = ? ORDER BY imported_at DESC
+SELECT id, movie_id, path, size, quality, video_codec, audio_codec, resolution, created_at, quality_id, original_path, original_filename, imported_at, slot_id, audio_channels, dynamic_range, edition, dolby_vision_profile, audio_tracks FROM movie_files WHERE movie_id = ? ORDER BY imported_at DESC
 `
 
 func (q *Queries) GetMovieFilesWithImportInfo(ctx context.Context, movieID int64) ([]*MovieFile, error) {
@@ -566,6 +659,9 @@ func (q *Queries) GetMovieFilesWithImportInfo(ctx context.Context, movieID int64
 			&i.SlotID,
 			&i.AudioChannels,
 			&i.DynamicRange,
+			&i.Edition,
+			&i.DolbyVisionProfile,
+			&i.AudioTracks,
 		); err != nil {
 			return nil, err
 		}
@@ -581,7 +677,7 @@ func (q *Queries) GetMovieFilesWithImportInfo(ctx context.Context, movieID int64
 }
 
 const getMovieWithAddedBy = `-- name: GetMovieWithAddedBy :one
-SELECT m.id, m.title, m.sort_title, m.year, m.tmdb_id, m.imdb_id, m.overview, m.runtime, m.path, m.root_folder_id, m.quality_profile_id, m.monitored, m.status, m.active_download_id, m.status_message, m.release_date, m.physical_release_date, m.added_at, m.updated_at, m.theatrical_release_date, m.studio, m.tvdb_id, m.content_rating, m.added_by, pu.username AS added_by_username FROM movies m
+SELECT m.id, m.title, m.sort_title, m.year, m.tmdb_id, m.imdb_id, m.overview, m.runtime, m.path, m.root_folder_id, m.quality_profile_id, m.monitored, m.status, m.active_download_id, m.status_message, m.release_date, m.physical_release_date, m.added_at, m.updated_at, m.theatrical_release_date, m.studio, m.tvdb_id, m.content_rating, m.added_by, m.language_profile_id, m.minimum_availability, m.edition_preference, pu.username AS added_by_username FROM movies m
 LEFT JOIN portal_users pu ON m.added_by = pu.id
 WHERE m.id = ? LIMIT 1
 `
@@ -611,6 +707,9 @@ type GetMovieWithAddedByRow struct {
 	TvdbID                sql.NullInt64  `json:"tvdb_id"`
 	ContentRating         sql.NullString `json:"content_rating"`
 	AddedBy               sql.NullInt64  `json:"added_by"`
+	LanguageProfileID     sql.NullInt64  `json:"language_profile_id"`
+	MinimumAvailability   sql.NullString `json:"minimum_availability"`
+	EditionPreference     sql.NullString `json:"edition_preference"`
 	AddedByUsername       sql.NullString `json:"added_by_username"`
 }
 
@@ -642,13 +741,16 @@ func (q *Queries) GetMovieWithAddedBy(ctx context.Context, id int64) (*GetMovieW
 		&i.TvdbID,
 		&i.ContentRating,
 		&i.AddedBy,
+		&i.LanguageProfileID,
+		&i.MinimumAvailability,
+		&i.EditionPreference,
 		&i.AddedByUsername,
 	)
 	return &i, err
 }
 
 const getMovieWithFileQuality = `-- name: GetMovieWithFileQuality :one
-SELECT m.id, m.title, m.sort_title, m.year, m.tmdb_id, m.imdb_id, m.overview, m.runtime, m.path, m.root_folder_id, m.quality_profile_id, m.monitored, m.status, m.active_download_id, m.status_message, m.release_date, m.physical_release_date, m.added_at, m.updated_at, m.theatrical_release_date, m.studio, m.tvdb_id, m.content_rating, m.added_by, mf.id as file_id, mf.quality_id as current_quality_id
+SELECT m.id, m.title, m.sort_title, m.year, m.tmdb_id, m.imdb_id, m.overview, m.runtime, m.path, m.root_folder_id, m.quality_profile_id, m.monitored, m.status, m.active_download_id, m.status_message, m.release_date, m.physical_release_date, m.added_at, m.updated_at, m.theatrical_release_date, m.studio, m.tvdb_id, m.content_rating, m.added_by, m.language_profile_id, m.minimum_availability, m.edition_preference, mf.id as file_id, mf.quality_id as current_quality_id
 FROM movies m
 LEFT JOIN movie_files mf ON m.id = mf.movie_id
 WHERE m.id = ?
@@ -680,6 +782,9 @@ type GetMovieWithFileQualityRow struct {
 	TvdbID                sql.NullInt64  `json:"tvdb_id"`
 	ContentRating         sql.NullString `json:"content_rating"`
 	AddedBy               sql.NullInt64  `json:"added_by"`
+	LanguageProfileID     sql.NullInt64  `json:"language_profile_id"`
+	MinimumAvailability   sql.NullString `json:"minimum_availability"`
+	EditionPreference     sql.NullString `json:"edition_preference"`
 	FileID                sql.NullInt64  `json:"file_id"`
 	CurrentQualityID      sql.NullInt64  `json:"current_quality_id"`
 }
@@ -712,6 +817,9 @@ func (q *Queries) GetMovieWithFileQuality(ctx context.Context, id int64) (*GetMo
 		&i.TvdbID,
 		&i.ContentRating,
 		&i.AddedBy,
+		&i.LanguageProfileID,
+		&i.MinimumAvailability,
+		&i.EditionPreference,
 		&i.FileID,
 		&i.CurrentQualityID,
 	)
@@ -719,7 +827,7 @@ func (q *Queries) GetMovieWithFileQuality(ctx context.Context, id int64) (*GetMo
 }
 
 const getMoviesInDateRange = `-- name: GetMoviesInDateRange :many
-SELECT id, title, sort_title, year, tmdb_id, imdb_id, overview, runtime, path, root_folder_id, quality_profile_id, monitored, status, active_download_id, status_message, release_date, physical_release_date, added_at, updated_at, theatrical_release_date, studio, tvdb_id, content_rating, added_by FROM movies
+SELECT id, title, sort_title, year, tmdb_id, imdb_id, overview, runtime, path, root_folder_id, quality_profile_id, monitored, status, active_download_id, status_message, release_date, physical_release_date, added_at, updated_at, theatrical_release_date, studio, tvdb_id, content_rating, added_by, language_profile_id, minimum_availability, edition_preference FROM movies
 WHERE (release_date BETWEEN ? AND ?)
    OR (physical_release_date BETWEEN ? AND ?)
    OR (theatrical_release_date BETWEEN ? AND ?)
@@ -777,6 +885,9 @@ func (q *Queries) GetMoviesInDateRange(ctx context.Context, arg GetMoviesInDateR
 			&i.TvdbID,
 			&i.ContentRating,
 			&i.AddedBy,
+			&i.LanguageProfileID,
+			&i.MinimumAvailability,
+			&i.EditionPreference,
 		); err != nil {
 			return nil, err
 		}
@@ -792,7 +903,7 @@ func (q *Queries) GetMoviesInDateRange(ctx context.Context, arg GetMoviesInDateR
 }
 
 const getUnreleasedMoviesWithPastDate = `-- name: GetUnreleasedMoviesWithPastDate :many
-SELECT id, title, sort_title, year, tmdb_id, imdb_id, overview, runtime, path, root_folder_id, quality_profile_id, monitored, status, active_download_id, status_message, release_date, physical_release_date, added_at, updated_at, theatrical_release_date, studio, tvdb_id, content_rating, added_by FROM movies
+SELECT id, title, sort_title, year, tmdb_id, imdb_id, overview, runtime, path, root_folder_id, quality_profile_id, monitored, status, active_download_id, status_message, release_date, physical_release_date, added_at, updated_at, theatrical_release_date, studio, tvdb_id, content_rating, added_by, language_profile_id, minimum_availability, edition_preference FROM movies
 WHERE status = 'unreleased' AND
     MIN(COALESCE(substr(release_date, 1, 10), '9999'), COALESCE(substr(physical_release_date, 1, 10), '9999')) <= date('now')
 `
@@ -831,6 +942,9 @@ func (q *Queries) GetUnreleasedMoviesWithPastDate(ctx context.Context) ([]*Movie
 			&i.TvdbID,
 			&i.ContentRating,
 			&i.AddedBy,
+			&i.LanguageProfileID,
+			&i.MinimumAvailability,
+			&i.EditionPreference,
 		); err != nil {
 			return nil, err
 		}
@@ -917,7 +1031,7 @@ func (q *Queries) ListDownloadingMovies(ctx context.Context) ([]*ListDownloading
 }
 
 const listMissingMovies = `-- name: ListMissingMovies :many
-SELECT m.id, m.title, m.sort_title, m.year, m.tmdb_id, m.imdb_id, m.overview, m.runtime, m.path, m.root_folder_id, m.quality_profile_id, m.monitored, m.status, m.active_download_id, m.status_message, m.release_date, m.physical_release_date, m.added_at, m.updated_at, m.theatrical_release_date, m.studio, m.tvdb_id, m.content_rating, m.added_by FROM movies m
+SELECT m.id, m.title, m.sort_title, m.year, m.tmdb_id, m.imdb_id, m.overview, m.runtime, m.path, m.root_folder_id, m.quality_profile_id, m.monitored, m.status, m.active_download_id, m.status_message, m.release_date, m.physical_release_date, m.added_at, m.updated_at, m.theatrical_release_date, m.studio, m.tvdb_id, m.content_rating, m.added_by, m.language_profile_id, m.minimum_availability, m.edition_preference FROM movies m
 WHERE m.status IN ('missing', 'failed')
   AND m.monitored = 1
 ORDER BY m.release_date DESC
@@ -958,6 +1072,9 @@ func (q *Queries) ListMissingMovies(ctx context.Context) ([]*Movie, error) {
 			&i.TvdbID,
 			&i.ContentRating,
 			&i.AddedBy,
+			&i.LanguageProfileID,
+			&i.MinimumAvailability,
+			&i.EditionPreference,
 		); err != nil {
 			return nil, err
 		}
@@ -973,7 +1090,7 @@ func (q *Queries) ListMissingMovies(ctx context.Context) ([]*Movie, error) {
 }
 
 const listMonitoredMovies = `-- name: ListMonitoredMovies :many
-SELECT id, title, sort_title, year, tmdb_id, imdb_id, overview, runtime, path, root_folder_id, quality_profile_id, monitored, status, active_download_id, status_message, release_date, physical_release_date, added_at, updated_at, theatrical_release_date, studio, tvdb_id, content_rating, added_by FROM movies WHERE monitored = 1 ORDER BY sort_title
+SELECT id, title, sort_title, year, tmdb_id, imdb_id, overview, runtime, path, root_folder_id, quality_profile_id, monitored, status, active_download_id, status_message, release_date, physical_release_date, added_at, updated_at, theatrical_release_date, studio, tvdb_id, content_rating, added_by, language_profile_id, minimum_availability, edition_preference FROM movies WHERE monitored = 1 ORDER BY sort_title
 `
 
 func (q *Queries) ListMonitoredMovies(ctx context.Context) ([]*Movie, error) {
@@ -1010,6 +1127,9 @@ func (q *Queries) ListMonitoredMovies(ctx context.Context) ([]*Movie, error) {
 			&i.TvdbID,
 			&i.ContentRating,
 			&i.AddedBy,
+			&i.LanguageProfileID,
+			&i.MinimumAvailability,
+			&i.EditionPreference,
 		); err != nil {
 			return nil, err
 		}
@@ -1025,7 +1145,7 @@ func (q *Queries) ListMonitoredMovies(ctx context.Context) ([]*Movie, error) {
 }
 
 const listMovieFiles = `-- name: ListMovieFiles :many
-SELECT id, movie_id, path, size, quality, video_codec, audio_codec, resolution, created_at, quality_id, original_path, original_filename, imported_at, slot_id, audio_channels, dynamic_range FROM movie_files WHERE movie_id = ? ORDER BY COALESCE(quality_id, 0) DESC, id DESC
+SELECT id, movie_id, path, size, quality, video_codec, audio_codec, resolution, created_at, quality_id, original_path, original_filename, imported_at, slot_id, audio_channels, dynamic_range, edition, dolby_vision_profile, audio_tracks FROM movie_files WHERE movie_id = ? ORDER BY COALESCE(quality_id, 0) DESC, id DESC
 `
 
 func (q *Queries) ListMovieFiles(ctx context.Context, movieID int64) ([]*MovieFile, error) {
@@ -1054,6 +1174,120 @@ func (q *Queries) ListMovieFiles(ctx context.Context, movieID int64) ([]*MovieFi
 			&i.SlotID,
 			&i.AudioChannels,
 			&i.DynamicRange,
+			&i.Edition,
+			&i.DolbyVisionProfile,
+			&i.AudioTracks,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listMovieFilesByMovieIDs = `-- name: ListMovieFilesByMovieIDs :many
+SELECT id, movie_id, path, size, quality, video_codec, audio_codec, resolution, created_at, quality_id, original_path, original_filename, imported_at, slot_id, audio_channels, dynamic_range, edition, dolby_vision_profile, audio_tracks FROM movie_files WHERE movie_id IN (/*SLICE:movieIds*/?) ORDER BY movie_id, COALESCE(quality_id, 0) DESC, id DESC
+`
+
+func (q *Queries) ListMovieFilesByMovieIDs(ctx context.Context, movieids []int64) ([]*MovieFile, error) {
+	query := listMovieFilesByMovieIDs
+	var queryParams []interface{}
+	if len(movieids) > 0 {
+		for _, v := range movieids {
+			queryParams = append(queryParams, v)
+		}
+		query = strings.Replace(query, "/*SLICE:movieIds*/?", strings.Repeat(",?", len(movieids))[1:], 1)
+	} else {
+		query = strings.Replace(query, "/*SLICE:movieIds*/?", "NULL", 1)
+	}
+	rows, err := q.db.QueryContext(ctx, query, queryParams...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []*MovieFile{}
+	for rows.Next() {
+		var i MovieFile
+		if err := rows.Scan(
+			&i.ID,
+			&i.MovieID,
+			&i.Path,
+			&i.Size,
+			&i.Quality,
+			&i.VideoCodec,
+			&i.AudioCodec,
+			&i.Resolution,
+			&i.CreatedAt,
+			&i.QualityID,
+			&i.OriginalPath,
+			&i.OriginalFilename,
+			&i.ImportedAt,
+			&i.SlotID,
+			&i.AudioChannels,
+			&i.DynamicRange,
+			&i.Edition,
+			&i.DolbyVisionProfile,
+			&i.AudioTracks,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listMovieFilesForQualityAudit = `-- name: ListMovieFilesForQualityAudit :many
+SELECT mf.id as file_id, mf.path, mf.size, mf.quality_id, mf.video_codec, mf.resolution,
+       m.id as movie_id, m.title, m.runtime
+FROM movie_files mf
+JOIN movies m ON mf.movie_id = m.id
+WHERE mf.quality_id IS NOT NULL
+ORDER BY m.sort_title, mf.id
+`
+
+type ListMovieFilesForQualityAuditRow struct {
+	FileID     int64          `json:"file_id"`
+	Path       string         `json:"path"`
+	Size       int64          `json:"size"`
+	QualityID  sql.NullInt64  `json:"quality_id"`
+	VideoCodec sql.NullString `json:"video_codec"`
+	Resolution sql.NullString `json:"resolution"`
+	MovieID    int64          `json:"movie_id"`
+	Title      string         `json:"title"`
+	Runtime    sql.NullInt64  `json:"runtime"`
+}
+
+func (q *Queries) ListMovieFilesForQualityAudit(ctx context.Context) ([]*ListMovieFilesForQualityAuditRow, error) {
+	rows, err := q.db.QueryContext(ctx, listMovieFilesForQualityAudit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []*ListMovieFilesForQualityAuditRow{}
+	for rows.Next() {
+		var i ListMovieFilesForQualityAuditRow
+		if err := rows.Scan(
+			&i.FileID,
+			&i.Path,
+			&i.Size,
+			&i.QualityID,
+			&i.VideoCodec,
+			&i.Resolution,
+			&i.MovieID,
+			&i.Title,
+			&i.Runtime,
 		); err != nil {
 			return nil, err
 		}
@@ -1111,8 +1345,125 @@ func (q *Queries) ListMovieFilesForRootFolder(ctx context.Context, rootFolderID
 	return items, nil
 }
 
+const listMoviesFiltered = `-- name: ListMoviesFiltered :many
+WITH o AS (
+    SELECT CAST(?3 AS TEXT) AS search_term,
+           CAST(?4 AS TEXT) AS statuses,
+           CAST(?5 AS BOOLEAN) AS monitored,
+           CAST(?6 AS INTEGER) AS root_folder_id,
+           CAST(?7 AS INTEGER) AS quality_profile_id,
+           CAST(?8 AS INTEGER) AS tag_id,
+           CAST(?9 AS TEXT) AS sort_key,
+           CAST(?10 AS BOOLEAN) AS descending
+)
+SELECT m.id, m.title, m.sort_title, m.year, m.tmdb_id, m.imdb_id, m.overview, m.runtime, m.path, m.root_folder_id, m.quality_profile_id, m.monitored, m.status, m.active_download_id, m.status_message, m.release_date, m.physical_release_date, m.added_at, m.updated_at, m.theatrical_release_date, m.studio, m.tvdb_id, m.content_rating, m.added_by, m.language_profile_id, m.minimum_availability, m.edition_preference FROM movies m, o
+WHERE (o.search_term = ''
+       OR m.title LIKE '%' || o.search_term || '%' OR m.sort_title LIKE '%' || o.search_term || '%'
+       OR REPLACE(m.title, '''', '') LIKE '%' || o.search_term || '%'
+       OR REPLACE(m.sort_title, '''', '') LIKE '%' || o.search_term || '%')
+  AND (o.monitored IS NULL OR m.monitored = o.monitored)
+  AND (o.root_folder_id IS NULL OR m.root_folder_id = o.root_folder_id)
+  AND (o.quality_profile_id IS NULL OR m.quality_profile_id = o.quality_profile_id)
+  AND (o.tag_id IS NULL OR EXISTS (
+       SELECT 1 FROM entity_tags et
+       WHERE et.entity_type = 'movie' AND et.entity_id = m.id AND et.tag_id = o.tag_id))
+  AND (o.statuses = '' OR instr(',' || o.statuses || ',', ',' || m.status || ',') > 0)
+ORDER BY
+    CASE WHEN o.sort_key = 'year' AND NOT o.descending THEN m.year END ASC,
+    CASE WHEN o.sort_key = 'year' AND o.descending THEN m.year END DESC,
+    CASE WHEN o.sort_key = 'added' AND NOT o.descending THEN m.added_at END ASC,
+    CASE WHEN o.sort_key = 'added' AND o.descending THEN m.added_at END DESC,
+    CASE WHEN o.sort_key = 'releaseDate' AND NOT o.descending THEN m.release_date END ASC,
+    CASE WHEN o.sort_key = 'releaseDate' AND o.descending THEN m.release_date END DESC,
+    CASE WHEN o.sort_key = 'status' AND NOT o.descending THEN m.status END ASC,
+    CASE WHEN o.sort_key = 'status' AND o.descending THEN m.status END DESC,
+    CASE WHEN o.sort_key = 'title' AND o.descending THEN m.sort_title END DESC,
+    m.sort_title, m.id
+LIMIT ?2 OFFSET ?1
+`
+
+type ListMoviesFilteredParams struct {
+	Off              int64         `json:"off"`
+	Lim              int64         `json:"lim"`
+	SearchTerm       string        `json:"search_term"`
+	Statuses         string        `json:"statuses"`
+	Monitored        sql.NullBool  `json:"monitored"`
+	RootFolderID     sql.NullInt64 `json:"root_folder_id"`
+	QualityProfileID sql.NullInt64 `json:"quality_profile_id"`
+	TagID            sql.NullInt64 `json:"tag_id"`
+	SortKey          string        `json:"sort_key"`
+	Descending       bool          `json:"descending"`
+}
+
+// Filters, sorts and pages the library in SQL. Empty or NULL arguments
+// disable their filter; a limit of -1 returns every match. The options CTE
+// binds the arguments once so the WHERE and ORDER BY can refer to them.
+func (q *Queries) ListMoviesFiltered(ctx context.Context, arg ListMoviesFilteredParams) ([]*Movie, error) {
+	rows, err := q.db.QueryContext(ctx, listMoviesFiltered,
+		arg.Off,
+		arg.Lim,
+		arg.SearchTerm,
+		arg.Statuses,
+		arg.Monitored,
+		arg.RootFolderID,
+		arg.QualityProfileID,
+		arg.TagID,
+		arg.SortKey,
+		arg.Descending,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []*Movie{}
+	for rows.Next() {
+		var i Movie
+		if err := rows.Scan(
+			&i.ID,
+			&i.Title,
+			&i.SortTitle,
+			&i.Year,
+			&i.TmdbID,
+			&i.ImdbID,
+			&i.Overview,
+			&i.Runtime,
+			&i.Path,
+			&i.RootFolderID,
+			&i.QualityProfileID,
+			&i.Monitored,
+			&i.Status,
+			&i.ActiveDownloadID,
+			&i.StatusMessage,
+			&i.ReleaseDate,
+			&i.PhysicalReleaseDate,
+			&i.AddedAt,
+			&i.UpdatedAt,
+			&i.TheatricalReleaseDate,
+			&i.Studio,
+			&i.TvdbID,
+			&i.ContentRating,
+			&i.AddedBy,
+			&i.LanguageProfileID,
+			&i.MinimumAvailability,
+			&i.EditionPreference,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listMovieUpgradeCandidates = `-- name: ListMovieUpgradeCandidates :many
-SELECT m.id, m.title, m.sort_title, m.year, m.tmdb_id, m.imdb_id, m.overview, m.runtime, m.path, m.root_folder_id, m.quality_profile_id, m.monitored, m.status, m.active_download_id, m.status_message, m.release_date, m.physical_release_date, m.added_at, m.updated_at, m.theatrical_release_date, m.studio, m.tvdb_id, m.content_rating, m.added_by, mf.quality_id as current_quality_id FROM movies m
+SELECT m.id, m.title, m.sort_title, m.year, m.tmdb_id, m.imdb_id, m.overview, m.runtime, m.path, m.root_folder_id, m.quality_profile_id, m.monitored, m.status, m.active_download_id, m.status_message, m.release_date, m.physical_release_date, m.added_at, m.updated_at, m.theatrical_release_date, m.studio, m.tvdb_id, m.content_rating, m.added_by, m.language_profile_id, m.minimum_availability, m.edition_preference, mf.quality_id as current_quality_id,
+       mf.path as current_file_path, mf.original_filename as current_original_filename
+FROM movies m
 JOIN movie_files mf ON mf.id = (
     SELECT id FROM movie_files WHERE movie_id = m.id ORDER BY id DESC LIMIT 1
 )
@@ -1122,31 +1473,36 @@ ORDER BY m.release_date DESC
 `
 
 type ListMovieUpgradeCandidatesRow struct {
-	ID                    int64          `json:"id"`
-	Title                 string         `json:"title"`
-	SortTitle             string         `json:"sort_title"`
-	Year                  sql.NullInt64  `json:"year"`
-	TmdbID                sql.NullInt64  `json:"tmdb_id"`
-	ImdbID                sql.NullString `json:"imdb_id"`
-	Overview              sql.NullString `json:"overview"`
-	Runtime               sql.NullInt64  `json:"runtime"`
-	Path                  sql.NullString `json:"path"`
-	RootFolderID          sql.NullInt64  `json:"root_folder_id"`
-	QualityProfileID      sql.NullInt64  `json:"quality_profile_id"`
-	Monitored             bool           `json:"monitored"`
-	Status                string         `json:"status"`
-	ActiveDownloadID      sql.NullString `json:"active_download_id"`
-	StatusMessage         sql.NullString `json:"status_message"`
-	ReleaseDate           sql.NullTime   `json:"release_date"`
-	PhysicalReleaseDate   sql.NullTime   `json:"physical_release_date"`
-	AddedAt               sql.NullTime   `json:"added_at"`
-	UpdatedAt             sql.NullTime   `json:"updated_at"`
-	TheatricalReleaseDate sql.NullTime   `json:"theatrical_release_date"`
-	Studio                sql.NullString `json:"studio"`
-	TvdbID                sql.NullInt64  `json:"tvdb_id"`
-	ContentRating         sql.NullString `json:"content_rating"`
-	AddedBy               sql.NullInt64  `json:"added_by"`
-	CurrentQualityID      sql.NullInt64  `json:"current_quality_id"`
+	ID                      int64          `json:"id"`
+	Title                   string         `json:"title"`
+	SortTitle               string         `json:"sort_title"`
+	Year                    sql.NullInt64  `json:"year"`
+	TmdbID                  sql.NullInt64  `json:"tmdb_id"`
+	ImdbID                  sql.NullString `json:"imdb_id"`
+	Overview                sql.NullString `json:"overview"`
+	Runtime                 sql.NullInt64  `json:"runtime"`
+	Path                    sql.NullString `json:"path"`
+	RootFolderID            sql.NullInt64  `json:"root_folder_id"`
+	QualityProfileID        sql.NullInt64  `json:"quality_profile_id"`
+	Monitored               bool           `json:"monitored"`
+	Status                  string         `json:"status"`
+	ActiveDownloadID        sql.NullString `json:"active_download_id"`
+	StatusMessage           sql.NullString `json:"status_message"`
+	ReleaseDate             sql.NullTime   `json:"release_date"`
+	PhysicalReleaseDate     sql.NullTime   `json:"physical_release_date"`
+	AddedAt                 sql.NullTime   `json:"added_at"`
+	UpdatedAt               sql.NullTime   `json:"updated_at"`
+	TheatricalReleaseDate   sql.NullTime   `json:"theatrical_release_date"`
+	Studio                  sql.NullString `json:"studio"`
+	TvdbID                  sql.NullInt64  `json:"tvdb_id"`
+	ContentRating           sql.NullString `json:"content_rating"`
+	AddedBy                 sql.NullInt64  `json:"added_by"`
+	LanguageProfileID       sql.NullInt64  `json:"language_profile_id"`
+	MinimumAvailability     sql.NullString `json:"minimum_availability"`
+	EditionPreference       sql.NullString `json:"edition_preference"`
+	CurrentQualityID        sql.NullInt64  `json:"current_quality_id"`
+	CurrentFilePath         string         `json:"current_file_path"`
+	CurrentOriginalFilename sql.NullString `json:"current_original_filename"`
 }
 
 // Upgrade candidate queries (status-based)
@@ -1184,7 +1540,12 @@ func (q *Queries) ListMovieUpgradeCandidates(ctx context.Context) ([]*ListMovieU
 			&i.TvdbID,
 			&i.ContentRating,
 			&i.AddedBy,
+			&i.LanguageProfileID,
+			&i.MinimumAvailability,
+			&i.EditionPreference,
 			&i.CurrentQualityID,
+			&i.CurrentFilePath,
+			&i.CurrentOriginalFilename,
 		); err != nil {
 			return nil, err
 		}
@@ -1200,7 +1561,7 @@ func (q *Queries) ListMovieUpgradeCandidates(ctx context.Context) ([]*ListMovieU
 }
 
 const listMovies = `-- name: ListMovies :many
-SELECT id, title, sort_title, year, tmdb_id, imdb_id, overview, runtime, path, root_folder_id, quality_profile_id, monitored, status, active_download_id, status_message, release_date, physical_release_date, added_at, updated_at, theatrical_release_date, studio, tvdb_id, content_rating, added_by FROM movies ORDER BY sort_title
+SELECT id, title, sort_title, year, tmdb_id, imdb_id, overview, runtime, path, root_folder_id, quality_profile_id, monitored, status, active_download_id, status_message, release_date, physical_release_date, added_at, updated_at, theatrical_release_date, studio, tvdb_id, content_rating, added_by, language_profile_id, minimum_availability, edition_preference FROM movies ORDER BY sort_title
 `
 
 func (q *Queries) ListMovies(ctx context.Context) ([]*Movie, error) {
@@ -1237,6 +1598,74 @@ func (q *Queries) ListMovies(ctx context.Context) ([]*Movie, error) {
 			&i.TvdbID,
 			&i.ContentRating,
 			&i.AddedBy,
+			&i.LanguageProfileID,
+			&i.MinimumAvailability,
+			&i.EditionPreference,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listMoviesByIDs = `-- name: ListMoviesByIDs :many
+SELECT id, title, sort_title, year, tmdb_id, imdb_id, overview, runtime, path, root_folder_id, quality_profile_id, monitored, status, active_download_id, status_message, release_date, physical_release_date, added_at, updated_at, theatrical_release_date, studio, tvdb_id, content_rating, added_by, language_profile_id, minimum_availability, edition_preference FROM movies WHERE id IN (/*SLICE:ids*/?)
+`
+
+func (q *Queries) ListMoviesByIDs(ctx context.Context, ids []int64) ([]*Movie, error) {
+	query := listMoviesByIDs
+	var queryParams []interface{}
+	if len(ids) > 0 {
+		for _, v := range ids {
+			queryParams = append(queryParams, v)
+		}
+		query = strings.Replace(query, "/*SLICE:ids*/?", strings.Repeat(",?", len(ids))[1:], 1)
+	} else {
+		query = strings.Replace(query, "/*SLICE:ids*/?", "NULL", 1)
+	}
+	rows, err := q.db.QueryContext(ctx, query, queryParams...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []*Movie{}
+	for rows.Next() {
+		var i Movie
+		if err := rows.Scan(
+			&i.ID,
+			&i.Title,
+			&i.SortTitle,
+			&i.Year,
+			&i.TmdbID,
+			&i.ImdbID,
+			&i.Overview,
+			&i.Runtime,
+			&i.Path,
+			&i.RootFolderID,
+			&i.QualityProfileID,
+			&i.Monitored,
+			&i.Status,
+			&i.ActiveDownloadID,
+			&i.StatusMessage,
+			&i.ReleaseDate,
+			&i.PhysicalReleaseDate,
+			&i.AddedAt,
+			&i.UpdatedAt,
+			&i.TheatricalReleaseDate,
+			&i.Studio,
+			&i.TvdbID,
+			&i.ContentRating,
+			&i.AddedBy,
+			&i.LanguageProfileID,
+			&i.MinimumAvailability,
+			&i.EditionPreference,
 		); err != nil {
 			return nil, err
 		}
@@ -1252,7 +1681,7 @@ func (q *Queries) ListMovies(ctx context.Context) ([]*Movie, error) {
 }
 
 const listMoviesByRootFolder = `-- name: ListMoviesByRootFolder :many
-SELECT id, title, sort_title, year, tmdb_id, imdb_id, overview, runtime, path, root_folder_id, quality_profile_id, monitored, status, active_download_id, status_message, release_date, physical_release_date, added_at, updated_at, theatrical_release_date, studio, tvdb_id, content_rating, added_by FROM movies WHERE root_folder_id = ? ORDER BY sort_title
+SELECT id, title, sort_title, year, tmdb_id, imdb_id, overview, runtime, path, root_folder_id, quality_profile_id, monitored, status, active_download_id, status_message, release_date, physical_release_date, added_at, updated_at, theatrical_release_date, studio, tvdb_id, content_rating, added_by, language_profile_id, minimum_availability, edition_preference FROM movies WHERE root_folder_id = ? ORDER BY sort_title
 `
 
 func (q *Queries) ListMoviesByRootFolder(ctx context.Context, rootFolderID sql.NullInt64) ([]*Movie, error) {
@@ -1289,6 +1718,9 @@ func (q *Queries) ListMoviesByRootFolder(ctx context.Context, rootFolderID sql.N
 			&i.TvdbID,
 			&i.ContentRating,
 			&i.AddedBy,
+			&i.LanguageProfileID,
+			&i.MinimumAvailability,
+			&i.EditionPreference,
 		); err != nil {
 			return nil, err
 		}
@@ -1304,7 +1736,7 @@ func (q *Queries) ListMoviesByRootFolder(ctx context.Context, rootFolderID sql.N
 }
 
 const listMoviesPaginated = `-- name: ListMoviesPaginated :many
-SELECT id, title, sort_title, year, tmdb_id, imdb_id, overview, runtime, path, root_folder_id, quality_profile_id, monitored, status, active_download_id, status_message, release_date, physical_release_date, added_at, updated_at, theatrical_release_date, studio, tvdb_id, content_rating, added_by FROM movies
+SELECT id, title, sort_title, year, tmdb_id, imdb_id, overview, runtime, path, root_folder_id, quality_profile_id, monitored, status, active_download_id, status_message, release_date, physical_release_date, added_at, updated_at, theatrical_release_date, studio, tvdb_id, content_rating, added_by, language_profile_id, minimum_availability, edition_preference FROM movies
 ORDER BY sort_title
 LIMIT ? OFFSET ?
 `
@@ -1348,6 +1780,9 @@ func (q *Queries) ListMoviesPaginated(ctx context.Context, arg ListMoviesPaginat
 			&i.TvdbID,
 			&i.ContentRating,
 			&i.AddedBy,
+			&i.LanguageProfileID,
+			&i.MinimumAvailability,
+			&i.EditionPreference,
 		); err != nil {
 			return nil, err
 		}
@@ -1407,8 +1842,71 @@ func (q *Queries) ListMoviesWithFilesForProfile(ctx context.Context, qualityProf
 	return items, nil
 }
 
+const listProperCandidateMovies = `-- name: ListProperCandidateMovies :many
+SELECT m.id, m.title, m.year, m.tmdb_id, m.imdb_id, m.runtime, m.quality_profile_id, m.edition_preference,
+       mf.quality_id as current_quality_id,
+       mf.path as current_file_path, mf.original_filename as current_original_filename
+FROM movies m
+JOIN movie_files mf ON mf.id = (
+    SELECT id FROM movie_files WHERE movie_id = m.id ORDER BY id DESC LIMIT 1
+)
+WHERE m.status = 'available' AND m.monitored = 1
+  AND mf.quality_id IS NOT NULL
+  AND mf.imported_at >= ?1
+`
+
+type ListProperCandidateMoviesRow struct {
+	ID                      int64          `json:"id"`
+	Title                   string         `json:"title"`
+	Year                    sql.NullInt64  `json:"year"`
+	TmdbID                  sql.NullInt64  `json:"tmdb_id"`
+	ImdbID                  sql.NullString `json:"imdb_id"`
+	Runtime                 sql.NullInt64  `json:"runtime"`
+	QualityProfileID        sql.NullInt64  `json:"quality_profile_id"`
+	EditionPreference       sql.NullString `json:"edition_preference"`
+	CurrentQualityID        sql.NullInt64  `json:"current_quality_id"`
+	CurrentFilePath         string         `json:"current_file_path"`
+	CurrentOriginalFilename sql.NullString `json:"current_original_filename"`
+}
+
+// Movies whose file met the cutoff, watched by RSS sync for PROPER/REPACK replacements
+func (q *Queries) ListProperCandidateMovies(ctx context.Context, importedSince sql.NullTime) ([]*ListProperCandidateMoviesRow, error) {
+	rows, err := q.db.QueryContext(ctx, listProperCandidateMovies, importedSince)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []*ListProperCandidateMoviesRow{}
+	for rows.Next() {
+		var i ListProperCandidateMoviesRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Title,
+			&i.Year,
+			&i.TmdbID,
+			&i.ImdbID,
+			&i.Runtime,
+			&i.QualityProfileID,
+			&i.EditionPreference,
+			&i.CurrentQualityID,
+			&i.CurrentFilePath,
+			&i.CurrentOriginalFilename,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listUnmatchedMoviesByRootFolder = `-- name: ListUnmatchedMoviesByRootFolder :many
-SELECT id, title, sort_title, year, tmdb_id, imdb_id, overview, runtime, path, root_folder_id, quality_profile_id, monitored, status, active_download_id, status_message, release_date, physical_release_date, added_at, updated_at, theatrical_release_date, studio, tvdb_id, content_rating, added_by FROM movies
+SELECT id, title, sort_title, year, tmdb_id, imdb_id, overview, runtime, path, root_folder_id, quality_profile_id, monitored, status, active_download_id, status_message, release_date, physical_release_date, added_at, updated_at, theatrical_release_date, studio, tvdb_id, content_rating, added_by, language_profile_id, minimum_availability, edition_preference FROM movies
 WHERE root_folder_id = ?
   AND (tmdb_id IS NULL OR tmdb_id = 0)
 ORDER BY sort_title
@@ -1448,6 +1946,9 @@ func (q *Queries) ListUnmatchedMoviesByRootFolder(ctx context.Context, rootFolde
 			&i.TvdbID,
 			&i.ContentRating,
 			&i.AddedBy,
+			&i.LanguageProfileID,
+			&i.MinimumAvailability,
+			&i.EditionPreference,
 		); err != nil {
 			return nil, err
 		}
@@ -1463,7 +1964,8 @@ func (q *Queries) ListUnmatchedMoviesByRootFolder(ctx context.Context, rootFolde
 }
 
 const listUpgradableMoviesWithQuality = `-- name: ListUpgradableMoviesWithQuality :many
-SELECT m.id, m.title, m.sort_title, m.year, m.tmdb_id, m.imdb_id, m.overview, m.runtime, m.path, m.root_folder_id, m.quality_profile_id, m.monitored, m.status, m.active_download_id, m.status_message, m.release_date, m.physical_release_date, m.added_at, m.updated_at, m.theatrical_release_date, m.studio, m.tvdb_id, m.content_rating, m.added_by, mf.quality_id as current_quality_id
+SELECT m.id, m.title, m.sort_title, m.year, m.tmdb_id, m.imdb_id, m.overview, m.runtime, m.path, m.root_folder_id, m.quality_profile_id, m.monitored, m.status, m.active_download_id, m.status_message, m.release_date, m.physical_release_date, m.added_at, m.updated_at, m.theatrical_release_date, m.studio, m.tvdb_id, m.content_rating, m.added_by, m.language_profile_id, m.minimum_availability, m.edition_preference, mf.quality_id as current_quality_id,
+       mf.path as current_file_path, mf.original_filename as current_original_filename
 FROM movies m
 JOIN movie_files mf ON mf.id = (
     SELECT id FROM movie_files WHERE movie_id = m.id ORDER BY id DESC LIMIT 1
@@ -1473,31 +1975,36 @@ ORDER BY m.release_date DESC
 `
 
 type ListUpgradableMoviesWithQualityRow struct {
-	ID                    int64          `json:"id"`
-	Title                 string         `json:"title"`
-	SortTitle             string         `json:"sort_title"`
-	Year                  sql.NullInt64  `json:"year"`
-	TmdbID                sql.NullInt64  `json:"tmdb_id"`
-	ImdbID                sql.NullString `json:"imdb_id"`
-	Overview              sql.NullString `json:"overview"`
-	Runtime               sql.NullInt64  `json:"runtime"`
-	Path                  sql.NullString `json:"path"`
-	RootFolderID          sql.NullInt64  `json:"root_folder_id"`
-	QualityProfileID      sql.NullInt64  `json:"quality_profile_id"`
-	Monitored             bool           `json:"monitored"`
-	Status                string         `json:"status"`
-	ActiveDownloadID      sql.NullString `json:"active_download_id"`
-	StatusMessage         sql.NullString `json:"status_message"`
-	ReleaseDate           sql.NullTime   `json:"release_date"`
-	PhysicalReleaseDate   sql.NullTime   `json:"physical_release_date"`
-	AddedAt               sql.NullTime   `json:"added_at"`
-	UpdatedAt             sql.NullTime   `json:"updated_at"`
-	TheatricalReleaseDate sql.NullTime   `json:"theatrical_release_date"`
-	Studio                sql.NullString `json:"studio"`
-	TvdbID                sql.NullInt64  `json:"tvdb_id"`
-	ContentRating         sql.NullString `json:"content_rating"`
-	AddedBy               sql.NullInt64  `json:"added_by"`
-	CurrentQualityID      sql.NullInt64  `json:"current_quality_id"`
+	ID                      int64          `json:"id"`
+	Title                   string         `json:"title"`
+	SortTitle               string         `json:"sort_title"`
+	Year                    sql.NullInt64  `json:"year"`
+	TmdbID                  sql.NullInt64  `json:"tmdb_id"`
+	ImdbID                  sql.NullString `json:"imdb_id"`
+	Overview                sql.NullString `json:"overview"`
+	Runtime                 sql.NullInt64  `json:"runtime"`
+	Path                    sql.NullString `json:"path"`
+	RootFolderID            sql.NullInt64  `json:"root_folder_id"`
+	QualityProfileID        sql.NullInt64  `json:"quality_profile_id"`
+	Monitored               bool           `json:"monitored"`
+	Status                  string         `json:"status"`
+	ActiveDownloadID        sql.NullString `json:"active_download_id"`
+	StatusMessage           sql.NullString `json:"status_message"`
+	ReleaseDate             sql.NullTime   `json:"release_date"`
+	PhysicalReleaseDate     sql.NullTime   `json:"physical_release_date"`
+	AddedAt                 sql.NullTime   `json:"added_at"`
+	UpdatedAt               sql.NullTime   `json:"updated_at"`
+	TheatricalReleaseDate   sql.NullTime   `json:"theatrical_release_date"`
+	Studio                  sql.NullString `json:"studio"`
+	TvdbID                  sql.NullInt64  `json:"tvdb_id"`
+	ContentRating           sql.NullString `json:"content_rating"`
+	AddedBy                 sql.NullInt64  `json:"added_by"`
+	LanguageProfileID       sql.NullInt64  `json:"language_profile_id"`
+	MinimumAvailability     sql.NullString `json:"minimum_availability"`
+	EditionPreference       sql.NullString `json:"edition_preference"`
+	CurrentQualityID        sql.NullInt64  `json:"current_quality_id"`
+	CurrentFilePath         string         `json:"current_file_path"`
+	CurrentOriginalFilename sql.NullString `json:"current_original_filename"`
 }
 
 // Upgradable movies with current file quality
@@ -1535,7 +2042,12 @@ func (q *Queries) ListUpgradableMoviesWithQuality(ctx context.Context) ([]*ListU
 			&i.TvdbID,
 			&i.ContentRating,
 			&i.AddedBy,
+			&i.LanguageProfileID,
+			&i.MinimumAvailability,
+			&i.EditionPreference,
 			&i.CurrentQualityID,
+			&i.CurrentFilePath,
+			&i.CurrentOriginalFilename,
 		); err != nil {
 			return nil, err
 		}
@@ -1551,7 +2063,7 @@ func (q *Queries) ListUpgradableMoviesWithQuality(ctx context.Context) ([]*ListU
 }
 
 const searchMovies = `-- name: SearchMovies :many
-SELECT id, title, sort_title, year, tmdb_id, imdb_id, overview, runtime, path, root_folder_id, quality_profile_id, monitored, status, active_download_id, status_message, release_date, physical_release_date, added_at, updated_at, theatrical_release_date, studio, tvdb_id, content_rating, added_by FROM movies
+SELECT id, title, sort_title, year, tmdb_id, imdb_id, overview, runtime, path, root_folder_id, quality_profile_id, monitored, status, active_download_id, status_message, release_date, physical_release_date, added_at, updated_at, theatrical_release_date, studio, tvdb_id, content_rating, added_by, language_profile_id, minimum_availability, edition_preference FROM movies
 WHERE title LIKE ?1 OR sort_title LIKE ?1
    OR REPLACE(title, '''', '') LIKE ?1
    OR REPLACE(sort_title, '''', '') LIKE ?1
@@ -1599,6 +2111,9 @@ func (q *Queries) SearchMovies(ctx context.Context, arg SearchMoviesParams) ([]*
 			&i.TvdbID,
 			&i.ContentRating,
 			&i.AddedBy,
+			&i.LanguageProfileID,
+			&i.MinimumAvailability,
+			&i.EditionPreference,
 		); err != nil {
 			return nil, err
 		}
@@ -1625,6 +2140,8 @@ UPDATE movies SET
     path = ?,
     root_folder_id = ?,
     quality_profile_id = ?,
+    language_profile_id = ?,
+    minimum_availability = ?,
     monitored = ?,
     status = ?,
     release_date = ?,
@@ -1633,9 +2150,10 @@ UPDATE movies SET
     studio = ?,
     tvdb_id = ?,
     content_rating = ?,
+    edition_preference = ?,
     updated_at = CURRENT_TIMESTAMP
 WHERE id = ?
-RETURNING id, title, sort_title, year, tmdb_id, imdb_id, overview, runtime, path, root_folder_id, quality_profile_id, monitored, status, active_download_id, status_message, release_date, physical_release_date, added_at, updated_at, theatrical_release_date, studio, tvdb_id, content_rating, added_by
+RETURNING id, title, sort_title, year, tmdb_id, imdb_id, overview, runtime, path, root_folder_id, quality_profile_id, monitored, status, active_download_id, status_message, release_date, physical_release_date, added_at, updated_at, theatrical_release_date, studio, tvdb_id, content_rating, added_by, language_profile_id, minimum_availability, edition_preference
 `
 
 type UpdateMovieParams struct {
@@ -1649,6 +2167,8 @@ type UpdateMovieParams struct {
 	Path                  sql.NullString `json:"path"`
 	RootFolderID          sql.NullInt64  `json:"root_folder_id"`
 	QualityProfileID      sql.NullInt64  `json:"quality_profile_id"`
+	LanguageProfileID     sql.NullInt64  `json:"language_profile_id"`
+	MinimumAvailability   sql.NullString `json:"minimum_availability"`
 	Monitored             bool           `json:"monitored"`
 	Status                string         `json:"status"`
 	ReleaseDate           sql.NullTime   `json:"release_date"`
@@ -1657,6 +2177,7 @@ type UpdateMovieParams struct {
 	Studio                sql.NullString `json:"studio"`
 	TvdbID                sql.NullInt64  `json:"tvdb_id"`
 	ContentRating         sql.NullString `json:"content_rating"`
+	EditionPreference     sql.NullString `json:"edition_preference"`
 	ID                    int64          `json:"id"`
 }
 
@@ -1672,6 +2193,8 @@ func (q *Queries) UpdateMovie(ctx context.Context, arg UpdateMovieParams) (*Movi
 		arg.Path,
 		arg.RootFolderID,
 		arg.QualityProfileID,
+		arg.LanguageProfileID,
+		arg.MinimumAvailability,
 		arg.Monitored,
 		arg.Status,
 		arg.ReleaseDate,
@@ -1680,6 +2203,7 @@ func (q *Queries) UpdateMovie(ctx context.Context, arg UpdateMovieParams) (*Movi
 		arg.Studio,
 		arg.TvdbID,
 		arg.ContentRating,
+		arg.EditionPreference,
 		arg.ID,
 	)
 	var i Movie
@@ -1708,17 +2232,54 @@ func (q *Queries) UpdateMovie(ctx context.Context, arg UpdateMovieParams) (*Movi
 		&i.TvdbID,
 		&i.ContentRating,
 		&i.AddedBy,
+		&i.LanguageProfileID,
+		&i.MinimumAvailability,
+		&i.EditionPreference,
 	)
 	return &i, err
 }
 
+const updateMovieFileDiskInfo = `-- name: UpdateMovieFileDiskInfo :exec
+UPDATE movie_files SET
+    size = ?,
+    video_codec = ?,
+    audio_codec = ?,
+    resolution = ?,
+    audio_channels = ?,
+    dynamic_range = ?
+WHERE id = ?
+`
+
+type UpdateMovieFileDiskInfoParams struct {
+	Size          int64          `json:"size"`
+	VideoCodec    sql.NullString `json:"video_codec"`
+	AudioCodec    sql.NullString `json:"audio_codec"`
+	Resolution    sql.NullString `json:"resolution"`
+	AudioChannels sql.NullString `json:"audio_channels"`
+	DynamicRange  sql.NullString `json:"dynamic_range"`
+	ID            int64          `json:"id"`
+}
+
+func (q *Queries) UpdateMovieFileDiskInfo(ctx context.Context, arg UpdateMovieFileDiskInfoParams) error {
+	_, err := q.db.ExecContext(ctx, updateMovieFileDiskInfo,
+		arg.Size,
+		arg.VideoCodec,
+		arg.AudioCodec,
+		arg.Resolution,
+		arg.AudioChannels,
+		arg.DynamicRange,
+		arg.ID,
+	)
+	return err
+}
+
 const updateMovieFileImportInfo = `-- name: UpdateMovieFileImportInfo :one
 UPDATE movie_files SET
     original_path = ?,
     original_filename = ?,
     imported_at = ?
 WHERE id = ?
-RETURNING id, movie_id, path, size, quality, video_codec, audio_codec, resolution, created_at, quality_id, original_path, original_filename, imported_at, slot_id, audio_channels, dynamic_range
+RETURNING id, movie_id, path, size, quality, video_codec, audio_codec, resolution, created_at, quality_id, original_path, original_filename, imported_at, slot_id, audio_channels, dynamic_range, edition, dolby_vision_profile, audio_tracks
 `
 
 type UpdateMovieFileImportInfoParams struct {
@@ -1753,6 +2314,9 @@ func (q *Queries) UpdateMovieFileImportInfo(ctx context.Context, arg UpdateMovie
 		&i.SlotID,
 		&i.AudioChannels,
 		&i.DynamicRange,
+		&i.Edition,
+		&i.DolbyVisionProfile,
+		&i.AudioTracks,
 	)
 	return &i, err
 }
@@ -1761,15 +2325,23 @@ const updateMovieFileMediaInfo = `-- name: UpdateMovieFileMediaInfo :exec
 UPDATE movie_files SET
     video_codec = ?,
     audio_codec = ?,
-    resolution = ?
+    resolution = ?,
+    audio_channels = ?,
+    dynamic_range = ?,
+    dolby_vision_profile = ?,
+    audio_tracks = ?
 WHERE movie_id = ?
 `
 
 type UpdateMovieFileMediaInfoParams struct {
-	VideoCodec sql.NullString `json:"video_codec"`
-	AudioCodec sql.NullString `json:"audio_codec"`
-	Resolution sql.NullString `json:"resolution"`
-	MovieID    int64          `json:"movie_id"`
+	VideoCodec         sql.NullString `json:"video_codec"`
+	AudioCodec         sql.NullString `json:"audio_codec"`
+	Resolution         sql.NullString `json:"resolution"`
+	AudioChannels      sql.NullString `json:"audio_channels"`
+	DynamicRange       sql.NullString `json:"dynamic_range"`
+	DolbyVisionProfile string         `json:"dolby_vision_profile"`
+	AudioTracks        string         `json:"audio_tracks"`
+	MovieID            int64          `json:"movie_id"`
 }
 
 func (q *Queries) UpdateMovieFileMediaInfo(ctx context.Context, arg UpdateMovieFileMediaInfoParams) error {
@@ -1777,6 +2349,10 @@ func (q *Queries) UpdateMovieFileMediaInfo(ctx context.Context, arg UpdateMovieF
 		arg.VideoCodec,
 		arg.AudioCodec,
 		arg.Resolution,
+		arg.AudioChannels,
+		arg.DynamicRange,
+		arg.DolbyVisionProfile,
+		arg.AudioTracks,
 		arg.MovieID,
 	)
 	return err
@@ -1810,6 +2386,21 @@ func (q *Queries) UpdateMovieFileQualityID(ctx context.Context, arg UpdateMovieF
 	return err
 }
 
+const updateMovieFolder = `-- name: UpdateMovieFolder :exec
+UPDATE movies SET path = ?, root_folder_id = ?, updated_at = CURRENT_TIMESTAMP WHERE id = ?
+`
+
+type UpdateMovieFolderParams struct {
+	Path         sql.NullString `json:"path"`
+	RootFolderID sql.NullInt64  `json:"root_folder_id"`
+	ID           int64          `json:"id"`
+}
+
+func (q *Queries) UpdateMovieFolder(ctx context.Context, arg UpdateMovieFolderParams) error {
+	_, err := q.db.ExecContext(ctx, updateMovieFolder, arg.Path, arg.RootFolderID, arg.ID)
+	return err
+}
+
 const updateMovieMonitored = `-- name: UpdateMovieMonitored :exec
 UPDATE movies SET monitored = ?, updated_at = CURRENT_TIMESTAMP WHERE id = ?
 `
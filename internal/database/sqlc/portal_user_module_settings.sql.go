@@ -11,6 +11,36 @@ import (
 	"time"
 )
 
+const decrementUserModuleQuota = `-- name: DecrementUserModuleQuota :one
+UPDATE portal_user_module_settings SET
+    quota_used = MAX(quota_used - ?1, 0),
+    updated_at = CURRENT_TIMESTAMP
+WHERE user_id = ?2 AND module_type = ?3
+RETURNING user_id, module_type, quota_limit, quota_used, quality_profile_id, period_start, created_at, updated_at
+`
+
+type DecrementUserModuleQuotaParams struct {
+	Amount     int64  `json:"amount"`
+	UserID     int64  `json:"user_id"`
+	ModuleType string `json:"module_type"`
+}
+
+func (q *Queries) DecrementUserModuleQuota(ctx context.Context, arg DecrementUserModuleQuotaParams) (*PortalUserModuleSetting, error) {
+	row := q.db.QueryRowContext(ctx, decrementUserModuleQuota, arg.Amount, arg.UserID, arg.ModuleType)
+	var i PortalUserModuleSetting
+	err := row.Scan(
+		&i.UserID,
+		&i.ModuleType,
+		&i.QuotaLimit,
+		&i.QuotaUsed,
+		&i.QualityProfileID,
+		&i.PeriodStart,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return &i, err
+}
+
 const deleteUserModuleSettings = `-- name: DeleteUserModuleSettings :exec
 DELETE FROM portal_user_module_settings WHERE user_id = ?
 `
@@ -62,19 +92,20 @@ func (q *Queries) GetUserModuleSettings(ctx context.Context, arg GetUserModuleSe
 
 const incrementUserModuleQuota = `-- name: IncrementUserModuleQuota :one
 UPDATE portal_user_module_settings SET
-    quota_used = quota_used + 1,
+    quota_used = quota_used + ?1,
     updated_at = CURRENT_TIMESTAMP
-WHERE user_id = ? AND module_type = ?
+WHERE user_id = ?2 AND module_type = ?3
 RETURNING user_id, module_type, quota_limit, quota_used, quality_profile_id, period_start, created_at, updated_at
 `
 
 type IncrementUserModuleQuotaParams struct {
+	Amount     int64  `json:"amount"`
 	UserID     int64  `json:"user_id"`
 	ModuleType string `json:"module_type"`
 }
 
 func (q *Queries) IncrementUserModuleQuota(ctx context.Context, arg IncrementUserModuleQuotaParams) (*PortalUserModuleSetting, error) {
-	row := q.db.QueryRowContext(ctx, incrementUserModuleQuota, arg.UserID, arg.ModuleType)
+	row := q.db.QueryRowContext(ctx, incrementUserModuleQuota, arg.Amount, arg.UserID, arg.ModuleType)
 	var i PortalUserModuleSetting
 	err := row.Scan(
 		&i.UserID,
@@ -46,7 +46,7 @@ const createAdminUser = `-- name: CreateAdminUser :one
 INSERT INTO portal_users (
     username, password_hash, is_admin, auto_approve, enabled
 ) VALUES (?, ?, 1, 1, 1)
-RETURNING id, username, password_hash, auto_approve, enabled, is_admin, created_at, updated_at
+RETURNING id, username, password_hash, auto_approve, enabled, is_admin, created_at, updated_at, can_stream
 `
 
 type CreateAdminUserParams struct {
@@ -66,6 +66,7 @@ func (q *Queries) CreateAdminUser(ctx context.Context, arg CreateAdminUserParams
 		&i.IsAdmin,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.CanStream,
 	)
 	return &i, err
 }
@@ -74,7 +75,7 @@ const createPortalUser = `-- name: CreatePortalUser :one
 INSERT INTO portal_users (
     username, password_hash, auto_approve, enabled
 ) VALUES (?, ?, ?, ?)
-RETURNING id, username, password_hash, auto_approve, enabled, is_admin, created_at, updated_at
+RETURNING id, username, password_hash, auto_approve, enabled, is_admin, created_at, updated_at, can_stream
 `
 
 type CreatePortalUserParams struct {
@@ -101,6 +102,7 @@ func (q *Queries) CreatePortalUser(ctx context.Context, arg CreatePortalUserPara
 		&i.IsAdmin,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.CanStream,
 	)
 	return &i, err
 }
@@ -109,7 +111,7 @@ const createPortalUserWithID = `-- name: CreatePortalUserWithID :one
 INSERT INTO portal_users (
     id, username, password_hash, auto_approve, enabled, is_admin
 ) VALUES (?, ?, ?, ?, ?, ?)
-RETURNING id, username, password_hash, auto_approve, enabled, is_admin, created_at, updated_at
+RETURNING id, username, password_hash, auto_approve, enabled, is_admin, created_at, updated_at, can_stream
 `
 
 type CreatePortalUserWithIDParams struct {
@@ -141,6 +143,7 @@ func (q *Queries) CreatePortalUserWithID(ctx context.Context, arg CreatePortalUs
 		&i.IsAdmin,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.CanStream,
 	)
 	return &i, err
 }
@@ -155,7 +158,7 @@ func (q *Queries) DeletePortalUser(ctx context.Context, id int64) error {
 }
 
 const getAdminUser = `-- name: GetAdminUser :one
-SELECT id, username, password_hash, auto_approve, enabled, is_admin, created_at, updated_at FROM portal_users WHERE is_admin = 1 LIMIT 1
+SELECT id, username, password_hash, auto_approve, enabled, is_admin, created_at, updated_at, can_stream FROM portal_users WHERE is_admin = 1 LIMIT 1
 `
 
 func (q *Queries) GetAdminUser(ctx context.Context) (*PortalUser, error) {
@@ -170,12 +173,13 @@ func (q *Queries) GetAdminUser(ctx context.Context) (*PortalUser, error) {
 		&i.IsAdmin,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.CanStream,
 	)
 	return &i, err
 }
 
 const getPortalUser = `-- name: GetPortalUser :one
-SELECT id, username, password_hash, auto_approve, enabled, is_admin, created_at, updated_at FROM portal_users WHERE id = ? LIMIT 1
+SELECT id, username, password_hash, auto_approve, enabled, is_admin, created_at, updated_at, can_stream FROM portal_users WHERE id = ? LIMIT 1
 `
 
 func (q *Queries) GetPortalUser(ctx context.Context, id int64) (*PortalUser, error) {
@@ -190,12 +194,13 @@ func (q *Queries) GetPortalUser(ctx context.Context, id int64) (*PortalUser, err
 		&i.IsAdmin,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.CanStream,
 	)
 	return &i, err
 }
 
 const getPortalUserByUsername = `-- name: GetPortalUserByUsername :one
-SELECT id, username, password_hash, auto_approve, enabled, is_admin, created_at, updated_at FROM portal_users WHERE username = ? LIMIT 1
+SELECT id, username, password_hash, auto_approve, enabled, is_admin, created_at, updated_at, can_stream FROM portal_users WHERE username = ? LIMIT 1
 `
 
 func (q *Queries) GetPortalUserByUsername(ctx context.Context, username string) (*PortalUser, error) {
@@ -210,6 +215,7 @@ func (q *Queries) GetPortalUserByUsername(ctx context.Context, username string)
 		&i.IsAdmin,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.CanStream,
 	)
 	return &i, err
 }
@@ -226,7 +232,7 @@ func (q *Queries) GetPortalUserUsername(ctx context.Context, id int64) (string,
 }
 
 const listEnabledPortalUsers = `-- name: ListEnabledPortalUsers :many
-SELECT id, username, password_hash, auto_approve, enabled, is_admin, created_at, updated_at FROM portal_users WHERE enabled = 1 ORDER BY username
+SELECT id, username, password_hash, auto_approve, enabled, is_admin, created_at, updated_at, can_stream FROM portal_users WHERE enabled = 1 ORDER BY username
 `
 
 func (q *Queries) ListEnabledPortalUsers(ctx context.Context) ([]*PortalUser, error) {
@@ -247,6 +253,7 @@ func (q *Queries) ListEnabledPortalUsers(ctx context.Context) ([]*PortalUser, er
 			&i.IsAdmin,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.CanStream,
 		); err != nil {
 			return nil, err
 		}
@@ -262,7 +269,7 @@ func (q *Queries) ListEnabledPortalUsers(ctx context.Context) ([]*PortalUser, er
 }
 
 const listPortalUsers = `-- name: ListPortalUsers :many
-SELECT id, username, password_hash, auto_approve, enabled, is_admin, created_at, updated_at FROM portal_users ORDER BY created_at DESC
+SELECT id, username, password_hash, auto_approve, enabled, is_admin, created_at, updated_at, can_stream FROM portal_users ORDER BY created_at DESC
 `
 
 func (q *Queries) ListPortalUsers(ctx context.Context) ([]*PortalUser, error) {
@@ -283,6 +290,7 @@ func (q *Queries) ListPortalUsers(ctx context.Context) ([]*PortalUser, error) {
 			&i.IsAdmin,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.CanStream,
 		); err != nil {
 			return nil, err
 		}
@@ -302,7 +310,7 @@ UPDATE portal_users SET
     username = ?,
     updated_at = CURRENT_TIMESTAMP
 WHERE id = ?
-RETURNING id, username, password_hash, auto_approve, enabled, is_admin, created_at, updated_at
+RETURNING id, username, password_hash, auto_approve, enabled, is_admin, created_at, updated_at, can_stream
 `
 
 type UpdatePortalUserParams struct {
@@ -322,6 +330,7 @@ func (q *Queries) UpdatePortalUser(ctx context.Context, arg UpdatePortalUserPara
 		&i.IsAdmin,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.CanStream,
 	)
 	return &i, err
 }
@@ -331,7 +340,7 @@ UPDATE portal_users SET
     auto_approve = ?,
     updated_at = CURRENT_TIMESTAMP
 WHERE id = ?
-RETURNING id, username, password_hash, auto_approve, enabled, is_admin, created_at, updated_at
+RETURNING id, username, password_hash, auto_approve, enabled, is_admin, created_at, updated_at, can_stream
 `
 
 type UpdatePortalUserAutoApproveParams struct {
@@ -351,6 +360,37 @@ func (q *Queries) UpdatePortalUserAutoApprove(ctx context.Context, arg UpdatePor
 		&i.IsAdmin,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.CanStream,
+	)
+	return &i, err
+}
+
+const updatePortalUserCanStream = `-- name: UpdatePortalUserCanStream :one
+UPDATE portal_users SET
+    can_stream = ?,
+    updated_at = CURRENT_TIMESTAMP
+WHERE id = ?
+RETURNING id, username, password_hash, auto_approve, enabled, is_admin, created_at, updated_at, can_stream
+`
+
+type UpdatePortalUserCanStreamParams struct {
+	CanStream bool  `json:"can_stream"`
+	ID        int64 `json:"id"`
+}
+
+func (q *Queries) UpdatePortalUserCanStream(ctx context.Context, arg UpdatePortalUserCanStreamParams) (*PortalUser, error) {
+	row := q.db.QueryRowContext(ctx, updatePortalUserCanStream, arg.CanStream, arg.ID)
+	var i PortalUser
+	err := row.Scan(
+		&i.ID,
+		&i.Username,
+		&i.PasswordHash,
+		&i.AutoApprove,
+		&i.Enabled,
+		&i.IsAdmin,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.CanStream,
 	)
 	return &i, err
 }
@@ -360,7 +400,7 @@ UPDATE portal_users SET
     enabled = ?,
     updated_at = CURRENT_TIMESTAMP
 WHERE id = ?
-RETURNING id, username, password_hash, auto_approve, enabled, is_admin, created_at, updated_at
+RETURNING id, username, password_hash, auto_approve, enabled, is_admin, created_at, updated_at, can_stream
 `
 
 type UpdatePortalUserEnabledParams struct {
@@ -380,6 +420,7 @@ func (q *Queries) UpdatePortalUserEnabled(ctx context.Context, arg UpdatePortalU
 		&i.IsAdmin,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.CanStream,
 	)
 	return &i, err
 }
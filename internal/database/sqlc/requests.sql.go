@@ -16,17 +16,20 @@ UPDATE requests SET
     approved_at = CURRENT_TIMESTAMP,
     approved_by = ?,
     updated_at = CURRENT_TIMESTAMP
-WHERE id = ?
+WHERE id = ? AND status = ?3
 RETURNING id, user_id, module_type, entity_type, tmdb_id, tvdb_id, title, year, season_number, episode_number, status, monitor_type, denied_reason, approved_at, approved_by, media_id, target_slot_id, poster_url, requested_seasons, created_at, updated_at
 `
 
 type ApproveRequestParams struct {
 	ApprovedBy sql.NullInt64 `json:"approved_by"`
 	ID         int64         `json:"id"`
+	FromStatus string        `json:"from_status"`
 }
 
+// Approve and deny only apply while the request is still in from_status, so
+// two admins acting at once cannot both move it.
 func (q *Queries) ApproveRequest(ctx context.Context, arg ApproveRequestParams) (*Request, error) {
-	row := q.db.QueryRowContext(ctx, approveRequest, arg.ApprovedBy, arg.ID)
+	row := q.db.QueryRowContext(ctx, approveRequest, arg.ApprovedBy, arg.ID, arg.FromStatus)
 	var i Request
 	err := row.Scan(
 		&i.ID,
@@ -191,17 +194,18 @@ UPDATE requests SET
     status = 'denied',
     denied_reason = ?,
     updated_at = CURRENT_TIMESTAMP
-WHERE id = ?
+WHERE id = ? AND status = ?3
 RETURNING id, user_id, module_type, entity_type, tmdb_id, tvdb_id, title, year, season_number, episode_number, status, monitor_type, denied_reason, approved_at, approved_by, media_id, target_slot_id, poster_url, requested_seasons, created_at, updated_at
 `
 
 type DenyRequestParams struct {
 	DeniedReason sql.NullString `json:"denied_reason"`
 	ID           int64          `json:"id"`
+	FromStatus   string         `json:"from_status"`
 }
 
 func (q *Queries) DenyRequest(ctx context.Context, arg DenyRequestParams) (*Request, error) {
-	row := q.db.QueryRowContext(ctx, denyRequest, arg.DeniedReason, arg.ID)
+	row := q.db.QueryRowContext(ctx, denyRequest, arg.DeniedReason, arg.ID, arg.FromStatus)
 	var i Request
 	err := row.Scan(
 		&i.ID,
@@ -482,17 +486,18 @@ func (q *Queries) GetRequest(ctx context.Context, id int64) (*Request, error) {
 
 const getRequestByTmdbID = `-- name: GetRequestByTmdbID :one
 SELECT id, user_id, module_type, entity_type, tmdb_id, tvdb_id, title, year, season_number, episode_number, status, monitor_type, denied_reason, approved_at, approved_by, media_id, target_slot_id, poster_url, requested_seasons, created_at, updated_at FROM requests
-WHERE tmdb_id = ? AND entity_type = ? AND status NOT IN ('denied', 'available')
+WHERE tmdb_id = ? AND entity_type = ? AND status NOT IN ('denied', 'available') AND target_slot_id IS ?
 LIMIT 1
 `
 
 type GetRequestByTmdbIDParams struct {
-	TmdbID     sql.NullInt64 `json:"tmdb_id"`
-	EntityType string        `json:"entity_type"`
+	TmdbID       sql.NullInt64 `json:"tmdb_id"`
+	EntityType   string        `json:"entity_type"`
+	TargetSlotID sql.NullInt64 `json:"target_slot_id"`
 }
 
 func (q *Queries) GetRequestByTmdbID(ctx context.Context, arg GetRequestByTmdbIDParams) (*Request, error) {
-	row := q.db.QueryRowContext(ctx, getRequestByTmdbID, arg.TmdbID, arg.EntityType)
+	row := q.db.QueryRowContext(ctx, getRequestByTmdbID, arg.TmdbID, arg.EntityType, arg.TargetSlotID)
 	var i Request
 	err := row.Scan(
 		&i.ID,
@@ -522,17 +527,18 @@ func (q *Queries) GetRequestByTmdbID(ctx context.Context, arg GetRequestByTmdbID
 
 const getRequestByTvdbID = `-- name: GetRequestByTvdbID :one
 SELECT id, user_id, module_type, entity_type, tmdb_id, tvdb_id, title, year, season_number, episode_number, status, monitor_type, denied_reason, approved_at, approved_by, media_id, target_slot_id, poster_url, requested_seasons, created_at, updated_at FROM requests
-WHERE tvdb_id = ? AND entity_type = ? AND status NOT IN ('denied', 'available')
+WHERE tvdb_id = ? AND entity_type = ? AND status NOT IN ('denied', 'available') AND target_slot_id IS ?
 LIMIT 1
 `
 
 type GetRequestByTvdbIDParams struct {
-	TvdbID     sql.NullInt64 `json:"tvdb_id"`
-	EntityType string        `json:"entity_type"`
+	TvdbID       sql.NullInt64 `json:"tvdb_id"`
+	EntityType   string        `json:"entity_type"`
+	TargetSlotID sql.NullInt64 `json:"target_slot_id"`
 }
 
 func (q *Queries) GetRequestByTvdbID(ctx context.Context, arg GetRequestByTvdbIDParams) (*Request, error) {
-	row := q.db.QueryRowContext(ctx, getRequestByTvdbID, arg.TvdbID, arg.EntityType)
+	row := q.db.QueryRowContext(ctx, getRequestByTvdbID, arg.TvdbID, arg.EntityType, arg.TargetSlotID)
 	var i Request
 	err := row.Scan(
 		&i.ID,
@@ -562,7 +568,7 @@ func (q *Queries) GetRequestByTvdbID(ctx context.Context, arg GetRequestByTvdbID
 
 const getRequestByTvdbIDAndEpisode = `-- name: GetRequestByTvdbIDAndEpisode :one
 SELECT id, user_id, module_type, entity_type, tmdb_id, tvdb_id, title, year, season_number, episode_number, status, monitor_type, denied_reason, approved_at, approved_by, media_id, target_slot_id, poster_url, requested_seasons, created_at, updated_at FROM requests
-WHERE tvdb_id = ? AND entity_type = 'episode' AND season_number = ? AND episode_number = ? AND status NOT IN ('denied', 'available')
+WHERE tvdb_id = ? AND entity_type = 'episode' AND season_number = ? AND episode_number = ? AND status NOT IN ('denied', 'available') AND target_slot_id IS ?
 LIMIT 1
 `
 
@@ -570,10 +576,11 @@ type GetRequestByTvdbIDAndEpisodeParams struct {
 	TvdbID        sql.NullInt64 `json:"tvdb_id"`
 	SeasonNumber  sql.NullInt64 `json:"season_number"`
 	EpisodeNumber sql.NullInt64 `json:"episode_number"`
+	TargetSlotID  sql.NullInt64 `json:"target_slot_id"`
 }
 
 func (q *Queries) GetRequestByTvdbIDAndEpisode(ctx context.Context, arg GetRequestByTvdbIDAndEpisodeParams) (*Request, error) {
-	row := q.db.QueryRowContext(ctx, getRequestByTvdbIDAndEpisode, arg.TvdbID, arg.SeasonNumber, arg.EpisodeNumber)
+	row := q.db.QueryRowContext(ctx, getRequestByTvdbIDAndEpisode, arg.TvdbID, arg.SeasonNumber, arg.EpisodeNumber, arg.TargetSlotID)
 	var i Request
 	err := row.Scan(
 		&i.ID,
@@ -603,17 +610,18 @@ func (q *Queries) GetRequestByTvdbIDAndEpisode(ctx context.Context, arg GetReque
 
 const getRequestByTvdbIDAndSeason = `-- name: GetRequestByTvdbIDAndSeason :one
 SELECT id, user_id, module_type, entity_type, tmdb_id, tvdb_id, title, year, season_number, episode_number, status, monitor_type, denied_reason, approved_at, approved_by, media_id, target_slot_id, poster_url, requested_seasons, created_at, updated_at FROM requests
-WHERE tvdb_id = ? AND entity_type = 'season' AND season_number = ? AND status NOT IN ('denied', 'available')
+WHERE tvdb_id = ? AND entity_type = 'season' AND season_number = ? AND status NOT IN ('denied', 'available') AND target_slot_id IS ?
 LIMIT 1
 `
 
 type GetRequestByTvdbIDAndSeasonParams struct {
 	TvdbID       sql.NullInt64 `json:"tvdb_id"`
 	SeasonNumber sql.NullInt64 `json:"season_number"`
+	TargetSlotID sql.NullInt64 `json:"target_slot_id"`
 }
 
 func (q *Queries) GetRequestByTvdbIDAndSeason(ctx context.Context, arg GetRequestByTvdbIDAndSeasonParams) (*Request, error) {
-	row := q.db.QueryRowContext(ctx, getRequestByTvdbIDAndSeason, arg.TvdbID, arg.SeasonNumber)
+	row := q.db.QueryRowContext(ctx, getRequestByTvdbIDAndSeason, arg.TvdbID, arg.SeasonNumber, arg.TargetSlotID)
 	var i Request
 	err := row.Scan(
 		&i.ID,
@@ -1198,3 +1206,45 @@ func (q *Queries) UpdateRequestStatus(ctx context.Context, arg UpdateRequestStat
 	)
 	return &i, err
 }
+
+const updateRequestOwner = `-- name: UpdateRequestOwner :one
+UPDATE requests SET
+    user_id = ?,
+    updated_at = CURRENT_TIMESTAMP
+WHERE id = ?
+RETURNING id, user_id, module_type, entity_type, tmdb_id, tvdb_id, title, year, season_number, episode_number, status, monitor_type, denied_reason, approved_at, approved_by, media_id, target_slot_id, poster_url, requested_seasons, created_at, updated_at
+`
+
+type UpdateRequestOwnerParams struct {
+	UserID int64 `json:"user_id"`
+	ID     int64 `json:"id"`
+}
+
+func (q *Queries) UpdateRequestOwner(ctx context.Context, arg UpdateRequestOwnerParams) (*Request, error) {
+	row := q.db.QueryRowContext(ctx, updateRequestOwner, arg.UserID, arg.ID)
+	var i Request
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.ModuleType,
+		&i.EntityType,
+		&i.TmdbID,
+		&i.TvdbID,
+		&i.Title,
+		&i.Year,
+		&i.SeasonNumber,
+		&i.EpisodeNumber,
+		&i.Status,
+		&i.MonitorType,
+		&i.DeniedReason,
+		&i.ApprovedAt,
+		&i.ApprovedBy,
+		&i.MediaID,
+		&i.TargetSlotID,
+		&i.PosterUrl,
+		&i.RequestedSeasons,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return &i, err
+}
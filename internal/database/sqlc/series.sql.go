@@ -97,6 +97,17 @@ func (q *Queries) CountEpisodesBySeries(ctx context.Context, seriesID int64) (in
 	return count, err
 }
 
+const countEpisodesByStatus = `-- name: CountEpisodesByStatus :one
+SELECT COUNT(*) FROM episodes WHERE status = ?
+`
+
+func (q *Queries) CountEpisodesByStatus(ctx context.Context, status string) (int64, error) {
+	row := q.db.QueryRowContext(ctx, countEpisodesByStatus, status)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
 const countMissingEpisodes = `-- name: CountMissingEpisodes :one
 SELECT COUNT(*) FROM episodes e
 JOIN series s ON e.series_id = s.id
@@ -210,11 +221,63 @@ func (q *Queries) CountSeries(ctx context.Context) (int64, error) {
 	return count, err
 }
 
+const countSeriesFiltered = `-- name: CountSeriesFiltered :one
+WITH o AS (
+    SELECT CAST(?1 AS TEXT) AS search_term,
+           CAST(?2 AS TEXT) AS production_status,
+           CAST(?3 AS BOOLEAN) AS monitored,
+           CAST(?4 AS INTEGER) AS root_folder_id,
+           CAST(?5 AS INTEGER) AS quality_profile_id,
+           CAST(?6 AS BOOLEAN) AS missing,
+           CAST(?7 AS INTEGER) AS tag_id
+)
+SELECT COUNT(*) FROM series s, o
+WHERE (o.search_term = ''
+       OR s.title LIKE '%' || o.search_term || '%' OR s.sort_title LIKE '%' || o.search_term || '%'
+       OR REPLACE(s.title, '''', '') LIKE '%' || o.search_term || '%'
+       OR REPLACE(s.sort_title, '''', '') LIKE '%' || o.search_term || '%')
+  AND (o.monitored IS NULL OR s.monitored = o.monitored)
+  AND (o.root_folder_id IS NULL OR s.root_folder_id = o.root_folder_id)
+  AND (o.quality_profile_id IS NULL OR s.quality_profile_id = o.quality_profile_id)
+  AND (o.production_status = '' OR lower(s.production_status) = lower(o.production_status))
+  AND (o.missing IS NULL OR o.missing = EXISTS (
+       SELECT 1 FROM episodes e
+       WHERE e.series_id = s.id AND e.season_number > 0 AND e.status = 'missing'))
+  AND (o.tag_id IS NULL OR EXISTS (
+       SELECT 1 FROM entity_tags et
+       WHERE et.entity_type = 'series' AND et.entity_id = s.id AND et.tag_id = o.tag_id))
+`
+
+type CountSeriesFilteredParams struct {
+	SearchTerm       string        `json:"search_term"`
+	ProductionStatus string        `json:"production_status"`
+	Monitored        sql.NullBool  `json:"monitored"`
+	RootFolderID     sql.NullInt64 `json:"root_folder_id"`
+	QualityProfileID sql.NullInt64 `json:"quality_profile_id"`
+	Missing          sql.NullBool  `json:"missing"`
+	TagID            sql.NullInt64 `json:"tag_id"`
+}
+
+func (q *Queries) CountSeriesFiltered(ctx context.Context, arg CountSeriesFilteredParams) (int64, error) {
+	row := q.db.QueryRowContext(ctx, countSeriesFiltered,
+		arg.SearchTerm,
+		arg.ProductionStatus,
+		arg.Monitored,
+		arg.RootFolderID,
+		arg.QualityProfileID,
+		arg.Missing,
+		arg.TagID,
+	)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
 const createEpisode = `-- name: CreateEpisode :one
 INSERT INTO episodes (
     series_id, season_number, episode_number, title, overview, air_date, monitored, status
 ) VALUES (?, ?, ?, ?, ?, ?, ?, ?)
-RETURNING id, series_id, season_number, episode_number, title, overview, air_date, monitored, status, active_download_id, status_message
+RETURNING id, series_id, season_number, episode_number, title, overview, air_date, monitored, status, active_download_id, status_message, still_url
 `
 
 type CreateEpisodeParams struct {
@@ -252,6 +315,7 @@ func (q *Queries) CreateEpisode(ctx context.Context, arg CreateEpisodeParams) (*
 		&i.Status,
 		&i.ActiveDownloadID,
 		&i.StatusMessage,
+		&i.StillUrl,
 	)
 	return &i, err
 }
@@ -259,7 +323,7 @@ func (q *Queries) CreateEpisode(ctx context.Context, arg CreateEpisodeParams) (*
 const createEpisodeFile = `-- name: CreateEpisodeFile :one
 INSERT INTO episode_files (episode_id, path, size, quality, quality_id, video_codec, audio_codec, resolution, audio_channels, dynamic_range)
 VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
-RETURNING id, episode_id, path, size, quality, video_codec, audio_codec, resolution, created_at, quality_id, original_path, original_filename, imported_at, slot_id, audio_channels, dynamic_range
+RETURNING id, episode_id, path, size, quality, video_codec, audio_codec, resolution, created_at, quality_id, original_path, original_filename, imported_at, slot_id, audio_channels, dynamic_range, primary_file_id, dolby_vision_profile, audio_tracks
 `
 
 type CreateEpisodeFileParams struct {
@@ -306,6 +370,9 @@ func (q *Queries) CreateEpisodeFile(ctx context.Context, arg CreateEpisodeFilePa
 		&i.SlotID,
 		&i.AudioChannels,
 		&i.DynamicRange,
+		&i.PrimaryFileID,
+		&i.DolbyVisionProfile,
+		&i.AudioTracks,
 	)
 	return &i, err
 }
@@ -315,7 +382,7 @@ INSERT INTO episode_files (
     episode_id, path, size, quality, quality_id, video_codec, audio_codec, resolution,
     audio_channels, dynamic_range, original_path, original_filename, imported_at
 ) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
-RETURNING id, episode_id, path, size, quality, video_codec, audio_codec, resolution, created_at, quality_id, original_path, original_filename, imported_at, slot_id, audio_channels, dynamic_range
+RETURNING id, episode_id, path, size, quality, video_codec, audio_codec, resolution, created_at, quality_id, original_path, original_filename, imported_at, slot_id, audio_channels, dynamic_range, primary_file_id, dolby_vision_profile, audio_tracks
 `
 
 type CreateEpisodeFileWithImportInfoParams struct {
@@ -369,6 +436,9 @@ func (q *Queries) CreateEpisodeFileWithImportInfo(ctx context.Context, arg Creat
 		&i.SlotID,
 		&i.AudioChannels,
 		&i.DynamicRange,
+		&i.PrimaryFileID,
+		&i.DolbyVisionProfile,
+		&i.AudioTracks,
 	)
 	return &i, err
 }
@@ -403,30 +473,31 @@ const createSeries = `-- name: CreateSeries :one
 INSERT INTO series (
     title, sort_title, year, tvdb_id, tmdb_id, imdb_id, overview, runtime,
     path, root_folder_id, quality_profile_id, monitored, season_folder, production_status, network, format_type,
-    network_logo_url, added_by
-) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
-RETURNING id, title, sort_title, year, tvdb_id, tmdb_id, imdb_id, overview, runtime, path, root_folder_id, quality_profile_id, monitored, season_folder, production_status, network, format_type, added_at, updated_at, network_logo_url, added_by
+    network_logo_url, added_by, language_profile_id
+) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+RETURNING id, title, sort_title, year, tvdb_id, tmdb_id, imdb_id, overview, runtime, path, root_folder_id, quality_profile_id, monitored, season_folder, production_status, network, format_type, added_at, updated_at, network_logo_url, added_by, language_profile_id, minimum_availability
 `
 
 type CreateSeriesParams struct {
-	Title            string         `json:"title"`
-	SortTitle        string         `json:"sort_title"`
-	Year             sql.NullInt64  `json:"year"`
-	TvdbID           sql.NullInt64  `json:"tvdb_id"`
-	TmdbID           sql.NullInt64  `json:"tmdb_id"`
-	ImdbID           sql.NullString `json:"imdb_id"`
-	Overview         sql.NullString `json:"overview"`
-	Runtime          sql.NullInt64  `json:"runtime"`
-	Path             sql.NullString `json:"path"`
-	RootFolderID     sql.NullInt64  `json:"root_folder_id"`
-	QualityProfileID sql.NullInt64  `json:"quality_profile_id"`
-	Monitored        bool           `json:"monitored"`
-	SeasonFolder     bool           `json:"season_folder"`
-	ProductionStatus string         `json:"production_status"`
-	Network          sql.NullString `json:"network"`
-	FormatType       sql.NullString `json:"format_type"`
-	NetworkLogoUrl   sql.NullString `json:"network_logo_url"`
-	AddedBy          sql.NullInt64  `json:"added_by"`
+	Title             string         `json:"title"`
+	SortTitle         string         `json:"sort_title"`
+	Year              sql.NullInt64  `json:"year"`
+	TvdbID            sql.NullInt64  `json:"tvdb_id"`
+	TmdbID            sql.NullInt64  `json:"tmdb_id"`
+	ImdbID            sql.NullString `json:"imdb_id"`
+	Overview          sql.NullString `json:"overview"`
+	Runtime           sql.NullInt64  `json:"runtime"`
+	Path              sql.NullString `json:"path"`
+	RootFolderID      sql.NullInt64  `json:"root_folder_id"`
+	QualityProfileID  sql.NullInt64  `json:"quality_profile_id"`
+	Monitored         bool           `json:"monitored"`
+	SeasonFolder      bool           `json:"season_folder"`
+	ProductionStatus  string         `json:"production_status"`
+	Network           sql.NullString `json:"network"`
+	FormatType        sql.NullString `json:"format_type"`
+	NetworkLogoUrl    sql.NullString `json:"network_logo_url"`
+	AddedBy           sql.NullInt64  `json:"added_by"`
+	LanguageProfileID sql.NullInt64  `json:"language_profile_id"`
 }
 
 func (q *Queries) CreateSeries(ctx context.Context, arg CreateSeriesParams) (*Series, error) {
@@ -449,6 +520,7 @@ func (q *Queries) CreateSeries(ctx context.Context, arg CreateSeriesParams) (*Se
 		arg.FormatType,
 		arg.NetworkLogoUrl,
 		arg.AddedBy,
+		arg.LanguageProfileID,
 	)
 	var i Series
 	err := row.Scan(
@@ -473,6 +545,8 @@ func (q *Queries) CreateSeries(ctx context.Context, arg CreateSeriesParams) (*Se
 		&i.UpdatedAt,
 		&i.NetworkLogoUrl,
 		&i.AddedBy,
+		&i.LanguageProfileID,
+		&i.MinimumAvailability,
 	)
 	return &i, err
 }
@@ -541,7 +615,7 @@ func (q *Queries) DeleteSeriesByRootFolder(ctx context.Context, rootFolderID sql
 }
 
 const getEpisode = `-- name: GetEpisode :one
-SELECT id, series_id, season_number, episode_number, title, overview, air_date, monitored, status, active_download_id, status_message FROM episodes WHERE id = ? LIMIT 1
+SELECT id, series_id, season_number, episode_number, title, overview, air_date, monitored, status, active_download_id, status_message, still_url FROM episodes WHERE id = ? LIMIT 1
 `
 
 // Episodes
@@ -560,6 +634,7 @@ func (q *Queries) GetEpisode(ctx context.Context, id int64) (*Episode, error) {
 		&i.Status,
 		&i.ActiveDownloadID,
 		&i.StatusMessage,
+		&i.StillUrl,
 	)
 	return &i, err
 }
@@ -615,8 +690,60 @@ func (q *Queries) GetEpisodeAvailabilityForSeason(ctx context.Context, arg GetEp
 	return items, nil
 }
 
+const getEpisodeAbsoluteNumber = `-- name: GetEpisodeAbsoluteNumber :one
+SELECT COUNT(*) FROM episodes
+WHERE series_id = ?1 AND season_number > 0
+  AND (season_number < ?2 OR (season_number = ?2 AND episode_number <= ?3))
+`
+
+type GetEpisodeAbsoluteNumberParams struct {
+	SeriesID      int64 `json:"series_id"`
+	SeasonNumber  int64 `json:"season_number"`
+	EpisodeNumber int64 `json:"episode_number"`
+}
+
+func (q *Queries) GetEpisodeAbsoluteNumber(ctx context.Context, arg GetEpisodeAbsoluteNumberParams) (int64, error) {
+	row := q.db.QueryRowContext(ctx, getEpisodeAbsoluteNumber, arg.SeriesID, arg.SeasonNumber, arg.EpisodeNumber)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const getEpisodeByAbsoluteNumber = `-- name: GetEpisodeByAbsoluteNumber :one
+SELECT id, series_id, season_number, episode_number, title, overview, air_date, monitored, status, active_download_id, status_message, still_url FROM episodes
+WHERE series_id = ? AND season_number > 0
+ORDER BY season_number, episode_number
+LIMIT 1 OFFSET ?
+`
+
+type GetEpisodeByAbsoluteNumberParams struct {
+	SeriesID int64 `json:"series_id"`
+	Offset   int64 `json:"offset"`
+}
+
+// Absolute numbering counts regular-season episodes in order, skipping specials.
+func (q *Queries) GetEpisodeByAbsoluteNumber(ctx context.Context, arg GetEpisodeByAbsoluteNumberParams) (*Episode, error) {
+	row := q.db.QueryRowContext(ctx, getEpisodeByAbsoluteNumber, arg.SeriesID, arg.Offset)
+	var i Episode
+	err := row.Scan(
+		&i.ID,
+		&i.SeriesID,
+		&i.SeasonNumber,
+		&i.EpisodeNumber,
+		&i.Title,
+		&i.Overview,
+		&i.AirDate,
+		&i.Monitored,
+		&i.Status,
+		&i.ActiveDownloadID,
+		&i.StatusMessage,
+		&i.StillUrl,
+	)
+	return &i, err
+}
+
 const getEpisodeByNumber = `-- name: GetEpisodeByNumber :one
-SELECT id, series_id, season_number, episode_number, title, overview, air_date, monitored, status, active_download_id, status_message FROM episodes
+SELECT id, series_id, season_number, episode_number, title, overview, air_date, monitored, status, active_download_id, status_message, still_url FROM episodes
 WHERE series_id = ? AND season_number = ? AND episode_number = ?
 LIMIT 1
 `
@@ -642,12 +769,13 @@ func (q *Queries) GetEpisodeByNumber(ctx context.Context, arg GetEpisodeByNumber
 		&i.Status,
 		&i.ActiveDownloadID,
 		&i.StatusMessage,
+		&i.StillUrl,
 	)
 	return &i, err
 }
 
 const getEpisodeFile = `-- name: GetEpisodeFile :one
-SELECT id, episode_id, path, size, quality, video_codec, audio_codec, resolution, created_at, quality_id, original_path, original_filename, imported_at, slot_id, audio_channels, dynamic_range FROM episode_files WHERE id = ? LIMIT 1
+SELECT id, episode_id, path, size, quality, video_codec, audio_codec, resolution, created_at, quality_id, original_path, original_filename, imported_at, slot_id, audio_channels, dynamic_range, primary_file_id, dolby_vision_profile, audio_tracks FROM episode_files WHERE id = ? LIMIT 1
 `
 
 // Episode Files
@@ -671,12 +799,15 @@ func (q *Queries) GetEpisodeFile(ctx context.Context, id int64) (*EpisodeFile, e
 		&i.SlotID,
 		&i.AudioChannels,
 		&i.DynamicRange,
+		&i.PrimaryFileID,
+		&i.DolbyVisionProfile,
+		&i.AudioTracks,
 	)
 	return &i, err
 }
 
 const getEpisodeFileByOriginalPath = `-- name: GetEpisodeFileByOriginalPath :one
-SELECT id, episode_id, path, size, quality, video_codec, audio_codec, resolution, created_at, quality_id, original_path, original_filename, imported_at, slot_id, audio_channels, dynamic_range FROM episode_files WHERE original_path = ? LIMIT 1
+SELECT id, episode_id, path, size, quality, video_codec, audio_codec, resolution, created_at, quality_id, original_path, original_filename, imported_at, slot_id, audio_channels, dynamic_range, primary_file_id, dolby_vision_profile, audio_tracks FROM episode_files WHERE original_path = ? LIMIT 1
 `
 
 func (q *Queries) GetEpisodeFileByOriginalPath(ctx context.Context, originalPath sql.NullString) (*EpisodeFile, error) {
@@ -699,12 +830,15 @@ func (q *Queries) GetEpisodeFileByOriginalPath(ctx context.Context, originalPath
 		&i.SlotID,
 		&i.AudioChannels,
 		&i.DynamicRange,
+		&i.PrimaryFileID,
+		&i.DolbyVisionProfile,
+		&i.AudioTracks,
 	)
 	return &i, err
 }
 
 const getEpisodeFileByPath = `-- name: GetEpisodeFileByPath :one
-SELECT id, episode_id, path, size, quality, video_codec, audio_codec, resolution, created_at, quality_id, original_path, original_filename, imported_at, slot_id, audio_channels, dynamic_range FROM episode_files WHERE path = ? LIMIT 1
+SELECT id, episode_id, path, size, quality, video_codec, audio_codec, resolution, created_at, quality_id, original_path, original_filename, imported_at, slot_id, audio_channels, dynamic_range, primary_file_id, dolby_vision_profile, audio_tracks FROM episode_files WHERE path = ? LIMIT 1
 `
 
 func (q *Queries) GetEpisodeFileByPath(ctx context.Context, path string) (*EpisodeFile, error) {
@@ -727,12 +861,15 @@ func (q *Queries) GetEpisodeFileByPath(ctx context.Context, path string) (*Episo
 		&i.SlotID,
 		&i.AudioChannels,
 		&i.DynamicRange,
+		&i.PrimaryFileID,
+		&i.DolbyVisionProfile,
+		&i.AudioTracks,
 	)
 	return &i, err
 }
 
 const getEpisodeFilesWithImportInfo = `-- name: GetEpisodeFilesWithImportInfo :many
-SELECT ef.id, ef.episode_id, ef.path, ef.size, ef.quality, ef.video_codec, ef.audio_codec, ef.resolution, ef.created_at, ef.quality_id, ef.original_path, ef.original_filename, ef.imported_at, ef.slot_id, ef.audio_channels, ef.dynamic_range, e.series_id, e.season_number, e.episode_number
+SELECT ef.id, ef.episode_id, ef.path, ef.size, ef.quality, ef.video_codec, ef.audio_codec, ef.resolution, ef.created_at, ef.quality_id, ef.original_path, ef.original_filename, ef.imported_at, ef.slot_id, ef.audio_channels, ef.dynamic_range, ef.primary_file_id, ef.dolby_vision_profile, ef.audio_tracks, e.series_id, e.season_number, e.episode_number
 FROM episode_files ef
 JOIN episodes e ON ef.episode_id = e.id
 WHERE e.series_id = ?
@@ -740,25 +877,28 @@ ORDER BY ef.imported_at DESC
 `
 
 type GetEpisodeFilesWithImportInfoRow struct {
-	ID               int64          `json:"id"`
-	EpisodeID        int64          `json:"episode_id"`
-	Path             string         `json:"path"`
-	Size             int64          `json:"size"`
-	Quality          sql.NullString `json:"quality"`
-	VideoCodec       sql.NullString `json:"video_codec"`
-	AudioCodec       sql.NullString `json:"audio_codec"`
-	Resolution       sql.NullString `json:"resolution"`
-	CreatedAt        sql.NullTime   `json:"created_at"`
-	QualityID        sql.NullInt64  `json:"quality_id"`
-	OriginalPath     sql.NullString `json:"original_path"`
-	OriginalFilename sql.NullString `json:"original_filename"`
-	ImportedAt       sql.NullTime   `json:"imported_at"`
-	SlotID           sql.NullInt64  `json:"slot_id"`
-	AudioChannels    sql.NullString `json:"audio_channels"`
-	DynamicRange     sql.NullString `json:"dynamic_range"`
-	SeriesID         int64          `json:"series_id"`
-	SeasonNumber     int64          `json:"season_number"`
-	EpisodeNumber    int64          `json:"episode_number"`
+	ID                 int64          `json:"id"`
+	EpisodeID          int64          `json:"episode_id"`
+	Path               string         `json:"path"`
+	Size               int64          `json:"size"`
+	Quality            sql.NullString `json:"quality"`
+	VideoCodec         sql.NullString `json:"video_codec"`
+	AudioCodec         sql.NullString `json:"audio_codec"`
+	Resolution         sql.NullString `json:"resolution"`
+	CreatedAt          sql.NullTime   `json:"created_at"`
+	QualityID          sql.NullInt64  `json:"quality_id"`
+	OriginalPath       sql.NullString `json:"original_path"`
+	OriginalFilename   sql.NullString `json:"original_filename"`
+	ImportedAt         sql.NullTime   `json:"imported_at"`
+	SlotID             sql.NullInt64  `json:"slot_id"`
+	AudioChannels      sql.NullString `json:"audio_channels"`
+	DynamicRange       sql.NullString `json:"dynamic_range"`
+	PrimaryFileID      sql.NullInt64  `json:"primary_file_id"`
+	DolbyVisionProfile string         `json:"dolby_vision_profile"`
+	AudioTracks        string         `json:"audio_tracks"`
+	SeriesID           int64          `json:"series_id"`
+	SeasonNumber       int64          `json:"season_number"`
+	EpisodeNumber      int64          `json:"episode_number"`
 }
 
 func (q *Queries) GetEpisodeFilesWithImportInfo(ctx context.Context, seriesID int64) ([]*GetEpisodeFilesWithImportInfoRow, error) {
@@ -787,6 +927,9 @@ func (q *Queries) GetEpisodeFilesWithImportInfo(ctx context.Context, seriesID in
 			&i.SlotID,
 			&i.AudioChannels,
 			&i.DynamicRange,
+			&i.PrimaryFileID,
+			&i.DolbyVisionProfile,
+			&i.AudioTracks,
 			&i.SeriesID,
 			&i.SeasonNumber,
 			&i.EpisodeNumber,
@@ -897,7 +1040,7 @@ func (q *Queries) GetEpisodeStatusCountsBySeries(ctx context.Context, seriesID i
 
 const getEpisodeWithFileQuality = `-- name: GetEpisodeWithFileQuality :one
 SELECT
-    e.id, e.series_id, e.season_number, e.episode_number, e.title, e.overview, e.air_date, e.monitored, e.status, e.active_download_id, e.status_message,
+    e.id, e.series_id, e.season_number, e.episode_number, e.title, e.overview, e.air_date, e.monitored, e.status, e.active_download_id, e.status_message, e.still_url,
     s.quality_profile_id as series_quality_profile_id,
     ef.id as file_id,
     ef.quality_id as current_quality_id
@@ -920,6 +1063,7 @@ type GetEpisodeWithFileQualityRow struct {
 	Status                 string         `json:"status"`
 	ActiveDownloadID       sql.NullString `json:"active_download_id"`
 	StatusMessage          sql.NullString `json:"status_message"`
+	StillUrl               sql.NullString `json:"still_url"`
 	SeriesQualityProfileID sql.NullInt64  `json:"series_quality_profile_id"`
 	FileID                 sql.NullInt64  `json:"file_id"`
 	CurrentQualityID       sql.NullInt64  `json:"current_quality_id"`
@@ -940,6 +1084,7 @@ func (q *Queries) GetEpisodeWithFileQuality(ctx context.Context, id int64) (*Get
 		&i.Status,
 		&i.ActiveDownloadID,
 		&i.StatusMessage,
+		&i.StillUrl,
 		&i.SeriesQualityProfileID,
 		&i.FileID,
 		&i.CurrentQualityID,
@@ -1037,7 +1182,7 @@ func (q *Queries) GetLatestSeasonNumber(ctx context.Context, seriesID int64) (in
 }
 
 const getMissingEpisodesBySeries = `-- name: GetMissingEpisodesBySeries :many
-SELECT e.id, e.series_id, e.season_number, e.episode_number, e.title, e.overview, e.air_date, e.monitored, e.status, e.active_download_id, e.status_message FROM episodes e
+SELECT e.id, e.series_id, e.season_number, e.episode_number, e.title, e.overview, e.air_date, e.monitored, e.status, e.active_download_id, e.status_message, e.still_url FROM episodes e
 JOIN seasons sea ON e.series_id = sea.series_id AND e.season_number = sea.season_number
 WHERE e.series_id = ?
   AND e.status IN ('missing', 'failed')
@@ -1067,6 +1212,7 @@ func (q *Queries) GetMissingEpisodesBySeries(ctx context.Context, seriesID int64
 			&i.Status,
 			&i.ActiveDownloadID,
 			&i.StatusMessage,
+			&i.StillUrl,
 		); err != nil {
 			return nil, err
 		}
@@ -1158,7 +1304,7 @@ func (q *Queries) GetSeasonsBySeriesID(ctx context.Context, seriesID int64) ([]*
 }
 
 const getSeries = `-- name: GetSeries :one
-SELECT id, title, sort_title, year, tvdb_id, tmdb_id, imdb_id, overview, runtime, path, root_folder_id, quality_profile_id, monitored, season_folder, production_status, network, format_type, added_at, updated_at, network_logo_url, added_by FROM series WHERE id = ? LIMIT 1
+SELECT id, title, sort_title, year, tvdb_id, tmdb_id, imdb_id, overview, runtime, path, root_folder_id, quality_profile_id, monitored, season_folder, production_status, network, format_type, added_at, updated_at, network_logo_url, added_by, language_profile_id, minimum_availability FROM series WHERE id = ? LIMIT 1
 `
 
 func (q *Queries) GetSeries(ctx context.Context, id int64) (*Series, error) {
@@ -1186,12 +1332,14 @@ func (q *Queries) GetSeries(ctx context.Context, id int64) (*Series, error) {
 		&i.UpdatedAt,
 		&i.NetworkLogoUrl,
 		&i.AddedBy,
+		&i.LanguageProfileID,
+		&i.MinimumAvailability,
 	)
 	return &i, err
 }
 
 const getSeriesByPath = `-- name: GetSeriesByPath :one
-SELECT id, title, sort_title, year, tvdb_id, tmdb_id, imdb_id, overview, runtime, path, root_folder_id, quality_profile_id, monitored, season_folder, production_status, network, format_type, added_at, updated_at, network_logo_url, added_by FROM series WHERE path = ? LIMIT 1
+SELECT id, title, sort_title, year, tvdb_id, tmdb_id, imdb_id, overview, runtime, path, root_folder_id, quality_profile_id, monitored, season_folder, production_status, network, format_type, added_at, updated_at, network_logo_url, added_by, language_profile_id, minimum_availability FROM series WHERE path = ? LIMIT 1
 `
 
 func (q *Queries) GetSeriesByPath(ctx context.Context, path sql.NullString) (*Series, error) {
@@ -1219,12 +1367,14 @@ func (q *Queries) GetSeriesByPath(ctx context.Context, path sql.NullString) (*Se
 		&i.UpdatedAt,
 		&i.NetworkLogoUrl,
 		&i.AddedBy,
+		&i.LanguageProfileID,
+		&i.MinimumAvailability,
 	)
 	return &i, err
 }
 
 const getSeriesByTmdbID = `-- name: GetSeriesByTmdbID :one
-SELECT id, title, sort_title, year, tvdb_id, tmdb_id, imdb_id, overview, runtime, path, root_folder_id, quality_profile_id, monitored, season_folder, production_status, network, format_type, added_at, updated_at, network_logo_url, added_by FROM series WHERE tmdb_id = ? LIMIT 1
+SELECT id, title, sort_title, year, tvdb_id, tmdb_id, imdb_id, overview, runtime, path, root_folder_id, quality_profile_id, monitored, season_folder, production_status, network, format_type, added_at, updated_at, network_logo_url, added_by, language_profile_id, minimum_availability FROM series WHERE tmdb_id = ? LIMIT 1
 `
 
 func (q *Queries) GetSeriesByTmdbID(ctx context.Context, tmdbID sql.NullInt64) (*Series, error) {
@@ -1252,12 +1402,14 @@ func (q *Queries) GetSeriesByTmdbID(ctx context.Context, tmdbID sql.NullInt64) (
 		&i.UpdatedAt,
 		&i.NetworkLogoUrl,
 		&i.AddedBy,
+		&i.LanguageProfileID,
+		&i.MinimumAvailability,
 	)
 	return &i, err
 }
 
 const getSeriesByTvdbID = `-- name: GetSeriesByTvdbID :one
-SELECT id, title, sort_title, year, tvdb_id, tmdb_id, imdb_id, overview, runtime, path, root_folder_id, quality_profile_id, monitored, season_folder, production_status, network, format_type, added_at, updated_at, network_logo_url, added_by FROM series WHERE tvdb_id = ? LIMIT 1
+SELECT id, title, sort_title, year, tvdb_id, tmdb_id, imdb_id, overview, runtime, path, root_folder_id, quality_profile_id, monitored, season_folder, production_status, network, format_type, added_at, updated_at, network_logo_url, added_by, language_profile_id, minimum_availability FROM series WHERE tvdb_id = ? LIMIT 1
 `
 
 func (q *Queries) GetSeriesByTvdbID(ctx context.Context, tvdbID sql.NullInt64) (*Series, error) {
@@ -1285,6 +1437,8 @@ func (q *Queries) GetSeriesByTvdbID(ctx context.Context, tvdbID sql.NullInt64) (
 		&i.UpdatedAt,
 		&i.NetworkLogoUrl,
 		&i.AddedBy,
+		&i.LanguageProfileID,
+		&i.MinimumAvailability,
 	)
 	return &i, err
 }
@@ -1398,34 +1552,36 @@ func (q *Queries) GetSeriesSeasonAvailabilitySummary(ctx context.Context, series
 }
 
 const getSeriesWithAddedBy = `-- name: GetSeriesWithAddedBy :one
-SELECT s.id, s.title, s.sort_title, s.year, s.tvdb_id, s.tmdb_id, s.imdb_id, s.overview, s.runtime, s.path, s.root_folder_id, s.quality_profile_id, s.monitored, s.season_folder, s.production_status, s.network, s.format_type, s.added_at, s.updated_at, s.network_logo_url, s.added_by, pu.username AS added_by_username FROM series s
+SELECT s.id, s.title, s.sort_title, s.year, s.tvdb_id, s.tmdb_id, s.imdb_id, s.overview, s.runtime, s.path, s.root_folder_id, s.quality_profile_id, s.monitored, s.season_folder, s.production_status, s.network, s.format_type, s.added_at, s.updated_at, s.network_logo_url, s.added_by, s.language_profile_id, s.minimum_availability, pu.username AS added_by_username FROM series s
 LEFT JOIN portal_users pu ON s.added_by = pu.id
 WHERE s.id = ? LIMIT 1
 `
 
 type GetSeriesWithAddedByRow struct {
-	ID               int64          `json:"id"`
-	Title            string         `json:"title"`
-	SortTitle        string         `json:"sort_title"`
-	Year             sql.NullInt64  `json:"year"`
-	TvdbID           sql.NullInt64  `json:"tvdb_id"`
-	TmdbID           sql.NullInt64  `json:"tmdb_id"`
-	ImdbID           sql.NullString `json:"imdb_id"`
-	Overview         sql.NullString `json:"overview"`
-	Runtime          sql.NullInt64  `json:"runtime"`
-	Path             sql.NullString `json:"path"`
-	RootFolderID     sql.NullInt64  `json:"root_folder_id"`
-	QualityProfileID sql.NullInt64  `json:"quality_profile_id"`
-	Monitored        bool           `json:"monitored"`
-	SeasonFolder     bool           `json:"season_folder"`
-	ProductionStatus string         `json:"production_status"`
-	Network          sql.NullString `json:"network"`
-	FormatType       sql.NullString `json:"format_type"`
-	AddedAt          sql.NullTime   `json:"added_at"`
-	UpdatedAt        sql.NullTime   `json:"updated_at"`
-	NetworkLogoUrl   sql.NullString `json:"network_logo_url"`
-	AddedBy          sql.NullInt64  `json:"added_by"`
-	AddedByUsername  sql.NullString `json:"added_by_username"`
+	ID                  int64          `json:"id"`
+	Title               string         `json:"title"`
+	SortTitle           string         `json:"sort_title"`
+	Year                sql.NullInt64  `json:"year"`
+	TvdbID              sql.NullInt64  `json:"tvdb_id"`
+	TmdbID              sql.NullInt64  `json:"tmdb_id"`
+	ImdbID              sql.NullString `json:"imdb_id"`
+	Overview            sql.NullString `json:"overview"`
+	Runtime             sql.NullInt64  `json:"runtime"`
+	Path                sql.NullString `json:"path"`
+	RootFolderID        sql.NullInt64  `json:"root_folder_id"`
+	QualityProfileID    sql.NullInt64  `json:"quality_profile_id"`
+	Monitored           bool           `json:"monitored"`
+	SeasonFolder        bool           `json:"season_folder"`
+	ProductionStatus    string         `json:"production_status"`
+	Network             sql.NullString `json:"network"`
+	FormatType          sql.NullString `json:"format_type"`
+	AddedAt             sql.NullTime   `json:"added_at"`
+	UpdatedAt           sql.NullTime   `json:"updated_at"`
+	NetworkLogoUrl      sql.NullString `json:"network_logo_url"`
+	AddedBy             sql.NullInt64  `json:"added_by"`
+	LanguageProfileID   sql.NullInt64  `json:"language_profile_id"`
+	MinimumAvailability sql.NullString `json:"minimum_availability"`
+	AddedByUsername     sql.NullString `json:"added_by_username"`
 }
 
 func (q *Queries) GetSeriesWithAddedBy(ctx context.Context, id int64) (*GetSeriesWithAddedByRow, error) {
@@ -1453,6 +1609,8 @@ func (q *Queries) GetSeriesWithAddedBy(ctx context.Context, id int64) (*GetSerie
 		&i.UpdatedAt,
 		&i.NetworkLogoUrl,
 		&i.AddedBy,
+		&i.LanguageProfileID,
+		&i.MinimumAvailability,
 		&i.AddedByUsername,
 	)
 	return &i, err
@@ -1469,6 +1627,20 @@ func (q *Queries) IsOriginalPathImportedEpisode(ctx context.Context, originalPat
 	return imported, err
 }
 
+const linkEpisodeFile = `-- name: LinkEpisodeFile :exec
+UPDATE episode_files SET primary_file_id = ? WHERE id = ?
+`
+
+type LinkEpisodeFileParams struct {
+	PrimaryFileID sql.NullInt64 `json:"primary_file_id"`
+	ID            int64         `json:"id"`
+}
+
+func (q *Queries) LinkEpisodeFile(ctx context.Context, arg LinkEpisodeFileParams) error {
+	_, err := q.db.ExecContext(ctx, linkEpisodeFile, arg.PrimaryFileID, arg.ID)
+	return err
+}
+
 const listAllEpisodeFilePaths = `-- name: ListAllEpisodeFilePaths :many
 SELECT path FROM episode_files
 `
@@ -1531,7 +1703,7 @@ func (q *Queries) ListDownloadingEpisodes(ctx context.Context) ([]*ListDownloadi
 }
 
 const listEpisodeFilesByEpisode = `-- name: ListEpisodeFilesByEpisode :many
-SELECT id, episode_id, path, size, quality, video_codec, audio_codec, resolution, created_at, quality_id, original_path, original_filename, imported_at, slot_id, audio_channels, dynamic_range FROM episode_files WHERE episode_id = ? ORDER BY COALESCE(quality_id, 0) DESC, id DESC
+SELECT id, episode_id, path, size, quality, video_codec, audio_codec, resolution, created_at, quality_id, original_path, original_filename, imported_at, slot_id, audio_channels, dynamic_range, primary_file_id, dolby_vision_profile, audio_tracks FROM episode_files WHERE episode_id = ? ORDER BY COALESCE(quality_id, 0) DESC, id DESC
 `
 
 func (q *Queries) ListEpisodeFilesByEpisode(ctx context.Context, episodeID int64) ([]*EpisodeFile, error) {
@@ -1560,6 +1732,66 @@ func (q *Queries) ListEpisodeFilesByEpisode(ctx context.Context, episodeID int64
 			&i.SlotID,
 			&i.AudioChannels,
 			&i.DynamicRange,
+			&i.PrimaryFileID,
+			&i.DolbyVisionProfile,
+			&i.AudioTracks,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listEpisodeFilesByEpisodeIDs = `-- name: ListEpisodeFilesByEpisodeIDs :many
+SELECT id, episode_id, path, size, quality, video_codec, audio_codec, resolution, created_at, quality_id, original_path, original_filename, imported_at, slot_id, audio_channels, dynamic_range, primary_file_id, dolby_vision_profile, audio_tracks FROM episode_files WHERE episode_id IN (/*SLICE:episodeIds*/?) ORDER BY episode_id, COALESCE(quality_id, 0) DESC, id DESC
+`
+
+func (q *Queries) ListEpisodeFilesByEpisodeIDs(ctx context.Context, episodeids []int64) ([]*EpisodeFile, error) {
+	query := listEpisodeFilesByEpisodeIDs
+	var queryParams []interface{}
+	if len(episodeids) > 0 {
+		for _, v := range episodeids {
+			queryParams = append(queryParams, v)
+		}
+		query = strings.Replace(query, "/*SLICE:episodeIds*/?", strings.Repeat(",?", len(episodeids))[1:], 1)
+	} else {
+		query = strings.Replace(query, "/*SLICE:episodeIds*/?", "NULL", 1)
+	}
+	rows, err := q.db.QueryContext(ctx, query, queryParams...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []*EpisodeFile{}
+	for rows.Next() {
+		var i EpisodeFile
+		if err := rows.Scan(
+			&i.ID,
+			&i.EpisodeID,
+			&i.Path,
+			&i.Size,
+			&i.Quality,
+			&i.VideoCodec,
+			&i.AudioCodec,
+			&i.Resolution,
+			&i.CreatedAt,
+			&i.QualityID,
+			&i.OriginalPath,
+			&i.OriginalFilename,
+			&i.ImportedAt,
+			&i.SlotID,
+			&i.AudioChannels,
+			&i.DynamicRange,
+			&i.PrimaryFileID,
+			&i.DolbyVisionProfile,
+			&i.AudioTracks,
 		); err != nil {
 			return nil, err
 		}
@@ -1575,7 +1807,7 @@ func (q *Queries) ListEpisodeFilesByEpisode(ctx context.Context, episodeID int64
 }
 
 const listEpisodeFilesBySeason = `-- name: ListEpisodeFilesBySeason :many
-SELECT ef.id, ef.episode_id, ef.path, ef.size, ef.quality, ef.video_codec, ef.audio_codec, ef.resolution, ef.created_at, ef.quality_id, ef.original_path, ef.original_filename, ef.imported_at, ef.slot_id, ef.audio_channels, ef.dynamic_range FROM episode_files ef
+SELECT ef.id, ef.episode_id, ef.path, ef.size, ef.quality, ef.video_codec, ef.audio_codec, ef.resolution, ef.created_at, ef.quality_id, ef.original_path, ef.original_filename, ef.imported_at, ef.slot_id, ef.audio_channels, ef.dynamic_range, ef.primary_file_id, ef.dolby_vision_profile, ef.audio_tracks FROM episode_files ef
 JOIN episodes e ON ef.episode_id = e.id
 WHERE e.series_id = ? AND e.season_number = ?
 ORDER BY e.episode_number
@@ -1612,6 +1844,9 @@ func (q *Queries) ListEpisodeFilesBySeason(ctx context.Context, arg ListEpisodeF
 			&i.SlotID,
 			&i.AudioChannels,
 			&i.DynamicRange,
+			&i.PrimaryFileID,
+			&i.DolbyVisionProfile,
+			&i.AudioTracks,
 		); err != nil {
 			return nil, err
 		}
@@ -1627,7 +1862,7 @@ func (q *Queries) ListEpisodeFilesBySeason(ctx context.Context, arg ListEpisodeF
 }
 
 const listEpisodeFilesBySeries = `-- name: ListEpisodeFilesBySeries :many
-SELECT ef.id, ef.episode_id, ef.path, ef.size, ef.quality, ef.video_codec, ef.audio_codec, ef.resolution, ef.created_at, ef.quality_id, ef.original_path, ef.original_filename, ef.imported_at, ef.slot_id, ef.audio_channels, ef.dynamic_range FROM episode_files ef
+SELECT ef.id, ef.episode_id, ef.path, ef.size, ef.quality, ef.video_codec, ef.audio_codec, ef.resolution, ef.created_at, ef.quality_id, ef.original_path, ef.original_filename, ef.imported_at, ef.slot_id, ef.audio_channels, ef.dynamic_range, ef.primary_file_id, ef.dolby_vision_profile, ef.audio_tracks FROM episode_files ef
 JOIN episodes e ON ef.episode_id = e.id
 WHERE e.series_id = ?
 ORDER BY e.season_number, e.episode_number
@@ -1659,6 +1894,72 @@ func (q *Queries) ListEpisodeFilesBySeries(ctx context.Context, seriesID int64)
 			&i.SlotID,
 			&i.AudioChannels,
 			&i.DynamicRange,
+			&i.PrimaryFileID,
+			&i.DolbyVisionProfile,
+			&i.AudioTracks,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listEpisodeFilesForQualityAudit = `-- name: ListEpisodeFilesForQualityAudit :many
+SELECT ef.id as file_id, ef.path, ef.size, ef.quality_id, ef.video_codec, ef.resolution,
+       e.id as episode_id, e.season_number, e.episode_number,
+       s.id as series_id, s.title, s.runtime
+FROM episode_files ef
+JOIN episodes e ON ef.episode_id = e.id
+JOIN series s ON e.series_id = s.id
+WHERE ef.quality_id IS NOT NULL
+  AND ef.primary_file_id IS NULL
+ORDER BY s.sort_title, e.season_number, e.episode_number
+`
+
+type ListEpisodeFilesForQualityAuditRow struct {
+	FileID        int64          `json:"file_id"`
+	Path          string         `json:"path"`
+	Size          int64          `json:"size"`
+	QualityID     sql.NullInt64  `json:"quality_id"`
+	VideoCodec    sql.NullString `json:"video_codec"`
+	Resolution    sql.NullString `json:"resolution"`
+	EpisodeID     int64          `json:"episode_id"`
+	SeasonNumber  int64          `json:"season_number"`
+	EpisodeNumber int64          `json:"episode_number"`
+	SeriesID      int64          `json:"series_id"`
+	Title         string         `json:"title"`
+	Runtime       sql.NullInt64  `json:"runtime"`
+}
+
+func (q *Queries) ListEpisodeFilesForQualityAudit(ctx context.Context) ([]*ListEpisodeFilesForQualityAuditRow, error) {
+	rows, err := q.db.QueryContext(ctx, listEpisodeFilesForQualityAudit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []*ListEpisodeFilesForQualityAuditRow{}
+	for rows.Next() {
+		var i ListEpisodeFilesForQualityAuditRow
+		if err := rows.Scan(
+			&i.FileID,
+			&i.Path,
+			&i.Size,
+			&i.QualityID,
+			&i.VideoCodec,
+			&i.Resolution,
+			&i.EpisodeID,
+			&i.SeasonNumber,
+			&i.EpisodeNumber,
+			&i.SeriesID,
+			&i.Title,
+			&i.Runtime,
 		); err != nil {
 			return nil, err
 		}
@@ -1719,14 +2020,17 @@ func (q *Queries) ListEpisodeFilesForRootFolder(ctx context.Context, rootFolderI
 
 const listEpisodeUpgradeCandidates = `-- name: ListEpisodeUpgradeCandidates :many
 SELECT
-    e.id, e.series_id, e.season_number, e.episode_number, e.title, e.overview, e.air_date, e.monitored, e.status, e.active_download_id, e.status_message,
+    e.id, e.series_id, e.season_number, e.episode_number, e.title, e.overview, e.air_date, e.monitored, e.status, e.active_download_id, e.status_message, e.still_url,
     s.title as series_title,
     s.tvdb_id as series_tvdb_id,
     s.tmdb_id as series_tmdb_id,
     s.imdb_id as series_imdb_id,
     s.year as series_year,
+    s.runtime as series_runtime,
     s.quality_profile_id as series_quality_profile_id,
-    ef.quality_id as current_quality_id
+    ef.quality_id as current_quality_id,
+    ef.path as current_file_path,
+    ef.original_filename as current_original_filename
 FROM episodes e
 JOIN series s ON e.series_id = s.id
 JOIN seasons sea ON e.series_id = sea.series_id AND e.season_number = sea.season_number
@@ -1741,24 +2045,28 @@ ORDER BY e.air_date DESC
 `
 
 type ListEpisodeUpgradeCandidatesRow struct {
-	ID                     int64          `json:"id"`
-	SeriesID               int64          `json:"series_id"`
-	SeasonNumber           int64          `json:"season_number"`
-	EpisodeNumber          int64          `json:"episode_number"`
-	Title                  sql.NullString `json:"title"`
-	Overview               sql.NullString `json:"overview"`
-	AirDate                sql.NullTime   `json:"air_date"`
-	Monitored              bool           `json:"monitored"`
-	Status                 string         `json:"status"`
-	ActiveDownloadID       sql.NullString `json:"active_download_id"`
-	StatusMessage          sql.NullString `json:"status_message"`
-	SeriesTitle            string         `json:"series_title"`
-	SeriesTvdbID           sql.NullInt64  `json:"series_tvdb_id"`
-	SeriesTmdbID           sql.NullInt64  `json:"series_tmdb_id"`
-	SeriesImdbID           sql.NullString `json:"series_imdb_id"`
-	SeriesYear             sql.NullInt64  `json:"series_year"`
-	SeriesQualityProfileID sql.NullInt64  `json:"series_quality_profile_id"`
-	CurrentQualityID       sql.NullInt64  `json:"current_quality_id"`
+	ID                      int64          `json:"id"`
+	SeriesID                int64          `json:"series_id"`
+	SeasonNumber            int64          `json:"season_number"`
+	EpisodeNumber           int64          `json:"episode_number"`
+	Title                   sql.NullString `json:"title"`
+	Overview                sql.NullString `json:"overview"`
+	AirDate                 sql.NullTime   `json:"air_date"`
+	Monitored               bool           `json:"monitored"`
+	Status                  string         `json:"status"`
+	ActiveDownloadID        sql.NullString `json:"active_download_id"`
+	StatusMessage           sql.NullString `json:"status_message"`
+	StillUrl                sql.NullString `json:"still_url"`
+	SeriesTitle             string         `json:"series_title"`
+	SeriesTvdbID            sql.NullInt64  `json:"series_tvdb_id"`
+	SeriesTmdbID            sql.NullInt64  `json:"series_tmdb_id"`
+	SeriesImdbID            sql.NullString `json:"series_imdb_id"`
+	SeriesYear              sql.NullInt64  `json:"series_year"`
+	SeriesRuntime           sql.NullInt64  `json:"series_runtime"`
+	SeriesQualityProfileID  sql.NullInt64  `json:"series_quality_profile_id"`
+	CurrentQualityID        sql.NullInt64  `json:"current_quality_id"`
+	CurrentFilePath         string         `json:"current_file_path"`
+	CurrentOriginalFilename sql.NullString `json:"current_original_filename"`
 }
 
 // Upgrade candidate queries (status-based)
@@ -1783,13 +2091,17 @@ func (q *Queries) ListEpisodeUpgradeCandidates(ctx context.Context) ([]*ListEpis
 			&i.Status,
 			&i.ActiveDownloadID,
 			&i.StatusMessage,
+			&i.StillUrl,
 			&i.SeriesTitle,
 			&i.SeriesTvdbID,
 			&i.SeriesTmdbID,
 			&i.SeriesImdbID,
 			&i.SeriesYear,
+			&i.SeriesRuntime,
 			&i.SeriesQualityProfileID,
 			&i.CurrentQualityID,
+			&i.CurrentFilePath,
+			&i.CurrentOriginalFilename,
 		); err != nil {
 			return nil, err
 		}
@@ -1806,7 +2118,7 @@ func (q *Queries) ListEpisodeUpgradeCandidates(ctx context.Context) ([]*ListEpis
 
 const listEpisodeUpgradeCandidatesBySeries = `-- name: ListEpisodeUpgradeCandidatesBySeries :many
 SELECT
-    e.id, e.series_id, e.season_number, e.episode_number, e.title, e.overview, e.air_date, e.monitored, e.status, e.active_download_id, e.status_message
+    e.id, e.series_id, e.season_number, e.episode_number, e.title, e.overview, e.air_date, e.monitored, e.status, e.active_download_id, e.status_message, e.still_url
 FROM episodes e
 JOIN series s ON e.series_id = s.id
 WHERE e.series_id = ?
@@ -1836,6 +2148,7 @@ func (q *Queries) ListEpisodeUpgradeCandidatesBySeries(ctx context.Context, seri
 			&i.Status,
 			&i.ActiveDownloadID,
 			&i.StatusMessage,
+			&i.StillUrl,
 		); err != nil {
 			return nil, err
 		}
@@ -1851,7 +2164,7 @@ func (q *Queries) ListEpisodeUpgradeCandidatesBySeries(ctx context.Context, seri
 }
 
 const listEpisodesBySeason = `-- name: ListEpisodesBySeason :many
-SELECT id, series_id, season_number, episode_number, title, overview, air_date, monitored, status, active_download_id, status_message FROM episodes WHERE series_id = ? AND season_number = ? ORDER BY episode_number
+SELECT id, series_id, season_number, episode_number, title, overview, air_date, monitored, status, active_download_id, status_message, still_url FROM episodes WHERE series_id = ? AND season_number = ? ORDER BY episode_number
 `
 
 type ListEpisodesBySeasonParams struct {
@@ -1880,6 +2193,7 @@ func (q *Queries) ListEpisodesBySeason(ctx context.Context, arg ListEpisodesBySe
 			&i.Status,
 			&i.ActiveDownloadID,
 			&i.StatusMessage,
+			&i.StillUrl,
 		); err != nil {
 			return nil, err
 		}
@@ -1895,7 +2209,7 @@ func (q *Queries) ListEpisodesBySeason(ctx context.Context, arg ListEpisodesBySe
 }
 
 const listEpisodesBySeries = `-- name: ListEpisodesBySeries :many
-SELECT id, series_id, season_number, episode_number, title, overview, air_date, monitored, status, active_download_id, status_message FROM episodes WHERE series_id = ? ORDER BY season_number, episode_number
+SELECT id, series_id, season_number, episode_number, title, overview, air_date, monitored, status, active_download_id, status_message, still_url FROM episodes WHERE series_id = ? ORDER BY season_number, episode_number
 `
 
 func (q *Queries) ListEpisodesBySeries(ctx context.Context, seriesID int64) ([]*Episode, error) {
@@ -1919,6 +2233,57 @@ func (q *Queries) ListEpisodesBySeries(ctx context.Context, seriesID int64) ([]*
 			&i.Status,
 			&i.ActiveDownloadID,
 			&i.StatusMessage,
+			&i.StillUrl,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listEpisodesBySeriesIDs = `-- name: ListEpisodesBySeriesIDs :many
+SELECT id, series_id, season_number, episode_number, title, overview, air_date, monitored, status, active_download_id, status_message, still_url FROM episodes WHERE series_id IN (/*SLICE:seriesIds*/?) ORDER BY series_id, season_number, episode_number
+`
+
+func (q *Queries) ListEpisodesBySeriesIDs(ctx context.Context, seriesids []int64) ([]*Episode, error) {
+	query := listEpisodesBySeriesIDs
+	var queryParams []interface{}
+	if len(seriesids) > 0 {
+		for _, v := range seriesids {
+			queryParams = append(queryParams, v)
+		}
+		query = strings.Replace(query, "/*SLICE:seriesIds*/?", strings.Repeat(",?", len(seriesids))[1:], 1)
+	} else {
+		query = strings.Replace(query, "/*SLICE:seriesIds*/?", "NULL", 1)
+	}
+	rows, err := q.db.QueryContext(ctx, query, queryParams...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []*Episode{}
+	for rows.Next() {
+		var i Episode
+		if err := rows.Scan(
+			&i.ID,
+			&i.SeriesID,
+			&i.SeasonNumber,
+			&i.EpisodeNumber,
+			&i.Title,
+			&i.Overview,
+			&i.AirDate,
+			&i.Monitored,
+			&i.Status,
+			&i.ActiveDownloadID,
+			&i.StatusMessage,
+			&i.StillUrl,
 		); err != nil {
 			return nil, err
 		}
@@ -1979,42 +2344,92 @@ func (q *Queries) ListEpisodesWithFilesForProfile(ctx context.Context, qualityPr
 	return items, nil
 }
 
-const listMissingEpisodes = `-- name: ListMissingEpisodes :many
-SELECT
-    e.id, e.series_id, e.season_number, e.episode_number, e.title, e.overview, e.air_date, e.monitored, e.status, e.active_download_id, e.status_message,
-    s.title as series_title,
-    s.tvdb_id as series_tvdb_id,
-    s.tmdb_id as series_tmdb_id,
-    s.imdb_id as series_imdb_id,
-    s.year as series_year,
-    s.quality_profile_id as series_quality_profile_id
-FROM episodes e
-JOIN series s ON e.series_id = s.id
-JOIN seasons sea ON e.series_id = sea.series_id AND e.season_number = sea.season_number
-WHERE e.status IN ('missing', 'failed')
-  AND s.monitored = 1
-  AND sea.monitored = 1
-  AND e.monitored = 1
-ORDER BY e.air_date DESC
+const listLinkedEpisodeFiles = `-- name: ListLinkedEpisodeFiles :many
+SELECT id, episode_id, path, size, quality, video_codec, audio_codec, resolution, created_at, quality_id, original_path, original_filename, imported_at, slot_id, audio_channels, dynamic_range, primary_file_id, dolby_vision_profile, audio_tracks FROM episode_files WHERE primary_file_id = ? ORDER BY id
 `
 
-type ListMissingEpisodesRow struct {
-	ID                     int64          `json:"id"`
-	SeriesID               int64          `json:"series_id"`
-	SeasonNumber           int64          `json:"season_number"`
-	EpisodeNumber          int64          `json:"episode_number"`
-	Title                  sql.NullString `json:"title"`
-	Overview               sql.NullString `json:"overview"`
-	AirDate                sql.NullTime   `json:"air_date"`
-	Monitored              bool           `json:"monitored"`
+func (q *Queries) ListLinkedEpisodeFiles(ctx context.Context, primaryFileID sql.NullInt64) ([]*EpisodeFile, error) {
+	rows, err := q.db.QueryContext(ctx, listLinkedEpisodeFiles, primaryFileID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []*EpisodeFile{}
+	for rows.Next() {
+		var i EpisodeFile
+		if err := rows.Scan(
+			&i.ID,
+			&i.EpisodeID,
+			&i.Path,
+			&i.Size,
+			&i.Quality,
+			&i.VideoCodec,
+			&i.AudioCodec,
+			&i.Resolution,
+			&i.CreatedAt,
+			&i.QualityID,
+			&i.OriginalPath,
+			&i.OriginalFilename,
+			&i.ImportedAt,
+			&i.SlotID,
+			&i.AudioChannels,
+			&i.DynamicRange,
+			&i.PrimaryFileID,
+			&i.DolbyVisionProfile,
+			&i.AudioTracks,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listMissingEpisodes = `-- name: ListMissingEpisodes :many
+SELECT
+    e.id, e.series_id, e.season_number, e.episode_number, e.title, e.overview, e.air_date, e.monitored, e.status, e.active_download_id, e.status_message, e.still_url,
+    s.title as series_title,
+    s.tvdb_id as series_tvdb_id,
+    s.tmdb_id as series_tmdb_id,
+    s.imdb_id as series_imdb_id,
+    s.year as series_year,
+    s.runtime as series_runtime,
+    s.quality_profile_id as series_quality_profile_id
+FROM episodes e
+JOIN series s ON e.series_id = s.id
+JOIN seasons sea ON e.series_id = sea.series_id AND e.season_number = sea.season_number
+WHERE e.status IN ('missing', 'failed')
+  AND s.monitored = 1
+  AND sea.monitored = 1
+  AND e.monitored = 1
+ORDER BY e.air_date DESC
+`
+
+type ListMissingEpisodesRow struct {
+	ID                     int64          `json:"id"`
+	SeriesID               int64          `json:"series_id"`
+	SeasonNumber           int64          `json:"season_number"`
+	EpisodeNumber          int64          `json:"episode_number"`
+	Title                  sql.NullString `json:"title"`
+	Overview               sql.NullString `json:"overview"`
+	AirDate                sql.NullTime   `json:"air_date"`
+	Monitored              bool           `json:"monitored"`
 	Status                 string         `json:"status"`
 	ActiveDownloadID       sql.NullString `json:"active_download_id"`
 	StatusMessage          sql.NullString `json:"status_message"`
+	StillUrl               sql.NullString `json:"still_url"`
 	SeriesTitle            string         `json:"series_title"`
 	SeriesTvdbID           sql.NullInt64  `json:"series_tvdb_id"`
 	SeriesTmdbID           sql.NullInt64  `json:"series_tmdb_id"`
 	SeriesImdbID           sql.NullString `json:"series_imdb_id"`
 	SeriesYear             sql.NullInt64  `json:"series_year"`
+	SeriesRuntime          sql.NullInt64  `json:"series_runtime"`
 	SeriesQualityProfileID sql.NullInt64  `json:"series_quality_profile_id"`
 }
 
@@ -2040,11 +2455,13 @@ func (q *Queries) ListMissingEpisodes(ctx context.Context) ([]*ListMissingEpisod
 			&i.Status,
 			&i.ActiveDownloadID,
 			&i.StatusMessage,
+			&i.StillUrl,
 			&i.SeriesTitle,
 			&i.SeriesTvdbID,
 			&i.SeriesTmdbID,
 			&i.SeriesImdbID,
 			&i.SeriesYear,
+			&i.SeriesRuntime,
 			&i.SeriesQualityProfileID,
 		); err != nil {
 			return nil, err
@@ -2061,7 +2478,7 @@ func (q *Queries) ListMissingEpisodes(ctx context.Context) ([]*ListMissingEpisod
 }
 
 const listMonitoredSeries = `-- name: ListMonitoredSeries :many
-SELECT id, title, sort_title, year, tvdb_id, tmdb_id, imdb_id, overview, runtime, path, root_folder_id, quality_profile_id, monitored, season_folder, production_status, network, format_type, added_at, updated_at, network_logo_url, added_by FROM series WHERE monitored = 1 ORDER BY sort_title
+SELECT id, title, sort_title, year, tvdb_id, tmdb_id, imdb_id, overview, runtime, path, root_folder_id, quality_profile_id, monitored, season_folder, production_status, network, format_type, added_at, updated_at, network_logo_url, added_by, language_profile_id, minimum_availability FROM series WHERE monitored = 1 ORDER BY sort_title
 `
 
 func (q *Queries) ListMonitoredSeries(ctx context.Context) ([]*Series, error) {
@@ -2095,6 +2512,141 @@ func (q *Queries) ListMonitoredSeries(ctx context.Context) ([]*Series, error) {
 			&i.UpdatedAt,
 			&i.NetworkLogoUrl,
 			&i.AddedBy,
+			&i.LanguageProfileID,
+			&i.MinimumAvailability,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listProperCandidateEpisodes = `-- name: ListProperCandidateEpisodes :many
+SELECT e.id, e.series_id, e.season_number, e.episode_number,
+       s.title as series_title, s.tvdb_id as series_tvdb_id,
+       s.tmdb_id as series_tmdb_id, s.imdb_id as series_imdb_id,
+       s.year as series_year, s.runtime as series_runtime,
+       s.quality_profile_id as series_quality_profile_id,
+       ef.quality_id as current_quality_id,
+       ef.path as current_file_path, ef.original_filename as current_original_filename
+FROM episodes e
+JOIN series s ON e.series_id = s.id
+JOIN seasons sea ON e.series_id = sea.series_id AND e.season_number = sea.season_number
+JOIN episode_files ef ON ef.id = (
+    SELECT id FROM episode_files WHERE episode_id = e.id ORDER BY id DESC LIMIT 1
+)
+WHERE e.status = 'available' AND e.monitored = 1 AND s.monitored = 1 AND sea.monitored = 1
+  AND ef.quality_id IS NOT NULL
+  AND ef.imported_at >= ?1
+`
+
+type ListProperCandidateEpisodesRow struct {
+	ID                      int64          `json:"id"`
+	SeriesID                int64          `json:"series_id"`
+	SeasonNumber            int64          `json:"season_number"`
+	EpisodeNumber           int64          `json:"episode_number"`
+	SeriesTitle             string         `json:"series_title"`
+	SeriesTvdbID            sql.NullInt64  `json:"series_tvdb_id"`
+	SeriesTmdbID            sql.NullInt64  `json:"series_tmdb_id"`
+	SeriesImdbID            sql.NullString `json:"series_imdb_id"`
+	SeriesYear              sql.NullInt64  `json:"series_year"`
+	SeriesRuntime           sql.NullInt64  `json:"series_runtime"`
+	SeriesQualityProfileID  sql.NullInt64  `json:"series_quality_profile_id"`
+	CurrentQualityID        sql.NullInt64  `json:"current_quality_id"`
+	CurrentFilePath         string         `json:"current_file_path"`
+	CurrentOriginalFilename sql.NullString `json:"current_original_filename"`
+}
+
+// Episodes whose file met the cutoff, watched by RSS sync for PROPER/REPACK replacements
+func (q *Queries) ListProperCandidateEpisodes(ctx context.Context, importedSince sql.NullTime) ([]*ListProperCandidateEpisodesRow, error) {
+	rows, err := q.db.QueryContext(ctx, listProperCandidateEpisodes, importedSince)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []*ListProperCandidateEpisodesRow{}
+	for rows.Next() {
+		var i ListProperCandidateEpisodesRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.SeriesID,
+			&i.SeasonNumber,
+			&i.EpisodeNumber,
+			&i.SeriesTitle,
+			&i.SeriesTvdbID,
+			&i.SeriesTmdbID,
+			&i.SeriesImdbID,
+			&i.SeriesYear,
+			&i.SeriesRuntime,
+			&i.SeriesQualityProfileID,
+			&i.CurrentQualityID,
+			&i.CurrentFilePath,
+			&i.CurrentOriginalFilename,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listSeasonGapEpisodes = `-- name: ListSeasonGapEpisodes :many
+SELECT
+    s.id AS series_id, s.title AS series_title, e.season_number, e.id AS episode_id, e.episode_number,
+    (SELECT COUNT(*) FROM episodes e3
+     WHERE e3.series_id = e.series_id AND e3.season_number = e.season_number
+       AND e3.air_date IS NOT NULL AND substr(e3.air_date, 1, 10) <= date('now')) AS aired_episodes
+FROM episodes e
+JOIN series s ON s.id = e.series_id
+WHERE e.season_number > 0
+  AND e.air_date IS NOT NULL AND substr(e.air_date, 1, 10) <= date('now')
+  AND NOT EXISTS (SELECT 1 FROM episode_files ef WHERE ef.episode_id = e.id)
+  AND EXISTS (
+      SELECT 1 FROM episodes e2
+      JOIN episode_files ef2 ON ef2.episode_id = e2.id
+      WHERE e2.series_id = e.series_id AND e2.season_number = e.season_number
+  )
+ORDER BY s.sort_title, e.season_number, e.episode_number
+`
+
+type ListSeasonGapEpisodesRow struct {
+	SeriesID      int64  `json:"series_id"`
+	SeriesTitle   string `json:"series_title"`
+	SeasonNumber  int64  `json:"season_number"`
+	EpisodeID     int64  `json:"episode_id"`
+	EpisodeNumber int64  `json:"episode_number"`
+	AiredEpisodes int64  `json:"aired_episodes"`
+}
+
+func (q *Queries) ListSeasonGapEpisodes(ctx context.Context) ([]*ListSeasonGapEpisodesRow, error) {
+	rows, err := q.db.QueryContext(ctx, listSeasonGapEpisodes)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []*ListSeasonGapEpisodesRow{}
+	for rows.Next() {
+		var i ListSeasonGapEpisodesRow
+		if err := rows.Scan(
+			&i.SeriesID,
+			&i.SeriesTitle,
+			&i.SeasonNumber,
+			&i.EpisodeID,
+			&i.EpisodeNumber,
+			&i.AiredEpisodes,
 		); err != nil {
 			return nil, err
 		}
@@ -2143,8 +2695,52 @@ func (q *Queries) ListSeasonsBySeries(ctx context.Context, seriesID int64) ([]*S
 	return items, nil
 }
 
+const listSeasonsBySeriesIDs = `-- name: ListSeasonsBySeriesIDs :many
+SELECT id, series_id, season_number, monitored, overview, poster_url FROM seasons WHERE series_id IN (/*SLICE:seriesIds*/?) ORDER BY series_id, season_number
+`
+
+func (q *Queries) ListSeasonsBySeriesIDs(ctx context.Context, seriesids []int64) ([]*Season, error) {
+	query := listSeasonsBySeriesIDs
+	var queryParams []interface{}
+	if len(seriesids) > 0 {
+		for _, v := range seriesids {
+			queryParams = append(queryParams, v)
+		}
+		query = strings.Replace(query, "/*SLICE:seriesIds*/?", strings.Repeat(",?", len(seriesids))[1:], 1)
+	} else {
+		query = strings.Replace(query, "/*SLICE:seriesIds*/?", "NULL", 1)
+	}
+	rows, err := q.db.QueryContext(ctx, query, queryParams...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []*Season{}
+	for rows.Next() {
+		var i Season
+		if err := rows.Scan(
+			&i.ID,
+			&i.SeriesID,
+			&i.SeasonNumber,
+			&i.Monitored,
+			&i.Overview,
+			&i.PosterUrl,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listSeries = `-- name: ListSeries :many
-SELECT id, title, sort_title, year, tvdb_id, tmdb_id, imdb_id, overview, runtime, path, root_folder_id, quality_profile_id, monitored, season_folder, production_status, network, format_type, added_at, updated_at, network_logo_url, added_by FROM series ORDER BY sort_title
+SELECT id, title, sort_title, year, tvdb_id, tmdb_id, imdb_id, overview, runtime, path, root_folder_id, quality_profile_id, monitored, season_folder, production_status, network, format_type, added_at, updated_at, network_logo_url, added_by, language_profile_id, minimum_availability FROM series ORDER BY sort_title
 `
 
 func (q *Queries) ListSeries(ctx context.Context) ([]*Series, error) {
@@ -2178,6 +2774,69 @@ func (q *Queries) ListSeries(ctx context.Context) ([]*Series, error) {
 			&i.UpdatedAt,
 			&i.NetworkLogoUrl,
 			&i.AddedBy,
+			&i.LanguageProfileID,
+			&i.MinimumAvailability,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listSeriesByIDs = `-- name: ListSeriesByIDs :many
+SELECT id, title, sort_title, year, tvdb_id, tmdb_id, imdb_id, overview, runtime, path, root_folder_id, quality_profile_id, monitored, season_folder, production_status, network, format_type, added_at, updated_at, network_logo_url, added_by, language_profile_id, minimum_availability FROM series WHERE id IN (/*SLICE:ids*/?)
+`
+
+func (q *Queries) ListSeriesByIDs(ctx context.Context, ids []int64) ([]*Series, error) {
+	query := listSeriesByIDs
+	var queryParams []interface{}
+	if len(ids) > 0 {
+		for _, v := range ids {
+			queryParams = append(queryParams, v)
+		}
+		query = strings.Replace(query, "/*SLICE:ids*/?", strings.Repeat(",?", len(ids))[1:], 1)
+	} else {
+		query = strings.Replace(query, "/*SLICE:ids*/?", "NULL", 1)
+	}
+	rows, err := q.db.QueryContext(ctx, query, queryParams...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []*Series{}
+	for rows.Next() {
+		var i Series
+		if err := rows.Scan(
+			&i.ID,
+			&i.Title,
+			&i.SortTitle,
+			&i.Year,
+			&i.TvdbID,
+			&i.TmdbID,
+			&i.ImdbID,
+			&i.Overview,
+			&i.Runtime,
+			&i.Path,
+			&i.RootFolderID,
+			&i.QualityProfileID,
+			&i.Monitored,
+			&i.SeasonFolder,
+			&i.ProductionStatus,
+			&i.Network,
+			&i.FormatType,
+			&i.AddedAt,
+			&i.UpdatedAt,
+			&i.NetworkLogoUrl,
+			&i.AddedBy,
+			&i.LanguageProfileID,
+			&i.MinimumAvailability,
 		); err != nil {
 			return nil, err
 		}
@@ -2193,7 +2852,7 @@ func (q *Queries) ListSeries(ctx context.Context) ([]*Series, error) {
 }
 
 const listSeriesByRootFolder = `-- name: ListSeriesByRootFolder :many
-SELECT id, title, sort_title, year, tvdb_id, tmdb_id, imdb_id, overview, runtime, path, root_folder_id, quality_profile_id, monitored, season_folder, production_status, network, format_type, added_at, updated_at, network_logo_url, added_by FROM series WHERE root_folder_id = ? ORDER BY sort_title
+SELECT id, title, sort_title, year, tvdb_id, tmdb_id, imdb_id, overview, runtime, path, root_folder_id, quality_profile_id, monitored, season_folder, production_status, network, format_type, added_at, updated_at, network_logo_url, added_by, language_profile_id, minimum_availability FROM series WHERE root_folder_id = ? ORDER BY sort_title
 `
 
 func (q *Queries) ListSeriesByRootFolder(ctx context.Context, rootFolderID sql.NullInt64) ([]*Series, error) {
@@ -2227,6 +2886,189 @@ func (q *Queries) ListSeriesByRootFolder(ctx context.Context, rootFolderID sql.N
 			&i.UpdatedAt,
 			&i.NetworkLogoUrl,
 			&i.AddedBy,
+			&i.LanguageProfileID,
+			&i.MinimumAvailability,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listSeriesCompletenessEpisodes = `-- name: ListSeriesCompletenessEpisodes :many
+SELECT
+    e.id, e.season_number, e.episode_number, e.title, e.air_date, e.monitored, e.status,
+    ef.id AS file_id, ef.quality, ef.quality_id, ef.resolution, ef.size
+FROM episodes e
+LEFT JOIN episode_files ef ON ef.id = (
+    SELECT ef2.id FROM episode_files ef2
+    WHERE ef2.episode_id = e.id
+    ORDER BY COALESCE(ef2.quality_id, 0) DESC, ef2.id DESC
+    LIMIT 1
+)
+WHERE e.series_id = ?
+ORDER BY e.season_number, e.episode_number
+`
+
+type ListSeriesCompletenessEpisodesRow struct {
+	ID            int64          `json:"id"`
+	SeasonNumber  int64          `json:"season_number"`
+	EpisodeNumber int64          `json:"episode_number"`
+	Title         sql.NullString `json:"title"`
+	AirDate       sql.NullTime   `json:"air_date"`
+	Monitored     bool           `json:"monitored"`
+	Status        string         `json:"status"`
+	FileID        sql.NullInt64  `json:"file_id"`
+	Quality       sql.NullString `json:"quality"`
+	QualityID     sql.NullInt64  `json:"quality_id"`
+	Resolution    sql.NullString `json:"resolution"`
+	Size          sql.NullInt64  `json:"size"`
+}
+
+func (q *Queries) ListSeriesCompletenessEpisodes(ctx context.Context, seriesID int64) ([]*ListSeriesCompletenessEpisodesRow, error) {
+	rows, err := q.db.QueryContext(ctx, listSeriesCompletenessEpisodes, seriesID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []*ListSeriesCompletenessEpisodesRow{}
+	for rows.Next() {
+		var i ListSeriesCompletenessEpisodesRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.SeasonNumber,
+			&i.EpisodeNumber,
+			&i.Title,
+			&i.AirDate,
+			&i.Monitored,
+			&i.Status,
+			&i.FileID,
+			&i.Quality,
+			&i.QualityID,
+			&i.Resolution,
+			&i.Size,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listSeriesFiltered = `-- name: ListSeriesFiltered :many
+WITH o AS (
+    SELECT CAST(?3 AS TEXT) AS search_term,
+           CAST(?4 AS TEXT) AS production_status,
+           CAST(?5 AS BOOLEAN) AS monitored,
+           CAST(?6 AS INTEGER) AS root_folder_id,
+           CAST(?7 AS INTEGER) AS quality_profile_id,
+           CAST(?8 AS BOOLEAN) AS missing,
+           CAST(?9 AS INTEGER) AS tag_id,
+           CAST(?10 AS TEXT) AS sort_key,
+           CAST(?11 AS BOOLEAN) AS descending
+)
+SELECT s.id, s.title, s.sort_title, s.year, s.tvdb_id, s.tmdb_id, s.imdb_id, s.overview, s.runtime, s.path, s.root_folder_id, s.quality_profile_id, s.monitored, s.season_folder, s.production_status, s.network, s.format_type, s.added_at, s.updated_at, s.network_logo_url, s.added_by, s.language_profile_id, s.minimum_availability FROM series s, o
+WHERE (o.search_term = ''
+       OR s.title LIKE '%' || o.search_term || '%' OR s.sort_title LIKE '%' || o.search_term || '%'
+       OR REPLACE(s.title, '''', '') LIKE '%' || o.search_term || '%'
+       OR REPLACE(s.sort_title, '''', '') LIKE '%' || o.search_term || '%')
+  AND (o.monitored IS NULL OR s.monitored = o.monitored)
+  AND (o.root_folder_id IS NULL OR s.root_folder_id = o.root_folder_id)
+  AND (o.quality_profile_id IS NULL OR s.quality_profile_id = o.quality_profile_id)
+  AND (o.production_status = '' OR lower(s.production_status) = lower(o.production_status))
+  AND (o.missing IS NULL OR o.missing = EXISTS (
+       SELECT 1 FROM episodes e
+       WHERE e.series_id = s.id AND e.season_number > 0 AND e.status = 'missing'))
+  AND (o.tag_id IS NULL OR EXISTS (
+       SELECT 1 FROM entity_tags et
+       WHERE et.entity_type = 'series' AND et.entity_id = s.id AND et.tag_id = o.tag_id))
+ORDER BY
+    CASE WHEN o.sort_key = 'year' AND NOT o.descending THEN s.year END ASC,
+    CASE WHEN o.sort_key = 'year' AND o.descending THEN s.year END DESC,
+    CASE WHEN o.sort_key = 'added' AND NOT o.descending THEN s.added_at END ASC,
+    CASE WHEN o.sort_key = 'added' AND o.descending THEN s.added_at END DESC,
+    CASE WHEN o.sort_key = 'network' AND NOT o.descending THEN s.network END ASC,
+    CASE WHEN o.sort_key = 'network' AND o.descending THEN s.network END DESC,
+    CASE WHEN o.sort_key = 'title' AND o.descending THEN s.sort_title END DESC,
+    s.sort_title, s.id
+LIMIT ?2 OFFSET ?1
+`
+
+type ListSeriesFilteredParams struct {
+	Off              int64         `json:"off"`
+	Lim              int64         `json:"lim"`
+	SearchTerm       string        `json:"search_term"`
+	ProductionStatus string        `json:"production_status"`
+	Monitored        sql.NullBool  `json:"monitored"`
+	RootFolderID     sql.NullInt64 `json:"root_folder_id"`
+	QualityProfileID sql.NullInt64 `json:"quality_profile_id"`
+	Missing          sql.NullBool  `json:"missing"`
+	TagID            sql.NullInt64 `json:"tag_id"`
+	SortKey          string        `json:"sort_key"`
+	Descending       bool          `json:"descending"`
+}
+
+// Filters, sorts and pages the library in SQL. Empty or NULL arguments
+// disable their filter; a limit of -1 returns every match. The options CTE
+// binds the arguments once so the WHERE and ORDER BY can refer to them.
+func (q *Queries) ListSeriesFiltered(ctx context.Context, arg ListSeriesFilteredParams) ([]*Series, error) {
+	rows, err := q.db.QueryContext(ctx, listSeriesFiltered,
+		arg.Off,
+		arg.Lim,
+		arg.SearchTerm,
+		arg.ProductionStatus,
+		arg.Monitored,
+		arg.RootFolderID,
+		arg.QualityProfileID,
+		arg.Missing,
+		arg.TagID,
+		arg.SortKey,
+		arg.Descending,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []*Series{}
+	for rows.Next() {
+		var i Series
+		if err := rows.Scan(
+			&i.ID,
+			&i.Title,
+			&i.SortTitle,
+			&i.Year,
+			&i.TvdbID,
+			&i.TmdbID,
+			&i.ImdbID,
+			&i.Overview,
+			&i.Runtime,
+			&i.Path,
+			&i.RootFolderID,
+			&i.QualityProfileID,
+			&i.Monitored,
+			&i.SeasonFolder,
+			&i.ProductionStatus,
+			&i.Network,
+			&i.FormatType,
+			&i.AddedAt,
+			&i.UpdatedAt,
+			&i.NetworkLogoUrl,
+			&i.AddedBy,
+			&i.LanguageProfileID,
+			&i.MinimumAvailability,
 		); err != nil {
 			return nil, err
 		}
@@ -2242,7 +3084,7 @@ func (q *Queries) ListSeriesByRootFolder(ctx context.Context, rootFolderID sql.N
 }
 
 const listSeriesPaginated = `-- name: ListSeriesPaginated :many
-SELECT id, title, sort_title, year, tvdb_id, tmdb_id, imdb_id, overview, runtime, path, root_folder_id, quality_profile_id, monitored, season_folder, production_status, network, format_type, added_at, updated_at, network_logo_url, added_by FROM series
+SELECT id, title, sort_title, year, tvdb_id, tmdb_id, imdb_id, overview, runtime, path, root_folder_id, quality_profile_id, monitored, season_folder, production_status, network, format_type, added_at, updated_at, network_logo_url, added_by, language_profile_id, minimum_availability FROM series
 ORDER BY sort_title
 LIMIT ? OFFSET ?
 `
@@ -2283,6 +3125,8 @@ func (q *Queries) ListSeriesPaginated(ctx context.Context, arg ListSeriesPaginat
 			&i.UpdatedAt,
 			&i.NetworkLogoUrl,
 			&i.AddedBy,
+			&i.LanguageProfileID,
+			&i.MinimumAvailability,
 		); err != nil {
 			return nil, err
 		}
@@ -2298,7 +3142,7 @@ func (q *Queries) ListSeriesPaginated(ctx context.Context, arg ListSeriesPaginat
 }
 
 const listSeriesWithMissingEpisodes = `-- name: ListSeriesWithMissingEpisodes :many
-SELECT DISTINCT s.id, s.title, s.sort_title, s.year, s.tvdb_id, s.tmdb_id, s.imdb_id, s.overview, s.runtime, s.path, s.root_folder_id, s.quality_profile_id, s.monitored, s.season_folder, s.production_status, s.network, s.format_type, s.added_at, s.updated_at, s.network_logo_url, s.added_by FROM series s
+SELECT DISTINCT s.id, s.title, s.sort_title, s.year, s.tvdb_id, s.tmdb_id, s.imdb_id, s.overview, s.runtime, s.path, s.root_folder_id, s.quality_profile_id, s.monitored, s.season_folder, s.production_status, s.network, s.format_type, s.added_at, s.updated_at, s.network_logo_url, s.added_by, s.language_profile_id, s.minimum_availability FROM series s
 JOIN episodes e ON s.id = e.series_id
 JOIN seasons sea ON e.series_id = sea.series_id AND e.season_number = sea.season_number
 WHERE e.status IN ('missing', 'failed')
@@ -2339,6 +3183,8 @@ func (q *Queries) ListSeriesWithMissingEpisodes(ctx context.Context) ([]*Series,
 			&i.UpdatedAt,
 			&i.NetworkLogoUrl,
 			&i.AddedBy,
+			&i.LanguageProfileID,
+			&i.MinimumAvailability,
 		); err != nil {
 			return nil, err
 		}
@@ -2354,7 +3200,7 @@ func (q *Queries) ListSeriesWithMissingEpisodes(ctx context.Context) ([]*Series,
 }
 
 const listUnmatchedSeriesByRootFolder = `-- name: ListUnmatchedSeriesByRootFolder :many
-SELECT id, title, sort_title, year, tvdb_id, tmdb_id, imdb_id, overview, runtime, path, root_folder_id, quality_profile_id, monitored, season_folder, production_status, network, format_type, added_at, updated_at, network_logo_url, added_by FROM series
+SELECT id, title, sort_title, year, tvdb_id, tmdb_id, imdb_id, overview, runtime, path, root_folder_id, quality_profile_id, monitored, season_folder, production_status, network, format_type, added_at, updated_at, network_logo_url, added_by, language_profile_id, minimum_availability FROM series
 WHERE root_folder_id = ?
   AND (tvdb_id IS NULL OR tvdb_id = 0)
   AND (tmdb_id IS NULL OR tmdb_id = 0)
@@ -2392,6 +3238,8 @@ func (q *Queries) ListUnmatchedSeriesByRootFolder(ctx context.Context, rootFolde
 			&i.UpdatedAt,
 			&i.NetworkLogoUrl,
 			&i.AddedBy,
+			&i.LanguageProfileID,
+			&i.MinimumAvailability,
 		); err != nil {
 			return nil, err
 		}
@@ -2407,10 +3255,12 @@ func (q *Queries) ListUnmatchedSeriesByRootFolder(ctx context.Context, rootFolde
 }
 
 const listUpgradableEpisodesWithQuality = `-- name: ListUpgradableEpisodesWithQuality :many
-SELECT e.id, e.series_id, e.season_number, e.episode_number, e.title, e.overview, e.air_date, e.monitored, e.status, e.active_download_id, e.status_message, s.title as series_title, s.tvdb_id as series_tvdb_id,
+SELECT e.id, e.series_id, e.season_number, e.episode_number, e.title, e.overview, e.air_date, e.monitored, e.status, e.active_download_id, e.status_message, e.still_url, s.title as series_title, s.tvdb_id as series_tvdb_id,
        s.tmdb_id as series_tmdb_id, s.imdb_id as series_imdb_id,
-       s.year as series_year, s.quality_profile_id as series_quality_profile_id,
-       ef.quality_id as current_quality_id
+       s.year as series_year, s.runtime as series_runtime,
+       s.quality_profile_id as series_quality_profile_id,
+       ef.quality_id as current_quality_id,
+       ef.path as current_file_path, ef.original_filename as current_original_filename
 FROM episodes e
 JOIN series s ON e.series_id = s.id
 JOIN seasons sea ON e.series_id = sea.series_id AND e.season_number = sea.season_number
@@ -2422,24 +3272,28 @@ ORDER BY e.air_date DESC
 `
 
 type ListUpgradableEpisodesWithQualityRow struct {
-	ID                     int64          `json:"id"`
-	SeriesID               int64          `json:"series_id"`
-	SeasonNumber           int64          `json:"season_number"`
-	EpisodeNumber          int64          `json:"episode_number"`
-	Title                  sql.NullString `json:"title"`
-	Overview               sql.NullString `json:"overview"`
-	AirDate                sql.NullTime   `json:"air_date"`
-	Monitored              bool           `json:"monitored"`
-	Status                 string         `json:"status"`
-	ActiveDownloadID       sql.NullString `json:"active_download_id"`
-	StatusMessage          sql.NullString `json:"status_message"`
-	SeriesTitle            string         `json:"series_title"`
-	SeriesTvdbID           sql.NullInt64  `json:"series_tvdb_id"`
-	SeriesTmdbID           sql.NullInt64  `json:"series_tmdb_id"`
-	SeriesImdbID           sql.NullString `json:"series_imdb_id"`
-	SeriesYear             sql.NullInt64  `json:"series_year"`
-	SeriesQualityProfileID sql.NullInt64  `json:"series_quality_profile_id"`
-	CurrentQualityID       sql.NullInt64  `json:"current_quality_id"`
+	ID                      int64          `json:"id"`
+	SeriesID                int64          `json:"series_id"`
+	SeasonNumber            int64          `json:"season_number"`
+	EpisodeNumber           int64          `json:"episode_number"`
+	Title                   sql.NullString `json:"title"`
+	Overview                sql.NullString `json:"overview"`
+	AirDate                 sql.NullTime   `json:"air_date"`
+	Monitored               bool           `json:"monitored"`
+	Status                  string         `json:"status"`
+	ActiveDownloadID        sql.NullString `json:"active_download_id"`
+	StatusMessage           sql.NullString `json:"status_message"`
+	StillUrl                sql.NullString `json:"still_url"`
+	SeriesTitle             string         `json:"series_title"`
+	SeriesTvdbID            sql.NullInt64  `json:"series_tvdb_id"`
+	SeriesTmdbID            sql.NullInt64  `json:"series_tmdb_id"`
+	SeriesImdbID            sql.NullString `json:"series_imdb_id"`
+	SeriesYear              sql.NullInt64  `json:"series_year"`
+	SeriesRuntime           sql.NullInt64  `json:"series_runtime"`
+	SeriesQualityProfileID  sql.NullInt64  `json:"series_quality_profile_id"`
+	CurrentQualityID        sql.NullInt64  `json:"current_quality_id"`
+	CurrentFilePath         string         `json:"current_file_path"`
+	CurrentOriginalFilename sql.NullString `json:"current_original_filename"`
 }
 
 // Upgradable episodes with current file quality
@@ -2464,13 +3318,17 @@ func (q *Queries) ListUpgradableEpisodesWithQuality(ctx context.Context) ([]*Lis
 			&i.Status,
 			&i.ActiveDownloadID,
 			&i.StatusMessage,
+			&i.StillUrl,
 			&i.SeriesTitle,
 			&i.SeriesTvdbID,
 			&i.SeriesTmdbID,
 			&i.SeriesImdbID,
 			&i.SeriesYear,
+			&i.SeriesRuntime,
 			&i.SeriesQualityProfileID,
 			&i.CurrentQualityID,
+			&i.CurrentFilePath,
+			&i.CurrentOriginalFilename,
 		); err != nil {
 			return nil, err
 		}
@@ -2514,7 +3372,7 @@ func (q *Queries) MonitorSeasonsWithFilesBySeries(ctx context.Context, seriesID
 }
 
 const searchSeries = `-- name: SearchSeries :many
-SELECT id, title, sort_title, year, tvdb_id, tmdb_id, imdb_id, overview, runtime, path, root_folder_id, quality_profile_id, monitored, season_folder, production_status, network, format_type, added_at, updated_at, network_logo_url, added_by FROM series
+SELECT id, title, sort_title, year, tvdb_id, tmdb_id, imdb_id, overview, runtime, path, root_folder_id, quality_profile_id, monitored, season_folder, production_status, network, format_type, added_at, updated_at, network_logo_url, added_by, language_profile_id, minimum_availability FROM series
 WHERE title LIKE ?1 OR sort_title LIKE ?1
    OR REPLACE(title, '''', '') LIKE ?1
    OR REPLACE(sort_title, '''', '') LIKE ?1
@@ -2559,6 +3417,8 @@ func (q *Queries) SearchSeries(ctx context.Context, arg SearchSeriesParams) ([]*
 			&i.UpdatedAt,
 			&i.NetworkLogoUrl,
 			&i.AddedBy,
+			&i.LanguageProfileID,
+			&i.MinimumAvailability,
 		); err != nil {
 			return nil, err
 		}
@@ -2620,7 +3480,7 @@ UPDATE episodes SET
     air_date = ?,
     monitored = ?
 WHERE id = ?
-RETURNING id, series_id, season_number, episode_number, title, overview, air_date, monitored, status, active_download_id, status_message
+RETURNING id, series_id, season_number, episode_number, title, overview, air_date, monitored, status, active_download_id, status_message, still_url
 `
 
 type UpdateEpisodeParams struct {
@@ -2652,17 +3512,52 @@ func (q *Queries) UpdateEpisode(ctx context.Context, arg UpdateEpisodeParams) (*
 		&i.Status,
 		&i.ActiveDownloadID,
 		&i.StatusMessage,
+		&i.StillUrl,
 	)
 	return &i, err
 }
 
+const updateEpisodeFileDiskInfo = `-- name: UpdateEpisodeFileDiskInfo :exec
+UPDATE episode_files SET
+    size = ?,
+    video_codec = ?,
+    audio_codec = ?,
+    resolution = ?,
+    audio_channels = ?,
+    dynamic_range = ?
+WHERE id = ?
+`
+
+type UpdateEpisodeFileDiskInfoParams struct {
+	Size          int64          `json:"size"`
+	VideoCodec    sql.NullString `json:"video_codec"`
+	AudioCodec    sql.NullString `json:"audio_codec"`
+	Resolution    sql.NullString `json:"resolution"`
+	AudioChannels sql.NullString `json:"audio_channels"`
+	DynamicRange  sql.NullString `json:"dynamic_range"`
+	ID            int64          `json:"id"`
+}
+
+func (q *Queries) UpdateEpisodeFileDiskInfo(ctx context.Context, arg UpdateEpisodeFileDiskInfoParams) error {
+	_, err := q.db.ExecContext(ctx, updateEpisodeFileDiskInfo,
+		arg.Size,
+		arg.VideoCodec,
+		arg.AudioCodec,
+		arg.Resolution,
+		arg.AudioChannels,
+		arg.DynamicRange,
+		arg.ID,
+	)
+	return err
+}
+
 const updateEpisodeFileImportInfo = `-- name: UpdateEpisodeFileImportInfo :one
 UPDATE episode_files SET
     original_path = ?,
     original_filename = ?,
     imported_at = ?
 WHERE id = ?
-RETURNING id, episode_id, path, size, quality, video_codec, audio_codec, resolution, created_at, quality_id, original_path, original_filename, imported_at, slot_id, audio_channels, dynamic_range
+RETURNING id, episode_id, path, size, quality, video_codec, audio_codec, resolution, created_at, quality_id, original_path, original_filename, imported_at, slot_id, audio_channels, dynamic_range, primary_file_id, dolby_vision_profile, audio_tracks
 `
 
 type UpdateEpisodeFileImportInfoParams struct {
@@ -2697,6 +3592,9 @@ func (q *Queries) UpdateEpisodeFileImportInfo(ctx context.Context, arg UpdateEpi
 		&i.SlotID,
 		&i.AudioChannels,
 		&i.DynamicRange,
+		&i.PrimaryFileID,
+		&i.DolbyVisionProfile,
+		&i.AudioTracks,
 	)
 	return &i, err
 }
@@ -2705,15 +3603,23 @@ const updateEpisodeFileMediaInfo = `-- name: UpdateEpisodeFileMediaInfo :exec
 UPDATE episode_files SET
     video_codec = ?,
     audio_codec = ?,
-    resolution = ?
+    resolution = ?,
+    audio_channels = ?,
+    dynamic_range = ?,
+    dolby_vision_profile = ?,
+    audio_tracks = ?
 WHERE episode_id = ?
 `
 
 type UpdateEpisodeFileMediaInfoParams struct {
-	VideoCodec sql.NullString `json:"video_codec"`
-	AudioCodec sql.NullString `json:"audio_codec"`
-	Resolution sql.NullString `json:"resolution"`
-	EpisodeID  int64          `json:"episode_id"`
+	VideoCodec         sql.NullString `json:"video_codec"`
+	AudioCodec         sql.NullString `json:"audio_codec"`
+	Resolution         sql.NullString `json:"resolution"`
+	AudioChannels      sql.NullString `json:"audio_channels"`
+	DynamicRange       sql.NullString `json:"dynamic_range"`
+	DolbyVisionProfile string         `json:"dolby_vision_profile"`
+	AudioTracks        string         `json:"audio_tracks"`
+	EpisodeID          int64          `json:"episode_id"`
 }
 
 func (q *Queries) UpdateEpisodeFileMediaInfo(ctx context.Context, arg UpdateEpisodeFileMediaInfoParams) error {
@@ -2721,6 +3627,10 @@ func (q *Queries) UpdateEpisodeFileMediaInfo(ctx context.Context, arg UpdateEpis
 		arg.VideoCodec,
 		arg.AudioCodec,
 		arg.Resolution,
+		arg.AudioChannels,
+		arg.DynamicRange,
+		arg.DolbyVisionProfile,
+		arg.AudioTracks,
 		arg.EpisodeID,
 	)
 	return err
@@ -2918,6 +3828,57 @@ func (q *Queries) UpdateFutureSeasonsMonitored(ctx context.Context, arg UpdateFu
 	return err
 }
 
+const updateLinkedEpisodeFilesMediaInfo = `-- name: UpdateLinkedEpisodeFilesMediaInfo :exec
+UPDATE episode_files SET
+    video_codec = ?,
+    audio_codec = ?,
+    resolution = ?,
+    audio_channels = ?,
+    dynamic_range = ?,
+    dolby_vision_profile = ?,
+    audio_tracks = ?
+WHERE primary_file_id IN (SELECT ef.id FROM episode_files ef WHERE ef.episode_id = ?)
+`
+
+type UpdateLinkedEpisodeFilesMediaInfoParams struct {
+	VideoCodec         sql.NullString `json:"video_codec"`
+	AudioCodec         sql.NullString `json:"audio_codec"`
+	Resolution         sql.NullString `json:"resolution"`
+	AudioChannels      sql.NullString `json:"audio_channels"`
+	DynamicRange       sql.NullString `json:"dynamic_range"`
+	DolbyVisionProfile string         `json:"dolby_vision_profile"`
+	AudioTracks        string         `json:"audio_tracks"`
+	EpisodeID          int64          `json:"episode_id"`
+}
+
+func (q *Queries) UpdateLinkedEpisodeFilesMediaInfo(ctx context.Context, arg UpdateLinkedEpisodeFilesMediaInfoParams) error {
+	_, err := q.db.ExecContext(ctx, updateLinkedEpisodeFilesMediaInfo,
+		arg.VideoCodec,
+		arg.AudioCodec,
+		arg.Resolution,
+		arg.AudioChannels,
+		arg.DynamicRange,
+		arg.DolbyVisionProfile,
+		arg.AudioTracks,
+		arg.EpisodeID,
+	)
+	return err
+}
+
+const updateLinkedEpisodeFilesPath = `-- name: UpdateLinkedEpisodeFilesPath :exec
+UPDATE episode_files SET path = ? WHERE primary_file_id = ?
+`
+
+type UpdateLinkedEpisodeFilesPathParams struct {
+	Path          string        `json:"path"`
+	PrimaryFileID sql.NullInt64 `json:"primary_file_id"`
+}
+
+func (q *Queries) UpdateLinkedEpisodeFilesPath(ctx context.Context, arg UpdateLinkedEpisodeFilesPathParams) error {
+	_, err := q.db.ExecContext(ctx, updateLinkedEpisodeFilesPath, arg.Path, arg.PrimaryFileID)
+	return err
+}
+
 const updateSeason = `-- name: UpdateSeason :one
 UPDATE seasons SET monitored = ? WHERE id = ? RETURNING id, series_id, season_number, monitored, overview, poster_url
 `
@@ -3077,6 +4038,8 @@ UPDATE series SET
     path = ?,
     root_folder_id = ?,
     quality_profile_id = ?,
+    language_profile_id = ?,
+    minimum_availability = ?,
     monitored = ?,
     season_folder = ?,
     production_status = ?,
@@ -3085,28 +4048,30 @@ UPDATE series SET
     network_logo_url = ?,
     updated_at = CURRENT_TIMESTAMP
 WHERE id = ?
-RETURNING id, title, sort_title, year, tvdb_id, tmdb_id, imdb_id, overview, runtime, path, root_folder_id, quality_profile_id, monitored, season_folder, production_status, network, format_type, added_at, updated_at, network_logo_url, added_by
+RETURNING id, title, sort_title, year, tvdb_id, tmdb_id, imdb_id, overview, runtime, path, root_folder_id, quality_profile_id, monitored, season_folder, production_status, network, format_type, added_at, updated_at, network_logo_url, added_by, language_profile_id, minimum_availability
 `
 
 type UpdateSeriesParams struct {
-	Title            string         `json:"title"`
-	SortTitle        string         `json:"sort_title"`
-	Year             sql.NullInt64  `json:"year"`
-	TvdbID           sql.NullInt64  `json:"tvdb_id"`
-	TmdbID           sql.NullInt64  `json:"tmdb_id"`
-	ImdbID           sql.NullString `json:"imdb_id"`
-	Overview         sql.NullString `json:"overview"`
-	Runtime          sql.NullInt64  `json:"runtime"`
-	Path             sql.NullString `json:"path"`
-	RootFolderID     sql.NullInt64  `json:"root_folder_id"`
-	QualityProfileID sql.NullInt64  `json:"quality_profile_id"`
-	Monitored        bool           `json:"monitored"`
-	SeasonFolder     bool           `json:"season_folder"`
-	ProductionStatus string         `json:"production_status"`
-	Network          sql.NullString `json:"network"`
-	FormatType       sql.NullString `json:"format_type"`
-	NetworkLogoUrl   sql.NullString `json:"network_logo_url"`
-	ID               int64          `json:"id"`
+	Title               string         `json:"title"`
+	SortTitle           string         `json:"sort_title"`
+	Year                sql.NullInt64  `json:"year"`
+	TvdbID              sql.NullInt64  `json:"tvdb_id"`
+	TmdbID              sql.NullInt64  `json:"tmdb_id"`
+	ImdbID              sql.NullString `json:"imdb_id"`
+	Overview            sql.NullString `json:"overview"`
+	Runtime             sql.NullInt64  `json:"runtime"`
+	Path                sql.NullString `json:"path"`
+	RootFolderID        sql.NullInt64  `json:"root_folder_id"`
+	QualityProfileID    sql.NullInt64  `json:"quality_profile_id"`
+	LanguageProfileID   sql.NullInt64  `json:"language_profile_id"`
+	MinimumAvailability sql.NullString `json:"minimum_availability"`
+	Monitored           bool           `json:"monitored"`
+	SeasonFolder        bool           `json:"season_folder"`
+	ProductionStatus    string         `json:"production_status"`
+	Network             sql.NullString `json:"network"`
+	FormatType          sql.NullString `json:"format_type"`
+	NetworkLogoUrl      sql.NullString `json:"network_logo_url"`
+	ID                  int64          `json:"id"`
 }
 
 func (q *Queries) UpdateSeries(ctx context.Context, arg UpdateSeriesParams) (*Series, error) {
@@ -3122,6 +4087,8 @@ func (q *Queries) UpdateSeries(ctx context.Context, arg UpdateSeriesParams) (*Se
 		arg.Path,
 		arg.RootFolderID,
 		arg.QualityProfileID,
+		arg.LanguageProfileID,
+		arg.MinimumAvailability,
 		arg.Monitored,
 		arg.SeasonFolder,
 		arg.ProductionStatus,
@@ -3153,12 +4120,29 @@ func (q *Queries) UpdateSeries(ctx context.Context, arg UpdateSeriesParams) (*Se
 		&i.UpdatedAt,
 		&i.NetworkLogoUrl,
 		&i.AddedBy,
+		&i.LanguageProfileID,
+		&i.MinimumAvailability,
 	)
 	return &i, err
 }
 
+const updateSeriesFolder = `-- name: UpdateSeriesFolder :exec
+UPDATE series SET path = ?, root_folder_id = ?, updated_at = CURRENT_TIMESTAMP WHERE id = ?
+`
+
+type UpdateSeriesFolderParams struct {
+	Path         sql.NullString `json:"path"`
+	RootFolderID sql.NullInt64  `json:"root_folder_id"`
+	ID           int64          `json:"id"`
+}
+
+func (q *Queries) UpdateSeriesFolder(ctx context.Context, arg UpdateSeriesFolderParams) error {
+	_, err := q.db.ExecContext(ctx, updateSeriesFolder, arg.Path, arg.RootFolderID, arg.ID)
+	return err
+}
+
 const updateSeriesFormatType = `-- name: UpdateSeriesFormatType :one
-UPDATE series SET format_type = ?, updated_at = CURRENT_TIMESTAMP WHERE id = ? RETURNING id, title, sort_title, year, tvdb_id, tmdb_id, imdb_id, overview, runtime, path, root_folder_id, quality_profile_id, monitored, season_folder, production_status, network, format_type, added_at, updated_at, network_logo_url, added_by
+UPDATE series SET format_type = ?, updated_at = CURRENT_TIMESTAMP WHERE id = ? RETURNING id, title, sort_title, year, tvdb_id, tmdb_id, imdb_id, overview, runtime, path, root_folder_id, quality_profile_id, monitored, season_folder, production_status, network, format_type, added_at, updated_at, network_logo_url, added_by, language_profile_id, minimum_availability
 `
 
 type UpdateSeriesFormatTypeParams struct {
@@ -3192,6 +4176,8 @@ func (q *Queries) UpdateSeriesFormatType(ctx context.Context, arg UpdateSeriesFo
 		&i.UpdatedAt,
 		&i.NetworkLogoUrl,
 		&i.AddedBy,
+		&i.LanguageProfileID,
+		&i.MinimumAvailability,
 	)
 	return &i, err
 }
@@ -3275,13 +4261,14 @@ func (q *Queries) UpdateUnreleasedEpisodesToMissingDateOnly(ctx context.Context)
 }
 
 const upsertEpisode = `-- name: UpsertEpisode :one
-INSERT INTO episodes (series_id, season_number, episode_number, title, overview, air_date, monitored, status)
-VALUES (?, ?, ?, ?, ?, ?, ?, ?)
+INSERT INTO episodes (series_id, season_number, episode_number, title, overview, air_date, monitored, status, still_url)
+VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)
 ON CONFLICT(series_id, season_number, episode_number) DO UPDATE SET
     title = COALESCE(excluded.title, episodes.title),
     overview = COALESCE(excluded.overview, episodes.overview),
-    air_date = COALESCE(excluded.air_date, episodes.air_date)
-RETURNING id, series_id, season_number, episode_number, title, overview, air_date, monitored, status, active_download_id, status_message
+    air_date = COALESCE(excluded.air_date, episodes.air_date),
+    still_url = COALESCE(excluded.still_url, episodes.still_url)
+RETURNING id, series_id, season_number, episode_number, title, overview, air_date, monitored, status, active_download_id, status_message, still_url
 `
 
 type UpsertEpisodeParams struct {
@@ -3293,6 +4280,7 @@ type UpsertEpisodeParams struct {
 	AirDate       sql.NullTime   `json:"air_date"`
 	Monitored     bool           `json:"monitored"`
 	Status        string         `json:"status"`
+	StillUrl      sql.NullString `json:"still_url"`
 }
 
 func (q *Queries) UpsertEpisode(ctx context.Context, arg UpsertEpisodeParams) (*Episode, error) {
@@ -3305,6 +4293,7 @@ func (q *Queries) UpsertEpisode(ctx context.Context, arg UpsertEpisodeParams) (*
 		arg.AirDate,
 		arg.Monitored,
 		arg.Status,
+		arg.StillUrl,
 	)
 	var i Episode
 	err := row.Scan(
@@ -3319,6 +4308,7 @@ func (q *Queries) UpsertEpisode(ctx context.Context, arg UpsertEpisodeParams) (*
 		&i.Status,
 		&i.ActiveDownloadID,
 		&i.StatusMessage,
+		&i.StillUrl,
 	)
 	return &i, err
 }
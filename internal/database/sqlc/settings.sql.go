@@ -44,34 +44,30 @@ func (q *Queries) CountHistoryByMediaType(ctx context.Context, entityType string
 }
 
 const countHistoryFiltered = `-- name: CountHistoryFiltered :one
-SELECT COUNT(*) FROM history
-WHERE (? = '' OR instr(',' || ? || ',', ',' || event_type || ',') > 0)
-  AND (? = '' OR entity_type = ?)
-  AND (? = '' OR created_at >= ?)
-  AND (? = '' OR created_at <= ?)
+WITH o AS (
+    SELECT CAST(?3 AS TEXT) AS event_types,
+           CAST(?4 AS TEXT) AS entity_type
+)
+SELECT COUNT(*) FROM history h, o
+WHERE (o.event_types = '' OR instr(',' || o.event_types || ',', ',' || h.event_type || ',') > 0)
+  AND (o.entity_type = '' OR h.entity_type = o.entity_type)
+  AND (h.created_at >= ?1 OR ?1 IS NULL)
+  AND (h.created_at <= ?2 OR ?2 IS NULL)
 `
 
 type CountHistoryFilteredParams struct {
-	Column1     interface{}    `json:"column_1"`
-	Column2     sql.NullString `json:"column_2"`
-	Column3     interface{}    `json:"column_3"`
-	EntityType  string         `json:"entity_type"`
-	Column5     interface{}    `json:"column_5"`
-	CreatedAt   sql.NullTime   `json:"created_at"`
-	Column7     interface{}    `json:"column_7"`
-	CreatedAt_2 sql.NullTime   `json:"created_at_2"`
+	CreatedAfter  sql.NullTime `json:"created_after"`
+	CreatedBefore sql.NullTime `json:"created_before"`
+	EventTypes    string       `json:"event_types"`
+	EntityType    string       `json:"entity_type"`
 }
 
 func (q *Queries) CountHistoryFiltered(ctx context.Context, arg CountHistoryFilteredParams) (int64, error) {
 	row := q.db.QueryRowContext(ctx, countHistoryFiltered,
-		arg.Column1,
-		arg.Column2,
-		arg.Column3,
+		arg.CreatedAfter,
+		arg.CreatedBefore,
+		arg.EventTypes,
 		arg.EntityType,
-		arg.Column5,
-		arg.CreatedAt,
-		arg.Column7,
-		arg.CreatedAt_2,
 	)
 	var count int64
 	err := row.Scan(&count)
@@ -211,9 +207,10 @@ const createQualityProfile = `-- name: CreateQualityProfile :one
 INSERT INTO quality_profiles (
     name, module_type, cutoff, items, hdr_settings, video_codec_settings,
     audio_codec_settings, audio_channel_settings, upgrades_enabled,
-    allow_auto_approve, upgrade_strategy, cutoff_overrides_strategy
-) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
-RETURNING id, name, module_type, cutoff, items, hdr_settings, video_codec_settings, audio_codec_settings, audio_channel_settings, upgrades_enabled, allow_auto_approve, upgrade_strategy, cutoff_overrides_strategy, created_at, updated_at
+    allow_auto_approve, upgrade_strategy, cutoff_overrides_strategy, release_groups,
+    indexer_flags
+) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+RETURNING id, name, module_type, cutoff, items, hdr_settings, video_codec_settings, audio_codec_settings, audio_channel_settings, upgrades_enabled, allow_auto_approve, upgrade_strategy, cutoff_overrides_strategy, created_at, updated_at, release_groups, indexer_flags
 `
 
 type CreateQualityProfileParams struct {
@@ -229,6 +226,8 @@ type CreateQualityProfileParams struct {
 	AllowAutoApprove        bool           `json:"allow_auto_approve"`
 	UpgradeStrategy         string         `json:"upgrade_strategy"`
 	CutoffOverridesStrategy int64          `json:"cutoff_overrides_strategy"`
+	ReleaseGroups           string         `json:"release_groups"`
+	IndexerFlags            string         `json:"indexer_flags"`
 }
 
 func (q *Queries) CreateQualityProfile(ctx context.Context, arg CreateQualityProfileParams) (*QualityProfile, error) {
@@ -245,6 +244,8 @@ func (q *Queries) CreateQualityProfile(ctx context.Context, arg CreateQualityPro
 		arg.AllowAutoApprove,
 		arg.UpgradeStrategy,
 		arg.CutoffOverridesStrategy,
+		arg.ReleaseGroups,
+		arg.IndexerFlags,
 	)
 	var i QualityProfile
 	err := row.Scan(
@@ -263,14 +264,16 @@ func (q *Queries) CreateQualityProfile(ctx context.Context, arg CreateQualityPro
 		&i.CutoffOverridesStrategy,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.ReleaseGroups,
+		&i.IndexerFlags,
 	)
 	return &i, err
 }
 
 const createRootFolder = `-- name: CreateRootFolder :one
-INSERT INTO root_folders (path, name, module_type, free_space)
-VALUES (?, ?, ?, ?)
-RETURNING id, path, name, module_type, free_space, created_at
+INSERT INTO root_folders (path, name, module_type, free_space, total_space)
+VALUES (?, ?, ?, ?, ?)
+RETURNING id, path, name, module_type, free_space, created_at, total_space, unreachable
 `
 
 type CreateRootFolderParams struct {
@@ -278,6 +281,7 @@ type CreateRootFolderParams struct {
 	Name       string        `json:"name"`
 	ModuleType string        `json:"module_type"`
 	FreeSpace  sql.NullInt64 `json:"free_space"`
+	TotalSpace sql.NullInt64 `json:"total_space"`
 }
 
 func (q *Queries) CreateRootFolder(ctx context.Context, arg CreateRootFolderParams) (*RootFolder, error) {
@@ -286,6 +290,7 @@ func (q *Queries) CreateRootFolder(ctx context.Context, arg CreateRootFolderPara
 		arg.Name,
 		arg.ModuleType,
 		arg.FreeSpace,
+		arg.TotalSpace,
 	)
 	var i RootFolder
 	err := row.Scan(
@@ -295,6 +300,8 @@ func (q *Queries) CreateRootFolder(ctx context.Context, arg CreateRootFolderPara
 		&i.ModuleType,
 		&i.FreeSpace,
 		&i.CreatedAt,
+		&i.TotalSpace,
+		&i.Unreachable,
 	)
 	return &i, err
 }
@@ -446,9 +453,30 @@ func (q *Queries) GetDownload(ctx context.Context, id int64) (*Download, error)
 	return &i, err
 }
 
+const getHistoryEntry = `-- name: GetHistoryEntry :one
+SELECT id, event_type, module_type, entity_type, entity_id, source, quality, data, created_at FROM history WHERE id = ? LIMIT 1
+`
+
+func (q *Queries) GetHistoryEntry(ctx context.Context, id int64) (*History, error) {
+	row := q.db.QueryRowContext(ctx, getHistoryEntry, id)
+	var i History
+	err := row.Scan(
+		&i.ID,
+		&i.EventType,
+		&i.ModuleType,
+		&i.EntityType,
+		&i.EntityID,
+		&i.Source,
+		&i.Quality,
+		&i.Data,
+		&i.CreatedAt,
+	)
+	return &i, err
+}
+
 const getQualityProfile = `-- name: GetQualityProfile :one
 
-SELECT id, name, module_type, cutoff, items, hdr_settings, video_codec_settings, audio_codec_settings, audio_channel_settings, upgrades_enabled, allow_auto_approve, upgrade_strategy, cutoff_overrides_strategy, created_at, updated_at FROM quality_profiles WHERE id = ? LIMIT 1
+SELECT id, name, module_type, cutoff, items, hdr_settings, video_codec_settings, audio_codec_settings, audio_channel_settings, upgrades_enabled, allow_auto_approve, upgrade_strategy, cutoff_overrides_strategy, created_at, updated_at, release_groups, indexer_flags FROM quality_profiles WHERE id = ? LIMIT 1
 `
 
 // Quality Profiles (module-scoped after migration 071)
@@ -471,12 +499,14 @@ func (q *Queries) GetQualityProfile(ctx context.Context, id int64) (*QualityProf
 		&i.CutoffOverridesStrategy,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.ReleaseGroups,
+		&i.IndexerFlags,
 	)
 	return &i, err
 }
 
 const getQualityProfileByName = `-- name: GetQualityProfileByName :one
-SELECT id, name, module_type, cutoff, items, hdr_settings, video_codec_settings, audio_codec_settings, audio_channel_settings, upgrades_enabled, allow_auto_approve, upgrade_strategy, cutoff_overrides_strategy, created_at, updated_at FROM quality_profiles WHERE name = ? AND module_type = ? LIMIT 1
+SELECT id, name, module_type, cutoff, items, hdr_settings, video_codec_settings, audio_codec_settings, audio_channel_settings, upgrades_enabled, allow_auto_approve, upgrade_strategy, cutoff_overrides_strategy, created_at, updated_at, release_groups, indexer_flags FROM quality_profiles WHERE name = ? AND module_type = ? LIMIT 1
 `
 
 type GetQualityProfileByNameParams struct {
@@ -503,12 +533,14 @@ func (q *Queries) GetQualityProfileByName(ctx context.Context, arg GetQualityPro
 		&i.CutoffOverridesStrategy,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.ReleaseGroups,
+		&i.IndexerFlags,
 	)
 	return &i, err
 }
 
 const getRootFolder = `-- name: GetRootFolder :one
-SELECT id, path, name, module_type, free_space, created_at FROM root_folders WHERE id = ? LIMIT 1
+SELECT id, path, name, module_type, free_space, created_at, total_space, unreachable FROM root_folders WHERE id = ? LIMIT 1
 `
 
 // Root Folders
@@ -522,12 +554,14 @@ func (q *Queries) GetRootFolder(ctx context.Context, id int64) (*RootFolder, err
 		&i.ModuleType,
 		&i.FreeSpace,
 		&i.CreatedAt,
+		&i.TotalSpace,
+		&i.Unreachable,
 	)
 	return &i, err
 }
 
 const getRootFolderByPath = `-- name: GetRootFolderByPath :one
-SELECT id, path, name, module_type, free_space, created_at FROM root_folders WHERE path = ? LIMIT 1
+SELECT id, path, name, module_type, free_space, created_at, total_space, unreachable FROM root_folders WHERE path = ? LIMIT 1
 `
 
 func (q *Queries) GetRootFolderByPath(ctx context.Context, path string) (*RootFolder, error) {
@@ -540,6 +574,8 @@ func (q *Queries) GetRootFolderByPath(ctx context.Context, path string) (*RootFo
 		&i.ModuleType,
 		&i.FreeSpace,
 		&i.CreatedAt,
+		&i.TotalSpace,
+		&i.Unreachable,
 	)
 	return &i, err
 }
@@ -855,40 +891,42 @@ func (q *Queries) ListHistoryByMediaType(ctx context.Context, arg ListHistoryByM
 }
 
 const listHistoryFiltered = `-- name: ListHistoryFiltered :many
-SELECT id, event_type, module_type, entity_type, entity_id, source, quality, data, created_at FROM history
-WHERE (? = '' OR instr(',' || ? || ',', ',' || event_type || ',') > 0)
-  AND (? = '' OR entity_type = ?)
-  AND (? = '' OR created_at >= ?)
-  AND (? = '' OR created_at <= ?)
-ORDER BY created_at DESC
-LIMIT ? OFFSET ?
+WITH o AS (
+    SELECT CAST(?5 AS TEXT) AS event_types,
+           CAST(?6 AS TEXT) AS entity_type,
+           CAST(?7 AS BOOLEAN) AS ascending
+)
+SELECT h.id, h.event_type, h.module_type, h.entity_type, h.entity_id, h.source, h.quality, h.data, h.created_at FROM history h, o
+WHERE (o.event_types = '' OR instr(',' || o.event_types || ',', ',' || h.event_type || ',') > 0)
+  AND (o.entity_type = '' OR h.entity_type = o.entity_type)
+  AND (h.created_at >= ?1 OR ?1 IS NULL)
+  AND (h.created_at <= ?2 OR ?2 IS NULL)
+ORDER BY
+    CASE WHEN o.ascending THEN h.created_at END ASC,
+    CASE WHEN o.ascending THEN h.id END ASC,
+    h.created_at DESC, h.id DESC
+LIMIT ?4 OFFSET ?3
 `
 
 type ListHistoryFilteredParams struct {
-	Column1     interface{}    `json:"column_1"`
-	Column2     sql.NullString `json:"column_2"`
-	Column3     interface{}    `json:"column_3"`
-	EntityType  string         `json:"entity_type"`
-	Column5     interface{}    `json:"column_5"`
-	CreatedAt   sql.NullTime   `json:"created_at"`
-	Column7     interface{}    `json:"column_7"`
-	CreatedAt_2 sql.NullTime   `json:"created_at_2"`
-	Limit       int64          `json:"limit"`
-	Offset      int64          `json:"offset"`
+	CreatedAfter  sql.NullTime `json:"created_after"`
+	CreatedBefore sql.NullTime `json:"created_before"`
+	Off           int64        `json:"off"`
+	Lim           int64        `json:"lim"`
+	EventTypes    string       `json:"event_types"`
+	EntityType    string       `json:"entity_type"`
+	Ascending     bool         `json:"ascending"`
 }
 
 func (q *Queries) ListHistoryFiltered(ctx context.Context, arg ListHistoryFilteredParams) ([]*History, error) {
 	rows, err := q.db.QueryContext(ctx, listHistoryFiltered,
-		arg.Column1,
-		arg.Column2,
-		arg.Column3,
+		arg.CreatedAfter,
+		arg.CreatedBefore,
+		arg.Off,
+		arg.Lim,
+		arg.EventTypes,
 		arg.EntityType,
-		arg.Column5,
-		arg.CreatedAt,
-		arg.Column7,
-		arg.CreatedAt_2,
-		arg.Limit,
-		arg.Offset,
+		arg.Ascending,
 	)
 	if err != nil {
 		return nil, err
@@ -966,7 +1004,7 @@ func (q *Queries) ListHistoryPaginated(ctx context.Context, arg ListHistoryPagin
 }
 
 const listQualityProfiles = `-- name: ListQualityProfiles :many
-SELECT id, name, module_type, cutoff, items, hdr_settings, video_codec_settings, audio_codec_settings, audio_channel_settings, upgrades_enabled, allow_auto_approve, upgrade_strategy, cutoff_overrides_strategy, created_at, updated_at FROM quality_profiles ORDER BY module_type, name
+SELECT id, name, module_type, cutoff, items, hdr_settings, video_codec_settings, audio_codec_settings, audio_channel_settings, upgrades_enabled, allow_auto_approve, upgrade_strategy, cutoff_overrides_strategy, created_at, updated_at, release_groups, indexer_flags FROM quality_profiles ORDER BY module_type, name
 `
 
 func (q *Queries) ListQualityProfiles(ctx context.Context) ([]*QualityProfile, error) {
@@ -994,6 +1032,8 @@ func (q *Queries) ListQualityProfiles(ctx context.Context) ([]*QualityProfile, e
 			&i.CutoffOverridesStrategy,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.ReleaseGroups,
+			&i.IndexerFlags,
 		); err != nil {
 			return nil, err
 		}
@@ -1009,7 +1049,7 @@ func (q *Queries) ListQualityProfiles(ctx context.Context) ([]*QualityProfile, e
 }
 
 const listQualityProfilesByModule = `-- name: ListQualityProfilesByModule :many
-SELECT id, name, module_type, cutoff, items, hdr_settings, video_codec_settings, audio_codec_settings, audio_channel_settings, upgrades_enabled, allow_auto_approve, upgrade_strategy, cutoff_overrides_strategy, created_at, updated_at FROM quality_profiles WHERE module_type = ? ORDER BY name
+SELECT id, name, module_type, cutoff, items, hdr_settings, video_codec_settings, audio_codec_settings, audio_channel_settings, upgrades_enabled, allow_auto_approve, upgrade_strategy, cutoff_overrides_strategy, created_at, updated_at, release_groups, indexer_flags FROM quality_profiles WHERE module_type = ? ORDER BY name
 `
 
 func (q *Queries) ListQualityProfilesByModule(ctx context.Context, moduleType string) ([]*QualityProfile, error) {
@@ -1037,6 +1077,8 @@ func (q *Queries) ListQualityProfilesByModule(ctx context.Context, moduleType st
 			&i.CutoffOverridesStrategy,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.ReleaseGroups,
+			&i.IndexerFlags,
 		); err != nil {
 			return nil, err
 		}
@@ -1052,7 +1094,7 @@ func (q *Queries) ListQualityProfilesByModule(ctx context.Context, moduleType st
 }
 
 const listRootFolders = `-- name: ListRootFolders :many
-SELECT id, path, name, module_type, free_space, created_at FROM root_folders ORDER BY name
+SELECT id, path, name, module_type, free_space, created_at, total_space, unreachable FROM root_folders ORDER BY name
 `
 
 func (q *Queries) ListRootFolders(ctx context.Context) ([]*RootFolder, error) {
@@ -1071,6 +1113,8 @@ func (q *Queries) ListRootFolders(ctx context.Context) ([]*RootFolder, error) {
 			&i.ModuleType,
 			&i.FreeSpace,
 			&i.CreatedAt,
+			&i.TotalSpace,
+			&i.Unreachable,
 		); err != nil {
 			return nil, err
 		}
@@ -1086,7 +1130,7 @@ func (q *Queries) ListRootFolders(ctx context.Context) ([]*RootFolder, error) {
 }
 
 const listRootFoldersByMediaType = `-- name: ListRootFoldersByMediaType :many
-SELECT id, path, name, module_type, free_space, created_at FROM root_folders WHERE module_type = ? ORDER BY name
+SELECT id, path, name, module_type, free_space, created_at, total_space, unreachable FROM root_folders WHERE module_type = ? ORDER BY name
 `
 
 func (q *Queries) ListRootFoldersByMediaType(ctx context.Context, moduleType string) ([]*RootFolder, error) {
@@ -1105,6 +1149,8 @@ func (q *Queries) ListRootFoldersByMediaType(ctx context.Context, moduleType str
 			&i.ModuleType,
 			&i.FreeSpace,
 			&i.CreatedAt,
+			&i.TotalSpace,
+			&i.Unreachable,
 		); err != nil {
 			return nil, err
 		}
@@ -1120,7 +1166,7 @@ func (q *Queries) ListRootFoldersByMediaType(ctx context.Context, moduleType str
 }
 
 const listRootFoldersByType = `-- name: ListRootFoldersByType :many
-SELECT id, path, name, module_type, free_space, created_at FROM root_folders WHERE module_type = ? ORDER BY name
+SELECT id, path, name, module_type, free_space, created_at, total_space, unreachable FROM root_folders WHERE module_type = ? ORDER BY name
 `
 
 func (q *Queries) ListRootFoldersByType(ctx context.Context, moduleType string) ([]*RootFolder, error) {
@@ -1139,6 +1185,8 @@ func (q *Queries) ListRootFoldersByType(ctx context.Context, moduleType string)
 			&i.ModuleType,
 			&i.FreeSpace,
 			&i.CreatedAt,
+			&i.TotalSpace,
+			&i.Unreachable,
 		); err != nil {
 			return nil, err
 		}
@@ -1278,9 +1326,11 @@ UPDATE quality_profiles SET
     allow_auto_approve = ?,
     upgrade_strategy = ?,
     cutoff_overrides_strategy = ?,
+    release_groups = ?,
+    indexer_flags = ?,
     updated_at = CURRENT_TIMESTAMP
 WHERE id = ?
-RETURNING id, name, module_type, cutoff, items, hdr_settings, video_codec_settings, audio_codec_settings, audio_channel_settings, upgrades_enabled, allow_auto_approve, upgrade_strategy, cutoff_overrides_strategy, created_at, updated_at
+RETURNING id, name, module_type, cutoff, items, hdr_settings, video_codec_settings, audio_codec_settings, audio_channel_settings, upgrades_enabled, allow_auto_approve, upgrade_strategy, cutoff_overrides_strategy, created_at, updated_at, release_groups, indexer_flags
 `
 
 type UpdateQualityProfileParams struct {
@@ -1295,6 +1345,8 @@ type UpdateQualityProfileParams struct {
 	AllowAutoApprove        bool           `json:"allow_auto_approve"`
 	UpgradeStrategy         string         `json:"upgrade_strategy"`
 	CutoffOverridesStrategy int64          `json:"cutoff_overrides_strategy"`
+	ReleaseGroups           string         `json:"release_groups"`
+	IndexerFlags            string         `json:"indexer_flags"`
 	ID                      int64          `json:"id"`
 }
 
@@ -1311,6 +1363,8 @@ func (q *Queries) UpdateQualityProfile(ctx context.Context, arg UpdateQualityPro
 		arg.AllowAutoApprove,
 		arg.UpgradeStrategy,
 		arg.CutoffOverridesStrategy,
+		arg.ReleaseGroups,
+		arg.IndexerFlags,
 		arg.ID,
 	)
 	var i QualityProfile
@@ -1330,6 +1384,8 @@ func (q *Queries) UpdateQualityProfile(ctx context.Context, arg UpdateQualityPro
 		&i.CutoffOverridesStrategy,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.ReleaseGroups,
+		&i.IndexerFlags,
 	)
 	return &i, err
 }
@@ -1340,7 +1396,7 @@ UPDATE root_folders SET
     name = ?,
     free_space = ?
 WHERE id = ?
-RETURNING id, path, name, module_type, free_space, created_at
+RETURNING id, path, name, module_type, free_space, created_at, total_space, unreachable
 `
 
 type UpdateRootFolderParams struct {
@@ -1365,20 +1421,29 @@ func (q *Queries) UpdateRootFolder(ctx context.Context, arg UpdateRootFolderPara
 		&i.ModuleType,
 		&i.FreeSpace,
 		&i.CreatedAt,
+		&i.TotalSpace,
+		&i.Unreachable,
 	)
 	return &i, err
 }
 
-const updateRootFolderFreeSpace = `-- name: UpdateRootFolderFreeSpace :exec
-UPDATE root_folders SET free_space = ? WHERE id = ?
+const updateRootFolderSpace = `-- name: UpdateRootFolderSpace :exec
+UPDATE root_folders SET free_space = ?, total_space = ?, unreachable = ? WHERE id = ?
 `
 
-type UpdateRootFolderFreeSpaceParams struct {
-	FreeSpace sql.NullInt64 `json:"free_space"`
-	ID        int64         `json:"id"`
+type UpdateRootFolderSpaceParams struct {
+	FreeSpace   sql.NullInt64 `json:"free_space"`
+	TotalSpace  sql.NullInt64 `json:"total_space"`
+	Unreachable bool          `json:"unreachable"`
+	ID          int64         `json:"id"`
 }
 
-func (q *Queries) UpdateRootFolderFreeSpace(ctx context.Context, arg UpdateRootFolderFreeSpaceParams) error {
-	_, err := q.db.ExecContext(ctx, updateRootFolderFreeSpace, arg.FreeSpace, arg.ID)
+func (q *Queries) UpdateRootFolderSpace(ctx context.Context, arg UpdateRootFolderSpaceParams) error {
+	_, err := q.db.ExecContext(ctx, updateRootFolderSpace,
+		arg.FreeSpace,
+		arg.TotalSpace,
+		arg.Unreachable,
+		arg.ID,
+	)
 	return err
 }
@@ -372,7 +372,7 @@ func (q *Queries) DeleteMovieSlotAssignments(ctx context.Context, movieID int64)
 
 const getEpisodeFileSlotAssignments = `-- name: GetEpisodeFileSlotAssignments :many
 
-SELECT ef.id, ef.episode_id, ef.path, ef.size, ef.quality, ef.video_codec, ef.audio_codec, ef.resolution, ef.created_at, ef.quality_id, ef.original_path, ef.original_filename, ef.imported_at, ef.slot_id, ef.audio_channels, ef.dynamic_range, vs.name as slot_name, vs.slot_number
+SELECT ef.id, ef.episode_id, ef.path, ef.size, ef.quality, ef.video_codec, ef.audio_codec, ef.resolution, ef.created_at, ef.quality_id, ef.original_path, ef.original_filename, ef.imported_at, ef.slot_id, ef.audio_channels, ef.dynamic_range, ef.primary_file_id, ef.dolby_vision_profile, ef.audio_tracks, vs.name as slot_name, vs.slot_number
 FROM episode_files ef
 LEFT JOIN version_slots vs ON ef.slot_id = vs.id
 WHERE ef.episode_id = ?
@@ -380,24 +380,27 @@ ORDER BY vs.slot_number
 `
 
 type GetEpisodeFileSlotAssignmentsRow struct {
-	ID               int64          `json:"id"`
-	EpisodeID        int64          `json:"episode_id"`
-	Path             string         `json:"path"`
-	Size             int64          `json:"size"`
-	Quality          sql.NullString `json:"quality"`
-	VideoCodec       sql.NullString `json:"video_codec"`
-	AudioCodec       sql.NullString `json:"audio_codec"`
-	Resolution       sql.NullString `json:"resolution"`
-	CreatedAt        sql.NullTime   `json:"created_at"`
-	QualityID        sql.NullInt64  `json:"quality_id"`
-	OriginalPath     sql.NullString `json:"original_path"`
-	OriginalFilename sql.NullString `json:"original_filename"`
-	ImportedAt       sql.NullTime   `json:"imported_at"`
-	SlotID           sql.NullInt64  `json:"slot_id"`
-	AudioChannels    sql.NullString `json:"audio_channels"`
-	DynamicRange     sql.NullString `json:"dynamic_range"`
-	SlotName         sql.NullString `json:"slot_name"`
-	SlotNumber       sql.NullInt64  `json:"slot_number"`
+	ID                 int64          `json:"id"`
+	EpisodeID          int64          `json:"episode_id"`
+	Path               string         `json:"path"`
+	Size               int64          `json:"size"`
+	Quality            sql.NullString `json:"quality"`
+	VideoCodec         sql.NullString `json:"video_codec"`
+	AudioCodec         sql.NullString `json:"audio_codec"`
+	Resolution         sql.NullString `json:"resolution"`
+	CreatedAt          sql.NullTime   `json:"created_at"`
+	QualityID          sql.NullInt64  `json:"quality_id"`
+	OriginalPath       sql.NullString `json:"original_path"`
+	OriginalFilename   sql.NullString `json:"original_filename"`
+	ImportedAt         sql.NullTime   `json:"imported_at"`
+	SlotID             sql.NullInt64  `json:"slot_id"`
+	AudioChannels      sql.NullString `json:"audio_channels"`
+	DynamicRange       sql.NullString `json:"dynamic_range"`
+	PrimaryFileID      sql.NullInt64  `json:"primary_file_id"`
+	DolbyVisionProfile string         `json:"dolby_vision_profile"`
+	AudioTracks        string         `json:"audio_tracks"`
+	SlotName           sql.NullString `json:"slot_name"`
+	SlotNumber         sql.NullInt64  `json:"slot_number"`
 }
 
 // =====================
@@ -429,6 +432,9 @@ func (q *Queries) GetEpisodeFileSlotAssignments(ctx context.Context, episodeID i
 			&i.SlotID,
 			&i.AudioChannels,
 			&i.DynamicRange,
+			&i.PrimaryFileID,
+			&i.DolbyVisionProfile,
+			&i.AudioTracks,
 			&i.SlotName,
 			&i.SlotNumber,
 		); err != nil {
@@ -504,7 +510,7 @@ func (q *Queries) GetEpisodeSlotAssignmentByFileID(ctx context.Context, fileID s
 
 const getMovieFileSlotAssignments = `-- name: GetMovieFileSlotAssignments :many
 
-SELECT mf.id, mf.movie_id, mf.path, mf.size, mf.quality, mf.video_codec, mf.audio_codec, mf.resolution, mf.created_at, mf.quality_id, mf.original_path, mf.original_filename, mf.imported_at, mf.slot_id, mf.audio_channels, mf.dynamic_range, vs.name as slot_name, vs.slot_number
+SELECT mf.id, mf.movie_id, mf.path, mf.size, mf.quality, mf.video_codec, mf.audio_codec, mf.resolution, mf.created_at, mf.quality_id, mf.original_path, mf.original_filename, mf.imported_at, mf.slot_id, mf.audio_channels, mf.dynamic_range, mf.edition, mf.dolby_vision_profile, mf.audio_tracks, vs.name as slot_name, vs.slot_number
 FROM movie_files mf
 LEFT JOIN version_slots vs ON mf.slot_id = vs.id
 WHERE mf.movie_id = ?
@@ -512,24 +518,27 @@ ORDER BY vs.slot_number
 `
 
 type GetMovieFileSlotAssignmentsRow struct {
-	ID               int64          `json:"id"`
-	MovieID          int64          `json:"movie_id"`
-	Path             string         `json:"path"`
-	Size             int64          `json:"size"`
-	Quality          sql.NullString `json:"quality"`
-	VideoCodec       sql.NullString `json:"video_codec"`
-	AudioCodec       sql.NullString `json:"audio_codec"`
-	Resolution       sql.NullString `json:"resolution"`
-	CreatedAt        sql.NullTime   `json:"created_at"`
-	QualityID        sql.NullInt64  `json:"quality_id"`
-	OriginalPath     sql.NullString `json:"original_path"`
-	OriginalFilename sql.NullString `json:"original_filename"`
-	ImportedAt       sql.NullTime   `json:"imported_at"`
-	SlotID           sql.NullInt64  `json:"slot_id"`
-	AudioChannels    sql.NullString `json:"audio_channels"`
-	DynamicRange     sql.NullString `json:"dynamic_range"`
-	SlotName         sql.NullString `json:"slot_name"`
-	SlotNumber       sql.NullInt64  `json:"slot_number"`
+	ID                 int64          `json:"id"`
+	MovieID            int64          `json:"movie_id"`
+	Path               string         `json:"path"`
+	Size               int64          `json:"size"`
+	Quality            sql.NullString `json:"quality"`
+	VideoCodec         sql.NullString `json:"video_codec"`
+	AudioCodec         sql.NullString `json:"audio_codec"`
+	Resolution         sql.NullString `json:"resolution"`
+	CreatedAt          sql.NullTime   `json:"created_at"`
+	QualityID          sql.NullInt64  `json:"quality_id"`
+	OriginalPath       sql.NullString `json:"original_path"`
+	OriginalFilename   sql.NullString `json:"original_filename"`
+	ImportedAt         sql.NullTime   `json:"imported_at"`
+	SlotID             sql.NullInt64  `json:"slot_id"`
+	AudioChannels      sql.NullString `json:"audio_channels"`
+	DynamicRange       sql.NullString `json:"dynamic_range"`
+	Edition            string         `json:"edition"`
+	DolbyVisionProfile string         `json:"dolby_vision_profile"`
+	AudioTracks        string         `json:"audio_tracks"`
+	SlotName           sql.NullString `json:"slot_name"`
+	SlotNumber         sql.NullInt64  `json:"slot_number"`
 }
 
 // =====================
@@ -561,6 +570,9 @@ func (q *Queries) GetMovieFileSlotAssignments(ctx context.Context, movieID int64
 			&i.SlotID,
 			&i.AudioChannels,
 			&i.DynamicRange,
+			&i.Edition,
+			&i.DolbyVisionProfile,
+			&i.AudioTracks,
 			&i.SlotName,
 			&i.SlotNumber,
 		); err != nil {
@@ -636,7 +648,7 @@ func (q *Queries) GetMovieSlotAssignmentByFileID(ctx context.Context, fileID sql
 
 const getMultiVersionSettings = `-- name: GetMultiVersionSettings :one
 
-SELECT id, enabled, dry_run_completed, last_migration_at, created_at, updated_at FROM multi_version_settings WHERE id = 1 LIMIT 1
+SELECT id, enabled, dry_run_completed, last_migration_at, created_at, updated_at, version_naming FROM multi_version_settings WHERE id = 1 LIMIT 1
 `
 
 // Code generated by gen_slots.go. DO NOT EDIT.
@@ -653,6 +665,7 @@ func (q *Queries) GetMultiVersionSettings(ctx context.Context) (*MultiVersionSet
 		&i.LastMigrationAt,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.VersionNaming,
 	)
 	return &i, err
 }
@@ -811,7 +824,7 @@ func (q *Queries) ListEnabledVersionSlotsWithProfiles(ctx context.Context) ([]*L
 }
 
 const listEpisodeFilesInSlot = `-- name: ListEpisodeFilesInSlot :many
-SELECT ef.id, ef.episode_id, ef.path, ef.size, ef.quality, ef.video_codec, ef.audio_codec, ef.resolution, ef.created_at, ef.quality_id, ef.original_path, ef.original_filename, ef.imported_at, ef.slot_id, ef.audio_channels, ef.dynamic_range, e.title as episode_title, e.season_number, e.episode_number, s.title as series_title
+SELECT ef.id, ef.episode_id, ef.path, ef.size, ef.quality, ef.video_codec, ef.audio_codec, ef.resolution, ef.created_at, ef.quality_id, ef.original_path, ef.original_filename, ef.imported_at, ef.slot_id, ef.audio_channels, ef.dynamic_range, ef.primary_file_id, ef.dolby_vision_profile, ef.audio_tracks, e.title as episode_title, e.season_number, e.episode_number, s.title as series_title
 FROM episode_files ef
 JOIN episodes e ON ef.episode_id = e.id
 JOIN series s ON e.series_id = s.id
@@ -820,26 +833,29 @@ ORDER BY s.title, e.season_number, e.episode_number
 `
 
 type ListEpisodeFilesInSlotRow struct {
-	ID               int64          `json:"id"`
-	EpisodeID        int64          `json:"episode_id"`
-	Path             string         `json:"path"`
-	Size             int64          `json:"size"`
-	Quality          sql.NullString `json:"quality"`
-	VideoCodec       sql.NullString `json:"video_codec"`
-	AudioCodec       sql.NullString `json:"audio_codec"`
-	Resolution       sql.NullString `json:"resolution"`
-	CreatedAt        sql.NullTime   `json:"created_at"`
-	QualityID        sql.NullInt64  `json:"quality_id"`
-	OriginalPath     sql.NullString `json:"original_path"`
-	OriginalFilename sql.NullString `json:"original_filename"`
-	ImportedAt       sql.NullTime   `json:"imported_at"`
-	SlotID           sql.NullInt64  `json:"slot_id"`
-	AudioChannels    sql.NullString `json:"audio_channels"`
-	DynamicRange     sql.NullString `json:"dynamic_range"`
-	EpisodeTitle     sql.NullString `json:"episode_title"`
-	SeasonNumber     int64          `json:"season_number"`
-	EpisodeNumber    int64          `json:"episode_number"`
-	SeriesTitle      string         `json:"series_title"`
+	ID                 int64          `json:"id"`
+	EpisodeID          int64          `json:"episode_id"`
+	Path               string         `json:"path"`
+	Size               int64          `json:"size"`
+	Quality            sql.NullString `json:"quality"`
+	VideoCodec         sql.NullString `json:"video_codec"`
+	AudioCodec         sql.NullString `json:"audio_codec"`
+	Resolution         sql.NullString `json:"resolution"`
+	CreatedAt          sql.NullTime   `json:"created_at"`
+	QualityID          sql.NullInt64  `json:"quality_id"`
+	OriginalPath       sql.NullString `json:"original_path"`
+	OriginalFilename   sql.NullString `json:"original_filename"`
+	ImportedAt         sql.NullTime   `json:"imported_at"`
+	SlotID             sql.NullInt64  `json:"slot_id"`
+	AudioChannels      sql.NullString `json:"audio_channels"`
+	DynamicRange       sql.NullString `json:"dynamic_range"`
+	PrimaryFileID      sql.NullInt64  `json:"primary_file_id"`
+	DolbyVisionProfile string         `json:"dolby_vision_profile"`
+	AudioTracks        string         `json:"audio_tracks"`
+	EpisodeTitle       sql.NullString `json:"episode_title"`
+	SeasonNumber       int64          `json:"season_number"`
+	EpisodeNumber      int64          `json:"episode_number"`
+	SeriesTitle        string         `json:"series_title"`
 }
 
 func (q *Queries) ListEpisodeFilesInSlot(ctx context.Context, slotID sql.NullInt64) ([]*ListEpisodeFilesInSlotRow, error) {
@@ -868,6 +884,9 @@ func (q *Queries) ListEpisodeFilesInSlot(ctx context.Context, slotID sql.NullInt
 			&i.SlotID,
 			&i.AudioChannels,
 			&i.DynamicRange,
+			&i.PrimaryFileID,
+			&i.DolbyVisionProfile,
+			&i.AudioTracks,
 			&i.EpisodeTitle,
 			&i.SeasonNumber,
 			&i.EpisodeNumber,
@@ -887,7 +906,7 @@ func (q *Queries) ListEpisodeFilesInSlot(ctx context.Context, slotID sql.NullInt
 }
 
 const listEpisodeFilesWithoutSlot = `-- name: ListEpisodeFilesWithoutSlot :many
-SELECT ef.id, ef.episode_id, ef.path, ef.size, ef.quality, ef.video_codec, ef.audio_codec, ef.resolution, ef.created_at, ef.quality_id, ef.original_path, ef.original_filename, ef.imported_at, ef.slot_id, ef.audio_channels, ef.dynamic_range, e.title as episode_title, e.season_number, e.episode_number, s.title as series_title
+SELECT ef.id, ef.episode_id, ef.path, ef.size, ef.quality, ef.video_codec, ef.audio_codec, ef.resolution, ef.created_at, ef.quality_id, ef.original_path, ef.original_filename, ef.imported_at, ef.slot_id, ef.audio_channels, ef.dynamic_range, ef.primary_file_id, ef.dolby_vision_profile, ef.audio_tracks, e.title as episode_title, e.season_number, e.episode_number, s.title as series_title
 FROM episode_files ef
 JOIN episodes e ON ef.episode_id = e.id
 JOIN series s ON e.series_id = s.id
@@ -896,26 +915,29 @@ ORDER BY s.title, e.season_number, e.episode_number
 `
 
 type ListEpisodeFilesWithoutSlotRow struct {
-	ID               int64          `json:"id"`
-	EpisodeID        int64          `json:"episode_id"`
-	Path             string         `json:"path"`
-	Size             int64          `json:"size"`
-	Quality          sql.NullString `json:"quality"`
-	VideoCodec       sql.NullString `json:"video_codec"`
-	AudioCodec       sql.NullString `json:"audio_codec"`
-	Resolution       sql.NullString `json:"resolution"`
-	CreatedAt        sql.NullTime   `json:"created_at"`
-	QualityID        sql.NullInt64  `json:"quality_id"`
-	OriginalPath     sql.NullString `json:"original_path"`
-	OriginalFilename sql.NullString `json:"original_filename"`
-	ImportedAt       sql.NullTime   `json:"imported_at"`
-	SlotID           sql.NullInt64  `json:"slot_id"`
-	AudioChannels    sql.NullString `json:"audio_channels"`
-	DynamicRange     sql.NullString `json:"dynamic_range"`
-	EpisodeTitle     sql.NullString `json:"episode_title"`
-	SeasonNumber     int64          `json:"season_number"`
-	EpisodeNumber    int64          `json:"episode_number"`
-	SeriesTitle      string         `json:"series_title"`
+	ID                 int64          `json:"id"`
+	EpisodeID          int64          `json:"episode_id"`
+	Path               string         `json:"path"`
+	Size               int64          `json:"size"`
+	Quality            sql.NullString `json:"quality"`
+	VideoCodec         sql.NullString `json:"video_codec"`
+	AudioCodec         sql.NullString `json:"audio_codec"`
+	Resolution         sql.NullString `json:"resolution"`
+	CreatedAt          sql.NullTime   `json:"created_at"`
+	QualityID          sql.NullInt64  `json:"quality_id"`
+	OriginalPath       sql.NullString `json:"original_path"`
+	OriginalFilename   sql.NullString `json:"original_filename"`
+	ImportedAt         sql.NullTime   `json:"imported_at"`
+	SlotID             sql.NullInt64  `json:"slot_id"`
+	AudioChannels      sql.NullString `json:"audio_channels"`
+	DynamicRange       sql.NullString `json:"dynamic_range"`
+	PrimaryFileID      sql.NullInt64  `json:"primary_file_id"`
+	DolbyVisionProfile string         `json:"dolby_vision_profile"`
+	AudioTracks        string         `json:"audio_tracks"`
+	EpisodeTitle       sql.NullString `json:"episode_title"`
+	SeasonNumber       int64          `json:"season_number"`
+	EpisodeNumber      int64          `json:"episode_number"`
+	SeriesTitle        string         `json:"series_title"`
 }
 
 func (q *Queries) ListEpisodeFilesWithoutSlot(ctx context.Context) ([]*ListEpisodeFilesWithoutSlotRow, error) {
@@ -944,6 +966,9 @@ func (q *Queries) ListEpisodeFilesWithoutSlot(ctx context.Context) ([]*ListEpiso
 			&i.SlotID,
 			&i.AudioChannels,
 			&i.DynamicRange,
+			&i.PrimaryFileID,
+			&i.DolbyVisionProfile,
+			&i.AudioTracks,
 			&i.EpisodeTitle,
 			&i.SeasonNumber,
 			&i.EpisodeNumber,
@@ -1197,6 +1222,61 @@ func (q *Queries) ListEpisodeSlotsNeedingSearch(ctx context.Context, episodeID i
 	return items, nil
 }
 
+const listEpisodeSlotsToFill = `-- name: ListEpisodeSlotsToFill :many
+SELECT e.id AS episode_id, s.title, vs.id AS slot_id,
+       CAST(esa.file_id IS NOT NULL AS INTEGER) AS has_file
+FROM episodes e
+JOIN series s ON s.id = e.series_id
+CROSS JOIN version_slots vs
+LEFT JOIN episode_slot_assignments esa ON esa.episode_id = e.id AND esa.slot_id = vs.id
+WHERE vs.enabled = 1
+  AND vs.quality_profile_id IS NOT NULL
+  AND s.monitored = 1
+  AND e.monitored = 1
+  AND e.status != 'unreleased'
+  AND (esa.id IS NULL OR (esa.monitored = 1 AND (
+      (esa.file_id IS NULL AND esa.status != 'downloading') OR esa.status = 'upgradable')))
+  AND NOT EXISTS (
+      SELECT 1 FROM download_mappings dm
+      WHERE dm.entity_type = 'episode' AND dm.entity_id = e.id AND dm.target_slot_id = vs.id)
+ORDER BY e.air_date DESC, e.id, vs.slot_number
+`
+
+type ListEpisodeSlotsToFillRow struct {
+	EpisodeID int64  `json:"episode_id"`
+	Title     string `json:"title"`
+	SlotID    int64  `json:"slot_id"`
+	HasFile   int64  `json:"has_file"`
+}
+
+func (q *Queries) ListEpisodeSlotsToFill(ctx context.Context) ([]*ListEpisodeSlotsToFillRow, error) {
+	rows, err := q.db.QueryContext(ctx, listEpisodeSlotsToFill)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []*ListEpisodeSlotsToFillRow{}
+	for rows.Next() {
+		var i ListEpisodeSlotsToFillRow
+		if err := rows.Scan(
+			&i.EpisodeID,
+			&i.Title,
+			&i.SlotID,
+			&i.HasFile,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listFilesAssignedToSlot = `-- name: ListFilesAssignedToSlot :many
 SELECT
     'movie' as media_type,
@@ -1321,7 +1401,7 @@ func (q *Queries) ListMonitoredMovieSlotStatuses(ctx context.Context, movieID in
 }
 
 const listMovieFilesInSlot = `-- name: ListMovieFilesInSlot :many
-SELECT mf.id, mf.movie_id, mf.path, mf.size, mf.quality, mf.video_codec, mf.audio_codec, mf.resolution, mf.created_at, mf.quality_id, mf.original_path, mf.original_filename, mf.imported_at, mf.slot_id, mf.audio_channels, mf.dynamic_range, m.title as movie_title
+SELECT mf.id, mf.movie_id, mf.path, mf.size, mf.quality, mf.video_codec, mf.audio_codec, mf.resolution, mf.created_at, mf.quality_id, mf.original_path, mf.original_filename, mf.imported_at, mf.slot_id, mf.audio_channels, mf.dynamic_range, mf.edition, mf.dolby_vision_profile, mf.audio_tracks, m.title as movie_title
 FROM movie_files mf
 JOIN movies m ON mf.movie_id = m.id
 WHERE mf.slot_id = ?
@@ -1329,23 +1409,26 @@ ORDER BY m.title
 `
 
 type ListMovieFilesInSlotRow struct {
-	ID               int64          `json:"id"`
-	MovieID          int64          `json:"movie_id"`
-	Path             string         `json:"path"`
-	Size             int64          `json:"size"`
-	Quality          sql.NullString `json:"quality"`
-	VideoCodec       sql.NullString `json:"video_codec"`
-	AudioCodec       sql.NullString `json:"audio_codec"`
-	Resolution       sql.NullString `json:"resolution"`
-	CreatedAt        sql.NullTime   `json:"created_at"`
-	QualityID        sql.NullInt64  `json:"quality_id"`
-	OriginalPath     sql.NullString `json:"original_path"`
-	OriginalFilename sql.NullString `json:"original_filename"`
-	ImportedAt       sql.NullTime   `json:"imported_at"`
-	SlotID           sql.NullInt64  `json:"slot_id"`
-	AudioChannels    sql.NullString `json:"audio_channels"`
-	DynamicRange     sql.NullString `json:"dynamic_range"`
-	MovieTitle       string         `json:"movie_title"`
+	ID                 int64          `json:"id"`
+	MovieID            int64          `json:"movie_id"`
+	Path               string         `json:"path"`
+	Size               int64          `json:"size"`
+	Quality            sql.NullString `json:"quality"`
+	VideoCodec         sql.NullString `json:"video_codec"`
+	AudioCodec         sql.NullString `json:"audio_codec"`
+	Resolution         sql.NullString `json:"resolution"`
+	CreatedAt          sql.NullTime   `json:"created_at"`
+	QualityID          sql.NullInt64  `json:"quality_id"`
+	OriginalPath       sql.NullString `json:"original_path"`
+	OriginalFilename   sql.NullString `json:"original_filename"`
+	ImportedAt         sql.NullTime   `json:"imported_at"`
+	SlotID             sql.NullInt64  `json:"slot_id"`
+	AudioChannels      sql.NullString `json:"audio_channels"`
+	DynamicRange       sql.NullString `json:"dynamic_range"`
+	Edition            string         `json:"edition"`
+	DolbyVisionProfile string         `json:"dolby_vision_profile"`
+	AudioTracks        string         `json:"audio_tracks"`
+	MovieTitle         string         `json:"movie_title"`
 }
 
 func (q *Queries) ListMovieFilesInSlot(ctx context.Context, slotID sql.NullInt64) ([]*ListMovieFilesInSlotRow, error) {
@@ -1374,6 +1457,9 @@ func (q *Queries) ListMovieFilesInSlot(ctx context.Context, slotID sql.NullInt64
 			&i.SlotID,
 			&i.AudioChannels,
 			&i.DynamicRange,
+			&i.Edition,
+			&i.DolbyVisionProfile,
+			&i.AudioTracks,
 			&i.MovieTitle,
 		); err != nil {
 			return nil, err
@@ -1390,7 +1476,7 @@ func (q *Queries) ListMovieFilesInSlot(ctx context.Context, slotID sql.NullInt64
 }
 
 const listMovieFilesWithoutSlot = `-- name: ListMovieFilesWithoutSlot :many
-SELECT mf.id, mf.movie_id, mf.path, mf.size, mf.quality, mf.video_codec, mf.audio_codec, mf.resolution, mf.created_at, mf.quality_id, mf.original_path, mf.original_filename, mf.imported_at, mf.slot_id, mf.audio_channels, mf.dynamic_range, m.title as movie_title
+SELECT mf.id, mf.movie_id, mf.path, mf.size, mf.quality, mf.video_codec, mf.audio_codec, mf.resolution, mf.created_at, mf.quality_id, mf.original_path, mf.original_filename, mf.imported_at, mf.slot_id, mf.audio_channels, mf.dynamic_range, mf.edition, mf.dolby_vision_profile, mf.audio_tracks, m.title as movie_title
 FROM movie_files mf
 JOIN movies m ON mf.movie_id = m.id
 WHERE mf.slot_id IS NULL
@@ -1398,23 +1484,26 @@ ORDER BY m.title
 `
 
 type ListMovieFilesWithoutSlotRow struct {
-	ID               int64          `json:"id"`
-	MovieID          int64          `json:"movie_id"`
-	Path             string         `json:"path"`
-	Size             int64          `json:"size"`
-	Quality          sql.NullString `json:"quality"`
-	VideoCodec       sql.NullString `json:"video_codec"`
-	AudioCodec       sql.NullString `json:"audio_codec"`
-	Resolution       sql.NullString `json:"resolution"`
-	CreatedAt        sql.NullTime   `json:"created_at"`
-	QualityID        sql.NullInt64  `json:"quality_id"`
-	OriginalPath     sql.NullString `json:"original_path"`
-	OriginalFilename sql.NullString `json:"original_filename"`
-	ImportedAt       sql.NullTime   `json:"imported_at"`
-	SlotID           sql.NullInt64  `json:"slot_id"`
-	AudioChannels    sql.NullString `json:"audio_channels"`
-	DynamicRange     sql.NullString `json:"dynamic_range"`
-	MovieTitle       string         `json:"movie_title"`
+	ID                 int64          `json:"id"`
+	MovieID            int64          `json:"movie_id"`
+	Path               string         `json:"path"`
+	Size               int64          `json:"size"`
+	Quality            sql.NullString `json:"quality"`
+	VideoCodec         sql.NullString `json:"video_codec"`
+	AudioCodec         sql.NullString `json:"audio_codec"`
+	Resolution         sql.NullString `json:"resolution"`
+	CreatedAt          sql.NullTime   `json:"created_at"`
+	QualityID          sql.NullInt64  `json:"quality_id"`
+	OriginalPath       sql.NullString `json:"original_path"`
+	OriginalFilename   sql.NullString `json:"original_filename"`
+	ImportedAt         sql.NullTime   `json:"imported_at"`
+	SlotID             sql.NullInt64  `json:"slot_id"`
+	AudioChannels      sql.NullString `json:"audio_channels"`
+	DynamicRange       sql.NullString `json:"dynamic_range"`
+	Edition            string         `json:"edition"`
+	DolbyVisionProfile string         `json:"dolby_vision_profile"`
+	AudioTracks        string         `json:"audio_tracks"`
+	MovieTitle         string         `json:"movie_title"`
 }
 
 func (q *Queries) ListMovieFilesWithoutSlot(ctx context.Context) ([]*ListMovieFilesWithoutSlotRow, error) {
@@ -1443,6 +1532,9 @@ func (q *Queries) ListMovieFilesWithoutSlot(ctx context.Context) ([]*ListMovieFi
 			&i.SlotID,
 			&i.AudioChannels,
 			&i.DynamicRange,
+			&i.Edition,
+			&i.DolbyVisionProfile,
+			&i.AudioTracks,
 			&i.MovieTitle,
 		); err != nil {
 			return nil, err
@@ -1686,8 +1778,64 @@ func (q *Queries) ListMovieSlotsNeedingSearch(ctx context.Context, movieID int64
 	return items, nil
 }
 
+const listMovieSlotsToFill = `-- name: ListMovieSlotsToFill :many
+SELECT m.id AS movie_id, m.title, vs.id AS slot_id,
+       CAST(msa.file_id IS NOT NULL AS INTEGER) AS has_file
+FROM movies m
+CROSS JOIN version_slots vs
+LEFT JOIN movie_slot_assignments msa ON msa.movie_id = m.id AND msa.slot_id = vs.id
+WHERE vs.enabled = 1
+  AND vs.quality_profile_id IS NOT NULL
+  AND m.monitored = 1
+  AND m.status != 'unreleased'
+  AND (msa.id IS NULL OR (msa.monitored = 1 AND (
+      (msa.file_id IS NULL AND msa.status != 'downloading') OR msa.status = 'upgradable')))
+  AND NOT EXISTS (
+      SELECT 1 FROM download_mappings dm
+      WHERE dm.entity_type = 'movie' AND dm.entity_id = m.id AND dm.target_slot_id = vs.id)
+ORDER BY COALESCE(m.release_date, m.physical_release_date, m.theatrical_release_date) DESC, m.id, vs.slot_number
+`
+
+type ListMovieSlotsToFillRow struct {
+	MovieID int64  `json:"movie_id"`
+	Title   string `json:"title"`
+	SlotID  int64  `json:"slot_id"`
+	HasFile int64  `json:"has_file"`
+}
+
+// Slot auto-fill: monitored slots of monitored, released items that are empty
+// or hold a file below the slot profile's cutoff and have no download in
+// flight. Items without an assignment row have never filled the slot.
+func (q *Queries) ListMovieSlotsToFill(ctx context.Context) ([]*ListMovieSlotsToFillRow, error) {
+	rows, err := q.db.QueryContext(ctx, listMovieSlotsToFill)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []*ListMovieSlotsToFillRow{}
+	for rows.Next() {
+		var i ListMovieSlotsToFillRow
+		if err := rows.Scan(
+			&i.MovieID,
+			&i.Title,
+			&i.SlotID,
+			&i.HasFile,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listMoviesMissingInMonitoredSlots = `-- name: ListMoviesMissingInMonitoredSlots :many
-SELECT DISTINCT m.id, m.title, m.sort_title, m.year, m.tmdb_id, m.imdb_id, m.overview, m.runtime, m.path, m.root_folder_id, m.quality_profile_id, m.monitored, m.status, m.active_download_id, m.status_message, m.release_date, m.physical_release_date, m.added_at, m.updated_at, m.theatrical_release_date, m.studio, m.tvdb_id, m.content_rating, m.added_by
+SELECT DISTINCT m.id, m.title, m.sort_title, m.year, m.tmdb_id, m.imdb_id, m.overview, m.runtime, m.path, m.root_folder_id, m.quality_profile_id, m.monitored, m.status, m.active_download_id, m.status_message, m.release_date, m.physical_release_date, m.added_at, m.updated_at, m.theatrical_release_date, m.studio, m.tvdb_id, m.content_rating, m.added_by, m.language_profile_id, m.minimum_availability, m.edition_preference
 FROM movies m
 CROSS JOIN version_slots vs
 LEFT JOIN movie_slot_assignments msa ON m.id = msa.movie_id AND vs.id = msa.slot_id
@@ -1732,6 +1880,9 @@ func (q *Queries) ListMoviesMissingInMonitoredSlots(ctx context.Context) ([]*Mov
 			&i.TvdbID,
 			&i.ContentRating,
 			&i.AddedBy,
+			&i.LanguageProfileID,
+			&i.MinimumAvailability,
+			&i.EditionPreference,
 		); err != nil {
 			return nil, err
 		}
@@ -1863,7 +2014,7 @@ UPDATE multi_version_settings SET
     dry_run_completed = ?,
     updated_at = CURRENT_TIMESTAMP
 WHERE id = 1
-RETURNING id, enabled, dry_run_completed, last_migration_at, created_at, updated_at
+RETURNING id, enabled, dry_run_completed, last_migration_at, created_at, updated_at, version_naming
 `
 
 func (q *Queries) SetDryRunCompleted(ctx context.Context, dryRunCompleted bool) (*MultiVersionSetting, error) {
@@ -1876,6 +2027,7 @@ func (q *Queries) SetDryRunCompleted(ctx context.Context, dryRunCompleted bool)
 		&i.LastMigrationAt,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.VersionNaming,
 	)
 	return &i, err
 }
@@ -1885,7 +2037,7 @@ UPDATE multi_version_settings SET
     enabled = ?,
     updated_at = CURRENT_TIMESTAMP
 WHERE id = 1
-RETURNING id, enabled, dry_run_completed, last_migration_at, created_at, updated_at
+RETURNING id, enabled, dry_run_completed, last_migration_at, created_at, updated_at, version_naming
 `
 
 func (q *Queries) SetMultiVersionEnabled(ctx context.Context, enabled bool) (*MultiVersionSetting, error) {
@@ -1898,6 +2050,30 @@ func (q *Queries) SetMultiVersionEnabled(ctx context.Context, enabled bool) (*Mu
 		&i.LastMigrationAt,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.VersionNaming,
+	)
+	return &i, err
+}
+
+const setMultiVersionNaming = `-- name: SetMultiVersionNaming :one
+UPDATE multi_version_settings SET
+    version_naming = ?,
+    updated_at = CURRENT_TIMESTAMP
+WHERE id = 1
+RETURNING id, enabled, dry_run_completed, last_migration_at, created_at, updated_at, version_naming
+`
+
+func (q *Queries) SetMultiVersionNaming(ctx context.Context, versionNaming string) (*MultiVersionSetting, error) {
+	row := q.db.QueryRowContext(ctx, setMultiVersionNaming, versionNaming)
+	var i MultiVersionSetting
+	err := row.Scan(
+		&i.ID,
+		&i.Enabled,
+		&i.DryRunCompleted,
+		&i.LastMigrationAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.VersionNaming,
 	)
 	return &i, err
 }
@@ -1937,7 +2113,7 @@ func (q *Queries) UpdateAllMovieSlotStatuses(ctx context.Context, arg UpdateAllM
 }
 
 const updateEpisodeFileSlot = `-- name: UpdateEpisodeFileSlot :one
-UPDATE episode_files SET slot_id = ? WHERE id = ? RETURNING id, episode_id, path, size, quality, video_codec, audio_codec, resolution, created_at, quality_id, original_path, original_filename, imported_at, slot_id, audio_channels, dynamic_range
+UPDATE episode_files SET slot_id = ? WHERE id = ? RETURNING id, episode_id, path, size, quality, video_codec, audio_codec, resolution, created_at, quality_id, original_path, original_filename, imported_at, slot_id, audio_channels, dynamic_range, primary_file_id, dolby_vision_profile, audio_tracks
 `
 
 type UpdateEpisodeFileSlotParams struct {
@@ -1965,6 +2141,9 @@ func (q *Queries) UpdateEpisodeFileSlot(ctx context.Context, arg UpdateEpisodeFi
 		&i.SlotID,
 		&i.AudioChannels,
 		&i.DynamicRange,
+		&i.PrimaryFileID,
+		&i.DolbyVisionProfile,
+		&i.AudioTracks,
 	)
 	return &i, err
 }
@@ -2091,7 +2270,7 @@ func (q *Queries) UpdateEpisodeSlotStatusWithDetails(ctx context.Context, arg Up
 }
 
 const updateMovieFileSlot = `-- name: UpdateMovieFileSlot :one
-UPDATE movie_files SET slot_id = ? WHERE id = ? RETURNING id, movie_id, path, size, quality, video_codec, audio_codec, resolution, created_at, quality_id, original_path, original_filename, imported_at, slot_id, audio_channels, dynamic_range
+UPDATE movie_files SET slot_id = ? WHERE id = ? RETURNING id, movie_id, path, size, quality, video_codec, audio_codec, resolution, created_at, quality_id, original_path, original_filename, imported_at, slot_id, audio_channels, dynamic_range, edition, dolby_vision_profile, audio_tracks
 `
 
 type UpdateMovieFileSlotParams struct {
@@ -2119,6 +2298,9 @@ func (q *Queries) UpdateMovieFileSlot(ctx context.Context, arg UpdateMovieFileSl
 		&i.SlotID,
 		&i.AudioChannels,
 		&i.DynamicRange,
+		&i.Edition,
+		&i.DolbyVisionProfile,
+		&i.AudioTracks,
 	)
 	return &i, err
 }
@@ -2251,7 +2433,7 @@ UPDATE multi_version_settings SET
     last_migration_at = ?,
     updated_at = CURRENT_TIMESTAMP
 WHERE id = 1
-RETURNING id, enabled, dry_run_completed, last_migration_at, created_at, updated_at
+RETURNING id, enabled, dry_run_completed, last_migration_at, created_at, updated_at, version_naming
 `
 
 type UpdateMultiVersionSettingsParams struct {
@@ -2270,6 +2452,7 @@ func (q *Queries) UpdateMultiVersionSettings(ctx context.Context, arg UpdateMult
 		&i.LastMigrationAt,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.VersionNaming,
 	)
 	return &i, err
 }
@@ -30,6 +30,10 @@ type Collector struct {
 	Queries        *sqlc.Queries
 	Logger         *zerolog.Logger
 	BackoffChecker BackoffChecker
+
+	// SeasonPackMissingPercent is the share of monitored episodes that must be
+	// missing before a season is searched as a pack. Zero means all of them.
+	SeasonPackMissingPercent int
 }
 
 // CollectWantedItems gathers all missing and upgrade-eligible movies and episodes.
@@ -129,7 +133,7 @@ func collectMissingEpisodes(ctx context.Context, c *Collector) ([]module.Searcha
 }
 
 func buildMissingItems(ctx context.Context, c *Collector, seriesID int64, seasonNumber int, episodes []*sqlc.ListMissingEpisodesRow) []module.SearchableItem {
-	if !IsSeasonPackEligible(ctx, c.Queries, c.Logger, seriesID, seasonNumber) {
+	if !IsSeasonPackEligible(ctx, c.Queries, c.Logger, seriesID, seasonNumber, c.SeasonPackMissingPercent) {
 		return buildIndividualMissingItems(ctx, c, episodes)
 	}
 
@@ -212,8 +216,15 @@ func findMaxQualityID(episodes []*sqlc.ListEpisodeUpgradeCandidatesRow) int {
 	return maxQualityID
 }
 
-// IsSeasonPackEligible checks if ALL episodes in a season are released, monitored, and missing.
-func IsSeasonPackEligible(ctx context.Context, queries *sqlc.Queries, logger *zerolog.Logger, seriesID int64, seasonNumber int) bool {
+// DefaultSeasonPackMissingPercent only searches for a season pack when every
+// episode in the season is missing.
+const DefaultSeasonPackMissingPercent = 100
+
+// IsSeasonPackEligible checks if a monitored season should be searched as a pack.
+// At 100 percent (or zero) every episode must be released, monitored, and missing.
+// Below that, the season qualifies once at least minMissingPercent of its
+// monitored episodes are missing; the pack then covers episodes already on disk.
+func IsSeasonPackEligible(ctx context.Context, queries *sqlc.Queries, logger *zerolog.Logger, seriesID int64, seasonNumber, minMissingPercent int) bool {
 	season, err := queries.GetSeasonByNumber(ctx, sqlc.GetSeasonByNumberParams{
 		SeriesID:     seriesID,
 		SeasonNumber: int64(seasonNumber),
@@ -235,13 +246,34 @@ func IsSeasonPackEligible(ctx context.Context, queries *sqlc.Queries, logger *ze
 		return false
 	}
 
+	return seasonMissingEnough(episodes, minMissingPercent)
+}
+
+// seasonMissingEnough reports whether enough of a season's episodes are missing
+// to search for it as a pack.
+func seasonMissingEnough(episodes []*sqlc.Episode, minMissingPercent int) bool {
+	if minMissingPercent <= 0 || minMissingPercent >= DefaultSeasonPackMissingPercent {
+		for _, ep := range episodes {
+			if !ep.Monitored || ep.Status != "missing" {
+				return false
+			}
+		}
+		return true
+	}
+
+	var monitored, missing int
 	for _, ep := range episodes {
-		if !ep.Monitored || ep.Status != "missing" {
-			return false
+		if !ep.Monitored {
+			continue
+		}
+		monitored++
+		if ep.Status == "missing" {
+			missing++
 		}
 	}
 
-	return true
+	// A single missing episode is always searched on its own
+	return missing > 1 && missing*100 >= monitored*minMissingPercent
 }
 
 // IsSeasonPackUpgradeEligible checks if ALL monitored episodes in a season are upgradable.
@@ -284,6 +316,12 @@ func movieToWantedItem(ctx context.Context, queries *sqlc.Queries, movie *sqlc.M
 	if movie.Year.Valid {
 		extra["year"] = int(movie.Year.Int64)
 	}
+	if movie.Runtime.Valid {
+		extra["runtime"] = int(movie.Runtime.Int64)
+	}
+	if movie.EditionPreference.Valid {
+		extra["editionPreference"] = movie.EditionPreference.String
+	}
 
 	var profileID int64
 	if movie.QualityProfileID.Valid {
@@ -292,26 +330,31 @@ func movieToWantedItem(ctx context.Context, queries *sqlc.Queries, movie *sqlc.M
 
 	var currentQID *int64
 	if movie.Status == "upgradable" || movie.Status == "available" {
-		currentQID = findMovieMaxQuality(ctx, queries, movie.ID)
+		var revision string
+		currentQID, revision = findMovieMaxQuality(ctx, queries, movie.ID)
+		extra["currentRevision"] = revision
 	}
 
 	return module.NewWantedItem(module.TypeMovie, string(MediaTypeMovie), movie.ID, movie.Title, extIDs, profileID, currentQID, module.SearchParams{Extra: extra})
 }
 
-func findMovieMaxQuality(ctx context.Context, queries *sqlc.Queries, movieID int64) *int64 {
+// findMovieMaxQuality returns the highest file quality of a movie and the
+// revision of the file holding it.
+func findMovieMaxQuality(ctx context.Context, queries *sqlc.Queries, movieID int64) (qualityID *int64, revision string) {
 	files, err := queries.GetMovieFilesWithImportInfo(ctx, movieID)
 	if err != nil || len(files) == 0 {
 		qid := int64(0)
-		return &qid // HasFile=true but quality unknown
+		return &qid, "" // HasFile=true but quality unknown
 	}
 
 	var maxQID int64
 	for _, f := range files {
 		if f.QualityID.Valid && f.QualityID.Int64 > maxQID {
 			maxQID = f.QualityID.Int64
+			revision = module.FileRevision(f.OriginalFilename.String, f.Path)
 		}
 	}
-	return &maxQID
+	return &maxQID, revision
 }
 
 func buildMovieExternalIDs(movie *sqlc.Movie) map[string]string {
@@ -339,6 +382,13 @@ func MovieUpgradeCandidateToWantedItem(movie *sqlc.ListMovieUpgradeCandidatesRow
 	if movie.Year.Valid {
 		extra["year"] = int(movie.Year.Int64)
 	}
+	if movie.Runtime.Valid {
+		extra["runtime"] = int(movie.Runtime.Int64)
+	}
+	if movie.EditionPreference.Valid {
+		extra["editionPreference"] = movie.EditionPreference.String
+	}
+	extra["currentRevision"] = module.FileRevision(movie.CurrentOriginalFilename.String, movie.CurrentFilePath)
 
 	var profileID int64
 	if movie.QualityProfileID.Valid {
@@ -368,6 +418,10 @@ func EpisodeUpgradeCandidateToWantedItem(row *sqlc.ListEpisodeUpgradeCandidatesR
 	if row.SeriesYear.Valid {
 		extra["year"] = int(row.SeriesYear.Int64)
 	}
+	if row.SeriesRuntime.Valid {
+		extra["runtime"] = int(row.SeriesRuntime.Int64)
+	}
+	extra["currentRevision"] = module.FileRevision(row.CurrentOriginalFilename.String, row.CurrentFilePath)
 
 	var profileID int64
 	if row.SeriesQualityProfileID.Valid {
@@ -410,6 +464,9 @@ func missingEpisodeRowToWantedItem(ep *sqlc.ListMissingEpisodesRow) module.Searc
 	if ep.SeriesYear.Valid {
 		extra["year"] = int(ep.SeriesYear.Int64)
 	}
+	if ep.SeriesRuntime.Valid {
+		extra["runtime"] = int(ep.SeriesRuntime.Int64)
+	}
 
 	var profileID int64
 	if ep.SeriesQualityProfileID.Valid {
@@ -488,6 +545,9 @@ func EpisodeToWantedItem(ctx context.Context, queries *sqlc.Queries, logger *zer
 	if series.Year.Valid {
 		extra["year"] = int(series.Year.Int64)
 	}
+	if series.Runtime.Valid {
+		extra["runtime"] = int(series.Runtime.Int64)
+	}
 
 	var profileID int64
 	if series.QualityProfileID.Valid {
@@ -496,26 +556,31 @@ func EpisodeToWantedItem(ctx context.Context, queries *sqlc.Queries, logger *zer
 
 	var currentQID *int64
 	if episode.Status == "upgradable" || episode.Status == "available" {
-		currentQID = findEpisodeMaxQuality(ctx, queries, episode.ID)
+		var revision string
+		currentQID, revision = findEpisodeMaxQuality(ctx, queries, episode.ID)
+		extra["currentRevision"] = revision
 	}
 
 	return module.NewWantedItem(module.TypeTV, string(MediaTypeEpisode), episode.ID, series.Title, extIDs, profileID, currentQID, module.SearchParams{Extra: extra})
 }
 
-func findEpisodeMaxQuality(ctx context.Context, queries *sqlc.Queries, episodeID int64) *int64 {
+// findEpisodeMaxQuality returns the highest file quality of an episode and
+// the revision of the file holding it.
+func findEpisodeMaxQuality(ctx context.Context, queries *sqlc.Queries, episodeID int64) (qualityID *int64, revision string) {
 	files, err := queries.ListEpisodeFilesByEpisode(ctx, episodeID)
 	if err != nil || len(files) == 0 {
 		qid := int64(0)
-		return &qid
+		return &qid, ""
 	}
 
 	var maxQID int64
 	for _, f := range files {
 		if f.QualityID.Valid && f.QualityID.Int64 > maxQID {
 			maxQID = f.QualityID.Int64
+			revision = module.FileRevision(f.OriginalFilename.String, f.Path)
 		}
 	}
-	return &maxQID
+	return &maxQID, revision
 }
 
 func buildSeriesExternalIDsFromSeries(series *sqlc.Series) map[string]string {
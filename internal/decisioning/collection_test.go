@@ -138,7 +138,7 @@ func TestIsSeasonPackEligible_AllMissing(t *testing.T) {
 		createEpisode(t, q, seriesID, 3, i, "missing")
 	}
 
-	if !IsSeasonPackEligible(ctx, q, &tdb.Logger, seriesID, 3) {
+	if !IsSeasonPackEligible(ctx, q, &tdb.Logger, seriesID, 3, DefaultSeasonPackMissingPercent) {
 		t.Error("expected season pack eligible when all 13 episodes are missing")
 	}
 }
@@ -204,7 +204,7 @@ func TestIsSeasonPackEligible_MissingPlusUnreleased(t *testing.T) {
 		createEpisode(t, q, seriesID, 3, i, "unreleased")
 	}
 
-	if IsSeasonPackEligible(ctx, q, &tdb.Logger, seriesID, 3) {
+	if IsSeasonPackEligible(ctx, q, &tdb.Logger, seriesID, 3, DefaultSeasonPackMissingPercent) {
 		t.Error("season pack should NOT be eligible when unreleased episodes present")
 	}
 }
@@ -224,7 +224,7 @@ func TestIsSeasonPackEligible_SingleMissingPlusUnreleased(t *testing.T) {
 		createEpisode(t, q, seriesID, 3, i, "unreleased")
 	}
 
-	if IsSeasonPackEligible(ctx, q, &tdb.Logger, seriesID, 3) {
+	if IsSeasonPackEligible(ctx, q, &tdb.Logger, seriesID, 3, DefaultSeasonPackMissingPercent) {
 		t.Error("season pack should NOT be eligible with unreleased episodes")
 	}
 }
@@ -245,11 +245,35 @@ func TestIsSeasonPackEligible_PartiallyAvailable(t *testing.T) {
 		createEpisode(t, q, seriesID, 2, i, "missing")
 	}
 
-	if IsSeasonPackEligible(ctx, q, &tdb.Logger, seriesID, 2) {
+	if IsSeasonPackEligible(ctx, q, &tdb.Logger, seriesID, 2, DefaultSeasonPackMissingPercent) {
 		t.Error("season pack should NOT be eligible when E01 is available")
 	}
 }
 
+// 1 available + 6 missing (86%) — eligible once the threshold is 80%, but not at 90%
+func TestIsSeasonPackEligible_MissingThreshold(t *testing.T) {
+	tdb := testutil.NewTestDB(t)
+	defer tdb.Close()
+	q := sqlc.New(tdb.Conn)
+	ctx := context.Background()
+	profileID := createProfile(t, tdb)
+
+	seriesID := createSeries(t, q, "The Last of Us", 100088, profileID, "continuing")
+	createSeason(t, q, seriesID, 2)
+	epID := createEpisode(t, q, seriesID, 2, 1, "available")
+	createEpisodeFile(t, q, epID, 10) // WEBDL-1080p
+	for i := int64(2); i <= 7; i++ {
+		createEpisode(t, q, seriesID, 2, i, "missing")
+	}
+
+	if !IsSeasonPackEligible(ctx, q, &tdb.Logger, seriesID, 2, 80) {
+		t.Error("expected season pack eligible when 6 of 7 episodes are missing at an 80% threshold")
+	}
+	if IsSeasonPackEligible(ctx, q, &tdb.Logger, seriesID, 2, 90) {
+		t.Error("season pack should NOT be eligible when 6 of 7 episodes are missing at a 90% threshold")
+	}
+}
+
 // Single episode doesn't qualify for season pack (needs >1)
 func TestIsSeasonPackEligible_SingleEpisode(t *testing.T) {
 	tdb := testutil.NewTestDB(t)
@@ -262,7 +286,7 @@ func TestIsSeasonPackEligible_SingleEpisode(t *testing.T) {
 	createSeason(t, q, seriesID, 1)
 	createEpisode(t, q, seriesID, 1, 1, "missing")
 
-	if IsSeasonPackEligible(ctx, q, &tdb.Logger, seriesID, 1) {
+	if IsSeasonPackEligible(ctx, q, &tdb.Logger, seriesID, 1, DefaultSeasonPackMissingPercent) {
 		t.Error("season pack should NOT be eligible with only 1 episode")
 	}
 }
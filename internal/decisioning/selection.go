@@ -7,9 +7,30 @@ import (
 
 	"github.com/slipstream/slipstream/internal/indexer/types"
 	"github.com/slipstream/slipstream/internal/library/quality"
+	"github.com/slipstream/slipstream/internal/library/scanner"
 	"github.com/slipstream/slipstream/internal/module"
+	"github.com/slipstream/slipstream/internal/module/parseutil"
 )
 
+// Rejection reasons reported by EvaluateReleases for quality checks.
+const (
+	RejectionQualityNotAcceptable  = "quality not acceptable"
+	RejectionCurrentQualityUnknown = "existing file quality unknown"
+	RejectionReleaseQualityUnknown = "release quality unknown"
+	RejectionNotAnUpgrade          = "not an upgrade"
+	RejectionSizeOutOfRange        = "size outside quality limits"
+	RejectionLanguageNotAccepted   = "language not accepted by language profile"
+	RejectionReleaseGroupForbidden = "release group forbidden by quality profile"
+	RejectionIndexerFlagMissing    = "indexer flag required by quality profile missing"
+)
+
+// ReleaseDecision is a scored release annotated with the outcome of automatic selection.
+type ReleaseDecision struct {
+	types.TorrentInfo
+	Approved   bool     `json:"approved"`
+	Rejections []string `json:"rejections"`
+}
+
 // ReleaseParser converts a raw release title into a ReleaseForFilter.
 // Callers provide an implementation backed by the module registry.
 type ReleaseParser func(title string, size int64, categories []int) *module.ReleaseForFilter
@@ -21,7 +42,8 @@ type ReleaseParser func(title string, size int64, categories []int) *module.Rele
 // Returns nil if no acceptable release is found.
 func SelectBestRelease(releases []types.TorrentInfo, profile *quality.Profile, item module.SearchableItem, strategy module.SearchStrategy, parser ReleaseParser, logger *zerolog.Logger) *types.TorrentInfo {
 	hasFile := module.ItemHasFile(item)
-	currentQualityID := module.ItemCurrentQualityID(item)
+	current := currentFile(item)
+	runtime := module.ItemRuntime(item)
 
 	for i := range releases {
 		release := &releases[i]
@@ -37,7 +59,7 @@ func SelectBestRelease(releases []types.TorrentInfo, profile *quality.Profile, i
 
 		releaseQualityID := extractReleaseQualityID(release)
 
-		if shouldSkipForQuality(release, releaseQualityID, hasFile, currentQualityID, profile, logger) {
+		if shouldSkipForQuality(release, releaseQualityID, hasFile, current, runtime, profile, logger) {
 			continue
 		}
 
@@ -47,6 +69,46 @@ func SelectBestRelease(releases []types.TorrentInfo, profile *quality.Profile, i
 	return nil
 }
 
+// EvaluateReleases applies the same checks as SelectBestRelease to every release
+// and returns all of them in their original order, each with its rejection reasons.
+// A release is approved when it has no rejections.
+func EvaluateReleases(releases []types.TorrentInfo, profile *quality.Profile, item module.SearchableItem, strategy module.SearchStrategy, parser ReleaseParser) []ReleaseDecision {
+	hasFile := module.ItemHasFile(item)
+	current := currentFile(item)
+	runtime := module.ItemRuntime(item)
+
+	decisions := make([]ReleaseDecision, 0, len(releases))
+	for i := range releases {
+		release := &releases[i]
+		rejections := []string{}
+
+		rff := parser(release.Title, release.Size, release.Categories)
+		if reject, reason := strategy.FilterRelease(context.Background(), rff, item); reject {
+			rejections = append(rejections, reason)
+		}
+		releaseQualityID := extractReleaseQualityID(release)
+		if reason := qualityRejection(release, releaseQualityID, hasFile, current, profile); reason != "" {
+			rejections = append(rejections, reason)
+		}
+		if !profile.IsSizeAcceptable(releaseQualityID, release.Size, runtime) {
+			rejections = append(rejections, RejectionSizeOutOfRange)
+		}
+		if IsForbiddenReleaseGroup(release.Title, profile) {
+			rejections = append(rejections, RejectionReleaseGroupForbidden)
+		}
+		if MissingRequiredFlag(release, profile) != "" {
+			rejections = append(rejections, RejectionIndexerFlagMissing)
+		}
+
+		decisions = append(decisions, ReleaseDecision{
+			TorrentInfo: *release,
+			Approved:    len(rejections) == 0,
+			Rejections:  rejections,
+		})
+	}
+	return decisions
+}
+
 func extractReleaseQualityID(release *types.TorrentInfo) int {
 	if release.ScoreBreakdown == nil {
 		return 0
@@ -54,43 +116,90 @@ func extractReleaseQualityID(release *types.TorrentInfo) int {
 	return release.ScoreBreakdown.QualityID
 }
 
-func shouldSkipForQuality(release *types.TorrentInfo, releaseQualityID int, hasFile bool, currentQualityID int, profile *quality.Profile, logger *zerolog.Logger) bool {
-	if releaseQualityID > 0 && !profile.IsAcceptable(releaseQualityID) {
-		logger.Debug().
-			Str("release", release.Title).
-			Int("qualityId", releaseQualityID).
-			Str("qualityName", release.ScoreBreakdown.QualityName).
-			Msg("Rejected - quality not acceptable")
-		return true
+func shouldSkipForQuality(release *types.TorrentInfo, releaseQualityID int, hasFile bool, current existingFile, runtime int, profile *quality.Profile, logger *zerolog.Logger) bool {
+	reason := qualityRejection(release, releaseQualityID, hasFile, current, profile)
+	if reason == "" && !profile.IsSizeAcceptable(releaseQualityID, release.Size, runtime) {
+		reason = RejectionSizeOutOfRange
 	}
-
-	if !hasFile {
+	if reason == "" && IsForbiddenReleaseGroup(release.Title, profile) {
+		reason = RejectionReleaseGroupForbidden
+	}
+	if reason == "" && MissingRequiredFlag(release, profile) != "" {
+		reason = RejectionIndexerFlagMissing
+	}
+	if reason == "" {
 		return false
 	}
+	logger.Debug().
+		Str("release", release.Title).
+		Int("currentQualityId", current.qualityID).
+		Int("releaseQualityId", releaseQualityID).
+		Str("reason", reason).
+		Msg("Skipping release")
+	return true
+}
+
+// existingFile is what SelectBestRelease knows of the file a release would replace.
+type existingFile struct {
+	qualityID     int
+	revision      string
+	revisionKnown bool
+}
 
-	if currentQualityID == 0 {
-		logger.Debug().
-			Str("release", release.Title).
-			Msg("Skipping release - have file but current quality unknown")
-		return true
+func currentFile(item module.SearchableItem) existingFile {
+	revision, known := module.ItemCurrentRevision(item)
+	return existingFile{qualityID: module.ItemCurrentQualityID(item), revision: revision, revisionKnown: known}
+}
+
+// IsRevisionUpgrade reports whether a release of the existing file's quality
+// is a PROPER, REPACK or REAL the existing file is not, which replaces the
+// file even when its quality already meets the cutoff.
+func IsRevisionUpgrade(releaseTitle string, releaseQualityID, currentQualityID int, currentRevision string) bool {
+	if releaseQualityID == 0 || releaseQualityID != currentQualityID {
+		return false
 	}
+	releaseRevision := scanner.ParseFilename(releaseTitle).Revision
+	return parseutil.RevisionRank(releaseRevision) > parseutil.RevisionRank(currentRevision)
+}
 
-	if releaseQualityID == 0 {
-		logger.Debug().
-			Str("release", release.Title).
-			Int("currentQualityId", currentQualityID).
-			Msg("Skipping release with unknown quality - already have file")
-		return true
+// IsForbiddenReleaseGroup reports whether a release title carries a release
+// group the profile forbids.
+func IsForbiddenReleaseGroup(title string, profile *quality.Profile) bool {
+	if len(profile.ReleaseGroups.Forbidden) == 0 {
+		return false
 	}
+	return profile.ReleaseGroups.IsForbidden(parseutil.ParseReleaseGroup(title))
+}
 
-	if !profile.IsUpgrade(currentQualityID, releaseQualityID) {
-		logger.Debug().
-			Str("release", release.Title).
-			Int("currentQualityId", currentQualityID).
-			Int("releaseQualityId", releaseQualityID).
-			Msg("Skipping release - not an upgrade")
-		return true
+// MissingRequiredFlag returns the freeleech or internal flag the profile
+// requires that the release lacks, or "" if it has every required flag.
+// Usenet releases carry no tracker flags and are never rejected for them.
+func MissingRequiredFlag(release *types.TorrentInfo, profile *quality.Profile) string {
+	if release.Protocol != types.ProtocolTorrent {
+		return ""
 	}
+	return profile.IndexerFlags.MissingRequired(release.Freeleech, release.Internal)
+}
 
-	return false
+// qualityRejection returns why a release's quality disqualifies it, or "" if it is acceptable.
+func qualityRejection(release *types.TorrentInfo, releaseQualityID int, hasFile bool, current existingFile, profile *quality.Profile) string {
+	if releaseQualityID > 0 && !profile.IsAcceptable(releaseQualityID) {
+		return RejectionQualityNotAcceptable
+	}
+	if !hasFile {
+		return ""
+	}
+	if current.qualityID == 0 {
+		return RejectionCurrentQualityUnknown
+	}
+	if releaseQualityID == 0 {
+		return RejectionReleaseQualityUnknown
+	}
+	if current.revisionKnown && IsRevisionUpgrade(release.Title, releaseQualityID, current.qualityID, current.revision) {
+		return ""
+	}
+	if !profile.IsUpgrade(current.qualityID, releaseQualityID) {
+		return RejectionNotAnUpgrade
+	}
+	return ""
 }
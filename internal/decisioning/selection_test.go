@@ -4,6 +4,7 @@ import (
 	"context"
 	"path/filepath"
 	"regexp"
+	"slices"
 	"sort"
 	"strconv"
 	"strings"
@@ -14,6 +15,7 @@ import (
 
 	"github.com/slipstream/slipstream/internal/indexer/scoring"
 	"github.com/slipstream/slipstream/internal/indexer/types"
+	"github.com/slipstream/slipstream/internal/library/language"
 	"github.com/slipstream/slipstream/internal/library/quality"
 	"github.com/slipstream/slipstream/internal/module"
 	"github.com/slipstream/slipstream/internal/module/parseutil"
@@ -567,3 +569,200 @@ func safeQualityID(t *types.TorrentInfo) int {
 	}
 	return t.ScoreBreakdown.QualityID
 }
+
+func TestEvaluateReleases_ReportsRejections(t *testing.T) {
+	profile := hd1080pProfile()
+	item := testEpisodeItem(10, 5, "Severance", 2, 3, profile.ID, nil)
+
+	releases := []types.TorrentInfo{
+		makeTorrent("Severance.S02E03.2160p.WEB-DL.x265", "WEB-DL", 2160, 150),
+		makeTorrent("Severance.S02E03.1080p.WEB-DL.x264", "WEB-DL", 1080, 100),
+		makeTorrent("Severance.S02E04.1080p.WEB-DL.x264", "WEB-DL", 1080, 90),
+	}
+	scoreAndSort(releases, profile, item)
+
+	decisions := EvaluateReleases(releases, profile, item, tvStrategy, testReleaseParser)
+	if len(decisions) != len(releases) {
+		t.Fatalf("EvaluateReleases() returned %d decisions, want %d", len(decisions), len(releases))
+	}
+
+	byTitle := make(map[string]ReleaseDecision, len(decisions))
+	for _, d := range decisions {
+		byTitle[d.Title] = d
+	}
+
+	if d := byTitle["Severance.S02E03.1080p.WEB-DL.x264"]; !d.Approved || len(d.Rejections) != 0 {
+		t.Errorf("1080p episode: approved = %v, rejections = %v; want approved", d.Approved, d.Rejections)
+	}
+	if d := byTitle["Severance.S02E03.2160p.WEB-DL.x265"]; d.Approved || !slices.Contains(d.Rejections, RejectionQualityNotAcceptable) {
+		t.Errorf("2160p episode: rejections = %v, want %q", d.Rejections, RejectionQualityNotAcceptable)
+	}
+	if d := byTitle["Severance.S02E04.1080p.WEB-DL.x264"]; d.Approved || len(d.Rejections) == 0 {
+		t.Errorf("wrong episode: approved = %v, want rejected", d.Approved)
+	}
+
+	best := SelectBestRelease(releases, profile, item, tvStrategy, testReleaseParser, logger)
+	if best == nil || best.Title != "Severance.S02E03.1080p.WEB-DL.x264" {
+		t.Errorf("SelectBestRelease() disagrees with EvaluateReleases, got %v", best)
+	}
+}
+
+func TestSelectBestRelease_SizeOutOfRange(t *testing.T) {
+	profile := hd1080pProfile()
+	for i := range profile.Items {
+		if profile.Items[i].Quality.ID == 11 {
+			profile.Items[i].MinSize = 20
+			profile.Items[i].MaxSize = 200
+		}
+	}
+	item := module.NewWantedItem(module.TypeMovie, string(MediaTypeMovie), 1, "Dune Part Two", nil, profile.ID, nil,
+		module.SearchParams{Extra: map[string]any{"year": 2024, "runtime": 100}})
+
+	fake := makeTorrent("Dune.Part.Two.2024.1080p.BluRay.x264", "BluRay", 1080, 200)
+	fake.Size = 400 << 20
+	web := makeTorrent("Dune.Part.Two.2024.1080p.WEB-DL.x264", "WEB-DL", 1080, 50)
+	web.Size = 5 << 30
+	releases := []types.TorrentInfo{fake, web}
+	scoreAndSort(releases, profile, item)
+
+	best := SelectBestRelease(releases, profile, item, movieStrategy, testReleaseParser, logger)
+	if best == nil || best.Title != web.Title {
+		t.Fatalf("SelectBestRelease() = %v, want %s", best, web.Title)
+	}
+
+	for _, d := range EvaluateReleases(releases, profile, item, movieStrategy, testReleaseParser) {
+		if d.Title == fake.Title && !slices.Contains(d.Rejections, RejectionSizeOutOfRange) {
+			t.Errorf("undersized release rejections = %v, want %q", d.Rejections, RejectionSizeOutOfRange)
+		}
+	}
+}
+
+func TestSelectBestRelease_LanguageProfile(t *testing.T) {
+	profile := hd1080pProfile()
+	item := module.NewWantedItem(module.TypeMovie, string(MediaTypeMovie), 1, "Dune Part Two", nil, profile.ID, nil,
+		module.SearchParams{Extra: map[string]any{"year": 2024}})
+	parser := func(title string, size int64, categories []int) *module.ReleaseForFilter {
+		rff := testReleaseParser(title, size, categories)
+		rff.Languages = parseutil.ParseLanguages(title)
+		return rff
+	}
+
+	english := makeTorrent("Dune.Part.Two.2024.1080p.BluRay.x264", "BluRay", 1080, 200)
+	french := makeTorrent("Dune.Part.Two.2024.FRENCH.1080p.BluRay.x264", "BluRay", 1080, 150)
+	multi := makeTorrent("Dune.Part.Two.2024.MULTI.1080p.WEB-DL.x264", "WEB-DL", 1080, 50)
+	releases := []types.TorrentInfo{english, french, multi}
+	scoreAndSort(releases, profile, item)
+
+	required := &language.Profile{Languages: []string{"French"}, Required: true, AcceptMulti: true}
+	best := SelectBestRelease(releases, profile, item, WithLanguageProfile(movieStrategy, required), parser, logger)
+	if best == nil || best.Title != french.Title {
+		t.Fatalf("SelectBestRelease() = %v, want %s", best, french.Title)
+	}
+
+	decisions := EvaluateReleases(releases, profile, item, WithLanguageProfile(movieStrategy, required), parser)
+	for _, d := range decisions {
+		rejected := slices.Contains(d.Rejections, RejectionLanguageNotAccepted)
+		if rejected != (d.Title == english.Title) {
+			t.Errorf("%s rejections = %v", d.Title, d.Rejections)
+		}
+	}
+
+	preferred := &language.Profile{Languages: []string{"French"}}
+	if got := WithLanguageProfile(movieStrategy, preferred); got != movieStrategy {
+		t.Error("WithLanguageProfile() wrapped a profile that is not required")
+	}
+}
+
+// A PROPER of the current quality replaces the file only when its revision is known
+func TestSelectBestRelease_SameQualityProper(t *testing.T) {
+	profile := hd1080pProfile()
+	releases := func() []types.TorrentInfo {
+		return []types.TorrentInfo{
+			makeTorrent("Inception.2010.720p.WEB-DL.x264", "WEB-DL", 720, 80),
+			makeTorrent("Inception.2010.PROPER.720p.WEB-DL.x264", "WEB-DL", 720, 40),
+		}
+	}
+	newItem := func(extra map[string]any) module.SearchableItem {
+		extra["year"] = 2010
+		return module.NewWantedItem(module.TypeMovie, string(MediaTypeMovie), 2, "Inception",
+			map[string]string{"tmdbId": "27205"}, profile.ID, int64Ptr(6), module.SearchParams{Extra: extra})
+	}
+
+	item := newItem(map[string]any{"currentRevision": ""})
+	rels := releases()
+	scoreAndSort(rels, profile, item)
+	best := SelectBestRelease(rels, profile, item, movieStrategy, testReleaseParser, logger)
+	if best == nil || !strings.Contains(best.Title, "PROPER") {
+		t.Fatalf("expected the PROPER to be selected, got %v", best)
+	}
+
+	item = newItem(map[string]any{"currentRevision": "PROPER"})
+	rels = releases()
+	scoreAndSort(rels, profile, item)
+	if best := SelectBestRelease(rels, profile, item, movieStrategy, testReleaseParser, logger); best != nil {
+		t.Errorf("expected nil when the file is already a PROPER, got %s", best.Title)
+	}
+
+	item = newItem(map[string]any{})
+	rels = releases()
+	scoreAndSort(rels, profile, item)
+	if best := SelectBestRelease(rels, profile, item, movieStrategy, testReleaseParser, logger); best != nil {
+		t.Errorf("expected nil when the current revision is unknown, got %s", best.Title)
+	}
+}
+
+// Torrents missing a flag the profile requires are skipped and reported
+func TestSelectBestRelease_RequiredIndexerFlag(t *testing.T) {
+	profile := hd1080pProfile()
+	profile.IndexerFlags = quality.IndexerFlagSettings{Freeleech: quality.FlagRequire}
+	item := testMovieItem(1, "Inception", 2010, 27205, profile.ID, nil)
+
+	releases := []types.TorrentInfo{
+		makeTorrent("Inception.2010.1080p.BluRay.x264-SPARKS", "BluRay", 1080, 500),
+		makeTorrent("Inception.2010.1080p.BluRay.x264-AMIABLE", "BluRay", 1080, 50),
+	}
+	for i := range releases {
+		releases[i].Protocol = types.ProtocolTorrent
+	}
+	releases[1].Freeleech = true
+	scoreAndSort(releases, profile, item)
+
+	best := SelectBestRelease(releases, profile, item, movieStrategy, testReleaseParser, logger)
+	if best == nil || !best.Freeleech {
+		t.Fatalf("expected the freeleech release, got %v", best)
+	}
+
+	decisions := EvaluateReleases(releases, profile, item, movieStrategy, testReleaseParser)
+	for _, d := range decisions {
+		missing := slices.Contains(d.Rejections, RejectionIndexerFlagMissing)
+		if missing == d.Freeleech {
+			t.Errorf("%s: rejections = %v", d.Title, d.Rejections)
+		}
+	}
+}
+
+// Releases from a group the profile forbids are skipped and reported
+func TestSelectBestRelease_ForbiddenReleaseGroup(t *testing.T) {
+	profile := hd1080pProfile()
+	profile.ReleaseGroups = quality.ReleaseGroupSettings{Forbidden: []string{"yify"}}
+	item := testMovieItem(1, "Inception", 2010, 27205, profile.ID, nil)
+
+	releases := []types.TorrentInfo{
+		makeTorrent("Inception.2010.1080p.BluRay.x264-YIFY", "BluRay", 1080, 500),
+		makeTorrent("Inception.2010.1080p.BluRay.x264-SPARKS", "BluRay", 1080, 50),
+	}
+	scoreAndSort(releases, profile, item)
+
+	best := SelectBestRelease(releases, profile, item, movieStrategy, testReleaseParser, logger)
+	if best == nil || !strings.HasSuffix(best.Title, "-SPARKS") {
+		t.Fatalf("expected the SPARKS release, got %v", best)
+	}
+
+	decisions := EvaluateReleases(releases, profile, item, movieStrategy, testReleaseParser)
+	for _, d := range decisions {
+		forbidden := slices.Contains(d.Rejections, RejectionReleaseGroupForbidden)
+		if forbidden != strings.HasSuffix(d.Title, "-YIFY") {
+			t.Errorf("%s: rejections = %v", d.Title, d.Rejections)
+		}
+	}
+}
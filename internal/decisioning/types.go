@@ -3,6 +3,7 @@ package decisioning
 import (
 	"context"
 
+	"github.com/slipstream/slipstream/internal/library/language"
 	"github.com/slipstream/slipstream/internal/module"
 )
 
@@ -36,6 +37,32 @@ func (DefaultStrategy) SuppressChildSearches(_ module.EntityType, _ int64, _ int
 	return nil
 }
 
+// languageStrategy wraps a SearchStrategy and additionally rejects releases whose
+// audio languages are not accepted by a required language profile.
+type languageStrategy struct {
+	module.SearchStrategy
+	profile *language.Profile
+}
+
+// WithLanguageProfile returns a strategy that enforces the given language profile.
+// Profiles that are nil or not required only affect scoring, so the strategy is returned unchanged.
+func WithLanguageProfile(strategy module.SearchStrategy, profile *language.Profile) module.SearchStrategy {
+	if profile == nil || !profile.Required {
+		return strategy
+	}
+	return languageStrategy{SearchStrategy: strategy, profile: profile}
+}
+
+func (s languageStrategy) FilterRelease(ctx context.Context, release *module.ReleaseForFilter, item module.SearchableItem) (reject bool, reason string) {
+	if reject, reason := s.SearchStrategy.FilterRelease(ctx, release, item); reject {
+		return reject, reason
+	}
+	if !s.profile.Accepts(release.Languages) {
+		return true, RejectionLanguageNotAccepted
+	}
+	return false, ""
+}
+
 // FallbackReleaseParser is a minimal ReleaseParser that returns a ReleaseForFilter
 // with only the raw title, size, and categories. Used when no module registry is available.
 func FallbackReleaseParser(title string, size int64, categories []int) *module.ReleaseForFilter {
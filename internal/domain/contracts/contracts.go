@@ -33,3 +33,12 @@ type FileDeleteHandler interface {
 type QueueTrigger interface {
 	Trigger()
 }
+
+// TagStore reads and writes tag assignments for library items and resources.
+type TagStore interface {
+	EntityTags(ctx context.Context, entityType string, entityID int64) ([]int64, error)
+	SetEntityTags(ctx context.Context, entityType string, entityID int64, tagIDs []int64) error
+	EntityTagMap(ctx context.Context, entityType string) (map[int64][]int64, error)
+	// ItemTags returns the tags of a searchable item, or nil if it cannot be resolved.
+	ItemTags(ctx context.Context, mediaType string, mediaID, seriesID int64) []int64
+}
@@ -15,6 +15,9 @@ type (
 	Client            = types.Client
 	TorrentClient     = types.TorrentClient
 	UsenetClient      = types.UsenetClient
+	FreeSpaceClient   = types.FreeSpaceClient
+	Reannouncer       = types.Reannouncer
+	Rechecker         = types.Rechecker
 	AddOptions        = types.AddOptions
 	DownloadItem      = types.DownloadItem
 	Status            = types.Status
@@ -46,6 +49,7 @@ const (
 	ClientTypeMock            = types.ClientTypeMock
 
 	StatusQueued      = types.StatusQueued
+	StatusChecking    = types.StatusChecking
 	StatusDownloading = types.StatusDownloading
 	StatusPaused      = types.StatusPaused
 	StatusCompleted   = types.StatusCompleted
@@ -23,6 +23,7 @@ type CompletedDownload struct {
 	DownloadPath string
 	MediaType    string
 	Size         int64
+	Seeding      bool // the client is still seeding the torrent
 
 	// Discriminator columns
 	ModuleType string
@@ -37,6 +38,7 @@ type CompletedDownload struct {
 	IsSeasonPack     bool
 	IsCompleteSeries bool
 	TargetSlotID     *int64
+	IndexerID        *int64
 
 	// Internal mapping reference
 	MappingID int64
@@ -107,13 +109,7 @@ func (s *Service) checkClientForCompletions(ctx context.Context, dbClient *sqlc.
 		return nil
 	}
 
-	client, err := s.GetClient(ctx, dbClient.ID)
-	if err != nil {
-		s.logger.Warn().Err(err).Int64("clientId", dbClient.ID).Msg("Failed to get client")
-		return nil
-	}
-
-	downloads, err := client.List(ctx)
+	downloads, err := s.listClientDownloads(ctx, dbClient.ID)
 	if err != nil {
 		s.logger.Warn().Err(err).Int64("clientId", dbClient.ID).Msg("Failed to list downloads")
 		return nil
@@ -160,6 +156,7 @@ func (s *Service) processDownload(d *types.DownloadItem, dbClient *sqlc.Download
 		DownloadPath: contentPath,
 		MediaType:    s.detectMediaType(d.DownloadDir),
 		Size:         d.Size,
+		Seeding:      d.Status == types.StatusSeeding,
 		MappingID:    mapping.ID,
 	}
 
@@ -167,6 +164,9 @@ func (s *Service) processDownload(d *types.DownloadItem, dbClient *sqlc.Download
 	return &cd
 }
 
+// isDownloadComplete reports whether a download is ready for import. Torrents
+// the client is still verifying report StatusChecking and are never complete,
+// even at 100% progress, so a hardlink import never reads unverified data.
 func isDownloadComplete(d *types.DownloadItem) bool {
 	if d.Status == types.StatusCompleted || d.Status == types.StatusSeeding {
 		return true
@@ -211,6 +211,10 @@ func (s *Service) populateCompletedDownloadFields(cd *CompletedDownload, mapping
 		id := mapping.TargetSlotID.Int64
 		cd.TargetSlotID = &id
 	}
+	if mapping.IndexerID.Valid {
+		id := mapping.IndexerID.Int64
+		cd.IndexerID = &id
+	}
 	cd.ImportAttempts = mapping.ImportAttempts
 	if mapping.NextImportRetryAt.Valid {
 		t := mapping.NextImportRetryAt.Time
@@ -278,21 +282,19 @@ func (s *Service) collectActiveDownloadIDs(ctx context.Context, clients []*sqlc.
 	return activeDownloadIDs
 }
 
+// downloadRemovedMessage is the failure reason for downloads that vanished
+// from their client.
+const downloadRemovedMessage = "Download removed from client"
+
 func (s *Service) checkDisappearedMovies(ctx context.Context, activeDownloadIDs map[string]struct{}) error {
 	movies, err := s.queries.ListDownloadingMovies(ctx)
 	if err != nil {
 		return fmt.Errorf("failed to list downloading movies: %w", err)
 	}
 
-	failedParams := sqlc.UpdateMovieStatusWithDetailsParams{
-		Status:           "failed",
-		ActiveDownloadID: sql.NullString{},
-		StatusMessage:    sql.NullString{String: "Download removed from client", Valid: true},
-	}
-
 	for _, m := range movies {
 		if shouldMarkMovieAsDisappeared(m, activeDownloadIDs) {
-			s.markMovieAsDisappeared(ctx, m, failedParams)
+			s.markMovieDownloadFailed(ctx, m, downloadRemovedMessage)
 		}
 	}
 
@@ -311,18 +313,23 @@ func shouldMarkMovieAsDisappeared(m *sqlc.ListDownloadingMoviesRow, activeDownlo
 	return !exists
 }
 
-func (s *Service) markMovieAsDisappeared(ctx context.Context, m *sqlc.ListDownloadingMoviesRow, failedParams sqlc.UpdateMovieStatusWithDetailsParams) {
-	failedParams.ID = m.ID
-	if err := s.queries.UpdateMovieStatusWithDetails(ctx, failedParams); err != nil {
-		s.logger.Warn().Err(err).Int64("movieId", m.ID).Msg("Failed to mark disappeared movie download as failed")
+// markMovieDownloadFailed marks a downloading movie as failed, so it is
+// searched for again, recording why its download failed.
+func (s *Service) markMovieDownloadFailed(ctx context.Context, m *sqlc.ListDownloadingMoviesRow, reason string) {
+	if err := s.queries.UpdateMovieStatusWithDetails(ctx, sqlc.UpdateMovieStatusWithDetailsParams{
+		ID:            m.ID,
+		Status:        "failed",
+		StatusMessage: sql.NullString{String: reason, Valid: true},
+	}); err != nil {
+		s.logger.Warn().Err(err).Int64("movieId", m.ID).Msg("Failed to mark movie download as failed")
 		return
 	}
 
 	downloadID := m.ActiveDownloadID.String
-	s.logger.Info().Int64("movieId", m.ID).Str("downloadId", downloadID).Msg("Download disappeared from client, marked movie as failed")
+	s.logger.Info().Int64("movieId", m.ID).Str("downloadId", downloadID).Str("reason", reason).Msg("Marked movie download as failed")
 
 	if s.statusChangeLogger != nil {
-		_ = s.statusChangeLogger.LogStatusChanged(ctx, "movie", m.ID, "downloading", "failed", "Download removed from client")
+		_ = s.statusChangeLogger.LogStatusChanged(ctx, "movie", m.ID, "downloading", "failed", reason)
 	}
 	if s.broadcaster != nil {
 		s.broadcaster.BroadcastEntity("movie", "movie", m.ID, "updated", nil)
@@ -338,15 +345,9 @@ func (s *Service) checkDisappearedEpisodes(ctx context.Context, activeDownloadID
 		return fmt.Errorf("failed to list downloading episodes: %w", err)
 	}
 
-	epFailedParams := sqlc.UpdateEpisodeStatusWithDetailsParams{
-		Status:           "failed",
-		ActiveDownloadID: sql.NullString{},
-		StatusMessage:    sql.NullString{String: "Download removed from client", Valid: true},
-	}
-
 	for _, ep := range episodes {
 		if shouldMarkEpisodeAsDisappeared(ep, activeDownloadIDs) {
-			s.markEpisodeAsDisappeared(ctx, ep, epFailedParams)
+			s.markEpisodeDownloadFailed(ctx, ep, downloadRemovedMessage)
 		}
 	}
 
@@ -365,18 +366,23 @@ func shouldMarkEpisodeAsDisappeared(ep *sqlc.ListDownloadingEpisodesRow, activeD
 	return !exists
 }
 
-func (s *Service) markEpisodeAsDisappeared(ctx context.Context, ep *sqlc.ListDownloadingEpisodesRow, epFailedParams sqlc.UpdateEpisodeStatusWithDetailsParams) {
-	epFailedParams.ID = ep.ID
-	if err := s.queries.UpdateEpisodeStatusWithDetails(ctx, epFailedParams); err != nil {
-		s.logger.Warn().Err(err).Int64("episodeId", ep.ID).Msg("Failed to mark disappeared episode download as failed")
+// markEpisodeDownloadFailed marks a downloading episode as failed, so it is
+// searched for again, recording why its download failed.
+func (s *Service) markEpisodeDownloadFailed(ctx context.Context, ep *sqlc.ListDownloadingEpisodesRow, reason string) {
+	if err := s.queries.UpdateEpisodeStatusWithDetails(ctx, sqlc.UpdateEpisodeStatusWithDetailsParams{
+		ID:            ep.ID,
+		Status:        "failed",
+		StatusMessage: sql.NullString{String: reason, Valid: true},
+	}); err != nil {
+		s.logger.Warn().Err(err).Int64("episodeId", ep.ID).Msg("Failed to mark episode download as failed")
 		return
 	}
 
 	downloadID := ep.ActiveDownloadID.String
-	s.logger.Info().Int64("episodeId", ep.ID).Str("downloadId", downloadID).Msg("Download disappeared from client, marked episode as failed")
+	s.logger.Info().Int64("episodeId", ep.ID).Str("downloadId", downloadID).Str("reason", reason).Msg("Marked episode download as failed")
 
 	if s.statusChangeLogger != nil {
-		_ = s.statusChangeLogger.LogStatusChanged(ctx, mediaTypeEpisode, ep.ID, "downloading", "failed", "Download removed from client")
+		_ = s.statusChangeLogger.LogStatusChanged(ctx, mediaTypeEpisode, ep.ID, "downloading", "failed", reason)
 	}
 	if s.broadcaster != nil {
 		s.broadcaster.BroadcastEntity("tv", "series", ep.SeriesID, "updated", nil)
@@ -386,14 +392,10 @@ func (s *Service) markEpisodeAsDisappeared(ctx context.Context, ep *sqlc.ListDow
 	}
 }
 
-// GetDownloadPath returns the file path for a specific download.
+// GetDownloadPath returns the local file path for a specific download, with
+// the client's remote path mappings applied.
 func (s *Service) GetDownloadPath(ctx context.Context, clientID int64, downloadID string) (string, error) {
-	client, err := s.GetClient(ctx, clientID)
-	if err != nil {
-		return "", err
-	}
-
-	downloads, err := client.List(ctx)
+	downloads, err := s.listClientDownloads(ctx, clientID)
 	if err != nil {
 		return "", fmt.Errorf("failed to list downloads: %w", err)
 	}
@@ -88,8 +88,8 @@ func (c *Client) AddMagnet(ctx context.Context, magnetURL string, opts *types.Ad
 		return "", fmt.Errorf("unexpected response type for add_torrent_magnet")
 	}
 
-	if opts != nil && opts.Category != "" {
-		_, _ = c.call(ctx, "label.set_torrent", []any{hash, opts.Category})
+	if opts != nil {
+		c.applyLabel(ctx, hash, opts.Category)
 	}
 
 	return hash, nil
@@ -120,18 +120,33 @@ func (c *Client) addFile(ctx context.Context, opts *types.AddOptions) (string, e
 		return "", fmt.Errorf("unexpected response type for add_torrent_file")
 	}
 
-	if opts.Category != "" {
-		_, _ = c.call(ctx, "label.set_torrent", []any{hash, opts.Category})
-	}
+	c.applyLabel(ctx, hash, opts.Category)
 
 	return hash, nil
 }
 
+// applyLabel assigns a label to a torrent, falling back to the client's default
+// category. The label plugin only accepts lowercase names and requires the
+// label to exist, so it is created first. Failures are ignored because the
+// plugin may not be enabled.
+func (c *Client) applyLabel(ctx context.Context, hash, label string) {
+	if label == "" {
+		label = c.config.Category
+	}
+	label = strings.ToLower(strings.TrimSpace(label))
+	if label == "" {
+		return
+	}
+	_, _ = c.call(ctx, "label.add", []any{label})
+	_, _ = c.call(ctx, "label.set_torrent", []any{hash, label})
+}
+
 func (c *Client) List(ctx context.Context) ([]types.DownloadItem, error) {
 	fields := []string{
 		"hash", "name", "state", "progress", "eta", "message", "is_finished",
 		"save_path", "total_size", "total_done", "time_added", "active_time",
 		"ratio", "is_auto_managed", "stop_at_ratio", "remove_at_ratio", "stop_ratio",
+		"download_payload_rate", "upload_payload_rate", "completed_time",
 	}
 
 	resp, err := c.call(ctx, "web.update_ui", []any{fields, map[string]any{}})
@@ -192,6 +207,18 @@ func (c *Client) Resume(ctx context.Context, id string) error {
 	return err
 }
 
+// Reannounce asks the torrent's trackers for peers immediately.
+func (c *Client) Reannounce(ctx context.Context, id string) error {
+	_, err := c.call(ctx, "core.force_reannounce", []any{[]string{strings.ToLower(id)}})
+	return err
+}
+
+// Recheck verifies the torrent's data on disk.
+func (c *Client) Recheck(ctx context.Context, id string) error {
+	_, err := c.call(ctx, "core.force_recheck", []any{[]string{strings.ToLower(id)}})
+	return err
+}
+
 func (c *Client) GetDownloadDir(ctx context.Context) (string, error) {
 	resp, err := c.call(ctx, "core.get_config", []any{})
 	if err != nil {
@@ -211,6 +238,20 @@ func (c *Client) GetDownloadDir(ctx context.Context) (string, error) {
 	return downloadDir, nil
 }
 
+func (c *Client) GetFreeSpace(ctx context.Context) (int64, error) {
+	resp, err := c.call(ctx, "core.get_free_space", []any{})
+	if err != nil {
+		return 0, err
+	}
+
+	freeSpace, ok := resp.(float64)
+	if !ok {
+		return 0, fmt.Errorf("unexpected response type for get_free_space")
+	}
+
+	return int64(freeSpace), nil
+}
+
 func (c *Client) SetSeedLimits(ctx context.Context, id string, ratio float64, seedTime time.Duration) error {
 	options := make(map[string]any)
 
@@ -445,6 +486,8 @@ func (c *Client) mapToDownloadItem(hash string, torrent map[string]any) types.Do
 		Progress:       progress,
 		Size:           int64(getFloat(torrent, "total_size")),
 		DownloadedSize: int64(getFloat(torrent, "total_done")),
+		DownloadSpeed:  int64(getFloat(torrent, "download_payload_rate")),
+		UploadSpeed:    int64(getFloat(torrent, "upload_payload_rate")),
 		ETA:            etaSeconds,
 		DownloadDir:    getString(torrent, "save_path"),
 	}
@@ -452,6 +495,9 @@ func (c *Client) mapToDownloadItem(hash string, torrent map[string]any) types.Do
 	if timeAdded := getFloat(torrent, "time_added"); timeAdded > 0 {
 		item.AddedAt = time.Unix(int64(timeAdded), 0)
 	}
+	if completed := getFloat(torrent, "completed_time"); completed > 0 {
+		item.CompletedAt = time.Unix(int64(completed), 0)
+	}
 
 	if status == types.StatusWarning {
 		item.Error = getString(torrent, "message")
@@ -465,7 +511,13 @@ func (c *Client) mapStatus(state string, isFinished bool) types.Status {
 		return types.StatusWarning
 	}
 
-	if isFinished && state != "Checking" && state != "Error" {
+	// A finished torrent that has been paused (typically by reaching its stop
+	// ratio) is no longer seeding but is still ready for import.
+	if isFinished && state == "Paused" {
+		return types.StatusCompleted
+	}
+
+	if isFinished && state != "Checking" {
 		return types.StatusSeeding
 	}
 
@@ -474,7 +526,9 @@ func (c *Client) mapStatus(state string, isFinished bool) types.Status {
 		return types.StatusPaused
 	case "Queued":
 		return types.StatusQueued
-	case "Checking", "Moving", "Downloading":
+	case "Checking":
+		return types.StatusChecking
+	case "Moving", "Downloading":
 		return types.StatusDownloading
 	case "Seeding":
 		return types.StatusSeeding
@@ -466,6 +466,41 @@ func TestClient_GetDownloadDir(t *testing.T) {
 	}
 }
 
+func TestClient_GetFreeSpace(t *testing.T) {
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			Method string `json:"method"`
+			Params []any  `json:"params"`
+			ID     int    `json:"id"`
+		}
+		json.NewDecoder(r.Body).Decode(&req)
+
+		switch req.Method {
+		case "auth.login":
+			w.Header().Set("Set-Cookie", "session=test123; Path=/")
+			json.NewEncoder(w).Encode(map[string]any{"result": true, "error": nil, "id": req.ID})
+		case "web.connected":
+			json.NewEncoder(w).Encode(map[string]any{"result": true, "error": nil, "id": req.ID})
+		case "core.get_free_space":
+			json.NewEncoder(w).Encode(map[string]any{"result": 10737418240, "error": nil, "id": req.ID})
+		}
+	})
+
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	client := setupTestClient(server)
+
+	free, err := client.GetFreeSpace(context.Background())
+	if err != nil {
+		t.Fatalf("GetFreeSpace() failed: %v", err)
+	}
+
+	if free != 10737418240 {
+		t.Errorf("expected 10737418240, got %d", free)
+	}
+}
+
 func TestClient_SessionReuse(t *testing.T) {
 	authCalls := 0
 
@@ -612,3 +647,79 @@ func setupTestClient(server *httptest.Server) *Client {
 		Password: "deluge",
 	})
 }
+
+func TestClient_Add_CreatesLabel(t *testing.T) {
+	var calls []string
+	var labelParams []any
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			Method string `json:"method"`
+			Params []any  `json:"params"`
+			ID     int    `json:"id"`
+		}
+		json.NewDecoder(r.Body).Decode(&req)
+		calls = append(calls, req.Method)
+
+		switch req.Method {
+		case "auth.login":
+			w.Header().Set("Set-Cookie", "session=test123; Path=/")
+			json.NewEncoder(w).Encode(map[string]any{"result": true, "error": nil, "id": req.ID})
+		case "core.add_torrent_magnet":
+			json.NewEncoder(w).Encode(map[string]any{"result": "abc123def456", "error": nil, "id": req.ID})
+		case "label.add":
+			json.NewEncoder(w).Encode(map[string]any{"result": nil, "error": map[string]any{"message": "Label already exists", "code": 4}, "id": req.ID})
+		case "label.set_torrent":
+			labelParams = req.Params
+			json.NewEncoder(w).Encode(map[string]any{"result": nil, "error": nil, "id": req.ID})
+		default:
+			json.NewEncoder(w).Encode(map[string]any{"result": true, "error": nil, "id": req.ID})
+		}
+	})
+
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	client := setupTestClient(server)
+	client.config.Category = "SlipStream"
+
+	if _, err := client.Add(context.Background(), &types.AddOptions{URL: "magnet:?xt=urn:btih:abc123"}); err != nil {
+		t.Fatalf("Add() failed: %v", err)
+	}
+
+	labelAdded := false
+	for _, m := range calls {
+		if m == "label.add" {
+			labelAdded = true
+		}
+	}
+	if !labelAdded {
+		t.Errorf("expected label.add to be called, calls: %v", calls)
+	}
+	if len(labelParams) != 2 || labelParams[1] != "slipstream" {
+		t.Errorf("expected label.set_torrent with lowercase default category, got %v", labelParams)
+	}
+}
+
+func TestClient_MapStatus(t *testing.T) {
+	client := NewFromConfig(&types.ClientConfig{Host: "localhost", Port: 8112})
+
+	tests := []struct {
+		state      string
+		isFinished bool
+		want       types.Status
+	}{
+		{"Downloading", false, types.StatusDownloading},
+		{"Seeding", true, types.StatusSeeding},
+		{"Paused", false, types.StatusPaused},
+		{"Paused", true, types.StatusCompleted},
+		{"Queued", true, types.StatusSeeding},
+		{"Checking", true, types.StatusChecking},
+		{"Error", true, types.StatusWarning},
+	}
+
+	for _, tt := range tests {
+		if got := client.mapStatus(tt.state, tt.isFinished); got != tt.want {
+			t.Errorf("mapStatus(%q, %v) = %s, want %s", tt.state, tt.isFinished, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,86 @@
+package downloader
+
+import (
+	"encoding/json"
+	"strings"
+	"time"
+)
+
+// Seeding import modes control when a torrent that is still seeding is imported.
+const (
+	// SeedingImportDelay imports seeding torrents after the client's import delay.
+	SeedingImportDelay = "delay"
+	// SeedingImportHardlink imports seeding torrents as soon as they complete,
+	// hardlinking (or copying) so the torrent keeps seeding from its own files.
+	SeedingImportHardlink = "hardlink"
+)
+
+// ImportDelayOverride replaces a client's import delay for one download
+// category. Categories are module IDs ("movie", "tv"), matching the
+// SlipStream/<Module> folders downloads are placed in.
+type ImportDelayOverride struct {
+	Category     string `json:"category"`
+	DelaySeconds int    `json:"delaySeconds"`
+}
+
+// ImportDelayFor returns the import delay for a download in category, using a
+// matching override if one exists.
+func (c *DownloadClient) ImportDelayFor(category string) time.Duration {
+	for _, o := range c.ImportDelayOverrides {
+		if strings.EqualFold(o.Category, category) {
+			return time.Duration(o.DelaySeconds) * time.Second
+		}
+	}
+	return time.Duration(c.ImportDelaySeconds) * time.Second
+}
+
+// ImportsSeedingImmediately reports whether seeding torrents are imported
+// without waiting for the import delay.
+func (c *DownloadClient) ImportsSeedingImmediately() bool {
+	return c.SeedingImportMode == SeedingImportHardlink
+}
+
+func isValidSeedingImportMode(mode string) bool {
+	return mode == "" || mode == SeedingImportDelay || mode == SeedingImportHardlink
+}
+
+func seedingImportModeOrDefault(mode string) string {
+	if mode == "" {
+		return SeedingImportDelay
+	}
+	return mode
+}
+
+// encodeImportDelayOverrides serializes overrides for the import_delay_overrides
+// column, dropping entries without a category and keeping the first entry per
+// category.
+func encodeImportDelayOverrides(overrides []ImportDelayOverride) string {
+	cleaned := make([]ImportDelayOverride, 0, len(overrides))
+	seen := make(map[string]bool, len(overrides))
+	for _, o := range overrides {
+		category := strings.ToLower(strings.TrimSpace(o.Category))
+		if category == "" || seen[category] {
+			continue
+		}
+		seen[category] = true
+		cleaned = append(cleaned, ImportDelayOverride{Category: category, DelaySeconds: max(o.DelaySeconds, 0)})
+	}
+	data, err := json.Marshal(cleaned)
+	if err != nil {
+		return "[]"
+	}
+	return string(data)
+}
+
+// decodeImportDelayOverrides parses the import_delay_overrides column.
+// Malformed values are treated as no overrides.
+func decodeImportDelayOverrides(raw string) []ImportDelayOverride {
+	overrides := []ImportDelayOverride{}
+	if raw == "" {
+		return overrides
+	}
+	if err := json.Unmarshal([]byte(raw), &overrides); err != nil {
+		return []ImportDelayOverride{}
+	}
+	return overrides
+}
@@ -0,0 +1,59 @@
+package downloader
+
+import (
+	"testing"
+	"time"
+)
+
+func TestDownloadClient_ImportDelayFor(t *testing.T) {
+	client := &DownloadClient{
+		ImportDelaySeconds: 60,
+		ImportDelayOverrides: []ImportDelayOverride{
+			{Category: "tv", DelaySeconds: 300},
+			{Category: "movie", DelaySeconds: 0},
+		},
+	}
+
+	tests := []struct {
+		category string
+		want     time.Duration
+	}{
+		{"tv", 5 * time.Minute},
+		{"TV", 5 * time.Minute},
+		{"movie", 0},
+		{"music", time.Minute},
+		{"", time.Minute},
+	}
+	for _, tt := range tests {
+		if got := client.ImportDelayFor(tt.category); got != tt.want {
+			t.Errorf("ImportDelayFor(%q) = %v, want %v", tt.category, got, tt.want)
+		}
+	}
+}
+
+func TestImportDelayOverridesRoundTrip(t *testing.T) {
+	encoded := encodeImportDelayOverrides([]ImportDelayOverride{
+		{Category: " TV ", DelaySeconds: 120},
+		{Category: "", DelaySeconds: 30},
+		{Category: "tv", DelaySeconds: 999},
+		{Category: "movie", DelaySeconds: -5},
+	})
+
+	got := decodeImportDelayOverrides(encoded)
+	want := []ImportDelayOverride{
+		{Category: "tv", DelaySeconds: 120},
+		{Category: "movie", DelaySeconds: 0},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("decoded %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("override[%d] = %v, want %v", i, got[i], want[i])
+		}
+	}
+
+	if got := decodeImportDelayOverrides("not json"); len(got) != 0 {
+		t.Errorf("decode malformed = %v, want empty", got)
+	}
+}
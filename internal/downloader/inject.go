@@ -0,0 +1,117 @@
+package downloader
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/slipstream/slipstream/internal/downloader/types"
+)
+
+// ErrTorrentDataMismatch means a torrent does not describe the data it would
+// be injected over.
+var ErrTorrentDataMismatch = errors.New("torrent does not match the existing data")
+
+const (
+	injectCheckInterval = 30 * time.Second
+	injectCheckTimeout  = 6 * time.Hour
+)
+
+// InjectTorrent adds a torrent whose data is already on disk, such as a
+// cross-seed of a completed download. The torrent's name and files must match
+// the source torrent's data exactly, otherwise the client would download the
+// release again. It is added paused into the source's directory, rechecked,
+// and only started once the client reports the data complete.
+func (s *Service) InjectTorrent(ctx context.Context, clientID int64, content []byte, source *TorrentInfo, category, name string) (string, error) {
+	meta, err := ParseTorrentMeta(content)
+	if err != nil {
+		return "", err
+	}
+	if meta.Name != source.Name {
+		return "", fmt.Errorf("%w: torrent is named %q, existing data is %q", ErrTorrentDataMismatch, meta.Name, source.Name)
+	}
+	localDir := MapRemotePath(source.DownloadDir, s.clientPathMappings(clientID))
+	if err := verifyTorrentFiles(meta, localDir); err != nil {
+		return "", err
+	}
+
+	client, err := s.GetTorrentClient(ctx, clientID)
+	if err != nil {
+		return "", err
+	}
+
+	torrentID, err := client.Add(ctx, &types.AddOptions{
+		FileContent: content,
+		Name:        name,
+		DownloadDir: source.DownloadDir,
+		Category:    category,
+		Paused:      true,
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to add torrent: %w", err)
+	}
+
+	if rechecker, ok := client.(Rechecker); ok {
+		if err := rechecker.Recheck(ctx, torrentID); err != nil {
+			s.logger.Warn().Err(err).Str("id", torrentID).Msg("Failed to recheck injected torrent")
+		}
+	}
+	go s.resumeWhenVerified(client, torrentID)
+
+	s.logger.Info().Str("torrentId", torrentID).Str("downloadDir", source.DownloadDir).Msg("Injected torrent for existing data")
+	return torrentID, nil
+}
+
+// verifyTorrentFiles checks that every file in the torrent exists under dir
+// with its exact size.
+func verifyTorrentFiles(meta *TorrentMeta, dir string) error {
+	for _, f := range meta.Files {
+		path := filepath.Join(dir, filepath.FromSlash(f.Path))
+		info, err := os.Stat(path)
+		if err != nil {
+			return fmt.Errorf("%w: %s is missing", ErrTorrentDataMismatch, f.Path)
+		}
+		if info.IsDir() || info.Size() != f.Size {
+			return fmt.Errorf("%w: %s is %d bytes, torrent expects %d", ErrTorrentDataMismatch, f.Path, info.Size(), f.Size)
+		}
+	}
+	return nil
+}
+
+// resumeWhenVerified starts an injected torrent once its recheck finds the
+// data complete. A torrent that never verifies is left paused so the client
+// does not download the release again.
+func (s *Service) resumeWhenVerified(client TorrentClient, torrentID string) {
+	ctx, cancel := context.WithTimeout(context.Background(), injectCheckTimeout)
+	defer cancel()
+
+	ticker := time.NewTicker(injectCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			s.logger.Warn().Str("id", torrentID).Msg("Injected torrent did not verify against the existing data, leaving it paused")
+			return
+		case <-ticker.C:
+		}
+
+		info, err := client.GetTorrentInfo(ctx, torrentID)
+		if err != nil {
+			s.logger.Debug().Err(err).Str("id", torrentID).Msg("Failed to get injected torrent status")
+			continue
+		}
+		if info.Status == StatusChecking || info.Progress < 100 {
+			continue
+		}
+		if err := client.Resume(ctx, torrentID); err != nil {
+			s.logger.Warn().Err(err).Str("id", torrentID).Msg("Failed to start injected torrent")
+			return
+		}
+		s.logger.Info().Str("id", torrentID).Msg("Injected torrent verified, seeding")
+		return
+	}
+}
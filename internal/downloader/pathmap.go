@@ -0,0 +1,100 @@
+package downloader
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+	"strings"
+
+	"github.com/slipstream/slipstream/internal/downloader/types"
+	"github.com/slipstream/slipstream/internal/pathutil"
+)
+
+// PathMapping translates a path as reported by a download client (Remote)
+// into the same location as seen by SlipStream (Local). It is needed when the
+// client runs on another host or inside a container with different mounts.
+type PathMapping struct {
+	Remote string `json:"remote"`
+	Local  string `json:"local"`
+}
+
+// MapRemotePath rewrites a client-reported path using the longest matching
+// remote prefix. Paths that match no mapping are returned unchanged.
+func MapRemotePath(path string, mappings []PathMapping) string {
+	bestLen := -1
+	mapped := path
+	for _, m := range mappings {
+		remainder, ok := pathutil.TrimPathPrefix(path, m.Remote)
+		if !ok || len(m.Remote) <= bestLen {
+			continue
+		}
+		bestLen = len(m.Remote)
+		mapped = strings.TrimRight(m.Local, `/\`) + remainder
+		if mapped == "" {
+			mapped = "/"
+		}
+	}
+	return mapped
+}
+
+// encodePathMappings serializes mappings for the path_mappings column,
+// dropping incomplete entries.
+func encodePathMappings(mappings []PathMapping) string {
+	cleaned := make([]PathMapping, 0, len(mappings))
+	for _, m := range mappings {
+		remote := strings.TrimSpace(m.Remote)
+		local := strings.TrimSpace(m.Local)
+		if remote == "" || local == "" {
+			continue
+		}
+		cleaned = append(cleaned, PathMapping{Remote: remote, Local: local})
+	}
+	sort.SliceStable(cleaned, func(i, j int) bool { return len(cleaned[i].Remote) > len(cleaned[j].Remote) })
+	data, err := json.Marshal(cleaned)
+	if err != nil {
+		return "[]"
+	}
+	return string(data)
+}
+
+// decodePathMappings parses the path_mappings column. Malformed values are
+// treated as no mappings.
+func decodePathMappings(raw string) []PathMapping {
+	mappings := []PathMapping{}
+	if raw == "" {
+		return mappings
+	}
+	if err := json.Unmarshal([]byte(raw), &mappings); err != nil {
+		return []PathMapping{}
+	}
+	return mappings
+}
+
+// listClientDownloads lists a client's downloads with every reported
+// DownloadDir translated through the client's remote path mappings.
+func (s *Service) listClientDownloads(ctx context.Context, clientID int64) ([]types.DownloadItem, error) {
+	client, err := s.GetClient(ctx, clientID)
+	if err != nil {
+		return nil, err
+	}
+
+	downloads, err := client.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	mappings := s.clientPathMappings(clientID)
+	if len(mappings) == 0 {
+		return downloads, nil
+	}
+	for i := range downloads {
+		downloads[i].DownloadDir = MapRemotePath(downloads[i].DownloadDir, mappings)
+	}
+	return downloads, nil
+}
+
+func (s *Service) clientPathMappings(clientID int64) []PathMapping {
+	s.clientPoolMu.RLock()
+	defer s.clientPoolMu.RUnlock()
+	return s.pathMappings[clientID]
+}
@@ -0,0 +1,107 @@
+package downloader
+
+import (
+	"context"
+	"testing"
+
+	"github.com/slipstream/slipstream/internal/testutil"
+)
+
+func TestMapRemotePath(t *testing.T) {
+	mappings := []PathMapping{
+		{Remote: "/downloads", Local: "/mnt/nas/downloads"},
+		{Remote: "/downloads/complete", Local: "/data/complete/"},
+		{Remote: `D:\Torrents`, Local: "/mnt/windows/torrents"},
+	}
+
+	tests := []struct {
+		name string
+		path string
+		want string
+	}{
+		{"prefix match", "/downloads/SlipStream/Movies", "/mnt/nas/downloads/SlipStream/Movies"},
+		{"longest prefix wins", "/downloads/complete/Show.S01", "/data/complete/Show.S01"},
+		{"exact match", "/downloads", "/mnt/nas/downloads"},
+		{"partial segment is not a match", "/downloads2/file", "/downloads2/file"},
+		{"windows remote path", `D:\Torrents\Movie (2020)`, "/mnt/windows/torrents/Movie (2020)"},
+		{"no mapping", "/other/path", "/other/path"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := MapRemotePath(tt.path, mappings); got != tt.want {
+				t.Errorf("MapRemotePath(%q) = %q, want %q", tt.path, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPathMappings_RoundTrip(t *testing.T) {
+	tdb := testutil.NewTestDB(t)
+	defer tdb.Close()
+
+	svc := NewService(tdb.Conn, &tdb.Logger, nil, nil, nil, nil)
+	ctx := context.Background()
+
+	created, err := svc.Create(ctx, &CreateClientInput{
+		Name:    "Remote qBit",
+		Type:    "qbittorrent",
+		Host:    "seedbox",
+		Port:    8080,
+		Enabled: true,
+		PathMappings: []PathMapping{
+			{Remote: "/downloads", Local: "/mnt/downloads"},
+			{Remote: " ", Local: "/ignored"},
+		},
+	})
+	if err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+
+	got, err := svc.Get(ctx, created.ID)
+	if err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	if len(got.PathMappings) != 1 {
+		t.Fatalf("PathMappings = %v, want 1 entry", got.PathMappings)
+	}
+	if got.PathMappings[0] != (PathMapping{Remote: "/downloads", Local: "/mnt/downloads"}) {
+		t.Errorf("PathMappings[0] = %+v", got.PathMappings[0])
+	}
+
+	// Updates that omit pathMappings keep the stored ones.
+	updated, err := svc.Update(ctx, created.ID, &UpdateClientInput{
+		Name:    "Remote qBit",
+		Type:    "qbittorrent",
+		Host:    "seedbox",
+		Port:    8080,
+		Enabled: true,
+	})
+	if err != nil {
+		t.Fatalf("Update() error = %v", err)
+	}
+	if len(updated.PathMappings) != 1 {
+		t.Errorf("PathMappings after update = %v, want 1 entry", updated.PathMappings)
+	}
+
+	if _, err := svc.GetClient(ctx, created.ID); err != nil {
+		t.Fatalf("GetClient() error = %v", err)
+	}
+	if mappings := svc.clientPathMappings(created.ID); len(mappings) != 1 {
+		t.Errorf("clientPathMappings() = %v, want 1 entry", mappings)
+	}
+
+	svc.EvictClient(created.ID)
+	if mappings := svc.clientPathMappings(created.ID); mappings != nil {
+		t.Errorf("clientPathMappings() after evict = %v, want nil", mappings)
+	}
+}
+
+func TestDecodePathMappings_Malformed(t *testing.T) {
+	if got := decodePathMappings("not json"); len(got) != 0 {
+		t.Errorf("decodePathMappings(malformed) = %v, want empty", got)
+	}
+	if got := decodePathMappings(""); len(got) != 0 {
+		t.Errorf("decodePathMappings(\"\") = %v, want empty", got)
+	}
+}
@@ -424,6 +424,66 @@ func (c *Client) Resume(ctx context.Context, id string) error {
 	return nil
 }
 
+// Reannounce asks the torrent's trackers for peers immediately.
+func (c *Client) Reannounce(ctx context.Context, id string) error {
+	if err := c.authenticate(ctx); err != nil {
+		return err
+	}
+
+	data := url.Values{}
+	data.Set("hashes", strings.ToLower(id))
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.baseURL+"api/v2/torrents/reannounce", strings.NewReader(data.Encode()))
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	c.setAuthHeaders(req)
+
+	resp, err := c.doWithRetry(ctx, req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("failed to reannounce torrent: status %d", resp.StatusCode)
+	}
+
+	return nil
+}
+
+// Recheck verifies the torrent's data on disk.
+func (c *Client) Recheck(ctx context.Context, id string) error {
+	if err := c.authenticate(ctx); err != nil {
+		return err
+	}
+
+	data := url.Values{}
+	data.Set("hashes", strings.ToLower(id))
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.baseURL+"api/v2/torrents/recheck", strings.NewReader(data.Encode()))
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	c.setAuthHeaders(req)
+
+	resp, err := c.doWithRetry(ctx, req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("failed to recheck torrent: status %d", resp.StatusCode)
+	}
+
+	return nil
+}
+
 func (c *Client) GetDownloadDir(ctx context.Context) (string, error) {
 	if err := c.authenticate(ctx); err != nil {
 		return "", err
@@ -454,6 +514,41 @@ func (c *Client) GetDownloadDir(ctx context.Context) (string, error) {
 	return prefs.SavePath, nil
 }
 
+// GetFreeSpace returns the free disk space reported in qBittorrent's server state.
+func (c *Client) GetFreeSpace(ctx context.Context) (int64, error) {
+	if err := c.authenticate(ctx); err != nil {
+		return 0, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.baseURL+"api/v2/sync/maindata", http.NoBody)
+	if err != nil {
+		return 0, err
+	}
+
+	c.setAuthHeaders(req)
+
+	resp, err := c.doWithRetry(ctx, req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return 0, fmt.Errorf("failed to get server state: status %d", resp.StatusCode)
+	}
+
+	var data struct {
+		ServerState struct {
+			FreeSpaceOnDisk int64 `json:"free_space_on_disk"`
+		} `json:"server_state"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&data); err != nil {
+		return 0, err
+	}
+
+	return data.ServerState.FreeSpaceOnDisk, nil
+}
+
 func (c *Client) SetSeedLimits(ctx context.Context, id string, ratio float64, seedTime time.Duration) error {
 	if err := c.authenticate(ctx); err != nil {
 		return err
@@ -663,7 +758,9 @@ func mapStatus(state string) types.Status {
 		return types.StatusWarning
 	case "pausedDL", "stoppedDL":
 		return types.StatusPaused
-	case "queuedDL", "checkingDL", "checkingUP", "checkingResumeData":
+	case "checkingDL", "checkingUP", "checkingResumeData":
+		return types.StatusChecking
+	case "queuedDL":
 		return types.StatusQueued
 	case "pausedUP", "stoppedUP", "uploading", "stalledUP", "queuedUP", "forcedUP":
 		return types.StatusSeeding
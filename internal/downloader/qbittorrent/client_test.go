@@ -386,6 +386,29 @@ func TestClient_GetDownloadDir(t *testing.T) {
 	}
 }
 
+func TestClient_GetFreeSpace(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/v2/sync/maindata" {
+			w.Header().Set("Content-Type", "application/json")
+			w.Write([]byte(`{"rid":1,"server_state":{"free_space_on_disk":53687091200}}`))
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	client := createClientFromServer(t, server, &types.ClientConfig{})
+
+	free, err := client.GetFreeSpace(context.Background())
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if free != 53687091200 {
+		t.Errorf("expected 53687091200, got %d", free)
+	}
+}
+
 func TestClient_SessionReuse(t *testing.T) {
 	var loginCount atomic.Int32
 
@@ -494,9 +517,9 @@ func TestMapStatus(t *testing.T) {
 		{"pausedDL", types.StatusPaused},
 		{"stoppedDL", types.StatusPaused},
 		{"queuedDL", types.StatusQueued},
-		{"checkingDL", types.StatusQueued},
-		{"checkingUP", types.StatusQueued},
-		{"checkingResumeData", types.StatusQueued},
+		{"checkingDL", types.StatusChecking},
+		{"checkingUP", types.StatusChecking},
+		{"checkingResumeData", types.StatusChecking},
 		{"pausedUP", types.StatusSeeding},
 		{"stoppedUP", types.StatusSeeding},
 		{"uploading", types.StatusSeeding},
@@ -220,12 +220,7 @@ func (s *Service) buildSlotLookup(ctx context.Context) map[int64]string {
 
 // getClientQueue fetches queue items from any download client using the unified interface.
 func (s *Service) getClientQueue(ctx context.Context, clientID int64, clientName, clientType string) ([]QueueItem, error) {
-	client, err := s.GetClient(ctx, clientID)
-	if err != nil {
-		return nil, err
-	}
-
-	downloads, err := client.List(ctx)
+	downloads, err := s.listClientDownloads(ctx, clientID)
 	if err != nil {
 		return nil, err
 	}
@@ -243,6 +238,9 @@ func (s *Service) getClientQueue(ctx context.Context, clientID int64, clientName
 }
 
 func shouldIncludeInQueue(d *types.DownloadItem) bool {
+	if d.Status == types.StatusChecking {
+		return true
+	}
 	if d.Status == types.StatusCompleted || d.Status == types.StatusSeeding {
 		return false
 	}
@@ -319,7 +317,7 @@ func mapDownloadStatus(status types.Status) string {
 		return "paused"
 	case types.StatusCompleted, types.StatusSeeding:
 		return "completed"
-	case types.StatusQueued:
+	case types.StatusQueued, types.StatusChecking:
 		return statusQueued
 	case types.StatusWarning:
 		return "warning"
@@ -46,6 +46,8 @@ type CreateDownloadMappingInput struct {
 	Source           string
 	// Req 10.1.2: Target slot for multi-version tracking
 	TargetSlotID *int64
+	// Indexer the release was grabbed from, used for per-indexer seed goals
+	IndexerID *int64
 }
 
 // CreateDownloadMapping creates a download mapping record when a download is initiated.
@@ -69,6 +71,9 @@ func (s *Service) CreateDownloadMapping(ctx context.Context, input *CreateDownlo
 	if input.TargetSlotID != nil {
 		params.TargetSlotID = sql.NullInt64{Int64: *input.TargetSlotID, Valid: true}
 	}
+	if input.IndexerID != nil {
+		params.IndexerID = sql.NullInt64{Int64: *input.IndexerID, Valid: true}
+	}
 
 	mapping, err := s.queries.CreateDownloadMapping(ctx, params)
 	if err != nil {
@@ -0,0 +1,261 @@
+package downloader
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/slipstream/slipstream/internal/database/sqlc"
+	"github.com/slipstream/slipstream/internal/library/status"
+)
+
+// remappedDownloadMessage is the reason logged for items a download no longer targets.
+const remappedDownloadMessage = "Download remapped to another item"
+
+var (
+	// ErrDownloadNotMapped means the download has no library mapping to change.
+	ErrDownloadNotMapped = errors.New("download is not mapped to a library item")
+	// ErrDownloadImporting means the download's files are already being imported.
+	ErrDownloadImporting = errors.New("download is already being imported")
+	// ErrInvalidRemapTarget means the requested library item does not exist.
+	ErrInvalidRemapTarget = errors.New("invalid remap target")
+)
+
+// RemapDownloadInput names the library item a download should import into.
+type RemapDownloadInput struct {
+	ClientID     int64  `json:"clientId"`
+	MediaType    string `json:"mediaType"`              // movie, episode, or season
+	MediaID      int64  `json:"mediaId"`                // movie, episode, or series ID
+	SeasonNumber int    `json:"seasonNumber,omitempty"` // required for season
+}
+
+// QualityStatusResolver picks the status a file of a quality earns under a
+// quality profile.
+type QualityStatusResolver interface {
+	StatusForQuality(ctx context.Context, profileID int64, qualityID int) (string, error)
+}
+
+// SetQualityStatusResolver sets the resolver used to restore the status of
+// items a remapped download no longer targets.
+func (s *Service) SetQualityStatusResolver(r QualityStatusResolver) {
+	s.qualityStatus = r
+}
+
+// RemapDownload points an active download at a different movie, episode, or
+// season before it is imported. Items it targeted lose their downloading
+// status, and its import state starts over against the new target.
+func (s *Service) RemapDownload(ctx context.Context, downloadID string, input *RemapDownloadInput) (*sqlc.DownloadMapping, error) {
+	mapping, err := s.GetDownloadMapping(ctx, input.ClientID, downloadID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrDownloadNotMapped
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get download mapping: %w", err)
+	}
+
+	if err := s.checkNotImporting(ctx, mapping.ID); err != nil {
+		return nil, err
+	}
+
+	params, err := s.remapTargetParams(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+	params.ClientID = input.ClientID
+	params.DownloadID = downloadID
+
+	if err := s.queries.DeleteQueueMediaByDownloadMapping(ctx, mapping.ID); err != nil {
+		return nil, fmt.Errorf("failed to clear queued files: %w", err)
+	}
+	updated, err := s.queries.UpdateDownloadMappingTarget(ctx, *params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update download mapping: %w", err)
+	}
+
+	s.releaseDownloadingMedia(ctx, downloadID)
+	s.markRemappedDownloading(ctx, input, downloadID)
+
+	s.logger.Info().
+		Int64("clientId", input.ClientID).
+		Str("downloadId", downloadID).
+		Str("fromEntityType", mapping.EntityType).
+		Int64("fromEntityId", mapping.EntityID).
+		Str("toMediaType", input.MediaType).
+		Int64("toMediaId", input.MediaID).
+		Msg("Remapped download")
+
+	return updated, nil
+}
+
+// checkNotImporting refuses to remap a download whose files are being or have
+// been imported.
+func (s *Service) checkNotImporting(ctx context.Context, mappingID int64) error {
+	entries, err := s.queries.GetQueueMediaByDownloadMapping(ctx, mappingID)
+	if err != nil {
+		return fmt.Errorf("failed to list queued files: %w", err)
+	}
+	for _, entry := range entries {
+		switch QueueMediaStatus(entry.FileStatus) {
+		case QueueMediaStatusImporting, QueueMediaStatusImported:
+			return ErrDownloadImporting
+		}
+	}
+	return nil
+}
+
+// remapTargetParams validates the new target and builds the mapping fields for it.
+func (s *Service) remapTargetParams(ctx context.Context, input *RemapDownloadInput) (*sqlc.UpdateDownloadMappingTargetParams, error) {
+	switch input.MediaType {
+	case mediaTypeMovie:
+		if _, err := s.queries.GetMovie(ctx, input.MediaID); err != nil {
+			return nil, fmt.Errorf("%w: movie %d not found", ErrInvalidRemapTarget, input.MediaID)
+		}
+		return &sqlc.UpdateDownloadMappingTargetParams{
+			ModuleType: "movie",
+			EntityType: "movie",
+			EntityID:   input.MediaID,
+		}, nil
+	case "episode":
+		ep, err := s.queries.GetEpisode(ctx, input.MediaID)
+		if err != nil {
+			return nil, fmt.Errorf("%w: episode %d not found", ErrInvalidRemapTarget, input.MediaID)
+		}
+		return &sqlc.UpdateDownloadMappingTargetParams{
+			ModuleType:   "tv",
+			EntityType:   "episode",
+			EntityID:     input.MediaID,
+			SeasonNumber: sql.NullInt64{Int64: ep.SeasonNumber, Valid: true},
+		}, nil
+	case "season":
+		if _, err := s.queries.GetSeasonByNumber(ctx, sqlc.GetSeasonByNumberParams{
+			SeriesID:     input.MediaID,
+			SeasonNumber: int64(input.SeasonNumber),
+		}); err != nil {
+			return nil, fmt.Errorf("%w: season %d of series %d not found", ErrInvalidRemapTarget, input.SeasonNumber, input.MediaID)
+		}
+		return &sqlc.UpdateDownloadMappingTargetParams{
+			ModuleType:   "tv",
+			EntityType:   mediaTypeSeries,
+			EntityID:     input.MediaID,
+			SeasonNumber: sql.NullInt64{Int64: int64(input.SeasonNumber), Valid: true},
+			IsSeasonPack: true,
+		}, nil
+	default:
+		return nil, fmt.Errorf("%w: media type must be movie, episode, or season", ErrInvalidRemapTarget)
+	}
+}
+
+// releaseDownloadingMedia restores the status of movies and episodes that were
+// downloading the release: missing without a file, otherwise what the file's
+// quality earns under the item's profile.
+func (s *Service) releaseDownloadingMedia(ctx context.Context, downloadID string) {
+	if movies, err := s.queries.ListDownloadingMovies(ctx); err == nil {
+		for _, m := range movies {
+			if m.ActiveDownloadID.String == downloadID {
+				s.releaseMovie(ctx, m.ID)
+			}
+		}
+	}
+	if episodes, err := s.queries.ListDownloadingEpisodes(ctx); err == nil {
+		for _, ep := range episodes {
+			if ep.ActiveDownloadID.String == downloadID {
+				s.releaseEpisode(ctx, ep.ID, ep.SeriesID)
+			}
+		}
+	}
+}
+
+func (s *Service) releaseMovie(ctx context.Context, movieID int64) {
+	movie, err := s.queries.GetMovie(ctx, movieID)
+	if err != nil {
+		return
+	}
+	newStatus := status.Missing
+	if files, err := s.queries.ListMovieFiles(ctx, movieID); err == nil && len(files) > 0 {
+		newStatus = s.statusForFile(ctx, movie.QualityProfileID, files[0].QualityID)
+	}
+
+	if err := s.queries.UpdateMovieStatusWithDetails(ctx, sqlc.UpdateMovieStatusWithDetailsParams{
+		ID:     movieID,
+		Status: newStatus,
+	}); err != nil {
+		s.logger.Warn().Err(err).Int64("movieId", movieID).Msg("Failed to restore movie status after remap")
+		return
+	}
+	if s.statusChangeLogger != nil {
+		_ = s.statusChangeLogger.LogStatusChanged(ctx, "movie", movieID, status.Downloading, newStatus, remappedDownloadMessage)
+	}
+	if s.broadcaster != nil {
+		s.broadcaster.BroadcastEntity("movie", "movie", movieID, "updated", nil)
+	}
+}
+
+func (s *Service) releaseEpisode(ctx context.Context, episodeID, seriesID int64) {
+	newStatus := status.Missing
+	if files, err := s.queries.ListEpisodeFilesByEpisode(ctx, episodeID); err == nil && len(files) > 0 {
+		var profileID sql.NullInt64
+		if series, err := s.queries.GetSeries(ctx, seriesID); err == nil {
+			profileID = series.QualityProfileID
+		}
+		newStatus = s.statusForFile(ctx, profileID, files[0].QualityID)
+	}
+
+	if err := s.queries.UpdateEpisodeStatusWithDetails(ctx, sqlc.UpdateEpisodeStatusWithDetailsParams{
+		ID:     episodeID,
+		Status: newStatus,
+	}); err != nil {
+		s.logger.Warn().Err(err).Int64("episodeId", episodeID).Msg("Failed to restore episode status after remap")
+		return
+	}
+	if s.statusChangeLogger != nil {
+		_ = s.statusChangeLogger.LogStatusChanged(ctx, "episode", episodeID, status.Downloading, newStatus, remappedDownloadMessage)
+	}
+	if s.broadcaster != nil {
+		s.broadcaster.BroadcastEntity("tv", "series", seriesID, "updated", nil)
+	}
+}
+
+// statusForFile returns the status an item with a file of qualityID earns.
+// Without a resolver or a known quality the item is left upgradable, so the
+// next search decides whether a better release is wanted.
+func (s *Service) statusForFile(ctx context.Context, profileID, qualityID sql.NullInt64) string {
+	if s.qualityStatus == nil || !profileID.Valid || !qualityID.Valid {
+		return status.Upgradable
+	}
+	st, err := s.qualityStatus.StatusForQuality(ctx, profileID.Int64, int(qualityID.Int64))
+	if err != nil {
+		return status.Upgradable
+	}
+	return st
+}
+
+// markRemappedDownloading marks the new movie or episode target as downloading.
+// Season targets, like season pack grabs, leave their episodes' status alone.
+func (s *Service) markRemappedDownloading(ctx context.Context, input *RemapDownloadInput, downloadID string) {
+	activeDownloadID := sql.NullString{String: downloadID, Valid: true}
+	switch input.MediaType {
+	case mediaTypeMovie:
+		if err := s.queries.UpdateMovieStatusWithDetails(ctx, sqlc.UpdateMovieStatusWithDetailsParams{
+			ID:               input.MediaID,
+			Status:           status.Downloading,
+			ActiveDownloadID: activeDownloadID,
+		}); err != nil {
+			s.logger.Warn().Err(err).Int64("movieId", input.MediaID).Msg("Failed to set movie status to downloading")
+		} else if s.broadcaster != nil {
+			s.broadcaster.BroadcastEntity("movie", "movie", input.MediaID, "updated", nil)
+		}
+	case "episode":
+		if err := s.queries.UpdateEpisodeStatusWithDetails(ctx, sqlc.UpdateEpisodeStatusWithDetailsParams{
+			ID:               input.MediaID,
+			Status:           status.Downloading,
+			ActiveDownloadID: activeDownloadID,
+		}); err != nil {
+			s.logger.Warn().Err(err).Int64("episodeId", input.MediaID).Msg("Failed to set episode status to downloading")
+		} else if s.broadcaster != nil {
+			if ep, err := s.queries.GetEpisode(ctx, input.MediaID); err == nil {
+				s.broadcaster.BroadcastEntity("tv", "series", ep.SeriesID, "updated", nil)
+			}
+		}
+	}
+}
@@ -0,0 +1,108 @@
+package downloader
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+
+	"github.com/slipstream/slipstream/internal/database/sqlc"
+	"github.com/slipstream/slipstream/internal/testutil"
+)
+
+func TestRemapDownload_MovieToMovie(t *testing.T) {
+	tdb := testutil.NewTestDB(t)
+	defer tdb.Close()
+
+	svc := NewService(tdb.Conn, &tdb.Logger, nil, nil, nil, nil)
+	ctx := context.Background()
+	queries := sqlc.New(tdb.Conn)
+
+	client := createSeedingTestClient(t, queries, "transmission", "leave")
+
+	wrong, err := queries.CreateMovie(ctx, sqlc.CreateMovieParams{Title: "Wrong", SortTitle: "wrong", Status: "missing"})
+	if err != nil {
+		t.Fatalf("CreateMovie error = %v", err)
+	}
+	right, err := queries.CreateMovie(ctx, sqlc.CreateMovieParams{Title: "Right", SortTitle: "right", Status: "missing"})
+	if err != nil {
+		t.Fatalf("CreateMovie error = %v", err)
+	}
+
+	_ = queries.UpdateMovieStatusWithDetails(ctx, sqlc.UpdateMovieStatusWithDetailsParams{
+		ID:               wrong.ID,
+		Status:           "downloading",
+		ActiveDownloadID: sql.NullString{String: "dl-1", Valid: true},
+	})
+	if _, err := queries.CreateDownloadMapping(ctx, sqlc.CreateDownloadMappingParams{
+		ClientID:   client.ID,
+		DownloadID: "dl-1",
+		ModuleType: "movie",
+		EntityType: "movie",
+		EntityID:   wrong.ID,
+		Source:     "auto-search",
+	}); err != nil {
+		t.Fatalf("CreateDownloadMapping error = %v", err)
+	}
+
+	mapping, err := svc.RemapDownload(ctx, "dl-1", &RemapDownloadInput{
+		ClientID:  client.ID,
+		MediaType: "movie",
+		MediaID:   right.ID,
+	})
+	if err != nil {
+		t.Fatalf("RemapDownload error = %v", err)
+	}
+	if mapping.EntityID != right.ID {
+		t.Errorf("mapping entity = %d, want %d", mapping.EntityID, right.ID)
+	}
+
+	gotWrong, _ := queries.GetMovie(ctx, wrong.ID)
+	if gotWrong.Status != "missing" || gotWrong.ActiveDownloadID.Valid {
+		t.Errorf("old movie status = %q (download %v), want missing with no download", gotWrong.Status, gotWrong.ActiveDownloadID)
+	}
+	gotRight, _ := queries.GetMovie(ctx, right.ID)
+	if gotRight.Status != "downloading" || gotRight.ActiveDownloadID.String != "dl-1" {
+		t.Errorf("new movie status = %q (download %q), want downloading dl-1", gotRight.Status, gotRight.ActiveDownloadID.String)
+	}
+}
+
+func TestRemapDownload_Errors(t *testing.T) {
+	tdb := testutil.NewTestDB(t)
+	defer tdb.Close()
+
+	svc := NewService(tdb.Conn, &tdb.Logger, nil, nil, nil, nil)
+	ctx := context.Background()
+	queries := sqlc.New(tdb.Conn)
+
+	client := createSeedingTestClient(t, queries, "transmission", "leave")
+	movie, err := queries.CreateMovie(ctx, sqlc.CreateMovieParams{Title: "Movie", SortTitle: "movie", Status: "missing"})
+	if err != nil {
+		t.Fatalf("CreateMovie error = %v", err)
+	}
+
+	_, err = svc.RemapDownload(ctx, "unknown", &RemapDownloadInput{ClientID: client.ID, MediaType: "movie", MediaID: movie.ID})
+	if !errors.Is(err, ErrDownloadNotMapped) {
+		t.Errorf("unmapped download error = %v, want ErrDownloadNotMapped", err)
+	}
+
+	if _, err := queries.CreateDownloadMapping(ctx, sqlc.CreateDownloadMappingParams{
+		ClientID:   client.ID,
+		DownloadID: "dl-2",
+		ModuleType: "movie",
+		EntityType: "movie",
+		EntityID:   movie.ID,
+		Source:     "auto-search",
+	}); err != nil {
+		t.Fatalf("CreateDownloadMapping error = %v", err)
+	}
+
+	_, err = svc.RemapDownload(ctx, "dl-2", &RemapDownloadInput{ClientID: client.ID, MediaType: "movie", MediaID: movie.ID + 100})
+	if !errors.Is(err, ErrInvalidRemapTarget) {
+		t.Errorf("missing movie error = %v, want ErrInvalidRemapTarget", err)
+	}
+	_, err = svc.RemapDownload(ctx, "dl-2", &RemapDownloadInput{ClientID: client.ID, MediaType: "album", MediaID: movie.ID})
+	if !errors.Is(err, ErrInvalidRemapTarget) {
+		t.Errorf("unknown media type error = %v, want ErrInvalidRemapTarget", err)
+	}
+}
@@ -0,0 +1,207 @@
+package downloader
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/slipstream/slipstream/internal/database/sqlc"
+)
+
+const (
+	cleanupModeLeave             = "leave"
+	cleanupModeDeleteAfterImport = "delete_after_import"
+)
+
+// SeedGoal is a seed ratio and/or seed time target. Reaching either target
+// satisfies the goal.
+type SeedGoal struct {
+	RatioTarget *float64
+	TimeTarget  time.Duration
+}
+
+// IsSet reports whether the goal has at least one target.
+func (g SeedGoal) IsSet() bool {
+	return g.RatioTarget != nil || g.TimeTarget > 0
+}
+
+// Reached reports whether a torrent with the given ratio that has seeded for
+// the given duration satisfies the goal.
+func (g SeedGoal) Reached(ratio float64, seeded time.Duration) bool {
+	if g.RatioTarget != nil && ratio >= *g.RatioTarget {
+		return true
+	}
+	return g.TimeTarget > 0 && seeded >= g.TimeTarget
+}
+
+func seedGoalFromTargets(ratio *float64, minutes *int) SeedGoal {
+	goal := SeedGoal{RatioTarget: ratio}
+	if minutes != nil && *minutes > 0 {
+		goal.TimeTarget = time.Duration(*minutes) * time.Minute
+	}
+	return goal
+}
+
+// TrackSeedingDownload records an imported torrent so the seed cleanup task can
+// remove it from its client once its seeding goal is met. Downloads on usenet
+// clients and on clients set to leave finished downloads alone are ignored.
+func (s *Service) TrackSeedingDownload(ctx context.Context, clientID int64, downloadID string, indexerID *int64) error {
+	client, err := s.Get(ctx, clientID)
+	if err != nil {
+		return err
+	}
+	if ProtocolForClient(ClientType(client.Type)) != ProtocolTorrent || client.CleanupMode == cleanupModeLeave {
+		return nil
+	}
+
+	params := sqlc.CreateSeedingDownloadParams{
+		ClientID:   clientID,
+		DownloadID: downloadID,
+	}
+	if indexerID != nil {
+		params.IndexerID.Int64 = *indexerID
+		params.IndexerID.Valid = true
+	}
+	if err := s.queries.CreateSeedingDownload(ctx, params); err != nil {
+		return fmt.Errorf("failed to track seeding download: %w", err)
+	}
+	return nil
+}
+
+// CleanupSeededDownloads checks imported torrents against their seeding goals
+// and removes those that have met them from the download client, deleting the
+// downloaded data. An indexer goal takes precedence over the client's goal so
+// that tracker requirements are honoured even when the client is set to delete
+// after import.
+func (s *Service) CleanupSeededDownloads(ctx context.Context) error {
+	tracked, err := s.queries.ListSeedingDownloads(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to list seeding downloads: %w", err)
+	}
+	if len(tracked) == 0 {
+		return nil
+	}
+
+	indexerGoals, err := s.loadIndexerSeedGoals(ctx)
+	if err != nil {
+		return err
+	}
+
+	byClient := make(map[int64][]*sqlc.SeedingDownload)
+	for _, sd := range tracked {
+		byClient[sd.ClientID] = append(byClient[sd.ClientID], sd)
+	}
+
+	removed := 0
+	for clientID, downloads := range byClient {
+		removed += s.cleanupClientSeeds(ctx, clientID, downloads, indexerGoals)
+	}
+
+	if removed > 0 {
+		s.logger.Info().Int("removed", removed).Msg("Removed torrents that reached their seeding goals")
+	}
+	return nil
+}
+
+func (s *Service) loadIndexerSeedGoals(ctx context.Context) (map[int64]SeedGoal, error) {
+	rows, err := s.queries.ListIndexerSeedGoals(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list indexer seed goals: %w", err)
+	}
+
+	goals := make(map[int64]SeedGoal, len(rows))
+	for _, row := range rows {
+		var goal SeedGoal
+		if row.SeedRatioTarget.Valid {
+			ratio := row.SeedRatioTarget.Float64
+			goal.RatioTarget = &ratio
+		}
+		if row.SeedTimeTargetMinutes.Valid && row.SeedTimeTargetMinutes.Int64 > 0 {
+			goal.TimeTarget = time.Duration(row.SeedTimeTargetMinutes.Int64) * time.Minute
+		}
+		goals[row.IndexerID] = goal
+	}
+	return goals, nil
+}
+
+func (s *Service) cleanupClientSeeds(ctx context.Context, clientID int64, downloads []*sqlc.SeedingDownload, indexerGoals map[int64]SeedGoal) int {
+	cfg, err := s.Get(ctx, clientID)
+	if err != nil {
+		s.logger.Warn().Err(err).Int64("clientId", clientID).Msg("Failed to load download client for seed cleanup")
+		return 0
+	}
+	if !cfg.Enabled || cfg.CleanupMode == cleanupModeLeave {
+		return 0
+	}
+
+	client, err := s.GetClient(ctx, clientID)
+	if err != nil {
+		s.logger.Warn().Err(err).Int64("clientId", clientID).Msg("Failed to connect to download client for seed cleanup")
+		return 0
+	}
+	torrentClient, ok := client.(TorrentClient)
+	if !ok {
+		return 0
+	}
+
+	clientGoal := seedGoalFromTargets(cfg.SeedRatioTarget, cfg.SeedTimeTargetMins)
+	now := time.Now()
+	removed := 0
+
+	for _, sd := range downloads {
+		info, err := torrentClient.GetTorrentInfo(ctx, sd.DownloadID)
+		if err != nil {
+			if errors.Is(err, ErrNotFound) {
+				// Removed outside SlipStream; nothing left to clean up.
+				s.forgetSeedingDownload(ctx, sd)
+				continue
+			}
+			s.logger.Warn().Err(err).Int64("clientId", clientID).Str("downloadId", sd.DownloadID).Msg("Failed to get torrent info for seed cleanup")
+			continue
+		}
+
+		goal := clientGoal
+		immediate := cfg.CleanupMode == cleanupModeDeleteAfterImport
+		if sd.IndexerID.Valid {
+			if indexerGoal, ok := indexerGoals[sd.IndexerID.Int64]; ok && indexerGoal.IsSet() {
+				goal = indexerGoal
+				immediate = false
+			}
+		}
+
+		seedingSince := sd.ImportedAt
+		if !info.CompletedAt.IsZero() {
+			seedingSince = info.CompletedAt
+		}
+
+		if !immediate && (!goal.IsSet() || !goal.Reached(info.Ratio, now.Sub(seedingSince))) {
+			continue
+		}
+
+		if err := torrentClient.Remove(ctx, sd.DownloadID, true); err != nil {
+			s.logger.Warn().Err(err).Int64("clientId", clientID).Str("downloadId", sd.DownloadID).Msg("Failed to remove seeded torrent")
+			continue
+		}
+		s.forgetSeedingDownload(ctx, sd)
+		removed++
+
+		s.logger.Info().
+			Int64("clientId", clientID).
+			Str("downloadId", sd.DownloadID).
+			Float64("ratio", info.Ratio).
+			Dur("seeded", now.Sub(seedingSince)).
+			Msg("Removed torrent after reaching seeding goal")
+	}
+
+	return removed
+}
+
+func (s *Service) forgetSeedingDownload(ctx context.Context, sd *sqlc.SeedingDownload) {
+	if err := s.queries.DeleteSeedingDownload(ctx, sqlc.DeleteSeedingDownloadParams{
+		ClientID:   sd.ClientID,
+		DownloadID: sd.DownloadID,
+	}); err != nil {
+		s.logger.Warn().Err(err).Int64("clientId", sd.ClientID).Str("downloadId", sd.DownloadID).Msg("Failed to delete seeding download record")
+	}
+}
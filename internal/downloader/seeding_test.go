@@ -0,0 +1,153 @@
+package downloader
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/slipstream/slipstream/internal/database/sqlc"
+	"github.com/slipstream/slipstream/internal/downloader/mock"
+	"github.com/slipstream/slipstream/internal/downloader/types"
+	"github.com/slipstream/slipstream/internal/testutil"
+)
+
+func TestSeedGoal_Reached(t *testing.T) {
+	ratio := 1.5
+
+	tests := []struct {
+		name   string
+		goal   SeedGoal
+		ratio  float64
+		seeded time.Duration
+		want   bool
+	}{
+		{"no targets", SeedGoal{}, 10, 48 * time.Hour, false},
+		{"ratio below target", SeedGoal{RatioTarget: &ratio}, 1.0, time.Hour, false},
+		{"ratio reached", SeedGoal{RatioTarget: &ratio}, 1.5, 0, true},
+		{"time below target", SeedGoal{TimeTarget: 2 * time.Hour}, 0, time.Hour, false},
+		{"time reached", SeedGoal{TimeTarget: 2 * time.Hour}, 0, 2 * time.Hour, true},
+		{"either target suffices", SeedGoal{RatioTarget: &ratio, TimeTarget: 2 * time.Hour}, 0.2, 3 * time.Hour, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.goal.Reached(tt.ratio, tt.seeded); got != tt.want {
+				t.Errorf("Reached(%v, %v) = %v, want %v", tt.ratio, tt.seeded, got, tt.want)
+			}
+		})
+	}
+}
+
+func createSeedingTestClient(t *testing.T, queries *sqlc.Queries, clientType, cleanupMode string) *sqlc.DownloadClient {
+	t.Helper()
+	dc, err := queries.CreateDownloadClient(context.Background(), sqlc.CreateDownloadClientParams{
+		Name:        "Seeding " + clientType,
+		Type:        clientType,
+		Host:        "localhost",
+		Port:        9091,
+		Enabled:     true,
+		Priority:    50,
+		CleanupMode: cleanupMode,
+	})
+	if err != nil {
+		t.Fatalf("CreateDownloadClient error = %v", err)
+	}
+	return dc
+}
+
+func TestTrackSeedingDownload(t *testing.T) {
+	tdb := testutil.NewTestDB(t)
+	defer tdb.Close()
+
+	svc := NewService(tdb.Conn, &tdb.Logger, nil, nil, nil, nil)
+	ctx := context.Background()
+	queries := sqlc.New(tdb.Conn)
+
+	leave := createSeedingTestClient(t, queries, "transmission", "leave")
+	usenet := createSeedingTestClient(t, queries, "sabnzbd", "delete_after_import")
+	torrent := createSeedingTestClient(t, queries, "transmission", "delete_after_seed_ratio")
+
+	indexerID := int64(7)
+	for _, dc := range []*sqlc.DownloadClient{leave, usenet, torrent} {
+		if err := svc.TrackSeedingDownload(ctx, dc.ID, "abc", &indexerID); err != nil {
+			t.Fatalf("TrackSeedingDownload(%s) error = %v", dc.Name, err)
+		}
+	}
+
+	tracked, err := queries.ListSeedingDownloads(ctx)
+	if err != nil {
+		t.Fatalf("ListSeedingDownloads error = %v", err)
+	}
+	if len(tracked) != 1 {
+		t.Fatalf("expected 1 tracked download, got %d", len(tracked))
+	}
+	if tracked[0].ClientID != torrent.ID {
+		t.Errorf("expected client %d to be tracked, got %d", torrent.ID, tracked[0].ClientID)
+	}
+	if !tracked[0].IndexerID.Valid || tracked[0].IndexerID.Int64 != indexerID {
+		t.Errorf("expected indexer %d, got %v", indexerID, tracked[0].IndexerID)
+	}
+}
+
+func TestCleanupSeededDownloads(t *testing.T) {
+	tdb := testutil.NewTestDB(t)
+	defer tdb.Close()
+
+	svc := NewService(tdb.Conn, &tdb.Logger, nil, nil, nil, nil)
+	ctx := context.Background()
+	queries := sqlc.New(tdb.Conn)
+
+	mockClient := mock.GetInstance()
+	mockClient.Clear()
+	t.Cleanup(mockClient.Clear)
+
+	dc := createSeedingTestClient(t, queries, "mock", "delete_after_import")
+
+	immediateID, err := mockClient.Add(ctx, &types.AddOptions{Name: "Immediate"})
+	if err != nil {
+		t.Fatalf("Add error = %v", err)
+	}
+	heldID, err := mockClient.Add(ctx, &types.AddOptions{Name: "Held By Indexer"})
+	if err != nil {
+		t.Fatalf("Add error = %v", err)
+	}
+
+	// The indexer's seed time goal overrides the client's delete-after-import mode.
+	trackerID := int64(3)
+	if _, err := queries.UpsertIndexerSeedGoal(ctx, sqlc.UpsertIndexerSeedGoalParams{
+		IndexerID:             trackerID,
+		SeedTimeTargetMinutes: sql.NullInt64{Int64: 24 * 60, Valid: true},
+	}); err != nil {
+		t.Fatalf("UpsertIndexerSeedGoal error = %v", err)
+	}
+
+	if err := svc.TrackSeedingDownload(ctx, dc.ID, immediateID, nil); err != nil {
+		t.Fatalf("TrackSeedingDownload error = %v", err)
+	}
+	if err := svc.TrackSeedingDownload(ctx, dc.ID, heldID, &trackerID); err != nil {
+		t.Fatalf("TrackSeedingDownload error = %v", err)
+	}
+	if err := svc.TrackSeedingDownload(ctx, dc.ID, "gone", nil); err != nil {
+		t.Fatalf("TrackSeedingDownload error = %v", err)
+	}
+
+	if err := svc.CleanupSeededDownloads(ctx); err != nil {
+		t.Fatalf("CleanupSeededDownloads error = %v", err)
+	}
+
+	if _, err := mockClient.Get(ctx, immediateID); err == nil {
+		t.Error("expected torrent to be removed after import")
+	}
+	if _, err := mockClient.Get(ctx, heldID); err != nil {
+		t.Errorf("expected torrent held by indexer goal to remain, got %v", err)
+	}
+
+	tracked, err := queries.ListSeedingDownloads(ctx)
+	if err != nil {
+		t.Fatalf("ListSeedingDownloads error = %v", err)
+	}
+	if len(tracked) != 1 || tracked[0].DownloadID != heldID {
+		t.Errorf("expected only %s to remain tracked, got %+v", heldID, tracked)
+	}
+}
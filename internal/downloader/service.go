@@ -15,6 +15,7 @@ import (
 	"github.com/slipstream/slipstream/internal/downloader/transmission"
 	"github.com/slipstream/slipstream/internal/downloader/types"
 	"github.com/slipstream/slipstream/internal/module"
+	"github.com/slipstream/slipstream/internal/tags"
 )
 
 var (
@@ -25,62 +26,77 @@ var (
 
 // DownloadClient represents a download client configuration.
 type DownloadClient struct {
-	ID                 int64     `json:"id"`
-	Name               string    `json:"name"`
-	Type               string    `json:"type"`
-	Host               string    `json:"host"`
-	Port               int       `json:"port"`
-	Username           string    `json:"username,omitempty"`
-	Password           string    `json:"password,omitempty"`
-	UseSSL             bool      `json:"useSsl"`
-	APIKey             string    `json:"apiKey,omitempty"`
-	Category           string    `json:"category,omitempty"`
-	URLBase            string    `json:"urlBase,omitempty"`
-	Priority           int       `json:"priority"`
-	Enabled            bool      `json:"enabled"`
-	CreatedAt          time.Time `json:"createdAt"`
-	UpdatedAt          time.Time `json:"updatedAt"`
-	ImportDelaySeconds int       `json:"importDelaySeconds"`
-	CleanupMode        string    `json:"cleanupMode"` // "leave", "delete_after_import", "delete_after_seed_ratio"
-	SeedRatioTarget    *float64  `json:"seedRatioTarget,omitempty"`
+	ID                   int64                 `json:"id"`
+	Name                 string                `json:"name"`
+	Type                 string                `json:"type"`
+	Host                 string                `json:"host"`
+	Port                 int                   `json:"port"`
+	Username             string                `json:"username,omitempty"`
+	Password             string                `json:"password,omitempty"`
+	UseSSL               bool                  `json:"useSsl"`
+	APIKey               string                `json:"apiKey,omitempty"`
+	Category             string                `json:"category,omitempty"`
+	URLBase              string                `json:"urlBase,omitempty"`
+	Priority             int                   `json:"priority"`
+	Enabled              bool                  `json:"enabled"`
+	CreatedAt            time.Time             `json:"createdAt"`
+	UpdatedAt            time.Time             `json:"updatedAt"`
+	ImportDelaySeconds   int                   `json:"importDelaySeconds"`
+	CleanupMode          string                `json:"cleanupMode"` // "leave", "delete_after_import", "delete_after_seed_ratio"
+	SeedRatioTarget      *float64              `json:"seedRatioTarget,omitempty"`
+	SeedTimeTargetMins   *int                  `json:"seedTimeTargetMinutes,omitempty"`
+	PathMappings         []PathMapping         `json:"pathMappings"`
+	ImportDelayOverrides []ImportDelayOverride `json:"importDelayOverrides"` // per-category ImportDelaySeconds
+	SeedingImportMode    string                `json:"seedingImportMode"`    // "delay" or "hardlink"
+	Tags                 []int64               `json:"tags"`
 }
 
 // CreateClientInput represents the input for creating a download client.
 type CreateClientInput struct {
-	Name               string   `json:"name"`
-	Type               string   `json:"type"`
-	Host               string   `json:"host"`
-	Port               int      `json:"port"`
-	Username           string   `json:"username,omitempty"`
-	Password           string   `json:"password,omitempty"`
-	UseSSL             bool     `json:"useSsl"`
-	APIKey             string   `json:"apiKey,omitempty"`
-	Category           string   `json:"category,omitempty"`
-	URLBase            string   `json:"urlBase,omitempty"`
-	Priority           int      `json:"priority"`
-	Enabled            bool     `json:"enabled"`
-	ImportDelaySeconds int      `json:"importDelaySeconds"`
-	CleanupMode        string   `json:"cleanupMode"` // "leave", "delete_after_import", "delete_after_seed_ratio"
-	SeedRatioTarget    *float64 `json:"seedRatioTarget,omitempty"`
+	Name                 string                `json:"name"`
+	Type                 string                `json:"type"`
+	Host                 string                `json:"host"`
+	Port                 int                   `json:"port"`
+	Username             string                `json:"username,omitempty"`
+	Password             string                `json:"password,omitempty"`
+	UseSSL               bool                  `json:"useSsl"`
+	APIKey               string                `json:"apiKey,omitempty"`
+	Category             string                `json:"category,omitempty"`
+	URLBase              string                `json:"urlBase,omitempty"`
+	Priority             int                   `json:"priority"`
+	Enabled              bool                  `json:"enabled"`
+	ImportDelaySeconds   int                   `json:"importDelaySeconds"`
+	CleanupMode          string                `json:"cleanupMode"` // "leave", "delete_after_import", "delete_after_seed_ratio"
+	SeedRatioTarget      *float64              `json:"seedRatioTarget,omitempty"`
+	SeedTimeTargetMins   *int                  `json:"seedTimeTargetMinutes,omitempty"`
+	PathMappings         []PathMapping         `json:"pathMappings,omitempty"`
+	ImportDelayOverrides []ImportDelayOverride `json:"importDelayOverrides,omitempty"`
+	SeedingImportMode    string                `json:"seedingImportMode,omitempty"` // "delay" or "hardlink"
+	Tags                 []int64               `json:"tags,omitempty"`
 }
 
 // UpdateClientInput represents the input for updating a download client.
 type UpdateClientInput struct {
-	Name               string   `json:"name"`
-	Type               string   `json:"type"`
-	Host               string   `json:"host"`
-	Port               int      `json:"port"`
-	Username           string   `json:"username,omitempty"`
-	Password           string   `json:"password,omitempty"`
-	UseSSL             bool     `json:"useSsl"`
-	APIKey             string   `json:"apiKey,omitempty"`
-	Category           string   `json:"category,omitempty"`
-	URLBase            string   `json:"urlBase,omitempty"`
-	Priority           int      `json:"priority"`
-	Enabled            bool     `json:"enabled"`
-	ImportDelaySeconds int      `json:"importDelaySeconds"`
-	CleanupMode        string   `json:"cleanupMode"` // "leave", "delete_after_import", "delete_after_seed_ratio"
-	SeedRatioTarget    *float64 `json:"seedRatioTarget,omitempty"`
+	Name                 string                `json:"name"`
+	Type                 string                `json:"type"`
+	Host                 string                `json:"host"`
+	Port                 int                   `json:"port"`
+	Username             string                `json:"username,omitempty"`
+	Password             string                `json:"password,omitempty"`
+	UseSSL               bool                  `json:"useSsl"`
+	APIKey               string                `json:"apiKey,omitempty"`
+	Category             string                `json:"category,omitempty"`
+	URLBase              string                `json:"urlBase,omitempty"`
+	Priority             int                   `json:"priority"`
+	Enabled              bool                  `json:"enabled"`
+	ImportDelaySeconds   int                   `json:"importDelaySeconds"`
+	CleanupMode          string                `json:"cleanupMode"` // "leave", "delete_after_import", "delete_after_seed_ratio"
+	SeedRatioTarget      *float64              `json:"seedRatioTarget,omitempty"`
+	SeedTimeTargetMins   *int                  `json:"seedTimeTargetMinutes,omitempty"`
+	PathMappings         []PathMapping         `json:"pathMappings,omitempty"`
+	ImportDelayOverrides []ImportDelayOverride `json:"importDelayOverrides,omitempty"`
+	SeedingImportMode    string                `json:"seedingImportMode,omitempty"` // "delay" or "hardlink"
+	Tags                 []int64               `json:"tags,omitempty"`
 }
 
 // TestResult represents the result of testing a download client connection.
@@ -103,12 +119,20 @@ type Service struct {
 	statusChangeLogger  contracts.StatusChangeLogger
 	portalStatusTracker PortalStatusTracker
 	registry            *module.Registry
+	tagStore            contracts.TagStore
+	qualityStatus       QualityStatusResolver
 
 	queueCacheMu sync.RWMutex
 	queueCache   map[int64][]QueueItem
 
 	clientPoolMu sync.RWMutex
 	clientPool   map[int64]Client
+	pathMappings map[int64][]PathMapping
+
+	// Progress of downloads by client, for stalled download nudging
+	stallMu sync.Mutex
+	stalls  map[int64]map[string]*stallState
+	now     func() time.Time
 }
 
 // SetRegistry sets the module registry for module-aware media type detection.
@@ -116,6 +140,11 @@ func (s *Service) SetRegistry(r *module.Registry) {
 	s.registry = r
 }
 
+// SetTagStore sets the store download client tags are read from and written to.
+func (s *Service) SetTagStore(store contracts.TagStore) {
+	s.tagStore = store
+}
+
 // NewService creates a new download client service.
 func NewService(
 	db *sql.DB,
@@ -135,6 +164,9 @@ func NewService(
 		portalStatusTracker: portalStatusTracker,
 		queueCache:          make(map[int64][]QueueItem),
 		clientPool:          make(map[int64]Client),
+		pathMappings:        make(map[int64][]PathMapping),
+		stalls:              make(map[int64]map[string]*stallState),
+		now:                 time.Now,
 	}
 }
 
@@ -145,6 +177,7 @@ func (s *Service) SetDB(db *sql.DB) {
 
 	s.clientPoolMu.Lock()
 	s.clientPool = make(map[int64]Client)
+	s.pathMappings = make(map[int64][]PathMapping)
 	s.clientPoolMu.Unlock()
 }
 
@@ -177,7 +210,7 @@ func (s *Service) Get(ctx context.Context, id int64) (*DownloadClient, error) {
 		}
 		return nil, fmt.Errorf("failed to get download client: %w", err)
 	}
-	return s.rowToClient(row), nil
+	return s.toClients(ctx, []*sqlc.DownloadClient{row})[0], nil
 }
 
 // List returns all download clients.
@@ -187,11 +220,7 @@ func (s *Service) List(ctx context.Context) ([]*DownloadClient, error) {
 		return nil, fmt.Errorf("failed to list download clients: %w", err)
 	}
 
-	clients := make([]*DownloadClient, 0, len(rows))
-	for _, row := range rows {
-		clients = append(clients, s.rowToClient(row))
-	}
-	return clients, nil
+	return s.toClients(ctx, rows), nil
 }
 
 var validClientTypes = map[string]bool{
@@ -208,6 +237,9 @@ func validateCreateInput(input *CreateClientInput) error {
 	if !validClientTypes[input.Type] {
 		return ErrUnsupportedClient
 	}
+	if !isValidSeedingImportMode(input.SeedingImportMode) {
+		return ErrInvalidClient
+	}
 	if input.Priority == 0 {
 		input.Priority = 50
 	}
@@ -226,21 +258,25 @@ func (s *Service) Create(ctx context.Context, input *CreateClientInput) (*Downlo
 	}
 
 	row, err := s.queries.CreateDownloadClient(ctx, sqlc.CreateDownloadClientParams{
-		Name:               input.Name,
-		Type:               input.Type,
-		Host:               input.Host,
-		Port:               int64(input.Port),
-		Username:           toNullString(input.Username),
-		Password:           toNullString(input.Password),
-		UseSsl:             input.UseSSL,
-		ApiKey:             toNullString(input.APIKey),
-		Category:           toNullString(input.Category),
-		UrlBase:            input.URLBase,
-		Priority:           int64(input.Priority),
-		Enabled:            input.Enabled,
-		ImportDelaySeconds: int64(input.ImportDelaySeconds),
-		CleanupMode:        cleanupMode,
-		SeedRatioTarget:    toNullFloat64(input.SeedRatioTarget),
+		Name:                  input.Name,
+		Type:                  input.Type,
+		Host:                  input.Host,
+		Port:                  int64(input.Port),
+		Username:              toNullString(input.Username),
+		Password:              toNullString(input.Password),
+		UseSsl:                input.UseSSL,
+		ApiKey:                toNullString(input.APIKey),
+		Category:              toNullString(input.Category),
+		UrlBase:               input.URLBase,
+		Priority:              int64(input.Priority),
+		Enabled:               input.Enabled,
+		ImportDelaySeconds:    int64(input.ImportDelaySeconds),
+		CleanupMode:           cleanupMode,
+		SeedRatioTarget:       toNullFloat64(input.SeedRatioTarget),
+		SeedTimeTargetMinutes: toNullInt64(input.SeedTimeTargetMins),
+		PathMappings:          encodePathMappings(input.PathMappings),
+		ImportDelayOverrides:  encodeImportDelayOverrides(input.ImportDelayOverrides),
+		SeedingImportMode:     seedingImportModeOrDefault(input.SeedingImportMode),
 	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to create download client: %w", err)
@@ -253,12 +289,18 @@ func (s *Service) Create(ctx context.Context, input *CreateClientInput) (*Downlo
 		s.healthService.RegisterItemStr("downloadClients", fmt.Sprintf("%d", row.ID), input.Name)
 	}
 
-	return s.rowToClient(row), nil
+	if len(input.Tags) > 0 {
+		if err := s.saveTags(ctx, row.ID, input.Tags); err != nil {
+			s.logger.Warn().Err(err).Int64("id", row.ID).Msg("Failed to tag download client")
+		}
+	}
+
+	return s.toClients(ctx, []*sqlc.DownloadClient{row})[0], nil
 }
 
 // Update updates an existing download client.
 func (s *Service) Update(ctx context.Context, id int64, input *UpdateClientInput) (*DownloadClient, error) {
-	if input.Name == "" {
+	if input.Name == "" || !isValidSeedingImportMode(input.SeedingImportMode) {
 		return nil, ErrInvalidClient
 	}
 
@@ -267,23 +309,51 @@ func (s *Service) Update(ctx context.Context, id int64, input *UpdateClientInput
 		cleanupMode = "leave"
 	}
 
+	// Omitted mappings, overrides, seeding mode and tags keep the stored ones;
+	// an explicit empty list clears them.
+	pathMappings := encodePathMappings(input.PathMappings)
+	delayOverrides := encodeImportDelayOverrides(input.ImportDelayOverrides)
+	seedingMode := input.SeedingImportMode
+	if input.PathMappings == nil || input.ImportDelayOverrides == nil || seedingMode == "" {
+		existing, err := s.queries.GetDownloadClient(ctx, id)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return nil, ErrClientNotFound
+			}
+			return nil, fmt.Errorf("failed to get download client: %w", err)
+		}
+		if input.PathMappings == nil {
+			pathMappings = existing.PathMappings
+		}
+		if input.ImportDelayOverrides == nil {
+			delayOverrides = existing.ImportDelayOverrides
+		}
+		if seedingMode == "" {
+			seedingMode = existing.SeedingImportMode
+		}
+	}
+
 	row, err := s.queries.UpdateDownloadClient(ctx, sqlc.UpdateDownloadClientParams{
-		ID:                 id,
-		Name:               input.Name,
-		Type:               input.Type,
-		Host:               input.Host,
-		Port:               int64(input.Port),
-		Username:           toNullString(input.Username),
-		Password:           toNullString(input.Password),
-		UseSsl:             input.UseSSL,
-		ApiKey:             toNullString(input.APIKey),
-		Category:           toNullString(input.Category),
-		UrlBase:            input.URLBase,
-		Priority:           int64(input.Priority),
-		Enabled:            input.Enabled,
-		ImportDelaySeconds: int64(input.ImportDelaySeconds),
-		CleanupMode:        cleanupMode,
-		SeedRatioTarget:    toNullFloat64(input.SeedRatioTarget),
+		ID:                    id,
+		Name:                  input.Name,
+		Type:                  input.Type,
+		Host:                  input.Host,
+		Port:                  int64(input.Port),
+		Username:              toNullString(input.Username),
+		Password:              toNullString(input.Password),
+		UseSsl:                input.UseSSL,
+		ApiKey:                toNullString(input.APIKey),
+		Category:              toNullString(input.Category),
+		UrlBase:               input.URLBase,
+		Priority:              int64(input.Priority),
+		Enabled:               input.Enabled,
+		ImportDelaySeconds:    int64(input.ImportDelaySeconds),
+		CleanupMode:           cleanupMode,
+		SeedRatioTarget:       toNullFloat64(input.SeedRatioTarget),
+		SeedTimeTargetMinutes: toNullInt64(input.SeedTimeTargetMins),
+		PathMappings:          pathMappings,
+		ImportDelayOverrides:  delayOverrides,
+		SeedingImportMode:     seedingMode,
 	})
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
@@ -294,10 +364,17 @@ func (s *Service) Update(ctx context.Context, id int64, input *UpdateClientInput
 
 	s.clientPoolMu.Lock()
 	delete(s.clientPool, id)
+	delete(s.pathMappings, id)
 	s.clientPoolMu.Unlock()
 
+	if input.Tags != nil {
+		if err := s.saveTags(ctx, id, input.Tags); err != nil {
+			return nil, err
+		}
+	}
+
 	s.logger.Info().Int64("id", id).Str("name", input.Name).Msg("Updated download client")
-	return s.rowToClient(row), nil
+	return s.toClients(ctx, []*sqlc.DownloadClient{row})[0], nil
 }
 
 // Delete deletes a download client.
@@ -308,6 +385,7 @@ func (s *Service) Delete(ctx context.Context, id int64) error {
 
 	s.clientPoolMu.Lock()
 	delete(s.clientPool, id)
+	delete(s.pathMappings, id)
 	s.clientPoolMu.Unlock()
 
 	// Unregister from health service
@@ -407,6 +485,8 @@ func (s *Service) GetTransmissionClient(ctx context.Context, id int64) (*transmi
 		Username: cfg.Username,
 		Password: cfg.Password,
 		UseSSL:   cfg.UseSSL,
+		Category: cfg.Category,
+		URLBase:  cfg.URLBase,
 	}), nil
 }
 
@@ -432,6 +512,7 @@ func (s *Service) GetClient(ctx context.Context, id int64) (Client, error) {
 
 	s.clientPoolMu.Lock()
 	s.clientPool[id] = c
+	s.pathMappings[id] = cfg.PathMappings
 	s.clientPoolMu.Unlock()
 
 	return c, nil
@@ -442,9 +523,25 @@ func (s *Service) GetClient(ctx context.Context, id int64) (Client, error) {
 func (s *Service) EvictClient(id int64) {
 	s.clientPoolMu.Lock()
 	delete(s.clientPool, id)
+	delete(s.pathMappings, id)
 	s.clientPoolMu.Unlock()
 }
 
+// GetFreeSpace returns the free disk space, in bytes, reported by a download client.
+// Returns ErrNotImplemented if the client type cannot report disk space.
+func (s *Service) GetFreeSpace(ctx context.Context, id int64) (int64, error) {
+	client, err := s.GetClient(ctx, id)
+	if err != nil {
+		return 0, err
+	}
+
+	fsClient, ok := client.(FreeSpaceClient)
+	if !ok {
+		return 0, ErrNotImplemented
+	}
+	return fsClient.GetFreeSpace(ctx)
+}
+
 // GetTorrentClient returns a TorrentClient interface for the given download client ID.
 // Returns an error if the client is not a torrent client.
 func (s *Service) GetTorrentClient(ctx context.Context, id int64) (TorrentClient, error) {
@@ -566,19 +663,67 @@ func (s *Service) AddTorrentWithContent(ctx context.Context, clientID int64, con
 	return torrentID, nil
 }
 
+// GetTorrentInfo returns a torrent's details as reported by its client,
+// without remote path mappings applied.
+func (s *Service) GetTorrentInfo(ctx context.Context, clientID int64, downloadID string) (*TorrentInfo, error) {
+	client, err := s.GetTorrentClient(ctx, clientID)
+	if err != nil {
+		return nil, err
+	}
+	return client.GetTorrentInfo(ctx, downloadID)
+}
+
+// toClients converts rows to download clients with their tags attached.
+// Clients are left untagged if no tag store is configured or the lookup fails.
+func (s *Service) toClients(ctx context.Context, rows []*sqlc.DownloadClient) []*DownloadClient {
+	clients := make([]*DownloadClient, len(rows))
+	for i, row := range rows {
+		clients[i] = s.rowToClient(row)
+		clients[i].Tags = []int64{}
+	}
+	if s.tagStore == nil || len(rows) == 0 {
+		return clients
+	}
+	tagMap, err := s.tagStore.EntityTagMap(ctx, tags.EntityDownloadClient)
+	if err != nil {
+		s.logger.Warn().Err(err).Msg("Failed to load download client tags")
+		return clients
+	}
+	for _, c := range clients {
+		if ids, ok := tagMap[c.ID]; ok {
+			c.Tags = ids
+		}
+	}
+	return clients
+}
+
+// saveTags replaces a download client's tags.
+func (s *Service) saveTags(ctx context.Context, id int64, tagIDs []int64) error {
+	if s.tagStore == nil {
+		return nil
+	}
+	if err := s.tagStore.SetEntityTags(ctx, tags.EntityDownloadClient, id, tagIDs); err != nil {
+		return fmt.Errorf("failed to set download client tags: %w", err)
+	}
+	return nil
+}
+
 // rowToClient converts a database row to a DownloadClient.
 func (s *Service) rowToClient(row *sqlc.DownloadClient) *DownloadClient {
 	client := &DownloadClient{
-		ID:                 row.ID,
-		Name:               row.Name,
-		Type:               row.Type,
-		Host:               row.Host,
-		Port:               int(row.Port),
-		UseSSL:             row.UseSsl,
-		Priority:           int(row.Priority),
-		Enabled:            row.Enabled,
-		ImportDelaySeconds: int(row.ImportDelaySeconds),
-		CleanupMode:        row.CleanupMode,
+		ID:                   row.ID,
+		Name:                 row.Name,
+		Type:                 row.Type,
+		Host:                 row.Host,
+		Port:                 int(row.Port),
+		UseSSL:               row.UseSsl,
+		Priority:             int(row.Priority),
+		Enabled:              row.Enabled,
+		ImportDelaySeconds:   int(row.ImportDelaySeconds),
+		CleanupMode:          row.CleanupMode,
+		PathMappings:         decodePathMappings(row.PathMappings),
+		ImportDelayOverrides: decodeImportDelayOverrides(row.ImportDelayOverrides),
+		SeedingImportMode:    seedingImportModeOrDefault(row.SeedingImportMode),
 	}
 
 	if row.Username.Valid {
@@ -599,6 +744,10 @@ func (s *Service) rowToClient(row *sqlc.DownloadClient) *DownloadClient {
 	if row.SeedRatioTarget.Valid {
 		client.SeedRatioTarget = &row.SeedRatioTarget.Float64
 	}
+	if row.SeedTimeTargetMinutes.Valid {
+		mins := int(row.SeedTimeTargetMinutes.Int64)
+		client.SeedTimeTargetMins = &mins
+	}
 
 	return client
 }
@@ -617,6 +766,13 @@ func toNullFloat64(f *float64) sql.NullFloat64 {
 	return sql.NullFloat64{Float64: *f, Valid: true}
 }
 
+func toNullInt64(i *int) sql.NullInt64 {
+	if i == nil {
+		return sql.NullInt64{}
+	}
+	return sql.NullInt64{Int64: int64(*i), Valid: true}
+}
+
 func (s *Service) getCachedQueue(clientID int64) []QueueItem {
 	s.queueCacheMu.RLock()
 	defer s.queueCacheMu.RUnlock()
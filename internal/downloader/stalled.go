@@ -0,0 +1,245 @@
+package downloader
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/slipstream/slipstream/internal/database/sqlc"
+)
+
+const (
+	stalledDownloadsSettingKey = "stalled_downloads"
+
+	defaultStalledMinutes   = 30
+	defaultFailAfterMinutes = 240
+
+	stalledDownloadMessage = "Download stalled with no progress"
+)
+
+// ErrInvalidStalledSettings means stalled download settings failed validation.
+var ErrInvalidStalledSettings = errors.New("invalid stalled download settings")
+
+// StalledDownloadSettings configures nudging of stalled torrents. A torrent
+// SlipStream grabbed that makes no progress for StalledMinutes is re-announced
+// to its trackers; if it still makes none for FailAfterMinutes after that, it
+// is removed and its media marked failed so it is searched for again. A
+// FailAfterMinutes of 0 only ever re-announces.
+type StalledDownloadSettings struct {
+	Enabled          bool `json:"enabled"`
+	StalledMinutes   int  `json:"stalledMinutes"`
+	FailAfterMinutes int  `json:"failAfterMinutes"`
+}
+
+// StalledDownloadResult summarises one stalled download check.
+type StalledDownloadResult struct {
+	Stalled     int `json:"stalled"`
+	Reannounced int `json:"reannounced"`
+	Failed      int `json:"failed"`
+}
+
+// stallState tracks when a torrent last made progress and when it was last
+// re-announced.
+type stallState struct {
+	downloaded    int64
+	since         time.Time
+	reannouncedAt time.Time
+}
+
+// StalledDownloadSettings returns the stalled download settings.
+func (s *Service) StalledDownloadSettings(ctx context.Context) (*StalledDownloadSettings, error) {
+	settings := &StalledDownloadSettings{
+		StalledMinutes:   defaultStalledMinutes,
+		FailAfterMinutes: defaultFailAfterMinutes,
+	}
+	row, err := s.queries.GetSetting(ctx, stalledDownloadsSettingKey)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return settings, nil
+		}
+		return nil, err
+	}
+	if err := json.Unmarshal([]byte(row.Value), settings); err != nil {
+		return nil, err
+	}
+	return settings, nil
+}
+
+// SetStalledDownloadSettings validates and saves the stalled download settings.
+func (s *Service) SetStalledDownloadSettings(ctx context.Context, input *StalledDownloadSettings) (*StalledDownloadSettings, error) {
+	if input.StalledMinutes < 1 {
+		return nil, fmt.Errorf("%w: stalled minutes must be at least 1", ErrInvalidStalledSettings)
+	}
+	if input.FailAfterMinutes < 0 {
+		return nil, fmt.Errorf("%w: fail after minutes must not be negative", ErrInvalidStalledSettings)
+	}
+	encoded, err := json.Marshal(input)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := s.queries.SetSetting(ctx, sqlc.SetSettingParams{Key: stalledDownloadsSettingKey, Value: string(encoded)}); err != nil {
+		return nil, err
+	}
+	settings := *input
+	return &settings, nil
+}
+
+// NudgeStalledDownloads re-announces grabbed torrents that have stalled and
+// fails those that stay stalled. Progress is tracked in memory across runs,
+// so a restart gives every torrent a fresh stall window.
+func (s *Service) NudgeStalledDownloads(ctx context.Context) error {
+	_, err := s.CheckStalledDownloads(ctx)
+	return err
+}
+
+// CheckStalledDownloads runs one stalled download check and reports what it did.
+func (s *Service) CheckStalledDownloads(ctx context.Context) (*StalledDownloadResult, error) {
+	result := &StalledDownloadResult{}
+	settings, err := s.StalledDownloadSettings(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load stalled download settings: %w", err)
+	}
+	if !settings.Enabled {
+		return result, nil
+	}
+
+	clients, err := s.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list download clients: %w", err)
+	}
+
+	now := s.now()
+	stalledAfter := time.Duration(settings.StalledMinutes) * time.Minute
+	failAfter := time.Duration(settings.FailAfterMinutes) * time.Minute
+
+	for _, cfg := range clients {
+		if !cfg.Enabled || ProtocolForClient(ClientType(cfg.Type)) != ProtocolTorrent {
+			continue
+		}
+		s.checkClientStalls(ctx, cfg.ID, now, stalledAfter, failAfter, result)
+	}
+
+	if result.Reannounced > 0 || result.Failed > 0 {
+		s.logger.Info().
+			Int("stalled", result.Stalled).
+			Int("reannounced", result.Reannounced).
+			Int("failed", result.Failed).
+			Msg("Nudged stalled downloads")
+	}
+	return result, nil
+}
+
+func (s *Service) checkClientStalls(ctx context.Context, clientID int64, now time.Time, stalledAfter, failAfter time.Duration, result *StalledDownloadResult) {
+	client, err := s.GetClient(ctx, clientID)
+	if err != nil {
+		s.logger.Warn().Err(err).Int64("clientId", clientID).Msg("Failed to connect to download client for stall check")
+		return
+	}
+	items, err := client.List(ctx)
+	if err != nil {
+		s.logger.Warn().Err(err).Int64("clientId", clientID).Msg("Failed to list downloads for stall check")
+		return
+	}
+
+	s.stallMu.Lock()
+	previous := s.stalls[clientID]
+	s.stallMu.Unlock()
+
+	current := make(map[string]*stallState)
+	for i := range items {
+		item := &items[i]
+		if !canStall(item.Status) {
+			continue
+		}
+		if _, err := s.queries.GetDownloadMapping(ctx, sqlc.GetDownloadMappingParams{ClientID: clientID, DownloadID: item.ID}); err != nil {
+			// Only torrents SlipStream grabbed are nudged or failed
+			continue
+		}
+
+		state := previous[item.ID]
+		if state == nil || state.downloaded != item.DownloadedSize {
+			state = &stallState{downloaded: item.DownloadedSize, since: now}
+		}
+		current[item.ID] = state
+
+		if now.Sub(state.since) < stalledAfter {
+			continue
+		}
+		result.Stalled++
+
+		switch {
+		case state.reannouncedAt.IsZero():
+			state.reannouncedAt = now
+			if s.reannounce(ctx, client, clientID, item) {
+				result.Reannounced++
+			}
+		case failAfter > 0 && now.Sub(state.reannouncedAt) >= failAfter:
+			if s.failStalledDownload(ctx, client, clientID, item) {
+				delete(current, item.ID)
+				result.Failed++
+			}
+		}
+	}
+
+	s.stallMu.Lock()
+	s.stalls[clientID] = current
+	s.stallMu.Unlock()
+}
+
+func (s *Service) reannounce(ctx context.Context, client Client, clientID int64, item *DownloadItem) bool {
+	reannouncer, ok := client.(Reannouncer)
+	if !ok {
+		return false
+	}
+	if err := reannouncer.Reannounce(ctx, item.ID); err != nil {
+		s.logger.Warn().Err(err).Int64("clientId", clientID).Str("downloadId", item.ID).Msg("Failed to re-announce stalled torrent")
+		return false
+	}
+	s.logger.Info().Int64("clientId", clientID).Str("downloadId", item.ID).Str("name", item.Name).Msg("Re-announced stalled torrent")
+	return true
+}
+
+// failStalledDownload removes a torrent that stayed stalled after being
+// re-announced and hands it to failed-download handling: its media is marked
+// failed, so automatic search picks it up again, and its mapping is dropped.
+func (s *Service) failStalledDownload(ctx context.Context, client Client, clientID int64, item *DownloadItem) bool {
+	if err := client.Remove(ctx, item.ID, true); err != nil {
+		s.logger.Warn().Err(err).Int64("clientId", clientID).Str("downloadId", item.ID).Msg("Failed to remove stalled torrent")
+		return false
+	}
+	s.logger.Warn().Int64("clientId", clientID).Str("downloadId", item.ID).Str("name", item.Name).Msg("Removed torrent that stayed stalled")
+
+	if movies, err := s.queries.ListDownloadingMovies(ctx); err == nil {
+		for _, m := range movies {
+			if m.ActiveDownloadID.String == item.ID {
+				s.markMovieDownloadFailed(ctx, m, stalledDownloadMessage)
+			}
+		}
+	}
+	if episodes, err := s.queries.ListDownloadingEpisodes(ctx); err == nil {
+		for _, ep := range episodes {
+			if ep.ActiveDownloadID.String == item.ID {
+				s.markEpisodeDownloadFailed(ctx, ep, stalledDownloadMessage)
+			}
+		}
+	}
+
+	if err := s.HandleFailedDownload(ctx, clientID, item.ID); err != nil {
+		s.logger.Warn().Err(err).Int64("clientId", clientID).Str("downloadId", item.ID).Msg("Failed to clear stalled download mapping")
+	}
+	return true
+}
+
+// canStall reports whether a download in this status is expected to progress.
+// Paused, completed and seeding downloads never count as stalled.
+func canStall(status Status) bool {
+	switch status {
+	case StatusQueued, StatusDownloading, StatusWarning, StatusError, StatusUnknown:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,114 @@
+package downloader
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/slipstream/slipstream/internal/database/sqlc"
+	"github.com/slipstream/slipstream/internal/downloader/mock"
+	"github.com/slipstream/slipstream/internal/downloader/types"
+	"github.com/slipstream/slipstream/internal/testutil"
+)
+
+func TestSetStalledDownloadSettings_Validation(t *testing.T) {
+	tdb := testutil.NewTestDB(t)
+	defer tdb.Close()
+
+	svc := NewService(tdb.Conn, &tdb.Logger, nil, nil, nil, nil)
+	ctx := context.Background()
+
+	settings, err := svc.StalledDownloadSettings(ctx)
+	if err != nil {
+		t.Fatalf("StalledDownloadSettings error = %v", err)
+	}
+	if settings.Enabled || settings.StalledMinutes != defaultStalledMinutes || settings.FailAfterMinutes != defaultFailAfterMinutes {
+		t.Errorf("default settings = %+v", settings)
+	}
+
+	for _, input := range []StalledDownloadSettings{
+		{StalledMinutes: 0, FailAfterMinutes: 10},
+		{StalledMinutes: 10, FailAfterMinutes: -1},
+	} {
+		if _, err := svc.SetStalledDownloadSettings(ctx, &input); !errors.Is(err, ErrInvalidStalledSettings) {
+			t.Errorf("SetStalledDownloadSettings(%+v) error = %v, want ErrInvalidStalledSettings", input, err)
+		}
+	}
+}
+
+func TestCheckStalledDownloads(t *testing.T) {
+	tdb := testutil.NewTestDB(t)
+	defer tdb.Close()
+
+	svc := NewService(tdb.Conn, &tdb.Logger, nil, nil, nil, nil)
+	ctx := context.Background()
+	queries := sqlc.New(tdb.Conn)
+
+	now := time.Now()
+	svc.now = func() time.Time { return now }
+
+	mockClient := mock.GetInstance()
+	mockClient.Clear()
+	t.Cleanup(mockClient.Clear)
+
+	dc := createSeedingTestClient(t, queries, "mock", "leave")
+
+	// Both downloads sit queued at 0% for the whole test; only the grabbed
+	// one has a mapping.
+	grabbedID, err := mockClient.Add(ctx, &types.AddOptions{Name: "Grabbed"})
+	if err != nil {
+		t.Fatalf("Add error = %v", err)
+	}
+	foreignID, err := mockClient.Add(ctx, &types.AddOptions{Name: "Foreign"})
+	if err != nil {
+		t.Fatalf("Add error = %v", err)
+	}
+	if _, err := queries.CreateDownloadMapping(ctx, sqlc.CreateDownloadMappingParams{
+		ClientID:   dc.ID,
+		DownloadID: grabbedID,
+		ModuleType: "movie",
+		EntityType: "movie",
+		EntityID:   1,
+		Source:     "auto-search",
+	}); err != nil {
+		t.Fatalf("CreateDownloadMapping error = %v", err)
+	}
+
+	if _, err := svc.SetStalledDownloadSettings(ctx, &StalledDownloadSettings{Enabled: true, StalledMinutes: 30, FailAfterMinutes: 60}); err != nil {
+		t.Fatalf("SetStalledDownloadSettings error = %v", err)
+	}
+
+	steps := []struct {
+		advance time.Duration
+		want    StalledDownloadResult
+	}{
+		{0, StalledDownloadResult{}},
+		{20 * time.Minute, StalledDownloadResult{}},
+		// Stalled: re-announced (the mock client cannot, so nothing is counted)
+		{15 * time.Minute, StalledDownloadResult{Stalled: 1}},
+		{30 * time.Minute, StalledDownloadResult{Stalled: 1}},
+		// Still stalled an hour after the re-announce: failed
+		{30 * time.Minute, StalledDownloadResult{Stalled: 1, Failed: 1}},
+	}
+	for i, step := range steps {
+		now = now.Add(step.advance)
+		result, err := svc.CheckStalledDownloads(ctx)
+		if err != nil {
+			t.Fatalf("step %d: CheckStalledDownloads error = %v", i, err)
+		}
+		if *result != step.want {
+			t.Errorf("step %d: result = %+v, want %+v", i, *result, step.want)
+		}
+	}
+
+	if _, err := mockClient.Get(ctx, grabbedID); err == nil {
+		t.Error("expected stalled grabbed download to be removed")
+	}
+	if _, err := mockClient.Get(ctx, foreignID); err != nil {
+		t.Errorf("expected download without a mapping to remain, got %v", err)
+	}
+	if _, err := svc.GetDownloadMapping(ctx, dc.ID, grabbedID); err == nil {
+		t.Error("expected mapping of failed download to be deleted")
+	}
+}
@@ -0,0 +1,151 @@
+package downloader
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"path"
+	"strconv"
+)
+
+// ErrInvalidTorrent means torrent file content could not be parsed.
+var ErrInvalidTorrent = errors.New("invalid torrent file")
+
+// TorrentFile is one file described by a torrent's metainfo. Path is
+// slash-separated and relative to the directory the torrent is saved in, so it
+// starts with the torrent's name.
+type TorrentFile struct {
+	Path string
+	Size int64
+}
+
+// TorrentMeta is the part of a torrent's metainfo that describes its data.
+type TorrentMeta struct {
+	Name  string
+	Files []TorrentFile
+}
+
+// ParseTorrentMeta reads the name and file list from torrent file content.
+func ParseTorrentMeta(content []byte) (*TorrentMeta, error) {
+	value, _, err := decodeBencode(content)
+	if err != nil {
+		return nil, err
+	}
+	root, ok := value.(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("%w: not a dictionary", ErrInvalidTorrent)
+	}
+	info, ok := root["info"].(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("%w: missing info dictionary", ErrInvalidTorrent)
+	}
+	name, ok := info["name"].(string)
+	if !ok || name == "" {
+		return nil, fmt.Errorf("%w: missing name", ErrInvalidTorrent)
+	}
+
+	meta := &TorrentMeta{Name: name}
+	if length, ok := info["length"].(int64); ok {
+		meta.Files = []TorrentFile{{Path: name, Size: length}}
+		return meta, nil
+	}
+	files, ok := info["files"].([]any)
+	if !ok || len(files) == 0 {
+		return nil, fmt.Errorf("%w: missing file list", ErrInvalidTorrent)
+	}
+	for _, f := range files {
+		file, ok := f.(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf("%w: malformed file entry", ErrInvalidTorrent)
+		}
+		length, ok := file["length"].(int64)
+		if !ok {
+			return nil, fmt.Errorf("%w: file without length", ErrInvalidTorrent)
+		}
+		parts, ok := file["path"].([]any)
+		if !ok || len(parts) == 0 {
+			return nil, fmt.Errorf("%w: file without path", ErrInvalidTorrent)
+		}
+		elems := []string{name}
+		for _, p := range parts {
+			part, ok := p.(string)
+			if !ok || part == "" || part == "." || part == ".." {
+				return nil, fmt.Errorf("%w: malformed file path", ErrInvalidTorrent)
+			}
+			elems = append(elems, part)
+		}
+		meta.Files = append(meta.Files, TorrentFile{Path: path.Join(elems...), Size: length})
+	}
+	return meta, nil
+}
+
+// decodeBencode decodes the bencoded value at the start of data and returns it
+// with the number of bytes consumed. Dictionaries decode to map[string]any,
+// lists to []any, integers to int64 and byte strings to string.
+func decodeBencode(data []byte) (any, int, error) { //nolint:gocognit,gocyclo // bencode parser requires branching
+	if len(data) == 0 {
+		return nil, 0, fmt.Errorf("%w: unexpected end of data", ErrInvalidTorrent)
+	}
+	switch data[0] {
+	case 'd':
+		dict := make(map[string]any)
+		pos := 1
+		for pos < len(data) && data[pos] != 'e' {
+			key, n, err := decodeBencode(data[pos:])
+			if err != nil {
+				return nil, 0, err
+			}
+			k, ok := key.(string)
+			if !ok {
+				return nil, 0, fmt.Errorf("%w: dictionary key is not a string", ErrInvalidTorrent)
+			}
+			pos += n
+			value, n, err := decodeBencode(data[pos:])
+			if err != nil {
+				return nil, 0, err
+			}
+			pos += n
+			dict[k] = value
+		}
+		if pos >= len(data) {
+			return nil, 0, fmt.Errorf("%w: unterminated dictionary", ErrInvalidTorrent)
+		}
+		return dict, pos + 1, nil
+	case 'l':
+		var list []any
+		pos := 1
+		for pos < len(data) && data[pos] != 'e' {
+			value, n, err := decodeBencode(data[pos:])
+			if err != nil {
+				return nil, 0, err
+			}
+			pos += n
+			list = append(list, value)
+		}
+		if pos >= len(data) {
+			return nil, 0, fmt.Errorf("%w: unterminated list", ErrInvalidTorrent)
+		}
+		return list, pos + 1, nil
+	case 'i':
+		end := bytes.IndexByte(data, 'e')
+		if end < 0 {
+			return nil, 0, fmt.Errorf("%w: unterminated integer", ErrInvalidTorrent)
+		}
+		n, err := strconv.ParseInt(string(data[1:end]), 10, 64)
+		if err != nil {
+			return nil, 0, fmt.Errorf("%w: bad integer", ErrInvalidTorrent)
+		}
+		return n, end + 1, nil
+	default:
+		colon := bytes.IndexByte(data, ':')
+		if colon < 0 {
+			return nil, 0, fmt.Errorf("%w: bad string", ErrInvalidTorrent)
+		}
+		length, err := strconv.Atoi(string(data[:colon]))
+		if err != nil || length < 0 || colon+1+length > len(data) {
+			return nil, 0, fmt.Errorf("%w: bad string length", ErrInvalidTorrent)
+		}
+		start := colon + 1
+		return string(data[start : start+length]), start + length, nil
+	}
+}
@@ -0,0 +1,78 @@
+package downloader
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+const multiFileTorrent = "d8:announce9:http://tr4:infod5:filesl" +
+	"d6:lengthi10e4:pathl9:movie.mkvee" +
+	"d6:lengthi3e4:pathl4:Subs7:eng.srtee" +
+	"e4:name9:Movie.GRP12:piece lengthi16384e6:pieces0:ee"
+
+func TestParseTorrentMeta(t *testing.T) {
+	meta, err := ParseTorrentMeta([]byte(multiFileTorrent))
+	if err != nil {
+		t.Fatalf("ParseTorrentMeta() error = %v", err)
+	}
+	if meta.Name != "Movie.GRP" {
+		t.Errorf("Name = %q, want Movie.GRP", meta.Name)
+	}
+	want := []TorrentFile{{Path: "Movie.GRP/movie.mkv", Size: 10}, {Path: "Movie.GRP/Subs/eng.srt", Size: 3}}
+	if len(meta.Files) != len(want) {
+		t.Fatalf("Files = %+v, want %+v", meta.Files, want)
+	}
+	for i := range want {
+		if meta.Files[i] != want[i] {
+			t.Errorf("Files[%d] = %+v, want %+v", i, meta.Files[i], want[i])
+		}
+	}
+
+	single, err := ParseTorrentMeta([]byte("d4:infod6:lengthi42e4:name9:movie.mkvee"))
+	if err != nil {
+		t.Fatalf("ParseTorrentMeta() single file error = %v", err)
+	}
+	if len(single.Files) != 1 || single.Files[0] != (TorrentFile{Path: "movie.mkv", Size: 42}) {
+		t.Errorf("single file Files = %+v", single.Files)
+	}
+
+	for _, bad := range []string{"", "d4:infoi1ee", "d4:infod4:name1:xe", "d4:infod5:filesld6:lengthi1e4:pathl2:..eee4:name1:xee", "d4:info"} {
+		if _, err := ParseTorrentMeta([]byte(bad)); !errors.Is(err, ErrInvalidTorrent) {
+			t.Errorf("ParseTorrentMeta(%q) err = %v, want ErrInvalidTorrent", bad, err)
+		}
+	}
+}
+
+func TestVerifyTorrentFiles(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(dir, "Movie.GRP", "Subs"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "Movie.GRP", "movie.mkv"), make([]byte, 10), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	meta, err := ParseTorrentMeta([]byte(multiFileTorrent))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := verifyTorrentFiles(meta, dir); !errors.Is(err, ErrTorrentDataMismatch) {
+		t.Errorf("verifyTorrentFiles() with a missing file err = %v, want ErrTorrentDataMismatch", err)
+	}
+
+	if err := os.WriteFile(filepath.Join(dir, "Movie.GRP", "Subs", "eng.srt"), make([]byte, 4), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if err := verifyTorrentFiles(meta, dir); !errors.Is(err, ErrTorrentDataMismatch) {
+		t.Errorf("verifyTorrentFiles() with a wrong size err = %v, want ErrTorrentDataMismatch", err)
+	}
+
+	if err := os.WriteFile(filepath.Join(dir, "Movie.GRP", "Subs", "eng.srt"), make([]byte, 3), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if err := verifyTorrentFiles(meta, dir); err != nil {
+		t.Errorf("verifyTorrentFiles() with matching data error = %v", err)
+	}
+}
@@ -19,8 +19,18 @@ import (
 
 const (
 	sessionIDHeader = "X-Transmission-Session-Id"
+	defaultURLBase  = "/transmission/"
 )
 
+// torrentFields are the torrent-get fields needed to build a DownloadItem.
+var torrentFields = []string{
+	"id", "name", "status", "percentDone",
+	"totalSize", "downloadDir", "hashString",
+	"eta", "rateDownload", "rateUpload",
+	"downloadedEver", "sizeWhenDone", "error", "errorString",
+	"isFinished", "addedDate", "doneDate",
+}
+
 // Config holds the configuration for a Transmission client.
 type Config struct {
 	Host     string
@@ -28,6 +38,8 @@ type Config struct {
 	Username string
 	Password string
 	UseSSL   bool
+	Category string // Default label applied to added torrents
+	URLBase  string // RPC path prefix, defaults to /transmission/
 }
 
 // Client implements a Transmission RPC client that satisfies the types.TorrentClient interface.
@@ -58,6 +70,8 @@ func NewFromConfig(cfg *types.ClientConfig) *Client {
 		Username: cfg.Username,
 		Password: cfg.Password,
 		UseSSL:   cfg.UseSSL,
+		Category: cfg.Category,
+		URLBase:  cfg.URLBase,
 	})
 }
 
@@ -120,7 +134,33 @@ func (c *Client) Add(ctx context.Context, opts *types.AddOptions) (string, error
 		return "", err
 	}
 
-	return c.extractTorrentID(resp)
+	id, err := c.extractTorrentID(resp)
+	if err != nil {
+		return "", err
+	}
+
+	label := opts.Category
+	if label == "" {
+		label = c.config.Category
+	}
+	if label != "" {
+		// Labels require RPC version 16 (Transmission 3.0); older daemons
+		// reject the argument, which must not fail the add itself.
+		_ = c.setLabels(ctx, id, []string{label})
+	}
+
+	return id, nil
+}
+
+// setLabels replaces the labels on a torrent.
+func (c *Client) setLabels(ctx context.Context, id string, labels []string) error {
+	args := map[string]interface{}{
+		"ids":    []string{id},
+		"labels": labels,
+	}
+
+	_, err := c.call(ctx, "torrent-set", args)
+	return err
 }
 
 // AddMagnet adds a torrent from a magnet URL.
@@ -161,12 +201,7 @@ func (c *Client) AddURL(url, subDir string) (string, error) {
 // List returns all torrents.
 func (c *Client) List(ctx context.Context) ([]types.DownloadItem, error) {
 	args := map[string]interface{}{
-		"fields": []string{
-			"id", "name", "status", "percentDone",
-			"totalSize", "downloadDir", "hashString",
-			"eta", "rateDownload", "rateUpload",
-			"downloadedEver", "sizeWhenDone", "error", "errorString",
-		},
+		"fields": torrentFields,
 	}
 
 	resp, err := c.call(ctx, "torrent-get", args)
@@ -195,13 +230,8 @@ func (c *Client) List(ctx context.Context) ([]types.DownloadItem, error) {
 // Get retrieves a specific torrent by ID.
 func (c *Client) Get(ctx context.Context, id string) (*types.DownloadItem, error) {
 	args := map[string]interface{}{
-		"ids": []string{id},
-		"fields": []string{
-			"id", "name", "status", "percentDone",
-			"totalSize", "downloadDir", "hashString",
-			"eta", "rateDownload", "rateUpload",
-			"downloadedEver", "sizeWhenDone", "error", "errorString",
-		},
+		"ids":    []string{id},
+		"fields": torrentFields,
 	}
 
 	resp, err := c.call(ctx, "torrent-get", args)
@@ -254,6 +284,26 @@ func (c *Client) Resume(ctx context.Context, id string) error {
 	return err
 }
 
+// Reannounce asks the torrent's trackers for peers immediately.
+func (c *Client) Reannounce(ctx context.Context, id string) error {
+	args := map[string]interface{}{
+		"ids": []string{id},
+	}
+
+	_, err := c.call(ctx, "torrent-reannounce", args)
+	return err
+}
+
+// Recheck verifies the torrent's data on disk.
+func (c *Client) Recheck(ctx context.Context, id string) error {
+	args := map[string]interface{}{
+		"ids": []string{id},
+	}
+
+	_, err := c.call(ctx, "torrent-verify", args)
+	return err
+}
+
 // GetDownloadDir returns the default download directory from Transmission.
 func (c *Client) GetDownloadDir(ctx context.Context) (string, error) {
 	resp, err := c.call(ctx, "session-get", nil)
@@ -268,6 +318,26 @@ func (c *Client) GetDownloadDir(ctx context.Context) (string, error) {
 	return "", fmt.Errorf("download-dir not found in session response")
 }
 
+// GetFreeSpace returns the free disk space in Transmission's download directory.
+func (c *Client) GetFreeSpace(ctx context.Context) (int64, error) {
+	downloadDir, err := c.GetDownloadDir(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	resp, err := c.call(ctx, "free-space", map[string]interface{}{"path": downloadDir})
+	if err != nil {
+		return 0, err
+	}
+
+	size, ok := resp.Arguments["size-bytes"].(float64)
+	if !ok {
+		return 0, fmt.Errorf("size-bytes not found in free-space response")
+	}
+
+	return int64(size), nil
+}
+
 // SetSeedLimits configures seed ratio/time limits for a torrent.
 func (c *Client) SetSeedLimits(ctx context.Context, id string, ratio float64, seedTime time.Duration) error {
 	args := map[string]interface{}{
@@ -291,15 +361,8 @@ func (c *Client) SetSeedLimits(ctx context.Context, id string, ratio float64, se
 // GetTorrentInfo returns torrent-specific information.
 func (c *Client) GetTorrentInfo(ctx context.Context, id string) (*types.TorrentInfo, error) {
 	args := map[string]interface{}{
-		"ids": []string{id},
-		"fields": []string{
-			"id", "name", "status", "percentDone",
-			"totalSize", "downloadDir", "hashString",
-			"eta", "rateDownload", "rateUpload",
-			"downloadedEver", "uploadedEver", "sizeWhenDone",
-			"error", "errorString", "uploadRatio",
-			"trackerStats", "isPrivate",
-		},
+		"ids":    []string{id},
+		"fields": append([]string{"uploadedEver", "uploadRatio", "trackerStats", "isPrivate"}, torrentFields...),
 	}
 
 	resp, err := c.call(ctx, "torrent-get", args)
@@ -428,7 +491,15 @@ func (c *Client) buildRPCRequest(ctx context.Context, method string, args map[st
 	if c.config.UseSSL {
 		scheme = "https"
 	}
-	url := fmt.Sprintf("%s://%s:%d/transmission/rpc", scheme, netutil.NormalizeLoopbackHost(c.config.Host), c.config.Port)
+	urlBase := c.config.URLBase
+	if urlBase == "" {
+		urlBase = defaultURLBase
+	}
+	urlPath := "/rpc"
+	if trimmed := strings.Trim(urlBase, "/"); trimmed != "" {
+		urlPath = "/" + trimmed + "/rpc"
+	}
+	url := fmt.Sprintf("%s://%s:%d%s", scheme, netutil.NormalizeLoopbackHost(c.config.Host), c.config.Port, urlPath)
 
 	body, err := json.Marshal(rpcRequest{Method: method, Arguments: args})
 	if err != nil {
@@ -487,7 +558,7 @@ func (c *Client) parseRPCResponse(resp *http.Response) (*rpcResponse, error) {
 
 // mapToDownloadItem converts a Transmission torrent response to a DownloadItem.
 func (c *Client) mapToDownloadItem(torrent map[string]interface{}) types.DownloadItem {
-	status := mapStatus(getInt(torrent, "status"))
+	status := mapStatus(getInt(torrent, "status"), getBool(torrent, "isFinished"))
 	progress := getFloat(torrent, "percentDone") * 100 // Convert from 0-1 to 0-100
 
 	item := types.DownloadItem{
@@ -503,6 +574,13 @@ func (c *Client) mapToDownloadItem(torrent map[string]interface{}) types.Downloa
 		DownloadDir:    getString(torrent, "downloadDir"),
 	}
 
+	if added := getInt(torrent, "addedDate"); added > 0 {
+		item.AddedAt = time.Unix(int64(added), 0)
+	}
+	if done := getInt(torrent, "doneDate"); done > 0 {
+		item.CompletedAt = time.Unix(int64(done), 0)
+	}
+
 	// Check for errors
 	if errNum := getInt(torrent, "error"); errNum > 0 {
 		item.Error = getString(torrent, "errorString")
@@ -537,15 +615,18 @@ func (c *Client) extractTorrentID(resp *rpcResponse) (string, error) {
 	return "", fmt.Errorf("could not extract torrent ID from response")
 }
 
-// mapStatus maps Transmission status codes to our status strings.
-func mapStatus(status int) types.Status {
+// mapStatus maps Transmission status codes to our status strings. A stopped
+// torrent that has reached its seed limit is reported as completed rather than
+// paused so the importer still picks it up.
+func mapStatus(status int, isFinished bool) types.Status {
 	switch status {
 	case 0: // Stopped
+		if isFinished {
+			return types.StatusCompleted
+		}
 		return types.StatusPaused
-	case 1: // Queued to verify
-		return types.StatusQueued
-	case 2: // Verifying
-		return types.StatusDownloading
+	case 1, 2: // Queued to verify, verifying
+		return types.StatusChecking
 	case 3: // Queued to download
 		return types.StatusQueued
 	case 4: // Downloading
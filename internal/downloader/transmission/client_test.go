@@ -0,0 +1,163 @@
+package transmission
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/slipstream/slipstream/internal/downloader/types"
+)
+
+type testRequest struct {
+	Method    string         `json:"method"`
+	Arguments map[string]any `json:"arguments"`
+}
+
+func newTestServer(t *testing.T, path string, handle func(req testRequest) map[string]any) *httptest.Server {
+	t.Helper()
+	const sessionID = "test-session"
+
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != path {
+			t.Errorf("unexpected path %s, want %s", r.URL.Path, path)
+		}
+		if r.Header.Get(sessionIDHeader) != sessionID {
+			w.Header().Set(sessionIDHeader, sessionID)
+			w.WriteHeader(http.StatusConflict)
+			return
+		}
+
+		var req testRequest
+		json.NewDecoder(r.Body).Decode(&req)
+		json.NewEncoder(w).Encode(map[string]any{"result": "success", "arguments": handle(req)})
+	}))
+}
+
+func setupTestClient(server *httptest.Server, cfg types.ClientConfig) *Client {
+	addr := server.Listener.Addr().(*net.TCPAddr)
+	cfg.Host = addr.IP.String()
+	cfg.Port = addr.Port
+	return NewFromConfig(&cfg)
+}
+
+func TestClient_Type(t *testing.T) {
+	client := NewFromConfig(&types.ClientConfig{Host: "localhost", Port: 9091})
+	if client.Type() != types.ClientTypeTransmission {
+		t.Errorf("expected type %s, got %s", types.ClientTypeTransmission, client.Type())
+	}
+}
+
+func TestClient_URLBase(t *testing.T) {
+	tests := []struct {
+		name    string
+		urlBase string
+		want    string
+	}{
+		{"default", "", "/transmission/rpc"},
+		{"custom", "/torrents/", "/torrents/rpc"},
+		{"root", "/", "/rpc"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := newTestServer(t, tt.want, func(testRequest) map[string]any {
+				return map[string]any{"download-dir": "/downloads"}
+			})
+			defer server.Close()
+
+			client := setupTestClient(server, types.ClientConfig{URLBase: tt.urlBase})
+			dir, err := client.GetDownloadDir(context.Background())
+			if err != nil {
+				t.Fatalf("GetDownloadDir() failed: %v", err)
+			}
+			if dir != "/downloads" {
+				t.Errorf("expected /downloads, got %s", dir)
+			}
+		})
+	}
+}
+
+func TestClient_Add_SetsLabel(t *testing.T) {
+	var labels []any
+	server := newTestServer(t, "/transmission/rpc", func(req testRequest) map[string]any {
+		switch req.Method {
+		case "torrent-add":
+			return map[string]any{"torrent-added": map[string]any{"id": 1.0, "hashString": "abc123"}}
+		case "torrent-set":
+			labels, _ = req.Arguments["labels"].([]any)
+		default:
+			t.Errorf("unexpected method: %s", req.Method)
+		}
+		return map[string]any{}
+	})
+	defer server.Close()
+
+	client := setupTestClient(server, types.ClientConfig{Category: "slipstream"})
+
+	id, err := client.Add(context.Background(), &types.AddOptions{URL: "magnet:?xt=urn:btih:abc123"})
+	if err != nil {
+		t.Fatalf("Add() failed: %v", err)
+	}
+	if id != "abc123" {
+		t.Errorf("expected id abc123, got %s", id)
+	}
+	if len(labels) != 1 || labels[0] != "slipstream" {
+		t.Errorf("expected labels [slipstream], got %v", labels)
+	}
+}
+
+func TestClient_List(t *testing.T) {
+	server := newTestServer(t, "/transmission/rpc", func(req testRequest) map[string]any {
+		if req.Method != "torrent-get" {
+			t.Errorf("unexpected method: %s", req.Method)
+		}
+		return map[string]any{"torrents": []any{
+			map[string]any{"hashString": "aaa", "status": 4.0, "percentDone": 0.5, "downloadDir": "/downloads"},
+			map[string]any{"hashString": "bbb", "status": 6.0, "percentDone": 1.0, "isFinished": false, "doneDate": 1609459200.0},
+			map[string]any{"hashString": "ccc", "status": 0.0, "percentDone": 1.0, "isFinished": true},
+			map[string]any{"hashString": "ddd", "status": 0.0, "percentDone": 0.2, "isFinished": false},
+			map[string]any{"hashString": "eee", "status": 4.0, "error": 2.0, "errorString": "tracker down"},
+		}}
+	})
+	defer server.Close()
+
+	client := setupTestClient(server, types.ClientConfig{})
+
+	items, err := client.List(context.Background())
+	if err != nil {
+		t.Fatalf("List() failed: %v", err)
+	}
+
+	want := map[string]types.Status{
+		"aaa": types.StatusDownloading,
+		"bbb": types.StatusSeeding,
+		"ccc": types.StatusCompleted,
+		"ddd": types.StatusPaused,
+		"eee": types.StatusWarning,
+	}
+	if len(items) != len(want) {
+		t.Fatalf("expected %d items, got %d", len(want), len(items))
+	}
+	for _, item := range items {
+		if item.Status != want[item.ID] {
+			t.Errorf("%s: expected status %s, got %s", item.ID, want[item.ID], item.Status)
+		}
+		switch item.ID {
+		case "aaa":
+			if item.Progress != 50 {
+				t.Errorf("expected progress 50, got %f", item.Progress)
+			}
+		case "bbb":
+			if item.CompletedAt.Unix() != 1609459200 {
+				t.Errorf("expected completedAt 1609459200, got %d", item.CompletedAt.Unix())
+			}
+		case "eee":
+			if item.Error != "tracker down" {
+				t.Errorf("expected error 'tracker down', got %q", item.Error)
+			}
+		}
+	}
+}
@@ -101,6 +101,24 @@ type Client interface {
 	GetDownloadDir(ctx context.Context) (string, error)
 }
 
+// FreeSpaceClient is implemented by clients that can report free disk space
+// in their default download directory.
+type FreeSpaceClient interface {
+	GetFreeSpace(ctx context.Context) (int64, error)
+}
+
+// Reannouncer is implemented by torrent clients that can force a tracker
+// announce, used to nudge stalled downloads.
+type Reannouncer interface {
+	Reannounce(ctx context.Context, id string) error
+}
+
+// Rechecker is implemented by torrent clients that can re-verify a torrent's
+// data on disk, used before seeding injected torrents.
+type Rechecker interface {
+	Recheck(ctx context.Context, id string) error
+}
+
 // TorrentClient extends Client with torrent-specific operations.
 type TorrentClient interface {
 	Client
@@ -163,6 +181,7 @@ type Status string
 
 const (
 	StatusQueued      Status = "queued"
+	StatusChecking    Status = "checking" // verifying data on disk, not yet safe to import
 	StatusDownloading Status = "downloading"
 	StatusPaused      Status = "paused"
 	StatusCompleted   Status = "completed"
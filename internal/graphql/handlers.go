@@ -0,0 +1,58 @@
+package graphql
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+)
+
+// Handlers provides the HTTP endpoint for GraphQL queries.
+type Handlers struct {
+	service *Service
+}
+
+// NewHandlers creates new GraphQL handlers.
+func NewHandlers(service *Service) *Handlers {
+	return &Handlers{service: service}
+}
+
+// RegisterRoutes registers the GraphQL routes.
+func (h *Handlers) RegisterRoutes(g *echo.Group) {
+	g.GET("", h.QueryGet)
+	g.POST("", h.Query)
+}
+
+// Query executes a query sent as a JSON body.
+// POST /api/v1/graphql
+func (h *Handlers) Query(c echo.Context) error {
+	var req Request
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid request body")
+	}
+	return h.execute(c, &req)
+}
+
+// QueryGet executes a query passed in the query string.
+// GET /api/v1/graphql?query=...&operationName=...&variables=...
+func (h *Handlers) QueryGet(c echo.Context) error {
+	req := Request{
+		Query:         c.QueryParam("query"),
+		OperationName: c.QueryParam("operationName"),
+	}
+	if vars := c.QueryParam("variables"); vars != "" {
+		if err := json.Unmarshal([]byte(vars), &req.Variables); err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, "variables must be a JSON object")
+		}
+	}
+	return h.execute(c, &req)
+}
+
+func (h *Handlers) execute(c echo.Context, req *Request) error {
+	if req.Query == "" {
+		return echo.NewHTTPError(http.StatusBadRequest, "query is required")
+	}
+	// Errors in the query or its resolvers travel in the GraphQL response
+	// body alongside any partial data, with a 200 status
+	return c.JSON(http.StatusOK, h.service.Execute(c.Request().Context(), req))
+}
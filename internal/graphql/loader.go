@@ -0,0 +1,165 @@
+package graphql
+
+import (
+	"context"
+	"slices"
+	"sync"
+
+	"github.com/slipstream/slipstream/internal/database/sqlc"
+)
+
+// loader batches lookups by ID for the lifetime of one request. Parent
+// resolvers prime the IDs their children will ask for, so the first child
+// load fetches every sibling's rows in a single query instead of one per
+// parent. Fetching under the lock makes concurrent siblings wait for that
+// batch and then read from the cache.
+type loader[V any] struct {
+	fetch   func(ctx context.Context, ids []int64) (map[int64]V, error)
+	mu      sync.Mutex
+	pending map[int64]struct{}
+	cache   map[int64]V
+	batches int
+}
+
+func newLoader[V any](fetch func(ctx context.Context, ids []int64) (map[int64]V, error)) *loader[V] {
+	return &loader[V]{
+		fetch:   fetch,
+		pending: make(map[int64]struct{}),
+		cache:   make(map[int64]V),
+	}
+}
+
+// prime queues ids for the next batch.
+func (l *loader[V]) prime(ids ...int64) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	for _, id := range ids {
+		if _, ok := l.cache[id]; !ok {
+			l.pending[id] = struct{}{}
+		}
+	}
+}
+
+// set caches a value the caller already has.
+func (l *loader[V]) set(id int64, v V) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.cache[id] = v
+	delete(l.pending, id)
+}
+
+// load returns the value for id, fetching it together with every pending ID
+// on a cache miss. IDs the fetch does not return load as the zero value.
+func (l *loader[V]) load(ctx context.Context, id int64) (V, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if v, ok := l.cache[id]; ok {
+		return v, nil
+	}
+
+	l.pending[id] = struct{}{}
+	ids := make([]int64, 0, len(l.pending))
+	for pending := range l.pending {
+		ids = append(ids, pending)
+	}
+	slices.Sort(ids)
+	clear(l.pending)
+
+	l.batches++
+	values, err := l.fetch(ctx, ids)
+	if err != nil {
+		var zero V
+		return zero, err
+	}
+	for _, k := range ids {
+		l.cache[k] = values[k]
+	}
+	for k, v := range values {
+		l.cache[k] = v
+	}
+	return l.cache[id], nil
+}
+
+// loaders holds one request's loaders.
+type loaders struct {
+	movies       *loader[*sqlc.Movie]
+	series       *loader[*sqlc.Series]
+	seasons      *loader[[]*sqlc.Season]
+	episodes     *loader[[]*sqlc.Episode]
+	movieFiles   *loader[[]*sqlc.MovieFile]
+	episodeFiles *loader[[]*sqlc.EpisodeFile]
+	slots        *loader[*sqlc.VersionSlot]
+}
+
+func newLoaders(q *sqlc.Queries) *loaders {
+	l := &loaders{}
+	l.movies = newLoader(func(ctx context.Context, ids []int64) (map[int64]*sqlc.Movie, error) {
+		rows, err := q.ListMoviesByIDs(ctx, ids)
+		return byID(rows, err, func(m *sqlc.Movie) int64 { return m.ID })
+	})
+	l.series = newLoader(func(ctx context.Context, ids []int64) (map[int64]*sqlc.Series, error) {
+		rows, err := q.ListSeriesByIDs(ctx, ids)
+		return byID(rows, err, func(s *sqlc.Series) int64 { return s.ID })
+	})
+	l.seasons = newLoader(func(ctx context.Context, seriesIDs []int64) (map[int64][]*sqlc.Season, error) {
+		rows, err := q.ListSeasonsBySeriesIDs(ctx, seriesIDs)
+		return groupBy(rows, err, func(s *sqlc.Season) int64 { return s.SeriesID })
+	})
+	l.episodes = newLoader(func(ctx context.Context, seriesIDs []int64) (map[int64][]*sqlc.Episode, error) {
+		rows, err := q.ListEpisodesBySeriesIDs(ctx, seriesIDs)
+		if err != nil {
+			return nil, err
+		}
+		// Every episode's files are likely wanted once any are
+		for _, e := range rows {
+			l.episodeFiles.prime(e.ID)
+		}
+		return groupBy(rows, nil, func(e *sqlc.Episode) int64 { return e.SeriesID })
+	})
+	l.movieFiles = newLoader(func(ctx context.Context, movieIDs []int64) (map[int64][]*sqlc.MovieFile, error) {
+		rows, err := q.ListMovieFilesByMovieIDs(ctx, movieIDs)
+		return groupBy(rows, err, func(f *sqlc.MovieFile) int64 { return f.MovieID })
+	})
+	l.episodeFiles = newLoader(func(ctx context.Context, episodeIDs []int64) (map[int64][]*sqlc.EpisodeFile, error) {
+		rows, err := q.ListEpisodeFilesByEpisodeIDs(ctx, episodeIDs)
+		return groupBy(rows, err, func(f *sqlc.EpisodeFile) int64 { return f.EpisodeID })
+	})
+	// There are only a handful of slots, so the first lookup loads them all
+	l.slots = newLoader(func(ctx context.Context, _ []int64) (map[int64]*sqlc.VersionSlot, error) {
+		rows, err := q.ListVersionSlots(ctx)
+		return byID(rows, err, func(s *sqlc.VersionSlot) int64 { return s.ID })
+	})
+	return l
+}
+
+func byID[T any](rows []T, err error, key func(T) int64) (map[int64]T, error) {
+	if err != nil {
+		return nil, err
+	}
+	m := make(map[int64]T, len(rows))
+	for _, row := range rows {
+		m[key(row)] = row
+	}
+	return m, nil
+}
+
+func groupBy[T any](rows []T, err error, key func(T) int64) (map[int64][]T, error) {
+	if err != nil {
+		return nil, err
+	}
+	m := make(map[int64][]T)
+	for _, row := range rows {
+		m[key(row)] = append(m[key(row)], row)
+	}
+	return m, nil
+}
+
+type loadersKey struct{}
+
+func withLoaders(ctx context.Context, l *loaders) context.Context {
+	return context.WithValue(ctx, loadersKey{}, l)
+}
+
+func loadersFrom(ctx context.Context) *loaders {
+	return ctx.Value(loadersKey{}).(*loaders)
+}
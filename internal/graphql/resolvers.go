@@ -0,0 +1,550 @@
+package graphql
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strconv"
+
+	gql "github.com/graph-gophers/graphql-go"
+
+	"github.com/slipstream/slipstream/internal/database/sqlc"
+	"github.com/slipstream/slipstream/internal/downloader"
+	"github.com/slipstream/slipstream/internal/history"
+)
+
+type rootResolver struct {
+	s *Service
+}
+
+func (r *rootResolver) Movies(ctx context.Context, args struct{ Monitored *bool }) ([]*movieResolver, error) {
+	var rows []*sqlc.Movie
+	var err error
+	if args.Monitored != nil && *args.Monitored {
+		rows, err = r.s.queries.ListMonitoredMovies(ctx)
+	} else {
+		rows, err = r.s.queries.ListMovies(ctx)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	l := loadersFrom(ctx)
+	out := make([]*movieResolver, 0, len(rows))
+	for _, m := range rows {
+		if args.Monitored != nil && m.Monitored != *args.Monitored {
+			continue
+		}
+		l.movies.set(m.ID, m)
+		l.movieFiles.prime(m.ID)
+		out = append(out, &movieResolver{m: m})
+	}
+	return out, nil
+}
+
+func (r *rootResolver) Movie(ctx context.Context, args struct{ ID gql.ID }) (*movieResolver, error) {
+	id, err := parseID(args.ID)
+	if err != nil {
+		return nil, err
+	}
+	return movieByID(ctx, id)
+}
+
+func (r *rootResolver) SeriesList(ctx context.Context, args struct{ Monitored *bool }) ([]*seriesResolver, error) {
+	var rows []*sqlc.Series
+	var err error
+	if args.Monitored != nil && *args.Monitored {
+		rows, err = r.s.queries.ListMonitoredSeries(ctx)
+	} else {
+		rows, err = r.s.queries.ListSeries(ctx)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	l := loadersFrom(ctx)
+	out := make([]*seriesResolver, 0, len(rows))
+	for _, s := range rows {
+		if args.Monitored != nil && s.Monitored != *args.Monitored {
+			continue
+		}
+		l.series.set(s.ID, s)
+		l.seasons.prime(s.ID)
+		l.episodes.prime(s.ID)
+		out = append(out, &seriesResolver{s: s})
+	}
+	return out, nil
+}
+
+func (r *rootResolver) Series(ctx context.Context, args struct{ ID gql.ID }) (*seriesResolver, error) {
+	id, err := parseID(args.ID)
+	if err != nil {
+		return nil, err
+	}
+	return seriesByID(ctx, id)
+}
+
+func (r *rootResolver) Slots(ctx context.Context) ([]*slotResolver, error) {
+	rows, err := r.s.queries.ListVersionSlots(ctx)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]*slotResolver, len(rows))
+	for i, slot := range rows {
+		out[i] = &slotResolver{s: slot}
+	}
+	return out, nil
+}
+
+func (r *rootResolver) Queue(ctx context.Context) (*queueResolver, error) {
+	if r.s.queue == nil {
+		return &queueResolver{resp: &downloader.QueueResponse{}}, nil
+	}
+	resp, err := r.s.queue.GetQueue(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	l := loadersFrom(ctx)
+	for i := range resp.Items {
+		if id := resp.Items[i].MovieID; id != nil {
+			l.movies.prime(*id)
+		}
+		if id := resp.Items[i].SeriesID; id != nil {
+			l.series.prime(*id)
+		}
+	}
+	return &queueResolver{resp: resp}, nil
+}
+
+type historyArgs struct {
+	Page      *int32
+	PageSize  *int32
+	EventType *string
+	MediaType *string
+}
+
+func (r *rootResolver) History(ctx context.Context, args historyArgs) (*historyPageResolver, error) {
+	if r.s.history == nil {
+		return &historyPageResolver{resp: &history.ListResponse{}}, nil
+	}
+	opts := &history.ListOptions{}
+	if args.Page != nil {
+		opts.Page = int(*args.Page)
+	}
+	if args.PageSize != nil {
+		opts.PageSize = int(*args.PageSize)
+	}
+	if args.EventType != nil {
+		opts.EventType = *args.EventType
+	}
+	if args.MediaType != nil {
+		opts.MediaType = *args.MediaType
+	}
+	resp, err := r.s.history.List(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	l := loadersFrom(ctx)
+	for _, e := range resp.Items {
+		if e.MediaType == history.MediaTypeMovie {
+			l.movies.prime(e.MediaID)
+		} else if e.SeriesID != nil {
+			l.series.prime(*e.SeriesID)
+		}
+	}
+	return &historyPageResolver{resp: resp}, nil
+}
+
+func movieByID(ctx context.Context, id int64) (*movieResolver, error) {
+	l := loadersFrom(ctx)
+	m, err := l.movies.load(ctx, id)
+	if err != nil || m == nil {
+		return nil, err
+	}
+	l.movieFiles.prime(m.ID)
+	return &movieResolver{m: m}, nil
+}
+
+func seriesByID(ctx context.Context, id int64) (*seriesResolver, error) {
+	l := loadersFrom(ctx)
+	s, err := l.series.load(ctx, id)
+	if err != nil || s == nil {
+		return nil, err
+	}
+	l.seasons.prime(s.ID)
+	l.episodes.prime(s.ID)
+	return &seriesResolver{s: s}, nil
+}
+
+type movieResolver struct {
+	m *sqlc.Movie
+}
+
+func (r *movieResolver) ID() gql.ID                     { return toID(r.m.ID) }
+func (r *movieResolver) Title() string                  { return r.m.Title }
+func (r *movieResolver) SortTitle() string              { return r.m.SortTitle }
+func (r *movieResolver) Year() *int32                   { return nullInt(r.m.Year) }
+func (r *movieResolver) TmdbID() *int32                 { return nullInt(r.m.TmdbID) }
+func (r *movieResolver) ImdbID() *string                { return nullString(r.m.ImdbID) }
+func (r *movieResolver) Overview() *string              { return nullString(r.m.Overview) }
+func (r *movieResolver) Runtime() *int32                { return nullInt(r.m.Runtime) }
+func (r *movieResolver) Path() *string                  { return nullString(r.m.Path) }
+func (r *movieResolver) RootFolderID() *gql.ID          { return nullID(r.m.RootFolderID) }
+func (r *movieResolver) QualityProfileID() *gql.ID      { return nullID(r.m.QualityProfileID) }
+func (r *movieResolver) Monitored() bool                { return r.m.Monitored }
+func (r *movieResolver) Status() string                 { return r.m.Status }
+func (r *movieResolver) Studio() *string                { return nullString(r.m.Studio) }
+func (r *movieResolver) ReleaseDate() *gql.Time         { return nullTime(r.m.ReleaseDate) }
+func (r *movieResolver) PhysicalReleaseDate() *gql.Time { return nullTime(r.m.PhysicalReleaseDate) }
+func (r *movieResolver) AddedAt() *gql.Time             { return nullTime(r.m.AddedAt) }
+
+func (r *movieResolver) Files(ctx context.Context) ([]*fileResolver, error) {
+	files, err := loadersFrom(ctx).movieFiles.load(ctx, r.m.ID)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]*fileResolver, len(files))
+	for i, f := range files {
+		out[i] = &fileResolver{
+			id: f.ID, path: f.Path, size: f.Size, quality: f.Quality,
+			videoCodec: f.VideoCodec, audioCodec: f.AudioCodec, audioChannels: f.AudioChannels,
+			resolution: f.Resolution, dynamicRange: f.DynamicRange, importedAt: f.ImportedAt, slotID: f.SlotID,
+		}
+	}
+	return out, nil
+}
+
+type seriesResolver struct {
+	s *sqlc.Series
+}
+
+func (r *seriesResolver) ID() gql.ID                { return toID(r.s.ID) }
+func (r *seriesResolver) Title() string             { return r.s.Title }
+func (r *seriesResolver) SortTitle() string         { return r.s.SortTitle }
+func (r *seriesResolver) Year() *int32              { return nullInt(r.s.Year) }
+func (r *seriesResolver) TvdbID() *int32            { return nullInt(r.s.TvdbID) }
+func (r *seriesResolver) TmdbID() *int32            { return nullInt(r.s.TmdbID) }
+func (r *seriesResolver) ImdbID() *string           { return nullString(r.s.ImdbID) }
+func (r *seriesResolver) Overview() *string         { return nullString(r.s.Overview) }
+func (r *seriesResolver) Runtime() *int32           { return nullInt(r.s.Runtime) }
+func (r *seriesResolver) Path() *string             { return nullString(r.s.Path) }
+func (r *seriesResolver) RootFolderID() *gql.ID     { return nullID(r.s.RootFolderID) }
+func (r *seriesResolver) QualityProfileID() *gql.ID { return nullID(r.s.QualityProfileID) }
+func (r *seriesResolver) Monitored() bool           { return r.s.Monitored }
+func (r *seriesResolver) ProductionStatus() string  { return r.s.ProductionStatus }
+func (r *seriesResolver) Network() *string          { return nullString(r.s.Network) }
+func (r *seriesResolver) AddedAt() *gql.Time        { return nullTime(r.s.AddedAt) }
+
+func (r *seriesResolver) Seasons(ctx context.Context) ([]*seasonResolver, error) {
+	seasons, err := loadersFrom(ctx).seasons.load(ctx, r.s.ID)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]*seasonResolver, len(seasons))
+	for i, s := range seasons {
+		out[i] = &seasonResolver{s: s}
+	}
+	return out, nil
+}
+
+func (r *seriesResolver) Episodes(ctx context.Context) ([]*episodeResolver, error) {
+	return episodesOf(ctx, r.s.ID, nil)
+}
+
+// episodesOf lists a series' episodes, optionally limited to one season.
+func episodesOf(ctx context.Context, seriesID int64, season *int64) ([]*episodeResolver, error) {
+	episodes, err := loadersFrom(ctx).episodes.load(ctx, seriesID)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]*episodeResolver, 0, len(episodes))
+	for _, e := range episodes {
+		if season == nil || e.SeasonNumber == *season {
+			out = append(out, &episodeResolver{e: e})
+		}
+	}
+	return out, nil
+}
+
+type seasonResolver struct {
+	s *sqlc.Season
+}
+
+func (r *seasonResolver) ID() gql.ID          { return toID(r.s.ID) }
+func (r *seasonResolver) SeasonNumber() int32 { return int32(r.s.SeasonNumber) }
+func (r *seasonResolver) Monitored() bool     { return r.s.Monitored }
+func (r *seasonResolver) Overview() *string   { return nullString(r.s.Overview) }
+func (r *seasonResolver) PosterURL() *string  { return nullString(r.s.PosterUrl) }
+
+func (r *seasonResolver) Series(ctx context.Context) (*seriesResolver, error) {
+	return requiredSeries(ctx, r.s.SeriesID)
+}
+
+func (r *seasonResolver) Episodes(ctx context.Context) ([]*episodeResolver, error) {
+	return episodesOf(ctx, r.s.SeriesID, &r.s.SeasonNumber)
+}
+
+type episodeResolver struct {
+	e *sqlc.Episode
+}
+
+func (r *episodeResolver) ID() gql.ID           { return toID(r.e.ID) }
+func (r *episodeResolver) SeasonNumber() int32  { return int32(r.e.SeasonNumber) }
+func (r *episodeResolver) EpisodeNumber() int32 { return int32(r.e.EpisodeNumber) }
+func (r *episodeResolver) Title() *string       { return nullString(r.e.Title) }
+func (r *episodeResolver) Overview() *string    { return nullString(r.e.Overview) }
+func (r *episodeResolver) AirDate() *gql.Time   { return nullTime(r.e.AirDate) }
+func (r *episodeResolver) Monitored() bool      { return r.e.Monitored }
+func (r *episodeResolver) Status() string       { return r.e.Status }
+
+func (r *episodeResolver) Series(ctx context.Context) (*seriesResolver, error) {
+	return requiredSeries(ctx, r.e.SeriesID)
+}
+
+func (r *episodeResolver) Files(ctx context.Context) ([]*fileResolver, error) {
+	files, err := loadersFrom(ctx).episodeFiles.load(ctx, r.e.ID)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]*fileResolver, len(files))
+	for i, f := range files {
+		out[i] = &fileResolver{
+			id: f.ID, path: f.Path, size: f.Size, quality: f.Quality,
+			videoCodec: f.VideoCodec, audioCodec: f.AudioCodec, audioChannels: f.AudioChannels,
+			resolution: f.Resolution, dynamicRange: f.DynamicRange, importedAt: f.ImportedAt, slotID: f.SlotID,
+		}
+	}
+	return out, nil
+}
+
+// requiredSeries resolves a non-null series back-reference.
+func requiredSeries(ctx context.Context, id int64) (*seriesResolver, error) {
+	s, err := seriesByID(ctx, id)
+	if err == nil && s == nil {
+		err = errors.New("series " + strconv.FormatInt(id, 10) + " not found")
+	}
+	return s, err
+}
+
+// fileResolver serves movie and episode files, which share their columns.
+type fileResolver struct {
+	id            int64
+	path          string
+	size          int64
+	quality       sql.NullString
+	videoCodec    sql.NullString
+	audioCodec    sql.NullString
+	audioChannels sql.NullString
+	resolution    sql.NullString
+	dynamicRange  sql.NullString
+	importedAt    sql.NullTime
+	slotID        sql.NullInt64
+}
+
+func (r *fileResolver) ID() gql.ID             { return toID(r.id) }
+func (r *fileResolver) Path() string           { return r.path }
+func (r *fileResolver) Size() float64          { return float64(r.size) }
+func (r *fileResolver) Quality() *string       { return nullString(r.quality) }
+func (r *fileResolver) VideoCodec() *string    { return nullString(r.videoCodec) }
+func (r *fileResolver) AudioCodec() *string    { return nullString(r.audioCodec) }
+func (r *fileResolver) AudioChannels() *string { return nullString(r.audioChannels) }
+func (r *fileResolver) Resolution() *string    { return nullString(r.resolution) }
+func (r *fileResolver) DynamicRange() *string  { return nullString(r.dynamicRange) }
+func (r *fileResolver) ImportedAt() *gql.Time  { return nullTime(r.importedAt) }
+
+func (r *fileResolver) Slot(ctx context.Context) (*slotResolver, error) {
+	if !r.slotID.Valid {
+		return nil, nil
+	}
+	return slotByID(ctx, r.slotID.Int64)
+}
+
+func slotByID(ctx context.Context, id int64) (*slotResolver, error) {
+	slot, err := loadersFrom(ctx).slots.load(ctx, id)
+	if err != nil || slot == nil {
+		return nil, err
+	}
+	return &slotResolver{s: slot}, nil
+}
+
+type slotResolver struct {
+	s *sqlc.VersionSlot
+}
+
+func (r *slotResolver) ID() gql.ID        { return toID(r.s.ID) }
+func (r *slotResolver) SlotNumber() int32 { return int32(r.s.SlotNumber) }
+func (r *slotResolver) Name() string      { return r.s.Name }
+func (r *slotResolver) Enabled() bool     { return r.s.Enabled }
+
+type queueResolver struct {
+	resp *downloader.QueueResponse
+}
+
+func (r *queueResolver) Items() []*queueItemResolver {
+	out := make([]*queueItemResolver, len(r.resp.Items))
+	for i := range r.resp.Items {
+		out[i] = &queueItemResolver{item: &r.resp.Items[i]}
+	}
+	return out
+}
+
+func (r *queueResolver) Errors() []*queueErrorResolver {
+	out := make([]*queueErrorResolver, len(r.resp.Errors))
+	for i := range r.resp.Errors {
+		out[i] = &queueErrorResolver{e: &r.resp.Errors[i]}
+	}
+	return out
+}
+
+type queueErrorResolver struct {
+	e *downloader.ClientError
+}
+
+func (r *queueErrorResolver) ClientName() string { return r.e.ClientName }
+func (r *queueErrorResolver) Message() string    { return r.e.Message }
+
+type queueItemResolver struct {
+	item *downloader.QueueItem
+}
+
+func (r *queueItemResolver) ID() gql.ID              { return gql.ID(r.item.ID) }
+func (r *queueItemResolver) ClientName() string      { return r.item.ClientName }
+func (r *queueItemResolver) Title() string           { return r.item.Title }
+func (r *queueItemResolver) ReleaseName() string     { return r.item.ReleaseName }
+func (r *queueItemResolver) MediaType() string       { return r.item.MediaType }
+func (r *queueItemResolver) Status() string          { return r.item.Status }
+func (r *queueItemResolver) Progress() float64       { return r.item.Progress }
+func (r *queueItemResolver) Size() float64           { return float64(r.item.Size) }
+func (r *queueItemResolver) DownloadedSize() float64 { return float64(r.item.DownloadedSize) }
+func (r *queueItemResolver) Eta() float64            { return float64(r.item.ETA) }
+func (r *queueItemResolver) IsSeasonPack() bool      { return r.item.IsSeasonPack }
+
+func (r *queueItemResolver) Quality() *string {
+	if r.item.Quality == "" {
+		return nil
+	}
+	return &r.item.Quality
+}
+
+func (r *queueItemResolver) SeasonNumber() *int32 {
+	if r.item.SeasonNumber == nil {
+		return nil
+	}
+	n := int32(*r.item.SeasonNumber)
+	return &n
+}
+
+func (r *queueItemResolver) Movie(ctx context.Context) (*movieResolver, error) {
+	if r.item.MovieID == nil {
+		return nil, nil
+	}
+	return movieByID(ctx, *r.item.MovieID)
+}
+
+func (r *queueItemResolver) Series(ctx context.Context) (*seriesResolver, error) {
+	if r.item.SeriesID == nil {
+		return nil, nil
+	}
+	return seriesByID(ctx, *r.item.SeriesID)
+}
+
+func (r *queueItemResolver) TargetSlot(ctx context.Context) (*slotResolver, error) {
+	if r.item.TargetSlotID == nil {
+		return nil, nil
+	}
+	return slotByID(ctx, *r.item.TargetSlotID)
+}
+
+type historyPageResolver struct {
+	resp *history.ListResponse
+}
+
+func (r *historyPageResolver) Items() []*historyEntryResolver {
+	out := make([]*historyEntryResolver, len(r.resp.Items))
+	for i, e := range r.resp.Items {
+		out[i] = &historyEntryResolver{e: e}
+	}
+	return out
+}
+
+func (r *historyPageResolver) Page() int32       { return int32(r.resp.Page) }
+func (r *historyPageResolver) PageSize() int32   { return int32(r.resp.PageSize) }
+func (r *historyPageResolver) TotalCount() int32 { return int32(r.resp.TotalCount) }
+func (r *historyPageResolver) TotalPages() int32 { return int32(r.resp.TotalPages) }
+
+type historyEntryResolver struct {
+	e *history.Entry
+}
+
+func (r *historyEntryResolver) ID() gql.ID          { return toID(r.e.ID) }
+func (r *historyEntryResolver) EventType() string   { return string(r.e.EventType) }
+func (r *historyEntryResolver) MediaType() string   { return string(r.e.MediaType) }
+func (r *historyEntryResolver) Source() *string     { return optionalString(r.e.Source) }
+func (r *historyEntryResolver) Quality() *string    { return optionalString(r.e.Quality) }
+func (r *historyEntryResolver) CreatedAt() string   { return r.e.CreatedAt }
+func (r *historyEntryResolver) MediaTitle() *string { return optionalString(r.e.MediaTitle) }
+
+func (r *historyEntryResolver) Movie(ctx context.Context) (*movieResolver, error) {
+	if r.e.MediaType != history.MediaTypeMovie {
+		return nil, nil
+	}
+	return movieByID(ctx, r.e.MediaID)
+}
+
+func (r *historyEntryResolver) Series(ctx context.Context) (*seriesResolver, error) {
+	if r.e.SeriesID == nil {
+		return nil, nil
+	}
+	return seriesByID(ctx, *r.e.SeriesID)
+}
+
+func parseID(id gql.ID) (int64, error) {
+	n, err := strconv.ParseInt(string(id), 10, 64)
+	if err != nil {
+		return 0, errors.New("invalid id " + strconv.Quote(string(id)))
+	}
+	return n, nil
+}
+
+func toID(id int64) gql.ID {
+	return gql.ID(strconv.FormatInt(id, 10))
+}
+
+func nullID(v sql.NullInt64) *gql.ID {
+	if !v.Valid {
+		return nil
+	}
+	id := toID(v.Int64)
+	return &id
+}
+
+func nullInt(v sql.NullInt64) *int32 {
+	if !v.Valid {
+		return nil
+	}
+	n := int32(v.Int64)
+	return &n
+}
+
+func nullString(v sql.NullString) *string {
+	if !v.Valid {
+		return nil
+	}
+	return &v.String
+}
+
+func optionalString(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
+
+func nullTime(v sql.NullTime) *gql.Time {
+	if !v.Valid {
+		return nil
+	}
+	return &gql.Time{Time: v.Time}
+}
@@ -0,0 +1,108 @@
+// Package graphql serves a read-only GraphQL view of the library, download
+// queue and history. Nested fields are resolved through per-request loaders
+// so a dashboard query over series, seasons, episodes and files costs one
+// query per level rather than one per parent.
+package graphql
+
+import (
+	"context"
+	"database/sql"
+	_ "embed"
+	"fmt"
+
+	gql "github.com/graph-gophers/graphql-go"
+	"github.com/rs/zerolog"
+
+	"github.com/slipstream/slipstream/internal/database/sqlc"
+	"github.com/slipstream/slipstream/internal/downloader"
+	"github.com/slipstream/slipstream/internal/history"
+)
+
+//go:embed schema.graphql
+var schemaSDL string
+
+const (
+	maxDepth       = 12
+	maxQueryLength = 16 << 10
+)
+
+// QueueSource lists download client queue items.
+type QueueSource interface {
+	GetQueue(ctx context.Context) (*downloader.QueueResponse, error)
+}
+
+// HistorySource lists history entries.
+type HistorySource interface {
+	List(ctx context.Context, opts *history.ListOptions) (*history.ListResponse, error)
+}
+
+// Request is a GraphQL request as sent over HTTP.
+type Request struct {
+	Query         string         `json:"query"`
+	OperationName string         `json:"operationName,omitempty"`
+	Variables     map[string]any `json:"variables,omitempty"`
+}
+
+// Service executes GraphQL queries.
+type Service struct {
+	queries *sqlc.Queries
+	queue   QueueSource
+	history HistorySource
+	schema  *gql.Schema
+	logger  *zerolog.Logger
+}
+
+// NewService creates a new GraphQL service.
+func NewService(db *sql.DB, queue QueueSource, historySource HistorySource, logger *zerolog.Logger) *Service {
+	subLogger := logger.With().Str("component", "graphql").Logger()
+	s := &Service{
+		queries: sqlc.New(db),
+		queue:   queue,
+		history: historySource,
+		logger:  &subLogger,
+	}
+	s.schema = gql.MustParseSchema(schemaSDL, &rootResolver{s: s},
+		gql.MaxDepth(maxDepth),
+		gql.MaxQueryLength(maxQueryLength),
+		gql.Logger(panicLogger{logger: s.logger}),
+	)
+	return s
+}
+
+// SetDB updates the database connection used by this service.
+func (s *Service) SetDB(db *sql.DB) {
+	s.queries = sqlc.New(db)
+}
+
+// Execute runs a query with a fresh set of loaders. Resolver failures are
+// reported in the response's errors, as GraphQL clients expect.
+func (s *Service) Execute(ctx context.Context, req *Request) *gql.Response {
+	return s.execute(ctx, req, newLoaders(s.queries))
+}
+
+func (s *Service) execute(ctx context.Context, req *Request, l *loaders) *gql.Response {
+	resp := s.schema.Exec(withLoaders(ctx, l), req.Query, req.OperationName, req.Variables)
+	for _, err := range resp.Errors {
+		if err.ResolverError != nil {
+			s.logger.Warn().Err(err.ResolverError).Strs("path", pathStrings(err.Path)).Msg("GraphQL resolver failed")
+		}
+	}
+	return resp
+}
+
+func pathStrings(path []any) []string {
+	out := make([]string, len(path))
+	for i, p := range path {
+		out[i] = fmt.Sprint(p)
+	}
+	return out
+}
+
+// panicLogger reports resolver panics through zerolog.
+type panicLogger struct {
+	logger *zerolog.Logger
+}
+
+func (p panicLogger) LogPanic(_ context.Context, value any) {
+	p.logger.Error().Interface("panic", value).Msg("GraphQL resolver panicked")
+}
@@ -0,0 +1,222 @@
+package graphql
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/slipstream/slipstream/internal/database/sqlc"
+	"github.com/slipstream/slipstream/internal/downloader"
+	"github.com/slipstream/slipstream/internal/history"
+	"github.com/slipstream/slipstream/internal/testutil"
+)
+
+type fakeQueue struct {
+	resp *downloader.QueueResponse
+}
+
+func (f *fakeQueue) GetQueue(context.Context) (*downloader.QueueResponse, error) {
+	return f.resp, nil
+}
+
+type fakeHistory struct {
+	resp *history.ListResponse
+}
+
+func (f *fakeHistory) List(context.Context, *history.ListOptions) (*history.ListResponse, error) {
+	return f.resp, nil
+}
+
+func newTestService(t *testing.T, queue QueueSource, hist HistorySource) (*Service, *sqlc.Queries) {
+	t.Helper()
+	tdb := testutil.NewTestDB(t)
+	t.Cleanup(tdb.Close)
+	return NewService(tdb.Conn, queue, hist, &tdb.Logger), sqlc.New(tdb.Conn)
+}
+
+// seedSeries creates series with two seasons of two episodes, each with a file.
+func seedSeries(t *testing.T, q *sqlc.Queries, count int) []int64 {
+	t.Helper()
+	ctx := context.Background()
+	var ids []int64
+	for i := 1; i <= count; i++ {
+		series, err := q.CreateSeries(ctx, sqlc.CreateSeriesParams{
+			Title:            fmt.Sprintf("Series %d", i),
+			SortTitle:        fmt.Sprintf("series %d", i),
+			TvdbID:           sql.NullInt64{Int64: int64(1000 + i), Valid: true},
+			Monitored:        true,
+			ProductionStatus: "continuing",
+		})
+		if err != nil {
+			t.Fatalf("CreateSeries() error = %v", err)
+		}
+		ids = append(ids, series.ID)
+		for season := int64(1); season <= 2; season++ {
+			if _, err := q.CreateSeason(ctx, sqlc.CreateSeasonParams{SeriesID: series.ID, SeasonNumber: season, Monitored: true}); err != nil {
+				t.Fatalf("CreateSeason() error = %v", err)
+			}
+			for number := int64(1); number <= 2; number++ {
+				ep, err := q.CreateEpisode(ctx, sqlc.CreateEpisodeParams{
+					SeriesID: series.ID, SeasonNumber: season, EpisodeNumber: number,
+					Title: sql.NullString{String: "Episode", Valid: true}, Monitored: true, Status: "available",
+				})
+				if err != nil {
+					t.Fatalf("CreateEpisode() error = %v", err)
+				}
+				if _, err := q.CreateEpisodeFile(ctx, sqlc.CreateEpisodeFileParams{
+					EpisodeID: ep.ID,
+					Path:      fmt.Sprintf("/tv/series-%d/s%02de%02d.mkv", i, season, number),
+					Size:      3 << 30,
+					Quality:   sql.NullString{String: "WEBDL-1080p", Valid: true},
+				}); err != nil {
+					t.Fatalf("CreateEpisodeFile() error = %v", err)
+				}
+			}
+		}
+	}
+	return ids
+}
+
+func execute(t *testing.T, s *Service, l *loaders, query string) map[string]any {
+	t.Helper()
+	resp := s.execute(context.Background(), &Request{Query: query}, l)
+	if len(resp.Errors) > 0 {
+		t.Fatalf("query errors: %v", resp.Errors)
+	}
+	var data map[string]any
+	if err := json.Unmarshal(resp.Data, &data); err != nil {
+		t.Fatalf("failed to decode data: %v", err)
+	}
+	return data
+}
+
+func TestSeriesTreeIsBatched(t *testing.T) {
+	s, q := newTestService(t, nil, nil)
+	seedSeries(t, q, 3)
+
+	l := newLoaders(s.queries)
+	data := execute(t, s, l, `{
+		seriesList {
+			title
+			seasons {
+				seasonNumber
+				series { title }
+				episodes { episodeNumber files { path size quality slot { name } } }
+			}
+		}
+	}`)
+
+	seriesList := data["seriesList"].([]any)
+	if len(seriesList) != 3 {
+		t.Fatalf("len(seriesList) = %d, want 3", len(seriesList))
+	}
+	seasons := seriesList[0].(map[string]any)["seasons"].([]any)
+	if len(seasons) != 2 {
+		t.Fatalf("len(seasons) = %d, want 2", len(seasons))
+	}
+	season := seasons[1].(map[string]any)
+	if got := season["series"].(map[string]any)["title"]; got != "Series 1" {
+		t.Errorf("season series title = %v, want Series 1", got)
+	}
+	episodes := season["episodes"].([]any)
+	if len(episodes) != 2 {
+		t.Fatalf("len(episodes) = %d, want 2", len(episodes))
+	}
+	files := episodes[0].(map[string]any)["files"].([]any)
+	if len(files) != 1 || files[0].(map[string]any)["path"] != "/tv/series-1/s02e01.mkv" {
+		t.Errorf("files = %v, want the s02e01 file", files)
+	}
+
+	for name, batches := range map[string]int{
+		"series":       l.series.batches,
+		"seasons":      l.seasons.batches,
+		"episodes":     l.episodes.batches,
+		"episodeFiles": l.episodeFiles.batches,
+	} {
+		want := 1
+		if name == "series" {
+			// Seeded by the list query itself
+			want = 0
+		}
+		if batches != want {
+			t.Errorf("%s loader ran %d batches, want %d", name, batches, want)
+		}
+	}
+}
+
+func TestMovieLookup(t *testing.T) {
+	s, q := newTestService(t, nil, nil)
+	ctx := context.Background()
+	movie, err := q.CreateMovie(ctx, sqlc.CreateMovieParams{
+		Title: "Heat", SortTitle: "heat", Year: sql.NullInt64{Int64: 1995, Valid: true},
+		Monitored: true, Status: "available",
+	})
+	if err != nil {
+		t.Fatalf("CreateMovie() error = %v", err)
+	}
+	if _, err := q.CreateMovieFile(ctx, sqlc.CreateMovieFileParams{MovieID: movie.ID, Path: "/movies/Heat.mkv", Size: 10}); err != nil {
+		t.Fatalf("CreateMovieFile() error = %v", err)
+	}
+
+	data := execute(t, s, newLoaders(s.queries), fmt.Sprintf(`{
+		movie(id: "%d") { title year files { path } }
+		missing: movie(id: "9999") { title }
+	}`, movie.ID))
+
+	got := data["movie"].(map[string]any)
+	if got["title"] != "Heat" || got["year"] != float64(1995) {
+		t.Errorf("movie = %v, want Heat (1995)", got)
+	}
+	if files := got["files"].([]any); len(files) != 1 {
+		t.Errorf("len(files) = %d, want 1", len(files))
+	}
+	if data["missing"] != nil {
+		t.Errorf("missing = %v, want null", data["missing"])
+	}
+}
+
+func TestQueueAndHistoryResolveMedia(t *testing.T) {
+	seriesID := int64(1)
+	queue := &fakeQueue{resp: &downloader.QueueResponse{
+		Items: []downloader.QueueItem{
+			{ID: "abc", ClientName: "qBittorrent", Title: "Series 1 S01", SeriesID: &seriesID, Progress: 50},
+		},
+	}}
+	hist := &fakeHistory{resp: &history.ListResponse{
+		Items: []*history.Entry{
+			{ID: 7, EventType: history.EventTypeImported, MediaType: history.MediaTypeEpisode, SeriesID: &seriesID},
+		},
+		Page: 1, PageSize: 50, TotalCount: 1, TotalPages: 1,
+	}}
+	s, q := newTestService(t, queue, hist)
+	seedSeries(t, q, 1)
+
+	l := newLoaders(s.queries)
+	data := execute(t, s, l, `{
+		queue { items { id progress series { title } movie { title } } errors { message } }
+		history { totalCount items { eventType series { title } } }
+	}`)
+
+	item := data["queue"].(map[string]any)["items"].([]any)[0].(map[string]any)
+	if item["series"].(map[string]any)["title"] != "Series 1" || item["movie"] != nil {
+		t.Errorf("queue item = %v, want Series 1 and no movie", item)
+	}
+	entry := data["history"].(map[string]any)["items"].([]any)[0].(map[string]any)
+	if entry["series"].(map[string]any)["title"] != "Series 1" {
+		t.Errorf("history entry = %v, want Series 1", entry)
+	}
+	if l.series.batches != 1 {
+		t.Errorf("series loader ran %d batches, want 1", l.series.batches)
+	}
+}
+
+func TestMutationsAreRejected(t *testing.T) {
+	s, _ := newTestService(t, nil, nil)
+
+	resp := s.Execute(context.Background(), &Request{Query: `mutation { deleteMovie(id: "1") }`})
+	if len(resp.Errors) == 0 {
+		t.Fatal("mutation succeeded, want an error")
+	}
+}
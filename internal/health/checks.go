@@ -0,0 +1,85 @@
+package health
+
+import (
+	"context"
+)
+
+// Check is a periodic health check. Each run reports the problems it
+// currently sees; items a check reported before and no longer reports are
+// resolved and removed from tracking.
+type Check interface {
+	Name() string
+	Category() HealthCategory
+	Run(ctx context.Context) ([]Finding, error)
+}
+
+// Finding is a single problem reported by a Check.
+type Finding struct {
+	ID      string
+	Name    string
+	Status  HealthStatus // StatusWarning or StatusError
+	Message string
+}
+
+// RegisterCheck adds a check to the set run by RunChecks.
+func (s *Service) RegisterCheck(check Check) {
+	s.checksMu.Lock()
+	defer s.checksMu.Unlock()
+
+	s.checks = append(s.checks, check)
+	if s.checkItems == nil {
+		s.checkItems = make(map[string]map[string]bool)
+	}
+	s.checkItems[check.Name()] = make(map[string]bool)
+}
+
+// RunChecks runs every registered check and reconciles its findings with the
+// tracked items. A failing check leaves its previous findings untouched.
+func (s *Service) RunChecks(ctx context.Context) {
+	s.checksMu.Lock()
+	defer s.checksMu.Unlock()
+
+	for _, check := range s.checks {
+		if ctx.Err() != nil {
+			return
+		}
+
+		findings, err := check.Run(ctx)
+		if err != nil {
+			s.logger.Warn().Err(err).Str("check", check.Name()).Msg("Health check failed")
+			continue
+		}
+
+		s.applyFindings(check, findings)
+	}
+}
+
+// applyFindings registers newly reported items, updates existing ones and
+// resolves items the check no longer reports.
+func (s *Service) applyFindings(check Check, findings []Finding) {
+	category := check.Category()
+	previous := s.checkItems[check.Name()]
+	current := make(map[string]bool, len(findings))
+
+	for _, f := range findings {
+		current[f.ID] = true
+		if s.GetItem(category, f.ID) == nil {
+			s.RegisterItem(category, f.ID, f.Name)
+		}
+		if f.Status == StatusError {
+			s.SetError(category, f.ID, f.Message)
+		} else {
+			s.SetWarning(category, f.ID, f.Message)
+		}
+	}
+
+	for id := range previous {
+		if current[id] {
+			continue
+		}
+		s.ClearStatus(category, id)
+		s.UnregisterItem(category, id)
+	}
+
+	s.checkItems[check.Name()] = current
+}
@@ -0,0 +1,152 @@
+package health
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/slipstream/slipstream/internal/testutil"
+)
+
+type fakeCheck struct {
+	findings []Finding
+}
+
+func (c *fakeCheck) Name() string                             { return "fake" }
+func (c *fakeCheck) Category() HealthCategory                 { return CategoryQueue }
+func (c *fakeCheck) Run(_ context.Context) ([]Finding, error) { return c.findings, nil }
+
+func newTestService(t *testing.T) *Service {
+	t.Helper()
+	tdb := testutil.NewTestDB(t)
+	t.Cleanup(tdb.Close)
+	return NewService(tdb.Conn, &tdb.Logger, nil)
+}
+
+func TestRunChecks_ReconcilesFindings(t *testing.T) {
+	svc := newTestService(t)
+	check := &fakeCheck{findings: []Finding{{ID: "a", Name: "A", Status: StatusWarning, Message: "stuck"}}}
+	svc.RegisterCheck(check)
+
+	svc.RunChecks(context.Background())
+	item := svc.GetItem(CategoryQueue, "a")
+	if item == nil || item.Status != StatusWarning || item.Name != "A" {
+		t.Fatalf("expected warning item A, got %+v", item)
+	}
+
+	check.findings = nil
+	svc.RunChecks(context.Background())
+	if item := svc.GetItem(CategoryQueue, "a"); item != nil {
+		t.Fatalf("expected resolved finding to be removed, got %+v", item)
+	}
+}
+
+func TestIssues_DismissAndMute(t *testing.T) {
+	svc := newTestService(t)
+	svc.RegisterItem(CategoryIndexers, "1", "Indexer")
+	svc.SetError(CategoryIndexers, "1", "timeout")
+
+	if got := svc.ListIssues(false); len(got) != 1 || got[0].Action == "" {
+		t.Fatalf("expected one issue with an action, got %+v", got)
+	}
+	if err := svc.DismissIssue(CategoryIndexers, "1"); err != nil {
+		t.Fatalf("DismissIssue: %v", err)
+	}
+	if got := svc.ListIssues(false); len(got) != 0 {
+		t.Fatalf("dismissed issue should be hidden, got %+v", got)
+	}
+	if svc.GetSummary().HasIssues {
+		t.Error("summary should not flag dismissed issues")
+	}
+
+	// A different failure brings the dismissed issue back
+	svc.SetError(CategoryIndexers, "1", "unauthorized")
+	if got := svc.ListIssues(false); len(got) != 1 {
+		t.Fatalf("changed issue should reappear, got %+v", got)
+	}
+
+	if _, err := svc.MuteIssue(CategoryIndexers, "1", time.Hour); err != nil {
+		t.Fatalf("MuteIssue: %v", err)
+	}
+	svc.SetError(CategoryIndexers, "1", "rate limited")
+	if got := svc.ListIssues(false); len(got) != 0 {
+		t.Fatalf("muted issue should be hidden, got %+v", got)
+	}
+	if got := svc.ListIssues(true); len(got) != 1 || got[0].MutedUntil == nil {
+		t.Fatalf("expected hidden muted issue when requested, got %+v", got)
+	}
+
+	if err := svc.DismissIssue(CategoryIndexers, "missing"); err != ErrIssueNotFound {
+		t.Errorf("expected ErrIssueNotFound, got %v", err)
+	}
+}
+
+func TestIssues_PersistAcrossRestart(t *testing.T) {
+	tdb := testutil.NewTestDB(t)
+	defer tdb.Close()
+
+	svc := NewService(tdb.Conn, &tdb.Logger, nil)
+	svc.RegisterItem(CategoryIndexers, "1", "Indexer")
+	svc.SetError(CategoryIndexers, "1", "timeout")
+	svc.RegisterItem(CategoryRootFolders, "2", "Movies")
+	svc.SetError(CategoryRootFolders, "2", "not writable")
+	if err := svc.DismissIssue(CategoryIndexers, "1"); err != nil {
+		t.Fatalf("DismissIssue: %v", err)
+	}
+	if _, err := svc.MuteIssue(CategoryRootFolders, "2", time.Hour); err != nil {
+		t.Fatalf("MuteIssue: %v", err)
+	}
+
+	restarted := NewService(tdb.Conn, &tdb.Logger, nil)
+	restarted.RegisterItem(CategoryIndexers, "1", "Indexer")
+	restarted.SetError(CategoryIndexers, "1", "timeout")
+	restarted.RegisterItem(CategoryRootFolders, "2", "Movies")
+	restarted.SetError(CategoryRootFolders, "2", "not writable")
+	if got := restarted.ListIssues(false); len(got) != 0 {
+		t.Fatalf("dismissed and muted issues should stay hidden after restart, got %+v", got)
+	}
+	if !restarted.IsMuted(CategoryRootFolders, "2") {
+		t.Error("mute should survive restart")
+	}
+
+	if err := restarted.UnmuteIssue(CategoryRootFolders, "2"); err != nil {
+		t.Fatalf("UnmuteIssue: %v", err)
+	}
+	if again := NewService(tdb.Conn, &tdb.Logger, nil); again.IsMuted(CategoryRootFolders, "2") {
+		t.Error("unmute should be persisted")
+	}
+}
+
+type fakeQueue struct {
+	items []QueueSnapshotItem
+}
+
+func (q *fakeQueue) QueueSnapshot(_ context.Context) ([]QueueSnapshotItem, error) {
+	return q.items, nil
+}
+
+func TestStalledQueueCheck(t *testing.T) {
+	queue := &fakeQueue{items: []QueueSnapshotItem{
+		{ID: "1-abc", Name: "Stuck", Status: "downloading", Downloaded: 100},
+		{ID: "1-def", Name: "Paused", Status: "paused", Downloaded: 0},
+	}}
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	check := NewStalledQueueCheck(queue, time.Hour)
+	check.now = func() time.Time { return now }
+
+	if findings, _ := check.Run(context.Background()); len(findings) != 0 {
+		t.Fatalf("first sighting should not be stalled, got %+v", findings)
+	}
+
+	now = now.Add(2 * time.Hour)
+	findings, _ := check.Run(context.Background())
+	if len(findings) != 1 || findings[0].ID != "1-abc" {
+		t.Fatalf("expected only the downloading item to stall, got %+v", findings)
+	}
+
+	queue.items[0].Downloaded = 200
+	now = now.Add(30 * time.Minute)
+	if findings, _ := check.Run(context.Background()); len(findings) != 0 {
+		t.Fatalf("progress should reset the stall timer, got %+v", findings)
+	}
+}
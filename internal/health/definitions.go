@@ -0,0 +1,50 @@
+package health
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// DefinitionUpdateSource reports whether indexer definitions are overdue for
+// an update. Implemented by the Cardigann manager.
+type DefinitionUpdateSource interface {
+	DefinitionsOverdue() (lastUpdate time.Time, overdue bool)
+}
+
+// DefinitionsCheck warns when automatic Cardigann definition updates have
+// stopped succeeding.
+type DefinitionsCheck struct {
+	source DefinitionUpdateSource
+}
+
+// NewDefinitionsCheck creates a new definitions check.
+func NewDefinitionsCheck(source DefinitionUpdateSource) *DefinitionsCheck {
+	return &DefinitionsCheck{source: source}
+}
+
+// Name implements Check.
+func (c *DefinitionsCheck) Name() string { return "cardigann-definitions" }
+
+// Category implements Check.
+func (c *DefinitionsCheck) Category() HealthCategory { return CategoryDefinitions }
+
+// Run implements Check.
+func (c *DefinitionsCheck) Run(_ context.Context) ([]Finding, error) {
+	lastUpdate, overdue := c.source.DefinitionsOverdue()
+	if !overdue {
+		return nil, nil
+	}
+
+	message := "Indexer definitions have never been updated"
+	if !lastUpdate.IsZero() {
+		message = fmt.Sprintf("Indexer definitions are out of date; last updated %s",
+			lastUpdate.Format("2006-01-02 15:04"))
+	}
+	return []Finding{{
+		ID:      "cardigann",
+		Name:    "Cardigann definitions",
+		Status:  StatusWarning,
+		Message: message,
+	}}, nil
+}
@@ -2,7 +2,9 @@ package health
 
 import (
 	"context"
+	"errors"
 	"net/http"
+	"time"
 
 	"github.com/labstack/echo/v4"
 )
@@ -65,6 +67,11 @@ func NewHandlers(health *Service, testFuncs *TestFunctions) *Handlers {
 func (h *Handlers) RegisterRoutes(g *echo.Group) {
 	g.GET("", h.GetAll)
 	g.GET("/summary", h.GetSummary)
+	g.GET("/issues", h.ListIssues)
+	g.POST("/issues/:category/:id/dismiss", h.DismissIssue)
+	g.DELETE("/issues/:category/:id/dismiss", h.RestoreIssue)
+	g.POST("/issues/:category/:id/mute", h.MuteIssue)
+	g.DELETE("/issues/:category/:id/mute", h.UnmuteIssue)
 	g.GET("/:category", h.GetByCategory)
 	g.POST("/:category/test", h.TestCategory)
 	g.POST("/:category/:id/test", h.TestItem)
@@ -82,6 +89,75 @@ func (h *Handlers) GetSummary(c echo.Context) error {
 	return c.JSON(http.StatusOK, h.health.GetSummary())
 }
 
+// ListIssues returns active health issues with suggested actions.
+// Dismissed and muted issues are included with ?includeHidden=true.
+// GET /api/v1/health/issues
+func (h *Handlers) ListIssues(c echo.Context) error {
+	includeHidden := c.QueryParam("includeHidden") == "true"
+	return c.JSON(http.StatusOK, h.health.ListIssues(includeHidden))
+}
+
+// DismissIssue hides an issue until its status or message changes.
+// POST /api/v1/health/issues/:category/:id/dismiss
+func (h *Handlers) DismissIssue(c echo.Context) error {
+	category := HealthCategory(c.Param("category"))
+	if err := h.health.DismissIssue(category, c.Param("id")); err != nil {
+		if errors.Is(err, ErrIssueNotFound) {
+			return echo.NewHTTPError(http.StatusNotFound, err.Error())
+		}
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	return c.NoContent(http.StatusNoContent)
+}
+
+// RestoreIssue undoes a dismissal.
+// DELETE /api/v1/health/issues/:category/:id/dismiss
+func (h *Handlers) RestoreIssue(c echo.Context) error {
+	if err := h.health.RestoreIssue(HealthCategory(c.Param("category")), c.Param("id")); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	return c.NoContent(http.StatusNoContent)
+}
+
+// MuteIssueInput is the request body for muting an issue.
+type MuteIssueInput struct {
+	Hours int `json:"hours"`
+}
+
+// maxMuteHours caps mutes at 30 days so forgotten mutes eventually lapse.
+const maxMuteHours = 30 * 24
+
+// MuteIssue hides an item's issues and silences its notifications.
+// POST /api/v1/health/issues/:category/:id/mute
+func (h *Handlers) MuteIssue(c echo.Context) error {
+	var input MuteIssueInput
+	if err := c.Bind(&input); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+	if input.Hours <= 0 || input.Hours > maxMuteHours {
+		return echo.NewHTTPError(http.StatusBadRequest, "hours must be between 1 and 720")
+	}
+
+	category := HealthCategory(c.Param("category"))
+	until, err := h.health.MuteIssue(category, c.Param("id"), time.Duration(input.Hours)*time.Hour)
+	if err != nil {
+		if errors.Is(err, ErrIssueNotFound) {
+			return echo.NewHTTPError(http.StatusNotFound, err.Error())
+		}
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	return c.JSON(http.StatusOK, map[string]time.Time{"mutedUntil": until})
+}
+
+// UnmuteIssue removes a mute.
+// DELETE /api/v1/health/issues/:category/:id/mute
+func (h *Handlers) UnmuteIssue(c echo.Context) error {
+	if err := h.health.UnmuteIssue(HealthCategory(c.Param("category")), c.Param("id")); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	return c.NoContent(http.StatusNoContent)
+}
+
 // GetByCategory returns health items for a specific category.
 // GET /api/v1/health/:category
 func (h *Handlers) GetByCategory(c echo.Context) error {
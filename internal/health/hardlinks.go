@@ -0,0 +1,122 @@
+package health
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/slipstream/slipstream/internal/library/organizer"
+	"github.com/slipstream/slipstream/internal/library/rootfolder"
+)
+
+// hardlinkItemPrefix marks import health items reported by HardlinkCheck.
+const hardlinkItemPrefix = "hardlink-"
+
+const hardlinkAction = "Mount the download folder and root folder from the same filesystem (in Docker, one shared volume such as /data) so imports can hardlink instead of copying."
+
+// LibraryFolder is a root folder imports are placed into.
+type LibraryFolder struct {
+	ID   int64
+	Name string
+	Path string
+}
+
+// LibraryFolderProvider lists the root folders imports are placed into.
+type LibraryFolderProvider interface {
+	LibraryFolders(ctx context.Context) ([]LibraryFolder, error)
+}
+
+// RootFolderAdapter exposes root folders as library folders.
+type RootFolderAdapter struct {
+	rootFolders *rootfolder.Service
+}
+
+// NewRootFolderAdapter creates a new adapter.
+func NewRootFolderAdapter(svc *rootfolder.Service) *RootFolderAdapter {
+	return &RootFolderAdapter{rootFolders: svc}
+}
+
+// LibraryFolders implements LibraryFolderProvider.
+func (a *RootFolderAdapter) LibraryFolders(ctx context.Context) ([]LibraryFolder, error) {
+	rootFolders, err := a.rootFolders.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	folders := make([]LibraryFolder, 0, len(rootFolders))
+	for _, rf := range rootFolders {
+		folders = append(folders, LibraryFolder{ID: rf.ID, Name: rf.Name, Path: rf.Path})
+	}
+	return folders, nil
+}
+
+// HardlinkCheck tests whether files can be hardlinked from each import folder
+// into each root folder. A pair that cannot hardlink makes every import
+// between them fall back to a copy, which is usually a volume misconfiguration.
+type HardlinkCheck struct {
+	imports   ImportFolderProvider
+	libraries LibraryFolderProvider
+	fsChecker *FilesystemChecker
+	probe     func(srcDir, destDir string) error
+}
+
+// NewHardlinkCheck creates a new hardlink check.
+func NewHardlinkCheck(imports ImportFolderProvider, libraries LibraryFolderProvider) *HardlinkCheck {
+	return &HardlinkCheck{
+		imports:   imports,
+		libraries: libraries,
+		fsChecker: NewFilesystemChecker(),
+		probe:     organizer.ProbeHardlink,
+	}
+}
+
+// Name implements Check.
+func (c *HardlinkCheck) Name() string { return "hardlinks" }
+
+// Category implements Check.
+func (c *HardlinkCheck) Category() HealthCategory { return CategoryImport }
+
+// Run implements Check. Folders that are missing or unreachable are skipped;
+// the import folder and root folder checks already report them.
+func (c *HardlinkCheck) Run(ctx context.Context) ([]Finding, error) {
+	imports, err := c.imports.ImportFolders(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list import folders: %w", err)
+	}
+	libraries, err := c.libraries.LibraryFolders(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list root folders: %w", err)
+	}
+
+	var findings []Finding
+	for _, imp := range imports {
+		if c.fsChecker.CheckFolderAccessible(imp.Path) != nil {
+			continue
+		}
+		for _, lib := range libraries {
+			if ctx.Err() != nil {
+				return findings, nil
+			}
+			if c.fsChecker.CheckFolderAccessible(lib.Path) != nil {
+				continue
+			}
+			if err := c.probe(imp.Path, lib.Path); err != nil {
+				findings = append(findings, hardlinkFinding(imp, lib, err))
+			}
+		}
+	}
+	return findings, nil
+}
+
+func hardlinkFinding(imp ImportFolder, lib LibraryFolder, err error) Finding {
+	message := fmt.Sprintf("Cannot hardlink from %s to %s: %s. Imports will be copied.",
+		imp.Path, lib.Path, organizer.LinkFallbackReason(err))
+	if imp.SeedingHardlinks {
+		message += " Seeding torrents from this client will use twice the disk space."
+	}
+	return Finding{
+		ID:      fmt.Sprintf("%s%s-root-%d", hardlinkItemPrefix, imp.ID, lib.ID),
+		Name:    fmt.Sprintf("%s to %s", imp.Name, lib.Name),
+		Status:  StatusWarning,
+		Message: message,
+	}
+}
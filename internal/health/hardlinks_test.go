@@ -0,0 +1,70 @@
+package health
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/slipstream/slipstream/internal/library/organizer"
+)
+
+type fakeImportFolders []ImportFolder
+
+func (f fakeImportFolders) ImportFolders(context.Context) ([]ImportFolder, error) { return f, nil }
+
+type fakeLibraryFolders []LibraryFolder
+
+func (f fakeLibraryFolders) LibraryFolders(context.Context) ([]LibraryFolder, error) { return f, nil }
+
+func TestHardlinkCheck_ReportsFailingPairs(t *testing.T) {
+	downloads, movies, cloud := t.TempDir(), t.TempDir(), t.TempDir()
+	check := NewHardlinkCheck(
+		fakeImportFolders{{ID: "client-1-mapping-0", Name: "qBit", Path: downloads, SeedingHardlinks: true}},
+		fakeLibraryFolders{
+			{ID: 1, Name: "Movies", Path: movies},
+			{ID: 2, Name: "Cloud", Path: cloud},
+			{ID: 3, Name: "Gone", Path: "/nonexistent/slipstream-root"},
+		},
+	)
+	check.probe = func(_, destDir string) error {
+		if destDir == cloud {
+			return fmt.Errorf("%w: invalid cross-device link", organizer.ErrCrossDevice)
+		}
+		return nil
+	}
+
+	findings, err := check.Run(context.Background())
+	if err != nil {
+		t.Fatalf("Run() error = %v", err)
+	}
+	if len(findings) != 1 {
+		t.Fatalf("expected one finding for the cross-device pair, got %+v", findings)
+	}
+	f := findings[0]
+	if f.ID != "hardlink-client-1-mapping-0-root-2" || f.Status != StatusWarning {
+		t.Errorf("unexpected finding %+v", f)
+	}
+	if !strings.Contains(f.Message, "different filesystems") || !strings.Contains(f.Message, "twice the disk space") {
+		t.Errorf("message should explain the cause and seeding cost, got %q", f.Message)
+	}
+	if got := issueAction(&HealthItem{ID: f.ID, Category: CategoryImport}); got != hardlinkAction {
+		t.Errorf("issueAction() = %q, want hardlink action", got)
+	}
+}
+
+func TestHardlinkCheck_SameFilesystem(t *testing.T) {
+	dir := t.TempDir()
+	check := NewHardlinkCheck(
+		fakeImportFolders{{ID: "dl", Name: "Downloads", Path: dir}},
+		fakeLibraryFolders{{ID: 1, Name: "Movies", Path: t.TempDir()}},
+	)
+
+	findings, err := check.Run(context.Background())
+	if err != nil {
+		t.Fatalf("Run() error = %v", err)
+	}
+	if len(findings) != 0 {
+		t.Errorf("temp dirs share a filesystem and should hardlink, got %+v", findings)
+	}
+}
@@ -0,0 +1,101 @@
+package health
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/slipstream/slipstream/internal/downloader"
+)
+
+// ImportFolder is a local folder the import pipeline reads downloads from.
+type ImportFolder struct {
+	ID   string
+	Name string
+	Path string
+
+	// SeedingHardlinks is set when the folder's client imports seeding
+	// torrents by hardlinking, so a copy fallback doubles disk usage.
+	SeedingHardlinks bool
+}
+
+// ImportFolderProvider lists the folders the import pipeline reads from.
+type ImportFolderProvider interface {
+	ImportFolders(ctx context.Context) ([]ImportFolder, error)
+}
+
+// DownloaderFolderAdapter exposes the local side of each enabled download
+// client's path mappings as import folders.
+type DownloaderFolderAdapter struct {
+	downloader *downloader.Service
+}
+
+// NewDownloaderFolderAdapter creates a new adapter.
+func NewDownloaderFolderAdapter(svc *downloader.Service) *DownloaderFolderAdapter {
+	return &DownloaderFolderAdapter{downloader: svc}
+}
+
+// ImportFolders implements ImportFolderProvider.
+func (a *DownloaderFolderAdapter) ImportFolders(ctx context.Context) ([]ImportFolder, error) {
+	clients, err := a.downloader.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var folders []ImportFolder
+	for _, client := range clients {
+		if !client.Enabled {
+			continue
+		}
+		for i, m := range client.PathMappings {
+			folders = append(folders, ImportFolder{
+				ID:               fmt.Sprintf("client-%d-mapping-%d", client.ID, i),
+				Name:             fmt.Sprintf("%s: %s", client.Name, m.Local),
+				Path:             m.Local,
+				SeedingHardlinks: client.SeedingImportMode == downloader.SeedingImportHardlink,
+			})
+		}
+	}
+	return folders, nil
+}
+
+// ImportFolderCheck reports import folders that are missing or that
+// SlipStream cannot write to, which would make every import from them fail.
+type ImportFolderCheck struct {
+	provider  ImportFolderProvider
+	fsChecker *FilesystemChecker
+}
+
+// NewImportFolderCheck creates a new import folder check.
+func NewImportFolderCheck(provider ImportFolderProvider) *ImportFolderCheck {
+	return &ImportFolderCheck{
+		provider:  provider,
+		fsChecker: NewFilesystemChecker(),
+	}
+}
+
+// Name implements Check.
+func (c *ImportFolderCheck) Name() string { return "import-folders" }
+
+// Category implements Check.
+func (c *ImportFolderCheck) Category() HealthCategory { return CategoryImport }
+
+// Run implements Check.
+func (c *ImportFolderCheck) Run(ctx context.Context) ([]Finding, error) {
+	folders, err := c.provider.ImportFolders(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list import folders: %w", err)
+	}
+
+	var findings []Finding
+	for _, f := range folders {
+		if ok, message := c.fsChecker.CheckFolderHealth(f.Path); !ok {
+			findings = append(findings, Finding{
+				ID:      f.ID,
+				Name:    f.Name,
+				Status:  StatusError,
+				Message: message,
+			})
+		}
+	}
+	return findings, nil
+}
@@ -0,0 +1,271 @@
+package health
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/slipstream/slipstream/internal/database/sqlc"
+)
+
+// ErrIssueNotFound is returned when dismissing or muting an item that has no
+// active issue.
+var ErrIssueNotFound = errors.New("health issue not found")
+
+// Issue is a non-OK health item surfaced with the action a user can take.
+// Dismissed issues stay hidden until their status or message changes; muted
+// issues stay hidden and send no notifications until the mute expires.
+type Issue struct {
+	Category   HealthCategory `json:"category"`
+	ID         string         `json:"id"`
+	Name       string         `json:"name"`
+	Severity   HealthStatus   `json:"severity"`
+	Message    string         `json:"message"`
+	Action     string         `json:"action,omitempty"`
+	Since      *time.Time     `json:"since,omitempty"`
+	Dismissed  bool           `json:"dismissed"`
+	MutedUntil *time.Time     `json:"mutedUntil,omitempty"`
+}
+
+// categoryActions suggests a next step for issues in each category.
+var categoryActions = map[HealthCategory]string{
+	CategoryDownloadClients: "Check that the download client is running and its host, port and credentials are correct.",
+	CategoryIndexers:        "Test the indexer and review its settings; it may be down or rate limiting requests.",
+	CategoryProwlarr:        "Check that Prowlarr is reachable and the API key is valid.",
+	CategoryRootFolders:     "Make sure the folder exists, the drive is mounted and SlipStream can write to it.",
+	CategoryMetadata:        "Verify the metadata provider API key in settings.",
+	CategoryStorage:         "Free up space on the volume or move media to another root folder.",
+	CategoryImport:          "Fix the folder permissions so SlipStream can read and write downloaded files.",
+	CategoryDefinitions:     "Check network access to the definitions repository or update definitions manually.",
+	CategoryQueue:           "Check the download in the client; it may have no seeders or be stuck on an error.",
+}
+
+// issueAction returns the suggested next step for an item, preferring an
+// item-specific action over the category default.
+func issueAction(item *HealthItem) string {
+	if strings.HasPrefix(item.ID, hardlinkItemPrefix) {
+		return hardlinkAction
+	}
+	return categoryActions[item.Category]
+}
+
+func issueKey(category HealthCategory, id string) string {
+	return string(category) + "/" + id
+}
+
+func issueSignature(item *HealthItem) string {
+	return string(item.Status) + "|" + item.Message
+}
+
+// ListIssues returns all non-OK items, most severe first. Dismissed and muted
+// issues are only included when includeHidden is set.
+func (s *Service) ListIssues(includeHidden bool) []Issue {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	issues := make([]Issue, 0)
+	for _, cat := range AllCategories() {
+		for _, item := range s.items[cat] {
+			if item.Status == StatusOK {
+				continue
+			}
+			issue := s.toIssue(item)
+			if !includeHidden && (issue.Dismissed || issue.MutedUntil != nil) {
+				continue
+			}
+			issues = append(issues, issue)
+		}
+	}
+
+	sort.Slice(issues, func(i, j int) bool {
+		if issues[i].Severity != issues[j].Severity {
+			return issues[i].Severity == StatusError
+		}
+		if issues[i].Category != issues[j].Category {
+			return issues[i].Category < issues[j].Category
+		}
+		return issues[i].Name < issues[j].Name
+	})
+	return issues
+}
+
+// toIssue converts a non-OK item. Caller must hold s.mu.
+func (s *Service) toIssue(item *HealthItem) Issue {
+	issue := Issue{
+		Category: item.Category,
+		ID:       item.ID,
+		Name:     item.Name,
+		Severity: item.Status,
+		Message:  item.Message,
+		Action:   issueAction(item),
+		Since:    item.Timestamp,
+	}
+
+	s.issuesMu.Lock()
+	defer s.issuesMu.Unlock()
+
+	key := issueKey(item.Category, item.ID)
+	if sig, ok := s.dismissed[key]; ok && sig == issueSignature(item) {
+		issue.Dismissed = true
+	}
+	if until, ok := s.muted[key]; ok && time.Now().Before(until) {
+		u := until
+		issue.MutedUntil = &u
+	}
+	return issue
+}
+
+// isHidden reports whether a non-OK item is dismissed or muted.
+// Caller must hold s.mu.
+func (s *Service) isHidden(item *HealthItem) bool {
+	issue := s.toIssue(item)
+	return issue.Dismissed || issue.MutedUntil != nil
+}
+
+// DismissIssue hides an issue until its status or message changes.
+func (s *Service) DismissIssue(category HealthCategory, id string) error {
+	s.mu.RLock()
+	item, exists := s.items[category][id]
+	if !exists || item.Status == StatusOK {
+		s.mu.RUnlock()
+		return ErrIssueNotFound
+	}
+	sig := issueSignature(item)
+	s.mu.RUnlock()
+
+	s.issuesMu.Lock()
+	defer s.issuesMu.Unlock()
+
+	err := s.queries.SetHealthIssueDismissal(context.Background(), sqlc.SetHealthIssueDismissalParams{
+		Category:           string(category),
+		ItemID:             id,
+		DismissedSignature: sql.NullString{String: sig, Valid: true},
+	})
+	if err != nil {
+		return err
+	}
+	s.dismissed[issueKey(category, id)] = sig
+	return nil
+}
+
+// RestoreIssue undoes a dismissal.
+func (s *Service) RestoreIssue(category HealthCategory, id string) error {
+	s.issuesMu.Lock()
+	defer s.issuesMu.Unlock()
+
+	err := s.queries.ClearHealthIssueDismissal(context.Background(), sqlc.ClearHealthIssueDismissalParams{
+		Category: string(category),
+		ItemID:   id,
+	})
+	if err != nil {
+		return err
+	}
+	delete(s.dismissed, issueKey(category, id))
+	return nil
+}
+
+// MuteIssue hides an item's issues and suppresses its notifications for the
+// given duration. Unlike a dismissal, the mute also covers new problems with
+// the same item.
+func (s *Service) MuteIssue(category HealthCategory, id string, d time.Duration) (time.Time, error) {
+	if s.GetItem(category, id) == nil {
+		return time.Time{}, ErrIssueNotFound
+	}
+
+	until := time.Now().Add(d)
+	s.issuesMu.Lock()
+	defer s.issuesMu.Unlock()
+
+	err := s.queries.SetHealthIssueMute(context.Background(), sqlc.SetHealthIssueMuteParams{
+		Category:   string(category),
+		ItemID:     id,
+		MutedUntil: sql.NullTime{Time: until, Valid: true},
+	})
+	if err != nil {
+		return time.Time{}, err
+	}
+	s.muted[issueKey(category, id)] = until
+	return until, nil
+}
+
+// UnmuteIssue removes a mute.
+func (s *Service) UnmuteIssue(category HealthCategory, id string) error {
+	s.issuesMu.Lock()
+	defer s.issuesMu.Unlock()
+
+	err := s.queries.ClearHealthIssueMute(context.Background(), sqlc.ClearHealthIssueMuteParams{
+		Category: string(category),
+		ItemID:   id,
+	})
+	if err != nil {
+		return err
+	}
+	delete(s.muted, issueKey(category, id))
+	return nil
+}
+
+// IsMuted reports whether notifications for an item are currently muted.
+func (s *Service) IsMuted(category HealthCategory, id string) bool {
+	s.issuesMu.Lock()
+	defer s.issuesMu.Unlock()
+
+	key := issueKey(category, id)
+	until, ok := s.muted[key]
+	if !ok {
+		return false
+	}
+	if time.Now().After(until) {
+		delete(s.muted, key)
+		return false
+	}
+	return true
+}
+
+// clearIssueState forgets dismissals and mutes for an item that is no
+// longer tracked.
+func (s *Service) clearIssueState(category HealthCategory, id string) {
+	s.issuesMu.Lock()
+	defer s.issuesMu.Unlock()
+
+	key := issueKey(category, id)
+	delete(s.dismissed, key)
+	delete(s.muted, key)
+
+	err := s.queries.DeleteHealthIssueState(context.Background(), sqlc.DeleteHealthIssueStateParams{
+		Category: string(category),
+		ItemID:   id,
+	})
+	if err != nil {
+		s.logger.Warn().Err(err).Str("category", string(category)).Str("id", id).Msg("Failed to delete health issue state")
+	}
+}
+
+// loadIssueState reads persisted dismissals and mutes, skipping mutes that
+// expired while the application was down.
+func (s *Service) loadIssueState(ctx context.Context) {
+	if err := s.queries.DeleteClearedHealthIssueStates(ctx); err != nil {
+		s.logger.Warn().Err(err).Msg("Failed to prune health issue states")
+	}
+	rows, err := s.queries.ListHealthIssueStates(ctx)
+	if err != nil {
+		s.logger.Warn().Err(err).Msg("Failed to load health issue states")
+		return
+	}
+
+	s.issuesMu.Lock()
+	defer s.issuesMu.Unlock()
+
+	now := time.Now()
+	for _, row := range rows {
+		key := issueKey(HealthCategory(row.Category), row.ItemID)
+		if row.DismissedSignature.Valid {
+			s.dismissed[key] = row.DismissedSignature.String
+		}
+		if row.MutedUntil.Valid && now.Before(row.MutedUntil.Time) {
+			s.muted[key] = row.MutedUntil.Time
+		}
+	}
+}
@@ -2,11 +2,13 @@ package health
 
 import (
 	"context"
+	"database/sql"
 	"sync"
 	"time"
 
 	"github.com/rs/zerolog"
 
+	"github.com/slipstream/slipstream/internal/database/sqlc"
 	"github.com/slipstream/slipstream/internal/domain/contracts"
 )
 
@@ -19,22 +21,36 @@ type NotificationDispatcher interface {
 }
 
 // Service manages the health state of all tracked items.
-// All state is in-memory and resets on application restart.
+// Item state is in-memory and resets on application restart; issue
+// dismissals and mutes are persisted so they survive it.
 type Service struct {
+	queries     *sqlc.Queries
 	items       map[HealthCategory]map[string]*HealthItem
 	mu          sync.RWMutex
 	broadcaster contracts.Broadcaster
 	notifier    NotificationDispatcher
 	logger      *zerolog.Logger
+
+	checksMu   sync.Mutex
+	checks     []Check
+	checkItems map[string]map[string]bool
+
+	issuesMu  sync.Mutex
+	dismissed map[string]string
+	muted     map[string]time.Time
 }
 
 // NewService creates a new health service.
-func NewService(logger *zerolog.Logger, broadcaster contracts.Broadcaster) *Service {
+func NewService(db *sql.DB, logger *zerolog.Logger, broadcaster contracts.Broadcaster) *Service {
 	subLogger := logger.With().Str("component", "health").Logger()
 	s := &Service{
+		queries:     sqlc.New(db),
 		items:       make(map[HealthCategory]map[string]*HealthItem),
 		logger:      &subLogger,
 		broadcaster: broadcaster,
+		checkItems:  make(map[string]map[string]bool),
+		dismissed:   make(map[string]string),
+		muted:       make(map[string]time.Time),
 	}
 
 	// Initialize maps for all categories
@@ -42,9 +58,22 @@ func NewService(logger *zerolog.Logger, broadcaster contracts.Broadcaster) *Serv
 		s.items[cat] = make(map[string]*HealthItem)
 	}
 
+	s.loadIssueState(context.Background())
 	return s
 }
 
+// SetDB updates the database connection used by this service and reloads
+// the issue dismissals and mutes stored in it.
+func (s *Service) SetDB(db *sql.DB) {
+	s.issuesMu.Lock()
+	s.queries = sqlc.New(db)
+	s.dismissed = make(map[string]string)
+	s.muted = make(map[string]time.Time)
+	s.issuesMu.Unlock()
+
+	s.loadIssueState(context.Background())
+}
+
 // SetNotifier sets the notification dispatcher for health alerts.
 func (s *Service) SetNotifier(n NotificationDispatcher) {
 	s.notifier = n
@@ -106,6 +135,7 @@ func (s *Service) UnregisterItem(category HealthCategory, id string) {
 
 	if _, exists := s.items[category][id]; exists {
 		delete(s.items[category], id)
+		s.clearIssueState(category, id)
 
 		s.logger.Debug().
 			Str("category", string(category)).
@@ -179,6 +209,9 @@ func (s *Service) setStatus(category HealthCategory, id string, status HealthSta
 	s.broadcastUpdate(item)
 	s.mu.Unlock()
 
+	if s.IsMuted(category, id) {
+		return
+	}
 	s.dispatchStatusTransition(category, itemName, oldStatus, status, message)
 }
 
@@ -224,6 +257,8 @@ func (s *Service) GetAll() *HealthResponse {
 		Metadata:        s.itemsToSlice(CategoryMetadata),
 		Storage:         s.itemsToSlice(CategoryStorage),
 		Import:          s.itemsToSlice(CategoryImport),
+		Definitions:     s.itemsToSlice(CategoryDefinitions),
+		Queue:           s.itemsToSlice(CategoryQueue),
 	}
 
 	return resp
@@ -271,10 +306,11 @@ func (s *Service) GetSummary() *HealthSummary {
 			case StatusError:
 				catSummary.Error++
 			}
-		}
 
-		if catSummary.HasIssues() {
-			summary.HasIssues = true
+			// Dismissed and muted issues still count but don't raise the flag
+			if item.Status != StatusOK && !s.isHidden(item) {
+				summary.HasIssues = true
+			}
 		}
 
 		summary.Categories = append(summary.Categories, catSummary)
@@ -0,0 +1,146 @@
+package health
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/slipstream/slipstream/internal/downloader"
+	"github.com/slipstream/slipstream/internal/downloader/types"
+)
+
+// QueueSnapshotItem is the progress of one download as seen by the stall check.
+type QueueSnapshotItem struct {
+	ID         string
+	Name       string
+	Status     string
+	Downloaded int64
+}
+
+// QueueSnapshotProvider returns the current download queue.
+type QueueSnapshotProvider interface {
+	QueueSnapshot(ctx context.Context) ([]QueueSnapshotItem, error)
+}
+
+// DownloaderQueueAdapter adapts downloader.Service to QueueSnapshotProvider.
+type DownloaderQueueAdapter struct {
+	downloader *downloader.Service
+}
+
+// NewDownloaderQueueAdapter creates a new adapter.
+func NewDownloaderQueueAdapter(svc *downloader.Service) *DownloaderQueueAdapter {
+	return &DownloaderQueueAdapter{downloader: svc}
+}
+
+// QueueSnapshot implements QueueSnapshotProvider.
+func (a *DownloaderQueueAdapter) QueueSnapshot(ctx context.Context) ([]QueueSnapshotItem, error) {
+	queue, err := a.downloader.GetQueue(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]QueueSnapshotItem, len(queue.Items))
+	for i := range queue.Items {
+		q := &queue.Items[i]
+		items[i] = QueueSnapshotItem{
+			ID:         fmt.Sprintf("%d-%s", q.ClientID, q.ID),
+			Name:       q.Title,
+			Status:     q.Status,
+			Downloaded: q.DownloadedSize,
+		}
+	}
+	return items, nil
+}
+
+// progressMark records when a download's downloaded size last changed.
+type progressMark struct {
+	downloaded int64
+	since      time.Time
+}
+
+// StalledQueueCheck reports downloads that have made no progress for longer
+// than the configured threshold. Paused, completed and seeding downloads are
+// never considered stalled.
+type StalledQueueCheck struct {
+	provider  QueueSnapshotProvider
+	threshold time.Duration
+	now       func() time.Time
+
+	mu   sync.Mutex
+	seen map[string]progressMark
+}
+
+// NewStalledQueueCheck creates a new stalled queue check.
+func NewStalledQueueCheck(provider QueueSnapshotProvider, threshold time.Duration) *StalledQueueCheck {
+	if threshold <= 0 {
+		threshold = 6 * time.Hour
+	}
+	return &StalledQueueCheck{
+		provider:  provider,
+		threshold: threshold,
+		now:       time.Now,
+		seen:      make(map[string]progressMark),
+	}
+}
+
+// Name implements Check.
+func (c *StalledQueueCheck) Name() string { return "stalled-queue" }
+
+// Category implements Check.
+func (c *StalledQueueCheck) Category() HealthCategory { return CategoryQueue }
+
+// Run implements Check.
+func (c *StalledQueueCheck) Run(ctx context.Context) ([]Finding, error) {
+	items, err := c.provider.QueueSnapshot(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch queue: %w", err)
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := c.now()
+	active := make(map[string]progressMark, len(items))
+	var findings []Finding
+
+	for _, item := range items {
+		if !canStall(item.Status) {
+			continue
+		}
+
+		mark, ok := c.seen[item.ID]
+		if !ok || mark.downloaded != item.Downloaded {
+			mark = progressMark{downloaded: item.Downloaded, since: now}
+		}
+		active[item.ID] = mark
+
+		stalledFor := now.Sub(mark.since)
+		if stalledFor < c.threshold {
+			continue
+		}
+
+		status := StatusWarning
+		if item.Status == string(types.StatusError) {
+			status = StatusError
+		}
+		findings = append(findings, Finding{
+			ID:      item.ID,
+			Name:    item.Name,
+			Status:  status,
+			Message: fmt.Sprintf("No download progress for %s (client status: %s)", stalledFor.Truncate(time.Minute), item.Status),
+		})
+	}
+
+	c.seen = active
+	return findings, nil
+}
+
+func canStall(status string) bool {
+	switch types.Status(status) {
+	case types.StatusQueued, types.StatusDownloading, types.StatusWarning, types.StatusError, types.StatusUnknown:
+		return true
+	default:
+		return false
+	}
+}
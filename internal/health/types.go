@@ -25,6 +25,8 @@ const (
 	CategoryMetadata        HealthCategory = "metadata"
 	CategoryStorage         HealthCategory = "storage"
 	CategoryImport          HealthCategory = "import"
+	CategoryDefinitions     HealthCategory = "definitions"
+	CategoryQueue           HealthCategory = "queue"
 )
 
 // AllCategories returns all health categories in display order.
@@ -37,6 +39,8 @@ func AllCategories() []HealthCategory {
 		CategoryMetadata,
 		CategoryStorage,
 		CategoryImport,
+		CategoryDefinitions,
+		CategoryQueue,
 	}
 }
 
@@ -91,6 +95,8 @@ type HealthResponse struct {
 	Metadata        []HealthItem `json:"metadata"`
 	Storage         []HealthItem `json:"storage"`
 	Import          []HealthItem `json:"import"`
+	Definitions     []HealthItem `json:"definitions"`
+	Queue           []HealthItem `json:"queue"`
 }
 
 // HealthSummary provides an overview of system health.
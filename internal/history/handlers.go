@@ -1,10 +1,14 @@
 package history
 
 import (
+	"errors"
 	"net/http"
 	"strconv"
+	"time"
 
 	"github.com/labstack/echo/v4"
+
+	"github.com/slipstream/slipstream/internal/pagination"
 )
 
 // Handlers provides HTTP handlers for history operations.
@@ -21,24 +25,31 @@ func NewHandlers(service *Service) *Handlers {
 func (h *Handlers) RegisterRoutes(g *echo.Group) {
 	g.GET("", h.List)
 	g.DELETE("", h.Clear)
+	g.GET("/snapshot", h.Snapshot)
 	g.GET("/settings", h.GetSettings)
 	g.PUT("/settings", h.UpdateSettings)
 }
 
-// List returns paginated history entries.
+// List returns paginated history entries, newest first unless order=asc.
 // GET /api/v1/history
 func (h *Handlers) List(c echo.Context) error {
-	page := 1
-	if p := c.QueryParam("page"); p != "" {
-		if v, err := strconv.Atoi(p); err == nil && v > 0 {
-			page = v
-		}
+	page, _, err := pagination.FromQuery(c.QueryParams())
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
 	}
-
-	pageSize := 50
-	if ps := c.QueryParam("pageSize"); ps != "" {
-		if v, err := strconv.Atoi(ps); err == nil && v > 0 {
-			pageSize = v
+	order := c.QueryParam("order")
+	if order == "" {
+		order = "desc"
+	}
+	sort, err := pagination.ParseSort(c.QueryParam("sort"), order, "date")
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+	for _, key := range []string{"before", "after"} {
+		if v := c.QueryParam(key); v != "" {
+			if _, err := time.Parse(time.RFC3339, v); err != nil {
+				return echo.NewHTTPError(http.StatusBadRequest, key+" must be an RFC 3339 timestamp")
+			}
 		}
 	}
 
@@ -47,10 +58,10 @@ func (h *Handlers) List(c echo.Context) error {
 		MediaType: c.QueryParam("mediaType"),
 		Before:    c.QueryParam("before"),
 		After:     c.QueryParam("after"),
-		Page:      page,
-		PageSize:  pageSize,
+		Ascending: !sort.Descending,
+		Page:      page.Page,
+		PageSize:  page.PageSize,
 	}
-
 	result, err := h.service.List(c.Request().Context(), &opts)
 	if err != nil {
 		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
@@ -59,6 +70,33 @@ func (h *Handlers) List(c echo.Context) error {
 	return c.JSON(http.StatusOK, result)
 }
 
+// Snapshot reconstructs an item's state at a past time from its history.
+// GET /api/v1/history/snapshot?mediaType=movie&mediaId=1&at=2024-01-02T15:04:05Z
+func (h *Handlers) Snapshot(c echo.Context) error {
+	mediaID, err := strconv.ParseInt(c.QueryParam("mediaId"), 10, 64)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid mediaId")
+	}
+
+	at := time.Now()
+	if raw := c.QueryParam("at"); raw != "" {
+		at, err = time.Parse(time.RFC3339, raw)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, "at must be an RFC 3339 timestamp")
+		}
+	}
+
+	snap, err := h.service.SnapshotAt(c.Request().Context(), MediaType(c.QueryParam("mediaType")), mediaID, at)
+	if err != nil {
+		if errors.Is(err, ErrInvalidSnapshotRequest) {
+			return echo.NewHTTPError(http.StatusBadRequest, "mediaType must be movie or episode")
+		}
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, snap)
+}
+
 // Clear deletes all history entries.
 // DELETE /api/v1/history
 func (h *Handlers) Clear(c echo.Context) error {
@@ -5,6 +5,7 @@ import (
 	"database/sql"
 	"encoding/json"
 	"fmt"
+	"path/filepath"
 	"strconv"
 	"strings"
 	"time"
@@ -14,7 +15,9 @@ import (
 	"github.com/slipstream/slipstream/internal/database/sqlc"
 	"github.com/slipstream/slipstream/internal/domain/contracts"
 	"github.com/slipstream/slipstream/internal/indexer/scoring"
+	"github.com/slipstream/slipstream/internal/library/quality"
 	"github.com/slipstream/slipstream/internal/library/scanner"
+	"github.com/slipstream/slipstream/internal/pagination"
 )
 
 // Service provides history management functionality.
@@ -23,6 +26,7 @@ type Service struct {
 	queries     *sqlc.Queries
 	logger      *zerolog.Logger
 	broadcaster contracts.Broadcaster
+	qualities   *quality.Registry
 }
 
 // NewService creates a new history service.
@@ -36,6 +40,11 @@ func NewService(db *sql.DB, logger *zerolog.Logger, broadcaster contracts.Broadc
 	}
 }
 
+// SetQualities sets the quality definitions used to backfill quality names.
+func (s *Service) SetQualities(qualities *quality.Registry) {
+	s.qualities = qualities
+}
+
 // SetDB updates the database connection used by this service.
 func (s *Service) SetDB(db *sql.DB) {
 	s.db = db
@@ -103,7 +112,7 @@ func (s *Service) List(ctx context.Context, opts *ListOptions) (*ListResponse, e
 	var totalCount int64
 	var err error
 
-	if hasFilters {
+	if hasFilters || normalized.Ascending {
 		rows, totalCount, err = s.listWithFilters(ctx, &normalized, limit, offset)
 	} else {
 		rows, totalCount, err = s.listAll(ctx, limit, offset)
@@ -120,18 +129,7 @@ func (s *Service) List(ctx context.Context, opts *ListOptions) (*ListResponse, e
 		entries = append(entries, entry)
 	}
 
-	totalPages := int(totalCount) / normalized.PageSize
-	if int(totalCount)%normalized.PageSize > 0 {
-		totalPages++
-	}
-
-	return &ListResponse{
-		Items:      entries,
-		Page:       normalized.Page,
-		PageSize:   normalized.PageSize,
-		TotalCount: totalCount,
-		TotalPages: totalPages,
-	}, nil
+	return pagination.NewPage(entries, pagination.Params{Page: normalized.Page, PageSize: normalized.PageSize}, totalCount), nil
 }
 
 func normalizeListOptions(opts *ListOptions) ListOptions {
@@ -169,30 +167,23 @@ func (s *Service) listWithFilters(ctx context.Context, opts *ListOptions, limit,
 	beforeTime := parseTimeFilter(opts.Before)
 
 	rows, err := s.queries.ListHistoryFiltered(ctx, sqlc.ListHistoryFilteredParams{
-		Column1:     opts.EventType,
-		Column2:     sql.NullString{String: opts.EventType, Valid: opts.EventType != ""},
-		Column3:     opts.MediaType,
-		EntityType:  opts.MediaType,
-		Column5:     opts.After,
-		CreatedAt:   afterTime,
-		Column7:     opts.Before,
-		CreatedAt_2: beforeTime,
-		Limit:       limit,
-		Offset:      offset,
+		EventTypes:    opts.EventType,
+		EntityType:    opts.MediaType,
+		CreatedAfter:  afterTime,
+		CreatedBefore: beforeTime,
+		Ascending:     opts.Ascending,
+		Lim:           limit,
+		Off:           offset,
 	})
 	if err != nil {
 		return nil, 0, err
 	}
 
 	totalCount, err := s.queries.CountHistoryFiltered(ctx, sqlc.CountHistoryFilteredParams{
-		Column1:     opts.EventType,
-		Column2:     sql.NullString{String: opts.EventType, Valid: opts.EventType != ""},
-		Column3:     opts.MediaType,
-		EntityType:  opts.MediaType,
-		Column5:     opts.After,
-		CreatedAt:   afterTime,
-		Column7:     opts.Before,
-		CreatedAt_2: beforeTime,
+		EventTypes:    opts.EventType,
+		EntityType:    opts.MediaType,
+		CreatedAfter:  afterTime,
+		CreatedBefore: beforeTime,
 	})
 	if err != nil {
 		return nil, 0, err
@@ -320,7 +311,7 @@ func (s *Service) enrichQualityInfo(entry *Entry) {
 		return
 	}
 
-	backfillMissingQualityFields(entry)
+	backfillMissingQualityFields(s.qualities, entry)
 }
 
 func shouldEnrichQuality(entry *Entry) bool {
@@ -338,45 +329,45 @@ func shouldEnrichQuality(entry *Entry) bool {
 	return !hasPrev || !hasNew
 }
 
-func backfillMissingQualityFields(entry *Entry) {
+func backfillMissingQualityFields(qualities *quality.Registry, entry *Entry) {
 	_, hasPrev := entry.Data["previousQuality"]
 	if !hasPrev {
-		tryBackfillPreviousQuality(entry)
+		tryBackfillPreviousQuality(qualities, entry)
 	}
 
 	_, hasNew := entry.Data["newQuality"]
 	if !hasNew {
-		tryBackfillNewQuality(entry)
+		tryBackfillNewQuality(qualities, entry)
 	}
 }
 
-func tryBackfillPreviousQuality(entry *Entry) {
+func tryBackfillPreviousQuality(qualities *quality.Registry, entry *Entry) {
 	prev, _ := entry.Data["previousFile"].(string)
 	if prev == "" {
 		return
 	}
 
-	if name := qualityNameFromPath(prev); name != "" {
+	if name := qualityNameFromPath(qualities, prev); name != "" {
 		entry.Data["previousQuality"] = name
 	}
 }
 
-func tryBackfillNewQuality(entry *Entry) {
+func tryBackfillNewQuality(qualities *quality.Registry, entry *Entry) {
 	dest, _ := entry.Data["destinationPath"].(string)
 	if dest == "" {
 		return
 	}
 
-	if name := qualityNameFromPath(dest); name != "" {
+	if name := qualityNameFromPath(qualities, dest); name != "" {
 		entry.Data["newQuality"] = name
 	}
 }
 
 // qualityNameFromPath derives a quality name (e.g. "Bluray-1080p") from a file path.
-func qualityNameFromPath(path string) string {
+func qualityNameFromPath(qualities *quality.Registry, path string) string {
 	parsed := scanner.ParsePath(path)
 	res, _ := strconv.Atoi(strings.TrimSuffix(parsed.Quality, "p"))
-	if result := scoring.MatchQuality(parsed.Source, res); result.Quality != nil {
+	if result := scoring.MatchRelease(qualities, filepath.Base(path), parsed.Source, res); result.Quality != nil {
 		return result.Quality.Name
 	}
 	return ""
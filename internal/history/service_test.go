@@ -307,4 +307,11 @@ func TestHistoryService_Pagination(t *testing.T) {
 	if resp.TotalPages != 3 {
 		t.Errorf("TotalPages = %d, want 3", resp.TotalPages)
 	}
+	oldest, err := service.List(ctx, &ListOptions{Ascending: true, Page: 1, PageSize: 2})
+	if err != nil {
+		t.Fatalf("List() ascending error = %v", err)
+	}
+	if len(oldest.Items) != 2 || oldest.Items[0].EntityID != 1 || oldest.TotalCount != 5 {
+		t.Errorf("List() ascending first item = %+v, want the first entry created", oldest.Items[0])
+	}
 }
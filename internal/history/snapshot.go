@@ -0,0 +1,271 @@
+package history
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"time"
+)
+
+// ErrInvalidSnapshotRequest is returned for an unknown media type or a missing timestamp.
+var ErrInvalidSnapshotRequest = errors.New("invalid snapshot request")
+
+// Snapshot is an item's state at a past moment, rebuilt by replaying its
+// history events up to that moment. It only knows what history recorded, so
+// changes older than the retention window are missing.
+type Snapshot struct {
+	MediaType MediaType      `json:"mediaType"`
+	MediaID   int64          `json:"mediaId"`
+	At        time.Time      `json:"at"`
+	Status    string         `json:"status,omitempty"`
+	Quality   string         `json:"quality,omitempty"`
+	Files     []SnapshotFile `json:"files"`
+	Slots     []SnapshotSlot `json:"slots"`
+	LastGrab  *SnapshotGrab  `json:"lastGrab,omitempty"`
+	Events    []*Entry       `json:"events"` // replayed events, oldest first
+}
+
+// SnapshotFile is a file the item had at the snapshot time.
+type SnapshotFile struct {
+	Path       string    `json:"path"`
+	Quality    string    `json:"quality,omitempty"`
+	SlotID     *int64    `json:"slotId,omitempty"`
+	ImportedAt time.Time `json:"importedAt"`
+	EventID    int64     `json:"eventId"` // history entry that added or last moved the file
+}
+
+// SnapshotSlot is a version slot assignment at the snapshot time.
+type SnapshotSlot struct {
+	SlotID   int64  `json:"slotId"`
+	SlotName string `json:"slotName,omitempty"`
+	FilePath string `json:"filePath,omitempty"`
+}
+
+// SnapshotGrab is the most recent release grabbed before the snapshot time.
+type SnapshotGrab struct {
+	ReleaseName string    `json:"releaseName,omitempty"`
+	Quality     string    `json:"quality,omitempty"`
+	Indexer     string    `json:"indexer,omitempty"`
+	IsUpgrade   bool      `json:"isUpgrade"`
+	GrabbedAt   time.Time `json:"grabbedAt"`
+}
+
+// SnapshotAt reconstructs the state of a movie or episode at the given time.
+func (s *Service) SnapshotAt(ctx context.Context, mediaType MediaType, mediaID int64, at time.Time) (*Snapshot, error) {
+	if (mediaType != MediaTypeMovie && mediaType != MediaTypeEpisode) || mediaID <= 0 || at.IsZero() {
+		return nil, ErrInvalidSnapshotRequest
+	}
+
+	entries, err := s.ListByMedia(ctx, mediaType, mediaID)
+	if err != nil {
+		return nil, err
+	}
+	return buildSnapshot(mediaType, mediaID, at, entries), nil
+}
+
+// buildSnapshot replays entries created at or before at, oldest first.
+func buildSnapshot(mediaType MediaType, mediaID int64, at time.Time, entries []*Entry) *Snapshot {
+	type timedEntry struct {
+		entry *Entry
+		at    time.Time
+	}
+	var replay []timedEntry
+	for _, e := range entries {
+		t, err := time.Parse(time.RFC3339, e.CreatedAt)
+		if err != nil || t.After(at) {
+			continue
+		}
+		replay = append(replay, timedEntry{entry: e, at: t})
+	}
+	sort.SliceStable(replay, func(i, j int) bool {
+		if !replay[i].at.Equal(replay[j].at) {
+			return replay[i].at.Before(replay[j].at)
+		}
+		return replay[i].entry.ID < replay[j].entry.ID
+	})
+
+	r := &snapshotReplay{snap: &Snapshot{
+		MediaType: mediaType,
+		MediaID:   mediaID,
+		At:        at,
+		Files:     []SnapshotFile{},
+		Slots:     []SnapshotSlot{},
+		Events:    make([]*Entry, 0, len(replay)),
+	}}
+	for _, te := range replay {
+		r.apply(te.entry, te.at)
+		r.snap.Events = append(r.snap.Events, te.entry)
+	}
+	if n := len(r.snap.Files); n > 0 {
+		r.snap.Quality = r.snap.Files[n-1].Quality
+	}
+	return r.snap
+}
+
+type snapshotReplay struct {
+	snap *Snapshot
+}
+
+func (r *snapshotReplay) apply(e *Entry, at time.Time) {
+	switch e.EventType {
+	case EventTypeGrabbed, EventTypeAutoSearchDownload:
+		r.snap.Status = "downloading"
+		r.snap.LastGrab = &SnapshotGrab{
+			ReleaseName: dataString(e.Data, "releaseName"),
+			Quality:     firstNonEmpty(dataString(e.Data, "newQuality"), e.Quality),
+			Indexer:     dataString(e.Data, "indexer"),
+			IsUpgrade:   dataBool(e.Data, "isUpgrade"),
+			GrabbedAt:   at,
+		}
+	case EventTypeImported:
+		r.applyImported(e, at)
+	case EventTypeImportFailed, EventTypeFailed:
+		r.snap.Status = "failed"
+	case EventTypeImportUndone:
+		r.removeFile(dataString(e.Data, "removedPath"))
+		if restored := dataString(e.Data, "restoredFile"); restored != "" {
+			r.snap.Files = append(r.snap.Files, SnapshotFile{Path: restored, ImportedAt: at, EventID: e.ID})
+		}
+		if len(r.snap.Files) == 0 {
+			r.snap.Status = "missing"
+		}
+	case EventTypeRenamed:
+		r.moveFile(firstNonEmpty(dataString(e.Data, "source_path"), dataString(e.Data, "sourcePath")),
+			firstNonEmpty(dataString(e.Data, "destination_path"), dataString(e.Data, "destinationPath")), e.ID)
+	case EventTypeDeleted:
+		if p := firstNonEmpty(dataString(e.Data, "path"), dataString(e.Data, "filePath")); p != "" {
+			r.removeFile(p)
+		} else {
+			r.snap.Files = r.snap.Files[:0]
+			r.snap.Slots = r.snap.Slots[:0]
+		}
+		if len(r.snap.Files) == 0 {
+			r.snap.Status = "missing"
+		}
+	case EventTypeStatusChanged:
+		if to := dataString(e.Data, "to"); to != "" {
+			r.snap.Status = to
+		}
+	case EventTypeSlotAssigned, EventTypeSlotReassigned:
+		if prev, ok := dataInt(e.Data, "previousSlotId"); ok {
+			r.removeSlot(prev)
+		}
+		if slotID, ok := dataInt(e.Data, "slotId"); ok {
+			r.assignSlot(slotID, dataString(e.Data, "slotName"), dataString(e.Data, "filePath"))
+		}
+	case EventTypeSlotUnassigned:
+		if slotID, ok := dataInt(e.Data, "slotId"); ok {
+			r.removeSlot(slotID)
+		}
+	}
+}
+
+func (r *snapshotReplay) applyImported(e *Entry, at time.Time) {
+	dest := dataString(e.Data, "destinationPath")
+	if prev := dataString(e.Data, "previousFile"); prev != "" && prev != dest {
+		r.removeFile(prev)
+	}
+	r.snap.Status = "available"
+	if dest == "" {
+		return
+	}
+
+	file := SnapshotFile{
+		Path:       dest,
+		Quality:    firstNonEmpty(dataString(e.Data, "newQuality"), e.Quality),
+		ImportedAt: at,
+		EventID:    e.ID,
+	}
+	r.removeFile(dest)
+	if slotID, ok := dataInt(e.Data, "slotId"); ok {
+		file.SlotID = &slotID
+		r.assignSlot(slotID, dataString(e.Data, "slotName"), dest)
+	}
+	r.snap.Files = append(r.snap.Files, file)
+}
+
+func (r *snapshotReplay) moveFile(from, to string, eventID int64) {
+	if from == "" || to == "" {
+		return
+	}
+	for i := range r.snap.Files {
+		if r.snap.Files[i].Path == from {
+			r.snap.Files[i].Path = to
+			r.snap.Files[i].EventID = eventID
+		}
+	}
+	for i := range r.snap.Slots {
+		if r.snap.Slots[i].FilePath == from {
+			r.snap.Slots[i].FilePath = to
+		}
+	}
+}
+
+func (r *snapshotReplay) removeFile(path string) {
+	files := r.snap.Files[:0]
+	for _, f := range r.snap.Files {
+		if f.Path != path {
+			files = append(files, f)
+		}
+	}
+	r.snap.Files = files
+
+	slots := r.snap.Slots[:0]
+	for _, sl := range r.snap.Slots {
+		if sl.FilePath != path {
+			slots = append(slots, sl)
+		}
+	}
+	r.snap.Slots = slots
+}
+
+func (r *snapshotReplay) assignSlot(slotID int64, name, path string) {
+	for i := range r.snap.Slots {
+		if r.snap.Slots[i].SlotID == slotID {
+			r.snap.Slots[i].FilePath = path
+			if name != "" {
+				r.snap.Slots[i].SlotName = name
+			}
+			return
+		}
+	}
+	r.snap.Slots = append(r.snap.Slots, SnapshotSlot{SlotID: slotID, SlotName: name, FilePath: path})
+}
+
+func (r *snapshotReplay) removeSlot(slotID int64) {
+	slots := r.snap.Slots[:0]
+	for _, sl := range r.snap.Slots {
+		if sl.SlotID != slotID {
+			slots = append(slots, sl)
+		}
+	}
+	r.snap.Slots = slots
+}
+
+func dataString(data map[string]any, key string) string {
+	v, _ := data[key].(string)
+	return v
+}
+
+func dataBool(data map[string]any, key string) bool {
+	v, _ := data[key].(bool)
+	return v
+}
+
+// dataInt reads a JSON number; decoded history data holds numbers as float64.
+func dataInt(data map[string]any, key string) (int64, bool) {
+	v, ok := data[key].(float64)
+	if !ok || v <= 0 {
+		return 0, false
+	}
+	return int64(v), true
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, v := range values {
+		if v != "" {
+			return v
+		}
+	}
+	return ""
+}
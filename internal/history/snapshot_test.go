@@ -0,0 +1,70 @@
+package history
+
+import (
+	"testing"
+	"time"
+)
+
+func TestBuildSnapshot(t *testing.T) {
+	base := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+	at := func(h int) string { return base.Add(time.Duration(h) * time.Hour).Format(time.RFC3339) }
+
+	// ListByMedia returns newest first.
+	entries := []*Entry{
+		{ID: 6, EventType: EventTypeStatusChanged, CreatedAt: at(10), Data: map[string]any{"to": "missing"}},
+		{ID: 5, EventType: EventTypeSlotReassigned, CreatedAt: at(4), Data: map[string]any{
+			"slotId": float64(2), "slotName": "4K", "filePath": "/m/Movie 2160p.mkv", "previousSlotId": float64(1),
+		}},
+		{ID: 4, EventType: EventTypeImported, CreatedAt: at(3), Quality: "2160p", Data: map[string]any{
+			"destinationPath": "/m/Movie 2160p.mkv", "previousFile": "/m/Movie.mkv", "isUpgrade": true,
+			"newQuality": "Bluray-2160p", "slotId": float64(1),
+		}},
+		{ID: 3, EventType: EventTypeRenamed, CreatedAt: at(2), Data: map[string]any{
+			"source_path": "/m/movie.mkv", "destination_path": "/m/Movie.mkv",
+		}},
+		{ID: 2, EventType: EventTypeImported, CreatedAt: at(1), Quality: "1080p", Data: map[string]any{
+			"destinationPath": "/m/movie.mkv", "slotId": float64(1), "slotName": "HD",
+		}},
+		{ID: 1, EventType: EventTypeAutoSearchDownload, CreatedAt: at(0), Data: map[string]any{"releaseName": "Movie.1080p"}},
+	}
+
+	t.Run("after rename", func(t *testing.T) {
+		snap := buildSnapshot(MediaTypeMovie, 1, base.Add(150*time.Minute), entries)
+		if snap.Status != "available" || len(snap.Files) != 1 || snap.Files[0].Path != "/m/Movie.mkv" {
+			t.Fatalf("snapshot = %+v", snap)
+		}
+		if snap.Quality != "1080p" || len(snap.Slots) != 1 || snap.Slots[0].FilePath != "/m/Movie.mkv" {
+			t.Errorf("quality/slots = %q %+v", snap.Quality, snap.Slots)
+		}
+		if snap.LastGrab == nil || snap.LastGrab.ReleaseName != "Movie.1080p" {
+			t.Errorf("LastGrab = %+v", snap.LastGrab)
+		}
+		if len(snap.Events) != 3 || snap.Events[0].ID != 1 {
+			t.Errorf("events = %d, first %d", len(snap.Events), snap.Events[0].ID)
+		}
+	})
+
+	t.Run("after upgrade and reassign", func(t *testing.T) {
+		snap := buildSnapshot(MediaTypeMovie, 1, base.Add(5*time.Hour), entries)
+		if len(snap.Files) != 1 || snap.Files[0].Path != "/m/Movie 2160p.mkv" || snap.Quality != "Bluray-2160p" {
+			t.Fatalf("files = %+v quality %q", snap.Files, snap.Quality)
+		}
+		if len(snap.Slots) != 1 || snap.Slots[0].SlotID != 2 || snap.Slots[0].SlotName != "4K" {
+			t.Errorf("slots = %+v", snap.Slots)
+		}
+	})
+
+	t.Run("latest status", func(t *testing.T) {
+		snap := buildSnapshot(MediaTypeMovie, 1, base.Add(24*time.Hour), entries)
+		if snap.Status != "missing" {
+			t.Errorf("Status = %q, want missing", snap.Status)
+		}
+	})
+
+	t.Run("before any event", func(t *testing.T) {
+		snap := buildSnapshot(MediaTypeMovie, 1, base.Add(-time.Hour), entries)
+		if snap.Status != "" || len(snap.Files) != 0 || len(snap.Events) != 0 {
+			t.Errorf("snapshot = %+v", snap)
+		}
+	})
+}
@@ -1,6 +1,10 @@
 package history
 
-import "encoding/json"
+import (
+	"encoding/json"
+
+	"github.com/slipstream/slipstream/internal/pagination"
+)
 
 // EventType represents the type of history event.
 type EventType string
@@ -14,6 +18,7 @@ const (
 	EventTypeAutoSearchDownload EventType = "autosearch_download"
 	EventTypeAutoSearchFailed   EventType = "autosearch_failed"
 	EventTypeImportFailed       EventType = "import_failed"
+	EventTypeImportUndone       EventType = "import_undone"
 	// Req 17.1.1: Slot-related event types for multi-version tracking
 	EventTypeSlotAssigned   EventType = "slot_assigned"
 	EventTypeSlotReassigned EventType = "slot_reassigned"
@@ -67,18 +72,13 @@ type ListOptions struct {
 	MediaType string
 	Before    string
 	After     string
+	Ascending bool
 	Page      int
 	PageSize  int
 }
 
 // ListResponse contains paginated history results.
-type ListResponse struct {
-	Items      []*Entry `json:"items"`
-	Page       int      `json:"page"`
-	PageSize   int      `json:"pageSize"`
-	TotalCount int64    `json:"totalCount"`
-	TotalPages int      `json:"totalPages"`
-}
+type ListResponse = pagination.Page[*Entry]
 
 // AutoSearchDownloadData contains data for autosearch download events (including upgrades).
 type AutoSearchDownloadData struct {
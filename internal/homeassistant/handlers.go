@@ -0,0 +1,135 @@
+package homeassistant
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+)
+
+const redactedSentinel = "********"
+
+// Handlers provides HTTP handlers for the Home Assistant integration.
+type Handlers struct {
+	service *Service
+}
+
+// NewHandlers creates new Home Assistant handlers.
+func NewHandlers(service *Service) *Handlers {
+	return &Handlers{service: service}
+}
+
+// RegisterRoutes registers the sensor routes. These are intended to be
+// mounted on a group that accepts API key authentication.
+func (h *Handlers) RegisterRoutes(g *echo.Group) {
+	g.GET("/summary", h.GetSummary)
+	g.GET("/sensors", h.GetSensors)
+	g.GET("/sensors/:sensor", h.GetSensor)
+}
+
+// RegisterSettingsRoutes registers MQTT settings routes on the settings group.
+func (h *Handlers) RegisterSettingsRoutes(settings *echo.Group) {
+	settings.GET("/homeassistant", h.GetSettings)
+	settings.PUT("/homeassistant", h.UpdateSettings)
+	settings.POST("/homeassistant/test", h.TestSettings)
+}
+
+// GetSummary returns the full compact summary.
+// GET /api/v1/integrations/homeassistant/summary
+func (h *Handlers) GetSummary(c echo.Context) error {
+	summary, err := h.service.GetSummary(c.Request().Context())
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	return c.JSON(http.StatusOK, summary)
+}
+
+// GetSensors returns all sensor states as a flat object.
+// GET /api/v1/integrations/homeassistant/sensors
+func (h *Handlers) GetSensors(c echo.Context) error {
+	summary, err := h.service.GetSummary(c.Request().Context())
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	return c.JSON(http.StatusOK, summary.Sensors())
+}
+
+// GetSensor returns a single sensor state.
+// GET /api/v1/integrations/homeassistant/sensors/:sensor
+func (h *Handlers) GetSensor(c echo.Context) error {
+	sensor := c.Param("sensor")
+	if !IsValidSensor(sensor) {
+		return echo.NewHTTPError(http.StatusNotFound, "unknown sensor")
+	}
+
+	summary, err := h.service.GetSummary(c.Request().Context())
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	return c.JSON(http.StatusOK, SensorState{Sensor: sensor, State: summary.Sensors()[sensor]})
+}
+
+// GetSettings returns the MQTT settings.
+// GET /api/v1/settings/homeassistant
+func (h *Handlers) GetSettings(c echo.Context) error {
+	settings, err := h.service.GetMQTTSettings(c.Request().Context())
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	if settings.Password != "" {
+		settings.Password = redactedSentinel
+	}
+	return c.JSON(http.StatusOK, settings)
+}
+
+// UpdateSettings saves the MQTT settings.
+// PUT /api/v1/settings/homeassistant
+func (h *Handlers) UpdateSettings(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	var input MQTTSettings
+	if err := c.Bind(&input); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid request body")
+	}
+	if err := h.restoreRedactedPassword(c, &input); err != nil {
+		return err
+	}
+
+	if err := h.service.UpdateMQTTSettings(ctx, &input); err != nil {
+		if errors.Is(err, ErrInvalidSettings) {
+			return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+		}
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	return h.GetSettings(c)
+}
+
+// TestSettings attempts a broker connection with the provided settings.
+// POST /api/v1/settings/homeassistant/test
+func (h *Handlers) TestSettings(c echo.Context) error {
+	var input MQTTSettings
+	if err := c.Bind(&input); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid request body")
+	}
+	if err := h.restoreRedactedPassword(c, &input); err != nil {
+		return err
+	}
+
+	if err := h.service.TestMQTT(c.Request().Context(), &input); err != nil {
+		return c.JSON(http.StatusOK, map[string]any{"success": false, "message": err.Error()})
+	}
+	return c.JSON(http.StatusOK, map[string]any{"success": true, "message": "Connected to MQTT broker"})
+}
+
+func (h *Handlers) restoreRedactedPassword(c echo.Context, input *MQTTSettings) error {
+	if input.Password != redactedSentinel {
+		return nil
+	}
+	existing, err := h.service.GetMQTTSettings(c.Request().Context())
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	input.Password = existing.Password
+	return nil
+}
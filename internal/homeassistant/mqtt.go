@@ -0,0 +1,178 @@
+package homeassistant
+
+import (
+	"bufio"
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"time"
+)
+
+// Minimal MQTT 3.1.1 publisher: CONNECT, QoS 0 PUBLISH, DISCONNECT.
+// SlipStream only ever publishes state, so subscriptions and QoS > 0 are not needed.
+
+const (
+	mqttPacketConnect    byte = 0x10
+	mqttPacketConnack    byte = 0x20
+	mqttPacketPublish    byte = 0x30
+	mqttPacketDisconnect byte = 0xE0
+
+	mqttFlagRetain       byte = 0x01
+	mqttFlagCleanSession byte = 0x02
+	mqttFlagPassword     byte = 0x40
+	mqttFlagUsername     byte = 0x80
+
+	mqttProtocolLevel    byte = 0x04
+	mqttKeepAliveSeconds      = 60
+	mqttMaxRemainingLen       = 268435455
+	mqttIOTimeout             = 10 * time.Second
+)
+
+var (
+	ErrMQTTConnectionRefused = errors.New("mqtt connection refused")
+	ErrMQTTProtocol          = errors.New("mqtt protocol error")
+)
+
+var connackReasons = map[byte]string{
+	1: "unacceptable protocol version",
+	2: "identifier rejected",
+	3: "server unavailable",
+	4: "bad username or password",
+	5: "not authorized",
+}
+
+type mqttClient struct {
+	conn net.Conn
+	w    *bufio.Writer
+}
+
+func dialMQTT(ctx context.Context, settings *MQTTSettings, clientID string) (*mqttClient, error) {
+	addr := net.JoinHostPort(settings.Host, strconv.Itoa(settings.Port))
+	dialer := &net.Dialer{Timeout: mqttIOTimeout}
+
+	var conn net.Conn
+	var err error
+	if settings.UseTLS {
+		tlsDialer := &tls.Dialer{NetDialer: dialer, Config: &tls.Config{ServerName: settings.Host, MinVersion: tls.VersionTLS12}}
+		conn, err = tlsDialer.DialContext(ctx, "tcp", addr)
+	} else {
+		conn, err = dialer.DialContext(ctx, "tcp", addr)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to MQTT broker %s: %w", addr, err)
+	}
+
+	c := &mqttClient{conn: conn, w: bufio.NewWriter(conn)}
+	if err := c.connect(clientID, settings.Username, settings.Password); err != nil {
+		conn.Close()
+		return nil, err
+	}
+	return c, nil
+}
+
+func (c *mqttClient) connect(clientID, username, password string) error {
+	if err := c.conn.SetDeadline(time.Now().Add(mqttIOTimeout)); err != nil {
+		return err
+	}
+
+	if err := c.writePacket(mqttPacketConnect, encodeConnect(clientID, username, password)); err != nil {
+		return err
+	}
+
+	header := make([]byte, 4)
+	if _, err := io.ReadFull(c.conn, header); err != nil {
+		return fmt.Errorf("failed to read CONNACK: %w", err)
+	}
+	if header[0] != mqttPacketConnack || header[1] != 2 {
+		return fmt.Errorf("%w: unexpected CONNACK header %#x", ErrMQTTProtocol, header[:2])
+	}
+	if code := header[3]; code != 0 {
+		reason, ok := connackReasons[code]
+		if !ok {
+			reason = "code " + strconv.Itoa(int(code))
+		}
+		return fmt.Errorf("%w: %s", ErrMQTTConnectionRefused, reason)
+	}
+	return nil
+}
+
+func (c *mqttClient) publish(topic string, payload []byte, retain bool) error {
+	if err := c.conn.SetWriteDeadline(time.Now().Add(mqttIOTimeout)); err != nil {
+		return err
+	}
+	header := mqttPacketPublish
+	if retain {
+		header |= mqttFlagRetain
+	}
+	body := append(encodeString(topic), payload...)
+	return c.writePacket(header, body)
+}
+
+func (c *mqttClient) close() {
+	_ = c.conn.SetWriteDeadline(time.Now().Add(mqttIOTimeout))
+	_ = c.writePacket(mqttPacketDisconnect, nil)
+	c.conn.Close()
+}
+
+func (c *mqttClient) writePacket(header byte, body []byte) error {
+	if len(body) > mqttMaxRemainingLen {
+		return fmt.Errorf("%w: packet too large", ErrMQTTProtocol)
+	}
+	if err := c.w.WriteByte(header); err != nil {
+		return err
+	}
+	if _, err := c.w.Write(encodeRemainingLength(len(body))); err != nil {
+		return err
+	}
+	if _, err := c.w.Write(body); err != nil {
+		return err
+	}
+	return c.w.Flush()
+}
+
+func encodeConnect(clientID, username, password string) []byte {
+	flags := mqttFlagCleanSession
+	if username != "" {
+		flags |= mqttFlagUsername
+		if password != "" {
+			flags |= mqttFlagPassword
+		}
+	}
+
+	buf := encodeString("MQTT")
+	buf = append(buf, mqttProtocolLevel, flags, byte(mqttKeepAliveSeconds>>8), byte(mqttKeepAliveSeconds&0xFF))
+	buf = append(buf, encodeString(clientID)...)
+	if flags&mqttFlagUsername != 0 {
+		buf = append(buf, encodeString(username)...)
+	}
+	if flags&mqttFlagPassword != 0 {
+		buf = append(buf, encodeString(password)...)
+	}
+	return buf
+}
+
+func encodeString(s string) []byte {
+	n := len(s)
+	buf := make([]byte, 0, n+2)
+	buf = append(buf, byte(n>>8), byte(n&0xFF))
+	return append(buf, s...)
+}
+
+func encodeRemainingLength(n int) []byte {
+	var buf []byte
+	for {
+		b := byte(n % 128)
+		n /= 128
+		if n > 0 {
+			b |= 0x80
+		}
+		buf = append(buf, b)
+		if n == 0 {
+			return buf
+		}
+	}
+}
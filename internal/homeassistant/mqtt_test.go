@@ -0,0 +1,172 @@
+package homeassistant
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net"
+	"strconv"
+	"testing"
+)
+
+type capturedPacket struct {
+	header byte
+	body   []byte
+}
+
+func readRemainingLength(r *bufio.Reader) (int, error) {
+	multiplier, value := 1, 0
+	for {
+		b, err := r.ReadByte()
+		if err != nil {
+			return 0, err
+		}
+		value += int(b&0x7F) * multiplier
+		if b&0x80 == 0 {
+			return value, nil
+		}
+		multiplier *= 128
+	}
+}
+
+// startFakeBroker accepts one connection, replies to CONNECT with the given
+// return code, and records every packet until the client disconnects.
+func startFakeBroker(t *testing.T, returnCode byte) (port int, packets <-chan []capturedPacket) {
+	t.Helper()
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listen: %v", err)
+	}
+	t.Cleanup(func() { ln.Close() })
+
+	out := make(chan []capturedPacket, 1)
+	go func() {
+		conn, err := ln.Accept()
+		if err != nil {
+			out <- nil
+			return
+		}
+		defer conn.Close()
+		r := bufio.NewReader(conn)
+		var captured []capturedPacket
+		for {
+			header, err := r.ReadByte()
+			if err != nil {
+				break
+			}
+			n, err := readRemainingLength(r)
+			if err != nil {
+				break
+			}
+			body := make([]byte, n)
+			if _, err := io.ReadFull(r, body); err != nil {
+				break
+			}
+			captured = append(captured, capturedPacket{header: header, body: body})
+			if header == mqttPacketConnect {
+				_, _ = conn.Write([]byte{mqttPacketConnack, 2, 0, returnCode})
+			}
+			if header == mqttPacketDisconnect {
+				break
+			}
+		}
+		out <- captured
+	}()
+
+	addr, ok := ln.Addr().(*net.TCPAddr)
+	if !ok {
+		t.Fatal("unexpected listener address type")
+	}
+	return addr.Port, out
+}
+
+func TestEncodeRemainingLength(t *testing.T) {
+	tests := []struct {
+		n    int
+		want []byte
+	}{
+		{0, []byte{0x00}},
+		{127, []byte{0x7F}},
+		{128, []byte{0x80, 0x01}},
+		{16383, []byte{0xFF, 0x7F}},
+		{16384, []byte{0x80, 0x80, 0x01}},
+	}
+	for _, tt := range tests {
+		t.Run(strconv.Itoa(tt.n), func(t *testing.T) {
+			if got := encodeRemainingLength(tt.n); !bytes.Equal(got, tt.want) {
+				t.Errorf("encodeRemainingLength(%d) = %x, want %x", tt.n, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMQTTClient_ConnectPublishDisconnect(t *testing.T) {
+	port, packets := startFakeBroker(t, 0)
+
+	settings := &MQTTSettings{Host: "127.0.0.1", Port: port, Username: "user", Password: "pass"}
+	client, err := dialMQTT(context.Background(), settings, "test-client")
+	if err != nil {
+		t.Fatalf("dialMQTT() error = %v", err)
+	}
+	if err := client.publish("slipstream/sensor/queue_total/state", []byte("3"), true); err != nil {
+		t.Fatalf("publish() error = %v", err)
+	}
+	client.close()
+
+	got := <-packets
+	if len(got) != 3 {
+		t.Fatalf("got %d packets, want 3", len(got))
+	}
+
+	connect := got[0].body
+	if !bytes.Equal(connect[:6], encodeString("MQTT")) {
+		t.Errorf("CONNECT protocol name = %q", connect[:6])
+	}
+	if flags := connect[7]; flags != mqttFlagCleanSession|mqttFlagUsername|mqttFlagPassword {
+		t.Errorf("CONNECT flags = %#x", flags)
+	}
+
+	if got[1].header != mqttPacketPublish|mqttFlagRetain {
+		t.Errorf("PUBLISH header = %#x, want retained publish", got[1].header)
+	}
+	wantBody := append(encodeString("slipstream/sensor/queue_total/state"), '3')
+	if !bytes.Equal(got[1].body, wantBody) {
+		t.Errorf("PUBLISH body = %q, want %q", got[1].body, wantBody)
+	}
+
+	if got[2].header != mqttPacketDisconnect {
+		t.Errorf("last packet header = %#x, want DISCONNECT", got[2].header)
+	}
+}
+
+func TestMQTTClient_ConnectionRefused(t *testing.T) {
+	port, _ := startFakeBroker(t, 4)
+
+	settings := &MQTTSettings{Host: "127.0.0.1", Port: port}
+	_, err := dialMQTT(context.Background(), settings, "test-client")
+	if !errors.Is(err, ErrMQTTConnectionRefused) {
+		t.Fatalf("dialMQTT() error = %v, want ErrMQTTConnectionRefused", err)
+	}
+}
+
+func TestMQTTSettings_Validate(t *testing.T) {
+	s := MQTTSettings{Enabled: true, Host: " broker ", TopicPrefix: "/home/slipstream/"}
+	if err := s.Validate(); err != nil {
+		t.Fatalf("Validate() error = %v", err)
+	}
+	if s.Host != "broker" || s.TopicPrefix != "home/slipstream" || s.Port != defaultMQTTPort {
+		t.Errorf("Validate() normalized to %+v", s)
+	}
+
+	missingHost := MQTTSettings{Enabled: true}
+	if err := missingHost.Validate(); !errors.Is(err, ErrInvalidSettings) {
+		t.Errorf("Validate() without host error = %v, want ErrInvalidSettings", err)
+	}
+
+	wildcard := MQTTSettings{TopicPrefix: "slip/#"}
+	if err := wildcard.Validate(); !errors.Is(err, ErrInvalidSettings) {
+		t.Errorf("Validate() with wildcard error = %v, want ErrInvalidSettings", err)
+	}
+}
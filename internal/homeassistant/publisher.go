@@ -0,0 +1,155 @@
+package homeassistant
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+const mqttClientID = "slipstream"
+
+type discoveryDevice struct {
+	Identifiers  []string `json:"identifiers"`
+	Name         string   `json:"name"`
+	Manufacturer string   `json:"manufacturer"`
+}
+
+type discoveryConfig struct {
+	Name              string          `json:"name"`
+	UniqueID          string          `json:"unique_id"`
+	StateTopic        string          `json:"state_topic"`
+	AvailabilityTopic string          `json:"availability_topic"`
+	UnitOfMeasurement string          `json:"unit_of_measurement,omitempty"`
+	DeviceClass       string          `json:"device_class,omitempty"`
+	Icon              string          `json:"icon,omitempty"`
+	Device            discoveryDevice `json:"device"`
+}
+
+// PublishChanges publishes sensor states that changed since the last run.
+// It is a no-op when MQTT is disabled.
+func (s *Service) PublishChanges(ctx context.Context) error {
+	settings, err := s.GetMQTTSettings(ctx)
+	if err != nil {
+		return err
+	}
+	if !settings.Enabled {
+		return nil
+	}
+
+	summary, err := s.GetSummary(ctx)
+	if err != nil {
+		return err
+	}
+
+	changed := s.changedSensors(summary.Sensors())
+	s.mu.Lock()
+	sendDiscovery := settings.Discovery && !s.discoverySent
+	s.mu.Unlock()
+	if len(changed) == 0 && !sendDiscovery {
+		return nil
+	}
+
+	client, err := dialMQTT(ctx, settings, mqttClientID)
+	if err != nil {
+		return err
+	}
+	defer client.close()
+
+	if sendDiscovery {
+		if err := publishDiscovery(client, settings.TopicPrefix); err != nil {
+			return err
+		}
+	}
+	if err := client.publish(availabilityTopic(settings.TopicPrefix), []byte("online"), true); err != nil {
+		return err
+	}
+	for sensor, value := range changed {
+		if err := client.publish(stateTopic(settings.TopicPrefix, sensor), []byte(value), true); err != nil {
+			return fmt.Errorf("failed to publish %s: %w", sensor, err)
+		}
+	}
+
+	s.markPublished(changed, sendDiscovery)
+	s.logger.Debug().Int("sensors", len(changed)).Msg("Published Home Assistant state changes")
+	return nil
+}
+
+// TestMQTT verifies that a connection to the broker can be established.
+func (s *Service) TestMQTT(ctx context.Context, settings *MQTTSettings) error {
+	if err := settings.Validate(); err != nil {
+		return err
+	}
+	if settings.Host == "" {
+		return fmt.Errorf("%w: host is required", ErrInvalidSettings)
+	}
+	client, err := dialMQTT(ctx, settings, mqttClientID+"-test")
+	if err != nil {
+		return err
+	}
+	client.close()
+	return nil
+}
+
+func (s *Service) changedSensors(sensors map[string]any) map[string]string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	changed := make(map[string]string)
+	for sensor, value := range sensors {
+		formatted := fmt.Sprint(value)
+		if s.lastPublished[sensor] != formatted {
+			changed[sensor] = formatted
+		}
+	}
+	return changed
+}
+
+func (s *Service) markPublished(published map[string]string, discoverySent bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for sensor, value := range published {
+		s.lastPublished[sensor] = value
+	}
+	if discoverySent {
+		s.discoverySent = true
+	}
+}
+
+func publishDiscovery(client *mqttClient, prefix string) error {
+	objectID := strings.ReplaceAll(prefix, "/", "_")
+	device := discoveryDevice{
+		Identifiers:  []string{objectID},
+		Name:         "SlipStream",
+		Manufacturer: "SlipStream",
+	}
+	for _, def := range sensorDefinitions() {
+		cfg := discoveryConfig{
+			Name:              def.Name,
+			UniqueID:          objectID + "_" + def.ID,
+			StateTopic:        stateTopic(prefix, def.ID),
+			AvailabilityTopic: availabilityTopic(prefix),
+			UnitOfMeasurement: def.Unit,
+			DeviceClass:       def.DeviceClass,
+			Icon:              def.Icon,
+			Device:            device,
+		}
+		payload, err := json.Marshal(cfg)
+		if err != nil {
+			return err
+		}
+		topic := fmt.Sprintf("%s/sensor/%s_%s/config", discoveryPrefix, objectID, def.ID)
+		if err := client.publish(topic, payload, true); err != nil {
+			return fmt.Errorf("failed to publish discovery for %s: %w", def.ID, err)
+		}
+	}
+	return nil
+}
+
+func stateTopic(prefix, sensor string) string {
+	return prefix + "/sensor/" + sensor + "/state"
+}
+
+func availabilityTopic(prefix string) string {
+	return prefix + "/status"
+}
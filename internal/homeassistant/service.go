@@ -0,0 +1,134 @@
+// Package homeassistant exposes compact state summaries for Home Assistant
+// REST sensors and optionally publishes state changes over MQTT.
+package homeassistant
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog"
+
+	"github.com/slipstream/slipstream/internal/database/sqlc"
+	"github.com/slipstream/slipstream/internal/downloader"
+	"github.com/slipstream/slipstream/internal/health"
+)
+
+const mediaStatusFailed = "failed"
+
+// QueueProvider returns the current download queue.
+type QueueProvider interface {
+	GetQueue(ctx context.Context) (*downloader.QueueResponse, error)
+}
+
+// HealthProvider returns the current health summary.
+type HealthProvider interface {
+	GetSummary() *health.HealthSummary
+}
+
+// Service builds Home Assistant summaries and publishes them over MQTT.
+type Service struct {
+	queries *sqlc.Queries
+	queue   QueueProvider
+	health  HealthProvider
+	logger  *zerolog.Logger
+
+	mu            sync.Mutex
+	lastPublished map[string]string
+	discoverySent bool
+}
+
+// NewService creates a new Home Assistant integration service.
+func NewService(db *sql.DB, downloaderSvc *downloader.Service, healthSvc *health.Service, logger *zerolog.Logger) *Service {
+	subLogger := logger.With().Str("component", "homeassistant").Logger()
+	return &Service{
+		queries:       sqlc.New(db),
+		queue:         downloaderSvc,
+		health:        healthSvc,
+		logger:        &subLogger,
+		lastPublished: make(map[string]string),
+	}
+}
+
+// SetDB updates the database connection used by this service.
+func (s *Service) SetDB(db *sql.DB) {
+	s.queries = sqlc.New(db)
+	s.resetPublishedState()
+}
+
+// GetSummary returns the current summary snapshot.
+func (s *Service) GetSummary(ctx context.Context) (*Summary, error) {
+	summary := &Summary{UpdatedAt: time.Now().UTC()}
+
+	if err := s.fillMediaCounts(ctx, summary); err != nil {
+		return nil, err
+	}
+
+	queue, err := s.queue.GetQueue(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get queue: %w", err)
+	}
+	summary.Queue = summarizeQueue(queue.Items)
+	summary.Health = summarizeHealth(s.health.GetSummary())
+
+	return summary, nil
+}
+
+func (s *Service) fillMediaCounts(ctx context.Context, summary *Summary) error {
+	var err error
+	if summary.Missing.Movies, err = s.queries.CountMissingMovies(ctx); err != nil {
+		return fmt.Errorf("failed to count missing movies: %w", err)
+	}
+	if summary.Missing.Episodes, err = s.queries.CountMissingEpisodes(ctx); err != nil {
+		return fmt.Errorf("failed to count missing episodes: %w", err)
+	}
+	if summary.Failed.Movies, err = s.queries.CountMoviesByStatus(ctx, mediaStatusFailed); err != nil {
+		return fmt.Errorf("failed to count failed movies: %w", err)
+	}
+	if summary.Failed.Episodes, err = s.queries.CountEpisodesByStatus(ctx, mediaStatusFailed); err != nil {
+		return fmt.Errorf("failed to count failed episodes: %w", err)
+	}
+	return nil
+}
+
+func summarizeQueue(items []downloader.QueueItem) QueueSummary {
+	summary := QueueSummary{Total: len(items)}
+	for i := range items {
+		switch items[i].Status {
+		case "downloading":
+			summary.Downloading++
+			summary.DownloadSpeed += items[i].DownloadSpeed
+		case "queued":
+			summary.Queued++
+		case "paused":
+			summary.Paused++
+		case "failed", "warning":
+			summary.Failed++
+		}
+	}
+	return summary
+}
+
+func summarizeHealth(hs *health.HealthSummary) HealthSummary {
+	result := HealthSummary{Status: HealthOK}
+	for _, cat := range hs.Categories {
+		result.Warnings += cat.Warning
+		result.Errors += cat.Error
+	}
+	switch {
+	case result.Errors > 0:
+		result.Status = HealthError
+	case result.Warnings > 0:
+		result.Status = HealthWarning
+	}
+	return result
+}
+
+func (s *Service) resetPublishedState() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.lastPublished = make(map[string]string)
+	s.discoverySent = false
+}
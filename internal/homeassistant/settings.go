@@ -0,0 +1,100 @@
+package homeassistant
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/slipstream/slipstream/internal/database/sqlc"
+)
+
+const (
+	settingsKey        = "homeassistant_mqtt_settings"
+	defaultMQTTPort    = 1883
+	defaultTopicPrefix = "slipstream"
+	discoveryPrefix    = "homeassistant"
+)
+
+var ErrInvalidSettings = errors.New("invalid MQTT settings")
+
+// MQTTSettings configures optional MQTT publishing of state changes.
+type MQTTSettings struct {
+	Enabled     bool   `json:"enabled"`
+	Host        string `json:"host"`
+	Port        int    `json:"port"`
+	Username    string `json:"username"`
+	Password    string `json:"password"`
+	UseTLS      bool   `json:"useTls"`
+	TopicPrefix string `json:"topicPrefix"`
+	Discovery   bool   `json:"discovery"` // Publish Home Assistant MQTT discovery configs
+}
+
+// DefaultMQTTSettings returns the settings used when none are saved.
+func DefaultMQTTSettings() MQTTSettings {
+	return MQTTSettings{
+		Port:        defaultMQTTPort,
+		TopicPrefix: defaultTopicPrefix,
+		Discovery:   true,
+	}
+}
+
+// Validate normalizes and checks the settings.
+func (m *MQTTSettings) Validate() error {
+	m.Host = strings.TrimSpace(m.Host)
+	m.TopicPrefix = strings.Trim(strings.TrimSpace(m.TopicPrefix), "/")
+	if m.TopicPrefix == "" {
+		m.TopicPrefix = defaultTopicPrefix
+	}
+	if m.Port == 0 {
+		m.Port = defaultMQTTPort
+	}
+	if m.Port < 1 || m.Port > 65535 {
+		return fmt.Errorf("%w: port must be between 1 and 65535", ErrInvalidSettings)
+	}
+	if strings.ContainsAny(m.TopicPrefix, "#+") {
+		return fmt.Errorf("%w: topic prefix must not contain wildcards", ErrInvalidSettings)
+	}
+	if m.Enabled && m.Host == "" {
+		return fmt.Errorf("%w: host is required when MQTT is enabled", ErrInvalidSettings)
+	}
+	return nil
+}
+
+// GetMQTTSettings returns the saved MQTT settings, or defaults if none exist.
+func (s *Service) GetMQTTSettings(ctx context.Context) (*MQTTSettings, error) {
+	settings := DefaultMQTTSettings()
+	row, err := s.queries.GetSetting(ctx, settingsKey)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return &settings, nil
+		}
+		return nil, err
+	}
+	if err := json.Unmarshal([]byte(row.Value), &settings); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal MQTT settings: %w", err)
+	}
+	return &settings, nil
+}
+
+// UpdateMQTTSettings validates and persists MQTT settings.
+// Previously published state is discarded so the next run republishes everything.
+func (s *Service) UpdateMQTTSettings(ctx context.Context, settings *MQTTSettings) error {
+	if err := settings.Validate(); err != nil {
+		return err
+	}
+	data, err := json.Marshal(settings)
+	if err != nil {
+		return err
+	}
+	if _, err := s.queries.SetSetting(ctx, sqlc.SetSettingParams{
+		Key:   settingsKey,
+		Value: string(data),
+	}); err != nil {
+		return err
+	}
+	s.resetPublishedState()
+	return nil
+}
@@ -0,0 +1,112 @@
+package homeassistant
+
+import "time"
+
+// Sensor identifiers exposed over REST and MQTT.
+const (
+	SensorMissingMovies   = "missing_movies"
+	SensorMissingEpisodes = "missing_episodes"
+	SensorFailedMovies    = "failed_movies"
+	SensorFailedEpisodes  = "failed_episodes"
+	SensorQueueTotal      = "queue_total"
+	SensorQueueActive     = "queue_downloading"
+	SensorQueueFailed     = "queue_failed"
+	SensorDownloadSpeed   = "download_speed"
+	SensorHealthStatus    = "health_status"
+	SensorHealthIssues    = "health_issues"
+)
+
+// Health states reported by the health sensor.
+const (
+	HealthOK      = "ok"
+	HealthWarning = "warning"
+	HealthError   = "error"
+)
+
+// MediaCounts holds per-media-type counts.
+type MediaCounts struct {
+	Movies   int64 `json:"movies"`
+	Episodes int64 `json:"episodes"`
+}
+
+// QueueSummary holds aggregate download queue state.
+type QueueSummary struct {
+	Total         int   `json:"total"`
+	Downloading   int   `json:"downloading"`
+	Queued        int   `json:"queued"`
+	Paused        int   `json:"paused"`
+	Failed        int   `json:"failed"`
+	DownloadSpeed int64 `json:"downloadSpeed"` // bytes/sec across all clients
+}
+
+// HealthSummary holds the overall health state.
+type HealthSummary struct {
+	Status   string `json:"status"`
+	Warnings int    `json:"warnings"`
+	Errors   int    `json:"errors"`
+}
+
+// Summary is a compact snapshot of SlipStream state designed for Home Assistant REST sensors.
+type Summary struct {
+	Missing   MediaCounts   `json:"missing"`
+	Failed    MediaCounts   `json:"failed"`
+	Queue     QueueSummary  `json:"queue"`
+	Health    HealthSummary `json:"health"`
+	UpdatedAt time.Time     `json:"updatedAt"`
+}
+
+// Sensors flattens the summary into sensor ID → state pairs.
+func (s *Summary) Sensors() map[string]any {
+	return map[string]any{
+		SensorMissingMovies:   s.Missing.Movies,
+		SensorMissingEpisodes: s.Missing.Episodes,
+		SensorFailedMovies:    s.Failed.Movies,
+		SensorFailedEpisodes:  s.Failed.Episodes,
+		SensorQueueTotal:      s.Queue.Total,
+		SensorQueueActive:     s.Queue.Downloading,
+		SensorQueueFailed:     s.Queue.Failed,
+		SensorDownloadSpeed:   s.Queue.DownloadSpeed,
+		SensorHealthStatus:    s.Health.Status,
+		SensorHealthIssues:    s.Health.Warnings + s.Health.Errors,
+	}
+}
+
+// SensorState is the REST response for a single sensor.
+type SensorState struct {
+	Sensor string `json:"sensor"`
+	State  any    `json:"state"`
+}
+
+// sensorDefinition describes a sensor for MQTT discovery.
+type sensorDefinition struct {
+	ID          string
+	Name        string
+	Unit        string
+	DeviceClass string
+	Icon        string
+}
+
+func sensorDefinitions() []sensorDefinition {
+	return []sensorDefinition{
+		{ID: SensorMissingMovies, Name: "Missing Movies", Icon: "mdi:movie-off"},
+		{ID: SensorMissingEpisodes, Name: "Missing Episodes", Icon: "mdi:television-off"},
+		{ID: SensorFailedMovies, Name: "Failed Movies", Icon: "mdi:movie-remove"},
+		{ID: SensorFailedEpisodes, Name: "Failed Episodes", Icon: "mdi:television-classic-off"},
+		{ID: SensorQueueTotal, Name: "Queue", Icon: "mdi:download-multiple"},
+		{ID: SensorQueueActive, Name: "Downloading", Icon: "mdi:download"},
+		{ID: SensorQueueFailed, Name: "Queue Failed", Icon: "mdi:download-off"},
+		{ID: SensorDownloadSpeed, Name: "Download Speed", Unit: "B/s", DeviceClass: "data_rate"},
+		{ID: SensorHealthStatus, Name: "Health", Icon: "mdi:heart-pulse"},
+		{ID: SensorHealthIssues, Name: "Health Issues", Icon: "mdi:alert"},
+	}
+}
+
+// IsValidSensor reports whether id names a known sensor.
+func IsValidSensor(id string) bool {
+	for _, def := range sensorDefinitions() {
+		if def.ID == id {
+			return true
+		}
+	}
+	return false
+}
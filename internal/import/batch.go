@@ -0,0 +1,53 @@
+package importer
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+)
+
+// BatchImportItem is one file of a batch manual import with its confirmed match.
+type BatchImportItem struct {
+	SourcePath   string
+	Match        *LibraryMatch
+	TargetSlotID *int64
+}
+
+// BatchImportOutcome is the result of importing one file of a batch.
+type BatchImportOutcome struct {
+	Result *ImportResult
+	Err    error
+}
+
+// ProcessManualImportBatch imports the files one after another as a single
+// job, so clients follow one job ID through the whole batch. Outcomes are in
+// item order and a failed file does not stop the batch.
+func (s *Service) ProcessManualImportBatch(ctx context.Context, items []BatchImportItem) (jobID string, outcomes []BatchImportOutcome) {
+	jobID = uuid.NewString()
+	outcomes = make([]BatchImportOutcome, len(items))
+	for i, item := range items {
+		if err := ctx.Err(); err != nil {
+			outcomes[i] = BatchImportOutcome{Err: err}
+			continue
+		}
+
+		if err := s.populateRootFolder(ctx, item.Match, item.TargetSlotID); err != nil {
+			outcomes[i] = BatchImportOutcome{Err: fmt.Errorf("failed to determine root folder: %w", err)}
+			continue
+		}
+
+		job := ImportJob{
+			ID:             jobID,
+			SourcePath:     item.SourcePath,
+			Manual:         true,
+			ConfirmedMatch: item.Match,
+			TargetSlotID:   item.TargetSlotID,
+		}
+		result, err := s.runManualImport(ctx, job)
+		outcomes[i] = BatchImportOutcome{Result: result, Err: err}
+	}
+
+	s.logger.Info().Str("jobId", jobID).Int("files", len(items)).Msg("Batch manual import finished")
+	return jobID, outcomes
+}
@@ -0,0 +1,76 @@
+package importer
+
+import (
+	"context"
+	"testing"
+
+	"github.com/slipstream/slipstream/internal/testutil"
+)
+
+func TestProcessManualImportBatch_ContinuesAfterFailure(t *testing.T) {
+	tdb := testutil.NewTestDB(t)
+	defer tdb.Close()
+
+	svc := NewService(tdb.Conn, nil, nil, nil, nil, nil, nil, nil, Config{}, &tdb.Logger, nil, nil, nil, nil, nil)
+
+	items := []BatchImportItem{
+		{SourcePath: "/downloads/a.mkv", Match: &LibraryMatch{MediaType: mediaTypeMovie}},
+		{SourcePath: "/downloads/b.mkv", Match: &LibraryMatch{MediaType: mediaTypeMovie}},
+	}
+
+	jobID, outcomes := svc.ProcessManualImportBatch(context.Background(), items)
+	if jobID == "" {
+		t.Error("expected a job ID")
+	}
+	if len(outcomes) != len(items) {
+		t.Fatalf("len(outcomes) = %d, want %d", len(outcomes), len(items))
+	}
+	for i, outcome := range outcomes {
+		if outcome.Err == nil {
+			t.Errorf("outcomes[%d].Err = nil, want root folder error", i)
+		}
+	}
+}
+
+func TestProcessManualImportBatch_CanceledContext(t *testing.T) {
+	tdb := testutil.NewTestDB(t)
+	defer tdb.Close()
+
+	svc := NewService(tdb.Conn, nil, nil, nil, nil, nil, nil, nil, Config{}, &tdb.Logger, nil, nil, nil, nil, nil)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, outcomes := svc.ProcessManualImportBatch(ctx, []BatchImportItem{
+		{SourcePath: "/downloads/a.mkv", Match: &LibraryMatch{MediaType: mediaTypeMovie}},
+	})
+	if outcomes[0].Err != context.Canceled {
+		t.Errorf("Err = %v, want context.Canceled", outcomes[0].Err)
+	}
+}
+
+func TestValidateManualImportRequest(t *testing.T) {
+	unknownQuality := 9999
+	knownQuality := 1
+
+	tests := []struct {
+		name string
+		req  ManualImportRequest
+		want string
+	}{
+		{"valid", ManualImportRequest{Path: "/a.mkv", MediaType: mediaTypeMovie, MediaID: 1}, ""},
+		{"valid with quality", ManualImportRequest{Path: "/a.mkv", MediaType: mediaTypeMovie, MediaID: 1, QualityID: &knownQuality}, ""},
+		{"missing path", ManualImportRequest{MediaType: mediaTypeMovie, MediaID: 1}, "path is required"},
+		{"bad media type", ManualImportRequest{Path: "/a.mkv", MediaType: "series", MediaID: 1}, "mediaType must be 'movie' or 'episode'"},
+		{"missing media id", ManualImportRequest{Path: "/a.mkv", MediaType: mediaTypeEpisode}, "mediaId is required"},
+		{"unknown quality", ManualImportRequest{Path: "/a.mkv", MediaType: mediaTypeMovie, MediaID: 1, QualityID: &unknownQuality}, "unknown qualityId"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := validateManualImportRequest(nil, &tt.req); got != tt.want {
+				t.Errorf("validateManualImportRequest() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
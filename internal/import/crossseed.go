@@ -0,0 +1,42 @@
+package importer
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/slipstream/slipstream/internal/indexer/grab"
+)
+
+const crossSeedTimeout = 5 * time.Minute
+
+// crossSeed cross-seeds the torrent behind an automatic import in the
+// background, once per download however many files it imports. Only
+// downloads still seeding qualify: their data stays in place in the client,
+// where the cross-seeded torrents are pointed.
+func (s *Service) crossSeed(mapping *DownloadMapping) {
+	if s.crossSeeder == nil || mapping == nil || !mapping.Seeding || mapping.DownloadClientID == 0 {
+		return
+	}
+	s.mu.Lock()
+	if s.crossSeeds[mapping.ID] {
+		s.mu.Unlock()
+		return
+	}
+	s.crossSeeds[mapping.ID] = true
+	s.mu.Unlock()
+
+	var sourceIndexerID int64
+	if mapping.IndexerID != nil {
+		sourceIndexerID = *mapping.IndexerID
+	}
+
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), crossSeedTimeout)
+		defer cancel()
+		_, err := s.crossSeeder.CrossSeedDownload(ctx, mapping.DownloadClientID, mapping.DownloadID, sourceIndexerID)
+		if err != nil && !errors.Is(err, grab.ErrCrossSeedDisabled) {
+			s.logger.Warn().Err(err).Str("downloadId", mapping.DownloadID).Msg("Cross-seed failed")
+		}
+	}()
+}
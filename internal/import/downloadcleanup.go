@@ -0,0 +1,198 @@
+package importer
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/slipstream/slipstream/internal/database/sqlc"
+	"github.com/slipstream/slipstream/internal/module/parseutil"
+)
+
+const downloadCleanupSettingKey = "import_download_cleanup"
+
+// defaultJunkExtensions are the leftovers removed with a download folder.
+var defaultJunkExtensions = []string{
+	".nfo", ".txt", ".exe", ".url", ".lnk", ".jpg", ".jpeg", ".png",
+	".sfv", ".md5", ".srr", ".par2", ".nzb", ".db",
+}
+
+// DownloadCleanupSettings configures removal of a download's folder once all
+// of its video files are imported. A folder holding anything besides imported
+// video, samples and files with a junk extension is left alone.
+type DownloadCleanupSettings struct {
+	Enabled        bool     `json:"enabled"`
+	JunkExtensions []string `json:"junkExtensions"`
+}
+
+// DownloadCleanup returns the download folder cleanup settings.
+func (s *Service) DownloadCleanup(ctx context.Context) (*DownloadCleanupSettings, error) {
+	settings := &DownloadCleanupSettings{JunkExtensions: defaultJunkExtensions}
+	row, err := s.queries.GetSetting(ctx, downloadCleanupSettingKey)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return settings, nil
+		}
+		return nil, err
+	}
+	if err := json.Unmarshal([]byte(row.Value), settings); err != nil {
+		return nil, err
+	}
+	return settings, nil
+}
+
+// SetDownloadCleanup saves the download folder cleanup settings. An empty
+// junk extension list restores the defaults.
+func (s *Service) SetDownloadCleanup(ctx context.Context, input *DownloadCleanupSettings) (*DownloadCleanupSettings, error) {
+	settings := &DownloadCleanupSettings{
+		Enabled:        input.Enabled,
+		JunkExtensions: normalizeJunkExtensions(input.JunkExtensions),
+	}
+	if len(settings.JunkExtensions) == 0 {
+		settings.JunkExtensions = defaultJunkExtensions
+	}
+	encoded, err := json.Marshal(settings)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := s.queries.SetSetting(ctx, sqlc.SetSettingParams{Key: downloadCleanupSettingKey, Value: string(encoded)}); err != nil {
+		return nil, err
+	}
+	return settings, nil
+}
+
+// cleanupDownloadFolder removes the folder of an automatically imported
+// download once nothing in it is left to import or seed. Called after each
+// successful import, so the last file of a download triggers the removal.
+// The download is removed from its client first, so the client never holds
+// a torrent whose data is gone.
+func (s *Service) cleanupDownloadFolder(ctx context.Context, mapping *DownloadMapping, sourcePath string) {
+	if mapping == nil || mapping.DownloadPath == "" || mapping.Seeding || s.downloader == nil {
+		return
+	}
+	settings, err := s.DownloadCleanup(ctx)
+	if err != nil {
+		s.logger.Warn().Err(err).Msg("Failed to load download cleanup settings")
+		return
+	}
+	if !settings.Enabled {
+		return
+	}
+
+	dir := filepath.Clean(mapping.DownloadPath)
+	// The client's download folder, or a category folder above it, is
+	// shared with other downloads and must never be removed. A download
+	// without a name resolves to that folder itself.
+	if mapping.DownloadDir == "" || pathWithin(filepath.Clean(mapping.DownloadDir), dir) {
+		return
+	}
+	// Single-file downloads sit directly in the client's download folder.
+	if info, err := os.Stat(dir); err != nil || !info.IsDir() {
+		return
+	}
+	if s.hasPendingImportsUnder(dir, sourcePath) {
+		return
+	}
+
+	videoSettings := s.loadSettingsOrNil(ctx)
+	if videoSettings == nil {
+		defaults := DefaultImportSettings()
+		videoSettings = &defaults
+	}
+	if !s.downloadFolderDisposable(ctx, dir, videoSettings, settings.JunkExtensions) {
+		return
+	}
+
+	if err := s.downloader.RemoveDownload(ctx, mapping.DownloadClientID, mapping.DownloadID, false); err != nil {
+		s.logger.Warn().Err(err).Str("downloadId", mapping.DownloadID).Msg("Failed to remove download from client, keeping its folder")
+		return
+	}
+	if err := os.RemoveAll(dir); err != nil {
+		s.logger.Warn().Err(err).Str("path", dir).Msg("Failed to remove download folder")
+		return
+	}
+	s.logger.Info().
+		Str("path", dir).
+		Str("downloadId", mapping.DownloadID).
+		Msg("Removed download folder after import")
+}
+
+// hasPendingImportsUnder reports whether a file below dir other than the
+// current one is still queued or importing.
+func (s *Service) hasPendingImportsUnder(dir, current string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for path := range s.processing {
+		if path != current && pathWithin(filepath.Clean(path), dir) {
+			return true
+		}
+	}
+	return false
+}
+
+// downloadFolderDisposable reports whether every file below dir is an
+// imported video, a sample or junk.
+func (s *Service) downloadFolderDisposable(ctx context.Context, dir string, settings *ImportSettings, junk []string) bool {
+	disposable := true
+	err := filepath.WalkDir(dir, func(path string, d os.DirEntry, walkErr error) error {
+		if walkErr != nil {
+			return walkErr
+		}
+		if d.IsDir() {
+			return nil
+		}
+		name := d.Name()
+		ext := strings.ToLower(filepath.Ext(name))
+		switch {
+		case settings.IsValidExtension(ext):
+			if !parseutil.IsSampleFile(name) && !s.importedFromSource(ctx, path) {
+				disposable = false
+			}
+		case !isJunkExtension(ext, junk):
+			disposable = false
+		}
+		if !disposable {
+			return filepath.SkipAll
+		}
+		return nil
+	})
+	return err == nil && disposable
+}
+
+// importedFromSource reports whether a library file was imported from path.
+func (s *Service) importedFromSource(ctx context.Context, path string) bool {
+	nullPath := toNullString(path)
+	if imported, err := s.queries.IsOriginalPathImportedMovie(ctx, nullPath); err == nil && imported != 0 {
+		return true
+	}
+	imported, err := s.queries.IsOriginalPathImportedEpisode(ctx, nullPath)
+	return err == nil && imported != 0
+}
+
+func isJunkExtension(ext string, junk []string) bool {
+	for _, j := range junk {
+		if ext == j {
+			return true
+		}
+	}
+	return false
+}
+
+func normalizeJunkExtensions(exts []string) []string {
+	normalized := make([]string, 0, len(exts))
+	for _, ext := range exts {
+		ext = strings.ToLower(strings.TrimSpace(ext))
+		if ext == "" {
+			continue
+		}
+		if !strings.HasPrefix(ext, ".") {
+			ext = "." + ext
+		}
+		normalized = append(normalized, ext)
+	}
+	return normalized
+}
@@ -0,0 +1,170 @@
+package importer
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+
+	"github.com/slipstream/slipstream/internal/downloader"
+	"github.com/slipstream/slipstream/internal/testutil"
+)
+
+func TestDownloadCleanupSettings(t *testing.T) {
+	tdb := testutil.NewTestDB(t)
+	defer tdb.Close()
+
+	ctx := context.Background()
+	svc := NewService(tdb.Conn, nil, nil, nil, nil, nil, nil, nil, Config{}, &tdb.Logger, nil, nil, nil, nil, nil)
+
+	settings, err := svc.DownloadCleanup(ctx)
+	if err != nil {
+		t.Fatalf("DownloadCleanup() error = %v", err)
+	}
+	if settings.Enabled || !slices.Equal(settings.JunkExtensions, defaultJunkExtensions) {
+		t.Errorf("default settings = %+v, want disabled with default junk", settings)
+	}
+
+	if _, err := svc.SetDownloadCleanup(ctx, &DownloadCleanupSettings{Enabled: true, JunkExtensions: []string{"NFO", " .txt ", ""}}); err != nil {
+		t.Fatalf("SetDownloadCleanup() error = %v", err)
+	}
+	settings, err = svc.DownloadCleanup(ctx)
+	if err != nil {
+		t.Fatalf("DownloadCleanup() error = %v", err)
+	}
+	if !settings.Enabled || !slices.Equal(settings.JunkExtensions, []string{".nfo", ".txt"}) {
+		t.Errorf("saved settings = %+v, want enabled with .nfo and .txt", settings)
+	}
+}
+
+// newCleanupService returns an import service with download cleanup enabled
+// and the ID of a mock download client to remove downloads from.
+func newCleanupService(t *testing.T, tdb *testutil.TestDB) (*Service, int64) {
+	t.Helper()
+	ctx := context.Background()
+	downloaderSvc := downloader.NewService(tdb.Conn, &tdb.Logger, nil, nil, nil, nil)
+	client, err := downloaderSvc.Create(ctx, &downloader.CreateClientInput{Name: "Mock", Type: "mock", Host: "localhost", Port: 1, Enabled: true})
+	if err != nil {
+		t.Fatalf("Create download client error = %v", err)
+	}
+	svc := NewService(tdb.Conn, downloaderSvc, nil, nil, nil, nil, nil, nil, Config{}, &tdb.Logger, nil, nil, nil, nil, nil)
+	if _, err := svc.SetDownloadCleanup(ctx, &DownloadCleanupSettings{Enabled: true}); err != nil {
+		t.Fatalf("SetDownloadCleanup() error = %v", err)
+	}
+	return svc, client.ID
+}
+
+func TestCleanupDownloadFolder(t *testing.T) {
+	tdb := testutil.NewTestDB(t)
+	defer tdb.Close()
+
+	ctx := context.Background()
+	svc, clientID := newCleanupService(t, tdb)
+
+	newDownload := func(t *testing.T, files ...string) string {
+		t.Helper()
+		dir := filepath.Join(t.TempDir(), "Movie.2020.1080p.WEB-DL-GRP")
+		for _, f := range files {
+			path := filepath.Join(dir, f)
+			if err := os.MkdirAll(filepath.Dir(path), 0o750); err != nil {
+				t.Fatal(err)
+			}
+			if err := os.WriteFile(path, []byte("x"), 0o600); err != nil {
+				t.Fatal(err)
+			}
+		}
+		return dir
+	}
+
+	tests := []struct {
+		name    string
+		files   []string
+		seeding bool
+		removed bool
+	}{
+		{"only junk left", []string{"movie.nfo", "readme.txt", "Sample/movie-sample.mkv"}, false, true},
+		{"still seeding", []string{"movie.nfo"}, true, false},
+		{"video not imported", []string{"movie.nfo", "movie.mkv"}, false, false},
+		{"unknown leftover", []string{"movie.nfo", "subs.srt"}, false, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := newDownload(t, tt.files...)
+			mapping := &DownloadMapping{
+				DownloadClientID: clientID,
+				DownloadID:       "abc",
+				DownloadDir:      filepath.Dir(dir),
+				DownloadPath:     dir,
+				Seeding:          tt.seeding,
+			}
+
+			svc.cleanupDownloadFolder(ctx, mapping, filepath.Join(dir, "movie.mkv"))
+
+			_, err := os.Stat(dir)
+			if removed := os.IsNotExist(err); removed != tt.removed {
+				t.Errorf("removed = %v, want %v", removed, tt.removed)
+			}
+		})
+	}
+}
+
+func TestCleanupDownloadFolder_PendingImport(t *testing.T) {
+	tdb := testutil.NewTestDB(t)
+	defer tdb.Close()
+
+	ctx := context.Background()
+	svc, clientID := newCleanupService(t, tdb)
+
+	dir := filepath.Join(t.TempDir(), "Movie.2020.1080p.WEB-DL-GRP")
+	if err := os.MkdirAll(dir, 0o750); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "movie.nfo"), []byte("x"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	svc.processing[filepath.Join(dir, "other.mkv")] = true
+
+	mapping := &DownloadMapping{DownloadClientID: clientID, DownloadID: "abc", DownloadDir: filepath.Dir(dir), DownloadPath: dir}
+	svc.cleanupDownloadFolder(ctx, mapping, filepath.Join(dir, "movie.mkv"))
+	if _, err := os.Stat(dir); err != nil {
+		t.Errorf("folder with a pending import was removed: %v", err)
+	}
+}
+
+func TestCleanupDownloadFolder_SharedFolder(t *testing.T) {
+	tdb := testutil.NewTestDB(t)
+	defer tdb.Close()
+
+	ctx := context.Background()
+	svc, clientID := newCleanupService(t, tdb)
+
+	downloads := filepath.Join(t.TempDir(), "downloads")
+	category := filepath.Join(downloads, "movies")
+	if err := os.MkdirAll(category, 0o750); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(category, "movie.nfo"), []byte("x"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name         string
+		downloadDir  string
+		downloadPath string
+	}{
+		{"download without a name", category, category},
+		{"path above the download folder", category, downloads},
+		{"download folder unknown", "", category},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mapping := &DownloadMapping{DownloadClientID: clientID, DownloadID: "abc", DownloadDir: tt.downloadDir, DownloadPath: tt.downloadPath}
+			svc.cleanupDownloadFolder(ctx, mapping, filepath.Join(category, "movie.mkv"))
+			if _, err := os.Stat(category); err != nil {
+				t.Errorf("shared download folder was removed: %v", err)
+			}
+		})
+	}
+}
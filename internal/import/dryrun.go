@@ -0,0 +1,143 @@
+package importer
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"path/filepath"
+
+	"github.com/slipstream/slipstream/internal/database/sqlc"
+)
+
+// DryRunResult describes what the automatic import of a completed download
+// would do, without touching the filesystem or the library.
+type DryRunResult struct {
+	MappingID        int64        `json:"mappingId"`
+	DownloadClientID int64        `json:"downloadClientId"`
+	DownloadID       string       `json:"downloadId"`
+	DownloadPath     string       `json:"downloadPath"`
+	Files            []DryRunFile `json:"files"`
+}
+
+// DryRunFile is the pipeline outcome for a single file of the download.
+type DryRunFile struct {
+	Path            string  `json:"path"`
+	FileName        string  `json:"fileName"`
+	WouldImport     bool    `json:"wouldImport"`
+	RejectionReason string  `json:"rejectionReason,omitempty"`
+	MediaType       string  `json:"mediaType,omitempty"`
+	MovieID         *int64  `json:"movieId,omitempty"`
+	SeriesID        *int64  `json:"seriesId,omitempty"`
+	EpisodeIDs      []int64 `json:"episodeIds,omitempty"`
+	MatchSource     string  `json:"matchSource,omitempty"`
+	Confidence      float64 `json:"confidence,omitempty"`
+	DestinationPath string  `json:"destinationPath,omitempty"`
+	Quality         string  `json:"quality,omitempty"`
+	ExistingQuality string  `json:"existingQuality,omitempty"`
+	IsUpgrade       bool    `json:"isUpgrade"`
+	PreviousFile    string  `json:"previousFile,omitempty"`
+	TargetSlotID    *int64  `json:"targetSlotId,omitempty"`
+}
+
+// DryRunDownloadImport runs the match, quality, slot and destination logic of
+// the automatic import for a completed download and reports the outcome for
+// each video file. Nothing is moved, linked or recorded.
+func (s *Service) DryRunDownloadImport(ctx context.Context, clientID int64, downloadID string) (*DryRunResult, error) {
+	row, err := s.queries.GetDownloadMapping(ctx, sqlc.GetDownloadMappingParams{
+		ClientID:   clientID,
+		DownloadID: downloadID,
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrMappingNotFound
+		}
+		return nil, fmt.Errorf("failed to get download mapping: %w", err)
+	}
+	mapping := s.convertMapping(row)
+
+	downloadDir, downloadPath, err := s.getDownloadPath(ctx, mapping)
+	if err != nil {
+		return nil, err
+	}
+	mapping.DownloadDir = downloadDir
+	mapping.DownloadPath = downloadPath
+
+	files, err := s.findDownloadVideoFiles(ctx, downloadPath, mapping)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find video files: %w", err)
+	}
+
+	result := &DryRunResult{
+		MappingID:        mapping.ID,
+		DownloadClientID: mapping.DownloadClientID,
+		DownloadID:       mapping.DownloadID,
+		DownloadPath:     downloadPath,
+		Files:            make([]DryRunFile, 0, len(files)),
+	}
+	for _, file := range files {
+		job := ImportJob{
+			SourcePath:      file,
+			DownloadMapping: mapping,
+			DryRun:          true,
+		}
+		result.Files = append(result.Files, s.dryRunFile(ctx, job))
+	}
+	return result, nil
+}
+
+// dryRunFile mirrors processImport up to the point where the file would be
+// moved into the library.
+func (s *Service) dryRunFile(ctx context.Context, job ImportJob) DryRunFile {
+	result := &ImportResult{SourcePath: job.SourcePath}
+	settings := s.loadAndApplySettings(ctx)
+
+	var targetSlotID *int64
+	err := s.checkExclusion(ctx, job.SourcePath)
+	if err == nil {
+		err = s.prepareImport(ctx, job, result, settings)
+	}
+	if err == nil {
+		isMultiVersion := s.slots != nil && s.slots.IsMultiVersionEnabled(ctx)
+		targetSlotID, _, err = s.processSlotAssignment(ctx, job, result, isMultiVersion)
+	}
+	if err == nil {
+		err = s.applySlotNaming(ctx, job, result, targetSlotID)
+	}
+
+	file := DryRunFile{
+		Path:            job.SourcePath,
+		FileName:        filepath.Base(job.SourcePath),
+		WouldImport:     err == nil,
+		DestinationPath: result.DestinationPath,
+		IsUpgrade:       result.IsUpgrade,
+		PreviousFile:    result.PreviousFile,
+		TargetSlotID:    targetSlotID,
+	}
+	if err != nil {
+		file.RejectionReason = err.Error()
+	}
+	if result.Match != nil {
+		s.describeDryRunMatch(ctx, &file, job, result.Match)
+	}
+	return file
+}
+
+func (s *Service) describeDryRunMatch(ctx context.Context, file *DryRunFile, job ImportJob, match *LibraryMatch) {
+	file.MediaType = match.MediaType
+	file.MovieID = match.MovieID
+	file.SeriesID = match.SeriesID
+	file.EpisodeIDs = match.coveredEpisodeIDs()
+	file.MatchSource = match.Source
+	file.Confidence = match.Confidence
+
+	if match.CandidateQualityID == 0 {
+		s.resolveQualityID(ctx, match, job.SourcePath)
+	}
+	file.Quality = s.qualityNameFromID(match.CandidateQualityID)
+	file.ExistingQuality = s.qualityNameFromID(match.ExistingQualityID)
+	if !file.IsUpgrade && match.IsUpgrade {
+		file.IsUpgrade = true
+		file.PreviousFile = match.ExistingFile
+	}
+}
@@ -0,0 +1,47 @@
+package importer
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+
+	"github.com/slipstream/slipstream/internal/testutil"
+)
+
+func TestDryRunDownloadImport_MappingNotFound(t *testing.T) {
+	tdb := testutil.NewTestDB(t)
+	defer tdb.Close()
+
+	svc := NewService(tdb.Conn, nil, nil, nil, nil, nil, nil, nil, Config{}, &tdb.Logger, nil, nil, nil, nil, nil)
+
+	_, err := svc.DryRunDownloadImport(context.Background(), 1, "missing")
+	if !errors.Is(err, ErrMappingNotFound) {
+		t.Fatalf("DryRunDownloadImport() error = %v, want ErrMappingNotFound", err)
+	}
+}
+
+func TestRecordImportDecision_SkippedOnDryRun(t *testing.T) {
+	tdb := testutil.NewTestDB(t)
+	defer tdb.Close()
+
+	ctx := context.Background()
+	svc := NewService(tdb.Conn, nil, nil, nil, nil, nil, nil, nil, Config{}, &tdb.Logger, nil, nil, nil, nil, nil)
+
+	movieID := int64(1)
+	match := &LibraryMatch{MediaType: mediaTypeMovie, MovieID: &movieID}
+
+	svc.recordImportDecision(ctx, ImportJob{SourcePath: "/downloads/dry.mkv", DryRun: true}, "not_upgrade", match)
+	if _, err := svc.queries.GetImportDecision(ctx, "/downloads/dry.mkv"); !errors.Is(err, sql.ErrNoRows) {
+		t.Errorf("dry run recorded a decision: err = %v", err)
+	}
+
+	svc.recordImportDecision(ctx, ImportJob{SourcePath: "/downloads/real.mkv"}, "not_upgrade", match)
+	decision, err := svc.queries.GetImportDecision(ctx, "/downloads/real.mkv")
+	if err != nil {
+		t.Fatalf("GetImportDecision() error = %v", err)
+	}
+	if decision.Decision != "not_upgrade" {
+		t.Errorf("Decision = %q, want %q", decision.Decision, "not_upgrade")
+	}
+}
@@ -0,0 +1,232 @@
+package importer
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/slipstream/slipstream/internal/database/sqlc"
+	"github.com/slipstream/slipstream/internal/library/scanner"
+	"github.com/slipstream/slipstream/internal/module/titleutil"
+)
+
+// Import exclusion kinds.
+const (
+	ExclusionKindPath   = "path"
+	ExclusionKindFolder = "folder"
+	ExclusionKindTitle  = "title"
+)
+
+var (
+	ErrExclusionNotFound = errors.New("import exclusion not found")
+	ErrInvalidExclusion  = errors.New("invalid import exclusion")
+	ErrExcluded          = errors.New("file matches an import exclusion")
+)
+
+// ImportExclusion keeps automatic imports away from a path, any folder with
+// a given name, or releases of a title (and year, when non-zero). Manual
+// imports are not affected.
+type ImportExclusion struct {
+	ID        int64     `json:"id"`
+	Kind      string    `json:"kind"`
+	Value     string    `json:"value"`
+	Year      int       `json:"year,omitempty"`
+	Reason    string    `json:"reason,omitempty"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+// ImportExclusionInput configures an import exclusion.
+type ImportExclusionInput struct {
+	Kind   string `json:"kind"`
+	Value  string `json:"value"`
+	Year   int    `json:"year,omitempty"`
+	Reason string `json:"reason,omitempty"`
+}
+
+// ListExclusions returns all import exclusions.
+func (s *Service) ListExclusions(ctx context.Context) ([]*ImportExclusion, error) {
+	rows, err := s.queries.ListImportExclusions(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list import exclusions: %w", err)
+	}
+	exclusions := make([]*ImportExclusion, 0, len(rows))
+	for _, row := range rows {
+		exclusions = append(exclusions, rowToExclusion(row))
+	}
+	return exclusions, nil
+}
+
+// AddExclusion creates an exclusion, or updates the reason of an identical one.
+func (s *Service) AddExclusion(ctx context.Context, input *ImportExclusionInput) (*ImportExclusion, error) {
+	value, err := normalizeExclusionValue(input.Kind, input.Value)
+	if err != nil {
+		return nil, err
+	}
+	year := 0
+	if input.Kind == ExclusionKindTitle {
+		if input.Year < 0 {
+			return nil, fmt.Errorf("%w: year must not be negative", ErrInvalidExclusion)
+		}
+		year = input.Year
+	}
+
+	row, err := s.queries.CreateImportExclusion(ctx, sqlc.CreateImportExclusionParams{
+		Kind:   input.Kind,
+		Value:  value,
+		Year:   int64(year),
+		Reason: strings.TrimSpace(input.Reason),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to save import exclusion: %w", err)
+	}
+	return rowToExclusion(row), nil
+}
+
+// DeleteExclusion removes an import exclusion.
+func (s *Service) DeleteExclusion(ctx context.Context, id int64) error {
+	if _, err := s.queries.GetImportExclusion(ctx, id); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return ErrExclusionNotFound
+		}
+		return err
+	}
+	return s.queries.DeleteImportExclusion(ctx, id)
+}
+
+// loadExclusions returns the exclusions to apply. Lookup errors are logged
+// and treated as no exclusions so imports keep flowing.
+func (s *Service) loadExclusions(ctx context.Context) []*ImportExclusion {
+	exclusions, err := s.ListExclusions(ctx)
+	if err != nil {
+		s.logger.Warn().Err(err).Msg("Failed to load import exclusions")
+		return nil
+	}
+	return exclusions
+}
+
+// checkExclusion returns an ErrExcluded error naming the matching exclusion,
+// or nil when the file may be imported automatically.
+func (s *Service) checkExclusion(ctx context.Context, filePath string) error {
+	ex := findExclusion(filePath, s.loadExclusions(ctx))
+	if ex == nil {
+		return nil
+	}
+	return fmt.Errorf("%w: %s %q", ErrExcluded, ex.Kind, ex.Value)
+}
+
+// filterExcluded drops excluded files, logging each one.
+func (s *Service) filterExcluded(ctx context.Context, files []string) []string {
+	exclusions := s.loadExclusions(ctx)
+	if len(exclusions) == 0 {
+		return files
+	}
+	kept := make([]string, 0, len(files))
+	for _, file := range files {
+		if ex := findExclusion(file, exclusions); ex != nil {
+			s.logger.Info().
+				Str("file", file).
+				Str("kind", ex.Kind).
+				Str("value", ex.Value).
+				Msg("Skipping excluded file")
+			continue
+		}
+		kept = append(kept, file)
+	}
+	return kept
+}
+
+// findExclusion returns the first exclusion covering the file, or nil.
+func findExclusion(filePath string, exclusions []*ImportExclusion) *ImportExclusion {
+	cleaned := filepath.Clean(filePath)
+	var parsed *scanner.ParsedMedia
+	for _, ex := range exclusions {
+		switch ex.Kind {
+		case ExclusionKindPath:
+			if pathWithin(cleaned, ex.Value) {
+				return ex
+			}
+		case ExclusionKindFolder:
+			if hasFolderNamed(cleaned, ex.Value) {
+				return ex
+			}
+		case ExclusionKindTitle:
+			if parsed == nil {
+				parsed = scanner.ParsePath(cleaned)
+			}
+			if titleExcluded(ex, parsed) {
+				return ex
+			}
+		}
+	}
+	return nil
+}
+
+// titleExcluded reports whether the parsed release is the excluded title,
+// and of the excluded year when one is set.
+func titleExcluded(ex *ImportExclusion, parsed *scanner.ParsedMedia) bool {
+	return titleutil.NormalizeTitle(parsed.Title) == ex.Value && (ex.Year == 0 || parsed.Year == ex.Year)
+}
+
+// pathWithin reports whether p is root or lies below it.
+func pathWithin(p, root string) bool {
+	if p == root {
+		return true
+	}
+	if !strings.HasSuffix(root, string(filepath.Separator)) {
+		root += string(filepath.Separator)
+	}
+	return strings.HasPrefix(p, root)
+}
+
+// hasFolderNamed reports whether any directory of p is named name, ignoring case.
+func hasFolderNamed(p, name string) bool {
+	dirs := strings.Split(filepath.ToSlash(filepath.Dir(p)), "/")
+	for _, dir := range dirs {
+		if strings.EqualFold(dir, name) {
+			return true
+		}
+	}
+	return false
+}
+
+func normalizeExclusionValue(kind, value string) (string, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return "", fmt.Errorf("%w: value is required", ErrInvalidExclusion)
+	}
+	switch kind {
+	case ExclusionKindPath:
+		if !filepath.IsAbs(value) {
+			return "", fmt.Errorf("%w: path must be absolute", ErrInvalidExclusion)
+		}
+		return filepath.Clean(value), nil
+	case ExclusionKindFolder:
+		if strings.ContainsAny(value, `/\`) {
+			return "", fmt.Errorf("%w: folder must be a single folder name", ErrInvalidExclusion)
+		}
+		return value, nil
+	case ExclusionKindTitle:
+		normalized := titleutil.NormalizeTitle(value)
+		if normalized == "" {
+			return "", fmt.Errorf("%w: title is required", ErrInvalidExclusion)
+		}
+		return normalized, nil
+	default:
+		return "", fmt.Errorf("%w: kind must be path, folder or title", ErrInvalidExclusion)
+	}
+}
+
+func rowToExclusion(row *sqlc.ImportExclusion) *ImportExclusion {
+	return &ImportExclusion{
+		ID:        row.ID,
+		Kind:      row.Kind,
+		Value:     row.Value,
+		Year:      int(row.Year),
+		Reason:    row.Reason,
+		CreatedAt: row.CreatedAt,
+	}
+}
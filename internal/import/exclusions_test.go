@@ -0,0 +1,134 @@
+package importer
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/slipstream/slipstream/internal/library/scanner"
+	"github.com/slipstream/slipstream/internal/testutil"
+)
+
+func TestFindExclusion(t *testing.T) {
+	exclusions := []*ImportExclusion{
+		{ID: 1, Kind: ExclusionKindPath, Value: "/downloads/seeds"},
+		{ID: 2, Kind: ExclusionKindFolder, Value: "Junk"},
+	}
+
+	tests := []struct {
+		name string
+		path string
+		want int64
+	}{
+		{"under excluded path", "/downloads/seeds/Movie.2020.1080p/movie.mkv", 1},
+		{"path prefix is not a parent", "/downloads/seedsbox/Movie.2020.1080p.mkv", 0},
+		{"folder name ignores case", "/downloads/junk/Other.Movie.2019.mkv", 2},
+		{"file name is not a folder", "/downloads/Junk.mkv", 0},
+		{"unrelated", "/downloads/Tears.of.Steel.2012.1080p.mkv", 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got int64
+			if ex := findExclusion(tt.path, exclusions); ex != nil {
+				got = ex.ID
+			}
+			if got != tt.want {
+				t.Errorf("findExclusion(%q) = %d, want %d", tt.path, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTitleExcluded(t *testing.T) {
+	withYear := &ImportExclusion{Kind: ExclusionKindTitle, Value: "big buck bunny", Year: 2008}
+	anyYear := &ImportExclusion{Kind: ExclusionKindTitle, Value: "sintel"}
+
+	tests := []struct {
+		name   string
+		ex     *ImportExclusion
+		parsed scanner.ParsedMedia
+		want   bool
+	}{
+		{"title and year", withYear, scanner.ParsedMedia{Title: "Big Buck Bunny", Year: 2008}, true},
+		{"other year", withYear, scanner.ParsedMedia{Title: "Big Buck Bunny", Year: 2015}, false},
+		{"any year", anyYear, scanner.ParsedMedia{Title: "Sintel", Year: 2010}, true},
+		{"other title", anyYear, scanner.ParsedMedia{Title: "Tears of Steel", Year: 2012}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := titleExcluded(tt.ex, &tt.parsed); got != tt.want {
+				t.Errorf("titleExcluded() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAddExclusion_Validation(t *testing.T) {
+	tdb := testutil.NewTestDB(t)
+	defer tdb.Close()
+
+	ctx := context.Background()
+	svc := NewService(tdb.Conn, nil, nil, nil, nil, nil, nil, nil, Config{}, &tdb.Logger, nil, nil, nil, nil, nil)
+
+	invalid := []ImportExclusionInput{
+		{Kind: "series", Value: "x"},
+		{Kind: ExclusionKindPath, Value: "relative/dir"},
+		{Kind: ExclusionKindFolder, Value: "a/b"},
+		{Kind: ExclusionKindTitle, Value: "  "},
+		{Kind: ExclusionKindTitle, Value: "Sintel", Year: -1},
+	}
+	for _, input := range invalid {
+		if _, err := svc.AddExclusion(ctx, &input); !errors.Is(err, ErrInvalidExclusion) {
+			t.Errorf("AddExclusion(%+v) error = %v, want ErrInvalidExclusion", input, err)
+		}
+	}
+
+	ex, err := svc.AddExclusion(ctx, &ImportExclusionInput{Kind: ExclusionKindTitle, Value: "Big Buck Bunny!", Year: 2008, Reason: "seed"})
+	if err != nil {
+		t.Fatalf("AddExclusion() error = %v", err)
+	}
+	if ex.Value != "big buck bunny" {
+		t.Errorf("Value = %q, want normalized title", ex.Value)
+	}
+
+	again, err := svc.AddExclusion(ctx, &ImportExclusionInput{Kind: ExclusionKindTitle, Value: "big buck bunny", Year: 2008, Reason: "keep seeding"})
+	if err != nil {
+		t.Fatalf("AddExclusion() duplicate error = %v", err)
+	}
+	if again.ID != ex.ID || again.Reason != "keep seeding" {
+		t.Errorf("duplicate exclusion = %+v, want reason update of ID %d", again, ex.ID)
+	}
+
+	if err := svc.DeleteExclusion(ctx, ex.ID); err != nil {
+		t.Fatalf("DeleteExclusion() error = %v", err)
+	}
+	if err := svc.DeleteExclusion(ctx, ex.ID); !errors.Is(err, ErrExclusionNotFound) {
+		t.Errorf("DeleteExclusion() second error = %v, want ErrExclusionNotFound", err)
+	}
+}
+
+func TestFilterExcluded(t *testing.T) {
+	tdb := testutil.NewTestDB(t)
+	defer tdb.Close()
+
+	ctx := context.Background()
+	svc := NewService(tdb.Conn, nil, nil, nil, nil, nil, nil, nil, Config{}, &tdb.Logger, nil, nil, nil, nil, nil)
+
+	files := []string{"/downloads/Sample/a.mkv", "/downloads/Movie.2020/b.mkv"}
+	if got := svc.filterExcluded(ctx, files); !slices.Equal(got, files) {
+		t.Errorf("filterExcluded() without exclusions = %v, want all files", got)
+	}
+
+	if _, err := svc.AddExclusion(ctx, &ImportExclusionInput{Kind: ExclusionKindFolder, Value: "sample"}); err != nil {
+		t.Fatalf("AddExclusion() error = %v", err)
+	}
+	if got := svc.filterExcluded(ctx, files); !slices.Equal(got, files[1:]) {
+		t.Errorf("filterExcluded() = %v, want %v", got, files[1:])
+	}
+	if err := svc.checkExclusion(ctx, files[0]); !errors.Is(err, ErrExcluded) {
+		t.Errorf("checkExclusion() error = %v, want ErrExcluded", err)
+	}
+}
@@ -0,0 +1,293 @@
+package importer
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/slipstream/slipstream/internal/database/sqlc"
+	"github.com/slipstream/slipstream/internal/library/scanner"
+)
+
+// Folder hint scopes.
+const (
+	HintScopeIndexer      = "indexer"
+	HintScopeReleaseGroup = "release_group"
+)
+
+// learnedHintMinHits is how many imported files must agree on a learned
+// content folder before it is used to pick files.
+const learnedHintMinHits = 2
+
+var (
+	ErrFolderHintNotFound = errors.New("folder hint not found")
+	ErrInvalidFolderHint  = errors.New("invalid folder hint")
+)
+
+// FolderHint guides video file discovery in downloads from one indexer or
+// release group. ContentPath is the folder, relative to the download, holding
+// the main files; SkipPatterns are globs for junk files and folders.
+type FolderHint struct {
+	ID           int64     `json:"id"`
+	Scope        string    `json:"scope"`
+	ScopeKey     string    `json:"scopeKey"`
+	ContentPath  string    `json:"contentPath"`
+	SkipPatterns []string  `json:"skipPatterns"`
+	Learned      bool      `json:"learned"`
+	Hits         int64     `json:"hits"`
+	UpdatedAt    time.Time `json:"updatedAt"`
+}
+
+// FolderHintInput configures a folder hint. Configured hints take precedence
+// over learning and are not overwritten by it.
+type FolderHintInput struct {
+	Scope        string   `json:"scope"`
+	ScopeKey     string   `json:"scopeKey"`
+	ContentPath  string   `json:"contentPath"`
+	SkipPatterns []string `json:"skipPatterns"`
+}
+
+// active reports whether the hint's content folder should steer discovery.
+func (h *FolderHint) active() bool {
+	return h.ContentPath != "" && (!h.Learned || h.Hits >= learnedHintMinHits)
+}
+
+// ListFolderHints returns all configured and learned folder hints.
+func (s *Service) ListFolderHints(ctx context.Context) ([]*FolderHint, error) {
+	rows, err := s.queries.ListImportFolderHints(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list folder hints: %w", err)
+	}
+	hints := make([]*FolderHint, 0, len(rows))
+	for _, row := range rows {
+		hints = append(hints, rowToFolderHint(row))
+	}
+	return hints, nil
+}
+
+// SaveFolderHint creates or replaces the configured hint for a scope.
+func (s *Service) SaveFolderHint(ctx context.Context, input *FolderHintInput) (*FolderHint, error) {
+	if input.Scope != HintScopeIndexer && input.Scope != HintScopeReleaseGroup {
+		return nil, fmt.Errorf("%w: scope must be indexer or release_group", ErrInvalidFolderHint)
+	}
+	key := normalizeHintKey(input.Scope, input.ScopeKey)
+	if key == "" {
+		return nil, fmt.Errorf("%w: scope key is required", ErrInvalidFolderHint)
+	}
+	contentPath := normalizeContentPath(input.ContentPath)
+	if contentPath == ".." || strings.HasPrefix(contentPath, "../") {
+		return nil, fmt.Errorf("%w: content path must stay inside the download", ErrInvalidFolderHint)
+	}
+	patterns := make([]string, 0, len(input.SkipPatterns))
+	for _, p := range input.SkipPatterns {
+		p = strings.TrimSpace(p)
+		if p == "" {
+			continue
+		}
+		if _, err := path.Match(p, ""); err != nil {
+			return nil, fmt.Errorf("%w: bad skip pattern %q", ErrInvalidFolderHint, p)
+		}
+		patterns = append(patterns, p)
+	}
+	encoded, err := json.Marshal(patterns)
+	if err != nil {
+		return nil, err
+	}
+
+	row, err := s.queries.UpsertImportFolderHint(ctx, sqlc.UpsertImportFolderHintParams{
+		Scope:        input.Scope,
+		ScopeKey:     key,
+		ContentPath:  contentPath,
+		SkipPatterns: string(encoded),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to save folder hint: %w", err)
+	}
+	return rowToFolderHint(row), nil
+}
+
+// DeleteFolderHint removes a configured or learned hint.
+func (s *Service) DeleteFolderHint(ctx context.Context, id int64) error {
+	if _, err := s.queries.GetImportFolderHint(ctx, id); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return ErrFolderHintNotFound
+		}
+		return err
+	}
+	return s.queries.DeleteImportFolderHint(ctx, id)
+}
+
+// findDownloadVideoFiles finds the video files of a completed download,
+// narrowed by the folder hints for its indexer and release group.
+func (s *Service) findDownloadVideoFiles(ctx context.Context, downloadPath string, mapping *DownloadMapping) ([]string, error) {
+	files, err := s.findVideoFiles(downloadPath)
+	if err != nil || len(files) == 0 {
+		return files, err
+	}
+	hints := s.folderHintsFor(ctx, downloadPath, mapping)
+	if len(hints) == 0 {
+		return files, nil
+	}
+	picked := applyFolderHints(downloadPath, files, hints)
+	if len(picked) != len(files) {
+		s.logger.Debug().
+			Str("path", downloadPath).
+			Int("found", len(files)).
+			Int("picked", len(picked)).
+			Msg("Applied import folder hints")
+	}
+	return picked, nil
+}
+
+// folderHintsFor returns the hints for the download's indexer and release
+// group, indexer first.
+func (s *Service) folderHintsFor(ctx context.Context, downloadPath string, mapping *DownloadMapping) []*FolderHint {
+	var hints []*FolderHint
+	for _, scope := range hintScopes(downloadPath, mapping) {
+		row, err := s.queries.GetImportFolderHintByScope(ctx, sqlc.GetImportFolderHintByScopeParams{Scope: scope[0], ScopeKey: scope[1]})
+		if err != nil {
+			if !errors.Is(err, sql.ErrNoRows) {
+				s.logger.Warn().Err(err).Str("scope", scope[0]).Msg("Failed to load import folder hint")
+			}
+			continue
+		}
+		hints = append(hints, rowToFolderHint(row))
+	}
+	return hints
+}
+
+// learnFolderHint records which folder of the download held a successfully
+// imported file, for both the indexer and the release group.
+func (s *Service) learnFolderHint(ctx context.Context, mapping *DownloadMapping, sourcePath string) {
+	if mapping == nil || mapping.DownloadPath == "" {
+		return
+	}
+	rel, err := filepath.Rel(mapping.DownloadPath, filepath.Dir(sourcePath))
+	if err != nil {
+		return
+	}
+	contentPath := normalizeContentPath(rel)
+	if strings.HasPrefix(contentPath, "..") {
+		return
+	}
+	for _, scope := range hintScopes(mapping.DownloadPath, mapping) {
+		if err := s.queries.LearnImportFolderHint(ctx, sqlc.LearnImportFolderHintParams{
+			Scope:       scope[0],
+			ScopeKey:    scope[1],
+			ContentPath: contentPath,
+		}); err != nil {
+			s.logger.Warn().Err(err).Str("scope", scope[0]).Msg("Failed to learn import folder hint")
+		}
+	}
+}
+
+// hintScopes lists the (scope, key) pairs that apply to a download.
+func hintScopes(downloadPath string, mapping *DownloadMapping) [][2]string {
+	var scopes [][2]string
+	if mapping != nil && mapping.IndexerID != nil {
+		scopes = append(scopes, [2]string{HintScopeIndexer, strconv.FormatInt(*mapping.IndexerID, 10)})
+	}
+	if parsed := scanner.ParseFolderName(filepath.Base(downloadPath)); parsed != nil && parsed.ReleaseGroup != "" {
+		scopes = append(scopes, [2]string{HintScopeReleaseGroup, normalizeHintKey(HintScopeReleaseGroup, parsed.ReleaseGroup)})
+	}
+	return scopes
+}
+
+// applyFolderHints drops files matching any skip pattern, then keeps only the
+// files under the first active content folder that has any. Without a match
+// the unskipped files are returned so a stale hint never blocks an import.
+func applyFolderHints(root string, files []string, hints []*FolderHint) []string {
+	kept := make([]string, 0, len(files))
+	for _, f := range files {
+		rel := relSlashPath(root, f)
+		if !matchesSkipPattern(rel, hints) {
+			kept = append(kept, f)
+		}
+	}
+
+	for _, h := range hints {
+		if !h.active() {
+			continue
+		}
+		var inFolder []string
+		for _, f := range kept {
+			if rel := relSlashPath(root, f); strings.HasPrefix(rel, h.ContentPath+"/") {
+				inFolder = append(inFolder, f)
+			}
+		}
+		if len(inFolder) > 0 {
+			return inFolder
+		}
+	}
+
+	if len(kept) == 0 {
+		return files
+	}
+	return kept
+}
+
+// matchesSkipPattern matches each pattern against the relative path, the
+// file name and every folder on the way to it.
+func matchesSkipPattern(rel string, hints []*FolderHint) bool {
+	parts := strings.Split(rel, "/")
+	for _, h := range hints {
+		for _, pattern := range h.SkipPatterns {
+			pattern = strings.ToLower(pattern)
+			if ok, _ := path.Match(pattern, strings.ToLower(rel)); ok {
+				return true
+			}
+			for _, part := range parts {
+				if ok, _ := path.Match(pattern, strings.ToLower(part)); ok {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+func relSlashPath(root, file string) string {
+	rel, err := filepath.Rel(root, file)
+	if err != nil {
+		return filepath.ToSlash(file)
+	}
+	return filepath.ToSlash(rel)
+}
+
+func normalizeContentPath(p string) string {
+	p = strings.Trim(filepath.ToSlash(strings.TrimSpace(p)), "/")
+	if p == "" || p == "." {
+		return ""
+	}
+	return path.Clean(p)
+}
+
+func normalizeHintKey(scope, key string) string {
+	key = strings.TrimSpace(key)
+	if scope == HintScopeReleaseGroup {
+		return strings.ToLower(key)
+	}
+	return key
+}
+
+func rowToFolderHint(row *sqlc.ImportFolderHint) *FolderHint {
+	hint := &FolderHint{
+		ID:           row.ID,
+		Scope:        row.Scope,
+		ScopeKey:     row.ScopeKey,
+		ContentPath:  row.ContentPath,
+		SkipPatterns: []string{},
+		Learned:      row.Learned,
+		Hits:         row.Hits,
+		UpdatedAt:    row.UpdatedAt,
+	}
+	_ = json.Unmarshal([]byte(row.SkipPatterns), &hint.SkipPatterns)
+	return hint
+}
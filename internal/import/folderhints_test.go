@@ -0,0 +1,85 @@
+package importer
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"github.com/slipstream/slipstream/internal/testutil"
+)
+
+func TestApplyFolderHints(t *testing.T) {
+	root := "/downloads/Show.S01.1080p.WEB-DL-GRP"
+	files := []string{
+		root + "/Extras/Show.S01.Featurette.mkv",
+		root + "/Content/Video/Show.S01E01.1080p.WEB-DL-GRP.mkv",
+		root + "/Content/Video/Show.S01E02.1080p.WEB-DL-GRP.mkv",
+		root + "/Content/Proof/proof.mkv",
+	}
+
+	skip := &FolderHint{SkipPatterns: []string{"proof", "extras"}}
+	got := applyFolderHints(root, files, []*FolderHint{skip})
+	if !slices.Equal(got, files[1:3]) {
+		t.Errorf("skip patterns kept %v, want %v", got, files[1:3])
+	}
+
+	learned := &FolderHint{ContentPath: "Content/Video", Learned: true, Hits: 1}
+	if got := applyFolderHints(root, files, []*FolderHint{learned}); !slices.Equal(got, files) {
+		t.Errorf("unconfirmed learned hint narrowed files to %v", got)
+	}
+	learned.Hits = learnedHintMinHits
+	if got := applyFolderHints(root, files, []*FolderHint{learned}); !slices.Equal(got, files[1:3]) {
+		t.Errorf("learned hint picked %v, want %v", got, files[1:3])
+	}
+
+	stale := &FolderHint{ContentPath: "Video"}
+	if got := applyFolderHints(root, files, []*FolderHint{stale}); !slices.Equal(got, files) {
+		t.Errorf("stale hint picked %v, want all files", got)
+	}
+}
+
+func TestLearnFolderHint(t *testing.T) {
+	tdb := testutil.NewTestDB(t)
+	defer tdb.Close()
+
+	svc := NewService(tdb.Conn, nil, nil, nil, nil, nil, nil, nil, Config{}, &tdb.Logger, nil, nil, nil, nil, nil)
+	ctx := context.Background()
+
+	indexerID := int64(4)
+	root := "/downloads/Show.S01.1080p.WEB-DL-GRP"
+	mapping := &DownloadMapping{IndexerID: &indexerID, DownloadPath: root}
+	svc.learnFolderHint(ctx, mapping, root+"/Content/Video/Show.S01E01.mkv")
+	svc.learnFolderHint(ctx, mapping, root+"/Content/Video/Show.S01E02.mkv")
+
+	hints := svc.folderHintsFor(ctx, root, mapping)
+	if len(hints) != 2 {
+		t.Fatalf("folderHintsFor() returned %d hints, want indexer and release group", len(hints))
+	}
+	for _, h := range hints {
+		if h.ContentPath != "Content/Video" || h.Hits != 2 || !h.active() {
+			t.Errorf("learned %s hint = %+v, want active Content/Video with 2 hits", h.Scope, h)
+		}
+	}
+
+	// A configured hint is not overwritten by learning
+	if _, err := svc.SaveFolderHint(ctx, &FolderHintInput{Scope: HintScopeReleaseGroup, ScopeKey: "GRP", SkipPatterns: []string{"*sample*"}}); err != nil {
+		t.Fatalf("SaveFolderHint() error = %v", err)
+	}
+	svc.learnFolderHint(ctx, mapping, root+"/Show.S01E03.mkv")
+	for _, h := range svc.folderHintsFor(ctx, root, mapping) {
+		switch h.Scope {
+		case HintScopeIndexer:
+			if h.ContentPath != "" || h.Hits != 1 {
+				t.Errorf("indexer hint after a top-level import = %+v, want reset to the top level", h)
+			}
+		case HintScopeReleaseGroup:
+			if h.Learned || h.ContentPath != "" || !slices.Equal(h.SkipPatterns, []string{"*sample*"}) {
+				t.Errorf("configured hint changed by learning: %+v", h)
+			}
+		}
+	}
+
+	if _, err := svc.SaveFolderHint(ctx, &FolderHintInput{Scope: HintScopeIndexer, ScopeKey: "4", ContentPath: "../elsewhere"}); err == nil {
+		t.Error("SaveFolderHint() accepted a content path outside the download")
+	}
+}
@@ -13,6 +13,7 @@ import (
 
 	"github.com/slipstream/slipstream/internal/database/sqlc"
 	"github.com/slipstream/slipstream/internal/import/renamer"
+	"github.com/slipstream/slipstream/internal/library/quality"
 	"github.com/slipstream/slipstream/internal/library/scanner"
 	"github.com/slipstream/slipstream/internal/module"
 )
@@ -40,14 +41,32 @@ func NewHandlers(service *Service, db *sql.DB) *Handlers {
 func (h *Handlers) RegisterRoutes(g *echo.Group) {
 	g.GET("/pending", h.GetPendingImports)
 	g.GET("/status", h.GetImportStatus)
+	g.GET("/jobs", h.ListActiveJobs)
+	g.GET("/jobs/:jobId", h.GetActiveJob)
 	g.POST("/manual", h.ManualImport)
 	g.POST("/manual/preview", h.PreviewManualImport)
+	g.POST("/manual/batch", h.BatchManualImport)
 	g.POST("/:id/retry", h.RetryImport)
+	g.GET("/downloads/:clientId/:downloadId/dry-run", h.DryRunDownloadImport)
 	g.POST("/scan", h.ScanDirectory)
+	g.GET("/match-accuracy", h.GetMatchAccuracy)
+	g.GET("/folder-hints", h.ListFolderHints)
+	g.PUT("/folder-hints", h.SaveFolderHint)
+	g.DELETE("/folder-hints/:id", h.DeleteFolderHint)
+	g.GET("/exclusions", h.ListExclusions)
+	g.POST("/exclusions", h.AddExclusion)
+	g.DELETE("/exclusions/:id", h.DeleteExclusion)
+	g.GET("/recycle-bin", h.GetRecycleBin)
+	g.PUT("/recycle-bin", h.SetRecycleBin)
+	g.GET("/download-cleanup", h.GetDownloadCleanup)
+	g.PUT("/download-cleanup", h.SetDownloadCleanup)
+	g.POST("/history/:id/undo", h.UndoImport)
 
 	// Mass rename endpoints
 	g.GET("/rename/preview", h.GetRenamePreview)
 	g.POST("/rename/execute", h.ExecuteRename)
+	g.GET("/rename/jobs", h.ListRenames)
+	g.DELETE("/rename/jobs/:id", h.CancelRename)
 }
 
 // ImportStatusResponse contains import service status.
@@ -65,6 +84,22 @@ func (h *Handlers) GetImportStatus(c echo.Context) error {
 	})
 }
 
+// ListActiveJobs returns progress for all in-flight import jobs.
+// GET /api/v1/import/jobs
+func (h *Handlers) ListActiveJobs(c echo.Context) error {
+	return c.JSON(http.StatusOK, h.service.ActiveJobs())
+}
+
+// GetActiveJob returns progress for a single in-flight import job.
+// GET /api/v1/import/jobs/:jobId
+func (h *Handlers) GetActiveJob(c echo.Context) error {
+	job, ok := h.service.ActiveJob(c.Param("jobId"))
+	if !ok {
+		return echo.NewHTTPError(http.StatusNotFound, "import job not found")
+	}
+	return c.JSON(http.StatusOK, job)
+}
+
 // PendingImport represents a file pending import.
 type PendingImport struct {
 	ID           int64   `json:"id,omitempty"`
@@ -128,6 +163,7 @@ type ManualImportRequest struct {
 	SeriesID     *int64 `json:"seriesId,omitempty"`
 	SeasonNum    *int   `json:"seasonNum,omitempty"`
 	TargetSlotID *int64 `json:"targetSlotId,omitempty"`
+	QualityID    *int   `json:"qualityId,omitempty"` // Overrides the quality parsed from the filename
 }
 
 // ManualImportSlotAssignment represents a slot assignment option for the response.
@@ -146,6 +182,7 @@ type ManualImportResponse struct {
 	SourcePath            string                       `json:"sourcePath"`
 	DestinationPath       string                       `json:"destinationPath,omitempty"`
 	LinkMode              string                       `json:"linkMode,omitempty"`
+	LinkFallbackReason    string                       `json:"linkFallbackReason,omitempty"` // Why the file was not hardlinked
 	IsUpgrade             bool                         `json:"isUpgrade"`
 	Error                 string                       `json:"error,omitempty"`
 	RequiresSlotSelection bool                         `json:"requiresSlotSelection"`
@@ -172,6 +209,10 @@ func (h *Handlers) ManualImport(c echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, "mediaType must be 'movie' or 'episode'")
 	}
 
+	if req.QualityID != nil && !isKnownQuality(h.service.qualities(), *req.QualityID) {
+		return echo.NewHTTPError(http.StatusBadRequest, "unknown qualityId")
+	}
+
 	match := h.buildManualMatch(&req)
 
 	if err := h.service.populateRootFolder(ctx, match, req.TargetSlotID); err != nil {
@@ -186,7 +227,12 @@ func (h *Handlers) buildManualMatch(req *ManualImportRequest) *LibraryMatch {
 	match := &LibraryMatch{
 		MediaType:  req.MediaType,
 		Confidence: 1.0,
-		Source:     "manual",
+		Source:     matchSourceManual,
+	}
+
+	if req.QualityID != nil {
+		match.QualityOverride = *req.QualityID
+		match.CandidateQualityID = *req.QualityID
 	}
 
 	if req.MediaType == mediaTypeMovie {
@@ -200,6 +246,103 @@ func (h *Handlers) buildManualMatch(req *ManualImportRequest) *LibraryMatch {
 	return match
 }
 
+// BatchManualImportRequest contains the files of a batch manual import, each
+// with its own match, target slot and quality override.
+type BatchManualImportRequest struct {
+	Files []ManualImportRequest `json:"files" validate:"required"`
+}
+
+// BatchManualImportResponse contains the per-file results of a batch manual import.
+type BatchManualImportResponse struct {
+	JobID     string                 `json:"jobId"`
+	Total     int                    `json:"total"`
+	Succeeded int                    `json:"succeeded"`
+	Failed    int                    `json:"failed"`
+	Results   []ManualImportResponse `json:"results"`
+}
+
+// BatchManualImport imports several files with explicit matches as one job.
+// POST /api/v1/import/manual/batch
+func (h *Handlers) BatchManualImport(c echo.Context) error {
+	var req BatchManualImportRequest
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid request body")
+	}
+	if len(req.Files) == 0 {
+		return echo.NewHTTPError(http.StatusBadRequest, "files is required")
+	}
+
+	resp := BatchManualImportResponse{
+		Total:   len(req.Files),
+		Results: make([]ManualImportResponse, len(req.Files)),
+	}
+
+	// Invalid entries fail on their own; the rest of the batch still imports
+	items := make([]BatchImportItem, 0, len(req.Files))
+	indexes := make([]int, 0, len(req.Files))
+	for i := range req.Files {
+		file := &req.Files[i]
+		if msg := validateManualImportRequest(h.service.qualities(), file); msg != "" {
+			resp.Results[i] = ManualImportResponse{SourcePath: file.Path, Error: msg, SlotAssignments: []ManualImportSlotAssignment{}}
+			continue
+		}
+		items = append(items, BatchImportItem{
+			SourcePath:   file.Path,
+			Match:        h.buildManualMatch(file),
+			TargetSlotID: file.TargetSlotID,
+		})
+		indexes = append(indexes, i)
+	}
+
+	jobID, outcomes := h.service.ProcessManualImportBatch(c.Request().Context(), items)
+	resp.JobID = jobID
+	for j, outcome := range outcomes {
+		i := indexes[j]
+		resp.Results[i] = h.buildManualImportResponse(req.Files[i].Path, outcome.Result, outcome.Err)
+	}
+
+	for i := range resp.Results {
+		if resp.Results[i].Success {
+			resp.Succeeded++
+		} else {
+			resp.Failed++
+		}
+	}
+
+	if h.service.hub != nil {
+		h.service.hub.Broadcast("import:batch:completed", map[string]interface{}{
+			"jobId":     resp.JobID,
+			"total":     resp.Total,
+			"succeeded": resp.Succeeded,
+			"failed":    resp.Failed,
+		})
+	}
+
+	return c.JSON(http.StatusOK, resp)
+}
+
+// validateManualImportRequest returns why a manual import entry cannot be
+// processed, or "" when it is valid.
+func validateManualImportRequest(registry *quality.Registry, req *ManualImportRequest) string {
+	switch {
+	case req.Path == "":
+		return "path is required"
+	case req.MediaType != mediaTypeMovie && req.MediaType != mediaTypeEpisode:
+		return "mediaType must be 'movie' or 'episode'"
+	case req.MediaID == 0:
+		return "mediaId is required"
+	case req.QualityID != nil && !isKnownQuality(registry, *req.QualityID):
+		return "unknown qualityId"
+	default:
+		return ""
+	}
+}
+
+func isKnownQuality(registry *quality.Registry, id int) bool {
+	_, ok := registry.GetQualityByID(id)
+	return ok
+}
+
 func (h *Handlers) buildManualImportResponse(sourcePath string, result *ImportResult, err error) ManualImportResponse {
 	resp := ManualImportResponse{
 		SourcePath:      sourcePath,
@@ -215,6 +358,7 @@ func (h *Handlers) buildManualImportResponse(sourcePath string, result *ImportRe
 	resp.Success = result.Success
 	resp.DestinationPath = result.DestinationPath
 	resp.LinkMode = string(result.LinkMode)
+	resp.LinkFallbackReason = result.LinkFallbackReason
 	resp.IsUpgrade = result.IsUpgrade
 	resp.RequiresSlotSelection = result.RequiresSlotSelection
 	resp.RecommendedSlotID = result.RecommendedSlotID
@@ -278,6 +422,196 @@ type SuggestedMatch struct {
 	SeriesTitle *string `json:"seriesTitle,omitempty"`
 }
 
+// GetMatchAccuracy returns how often automatic matches were corrected manually, per match source.
+// GET /api/v1/import/match-accuracy?days=30
+func (h *Handlers) GetMatchAccuracy(c echo.Context) error {
+	days := defaultMatchAccuracyDays
+	if raw := c.QueryParam("days"); raw != "" {
+		parsed, err := strconv.Atoi(raw)
+		if err != nil || parsed < 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, "days must be a positive integer")
+		}
+		days = parsed
+	}
+
+	stats, err := h.service.GetMatchAccuracy(c.Request().Context(), days)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	return c.JSON(http.StatusOK, stats)
+}
+
+// ListFolderHints returns configured and learned download folder hints.
+// GET /api/v1/import/folder-hints
+func (h *Handlers) ListFolderHints(c echo.Context) error {
+	hints, err := h.service.ListFolderHints(c.Request().Context())
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	return c.JSON(http.StatusOK, hints)
+}
+
+// SaveFolderHint creates or replaces the configured hint for an indexer or release group.
+// PUT /api/v1/import/folder-hints
+func (h *Handlers) SaveFolderHint(c echo.Context) error {
+	var input FolderHintInput
+	if err := c.Bind(&input); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	hint, err := h.service.SaveFolderHint(c.Request().Context(), &input)
+	if err != nil {
+		if errors.Is(err, ErrInvalidFolderHint) {
+			return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+		}
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	return c.JSON(http.StatusOK, hint)
+}
+
+// DeleteFolderHint removes a folder hint.
+// DELETE /api/v1/import/folder-hints/:id
+func (h *Handlers) DeleteFolderHint(c echo.Context) error {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid hint ID")
+	}
+
+	if err := h.service.DeleteFolderHint(c.Request().Context(), id); err != nil {
+		if errors.Is(err, ErrFolderHintNotFound) {
+			return echo.NewHTTPError(http.StatusNotFound, err.Error())
+		}
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	return c.NoContent(http.StatusNoContent)
+}
+
+// ListExclusions returns the paths, folders and titles never imported automatically.
+// GET /api/v1/import/exclusions
+func (h *Handlers) ListExclusions(c echo.Context) error {
+	exclusions, err := h.service.ListExclusions(c.Request().Context())
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	return c.JSON(http.StatusOK, exclusions)
+}
+
+// AddExclusion creates an import exclusion.
+// POST /api/v1/import/exclusions
+func (h *Handlers) AddExclusion(c echo.Context) error {
+	var input ImportExclusionInput
+	if err := c.Bind(&input); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	exclusion, err := h.service.AddExclusion(c.Request().Context(), &input)
+	if err != nil {
+		if errors.Is(err, ErrInvalidExclusion) {
+			return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+		}
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	return c.JSON(http.StatusCreated, exclusion)
+}
+
+// DeleteExclusion removes an import exclusion.
+// DELETE /api/v1/import/exclusions/:id
+func (h *Handlers) DeleteExclusion(c echo.Context) error {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid exclusion ID")
+	}
+
+	if err := h.service.DeleteExclusion(c.Request().Context(), id); err != nil {
+		if errors.Is(err, ErrExclusionNotFound) {
+			return echo.NewHTTPError(http.StatusNotFound, err.Error())
+		}
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	return c.NoContent(http.StatusNoContent)
+}
+
+// GetDownloadCleanup returns the post-import download folder cleanup settings.
+// GET /api/v1/import/download-cleanup
+func (h *Handlers) GetDownloadCleanup(c echo.Context) error {
+	settings, err := h.service.DownloadCleanup(c.Request().Context())
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	return c.JSON(http.StatusOK, settings)
+}
+
+// SetDownloadCleanup updates the post-import download folder cleanup settings.
+// PUT /api/v1/import/download-cleanup
+func (h *Handlers) SetDownloadCleanup(c echo.Context) error {
+	var input DownloadCleanupSettings
+	if err := c.Bind(&input); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	settings, err := h.service.SetDownloadCleanup(c.Request().Context(), &input)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	return c.JSON(http.StatusOK, settings)
+}
+
+// RecycleBinSettings is the recycle bin folder for files replaced by upgrades.
+type RecycleBinSettings struct {
+	Path string `json:"path"`
+}
+
+// GetRecycleBin returns the recycle bin folder; empty when disabled.
+// GET /api/v1/import/recycle-bin
+func (h *Handlers) GetRecycleBin(c echo.Context) error {
+	path, err := h.service.RecycleBinPath(c.Request().Context())
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	return c.JSON(http.StatusOK, RecycleBinSettings{Path: path})
+}
+
+// SetRecycleBin sets the recycle bin folder; an empty path disables it.
+// PUT /api/v1/import/recycle-bin
+func (h *Handlers) SetRecycleBin(c echo.Context) error {
+	var input RecycleBinSettings
+	if err := c.Bind(&input); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	path, err := h.service.SetRecycleBinPath(c.Request().Context(), input.Path)
+	if err != nil {
+		if errors.Is(err, ErrInvalidRecycleBin) {
+			return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+		}
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	return c.JSON(http.StatusOK, RecycleBinSettings{Path: path})
+}
+
+// UndoImport reverts the import recorded by a history entry.
+// POST /api/v1/import/history/:id/undo
+func (h *Handlers) UndoImport(c echo.Context) error {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid history ID")
+	}
+
+	result, err := h.service.UndoImport(c.Request().Context(), id)
+	if err != nil {
+		switch {
+		case errors.Is(err, ErrHistoryEntryNotFound):
+			return echo.NewHTTPError(http.StatusNotFound, err.Error())
+		case errors.Is(err, ErrNotUndoable):
+			return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+		case errors.Is(err, ErrImportAlreadyUndone):
+			return echo.NewHTTPError(http.StatusConflict, err.Error())
+		}
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	return c.JSON(http.StatusOK, result)
+}
+
 // PreviewManualImport previews what would happen if a file was imported.
 // POST /api/v1/import/manual/preview
 func (h *Handlers) PreviewManualImport(c echo.Context) error {
@@ -322,6 +656,29 @@ func (h *Handlers) PreviewManualImport(c echo.Context) error {
 	return c.JSON(http.StatusOK, resp)
 }
 
+// DryRunDownloadImport reports what the automatic import of a completed
+// download would do without importing anything.
+// GET /api/v1/import/downloads/:clientId/:downloadId/dry-run
+func (h *Handlers) DryRunDownloadImport(c echo.Context) error {
+	clientID, err := strconv.ParseInt(c.Param("clientId"), 10, 64)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid client id")
+	}
+	downloadID := c.Param("downloadId")
+	if downloadID == "" {
+		return echo.NewHTTPError(http.StatusBadRequest, "download id is required")
+	}
+
+	result, err := h.service.DryRunDownloadImport(c.Request().Context(), clientID, downloadID)
+	if err != nil {
+		if errors.Is(err, ErrMappingNotFound) {
+			return echo.NewHTTPError(http.StatusNotFound, err.Error())
+		}
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	return c.JSON(http.StatusOK, result)
+}
+
 // RetryImport retries a failed import.
 // POST /api/v1/import/:id/retry
 func (h *Handlers) RetryImport(c echo.Context) error {
@@ -414,10 +771,11 @@ type ScannedFile struct {
 
 // ScanDirectoryResponse contains the response for a directory scan.
 type ScanDirectoryResponse struct {
-	Path  string        `json:"path"`
-	Files []ScannedFile `json:"files"`
-	Total int           `json:"total"`
-	Valid int           `json:"valid"`
+	Path     string        `json:"path"`
+	Files    []ScannedFile `json:"files"`
+	Total    int           `json:"total"`
+	Valid    int           `json:"valid"`
+	Excluded int           `json:"excluded"` // Files skipped by import exclusions
 }
 
 // ScanDirectory scans a directory for importable video files.
@@ -434,15 +792,17 @@ func (h *Handlers) ScanDirectory(c echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, "path is required")
 	}
 
-	files, err := h.service.findVideoFiles(req.Path)
+	found, err := h.service.findVideoFiles(req.Path)
 	if err != nil {
 		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
 	}
+	files := h.service.filterExcluded(ctx, found)
 
 	resp := ScanDirectoryResponse{
-		Path:  req.Path,
-		Files: make([]ScannedFile, 0, len(files)),
-		Total: len(files),
+		Path:     req.Path,
+		Files:    make([]ScannedFile, 0, len(files)),
+		Total:    len(files),
+		Excluded: len(found) - len(files),
 	}
 
 	for _, path := range files {
@@ -552,7 +912,8 @@ type ExecuteRenameRequest struct {
 	FileIDs   []int64 `json:"fileIds" validate:"required"`
 }
 
-// ExecuteRename performs mass rename operations.
+// ExecuteRename starts a mass rename in the background. Progress is
+// broadcast as rename:started, rename:progress and rename:completed.
 // POST /api/v1/import/rename/execute
 func (h *Handlers) ExecuteRename(c echo.Context) error {
 	ctx := c.Request().Context()
@@ -570,22 +931,33 @@ func (h *Handlers) ExecuteRename(c echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, "fileIds is required and cannot be empty")
 	}
 
-	result, err := h.service.ExecuteMassRename(ctx, req.MediaType, req.FileIDs)
+	progress, err := h.service.StartMassRename(ctx, req.MediaType, req.FileIDs)
+	if errors.Is(err, ErrInvalidRenameMediaType) {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
 	if err != nil {
 		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
 	}
 
-	// Broadcast rename progress via WebSocket
-	if h.service.hub != nil {
-		h.service.hub.Broadcast("rename:completed", map[string]interface{}{
-			"total":     result.Total,
-			"succeeded": result.Succeeded,
-			"failed":    result.Failed,
-			"skipped":   result.Skipped,
-		})
-	}
+	return c.JSON(http.StatusAccepted, progress)
+}
 
-	return c.JSON(http.StatusOK, result)
+// ListRenames returns the mass renames currently running.
+// GET /api/v1/import/rename/jobs
+func (h *Handlers) ListRenames(c echo.Context) error {
+	return c.JSON(http.StatusOK, h.service.ActiveRenames())
+}
+
+// CancelRename stops a running mass rename after the file in progress.
+// DELETE /api/v1/import/rename/jobs/:id
+func (h *Handlers) CancelRename(c echo.Context) error {
+	if err := h.service.CancelRename(c.Param("id")); err != nil {
+		if errors.Is(err, ErrRenameNotFound) {
+			return echo.NewHTTPError(http.StatusNotFound, err.Error())
+		}
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	return c.NoContent(http.StatusNoContent)
 }
 
 // SettingsHandlers provides HTTP handlers for import settings.
@@ -1039,6 +1411,14 @@ func buildTVTokens(parsed *scanner.ParsedMedia) []ParsedTokenDetail {
 		tokens = append(tokens, ParsedTokenDetail{Name: "Episode", Value: epValue})
 	}
 
+	if parsed.AbsoluteEpisode > 0 {
+		absValue := strconv.Itoa(parsed.AbsoluteEpisode)
+		if parsed.EndAbsolute > parsed.AbsoluteEpisode {
+			absValue += "-" + strconv.Itoa(parsed.EndAbsolute)
+		}
+		tokens = append(tokens, ParsedTokenDetail{Name: "Absolute Episode", Value: absValue})
+	}
+
 	if parsed.IsSeasonPack {
 		tokens = append(tokens, ParsedTokenDetail{Name: "Type", Value: "Season Pack"})
 	}
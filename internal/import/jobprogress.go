@@ -0,0 +1,155 @@
+package importer
+
+import (
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/slipstream/slipstream/internal/library/organizer"
+)
+
+// JobStage is the phase an import job is in.
+type JobStage string
+
+const (
+	JobStageImporting = JobStage("importing") // validating, matching and linking
+	JobStageCopying   = JobStage(organizer.CopyStageCopying)
+	JobStageVerifying = JobStage(organizer.CopyStageVerifying)
+)
+
+// progressInterval throttles import:progress broadcasts during a copy.
+const progressInterval = 500 * time.Millisecond
+
+// JobProgress is a snapshot of an in-flight import job, broadcast as import:progress.
+type JobProgress struct {
+	JobID          string    `json:"jobId"`
+	SourcePath     string    `json:"sourcePath"`
+	FileName       string    `json:"fileName"`
+	Stage          JobStage  `json:"stage"`
+	BytesCopied    int64     `json:"bytesCopied"`
+	TotalBytes     int64     `json:"totalBytes"`
+	BytesPerSecond int64     `json:"bytesPerSecond"`
+	StartedAt      time.Time `json:"startedAt"`
+}
+
+// ensureJobID assigns a job ID so progress can be followed by clients.
+func ensureJobID(job *ImportJob) {
+	if job.ID == "" {
+		job.ID = uuid.NewString()
+	}
+}
+
+// startJobProgress registers an in-flight job and announces it as import:started.
+func (s *Service) startJobProgress(job ImportJob) {
+	progress := &JobProgress{
+		JobID:      job.ID,
+		SourcePath: job.SourcePath,
+		FileName:   filepath.Base(job.SourcePath),
+		Stage:      JobStageImporting,
+		StartedAt:  time.Now(),
+	}
+
+	s.mu.Lock()
+	s.jobs[job.ID] = progress
+	s.mu.Unlock()
+
+	if s.hub != nil {
+		s.hub.Broadcast("import:started", *progress)
+	}
+}
+
+// endJobProgress removes a finished job. Completion is announced by import:completed or import:failed.
+func (s *Service) endJobProgress(jobID string) {
+	s.mu.Lock()
+	delete(s.jobs, jobID)
+	s.mu.Unlock()
+}
+
+// ActiveJobs returns snapshots of all in-flight import jobs, oldest first.
+func (s *Service) ActiveJobs() []JobProgress {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	jobs := make([]JobProgress, 0, len(s.jobs))
+	for _, p := range s.jobs {
+		jobs = append(jobs, *p)
+	}
+	sort.Slice(jobs, func(i, j int) bool { return jobs[i].StartedAt.Before(jobs[j].StartedAt) })
+	return jobs
+}
+
+// ActiveJob returns a snapshot of an in-flight import job.
+func (s *Service) ActiveJob(jobID string) (JobProgress, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	p, ok := s.jobs[jobID]
+	if !ok {
+		return JobProgress{}, false
+	}
+	return *p, true
+}
+
+// PendingJob is an import waiting in the queue for a free worker.
+type PendingJob struct {
+	JobID      string `json:"jobId"`
+	SourcePath string `json:"sourcePath"`
+	FileName   string `json:"fileName"`
+	Manual     bool   `json:"manual"`
+	Position   int    `json:"position"` // 1-based place in the queue
+}
+
+// PendingJobs returns queued imports in the order workers will pick them up.
+func (s *Service) PendingJobs() []PendingJob {
+	queued := s.importQueue.snapshot()
+	pending := make([]PendingJob, len(queued))
+	for i, job := range queued {
+		pending[i] = PendingJob{
+			JobID:      job.ID,
+			SourcePath: job.SourcePath,
+			FileName:   filepath.Base(job.SourcePath),
+			Manual:     job.Manual,
+			Position:   i + 1,
+		}
+	}
+	return pending
+}
+
+// copyProgressFunc returns an organizer callback that records copy progress for a job
+// and broadcasts it at most every progressInterval, plus on every stage change.
+func (s *Service) copyProgressFunc(jobID string) organizer.CopyProgressFunc {
+	var lastSent time.Time
+	var lastBytes int64
+	var lastStage JobStage
+
+	return func(p organizer.CopyProgress) {
+		now := time.Now()
+		stage := JobStage(p.Stage)
+		elapsed := now.Sub(lastSent)
+		if stage == lastStage && elapsed < progressInterval {
+			return
+		}
+
+		s.mu.Lock()
+		progress, ok := s.jobs[jobID]
+		if !ok {
+			s.mu.Unlock()
+			return
+		}
+		progress.Stage = stage
+		progress.BytesCopied = p.BytesCopied
+		progress.TotalBytes = p.TotalBytes
+		if !lastSent.IsZero() && elapsed > 0 && stage == JobStageCopying {
+			progress.BytesPerSecond = int64(float64(p.BytesCopied-lastBytes) / elapsed.Seconds())
+		}
+		snapshot := *progress
+		s.mu.Unlock()
+
+		lastSent, lastBytes, lastStage = now, p.BytesCopied, stage
+		if s.hub != nil {
+			s.hub.Broadcast("import:progress", snapshot)
+		}
+	}
+}
@@ -0,0 +1,135 @@
+package importer
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/slipstream/slipstream/internal/database/sqlc"
+)
+
+const (
+	matchSourceManual = "manual"
+
+	matchOutcomeAccepted = "accepted"
+
+	defaultMatchAccuracyDays = 30
+)
+
+// MatchAccuracy summarizes how often automatic matches from one source were
+// later corrected by a manual import.
+type MatchAccuracy struct {
+	Source    string  `json:"source"`
+	Total     int64   `json:"total"`
+	Corrected int64   `json:"corrected"`
+	Accuracy  float64 `json:"accuracy"` // Share of matches never corrected, 0.0 - 1.0
+}
+
+// GetMatchAccuracy returns per-source match accuracy over the last days days.
+func (s *Service) GetMatchAccuracy(ctx context.Context, days int) ([]MatchAccuracy, error) {
+	rows, err := s.queries.GetMatchAccuracyBySource(ctx, fmt.Sprintf("-%d days", days))
+	if err != nil {
+		return nil, fmt.Errorf("failed to load match accuracy: %w", err)
+	}
+
+	stats := make([]MatchAccuracy, 0, len(rows))
+	for _, row := range rows {
+		stat := MatchAccuracy{Source: row.MatchSource, Total: row.Total, Corrected: row.Corrected}
+		if row.Total > 0 {
+			stat.Accuracy = float64(row.Total-row.Corrected) / float64(row.Total)
+		}
+		stats = append(stats, stat)
+	}
+	return stats, nil
+}
+
+// recordAutomaticMatch records a match made without user input so a later
+// manual import of the same file can mark it corrected.
+func (s *Service) recordAutomaticMatch(ctx context.Context, sourcePath string, match *LibraryMatch) {
+	mediaID := s.getMediaIDFromMatch(match)
+	if mediaID == nil || match.Source == "" || match.Source == matchSourceManual {
+		return
+	}
+	s.createMatchOutcome(ctx, sourcePath, match.Source, match.MediaType, *mediaID)
+}
+
+// suggestMatch returns the match automatic import would pick for a file that
+// has no recorded automatic match, or nil. Called before a manual import so the
+// suggestion can still be computed from the file's original location.
+func (s *Service) suggestMatch(ctx context.Context, sourcePath string) *LibraryMatch {
+	if s.registry == nil {
+		return nil
+	}
+	if _, err := s.queries.GetLatestMatchOutcome(ctx, sourcePath); err == nil {
+		return nil
+	}
+	match, err := s.matchToLibrary(ctx, sourcePath, nil)
+	if err != nil {
+		return nil
+	}
+	return match
+}
+
+// recordManualMatch compares a manual import with the automatic match for the
+// same file. An earlier automatic import is marked corrected when the user
+// chose a different item; without one, the suggestion is recorded and marked
+// corrected if the user overrode it.
+func (s *Service) recordManualMatch(ctx context.Context, sourcePath string, match, suggested *LibraryMatch) {
+	mediaID := s.getMediaIDFromMatch(match)
+	if mediaID == nil {
+		return
+	}
+
+	prior, err := s.queries.GetLatestMatchOutcome(ctx, sourcePath)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		s.logger.Warn().Err(err).Str("path", sourcePath).Msg("Failed to load prior match outcome")
+		return
+	}
+
+	var outcomeID, matchedID int64
+	var matchedType string
+	switch {
+	case err == nil:
+		outcomeID, matchedType, matchedID = prior.ID, prior.MediaType, prior.MediaID
+	case suggested != nil:
+		suggestedID := s.getMediaIDFromMatch(suggested)
+		if suggestedID == nil {
+			return
+		}
+		id, ok := s.createMatchOutcome(ctx, sourcePath, suggested.Source, suggested.MediaType, *suggestedID)
+		if !ok {
+			return
+		}
+		outcomeID, matchedType, matchedID = id, suggested.MediaType, *suggestedID
+	default:
+		return
+	}
+
+	if matchedType == match.MediaType && matchedID == *mediaID {
+		return
+	}
+	err = s.queries.MarkMatchOutcomeCorrected(ctx, sqlc.MarkMatchOutcomeCorrectedParams{
+		CorrectedMediaType: sql.NullString{String: match.MediaType, Valid: true},
+		CorrectedMediaID:   sql.NullInt64{Int64: *mediaID, Valid: true},
+		ID:                 outcomeID,
+	})
+	if err != nil {
+		s.logger.Warn().Err(err).Int64("outcomeId", outcomeID).Msg("Failed to mark match corrected")
+	}
+}
+
+func (s *Service) createMatchOutcome(ctx context.Context, sourcePath, source, mediaType string, mediaID int64) (int64, bool) {
+	outcome, err := s.queries.CreateMatchOutcome(ctx, sqlc.CreateMatchOutcomeParams{
+		SourcePath:  sourcePath,
+		MatchSource: source,
+		MediaType:   mediaType,
+		MediaID:     mediaID,
+		Outcome:     matchOutcomeAccepted,
+	})
+	if err != nil {
+		s.logger.Warn().Err(err).Str("path", sourcePath).Msg("Failed to record match outcome")
+		return 0, false
+	}
+	return outcome.ID, true
+}
@@ -0,0 +1,47 @@
+package importer
+
+import (
+	"context"
+	"testing"
+
+	"github.com/slipstream/slipstream/internal/testutil"
+)
+
+func TestMatchAccuracy_ManualCorrection(t *testing.T) {
+	tdb := testutil.NewTestDB(t)
+	defer tdb.Close()
+
+	svc := NewService(tdb.Conn, nil, nil, nil, nil, nil, nil, nil, Config{}, &tdb.Logger, nil, nil, nil, nil, nil)
+	ctx := context.Background()
+
+	movieA, movieB := int64(1), int64(2)
+	auto := &LibraryMatch{MediaType: mediaTypeMovie, MovieID: &movieA, Source: "queue"}
+	svc.recordAutomaticMatch(ctx, "/downloads/a.mkv", auto)
+	svc.recordAutomaticMatch(ctx, "/downloads/b.mkv", auto)
+
+	// Re-importing to the same movie is not a correction
+	svc.recordManualMatch(ctx, "/downloads/a.mkv", &LibraryMatch{MediaType: mediaTypeMovie, MovieID: &movieA, Source: matchSourceManual}, nil)
+	svc.recordManualMatch(ctx, "/downloads/b.mkv", &LibraryMatch{MediaType: mediaTypeMovie, MovieID: &movieB, Source: matchSourceManual}, nil)
+
+	// An overridden suggestion counts against the parser
+	svc.recordManualMatch(ctx, "/downloads/c.mkv", &LibraryMatch{MediaType: mediaTypeMovie, MovieID: &movieB, Source: matchSourceManual},
+		&LibraryMatch{MediaType: mediaTypeMovie, MovieID: &movieA, Source: "parse"})
+
+	stats, err := svc.GetMatchAccuracy(ctx, 30)
+	if err != nil {
+		t.Fatalf("GetMatchAccuracy() error = %v", err)
+	}
+	if len(stats) != 2 {
+		t.Fatalf("GetMatchAccuracy() returned %d sources, want 2: %+v", len(stats), stats)
+	}
+
+	want := map[string]MatchAccuracy{
+		"parse": {Source: "parse", Total: 1, Corrected: 1, Accuracy: 0},
+		"queue": {Source: "queue", Total: 2, Corrected: 1, Accuracy: 0.5},
+	}
+	for _, got := range stats {
+		if got != want[got.Source] {
+			t.Errorf("source %s = %+v, want %+v", got.Source, got, want[got.Source])
+		}
+	}
+}
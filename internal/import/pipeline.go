@@ -13,6 +13,7 @@ import (
 	"github.com/slipstream/slipstream/internal/downloader"
 	fsmock "github.com/slipstream/slipstream/internal/filesystem/mock"
 	"github.com/slipstream/slipstream/internal/import/renamer"
+	"github.com/slipstream/slipstream/internal/library/language"
 	"github.com/slipstream/slipstream/internal/library/movies"
 	"github.com/slipstream/slipstream/internal/library/organizer"
 	"github.com/slipstream/slipstream/internal/library/quality"
@@ -36,12 +37,14 @@ func (s *Service) ProcessCompletedDownload(ctx context.Context, mapping *Downloa
 		return s.processMockImport(ctx, mapping)
 	}
 
-	downloadPath, err := s.getDownloadPath(ctx, mapping)
+	downloadDir, downloadPath, err := s.getDownloadPath(ctx, mapping)
 	if err != nil {
 		return err
 	}
 
-	files, err := s.findVideoFiles(downloadPath)
+	mapping.DownloadDir = downloadDir
+	mapping.DownloadPath = downloadPath
+	files, err := s.findDownloadVideoFiles(ctx, downloadPath, mapping)
 	if err != nil {
 		return fmt.Errorf("failed to find video files: %w", err)
 	}
@@ -50,29 +53,38 @@ func (s *Service) ProcessCompletedDownload(ctx context.Context, mapping *Downloa
 		return fmt.Errorf("no video files found in %s", downloadPath)
 	}
 
+	// A fully excluded download is done, not failed, so it is not retried
+	files = s.filterExcluded(ctx, files)
+	if len(files) == 0 {
+		s.logger.Info().Str("path", downloadPath).Msg("All files of download are excluded from import")
+		return nil
+	}
+
 	s.queueFilesForImport(files, mapping)
 	return nil
 }
 
-func (s *Service) getDownloadPath(ctx context.Context, mapping *DownloadMapping) (string, error) {
+// getDownloadPath returns the client folder a download was saved in and the
+// path of the download's own data within it.
+func (s *Service) getDownloadPath(ctx context.Context, mapping *DownloadMapping) (downloadDir, downloadPath string, err error) {
 	client, err := s.downloader.GetClient(ctx, mapping.DownloadClientID)
 	if err != nil {
-		return "", fmt.Errorf("failed to get download client: %w", err)
+		return "", "", fmt.Errorf("failed to get download client: %w", err)
 	}
 
 	items, err := client.List(ctx)
 	if err != nil {
-		return "", fmt.Errorf("failed to list downloads: %w", err)
+		return "", "", fmt.Errorf("failed to list downloads: %w", err)
 	}
 
 	for i := range items {
 		item := &items[i]
 		if item.ID == mapping.DownloadID {
-			return filepath.Join(item.DownloadDir, item.Name), nil
+			return item.DownloadDir, filepath.Join(item.DownloadDir, item.Name), nil
 		}
 	}
 
-	return "", fmt.Errorf("could not find download path for ID %s", mapping.DownloadID)
+	return "", "", fmt.Errorf("could not find download path for ID %s", mapping.DownloadID)
 }
 
 func (s *Service) queueFilesForImport(files []string, mapping *DownloadMapping) {
@@ -96,9 +108,23 @@ func (s *Service) ProcessManualImport(ctx context.Context, sourcePath string, ma
 		ConfirmedMatch: match,
 		TargetSlotID:   targetSlotID,
 	}
+	ensureJobID(&job)
+	return s.runManualImport(ctx, job)
+}
+
+// runManualImport processes a manual import job synchronously and records the
+// confirmed match for match-accuracy metrics.
+func (s *Service) runManualImport(ctx context.Context, job ImportJob) (*ImportResult, error) {
+	s.startJobProgress(job)
+	defer s.endJobProgress(job.ID)
+
+	suggested := s.suggestMatch(ctx, job.SourcePath)
 
-	// Process synchronously for manual imports
-	return s.processImport(ctx, job)
+	result, err := s.processImport(ctx, job)
+	if err == nil && result.Success {
+		s.recordManualMatch(ctx, job.SourcePath, job.ConfirmedMatch, suggested)
+	}
+	return result, err
 }
 
 // SlipStreamSubdirs are the subdirectories where SlipStream places downloads.
@@ -356,7 +382,7 @@ func (s *Service) matchOrphanViaModules(ctx context.Context, filePath string) *m
 
 // processImport handles the actual import of a single file.
 func (s *Service) processImport(ctx context.Context, job ImportJob) (*ImportResult, error) {
-	result := &ImportResult{SourcePath: job.SourcePath}
+	result := &ImportResult{JobID: job.ID, SourcePath: job.SourcePath}
 
 	settings := s.loadAndApplySettings(ctx)
 
@@ -370,7 +396,11 @@ func (s *Service) processImport(ctx context.Context, job ImportJob) (*ImportResu
 		return result, err
 	}
 
-	if err := s.performFileImport(ctx, job, result); err != nil {
+	if err := s.applySlotNaming(ctx, job, result, targetSlotID); err != nil {
+		return result, err
+	}
+
+	if err := s.performFileImport(ctx, job, result, s.getOldFileID(result.Match, slotUpgradeFile, isMultiVersion)); err != nil {
 		return result, err
 	}
 
@@ -400,6 +430,21 @@ func (s *Service) prepareImport(ctx context.Context, job ImportJob, result *Impo
 		return err
 	}
 
+	if err := s.enforceSizeLimits(ctx, job, match); err != nil {
+		result.Error = err
+		return err
+	}
+
+	if err := s.enforceLanguageProfile(ctx, job, match); err != nil {
+		result.Error = err
+		return err
+	}
+
+	if err := s.enforceReleaseGroups(ctx, job, match); err != nil {
+		result.Error = err
+		return err
+	}
+
 	mediaInfo := &mediainfo.MediaInfo{}
 	result.MediaInfo = mediaInfo
 
@@ -431,13 +476,38 @@ func (s *Service) processSlotAssignment(ctx context.Context, job ImportJob, resu
 	return targetSlotID, slotUpgradeFile, nil
 }
 
-func (s *Service) performFileImport(ctx context.Context, job ImportJob, result *ImportResult) error {
-	linkMode, err := s.executeImport(job.SourcePath, result.DestinationPath)
+// performFileImport places the file in the library. An upgrade is imported
+// to a staging path and renamed over the destination once complete, so a
+// failure part way leaves the previous file in place.
+func (s *Service) performFileImport(ctx context.Context, job ImportJob, result *ImportResult, oldFileID *int64) error {
+	importPath := result.DestinationPath
+	if result.IsUpgrade && result.PreviousFile != "" {
+		tempPath, err := s.stageUpgrade(ctx, result, oldFileID)
+		if err != nil {
+			result.Error = err
+			return err
+		}
+		importPath = tempPath
+	}
+
+	release, err := s.volumes.acquire(ctx, result.DestinationPath)
+	if err != nil {
+		s.abortUpgrade(result)
+		result.Error = err
+		return err
+	}
+	outcome, err := s.executeImport(job.SourcePath, importPath, job.KeepSeeding, s.copyProgressFunc(job.ID))
+	release()
+	if err == nil && result.pendingUpgradeID != 0 {
+		err = s.swapUpgrade(ctx, result)
+	}
 	if err != nil {
+		s.abortUpgrade(result)
 		result.Error = err
 		return err
 	}
-	result.LinkMode = linkMode
+	result.LinkMode = outcome.Mode
+	result.LinkFallbackReason = outcome.FallbackReason
 
 	s.queueMediaInfoProbe(result.DestinationPath, result.Match)
 
@@ -460,6 +530,9 @@ func (s *Service) finalizeImport(ctx context.Context, job ImportJob, result *Imp
 	if err := s.logImportHistory(ctx, result); err != nil {
 		s.logger.Warn().Err(err).Msg("Failed to log import history")
 	}
+	if !job.Manual {
+		s.recordAutomaticMatch(ctx, job.SourcePath, result.Match)
+	}
 
 	if s.health != nil {
 		s.health.ClearStatusStr("import", job.SourcePath)
@@ -519,10 +592,130 @@ func (s *Service) enforceUpgradePolicy(ctx context.Context, job ImportJob, match
 		Int("candidateQuality", match.CandidateQualityID).
 		Int("existingQuality", match.ExistingQualityID).
 		Msg("File is not a quality upgrade, rejecting")
-	s.recordImportDecision(ctx, job.SourcePath, "not_upgrade", match)
+	s.recordImportDecision(ctx, job, "not_upgrade", match)
 	return err
 }
 
+// enforceSizeLimits rejects automatic imports whose size per minute of runtime
+// falls outside the limits configured for the file's quality.
+func (s *Service) enforceSizeLimits(ctx context.Context, job ImportJob, match *LibraryMatch) error {
+	if job.Manual || s.quality == nil {
+		return nil
+	}
+	runtime := s.getMatchRuntime(ctx, match)
+	if runtime == 0 {
+		return nil
+	}
+
+	profileID := s.getQualityProfileID(ctx, match)
+	profile, err := s.quality.Get(ctx, profileID)
+	if err != nil {
+		return nil //nolint:nilerr // no profile means no size limits to enforce
+	}
+	parsed := scanner.ParsePath(job.SourcePath)
+	candidate := quality.MatchReleaseQuality(s.qualities(), filepath.Base(job.SourcePath), parsed.Quality, parsed.Source, profile)
+	if !candidate.Matches {
+		return nil
+	}
+
+	info, err := os.Stat(job.SourcePath)
+	if err != nil {
+		return fmt.Errorf("failed to stat source file: %w", err)
+	}
+	if profile.IsSizeAcceptable(candidate.MatchedQualityID, info.Size(), runtime) {
+		return nil
+	}
+
+	match.CandidateQualityID = candidate.MatchedQualityID
+	match.QualityProfileID = profileID
+	s.logger.Info().
+		Str("path", job.SourcePath).
+		Int("candidateQuality", candidate.MatchedQualityID).
+		Int64("size", info.Size()).
+		Int("runtime", runtime).
+		Msg("File size is outside quality limits, rejecting")
+	s.recordImportDecision(ctx, job, "size_out_of_range", match)
+	return ErrSizeOutOfRange
+}
+
+// enforceLanguageProfile rejects automatic imports whose audio languages are not
+// accepted by the required language profile of the matched movie or series.
+func (s *Service) enforceLanguageProfile(ctx context.Context, job ImportJob, match *LibraryMatch) error {
+	if job.Manual || s.language == nil {
+		return nil
+	}
+
+	var profile *language.Profile
+	var err error
+	switch {
+	case match.MediaType == mediaTypeMovie && match.MovieID != nil:
+		profile, err = s.language.ForMovie(ctx, *match.MovieID)
+	case match.MediaType == mediaTypeEpisode && match.SeriesID != nil:
+		profile, err = s.language.ForSeries(ctx, *match.SeriesID)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to get language profile: %w", err)
+	}
+	if profile == nil || !profile.Required {
+		return nil
+	}
+
+	languages := scanner.ParsePath(job.SourcePath).Languages
+	if profile.Accepts(languages) {
+		return nil
+	}
+
+	s.logger.Info().
+		Str("path", job.SourcePath).
+		Strs("languages", languages).
+		Str("languageProfile", profile.Name).
+		Msg("File language is not accepted by language profile, rejecting")
+	s.recordImportDecision(ctx, job, "language_not_accepted", match)
+	return ErrLanguageNotAccepted
+}
+
+// enforceReleaseGroups rejects automatic imports from a release group the
+// matched item's quality profile forbids.
+func (s *Service) enforceReleaseGroups(ctx context.Context, job ImportJob, match *LibraryMatch) error {
+	if job.Manual || s.quality == nil {
+		return nil
+	}
+	profileID := s.getQualityProfileID(ctx, match)
+	profile, err := s.quality.Get(ctx, profileID)
+	if err != nil {
+		return nil //nolint:nilerr // no profile means no release groups to enforce
+	}
+	group := scanner.ParsePath(job.SourcePath).ReleaseGroup
+	if !profile.ReleaseGroups.IsForbidden(group) {
+		return nil
+	}
+
+	match.QualityProfileID = profileID
+	s.logger.Info().
+		Str("path", job.SourcePath).
+		Str("releaseGroup", group).
+		Str("qualityProfile", profile.Name).
+		Msg("File release group is forbidden by quality profile, rejecting")
+	s.recordImportDecision(ctx, job, "release_group_forbidden", match)
+	return ErrReleaseGroupBlocked
+}
+
+// getMatchRuntime returns the runtime in minutes covered by the matched file, or 0 if unknown.
+// Multi-episode files cover the series runtime once per episode.
+func (s *Service) getMatchRuntime(ctx context.Context, match *LibraryMatch) int {
+	if match.MediaType == mediaTypeMovie && match.MovieID != nil {
+		if movie, err := s.movies.Get(ctx, *match.MovieID); err == nil {
+			return movie.Runtime
+		}
+	}
+	if match.MediaType == mediaTypeEpisode && match.SeriesID != nil {
+		if series, err := s.tv.GetSeries(ctx, *match.SeriesID); err == nil {
+			return series.Runtime * max(len(match.EpisodeIDs), 1)
+		}
+	}
+	return 0
+}
+
 // resolveSlotTarget evaluates slot assignment for multi-version imports.
 // Returns the target slot ID and any file to upgrade, or sets RequiresSlotSelection on result.
 //
@@ -552,7 +745,7 @@ func (s *Service) resolveSlotTarget(ctx context.Context, job ImportJob, match *L
 	targetSlotID = s.selectTargetSlot(job, slotResult)
 
 	if targetSlotID == nil && len(slotResult.Assignments) == 0 {
-		s.recordImportDecision(ctx, job.SourcePath, "not_acceptable", match)
+		s.recordImportDecision(ctx, job, "not_acceptable", match)
 		err := ErrNotAnUpgrade
 		result.Error = err
 		return nil, err
@@ -660,12 +853,17 @@ func (s *Service) assignFileToSlot(ctx context.Context, match *LibraryMatch, tar
 	}
 }
 
-// cleanupUpgradedFile deletes the old file and database record when an import is an upgrade.
+// cleanupUpgradedFile recycles or deletes the old file and removes its database
+// record when an import is an upgrade.
 func (s *Service) cleanupUpgradedFile(ctx context.Context, match *LibraryMatch, result *ImportResult, slotUpgradeFile *int64, destPath string, isMultiVersion bool) {
 	if !result.IsUpgrade || result.PreviousFile == "" {
 		return
 	}
-	if err := s.organizer.DeleteUpgradedFile(result.PreviousFile, destPath); err != nil {
+	defer s.finishUpgrade(result)
+
+	if recycled := s.recycleUpgradedFile(ctx, result.PreviousFile, destPath); recycled != "" {
+		result.RecycledFile = recycled
+	} else if err := s.organizer.DeleteUpgradedFile(result.PreviousFile, destPath); err != nil {
 		s.logger.Warn().Err(err).Str("file", result.PreviousFile).Msg("Failed to delete upgraded file")
 	}
 
@@ -841,16 +1039,17 @@ func isIntermediateLevelEnabled(mod module.Module, entity *module.MatchedEntity)
 	return true
 }
 
-// executeImport performs the actual file import using hardlink/copy.
-func (s *Service) executeImport(source, dest string) (organizer.LinkMode, error) {
+// executeImport performs the actual file import using hardlink/copy. When
+// keepSeeding is set the symlink fallback is skipped, since a symlink would
+// break once the seeding torrent is removed.
+func (s *Service) executeImport(source, dest string, keepSeeding bool, onProgress organizer.CopyProgressFunc) (organizer.ImportOutcome, error) {
 	// Ensure destination directory exists
 	destDir := filepath.Dir(dest)
 	if err := os.MkdirAll(destDir, 0o750); err != nil {
-		return "", fmt.Errorf("failed to create destination directory: %w", err)
+		return organizer.ImportOutcome{}, fmt.Errorf("failed to create destination directory: %w", err)
 	}
 
-	// Use organizer's ImportFile which handles hardlink/symlink/copy fallback
-	return s.organizer.ImportFile(source, dest)
+	return s.organizer.PlaceFile(source, dest, !keepSeeding, onProgress)
 }
 
 // logImportHistory logs the import to history.
@@ -877,12 +1076,18 @@ func (s *Service) logImportHistory(ctx context.Context, result *ImportResult) er
 		"isUpgrade":        result.IsUpgrade,
 		"previousFile":     result.PreviousFile,
 	}
+	if result.RecycledFile != "" {
+		data["recycledFile"] = result.RecycledFile
+	}
+	if result.LinkFallbackReason != "" {
+		data["linkFallbackReason"] = result.LinkFallbackReason
+	}
 
 	if result.IsUpgrade {
-		if q, ok := quality.GetQualityByID(result.Match.CandidateQualityID); ok {
+		if q, ok := s.qualities().GetQualityByID(result.Match.CandidateQualityID); ok {
 			data["newQuality"] = q.Name
 		}
-		if q, ok := quality.GetQualityByID(result.Match.ExistingQualityID); ok {
+		if q, ok := s.qualities().GetQualityByID(result.Match.ExistingQualityID); ok {
 			data["previousQuality"] = q.Name
 		}
 	}
@@ -942,18 +1147,25 @@ func (s *Service) findVideoFilesWithSettings(ctx context.Context, dir string, se
 	return files, err
 }
 
-// applyImportDelay waits for the configured import delay.
-func (s *Service) applyImportDelay(ctx context.Context, clientID int64) error {
-	client, err := s.downloader.Get(ctx, clientID)
+// applyImportDelay waits for the client's import delay for the download's
+// category. Seeding torrents on a client that imports them immediately skip
+// the delay and are marked to keep their source files intact.
+func (s *Service) applyImportDelay(ctx context.Context, job *ImportJob) error {
+	client, err := s.downloader.Get(ctx, job.DownloadMapping.DownloadClientID)
 	if err != nil {
 		return err
 	}
 
-	if client.ImportDelaySeconds > 0 {
+	if job.DownloadMapping.Seeding && client.ImportsSeedingImmediately() {
+		job.KeepSeeding = true
+		return nil
+	}
+
+	if delay := client.ImportDelayFor(job.DownloadMapping.ModuleType); delay > 0 {
 		select {
 		case <-ctx.Done():
 			return ctx.Err()
-		case <-time.After(time.Duration(client.ImportDelaySeconds) * time.Second):
+		case <-time.After(delay):
 			return nil
 		}
 	}
@@ -1047,7 +1259,7 @@ func (s *Service) resolveQualityID(ctx context.Context, match *LibraryMatch, sou
 	}
 
 	parsed := scanner.ParsePath(sourcePath)
-	candidateMatch := quality.MatchQuality(parsed.Quality, parsed.Source, profile)
+	candidateMatch := quality.MatchReleaseQuality(s.qualities(), filepath.Base(sourcePath), parsed.Quality, parsed.Source, profile)
 	if candidateMatch.Matches {
 		match.CandidateQualityID = candidateMatch.MatchedQualityID
 		match.QualityProfileID = qualityProfileID
@@ -1083,6 +1295,9 @@ func (s *Service) updateLibraryWithID(ctx context.Context, match *LibraryMatch,
 
 	parsed := scanner.ParsePath(sourcePath)
 	qualityStr := parsed.Quality
+	if q, ok := s.qualities().GetQualityByID(match.QualityOverride); ok && q.Resolution > 0 {
+		qualityStr = fmt.Sprintf("%dp", q.Resolution)
+	}
 	qualityID := s.getQualityIDPtr(match.CandidateQualityID)
 
 	if match.MediaType == mediaTypeMovie && match.MovieID != nil {
@@ -1137,7 +1352,7 @@ func (s *Service) addMovieFile(ctx context.Context, match *LibraryMatch, destPat
 }
 
 func (s *Service) addEpisodeFile(ctx context.Context, match *LibraryMatch, destPath, sourcePath string, stat os.FileInfo, qualityStr string, qualityID *int64, mediaInfo *mediainfo.MediaInfo) (*int64, error) {
-	file, err := s.tv.AddEpisodeFile(ctx, *match.EpisodeID, &tv.CreateEpisodeFileInput{
+	file, err := s.tv.AddMultiEpisodeFile(ctx, match.coveredEpisodeIDs(), &tv.CreateEpisodeFileInput{
 		Path:             destPath,
 		Size:             stat.Size(),
 		Quality:          qualityStr,
@@ -0,0 +1,85 @@
+package importer
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/slipstream/slipstream/internal/database/sqlc"
+	"github.com/slipstream/slipstream/internal/pathutil"
+)
+
+const recycleBinSettingKey = "import_recycle_bin_path"
+
+var ErrInvalidRecycleBin = errors.New("recycle bin must be an absolute path")
+
+// RecycleBinPath returns the folder replaced library files are moved to, or ""
+// when upgrades delete the replaced file outright.
+func (s *Service) RecycleBinPath(ctx context.Context) (string, error) {
+	row, err := s.queries.GetSetting(ctx, recycleBinSettingKey)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return "", nil
+		}
+		return "", err
+	}
+	return row.Value, nil
+}
+
+// SetRecycleBinPath sets the recycle bin folder. An empty path disables it.
+func (s *Service) SetRecycleBinPath(ctx context.Context, path string) (string, error) {
+	path = strings.TrimSpace(path)
+	if path == "" {
+		return "", s.queries.DeleteSetting(ctx, recycleBinSettingKey)
+	}
+	if !filepath.IsAbs(path) {
+		return "", ErrInvalidRecycleBin
+	}
+	path = filepath.Clean(path)
+	if err := os.MkdirAll(path, 0o750); err != nil {
+		return "", fmt.Errorf("failed to create recycle bin: %w", err)
+	}
+	if _, err := s.queries.SetSetting(ctx, sqlc.SetSettingParams{Key: recycleBinSettingKey, Value: path}); err != nil {
+		return "", err
+	}
+	return path, nil
+}
+
+// recycleUpgradedFile moves a file replaced by an upgrade into the recycle bin
+// so the import can be undone. It returns the recycled path, or "" when the
+// bin is disabled or the file could not be moved and should be deleted instead.
+func (s *Service) recycleUpgradedFile(ctx context.Context, oldPath, newPath string) string {
+	if oldPath == "" || pathutil.PathsEqual(oldPath, newPath) {
+		return ""
+	}
+	if _, err := os.Stat(oldPath); err != nil {
+		return ""
+	}
+	dest, err := s.RecycleFile(ctx, oldPath)
+	if err != nil {
+		s.logger.Warn().Err(err).Str("file", oldPath).Msg("Failed to recycle upgraded file")
+		return ""
+	}
+	return dest
+}
+
+// RecycleFile moves a library file into the recycle bin and returns its new
+// path, or "" when the bin is disabled.
+func (s *Service) RecycleFile(ctx context.Context, path string) (string, error) {
+	bin, err := s.RecycleBinPath(ctx)
+	if err != nil || bin == "" {
+		return "", err
+	}
+
+	// A folder per recycle keeps same-named files from different items apart.
+	dest := filepath.Join(bin, time.Now().UTC().Format("20060102-150405.000000000"), filepath.Base(path))
+	if err := s.organizer.MoveFile(path, dest); err != nil {
+		return "", err
+	}
+	return dest, nil
+}
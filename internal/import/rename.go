@@ -31,15 +31,6 @@ type RenamePreview struct {
 	Year         int    `json:"year,omitempty"`
 }
 
-// MassRenameResult contains results from a mass rename operation.
-type MassRenameResult struct {
-	Total     int             `json:"total"`
-	Succeeded int             `json:"succeeded"`
-	Failed    int             `json:"failed"`
-	Skipped   int             `json:"skipped"`
-	Results   []RenamePreview `json:"results"`
-}
-
 // GetRenamePreviewSeries returns a preview of files that would be renamed for series.
 func (s *Service) GetRenamePreviewSeries(ctx context.Context, seriesID *int64) ([]RenamePreview, error) {
 	var previews []RenamePreview
@@ -76,11 +67,12 @@ func (s *Service) GetRenamePreviewSeries(ctx context.Context, seriesID *int64) (
 		}
 
 		for _, ep := range episodes {
-			if ep.EpisodeFile == nil {
+			// Linked records of a multi-episode file are renamed with their primary
+			if ep.EpisodeFile == nil || ep.EpisodeFile.PrimaryFileID != nil {
 				continue
 			}
 
-			preview := s.computeEpisodeRenamePreview(series, &ep, rf.Path)
+			preview := s.computeEpisodeRenamePreview(ctx, series, &ep, rf.Path)
 			previews = append(previews, preview)
 		}
 	}
@@ -134,7 +126,7 @@ func (s *Service) collectMovieRenamePreviews(ctx context.Context, movie *movies.
 	var previews []RenamePreview
 	for i := range movie.MovieFiles {
 		file := &movie.MovieFiles[i]
-		previews = append(previews, s.computeMovieRenamePreview(movie, file, rf.Path))
+		previews = append(previews, s.computeMovieRenamePreview(ctx, movie, file, rf.Path))
 	}
 	return previews
 }
@@ -151,7 +143,7 @@ func (s *Service) ensureMovieFilesLoaded(ctx context.Context, movie *movies.Movi
 }
 
 // computeEpisodeRenamePreview computes the rename preview for a single episode.
-func (s *Service) computeEpisodeRenamePreview(series *tv.Series, ep *tv.Episode, rootPath string) RenamePreview {
+func (s *Service) computeEpisodeRenamePreview(ctx context.Context, series *tv.Series, ep *tv.Episode, rootPath string) RenamePreview {
 	preview := RenamePreview{
 		ID:              ep.EpisodeFile.ID,
 		MediaType:       mediaTypeEpisode,
@@ -183,6 +175,11 @@ func (s *Service) computeEpisodeRenamePreview(series *tv.Series, ep *tv.Episode,
 	if ep.AirDate != nil {
 		tokenData["AirDate"] = *ep.AirDate
 	}
+	if series.IsAnime() {
+		if abs, err := s.tv.GetAbsoluteNumber(ctx, series.ID, ep.SeasonNumber, ep.EpisodeNumber); err == nil && abs > 0 {
+			tokenData["AbsoluteNumber"] = abs
+		}
+	}
 
 	entity := &module.MatchedEntity{
 		ModuleType: module.TypeTV,
@@ -191,16 +188,20 @@ func (s *Service) computeEpisodeRenamePreview(series *tv.Series, ep *tv.Episode,
 		RootFolder: rootPath,
 		TokenData:  tokenData,
 	}
+	s.applyMultiEpisodeTokens(ctx, entity, ep.EpisodeFile.ID)
 
 	mi := &mediainfo.MediaInfo{
-		VideoCodec:    ep.EpisodeFile.VideoCodec,
-		AudioCodec:    ep.EpisodeFile.AudioCodec,
-		AudioChannels: ep.EpisodeFile.AudioChannels,
-		DynamicRange:  ep.EpisodeFile.DynamicRange,
+		VideoCodec:         ep.EpisodeFile.VideoCodec,
+		AudioCodec:         ep.EpisodeFile.AudioCodec,
+		AudioChannels:      ep.EpisodeFile.AudioChannels,
+		DynamicRange:       ep.EpisodeFile.DynamicRange,
+		DolbyVisionProfile: ep.EpisodeFile.DolbyVisionProfile,
+		AudioTracks:        ep.EpisodeFile.AudioTracks,
 	}
 
 	ext := filepath.Ext(ep.EpisodeFile.Path)
-	newPath, err := s.computeDestinationViaModule(context.Background(), entity, nil, mi, ext)
+	slot := s.fileSlot(ctx, mediaTypeEpisode, ep.EpisodeFile.ID)
+	newPath, err := s.computeSlotDestination(ctx, entity, slot, mi, ext)
 	if err != nil {
 		preview.Error = err.Error()
 		return preview
@@ -213,8 +214,24 @@ func (s *Service) computeEpisodeRenamePreview(series *tv.Series, ep *tv.Episode,
 	return preview
 }
 
+// applyMultiEpisodeTokens names a multi-episode file for every episode it covers.
+func (s *Service) applyMultiEpisodeTokens(ctx context.Context, entity *module.MatchedEntity, fileID int64) {
+	episodes, err := s.tv.FileEpisodes(ctx, fileID)
+	if err != nil || len(episodes) < 2 {
+		return
+	}
+	ids := make([]int64, 0, len(episodes))
+	numbers := make([]int, 0, len(episodes))
+	for _, e := range episodes {
+		ids = append(ids, e.ID)
+		numbers = append(numbers, e.EpisodeNumber)
+	}
+	entity.EntityIDs = ids
+	entity.TokenData["EpisodeNumbers"] = numbers
+}
+
 // computeMovieRenamePreview computes the rename preview for a single movie.
-func (s *Service) computeMovieRenamePreview(movie *movies.Movie, file *movies.MovieFile, rootPath string) RenamePreview {
+func (s *Service) computeMovieRenamePreview(ctx context.Context, movie *movies.Movie, file *movies.MovieFile, rootPath string) RenamePreview {
 	preview := RenamePreview{
 		ID:              file.ID,
 		MediaType:       "movie",
@@ -236,14 +253,17 @@ func (s *Service) computeMovieRenamePreview(movie *movies.Movie, file *movies.Mo
 	}
 
 	mi := &mediainfo.MediaInfo{
-		VideoCodec:    file.VideoCodec,
-		AudioCodec:    file.AudioCodec,
-		AudioChannels: file.AudioChannels,
-		DynamicRange:  file.DynamicRange,
+		VideoCodec:         file.VideoCodec,
+		AudioCodec:         file.AudioCodec,
+		AudioChannels:      file.AudioChannels,
+		DynamicRange:       file.DynamicRange,
+		DolbyVisionProfile: file.DolbyVisionProfile,
+		AudioTracks:        file.AudioTracks,
 	}
 
 	ext := filepath.Ext(file.Path)
-	newPath, err := s.computeDestinationViaModule(context.Background(), entity, nil, mi, ext)
+	slot := s.fileSlot(ctx, mediaTypeMovie, file.ID)
+	newPath, err := s.computeSlotDestination(ctx, entity, slot, mi, ext)
 	if err != nil {
 		preview.Error = err.Error()
 		return preview
@@ -256,155 +276,110 @@ func (s *Service) computeMovieRenamePreview(movie *movies.Movie, file *movies.Mo
 	return preview
 }
 
-// ExecuteMassRename performs mass rename operations for the specified items.
-func (s *Service) ExecuteMassRename(ctx context.Context, mediaType string, fileIDs []int64) (*MassRenameResult, error) {
-	result := &MassRenameResult{
-		Total:   len(fileIDs),
-		Results: make([]RenamePreview, 0, len(fileIDs)),
-	}
+// renameOutcome is what happened to one file of a mass rename.
+type renameOutcome int
 
-	switch mediaType {
-	case mediaTypeEpisode, "series":
-		return s.executeMassRenameEpisodes(ctx, fileIDs, result)
-	case mediaTypeMovie:
-		return s.executeMassRenameMovies(ctx, fileIDs, result)
-	}
+const (
+	renameSucceeded renameOutcome = iota
+	renameFailed
+	renameSkipped
+)
 
-	return nil, fmt.Errorf("invalid media type: %s", mediaType)
+// renameFileByID renames one file of a mass rename to its current naming.
+func (s *Service) renameFileByID(ctx context.Context, mediaType string, fileID int64) (RenamePreview, renameOutcome) {
+	if mediaType == mediaTypeMovie {
+		return s.renameMovieFile(ctx, fileID)
+	}
+	return s.renameEpisodeFile(ctx, fileID)
 }
 
-// executeMassRenameEpisodes renames episode files.
-func (s *Service) executeMassRenameEpisodes(ctx context.Context, fileIDs []int64, result *MassRenameResult) (*MassRenameResult, error) {
-	for _, fileID := range fileIDs {
-		// Get file info - need to find which episode this file belongs to
-		file, episode, series, err := s.getEpisodeFileInfo(ctx, fileID)
-		if err != nil {
-			result.Failed++
-			result.Results = append(result.Results, RenamePreview{
-				ID:        fileID,
-				MediaType: mediaTypeEpisode,
-				Error:     err.Error(),
-			})
-			continue
-		}
-
-		// Get root folder
-		rf, err := s.rootfolder.Get(ctx, series.RootFolderID)
-		if err != nil {
-			result.Failed++
-			result.Results = append(result.Results, RenamePreview{
-				ID:          fileID,
-				MediaType:   mediaTypeEpisode,
-				CurrentPath: file.Path,
-				Error:       fmt.Sprintf("failed to get root folder: %v", err),
-			})
-			continue
-		}
-
-		// Compute new path
-		preview := s.computeEpisodeRenamePreview(series, episode, rf.Path)
-		preview.ID = fileID
-
-		if !preview.NeedsRename {
-			result.Skipped++
-			result.Results = append(result.Results, preview)
-			continue
-		}
+// renameEpisodeFile renames an episode file.
+func (s *Service) renameEpisodeFile(ctx context.Context, fileID int64) (RenamePreview, renameOutcome) {
+	// Get file info - need to find which episode this file belongs to
+	file, episode, series, err := s.getEpisodeFileInfo(ctx, fileID)
+	if err != nil {
+		return RenamePreview{ID: fileID, MediaType: mediaTypeEpisode, Error: err.Error()}, renameFailed
+	}
 
-		if preview.Error != "" {
-			result.Failed++
-			result.Results = append(result.Results, preview)
-			continue
-		}
+	// Get root folder
+	rf, err := s.rootfolder.Get(ctx, series.RootFolderID)
+	if err != nil {
+		return RenamePreview{
+			ID:          fileID,
+			MediaType:   mediaTypeEpisode,
+			CurrentPath: file.Path,
+			Error:       fmt.Sprintf("failed to get root folder: %v", err),
+		}, renameFailed
+	}
 
-		// Perform the rename
-		if err := s.renameFile(preview.CurrentPath, preview.NewPath); err != nil {
-			result.Failed++
-			preview.Error = err.Error()
-			result.Results = append(result.Results, preview)
-			continue
-		}
+	// Compute new path
+	preview := s.computeEpisodeRenamePreview(ctx, series, episode, rf.Path)
+	preview.ID = fileID
 
-		// Update database with new path
-		if err := s.tv.UpdateEpisodeFilePath(ctx, fileID, preview.NewPath); err != nil {
-			s.logger.Warn().Err(err).Int64("fileId", fileID).Msg("Failed to update file path in database")
-		}
+	if !preview.NeedsRename {
+		return preview, renameSkipped
+	}
+	if preview.Error != "" {
+		return preview, renameFailed
+	}
 
-		result.Succeeded++
-		result.Results = append(result.Results, preview)
+	// Perform the rename
+	if err := s.renameFile(preview.CurrentPath, preview.NewPath); err != nil {
+		preview.Error = err.Error()
+		return preview, renameFailed
+	}
 
-		// Log to history
-		s.logRenameToHistory(ctx, mediaTypeEpisode, episode.ID, &preview)
+	// Update database with new path
+	if err := s.tv.UpdateEpisodeFilePath(ctx, fileID, preview.NewPath); err != nil {
+		s.logger.Warn().Err(err).Int64("fileId", fileID).Msg("Failed to update file path in database")
 	}
 
-	return result, nil
+	s.logRenameToHistory(ctx, mediaTypeEpisode, episode.ID, &preview)
+	return preview, renameSucceeded
 }
 
-// executeMassRenameMovies renames movie files.
-func (s *Service) executeMassRenameMovies(ctx context.Context, fileIDs []int64, result *MassRenameResult) (*MassRenameResult, error) {
-	for _, fileID := range fileIDs {
-		// Get file info
-		file, movie, err := s.getMovieFileInfo(ctx, fileID)
-		if err != nil {
-			result.Failed++
-			result.Results = append(result.Results, RenamePreview{
-				ID:        fileID,
-				MediaType: "movie",
-				Error:     err.Error(),
-			})
-			continue
-		}
-
-		// Get root folder
-		rf, err := s.rootfolder.Get(ctx, movie.RootFolderID)
-		if err != nil {
-			result.Failed++
-			result.Results = append(result.Results, RenamePreview{
-				ID:          fileID,
-				MediaType:   "movie",
-				CurrentPath: file.Path,
-				Error:       fmt.Sprintf("failed to get root folder: %v", err),
-			})
-			continue
-		}
-
-		// Compute new path
-		preview := s.computeMovieRenamePreview(movie, file, rf.Path)
-		preview.ID = fileID
-
-		if !preview.NeedsRename {
-			result.Skipped++
-			result.Results = append(result.Results, preview)
-			continue
-		}
+// renameMovieFile renames a movie file.
+func (s *Service) renameMovieFile(ctx context.Context, fileID int64) (RenamePreview, renameOutcome) {
+	file, movie, err := s.getMovieFileInfo(ctx, fileID)
+	if err != nil {
+		return RenamePreview{ID: fileID, MediaType: mediaTypeMovie, Error: err.Error()}, renameFailed
+	}
 
-		if preview.Error != "" {
-			result.Failed++
-			result.Results = append(result.Results, preview)
-			continue
-		}
+	// Get root folder
+	rf, err := s.rootfolder.Get(ctx, movie.RootFolderID)
+	if err != nil {
+		return RenamePreview{
+			ID:          fileID,
+			MediaType:   mediaTypeMovie,
+			CurrentPath: file.Path,
+			Error:       fmt.Sprintf("failed to get root folder: %v", err),
+		}, renameFailed
+	}
 
-		// Perform the rename
-		if err := s.renameFile(preview.CurrentPath, preview.NewPath); err != nil {
-			result.Failed++
-			preview.Error = err.Error()
-			result.Results = append(result.Results, preview)
-			continue
-		}
+	// Compute new path
+	preview := s.computeMovieRenamePreview(ctx, movie, file, rf.Path)
+	preview.ID = fileID
 
-		// Update database with new path
-		if err := s.movies.UpdateMovieFilePath(ctx, fileID, preview.NewPath); err != nil {
-			s.logger.Warn().Err(err).Int64("fileId", fileID).Msg("Failed to update file path in database")
-		}
+	if !preview.NeedsRename {
+		return preview, renameSkipped
+	}
+	if preview.Error != "" {
+		return preview, renameFailed
+	}
 
-		result.Succeeded++
-		result.Results = append(result.Results, preview)
+	// Perform the rename
+	if err := s.renameFile(preview.CurrentPath, preview.NewPath); err != nil {
+		preview.Error = err.Error()
+		return preview, renameFailed
+	}
 
-		// Log to history
-		s.logRenameToHistory(ctx, mediaTypeMovie, movie.ID, &preview)
+	// Update database with new path
+	if err := s.movies.UpdateMovieFilePath(ctx, fileID, preview.NewPath); err != nil {
+		s.logger.Warn().Err(err).Int64("fileId", fileID).Msg("Failed to update file path in database")
 	}
 
-	return result, nil
+	s.logRenameToHistory(ctx, mediaTypeMovie, movie.ID, &preview)
+	return preview, renameSucceeded
 }
 
 // getEpisodeFileInfo retrieves file, episode, and series info for a file ID.
@@ -414,6 +389,12 @@ func (s *Service) getEpisodeFileInfo(ctx context.Context, fileID int64) (*tv.Epi
 	if err != nil {
 		return nil, nil, nil, fmt.Errorf("failed to get episode file: %w", err)
 	}
+	// A multi-episode file is renamed through its primary record
+	if file.PrimaryFileID != nil {
+		if file, err = s.tv.GetEpisodeFileByID(ctx, *file.PrimaryFileID); err != nil {
+			return nil, nil, nil, fmt.Errorf("failed to get episode file: %w", err)
+		}
+	}
 
 	// Get the episode
 	episode, err := s.tv.GetEpisode(ctx, file.EpisodeID)
@@ -0,0 +1,268 @@
+package importer
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/slipstream/slipstream/internal/database/sqlc"
+)
+
+// renameChunkSize is how many files a mass rename processes between checkpoints.
+const renameChunkSize = 50
+
+// maxRenameErrors caps the failures a mass rename keeps for display.
+const maxRenameErrors = 100
+
+var (
+	ErrRenameNotFound         = errors.New("rename not found")
+	ErrInvalidRenameMediaType = errors.New("mediaType must be 'series', 'episode' or 'movie'")
+)
+
+// RenameStatus is the state of a mass rename.
+type RenameStatus string
+
+const (
+	RenameStatusRunning   RenameStatus = "running"
+	RenameStatusCompleted RenameStatus = "completed"
+	RenameStatusCancelled RenameStatus = "cancelled"
+)
+
+// RenameProgress is a snapshot of a mass rename, broadcast as rename:started,
+// rename:progress and rename:completed.
+type RenameProgress struct {
+	ID          string          `json:"id"`
+	MediaType   string          `json:"mediaType"`
+	Status      RenameStatus    `json:"status"`
+	Total       int             `json:"total"`
+	Done        int             `json:"done"`
+	Succeeded   int             `json:"succeeded"`
+	Failed      int             `json:"failed"`
+	Skipped     int             `json:"skipped"`
+	CurrentFile string          `json:"currentFile,omitempty"`
+	Errors      []RenamePreview `json:"errors,omitempty"` // First maxRenameErrors failures
+	Resumed     bool            `json:"resumed,omitempty"`
+	StartedAt   time.Time       `json:"startedAt"`
+}
+
+// renameJob is a mass rename running in the background.
+type renameJob struct {
+	progress RenameProgress
+	fileIDs  []int64
+	ctx      context.Context
+	cancel   context.CancelFunc
+	lastSent time.Time
+}
+
+// StartMassRename records a mass rename and runs it in the background,
+// checkpointing every renameChunkSize files so it survives a restart.
+func (s *Service) StartMassRename(ctx context.Context, mediaType string, fileIDs []int64) (*RenameProgress, error) {
+	switch mediaType {
+	case mediaTypeEpisode, mediaSeries:
+		mediaType = mediaTypeEpisode
+	case mediaTypeMovie:
+	default:
+		return nil, ErrInvalidRenameMediaType
+	}
+
+	ids, err := json.Marshal(fileIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode file IDs: %w", err)
+	}
+	row, err := s.queries.CreateRenameJob(ctx, sqlc.CreateRenameJobParams{
+		ID:        uuid.NewString(),
+		MediaType: mediaType,
+		FileIds:   string(ids),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to record rename: %w", err)
+	}
+
+	job := s.registerRename(row, fileIDs)
+	snapshot := s.renameSnapshot(job)
+	go s.runRename(job)
+	return &snapshot, nil
+}
+
+// CancelRename stops a running mass rename after the file in progress.
+func (s *Service) CancelRename(id string) error {
+	s.mu.Lock()
+	job, ok := s.renames[id]
+	s.mu.Unlock()
+	if !ok {
+		return ErrRenameNotFound
+	}
+	job.cancel()
+	return nil
+}
+
+// ActiveRenames returns snapshots of running mass renames, oldest first.
+func (s *Service) ActiveRenames() []RenameProgress {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	ops := make([]RenameProgress, 0, len(s.renames))
+	for _, job := range s.renames {
+		ops = append(ops, job.progress)
+	}
+	sort.Slice(ops, func(i, j int) bool { return ops[i].StartedAt.Before(ops[j].StartedAt) })
+	return ops
+}
+
+// resumeRenames restarts mass renames interrupted by a shutdown from their
+// last checkpoint. Files already renamed past it are skipped as up to date.
+func (s *Service) resumeRenames(ctx context.Context) {
+	rows, err := s.queries.ListRenameJobs(ctx)
+	if err != nil {
+		s.logger.Warn().Err(err).Msg("Failed to list interrupted renames")
+		return
+	}
+	for _, row := range rows {
+		var fileIDs []int64
+		if err := json.Unmarshal([]byte(row.FileIds), &fileIDs); err != nil {
+			s.logger.Warn().Err(err).Str("renameId", row.ID).Msg("Dropping rename with unreadable file list")
+			_ = s.queries.DeleteRenameJob(ctx, row.ID)
+			continue
+		}
+		job := s.registerRename(row, fileIDs)
+		job.progress.Resumed = true
+		s.logger.Info().
+			Str("renameId", row.ID).
+			Int("done", job.progress.Done).
+			Int("total", job.progress.Total).
+			Msg("Resuming interrupted rename")
+		go s.runRename(job)
+	}
+}
+
+func (s *Service) registerRename(row *sqlc.RenameJob, fileIDs []int64) *renameJob {
+	ctx, cancel := context.WithCancel(context.Background())
+	job := &renameJob{
+		progress: RenameProgress{
+			ID:        row.ID,
+			MediaType: row.MediaType,
+			Status:    RenameStatusRunning,
+			Total:     len(fileIDs),
+			Done:      int(row.NextIndex),
+			Succeeded: int(row.Succeeded),
+			Failed:    int(row.Failed),
+			Skipped:   int(row.Skipped),
+			StartedAt: row.CreatedAt,
+		},
+		fileIDs: fileIDs,
+		ctx:     ctx,
+		cancel:  cancel,
+	}
+	s.mu.Lock()
+	s.renames[row.ID] = job
+	s.mu.Unlock()
+	return job
+}
+
+// runRename renames the job's remaining files, broadcasting throttled progress.
+func (s *Service) runRename(job *renameJob) {
+	defer job.cancel()
+	s.broadcastRename("rename:started", job)
+
+	for i := job.progress.Done; i < len(job.fileIDs); i++ {
+		if job.ctx.Err() != nil {
+			break
+		}
+		// Cancellation only takes effect between files, so a file is never
+		// renamed on disk without its record following.
+		preview, outcome := s.renameFileByID(context.Background(), job.progress.MediaType, job.fileIDs[i])
+		s.recordRenameOutcome(job, i+1, &preview, outcome)
+
+		if (i+1)%renameChunkSize == 0 {
+			s.checkpointRename(job)
+		}
+		if time.Since(job.lastSent) >= progressInterval {
+			s.broadcastRename("rename:progress", job)
+		}
+	}
+
+	s.finishRename(job)
+}
+
+func (s *Service) recordRenameOutcome(job *renameJob, done int, preview *RenamePreview, outcome renameOutcome) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	p := &job.progress
+	p.Done = done
+	p.CurrentFile = preview.NewFilename
+	if p.CurrentFile == "" {
+		p.CurrentFile = preview.CurrentFilename
+	}
+	switch outcome {
+	case renameSucceeded:
+		p.Succeeded++
+	case renameSkipped:
+		p.Skipped++
+	case renameFailed:
+		p.Failed++
+		if len(p.Errors) < maxRenameErrors {
+			p.Errors = append(p.Errors, *preview)
+		}
+	}
+}
+
+func (s *Service) checkpointRename(job *renameJob) {
+	p := s.renameSnapshot(job)
+	err := s.queries.UpdateRenameJobProgress(context.Background(), sqlc.UpdateRenameJobProgressParams{
+		NextIndex: int64(p.Done),
+		Succeeded: int64(p.Succeeded),
+		Failed:    int64(p.Failed),
+		Skipped:   int64(p.Skipped),
+		ID:        p.ID,
+	})
+	if err != nil {
+		s.logger.Warn().Err(err).Str("renameId", p.ID).Msg("Failed to checkpoint rename")
+	}
+}
+
+// finishRename drops the job's checkpoint and announces the result.
+func (s *Service) finishRename(job *renameJob) {
+	if err := s.queries.DeleteRenameJob(context.Background(), job.progress.ID); err != nil {
+		s.logger.Warn().Err(err).Str("renameId", job.progress.ID).Msg("Failed to remove finished rename")
+	}
+
+	s.mu.Lock()
+	job.progress.Status = RenameStatusCompleted
+	if job.progress.Done < job.progress.Total {
+		job.progress.Status = RenameStatusCancelled
+	}
+	job.progress.CurrentFile = ""
+	delete(s.renames, job.progress.ID)
+	s.mu.Unlock()
+
+	p := s.renameSnapshot(job)
+	s.logger.Info().
+		Str("renameId", p.ID).
+		Str("status", string(p.Status)).
+		Int("succeeded", p.Succeeded).
+		Int("failed", p.Failed).
+		Int("skipped", p.Skipped).
+		Msg("Mass rename finished")
+	s.broadcastRename("rename:completed", job)
+}
+
+func (s *Service) renameSnapshot(job *renameJob) RenameProgress {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	p := job.progress
+	p.Errors = append([]RenamePreview(nil), job.progress.Errors...)
+	return p
+}
+
+func (s *Service) broadcastRename(event string, job *renameJob) {
+	job.lastSent = time.Now()
+	if s.hub != nil {
+		s.hub.Broadcast(event, s.renameSnapshot(job))
+	}
+}
@@ -0,0 +1,84 @@
+package importer
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/slipstream/slipstream/internal/database/sqlc"
+	"github.com/slipstream/slipstream/internal/testutil"
+)
+
+func TestStartMassRename_InvalidMediaType(t *testing.T) {
+	tdb := testutil.NewTestDB(t)
+	defer tdb.Close()
+
+	svc := NewService(tdb.Conn, nil, nil, nil, nil, nil, nil, nil, Config{}, &tdb.Logger, nil, nil, nil, nil, nil)
+
+	if _, err := svc.StartMassRename(context.Background(), "music", []int64{1}); !errors.Is(err, ErrInvalidRenameMediaType) {
+		t.Errorf("StartMassRename(music) error = %v, want ErrInvalidRenameMediaType", err)
+	}
+	if err := svc.CancelRename("missing"); !errors.Is(err, ErrRenameNotFound) {
+		t.Errorf("CancelRename(missing) error = %v, want ErrRenameNotFound", err)
+	}
+}
+
+func TestResumeRenames_ContinuesFromCheckpoint(t *testing.T) {
+	tdb := testutil.NewTestDB(t)
+	defer tdb.Close()
+
+	svc := NewService(tdb.Conn, nil, nil, nil, nil, nil, nil, nil, Config{}, &tdb.Logger, nil, nil, nil, nil, nil)
+	ctx := context.Background()
+	queries := sqlc.New(tdb.Conn)
+
+	if _, err := queries.CreateRenameJob(ctx, sqlc.CreateRenameJobParams{ID: "r1", MediaType: mediaTypeMovie, FileIds: "[4,5]"}); err != nil {
+		t.Fatalf("CreateRenameJob error = %v", err)
+	}
+	if err := queries.UpdateRenameJobProgress(ctx, sqlc.UpdateRenameJobProgressParams{NextIndex: 2, Succeeded: 2, ID: "r1"}); err != nil {
+		t.Fatalf("UpdateRenameJobProgress error = %v", err)
+	}
+
+	// Every file is past the checkpoint, so the resumed rename finishes
+	// without touching any file and removes its checkpoint.
+	svc.resumeRenames(ctx)
+	deadline := time.Now().Add(2 * time.Second)
+	for {
+		rows, err := queries.ListRenameJobs(ctx)
+		if err != nil {
+			t.Fatalf("ListRenameJobs error = %v", err)
+		}
+		if len(rows) == 0 && len(svc.ActiveRenames()) == 0 {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("resumed rename still pending: %d rows, %d active", len(rows), len(svc.ActiveRenames()))
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func TestRecordRenameOutcome(t *testing.T) {
+	tdb := testutil.NewTestDB(t)
+	defer tdb.Close()
+
+	svc := NewService(tdb.Conn, nil, nil, nil, nil, nil, nil, nil, Config{}, &tdb.Logger, nil, nil, nil, nil, nil)
+	job := &renameJob{progress: RenameProgress{Total: maxRenameErrors + 3}}
+
+	svc.recordRenameOutcome(job, 1, &RenamePreview{NewFilename: "a.mkv"}, renameSucceeded)
+	svc.recordRenameOutcome(job, 2, &RenamePreview{CurrentFilename: "b.mkv"}, renameSkipped)
+	for i := 0; i <= maxRenameErrors; i++ {
+		svc.recordRenameOutcome(job, 3+i, &RenamePreview{Error: "boom"}, renameFailed)
+	}
+
+	p := svc.renameSnapshot(job)
+	if p.Succeeded != 1 || p.Skipped != 1 || p.Failed != maxRenameErrors+1 {
+		t.Errorf("counts = %d/%d/%d, want 1/1/%d", p.Succeeded, p.Skipped, p.Failed, maxRenameErrors+1)
+	}
+	if len(p.Errors) != maxRenameErrors {
+		t.Errorf("kept %d errors, want %d", len(p.Errors), maxRenameErrors)
+	}
+	if p.Done != maxRenameErrors+3 {
+		t.Errorf("done = %d, want %d", p.Done, maxRenameErrors+3)
+	}
+}
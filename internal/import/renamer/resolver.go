@@ -47,7 +47,7 @@ func DefaultSettings() Settings {
 		Patterns: map[string]string{
 			"movie-folder":          "{Movie Title} ({Year})",
 			"movie-file":            "{Movie Title} ({Year}) - {Quality Title}",
-			"series-folder":         "{Series Title}",
+			"series-folder":         "{Series TitleYear}",
 			"season-folder":         "Season {season}",
 			"specials-folder":       "Specials",
 			"episode-file.standard": "{Series Title} - S{season:00}E{episode:00} - {Quality Title} {MediaInfo VideoDynamicRangeType}",
@@ -258,6 +258,28 @@ func TestResolveContext_MovieWithEdition(t *testing.T) {
 	}
 }
 
+func TestResolveContext_MovieWithPlexEdition(t *testing.T) {
+	settings := DefaultSettings()
+	settings.Patterns["movie-file"] = "{Movie Title} ({Year}) {Edition Plex}"
+	r := NewResolver(&settings)
+
+	ctx := &TokenContext{
+		MovieTitle:  "Blade Runner",
+		MovieYear:   1982,
+		EditionTags: "Final Cut",
+	}
+
+	got, err := r.ResolveContext("movie-file", ctx, ".mkv")
+	if err != nil {
+		t.Fatalf("error = %v", err)
+	}
+
+	want := "Blade Runner (1982) {edition-Final Cut}.mkv"
+	if got != want {
+		t.Errorf("got = %q, want %q", got, want)
+	}
+}
+
 // ===== FOLDER NAME RESOLUTION =====
 
 func TestResolveContext_SeriesFolder(t *testing.T) {
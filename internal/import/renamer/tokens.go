@@ -34,13 +34,15 @@ type TokenContext struct {
 	Revision string // "Proper", "Repack", "v2", etc.
 
 	// MediaInfo (from actual file analysis)
-	VideoCodec        string
-	VideoBitDepth     int
-	VideoDynamicRange string // "HDR10", "DV", "SDR", etc.
-	AudioCodec        string
-	AudioChannels     string
-	AudioLanguages    []string
-	SubtitleLanguages []string
+	VideoCodec         string
+	VideoBitDepth      int
+	VideoDynamicRange  string // "HDR10", "DV", "SDR", etc.
+	DolbyVisionProfile string // "5", "8.1", etc.
+	AudioCodec         string
+	AudioChannels      string
+	AudioTracks        []string // "TrueHD Atmos 7.1 EN", one per track
+	AudioLanguages     []string
+	SubtitleLanguages  []string
 
 	// Other
 	ReleaseGroup   string
@@ -53,6 +55,10 @@ type TokenContext struct {
 	// Movie info (for movie renaming)
 	MovieTitle string
 	MovieYear  int
+
+	// Version slot info (multi-version mode)
+	SlotName   string // "4K", "1080p", etc.
+	SlotNumber int
 }
 
 // Token represents a parsed token from a format pattern.
@@ -146,6 +152,8 @@ func (t *Token) resolveValue(ctx *TokenContext) string {
 		return t.resolveMediaInfoToken(name, ctx)
 	case strings.HasPrefix(name, "movie "):
 		return t.resolveMovieToken(name, ctx)
+	case strings.HasPrefix(name, "slot "):
+		return t.resolveSlotToken(name, ctx)
 	default:
 		return t.resolveSimpleToken(name, ctx)
 	}
@@ -223,6 +231,16 @@ func (t *Token) resolveMediaInfoToken(name string, ctx *TokenContext) string {
 		return t.formatLanguages(ctx.AudioLanguages)
 	case "mediainfo subtitlelanguages":
 		return t.formatLanguages(ctx.SubtitleLanguages)
+	case "mediainfo audiotracks":
+		if len(ctx.AudioTracks) == 0 {
+			return ""
+		}
+		return "[" + strings.Join(ctx.AudioTracks, "+") + "]"
+	case "mediainfo dolbyvisionprofile":
+		if ctx.DolbyVisionProfile == "" {
+			return ""
+		}
+		return "DV P" + ctx.DolbyVisionProfile
 	default:
 		return t.resolveMediaInfoField(name, ctx)
 	}
@@ -269,6 +287,12 @@ func (t *Token) resolveOtherToken(name string, ctx *TokenContext) string {
 		return ctx.Revision
 	case "edition tags":
 		return ctx.EditionTags
+	case "edition plex":
+		// Plex and Jellyfin read the edition from a {edition-...} tag
+		if ctx.EditionTags != "" {
+			return "{edition-" + ctx.EditionTags + "}"
+		}
+		return ""
 	default:
 		return ""
 	}
@@ -308,6 +332,26 @@ func (t *Token) resolveMovieToken(name string, ctx *TokenContext) string {
 	}
 }
 
+func (t *Token) resolveSlotToken(name string, ctx *TokenContext) string {
+	switch name {
+	case "slot name":
+		return ctx.SlotName
+	case "slot number":
+		if ctx.SlotNumber > 0 {
+			return t.formatNumber(ctx.SlotNumber)
+		}
+		return ""
+	case "slot tag":
+		// Plex and Jellyfin group "Title - [Version]" files as versions of one item
+		if ctx.SlotName != "" {
+			return "[" + ctx.SlotName + "]"
+		}
+		return ""
+	default:
+		return ""
+	}
+}
+
 func (t *Token) resolveSimpleToken(name string, ctx *TokenContext) string {
 	switch name {
 	case "season":
@@ -537,12 +581,15 @@ func GetAllTokenNames() []string {
 		"MediaInfo VideoBitDepth", "MediaInfo VideoDynamicRange", "MediaInfo VideoDynamicRangeType",
 		"MediaInfo AudioCodec", "MediaInfo AudioChannels",
 		"MediaInfo AudioLanguages", "MediaInfo SubtitleLanguages",
+		"MediaInfo DolbyVisionProfile", "MediaInfo AudioTracks",
 		// Other tokens
-		"Release Group", "Edition Tags", "Custom Formats", "Original Title", "Original Filename", "Revision",
+		"Release Group", "Edition Tags", "Edition Plex", "Custom Formats", "Original Title", "Original Filename", "Revision",
 		// Anime tokens
 		"absolute", "version",
 		// Movie tokens
 		"Movie Title", "Movie TitleYear", "Movie CleanTitle", "Movie CleanTitleYear", "Year",
+		// Version slot tokens
+		"Slot Name", "Slot Number", "Slot Tag",
 	}
 }
 
@@ -771,6 +771,50 @@ func TestToken_MediaInfoAudioLanguages(t *testing.T) {
 	}
 }
 
+func TestToken_MediaInfoDetails(t *testing.T) {
+	tests := []struct {
+		name    string
+		ctx     *TokenContext
+		pattern string
+		want    string
+	}{
+		{
+			name:    "dolby vision profile",
+			ctx:     &TokenContext{DolbyVisionProfile: "8.1"},
+			pattern: "{MediaInfo DolbyVisionProfile}",
+			want:    "DV P8.1",
+		},
+		{
+			name:    "no dolby vision",
+			ctx:     &TokenContext{},
+			pattern: "{MediaInfo DolbyVisionProfile}",
+			want:    "",
+		},
+		{
+			name:    "audio tracks",
+			ctx:     &TokenContext{AudioTracks: []string{"TrueHD Atmos 7.1 EN", "AC3 5.1 DE"}},
+			pattern: "{MediaInfo AudioTracks}",
+			want:    "[TrueHD Atmos 7.1 EN+AC3 5.1 DE]",
+		},
+		{
+			name:    "no audio tracks",
+			ctx:     &TokenContext{},
+			pattern: "{MediaInfo AudioTracks}",
+			want:    "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tokens := ParseTokens(tt.pattern)
+			got := tokens[0].Resolve(tt.ctx)
+			if got != tt.want {
+				t.Errorf("Resolve() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
 func TestToken_MediaInfoSubtitleLanguages(t *testing.T) {
 	tests := []struct {
 		name    string
@@ -870,6 +914,53 @@ func TestToken_EditionTags(t *testing.T) {
 	}
 }
 
+func TestToken_EditionPlex(t *testing.T) {
+	tests := []struct {
+		name string
+		ctx  *TokenContext
+		want string
+	}{
+		{"Directors Cut", &TokenContext{EditionTags: "Director's Cut"}, "{edition-Director's Cut}"},
+		{"empty", &TokenContext{}, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tokens := ParseTokens("{Edition Plex}")
+			got := tokens[0].Resolve(tt.ctx)
+			if got != tt.want {
+				t.Errorf("Resolve() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestToken_Slot(t *testing.T) {
+	tests := []struct {
+		name    string
+		pattern string
+		ctx     *TokenContext
+		want    string
+	}{
+		{"name", "{Slot Name}", &TokenContext{SlotName: "4K", SlotNumber: 1}, "4K"},
+		{"number", "{Slot Number}", &TokenContext{SlotName: "4K", SlotNumber: 2}, "2"},
+		{"padded number", "{Slot Number:00}", &TokenContext{SlotNumber: 2}, "02"},
+		{"tag", "{Slot Tag}", &TokenContext{SlotName: "1080p"}, "[1080p]"},
+		{"no slot", "{Slot Tag}", &TokenContext{}, ""},
+		{"no slot number", "{Slot Number}", &TokenContext{}, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tokens := ParseTokens(tt.pattern)
+			got := tokens[0].Resolve(tt.ctx)
+			if got != tt.want {
+				t.Errorf("Resolve() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
 func TestToken_CustomFormats(t *testing.T) {
 	tests := []struct {
 		name string
@@ -1235,9 +1326,11 @@ func TestIsValidTokenName(t *testing.T) {
 		"MediaInfo VideoBitDepth", "MediaInfo VideoDynamicRange", "MediaInfo VideoDynamicRangeType",
 		"MediaInfo AudioCodec", "MediaInfo AudioChannels",
 		"MediaInfo AudioLanguages", "MediaInfo SubtitleLanguages",
-		"Release Group", "Edition Tags", "Custom Formats", "Original Title", "Original Filename", "Revision",
+		"MediaInfo DolbyVisionProfile", "MediaInfo AudioTracks",
+		"Release Group", "Edition Tags", "Edition Plex", "Custom Formats", "Original Title", "Original Filename", "Revision",
 		"absolute", "version",
 		"Movie Title", "Movie TitleYear", "Movie CleanTitle", "Movie CleanTitleYear", "Year",
+		"Slot Name", "Slot Number", "Slot Tag",
 		"Custom Format:Remux",
 	}
 
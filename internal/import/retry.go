@@ -50,6 +50,9 @@ func classifyError(err error) RetryPolicy {
 		ErrPathTooLong,
 		ErrFileAlreadyInLibrary,
 		ErrNotAnUpgrade,
+		ErrSizeOutOfRange,
+		ErrLanguageNotAccepted,
+		ErrReleaseGroupBlocked,
 	}
 
 	for _, permErr := range permanentErrors {
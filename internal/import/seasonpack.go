@@ -406,6 +406,10 @@ func (s *Service) populateNullableFields(mapping *DownloadMapping, m *sqlc.Downl
 		id := m.TargetSlotID.Int64
 		mapping.TargetSlotID = &id
 	}
+	if m.IndexerID.Valid {
+		id := m.IndexerID.Int64
+		mapping.IndexerID = &id
+	}
 	// Derive legacy pointer fields from discriminator columns
 	switch m.ModuleType {
 	case mediaTypeMovie:
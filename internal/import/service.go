@@ -15,6 +15,8 @@ import (
 	"github.com/slipstream/slipstream/internal/domain/contracts"
 	"github.com/slipstream/slipstream/internal/downloader"
 	"github.com/slipstream/slipstream/internal/import/renamer"
+	"github.com/slipstream/slipstream/internal/indexer/grab"
+	"github.com/slipstream/slipstream/internal/library/language"
 	"github.com/slipstream/slipstream/internal/library/movies"
 	"github.com/slipstream/slipstream/internal/library/organizer"
 	"github.com/slipstream/slipstream/internal/library/quality"
@@ -24,6 +26,7 @@ import (
 	"github.com/slipstream/slipstream/internal/library/tv"
 	"github.com/slipstream/slipstream/internal/mediainfo"
 	"github.com/slipstream/slipstream/internal/module"
+	"github.com/slipstream/slipstream/internal/module/parseutil"
 	"github.com/slipstream/slipstream/internal/pathutil"
 	"github.com/slipstream/slipstream/internal/websocket"
 )
@@ -41,6 +44,10 @@ var (
 	ErrPathTooLong          = errors.New("destination path exceeds maximum length")
 	ErrFileAlreadyInLibrary = errors.New("file already exists in library")
 	ErrNotAnUpgrade         = errors.New("candidate file is not a quality upgrade")
+	ErrSizeOutOfRange       = errors.New("file size is outside the quality's size limits")
+	ErrLanguageNotAccepted  = errors.New("file language is not accepted by the language profile")
+	ErrReleaseGroupBlocked  = errors.New("file release group is forbidden by the quality profile")
+	ErrMappingNotFound      = errors.New("download mapping not found")
 )
 
 // HistoryService defines the interface for history logging.
@@ -64,6 +71,11 @@ type NotificationDispatcher interface {
 	DispatchUpgrade(ctx context.Context, event *UpgradeNotificationEvent)
 }
 
+// CrossSeeder cross-seeds the torrent behind an imported download.
+type CrossSeeder interface {
+	CrossSeedDownload(ctx context.Context, clientID int64, downloadID string, sourceIndexerID int64) (*grab.CrossSeedResult, error)
+}
+
 // StatusTrackerService defines the interface for request status tracking.
 type StatusTrackerService interface {
 	OnEntityAvailable(ctx context.Context, moduleType, entityType string, entityID int64) error
@@ -113,13 +125,15 @@ type UpgradeNotificationEvent struct {
 
 // Config holds import service configuration.
 type Config struct {
-	WorkerCount int // Number of concurrent import workers (default: 1)
+	WorkerCount        int // Number of concurrent import workers (default: 2)
+	MaxCopiesPerVolume int // Simultaneous transfers per destination filesystem (default: 1, 0 = unlimited)
 }
 
 // DefaultConfig returns the default configuration.
 func DefaultConfig() Config {
 	return Config{
-		WorkerCount: 1, // Sequential processing as per spec
+		WorkerCount:        2,
+		MaxCopiesPerVolume: 1,
 	}
 }
 
@@ -135,10 +149,12 @@ type Service struct {
 	renamer         *renamer.Resolver
 	mediainfo       *mediainfo.Service
 	quality         *quality.Service
+	language        *language.Service
 	slots           *slots.Service
 	health          contracts.HealthService
 	history         HistoryService
 	notifier        NotificationDispatcher
+	crossSeeder     CrossSeeder
 	statusTracker   StatusTrackerService
 	hub             *websocket.Hub
 	registry        *module.Registry
@@ -147,23 +163,31 @@ type Service struct {
 	config          Config
 
 	// Import queue
-	importQueue chan ImportJob
+	importQueue *jobQueue
+	volumes     *volumeLimiter
 	wg          sync.WaitGroup
 
 	// Processing state
-	mu         sync.Mutex
-	processing map[string]bool // Track in-progress imports by path
-	shutdown   chan struct{}
+	mu          sync.Mutex
+	processing  map[string]bool         // Track in-progress imports by path
+	jobs        map[string]*JobProgress // In-flight job progress by job ID
+	renames     map[string]*renameJob
+	crossSeeds  map[int64]bool // Download mappings already cross-seeded
+	mappingJobs map[int64]int  // Queued or running jobs per download mapping
+	shutdown    chan struct{}
 }
 
 // ImportJob represents a single import task.
 type ImportJob struct {
+	ID              string           // Assigned when queued; keys import:started/progress events
 	SourcePath      string           // Path to the source file
 	DownloadMapping *DownloadMapping // Queue metadata (nil for manual imports)
 	QueueMedia      *QueueMedia      // Per-file status for season packs (nil for single files)
 	Manual          bool             // Whether this is a manual import
 	ConfirmedMatch  *LibraryMatch    // Pre-confirmed match for manual imports
 	TargetSlotID    *int64           // Req 5.2.3: User-specified target slot (nil = auto-detect)
+	KeepSeeding     bool             // Import while the torrent seeds: hardlink or copy, never symlink
+	DryRun          bool             // Evaluate only: no decisions are recorded and no files touched
 }
 
 // DownloadMapping represents the queue-to-library mapping.
@@ -183,6 +207,10 @@ type DownloadMapping struct {
 	IsCompleteSeries bool
 	TargetSlotID     *int64
 	Source           string // "auto-search", "manual-search", "portal-request"
+	Seeding          bool   // the client was still seeding when the download completed
+	IndexerID        *int64 // indexer the release was grabbed from, when known
+	DownloadPath     string // download folder, set once a completed download is scanned
+	DownloadDir      string // client folder holding DownloadPath, set with it
 }
 
 // QueueMedia represents per-file status within a download.
@@ -217,14 +245,28 @@ type LibraryMatch struct {
 	CandidateQualityID int     // Quality ID of the candidate file
 	ExistingQualityID  int     // Quality ID of the existing file
 	QualityProfileID   int64   // Quality profile used for evaluation
+	QualityOverride    int     // Quality ID chosen during manual import; wins over the parsed quality
 
 	// ModuleEntity carries the module-aware matched entity when registry is present.
 	// Populated by matchToLibraryViaModule; nil when using legacy matching.
 	ModuleEntity *module.MatchedEntity
 }
 
+// coveredEpisodeIDs returns every episode the matched file covers: all of
+// EpisodeIDs for a multi-episode file, otherwise just EpisodeID.
+func (m *LibraryMatch) coveredEpisodeIDs() []int64 {
+	if len(m.EpisodeIDs) > 1 {
+		return m.EpisodeIDs
+	}
+	if m.EpisodeID != nil {
+		return []int64{*m.EpisodeID}
+	}
+	return nil
+}
+
 // ImportResult contains the result of an import operation.
 type ImportResult struct {
+	JobID           string
 	Success         bool
 	SourcePath      string
 	DestinationPath string
@@ -234,6 +276,13 @@ type ImportResult struct {
 	Error           error
 	IsUpgrade       bool
 	PreviousFile    string
+	RecycledFile    string // Where PreviousFile was moved in the recycle bin, if anywhere
+
+	// LinkFallbackReason explains why the file was copied or symlinked
+	// instead of hardlinked.
+	LinkFallbackReason string
+
+	pendingUpgradeID int64 // Upgrade record cleared once the previous file is cleaned up
 
 	// Slot information (Req 5.2.1-5.2.3)
 	RequiresSlotSelection bool             // True if user must select a slot
@@ -288,8 +337,13 @@ func NewService(
 		quality:       qualityService,
 		slots:         slotsService,
 		statusTracker: statusTracker,
-		importQueue:   make(chan ImportJob, 100),
+		importQueue:   newJobQueue(queueCapacity),
+		volumes:       newVolumeLimiter(config.MaxCopiesPerVolume),
 		processing:    make(map[string]bool),
+		jobs:          make(map[string]*JobProgress),
+		renames:       make(map[string]*renameJob),
+		crossSeeds:    make(map[int64]bool),
+		mappingJobs:   make(map[int64]int),
 		shutdown:      make(chan struct{}),
 	}
 
@@ -306,6 +360,16 @@ func (s *Service) SetNotificationDispatcher(n NotificationDispatcher) {
 	s.notifier = n
 }
 
+// SetCrossSeeder sets the cross-seeder run after automatic imports of seeding torrents.
+func (s *Service) SetCrossSeeder(c CrossSeeder) {
+	s.crossSeeder = c
+}
+
+// SetLanguageService sets the language profile service used to reject wrong-language imports.
+func (s *Service) SetLanguageService(languageService *language.Service) {
+	s.language = languageService
+}
+
 // SetDB updates the database connection used by this service.
 // This is called when switching between production and development databases.
 func (s *Service) SetDB(db *sql.DB) {
@@ -355,11 +419,19 @@ func (s *Service) ReloadModuleRenamer(ctx context.Context, moduleType module.Typ
 
 // Start starts the import worker(s).
 func (s *Service) Start(ctx context.Context) {
-	for i := 0; i < s.config.WorkerCount; i++ {
+	// Interrupted upgrades are settled before workers can touch the same files.
+	s.recoverUpgrades(ctx)
+
+	workers := max(s.config.WorkerCount, 1)
+	for i := 0; i < workers; i++ {
 		s.wg.Add(1)
 		go s.worker(ctx)
 	}
-	s.logger.Info().Int("workers", s.config.WorkerCount).Msg("Import service started")
+	go s.resumeRenames(ctx)
+	s.logger.Info().
+		Int("workers", workers).
+		Int("maxCopiesPerVolume", s.config.MaxCopiesPerVolume).
+		Msg("Import service started")
 }
 
 // Stop stops the import service and waits for pending jobs.
@@ -379,28 +451,36 @@ func (s *Service) worker(ctx context.Context) {
 			return
 		case <-s.shutdown:
 			return
-		case job := <-s.importQueue:
-			s.processJob(ctx, job)
+		case <-s.importQueue.ready:
+			s.processJob(ctx, s.importQueue.pop())
 		}
 	}
 }
 
-// QueueImport queues a file for import.
+// QueueImport queues a file for import. Manual imports run before upgrades,
+// and upgrades before other imports.
 func (s *Service) QueueImport(job ImportJob) error {
+	if s.IsProcessing(job.SourcePath) {
+		return ErrAlreadyImporting
+	}
+	priority := s.jobPriority(context.Background(), &job)
+
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
 	if s.processing[job.SourcePath] {
 		return ErrAlreadyImporting
 	}
+	ensureJobID(&job)
 
-	select {
-	case s.importQueue <- job:
-		s.processing[job.SourcePath] = true
-		return nil
-	default:
-		return errors.New("import queue is full")
+	if err := s.importQueue.push(job, priority); err != nil {
+		return err
+	}
+	s.processing[job.SourcePath] = true
+	if job.DownloadMapping != nil {
+		s.mappingJobs[job.DownloadMapping.ID]++
 	}
+	return nil
 }
 
 // IsProcessing returns whether a file is currently being imported.
@@ -410,16 +490,28 @@ func (s *Service) IsProcessing(path string) bool {
 	return s.processing[path]
 }
 
-// markComplete removes a path from the processing set.
-func (s *Service) markComplete(path string) {
+// markComplete removes a job's path from the processing set. Once the last
+// job of a download finishes, the download's mapping is removed, so its
+// cross-seed state is dropped with it.
+func (s *Service) markComplete(job *ImportJob) {
 	s.mu.Lock()
-	delete(s.processing, path)
-	s.mu.Unlock()
+	defer s.mu.Unlock()
+	delete(s.processing, job.SourcePath)
+	if job.DownloadMapping == nil {
+		return
+	}
+	id := job.DownloadMapping.ID
+	if s.mappingJobs[id]--; s.mappingJobs[id] <= 0 {
+		delete(s.mappingJobs, id)
+		delete(s.crossSeeds, id)
+	}
 }
 
 // processJob handles a single import job with retry logic.
 func (s *Service) processJob(ctx context.Context, job ImportJob) {
-	defer s.markComplete(job.SourcePath)
+	defer s.markComplete(&job)
+	s.startJobProgress(job)
+	defer s.endJobProgress(job.ID)
 
 	s.logger.Info().
 		Str("path", job.SourcePath).
@@ -427,7 +519,7 @@ func (s *Service) processJob(ctx context.Context, job ImportJob) {
 		Msg("Processing import job")
 
 	if job.DownloadMapping != nil && job.DownloadMapping.DownloadClientID > 0 {
-		if err := s.applyImportDelay(ctx, job.DownloadMapping.DownloadClientID); err != nil {
+		if err := s.applyImportDelay(ctx, &job); err != nil {
 			s.logger.Warn().Err(err).Msg("Failed to apply import delay")
 		}
 	}
@@ -435,7 +527,14 @@ func (s *Service) processJob(ctx context.Context, job ImportJob) {
 	result := s.processWithRetry(ctx, job)
 
 	if result.Success {
+		if !job.Manual {
+			s.learnFolderHint(ctx, job.DownloadMapping, job.SourcePath)
+		}
 		s.handleSuccessfulImport(ctx, result)
+		if !job.Manual {
+			s.crossSeed(job.DownloadMapping)
+			s.cleanupDownloadFolder(ctx, job.DownloadMapping, job.SourcePath)
+		}
 	} else {
 		s.handleFailedImport(ctx, job, result)
 	}
@@ -446,6 +545,7 @@ func (s *Service) handleSuccessfulImport(ctx context.Context, result *ImportResu
 		Str("source", result.SourcePath).
 		Str("destination", result.DestinationPath).
 		Str("linkMode", string(result.LinkMode)).
+		Str("linkFallbackReason", result.LinkFallbackReason).
 		Bool("upgrade", result.IsUpgrade).
 		Msg("Import completed successfully")
 
@@ -463,9 +563,11 @@ func (s *Service) updatePortalRequestStatus(ctx context.Context, result *ImportR
 		if err := s.statusTracker.OnEntityAvailable(ctx, "movie", "movie", *result.Match.MovieID); err != nil {
 			s.logger.Warn().Err(err).Int64("movieId", *result.Match.MovieID).Msg("Failed to update request status")
 		}
-	} else if result.Match.MediaType == mediaTypeEpisode && result.Match.EpisodeID != nil {
-		if err := s.statusTracker.OnEntityAvailable(ctx, "tv", "episode", *result.Match.EpisodeID); err != nil {
-			s.logger.Warn().Err(err).Int64("episodeId", *result.Match.EpisodeID).Msg("Failed to update request status")
+	} else if result.Match.MediaType == mediaTypeEpisode {
+		for _, episodeID := range result.Match.coveredEpisodeIDs() {
+			if err := s.statusTracker.OnEntityAvailable(ctx, "tv", "episode", episodeID); err != nil {
+				s.logger.Warn().Err(err).Int64("episodeId", episodeID).Msg("Failed to update request status")
+			}
 		}
 	}
 }
@@ -476,6 +578,7 @@ func (s *Service) broadcastImportSuccess(result *ImportResult) {
 	}
 
 	s.hub.Broadcast("import:completed", map[string]any{
+		"jobId":       result.JobID,
 		"source":      result.SourcePath,
 		"destination": result.DestinationPath,
 		"mediaType":   result.Match.MediaType,
@@ -560,6 +663,7 @@ func (s *Service) registerImportFailureHealth(job ImportJob, result *ImportResul
 func (s *Service) broadcastImportFailure(result *ImportResult) {
 	if s.hub != nil {
 		s.hub.Broadcast("import:failed", map[string]any{
+			"jobId":  result.JobID,
 			"source": result.SourcePath,
 			"error":  result.Error.Error(),
 		})
@@ -567,7 +671,7 @@ func (s *Service) broadcastImportFailure(result *ImportResult) {
 }
 
 func (s *Service) GetQueueLength() int {
-	return len(s.importQueue)
+	return s.importQueue.len()
 }
 
 // HandleDownloadWatcherEvent is the handler for download watcher events.
@@ -578,6 +682,11 @@ func (s *Service) HandleDownloadWatcherEvent(ctx context.Context, path string, c
 		Int64("clientId", clientID).
 		Msg("Received download watcher event")
 
+	if err := s.checkExclusion(ctx, path); err != nil {
+		s.logger.Info().Err(err).Str("path", path).Msg("Skipping excluded file")
+		return nil
+	}
+
 	// Check if we should skip stalled downloads
 	// Try to find a mapping for this file's parent directory
 	mapping, err := s.findMappingForPath(ctx, path, clientID)
@@ -702,6 +811,13 @@ func (s *Service) processCompletedEntry(ctx context.Context, cd *downloader.Comp
 		return
 	}
 
+	if err := s.downloader.TrackSeedingDownload(ctx, cd.ClientID, cd.DownloadID, cd.IndexerID); err != nil {
+		s.logger.Warn().Err(err).
+			Int64("clientId", cd.ClientID).
+			Str("downloadId", cd.DownloadID).
+			Msg("Failed to track download for seed cleanup")
+	}
+
 	if err := s.downloader.DeleteDownloadMapping(ctx, cd.ClientID, cd.DownloadID); err != nil {
 		s.logger.Warn().Err(err).
 			Int64("clientId", cd.ClientID).
@@ -831,6 +947,8 @@ func (s *Service) completedDownloadToMapping(cd *downloader.CompletedDownload) *
 		IsSeasonPack:     cd.IsSeasonPack,
 		IsCompleteSeries: cd.IsCompleteSeries,
 		TargetSlotID:     cd.TargetSlotID,
+		Seeding:          cd.Seeding,
+		IndexerID:        cd.IndexerID,
 	}
 	mapping.MediaType = determineMappingMediaType(mapping)
 	return mapping
@@ -915,6 +1033,7 @@ type existingFileInfo struct {
 	id        int64
 	path      string
 	qualityID sql.NullInt64
+	revision  string
 }
 
 func (s *Service) fetchExistingFileInfo(ctx context.Context, match *LibraryMatch) (*existingFileInfo, int64, error) {
@@ -949,6 +1068,7 @@ func (s *Service) fetchMovieFileInfo(ctx context.Context, movieID int64) (*exist
 
 	if dbFile, dbErr := s.queries.GetMovieFile(ctx, file.ID); dbErr == nil {
 		info.qualityID = dbFile.QualityID
+		info.revision = module.FileRevision(dbFile.OriginalFilename.String, dbFile.Path)
 	}
 
 	return info, movie.QualityProfileID, nil
@@ -970,6 +1090,7 @@ func (s *Service) fetchEpisodeFileInfo(ctx context.Context, match *LibraryMatch)
 
 	if dbFile, dbErr := s.queries.GetEpisodeFile(ctx, file.ID); dbErr == nil {
 		info.qualityID = dbFile.QualityID
+		info.revision = module.FileRevision(dbFile.OriginalFilename.String, dbFile.Path)
 	}
 
 	var qualityProfileID int64
@@ -1007,9 +1128,11 @@ func (s *Service) evaluateUpgradeEligibility(ctx context.Context, match *Library
 
 func (s *Service) compareQualityForUpgrade(match *LibraryMatch, existingFile *existingFileInfo, profile *quality.Profile, sourcePath string) error {
 	parsed := scanner.ParsePath(sourcePath)
-	candidateMatch := quality.MatchQuality(parsed.Quality, parsed.Source, profile)
+	candidateMatch := quality.MatchReleaseQuality(s.qualities(), filepath.Base(sourcePath), parsed.Quality, parsed.Source, profile)
 
-	if candidateMatch.Matches {
+	if match.QualityOverride > 0 {
+		match.CandidateQualityID = match.QualityOverride
+	} else if candidateMatch.Matches {
 		match.CandidateQualityID = candidateMatch.MatchedQualityID
 	}
 
@@ -1029,6 +1152,14 @@ func (s *Service) compareQualityForUpgrade(match *LibraryMatch, existingFile *ex
 		return ErrNotAnUpgrade
 	}
 
+	// A PROPER or REPACK of the existing quality replaces the flawed file
+	// even once the cutoff is met
+	if match.CandidateQualityID == existingQualityID &&
+		parseutil.RevisionRank(parsed.Revision) > parseutil.RevisionRank(existingFile.revision) {
+		match.IsUpgrade = true
+		return nil
+	}
+
 	if profile.IsAtOrAboveCutoff(existingQualityID) {
 		match.IsUpgrade = false
 		return ErrNotAnUpgrade
@@ -1083,7 +1214,13 @@ func (s *Service) getFilePath(ctx context.Context, mediaType string, fileID int6
 }
 
 // recordImportDecision records a rejection decision for a source file.
-func (s *Service) recordImportDecision(ctx context.Context, sourcePath, decision string, match *LibraryMatch) {
+// Dry runs leave no decision behind so the file is still imported later.
+func (s *Service) recordImportDecision(ctx context.Context, job ImportJob, decision string, match *LibraryMatch) {
+	if job.DryRun {
+		return
+	}
+
+	sourcePath := job.SourcePath
 	var mediaID int64
 	mediaType := match.MediaType
 	switch {
@@ -1150,10 +1287,10 @@ func (s *Service) dispatchImportNotification(ctx context.Context, result *Import
 func (s *Service) dispatchUpgradeNotification(ctx context.Context, result *ImportResult) {
 	event := UpgradeNotificationEvent{
 		MediaType:   result.Match.MediaType,
-		OldQuality:  qualityNameFromID(result.Match.ExistingQualityID),
+		OldQuality:  s.qualityNameFromID(result.Match.ExistingQualityID),
 		OldPath:     result.PreviousFile,
 		NewPath:     result.DestinationPath,
-		NewQuality:  qualityNameFromID(result.Match.CandidateQualityID),
+		NewQuality:  s.qualityNameFromID(result.Match.CandidateQualityID),
 		ReleaseName: filepath.Base(result.SourcePath),
 		SlotID:      result.AssignedSlotID,
 	}
@@ -1196,7 +1333,7 @@ func (s *Service) populateUpgradeEpisodeDetails(ctx context.Context, event *Upgr
 func (s *Service) dispatchImportNotificationEvent(ctx context.Context, result *ImportResult) {
 	event := ImportNotificationEvent{
 		MediaType:       result.Match.MediaType,
-		Quality:         qualityNameFromID(result.Match.CandidateQualityID),
+		Quality:         s.qualityNameFromID(result.Match.CandidateQualityID),
 		SourcePath:      result.SourcePath,
 		DestinationPath: result.DestinationPath,
 		ReleaseName:     filepath.Base(result.SourcePath),
@@ -1238,9 +1375,18 @@ func (s *Service) populateImportEpisodeDetails(ctx context.Context, event *Impor
 	}
 }
 
+// qualities returns the active quality definitions, falling back to the
+// predefined set when no quality service is wired.
+func (s *Service) qualities() *quality.Registry {
+	if s.quality == nil {
+		return nil
+	}
+	return s.quality.Registry()
+}
+
 // qualityNameFromID returns the quality name for a given ID, or empty string if unknown.
-func qualityNameFromID(id int) string {
-	if q, ok := quality.GetQualityByID(id); ok {
+func (s *Service) qualityNameFromID(id int) string {
+	if q, ok := s.qualities().GetQualityByID(id); ok {
 		return q.Name
 	}
 	return ""
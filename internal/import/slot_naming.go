@@ -0,0 +1,100 @@
+package importer
+
+import (
+	"context"
+	"path/filepath"
+	"strings"
+
+	"github.com/slipstream/slipstream/internal/import/renamer"
+	"github.com/slipstream/slipstream/internal/library/slots"
+	"github.com/slipstream/slipstream/internal/mediainfo"
+	"github.com/slipstream/slipstream/internal/module"
+)
+
+// applySlotNaming recomputes the destination once the target slot is known so
+// slot tokens resolve and the configured version naming keeps the files of
+// different slots apart.
+func (s *Service) applySlotNaming(ctx context.Context, job ImportJob, result *ImportResult, targetSlotID *int64) error {
+	if targetSlotID == nil || s.slots == nil || result.Match == nil || result.Match.ModuleEntity == nil {
+		return nil
+	}
+
+	slot, err := s.slots.Get(ctx, *targetSlotID)
+	if err != nil {
+		s.logger.Warn().Err(err).Int64("slotId", *targetSlotID).Msg("Failed to load slot for naming")
+		return nil
+	}
+
+	destPath, err := s.computeSlotDestination(ctx, result.Match.ModuleEntity, slot, result.MediaInfo, filepath.Ext(job.SourcePath))
+	if err != nil {
+		result.Error = err
+		return err
+	}
+	result.DestinationPath = destPath
+
+	if !job.Manual && s.isSameFile(job.SourcePath, destPath) {
+		result.Error = ErrFileAlreadyInLibrary
+		return result.Error
+	}
+	return nil
+}
+
+// fileSlot returns the slot a library file is assigned to. It returns nil
+// outside multi-version mode or when the file has no slot.
+func (s *Service) fileSlot(ctx context.Context, mediaType string, fileID int64) *slots.Slot {
+	if s.slots == nil || !s.slots.IsMultiVersionEnabled(ctx) {
+		return nil
+	}
+	slot, err := s.slots.GetSlotForFile(ctx, mediaType, fileID)
+	if err != nil {
+		s.logger.Warn().Err(err).Int64("fileId", fileID).Msg("Failed to look up slot for file")
+		return nil
+	}
+	return slot
+}
+
+// computeSlotDestination computes the destination of a file in the given
+// slot. A nil slot resolves the plain destination.
+func (s *Service) computeSlotDestination(ctx context.Context, entity *module.MatchedEntity, slot *slots.Slot, mi *mediainfo.MediaInfo, ext string) (string, error) {
+	if slot == nil {
+		return s.computeDestinationViaModule(ctx, entity, nil, mi, ext)
+	}
+
+	if entity.TokenData == nil {
+		entity.TokenData = make(map[string]any)
+	}
+	entity.TokenData["SlotName"] = slot.Name
+	entity.TokenData["SlotNumber"] = slot.SlotNumber
+
+	destPath, err := s.computeDestinationViaModule(ctx, entity, nil, mi, ext)
+	if err != nil {
+		return "", err
+	}
+	return applyVersionNaming(destPath, slot.Name, s.slots.GetVersionNaming(ctx)), nil
+}
+
+// applyVersionNaming separates a slot's file from the other slots' files by
+// suffixing the filename with the slot tag or moving it into a slot subfolder.
+// A filename that already carries the tag (via {Slot Tag}) is left alone.
+func applyVersionNaming(destPath, slotName string, mode slots.VersionNaming) string {
+	name := renamer.SanitizeFilename(slotName, true, renamer.ColonDash, "")
+	if name == "" {
+		return destPath
+	}
+
+	dir, file := filepath.Split(destPath)
+	switch mode {
+	case slots.VersionNamingSuffix:
+		tag := "[" + name + "]"
+		ext := filepath.Ext(file)
+		stem := strings.TrimSuffix(file, ext)
+		if strings.Contains(stem, tag) {
+			return destPath
+		}
+		return filepath.Join(dir, stem+" - "+tag+ext)
+	case slots.VersionNamingSubfolder:
+		return filepath.Join(dir, name, file)
+	default:
+		return destPath
+	}
+}
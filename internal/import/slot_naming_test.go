@@ -0,0 +1,40 @@
+package importer
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/slipstream/slipstream/internal/library/slots"
+)
+
+func TestApplyVersionNaming(t *testing.T) {
+	dest := filepath.Join("/movies", "Inception (2010)", "Inception (2010) - Bluray-2160p.mkv")
+
+	tests := []struct {
+		name     string
+		dest     string
+		slotName string
+		mode     slots.VersionNaming
+		want     string
+	}{
+		{"none", dest, "4K", slots.VersionNamingNone, dest},
+		{"suffix", dest, "4K", slots.VersionNamingSuffix,
+			filepath.Join("/movies", "Inception (2010)", "Inception (2010) - Bluray-2160p - [4K].mkv")},
+		{"subfolder", dest, "4K", slots.VersionNamingSubfolder,
+			filepath.Join("/movies", "Inception (2010)", "4K", "Inception (2010) - Bluray-2160p.mkv")},
+		{"suffix already tagged",
+			filepath.Join("/movies", "Inception (2010)", "Inception (2010) - [4K].mkv"), "4K", slots.VersionNamingSuffix,
+			filepath.Join("/movies", "Inception (2010)", "Inception (2010) - [4K].mkv")},
+		{"empty slot name", dest, "", slots.VersionNamingSubfolder, dest},
+		{"illegal characters", dest, "4K/HDR", slots.VersionNamingSubfolder,
+			filepath.Join("/movies", "Inception (2010)", "4K-HDR", "Inception (2010) - Bluray-2160p.mkv")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := applyVersionNaming(tt.dest, tt.slotName, tt.mode); got != tt.want {
+				t.Errorf("applyVersionNaming() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
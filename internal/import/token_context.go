@@ -23,6 +23,7 @@ func applyEntityTokenData(ctx *renamer.TokenContext, entity *module.MatchedEntit
 	d := entity.TokenData
 	applyMovieTokenData(ctx, d)
 	applyTVTokenData(ctx, d)
+	applySlotTokenData(ctx, d)
 
 	if len(entity.EntityIDs) > 1 {
 		if v, ok := d["EpisodeNumbers"].([]int); ok {
@@ -67,6 +68,15 @@ func applyTVTokenData(ctx *renamer.TokenContext, d map[string]any) {
 	}
 }
 
+func applySlotTokenData(ctx *renamer.TokenContext, d map[string]any) {
+	if v, ok := d["SlotName"].(string); ok {
+		ctx.SlotName = v
+	}
+	if v, ok := d["SlotNumber"].(int); ok {
+		ctx.SlotNumber = v
+	}
+}
+
 func applyParseResultToTokenCtx(ctx *renamer.TokenContext, parsed *module.ParseResult) {
 	if parsed == nil {
 		return
@@ -86,8 +96,12 @@ func applyMediaInfoToTokenCtx(ctx *renamer.TokenContext, mi *mediainfo.MediaInfo
 	ctx.VideoCodec = mi.VideoCodec
 	ctx.VideoBitDepth = mi.VideoBitDepth
 	ctx.VideoDynamicRange = mi.DynamicRange
+	ctx.DolbyVisionProfile = mi.DolbyVisionProfile
 	ctx.AudioCodec = mi.AudioCodec
 	ctx.AudioChannels = mi.AudioChannels
+	for _, track := range mi.AudioTracks {
+		ctx.AudioTracks = append(ctx.AudioTracks, track.String())
+	}
 	if len(mi.AudioLanguages) > 0 {
 		ctx.AudioLanguages = mi.AudioLanguages
 	}
@@ -0,0 +1,223 @@
+package importer
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/slipstream/slipstream/internal/database/sqlc"
+	"github.com/slipstream/slipstream/internal/library/movies"
+	"github.com/slipstream/slipstream/internal/library/tv"
+)
+
+const eventTypeImportUndone = "import_undone"
+
+var (
+	ErrHistoryEntryNotFound = errors.New("history entry not found")
+	ErrNotUndoable          = errors.New("history entry is not an import that can be undone")
+	ErrImportAlreadyUndone  = errors.New("imported file is no longer in the library")
+)
+
+// UndoResult describes what undoing an import changed.
+type UndoResult struct {
+	HistoryID    int64  `json:"historyId"`
+	MediaType    string `json:"mediaType"`
+	MediaID      int64  `json:"mediaId"`
+	RemovedPath  string `json:"removedPath"`
+	ReturnedTo   string `json:"returnedTo,omitempty"`   // Where the file was moved back to when its download copy was gone
+	RestoredFile string `json:"restoredFile,omitempty"` // Previous file brought back from the recycle bin
+}
+
+// importRecord is the part of an "imported" history entry undo needs.
+type importRecord struct {
+	SourcePath      string `json:"sourcePath"`
+	DestinationPath string `json:"destinationPath"`
+	PreviousFile    string `json:"previousFile"`
+	PreviousQuality string `json:"previousQuality"`
+	RecycledFile    string `json:"recycledFile"`
+}
+
+// UndoImport reverts the import recorded by a history entry: the library file
+// and its record are removed, which resets the item's status, and a file the
+// import replaced is restored from the recycle bin when it was kept there.
+// If the download's copy of the file is gone, the library file is moved back
+// to the download location instead of being deleted.
+func (s *Service) UndoImport(ctx context.Context, historyID int64) (*UndoResult, error) {
+	entry, err := s.queries.GetHistoryEntry(ctx, historyID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrHistoryEntryNotFound
+		}
+		return nil, err
+	}
+	if entry.EventType != "imported" || (entry.EntityType != mediaTypeMovie && entry.EntityType != mediaTypeEpisode) {
+		return nil, ErrNotUndoable
+	}
+
+	var rec importRecord
+	if err := json.Unmarshal([]byte(entry.Data.String), &rec); err != nil || rec.DestinationPath == "" {
+		return nil, ErrNotUndoable
+	}
+
+	fileID, err := s.importedFileID(ctx, entry.EntityType, entry.EntityID, rec.DestinationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &UndoResult{
+		HistoryID:   historyID,
+		MediaType:   entry.EntityType,
+		MediaID:     entry.EntityID,
+		RemovedPath: rec.DestinationPath,
+	}
+
+	if err := s.removeImportedFile(rec, result); err != nil {
+		return nil, err
+	}
+	s.removeOldFileRecord(ctx, entry.EntityType, fileID)
+	s.restoreRecycledFile(ctx, entry.EntityType, entry.EntityID, rec, result)
+	s.markUndoneMatchCorrected(ctx, rec.SourcePath, entry.EntityType, entry.EntityID)
+
+	if s.history != nil {
+		data := map[string]any{
+			"undoneHistoryId": historyID,
+			"removedPath":     result.RemovedPath,
+		}
+		if result.ReturnedTo != "" {
+			data["returnedTo"] = result.ReturnedTo
+		}
+		if result.RestoredFile != "" {
+			data["restoredFile"] = result.RestoredFile
+		}
+		if err := s.history.Create(ctx, &HistoryInput{
+			EventType: eventTypeImportUndone,
+			MediaType: entry.EntityType,
+			MediaID:   entry.EntityID,
+			Data:      data,
+		}); err != nil {
+			s.logger.Warn().Err(err).Msg("Failed to log import undo")
+		}
+	}
+
+	s.logger.Info().
+		Int64("historyId", historyID).
+		Str("path", rec.DestinationPath).
+		Str("restored", result.RestoredFile).
+		Msg("Import undone")
+	return result, nil
+}
+
+// importedFileID finds the library record for the imported file and checks it
+// still belongs to the item the import was logged against.
+func (s *Service) importedFileID(ctx context.Context, mediaType string, mediaID int64, path string) (int64, error) {
+	switch mediaType {
+	case mediaTypeMovie:
+		file, err := s.movies.GetFileByPath(ctx, path)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return 0, ErrImportAlreadyUndone
+			}
+			return 0, err
+		}
+		if file.MovieID != mediaID {
+			return 0, ErrImportAlreadyUndone
+		}
+		return file.ID, nil
+	default:
+		file, err := s.tv.GetEpisodeFileByPath(ctx, path)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return 0, ErrImportAlreadyUndone
+			}
+			return 0, err
+		}
+		if file.EpisodeID != mediaID {
+			return 0, ErrImportAlreadyUndone
+		}
+		return file.ID, nil
+	}
+}
+
+// removeImportedFile deletes the library file, or moves it back to the
+// download location when the download no longer has its own copy.
+func (s *Service) removeImportedFile(rec importRecord, result *UndoResult) error {
+	if _, err := os.Stat(rec.DestinationPath); os.IsNotExist(err) {
+		return nil
+	}
+	if rec.SourcePath != "" {
+		if _, err := os.Stat(rec.SourcePath); os.IsNotExist(err) {
+			if err := s.organizer.MoveFile(rec.DestinationPath, rec.SourcePath); err != nil {
+				return fmt.Errorf("failed to return file to %s: %w", rec.SourcePath, err)
+			}
+			result.ReturnedTo = rec.SourcePath
+			return nil
+		}
+	}
+	return s.organizer.DeleteFile(rec.DestinationPath)
+}
+
+// restoreRecycledFile moves the file the import replaced back into place and
+// re-adds its record. Failures are logged; the undo itself has succeeded.
+func (s *Service) restoreRecycledFile(ctx context.Context, mediaType string, mediaID int64, rec importRecord, result *UndoResult) {
+	if rec.RecycledFile == "" || rec.PreviousFile == "" {
+		return
+	}
+	if _, err := os.Stat(rec.RecycledFile); err != nil {
+		s.logger.Warn().Str("file", rec.RecycledFile).Msg("Replaced file is no longer in the recycle bin")
+		return
+	}
+	if err := s.organizer.MoveFile(rec.RecycledFile, rec.PreviousFile); err != nil {
+		s.logger.Warn().Err(err).Str("file", rec.RecycledFile).Msg("Failed to restore replaced file")
+		return
+	}
+
+	var size int64
+	if info, err := os.Stat(rec.PreviousFile); err == nil {
+		size = info.Size()
+	}
+	var qualityID *int64
+	if q, ok := s.qualities().GetQualityByName(rec.PreviousQuality); ok {
+		id := int64(q.ID)
+		qualityID = &id
+	}
+
+	var err error
+	switch mediaType {
+	case mediaTypeMovie:
+		_, err = s.movies.AddFile(ctx, mediaID, &movies.CreateMovieFileInput{
+			Path:      rec.PreviousFile,
+			Size:      size,
+			Quality:   rec.PreviousQuality,
+			QualityID: qualityID,
+		})
+	case mediaTypeEpisode:
+		_, err = s.tv.AddEpisodeFile(ctx, mediaID, &tv.CreateEpisodeFileInput{
+			Path:      rec.PreviousFile,
+			Size:      size,
+			Quality:   rec.PreviousQuality,
+			QualityID: qualityID,
+		})
+	}
+	if err != nil {
+		s.logger.Warn().Err(err).Str("file", rec.PreviousFile).Msg("Failed to re-add restored file")
+		return
+	}
+	result.RestoredFile = rec.PreviousFile
+}
+
+// markUndoneMatchCorrected counts an undone automatic import as a wrong match.
+func (s *Service) markUndoneMatchCorrected(ctx context.Context, sourcePath, mediaType string, mediaID int64) {
+	if sourcePath == "" {
+		return
+	}
+	prior, err := s.queries.GetLatestMatchOutcome(ctx, sourcePath)
+	if err != nil || prior.MediaType != mediaType || prior.MediaID != mediaID || prior.Outcome != matchOutcomeAccepted {
+		return
+	}
+	if err := s.queries.MarkMatchOutcomeCorrected(ctx, sqlc.MarkMatchOutcomeCorrectedParams{ID: prior.ID}); err != nil {
+		s.logger.Warn().Err(err).Int64("outcomeId", prior.ID).Msg("Failed to mark match corrected")
+	}
+}
@@ -0,0 +1,152 @@
+package importer
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/slipstream/slipstream/internal/database/sqlc"
+	"github.com/slipstream/slipstream/internal/library/movies"
+	"github.com/slipstream/slipstream/internal/library/organizer"
+	"github.com/slipstream/slipstream/internal/testutil"
+)
+
+func TestUndoImport_RestoresRecycledFile(t *testing.T) {
+	tdb := testutil.NewTestDB(t)
+	defer tdb.Close()
+
+	ctx := context.Background()
+	moviesSvc := movies.NewService(tdb.Conn, nil, &tdb.Logger, nil, nil)
+	svc := NewService(tdb.Conn, nil, moviesSvc, nil, nil, organizer.NewService(&tdb.Logger), nil, nil, Config{}, &tdb.Logger, nil, nil, nil, nil, nil)
+
+	movie, err := moviesSvc.Create(ctx, &movies.CreateMovieInput{Title: "The Matrix", Year: 1999, TmdbID: 603})
+	if err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+
+	tmpDir := t.TempDir()
+	if _, err := svc.SetRecycleBinPath(ctx, filepath.Join(tmpDir, "recycle")); err != nil {
+		t.Fatalf("SetRecycleBinPath() error = %v", err)
+	}
+	source := writeFile(t, filepath.Join(tmpDir, "downloads", "matrix.2160p.mkv"), "new")
+	oldPath := writeFile(t, filepath.Join(tmpDir, "library", "The Matrix (1999) 1080p.mkv"), "old")
+	newPath := writeFile(t, filepath.Join(tmpDir, "library", "The Matrix (1999) 2160p.mkv"), "new")
+
+	recycled := svc.recycleUpgradedFile(ctx, oldPath, newPath)
+	if recycled == "" {
+		t.Fatal("recycleUpgradedFile() did not recycle the replaced file")
+	}
+	if _, err := moviesSvc.AddFile(ctx, movie.ID, &movies.CreateMovieFileInput{Path: newPath, Size: 3}); err != nil {
+		t.Fatalf("AddFile() error = %v", err)
+	}
+
+	data, _ := json.Marshal(map[string]any{
+		"sourcePath":      source,
+		"destinationPath": newPath,
+		"isUpgrade":       true,
+		"previousFile":    oldPath,
+		"previousQuality": "Bluray-1080p",
+		"recycledFile":    recycled,
+	})
+	entry, err := svc.queries.CreateHistoryEntry(ctx, sqlc.CreateHistoryEntryParams{
+		EventType:  "imported",
+		ModuleType: "movie",
+		EntityType: mediaTypeMovie,
+		EntityID:   movie.ID,
+		Data:       sql.NullString{String: string(data), Valid: true},
+	})
+	if err != nil {
+		t.Fatalf("CreateHistoryEntry() error = %v", err)
+	}
+
+	result, err := svc.UndoImport(ctx, entry.ID)
+	if err != nil {
+		t.Fatalf("UndoImport() error = %v", err)
+	}
+	if result.RestoredFile != oldPath || result.ReturnedTo != "" {
+		t.Errorf("UndoImport() = %+v, want old file restored and library copy deleted", result)
+	}
+	if _, err := os.Stat(newPath); !os.IsNotExist(err) {
+		t.Error("imported file still in the library")
+	}
+	if _, err := os.Stat(oldPath); err != nil {
+		t.Errorf("replaced file not restored: %v", err)
+	}
+	if _, err := moviesSvc.GetFileByPath(ctx, newPath); !errors.Is(err, sql.ErrNoRows) {
+		t.Errorf("imported file record still present: %v", err)
+	}
+	if file, err := moviesSvc.GetFileByPath(ctx, oldPath); err != nil || file.MovieID != movie.ID {
+		t.Errorf("restored file record = %+v, %v", file, err)
+	}
+
+	if _, err := svc.UndoImport(ctx, entry.ID); !errors.Is(err, ErrImportAlreadyUndone) {
+		t.Errorf("second UndoImport() error = %v, want %v", err, ErrImportAlreadyUndone)
+	}
+}
+
+func TestUndoImport_ReturnsFileWhenSourceGone(t *testing.T) {
+	tdb := testutil.NewTestDB(t)
+	defer tdb.Close()
+
+	ctx := context.Background()
+	moviesSvc := movies.NewService(tdb.Conn, nil, &tdb.Logger, nil, nil)
+	svc := NewService(tdb.Conn, nil, moviesSvc, nil, nil, organizer.NewService(&tdb.Logger), nil, nil, Config{}, &tdb.Logger, nil, nil, nil, nil, nil)
+
+	movie, err := moviesSvc.Create(ctx, &movies.CreateMovieInput{Title: "Heat", Year: 1995, TmdbID: 949})
+	if err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+
+	tmpDir := t.TempDir()
+	source := filepath.Join(tmpDir, "downloads", "heat.mkv")
+	dest := writeFile(t, filepath.Join(tmpDir, "library", "Heat (1995).mkv"), "movie")
+	if _, err := moviesSvc.AddFile(ctx, movie.ID, &movies.CreateMovieFileInput{Path: dest, Size: 5}); err != nil {
+		t.Fatalf("AddFile() error = %v", err)
+	}
+
+	data, _ := json.Marshal(map[string]any{"sourcePath": source, "destinationPath": dest})
+	entry, err := svc.queries.CreateHistoryEntry(ctx, sqlc.CreateHistoryEntryParams{
+		EventType:  "imported",
+		ModuleType: "movie",
+		EntityType: mediaTypeMovie,
+		EntityID:   movie.ID,
+		Data:       sql.NullString{String: string(data), Valid: true},
+	})
+	if err != nil {
+		t.Fatalf("CreateHistoryEntry() error = %v", err)
+	}
+
+	result, err := svc.UndoImport(ctx, entry.ID)
+	if err != nil {
+		t.Fatalf("UndoImport() error = %v", err)
+	}
+	if result.ReturnedTo != source {
+		t.Errorf("UndoImport() ReturnedTo = %q, want %q", result.ReturnedTo, source)
+	}
+	if _, err := os.Stat(source); err != nil {
+		t.Errorf("file not returned to the download folder: %v", err)
+	}
+
+	got, err := moviesSvc.Get(ctx, movie.ID)
+	if err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	if got.Status != "missing" {
+		t.Errorf("movie status = %q, want missing", got.Status)
+	}
+}
+
+func writeFile(t *testing.T, path, content string) string {
+	t.Helper()
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
@@ -0,0 +1,206 @@
+package importer
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/slipstream/slipstream/internal/database/sqlc"
+	"github.com/slipstream/slipstream/internal/library/movies"
+	"github.com/slipstream/slipstream/internal/library/tv"
+	"github.com/slipstream/slipstream/internal/mediainfo"
+	"github.com/slipstream/slipstream/internal/pathutil"
+)
+
+// upgradeTempPrefix marks the hidden file an upgrade is imported to before it
+// replaces the destination, so scanners skip a half-written import.
+const upgradeTempPrefix = ".slipstream-upgrade-"
+
+// upgradeTempPath returns the staging path for an upgrade imported to dest.
+func upgradeTempPath(dest string) string {
+	return filepath.Join(filepath.Dir(dest), upgradeTempPrefix+filepath.Base(dest))
+}
+
+// stageUpgrade records an upgrade before any file is touched and returns the
+// path the new file should be imported to. Until swapUpgrade renames it into
+// place the library keeps serving the previous file.
+func (s *Service) stageUpgrade(ctx context.Context, result *ImportResult, oldFileID *int64) (string, error) {
+	tempPath := upgradeTempPath(result.DestinationPath)
+	var oldID sql.NullInt64
+	if oldFileID != nil {
+		oldID = sql.NullInt64{Int64: *oldFileID, Valid: true}
+	}
+	row, err := s.queries.CreatePendingUpgrade(ctx, sqlc.CreatePendingUpgradeParams{
+		MediaType:    result.Match.MediaType,
+		OldFileID:    oldID,
+		PreviousPath: result.PreviousFile,
+		TempPath:     tempPath,
+		DestPath:     result.DestinationPath,
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to record upgrade: %w", err)
+	}
+	result.pendingUpgradeID = row.ID
+	return tempPath, nil
+}
+
+// abortUpgrade removes a staged upgrade's partial file and its record after a
+// failed import, leaving the previous file untouched.
+func (s *Service) abortUpgrade(result *ImportResult) {
+	if result.pendingUpgradeID == 0 {
+		return
+	}
+	if err := os.Remove(upgradeTempPath(result.DestinationPath)); err != nil && !os.IsNotExist(err) {
+		s.logger.Warn().Err(err).Str("dest", result.DestinationPath).Msg("Failed to remove partial upgrade")
+	}
+	s.finishUpgrade(result)
+}
+
+// swapUpgrade renames the staged file over the destination. When the upgrade
+// replaces a file of the same name, that file is first preserved in the
+// recycle bin, since the rename discards it.
+func (s *Service) swapUpgrade(ctx context.Context, result *ImportResult) error {
+	if pathutil.PathsEqual(result.PreviousFile, result.DestinationPath) {
+		result.RecycledFile = s.preserveReplacedFile(ctx, result.DestinationPath)
+	}
+	if err := os.Rename(upgradeTempPath(result.DestinationPath), result.DestinationPath); err != nil {
+		return fmt.Errorf("failed to replace %s: %w", filepath.Base(result.DestinationPath), err)
+	}
+	return nil
+}
+
+// preserveReplacedFile links or copies a file about to be overwritten into
+// the recycle bin, returning the recycled path or "" when the bin is disabled.
+func (s *Service) preserveReplacedFile(ctx context.Context, path string) string {
+	bin, err := s.RecycleBinPath(ctx)
+	if err != nil || bin == "" {
+		return ""
+	}
+	dest := filepath.Join(bin, time.Now().UTC().Format("20060102-150405.000000000"), filepath.Base(path))
+	if _, err := s.organizer.HardlinkOrCopy(path, dest, nil); err != nil {
+		s.logger.Warn().Err(err).Str("file", path).Msg("Failed to recycle replaced file")
+		return ""
+	}
+	return dest
+}
+
+// finishUpgrade drops an upgrade's record once the previous file has been
+// cleaned up.
+func (s *Service) finishUpgrade(result *ImportResult) {
+	if result.pendingUpgradeID == 0 {
+		return
+	}
+	if err := s.queries.DeletePendingUpgrade(context.Background(), result.pendingUpgradeID); err != nil {
+		s.logger.Warn().Err(err).Int64("upgradeId", result.pendingUpgradeID).Msg("Failed to clear upgrade record")
+	}
+	result.pendingUpgradeID = 0
+}
+
+// recoverUpgrades settles upgrades interrupted by a crash. An upgrade whose
+// staged file still exists never replaced anything, so the partial file is
+// removed and the previous file kept. One that was swapped in has its
+// previous file cleaned up and its new file recorded as the import would have.
+func (s *Service) recoverUpgrades(ctx context.Context) {
+	rows, err := s.queries.ListPendingUpgrades(ctx)
+	if err != nil {
+		s.logger.Warn().Err(err).Msg("Failed to list interrupted upgrades")
+		return
+	}
+	for _, row := range rows {
+		s.recoverUpgrade(ctx, row)
+		if err := s.queries.DeletePendingUpgrade(ctx, row.ID); err != nil {
+			s.logger.Warn().Err(err).Int64("upgradeId", row.ID).Msg("Failed to clear upgrade record")
+		}
+	}
+}
+
+func (s *Service) recoverUpgrade(ctx context.Context, row *sqlc.PendingUpgrade) {
+	logger := s.logger.With().Str("dest", row.DestPath).Str("previous", row.PreviousPath).Logger()
+
+	if _, err := os.Stat(row.TempPath); err == nil {
+		if err := os.Remove(row.TempPath); err != nil {
+			logger.Warn().Err(err).Msg("Failed to remove partial upgrade")
+			return
+		}
+		logger.Info().Msg("Rolled back interrupted upgrade, keeping previous file")
+		return
+	}
+
+	if _, err := os.Stat(row.DestPath); err != nil {
+		return
+	}
+	if !pathutil.PathsEqual(row.PreviousPath, row.DestPath) {
+		if _, err := os.Stat(row.PreviousPath); err == nil {
+			if s.recycleUpgradedFile(ctx, row.PreviousPath, row.DestPath) == "" {
+				if err := s.organizer.DeleteUpgradedFile(row.PreviousPath, row.DestPath); err != nil {
+					logger.Warn().Err(err).Msg("Failed to remove file replaced by interrupted upgrade")
+					return
+				}
+			}
+		}
+	}
+
+	if err := s.recordUpgradedFile(ctx, row); err != nil {
+		logger.Warn().Err(err).Msg("Failed to record the new file of an interrupted upgrade; rescan the item")
+		return
+	}
+	logger.Info().Msg("Finished interrupted upgrade")
+}
+
+// recordUpgradedFile replaces the record of the file an interrupted upgrade
+// swapped out with one for the new file, on the same movie or episodes. The
+// import removes that record as it records the new file, so once it is gone
+// there is nothing left to do.
+func (s *Service) recordUpgradedFile(ctx context.Context, row *sqlc.PendingUpgrade) error {
+	if !row.OldFileID.Valid {
+		return fmt.Errorf("no record of the replaced file")
+	}
+
+	match, err := s.matchFromFileRecord(ctx, row.MediaType, row.OldFileID.Int64)
+	if errors.Is(err, movies.ErrMovieFileNotFound) || errors.Is(err, tv.ErrEpisodeFileNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	s.resolveQualityID(ctx, match, row.DestPath)
+	if _, err := s.updateLibraryWithID(ctx, match, row.DestPath, row.DestPath, &mediainfo.MediaInfo{}); err != nil {
+		return err
+	}
+	s.queueMediaInfoProbe(row.DestPath, match)
+	return nil
+}
+
+// matchFromFileRecord rebuilds the library match of a file from its record,
+// which names the movie or the episodes it covers. The record is set as the
+// existing file so recording a replacement removes it.
+func (s *Service) matchFromFileRecord(ctx context.Context, mediaType string, fileID int64) (*LibraryMatch, error) {
+	match := &LibraryMatch{MediaType: mediaType, ExistingFileID: &fileID}
+	switch mediaType {
+	case mediaTypeMovie:
+		file, err := s.movies.GetFileByID(ctx, fileID)
+		if err != nil {
+			return nil, err
+		}
+		match.MovieID = &file.MovieID
+	case mediaTypeEpisode:
+		episodes, err := s.tv.FileEpisodes(ctx, fileID)
+		if err != nil {
+			return nil, err
+		}
+		match.SeriesID = &episodes[0].SeriesID
+		match.EpisodeID = &episodes[0].ID
+		if len(episodes) > 1 {
+			for _, ep := range episodes {
+				match.EpisodeIDs = append(match.EpisodeIDs, ep.ID)
+			}
+		}
+	default:
+		return nil, fmt.Errorf("unknown media type %q", mediaType)
+	}
+	return match, nil
+}
@@ -0,0 +1,146 @@
+package importer
+
+import (
+	"context"
+	"database/sql"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/slipstream/slipstream/internal/database/sqlc"
+	"github.com/slipstream/slipstream/internal/library/movies"
+	"github.com/slipstream/slipstream/internal/testutil"
+)
+
+func TestUpgrade_StageAndSwapInPlace(t *testing.T) {
+	tdb := testutil.NewTestDB(t)
+	defer tdb.Close()
+
+	svc := NewService(tdb.Conn, nil, nil, nil, nil, nil, nil, nil, Config{}, &tdb.Logger, nil, nil, nil, nil, nil)
+	ctx := context.Background()
+
+	dest := filepath.Join(t.TempDir(), "Movie (2020).mkv")
+	if err := os.WriteFile(dest, []byte("old"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	result := &ImportResult{
+		DestinationPath: dest,
+		PreviousFile:    dest,
+		IsUpgrade:       true,
+		Match:           &LibraryMatch{MediaType: mediaTypeMovie},
+	}
+
+	tempPath, err := svc.stageUpgrade(ctx, result, nil)
+	if err != nil {
+		t.Fatalf("stageUpgrade() error = %v", err)
+	}
+	if err := os.WriteFile(tempPath, []byte("new"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if content, _ := os.ReadFile(dest); string(content) != "old" {
+		t.Fatalf("destination changed before swap: %q", content)
+	}
+
+	if err := svc.swapUpgrade(ctx, result); err != nil {
+		t.Fatalf("swapUpgrade() error = %v", err)
+	}
+	svc.finishUpgrade(result)
+
+	if content, _ := os.ReadFile(dest); string(content) != "new" {
+		t.Errorf("destination = %q, want new file", content)
+	}
+	if _, err := os.Stat(tempPath); !os.IsNotExist(err) {
+		t.Errorf("staged file should be gone, stat error = %v", err)
+	}
+	if rows, _ := sqlc.New(tdb.Conn).ListPendingUpgrades(ctx); len(rows) != 0 {
+		t.Errorf("expected upgrade record to be cleared, got %d", len(rows))
+	}
+}
+
+func TestRecoverUpgrades_RollsBackUnswappedUpgrade(t *testing.T) {
+	tdb := testutil.NewTestDB(t)
+	defer tdb.Close()
+
+	svc := NewService(tdb.Conn, nil, nil, nil, nil, nil, nil, nil, Config{}, &tdb.Logger, nil, nil, nil, nil, nil)
+	ctx := context.Background()
+	queries := sqlc.New(tdb.Conn)
+
+	dest := filepath.Join(t.TempDir(), "Movie (2020).mkv")
+	tempPath := upgradeTempPath(dest)
+	if err := os.WriteFile(dest, []byte("old"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(tempPath, []byte("partial"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := queries.CreatePendingUpgrade(ctx, sqlc.CreatePendingUpgradeParams{
+		MediaType:    mediaTypeMovie,
+		PreviousPath: dest,
+		TempPath:     tempPath,
+		DestPath:     dest,
+	}); err != nil {
+		t.Fatalf("CreatePendingUpgrade error = %v", err)
+	}
+
+	svc.recoverUpgrades(ctx)
+
+	if content, _ := os.ReadFile(dest); string(content) != "old" {
+		t.Errorf("previous file = %q, want it kept", content)
+	}
+	if _, err := os.Stat(tempPath); !os.IsNotExist(err) {
+		t.Errorf("partial upgrade should be removed, stat error = %v", err)
+	}
+	if rows, _ := queries.ListPendingUpgrades(ctx); len(rows) != 0 {
+		t.Errorf("expected upgrade record to be cleared, got %d", len(rows))
+	}
+}
+
+func TestRecoverUpgrades_RecordsSwappedFile(t *testing.T) {
+	tdb := testutil.NewTestDB(t)
+	defer tdb.Close()
+
+	ctx := context.Background()
+	moviesSvc := movies.NewService(tdb.Conn, nil, &tdb.Logger, nil, nil)
+	svc := NewService(tdb.Conn, nil, moviesSvc, nil, nil, nil, nil, nil, Config{}, &tdb.Logger, nil, nil, nil, nil, nil)
+	queries := sqlc.New(tdb.Conn)
+
+	dir := t.TempDir()
+	dest := filepath.Join(dir, "Movie (2020).mkv")
+	movie, err := moviesSvc.Create(ctx, &movies.CreateMovieInput{Title: "Movie", Year: 2020, Path: dir})
+	if err != nil {
+		t.Fatalf("Create movie: %v", err)
+	}
+	oldFile, err := moviesSvc.AddFile(ctx, movie.ID, &movies.CreateMovieFileInput{Path: dest, Size: 3})
+	if err != nil {
+		t.Fatalf("AddFile: %v", err)
+	}
+
+	// The crash came after the new file was renamed into place but before
+	// its record was written: the staged file is gone and the old record
+	// still describes the destination.
+	if err := os.WriteFile(dest, []byte("new file"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := queries.CreatePendingUpgrade(ctx, sqlc.CreatePendingUpgradeParams{
+		MediaType:    mediaTypeMovie,
+		OldFileID:    sql.NullInt64{Int64: oldFile.ID, Valid: true},
+		PreviousPath: dest,
+		TempPath:     upgradeTempPath(dest),
+		DestPath:     dest,
+	}); err != nil {
+		t.Fatalf("CreatePendingUpgrade error = %v", err)
+	}
+
+	svc.recoverUpgrades(ctx)
+
+	files, err := moviesSvc.GetFiles(ctx, movie.ID)
+	if err != nil {
+		t.Fatalf("GetFiles: %v", err)
+	}
+	if len(files) != 1 || files[0].ID == oldFile.ID || files[0].Size != int64(len("new file")) {
+		t.Errorf("files = %+v, want one new record for the swapped-in file", files)
+	}
+	if rows, _ := queries.ListPendingUpgrades(ctx); len(rows) != 0 {
+		t.Errorf("expected upgrade record to be cleared, got %d", len(rows))
+	}
+}
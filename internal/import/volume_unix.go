@@ -0,0 +1,23 @@
+//go:build !windows
+
+package importer
+
+import (
+	"os"
+	"strconv"
+
+	"golang.org/x/sys/unix"
+)
+
+// volumeKey identifies the filesystem holding path by its device number.
+func volumeKey(path string) string {
+	dir := nearestExisting(path, func(p string) bool {
+		_, err := os.Stat(p)
+		return err == nil
+	})
+	var st unix.Stat_t
+	if err := unix.Stat(dir, &st); err != nil {
+		return dir
+	}
+	return "dev:" + strconv.FormatUint(uint64(st.Dev), 10) //nolint:unconvert // Dev is uint32 on some platforms
+}
@@ -0,0 +1,16 @@
+//go:build windows
+
+package importer
+
+import (
+	"path/filepath"
+	"strings"
+)
+
+// volumeKey identifies the filesystem holding path by its drive or UNC share.
+func volumeKey(path string) string {
+	if vol := filepath.VolumeName(path); vol != "" {
+		return strings.ToUpper(vol)
+	}
+	return path
+}
@@ -0,0 +1,177 @@
+package importer
+
+import (
+	"container/heap"
+	"context"
+	"errors"
+	"path/filepath"
+	"sort"
+	"sync"
+)
+
+// queueCapacity is the most jobs the import queue holds before rejecting new ones.
+const queueCapacity = 100
+
+var errQueueFull = errors.New("import queue is full")
+
+// Job priorities; higher runs first.
+const (
+	priorityNormal  = 0
+	priorityUpgrade = 1
+	priorityManual  = 2
+)
+
+type queuedJob struct {
+	job      ImportJob
+	priority int
+	seq      uint64
+}
+
+// jobHeap orders queued jobs by priority, then by arrival.
+type jobHeap []queuedJob
+
+func (h jobHeap) Len() int { return len(h) }
+func (h jobHeap) Less(i, j int) bool {
+	if h[i].priority != h[j].priority {
+		return h[i].priority > h[j].priority
+	}
+	return h[i].seq < h[j].seq
+}
+func (h jobHeap) Swap(i, j int) { h[i], h[j] = h[j], h[i] }
+func (h *jobHeap) Push(x any)   { *h = append(*h, x.(queuedJob)) }
+func (h *jobHeap) Pop() any {
+	old := *h
+	n := len(old)
+	item := old[n-1]
+	*h = old[:n-1]
+	return item
+}
+
+// jobQueue is the pending import queue: manual imports first, then upgrades,
+// then everything else, oldest first within each tier. Every queued job puts
+// one token on ready, so a worker that receives a token always finds a job.
+type jobQueue struct {
+	mu    sync.Mutex
+	items jobHeap
+	seq   uint64
+	ready chan struct{}
+}
+
+func newJobQueue(capacity int) *jobQueue {
+	return &jobQueue{ready: make(chan struct{}, capacity)}
+}
+
+func (q *jobQueue) push(job ImportJob, priority int) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if len(q.items) >= cap(q.ready) {
+		return errQueueFull
+	}
+	q.seq++
+	heap.Push(&q.items, queuedJob{job: job, priority: priority, seq: q.seq})
+	q.ready <- struct{}{}
+	return nil
+}
+
+func (q *jobQueue) pop() ImportJob {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return heap.Pop(&q.items).(queuedJob).job
+}
+
+// snapshot returns the queued jobs in the order they will be popped.
+func (q *jobQueue) snapshot() []ImportJob {
+	q.mu.Lock()
+	items := make(jobHeap, len(q.items))
+	copy(items, q.items)
+	q.mu.Unlock()
+
+	sort.Slice(items, items.Less)
+	jobs := make([]ImportJob, len(items))
+	for i := range items {
+		jobs[i] = items[i].job
+	}
+	return jobs
+}
+
+func (q *jobQueue) len() int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return len(q.items)
+}
+
+// jobPriority ranks a job for the queue. Upgrades are detected from the
+// confirmed match or, for queued downloads, from files already in the library.
+func (s *Service) jobPriority(ctx context.Context, job *ImportJob) int {
+	if job.Manual {
+		return priorityManual
+	}
+	if job.ConfirmedMatch != nil && job.ConfirmedMatch.IsUpgrade {
+		return priorityUpgrade
+	}
+	if m := job.DownloadMapping; m != nil {
+		var count int64
+		var err error
+		switch {
+		case m.MovieID != nil:
+			count, err = s.queries.CountMovieFiles(ctx, *m.MovieID)
+		case m.EpisodeID != nil:
+			count, err = s.queries.CountEpisodeFiles(ctx, *m.EpisodeID)
+		}
+		if err == nil && count > 0 {
+			return priorityUpgrade
+		}
+	}
+	return priorityNormal
+}
+
+// volumeLimiter caps simultaneous file transfers per destination filesystem
+// so parallel workers do not thrash a single spinning disk.
+type volumeLimiter struct {
+	limit int
+	mu    sync.Mutex
+	slots map[string]chan struct{}
+}
+
+func newVolumeLimiter(limit int) *volumeLimiter {
+	return &volumeLimiter{limit: limit, slots: make(map[string]chan struct{})}
+}
+
+// acquire blocks until a transfer slot on the volume holding dest is free and
+// returns its release func. A non-positive limit disables the cap.
+func (l *volumeLimiter) acquire(ctx context.Context, dest string) (func(), error) {
+	if l == nil || l.limit <= 0 {
+		return func() {}, nil
+	}
+	key := volumeKey(filepath.Dir(dest))
+
+	l.mu.Lock()
+	sem, ok := l.slots[key]
+	if !ok {
+		sem = make(chan struct{}, l.limit)
+		l.slots[key] = sem
+	}
+	l.mu.Unlock()
+
+	select {
+	case sem <- struct{}{}:
+		return func() { <-sem }, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// nearestExisting walks up from path to the first directory that exists, so a
+// destination folder that is about to be created resolves to its volume.
+func nearestExisting(path string, exists func(string) bool) string {
+	for {
+		if exists(path) {
+			return path
+		}
+		parent := filepath.Dir(path)
+		if parent == path {
+			return path
+		}
+		path = parent
+	}
+}
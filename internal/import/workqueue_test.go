@@ -0,0 +1,112 @@
+package importer
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/slipstream/slipstream/internal/testutil"
+)
+
+func TestJobQueueOrdering(t *testing.T) {
+	q := newJobQueue(10)
+	jobs := []struct {
+		path     string
+		priority int
+	}{
+		{"a.mkv", priorityNormal},
+		{"b.mkv", priorityUpgrade},
+		{"c.mkv", priorityManual},
+		{"d.mkv", priorityNormal},
+		{"e.mkv", priorityUpgrade},
+	}
+	for _, j := range jobs {
+		if err := q.push(ImportJob{SourcePath: j.path}, j.priority); err != nil {
+			t.Fatalf("push %s: %v", j.path, err)
+		}
+	}
+
+	want := []string{"c.mkv", "b.mkv", "e.mkv", "a.mkv", "d.mkv"}
+	for _, w := range want {
+		<-q.ready
+		if got := q.pop().SourcePath; got != w {
+			t.Errorf("pop = %s, want %s", got, w)
+		}
+	}
+	if q.len() != 0 {
+		t.Errorf("len = %d, want 0", q.len())
+	}
+}
+
+func TestJobQueueFull(t *testing.T) {
+	q := newJobQueue(1)
+	if err := q.push(ImportJob{SourcePath: "a.mkv"}, priorityNormal); err != nil {
+		t.Fatalf("push: %v", err)
+	}
+	if err := q.push(ImportJob{SourcePath: "b.mkv"}, priorityManual); !errors.Is(err, errQueueFull) {
+		t.Errorf("push on full queue = %v, want errQueueFull", err)
+	}
+}
+
+func TestVolumeLimiter(t *testing.T) {
+	dir := t.TempDir()
+	l := newVolumeLimiter(1)
+
+	release, err := l.acquire(context.Background(), filepath.Join(dir, "Show", "a.mkv"))
+	if err != nil {
+		t.Fatalf("acquire: %v", err)
+	}
+
+	// A second transfer to the same volume waits for the first to finish.
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	if _, err := l.acquire(ctx, filepath.Join(dir, "b.mkv")); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("second acquire = %v, want deadline exceeded", err)
+	}
+
+	release()
+	release2, err := l.acquire(context.Background(), filepath.Join(dir, "b.mkv"))
+	if err != nil {
+		t.Fatalf("acquire after release: %v", err)
+	}
+	release2()
+}
+
+func TestVolumeLimiterUnlimited(t *testing.T) {
+	l := newVolumeLimiter(0)
+	dir := t.TempDir()
+	for i := 0; i < 3; i++ {
+		if _, err := l.acquire(context.Background(), filepath.Join(dir, "a.mkv")); err != nil {
+			t.Fatalf("acquire %d: %v", i, err)
+		}
+	}
+}
+
+func TestMarkCompleteDropsCrossSeedState(t *testing.T) {
+	tdb := testutil.NewTestDB(t)
+	defer tdb.Close()
+
+	svc := NewService(tdb.Conn, nil, nil, nil, nil, nil, nil, nil, Config{}, &tdb.Logger, nil, nil, nil, nil, nil)
+	mapping := &DownloadMapping{ID: 7}
+	jobs := []ImportJob{
+		{SourcePath: "/downloads/show/e01.mkv", DownloadMapping: mapping},
+		{SourcePath: "/downloads/show/e02.mkv", DownloadMapping: mapping},
+	}
+	for _, job := range jobs {
+		if err := svc.QueueImport(job); err != nil {
+			t.Fatalf("QueueImport(%s) error = %v", job.SourcePath, err)
+		}
+	}
+	svc.crossSeeds[mapping.ID] = true
+
+	svc.markComplete(&jobs[0])
+	if !svc.crossSeeds[mapping.ID] {
+		t.Fatal("cross-seed state dropped while a job for the download is still queued")
+	}
+	svc.markComplete(&jobs[1])
+	if len(svc.crossSeeds) != 0 || len(svc.mappingJobs) != 0 {
+		t.Errorf("crossSeeds = %v, mappingJobs = %v, want both empty", svc.crossSeeds, svc.mappingJobs)
+	}
+}
@@ -23,6 +23,12 @@ type CookieStore interface {
 	ClearCookies(ctx context.Context, indexerID int64) error
 }
 
+// QuarantineStore persists malformed result rows set aside during a search.
+type QuarantineStore interface {
+	// QuarantineRows stores the rows rejected while parsing results for a query.
+	QuarantineRows(ctx context.Context, indexerID int64, query string, rows []QuarantinedRow) error
+}
+
 // Client implements the indexer.Indexer interface using a Cardigann definition.
 type Client struct {
 	def           *Definition
@@ -33,6 +39,7 @@ type Client struct {
 	httpClient    *http.Client
 	logger        *zerolog.Logger
 	cookieStore   CookieStore
+	quarantine    QuarantineStore
 	authenticated bool
 	lastLogin     time.Time
 }
@@ -43,7 +50,9 @@ type ClientConfig struct {
 	IndexerDef  *types.IndexerDefinition
 	Settings    map[string]string
 	Logger      *zerolog.Logger
-	CookieStore CookieStore // Optional: provides persistent cookie storage
+	CookieStore CookieStore     // Optional: provides persistent cookie storage
+	Quarantine  QuarantineStore // Optional: stores malformed result rows
+	Proxy       ProxyConfig
 }
 
 // NewClient creates a new Cardigann client.
@@ -63,7 +72,7 @@ func NewClient(cfg ClientConfig) (*Client, error) {
 		Logger()
 
 	// Create login handler
-	loginHandler, err := NewLoginHandler(baseURL, &logger)
+	loginHandler, err := NewLoginHandler(baseURL, cfg.Proxy, &logger)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create login handler: %w", err)
 	}
@@ -80,6 +89,7 @@ func NewClient(cfg ClientConfig) (*Client, error) {
 		httpClient:   loginHandler.GetHTTPClient(),
 		logger:       &logger,
 		cookieStore:  cfg.CookieStore,
+		quarantine:   cfg.Quarantine,
 	}, nil
 }
 
@@ -136,7 +146,7 @@ func (c *Client) Search(ctx context.Context, criteria *types.SearchCriteria) ([]
 	query := c.buildSearchQuery(criteria)
 
 	// Execute search
-	results, err := c.searchEngine.Search(ctx, &query, c.settings)
+	results, err := c.runSearch(ctx, &query)
 	if err != nil {
 		return nil, fmt.Errorf("search failed: %w", err)
 	}
@@ -176,7 +186,7 @@ func (c *Client) SearchTorrents(ctx context.Context, criteria *types.SearchCrite
 		Msg("Executing search with enhanced query")
 
 	// Execute search
-	results, err := c.searchEngine.Search(ctx, &query, c.settings)
+	results, err := c.runSearch(ctx, &query)
 	if err != nil {
 		return nil, fmt.Errorf("search failed: %w", err)
 	}
@@ -196,6 +206,23 @@ func (c *Client) SearchTorrents(ctx context.Context, criteria *types.SearchCrite
 	return torrents, nil
 }
 
+// runSearch executes a query through the search engine and hands any
+// quarantined rows to the quarantine store.
+func (c *Client) runSearch(ctx context.Context, query *SearchQuery) ([]SearchResult, error) {
+	results, quarantined, err := c.searchEngine.Search(ctx, query, c.settings)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(quarantined) > 0 && c.quarantine != nil && c.indexerDef != nil {
+		if err := c.quarantine.QuarantineRows(ctx, c.indexerDef.ID, query.Query, quarantined); err != nil {
+			c.logger.Warn().Err(err).Int("rows", len(quarantined)).Msg("Failed to store quarantined rows")
+		}
+	}
+
+	return results, nil
+}
+
 // Download retrieves the torrent/nzb file from the given URL.
 func (c *Client) Download(ctx context.Context, url string) ([]byte, error) {
 	// Ensure we're authenticated
@@ -509,6 +536,8 @@ func (c *Client) convertToTorrentInfo(result *SearchResult) types.TorrentInfo {
 		MinimumSeedTime:      result.MinimumSeedTime,
 		DownloadVolumeFactor: result.DownloadVolumeFactor,
 		UploadVolumeFactor:   result.UploadVolumeFactor,
+		Freeleech:            result.DownloadVolumeFactor == 0,
+		Internal:             result.Internal,
 	}
 
 	return torrent
@@ -0,0 +1,177 @@
+package cardigann
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// ErrFlareSolverrUnavailable is returned when the configured FlareSolverr
+// instance cannot be reached or fails to solve a challenge.
+var ErrFlareSolverrUnavailable = errors.New("flaresolverr unavailable")
+
+const (
+	flareSolverrMaxTimeout = 60 * time.Second
+	flareSolverrOverhead   = 10 * time.Second
+)
+
+// flareSolverr is a minimal client for the FlareSolverr v1 API.
+type flareSolverr struct {
+	endpoint   string
+	proxyURL   string
+	httpClient *http.Client
+}
+
+func newFlareSolverr(baseURL, proxyURL string) *flareSolverr {
+	return &flareSolverr{
+		endpoint:   strings.TrimSuffix(baseURL, "/") + "/v1",
+		proxyURL:   proxyURL,
+		httpClient: &http.Client{Timeout: flareSolverrMaxTimeout + flareSolverrOverhead},
+	}
+}
+
+type flareSolverrRequest struct {
+	Cmd        string             `json:"cmd"`
+	URL        string             `json:"url"`
+	PostData   string             `json:"postData,omitempty"`
+	MaxTimeout int64              `json:"maxTimeout"`
+	Proxy      *flareSolverrProxy `json:"proxy,omitempty"`
+}
+
+type flareSolverrProxy struct {
+	URL string `json:"url"`
+}
+
+type flareSolverrResponse struct {
+	Status   string               `json:"status"`
+	Message  string               `json:"message"`
+	Solution flareSolverrSolution `json:"solution"`
+}
+
+type flareSolverrSolution struct {
+	URL       string               `json:"url"`
+	Status    int                  `json:"status"`
+	Cookies   []flareSolverrCookie `json:"cookies"`
+	UserAgent string               `json:"userAgent"`
+}
+
+type flareSolverrCookie struct {
+	Name     string  `json:"name"`
+	Value    string  `json:"value"`
+	Domain   string  `json:"domain"`
+	Path     string  `json:"path"`
+	Expires  float64 `json:"expires"`
+	HTTPOnly bool    `json:"httpOnly"`
+	Secure   bool    `json:"secure"`
+}
+
+// solve asks FlareSolverr to load the request's URL in a real browser and
+// returns the clearance cookies and user agent it ended up with.
+func (f *flareSolverr) solve(ctx context.Context, req *http.Request) (*flareSolverrSolution, error) {
+	payload := flareSolverrRequest{
+		Cmd:        "request.get",
+		URL:        req.URL.String(),
+		MaxTimeout: flareSolverrMaxTimeout.Milliseconds(),
+	}
+	if req.Method == http.MethodPost {
+		postData, err := readReplayableBody(req)
+		if err != nil {
+			return nil, err
+		}
+		payload.Cmd = "request.post"
+		payload.PostData = postData
+	}
+	if f.proxyURL != "" {
+		payload.Proxy = &flareSolverrProxy{URL: f.proxyURL}
+	}
+
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode flaresolverr request: %w", err)
+	}
+	solverReq, err := http.NewRequestWithContext(ctx, http.MethodPost, f.endpoint, bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create flaresolverr request: %w", err)
+	}
+	solverReq.Header.Set("Content-Type", "application/json")
+
+	resp, err := f.httpClient.Do(solverReq)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrFlareSolverrUnavailable, err)
+	}
+	defer resp.Body.Close()
+
+	var result flareSolverrResponse
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, fmt.Errorf("%w: invalid response (status %d): %w", ErrFlareSolverrUnavailable, resp.StatusCode, err)
+	}
+	if result.Status != "ok" {
+		return nil, fmt.Errorf("%w: %s", ErrFlareSolverrUnavailable, result.Message)
+	}
+	return &result.Solution, nil
+}
+
+// httpCookies converts the solution's cookies for storage in a cookie jar.
+func (s *flareSolverrSolution) httpCookies() []*http.Cookie {
+	cookies := make([]*http.Cookie, 0, len(s.Cookies))
+	for _, c := range s.Cookies {
+		cookie := &http.Cookie{
+			Name:     c.Name,
+			Value:    c.Value,
+			Domain:   c.Domain,
+			Path:     c.Path,
+			HttpOnly: c.HTTPOnly,
+			Secure:   c.Secure,
+		}
+		if c.Expires > 0 {
+			cookie.Expires = time.Unix(int64(c.Expires), 0)
+		}
+		cookies = append(cookies, cookie)
+	}
+	return cookies
+}
+
+// PingFlareSolverr checks that a FlareSolverr instance is reachable and ready.
+func PingFlareSolverr(ctx context.Context, baseURL string) error {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, strings.TrimSuffix(baseURL, "/")+"/health", http.NoBody)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrFlareSolverrUnavailable, err)
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrFlareSolverrUnavailable, err)
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, resp.Body)
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("%w: health check returned status %d", ErrFlareSolverrUnavailable, resp.StatusCode)
+	}
+	return nil
+}
+
+// readReplayableBody returns the request body as a string without consuming it.
+func readReplayableBody(req *http.Request) (string, error) {
+	if req.GetBody == nil {
+		return "", nil
+	}
+	body, err := req.GetBody()
+	if err != nil {
+		return "", fmt.Errorf("failed to read request body: %w", err)
+	}
+	defer body.Close()
+	data, err := io.ReadAll(body)
+	if err != nil {
+		return "", fmt.Errorf("failed to read request body: %w", err)
+	}
+	return string(data), nil
+}
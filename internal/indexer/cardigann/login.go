@@ -8,7 +8,6 @@ import (
 	"net/http/cookiejar"
 	"net/url"
 	"strings"
-	"time"
 
 	"github.com/rs/zerolog"
 )
@@ -32,18 +31,25 @@ func joinURL(baseURL, path string) string {
 	return baseURL + "/" + path
 }
 
-// NewLoginHandler creates a new login handler.
-func NewLoginHandler(baseURL string, logger *zerolog.Logger) (*LoginHandler, error) {
+// NewLoginHandler creates a new login handler whose HTTP client is routed
+// according to proxy.
+func NewLoginHandler(baseURL string, proxy ProxyConfig, logger *zerolog.Logger) (*LoginHandler, error) {
 	jar, err := cookiejar.New(nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create cookie jar: %w", err)
 	}
 
 	subLogger := logger.With().Str("component", "cardigann").Str("service", "login").Logger()
+	transport, err := newTransport(proxy, jar, &subLogger)
+	if err != nil {
+		return nil, err
+	}
+
 	return &LoginHandler{
 		httpClient: &http.Client{
-			Timeout: 30 * time.Second,
-			Jar:     jar,
+			Timeout:   proxy.requestTimeout(),
+			Jar:       jar,
+			Transport: transport,
 			CheckRedirect: func(req *http.Request, via []*http.Request) error {
 				if len(via) >= 10 {
 					return fmt.Errorf("too many redirects")
@@ -27,6 +27,7 @@ type Manager struct {
 	autoUpdate     bool
 	updateInterval time.Duration
 	cookieStore    CookieStore
+	quarantine     QuarantineStore
 	modeCheckFunc  func() bool // Returns true if SlipStream mode (definitions should be used)
 }
 
@@ -72,6 +73,11 @@ func (m *Manager) SetCookieStore(store CookieStore) {
 	m.cookieStore = store
 }
 
+// SetQuarantineStore sets the store for malformed search result rows.
+func (m *Manager) SetQuarantineStore(store QuarantineStore) {
+	m.quarantine = store
+}
+
 // SetModeCheckFunc sets a function that returns true when SlipStream mode is active.
 // When in Prowlarr mode, definition updates are skipped.
 func (m *Manager) SetModeCheckFunc(fn func() bool) {
@@ -262,7 +268,7 @@ func (m *Manager) CreateClient(indexerDef *types.IndexerDefinition, settings map
 }
 
 // CreateClientFromDefinition creates a new indexer client from a definition ID.
-func (m *Manager) CreateClientFromDefinition(defID string, indexerID int64, name string, settings map[string]string) (*Client, error) {
+func (m *Manager) CreateClientFromDefinition(defID string, indexerID int64, name string, settings map[string]string, proxy ProxyConfig) (*Client, error) {
 	def, err := m.cache.Get(defID)
 	if err != nil {
 		return nil, fmt.Errorf("definition not found: %w", err)
@@ -286,6 +292,8 @@ func (m *Manager) CreateClientFromDefinition(defID string, indexerID int64, name
 		Settings:    settings,
 		Logger:      m.logger,
 		CookieStore: m.cookieStore,
+		Quarantine:  m.quarantine,
+		Proxy:       proxy,
 	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to create client: %w", err)
@@ -316,9 +324,9 @@ func (m *Manager) RemoveClient(indexerID int64) {
 	m.clientsMu.Unlock()
 }
 
-// TestDefinition tests a definition with the given settings.
-func (m *Manager) TestDefinition(ctx context.Context, defID string, settings map[string]string) error {
-	client, err := m.CreateClientFromDefinition(defID, 0, "Test", settings)
+// TestDefinition tests a definition with the given settings and routing.
+func (m *Manager) TestDefinition(ctx context.Context, defID string, settings map[string]string, proxy ProxyConfig) error {
+	client, err := m.CreateClientFromDefinition(defID, 0, "Test", settings, proxy)
 	if err != nil {
 		return fmt.Errorf("failed to create test client: %w", err)
 	}
@@ -353,6 +361,16 @@ func (m *Manager) NeedsUpdate() bool {
 	return time.Since(m.lastUpdate) > m.updateInterval
 }
 
+// DefinitionsOverdue reports whether automatic updates are enabled but the
+// definitions have not been refreshed for more than two update intervals,
+// which usually means the definitions repository is unreachable.
+func (m *Manager) DefinitionsOverdue() (lastUpdate time.Time, overdue bool) {
+	if !m.autoUpdate || !m.isSlipStreamMode() {
+		return m.lastUpdate, false
+	}
+	return m.lastUpdate, time.Since(m.lastUpdate) > 2*m.updateInterval
+}
+
 // GetLastUpdate returns the time of the last definition update.
 func (m *Manager) GetLastUpdate() time.Time {
 	return m.lastUpdate
@@ -0,0 +1,206 @@
+package cardigann
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog"
+)
+
+const (
+	defaultRequestTimeout = 30 * time.Second
+	solverRequestTimeout  = 2*flareSolverrMaxTimeout + flareSolverrOverhead
+	challengeBodyLimit    = 64 << 10
+)
+
+// ProxyConfig routes an indexer's HTTP traffic. ProxyURL is an HTTP, HTTPS
+// or SOCKS5 proxy used for every request; FlareSolverrURL points at a
+// FlareSolverr instance consulted when a Cloudflare or DDoS-Guard challenge
+// is detected.
+type ProxyConfig struct {
+	ProxyURL        string
+	FlareSolverrURL string
+}
+
+// requestTimeout leaves room for a FlareSolverr round trip plus the retry.
+func (p ProxyConfig) requestTimeout() time.Duration {
+	if p.FlareSolverrURL != "" {
+		return solverRequestTimeout
+	}
+	return defaultRequestTimeout
+}
+
+// ValidateProxyURL checks that a proxy URL uses a scheme the HTTP transport supports.
+func ValidateProxyURL(raw string) error {
+	_, err := parseProxyURL(raw)
+	return err
+}
+
+func parseProxyURL(raw string) (*url.URL, error) {
+	u, err := url.Parse(raw)
+	if err != nil || u.Host == "" {
+		return nil, fmt.Errorf("invalid proxy URL %q", raw)
+	}
+	switch u.Scheme {
+	case "http", "https", "socks5", "socks5h":
+		return u, nil
+	default:
+		return nil, fmt.Errorf("unsupported proxy scheme %q", u.Scheme)
+	}
+}
+
+// ValidateFlareSolverrURL checks that a FlareSolverr URL is an absolute HTTP(S) URL.
+func ValidateFlareSolverrURL(raw string) error {
+	u, err := url.Parse(raw)
+	if err != nil || u.Host == "" || (u.Scheme != "http" && u.Scheme != "https") {
+		return fmt.Errorf("invalid FlareSolverr URL %q", raw)
+	}
+	return nil
+}
+
+// newTransport builds the round tripper for an indexer's HTTP client.
+func newTransport(cfg ProxyConfig, jar http.CookieJar, logger *zerolog.Logger) (http.RoundTripper, error) {
+	base := http.DefaultTransport.(*http.Transport).Clone()
+	if cfg.ProxyURL != "" {
+		proxyURL, err := parseProxyURL(cfg.ProxyURL)
+		if err != nil {
+			return nil, err
+		}
+		base.Proxy = http.ProxyURL(proxyURL)
+	}
+	if cfg.FlareSolverrURL == "" {
+		return base, nil
+	}
+	if err := ValidateFlareSolverrURL(cfg.FlareSolverrURL); err != nil {
+		return nil, err
+	}
+	return &challengeTransport{
+		base:   base,
+		solver: newFlareSolverr(cfg.FlareSolverrURL, cfg.ProxyURL),
+		jar:    jar,
+		logger: logger,
+	}, nil
+}
+
+// challengeTransport retries requests that hit an anti-bot challenge after
+// having FlareSolverr obtain clearance cookies. Cloudflare binds clearance to
+// the browser's user agent, so once solved that user agent is used for every
+// subsequent request.
+type challengeTransport struct {
+	base   http.RoundTripper
+	solver *flareSolverr
+	jar    http.CookieJar
+	logger *zerolog.Logger
+
+	mu        sync.Mutex
+	userAgent string
+}
+
+func (t *challengeTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := t.base.RoundTrip(t.withUserAgent(req))
+	if err != nil {
+		return nil, err
+	}
+
+	challenged, err := isChallenge(resp)
+	if err != nil || !challenged {
+		return resp, err
+	}
+	resp.Body.Close()
+
+	t.logger.Info().Str("url", req.URL.Redacted()).Msg("Challenge detected, solving with FlareSolverr")
+	solution, err := t.solver.solve(req.Context(), req)
+	if err != nil {
+		return nil, err
+	}
+
+	t.jar.SetCookies(req.URL, solution.httpCookies())
+	t.mu.Lock()
+	t.userAgent = solution.UserAgent
+	t.mu.Unlock()
+
+	retry, err := t.replay(req)
+	if err != nil {
+		return nil, err
+	}
+	return t.base.RoundTrip(retry)
+}
+
+func (t *challengeTransport) withUserAgent(req *http.Request) *http.Request {
+	t.mu.Lock()
+	userAgent := t.userAgent
+	t.mu.Unlock()
+	if userAgent == "" {
+		return req
+	}
+	clone := req.Clone(req.Context())
+	clone.Header.Set("User-Agent", userAgent)
+	return clone
+}
+
+// replay rebuilds req with the freshly solved cookies and user agent.
+func (t *challengeTransport) replay(req *http.Request) (*http.Request, error) {
+	retry := t.withUserAgent(req)
+	if retry == req {
+		retry = req.Clone(req.Context())
+	}
+	if req.GetBody != nil {
+		body, err := req.GetBody()
+		if err != nil {
+			return nil, fmt.Errorf("failed to replay request body: %w", err)
+		}
+		retry.Body = body
+	}
+
+	retry.Header.Del("Cookie")
+	for _, cookie := range t.jar.Cookies(req.URL) {
+		retry.AddCookie(cookie)
+	}
+	return retry, nil
+}
+
+var challengeServers = []string{"cloudflare", "cloudflare-nginx", "ddos-guard"}
+
+var challengeMarkers = []string{
+	"<title>Just a moment...</title>",
+	"<title>Access denied</title>",
+	"<title>Attention Required! | Cloudflare</title>",
+	"<title>DDOS-GUARD</title>",
+	"error code: 1020",
+}
+
+// isChallenge reports whether resp is an anti-bot challenge page. The body is
+// restored so that non-challenge responses can still be read by the caller.
+func isChallenge(resp *http.Response) (bool, error) {
+	if resp.StatusCode != http.StatusForbidden && resp.StatusCode != http.StatusServiceUnavailable {
+		return false, nil
+	}
+	if !slices.Contains(challengeServers, strings.ToLower(resp.Header.Get("Server"))) {
+		return false, nil
+	}
+
+	head, err := io.ReadAll(io.LimitReader(resp.Body, challengeBodyLimit))
+	if err != nil {
+		resp.Body.Close()
+		return false, fmt.Errorf("failed to read response: %w", err)
+	}
+	resp.Body = struct {
+		io.Reader
+		io.Closer
+	}{io.MultiReader(bytes.NewReader(head), resp.Body), resp.Body}
+
+	body := string(head)
+	for _, marker := range challengeMarkers {
+		if strings.Contains(body, marker) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
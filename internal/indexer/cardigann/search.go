@@ -2,6 +2,7 @@ package cardigann
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
@@ -16,6 +17,9 @@ import (
 
 const (
 	searchTypeMovie = "movie"
+
+	// maxRawFragmentBytes caps the stored markup of a quarantined row.
+	maxRawFragmentBytes = 4096
 )
 
 // SearchEngine executes searches using a Cardigann definition.
@@ -48,10 +52,18 @@ type SearchResult struct {
 	TVDBID               int
 	DownloadVolumeFactor float64
 	UploadVolumeFactor   float64
+	Internal             bool
 	MinimumRatio         float64
 	MinimumSeedTime      int64
 }
 
+// QuarantinedRow is a result row that could not be turned into a valid
+// SearchResult, kept with its raw HTML or JSON so the definition can be fixed.
+type QuarantinedRow struct {
+	Reason      string
+	RawFragment string
+}
+
 // NewSearchEngine creates a new search engine for a definition.
 func NewSearchEngine(def *Definition, httpClient *http.Client, logger *zerolog.Logger) *SearchEngine {
 	subLogger := logger.With().Str("component", "cardigann").Str("service", "search").Str("indexer", def.ID).Logger()
@@ -65,8 +77,9 @@ func NewSearchEngine(def *Definition, httpClient *http.Client, logger *zerolog.L
 	}
 }
 
-// Search executes a search and returns parsed results.
-func (e *SearchEngine) Search(ctx context.Context, query *SearchQuery, settings map[string]string) ([]SearchResult, error) {
+// Search executes a search and returns parsed results along with any rows
+// that were malformed and set aside.
+func (e *SearchEngine) Search(ctx context.Context, query *SearchQuery, settings map[string]string) ([]SearchResult, []QuarantinedRow, error) {
 	mergedSettings := e.mergeSettingsWithDefaults(settings)
 	e.logSettingsDebug(mergedSettings, len(settings))
 
@@ -77,19 +90,25 @@ func (e *SearchEngine) Search(ctx context.Context, query *SearchQuery, settings
 	tmplCtx.Keywords = keywords
 
 	var allResults []SearchResult
+	var allQuarantined []QuarantinedRow
 	for i := range e.def.Search.Paths {
 		if !e.pathMatchesCategories(&e.def.Search.Paths[i], query.Categories) {
 			continue
 		}
-		results, err := e.executeSearchPath(ctx, &e.def.Search.Paths[i], tmplCtx)
+		results, quarantined, err := e.executeSearchPath(ctx, &e.def.Search.Paths[i], tmplCtx)
 		if err != nil {
 			e.logger.Error().Err(err).Str("path", e.def.Search.Paths[i].Path).Msg("Search path failed")
 			continue
 		}
 		allResults = append(allResults, results...)
+		allQuarantined = append(allQuarantined, quarantined...)
+	}
+
+	if len(allQuarantined) > 0 {
+		e.logger.Warn().Int("quarantined", len(allQuarantined)).Int("results", len(allResults)).Msg("Quarantined malformed result rows")
 	}
 
-	return allResults, nil
+	return allResults, allQuarantined, nil
 }
 
 func (e *SearchEngine) logSettingsDebug(mergedSettings map[string]string, inputCount int) {
@@ -371,10 +390,10 @@ func (e *SearchEngine) executeSearchRequest(req *http.Request) ([]byte, error) {
 }
 
 // executeSearchPath executes a single search path and returns results.
-func (e *SearchEngine) executeSearchPath(ctx context.Context, path *SearchPath, tmplCtx *TemplateContext) ([]SearchResult, error) {
+func (e *SearchEngine) executeSearchPath(ctx context.Context, path *SearchPath, tmplCtx *TemplateContext) ([]SearchResult, []QuarantinedRow, error) {
 	searchURL, err := e.buildSearchURL(path, tmplCtx)
 	if err != nil {
-		return nil, fmt.Errorf("failed to build search URL: %w", err)
+		return nil, nil, fmt.Errorf("failed to build search URL: %w", err)
 	}
 
 	e.logger.Debug().Str("url", searchURL).Msg("Executing search")
@@ -386,12 +405,12 @@ func (e *SearchEngine) executeSearchPath(ctx context.Context, path *SearchPath,
 
 	req, err := e.createSearchRequest(ctx, method, searchURL, path, tmplCtx)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	body, err := e.executeSearchRequest(req)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	responseType := "html"
@@ -481,29 +500,32 @@ func (e *SearchEngine) checkHTMLErrors(htmlSel *HTMLSelector) error {
 }
 
 // parseHTMLResponse parses an HTML search response.
-func (e *SearchEngine) parseHTMLResponse(body []byte, tmplCtx *TemplateContext) ([]SearchResult, error) {
+func (e *SearchEngine) parseHTMLResponse(body []byte, tmplCtx *TemplateContext) ([]SearchResult, []QuarantinedRow, error) {
 	htmlSel, err := NewHTMLSelector(body)
 	if err != nil {
-		return nil, fmt.Errorf("failed to parse HTML: %w", err)
+		return nil, nil, fmt.Errorf("failed to parse HTML: %w", err)
 	}
 
 	if err := e.checkHTMLErrors(htmlSel); err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	rows := htmlSel.ExtractRows(&e.def.Search.Rows)
 	if len(rows) == 0 {
 		e.logger.Debug().Msg("No rows found in search results")
-		return nil, nil
+		return nil, nil, nil
 	}
 
 	e.logger.Debug().Int("rows", len(rows)).Msg("Found search result rows")
 
 	var results []SearchResult
+	var quarantined []QuarantinedRow
 	for _, row := range rows {
 		result, err := e.extractResultFromRow(row, tmplCtx)
 		if err != nil {
 			e.logger.Debug().Err(err).Msg("Failed to extract result from row")
+			raw, _ := goquery.OuterHtml(row)
+			quarantined = append(quarantined, newQuarantinedRow(err, raw))
 			continue
 		}
 		if result != nil {
@@ -511,25 +533,26 @@ func (e *SearchEngine) parseHTMLResponse(body []byte, tmplCtx *TemplateContext)
 		}
 	}
 
-	return results, nil
+	return results, quarantined, nil
 }
 
 // parseJSONResponse parses a JSON search response.
-func (e *SearchEngine) parseJSONResponse(body []byte, tmplCtx *TemplateContext) ([]SearchResult, error) {
+func (e *SearchEngine) parseJSONResponse(body []byte, tmplCtx *TemplateContext) ([]SearchResult, []QuarantinedRow, error) {
 	jsonSel, err := NewJSONSelector(body)
 	if err != nil {
-		return nil, fmt.Errorf("failed to parse JSON: %w", err)
+		return nil, nil, fmt.Errorf("failed to parse JSON: %w", err)
 	}
 
 	rowsData, err := jsonSel.SelectArray(e.def.Search.Rows.Selector)
 	if err != nil {
 		e.logger.Debug().Err(err).Str("path", e.def.Search.Rows.Selector).Msg("Failed to select rows")
-		return nil, nil
+		return nil, nil, nil
 	}
 
 	e.logger.Debug().Int("rows", len(rowsData)).Msg("Found JSON result rows")
 
 	var results []SearchResult
+	var quarantined []QuarantinedRow
 	for i, rowData := range rowsData {
 		if i < e.def.Search.Rows.After {
 			continue
@@ -543,6 +566,8 @@ func (e *SearchEngine) parseJSONResponse(body []byte, tmplCtx *TemplateContext)
 		result, err := e.extractResultFromJSON(actualRowData, tmplCtx)
 		if err != nil {
 			e.logger.Debug().Err(err).Msg("Failed to extract result from JSON row")
+			raw, _ := json.Marshal(actualRowData)
+			quarantined = append(quarantined, newQuarantinedRow(err, string(raw)))
 			continue
 		}
 		if result != nil {
@@ -550,7 +575,15 @@ func (e *SearchEngine) parseJSONResponse(body []byte, tmplCtx *TemplateContext)
 		}
 	}
 
-	return results, nil
+	return results, quarantined, nil
+}
+
+// newQuarantinedRow records why a row was rejected along with its truncated raw markup.
+func newQuarantinedRow(reason error, raw string) QuarantinedRow {
+	if len(raw) > maxRawFragmentBytes {
+		raw = strings.ToValidUTF8(raw[:maxRawFragmentBytes], "")
+	}
+	return QuarantinedRow{Reason: reason.Error(), RawFragment: raw}
 }
 
 // extractJSONRowData extracts the actual row data, handling nested attributes
@@ -606,14 +639,17 @@ func (e *SearchEngine) applyFieldsToResult(result *SearchResult, localCtx *Templ
 	}
 }
 
-// validateResult checks that required fields are present
-func (e *SearchEngine) validateResult(result *SearchResult) error {
+// validateResult checks that required fields are present and parseable
+func (e *SearchEngine) validateResult(result *SearchResult, fields map[string]string) error {
 	if result.Title == "" {
 		return fmt.Errorf("missing title")
 	}
 	if result.DownloadURL == "" && result.MagnetURL == "" && result.InfoHash == "" {
 		return fmt.Errorf("missing download URL")
 	}
+	if size := strings.TrimSpace(fields["size"]); size != "" && !strings.ContainsAny(size, "0123456789") {
+		return fmt.Errorf("unparsable size %q", size)
+	}
 	return nil
 }
 
@@ -649,7 +685,7 @@ func (e *SearchEngine) extractResultFromRow(row *goquery.Selection, tmplCtx *Tem
 
 	e.applyFieldsToResult(result, &localCtx)
 
-	if err := e.validateResult(result); err != nil {
+	if err := e.validateResult(result, localCtx.Result); err != nil {
 		return nil, err
 	}
 
@@ -677,7 +713,7 @@ func (e *SearchEngine) extractResultFromJSON(rowData interface{}, tmplCtx *Templ
 
 	e.applyFieldsToResult(result, &localCtx)
 
-	if err := e.validateResult(result); err != nil {
+	if err := e.validateResult(result, localCtx.Result); err != nil {
 		return nil, err
 	}
 
@@ -717,6 +753,7 @@ var fieldMappers = map[string]fieldMapper{
 	"downloadvolumefactor": func(r *SearchResult, v string) { r.DownloadVolumeFactor = parseFloat(v) },
 	"freeleech":            func(r *SearchResult, v string) { r.DownloadVolumeFactor = parseFloat(v) },
 	"uploadvolumefactor":   func(r *SearchResult, v string) { r.UploadVolumeFactor = parseFloat(v) },
+	"internal":             func(r *SearchResult, v string) { r.Internal = parseFlag(v) },
 	"minimumratio":         func(r *SearchResult, v string) { r.MinimumRatio = parseFloat(v) },
 	"minimumseedtime":      func(r *SearchResult, v string) { r.MinimumSeedTime = parseInt64(v) },
 	"guid":                 func(r *SearchResult, v string) { r.GUID = v },
@@ -835,3 +872,14 @@ func parseFloat(s string) float64 {
 	f, _ := strconv.ParseFloat(s, 64)
 	return f
 }
+
+// parseFlag reads a boolean field. Definitions fill flags from templates, so
+// anything other than an empty, "0", "false" or "no" value sets the flag.
+func parseFlag(s string) bool {
+	switch strings.ToLower(strings.TrimSpace(s)) {
+	case "", "0", "false", "no":
+		return false
+	default:
+		return true
+	}
+}
@@ -0,0 +1,93 @@
+package cardigann
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/rs/zerolog"
+)
+
+func newQuarantineTestEngine(fields map[string]Field, rows RowSelector) *SearchEngine {
+	logger := zerolog.Nop()
+	def := &Definition{
+		ID:    "test",
+		Links: []string{"https://tracker.example"},
+		Search: SearchBlock{
+			Rows:   rows,
+			Fields: fields,
+		},
+	}
+	return NewSearchEngine(def, nil, &logger)
+}
+
+func TestParseHTMLResponse_QuarantinesMalformedRows(t *testing.T) {
+	engine := newQuarantineTestEngine(map[string]Field{
+		"title":    {Selector: "td.title"},
+		"download": {Selector: "a.dl", Attribute: "href"},
+		"size":     {Selector: "td.size"},
+	}, RowSelector{Selector: "table tr"})
+
+	body := `<table>
+		<tr><td class="title">Good.Release.1080p</td><td><a class="dl" href="/dl/1">dl</a></td><td class="size">1.5 GB</td></tr>
+		<tr><td class="title">Bad.Size.1080p</td><td><a class="dl" href="/dl/2">dl</a></td><td class="size">N/A</td></tr>
+		<tr><td class="title"></td><td><a class="dl" href="/dl/3">dl</a></td><td class="size">700 MB</td></tr>
+	</table>`
+
+	results, quarantined, err := engine.parseHTMLResponse([]byte(body), NewTemplateContext())
+	if err != nil {
+		t.Fatalf("parseHTMLResponse() error = %v", err)
+	}
+	if len(results) != 1 || results[0].Title != "Good.Release.1080p" {
+		t.Fatalf("results = %+v, want only Good.Release.1080p", results)
+	}
+	if len(quarantined) != 2 {
+		t.Fatalf("quarantined = %d rows, want 2", len(quarantined))
+	}
+	if !strings.Contains(quarantined[0].Reason, "unparsable size") {
+		t.Errorf("quarantined[0].Reason = %q, want unparsable size", quarantined[0].Reason)
+	}
+	if !strings.Contains(quarantined[0].RawFragment, "Bad.Size.1080p") {
+		t.Errorf("quarantined[0].RawFragment = %q, want the row markup", quarantined[0].RawFragment)
+	}
+	if !strings.Contains(quarantined[1].Reason, "missing title") {
+		t.Errorf("quarantined[1].Reason = %q, want missing title", quarantined[1].Reason)
+	}
+}
+
+func TestParseJSONResponse_QuarantinesMalformedRows(t *testing.T) {
+	engine := newQuarantineTestEngine(map[string]Field{
+		"title":    {Selector: "name"},
+		"download": {Selector: "link"},
+		"size":     {Selector: "size"},
+	}, RowSelector{Selector: "data"})
+
+	body := `{"data":[
+		{"name":"Good.Release","link":"https://tracker.example/dl/1","size":"1073741824"},
+		{"link":"https://tracker.example/dl/2","size":"1024"}
+	]}`
+
+	results, quarantined, err := engine.parseJSONResponse([]byte(body), NewTemplateContext())
+	if err != nil {
+		t.Fatalf("parseJSONResponse() error = %v", err)
+	}
+	if len(results) != 1 {
+		t.Fatalf("results = %d, want 1", len(results))
+	}
+	if len(quarantined) != 1 {
+		t.Fatalf("quarantined = %d rows, want 1", len(quarantined))
+	}
+	if !strings.Contains(quarantined[0].RawFragment, "dl/2") {
+		t.Errorf("RawFragment = %q, want the JSON row", quarantined[0].RawFragment)
+	}
+}
+
+func TestNewQuarantinedRow_TruncatesFragment(t *testing.T) {
+	row := newQuarantinedRow(errors.New("bad row"), strings.Repeat("é", maxRawFragmentBytes))
+	if len(row.RawFragment) > maxRawFragmentBytes {
+		t.Errorf("RawFragment length = %d, want at most %d", len(row.RawFragment), maxRawFragmentBytes)
+	}
+	if !strings.HasPrefix(row.RawFragment, "é") || strings.ContainsRune(row.RawFragment, '�') {
+		t.Errorf("RawFragment was not truncated on a UTF-8 boundary")
+	}
+}
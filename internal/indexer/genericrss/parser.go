@@ -260,6 +260,7 @@ func parseTorrentPotato(data []byte, indexerID int64, indexerName string) ([]typ
 			Seeders:              item.Seeders,
 			Leechers:             item.Leechers,
 			DownloadVolumeFactor: dvf,
+			Freeleech:            item.Freeleech,
 		})
 	}
 
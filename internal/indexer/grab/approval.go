@@ -0,0 +1,222 @@
+package grab
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/slipstream/slipstream/internal/database/sqlc"
+	"github.com/slipstream/slipstream/internal/indexer/types"
+)
+
+// Grab approval modes. In approval mode automatic grabs are held as pending
+// grabs until an admin approves them.
+const (
+	ApprovalOff    = "off"
+	ApprovalAll    = "all"
+	ApprovalTagged = "tagged"
+)
+
+// ErrPendingGrabNotFound means no pending grab has the given ID.
+var ErrPendingGrabNotFound = errors.New("pending grab not found")
+
+// ApprovalPolicy selects which automatic grabs are held for approval. In
+// tagged mode only items sharing one of TagIDs are held.
+type ApprovalPolicy struct {
+	Mode   string
+	TagIDs []int64
+}
+
+// PendingGrab is an automatic grab held for approval, with the score of the
+// selected release so the admin can judge the pick.
+type PendingGrab struct {
+	ID              int64                 `json:"id"`
+	MediaType       string                `json:"mediaType"`
+	MediaID         int64                 `json:"mediaId"`
+	SeriesID        int64                 `json:"seriesId,omitempty"`
+	SeasonNumber    int                   `json:"seasonNumber,omitempty"`
+	TargetSlotID    *int64                `json:"targetSlotId,omitempty"`
+	Source          string                `json:"source"`
+	Release         *types.ReleaseInfo    `json:"release"`
+	Score           float64               `json:"score"`
+	NormalizedScore int                   `json:"normalizedScore"`
+	ScoreBreakdown  *types.ScoreBreakdown `json:"scoreBreakdown,omitempty"`
+	CreatedAt       time.Time             `json:"createdAt"`
+}
+
+// SetApprovalPolicy sets the source of the grab approval policy. It is read on
+// every grab so settings changes apply immediately.
+func (s *Service) SetApprovalPolicy(policy func() ApprovalPolicy) {
+	s.approvalPolicy = policy
+}
+
+// requiresApproval reports whether an automatic grab must be held for approval.
+func (s *Service) requiresApproval(ctx context.Context, req *GrabRequest) bool {
+	if !req.isAutomatic() || s.approvalPolicy == nil {
+		return false
+	}
+	policy := s.approvalPolicy()
+	switch policy.Mode {
+	case ApprovalAll:
+		return true
+	case ApprovalTagged:
+		return sharesTag(policy.TagIDs, s.itemTags(ctx, req))
+	default:
+		return false
+	}
+}
+
+func sharesTag(policyTags, itemTags []int64) bool {
+	for _, p := range policyTags {
+		for _, i := range itemTags {
+			if p == i {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// checkApprovalHold stores an automatic grab as pending when the approval
+// policy covers it. A nil result means the grab may proceed.
+func (s *Service) checkApprovalHold(ctx context.Context, req *GrabRequest) (*GrabResult, error) {
+	if !s.requiresApproval(ctx, req) {
+		return nil, nil //nolint:nilnil // nil result means the grab is not held
+	}
+
+	pending, err := s.holdForApproval(ctx, req)
+	if err != nil {
+		s.log(ctx).Error().Err(err).Str("title", req.Release.Title).Msg("Failed to hold grab for approval")
+		s.broadcastGrabCompleted(req.Release, nil, err.Error())
+		return &GrabResult{Success: false, Error: err.Error()}, err
+	}
+
+	s.log(ctx).Info().
+		Str("title", req.Release.Title).
+		Str("mediaType", req.MediaType).
+		Int64("mediaId", req.MediaID).
+		Int64("pendingGrabId", pending.ID).
+		Msg("Automatic grab held for approval")
+
+	msg := "held for approval"
+	s.broadcastGrabCompleted(req.Release, nil, msg)
+	return &GrabResult{Success: false, PendingApproval: true, Error: msg}, ErrPendingApproval
+}
+
+func (s *Service) holdForApproval(ctx context.Context, req *GrabRequest) (*sqlc.PendingGrab, error) {
+	data, err := json.Marshal(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode pending grab: %w", err)
+	}
+	var slotID int64
+	if req.TargetSlotID != nil {
+		slotID = *req.TargetSlotID
+	}
+	return s.queries.UpsertPendingGrab(ctx, sqlc.UpsertPendingGrabParams{
+		MediaType:    req.MediaType,
+		MediaID:      req.MediaID,
+		SlotID:       slotID,
+		ReleaseTitle: req.Release.Title,
+		IndexerName:  req.Release.IndexerName,
+		Source:       req.Source,
+		Score:        req.Score,
+		Request:      string(data),
+	})
+}
+
+// ListPendingGrabs returns the grabs awaiting approval, newest first.
+func (s *Service) ListPendingGrabs(ctx context.Context) ([]*PendingGrab, error) {
+	rows, err := s.queries.ListPendingGrabs(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list pending grabs: %w", err)
+	}
+	result := make([]*PendingGrab, 0, len(rows))
+	for _, row := range rows {
+		req, err := decodePendingRequest(row)
+		if err != nil {
+			s.log(ctx).Warn().Err(err).Int64("pendingGrabId", row.ID).Msg("Skipping unreadable pending grab")
+			continue
+		}
+		result = append(result, pendingGrabFromRequest(row, req))
+	}
+	return result, nil
+}
+
+// ApprovePendingGrab sends a held release to a download client. The pending
+// grab is removed once the grab succeeds; on failure it stays for a retry.
+func (s *Service) ApprovePendingGrab(ctx context.Context, id int64) (*GrabResult, error) {
+	row, err := s.getPendingGrab(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	req, err := decodePendingRequest(row)
+	if err != nil {
+		return nil, err
+	}
+
+	// An approved grab is the admin's choice, so automatic-grab guards no longer apply
+	req.Approved = true
+	result, err := s.Grab(ctx, req)
+	if err != nil || !result.Success {
+		return result, err
+	}
+
+	if err := s.queries.DeletePendingGrab(ctx, id); err != nil {
+		s.log(ctx).Warn().Err(err).Int64("pendingGrabId", id).Msg("Failed to remove approved pending grab")
+	}
+	return result, nil
+}
+
+// RejectPendingGrab discards a held release. The item stays wanted and is
+// picked up by the next automatic search.
+func (s *Service) RejectPendingGrab(ctx context.Context, id int64) error {
+	if _, err := s.getPendingGrab(ctx, id); err != nil {
+		return err
+	}
+	if err := s.queries.DeletePendingGrab(ctx, id); err != nil {
+		return fmt.Errorf("failed to delete pending grab: %w", err)
+	}
+	return nil
+}
+
+func (s *Service) getPendingGrab(ctx context.Context, id int64) (*sqlc.PendingGrab, error) {
+	row, err := s.queries.GetPendingGrab(ctx, id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrPendingGrabNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get pending grab: %w", err)
+	}
+	return row, nil
+}
+
+func decodePendingRequest(row *sqlc.PendingGrab) (*GrabRequest, error) {
+	var req GrabRequest
+	if err := json.Unmarshal([]byte(row.Request), &req); err != nil {
+		return nil, fmt.Errorf("failed to decode pending grab %d: %w", row.ID, err)
+	}
+	if req.Release == nil {
+		return nil, fmt.Errorf("pending grab %d has no release", row.ID)
+	}
+	return &req, nil
+}
+
+func pendingGrabFromRequest(row *sqlc.PendingGrab, req *GrabRequest) *PendingGrab {
+	return &PendingGrab{
+		ID:              row.ID,
+		MediaType:       row.MediaType,
+		MediaID:         row.MediaID,
+		SeriesID:        req.SeriesID,
+		SeasonNumber:    req.SeasonNumber,
+		TargetSlotID:    req.TargetSlotID,
+		Source:          row.Source,
+		Release:         req.Release,
+		Score:           req.Score,
+		NormalizedScore: req.NormalizedScore,
+		ScoreBreakdown:  req.ScoreBreakdown,
+		CreatedAt:       row.CreatedAt,
+	}
+}
@@ -0,0 +1,86 @@
+package grab
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/slipstream/slipstream/internal/domain/contracts"
+	"github.com/slipstream/slipstream/internal/indexer/types"
+	"github.com/slipstream/slipstream/internal/testutil"
+)
+
+type fakeTagStore struct {
+	contracts.TagStore
+	tags map[int64][]int64
+}
+
+func (f *fakeTagStore) ItemTags(_ context.Context, _ string, mediaID, _ int64) []int64 {
+	return f.tags[mediaID]
+}
+
+func TestCheckApprovalHold(t *testing.T) {
+	tdb := testutil.NewTestDB(t)
+	defer tdb.Close()
+
+	svc := NewService(tdb.Conn, nil, &tdb.Logger, nil, nil, nil, nil, nil, nil)
+	policy := ApprovalPolicy{Mode: ApprovalAll}
+	svc.SetApprovalPolicy(func() ApprovalPolicy { return policy })
+	svc.SetTagStore(&fakeTagStore{tags: map[int64][]int64{1: {7}, 2: {8}}})
+	ctx := context.Background()
+
+	release := &types.ReleaseInfo{Title: "Movie.2024.1080p", IndexerName: "Indexer"}
+	auto := &GrabRequest{Release: release, MediaType: "movie", MediaID: 1, Source: sourceAutoSearch, Score: 42}
+	manual := &GrabRequest{Release: release, MediaType: "movie", MediaID: 1, Source: "manual"}
+
+	result, err := svc.checkApprovalHold(ctx, auto)
+	if !errors.Is(err, ErrPendingApproval) || result == nil || !result.PendingApproval {
+		t.Fatalf("automatic grab: result=%+v err=%v, want ErrPendingApproval", result, err)
+	}
+	if result, _ := svc.checkApprovalHold(ctx, manual); result != nil {
+		t.Errorf("manual grab was held: %+v", result)
+	}
+
+	// A newer selection for the same item replaces the held one
+	if _, err := svc.checkApprovalHold(ctx, auto); !errors.Is(err, ErrPendingApproval) {
+		t.Fatalf("second hold err = %v, want ErrPendingApproval", err)
+	}
+
+	policy = ApprovalPolicy{Mode: ApprovalTagged, TagIDs: []int64{8}}
+	if result, _ := svc.checkApprovalHold(ctx, auto); result != nil {
+		t.Errorf("untagged item was held in tagged mode: %+v", result)
+	}
+	tagged := &GrabRequest{Release: release, MediaType: "movie", MediaID: 2, Source: sourceAutoSearch}
+	if _, err := svc.checkApprovalHold(ctx, tagged); !errors.Is(err, ErrPendingApproval) {
+		t.Errorf("tagged item err = %v, want ErrPendingApproval", err)
+	}
+
+	pending, err := svc.ListPendingGrabs(ctx)
+	if err != nil {
+		t.Fatalf("ListPendingGrabs() error = %v", err)
+	}
+	if len(pending) != 2 {
+		t.Fatalf("ListPendingGrabs() returned %d grabs, want 2", len(pending))
+	}
+	var held *PendingGrab
+	for _, p := range pending {
+		if p.MediaID == 1 {
+			held = p
+		}
+	}
+	if held == nil || held.Score != 42 || held.Release.Title != release.Title {
+		t.Fatalf("pending grab for item 1 = %+v, want score 42 and the release", held)
+	}
+
+	if err := svc.RejectPendingGrab(ctx, held.ID); err != nil {
+		t.Fatalf("RejectPendingGrab() error = %v", err)
+	}
+	if err := svc.RejectPendingGrab(ctx, held.ID); !errors.Is(err, ErrPendingGrabNotFound) {
+		t.Errorf("second RejectPendingGrab() error = %v, want ErrPendingGrabNotFound", err)
+	}
+
+	policy = ApprovalPolicy{Mode: ApprovalOff}
+	if result, _ := svc.checkApprovalHold(ctx, auto); result != nil {
+		t.Errorf("grab held with approval off: %+v", result)
+	}
+}
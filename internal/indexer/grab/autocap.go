@@ -0,0 +1,134 @@
+package grab
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/slipstream/slipstream/internal/domain/contracts"
+	"github.com/slipstream/slipstream/internal/indexer"
+	"github.com/slipstream/slipstream/internal/indexer/ratelimit"
+)
+
+const (
+	sourceAutoSearch = "auto-search"
+	sourceRssSync    = "rss-sync"
+
+	healthCategoryIndexers = "indexers"
+	autoGrabCapHealthID    = "auto-grab-cap"
+)
+
+// GrabCapProvider returns the stored automatic grab cap for an indexer.
+type GrabCapProvider interface {
+	GetGrabCap(ctx context.Context, indexerID int64) (*indexer.GrabCap, error)
+}
+
+// SetAutoGrabCaps sets the sources of the global and per-indexer caps on
+// automatic grabs. The global caps are read on every grab so settings changes
+// apply immediately.
+func (s *Service) SetAutoGrabCaps(global func() ratelimit.GrabCaps, perIndexer GrabCapProvider) {
+	s.globalGrabCaps = global
+	s.indexerGrabCaps = perIndexer
+}
+
+// SetHealthService sets the health service used to surface reached grab caps.
+func (s *Service) SetHealthService(healthService contracts.HealthService) {
+	s.healthService = healthService
+}
+
+// isAutomaticSource reports whether a grab was made without a user choosing the release.
+func isAutomaticSource(source string) bool {
+	return source == sourceAutoSearch || source == sourceRssSync
+}
+
+// AutoGrabsBlocked reports whether the global caps currently block every
+// automatic grab, and when the earliest one may proceed.
+func (s *Service) AutoGrabsBlocked() (bool, time.Time) {
+	if s.globalGrabCaps == nil {
+		return false, time.Time{}
+	}
+	hit := s.autoGrabs.Check(0, s.globalGrabCaps(), ratelimit.GrabCaps{})
+	if hit == nil {
+		return false, time.Time{}
+	}
+	return true, hit.RetryAt
+}
+
+// checkAutoGrabCaps defers automatic grabs that would exceed a global or
+// per-indexer cap. A nil result means the grab may proceed.
+func (s *Service) checkAutoGrabCaps(ctx context.Context, req *GrabRequest) (*GrabResult, error) {
+	if !req.isAutomatic() {
+		return nil, nil //nolint:nilnil // nil result means the grab is not capped
+	}
+
+	hit := s.autoGrabs.Check(req.Release.IndexerID, s.currentGlobalCaps(), s.currentIndexerCaps(ctx, req.Release.IndexerID))
+	if hit == nil {
+		return nil, nil //nolint:nilnil // nil result means the grab is not capped
+	}
+
+	msg := describeCapHit(hit)
+	s.log(ctx).Warn().
+		Str("title", req.Release.Title).
+		Int64("indexerId", req.Release.IndexerID).
+		Str("scope", hit.Scope).
+		Str("window", hit.Window).
+		Int("limit", hit.Limit).
+		Time("retryAt", hit.RetryAt).
+		Msg("Automatic grab deferred: cap reached")
+
+	if s.healthService != nil {
+		s.healthService.SetWarningStr(healthCategoryIndexers, capHealthID(hit), msg)
+	}
+
+	s.broadcastGrabCompleted(req.Release, nil, msg)
+	retryAt := hit.RetryAt
+	return &GrabResult{Success: false, Deferred: true, RetryAt: &retryAt, Error: msg}, ErrAutoGrabCapReached
+}
+
+// recordAutoGrab counts a successful automatic grab and clears any cap
+// notices it proves are no longer blocking.
+func (s *Service) recordAutoGrab(req *GrabRequest) {
+	if !req.isAutomatic() {
+		return
+	}
+	s.autoGrabs.Record(req.Release.IndexerID)
+	if s.healthService != nil {
+		s.healthService.ClearStatusStr(healthCategoryIndexers, autoGrabCapHealthID)
+		s.healthService.ClearStatusStr(healthCategoryIndexers, fmt.Sprintf("%s-%d", autoGrabCapHealthID, req.Release.IndexerID))
+	}
+}
+
+func (s *Service) currentGlobalCaps() ratelimit.GrabCaps {
+	if s.globalGrabCaps == nil {
+		return ratelimit.GrabCaps{}
+	}
+	return s.globalGrabCaps()
+}
+
+func (s *Service) currentIndexerCaps(ctx context.Context, indexerID int64) ratelimit.GrabCaps {
+	if s.indexerGrabCaps == nil {
+		return ratelimit.GrabCaps{}
+	}
+	grabCap, err := s.indexerGrabCaps.GetGrabCap(ctx, indexerID)
+	if err != nil {
+		s.log(ctx).Warn().Err(err).Int64("indexerId", indexerID).Msg("Failed to load indexer grab cap")
+		return ratelimit.GrabCaps{}
+	}
+	return ratelimit.GrabCaps{PerHour: grabCap.MaxGrabsPerHour, PerDay: grabCap.MaxGrabsPerDay}
+}
+
+func capHealthID(hit *ratelimit.CapHit) string {
+	if hit.Scope == ratelimit.ScopeIndexer {
+		return fmt.Sprintf("%s-%d", autoGrabCapHealthID, hit.IndexerID)
+	}
+	return autoGrabCapHealthID
+}
+
+func describeCapHit(hit *ratelimit.CapHit) string {
+	scope := "Automatic grab cap"
+	if hit.Scope == ratelimit.ScopeIndexer {
+		scope = fmt.Sprintf("Automatic grab cap for indexer %d", hit.IndexerID)
+	}
+	return fmt.Sprintf("%s reached (%d per %s); grabs deferred until %s",
+		scope, hit.Limit, hit.Window, hit.RetryAt.Local().Format("2006-01-02 15:04"))
+}
@@ -0,0 +1,178 @@
+package grab
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/slipstream/slipstream/internal/database/sqlc"
+	"github.com/slipstream/slipstream/internal/downloader"
+	"github.com/slipstream/slipstream/internal/indexer/types"
+	"github.com/slipstream/slipstream/internal/module/titleutil"
+)
+
+const crossSeedSettingKey = "cross_seed"
+
+// ErrCrossSeedDisabled means cross-seeding is turned off.
+var ErrCrossSeedDisabled = errors.New("cross-seeding is disabled")
+
+// CrossSeedSearcher finds releases by name on torrent indexers.
+type CrossSeedSearcher interface {
+	SearchTorrentsByName(ctx context.Context, name string, excludeIndexerID int64) ([]types.TorrentInfo, error)
+}
+
+// CrossSeedSettings configures cross-seeding of imported torrents. When
+// enabled, the other torrent indexers are searched for the identical release
+// and any match is added to the same client over the existing data. Category
+// labels the injected torrents in the client; empty keeps the default.
+type CrossSeedSettings struct {
+	Enabled  bool   `json:"enabled"`
+	Category string `json:"category"`
+}
+
+// CrossSeedInjection is a matching release added to the download client.
+type CrossSeedInjection struct {
+	IndexerID   int64  `json:"indexerId"`
+	IndexerName string `json:"indexer"`
+	Title       string `json:"title"`
+	DownloadID  string `json:"downloadId"`
+}
+
+// CrossSeedResult reports the outcome of cross-seeding one download.
+type CrossSeedResult struct {
+	Matches  int                  `json:"matches"`
+	Injected []CrossSeedInjection `json:"injected"`
+	Errors   []string             `json:"errors,omitempty"`
+}
+
+// SetCrossSeedSearcher sets the searcher used to find cross-seed matches.
+func (s *Service) SetCrossSeedSearcher(searcher CrossSeedSearcher) {
+	s.crossSeedSearcher = searcher
+}
+
+// CrossSeedSettings returns the cross-seed settings.
+func (s *Service) CrossSeedSettings(ctx context.Context) (*CrossSeedSettings, error) {
+	settings := &CrossSeedSettings{}
+	row, err := s.queries.GetSetting(ctx, crossSeedSettingKey)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return settings, nil
+		}
+		return nil, err
+	}
+	if err := json.Unmarshal([]byte(row.Value), settings); err != nil {
+		return nil, err
+	}
+	return settings, nil
+}
+
+// SetCrossSeedSettings saves the cross-seed settings.
+func (s *Service) SetCrossSeedSettings(ctx context.Context, input *CrossSeedSettings) (*CrossSeedSettings, error) {
+	settings := &CrossSeedSettings{
+		Enabled:  input.Enabled,
+		Category: strings.TrimSpace(input.Category),
+	}
+	encoded, err := json.Marshal(settings)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := s.queries.SetSetting(ctx, sqlc.SetSettingParams{Key: crossSeedSettingKey, Value: string(encoded)}); err != nil {
+		return nil, err
+	}
+	return settings, nil
+}
+
+// CrossSeedDownload searches the other torrent indexers for the release behind
+// an imported download and injects every identical release into the same
+// client, saved over the download's existing data.
+func (s *Service) CrossSeedDownload(ctx context.Context, clientID int64, downloadID string, sourceIndexerID int64) (*CrossSeedResult, error) {
+	settings, err := s.CrossSeedSettings(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load cross-seed settings: %w", err)
+	}
+	if !settings.Enabled {
+		return nil, ErrCrossSeedDisabled
+	}
+	if s.crossSeedSearcher == nil || s.downloaderService == nil || s.indexerService == nil {
+		return nil, fmt.Errorf("cross-seeding is not available")
+	}
+
+	source, err := s.downloaderService.GetTorrentInfo(ctx, clientID, downloadID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get source torrent: %w", err)
+	}
+
+	releases, err := s.crossSeedSearcher.SearchTorrentsByName(ctx, source.Name, sourceIndexerID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search for cross-seeds: %w", err)
+	}
+
+	result := &CrossSeedResult{Injected: []CrossSeedInjection{}}
+	seenIndexers := make(map[int64]bool)
+	for i := range releases {
+		release := &releases[i]
+		if seenIndexers[release.IndexerID] || !isCrossSeedMatch(source.Name, source.Size, source.InfoHash, release) {
+			continue
+		}
+		seenIndexers[release.IndexerID] = true
+		result.Matches++
+
+		injected, err := s.injectCrossSeed(ctx, clientID, source, settings.Category, release)
+		if err != nil {
+			s.log(ctx).Warn().Err(err).
+				Str("title", release.Title).
+				Str("indexer", release.IndexerName).
+				Msg("Failed to inject cross-seed")
+			result.Errors = append(result.Errors, fmt.Sprintf("%s: %v", release.IndexerName, err))
+			continue
+		}
+		result.Injected = append(result.Injected, *injected)
+	}
+
+	s.log(ctx).Info().
+		Str("downloadId", downloadID).
+		Str("name", source.Name).
+		Int("matches", result.Matches).
+		Int("injected", len(result.Injected)).
+		Msg("Cross-seed search completed")
+	return result, nil
+}
+
+func (s *Service) injectCrossSeed(ctx context.Context, clientID int64, source *downloader.TorrentInfo, category string, release *types.TorrentInfo) (*CrossSeedInjection, error) {
+	indexerClient, err := s.indexerService.GetClient(ctx, release.IndexerID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get indexer client: %w", err)
+	}
+	torrentData, err := indexerClient.Download(ctx, release.DownloadURL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to download torrent: %w", err)
+	}
+	id, err := s.downloaderService.InjectTorrent(ctx, clientID, torrentData, source, category, release.Title)
+	if err != nil {
+		return nil, err
+	}
+	return &CrossSeedInjection{
+		IndexerID:   release.IndexerID,
+		IndexerName: release.IndexerName,
+		Title:       release.Title,
+		DownloadID:  id,
+	}, nil
+}
+
+// isCrossSeedMatch reports whether a search result is the same release as the
+// source torrent, published as a different torrent: the same name and exact
+// size, but another info hash. Size must match exactly because a single
+// differing file makes the data unusable. The file list itself is checked
+// against the data on disk when the torrent is injected.
+func isCrossSeedMatch(name string, size int64, infoHash string, release *types.TorrentInfo) bool {
+	if infoHash != "" && strings.EqualFold(release.InfoHash, infoHash) {
+		return false
+	}
+	if size <= 0 || release.Size != size {
+		return false
+	}
+	return titleutil.NormalizeTitle(release.Title) == titleutil.NormalizeTitle(name)
+}
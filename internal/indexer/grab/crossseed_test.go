@@ -0,0 +1,61 @@
+package grab
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/slipstream/slipstream/internal/indexer/types"
+	"github.com/slipstream/slipstream/internal/testutil"
+)
+
+func TestIsCrossSeedMatch(t *testing.T) {
+	const name = "Movie.2024.1080p.BluRay.x264-GRP"
+	const size = int64(8_000_000_000)
+	release := func(title string, size int64, hash string) *types.TorrentInfo {
+		return &types.TorrentInfo{ReleaseInfo: types.ReleaseInfo{Title: title, Size: size}, InfoHash: hash}
+	}
+
+	tests := []struct {
+		name    string
+		release *types.TorrentInfo
+		want    bool
+	}{
+		{"same name and size", release(name, size, ""), true},
+		{"name with spaces", release("Movie 2024 1080p BluRay x264-GRP", size, ""), true},
+		{"same torrent", release(name, size, "ABCDEF"), false},
+		{"different size", release(name, size+1, ""), false},
+		{"different name", release("Movie.2024.1080p.BluRay.x264-OTHER", size, ""), false},
+		{"unknown size", release(name, 0, ""), false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := isCrossSeedMatch(name, size, "abcdef", tt.release); got != tt.want {
+				t.Errorf("isCrossSeedMatch() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCrossSeedSettings(t *testing.T) {
+	tdb := testutil.NewTestDB(t)
+	defer tdb.Close()
+
+	svc := NewService(tdb.Conn, nil, &tdb.Logger, nil, nil, nil, nil, nil, nil)
+	ctx := context.Background()
+
+	if _, err := svc.CrossSeedDownload(ctx, 1, "hash", 0); !errors.Is(err, ErrCrossSeedDisabled) {
+		t.Fatalf("CrossSeedDownload() with defaults err = %v, want ErrCrossSeedDisabled", err)
+	}
+
+	if _, err := svc.SetCrossSeedSettings(ctx, &CrossSeedSettings{Enabled: true, Category: " cross-seed "}); err != nil {
+		t.Fatalf("SetCrossSeedSettings() error = %v", err)
+	}
+	settings, err := svc.CrossSeedSettings(ctx)
+	if err != nil {
+		t.Fatalf("CrossSeedSettings() error = %v", err)
+	}
+	if !settings.Enabled || settings.Category != "cross-seed" {
+		t.Errorf("CrossSeedSettings() = %+v, want enabled with category cross-seed", settings)
+	}
+}
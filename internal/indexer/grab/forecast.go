@@ -0,0 +1,253 @@
+package grab
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/slipstream/slipstream/internal/filesystem"
+	"github.com/slipstream/slipstream/internal/library/quality"
+)
+
+const (
+	bytesPerMB = 1 << 20
+
+	defaultMovieRuntime   = 110
+	defaultEpisodeRuntime = 45
+)
+
+// estimatedMBPerMinute is the typical size of a release at each resolution,
+// used when the profile sets no size limits for its cutoff quality.
+var estimatedMBPerMinute = map[int]float64{
+	2160: 150,
+	1080: 50,
+	720:  25,
+	480:  10,
+}
+
+// SpaceForecast projects the space queued downloads and missing monitored
+// items will need on each library volume.
+type SpaceForecast struct {
+	GeneratedAt time.Time        `json:"generatedAt"`
+	Volumes     []VolumeForecast `json:"volumes"`
+	Errors      []string         `json:"errors,omitempty"`
+}
+
+// VolumeForecast is the projection for one volume. ProjectedFree goes
+// negative when the volume cannot hold everything wanted.
+type VolumeForecast struct {
+	Path          string               `json:"path"`
+	Label         string               `json:"label"`
+	FreeSpace     int64                `json:"freeSpace"`
+	QueuedBytes   int64                `json:"queuedBytes"`
+	MissingBytes  int64                `json:"missingBytes"`
+	ProjectedFree int64                `json:"projectedFree"`
+	RootFolders   []RootFolderForecast `json:"rootFolders"`
+}
+
+// RootFolderForecast breaks a volume's projection down per root folder.
+// Queued sizes are the release sizes in the download clients; missing sizes
+// are estimated from the runtime and the profile's cutoff quality.
+type RootFolderForecast struct {
+	RootFolderID int64  `json:"rootFolderId"`
+	Name         string `json:"name"`
+	Path         string `json:"path"`
+	QueuedItems  int    `json:"queuedItems"`
+	QueuedBytes  int64  `json:"queuedBytes"`
+	MissingItems int    `json:"missingItems"`
+	MissingBytes int64  `json:"missingBytes"`
+}
+
+// forecastLookup caches root folder and profile lookups while forecasting.
+type forecastLookup struct {
+	seriesRootFolders map[int64]int64
+	profileMBPerMin   map[int64]float64
+}
+
+// SpaceForecast projects the space needed for all queued downloads and
+// missing monitored movies and episodes, per library volume.
+func (s *Service) SpaceForecast(ctx context.Context) (*SpaceForecast, error) {
+	if s.storageInfo == nil {
+		return nil, fmt.Errorf("storage information is not available")
+	}
+	volumes, err := s.storageInfo.GetStorageInfo(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get storage info: %w", err)
+	}
+
+	forecast := &SpaceForecast{GeneratedAt: time.Now(), Volumes: []VolumeForecast{}}
+	byRootFolder := make(map[int64]*RootFolderForecast)
+	for i := range volumes {
+		v := &volumes[i]
+		if len(v.RootFolders) == 0 {
+			continue
+		}
+		forecast.Volumes = append(forecast.Volumes, VolumeForecast{
+			Path:        v.Path,
+			Label:       v.Label,
+			FreeSpace:   v.FreeSpace,
+			RootFolders: rootFolderForecasts(v.RootFolders),
+		})
+	}
+	for i := range forecast.Volumes {
+		for j := range forecast.Volumes[i].RootFolders {
+			rf := &forecast.Volumes[i].RootFolders[j]
+			byRootFolder[rf.RootFolderID] = rf
+		}
+	}
+
+	lookup := &forecastLookup{seriesRootFolders: make(map[int64]int64), profileMBPerMin: make(map[int64]float64)}
+	if err := s.forecastQueued(ctx, lookup, byRootFolder); err != nil {
+		forecast.Errors = append(forecast.Errors, err.Error())
+	}
+	if err := s.forecastMissingMovies(ctx, lookup, byRootFolder); err != nil {
+		forecast.Errors = append(forecast.Errors, err.Error())
+	}
+	if err := s.forecastMissingEpisodes(ctx, lookup, byRootFolder); err != nil {
+		forecast.Errors = append(forecast.Errors, err.Error())
+	}
+
+	for i := range forecast.Volumes {
+		v := &forecast.Volumes[i]
+		for _, rf := range v.RootFolders {
+			v.QueuedBytes += rf.QueuedBytes
+			v.MissingBytes += rf.MissingBytes
+		}
+		v.ProjectedFree = v.FreeSpace - v.QueuedBytes - v.MissingBytes
+	}
+	return forecast, nil
+}
+
+func rootFolderForecasts(refs []filesystem.RootFolderRef) []RootFolderForecast {
+	folders := make([]RootFolderForecast, 0, len(refs))
+	for _, ref := range refs {
+		folders = append(folders, RootFolderForecast{RootFolderID: ref.ID, Name: ref.Name, Path: ref.Path})
+	}
+	return folders
+}
+
+func (s *Service) forecastQueued(ctx context.Context, lookup *forecastLookup, byRootFolder map[int64]*RootFolderForecast) error {
+	if s.downloaderService == nil {
+		return nil
+	}
+	queue, err := s.downloaderService.GetQueue(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get download queue: %w", err)
+	}
+	for i := range queue.Items {
+		item := &queue.Items[i]
+		var rootFolderID int64
+		switch {
+		case item.MovieID != nil:
+			if movie, err := s.queries.GetMovie(ctx, *item.MovieID); err == nil {
+				rootFolderID = movie.RootFolderID.Int64
+			}
+		case item.SeriesID != nil:
+			rootFolderID = s.seriesRootFolder(ctx, lookup, *item.SeriesID)
+		}
+		if rf := byRootFolder[rootFolderID]; rf != nil {
+			rf.QueuedItems++
+			rf.QueuedBytes += item.Size
+		}
+	}
+	return nil
+}
+
+func (s *Service) forecastMissingMovies(ctx context.Context, lookup *forecastLookup, byRootFolder map[int64]*RootFolderForecast) error {
+	movies, err := s.queries.ListMissingMovies(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to list missing movies: %w", err)
+	}
+	for _, movie := range movies {
+		rf := byRootFolder[movie.RootFolderID.Int64]
+		if rf == nil {
+			continue
+		}
+		rf.MissingItems++
+		rf.MissingBytes += estimateSize(movie.Runtime.Int64, defaultMovieRuntime,
+			s.profileMBPerMinute(ctx, lookup, movie.QualityProfileID.Int64))
+	}
+	return nil
+}
+
+func (s *Service) forecastMissingEpisodes(ctx context.Context, lookup *forecastLookup, byRootFolder map[int64]*RootFolderForecast) error {
+	episodes, err := s.queries.ListMissingEpisodes(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to list missing episodes: %w", err)
+	}
+	for _, ep := range episodes {
+		rf := byRootFolder[s.seriesRootFolder(ctx, lookup, ep.SeriesID)]
+		if rf == nil {
+			continue
+		}
+		rf.MissingItems++
+		rf.MissingBytes += estimateSize(ep.SeriesRuntime.Int64, defaultEpisodeRuntime,
+			s.profileMBPerMinute(ctx, lookup, ep.SeriesQualityProfileID.Int64))
+	}
+	return nil
+}
+
+func (s *Service) seriesRootFolder(ctx context.Context, lookup *forecastLookup, seriesID int64) int64 {
+	if id, ok := lookup.seriesRootFolders[seriesID]; ok {
+		return id
+	}
+	var id int64
+	if series, err := s.queries.GetSeries(ctx, seriesID); err == nil {
+		id = series.RootFolderID.Int64
+	}
+	lookup.seriesRootFolders[seriesID] = id
+	return id
+}
+
+// profileMBPerMinute estimates the size per minute of a release at the
+// profile's cutoff: the middle of its size limits when set, otherwise the
+// typical size for the cutoff resolution.
+func (s *Service) profileMBPerMinute(ctx context.Context, lookup *forecastLookup, profileID int64) float64 {
+	if rate, ok := lookup.profileMBPerMin[profileID]; ok {
+		return rate
+	}
+	rate := estimatedMBPerMinute[1080]
+	if profile, err := s.queries.GetQualityProfile(ctx, profileID); err == nil {
+		if items, err := quality.DeserializeItems(profile.Items); err == nil {
+			rate = cutoffMBPerMinute(items, int(profile.Cutoff))
+		}
+	}
+	lookup.profileMBPerMin[profileID] = rate
+	return rate
+}
+
+func cutoffMBPerMinute(items []quality.QualityItem, cutoff int) float64 {
+	resolution := 1080
+	if q, ok := quality.PredefinedQualityByID(cutoff); ok {
+		resolution = q.Resolution
+	}
+	for _, item := range items {
+		if item.Quality.ID != cutoff {
+			continue
+		}
+		// Profile items carry the definition saved with them, which covers
+		// custom cutoffs too.
+		if item.Quality.Resolution > 0 {
+			resolution = item.Quality.Resolution
+		}
+		switch {
+		case item.MinSize > 0 && item.MaxSize > 0:
+			return (item.MinSize + item.MaxSize) / 2
+		case item.MaxSize > 0 && item.MaxSize < estimatedMBPerMinute[resolution]:
+			return item.MaxSize
+		case item.MinSize > estimatedMBPerMinute[resolution]:
+			return item.MinSize
+		}
+	}
+	if rate, ok := estimatedMBPerMinute[resolution]; ok {
+		return rate
+	}
+	return estimatedMBPerMinute[480]
+}
+
+func estimateSize(runtime, defaultRuntime int64, mbPerMinute float64) int64 {
+	if runtime <= 0 {
+		runtime = defaultRuntime
+	}
+	return int64(float64(runtime) * mbPerMinute * bytesPerMB)
+}
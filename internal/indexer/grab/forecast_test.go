@@ -0,0 +1,37 @@
+package grab
+
+import (
+	"testing"
+
+	"github.com/slipstream/slipstream/internal/library/quality"
+)
+
+func TestCutoffMBPerMinute(t *testing.T) {
+	bluray1080, _ := quality.PredefinedQualityByID(11)
+	tests := []struct {
+		name  string
+		items []quality.QualityItem
+		want  float64
+	}{
+		{"no limits uses resolution default", []quality.QualityItem{{Quality: bluray1080, Allowed: true}}, 50},
+		{"midpoint of limits", []quality.QualityItem{{Quality: bluray1080, Allowed: true, MinSize: 20, MaxSize: 100}}, 60},
+		{"max below default", []quality.QualityItem{{Quality: bluray1080, Allowed: true, MaxSize: 30}}, 30},
+		{"min above default", []quality.QualityItem{{Quality: bluray1080, Allowed: true, MinSize: 80}}, 80},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := cutoffMBPerMinute(tt.items, 11); got != tt.want {
+				t.Errorf("cutoffMBPerMinute() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEstimateSize(t *testing.T) {
+	if got, want := estimateSize(100, defaultMovieRuntime, 50), int64(100*50*bytesPerMB); got != want {
+		t.Errorf("estimateSize() = %d, want %d", got, want)
+	}
+	if got, want := estimateSize(0, defaultEpisodeRuntime, 10), int64(defaultEpisodeRuntime*10*bytesPerMB); got != want {
+		t.Errorf("estimateSize() without runtime = %d, want %d", got, want)
+	}
+}
@@ -0,0 +1,202 @@
+package grab
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/slipstream/slipstream/internal/filesystem"
+)
+
+const (
+	healthCategoryStorage  = "storage"
+	freeSpaceGuardHealthID = "grab-free-space-guard"
+	rootFolderSpaceHealth  = "grab-root-folder-space-%d"
+	bytesPerGB             = 1 << 30
+)
+
+// StorageInfoProvider reports free space on the volumes holding library root folders.
+type StorageInfoProvider interface {
+	GetStorageInfo(ctx context.Context) ([]filesystem.StorageInfo, error)
+}
+
+// SetFreeSpaceGuard sets the library free space, in bytes, below which every
+// automatic grab pauses. The threshold is read on every check so settings
+// changes apply immediately; zero disables the guard.
+func (s *Service) SetFreeSpaceGuard(threshold func() int64, storage StorageInfoProvider) {
+	s.freeSpaceThreshold = threshold
+	s.storageInfo = storage
+}
+
+// AutoGrabsPaused reports whether a library volume is below the free space
+// guard, with a description of the lowest volume. The storage health item is
+// raised or cleared to match, so callers skipping work stay visible.
+func (s *Service) AutoGrabsPaused(ctx context.Context) (bool, string) {
+	if s.freeSpaceThreshold == nil || s.storageInfo == nil {
+		return false, ""
+	}
+	threshold := s.freeSpaceThreshold()
+	if threshold <= 0 {
+		s.clearFreeSpaceGuard()
+		return false, ""
+	}
+
+	volumes, err := s.storageInfo.GetStorageInfo(ctx)
+	if err != nil {
+		s.log(ctx).Warn().Err(err).Msg("Failed to check library free space")
+		return false, ""
+	}
+
+	low := lowestLibraryVolume(volumes)
+	if low == nil || low.FreeSpace >= threshold {
+		s.clearFreeSpaceGuard()
+		return false, ""
+	}
+
+	msg := fmt.Sprintf("Automatic grabs paused: %s has %.1f GB free, below the %.1f GB minimum",
+		low.Path, float64(low.FreeSpace)/bytesPerGB, float64(threshold)/bytesPerGB)
+	if s.healthService != nil {
+		s.healthService.SetErrorStr(healthCategoryStorage, freeSpaceGuardHealthID, msg)
+	}
+	return true, msg
+}
+
+// checkFreeSpaceGuard refuses automatic grabs while library free space is
+// below the guard. A nil result means the grab may proceed.
+func (s *Service) checkFreeSpaceGuard(ctx context.Context, req *GrabRequest) (*GrabResult, error) {
+	if !req.isAutomatic() {
+		return nil, nil //nolint:nilnil // nil result means the grab is not paused
+	}
+	paused, msg := s.AutoGrabsPaused(ctx)
+	if !paused {
+		return nil, nil //nolint:nilnil // nil result means the grab is not paused
+	}
+
+	s.log(ctx).Warn().Str("title", req.Release.Title).Str("reason", msg).Msg("Automatic grab skipped: low library free space")
+	s.broadcastGrabCompleted(req.Release, nil, msg)
+	return &GrabResult{Success: false, Error: msg}, ErrLowFreeSpace
+}
+
+// SetFreeSpaceBuffer sets the bytes that must remain free in a grab's target
+// root folder on top of the release size. The buffer is read on every check.
+// Uses the storage provider passed to SetFreeSpaceGuard.
+func (s *Service) SetFreeSpaceBuffer(buffer func() int64) {
+	s.freeSpaceBuffer = buffer
+}
+
+// checkRootFolderSpace refuses any grab whose release would not fit in the
+// target root folder's free space plus the buffer, so a download cannot fill
+// the library disk before it imports. A nil result means the grab may proceed.
+func (s *Service) checkRootFolderSpace(ctx context.Context, req *GrabRequest) (*GrabResult, error) {
+	if s.storageInfo == nil || req.Release.Size <= 0 {
+		return nil, nil //nolint:nilnil // nil result means the grab may proceed
+	}
+	rootFolderID := s.targetRootFolderID(ctx, req)
+	if rootFolderID == 0 {
+		return nil, nil //nolint:nilnil // nil result means the grab may proceed
+	}
+	volumes, err := s.storageInfo.GetStorageInfo(ctx)
+	if err != nil {
+		s.log(ctx).Warn().Err(err).Msg("Failed to check root folder free space")
+		return nil, nil //nolint:nilnil // nil result means the grab may proceed
+	}
+	volume := volumeForRootFolder(volumes, rootFolderID)
+	if volume == nil {
+		return nil, nil //nolint:nilnil // nil result means the grab may proceed
+	}
+
+	var buffer int64
+	if s.freeSpaceBuffer != nil {
+		buffer = s.freeSpaceBuffer()
+	}
+	healthID := fmt.Sprintf(rootFolderSpaceHealth, rootFolderID)
+	if req.Release.Size+buffer <= volume.FreeSpace {
+		if s.healthService != nil {
+			s.healthService.ClearStatusStr(healthCategoryStorage, healthID)
+		}
+		return nil, nil //nolint:nilnil // nil result means the grab may proceed
+	}
+
+	msg := fmt.Sprintf("Grab of %s rejected: it needs %.1f GB plus a %.1f GB buffer but %s has %.1f GB free",
+		req.Release.Title, float64(req.Release.Size)/bytesPerGB, float64(buffer)/bytesPerGB,
+		volume.Path, float64(volume.FreeSpace)/bytesPerGB)
+	if s.healthService != nil {
+		s.healthService.SetWarningStr(healthCategoryStorage, healthID, msg)
+	}
+	s.log(ctx).Warn().
+		Str("title", req.Release.Title).
+		Int64("size", req.Release.Size).
+		Int64("freeSpace", volume.FreeSpace).
+		Int64("rootFolderId", rootFolderID).
+		Msg("Grab rejected: not enough free space in root folder")
+	s.broadcastGrabCompleted(req.Release, nil, msg)
+	return &GrabResult{Success: false, Error: msg}, ErrInsufficientSpace
+}
+
+// targetRootFolderID returns the root folder the grabbed media lives in, or 0.
+func (s *Service) targetRootFolderID(ctx context.Context, req *GrabRequest) int64 {
+	if s.queries == nil {
+		return 0
+	}
+	seriesID := req.SeriesID
+	switch req.MediaType {
+	case mediaTypeMovie:
+		movie, err := s.queries.GetMovie(ctx, req.MediaID)
+		if err != nil {
+			return 0
+		}
+		return movie.RootFolderID.Int64
+	case mediaTypeEpisode:
+		if seriesID == 0 {
+			episode, err := s.queries.GetEpisode(ctx, req.MediaID)
+			if err != nil {
+				return 0
+			}
+			seriesID = episode.SeriesID
+		}
+	case mediaTypeSeason:
+		if seriesID == 0 {
+			seriesID = req.MediaID
+		}
+	default:
+		return 0
+	}
+	series, err := s.queries.GetSeries(ctx, seriesID)
+	if err != nil {
+		return 0
+	}
+	return series.RootFolderID.Int64
+}
+
+// volumeForRootFolder returns the volume holding the root folder, or nil.
+func volumeForRootFolder(volumes []filesystem.StorageInfo, rootFolderID int64) *filesystem.StorageInfo {
+	for i := range volumes {
+		for _, rf := range volumes[i].RootFolders {
+			if rf.ID == rootFolderID {
+				return &volumes[i]
+			}
+		}
+	}
+	return nil
+}
+
+func (s *Service) clearFreeSpaceGuard() {
+	if s.healthService != nil {
+		s.healthService.ClearStatusStr(healthCategoryStorage, freeSpaceGuardHealthID)
+	}
+}
+
+// lowestLibraryVolume returns the volume with the least free space among
+// those holding at least one root folder.
+func lowestLibraryVolume(volumes []filesystem.StorageInfo) *filesystem.StorageInfo {
+	var low *filesystem.StorageInfo
+	for i := range volumes {
+		v := &volumes[i]
+		if len(v.RootFolders) == 0 {
+			continue
+		}
+		if low == nil || v.FreeSpace < low.FreeSpace {
+			low = v
+		}
+	}
+	return low
+}
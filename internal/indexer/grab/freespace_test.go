@@ -0,0 +1,118 @@
+package grab
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+
+	"github.com/rs/zerolog"
+
+	"github.com/slipstream/slipstream/internal/database/sqlc"
+	"github.com/slipstream/slipstream/internal/filesystem"
+	"github.com/slipstream/slipstream/internal/indexer/types"
+	"github.com/slipstream/slipstream/internal/testutil"
+)
+
+type fakeStorage struct {
+	volumes []filesystem.StorageInfo
+}
+
+func (f *fakeStorage) GetStorageInfo(context.Context) ([]filesystem.StorageInfo, error) {
+	return f.volumes, nil
+}
+
+func TestCheckFreeSpaceGuard(t *testing.T) {
+	logger := zerolog.Nop()
+	svc := NewService(nil, nil, &logger, nil, nil, nil, nil, nil, nil)
+	storage := &fakeStorage{volumes: []filesystem.StorageInfo{
+		{Path: "/media", FreeSpace: 5 * bytesPerGB, RootFolders: []filesystem.RootFolderRef{{ID: 1}}},
+		{Path: "/scratch", FreeSpace: 1 * bytesPerGB},
+	}}
+	threshold := int64(10 * bytesPerGB)
+	svc.SetFreeSpaceGuard(func() int64 { return threshold }, storage)
+
+	release := &types.ReleaseInfo{Title: "Movie.2024.1080p"}
+	auto := &GrabRequest{Release: release, Source: sourceAutoSearch}
+	manual := &GrabRequest{Release: release, Source: "manual"}
+
+	result, err := svc.checkFreeSpaceGuard(context.Background(), auto)
+	if !errors.Is(err, ErrLowFreeSpace) || result == nil || result.Success {
+		t.Fatalf("automatic grab below threshold: result=%+v err=%v, want ErrLowFreeSpace", result, err)
+	}
+	if result, _ := svc.checkFreeSpaceGuard(context.Background(), manual); result != nil {
+		t.Errorf("manual grab was paused: %+v", result)
+	}
+
+	// Volumes without root folders are ignored
+	storage.volumes[0].FreeSpace = 20 * bytesPerGB
+	if paused, msg := svc.AutoGrabsPaused(context.Background()); paused {
+		t.Errorf("AutoGrabsPaused() = true (%s), want false", msg)
+	}
+
+	threshold = 0
+	storage.volumes[0].FreeSpace = 0
+	if paused, _ := svc.AutoGrabsPaused(context.Background()); paused {
+		t.Error("AutoGrabsPaused() = true with the guard disabled")
+	}
+}
+
+func TestCheckRootFolderSpace(t *testing.T) {
+	tdb := testutil.NewTestDB(t)
+	defer tdb.Close()
+	ctx := context.Background()
+
+	queries := sqlc.New(tdb.Conn)
+	folder, err := queries.CreateRootFolder(ctx, sqlc.CreateRootFolderParams{Path: "/media/movies", Name: "Movies", ModuleType: "movie"})
+	if err != nil {
+		t.Fatalf("CreateRootFolder() error = %v", err)
+	}
+	movie, err := queries.CreateMovie(ctx, sqlc.CreateMovieParams{
+		Title:        "Movie",
+		SortTitle:    "movie",
+		Status:       "missing",
+		RootFolderID: sql.NullInt64{Int64: folder.ID, Valid: true},
+	})
+	if err != nil {
+		t.Fatalf("CreateMovie() error = %v", err)
+	}
+
+	svc := NewService(tdb.Conn, nil, &tdb.Logger, nil, nil, nil, nil, nil, nil)
+	storage := &fakeStorage{volumes: []filesystem.StorageInfo{
+		{Path: "/media", FreeSpace: 30 * bytesPerGB, RootFolders: []filesystem.RootFolderRef{{ID: folder.ID}}},
+	}}
+	svc.SetFreeSpaceGuard(func() int64 { return 0 }, storage)
+	svc.SetFreeSpaceBuffer(func() int64 { return 5 * bytesPerGB })
+
+	fits := &GrabRequest{
+		Release:   &types.ReleaseInfo{Title: "Movie.2024.1080p", Size: 20 * bytesPerGB},
+		MediaType: mediaTypeMovie,
+		MediaID:   movie.ID,
+		Source:    "manual",
+	}
+	if result, err := svc.checkRootFolderSpace(ctx, fits); result != nil {
+		t.Fatalf("release within free space was rejected: result=%+v err=%v", result, err)
+	}
+
+	tooBig := &GrabRequest{
+		Release:   &types.ReleaseInfo{Title: "Movie.2024.2160p", Size: 26 * bytesPerGB},
+		MediaType: mediaTypeMovie,
+		MediaID:   movie.ID,
+		Source:    "manual",
+	}
+	result, err := svc.checkRootFolderSpace(ctx, tooBig)
+	if !errors.Is(err, ErrInsufficientSpace) || result == nil || result.Success {
+		t.Fatalf("release over free space minus buffer: result=%+v err=%v, want ErrInsufficientSpace", result, err)
+	}
+
+	// Unknown sizes and media outside any root folder are not checked
+	tooBig.Release.Size = 0
+	if result, _ := svc.checkRootFolderSpace(ctx, tooBig); result != nil {
+		t.Errorf("release without a size was rejected: %+v", result)
+	}
+	tooBig.Release.Size = 100 * bytesPerGB
+	tooBig.MediaID = movie.ID + 100
+	if result, _ := svc.checkRootFolderSpace(ctx, tooBig); result != nil {
+		t.Errorf("grab for unknown media was rejected: %+v", result)
+	}
+}
@@ -1,7 +1,9 @@
 package grab
 
 import (
+	"errors"
 	"net/http"
+	"strconv"
 
 	"github.com/labstack/echo/v4"
 
@@ -26,6 +28,13 @@ func (h *Handlers) RegisterRoutes(g *echo.Group) {
 	g.POST("/grab", h.Grab)
 	g.POST("/grab/bulk", h.GrabBulk)
 	g.GET("/grab/history", h.GetHistory)
+	g.GET("/grab/pending", h.ListPending)
+	g.POST("/grab/pending/:id/approve", h.ApprovePending)
+	g.DELETE("/grab/pending/:id", h.RejectPending)
+	g.GET("/grab/space-forecast", h.SpaceForecast)
+	g.GET("/grab/cross-seed", h.GetCrossSeedSettings)
+	g.PUT("/grab/cross-seed", h.UpdateCrossSeedSettings)
+	g.POST("/grab/cross-seed/:clientId/:downloadId", h.CrossSeedDownload)
 }
 
 // GrabRequestDTO is the API request format for grabbing a release.
@@ -74,21 +83,15 @@ type BulkGrabRequestDTO struct {
 func (h *Handlers) Grab(c echo.Context) error {
 	var req GrabRequestDTO
 	if err := c.Bind(&req); err != nil {
-		return c.JSON(http.StatusBadRequest, map[string]string{
-			"error": "Invalid request body",
-		})
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid request body")
 	}
 
 	// Validate required fields
 	if req.Release.DownloadURL == "" {
-		return c.JSON(http.StatusBadRequest, map[string]string{
-			"error": "downloadUrl is required",
-		})
+		return echo.NewHTTPError(http.StatusBadRequest, "downloadUrl is required")
 	}
 	if req.Release.IndexerID == 0 {
-		return c.JSON(http.StatusBadRequest, map[string]string{
-			"error": "indexerId is required",
-		})
+		return echo.NewHTTPError(http.StatusBadRequest, "indexerId is required")
 	}
 
 	// Convert DTO to internal type
@@ -121,15 +124,11 @@ func (h *Handlers) Grab(c echo.Context) error {
 func (h *Handlers) GrabBulk(c echo.Context) error {
 	var req BulkGrabRequestDTO
 	if err := c.Bind(&req); err != nil {
-		return c.JSON(http.StatusBadRequest, map[string]string{
-			"error": "Invalid request body",
-		})
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid request body")
 	}
 
 	if len(req.Releases) == 0 {
-		return c.JSON(http.StatusBadRequest, map[string]string{
-			"error": "At least one release is required",
-		})
+		return echo.NewHTTPError(http.StatusBadRequest, "At least one release is required")
 	}
 
 	// Convert DTOs to internal types
@@ -153,9 +152,7 @@ func (h *Handlers) GrabBulk(c echo.Context) error {
 	})
 
 	if err != nil {
-		return c.JSON(http.StatusInternalServerError, map[string]string{
-			"error": err.Error(),
-		})
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
 	}
 
 	return c.JSON(http.StatusOK, result)
@@ -168,30 +165,126 @@ func (h *Handlers) GetHistory(c echo.Context) error {
 
 	if l := c.QueryParam("limit"); l != "" {
 		if err := echo.QueryParamsBinder(c).Int("limit", &limit).BindError(); err != nil {
-			return c.JSON(http.StatusBadRequest, map[string]string{
-				"error": "Invalid limit parameter",
-			})
+			return echo.NewHTTPError(http.StatusBadRequest, "Invalid limit parameter")
 		}
 	}
 
 	if o := c.QueryParam("offset"); o != "" {
 		if err := echo.QueryParamsBinder(c).Int("offset", &offset).BindError(); err != nil {
-			return c.JSON(http.StatusBadRequest, map[string]string{
-				"error": "Invalid offset parameter",
-			})
+			return echo.NewHTTPError(http.StatusBadRequest, "Invalid offset parameter")
 		}
 	}
 
 	history, err := h.service.GetGrabHistory(c.Request().Context(), limit, offset)
 	if err != nil {
-		return c.JSON(http.StatusInternalServerError, map[string]string{
-			"error": err.Error(),
-		})
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
 	}
 
 	return c.JSON(http.StatusOK, history)
 }
 
+// ListPending handles GET /grab/pending - list grabs held for approval.
+func (h *Handlers) ListPending(c echo.Context) error {
+	pending, err := h.service.ListPendingGrabs(c.Request().Context())
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	return c.JSON(http.StatusOK, pending)
+}
+
+// ApprovePending handles POST /grab/pending/:id/approve - grab a held release.
+func (h *Handlers) ApprovePending(c echo.Context) error {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid pending grab ID")
+	}
+
+	result, err := h.service.ApprovePendingGrab(c.Request().Context(), id)
+	if errors.Is(err, ErrPendingGrabNotFound) {
+		return echo.NewHTTPError(http.StatusNotFound, err.Error())
+	}
+	if result == nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	// Like Grab, a failed grab still returns its result with the error details
+	return c.JSON(http.StatusOK, result)
+}
+
+// RejectPending handles DELETE /grab/pending/:id - discard a held release.
+func (h *Handlers) RejectPending(c echo.Context) error {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid pending grab ID")
+	}
+
+	if err := h.service.RejectPendingGrab(c.Request().Context(), id); err != nil {
+		if errors.Is(err, ErrPendingGrabNotFound) {
+			return echo.NewHTTPError(http.StatusNotFound, err.Error())
+		}
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	return c.NoContent(http.StatusNoContent)
+}
+
+// SpaceForecast handles GET /grab/space-forecast - project disk usage of
+// queued and missing items per volume.
+func (h *Handlers) SpaceForecast(c echo.Context) error {
+	forecast, err := h.service.SpaceForecast(c.Request().Context())
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	return c.JSON(http.StatusOK, forecast)
+}
+
+// GetCrossSeedSettings handles GET /grab/cross-seed - get cross-seed settings.
+func (h *Handlers) GetCrossSeedSettings(c echo.Context) error {
+	settings, err := h.service.CrossSeedSettings(c.Request().Context())
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	return c.JSON(http.StatusOK, settings)
+}
+
+// UpdateCrossSeedSettings handles PUT /grab/cross-seed - save cross-seed settings.
+func (h *Handlers) UpdateCrossSeedSettings(c echo.Context) error {
+	var input CrossSeedSettings
+	if err := c.Bind(&input); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	settings, err := h.service.SetCrossSeedSettings(c.Request().Context(), &input)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	return c.JSON(http.StatusOK, settings)
+}
+
+// CrossSeedDownload handles POST /grab/cross-seed/:clientId/:downloadId -
+// search for and inject cross-seeds of a download now.
+func (h *Handlers) CrossSeedDownload(c echo.Context) error {
+	clientID, err := strconv.ParseInt(c.Param("clientId"), 10, 64)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid client ID")
+	}
+
+	var sourceIndexerID int64
+	if v := c.QueryParam("indexerId"); v != "" {
+		if sourceIndexerID, err = strconv.ParseInt(v, 10, 64); err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, "Invalid indexer ID")
+		}
+	}
+
+	result, err := h.service.CrossSeedDownload(c.Request().Context(), clientID, c.Param("downloadId"), sourceIndexerID)
+	if errors.Is(err, ErrCrossSeedDisabled) {
+		return echo.NewHTTPError(http.StatusConflict, err.Error())
+	}
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	return c.JSON(http.StatusOK, result)
+}
+
 // dtoToRelease converts a ReleaseDTO to a types.ReleaseInfo.
 func (h *Handlers) dtoToRelease(dto *ReleaseDTO) *types.ReleaseInfo {
 	return &types.ReleaseInfo{
@@ -17,6 +17,8 @@ import (
 	"github.com/slipstream/slipstream/internal/indexer/ratelimit"
 	"github.com/slipstream/slipstream/internal/indexer/status"
 	"github.com/slipstream/slipstream/internal/indexer/types"
+	"github.com/slipstream/slipstream/internal/logger"
+	"github.com/slipstream/slipstream/internal/tags"
 )
 
 var (
@@ -25,6 +27,17 @@ var (
 	ErrDownloadFailed    = errors.New("download failed")
 	ErrGrabLimitExceeded = errors.New("grab limit exceeded for indexer")
 	ErrIndexerDisabled   = errors.New("indexer is temporarily disabled")
+
+	// ErrAutoGrabCapReached means an automatic grab was deferred by a grab cap.
+	ErrAutoGrabCapReached = errors.New("automatic grab cap reached")
+
+	// ErrLowFreeSpace means automatic grabs are paused because a library volume is low on space.
+	ErrLowFreeSpace = errors.New("automatic grabs paused: library free space below threshold")
+	// ErrInsufficientSpace means the release would not fit in its target root folder.
+	ErrInsufficientSpace = errors.New("not enough free space in the target root folder")
+
+	// ErrPendingApproval means an automatic grab was held until an admin approves it.
+	ErrPendingApproval = errors.New("grab held for approval")
 )
 
 const (
@@ -66,6 +79,29 @@ type Service struct {
 	notificationService NotificationService
 	portalStatusTracker PortalStatusTracker
 	logger              *zerolog.Logger
+
+	// Bytes that must remain free on a client's disk after a grab
+	minFreeSpace int64
+
+	// Tag store for restricting grabs to clients sharing the item's tags
+	tagStore contracts.TagStore
+
+	// Caps on automatic grabs, global and per indexer
+	autoGrabs       *ratelimit.AutoGrabLimiter
+	globalGrabCaps  func() ratelimit.GrabCaps
+	indexerGrabCaps GrabCapProvider
+	healthService   contracts.HealthService
+
+	// Library free space below which automatic grabs pause
+	freeSpaceThreshold func() int64
+	storageInfo        StorageInfoProvider
+	freeSpaceBuffer    func() int64
+
+	// Which automatic grabs are held for admin approval
+	approvalPolicy func() ApprovalPolicy
+
+	// Finds identical releases on other indexers for cross-seeding
+	crossSeedSearcher CrossSeedSearcher
 }
 
 // IndexerClientProvider provides access to indexer clients for downloading torrents.
@@ -96,14 +132,32 @@ func NewService(
 		queueTrigger:        queueTrigger,
 		portalStatusTracker: portalStatusTracker,
 		logger:              &subLogger,
+		autoGrabs:           ratelimit.NewAutoGrabLimiter(),
 	}
 }
 
+// log returns the service logger tagged with the correlation ID of the API
+// request ctx belongs to, if any.
+func (s *Service) log(ctx context.Context) *zerolog.Logger {
+	return logger.FromContext(ctx, s.logger)
+}
+
 // SetNotificationService sets the notification service for external notifications.
 func (s *Service) SetNotificationService(notificationService NotificationService) {
 	s.notificationService = notificationService
 }
 
+// SetMinFreeSpace sets the number of bytes a download client must keep free
+// after accepting a release. Clients that cannot report disk space are not checked.
+func (s *Service) SetMinFreeSpace(bytes int64) {
+	s.minFreeSpace = bytes
+}
+
+// SetTagStore sets the store grabbed items' tags are read from.
+func (s *Service) SetTagStore(store contracts.TagStore) {
+	s.tagStore = store
+}
+
 // SetDB updates the database connection used by this service.
 // This is called when switching between production and development databases.
 func (s *Service) SetDB(db *sql.DB) {
@@ -122,6 +176,26 @@ type GrabRequest struct {
 	IsCompleteSeries bool               `json:"isCompleteSeries,omitempty"`
 	TargetSlotID     *int64             `json:"targetSlotId,omitempty"` // Target slot for multi-version mode
 	Source           string             `json:"source,omitempty"`       // "auto-search", "manual-search", "portal-request"
+
+	// Score of the selected release, kept with grabs held for approval
+	Score           float64               `json:"score,omitempty"`
+	NormalizedScore int                   `json:"normalizedScore,omitempty"`
+	ScoreBreakdown  *types.ScoreBreakdown `json:"scoreBreakdown,omitempty"`
+
+	// Approved marks a held grab an admin approved; it bypasses automatic-grab guards
+	Approved bool `json:"-"`
+}
+
+// SetScore copies the scoring of the selected release onto the request.
+func (r *GrabRequest) SetScore(release *types.TorrentInfo) {
+	r.Score = release.Score
+	r.NormalizedScore = release.NormalizedScore
+	r.ScoreBreakdown = release.ScoreBreakdown
+}
+
+// isAutomatic reports whether the grab is subject to automatic-grab guards.
+func (r *GrabRequest) isAutomatic() bool {
+	return isAutomaticSource(r.Source) && !r.Approved
 }
 
 // GrabResult contains the result of a grab operation.
@@ -131,6 +205,13 @@ type GrabResult struct {
 	ClientID   int64  `json:"clientId,omitempty"`
 	ClientName string `json:"clientName,omitempty"`
 	Error      string `json:"error,omitempty"`
+
+	// Deferred is set when an automatic grab hit a grab cap; RetryAt is when it may proceed.
+	Deferred bool       `json:"deferred,omitempty"`
+	RetryAt  *time.Time `json:"retryAt,omitempty"`
+
+	// PendingApproval is set when an automatic grab was held for admin approval.
+	PendingApproval bool `json:"pendingApproval,omitempty"`
 }
 
 // BulkGrabRequest represents a request to grab multiple releases.
@@ -163,7 +244,7 @@ func (s *Service) Grab(ctx context.Context, req *GrabRequest) (*GrabResult, erro
 	}
 
 	s.broadcastGrabStarted(req.Release)
-	s.logger.Info().
+	s.log(ctx).Info().
 		Str("title", req.Release.Title).
 		Int64("indexerId", req.Release.IndexerID).
 		Str("protocol", string(req.Release.Protocol)).
@@ -172,8 +253,20 @@ func (s *Service) Grab(ctx context.Context, req *GrabRequest) (*GrabResult, erro
 	if result, err := s.checkGrabPreconditions(ctx, req.Release); result != nil {
 		return result, err
 	}
+	if result, err := s.checkApprovalHold(ctx, req); result != nil {
+		return result, err
+	}
+	if result, err := s.checkFreeSpaceGuard(ctx, req); result != nil {
+		return result, err
+	}
+	if result, err := s.checkRootFolderSpace(ctx, req); result != nil {
+		return result, err
+	}
+	if result, err := s.checkAutoGrabCaps(ctx, req); result != nil {
+		return result, err
+	}
 
-	client, err := s.selectDownloadClient(ctx, req.Release.Protocol, req.ClientID)
+	client, err := s.selectDownloadClient(ctx, req.Release, req.ClientID, s.itemTags(ctx, req))
 	if err != nil {
 		s.broadcastGrabCompleted(req.Release, nil, fmt.Sprintf("no suitable download client: %v", err))
 		return &GrabResult{Success: false, Error: fmt.Sprintf("no suitable download client: %v", err)}, err
@@ -182,6 +275,7 @@ func (s *Service) Grab(ctx context.Context, req *GrabRequest) (*GrabResult, erro
 	downloadID, err := s.sendToClient(ctx, client, req.Release, req.MediaType)
 	if err != nil {
 		s.recordFailure(ctx, req.Release.IndexerID, err)
+		s.recordGrabFailureHistory(ctx, req, client, err)
 		errMsg := fmt.Sprintf("failed to send to client: %v", err)
 		s.broadcastGrabCompleted(req.Release, &GrabResult{ClientID: client.ID, ClientName: client.Name}, errMsg)
 		return &GrabResult{Success: false, ClientID: client.ID, ClientName: client.Name, Error: errMsg}, err
@@ -194,7 +288,7 @@ func (s *Service) Grab(ctx context.Context, req *GrabRequest) (*GrabResult, erro
 	s.broadcastQueueUpdated()
 	s.notifyGrab(ctx, req, client, downloadID)
 
-	s.logger.Info().
+	s.log(ctx).Info().
 		Str("title", req.Release.Title).
 		Str("downloadId", downloadID).
 		Str("clientName", client.Name).
@@ -207,7 +301,7 @@ func (s *Service) checkGrabPreconditions(ctx context.Context, release *types.Rel
 	if s.statusService != nil {
 		disabled, _, err := s.statusService.IsDisabled(ctx, release.IndexerID)
 		if err != nil {
-			s.logger.Warn().Err(err).Msg("Failed to check indexer status")
+			s.log(ctx).Warn().Err(err).Msg("Failed to check indexer status")
 		} else if disabled {
 			s.broadcastGrabCompleted(release, nil, "indexer is temporarily disabled due to failures")
 			return &GrabResult{Success: false, Error: "indexer is temporarily disabled due to failures"}, ErrIndexerDisabled
@@ -217,7 +311,7 @@ func (s *Service) checkGrabPreconditions(ctx context.Context, release *types.Rel
 	if s.rateLimiter != nil {
 		limited, err := s.rateLimiter.CheckGrabLimit(ctx, release.IndexerID)
 		if err != nil {
-			s.logger.Warn().Err(err).Msg("Failed to check grab limit")
+			s.log(ctx).Warn().Err(err).Msg("Failed to check grab limit")
 		} else if limited {
 			s.broadcastGrabCompleted(release, nil, "grab limit exceeded for this indexer")
 			return &GrabResult{Success: false, Error: "grab limit exceeded for this indexer"}, ErrGrabLimitExceeded
@@ -232,6 +326,7 @@ func (s *Service) onGrabSuccess(ctx context.Context, req *GrabRequest, client *d
 	if s.rateLimiter != nil {
 		s.rateLimiter.RecordGrab(ctx, req.Release.IndexerID)
 	}
+	s.recordAutoGrab(req)
 	s.recordGrabHistory(ctx, req, client, downloadID)
 	s.createDownloadMapping(ctx, req, client.ID, downloadID)
 }
@@ -322,13 +417,18 @@ func (s *Service) GrabBulk(ctx context.Context, req *BulkGrabRequest) (*BulkGrab
 }
 
 // selectDownloadClient selects an appropriate download client.
-func (s *Service) selectDownloadClient(ctx context.Context, protocol types.Protocol, preferredClientID int64) (*downloader.DownloadClient, error) {
+// Clients without enough free disk space for the release are skipped, as are
+// tagged clients sharing no tag with the item. An explicitly preferred client
+// is used regardless of tags.
+func (s *Service) selectDownloadClient(ctx context.Context, release *types.ReleaseInfo, preferredClientID int64, itemTags []int64) (*downloader.DownloadClient, error) {
+	protocol := release.Protocol
+
 	// If preferred client specified, try to use it
 	if preferredClientID > 0 {
 		client, err := s.downloaderService.Get(ctx, preferredClientID)
 		if err == nil && client.Enabled {
 			// Check if client supports the protocol
-			if s.clientSupportsProtocol(client, protocol) {
+			if s.clientSupportsProtocol(client, protocol) && s.hasFreeSpaceFor(ctx, client, release.Size) {
 				return client, nil
 			}
 		}
@@ -340,12 +440,15 @@ func (s *Service) selectDownloadClient(ctx context.Context, protocol types.Proto
 		return nil, fmt.Errorf("failed to list download clients: %w", err)
 	}
 
-	// Filter by protocol support and enabled status
+	// Filter by protocol support, enabled status and tags
 	for _, client := range clients {
 		if !client.Enabled {
 			continue
 		}
-		if s.clientSupportsProtocol(client, protocol) {
+		if itemTags != nil && !tags.Matches(client.Tags, itemTags) {
+			continue
+		}
+		if s.clientSupportsProtocol(client, protocol) && s.hasFreeSpaceFor(ctx, client, release.Size) {
 			return client, nil
 		}
 	}
@@ -353,6 +456,42 @@ func (s *Service) selectDownloadClient(ctx context.Context, protocol types.Proto
 	return nil, ErrNoDownloadClient
 }
 
+// itemTags returns the tags of the item a grab is for, or nil if the grab is
+// not tied to a library item or no tag store is configured.
+func (s *Service) itemTags(ctx context.Context, req *GrabRequest) []int64 {
+	if s.tagStore == nil || req.MediaID == 0 {
+		return nil
+	}
+	return s.tagStore.ItemTags(ctx, req.MediaType, req.MediaID, req.SeriesID)
+}
+
+// hasFreeSpaceFor reports whether a client can fit a release of the given size
+// while keeping the configured minimum free. Unknown free space is treated as enough.
+func (s *Service) hasFreeSpaceFor(ctx context.Context, client *downloader.DownloadClient, size int64) bool {
+	if s.minFreeSpace == 0 {
+		return true
+	}
+
+	free, err := s.downloaderService.GetFreeSpace(ctx, client.ID)
+	if err != nil {
+		if !errors.Is(err, downloader.ErrNotImplemented) {
+			s.log(ctx).Warn().Err(err).Int64("clientId", client.ID).Msg("Failed to get client free space")
+		}
+		return true
+	}
+
+	if free-size < s.minFreeSpace {
+		s.log(ctx).Warn().
+			Int64("clientId", client.ID).
+			Str("clientName", client.Name).
+			Int64("freeSpace", free).
+			Int64("releaseSize", size).
+			Msg("Skipping download client: insufficient free space")
+		return false
+	}
+	return true
+}
+
 // clientSupportsProtocol checks if a client supports the given protocol.
 func (s *Service) clientSupportsProtocol(client *downloader.DownloadClient, protocol types.Protocol) bool {
 	switch protocol {
@@ -383,14 +522,14 @@ func (s *Service) sendToClient(ctx context.Context, client *downloader.DownloadC
 func (s *Service) sendViaIndexer(ctx context.Context, client *downloader.DownloadClient, release *types.ReleaseInfo, mediaType string) (string, error) {
 	indexerClient, err := s.indexerService.GetClient(ctx, release.IndexerID)
 	if err != nil {
-		s.logger.Warn().Err(err).Int64("indexerId", release.IndexerID).
+		s.log(ctx).Warn().Err(err).Int64("indexerId", release.IndexerID).
 			Msg("Failed to get indexer client, falling back to direct URL")
 		return "", err
 	}
 
 	torrentData, err := indexerClient.Download(ctx, release.DownloadURL)
 	if err != nil {
-		s.logger.Warn().Err(err).Str("url", release.DownloadURL).
+		s.log(ctx).Warn().Err(err).Str("url", release.DownloadURL).
 			Msg("Failed to download torrent via indexer, falling back to direct URL")
 		return "", err
 	}
@@ -408,7 +547,7 @@ func (s *Service) recordSuccess(ctx context.Context, indexerID int64) {
 		return
 	}
 	if err := s.statusService.RecordSuccess(ctx, indexerID); err != nil {
-		s.logger.Warn().Err(err).Int64("indexerId", indexerID).Msg("Failed to record success")
+		s.log(ctx).Warn().Err(err).Int64("indexerId", indexerID).Msg("Failed to record success")
 	}
 }
 
@@ -418,35 +557,42 @@ func (s *Service) recordFailure(ctx context.Context, indexerID int64, opError er
 		return
 	}
 	if err := s.statusService.RecordFailure(ctx, indexerID, opError); err != nil {
-		s.logger.Warn().Err(err).Int64("indexerId", indexerID).Msg("Failed to record failure")
+		s.log(ctx).Warn().Err(err).Int64("indexerId", indexerID).Msg("Failed to record failure")
 	}
 }
 
 // recordGrabHistory records the grab in indexer history.
 func (s *Service) recordGrabHistory(ctx context.Context, req *GrabRequest, client *downloader.DownloadClient, downloadID string) {
+	s.createGrabHistoryEvent(ctx, req, true, fmt.Sprintf(`{"downloadId":%q,"clientId":%d,"clientName":%q,"mediaType":%q,"mediaId":%d}`,
+		downloadID, client.ID, client.Name, req.MediaType, req.MediaID))
+}
+
+// recordGrabFailureHistory records a failed grab in indexer history.
+func (s *Service) recordGrabFailureHistory(ctx context.Context, req *GrabRequest, client *downloader.DownloadClient, grabErr error) {
+	s.createGrabHistoryEvent(ctx, req, false, fmt.Sprintf(`{"error":%q,"clientId":%d,"clientName":%q,"mediaType":%q,"mediaId":%d}`,
+		grabErr.Error(), client.ID, client.Name, req.MediaType, req.MediaID))
+}
+
+func (s *Service) createGrabHistoryEvent(ctx context.Context, req *GrabRequest, successful bool, data string) {
 	// Check if the indexer exists locally before recording history.
 	// In Prowlarr mode, indexer IDs come from Prowlarr and don't exist in the local database.
 	if _, err := s.queries.GetIndexer(ctx, req.Release.IndexerID); err != nil {
-		s.logger.Debug().Int64("indexerId", req.Release.IndexerID).Msg("Skipping grab history - indexer not in local database (likely Prowlarr mode)")
+		s.log(ctx).Debug().Int64("indexerId", req.Release.IndexerID).Msg("Skipping grab history - indexer not in local database (likely Prowlarr mode)")
 		return
 	}
 
 	_, err := s.queries.CreateIndexerHistoryEvent(ctx, sqlc.CreateIndexerHistoryEventParams{
 		IndexerID:    req.Release.IndexerID,
 		EventType:    "grab",
-		Successful:   true,
+		Successful:   successful,
 		Query:        sql.NullString{String: req.Release.Title, Valid: true},
 		Categories:   sql.NullString{},
 		ResultsCount: sql.NullInt64{Int64: 1, Valid: true},
 		ElapsedMs:    sql.NullInt64{},
-		Data: sql.NullString{
-			String: fmt.Sprintf(`{"downloadId":%q,"clientId":%d,"clientName":%q,"mediaType":%q,"mediaId":%d}`,
-				downloadID, client.ID, client.Name, req.MediaType, req.MediaID),
-			Valid: true,
-		},
+		Data:         sql.NullString{String: data, Valid: true},
 	})
 	if err != nil {
-		s.logger.Warn().Err(err).Msg("Failed to record grab history")
+		s.log(ctx).Warn().Err(err).Msg("Failed to record grab history")
 	}
 }
 
@@ -457,19 +603,16 @@ func (s *Service) GetGrabHistory(ctx context.Context, limit, offset int) ([]Grab
 	}
 
 	rows, err := s.queries.ListRecentIndexerHistory(ctx, sqlc.ListRecentIndexerHistoryParams{
-		Limit:  int64(limit),
-		Offset: int64(offset),
+		EventType: "grab",
+		Limit:     int64(limit),
+		Offset:    int64(offset),
 	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to get history: %w", err)
 	}
 
-	// Filter for grab events only
-	items := make([]GrabHistoryItem, 0)
+	items := make([]GrabHistoryItem, 0, len(rows))
 	for _, row := range rows {
-		if row.EventType != "grab" {
-			continue
-		}
 		items = append(items, GrabHistoryItem{
 			ID:         row.ID,
 			IndexerID:  row.IndexerID,
@@ -502,7 +645,7 @@ func (s *Service) createDownloadMapping(ctx context.Context, req *GrabRequest, c
 
 	_, err := s.queries.CreateDownloadMapping(ctx, *params)
 	if err != nil {
-		s.logger.Warn().Err(err).
+		s.log(ctx).Warn().Err(err).
 			Str("downloadId", downloadID).
 			Str("mediaType", req.MediaType).
 			Int64("mediaId", req.MediaID).
@@ -531,6 +674,9 @@ func (s *Service) buildMappingParams(req *GrabRequest, clientID int64, downloadI
 	if req.TargetSlotID != nil {
 		params.TargetSlotID = sql.NullInt64{Int64: *req.TargetSlotID, Valid: true}
 	}
+	if req.Release != nil && req.Release.IndexerID > 0 {
+		params.IndexerID = sql.NullInt64{Int64: req.Release.IndexerID, Valid: true}
+	}
 
 	switch req.MediaType {
 	case mediaTypeMovie:
@@ -569,7 +715,7 @@ func (s *Service) setMediaDownloadingStatus(ctx context.Context, req *GrabReques
 			StatusMessage:    sql.NullString{},
 			ID:               req.MediaID,
 		}); err != nil {
-			s.logger.Warn().Err(err).Int64("movieId", req.MediaID).Msg("Failed to set movie status to downloading")
+			s.log(ctx).Warn().Err(err).Int64("movieId", req.MediaID).Msg("Failed to set movie status to downloading")
 		} else if s.broadcaster != nil {
 			s.broadcaster.BroadcastEntity("movie", "movie", req.MediaID, "updated", nil)
 		}
@@ -580,7 +726,7 @@ func (s *Service) setMediaDownloadingStatus(ctx context.Context, req *GrabReques
 			StatusMessage:    sql.NullString{},
 			ID:               req.MediaID,
 		}); err != nil {
-			s.logger.Warn().Err(err).Int64("episodeId", req.MediaID).Msg("Failed to set episode status to downloading")
+			s.log(ctx).Warn().Err(err).Int64("episodeId", req.MediaID).Msg("Failed to set episode status to downloading")
 		} else if s.broadcaster != nil && req.SeriesID > 0 {
 			s.broadcaster.BroadcastEntity("tv", "series", req.SeriesID, "updated", nil)
 		}
@@ -0,0 +1,67 @@
+package indexer
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/slipstream/slipstream/internal/database/sqlc"
+)
+
+// GrabCap limits how many automatic grabs (autosearch and RSS sync) an
+// indexer may receive per hour and per day, typically to respect tracker
+// rules. Zero disables the cap for that window. Manual grabs are not capped.
+type GrabCap struct {
+	IndexerID       int64 `json:"indexerId"`
+	MaxGrabsPerHour int   `json:"maxGrabsPerHour"`
+	MaxGrabsPerDay  int   `json:"maxGrabsPerDay"`
+}
+
+// GetGrabCap returns the automatic grab cap for an indexer. An indexer
+// without a stored cap returns an empty cap rather than an error.
+func (s *Service) GetGrabCap(ctx context.Context, indexerID int64) (*GrabCap, error) {
+	row, err := s.queries.GetIndexerGrabCap(ctx, indexerID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return &GrabCap{IndexerID: indexerID}, nil
+		}
+		return nil, fmt.Errorf("failed to get grab cap: %w", err)
+	}
+	return rowToGrabCap(row), nil
+}
+
+// SetGrabCap stores the automatic grab cap for an indexer. Clearing both
+// limits removes the stored cap.
+func (s *Service) SetGrabCap(ctx context.Context, grabCap *GrabCap) (*GrabCap, error) {
+	if grabCap.MaxGrabsPerHour < 0 || grabCap.MaxGrabsPerDay < 0 {
+		return nil, fmt.Errorf("%w: grab caps must not be negative", ErrInvalidIndexer)
+	}
+
+	if grabCap.MaxGrabsPerHour == 0 && grabCap.MaxGrabsPerDay == 0 {
+		if err := s.queries.DeleteIndexerGrabCap(ctx, grabCap.IndexerID); err != nil {
+			return nil, fmt.Errorf("failed to clear grab cap: %w", err)
+		}
+		return &GrabCap{IndexerID: grabCap.IndexerID}, nil
+	}
+
+	row, err := s.queries.UpsertIndexerGrabCap(ctx, sqlc.UpsertIndexerGrabCapParams{
+		IndexerID:       grabCap.IndexerID,
+		MaxGrabsPerHour: int64(grabCap.MaxGrabsPerHour),
+		MaxGrabsPerDay:  int64(grabCap.MaxGrabsPerDay),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to save grab cap: %w", err)
+	}
+
+	s.logger.Info().Int64("indexerId", grabCap.IndexerID).Msg("Updated indexer grab cap")
+	return rowToGrabCap(row), nil
+}
+
+func rowToGrabCap(row *sqlc.IndexerGrabCap) *GrabCap {
+	return &GrabCap{
+		IndexerID:       row.IndexerID,
+		MaxGrabsPerHour: int(row.MaxGrabsPerHour),
+		MaxGrabsPerDay:  int(row.MaxGrabsPerDay),
+	}
+}
@@ -6,11 +6,14 @@ import (
 	"errors"
 	"net/http"
 	"strconv"
+	"time"
 
 	"github.com/labstack/echo/v4"
 
 	"github.com/slipstream/slipstream/internal/indexer/cardigann"
 	"github.com/slipstream/slipstream/internal/indexer/status"
+	"github.com/slipstream/slipstream/internal/logger"
+	"github.com/slipstream/slipstream/internal/tags"
 )
 
 const redactedSentinel = "********"
@@ -41,12 +44,19 @@ func (h *Handlers) RegisterRoutes(g *echo.Group) {
 	g.GET("/definitions/:id/schema", h.GetDefinitionSchema)
 	g.POST("/definitions/update", h.UpdateDefinitions)
 	g.GET("/status", h.GetAllStatuses)
+	g.GET("/stats", h.GetStats)
 	g.POST("/test", h.TestConfig)
 	g.GET("/:id", h.Get)
 	g.PUT("/:id", h.Update)
 	g.DELETE("/:id", h.Delete)
 	g.POST("/:id/test", h.Test)
 	g.GET("/:id/status", h.GetStatus)
+	g.GET("/:id/quarantine", h.ListQuarantine)
+	g.DELETE("/:id/quarantine", h.ClearQuarantine)
+	g.GET("/:id/seed-goal", h.GetSeedGoal)
+	g.PUT("/:id/seed-goal", h.UpdateSeedGoal)
+	g.GET("/:id/grab-cap", h.GetGrabCap)
+	g.PUT("/:id/grab-cap", h.UpdateGrabCap)
 }
 
 // indexerSensitiveFields returns the set of settings field names that are passwords for the given definition.
@@ -208,7 +218,7 @@ func (h *Handlers) Create(c echo.Context) error {
 	}
 
 	// Debug: Log received settings
-	h.service.logger.Debug().
+	logger.FromContext(c.Request().Context(), h.service.logger).Debug().
 		Str("name", input.Name).
 		Str("definitionId", input.DefinitionID).
 		RawJSON("settings", input.Settings).
@@ -251,7 +261,7 @@ func (h *Handlers) Update(c echo.Context) error {
 		if errors.Is(err, ErrIndexerNotFound) {
 			return echo.NewHTTPError(http.StatusNotFound, err.Error())
 		}
-		if errors.Is(err, ErrInvalidIndexer) || errors.Is(err, ErrDefinitionNotFound) {
+		if errors.Is(err, ErrInvalidIndexer) || errors.Is(err, ErrDefinitionNotFound) || errors.Is(err, tags.ErrTagNotFound) {
 			return echo.NewHTTPError(http.StatusBadRequest, err.Error())
 		}
 		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
@@ -401,6 +411,164 @@ func (h *Handlers) GetAllStatuses(c echo.Context) error {
 	})
 }
 
+// ListQuarantine returns malformed result rows recently set aside for an indexer.
+// GET /api/v1/indexers/:id/quarantine?limit=50
+func (h *Handlers) ListQuarantine(c echo.Context) error {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid id")
+	}
+
+	limit := 50
+	if v := c.QueryParam("limit"); v != "" {
+		parsed, err := strconv.Atoi(v)
+		if err != nil || parsed <= 0 {
+			return echo.NewHTTPError(http.StatusBadRequest, "invalid limit")
+		}
+		limit = parsed
+	}
+
+	if h.statusService == nil {
+		return c.JSON(http.StatusOK, []*status.QuarantinedRow{})
+	}
+
+	rows, err := h.statusService.ListQuarantinedRows(c.Request().Context(), id, limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, rows)
+}
+
+// ClearQuarantine deletes the quarantined result rows for an indexer.
+// DELETE /api/v1/indexers/:id/quarantine
+func (h *Handlers) ClearQuarantine(c echo.Context) error {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid id")
+	}
+
+	if h.statusService != nil {
+		if err := h.statusService.ClearQuarantinedRows(c.Request().Context(), id); err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+		}
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+// GetSeedGoal returns the seed ratio and seed time goal for an indexer.
+// GET /api/v1/indexers/:id/seed-goal
+func (h *Handlers) GetSeedGoal(c echo.Context) error {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid id")
+	}
+
+	goal, err := h.service.GetSeedGoal(c.Request().Context(), id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, goal)
+}
+
+// UpdateSeedGoal sets the seed ratio and seed time goal for an indexer.
+// PUT /api/v1/indexers/:id/seed-goal
+func (h *Handlers) UpdateSeedGoal(c echo.Context) error {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid id")
+	}
+
+	var goal SeedGoal
+	if err := c.Bind(&goal); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+	goal.IndexerID = id
+
+	saved, err := h.service.SetSeedGoal(c.Request().Context(), &goal)
+	if err != nil {
+		if errors.Is(err, ErrInvalidIndexer) {
+			return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+		}
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, saved)
+}
+
+// GetGrabCap returns the automatic grab cap for an indexer.
+// GET /api/v1/indexers/:id/grab-cap
+func (h *Handlers) GetGrabCap(c echo.Context) error {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid id")
+	}
+
+	grabCap, err := h.service.GetGrabCap(c.Request().Context(), id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, grabCap)
+}
+
+// UpdateGrabCap sets the automatic grab cap for an indexer.
+// PUT /api/v1/indexers/:id/grab-cap
+func (h *Handlers) UpdateGrabCap(c echo.Context) error {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid id")
+	}
+
+	var grabCap GrabCap
+	if err := c.Bind(&grabCap); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+	grabCap.IndexerID = id
+
+	saved, err := h.service.SetGrabCap(c.Request().Context(), &grabCap)
+	if err != nil {
+		if errors.Is(err, ErrInvalidIndexer) {
+			return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+		}
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, saved)
+}
+
+// GetStats returns query, response time, failure and grab statistics for all indexers.
+// GET /api/v1/indexers/stats?days=7
+func (h *Handlers) GetStats(c echo.Context) error {
+	days := 7
+	if v := c.QueryParam("days"); v != "" {
+		parsed, err := strconv.Atoi(v)
+		if err != nil || parsed <= 0 {
+			return echo.NewHTTPError(http.StatusBadRequest, "invalid days")
+		}
+		days = parsed
+	}
+
+	if h.statusService == nil {
+		return c.JSON(http.StatusOK, map[string]interface{}{
+			"indexers": []*status.IndexerStats{},
+		})
+	}
+
+	since := time.Now().AddDate(0, 0, -days)
+	stats, err := h.statusService.GetIndexerStats(c.Request().Context(), since)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"indexers": stats,
+		"since":    since,
+	})
+}
+
 // ListDefinitions returns all available Cardigann definitions.
 // GET /api/v1/indexers/definitions
 func (h *Handlers) ListDefinitions(c echo.Context) error {
@@ -104,6 +104,7 @@ func (c *Client) SearchTorrents(ctx context.Context, criteria *types.SearchCrite
 			Leechers:             5 + i,
 			DownloadVolumeFactor: 0, // Freeleech
 			UploadVolumeFactor:   1,
+			Freeleech:            true,
 		}
 	}
 
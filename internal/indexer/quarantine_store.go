@@ -0,0 +1,26 @@
+package indexer
+
+import (
+	"context"
+
+	"github.com/slipstream/slipstream/internal/indexer/cardigann"
+	"github.com/slipstream/slipstream/internal/indexer/status"
+)
+
+// statusQuarantineStore wraps the status service to implement cardigann.QuarantineStore.
+type statusQuarantineStore struct {
+	statusService *status.Service
+}
+
+// NewQuarantineStore creates a QuarantineStore that uses the status service for persistence.
+func NewQuarantineStore(statusService *status.Service) cardigann.QuarantineStore {
+	return &statusQuarantineStore{statusService: statusService}
+}
+
+func (s *statusQuarantineStore) QuarantineRows(ctx context.Context, indexerID int64, query string, rows []cardigann.QuarantinedRow) error {
+	converted := make([]status.QuarantinedRow, len(rows))
+	for i := range rows {
+		converted[i] = status.QuarantinedRow{Reason: rows[i].Reason, RawFragment: rows[i].RawFragment}
+	}
+	return s.statusService.RecordQuarantinedRows(ctx, indexerID, query, converted)
+}
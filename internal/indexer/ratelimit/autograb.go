@@ -0,0 +1,144 @@
+package ratelimit
+
+import (
+	"sync"
+	"time"
+)
+
+const (
+	// ScopeGlobal marks a cap that applies to all automatic grabs.
+	ScopeGlobal = "global"
+	// ScopeIndexer marks a cap that applies to one indexer's automatic grabs.
+	ScopeIndexer = "indexer"
+
+	WindowHour = "hour"
+	WindowDay  = "day"
+)
+
+// GrabCaps bounds how many automatic grabs may happen per hour and per day.
+// Zero disables the cap for that window.
+type GrabCaps struct {
+	PerHour int `json:"perHour"`
+	PerDay  int `json:"perDay"`
+}
+
+// CapHit describes the cap that blocked an automatic grab.
+type CapHit struct {
+	Scope     string    `json:"scope"`
+	IndexerID int64     `json:"indexerId,omitempty"`
+	Window    string    `json:"window"`
+	Limit     int       `json:"limit"`
+	RetryAt   time.Time `json:"retryAt"`
+}
+
+type autoGrab struct {
+	indexerID int64
+	at        time.Time
+}
+
+// AutoGrabLimiter enforces sliding-window caps on automatic grabs, both across
+// all indexers and per indexer. Manual grabs are never recorded here.
+type AutoGrabLimiter struct {
+	mu    sync.Mutex
+	grabs []autoGrab // oldest first, pruned to the last day
+	now   func() time.Time
+}
+
+// NewAutoGrabLimiter creates an empty automatic grab limiter.
+func NewAutoGrabLimiter() *AutoGrabLimiter {
+	return &AutoGrabLimiter{now: time.Now}
+}
+
+// Check returns the first cap an automatic grab from indexerID would exceed,
+// or nil if the grab may proceed. Global caps are checked before indexer caps.
+func (l *AutoGrabLimiter) Check(indexerID int64, global, indexer GrabCaps) *CapHit {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	l.prune(now)
+
+	if hit := l.checkCaps(now, 0, global); hit != nil {
+		hit.Scope = ScopeGlobal
+		return hit
+	}
+	if hit := l.checkCaps(now, indexerID, indexer); hit != nil {
+		hit.Scope = ScopeIndexer
+		hit.IndexerID = indexerID
+		return hit
+	}
+	return nil
+}
+
+// Record counts an automatic grab from indexerID against the caps.
+func (l *AutoGrabLimiter) Record(indexerID int64) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	l.prune(now)
+	l.grabs = append(l.grabs, autoGrab{indexerID: indexerID, at: now})
+}
+
+// Counts returns the automatic grabs in the last hour and day. An indexerID of
+// zero counts grabs from every indexer.
+func (l *AutoGrabLimiter) Counts(indexerID int64) (hour, day int) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	l.prune(now)
+	return len(l.matching(now.Add(-time.Hour), indexerID)), len(l.matching(now.Add(-24*time.Hour), indexerID))
+}
+
+func (l *AutoGrabLimiter) checkCaps(now time.Time, indexerID int64, caps GrabCaps) *CapHit {
+	if hit := l.checkWindow(now, indexerID, time.Hour, caps.PerHour); hit != nil {
+		hit.Window = WindowHour
+		return hit
+	}
+	if hit := l.checkWindow(now, indexerID, 24*time.Hour, caps.PerDay); hit != nil {
+		hit.Window = WindowDay
+		return hit
+	}
+	return nil
+}
+
+// checkWindow reports a hit when the window already holds limit grabs. The
+// retry time is when enough of those grabs age out to admit one more.
+func (l *AutoGrabLimiter) checkWindow(now time.Time, indexerID int64, window time.Duration, limit int) *CapHit {
+	if limit <= 0 {
+		return nil
+	}
+	inWindow := l.matching(now.Add(-window), indexerID)
+	if len(inWindow) < limit {
+		return nil
+	}
+	return &CapHit{
+		Limit:   limit,
+		RetryAt: inWindow[len(inWindow)-limit].Add(window),
+	}
+}
+
+// matching returns the times of grabs after since, optionally filtered by indexer.
+func (l *AutoGrabLimiter) matching(since time.Time, indexerID int64) []time.Time {
+	var times []time.Time
+	for _, g := range l.grabs {
+		if !g.at.After(since) {
+			continue
+		}
+		if indexerID != 0 && g.indexerID != indexerID {
+			continue
+		}
+		times = append(times, g.at)
+	}
+	return times
+}
+
+func (l *AutoGrabLimiter) prune(now time.Time) {
+	cutoff := now.Add(-24 * time.Hour)
+	i := 0
+	for i < len(l.grabs) && !l.grabs[i].at.After(cutoff) {
+		i++
+	}
+	l.grabs = l.grabs[i:]
+}
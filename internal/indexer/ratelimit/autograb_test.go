@@ -0,0 +1,61 @@
+package ratelimit
+
+import (
+	"testing"
+	"time"
+)
+
+func TestAutoGrabLimiterCaps(t *testing.T) {
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	l := NewAutoGrabLimiter()
+	l.now = func() time.Time { return now }
+
+	global := GrabCaps{PerHour: 3, PerDay: 4}
+	indexer := GrabCaps{PerHour: 2}
+
+	l.Record(1)
+	now = now.Add(10 * time.Minute)
+	l.Record(1)
+
+	hit := l.Check(1, global, indexer)
+	if hit == nil || hit.Scope != ScopeIndexer || hit.Window != WindowHour || hit.IndexerID != 1 {
+		t.Fatalf("indexer 1 at its hourly cap: got %+v", hit)
+	}
+	if want := now.Add(-10 * time.Minute).Add(time.Hour); !hit.RetryAt.Equal(want) {
+		t.Errorf("RetryAt = %v, want %v", hit.RetryAt, want)
+	}
+	if hit := l.Check(2, global, indexer); hit != nil {
+		t.Fatalf("indexer 2 should not be capped: %+v", hit)
+	}
+
+	l.Record(2)
+	hit = l.Check(2, global, indexer)
+	if hit == nil || hit.Scope != ScopeGlobal || hit.Window != WindowHour {
+		t.Fatalf("global hourly cap: got %+v", hit)
+	}
+
+	now = now.Add(2 * time.Hour)
+	if hit := l.Check(3, global, GrabCaps{}); hit != nil {
+		t.Fatalf("hourly window should have expired: %+v", hit)
+	}
+	l.Record(3)
+	hit = l.Check(3, global, GrabCaps{})
+	if hit == nil || hit.Window != WindowDay {
+		t.Fatalf("global daily cap: got %+v", hit)
+	}
+
+	now = now.Add(24 * time.Hour)
+	if hour, day := l.Counts(0); hour != 0 || day != 0 {
+		t.Errorf("Counts after a day = %d, %d, want 0, 0", hour, day)
+	}
+}
+
+func TestAutoGrabLimiterNoCaps(t *testing.T) {
+	l := NewAutoGrabLimiter()
+	for range 100 {
+		l.Record(1)
+	}
+	if hit := l.Check(1, GrabCaps{}, GrabCaps{}); hit != nil {
+		t.Fatalf("zero caps should never block: %+v", hit)
+	}
+}
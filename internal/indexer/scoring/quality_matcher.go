@@ -1,6 +1,7 @@
 package scoring
 
 import (
+	"slices"
 	"strings"
 
 	"github.com/slipstream/slipstream/internal/library/quality"
@@ -14,8 +15,8 @@ type MatchResult struct {
 }
 
 // sourceCAM is the canonical source identifier for camera-recorded releases
-// (CAM, HDCAM, TS, HDTS, TELESYNC). Match against quality.PredefinedQualities
-// where Source == sourceCAM.
+// (CAM, HDCAM, TS, HDTS, TELESYNC). Match against qualities where
+// Source == sourceCAM.
 const sourceCAM = "cam"
 
 // sourceMapping maps parsed source strings to quality source identifiers.
@@ -85,6 +86,16 @@ func NormalizeSource(source string) string {
 	return ""
 }
 
+// MatchRelease matches a release to a quality, preferring custom qualities in
+// the registry whose match pattern hits the title over the source/resolution
+// match.
+func MatchRelease(registry *quality.Registry, title, source string, resolution int) MatchResult {
+	if q, ok := registry.MatchCustomQuality(title); ok {
+		return MatchResult{QualityID: q.ID, Quality: &q, Confidence: 1.0}
+	}
+	return MatchQuality(source, resolution)
+}
+
 // MatchQuality matches a release's source/resolution to a quality.
 // Returns the matched quality ID, the quality definition, and a confidence score.
 func MatchQuality(source string, resolution int) MatchResult {
@@ -130,8 +141,9 @@ func matchExact(source string, resolution int) (MatchResult, bool) {
 
 func bestQualityByField(matches func(q *quality.Quality) bool) *quality.Quality {
 	var best *quality.Quality
-	for i := range quality.PredefinedQualities {
-		q := &quality.PredefinedQualities[i]
+	qualities := slices.Clone(quality.PredefinedQualities)
+	for i := range qualities {
+		q := &qualities[i]
 		if matches(q) && (best == nil || q.Weight > best.Weight) {
 			best = q
 		}
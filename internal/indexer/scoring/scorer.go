@@ -6,6 +6,7 @@ import (
 
 	"github.com/slipstream/slipstream/internal/indexer/types"
 	"github.com/slipstream/slipstream/internal/library/scanner"
+	"github.com/slipstream/slipstream/internal/module/parseutil"
 )
 
 const (
@@ -41,11 +42,15 @@ func (s *Scorer) ScoreTorrent(torrent *types.TorrentInfo, ctx *ScoringContext) {
 	breakdown.MatchScore = s.calculateMatchScore(torrent, ctx)
 	breakdown.AgeScore = s.calculateAgeScore(torrent, ctx)
 	breakdown.LanguageScore = s.calculateLanguageScore(torrent, ctx)
+	breakdown.RevisionScore = s.calculateRevisionScore(scanner.ParseFilename(torrent.Title).Revision)
+	breakdown.ReleaseGroupScore = s.calculateReleaseGroupScore(parseutil.ParseReleaseGroup(torrent.Title), ctx)
+	breakdown.IndexerFlagScore = s.calculateIndexerFlagScore(torrent, ctx)
 
 	// Total score
 	torrent.Score = breakdown.QualityScore + breakdown.HealthScore +
 		breakdown.IndexerScore + breakdown.MatchScore + breakdown.AgeScore +
-		breakdown.LanguageScore
+		breakdown.LanguageScore + breakdown.RevisionScore + breakdown.ReleaseGroupScore +
+		breakdown.IndexerFlagScore
 
 	// Normalized score (0-100), clamped
 	// Max theoretical positive score: 100 (quality) + 65 (health: 35+15+15) + 20 (indexer) + 30 (match) = 215
@@ -78,7 +83,7 @@ func (s *Scorer) ScoreTorrents(torrents []types.TorrentInfo, ctx *ScoringContext
 // Returns 0-100 for allowed qualities, or DisallowedPenalty for disallowed ones.
 func (s *Scorer) calculateQualityScore(torrent *types.TorrentInfo, ctx *ScoringContext, breakdown *types.ScoreBreakdown) float64 {
 	// Try to match quality from source and resolution
-	matchResult := MatchQuality(torrent.Source, torrent.Resolution)
+	matchResult := MatchRelease(ctx.Qualities, torrent.Title, torrent.Source, torrent.Resolution)
 
 	if matchResult.Quality != nil {
 		breakdown.QualityID = matchResult.QualityID
@@ -89,8 +94,12 @@ func (s *Scorer) calculateQualityScore(torrent *types.TorrentInfo, ctx *ScoringC
 			return s.config.DisallowedPenalty
 		}
 
-		// Score based on quality weight
-		score := (float64(matchResult.Quality.Weight) / float64(MaxQualityWeight)) * s.config.MaxQualityPoints
+		// Score based on quality weight, as ranked by the profile
+		weight := matchResult.Quality.Weight
+		if ctx.QualityProfile != nil {
+			weight = ctx.QualityProfile.RankWeight(*matchResult.Quality)
+		}
+		score := (float64(weight) / float64(MaxQualityWeight)) * s.config.MaxQualityPoints
 		return score * matchResult.Confidence
 	}
 
@@ -172,6 +181,10 @@ func (s *Scorer) calculateYearScore(parsedYear, searchYear int) float64 {
 
 // calculateEpisodeScore calculates episode matching score component
 func (s *Scorer) calculateEpisodeScore(parsed *scanner.ParsedMedia, ctx *ScoringContext) float64 {
+	if parsed.Season == 0 && parsed.AbsoluteEpisode > 0 {
+		return s.scoreAbsoluteEpisode(parsed, ctx.SearchAbsolute)
+	}
+
 	if ctx.SearchSeason == 0 {
 		return 0
 	}
@@ -183,6 +196,21 @@ func (s *Scorer) calculateEpisodeScore(parsed *scanner.ParsedMedia, ctx *Scoring
 	return s.scoreSeasonMatch(parsed.Episode, ctx.SearchEpisode)
 }
 
+// scoreAbsoluteEpisode scores absolute-numbered anime releases, treating a
+// range that covers the episode like a season pack.
+func (s *Scorer) scoreAbsoluteEpisode(parsed *scanner.ParsedMedia, searchAbsolute int) float64 {
+	switch {
+	case searchAbsolute <= 0:
+		return 0
+	case parsed.AbsoluteEpisode == searchAbsolute && parsed.EndAbsolute <= searchAbsolute:
+		return s.config.ExactEpisodePoints
+	case parsed.EndAbsolute > 0 && searchAbsolute >= parsed.AbsoluteEpisode && searchAbsolute <= parsed.EndAbsolute:
+		return s.config.SeasonPackPoints
+	default:
+		return 0
+	}
+}
+
 // scoreSeasonMatch scores season match based on episode numbers
 func (s *Scorer) scoreSeasonMatch(parsedEpisode, searchEpisode int) float64 {
 	if searchEpisode > 0 {
@@ -220,6 +248,31 @@ func (s *Scorer) calculateMatchScore(torrent *types.TorrentInfo, ctx *ScoringCon
 	return score
 }
 
+// calculateRevisionScore favors PROPER, REPACK and REAL releases, which fix
+// a flawed earlier release of the same quality.
+func (s *Scorer) calculateRevisionScore(revision string) float64 {
+	return float64(parseutil.RevisionRank(revision)) * s.config.RevisionPoints
+}
+
+// calculateReleaseGroupScore favors releases from groups the quality profile
+// prefers. Forbidden groups are rejected during selection, not scored.
+func (s *Scorer) calculateReleaseGroupScore(group string, ctx *ScoringContext) float64 {
+	if ctx.QualityProfile == nil || !ctx.QualityProfile.ReleaseGroups.IsPreferred(group) {
+		return 0
+	}
+	return s.config.PreferredGroupPoints
+}
+
+// calculateIndexerFlagScore favors freeleech and internal releases when the
+// quality profile prefers them. Required flags are enforced during selection.
+func (s *Scorer) calculateIndexerFlagScore(torrent *types.TorrentInfo, ctx *ScoringContext) float64 {
+	if ctx.QualityProfile == nil {
+		return 0
+	}
+	count := ctx.QualityProfile.IndexerFlags.PreferredCount(torrent.Freeleech, torrent.Internal)
+	return float64(count) * s.config.PreferredFlagPoints
+}
+
 // calculateAgeScore calculates the age penalty component.
 // Returns 0 to -20 based on how old the release is.
 func (s *Scorer) calculateAgeScore(torrent *types.TorrentInfo, ctx *ScoringContext) float64 {
@@ -245,16 +298,18 @@ func (s *Scorer) calculateAgeScore(torrent *types.TorrentInfo, ctx *ScoringConte
 }
 
 // calculateLanguageScore calculates the language penalty component.
-// Returns 0 for matching language or releases without explicit language tags.
+// Returns 0 for matching language. Untagged releases are assumed to be English,
+// and MULTI/DUAL releases are assumed to carry an English track.
 // Returns a negative penalty for releases tagged with non-preferred languages.
 func (s *Scorer) calculateLanguageScore(torrent *types.TorrentInfo, ctx *ScoringContext) float64 {
-	// No languages detected means we assume English (default)
-	if len(torrent.Languages) == 0 {
-		return 0
-	}
-
 	preferredLang := ctx.GetPreferredLanguage()
 
+	if len(torrent.Languages) == 0 || parseutil.IsMultiAudio(torrent.Languages) {
+		if preferredLang == "English" {
+			return 0
+		}
+	}
+
 	// Check if any detected language matches the preferred language
 	for _, lang := range torrent.Languages {
 		if lang == preferredLang {
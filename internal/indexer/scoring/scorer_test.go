@@ -6,6 +6,7 @@ import (
 
 	"github.com/slipstream/slipstream/internal/indexer/types"
 	"github.com/slipstream/slipstream/internal/library/quality"
+	"github.com/slipstream/slipstream/internal/library/scanner"
 )
 
 func TestQualityMatcher(t *testing.T) {
@@ -402,6 +403,31 @@ func TestScorer_MatchScore_Episode(t *testing.T) {
 	}
 }
 
+func TestScorer_EpisodeScore_Absolute(t *testing.T) {
+	scorer := NewDefaultScorer()
+
+	tests := []struct {
+		name           string
+		parsed         scanner.ParsedMedia
+		searchAbsolute int
+		want           float64
+	}{
+		{"exact absolute", scanner.ParsedMedia{AbsoluteEpisode: 12}, 12, scorer.config.ExactEpisodePoints},
+		{"batch covering episode", scanner.ParsedMedia{AbsoluteEpisode: 1, EndAbsolute: 24}, 12, scorer.config.SeasonPackPoints},
+		{"wrong absolute", scanner.ParsedMedia{AbsoluteEpisode: 13}, 12, 0},
+		{"non-anime search", scanner.ParsedMedia{AbsoluteEpisode: 12}, 0, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := ScoringContext{SearchSeason: 1, SearchEpisode: 12, SearchAbsolute: tt.searchAbsolute}
+			if got := scorer.calculateEpisodeScore(&tt.parsed, &ctx); got != tt.want {
+				t.Errorf("calculateEpisodeScore() = %f, want %f", got, tt.want)
+			}
+		})
+	}
+}
+
 func TestScorer_AgeScore(t *testing.T) {
 	scorer := NewDefaultScorer()
 	now := time.Now()
@@ -718,6 +744,18 @@ func TestScorer_LanguageScore(t *testing.T) {
 			preferredLanguage: "English",
 			expectedPenalty:   false, // English is one of the languages
 		},
+		{
+			name:              "MULTI release with English preference",
+			languages:         []string{"French", "Multi"},
+			preferredLanguage: "English",
+			expectedPenalty:   false,
+		},
+		{
+			name:              "Untagged release with French preference",
+			languages:         nil,
+			preferredLanguage: "French",
+			expectedPenalty:   true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -745,3 +783,62 @@ func TestScorer_LanguageScore(t *testing.T) {
 		})
 	}
 }
+
+func TestScorer_RevisionScore(t *testing.T) {
+	scorer := NewDefaultScorer()
+
+	tests := []struct {
+		title string
+		want  float64
+	}{
+		{"Movie.2020.1080p.BluRay.x264-GROUP", 0},
+		{"Movie.2020.PROPER.1080p.BluRay.x264-GROUP", 4},
+		{"Movie.2020.REPACK.1080p.BluRay.x264-GROUP", 4},
+		{"Movie.2020.REAL.PROPER.1080p.BluRay.x264-GROUP", 8},
+		{"Real.Steel.2011.1080p.BluRay.x264-GROUP", 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.title, func(t *testing.T) {
+			revision := scanner.ParseFilename(tt.title).Revision
+			if got := scorer.calculateRevisionScore(revision); got != tt.want {
+				t.Errorf("calculateRevisionScore(%q) = %v, want %v", tt.title, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestScorer_ReleaseGroupScore(t *testing.T) {
+	scorer := NewDefaultScorer()
+	profile := quality.HD1080pProfile()
+	profile.ReleaseGroups = quality.ReleaseGroupSettings{Preferred: []string{"FraMeSToR"}}
+	ctx := ScoringContext{QualityProfile: &profile}
+
+	if got := scorer.calculateReleaseGroupScore("framestor", &ctx); got != 5 {
+		t.Errorf("preferred group score = %v, want 5", got)
+	}
+	if got := scorer.calculateReleaseGroupScore("YIFY", &ctx); got != 0 {
+		t.Errorf("other group score = %v, want 0", got)
+	}
+	if got := scorer.calculateReleaseGroupScore("FraMeSToR", &ScoringContext{}); got != 0 {
+		t.Errorf("score without profile = %v, want 0", got)
+	}
+}
+
+func TestScorer_IndexerFlagScore(t *testing.T) {
+	scorer := NewDefaultScorer()
+	profile := quality.HD1080pProfile()
+	profile.IndexerFlags = quality.IndexerFlagSettings{Freeleech: quality.FlagPrefer, Internal: quality.FlagPrefer}
+	ctx := ScoringContext{QualityProfile: &profile}
+
+	both := &types.TorrentInfo{Freeleech: true, Internal: true}
+	if got := scorer.calculateIndexerFlagScore(both, &ctx); got != 10 {
+		t.Errorf("freeleech internal score = %v, want 10", got)
+	}
+	if got := scorer.calculateIndexerFlagScore(&types.TorrentInfo{}, &ctx); got != 0 {
+		t.Errorf("unflagged score = %v, want 0", got)
+	}
+	if got := scorer.calculateIndexerFlagScore(both, &ScoringContext{}); got != 0 {
+		t.Errorf("score without profile = %v, want 0", got)
+	}
+}
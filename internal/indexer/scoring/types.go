@@ -27,6 +27,19 @@ type ScoringConfig struct {
 	ExactEpisodePoints float64 // default: 20
 	SeasonPackPoints   float64 // default: 10
 
+	// Revision bonus, per PROPER/REPACK step (see parseutil.RevisionRank).
+	// Kept below the gap between adjacent quality tiers so a PROPER never
+	// outranks a better quality.
+	RevisionPoints float64 // default: 4
+
+	// Bonus for a release group the quality profile prefers, also kept below
+	// the gap between adjacent quality tiers.
+	PreferredGroupPoints float64 // default: 5
+
+	// Bonus per freeleech or internal flag the quality profile prefers,
+	// likewise kept below the gap between adjacent quality tiers.
+	PreferredFlagPoints float64 // default: 5
+
 	// Age penalty
 	AgePenaltyStartDays int     // default: 7 (no penalty for first 7 days)
 	MaxAgePenalty       float64 // default: 20
@@ -56,6 +69,15 @@ func DefaultConfig() ScoringConfig {
 		ExactEpisodePoints: 20,
 		SeasonPackPoints:   10,
 
+		// Revision bonus
+		RevisionPoints: 4,
+
+		// Preferred release group bonus
+		PreferredGroupPoints: 5,
+
+		// Preferred indexer flag bonus
+		PreferredFlagPoints: 5,
+
 		// Age penalty
 		AgePenaltyStartDays: 7,
 		MaxAgePenalty:       20,
@@ -70,6 +92,10 @@ type ScoringContext struct {
 	// QualityProfile is used to determine quality scores and allowed qualities.
 	QualityProfile *quality.Profile
 
+	// Qualities supplies custom qualities whose patterns are matched against
+	// release titles. If nil, only the predefined qualities are matched.
+	Qualities *quality.Registry
+
 	// SearchYear is the expected year (for movies). Zero means no year matching.
 	SearchYear int
 
@@ -79,6 +105,10 @@ type ScoringContext struct {
 	// SearchEpisode is the expected episode (for TV). Zero means no episode matching.
 	SearchEpisode int
 
+	// SearchAbsolute is the expected anime absolute episode. Zero means
+	// absolute-numbered releases get no episode score.
+	SearchAbsolute int
+
 	// IndexerPriorities maps indexer ID to priority (1-100, lower = better).
 	// If not set, default priority of 50 is assumed.
 	IndexerPriorities map[int64]int
@@ -2,10 +2,12 @@ package search
 
 import (
 	"sort"
+	"strconv"
 	"strings"
 
 	"github.com/slipstream/slipstream/internal/indexer/types"
 	"github.com/slipstream/slipstream/internal/library/scanner"
+	"github.com/slipstream/slipstream/internal/module/titleutil"
 )
 
 const (
@@ -25,6 +27,7 @@ func (s *Service) aggregateResults(results <-chan searchTaskResult) *SearchResul
 				IndexerID:   result.IndexerID,
 				IndexerName: result.IndexerName,
 				Error:       result.Error.Error(),
+				TimedOut:    result.TimedOut,
 			})
 			continue
 		}
@@ -62,6 +65,7 @@ func (s *Service) aggregateTorrentResults(results <-chan searchTaskResult, crite
 				IndexerID:   result.IndexerID,
 				IndexerName: result.IndexerName,
 				Error:       result.Error.Error(),
+				TimedOut:    result.TimedOut,
 			})
 			continue
 		}
@@ -186,6 +190,37 @@ func deduplicateTorrents(torrents []types.TorrentInfo) []types.TorrentInfo {
 	return result
 }
 
+// dedupeAcrossIndexers drops the same release found on several indexers,
+// recognised by info hash or by normalized title and exact size, keeping the
+// first occurrence. Torrents must already be sorted best-first, so the copy
+// from the best-scoring source survives.
+func dedupeAcrossIndexers(torrents []types.TorrentInfo) []types.TorrentInfo {
+	seen := make(map[string]bool)
+	result := make([]types.TorrentInfo, 0, len(torrents))
+	for i := range torrents {
+		torrent := &torrents[i]
+		hashKey := ""
+		if torrent.InfoHash != "" {
+			hashKey = "hash:" + strings.ToLower(torrent.InfoHash)
+		}
+		titleKey := ""
+		if torrent.Size > 0 {
+			titleKey = "title:" + titleutil.NormalizeTitle(torrent.Title) + ":" + strconv.FormatInt(torrent.Size, 10)
+		}
+		if (hashKey != "" && seen[hashKey]) || (titleKey != "" && seen[titleKey]) {
+			continue
+		}
+		if hashKey != "" {
+			seen[hashKey] = true
+		}
+		if titleKey != "" {
+			seen[titleKey] = true
+		}
+		result = append(result, *torrent)
+	}
+	return result
+}
+
 // normalizeGUID normalizes a GUID for comparison.
 func normalizeGUID(guid string) string {
 	// Trim whitespace and convert to lowercase
@@ -287,6 +322,9 @@ func getTVFilterReason(parsed *scanner.ParsedMedia, criteria *types.SearchCriter
 	if !TVTitlesMatch(parsed.Title, criteria.Query) {
 		return "title mismatch: '" + parsed.Title + "' != '" + criteria.Query + "'"
 	}
+	if parsed.Season == 0 && parsed.AbsoluteEpisode > 0 {
+		return checkTVAbsoluteMatch(parsed, criteria)
+	}
 	if reason := checkTVSeasonMatch(parsed, criteria); reason != "" {
 		return reason
 	}
@@ -319,6 +357,24 @@ func checkSeasonPackMatch(parsed *scanner.ParsedMedia, criteria *types.SearchCri
 	return "wrong season pack"
 }
 
+// checkTVAbsoluteMatch handles absolute-numbered (anime) releases, which carry
+// no season. They only match searches that know the episode's absolute number.
+func checkTVAbsoluteMatch(parsed *scanner.ParsedMedia, criteria *types.SearchCriteria) string {
+	if criteria.AbsoluteEpisode <= 0 {
+		if criteria.Season > 0 {
+			return "wrong season"
+		}
+		return ""
+	}
+	if parsed.AbsoluteEpisode == criteria.AbsoluteEpisode {
+		return ""
+	}
+	if parsed.EndAbsolute > 0 && criteria.AbsoluteEpisode >= parsed.AbsoluteEpisode && criteria.AbsoluteEpisode <= parsed.EndAbsolute {
+		return ""
+	}
+	return "wrong absolute episode"
+}
+
 func checkTVEpisodeMatch(parsed *scanner.ParsedMedia, criteria *types.SearchCriteria) string {
 	if criteria.Episode <= 0 {
 		return ""
@@ -346,14 +402,8 @@ func getMovieFilterReason(parsed *scanner.ParsedMedia, criteria *types.SearchCri
 	if !TitlesMatch(parsed.Title, criteria.Query) {
 		return "title mismatch: '" + parsed.Title + "' != '" + criteria.Query + "'"
 	}
-	if criteria.Year > 0 && parsed.Year > 0 {
-		yearDiff := criteria.Year - parsed.Year
-		if yearDiff < 0 {
-			yearDiff = -yearDiff
-		}
-		if yearDiff > 1 {
-			return "year mismatch (>1 year difference)"
-		}
+	if !YearsMatch(criteria.Year, parsed.Year) {
+		return "year mismatch (>1 year difference)"
 	}
 	return ""
 }
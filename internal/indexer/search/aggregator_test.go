@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/slipstream/slipstream/internal/indexer/types"
+	"github.com/slipstream/slipstream/internal/library/scanner"
 )
 
 func TestFilterByCriteria_TV(t *testing.T) {
@@ -263,3 +264,70 @@ func TestFilterByCriteria_MultipleResults(t *testing.T) {
 		t.Errorf("Expected GUIDs 1, 2, and 4 to pass, got %v", guids)
 	}
 }
+
+func TestGetTVFilterReason_AbsoluteEpisode(t *testing.T) {
+	tests := []struct {
+		name       string
+		parsed     scanner.ParsedMedia
+		criteria   types.SearchCriteria
+		wantReason string
+	}{
+		{
+			name:       "exact absolute match",
+			parsed:     scanner.ParsedMedia{Title: "Frieren", IsTV: true, AbsoluteEpisode: 12},
+			criteria:   types.SearchCriteria{Query: "Frieren", Type: "tvsearch", Season: 1, Episode: 12, AbsoluteEpisode: 12},
+			wantReason: "",
+		},
+		{
+			name:       "absolute batch covers episode",
+			parsed:     scanner.ParsedMedia{Title: "Frieren", IsTV: true, AbsoluteEpisode: 1, EndAbsolute: 28},
+			criteria:   types.SearchCriteria{Query: "Frieren", Type: "tvsearch", Season: 1, Episode: 12, AbsoluteEpisode: 12},
+			wantReason: "",
+		},
+		{
+			name:       "wrong absolute episode",
+			parsed:     scanner.ParsedMedia{Title: "Frieren", IsTV: true, AbsoluteEpisode: 13},
+			criteria:   types.SearchCriteria{Query: "Frieren", Type: "tvsearch", Season: 1, Episode: 12, AbsoluteEpisode: 12},
+			wantReason: "wrong absolute episode",
+		},
+		{
+			name:       "absolute release for non-anime search",
+			parsed:     scanner.ParsedMedia{Title: "Frieren", IsTV: true, AbsoluteEpisode: 12},
+			criteria:   types.SearchCriteria{Query: "Frieren", Type: "tvsearch", Season: 1, Episode: 12},
+			wantReason: "wrong season",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := getTVFilterReason(&tt.parsed, &tt.criteria); got != tt.wantReason {
+				t.Errorf("getTVFilterReason() = %q, want %q", got, tt.wantReason)
+			}
+		})
+	}
+}
+
+func TestDedupeAcrossIndexers(t *testing.T) {
+	torrent := func(indexerID int64, title, hash string, size int64) types.TorrentInfo {
+		return types.TorrentInfo{
+			ReleaseInfo: types.ReleaseInfo{Title: title, Size: size, IndexerID: indexerID},
+			InfoHash:    hash,
+		}
+	}
+	// Sorted best-first, as SearchTorrents leaves them after scoring
+	torrents := []types.TorrentInfo{
+		torrent(1, "Dark.S01E01.1080p.WEB-DL-GROUP", "", 1000),
+		torrent(2, "Dark S01E01 1080p WEB-DL GROUP", "", 1000),
+		torrent(2, "Dark.S01E01.720p.WEB-DL-GROUP", "ABC", 500),
+		torrent(3, "Dark.S01E01.720p.WEB.DL-GROUP.renamed", "abc", 501),
+		torrent(3, "Dark.S01E01.1080p.WEB-DL-GROUP", "", 999),
+	}
+
+	got := dedupeAcrossIndexers(torrents)
+	if len(got) != 3 {
+		t.Fatalf("dedupeAcrossIndexers() kept %d torrents, want 3", len(got))
+	}
+	if got[0].IndexerID != 1 || got[1].IndexerID != 2 || got[2].Size != 999 {
+		t.Errorf("kept %+v, want the first of each duplicate and the differently sized release", got)
+	}
+}
@@ -0,0 +1,101 @@
+package search
+
+import (
+	"encoding/json"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/slipstream/slipstream/internal/indexer/types"
+)
+
+// searchCacheTTL is how long an indexer's results for a query are reused.
+// Season and batch searches repeat near-identical queries seconds apart;
+// the TTL is short enough that new uploads still show up promptly.
+const searchCacheTTL = 2 * time.Minute
+
+// searchCache holds recent per-indexer search results keyed by the exact
+// criteria sent. Only successful searches are cached.
+type searchCache struct {
+	mu      sync.Mutex
+	entries map[string]searchCacheEntry
+	ttl     time.Duration
+	now     func() time.Time
+}
+
+type searchCacheEntry struct {
+	releases  []types.ReleaseInfo
+	torrents  []types.TorrentInfo
+	expiresAt time.Time
+}
+
+func newSearchCache(ttl time.Duration) *searchCache {
+	return &searchCache{
+		entries: make(map[string]searchCacheEntry),
+		ttl:     ttl,
+		now:     time.Now,
+	}
+}
+
+// cacheKey identifies a query to one indexer. Release and torrent searches
+// return different types, so they are keyed separately.
+func cacheKey(kind string, indexerID int64, criteria *types.SearchCriteria) string {
+	encoded, _ := json.Marshal(criteria)
+	return kind + ":" + strconv.FormatInt(indexerID, 10) + ":" + string(encoded)
+}
+
+// getTorrents returns a copy of the cached torrents for key. Callers score
+// and enrich results in place, so the cached slice is never handed out.
+func (c *searchCache) getTorrents(key string) ([]types.TorrentInfo, bool) {
+	entry, ok := c.get(key)
+	if !ok || entry.torrents == nil {
+		return nil, false
+	}
+	return append([]types.TorrentInfo(nil), entry.torrents...), true
+}
+
+func (c *searchCache) putTorrents(key string, torrents []types.TorrentInfo) {
+	c.put(key, searchCacheEntry{torrents: append([]types.TorrentInfo{}, torrents...)})
+}
+
+// getReleases returns a copy of the cached releases for key.
+func (c *searchCache) getReleases(key string) ([]types.ReleaseInfo, bool) {
+	entry, ok := c.get(key)
+	if !ok || entry.releases == nil {
+		return nil, false
+	}
+	return append([]types.ReleaseInfo(nil), entry.releases...), true
+}
+
+func (c *searchCache) putReleases(key string, releases []types.ReleaseInfo) {
+	c.put(key, searchCacheEntry{releases: append([]types.ReleaseInfo{}, releases...)})
+}
+
+func (c *searchCache) get(key string) (searchCacheEntry, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	entry, ok := c.entries[key]
+	if !ok {
+		return searchCacheEntry{}, false
+	}
+	if !c.now().Before(entry.expiresAt) {
+		delete(c.entries, key)
+		return searchCacheEntry{}, false
+	}
+	return entry, true
+}
+
+// put stores an entry and sweeps expired ones so the cache stays bounded by
+// the number of distinct queries made within one TTL.
+func (c *searchCache) put(key string, entry searchCacheEntry) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	now := c.now()
+	for k, e := range c.entries {
+		if !now.Before(e.expiresAt) {
+			delete(c.entries, k)
+		}
+	}
+	entry.expiresAt = now.Add(c.ttl)
+	c.entries[key] = entry
+}
@@ -0,0 +1,39 @@
+package search
+
+import (
+	"testing"
+	"time"
+
+	"github.com/slipstream/slipstream/internal/indexer/types"
+)
+
+func TestSearchCache_ExpiresAndCopies(t *testing.T) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	cache := newSearchCache(time.Minute)
+	cache.now = func() time.Time { return now }
+
+	criteria := &types.SearchCriteria{Type: "tvsearch", Query: "Dark", Season: 1, Episode: 1}
+	key := cacheKey("torrent", 1, criteria)
+	cache.putTorrents(key, []types.TorrentInfo{{Seeders: 10}})
+
+	got, ok := cache.getTorrents(key)
+	if !ok || len(got) != 1 {
+		t.Fatalf("getTorrents() = %v, %v, want one cached torrent", got, ok)
+	}
+	got[0].Seeders = 99
+	if again, _ := cache.getTorrents(key); again[0].Seeders != 10 {
+		t.Error("mutating returned results changed the cache")
+	}
+
+	if _, ok := cache.getTorrents(cacheKey("torrent", 2, criteria)); ok {
+		t.Error("another indexer's query should not hit the cache")
+	}
+	if _, ok := cache.getReleases(cacheKey("release", 1, criteria)); ok {
+		t.Error("a release search should not hit a cached torrent search")
+	}
+
+	now = now.Add(time.Minute)
+	if _, ok := cache.getTorrents(key); ok {
+		t.Error("entry should expire after the TTL")
+	}
+}
@@ -0,0 +1,96 @@
+package search
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/slipstream/slipstream/internal/indexer/types"
+)
+
+const (
+	// defaultIndexerTimeout bounds a single indexer's search, so one slow
+	// tracker delays a search by at most this long.
+	defaultIndexerTimeout = 20 * time.Second
+
+	// flareSolverrIndexerTimeout allows for a FlareSolverr challenge round
+	// trip, which routinely takes much longer than a direct request.
+	flareSolverrIndexerTimeout = 75 * time.Second
+)
+
+// indexerSearch runs one indexer's part of a search.
+type indexerSearch func(ctx context.Context, def *types.IndexerDefinition) searchTaskResult
+
+// timeoutFor returns how long to wait for an indexer before giving up on it.
+// Indexers behind FlareSolverr get at least flareSolverrIndexerTimeout.
+func (s *Service) timeoutFor(def *types.IndexerDefinition) time.Duration {
+	timeout := s.indexerTimeout
+	if timeout <= 0 {
+		timeout = defaultIndexerTimeout
+	}
+	if def.FlareSolverrURL != "" && timeout < flareSolverrIndexerTimeout {
+		timeout = flareSolverrIndexerTimeout
+	}
+	return timeout
+}
+
+// fanOut searches every indexer concurrently, each under its own timeout,
+// and returns a channel of their results that is closed once all have
+// reported. An indexer that runs past its timeout reports a timeout error
+// straight away, even if its client ignores cancellation, so the other
+// indexers' results are aggregated without waiting on it.
+func (s *Service) fanOut(ctx context.Context, indexers []*types.IndexerDefinition, search indexerSearch) <-chan searchTaskResult {
+	var wg sync.WaitGroup
+	results := make(chan searchTaskResult, len(indexers))
+
+	for _, idx := range indexers {
+		wg.Add(1)
+		go func(def *types.IndexerDefinition) {
+			defer wg.Done()
+			results <- s.searchWithTimeout(ctx, def, search)
+		}(idx)
+	}
+
+	go func() {
+		wg.Wait()
+		close(results)
+	}()
+
+	return results
+}
+
+func (s *Service) searchWithTimeout(ctx context.Context, def *types.IndexerDefinition, search indexerSearch) searchTaskResult {
+	timeout := s.timeoutFor(def)
+	indexerCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	done := make(chan searchTaskResult, 1)
+	go func() {
+		done <- search(indexerCtx, def)
+	}()
+
+	select {
+	case result := <-done:
+		if result.Error != nil && ctx.Err() == nil && errors.Is(indexerCtx.Err(), context.DeadlineExceeded) {
+			result.Error = fmt.Errorf("timed out after %s", timeout)
+			result.TimedOut = true
+		}
+		return result
+	case <-indexerCtx.Done():
+		result := searchTaskResult{IndexerID: def.ID, IndexerName: def.Name}
+		if ctx.Err() != nil {
+			result.Error = ctx.Err()
+			return result
+		}
+		result.Error = fmt.Errorf("timed out after %s", timeout)
+		result.TimedOut = true
+		s.log(ctx).Warn().
+			Int64("indexerId", def.ID).
+			Str("indexerName", def.Name).
+			Dur("timeout", timeout).
+			Msg("Indexer search timed out, continuing with other indexers")
+		return result
+	}
+}
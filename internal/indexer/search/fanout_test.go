@@ -0,0 +1,62 @@
+package search
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+
+	"github.com/slipstream/slipstream/internal/indexer/types"
+)
+
+func TestFanOut_SlowIndexerDoesNotBlockOthers(t *testing.T) {
+	logger := zerolog.Nop()
+	s := &Service{logger: &logger, indexerTimeout: 50 * time.Millisecond}
+
+	release := make(chan struct{})
+	defer close(release)
+
+	indexers := []*types.IndexerDefinition{{ID: 1, Name: "fast"}, {ID: 2, Name: "stuck"}}
+	search := func(ctx context.Context, def *types.IndexerDefinition) searchTaskResult {
+		result := searchTaskResult{IndexerID: def.ID, IndexerName: def.Name}
+		if def.ID == 2 {
+			// Ignores cancellation, like a client without context support
+			<-release
+			return result
+		}
+		result.Torrents = []types.TorrentInfo{{Seeders: 5}}
+		return result
+	}
+
+	start := time.Now()
+	var fast, stuck searchTaskResult
+	for result := range s.fanOut(context.Background(), indexers, search) {
+		if result.IndexerID == 1 {
+			fast = result
+		} else {
+			stuck = result
+		}
+	}
+
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("fan-out took %v, want it bounded by the indexer timeout", elapsed)
+	}
+	if fast.Error != nil || len(fast.Torrents) != 1 {
+		t.Errorf("fast indexer = %+v, want its result", fast)
+	}
+	if stuck.Error == nil || !stuck.TimedOut {
+		t.Errorf("stuck indexer = %+v, want a timeout", stuck)
+	}
+}
+
+func TestTimeoutFor_FlareSolverrIndexers(t *testing.T) {
+	s := &Service{indexerTimeout: 10 * time.Second}
+
+	if got := s.timeoutFor(&types.IndexerDefinition{}); got != 10*time.Second {
+		t.Errorf("timeoutFor(direct) = %v, want 10s", got)
+	}
+	if got := s.timeoutFor(&types.IndexerDefinition{FlareSolverrURL: "http://flaresolverr:8191"}); got != flareSolverrIndexerTimeout {
+		t.Errorf("timeoutFor(FlareSolverr) = %v, want %v", got, flareSolverrIndexerTimeout)
+	}
+}
@@ -63,18 +63,14 @@ type SearchRequest struct {
 func (h *Handlers) Search(c echo.Context) error {
 	var req SearchRequest
 	if err := c.Bind(&req); err != nil {
-		return c.JSON(http.StatusBadRequest, map[string]string{
-			"error": "Invalid request parameters",
-		})
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid request parameters")
 	}
 
 	criteria := h.toCriteria(&req)
 
 	result, err := h.service.Search(c.Request().Context(), criteria)
 	if err != nil {
-		return c.JSON(http.StatusInternalServerError, map[string]string{
-			"error": err.Error(),
-		})
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
 	}
 
 	return c.JSON(http.StatusOK, result)
@@ -86,24 +82,18 @@ func (h *Handlers) Search(c echo.Context) error {
 func (h *Handlers) SearchMovie(c echo.Context) error {
 	var req SearchRequest
 	if err := c.Bind(&req); err != nil {
-		return c.JSON(http.StatusBadRequest, map[string]string{
-			"error": "Invalid request parameters",
-		})
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid request parameters")
 	}
 
 	// Quality profile is required for scoring
 	if req.QualityProfileID == 0 {
-		return c.JSON(http.StatusBadRequest, map[string]string{
-			"error": "qualityProfileId is required",
-		})
+		return echo.NewHTTPError(http.StatusBadRequest, "qualityProfileId is required")
 	}
 
 	// Fetch the quality profile
 	profile, err := h.qualityService.Get(c.Request().Context(), req.QualityProfileID)
 	if err != nil {
-		return c.JSON(http.StatusNotFound, map[string]string{
-			"error": "Quality profile not found",
-		})
+		return echo.NewHTTPError(http.StatusNotFound, "Quality profile not found")
 	}
 
 	criteria := h.toCriteria(&req)
@@ -116,9 +106,7 @@ func (h *Handlers) SearchMovie(c echo.Context) error {
 
 	result, err := h.service.SearchTorrents(c.Request().Context(), criteria, params)
 	if err != nil {
-		return c.JSON(http.StatusInternalServerError, map[string]string{
-			"error": err.Error(),
-		})
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
 	}
 
 	return c.JSON(http.StatusOK, result)
@@ -130,24 +118,18 @@ func (h *Handlers) SearchMovie(c echo.Context) error {
 func (h *Handlers) SearchTV(c echo.Context) error {
 	var req SearchRequest
 	if err := c.Bind(&req); err != nil {
-		return c.JSON(http.StatusBadRequest, map[string]string{
-			"error": "Invalid request parameters",
-		})
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid request parameters")
 	}
 
 	// Quality profile is required for scoring
 	if req.QualityProfileID == 0 {
-		return c.JSON(http.StatusBadRequest, map[string]string{
-			"error": "qualityProfileId is required",
-		})
+		return echo.NewHTTPError(http.StatusBadRequest, "qualityProfileId is required")
 	}
 
 	// Fetch the quality profile
 	profile, err := h.qualityService.Get(c.Request().Context(), req.QualityProfileID)
 	if err != nil {
-		return c.JSON(http.StatusNotFound, map[string]string{
-			"error": "Quality profile not found",
-		})
+		return echo.NewHTTPError(http.StatusNotFound, "Quality profile not found")
 	}
 
 	criteria := h.toCriteria(&req)
@@ -161,9 +143,7 @@ func (h *Handlers) SearchTV(c echo.Context) error {
 
 	result, err := h.service.SearchTorrents(c.Request().Context(), criteria, params)
 	if err != nil {
-		return c.JSON(http.StatusInternalServerError, map[string]string{
-			"error": err.Error(),
-		})
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
 	}
 
 	return c.JSON(http.StatusOK, result)
@@ -175,24 +155,18 @@ func (h *Handlers) SearchTV(c echo.Context) error {
 func (h *Handlers) SearchTorrents(c echo.Context) error {
 	var req SearchRequest
 	if err := c.Bind(&req); err != nil {
-		return c.JSON(http.StatusBadRequest, map[string]string{
-			"error": "Invalid request parameters",
-		})
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid request parameters")
 	}
 
 	// Quality profile is required for scoring
 	if req.QualityProfileID == 0 {
-		return c.JSON(http.StatusBadRequest, map[string]string{
-			"error": "qualityProfileId is required",
-		})
+		return echo.NewHTTPError(http.StatusBadRequest, "qualityProfileId is required")
 	}
 
 	// Fetch the quality profile
 	profile, err := h.qualityService.Get(c.Request().Context(), req.QualityProfileID)
 	if err != nil {
-		return c.JSON(http.StatusNotFound, map[string]string{
-			"error": "Quality profile not found",
-		})
+		return echo.NewHTTPError(http.StatusNotFound, "Quality profile not found")
 	}
 
 	criteria := h.toCriteria(&req)
@@ -206,9 +180,7 @@ func (h *Handlers) SearchTorrents(c echo.Context) error {
 
 	result, err := h.service.SearchTorrents(c.Request().Context(), criteria, params)
 	if err != nil {
-		return c.JSON(http.StatusInternalServerError, map[string]string{
-			"error": err.Error(),
-		})
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
 	}
 
 	return c.JSON(http.StatusOK, result)
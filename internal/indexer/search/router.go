@@ -261,9 +261,12 @@ func (r *Router) scoreTorrents(torrents []types.TorrentInfo, params *ScoredSearc
 	// Build scoring context (Prowlarr results don't have indexer priorities)
 	scoringCtx := scoring.ScoringContext{
 		QualityProfile:    params.QualityProfile,
+		Qualities:         r.slipstreamService.qualities,
 		SearchYear:        params.SearchYear,
 		SearchSeason:      params.SearchSeason,
 		SearchEpisode:     params.SearchEpisode,
+		SearchAbsolute:    params.SearchAbsolute,
+		PreferredLanguage: params.PreferredLanguage,
 		IndexerPriorities: make(map[int64]int),
 		Now:               time.Now(),
 	}
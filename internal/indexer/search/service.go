@@ -5,7 +5,6 @@ import (
 	"context"
 	"fmt"
 	"sort"
-	"sync"
 	"time"
 
 	"github.com/rs/zerolog"
@@ -17,7 +16,9 @@ import (
 	"github.com/slipstream/slipstream/internal/indexer/status"
 	"github.com/slipstream/slipstream/internal/indexer/types"
 	"github.com/slipstream/slipstream/internal/library/quality"
+	"github.com/slipstream/slipstream/internal/logger"
 	"github.com/slipstream/slipstream/internal/module"
+	"github.com/slipstream/slipstream/internal/tags"
 )
 
 // Service orchestrates searches across multiple indexers.
@@ -27,6 +28,9 @@ type Service struct {
 	rateLimiter    *ratelimit.Limiter
 	broadcaster    contracts.Broadcaster
 	registry       *module.Registry
+	qualities      *quality.Registry
+	cache          *searchCache
+	indexerTimeout time.Duration
 	logger         *zerolog.Logger
 }
 
@@ -38,15 +42,29 @@ func NewService(indexerService *indexer.Service, logger *zerolog.Logger, statusS
 		statusService:  statusService,
 		rateLimiter:    rateLimiter,
 		broadcaster:    broadcaster,
+		cache:          newSearchCache(searchCacheTTL),
+		indexerTimeout: defaultIndexerTimeout,
 		logger:         &subLogger,
 	}
 }
 
+// log returns the service logger tagged with the correlation ID of the API
+// request ctx belongs to, if any.
+func (s *Service) log(ctx context.Context) *zerolog.Logger {
+	return logger.FromContext(ctx, s.logger)
+}
+
 // SetRegistry sets the module registry for module-aware indexer selection.
 func (s *Service) SetRegistry(r *module.Registry) {
 	s.registry = r
 }
 
+// SetQualities sets the quality definitions whose custom patterns are
+// matched when scoring releases.
+func (s *Service) SetQualities(qualities *quality.Registry) {
+	s.qualities = qualities
+}
+
 // SearchResult contains aggregated search results.
 type SearchResult struct {
 	Releases      []types.ReleaseInfo  `json:"releases"`
@@ -68,6 +86,7 @@ type SearchIndexerError struct {
 	IndexerID   int64  `json:"indexerId"`
 	IndexerName string `json:"indexerName"`
 	Error       string `json:"error"`
+	TimedOut    bool   `json:"timedOut,omitempty"`
 }
 
 // searchTaskResult represents the result from a single indexer search.
@@ -77,6 +96,7 @@ type searchTaskResult struct {
 	Releases    []types.ReleaseInfo
 	Torrents    []types.TorrentInfo
 	Error       error
+	TimedOut    bool
 }
 
 // Search executes a search across all enabled indexers.
@@ -91,8 +111,8 @@ func getIndexerIDs(indexers []*types.IndexerDefinition) []int64 {
 }
 
 // logSearchStart logs the start of a search operation
-func (s *Service) logSearchStart(indexers []*types.IndexerDefinition, criteria *types.SearchCriteria) {
-	s.logger.Info().
+func (s *Service) logSearchStart(ctx context.Context, indexers []*types.IndexerDefinition, criteria *types.SearchCriteria) {
+	s.log(ctx).Info().
 		Int("indexerCount", len(indexers)).
 		Str("query", criteria.Query).
 		Str("type", criteria.Type).
@@ -100,8 +120,8 @@ func (s *Service) logSearchStart(indexers []*types.IndexerDefinition, criteria *
 }
 
 // logSearchComplete logs the completion of a search operation
-func (s *Service) logSearchComplete(result *SearchResult) {
-	s.logger.Info().
+func (s *Service) logSearchComplete(ctx context.Context, result *SearchResult) {
+	s.log(ctx).Info().
 		Int("totalResults", result.TotalResults).
 		Int("indexersUsed", result.IndexersUsed).
 		Int("errors", len(result.IndexerErrors)).
@@ -126,13 +146,13 @@ func (s *Service) Search(ctx context.Context, criteria *types.SearchCriteria) (*
 
 	indexerIDs := getIndexerIDs(indexers)
 	s.broadcastSearchStarted(criteria, indexerIDs)
-	s.logSearchStart(indexers, criteria)
+	s.logSearchStart(ctx, indexers, criteria)
 
 	result := s.dispatchSearches(ctx, indexers, criteria)
 
 	elapsed := time.Since(startTime)
 	s.broadcastSearchCompleted(criteria, result, elapsed)
-	s.logSearchComplete(result)
+	s.logSearchComplete(ctx, result)
 
 	return result, nil
 }
@@ -188,7 +208,7 @@ func (s *Service) searchTorrentsInternal(ctx context.Context, criteria *types.Se
 		}, nil
 	}
 
-	s.logger.Info().
+	s.log(ctx).Info().
 		Int("indexerCount", len(indexers)).
 		Str("query", criteria.Query).
 		Str("type", criteria.Type).
@@ -200,7 +220,7 @@ func (s *Service) searchTorrentsInternal(ctx context.Context, criteria *types.Se
 	// Dispatch parallel searches
 	result := s.dispatchTorrentSearches(ctx, indexers, criteria)
 
-	s.logger.Info().
+	s.log(ctx).Info().
 		Int("totalResults", result.TotalResults).
 		Int("indexersUsed", result.IndexersUsed).
 		Int("errors", len(result.IndexerErrors)).
@@ -209,6 +229,44 @@ func (s *Service) searchTorrentsInternal(ctx context.Context, criteria *types.Se
 	return result, nil
 }
 
+// ProxySearchTorrents searches the given torrent indexers and returns the
+// deduplicated raw results without criteria filtering or scoring, leaving
+// both to the downstream consumer. It backs the Torznab proxy endpoint.
+func (s *Service) ProxySearchTorrents(ctx context.Context, indexers []*types.IndexerDefinition, criteria *types.SearchCriteria) *TorrentSearchResult {
+	indexers = s.filterBySearchSupport(indexers, criteria)
+	indexers = s.filterDisabledIndexers(ctx, indexers)
+	indexers = s.filterRateLimitedIndexers(ctx, indexers)
+
+	if len(indexers) == 0 {
+		return &TorrentSearchResult{
+			Releases:      []types.TorrentInfo{},
+			IndexerErrors: []SearchIndexerError{},
+		}
+	}
+
+	return s.aggregateTorrentResults(s.startTorrentSearches(ctx, indexers, criteria), nil)
+}
+
+// SearchTorrentsByName runs a plain text search for a release name on every
+// enabled torrent indexer except excludeIndexerID and returns the raw
+// results. It backs cross-seeding, which matches results on name and size.
+func (s *Service) SearchTorrentsByName(ctx context.Context, name string, excludeIndexerID int64) ([]types.TorrentInfo, error) {
+	indexers, err := s.indexerService.ListEnabledByProtocol(ctx, indexer.ProtocolTorrent)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get indexers: %w", err)
+	}
+
+	others := make([]*types.IndexerDefinition, 0, len(indexers))
+	for _, def := range indexers {
+		if def.ID != excludeIndexerID {
+			others = append(others, def)
+		}
+	}
+
+	result := s.ProxySearchTorrents(ctx, others, &types.SearchCriteria{Type: "search", Query: name})
+	return result.Releases, nil
+}
+
 // SearchMovies searches for movie releases.
 func (s *Service) SearchMovies(ctx context.Context, criteria *types.SearchCriteria) (*SearchResult, error) {
 	criteria.Type = searchTypeMovie
@@ -264,6 +322,11 @@ func (s *Service) getIndexersForSearch(ctx context.Context, criteria *types.Sear
 	// Filter by search support
 	indexers = s.filterBySearchSupport(indexers, criteria)
 
+	// Filter out indexers reserved for items with other tags
+	if criteria.Tags != nil {
+		indexers = filterByTags(indexers, criteria.Tags)
+	}
+
 	// Filter out disabled indexers (due to failures)
 	indexers = s.filterDisabledIndexers(ctx, indexers)
 
@@ -278,6 +341,17 @@ func (s *Service) getIndexersForSearch(ctx context.Context, criteria *types.Sear
 	return indexers, nil
 }
 
+// filterByTags filters indexers to those whose tags allow serving an item with itemTags.
+func filterByTags(indexers []*types.IndexerDefinition, itemTags []int64) []*types.IndexerDefinition {
+	filtered := make([]*types.IndexerDefinition, 0, len(indexers))
+	for _, idx := range indexers {
+		if tags.Matches(idx.Tags, itemTags) {
+			filtered = append(filtered, idx)
+		}
+	}
+	return filtered
+}
+
 // filterByModuleSupport filters indexers to those whose categories overlap with the module's category range.
 func filterByModuleSupport(indexers []*types.IndexerDefinition, moduleCategories []int) []*types.IndexerDefinition {
 	filtered := make([]*types.IndexerDefinition, 0, len(indexers))
@@ -299,13 +373,13 @@ func (s *Service) filterDisabledIndexers(ctx context.Context, indexers []*types.
 	for _, idx := range indexers {
 		disabled, disabledTill, err := s.statusService.IsDisabled(ctx, idx.ID)
 		if err != nil {
-			s.logger.Warn().Err(err).Int64("indexerId", idx.ID).Msg("Failed to check indexer status")
+			s.log(ctx).Warn().Err(err).Int64("indexerId", idx.ID).Msg("Failed to check indexer status")
 			filtered = append(filtered, idx)
 			continue
 		}
 
 		if disabled {
-			s.logger.Debug().
+			s.log(ctx).Debug().
 				Int64("indexerId", idx.ID).
 				Str("indexerName", idx.Name).
 				Time("disabledTill", *disabledTill).
@@ -329,13 +403,13 @@ func (s *Service) filterRateLimitedIndexers(ctx context.Context, indexers []*typ
 	for _, idx := range indexers {
 		limited, err := s.rateLimiter.CheckQueryLimit(ctx, idx.ID)
 		if err != nil {
-			s.logger.Warn().Err(err).Int64("indexerId", idx.ID).Msg("Failed to check rate limit")
+			s.log(ctx).Warn().Err(err).Int64("indexerId", idx.ID).Msg("Failed to check rate limit")
 			filtered = append(filtered, idx)
 			continue
 		}
 
 		if limited {
-			s.logger.Debug().
+			s.log(ctx).Debug().
 				Int64("indexerId", idx.ID).
 				Str("indexerName", idx.Name).
 				Msg("Skipping rate-limited indexer")
@@ -378,56 +452,60 @@ func (s *Service) filterBySearchSupport(indexers []*types.IndexerDefinition, cri
 
 // dispatchSearches runs searches in parallel across indexers.
 func (s *Service) dispatchSearches(ctx context.Context, indexers []*types.IndexerDefinition, criteria *types.SearchCriteria) *SearchResult {
-	var wg sync.WaitGroup
-	resultsChan := make(chan searchTaskResult, len(indexers))
-
-	// Set timeout for individual indexer searches
-	searchCtx, cancel := context.WithTimeout(ctx, 30*time.Second)
-	defer cancel()
-
-	for _, idx := range indexers {
-		wg.Add(1)
-		go func(def *types.IndexerDefinition) {
-			defer wg.Done()
-			result := s.searchIndexer(searchCtx, def, criteria)
-			resultsChan <- result
-		}(idx)
-	}
-
-	// Wait and collect results
-	go func() {
-		wg.Wait()
-		close(resultsChan)
-	}()
-
-	return s.aggregateResults(resultsChan)
+	return s.aggregateResults(s.fanOut(ctx, indexers, func(ctx context.Context, def *types.IndexerDefinition) searchTaskResult {
+		return s.searchIndexer(ctx, def, criteria)
+	}))
 }
 
 // dispatchTorrentSearches runs torrent searches in parallel across indexers.
 func (s *Service) dispatchTorrentSearches(ctx context.Context, indexers []*types.IndexerDefinition, criteria *types.SearchCriteria) *TorrentSearchResult {
-	var wg sync.WaitGroup
-	resultsChan := make(chan searchTaskResult, len(indexers))
+	return s.aggregateTorrentResults(s.startTorrentSearches(ctx, indexers, criteria), criteria)
+}
 
-	// Set timeout for individual indexer searches
-	searchCtx, cancel := context.WithTimeout(ctx, 30*time.Second)
-	defer cancel()
+// startTorrentSearches launches torrent searches in parallel and returns a channel
+// that is closed once every indexer has reported.
+func (s *Service) startTorrentSearches(ctx context.Context, indexers []*types.IndexerDefinition, criteria *types.SearchCriteria) <-chan searchTaskResult {
+	animeCriteria := buildAnimeAbsoluteCriteria(criteria)
 
-	for _, idx := range indexers {
-		wg.Add(1)
-		go func(def *types.IndexerDefinition) {
-			defer wg.Done()
-			result := s.searchIndexerTorrents(searchCtx, def, criteria)
-			resultsChan <- result
-		}(idx)
-	}
+	return s.fanOut(ctx, indexers, func(ctx context.Context, def *types.IndexerDefinition) searchTaskResult {
+		if animeCriteria == nil {
+			return s.searchIndexerTorrents(ctx, def, criteria)
+		}
+		return s.searchIndexerTorrentsWithAnime(ctx, def, criteria, animeCriteria)
+	})
+}
 
-	// Wait and collect results
+// searchIndexerTorrentsWithAnime runs the regular and the anime absolute
+// episode query against one indexer at the same time. Anime results are
+// added only when both succeed, matching a failed indexer's empty result.
+func (s *Service) searchIndexerTorrentsWithAnime(ctx context.Context, def *types.IndexerDefinition, criteria, animeCriteria *types.SearchCriteria) searchTaskResult {
+	extraCh := make(chan searchTaskResult, 1)
 	go func() {
-		wg.Wait()
-		close(resultsChan)
+		extraCh <- s.searchIndexerTorrents(ctx, def, animeCriteria)
 	}()
 
-	return s.aggregateTorrentResults(resultsChan, criteria)
+	result := s.searchIndexerTorrents(ctx, def, criteria)
+	extra := <-extraCh
+	if result.Error == nil && extra.Error == nil {
+		result.Torrents = append(result.Torrents, extra.Torrents...)
+	}
+	return result
+}
+
+// buildAnimeAbsoluteCriteria returns a text search in the anime category for
+// criteria carrying an absolute episode number, or nil. Anime releases are
+// mostly numbered "Title - 012", which a tvsearch by season/episode misses.
+func buildAnimeAbsoluteCriteria(criteria *types.SearchCriteria) *types.SearchCriteria {
+	if criteria == nil || criteria.AbsoluteEpisode <= 0 {
+		return nil
+	}
+	return &types.SearchCriteria{
+		ModuleType: criteria.ModuleType,
+		Query:      fmt.Sprintf("%s %02d", criteria.Query, criteria.AbsoluteEpisode),
+		Type:       "search",
+		Categories: []int{indexer.CategoryTVAnime},
+		Tags:       criteria.Tags,
+	}
 }
 
 // searchIndexer performs a search on a single indexer.
@@ -437,6 +515,13 @@ func (s *Service) searchIndexer(ctx context.Context, def *types.IndexerDefinitio
 		IndexerName: def.Name,
 	}
 
+	key := cacheKey("release", def.ID, criteria)
+	if releases, ok := s.cache.getReleases(key); ok {
+		result.Releases = releases
+		s.logCacheHit(def, len(releases))
+		return result
+	}
+
 	// Record the query in rate limiter
 	if s.rateLimiter != nil {
 		s.rateLimiter.RecordQuery(ctx, def.ID)
@@ -446,7 +531,7 @@ func (s *Service) searchIndexer(ctx context.Context, def *types.IndexerDefinitio
 	client, err := s.indexerService.GetClient(ctx, def.ID)
 	if err != nil {
 		result.Error = fmt.Errorf("failed to get client: %w", err)
-		s.logger.Error().
+		s.log(ctx).Error().
 			Err(err).
 			Int64("indexerId", def.ID).
 			Str("indexerName", def.Name).
@@ -459,10 +544,11 @@ func (s *Service) searchIndexer(ctx context.Context, def *types.IndexerDefinitio
 	start := time.Now()
 	releases, err := client.Search(ctx, criteria)
 	elapsed := time.Since(start)
+	s.recordQuery(ctx, def.ID, criteria, len(releases), elapsed, err)
 
 	if err != nil {
 		result.Error = fmt.Errorf("search failed: %w", err)
-		s.logger.Error().
+		s.log(ctx).Error().
 			Err(err).
 			Int64("indexerId", def.ID).
 			Str("indexerName", def.Name).
@@ -476,8 +562,9 @@ func (s *Service) searchIndexer(ctx context.Context, def *types.IndexerDefinitio
 	s.recordSuccess(ctx, def.ID)
 
 	result.Releases = releases
+	s.cache.putReleases(key, releases)
 
-	s.logger.Debug().
+	s.log(ctx).Debug().
 		Int64("indexerId", def.ID).
 		Str("indexerName", def.Name).
 		Int("results", len(releases)).
@@ -487,13 +574,39 @@ func (s *Service) searchIndexer(ctx context.Context, def *types.IndexerDefinitio
 	return result
 }
 
+// logCacheHit notes a search answered from the cache instead of the indexer.
+func (s *Service) logCacheHit(def *types.IndexerDefinition, results int) {
+	s.logger.Debug().
+		Int64("indexerId", def.ID).
+		Str("indexerName", def.Name).
+		Int("results", results).
+		Msg("Search served from cache")
+}
+
 // recordSuccess records a successful operation for an indexer.
 func (s *Service) recordSuccess(ctx context.Context, indexerID int64) {
 	if s.statusService == nil {
 		return
 	}
 	if err := s.statusService.RecordSuccess(ctx, indexerID); err != nil {
-		s.logger.Warn().Err(err).Int64("indexerId", indexerID).Msg("Failed to record success")
+		s.log(ctx).Warn().Err(err).Int64("indexerId", indexerID).Msg("Failed to record success")
+	}
+}
+
+// recordQuery stores a query and its outcome for indexer statistics.
+func (s *Service) recordQuery(ctx context.Context, indexerID int64, criteria *types.SearchCriteria, results int, elapsed time.Duration, queryErr error) {
+	if s.statusService == nil {
+		return
+	}
+	err := s.statusService.RecordQuery(ctx, indexerID, status.QueryResult{
+		Query:      criteria.Query,
+		Categories: criteria.Categories,
+		Results:    results,
+		Elapsed:    elapsed,
+		Err:        queryErr,
+	})
+	if err != nil {
+		s.log(ctx).Warn().Err(err).Int64("indexerId", indexerID).Msg("Failed to record query")
 	}
 }
 
@@ -503,7 +616,7 @@ func (s *Service) recordFailure(ctx context.Context, indexerID int64, opError er
 		return
 	}
 	if err := s.statusService.RecordFailure(ctx, indexerID, opError); err != nil {
-		s.logger.Warn().Err(err).Int64("indexerId", indexerID).Msg("Failed to record failure")
+		s.log(ctx).Warn().Err(err).Int64("indexerId", indexerID).Msg("Failed to record failure")
 	}
 }
 
@@ -514,6 +627,13 @@ func (s *Service) searchIndexerTorrents(ctx context.Context, def *types.IndexerD
 		IndexerName: def.Name,
 	}
 
+	key := cacheKey("torrent", def.ID, criteria)
+	if torrents, ok := s.cache.getTorrents(key); ok {
+		result.Torrents = torrents
+		s.logCacheHit(def, len(torrents))
+		return result
+	}
+
 	// Record the query in rate limiter
 	if s.rateLimiter != nil {
 		s.rateLimiter.RecordQuery(ctx, def.ID)
@@ -529,7 +649,7 @@ func (s *Service) searchIndexerTorrents(ctx context.Context, def *types.IndexerD
 	client, err := s.indexerService.GetClient(ctx, def.ID)
 	if err != nil {
 		result.Error = fmt.Errorf("failed to get client: %w", err)
-		s.logger.Error().
+		s.log(ctx).Error().
 			Err(err).
 			Int64("indexerId", def.ID).
 			Str("indexerName", def.Name).
@@ -549,10 +669,11 @@ func (s *Service) searchIndexerTorrents(ctx context.Context, def *types.IndexerD
 	start := time.Now()
 	torrents, err := torrentClient.SearchTorrents(ctx, criteria)
 	elapsed := time.Since(start)
+	s.recordQuery(ctx, def.ID, criteria, len(torrents), elapsed, err)
 
 	if err != nil {
 		result.Error = fmt.Errorf("search failed: %w", err)
-		s.logger.Error().
+		s.log(ctx).Error().
 			Err(err).
 			Int64("indexerId", def.ID).
 			Str("indexerName", def.Name).
@@ -566,8 +687,9 @@ func (s *Service) searchIndexerTorrents(ctx context.Context, def *types.IndexerD
 	s.recordSuccess(ctx, def.ID)
 
 	result.Torrents = torrents
+	s.cache.putTorrents(key, torrents)
 
-	s.logger.Debug().
+	s.log(ctx).Debug().
 		Int64("indexerId", def.ID).
 		Str("indexerName", def.Name).
 		Int("results", len(torrents)).
@@ -579,10 +701,12 @@ func (s *Service) searchIndexerTorrents(ctx context.Context, def *types.IndexerD
 
 // ScoredSearchParams contains parameters for scored search operations.
 type ScoredSearchParams struct {
-	QualityProfile *quality.Profile
-	SearchYear     int // Expected year for movies
-	SearchSeason   int // Expected season for TV
-	SearchEpisode  int // Expected episode for TV
+	QualityProfile    *quality.Profile
+	SearchYear        int    // Expected year for movies
+	SearchSeason      int    // Expected season for TV
+	SearchEpisode     int    // Expected episode for TV
+	SearchAbsolute    int    // Expected absolute episode for anime
+	PreferredLanguage string // Preferred audio language for scoring; empty means English
 }
 
 // SearchTorrents performs a torrent search and returns results scored by desirability.
@@ -602,16 +726,19 @@ func (s *Service) SearchTorrents(ctx context.Context, criteria *types.SearchCrit
 	// Build indexer priority map
 	indexerPriorities, err := s.getIndexerPriorities(ctx)
 	if err != nil {
-		s.logger.Warn().Err(err).Msg("Failed to get indexer priorities, using defaults")
+		s.log(ctx).Warn().Err(err).Msg("Failed to get indexer priorities, using defaults")
 		indexerPriorities = make(map[int64]int)
 	}
 
 	// Create scoring context
 	scoringCtx := scoring.ScoringContext{
 		QualityProfile:    params.QualityProfile,
+		Qualities:         s.qualities,
 		SearchYear:        params.SearchYear,
 		SearchSeason:      params.SearchSeason,
 		SearchEpisode:     params.SearchEpisode,
+		SearchAbsolute:    params.SearchAbsolute,
+		PreferredLanguage: params.PreferredLanguage,
 		IndexerPriorities: indexerPriorities,
 		Now:               time.Now(),
 	}
@@ -620,8 +747,13 @@ func (s *Service) SearchTorrents(ctx context.Context, criteria *types.SearchCrit
 	scorer := scoring.NewDefaultScorer()
 	scorer.ScoreTorrents(result.Releases, &scoringCtx)
 
-	s.logger.Debug().
+	beforeDedup := len(result.Releases)
+	result.Releases = dedupeAcrossIndexers(result.Releases)
+	result.TotalResults = len(result.Releases)
+
+	s.log(ctx).Debug().
 		Int("totalResults", len(result.Releases)).
+		Int("crossIndexerDuplicates", beforeDedup-len(result.Releases)).
 		Msg("Scored and sorted torrent search results")
 
 	return result, nil
@@ -9,6 +9,7 @@ import (
 func NormalizeTitle(title string) string { return titleutil.NormalizeTitle(title) }
 func TitlesMatch(a, b string) bool       { return titleutil.TitlesMatch(a, b) }
 func TVTitlesMatch(a, b string) bool     { return titleutil.TVTitlesMatch(a, b) }
+func YearsMatch(a, b int) bool           { return titleutil.YearsMatch(a, b) }
 
 // CalculateTitleSimilarity calculates the Jaccard similarity between two titles.
 // Returns a value between 0.0 (no match) and 1.0 (identical).
@@ -0,0 +1,80 @@
+package indexer
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/slipstream/slipstream/internal/database/sqlc"
+)
+
+// SeedGoal is the seed ratio and/or seed time an indexer requires before a
+// grabbed torrent may be removed from the download client. Either target
+// being reached satisfies the goal. A goal with neither target set defers to
+// the download client's own goal.
+type SeedGoal struct {
+	IndexerID             int64    `json:"indexerId"`
+	SeedRatioTarget       *float64 `json:"seedRatioTarget,omitempty"`
+	SeedTimeTargetMinutes *int     `json:"seedTimeTargetMinutes,omitempty"`
+}
+
+// GetSeedGoal returns the seed goal for an indexer. An indexer without a
+// stored goal returns an empty goal rather than an error.
+func (s *Service) GetSeedGoal(ctx context.Context, indexerID int64) (*SeedGoal, error) {
+	row, err := s.queries.GetIndexerSeedGoal(ctx, indexerID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return &SeedGoal{IndexerID: indexerID}, nil
+		}
+		return nil, fmt.Errorf("failed to get seed goal: %w", err)
+	}
+	return rowToSeedGoal(row), nil
+}
+
+// SetSeedGoal stores the seed goal for an indexer. Clearing both targets
+// removes the stored goal.
+func (s *Service) SetSeedGoal(ctx context.Context, goal *SeedGoal) (*SeedGoal, error) {
+	if goal.SeedRatioTarget != nil && *goal.SeedRatioTarget < 0 {
+		return nil, fmt.Errorf("%w: seed ratio target must not be negative", ErrInvalidIndexer)
+	}
+	if goal.SeedTimeTargetMinutes != nil && *goal.SeedTimeTargetMinutes < 0 {
+		return nil, fmt.Errorf("%w: seed time target must not be negative", ErrInvalidIndexer)
+	}
+
+	if goal.SeedRatioTarget == nil && goal.SeedTimeTargetMinutes == nil {
+		if err := s.queries.DeleteIndexerSeedGoal(ctx, goal.IndexerID); err != nil {
+			return nil, fmt.Errorf("failed to clear seed goal: %w", err)
+		}
+		return &SeedGoal{IndexerID: goal.IndexerID}, nil
+	}
+
+	params := sqlc.UpsertIndexerSeedGoalParams{IndexerID: goal.IndexerID}
+	if goal.SeedRatioTarget != nil {
+		params.SeedRatioTarget = sql.NullFloat64{Float64: *goal.SeedRatioTarget, Valid: true}
+	}
+	if goal.SeedTimeTargetMinutes != nil {
+		params.SeedTimeTargetMinutes = sql.NullInt64{Int64: int64(*goal.SeedTimeTargetMinutes), Valid: true}
+	}
+
+	row, err := s.queries.UpsertIndexerSeedGoal(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to save seed goal: %w", err)
+	}
+
+	s.logger.Info().Int64("indexerId", goal.IndexerID).Msg("Updated indexer seed goal")
+	return rowToSeedGoal(row), nil
+}
+
+func rowToSeedGoal(row *sqlc.IndexerSeedGoal) *SeedGoal {
+	goal := &SeedGoal{IndexerID: row.IndexerID}
+	if row.SeedRatioTarget.Valid {
+		ratio := row.SeedRatioTarget.Float64
+		goal.SeedRatioTarget = &ratio
+	}
+	if row.SeedTimeTargetMinutes.Valid {
+		mins := int(row.SeedTimeTargetMinutes.Int64)
+		goal.SeedTimeTargetMinutes = &mins
+	}
+	return goal
+}
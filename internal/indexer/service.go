@@ -14,6 +14,7 @@ import (
 	"github.com/slipstream/slipstream/internal/indexer/cardigann"
 	"github.com/slipstream/slipstream/internal/indexer/genericrss"
 	indexermock "github.com/slipstream/slipstream/internal/indexer/mock"
+	"github.com/slipstream/slipstream/internal/tags"
 )
 
 // MockDefinitionID is the special definition ID for mock indexers.
@@ -31,6 +32,7 @@ type Service struct {
 	manager       *cardigann.Manager
 	logger        *zerolog.Logger
 	healthService contracts.HealthService
+	tagStore      contracts.TagStore
 }
 
 // NewService creates a new indexer service.
@@ -50,6 +52,11 @@ func (s *Service) SetDB(db *sql.DB) {
 	s.queries = sqlc.New(db)
 }
 
+// SetTagStore sets the store indexer tags are read from and written to.
+func (s *Service) SetTagStore(store contracts.TagStore) {
+	s.tagStore = store
+}
+
 // InitializeDefinitions fetches the latest indexer definitions from the remote repository.
 // This is called during startup with network retry logic.
 func (s *Service) InitializeDefinitions(ctx context.Context) error {
@@ -87,7 +94,7 @@ func (s *Service) Get(ctx context.Context, id int64) (*IndexerDefinition, error)
 		}
 		return nil, fmt.Errorf("failed to get indexer: %w", err)
 	}
-	return s.rowToDefinition(row), nil
+	return s.toDefinitions(ctx, []*sqlc.Indexer{row})[0], nil
 }
 
 // List returns all indexers.
@@ -97,11 +104,7 @@ func (s *Service) List(ctx context.Context) ([]*IndexerDefinition, error) {
 		return nil, fmt.Errorf("failed to list indexers: %w", err)
 	}
 
-	indexers := make([]*IndexerDefinition, 0, len(rows))
-	for _, row := range rows {
-		indexers = append(indexers, s.rowToDefinition(row))
-	}
-	return indexers, nil
+	return s.toDefinitions(ctx, rows), nil
 }
 
 // ListEnabled returns all enabled indexers.
@@ -111,11 +114,7 @@ func (s *Service) ListEnabled(ctx context.Context) ([]*IndexerDefinition, error)
 		return nil, fmt.Errorf("failed to list enabled indexers: %w", err)
 	}
 
-	indexers := make([]*IndexerDefinition, 0, len(rows))
-	for _, row := range rows {
-		indexers = append(indexers, s.rowToDefinition(row))
-	}
-	return indexers, nil
+	return s.toDefinitions(ctx, rows), nil
 }
 
 // ListEnabledForMovies returns all enabled indexers that support movies.
@@ -125,11 +124,7 @@ func (s *Service) ListEnabledForMovies(ctx context.Context) ([]*IndexerDefinitio
 		return nil, fmt.Errorf("failed to list movie indexers: %w", err)
 	}
 
-	indexers := make([]*IndexerDefinition, 0, len(rows))
-	for _, row := range rows {
-		indexers = append(indexers, s.rowToDefinition(row))
-	}
-	return indexers, nil
+	return s.toDefinitions(ctx, rows), nil
 }
 
 // ListEnabledForTV returns all enabled indexers that support TV shows.
@@ -139,11 +134,7 @@ func (s *Service) ListEnabledForTV(ctx context.Context) ([]*IndexerDefinition, e
 		return nil, fmt.Errorf("failed to list TV indexers: %w", err)
 	}
 
-	indexers := make([]*IndexerDefinition, 0, len(rows))
-	for _, row := range rows {
-		indexers = append(indexers, s.rowToDefinition(row))
-	}
-	return indexers, nil
+	return s.toDefinitions(ctx, rows), nil
 }
 
 // ListEnabledByProtocol returns all enabled indexers with the specified protocol.
@@ -175,6 +166,9 @@ type CreateIndexerInput struct {
 	Enabled           bool            `json:"enabled"`
 	AutoSearchEnabled *bool           `json:"autoSearchEnabled,omitempty"`
 	RssEnabled        *bool           `json:"rssEnabled,omitempty"`
+	ProxyURL          string          `json:"proxyUrl,omitempty"`
+	FlareSolverrURL   string          `json:"flareSolverrUrl,omitempty"`
+	Tags              []int64         `json:"tags,omitempty"`
 }
 
 // UpdateIndexerInput is the input for updating an indexer (all fields optional for partial updates).
@@ -189,6 +183,9 @@ type UpdateIndexerInput struct {
 	Enabled           *bool           `json:"enabled,omitempty"`
 	AutoSearchEnabled *bool           `json:"autoSearchEnabled,omitempty"`
 	RssEnabled        *bool           `json:"rssEnabled,omitempty"`
+	ProxyURL          *string         `json:"proxyUrl,omitempty"`
+	FlareSolverrURL   *string         `json:"flareSolverrUrl,omitempty"`
+	Tags              *[]int64        `json:"tags,omitempty"`
 }
 
 // Create creates a new indexer.
@@ -222,7 +219,13 @@ func (s *Service) Create(ctx context.Context, input *CreateIndexerInput) (*Index
 		s.healthService.RegisterItemStr("indexers", fmt.Sprintf("%d", row.ID), input.Name)
 	}
 
-	return s.rowToDefinition(row), nil
+	if len(input.Tags) > 0 {
+		if err := s.saveTags(ctx, row.ID, input.Tags); err != nil {
+			s.logger.Warn().Err(err).Int64("id", row.ID).Msg("Failed to tag indexer")
+		}
+	}
+
+	return s.toDefinitions(ctx, []*sqlc.Indexer{row})[0], nil
 }
 
 func (s *Service) validateDefinition(definitionID string) error {
@@ -254,6 +257,8 @@ func (s *Service) buildCreateParams(input *CreateIndexerInput) (sqlc.CreateIndex
 		Enabled:           input.Enabled,
 		AutoSearchEnabled: optBool(input.AutoSearchEnabled, true),
 		RssEnabled:        optBool(input.RssEnabled, true),
+		ProxyUrl:          input.ProxyURL,
+		FlaresolverrUrl:   input.FlareSolverrURL,
 	}, nil
 }
 
@@ -298,8 +303,14 @@ func (s *Service) Update(ctx context.Context, id int64, input *UpdateIndexerInpu
 	name := optStr(input.Name, existing.Name)
 	s.updateHealthRegistration(id, name, enabled, existing.Enabled)
 
+	if input.Tags != nil {
+		if err := s.saveTags(ctx, id, *input.Tags); err != nil {
+			return nil, err
+		}
+	}
+
 	s.logger.Info().Int64("id", id).Str("name", name).Msg("Updated indexer")
-	return s.rowToDefinition(row), nil
+	return s.toDefinitions(ctx, []*sqlc.Indexer{row})[0], nil
 }
 
 func (s *Service) buildUpdateParams(id int64, existing *IndexerDefinition, input *UpdateIndexerInput) (sqlc.UpdateIndexerParams, error) {
@@ -317,6 +328,12 @@ func (s *Service) buildUpdateParams(id int64, existing *IndexerDefinition, input
 		return sqlc.UpdateIndexerParams{}, err
 	}
 
+	proxyURL := optStr(input.ProxyURL, existing.ProxyURL)
+	flareSolverrURL := optStr(input.FlareSolverrURL, existing.FlareSolverrURL)
+	if err := validateProxy(proxyURL, flareSolverrURL); err != nil {
+		return sqlc.UpdateIndexerParams{}, err
+	}
+
 	categories := existing.Categories
 	if input.Categories != nil {
 		categories = input.Categories
@@ -340,6 +357,8 @@ func (s *Service) buildUpdateParams(id int64, existing *IndexerDefinition, input
 		Enabled:           optBool(input.Enabled, existing.Enabled),
 		AutoSearchEnabled: optBool(input.AutoSearchEnabled, existing.AutoSearchEnabled),
 		RssEnabled:        optBool(input.RssEnabled, existing.RssEnabled),
+		ProxyUrl:          proxyURL,
+		FlaresolverrUrl:   flareSolverrURL,
 	}, nil
 }
 
@@ -427,15 +446,19 @@ func (s *Service) Test(ctx context.Context, id int64) (*TestResult, error) {
 	}
 
 	return s.TestConfig(ctx, TestConfigInput{
-		DefinitionID: indexer.DefinitionID,
-		Settings:     indexer.Settings,
+		DefinitionID:    indexer.DefinitionID,
+		Settings:        indexer.Settings,
+		ProxyURL:        indexer.ProxyURL,
+		FlareSolverrURL: indexer.FlareSolverrURL,
 	})
 }
 
 // TestConfigInput is the input for testing an indexer configuration.
 type TestConfigInput struct {
-	DefinitionID string          `json:"definitionId"`
-	Settings     json.RawMessage `json:"settings"`
+	DefinitionID    string          `json:"definitionId"`
+	Settings        json.RawMessage `json:"settings"`
+	ProxyURL        string          `json:"proxyUrl,omitempty"`
+	FlareSolverrURL string          `json:"flareSolverrUrl,omitempty"`
 }
 
 // TestConfig tests an indexer configuration without saving.
@@ -485,8 +508,25 @@ func (s *Service) TestConfig(ctx context.Context, input TestConfigInput) (*TestR
 		}
 	}
 
+	if err := validateProxy(input.ProxyURL, input.FlareSolverrURL); err != nil {
+		return &TestResult{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	if input.FlareSolverrURL != "" {
+		if err := cardigann.PingFlareSolverr(ctx, input.FlareSolverrURL); err != nil {
+			return &TestResult{
+				Success: false,
+				Message: fmt.Sprintf("Connection test failed: %s", err.Error()),
+			}, nil
+		}
+	}
+
 	// Test the definition
-	if err := s.manager.TestDefinition(ctx, input.DefinitionID, settings); err != nil {
+	proxy := cardigann.ProxyConfig{ProxyURL: input.ProxyURL, FlareSolverrURL: input.FlareSolverrURL}
+	if err := s.manager.TestDefinition(ctx, input.DefinitionID, settings, proxy); err != nil {
 		return &TestResult{
 			Success: false,
 			Message: fmt.Sprintf("Connection test failed: %s", err.Error()),
@@ -553,7 +593,8 @@ func (s *Service) getOrCreateCardigannClient(id int64, indexer *IndexerDefinitio
 		return nil, err
 	}
 
-	client, err := s.manager.CreateClientFromDefinition(indexer.DefinitionID, indexer.ID, indexer.Name, settings)
+	proxy := cardigann.ProxyConfig{ProxyURL: indexer.ProxyURL, FlareSolverrURL: indexer.FlareSolverrURL}
+	client, err := s.manager.CreateClientFromDefinition(indexer.DefinitionID, indexer.ID, indexer.Name, settings, proxy)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create client: %w", err)
 	}
@@ -631,10 +672,60 @@ func (s *Service) validateInput(input *CreateIndexerInput) error {
 	if input.DefinitionID == "" {
 		return fmt.Errorf("%w: definition ID is required", ErrInvalidIndexer)
 	}
+	return validateProxy(input.ProxyURL, input.FlareSolverrURL)
+}
+
+// validateProxy checks the optional per-indexer proxy and FlareSolverr URLs.
+func validateProxy(proxyURL, flareSolverrURL string) error {
+	if proxyURL != "" {
+		if err := cardigann.ValidateProxyURL(proxyURL); err != nil {
+			return fmt.Errorf("%w: %w", ErrInvalidIndexer, err)
+		}
+	}
+	if flareSolverrURL != "" {
+		if err := cardigann.ValidateFlareSolverrURL(flareSolverrURL); err != nil {
+			return fmt.Errorf("%w: %w", ErrInvalidIndexer, err)
+		}
+	}
 	return nil
 }
 
 // rowToDefinition converts a database row to an IndexerDefinition.
+// toDefinitions converts rows to indexer definitions with their tags attached.
+// Indexers are left untagged if no tag store is configured or the lookup fails.
+func (s *Service) toDefinitions(ctx context.Context, rows []*sqlc.Indexer) []*IndexerDefinition {
+	indexers := make([]*IndexerDefinition, len(rows))
+	for i, row := range rows {
+		indexers[i] = s.rowToDefinition(row)
+		indexers[i].Tags = []int64{}
+	}
+	if s.tagStore == nil || len(rows) == 0 {
+		return indexers
+	}
+	tagMap, err := s.tagStore.EntityTagMap(ctx, tags.EntityIndexer)
+	if err != nil {
+		s.logger.Warn().Err(err).Msg("Failed to load indexer tags")
+		return indexers
+	}
+	for _, idx := range indexers {
+		if ids, ok := tagMap[idx.ID]; ok {
+			idx.Tags = ids
+		}
+	}
+	return indexers
+}
+
+// saveTags replaces an indexer's tags.
+func (s *Service) saveTags(ctx context.Context, id int64, tagIDs []int64) error {
+	if s.tagStore == nil {
+		return nil
+	}
+	if err := s.tagStore.SetEntityTags(ctx, tags.EntityIndexer, id, tagIDs); err != nil {
+		return fmt.Errorf("failed to set indexer tags: %w", err)
+	}
+	return nil
+}
+
 func (s *Service) rowToDefinition(row *sqlc.Indexer) *IndexerDefinition {
 	def := &IndexerDefinition{
 		ID:                row.ID,
@@ -646,6 +737,8 @@ func (s *Service) rowToDefinition(row *sqlc.Indexer) *IndexerDefinition {
 		Enabled:           row.Enabled,
 		AutoSearchEnabled: row.AutoSearchEnabled,
 		RssEnabled:        row.RssEnabled,
+		ProxyURL:          row.ProxyUrl,
+		FlareSolverrURL:   row.FlaresolverrUrl,
 		Categories:        []int{},
 	}
 
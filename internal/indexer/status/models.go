@@ -59,6 +59,14 @@ type BackoffConfig struct {
 	Multiplier float64
 	// MaxEscalation is the maximum escalation level
 	MaxEscalation int
+	// FailureRateWindow is how far back query results count towards the failure rate
+	FailureRateWindow time.Duration
+	// FailureRateThreshold is the failure rate at which a success no longer resets the backoff
+	FailureRateThreshold float64
+	// FailureRateMinQueries is the number of queries required before the failure rate applies
+	FailureRateMinQueries int
+	// HistoryRetention is how long query and grab history is kept
+	HistoryRetention time.Duration
 }
 
 // DefaultBackoffConfig returns the default backoff configuration.
@@ -68,6 +76,11 @@ func DefaultBackoffConfig() BackoffConfig {
 		MaxBackoff:     3 * time.Hour,
 		Multiplier:     2.0,
 		MaxEscalation:  5,
+
+		FailureRateWindow:     24 * time.Hour,
+		FailureRateThreshold:  0.5,
+		FailureRateMinQueries: 10,
+		HistoryRetention:      30 * 24 * time.Hour,
 	}
 }
 
@@ -87,6 +100,22 @@ type StatusStats struct {
 	DisabledIndexers int `json:"disabledIndexers"`
 }
 
+// IndexerStats summarizes an indexer's query and grab activity over a period.
+type IndexerStats struct {
+	IndexerID       int64      `json:"indexerId"`
+	IndexerName     string     `json:"indexerName"`
+	Queries         int64      `json:"queries"`
+	FailedQueries   int64      `json:"failedQueries"`
+	FailureRate     float64    `json:"failureRate"`
+	AvgResponseMs   float64    `json:"avgResponseMs"`
+	Grabs           int64      `json:"grabs"`
+	FailedGrabs     int64      `json:"failedGrabs"`
+	QuarantinedRows int64      `json:"quarantinedRows"`
+	EscalationLevel int        `json:"escalationLevel"`
+	DisabledTill    *time.Time `json:"disabledTill,omitempty"`
+	IsDisabled      bool       `json:"isDisabled"`
+}
+
 // CookieData contains cached session cookies for an indexer.
 type CookieData struct {
 	Cookies   string     // Serialized cookie string (name=value; name2=value2)
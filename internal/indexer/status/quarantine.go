@@ -0,0 +1,97 @@
+package status
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/slipstream/slipstream/internal/database/sqlc"
+)
+
+// maxQuarantineListLimit caps how many quarantined rows are returned at once.
+const maxQuarantineListLimit = 200
+
+// QuarantinedRow is a malformed search result row kept for inspection.
+type QuarantinedRow struct {
+	ID          int64      `json:"id"`
+	IndexerID   int64      `json:"indexerId"`
+	Query       string     `json:"query,omitempty"`
+	Reason      string     `json:"reason"`
+	RawFragment string     `json:"rawFragment"`
+	CreatedAt   *time.Time `json:"createdAt,omitempty"`
+}
+
+// RecordQuarantinedRows stores malformed rows returned by an indexer query.
+func (s *Service) RecordQuarantinedRows(ctx context.Context, indexerID int64, query string, rows []QuarantinedRow) error {
+	// In Prowlarr mode, indexer IDs come from Prowlarr and don't exist in the local database.
+	if len(rows) == 0 || !s.indexerExists(ctx, indexerID) {
+		return nil
+	}
+
+	for i := range rows {
+		err := s.queries.CreateIndexerQuarantinedRow(ctx, sqlc.CreateIndexerQuarantinedRowParams{
+			IndexerID:   indexerID,
+			Query:       sql.NullString{String: query, Valid: query != ""},
+			Reason:      rows[i].Reason,
+			RawFragment: rows[i].RawFragment,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to record quarantined row: %w", err)
+		}
+	}
+	return nil
+}
+
+// ListQuarantinedRows returns the most recent quarantined rows for an indexer.
+func (s *Service) ListQuarantinedRows(ctx context.Context, indexerID int64, limit int) ([]*QuarantinedRow, error) {
+	if limit <= 0 || limit > maxQuarantineListLimit {
+		limit = maxQuarantineListLimit
+	}
+
+	rows, err := s.queries.ListIndexerQuarantinedRows(ctx, sqlc.ListIndexerQuarantinedRowsParams{
+		IndexerID: indexerID,
+		Limit:     int64(limit),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list quarantined rows: %w", err)
+	}
+
+	result := make([]*QuarantinedRow, 0, len(rows))
+	for _, row := range rows {
+		q := &QuarantinedRow{
+			ID:          row.ID,
+			IndexerID:   row.IndexerID,
+			Query:       row.Query.String,
+			Reason:      row.Reason,
+			RawFragment: row.RawFragment,
+		}
+		if row.CreatedAt.Valid {
+			q.CreatedAt = &row.CreatedAt.Time
+		}
+		result = append(result, q)
+	}
+	return result, nil
+}
+
+// ClearQuarantinedRows deletes all quarantined rows for an indexer.
+func (s *Service) ClearQuarantinedRows(ctx context.Context, indexerID int64) error {
+	if err := s.queries.DeleteIndexerQuarantinedRows(ctx, indexerID); err != nil {
+		return fmt.Errorf("failed to clear quarantined rows: %w", err)
+	}
+	return nil
+}
+
+// countQuarantinedRows returns the number of rows quarantined per indexer since the given time.
+func (s *Service) countQuarantinedRows(ctx context.Context, since time.Time) (map[int64]int64, error) {
+	rows, err := s.queries.CountIndexerQuarantinedRows(ctx, sql.NullTime{Time: since.UTC(), Valid: true})
+	if err != nil {
+		return nil, fmt.Errorf("failed to count quarantined rows: %w", err)
+	}
+
+	counts := make(map[int64]int64, len(rows))
+	for _, row := range rows {
+		counts[row.IndexerID] = row.Quarantined
+	}
+	return counts, nil
+}
@@ -98,6 +98,17 @@ func (s *Service) RecordSuccess(ctx context.Context, indexerID int64) error {
 		return nil
 	}
 
+	// An indexer that fails most of its queries keeps its escalation level so the
+	// next failure backs off further instead of starting over.
+	failing, err := s.isFailingConsistently(ctx, indexerID)
+	if err != nil {
+		return err
+	}
+	if failing {
+		s.logger.Debug().Int64("indexerId", indexerID).Msg("Indexer is failing consistently, keeping backoff escalation")
+		return nil
+	}
+
 	// Clear any existing failure state
 	if err := s.queries.ClearIndexerFailure(ctx, indexerID); err != nil {
 		return fmt.Errorf("failed to clear failure state: %w", err)
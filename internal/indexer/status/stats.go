@@ -0,0 +1,128 @@
+package status
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/slipstream/slipstream/internal/database/sqlc"
+)
+
+// QueryResult describes the outcome of a single indexer query.
+type QueryResult struct {
+	Query      string
+	Categories []int
+	Results    int
+	Elapsed    time.Duration
+	Err        error
+}
+
+// RecordQuery stores a query in the indexer history for statistics.
+func (s *Service) RecordQuery(ctx context.Context, indexerID int64, result QueryResult) error {
+	// In Prowlarr mode, indexer IDs come from Prowlarr and don't exist in the local database.
+	if !s.indexerExists(ctx, indexerID) {
+		return nil
+	}
+
+	data := sql.NullString{}
+	if result.Err != nil {
+		data = sql.NullString{String: fmt.Sprintf(`{"error":%q}`, result.Err.Error()), Valid: true}
+	}
+
+	_, err := s.queries.CreateIndexerHistoryEvent(ctx, sqlc.CreateIndexerHistoryEventParams{
+		IndexerID:    indexerID,
+		EventType:    "query",
+		Successful:   result.Err == nil,
+		Query:        sql.NullString{String: result.Query, Valid: result.Query != ""},
+		Categories:   joinCategories(result.Categories),
+		ResultsCount: sql.NullInt64{Int64: int64(result.Results), Valid: true},
+		ElapsedMs:    sql.NullInt64{Int64: result.Elapsed.Milliseconds(), Valid: true},
+		Data:         data,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to record query: %w", err)
+	}
+	return nil
+}
+
+func joinCategories(categories []int) sql.NullString {
+	if len(categories) == 0 {
+		return sql.NullString{}
+	}
+	parts := make([]string, len(categories))
+	for i, c := range categories {
+		parts[i] = strconv.Itoa(c)
+	}
+	return sql.NullString{String: strings.Join(parts, ","), Valid: true}
+}
+
+// isFailingConsistently reports whether enough of the indexer's recent queries
+// failed that a single success should not reset its backoff.
+func (s *Service) isFailingConsistently(ctx context.Context, indexerID int64) (bool, error) {
+	counts, err := s.queries.CountIndexerQueryResults(ctx, sqlc.CountIndexerQueryResultsParams{
+		IndexerID: indexerID,
+		CreatedAt: sql.NullTime{Time: time.Now().Add(-s.config.FailureRateWindow).UTC(), Valid: true},
+	})
+	if err != nil {
+		return false, fmt.Errorf("failed to count queries: %w", err)
+	}
+	if counts.Total < int64(s.config.FailureRateMinQueries) {
+		return false, nil
+	}
+	return float64(counts.Failed)/float64(counts.Total) >= s.config.FailureRateThreshold, nil
+}
+
+// GetIndexerStats returns query and grab statistics for every indexer since the given time.
+func (s *Service) GetIndexerStats(ctx context.Context, since time.Time) ([]*IndexerStats, error) {
+	rows, err := s.queries.ListIndexerStats(ctx, sql.NullTime{Time: since.UTC(), Valid: true})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list indexer stats: %w", err)
+	}
+
+	quarantined, err := s.countQuarantinedRows(ctx, since)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	stats := make([]*IndexerStats, 0, len(rows))
+	for _, row := range rows {
+		st := &IndexerStats{
+			IndexerID:       row.IndexerID,
+			IndexerName:     row.IndexerName,
+			Queries:         row.TotalQueries,
+			FailedQueries:   row.FailedQueries,
+			AvgResponseMs:   row.AvgResponseMs,
+			Grabs:           row.TotalGrabs,
+			FailedGrabs:     row.FailedGrabs,
+			QuarantinedRows: quarantined[row.IndexerID],
+			EscalationLevel: int(row.EscalationLevel.Int64),
+		}
+		if row.TotalQueries > 0 {
+			st.FailureRate = float64(row.FailedQueries) / float64(row.TotalQueries)
+		}
+		if row.DisabledTill.Valid && row.DisabledTill.Time.After(now) {
+			st.DisabledTill = &row.DisabledTill.Time
+			st.IsDisabled = true
+		}
+		stats = append(stats, st)
+	}
+	return stats, nil
+}
+
+// PruneHistory deletes query and grab history, and quarantined result rows,
+// older than the retention period.
+func (s *Service) PruneHistory(ctx context.Context) error {
+	cutoff := time.Now().Add(-s.config.HistoryRetention).UTC()
+	if err := s.queries.DeleteOldIndexerHistory(ctx, sql.NullTime{Time: cutoff, Valid: true}); err != nil {
+		return fmt.Errorf("failed to prune indexer history: %w", err)
+	}
+	if err := s.queries.DeleteOldIndexerQuarantinedRows(ctx, sql.NullTime{Time: cutoff, Valid: true}); err != nil {
+		return fmt.Errorf("failed to prune quarantined rows: %w", err)
+	}
+	s.logger.Info().Time("cutoff", cutoff).Msg("Pruned indexer history")
+	return nil
+}
@@ -0,0 +1,162 @@
+package status
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/slipstream/slipstream/internal/database/sqlc"
+	"github.com/slipstream/slipstream/internal/testutil"
+)
+
+func createTestIndexer(t *testing.T, tdb *testutil.TestDB) int64 {
+	t.Helper()
+	idx, err := sqlc.New(tdb.Conn).CreateIndexer(context.Background(), sqlc.CreateIndexerParams{
+		Name:           "Test Indexer",
+		DefinitionID:   "test",
+		SupportsMovies: true,
+		Priority:       25,
+		Enabled:        true,
+	})
+	if err != nil {
+		t.Fatalf("CreateIndexer() error = %v", err)
+	}
+	return idx.ID
+}
+
+func TestService_GetIndexerStats(t *testing.T) {
+	tdb := testutil.NewTestDB(t)
+	defer tdb.Close()
+
+	service := NewService(tdb.Conn, &tdb.Logger, nil)
+	ctx := context.Background()
+	id := createTestIndexer(t, tdb)
+
+	results := []QueryResult{
+		{Query: "a", Results: 5, Elapsed: 100 * time.Millisecond},
+		{Query: "b", Results: 3, Elapsed: 300 * time.Millisecond},
+		{Query: "c", Elapsed: 5 * time.Second, Err: errors.New("timeout")},
+	}
+	for _, r := range results {
+		if err := service.RecordQuery(ctx, id, r); err != nil {
+			t.Fatalf("RecordQuery() error = %v", err)
+		}
+	}
+
+	stats, err := service.GetIndexerStats(ctx, time.Now().Add(-time.Hour))
+	if err != nil {
+		t.Fatalf("GetIndexerStats() error = %v", err)
+	}
+	if len(stats) != 1 {
+		t.Fatalf("GetIndexerStats() returned %d indexers, want 1", len(stats))
+	}
+	st := stats[0]
+	if st.Queries != 3 || st.FailedQueries != 1 {
+		t.Errorf("queries = %d, failed = %d, want 3 and 1", st.Queries, st.FailedQueries)
+	}
+	if st.AvgResponseMs != 200 {
+		t.Errorf("AvgResponseMs = %v, want 200 (failed queries excluded)", st.AvgResponseMs)
+	}
+	if st.FailureRate < 0.33 || st.FailureRate > 0.34 {
+		t.Errorf("FailureRate = %v, want 1/3", st.FailureRate)
+	}
+}
+
+func TestService_RecordSuccessKeepsBackoffWhenFailingConsistently(t *testing.T) {
+	tdb := testutil.NewTestDB(t)
+	defer tdb.Close()
+
+	config := DefaultBackoffConfig()
+	config.FailureRateMinQueries = 4
+	service := NewServiceWithConfig(tdb.Conn, config, &tdb.Logger, nil)
+	ctx := context.Background()
+	id := createTestIndexer(t, tdb)
+
+	queryErr := errors.New("connection refused")
+	for range 3 {
+		if err := service.RecordQuery(ctx, id, QueryResult{Err: queryErr}); err != nil {
+			t.Fatalf("RecordQuery() error = %v", err)
+		}
+		if err := service.RecordFailure(ctx, id, queryErr); err != nil {
+			t.Fatalf("RecordFailure() error = %v", err)
+		}
+	}
+	if err := service.RecordQuery(ctx, id, QueryResult{Results: 1}); err != nil {
+		t.Fatalf("RecordQuery() error = %v", err)
+	}
+	if err := service.RecordSuccess(ctx, id); err != nil {
+		t.Fatalf("RecordSuccess() error = %v", err)
+	}
+
+	st, err := service.GetStatus(ctx, id)
+	if err != nil {
+		t.Fatalf("GetStatus() error = %v", err)
+	}
+	if st.EscalationLevel != 3 {
+		t.Errorf("EscalationLevel = %d, want 3 to be kept", st.EscalationLevel)
+	}
+
+	for range 4 {
+		if err := service.RecordQuery(ctx, id, QueryResult{Results: 1}); err != nil {
+			t.Fatalf("RecordQuery() error = %v", err)
+		}
+	}
+	if err := service.RecordSuccess(ctx, id); err != nil {
+		t.Fatalf("RecordSuccess() error = %v", err)
+	}
+	st, err = service.GetStatus(ctx, id)
+	if err != nil {
+		t.Fatalf("GetStatus() error = %v", err)
+	}
+	if st.EscalationLevel != 0 {
+		t.Errorf("EscalationLevel = %d, want reset once the failure rate drops", st.EscalationLevel)
+	}
+}
+
+func TestService_QuarantinedRows(t *testing.T) {
+	tdb := testutil.NewTestDB(t)
+	defer tdb.Close()
+
+	service := NewService(tdb.Conn, &tdb.Logger, nil)
+	ctx := context.Background()
+	id := createTestIndexer(t, tdb)
+
+	rows := []QuarantinedRow{
+		{Reason: "missing title", RawFragment: "<tr><td></td></tr>"},
+		{Reason: `unparsable size "N/A"`, RawFragment: "<tr><td>N/A</td></tr>"},
+	}
+	if err := service.RecordQuarantinedRows(ctx, id, "dark", rows); err != nil {
+		t.Fatalf("RecordQuarantinedRows() error = %v", err)
+	}
+	// Unknown indexers (e.g. Prowlarr mode) are ignored.
+	if err := service.RecordQuarantinedRows(ctx, 9999, "dark", rows); err != nil {
+		t.Fatalf("RecordQuarantinedRows(unknown) error = %v", err)
+	}
+
+	listed, err := service.ListQuarantinedRows(ctx, id, 10)
+	if err != nil {
+		t.Fatalf("ListQuarantinedRows() error = %v", err)
+	}
+	if len(listed) != 2 {
+		t.Fatalf("ListQuarantinedRows() returned %d rows, want 2", len(listed))
+	}
+	if listed[0].Query != "dark" || listed[0].RawFragment == "" {
+		t.Errorf("listed[0] = %+v, want query and raw fragment", listed[0])
+	}
+
+	stats, err := service.GetIndexerStats(ctx, time.Now().Add(-time.Hour))
+	if err != nil {
+		t.Fatalf("GetIndexerStats() error = %v", err)
+	}
+	if len(stats) != 1 || stats[0].QuarantinedRows != 2 {
+		t.Errorf("QuarantinedRows = %+v, want 2", stats)
+	}
+
+	if err := service.ClearQuarantinedRows(ctx, id); err != nil {
+		t.Fatalf("ClearQuarantinedRows() error = %v", err)
+	}
+	if listed, _ := service.ListQuarantinedRows(ctx, id, 10); len(listed) != 0 {
+		t.Errorf("ListQuarantinedRows() after clear = %d rows, want 0", len(listed))
+	}
+}
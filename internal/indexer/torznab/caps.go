@@ -0,0 +1,169 @@
+package torznab
+
+import (
+	"encoding/xml"
+	"slices"
+	"strings"
+)
+
+// Caps is the Torznab capabilities document.
+type Caps struct {
+	XMLName    xml.Name   `xml:"caps"`
+	Server     CapsServer `xml:"server"`
+	Limits     CapsLimits `xml:"limits"`
+	Searching  Searching  `xml:"searching"`
+	Categories []Category `xml:"categories>category"`
+}
+
+// CapsServer identifies the proxy.
+type CapsServer struct {
+	Title string `xml:"title,attr"`
+}
+
+// CapsLimits advertises paging limits.
+type CapsLimits struct {
+	Max     int `xml:"max,attr"`
+	Default int `xml:"default,attr"`
+}
+
+// Searching lists the supported search functions.
+type Searching struct {
+	Search      SearchCap `xml:"search"`
+	TVSearch    SearchCap `xml:"tv-search"`
+	MovieSearch SearchCap `xml:"movie-search"`
+}
+
+// SearchCap describes one search function.
+type SearchCap struct {
+	Available       string `xml:"available,attr"`
+	SupportedParams string `xml:"supportedParams,attr"`
+}
+
+// Category is a top-level Newznab category with its subcategories.
+type Category struct {
+	ID      int      `xml:"id,attr"`
+	Name    string   `xml:"name,attr"`
+	Subcats []Subcat `xml:"subcat"`
+}
+
+// Subcat is a Newznab subcategory.
+type Subcat struct {
+	ID   int    `xml:"id,attr"`
+	Name string `xml:"name,attr"`
+}
+
+const (
+	defaultLimit = 100
+	maxLimit     = 100
+)
+
+var categoryNames = map[int]string{
+	1000: "Console",
+	2000: "Movies",
+	2010: "Movies/Foreign",
+	2020: "Movies/Other",
+	2030: "Movies/SD",
+	2040: "Movies/HD",
+	2045: "Movies/UHD",
+	2050: "Movies/BluRay",
+	2060: "Movies/3D",
+	2070: "Movies/DVD",
+	2080: "Movies/WEB-DL",
+	3000: "Audio",
+	4000: "PC",
+	5000: "TV",
+	5010: "TV/Foreign",
+	5020: "TV/Other",
+	5030: "TV/SD",
+	5040: "TV/HD",
+	5045: "TV/UHD",
+	5060: "TV/Sport",
+	5070: "TV/Anime",
+	5080: "TV/Documentary",
+	5090: "TV/WEB-DL",
+	6000: "XXX",
+	7000: "Books",
+	8000: "Other",
+}
+
+// capsInput is the merged capability set of the indexers behind an endpoint.
+type capsInput struct {
+	supportsSearch bool
+	supportsTV     bool
+	supportsMovies bool
+	tvParams       []string
+	movieParams    []string
+	categories     []int
+}
+
+func newCaps(title string, in *capsInput) *Caps {
+	return &Caps{
+		Server: CapsServer{Title: title},
+		Limits: CapsLimits{Max: maxLimit, Default: defaultLimit},
+		Searching: Searching{
+			Search:      searchCap(in.supportsSearch, []string{"q"}),
+			TVSearch:    searchCap(in.supportsTV, append([]string{"q"}, in.tvParams...)),
+			MovieSearch: searchCap(in.supportsMovies, append([]string{"q"}, in.movieParams...)),
+		},
+		Categories: buildCategories(in.categories),
+	}
+}
+
+func searchCap(available bool, params []string) SearchCap {
+	if !available {
+		return SearchCap{Available: "no"}
+	}
+	return SearchCap{Available: "yes", SupportedParams: strings.Join(dedupe(params), ",")}
+}
+
+// buildCategories groups category IDs under their parent. Parents are always
+// emitted when any of their subcategories are present; IDs without a known
+// name are skipped.
+func buildCategories(ids []int) []Category {
+	parents := make(map[int]*Category)
+	for _, id := range dedupeInts(ids) {
+		parentID := id / 1000 * 1000
+		parentName, ok := categoryNames[parentID]
+		if !ok {
+			continue
+		}
+		parent, exists := parents[parentID]
+		if !exists {
+			parent = &Category{ID: parentID, Name: parentName, Subcats: []Subcat{}}
+			parents[parentID] = parent
+		}
+		if id == parentID {
+			continue
+		}
+		if name, ok := categoryNames[id]; ok {
+			parent.Subcats = append(parent.Subcats, Subcat{ID: id, Name: name})
+		}
+	}
+
+	categories := make([]Category, 0, len(parents))
+	for _, parent := range parents {
+		categories = append(categories, *parent)
+	}
+	slices.SortFunc(categories, func(a, b Category) int { return a.ID - b.ID })
+	return categories
+}
+
+func dedupe(values []string) []string {
+	seen := make(map[string]bool, len(values))
+	out := make([]string, 0, len(values))
+	for _, v := range values {
+		v = strings.ToLower(strings.TrimSpace(v))
+		if v == "" || seen[v] {
+			continue
+		}
+		seen[v] = true
+		out = append(out, v)
+	}
+	return out
+}
+
+func dedupeInts(values []int) []int {
+	out := slices.Clone(values)
+	slices.Sort(out)
+	return slices.Compact(out)
+}
@@ -0,0 +1,175 @@
+package torznab
+
+import (
+	"encoding/xml"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/slipstream/slipstream/internal/indexer/types"
+)
+
+const torznabNamespace = "http://torznab.com/schemas/2015/feed"
+
+// Torznab error codes, as defined by the Newznab API specification.
+const (
+	ErrorCodeIncorrectParameter  = 201
+	ErrorCodeNoSuchFunction      = 202
+	ErrorCodeFunctionUnavailable = 203
+	ErrorCodeNoSuchItem          = 300
+	ErrorCodeUnknown             = 900
+)
+
+// ErrorResponse is the Torznab error document.
+type ErrorResponse struct {
+	XMLName     xml.Name `xml:"error"`
+	Code        int      `xml:"code,attr"`
+	Description string   `xml:"description,attr"`
+}
+
+// Feed is the Torznab search response document.
+type Feed struct {
+	XMLName   xml.Name `xml:"rss"`
+	Version   string   `xml:"version,attr"`
+	AtomNS    string   `xml:"xmlns:atom,attr"`
+	TorznabNS string   `xml:"xmlns:torznab,attr"`
+	Channel   Channel  `xml:"channel"`
+}
+
+// Channel holds the feed metadata and items.
+type Channel struct {
+	Title       string   `xml:"title"`
+	Description string   `xml:"description"`
+	Response    Response `xml:"torznab:response"`
+	Items       []Item   `xml:"item"`
+}
+
+// Response carries paging information for the feed.
+type Response struct {
+	Offset int `xml:"offset,attr"`
+	Total  int `xml:"total,attr"`
+}
+
+// Item is a single release in the feed.
+type Item struct {
+	Title      string    `xml:"title"`
+	GUID       GUID      `xml:"guid"`
+	Indexer    Indexer   `xml:"jackettindexer"`
+	Comments   string    `xml:"comments,omitempty"`
+	PubDate    string    `xml:"pubDate"`
+	Size       int64     `xml:"size"`
+	Link       string    `xml:"link"`
+	Categories []int     `xml:"category"`
+	Enclosure  Enclosure `xml:"enclosure"`
+	Attributes []Attr    `xml:"torznab:attr"`
+}
+
+// GUID is the release identifier.
+type GUID struct {
+	IsPermaLink bool   `xml:"isPermaLink,attr"`
+	Value       string `xml:",chardata"`
+}
+
+// Indexer names the source indexer of an item.
+type Indexer struct {
+	ID    int64  `xml:"id,attr"`
+	Value string `xml:",chardata"`
+}
+
+// Enclosure points at the downloadable release.
+type Enclosure struct {
+	URL    string `xml:"url,attr"`
+	Length int64  `xml:"length,attr"`
+	Type   string `xml:"type,attr"`
+}
+
+// Attr is a torznab:attr name/value pair.
+type Attr struct {
+	Name  string `xml:"name,attr"`
+	Value string `xml:"value,attr"`
+}
+
+// LinkFunc returns the URL a consumer should fetch to download a release.
+type LinkFunc func(release *types.TorrentInfo) string
+
+// NewFeed builds a Torznab feed from torrent search results.
+func NewFeed(title string, releases []types.TorrentInfo, offset int, link LinkFunc) *Feed {
+	items := make([]Item, 0, len(releases))
+	for i := range releases {
+		items = append(items, newItem(&releases[i], link(&releases[i])))
+	}
+	return &Feed{
+		Version:   "2.0",
+		AtomNS:    "http://www.w3.org/2005/Atom",
+		TorznabNS: torznabNamespace,
+		Channel: Channel{
+			Title:       title,
+			Description: title + " Torznab feed",
+			Response:    Response{Offset: offset, Total: len(items)},
+			Items:       items,
+		},
+	}
+}
+
+func newItem(r *types.TorrentInfo, link string) Item {
+	enclosureType := "application/x-bittorrent"
+	if strings.HasPrefix(link, "magnet:") {
+		enclosureType = "application/x-bittorrent;x-scheme-handler/magnet"
+	}
+
+	return Item{
+		Title:      r.Title,
+		GUID:       GUID{IsPermaLink: false, Value: r.GUID},
+		Indexer:    Indexer{ID: r.IndexerID, Value: r.IndexerName},
+		Comments:   r.InfoURL,
+		PubDate:    r.PublishDate.UTC().Format(time.RFC1123Z),
+		Size:       r.Size,
+		Link:       link,
+		Categories: r.Categories,
+		Enclosure:  Enclosure{URL: link, Length: r.Size, Type: enclosureType},
+		Attributes: itemAttributes(r),
+	}
+}
+
+func itemAttributes(r *types.TorrentInfo) []Attr {
+	attrs := make([]Attr, 0, len(r.Categories)+10)
+	for _, cat := range r.Categories {
+		attrs = append(attrs, Attr{Name: "category", Value: strconv.Itoa(cat)})
+	}
+	attrs = append(attrs,
+		Attr{Name: "size", Value: strconv.FormatInt(r.Size, 10)},
+		Attr{Name: "seeders", Value: strconv.Itoa(r.Seeders)},
+		Attr{Name: "peers", Value: strconv.Itoa(r.Seeders + r.Leechers)},
+		Attr{Name: "downloadvolumefactor", Value: strconv.FormatFloat(r.DownloadVolumeFactor, 'f', -1, 64)},
+		Attr{Name: "uploadvolumefactor", Value: strconv.FormatFloat(r.UploadVolumeFactor, 'f', -1, 64)},
+	)
+	if r.Freeleech {
+		attrs = append(attrs, Attr{Name: "tag", Value: "freeleech"})
+	}
+	if r.Internal {
+		attrs = append(attrs, Attr{Name: "tag", Value: "internal"})
+	}
+	if r.InfoHash != "" {
+		attrs = append(attrs, Attr{Name: "infohash", Value: r.InfoHash})
+	}
+	if r.MagnetURL != "" {
+		attrs = append(attrs, Attr{Name: "magneturl", Value: r.MagnetURL})
+	}
+	if r.ImdbID > 0 {
+		attrs = append(attrs, Attr{Name: "imdbid", Value: fmt.Sprintf("tt%07d", r.ImdbID)})
+	}
+	if r.TmdbID > 0 {
+		attrs = append(attrs, Attr{Name: "tmdbid", Value: strconv.Itoa(r.TmdbID)})
+	}
+	if r.TvdbID > 0 {
+		attrs = append(attrs, Attr{Name: "tvdbid", Value: strconv.Itoa(r.TvdbID)})
+	}
+	if r.MinimumRatio > 0 {
+		attrs = append(attrs, Attr{Name: "minimumratio", Value: strconv.FormatFloat(r.MinimumRatio, 'f', -1, 64)})
+	}
+	if r.MinimumSeedTime > 0 {
+		attrs = append(attrs, Attr{Name: "minimumseedtime", Value: strconv.FormatInt(r.MinimumSeedTime, 10)})
+	}
+	return attrs
+}
@@ -0,0 +1,131 @@
+package torznab
+
+import (
+	"encoding/xml"
+	"errors"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/slipstream/slipstream/internal/indexer"
+	"github.com/slipstream/slipstream/internal/indexer/types"
+)
+
+// Handlers provides the Torznab HTTP endpoints.
+type Handlers struct {
+	service *Service
+}
+
+// NewHandlers creates new Torznab handlers.
+func NewHandlers(service *Service) *Handlers {
+	return &Handlers{service: service}
+}
+
+// RegisterRoutes registers the Torznab routes. These are intended to be
+// mounted on a group that accepts API key authentication.
+func (h *Handlers) RegisterRoutes(g *echo.Group) {
+	g.GET("/:id/api", h.API)
+	g.GET("/:id/download", h.Download)
+}
+
+// API dispatches a Torznab request on its t parameter.
+// GET /api/v1/torznab/:id/api?t=caps|search|tvsearch|movie
+func (h *Handlers) API(c echo.Context) error {
+	ctx := c.Request().Context()
+	endpointID := c.Param("id")
+
+	function := c.QueryParam("t")
+	switch function {
+	case FunctionCaps:
+		caps, err := h.service.Caps(ctx, endpointID)
+		if err != nil {
+			return writeServiceError(c, err)
+		}
+		return writeXML(c, http.StatusOK, caps)
+	case FunctionSearch, FunctionTVSearch, FunctionMovie:
+		req, err := ParseRequest(function, c.QueryParams())
+		if err != nil {
+			return writeError(c, http.StatusBadRequest, ErrorCodeIncorrectParameter, err.Error())
+		}
+		feed, err := h.service.Search(ctx, endpointID, req, downloadLinker(c, endpointID))
+		if err != nil {
+			return writeServiceError(c, err)
+		}
+		return writeXML(c, http.StatusOK, feed)
+	case "":
+		return writeError(c, http.StatusBadRequest, ErrorCodeIncorrectParameter, "missing parameter t")
+	default:
+		return writeError(c, http.StatusBadRequest, ErrorCodeNoSuchFunction, "no such function: "+function)
+	}
+}
+
+// Download proxies a release download through the originating indexer.
+// GET /api/v1/torznab/:id/download?link=...
+func (h *Handlers) Download(c echo.Context) error {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return writeError(c, http.StatusBadRequest, ErrorCodeIncorrectParameter, ErrInvalidIndexerID.Error())
+	}
+	link := c.QueryParam("link")
+	if link == "" {
+		return writeError(c, http.StatusBadRequest, ErrorCodeIncorrectParameter, "missing parameter link")
+	}
+
+	data, err := h.service.Download(c.Request().Context(), id, link)
+	if err != nil {
+		return writeServiceError(c, err)
+	}
+	return c.Blob(http.StatusOK, "application/x-bittorrent", data)
+}
+
+// downloadLinker rewrites release links to this proxy's download endpoint.
+// Magnet-only releases are passed through unchanged. The caller's API key is
+// carried over so download clients can fetch the link without further setup.
+func downloadLinker(c echo.Context, endpointID string) LinkFunc {
+	req := c.Request()
+	base := c.Scheme() + "://" + req.Host + strings.TrimSuffix(req.URL.Path, endpointID+"/api")
+	apiKey := req.Header.Get("X-Api-Key")
+	if apiKey == "" {
+		apiKey = c.QueryParam("apikey")
+	}
+
+	return func(release *types.TorrentInfo) string {
+		if release.DownloadURL == "" || strings.HasPrefix(release.DownloadURL, "magnet:") {
+			return release.MagnetURL
+		}
+		params := url.Values{}
+		params.Set("link", release.DownloadURL)
+		if apiKey != "" {
+			params.Set("apikey", apiKey)
+		}
+		return base + strconv.FormatInt(release.IndexerID, 10) + "/download?" + params.Encode()
+	}
+}
+
+func writeServiceError(c echo.Context, err error) error {
+	switch {
+	case errors.Is(err, ErrInvalidIndexerID):
+		return writeError(c, http.StatusBadRequest, ErrorCodeIncorrectParameter, err.Error())
+	case errors.Is(err, indexer.ErrIndexerNotFound):
+		return writeError(c, http.StatusNotFound, ErrorCodeNoSuchItem, err.Error())
+	case errors.Is(err, ErrIndexerUnavailable):
+		return writeError(c, http.StatusNotFound, ErrorCodeFunctionUnavailable, err.Error())
+	default:
+		return writeError(c, http.StatusInternalServerError, ErrorCodeUnknown, err.Error())
+	}
+}
+
+func writeError(c echo.Context, status, code int, description string) error {
+	return writeXML(c, status, &ErrorResponse{Code: code, Description: description})
+}
+
+func writeXML(c echo.Context, status int, v any) error {
+	body, err := xml.Marshal(v)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	return c.Blob(status, echo.MIMEApplicationXMLCharsetUTF8, append([]byte(xml.Header), body...))
+}
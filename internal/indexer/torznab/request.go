@@ -0,0 +1,102 @@
+package torznab
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/slipstream/slipstream/internal/indexer/types"
+)
+
+// Torznab functions selected by the t parameter.
+const (
+	FunctionCaps     = "caps"
+	FunctionSearch   = "search"
+	FunctionTVSearch = "tvsearch"
+	FunctionMovie    = "movie"
+)
+
+// Request is a parsed Torznab search request.
+type Request struct {
+	Criteria types.SearchCriteria
+	Offset   int
+	Limit    int
+}
+
+// ParseRequest parses the query parameters of a Torznab search function.
+func ParseRequest(function string, values url.Values) (*Request, error) {
+	req := &Request{
+		Criteria: types.SearchCriteria{
+			Query:  strings.TrimSpace(values.Get("q")),
+			Type:   function,
+			ImdbID: strings.TrimSpace(values.Get("imdbid")),
+		},
+		Limit: defaultLimit,
+	}
+
+	cats, err := parseCategories(values.Get("cat"))
+	if err != nil {
+		return nil, err
+	}
+	req.Criteria.Categories = cats
+
+	ints := []struct {
+		key  string
+		dest *int
+	}{
+		{"tmdbid", &req.Criteria.TmdbID},
+		{"tvdbid", &req.Criteria.TvdbID},
+		{"season", &req.Criteria.Season},
+		{"ep", &req.Criteria.Episode},
+		{"year", &req.Criteria.Year},
+		{"offset", &req.Offset},
+		{"limit", &req.Limit},
+	}
+	for _, p := range ints {
+		raw := strings.TrimSpace(values.Get(p.key))
+		if raw == "" {
+			continue
+		}
+		n, err := strconv.Atoi(raw)
+		if err != nil || n < 0 {
+			return nil, fmt.Errorf("invalid %s: %q", p.key, raw)
+		}
+		*p.dest = n
+	}
+
+	if req.Limit == 0 || req.Limit > maxLimit {
+		req.Limit = maxLimit
+	}
+	req.Criteria.Limit = req.Limit
+	return req, nil
+}
+
+func parseCategories(raw string) ([]int, error) {
+	if strings.TrimSpace(raw) == "" {
+		return nil, nil
+	}
+	parts := strings.Split(raw, ",")
+	cats := make([]int, 0, len(parts))
+	for _, part := range parts {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		cat, err := strconv.Atoi(part)
+		if err != nil {
+			return nil, fmt.Errorf("invalid cat: %q", part)
+		}
+		cats = append(cats, cat)
+	}
+	return cats, nil
+}
+
+// page returns the requested window of the aggregated results.
+func (r *Request) page(releases []types.TorrentInfo) []types.TorrentInfo {
+	if r.Offset >= len(releases) {
+		return []types.TorrentInfo{}
+	}
+	end := min(r.Offset+r.Limit, len(releases))
+	return releases[r.Offset:end]
+}
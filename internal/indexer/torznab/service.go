@@ -0,0 +1,148 @@
+// Package torznab exposes SlipStream's torrent indexers through a
+// Torznab-compatible API so other tools can use SlipStream as an aggregator.
+package torznab
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/rs/zerolog"
+
+	"github.com/slipstream/slipstream/internal/indexer"
+	"github.com/slipstream/slipstream/internal/indexer/search"
+	"github.com/slipstream/slipstream/internal/indexer/types"
+)
+
+// AllIndexers is the endpoint ID that aggregates every enabled torrent indexer.
+const AllIndexers = "all"
+
+const serverTitle = "SlipStream"
+
+var (
+	ErrIndexerUnavailable = errors.New("indexer is not an enabled torrent indexer")
+	ErrInvalidIndexerID   = errors.New("invalid indexer id")
+)
+
+// Service answers Torznab requests using the configured indexers.
+type Service struct {
+	indexers *indexer.Service
+	search   *search.Service
+	logger   *zerolog.Logger
+}
+
+// NewService creates a new Torznab proxy service.
+func NewService(indexerService *indexer.Service, searchService *search.Service, logger *zerolog.Logger) *Service {
+	subLogger := logger.With().Str("component", "torznab").Logger()
+	return &Service{
+		indexers: indexerService,
+		search:   searchService,
+		logger:   &subLogger,
+	}
+}
+
+// Caps returns the merged capabilities of the indexers behind an endpoint.
+func (s *Service) Caps(ctx context.Context, endpointID string) (*Caps, error) {
+	defs, err := s.resolveIndexers(ctx, endpointID)
+	if err != nil {
+		return nil, err
+	}
+
+	in := &capsInput{}
+	for _, def := range defs {
+		in.supportsSearch = in.supportsSearch || def.SupportsSearch
+		in.supportsTV = in.supportsTV || def.SupportsTV
+		in.supportsMovies = in.supportsMovies || def.SupportsMovies
+		in.categories = append(in.categories, def.Categories...)
+
+		client, err := s.indexers.GetClient(ctx, def.ID)
+		if err != nil {
+			s.logger.Warn().Err(err).Int64("indexerId", def.ID).Msg("Failed to load indexer client for capabilities")
+			continue
+		}
+		caps := client.Capabilities()
+		in.tvParams = append(in.tvParams, caps.TvSearchParams...)
+		in.movieParams = append(in.movieParams, caps.MovieSearchParams...)
+	}
+
+	return newCaps(s.title(defs, endpointID), in), nil
+}
+
+// Search runs a Torznab search function and builds the response feed.
+func (s *Service) Search(ctx context.Context, endpointID string, req *Request, link LinkFunc) (*Feed, error) {
+	defs, err := s.resolveIndexers(ctx, endpointID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := s.search.ProxySearchTorrents(ctx, defs, &req.Criteria)
+	for _, e := range result.IndexerErrors {
+		s.logger.Warn().
+			Int64("indexerId", e.IndexerID).
+			Str("indexerName", e.IndexerName).
+			Str("error", e.Error).
+			Msg("Indexer failed during proxied search")
+	}
+
+	s.logger.Debug().
+		Str("endpoint", endpointID).
+		Str("function", req.Criteria.Type).
+		Str("query", req.Criteria.Query).
+		Int("results", result.TotalResults).
+		Msg("Proxied Torznab search")
+
+	feed := NewFeed(s.title(defs, endpointID), req.page(result.Releases), req.Offset, link)
+	feed.Channel.Response.Total = result.TotalResults
+	return feed, nil
+}
+
+// Download fetches a release file through the indexer's client so that
+// authenticated or cookie-protected links work for the consumer.
+func (s *Service) Download(ctx context.Context, indexerID int64, link string) ([]byte, error) {
+	def, err := s.indexers.Get(ctx, indexerID)
+	if err != nil {
+		return nil, err
+	}
+	if !def.Enabled || def.Protocol != types.ProtocolTorrent {
+		return nil, ErrIndexerUnavailable
+	}
+
+	client, err := s.indexers.GetClient(ctx, indexerID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get indexer client: %w", err)
+	}
+	data, err := client.Download(ctx, link)
+	if err != nil {
+		return nil, fmt.Errorf("failed to download release: %w", err)
+	}
+	return data, nil
+}
+
+// resolveIndexers returns the indexers served by an endpoint: every enabled
+// torrent indexer for "all", otherwise the single indexer with that ID.
+func (s *Service) resolveIndexers(ctx context.Context, endpointID string) ([]*types.IndexerDefinition, error) {
+	if endpointID == AllIndexers {
+		return s.indexers.ListEnabledByProtocol(ctx, types.ProtocolTorrent)
+	}
+
+	id, err := strconv.ParseInt(endpointID, 10, 64)
+	if err != nil {
+		return nil, ErrInvalidIndexerID
+	}
+	def, err := s.indexers.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if !def.Enabled || def.Protocol != types.ProtocolTorrent {
+		return nil, ErrIndexerUnavailable
+	}
+	return []*types.IndexerDefinition{def}, nil
+}
+
+func (s *Service) title(defs []*types.IndexerDefinition, endpointID string) string {
+	if endpointID != AllIndexers && len(defs) == 1 {
+		return serverTitle + " (" + defs[0].Name + ")"
+	}
+	return serverTitle
+}
@@ -0,0 +1,117 @@
+package torznab
+
+import (
+	"encoding/xml"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/slipstream/slipstream/internal/indexer/types"
+)
+
+func TestParseRequest(t *testing.T) {
+	values := url.Values{
+		"q":      {" The Show "},
+		"cat":    {"5000,5040"},
+		"tvdbid": {"12345"},
+		"season": {"2"},
+		"ep":     {"7"},
+		"offset": {"10"},
+		"limit":  {"500"},
+	}
+
+	req, err := ParseRequest(FunctionTVSearch, values)
+	if err != nil {
+		t.Fatalf("ParseRequest() error = %v", err)
+	}
+	if req.Criteria.Query != "The Show" || req.Criteria.Type != FunctionTVSearch {
+		t.Errorf("criteria = %+v", req.Criteria)
+	}
+	if len(req.Criteria.Categories) != 2 || req.Criteria.Categories[1] != 5040 {
+		t.Errorf("Categories = %v, want [5000 5040]", req.Criteria.Categories)
+	}
+	if req.Criteria.TvdbID != 12345 || req.Criteria.Season != 2 || req.Criteria.Episode != 7 {
+		t.Errorf("criteria = %+v", req.Criteria)
+	}
+	if req.Offset != 10 || req.Limit != maxLimit {
+		t.Errorf("Offset = %d, Limit = %d, want 10 and %d", req.Offset, req.Limit, maxLimit)
+	}
+
+	if _, err := ParseRequest(FunctionSearch, url.Values{"season": {"x"}}); err == nil {
+		t.Error("ParseRequest() with invalid season should fail")
+	}
+	if _, err := ParseRequest(FunctionSearch, url.Values{"cat": {"2000,movies"}}); err == nil {
+		t.Error("ParseRequest() with invalid cat should fail")
+	}
+}
+
+func TestRequestPage(t *testing.T) {
+	releases := make([]types.TorrentInfo, 5)
+	req := &Request{Offset: 3, Limit: 10}
+	if got := len(req.page(releases)); got != 2 {
+		t.Errorf("page() len = %d, want 2", got)
+	}
+	req.Offset = 5
+	if got := req.page(releases); got == nil || len(got) != 0 {
+		t.Errorf("page() past end = %v, want empty", got)
+	}
+}
+
+func TestNewFeed_RendersTorznabAttributes(t *testing.T) {
+	releases := []types.TorrentInfo{{
+		ReleaseInfo: types.ReleaseInfo{
+			GUID:        "guid-1",
+			Title:       "Movie.2020.1080p.BluRay",
+			DownloadURL: "https://tracker.example/dl/1",
+			Size:        1024,
+			PublishDate: time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC),
+			Categories:  []int{2040},
+			IndexerID:   3,
+			IndexerName: "Tracker",
+			ImdbID:      12345,
+		},
+		Seeders:              10,
+		Leechers:             5,
+		InfoHash:             "abc",
+		DownloadVolumeFactor: 0,
+		UploadVolumeFactor:   1,
+	}}
+
+	feed := NewFeed("SlipStream", releases, 0, func(r *types.TorrentInfo) string {
+		return "http://proxy/download?link=" + url.QueryEscape(r.DownloadURL)
+	})
+	body, err := xml.Marshal(feed)
+	if err != nil {
+		t.Fatalf("xml.Marshal() error = %v", err)
+	}
+	out := string(body)
+
+	for _, want := range []string{
+		`xmlns:torznab="http://torznab.com/schemas/2015/feed"`,
+		`<torznab:attr name="seeders" value="10"></torznab:attr>`,
+		`<torznab:attr name="peers" value="15"></torznab:attr>`,
+		`<torznab:attr name="infohash" value="abc"></torznab:attr>`,
+		`<torznab:attr name="imdbid" value="tt0012345"></torznab:attr>`,
+		`<torznab:attr name="downloadvolumefactor" value="0"></torznab:attr>`,
+		`<enclosure url="http://proxy/download?link=https%3A%2F%2Ftracker.example%2Fdl%2F1" length="1024" type="application/x-bittorrent"></enclosure>`,
+		`<pubDate>Tue, 02 Jan 2024 03:04:05 +0000</pubDate>`,
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("feed missing %s\n%s", want, out)
+		}
+	}
+}
+
+func TestBuildCategories(t *testing.T) {
+	cats := buildCategories([]int{5040, 2000, 2045, 5040, 99999, 2040})
+	if len(cats) != 2 {
+		t.Fatalf("buildCategories() returned %d categories, want 2", len(cats))
+	}
+	if cats[0].ID != 2000 || len(cats[0].Subcats) != 2 || cats[0].Subcats[0].ID != 2040 {
+		t.Errorf("movies category = %+v", cats[0])
+	}
+	if cats[1].ID != 5000 || len(cats[1].Subcats) != 1 || cats[1].Subcats[0].ID != 5040 {
+		t.Errorf("tv category = %+v", cats[1])
+	}
+}
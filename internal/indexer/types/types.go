@@ -49,7 +49,10 @@ type IndexerDefinition struct {
 	Enabled           bool            `json:"enabled"`
 	AutoSearchEnabled bool            `json:"autoSearchEnabled"`
 	RssEnabled        bool            `json:"rssEnabled"`
+	ProxyURL          string          `json:"proxyUrl"`
+	FlareSolverrURL   string          `json:"flareSolverrUrl"`
 	Settings          json.RawMessage `json:"settings,omitempty"`
+	Tags              []int64         `json:"tags"`
 	CreatedAt         time.Time       `json:"createdAt,omitempty"`
 	UpdatedAt         time.Time       `json:"updatedAt,omitempty"`
 }
@@ -91,9 +94,17 @@ type SearchCriteria struct {
 	Season  int `json:"season,omitempty"`
 	Episode int `json:"episode,omitempty"`
 
+	// AbsoluteEpisode is the anime absolute episode number. When set, an extra
+	// text search in the anime category is run alongside the tvsearch.
+	AbsoluteEpisode int `json:"absoluteEpisode,omitempty"`
+
 	// Pagination
 	Limit  int `json:"limit,omitempty"`
 	Offset int `json:"offset,omitempty"`
+
+	// Tags of the library item being searched for. Tagged indexers only serve
+	// items sharing one of their tags; nil (no item) skips tag filtering.
+	Tags []int64 `json:"tags,omitempty"`
 }
 
 // ReleaseInfo represents a search result from an indexer.
@@ -135,6 +146,10 @@ type ScoreBreakdown struct {
 	MatchScore    float64 `json:"matchScore"`
 	AgeScore      float64 `json:"ageScore"`
 	LanguageScore float64 `json:"languageScore"` // Penalty for non-preferred language
+	RevisionScore float64 `json:"revisionScore"` // Bonus for PROPER/REPACK releases
+
+	ReleaseGroupScore float64 `json:"releaseGroupScore"` // Bonus for preferred release groups
+	IndexerFlagScore  float64 `json:"indexerFlagScore"`  // Bonus for preferred freeleech/internal flags
 }
 
 // TorrentInfo extends ReleaseInfo with torrent-specific fields.
@@ -148,6 +163,8 @@ type TorrentInfo struct {
 	MinimumSeedTime      int64   `json:"minimumSeedTime,omitempty"` // seconds
 	DownloadVolumeFactor float64 `json:"downloadVolumeFactor"`      // 0 = freeleech
 	UploadVolumeFactor   float64 `json:"uploadVolumeFactor"`        // 2 = double upload
+	Freeleech            bool    `json:"freeleech"`                 // Tracker marks the release freeleech
+	Internal             bool    `json:"internal"`                  // Release by the tracker's internal group
 
 	// Scoring fields (populated by scored search endpoints)
 	Score           float64         `json:"score,omitempty"`
@@ -0,0 +1,103 @@
+// Package alttitles stores the AKA titles and translations metadata providers
+// report for movies and series, so releases and files named in another
+// language can be matched to the library item.
+package alttitles
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/slipstream/slipstream/internal/database/sqlc"
+	"github.com/slipstream/slipstream/internal/metadata"
+	"github.com/slipstream/slipstream/internal/module/titleutil"
+)
+
+// Media types alternate titles are stored for.
+const (
+	MediaMovie  = "movie"
+	MediaSeries = "series"
+)
+
+// Key reduces a title to the form alternate titles are looked up by. Spaces
+// are dropped as well as punctuation so "d'Amélie" and a release's "d.Amelie"
+// share a key.
+func Key(title string) string {
+	return strings.ReplaceAll(titleutil.NormalizeTitle(title), " ", "")
+}
+
+// Replace swaps the stored alternate titles of one movie or series for titles.
+// Titles that reduce to the item's own title or to an empty key are skipped.
+func Replace(ctx context.Context, db *sql.DB, mediaType string, mediaID int64, title string, titles []metadata.AlternateTitle) error {
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	q := sqlc.New(tx)
+	if err := q.DeleteAlternateTitles(ctx, sqlc.DeleteAlternateTitlesParams{MediaType: mediaType, MediaID: mediaID}); err != nil {
+		return fmt.Errorf("failed to clear alternate titles: %w", err)
+	}
+
+	own := Key(title)
+	for _, t := range titles {
+		key := Key(t.Title)
+		if key == "" || key == own {
+			continue
+		}
+		if err := q.CreateAlternateTitle(ctx, sqlc.CreateAlternateTitleParams{
+			MediaType:  mediaType,
+			MediaID:    mediaID,
+			Title:      t.Title,
+			CleanTitle: key,
+			Locale:     t.Locale,
+			Kind:       t.Kind,
+			Source:     t.Source,
+		}); err != nil {
+			return fmt.Errorf("failed to add alternate title: %w", err)
+		}
+	}
+	return tx.Commit()
+}
+
+// List returns the alternate titles stored for one movie or series.
+func List(ctx context.Context, q *sqlc.Queries, mediaType string, mediaID int64) ([]metadata.AlternateTitle, error) {
+	rows, err := q.ListAlternateTitles(ctx, sqlc.ListAlternateTitlesParams{MediaType: mediaType, MediaID: mediaID})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list alternate titles: %w", err)
+	}
+	titles := make([]metadata.AlternateTitle, len(rows))
+	for i, row := range rows {
+		titles[i] = metadata.AlternateTitle{Title: row.Title, Locale: row.Locale, Kind: row.Kind, Source: row.Source}
+	}
+	return titles, nil
+}
+
+// MediaIDs returns the movies or series with an alternate title matching title.
+func MediaIDs(ctx context.Context, q *sqlc.Queries, mediaType, title string) ([]int64, error) {
+	key := Key(title)
+	if key == "" {
+		return nil, nil
+	}
+	ids, err := q.ListAlternateTitleMediaIDs(ctx, sqlc.ListAlternateTitleMediaIDsParams{MediaType: mediaType, CleanTitle: key})
+	if err != nil {
+		return nil, fmt.Errorf("failed to look up alternate titles: %w", err)
+	}
+	return ids, nil
+}
+
+// KeysByMedia returns the lookup keys of every alternate title of a media
+// type, grouped by movie or series ID.
+func KeysByMedia(ctx context.Context, q *sqlc.Queries, mediaType string) (map[int64][]string, error) {
+	rows, err := q.ListAlternateTitlesByMediaType(ctx, mediaType)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list alternate titles: %w", err)
+	}
+	keys := make(map[int64][]string)
+	for _, row := range rows {
+		keys[row.MediaID] = append(keys[row.MediaID], row.CleanTitle)
+	}
+	return keys, nil
+}
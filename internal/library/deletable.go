@@ -1,6 +1,7 @@
 package library
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"path/filepath"
@@ -62,3 +63,45 @@ func DeleteFiles(paths []string) (int, error) {
 	}
 	return deleted, nil
 }
+
+// DeleteOptions controls what happens beyond removing a movie or series from
+// the library.
+type DeleteOptions struct {
+	DeleteFiles  bool // Remove the item's files, to the recycle bin when one is set
+	AddExclusion bool // Keep automated additions from adding the item back
+}
+
+// FileRecycler moves files into the recycle bin. RecycleFile returns "" when
+// no recycle bin is configured.
+type FileRecycler interface {
+	RecycleFile(ctx context.Context, path string) (string, error)
+}
+
+// RemoveFiles recycles the given files when recycler has a recycle bin and
+// deletes them otherwise. Files that don't exist are silently skipped.
+// Returns the number of files removed.
+func RemoveFiles(ctx context.Context, recycler FileRecycler, paths []string) (int, error) {
+	if recycler == nil {
+		return DeleteFiles(paths)
+	}
+
+	removed := 0
+	for i, p := range paths {
+		if p == "" {
+			continue
+		}
+		if _, err := os.Stat(p); os.IsNotExist(err) {
+			continue
+		}
+		recycled, err := recycler.RecycleFile(ctx, p)
+		if err != nil {
+			return removed, fmt.Errorf("failed to recycle %s: %w", filepath.Base(p), err)
+		}
+		if recycled == "" {
+			deleted, err := DeleteFiles(paths[i:])
+			return removed + deleted, err
+		}
+		removed++
+	}
+	return removed, nil
+}
@@ -0,0 +1,52 @@
+package exclusion
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+)
+
+// Handlers provides HTTP handlers for library exclusions.
+type Handlers struct {
+	service *Service
+}
+
+// NewHandlers creates new library exclusion handlers.
+func NewHandlers(service *Service) *Handlers {
+	return &Handlers{service: service}
+}
+
+// RegisterRoutes registers the library exclusion routes.
+func (h *Handlers) RegisterRoutes(g *echo.Group) {
+	g.GET("", h.List)
+	g.DELETE("/:id", h.Delete)
+}
+
+// List returns all library exclusions.
+// GET /api/v1/exclusions
+func (h *Handlers) List(c echo.Context) error {
+	exclusions, err := h.service.List(c.Request().Context())
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	return c.JSON(http.StatusOK, exclusions)
+}
+
+// Delete removes a library exclusion.
+// DELETE /api/v1/exclusions/:id
+func (h *Handlers) Delete(c echo.Context) error {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid id")
+	}
+
+	if err := h.service.Delete(c.Request().Context(), id); err != nil {
+		if errors.Is(err, ErrExclusionNotFound) {
+			return echo.NewHTTPError(http.StatusNotFound, err.Error())
+		}
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	return c.NoContent(http.StatusNoContent)
+}
@@ -0,0 +1,80 @@
+// Package exclusion manages movies and series that were deleted from the
+// library with an exclusion, so automated additions do not add them back.
+package exclusion
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/rs/zerolog"
+
+	"github.com/slipstream/slipstream/internal/database/sqlc"
+)
+
+var ErrExclusionNotFound = errors.New("exclusion not found")
+
+// Exclusion is a movie (by TMDB ID) or series (by TVDB ID) kept out of the library.
+type Exclusion struct {
+	ID         int64     `json:"id"`
+	MediaType  string    `json:"mediaType"` // "movie" or "series"
+	ExternalID int64     `json:"externalId"`
+	Title      string    `json:"title"`
+	Year       int       `json:"year,omitempty"`
+	CreatedAt  time.Time `json:"createdAt"`
+}
+
+// Service provides library exclusion operations.
+type Service struct {
+	queries *sqlc.Queries
+	logger  *zerolog.Logger
+}
+
+// NewService creates a new library exclusion service.
+func NewService(db *sql.DB, logger *zerolog.Logger) *Service {
+	subLogger := logger.With().Str("component", "exclusion").Logger()
+	return &Service{
+		queries: sqlc.New(db),
+		logger:  &subLogger,
+	}
+}
+
+// SetDB updates the database connection used by this service.
+func (s *Service) SetDB(db *sql.DB) {
+	s.queries = sqlc.New(db)
+}
+
+// List returns all exclusions ordered by title.
+func (s *Service) List(ctx context.Context) ([]*Exclusion, error) {
+	rows, err := s.queries.ListLibraryExclusions(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list exclusions: %w", err)
+	}
+	exclusions := make([]*Exclusion, len(rows))
+	for i, row := range rows {
+		exclusions[i] = &Exclusion{
+			ID:         row.ID,
+			MediaType:  row.MediaType,
+			ExternalID: row.ExternalID,
+			Title:      row.Title,
+			Year:       int(row.Year),
+			CreatedAt:  row.CreatedAt,
+		}
+	}
+	return exclusions, nil
+}
+
+// Delete removes an exclusion, allowing the item to be added again.
+func (s *Service) Delete(ctx context.Context, id int64) error {
+	n, err := s.queries.DeleteLibraryExclusion(ctx, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete exclusion: %w", err)
+	}
+	if n == 0 {
+		return ErrExclusionNotFound
+	}
+	s.logger.Info().Int64("id", id).Msg("Removed library exclusion")
+	return nil
+}
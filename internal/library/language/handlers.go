@@ -0,0 +1,126 @@
+package language
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/slipstream/slipstream/internal/module/parseutil"
+)
+
+// Handlers provides HTTP handlers for language profile operations.
+type Handlers struct {
+	service *Service
+}
+
+// NewHandlers creates new language profile handlers.
+func NewHandlers(service *Service) *Handlers {
+	return &Handlers{service: service}
+}
+
+// RegisterRoutes registers the language profile routes.
+func (h *Handlers) RegisterRoutes(g *echo.Group) {
+	g.GET("", h.List)
+	g.POST("", h.Create)
+	g.GET("/languages", h.ListLanguages)
+	g.GET("/:id", h.Get)
+	g.PUT("/:id", h.Update)
+	g.DELETE("/:id", h.Delete)
+}
+
+// List returns all language profiles.
+// GET /api/v1/languageprofiles
+func (h *Handlers) List(c echo.Context) error {
+	profiles, err := h.service.List(c.Request().Context())
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	return c.JSON(http.StatusOK, profiles)
+}
+
+// ListLanguages returns the languages that can be detected in release names.
+// GET /api/v1/languageprofiles/languages
+func (h *Handlers) ListLanguages(c echo.Context) error {
+	return c.JSON(http.StatusOK, parseutil.KnownLanguages())
+}
+
+// Get returns a single language profile.
+// GET /api/v1/languageprofiles/:id
+func (h *Handlers) Get(c echo.Context) error {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid id")
+	}
+
+	profile, err := h.service.Get(c.Request().Context(), id)
+	if err != nil {
+		if errors.Is(err, ErrProfileNotFound) {
+			return echo.NewHTTPError(http.StatusNotFound, err.Error())
+		}
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	return c.JSON(http.StatusOK, profile)
+}
+
+// Create creates a new language profile.
+// POST /api/v1/languageprofiles
+func (h *Handlers) Create(c echo.Context) error {
+	var input ProfileInput
+	if err := c.Bind(&input); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	profile, err := h.service.Create(c.Request().Context(), &input)
+	if err != nil {
+		if errors.Is(err, ErrInvalidProfile) {
+			return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+		}
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	return c.JSON(http.StatusCreated, profile)
+}
+
+// Update updates an existing language profile.
+// PUT /api/v1/languageprofiles/:id
+func (h *Handlers) Update(c echo.Context) error {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid id")
+	}
+
+	var input ProfileInput
+	if err := c.Bind(&input); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	profile, err := h.service.Update(c.Request().Context(), id, &input)
+	if err != nil {
+		if errors.Is(err, ErrProfileNotFound) {
+			return echo.NewHTTPError(http.StatusNotFound, err.Error())
+		}
+		if errors.Is(err, ErrInvalidProfile) {
+			return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+		}
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	return c.JSON(http.StatusOK, profile)
+}
+
+// Delete deletes a language profile.
+// DELETE /api/v1/languageprofiles/:id
+func (h *Handlers) Delete(c echo.Context) error {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid id")
+	}
+
+	if err := h.service.Delete(c.Request().Context(), id); err != nil {
+		if errors.Is(err, ErrProfileNotFound) {
+			return echo.NewHTTPError(http.StatusNotFound, err.Error())
+		}
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	return c.NoContent(http.StatusNoContent)
+}
@@ -0,0 +1,88 @@
+// Package language manages language profiles that restrict or prefer release audio languages.
+package language
+
+import (
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/slipstream/slipstream/internal/module/parseutil"
+)
+
+// defaultLanguage is assumed for releases without language tags.
+const defaultLanguage = "English"
+
+// Profile describes the audio languages acceptable for a movie or series.
+type Profile struct {
+	ID          int64     `json:"id"`
+	Name        string    `json:"name"`
+	Languages   []string  `json:"languages"`   // Ordered by preference; the first is preferred
+	Required    bool      `json:"required"`    // Reject releases without an accepted language
+	AcceptMulti bool      `json:"acceptMulti"` // Treat MULTI/DUAL releases as acceptable
+	CreatedAt   time.Time `json:"createdAt"`
+	UpdatedAt   time.Time `json:"updatedAt"`
+}
+
+// ProfileInput is the request body for creating or updating a language profile.
+type ProfileInput struct {
+	Name        string   `json:"name"`
+	Languages   []string `json:"languages"`
+	Required    bool     `json:"required"`
+	AcceptMulti bool     `json:"acceptMulti"`
+}
+
+// Validate checks the input and returns ErrInvalidProfile on failure.
+func (in *ProfileInput) Validate() error {
+	if in.Name == "" {
+		return fmt.Errorf("%w: name is required", ErrInvalidProfile)
+	}
+	if in.Required && len(in.Languages) == 0 {
+		return fmt.Errorf("%w: a required profile needs at least one language", ErrInvalidProfile)
+	}
+	known := parseutil.KnownLanguages()
+	for _, lang := range in.Languages {
+		if !slices.Contains(known, lang) {
+			return fmt.Errorf("%w: unknown language %q", ErrInvalidProfile, lang)
+		}
+	}
+	return nil
+}
+
+// PreferredLanguage returns the most preferred language, or empty when there is none.
+// A nil profile has no preference.
+func (p *Profile) PreferredLanguage() string {
+	if p == nil || len(p.Languages) == 0 {
+		return ""
+	}
+	return p.Languages[0]
+}
+
+// Accepts reports whether a release with the given parsed languages satisfies the profile.
+// Untagged releases are assumed to be English; MULTI/DUAL releases are assumed to
+// include English and are accepted outright when AcceptMulti is set.
+func (p *Profile) Accepts(languages []string) bool {
+	if len(p.Languages) == 0 {
+		return true
+	}
+	multi := parseutil.IsMultiAudio(languages)
+	if multi && p.AcceptMulti {
+		return true
+	}
+
+	var audio []string
+	for _, lang := range languages {
+		if lang != parseutil.LanguageMulti && lang != parseutil.LanguageDualAudio {
+			audio = append(audio, lang)
+		}
+	}
+	if len(audio) == 0 || multi {
+		audio = append(audio, defaultLanguage)
+	}
+
+	for _, lang := range audio {
+		if slices.Contains(p.Languages, lang) {
+			return true
+		}
+	}
+	return false
+}
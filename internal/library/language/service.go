@@ -0,0 +1,184 @@
+package language
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/rs/zerolog"
+
+	"github.com/slipstream/slipstream/internal/database/sqlc"
+)
+
+var (
+	ErrProfileNotFound = errors.New("language profile not found")
+	ErrInvalidProfile  = errors.New("invalid language profile")
+)
+
+// Service provides language profile operations.
+type Service struct {
+	queries *sqlc.Queries
+	logger  *zerolog.Logger
+}
+
+// NewService creates a new language profile service.
+func NewService(db *sql.DB, logger *zerolog.Logger) *Service {
+	subLogger := logger.With().Str("component", "language").Logger()
+	return &Service{
+		queries: sqlc.New(db),
+		logger:  &subLogger,
+	}
+}
+
+// SetDB updates the database connection used by this service.
+func (s *Service) SetDB(db *sql.DB) {
+	s.queries = sqlc.New(db)
+}
+
+// Get retrieves a language profile by ID.
+func (s *Service) Get(ctx context.Context, id int64) (*Profile, error) {
+	row, err := s.queries.GetLanguageProfile(ctx, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrProfileNotFound
+		}
+		return nil, fmt.Errorf("failed to get language profile: %w", err)
+	}
+	return rowToProfile(row)
+}
+
+// List returns all language profiles.
+func (s *Service) List(ctx context.Context) ([]*Profile, error) {
+	rows, err := s.queries.ListLanguageProfiles(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list language profiles: %w", err)
+	}
+	profiles := make([]*Profile, 0, len(rows))
+	for _, row := range rows {
+		p, err := rowToProfile(row)
+		if err != nil {
+			s.logger.Warn().Err(err).Int64("id", row.ID).Msg("Failed to parse language profile")
+			continue
+		}
+		profiles = append(profiles, p)
+	}
+	return profiles, nil
+}
+
+// Create creates a new language profile.
+func (s *Service) Create(ctx context.Context, input *ProfileInput) (*Profile, error) {
+	if err := input.Validate(); err != nil {
+		return nil, err
+	}
+	languages, err := serializeLanguages(input.Languages)
+	if err != nil {
+		return nil, err
+	}
+
+	row, err := s.queries.CreateLanguageProfile(ctx, sqlc.CreateLanguageProfileParams{
+		Name:        input.Name,
+		Languages:   languages,
+		Required:    input.Required,
+		AcceptMulti: input.AcceptMulti,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create language profile: %w", err)
+	}
+
+	s.logger.Info().Int64("id", row.ID).Str("name", input.Name).Msg("Created language profile")
+	return rowToProfile(row)
+}
+
+// Update updates an existing language profile.
+func (s *Service) Update(ctx context.Context, id int64, input *ProfileInput) (*Profile, error) {
+	if err := input.Validate(); err != nil {
+		return nil, err
+	}
+	languages, err := serializeLanguages(input.Languages)
+	if err != nil {
+		return nil, err
+	}
+
+	row, err := s.queries.UpdateLanguageProfile(ctx, sqlc.UpdateLanguageProfileParams{
+		Name:        input.Name,
+		Languages:   languages,
+		Required:    input.Required,
+		AcceptMulti: input.AcceptMulti,
+		ID:          id,
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrProfileNotFound
+		}
+		return nil, fmt.Errorf("failed to update language profile: %w", err)
+	}
+
+	s.logger.Info().Int64("id", id).Str("name", input.Name).Msg("Updated language profile")
+	return rowToProfile(row)
+}
+
+// Delete deletes a language profile. Movies and series using it fall back to no profile.
+func (s *Service) Delete(ctx context.Context, id int64) error {
+	if _, err := s.Get(ctx, id); err != nil {
+		return err
+	}
+	if err := s.queries.DeleteLanguageProfile(ctx, id); err != nil {
+		return fmt.Errorf("failed to delete language profile: %w", err)
+	}
+	s.logger.Info().Int64("id", id).Msg("Deleted language profile")
+	return nil
+}
+
+// ForMovie returns the language profile assigned to a movie, or nil if none is assigned.
+func (s *Service) ForMovie(ctx context.Context, movieID int64) (*Profile, error) {
+	return s.forEntity(s.queries.GetLanguageProfileForMovie(ctx, movieID))
+}
+
+// ForSeries returns the language profile assigned to a series, or nil if none is assigned.
+func (s *Service) ForSeries(ctx context.Context, seriesID int64) (*Profile, error) {
+	return s.forEntity(s.queries.GetLanguageProfileForSeries(ctx, seriesID))
+}
+
+func (s *Service) forEntity(row *sqlc.LanguageProfile, err error) (*Profile, error) {
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get language profile: %w", err)
+	}
+	return rowToProfile(row)
+}
+
+func serializeLanguages(languages []string) (string, error) {
+	if languages == nil {
+		languages = []string{}
+	}
+	data, err := json.Marshal(languages)
+	if err != nil {
+		return "", fmt.Errorf("failed to serialize languages: %w", err)
+	}
+	return string(data), nil
+}
+
+func rowToProfile(row *sqlc.LanguageProfile) (*Profile, error) {
+	languages := []string{}
+	if err := json.Unmarshal([]byte(row.Languages), &languages); err != nil {
+		return nil, fmt.Errorf("failed to deserialize languages: %w", err)
+	}
+	p := &Profile{
+		ID:          row.ID,
+		Name:        row.Name,
+		Languages:   languages,
+		Required:    row.Required,
+		AcceptMulti: row.AcceptMulti,
+	}
+	if row.CreatedAt.Valid {
+		p.CreatedAt = row.CreatedAt.Time
+	}
+	if row.UpdatedAt.Valid {
+		p.UpdatedAt = row.UpdatedAt.Time
+	}
+	return p, nil
+}
@@ -0,0 +1,111 @@
+package language
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/slipstream/slipstream/internal/database/sqlc"
+	"github.com/slipstream/slipstream/internal/module/parseutil"
+	"github.com/slipstream/slipstream/internal/testutil"
+)
+
+func TestService_ProfileCRUD(t *testing.T) {
+	tdb := testutil.NewTestDB(t)
+	defer tdb.Close()
+
+	ctx := context.Background()
+	svc := NewService(tdb.Conn, &tdb.Logger)
+
+	created, err := svc.Create(ctx, &ProfileInput{Name: "French", Languages: []string{"French", "English"}, Required: true, AcceptMulti: true})
+	if err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+	if created.PreferredLanguage() != "French" {
+		t.Errorf("PreferredLanguage() = %q, want French", created.PreferredLanguage())
+	}
+
+	updated, err := svc.Update(ctx, created.ID, &ProfileInput{Name: "German", Languages: []string{"German"}})
+	if err != nil {
+		t.Fatalf("Update() error = %v", err)
+	}
+	if updated.Name != "German" || updated.Required || len(updated.Languages) != 1 {
+		t.Errorf("Update() = %+v", updated)
+	}
+
+	if _, err := svc.Create(ctx, &ProfileInput{Name: "Bad", Languages: []string{"Klingon"}}); !errors.Is(err, ErrInvalidProfile) {
+		t.Errorf("Create() with unknown language error = %v, want ErrInvalidProfile", err)
+	}
+
+	if err := svc.Delete(ctx, created.ID); err != nil {
+		t.Fatalf("Delete() error = %v", err)
+	}
+	if _, err := svc.Get(ctx, created.ID); !errors.Is(err, ErrProfileNotFound) {
+		t.Errorf("Get() after delete error = %v, want ErrProfileNotFound", err)
+	}
+}
+
+func TestService_ForMovie(t *testing.T) {
+	tdb := testutil.NewTestDB(t)
+	defer tdb.Close()
+
+	ctx := context.Background()
+	queries := sqlc.New(tdb.Conn)
+	svc := NewService(tdb.Conn, &tdb.Logger)
+
+	movie, err := queries.CreateMovie(ctx, sqlc.CreateMovieParams{Title: "Amélie", SortTitle: "amelie", Status: "missing"})
+	if err != nil {
+		t.Fatalf("CreateMovie() error = %v", err)
+	}
+
+	got, err := svc.ForMovie(ctx, movie.ID)
+	if err != nil || got != nil {
+		t.Fatalf("ForMovie() without profile = %v, %v; want nil, nil", got, err)
+	}
+
+	profile, err := svc.Create(ctx, &ProfileInput{Name: "French", Languages: []string{"French"}, Required: true})
+	if err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+	if _, err := tdb.Conn.ExecContext(ctx, "UPDATE movies SET language_profile_id = ? WHERE id = ?", profile.ID, movie.ID); err != nil {
+		t.Fatalf("assign profile: %v", err)
+	}
+
+	got, err = svc.ForMovie(ctx, movie.ID)
+	if err != nil {
+		t.Fatalf("ForMovie() error = %v", err)
+	}
+	if got == nil || got.ID != profile.ID {
+		t.Errorf("ForMovie() = %+v, want profile %d", got, profile.ID)
+	}
+}
+
+func TestProfile_Accepts(t *testing.T) {
+	french := Profile{Languages: []string{"French"}, Required: true}
+	frenchMulti := Profile{Languages: []string{"French"}, Required: true, AcceptMulti: true}
+	english := Profile{Languages: []string{"English"}, Required: true}
+
+	tests := []struct {
+		name    string
+		profile Profile
+		release string
+		want    bool
+	}{
+		{"empty profile accepts anything", Profile{}, "Movie.2024.German.1080p.mkv", true},
+		{"matching language", french, "Movie.2024.FRENCH.1080p.mkv", true},
+		{"untagged is English", french, "Movie.2024.1080p.mkv", false},
+		{"vostfr is not French audio", french, "Movie.2024.VOSTFR.1080p.mkv", false},
+		{"multi rejected without acceptMulti", french, "Movie.2024.MULTi.1080p.mkv", false},
+		{"multi accepted with acceptMulti", frenchMulti, "Movie.2024.MULTi.1080p.mkv", true},
+		{"untagged accepted for English", english, "Movie.2024.1080p.mkv", true},
+		{"multi includes English", english, "Movie.2024.MULTi.1080p.mkv", true},
+		{"foreign rejected for English", english, "Movie.2024.German.1080p.mkv", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.profile.Accepts(parseutil.ParseLanguages(tt.release)); got != tt.want {
+				t.Errorf("Accepts(%q) = %v, want %v", tt.release, got, tt.want)
+			}
+		})
+	}
+}
@@ -12,29 +12,50 @@ import (
 )
 
 type AddMovieInput struct {
-	Title                 string `json:"title"`
-	Year                  int    `json:"year,omitempty"`
-	TmdbID                int    `json:"tmdbId,omitempty"`
-	ImdbID                string `json:"imdbId,omitempty"`
-	Overview              string `json:"overview,omitempty"`
-	Runtime               int    `json:"runtime,omitempty"`
-	Path                  string `json:"path,omitempty"`
-	RootFolderID          int64  `json:"rootFolderId"`
-	QualityProfileID      int64  `json:"qualityProfileId"`
-	Monitored             bool   `json:"monitored"`
-	PosterURL             string `json:"posterUrl,omitempty"`
-	BackdropURL           string `json:"backdropUrl,omitempty"`
-	ReleaseDate           string `json:"releaseDate,omitempty"`           // Digital/streaming release date
-	PhysicalReleaseDate   string `json:"physicalReleaseDate,omitempty"`   // Bluray release date
-	TheatricalReleaseDate string `json:"theatricalReleaseDate,omitempty"` // Theatrical release date
-	Studio                string `json:"studio,omitempty"`
-	ContentRating         string `json:"contentRating,omitempty"`
-	SearchOnAdd           *bool  `json:"searchOnAdd,omitempty"` // Trigger autosearch after add
-	AddedBy               *int64 `json:"-"`
+	Title                 string  `json:"title"`
+	Year                  int     `json:"year,omitempty"`
+	TmdbID                int     `json:"tmdbId,omitempty"`
+	ImdbID                string  `json:"imdbId,omitempty"`
+	Overview              string  `json:"overview,omitempty"`
+	Runtime               int     `json:"runtime,omitempty"`
+	Path                  string  `json:"path,omitempty"`
+	RootFolderID          int64   `json:"rootFolderId"`
+	QualityProfileID      int64   `json:"qualityProfileId"`
+	LanguageProfileID     int64   `json:"languageProfileId,omitempty"`
+	Monitored             bool    `json:"monitored"`
+	PosterURL             string  `json:"posterUrl,omitempty"`
+	BackdropURL           string  `json:"backdropUrl,omitempty"`
+	ReleaseDate           string  `json:"releaseDate,omitempty"`           // Digital/streaming release date
+	PhysicalReleaseDate   string  `json:"physicalReleaseDate,omitempty"`   // Bluray release date
+	TheatricalReleaseDate string  `json:"theatricalReleaseDate,omitempty"` // Theatrical release date
+	Studio                string  `json:"studio,omitempty"`
+	ContentRating         string  `json:"contentRating,omitempty"`
+	SearchOnAdd           *bool   `json:"searchOnAdd,omitempty"` // Trigger autosearch after add
+	Tags                  []int64 `json:"tags,omitempty"`
+	AddedBy               *int64  `json:"-"`
 }
 
 // AddMovie creates a new movie and downloads artwork in the background.
 func (s *Service) AddMovie(ctx context.Context, input *AddMovieInput) (*movies.Movie, error) {
+	if err := s.checkPathInRoot(ctx, mediaTypeMovie, input.Path, input.RootFolderID); err != nil {
+		return nil, err
+	}
+	if err := s.checkPathConflict(ctx, &pathCandidate{
+		mediaType:    mediaTypeMovie,
+		title:        input.Title,
+		year:         input.Year,
+		tmdbID:       input.TmdbID,
+		path:         input.Path,
+		rootFolderID: input.RootFolderID,
+	}); err != nil {
+		return nil, err
+	}
+
+	return s.addMovie(ctx, input)
+}
+
+// addMovie creates the movie once the caller has vetted its folder.
+func (s *Service) addMovie(ctx context.Context, input *AddMovieInput) (*movies.Movie, error) {
 	releaseDate, physicalReleaseDate, theatricalReleaseDate := s.fetchMovieReleaseDates(ctx, input)
 	contentRating := s.fetchMovieContentRating(ctx, input)
 
@@ -48,18 +69,22 @@ func (s *Service) AddMovie(ctx context.Context, input *AddMovieInput) (*movies.M
 		Path:                  input.Path,
 		RootFolderID:          input.RootFolderID,
 		QualityProfileID:      input.QualityProfileID,
+		LanguageProfileID:     input.LanguageProfileID,
 		Monitored:             input.Monitored,
 		ReleaseDate:           releaseDate,
 		PhysicalReleaseDate:   physicalReleaseDate,
 		TheatricalReleaseDate: theatricalReleaseDate,
 		Studio:                input.Studio,
 		ContentRating:         contentRating,
+		Tags:                  input.Tags,
 		AddedBy:               input.AddedBy,
 	})
 	if err != nil {
 		return nil, err
 	}
 
+	s.updateMovieAlternateTitles(ctx, movie.ID, movie.Title, input.TmdbID)
+	s.updateMovieCredits(ctx, movie.ID, input.TmdbID)
 	s.downloadMovieArtworkIfNeeded(ctx, input)
 	s.triggerMovieSearchIfNeeded(movie, input.SearchOnAdd)
 	s.saveMoviePreferenceIfNeeded(input.SearchOnAdd)
@@ -166,31 +191,33 @@ func (s *Service) saveMoviePreferenceIfNeeded(searchOnAdd *bool) {
 
 // AddSeriesInput contains fields for adding a series with artwork.
 type AddSeriesInput struct {
-	Title            string           `json:"title"`
-	Year             int              `json:"year,omitempty"`
-	TvdbID           int              `json:"tvdbId,omitempty"`
-	TmdbID           int              `json:"tmdbId,omitempty"`
-	ImdbID           string           `json:"imdbId,omitempty"`
-	Overview         string           `json:"overview,omitempty"`
-	Runtime          int              `json:"runtime,omitempty"`
-	ProductionStatus string           `json:"productionStatus,omitempty"` // "continuing", "ended", "upcoming"
-	Path             string           `json:"path,omitempty"`
-	RootFolderID     int64            `json:"rootFolderId"`
-	QualityProfileID int64            `json:"qualityProfileId"`
-	Monitored        bool             `json:"monitored"`
-	SeasonFolder     bool             `json:"seasonFolder"`
-	Seasons          []tv.SeasonInput `json:"seasons,omitempty"`
-	Network          string           `json:"network,omitempty"`
-	NetworkLogoURL   string           `json:"networkLogoUrl,omitempty"`
-	PosterURL        string           `json:"posterUrl,omitempty"`
-	BackdropURL      string           `json:"backdropUrl,omitempty"`
+	Title             string           `json:"title"`
+	Year              int              `json:"year,omitempty"`
+	TvdbID            int              `json:"tvdbId,omitempty"`
+	TmdbID            int              `json:"tmdbId,omitempty"`
+	ImdbID            string           `json:"imdbId,omitempty"`
+	Overview          string           `json:"overview,omitempty"`
+	Runtime           int              `json:"runtime,omitempty"`
+	ProductionStatus  string           `json:"productionStatus,omitempty"` // "continuing", "ended", "upcoming"
+	Path              string           `json:"path,omitempty"`
+	RootFolderID      int64            `json:"rootFolderId"`
+	QualityProfileID  int64            `json:"qualityProfileId"`
+	LanguageProfileID int64            `json:"languageProfileId,omitempty"`
+	Monitored         bool             `json:"monitored"`
+	SeasonFolder      bool             `json:"seasonFolder"`
+	Seasons           []tv.SeasonInput `json:"seasons,omitempty"`
+	Network           string           `json:"network,omitempty"`
+	NetworkLogoURL    string           `json:"networkLogoUrl,omitempty"`
+	PosterURL         string           `json:"posterUrl,omitempty"`
+	BackdropURL       string           `json:"backdropUrl,omitempty"`
 
 	// Search and monitoring options for add flow
 	SearchOnAdd     *string `json:"searchOnAdd,omitempty"`     // "no", "first_episode", "first_season", "latest_season", "all"
-	MonitorOnAdd    *string `json:"monitorOnAdd,omitempty"`    // "none", "first_season", "latest_season", "future", "all"
+	MonitorOnAdd    *string `json:"monitorOnAdd,omitempty"`    // "none", "first_season", "latest_season", "future", "pilot", "all"
 	IncludeSpecials *bool   `json:"includeSpecials,omitempty"` // Whether to include specials in monitoring/search
 
-	AddedBy *int64 `json:"-"`
+	Tags    []int64 `json:"tags,omitempty"`
+	AddedBy *int64  `json:"-"`
 }
 
 // applyMonitoringOnAdd applies the monitoring-on-add settings to a newly added series
@@ -221,6 +248,8 @@ func (s *Service) applyMonitoringType(ctx context.Context, seriesID int64, monit
 		return s.applyMonitorLatestSeason(ctx, seriesID)
 	case preferences.SeriesMonitorOnAddFuture:
 		return s.applyMonitorFuture(ctx, seriesID)
+	case preferences.SeriesMonitorOnAddPilot:
+		return s.applyMonitorPilot(ctx, seriesID)
 	case preferences.SeriesMonitorOnAddAll:
 		return nil
 	}
@@ -281,6 +310,10 @@ func (s *Service) applyMonitorFuture(ctx context.Context, seriesID int64) error
 	})
 }
 
+func (s *Service) applyMonitorPilot(ctx context.Context, seriesID int64) error {
+	return s.tv.BulkMonitor(ctx, seriesID, tv.BulkMonitorInput{MonitorType: tv.MonitorTypePilot})
+}
+
 func (s *Service) unmonitorAll(ctx context.Context, seriesID int64) error {
 	if err := s.queries.UpdateAllEpisodesMonitoredBySeries(ctx, sqlc.UpdateAllEpisodesMonitoredBySeriesParams{
 		Monitored: false,
@@ -343,30 +376,54 @@ func boolPtr(b bool) *bool {
 
 // AddSeries creates a new series, fetches metadata, and downloads artwork in the background.
 func (s *Service) AddSeries(ctx context.Context, input *AddSeriesInput) (*tv.Series, error) {
+	if err := s.checkPathInRoot(ctx, mediaTypeSeries, input.Path, input.RootFolderID); err != nil {
+		return nil, err
+	}
+	if err := s.checkPathConflict(ctx, &pathCandidate{
+		mediaType:    mediaTypeSeries,
+		title:        input.Title,
+		year:         input.Year,
+		tmdbID:       input.TmdbID,
+		tvdbID:       input.TvdbID,
+		path:         input.Path,
+		rootFolderID: input.RootFolderID,
+	}); err != nil {
+		return nil, err
+	}
+
+	return s.addSeries(ctx, input)
+}
+
+// addSeries creates the series once the caller has vetted its folder.
+func (s *Service) addSeries(ctx context.Context, input *AddSeriesInput) (*tv.Series, error) {
 	series, err := s.tv.CreateSeries(ctx, &tv.CreateSeriesInput{
-		Title:            input.Title,
-		Year:             input.Year,
-		TvdbID:           input.TvdbID,
-		TmdbID:           input.TmdbID,
-		ImdbID:           input.ImdbID,
-		Overview:         input.Overview,
-		Runtime:          input.Runtime,
-		ProductionStatus: input.ProductionStatus,
-		Network:          input.Network,
-		NetworkLogoURL:   input.NetworkLogoURL,
-		Path:             input.Path,
-		RootFolderID:     input.RootFolderID,
-		QualityProfileID: input.QualityProfileID,
-		Monitored:        input.Monitored,
-		SeasonFolder:     input.SeasonFolder,
-		Seasons:          input.Seasons,
-		AddedBy:          input.AddedBy,
+		Title:             input.Title,
+		Year:              input.Year,
+		TvdbID:            input.TvdbID,
+		TmdbID:            input.TmdbID,
+		ImdbID:            input.ImdbID,
+		Overview:          input.Overview,
+		Runtime:           input.Runtime,
+		ProductionStatus:  input.ProductionStatus,
+		Network:           input.Network,
+		NetworkLogoURL:    input.NetworkLogoURL,
+		Path:              input.Path,
+		RootFolderID:      input.RootFolderID,
+		QualityProfileID:  input.QualityProfileID,
+		LanguageProfileID: input.LanguageProfileID,
+		Monitored:         input.Monitored,
+		SeasonFolder:      input.SeasonFolder,
+		Seasons:           input.Seasons,
+		Tags:              input.Tags,
+		AddedBy:           input.AddedBy,
 	})
 	if err != nil {
 		return nil, err
 	}
 
 	s.fetchAndUpdateSeasonMetadata(ctx, series.ID, input.TmdbID, input.TvdbID)
+	s.updateSeriesAlternateTitles(ctx, series.ID, series.Title, input.TvdbID, input.TmdbID)
+	s.updateSeriesCredits(ctx, series.ID, input.TmdbID)
 	s.downloadSeriesArtworkAsync(ctx, input)
 	s.applyMonitoringSettings(ctx, series.ID, input.MonitorOnAdd, input.IncludeSpecials)
 	s.saveSeriesPreferences(input.SearchOnAdd, input.MonitorOnAdd, input.IncludeSpecials)
@@ -398,6 +455,21 @@ func (s *Service) fetchAndUpdateSeasonMetadata(ctx context.Context, seriesID int
 		Int("seasons", len(seasonMeta)).
 		Int("episodes", totalEpisodes).
 		Msg("Updated seasons and episodes for new series")
+
+	s.downloadSeasonArtworkAsync(tmdbID, tvdbID, seasonResults)
+}
+
+// downloadSeasonArtworkAsync caches season posters and episode stills in the
+// background; a long-running show can have hundreds of stills.
+func (s *Service) downloadSeasonArtworkAsync(tmdbID, tvdbID int, seasons []metadata.SeasonResult) {
+	if s.artwork == nil {
+		return
+	}
+	go func() {
+		if err := s.artwork.DownloadSeasonArtwork(context.Background(), tmdbID, tvdbID, seasons); err != nil {
+			s.logger.Warn().Err(err).Int("tmdbId", tmdbID).Int("tvdbId", tvdbID).Msg("Failed to download season artwork")
+		}
+	}()
 }
 
 func (s *Service) countTotalEpisodes(seasonMeta []tv.SeasonMetadata) int {
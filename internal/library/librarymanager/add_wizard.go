@@ -0,0 +1,565 @@
+package librarymanager
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/slipstream/slipstream/internal/defaults"
+	"github.com/slipstream/slipstream/internal/filesystem"
+	"github.com/slipstream/slipstream/internal/filesystem/mock"
+	"github.com/slipstream/slipstream/internal/library/movies"
+	"github.com/slipstream/slipstream/internal/library/rootfolder"
+	"github.com/slipstream/slipstream/internal/metadata"
+	"github.com/slipstream/slipstream/internal/preferences"
+)
+
+// The add wizard walks a new movie or series through three steps: lookup of
+// metadata matches flagged when already in the library, options listing root
+// folders and quality profiles with per-option checks, and create, which runs
+// the same checks before adding.
+
+// ErrInvalidAddMediaType is returned for a media type other than movie or series.
+var ErrInvalidAddMediaType = errors.New("media type must be movie or series")
+
+// Add check severities. Errors block the add; warnings are informational.
+const (
+	AddCheckError   = "error"
+	AddCheckWarning = "warning"
+)
+
+// Add check codes.
+const (
+	AddCheckDuplicate             = "duplicate"
+	AddCheckRootFolderNotFound    = "root_folder_not_found"
+	AddCheckRootFolderWrongType   = "root_folder_wrong_type"
+	AddCheckRootFolderMissing     = "root_folder_missing"
+	AddCheckNoFreeSpace           = "no_free_space"
+	AddCheckLowFreeSpace          = "low_free_space"
+	AddCheckPathConflict          = "path_conflict"
+	AddCheckPathOutsideRoot       = "path_outside_root"
+	AddCheckProfileNotFound       = "quality_profile_not_found"
+	AddCheckProfileWrongType      = "quality_profile_wrong_type"
+	AddCheckRootFolderUnspecified = "root_folder_required"
+	AddCheckProfileUnspecified    = "quality_profile_required"
+)
+
+// StorageInfoProvider reports free space on the volumes holding root folders.
+type StorageInfoProvider interface {
+	GetStorageInfo(ctx context.Context) ([]filesystem.StorageInfo, error)
+}
+
+// AddCheck is one validation finding for an add option or selection.
+type AddCheck struct {
+	Field    string `json:"field"`
+	Code     string `json:"code"`
+	Severity string `json:"severity"`
+	Message  string `json:"message"`
+}
+
+// AddLookupResult is a metadata match annotated with its library state.
+type AddLookupResult struct {
+	TmdbID    int    `json:"tmdbId,omitempty"`
+	TvdbID    int    `json:"tvdbId,omitempty"`
+	ImdbID    string `json:"imdbId,omitempty"`
+	Title     string `json:"title"`
+	Year      int    `json:"year,omitempty"`
+	Overview  string `json:"overview,omitempty"`
+	PosterURL string `json:"posterUrl,omitempty"`
+	InLibrary bool   `json:"inLibrary"`
+	LibraryID int64  `json:"libraryId,omitempty"`
+	Metadata  any    `json:"metadata"`
+}
+
+// AddOptionsRequest describes the item being added and the current selection.
+type AddOptionsRequest struct {
+	Title            string `json:"title"`
+	Year             int    `json:"year,omitempty"`
+	TmdbID           int    `json:"tmdbId,omitempty"`
+	TvdbID           int    `json:"tvdbId,omitempty"`
+	Path             string `json:"path,omitempty"`
+	RootFolderID     int64  `json:"rootFolderId,omitempty"`
+	QualityProfileID int64  `json:"qualityProfileId,omitempty"`
+}
+
+// RootFolderOption is a root folder the item can be added to.
+type RootFolderOption struct {
+	*rootfolder.RootFolder
+	ItemPath  string     `json:"itemPath"`
+	FreeSpace int64      `json:"freeSpace"`
+	Valid     bool       `json:"valid"`
+	Checks    []AddCheck `json:"checks"`
+}
+
+// QualityProfileOption is a quality profile the item can use.
+type QualityProfileOption struct {
+	ID        int64  `json:"id"`
+	Name      string `json:"name"`
+	IsDefault bool   `json:"isDefault"`
+}
+
+// AddOptions is the options step of the add wizard.
+type AddOptions struct {
+	RootFolders     []RootFolderOption              `json:"rootFolders"`
+	QualityProfiles []QualityProfileOption          `json:"qualityProfiles"`
+	Preferences     *preferences.AddFlowPreferences `json:"preferences,omitempty"`
+	Checks          []AddCheck                      `json:"checks"`
+	Valid           bool                            `json:"valid"`
+}
+
+// AddValidationError is returned by the create step when the selection fails
+// a blocking check.
+type AddValidationError struct {
+	Checks []AddCheck `json:"checks"`
+}
+
+func (e *AddValidationError) Error() string {
+	for _, c := range e.Checks {
+		if c.Severity == AddCheckError {
+			return c.Message
+		}
+	}
+	return "add validation failed"
+}
+
+// SetDefaultsService sets the service the default root folder and quality
+// profile are read from.
+func (s *Service) SetDefaultsService(svc *defaults.Service) {
+	s.defaults = svc
+}
+
+// SetFreeSpaceCheck sets the volume free space source and the threshold, in
+// bytes, below which a root folder is flagged as low on space.
+func (s *Service) SetFreeSpaceCheck(threshold func() int64, storage StorageInfoProvider) {
+	s.freeSpaceThreshold = threshold
+	s.storageInfo = storage
+}
+
+// LookupForAdd searches metadata for a movie or series and flags matches that
+// are already in the library. A tmdbID or tvdbID looks up that title directly.
+func (s *Service) LookupForAdd(ctx context.Context, mediaType, term string, year, tmdbID, tvdbID int) ([]AddLookupResult, error) {
+	switch mediaType {
+	case mediaTypeMovie:
+		return s.lookupMovies(ctx, term, year, tmdbID)
+	case mediaTypeSeries:
+		return s.lookupSeries(ctx, term, tmdbID, tvdbID)
+	default:
+		return nil, ErrInvalidAddMediaType
+	}
+}
+
+func (s *Service) lookupMovies(ctx context.Context, term string, year, tmdbID int) ([]AddLookupResult, error) {
+	if s.metadata == nil || !s.metadata.HasMovieProvider() {
+		return nil, ErrNoMetadataProvider
+	}
+
+	var results []metadata.MovieResult
+	if tmdbID > 0 {
+		movie, err := s.metadata.GetMovie(ctx, tmdbID)
+		if err != nil {
+			return nil, err
+		}
+		results = []metadata.MovieResult{*movie}
+	} else {
+		var err error
+		if results, err = s.metadata.SearchMovies(ctx, term, year); err != nil {
+			return nil, err
+		}
+	}
+
+	out := make([]AddLookupResult, 0, len(results))
+	for i := range results {
+		r := &results[i]
+		item := AddLookupResult{
+			TmdbID: r.ID, ImdbID: r.ImdbID, Title: r.Title, Year: r.Year,
+			Overview: r.Overview, PosterURL: r.PosterURL, Metadata: r,
+		}
+		id, _, err := s.movieInLibrary(ctx, r.ID)
+		if err != nil {
+			return nil, err
+		}
+		item.InLibrary, item.LibraryID = id > 0, id
+		out = append(out, item)
+	}
+	return out, nil
+}
+
+func (s *Service) lookupSeries(ctx context.Context, term string, tmdbID, tvdbID int) ([]AddLookupResult, error) {
+	if s.metadata == nil || !s.metadata.HasSeriesProvider() {
+		return nil, ErrNoMetadataProvider
+	}
+
+	var results []metadata.SeriesResult
+	if tmdbID > 0 || tvdbID > 0 {
+		series, err := s.metadata.GetSeries(ctx, tvdbID, tmdbID)
+		if err != nil {
+			return nil, err
+		}
+		results = []metadata.SeriesResult{*series}
+	} else {
+		var err error
+		if results, err = s.metadata.SearchSeries(ctx, term); err != nil {
+			return nil, err
+		}
+	}
+
+	out := make([]AddLookupResult, 0, len(results))
+	for i := range results {
+		r := &results[i]
+		item := AddLookupResult{
+			TmdbID: r.TmdbID, TvdbID: r.TvdbID, ImdbID: r.ImdbID, Title: r.Title, Year: r.Year,
+			Overview: r.Overview, PosterURL: r.PosterURL, Metadata: r,
+		}
+		id, _, err := s.seriesInLibrary(ctx, r.TvdbID, r.TmdbID)
+		if err != nil {
+			return nil, err
+		}
+		item.InLibrary, item.LibraryID = id > 0, id
+		out = append(out, item)
+	}
+	return out, nil
+}
+
+// AddOptions lists root folders and quality profiles for the item with checks
+// for each option, and validates the current selection.
+func (s *Service) AddOptions(ctx context.Context, mediaType string, req *AddOptionsRequest) (*AddOptions, error) {
+	moduleType, err := addModuleType(mediaType)
+	if err != nil {
+		return nil, err
+	}
+
+	opts := &AddOptions{Checks: []AddCheck{}}
+
+	folders, err := s.rootfolders.ListByType(ctx, moduleType)
+	if err != nil {
+		return nil, err
+	}
+	free := s.volumeFreeSpace(ctx)
+	for _, rf := range folders {
+		opts.RootFolders = append(opts.RootFolders, s.rootFolderOption(ctx, mediaType, req, rf, free))
+	}
+
+	profiles, err := s.qualityProfiles.ListByModule(ctx, moduleType)
+	if err != nil {
+		return nil, err
+	}
+	defaultProfile := s.defaultEntityID(ctx, defaults.EntityTypeQualityProfile, moduleType)
+	for _, p := range profiles {
+		opts.QualityProfiles = append(opts.QualityProfiles, QualityProfileOption{ID: p.ID, Name: p.Name, IsDefault: p.ID == defaultProfile})
+	}
+
+	if s.preferencesSvc != nil {
+		if prefs, err := s.preferencesSvc.GetAddFlowPreferences(ctx); err == nil {
+			opts.Preferences = prefs
+		}
+	}
+
+	checks, err := s.validateAdd(ctx, mediaType, req, free)
+	if err != nil {
+		return nil, err
+	}
+	opts.Checks = checks
+	opts.Valid = !hasBlockingCheck(checks)
+	return opts, nil
+}
+
+// ValidateAdd runs the create-step checks for a selection. It returns an
+// *AddValidationError when a blocking check fails.
+func (s *Service) ValidateAdd(ctx context.Context, mediaType string, req *AddOptionsRequest) error {
+	checks, err := s.validateAdd(ctx, mediaType, req, s.volumeFreeSpace(ctx))
+	if err != nil {
+		return err
+	}
+	if hasBlockingCheck(checks) {
+		return &AddValidationError{Checks: checks}
+	}
+	return nil
+}
+
+// AddMovieByTmdbID adds a movie known only by its TMDB ID, filling the rest
+// of input from metadata and placing it at the root folder's default path.
+// It runs the create-step checks first, so a movie already in the library
+// fails with an *AddValidationError.
+func (s *Service) AddMovieByTmdbID(ctx context.Context, input *AddMovieInput) (*movies.Movie, error) {
+	if s.metadata == nil || !s.metadata.HasMovieProvider() {
+		return nil, ErrNoMetadataProvider
+	}
+	movie, err := s.metadata.GetMovie(ctx, input.TmdbID)
+	if err != nil {
+		return nil, err
+	}
+	input.Title = movie.Title
+	input.Year = movie.Year
+	input.ImdbID = movie.ImdbID
+	input.Overview = movie.Overview
+	input.Runtime = movie.Runtime
+	input.Studio = movie.Studio
+	input.PosterURL = movie.PosterURL
+	input.BackdropURL = movie.BackdropURL
+
+	req := &AddOptionsRequest{
+		Title:            input.Title,
+		Year:             input.Year,
+		TmdbID:           input.TmdbID,
+		Path:             input.Path,
+		RootFolderID:     input.RootFolderID,
+		QualityProfileID: input.QualityProfileID,
+	}
+	if err := s.ValidateAdd(ctx, mediaTypeMovie, req); err != nil {
+		return nil, err
+	}
+	if input.Path == "" {
+		rf, err := s.rootfolders.Get(ctx, input.RootFolderID)
+		if err != nil {
+			return nil, err
+		}
+		input.Path = s.addPathCandidate(mediaTypeMovie, req, rf.ID).defaultPath(rf.Path)
+	}
+	return s.AddMovie(ctx, input)
+}
+
+func (s *Service) validateAdd(ctx context.Context, mediaType string, req *AddOptionsRequest, free map[int64]int64) ([]AddCheck, error) {
+	moduleType, err := addModuleType(mediaType)
+	if err != nil {
+		return nil, err
+	}
+
+	checks := []AddCheck{}
+	title, err := s.findDuplicate(ctx, mediaType, req.TmdbID, req.TvdbID)
+	if err != nil {
+		return nil, err
+	}
+	if title != "" {
+		checks = append(checks, AddCheck{Field: "item", Code: AddCheckDuplicate, Severity: AddCheckError,
+			Message: fmt.Sprintf("%s is already in the library", title)})
+	}
+
+	if req.RootFolderID == 0 {
+		checks = append(checks, AddCheck{Field: "rootFolderId", Code: AddCheckRootFolderUnspecified, Severity: AddCheckError,
+			Message: "A root folder is required"})
+	} else {
+		rf, err := s.rootfolders.Get(ctx, req.RootFolderID)
+		switch {
+		case errors.Is(err, rootfolder.ErrRootFolderNotFound):
+			checks = append(checks, AddCheck{Field: "rootFolderId", Code: AddCheckRootFolderNotFound, Severity: AddCheckError,
+				Message: "Root folder not found"})
+		case err != nil:
+			return nil, err
+		case rf.MediaType != moduleType:
+			checks = append(checks, AddCheck{Field: "rootFolderId", Code: AddCheckRootFolderWrongType, Severity: AddCheckError,
+				Message: fmt.Sprintf("%s is a %s root folder", rf.Path, rf.MediaType)})
+		default:
+			rfChecks, err := s.rootFolderChecks(ctx, mediaType, req, rf, free)
+			if err != nil {
+				return nil, err
+			}
+			checks = append(checks, rfChecks...)
+		}
+	}
+
+	checks = append(checks, s.qualityProfileChecks(ctx, moduleType, req.QualityProfileID)...)
+	return checks, nil
+}
+
+func (s *Service) rootFolderOption(ctx context.Context, mediaType string, req *AddOptionsRequest, rf *rootfolder.RootFolder, free map[int64]int64) RootFolderOption {
+	c := s.addPathCandidate(mediaType, req, rf.ID)
+	opt := RootFolderOption{RootFolder: rf, ItemPath: c.defaultPath(rf.Path), FreeSpace: rf.FreeSpace}
+	if v, ok := free[rf.ID]; ok {
+		opt.FreeSpace = v
+	}
+	if req.Path != "" && req.RootFolderID == rf.ID {
+		opt.ItemPath = req.Path
+	}
+
+	checks, err := s.rootFolderChecks(ctx, mediaType, req, rf, free)
+	if err != nil {
+		checks = []AddCheck{{Field: "rootFolderId", Code: AddCheckRootFolderMissing, Severity: AddCheckError, Message: err.Error()}}
+	}
+	opt.Checks = checks
+	opt.Valid = !hasBlockingCheck(checks)
+	return opt
+}
+
+// rootFolderChecks verifies the folder exists, has space, and that the item's
+// folder under it is not taken.
+func (s *Service) rootFolderChecks(ctx context.Context, mediaType string, req *AddOptionsRequest, rf *rootfolder.RootFolder, free map[int64]int64) ([]AddCheck, error) {
+	checks := []AddCheck{}
+	if !rootFolderExists(rf.Path) {
+		checks = append(checks, AddCheck{Field: "rootFolderId", Code: AddCheckRootFolderMissing, Severity: AddCheckError,
+			Message: fmt.Sprintf("%s does not exist", rf.Path)})
+		return checks, nil
+	}
+
+	if space, ok := free[rf.ID]; ok {
+		threshold := int64(0)
+		if s.freeSpaceThreshold != nil {
+			threshold = s.freeSpaceThreshold()
+		}
+		switch {
+		case space <= 0:
+			checks = append(checks, AddCheck{Field: "rootFolderId", Code: AddCheckNoFreeSpace, Severity: AddCheckError,
+				Message: fmt.Sprintf("%s has no free space", rf.Path)})
+		case space < threshold:
+			checks = append(checks, AddCheck{Field: "rootFolderId", Code: AddCheckLowFreeSpace, Severity: AddCheckWarning,
+				Message: fmt.Sprintf("%s is below the free space guard; automatic grabs are paused", rf.Path)})
+		}
+	}
+
+	c := s.addPathCandidate(mediaType, req, rf.ID)
+	if req.RootFolderID != rf.ID {
+		c.path = ""
+	}
+	if c.path != "" && !pathInside(c.path, rf.Path) {
+		checks = append(checks, AddCheck{Field: "path", Code: AddCheckPathOutsideRoot, Severity: AddCheckError,
+			Message: fmt.Sprintf("%s is not inside %s", c.path, rf.Path)})
+		return checks, nil
+	}
+	err := s.checkPathConflict(ctx, c)
+	var conflict *PathConflictError
+	if errors.As(err, &conflict) {
+		checks = append(checks, AddCheck{Field: "path", Code: AddCheckPathConflict, Severity: AddCheckError, Message: conflict.Error()})
+		return checks, nil
+	}
+	return checks, err
+}
+
+func (s *Service) qualityProfileChecks(ctx context.Context, moduleType string, profileID int64) []AddCheck {
+	if profileID == 0 {
+		return []AddCheck{{Field: "qualityProfileId", Code: AddCheckProfileUnspecified, Severity: AddCheckError,
+			Message: "A quality profile is required"}}
+	}
+	profile, err := s.qualityProfiles.Get(ctx, profileID)
+	if err != nil {
+		return []AddCheck{{Field: "qualityProfileId", Code: AddCheckProfileNotFound, Severity: AddCheckError,
+			Message: "Quality profile not found"}}
+	}
+	if profile.ModuleType != "" && profile.ModuleType != moduleType {
+		return []AddCheck{{Field: "qualityProfileId", Code: AddCheckProfileWrongType, Severity: AddCheckError,
+			Message: fmt.Sprintf("%s is a %s quality profile", profile.Name, profile.ModuleType)}}
+	}
+	return nil
+}
+
+func (s *Service) addPathCandidate(mediaType string, req *AddOptionsRequest, rootFolderID int64) *pathCandidate {
+	return &pathCandidate{
+		mediaType:    mediaType,
+		title:        req.Title,
+		year:         req.Year,
+		tmdbID:       req.TmdbID,
+		tvdbID:       req.TvdbID,
+		path:         req.Path,
+		rootFolderID: rootFolderID,
+	}
+}
+
+// findDuplicate returns the title of the library item with the same metadata ID.
+func (s *Service) findDuplicate(ctx context.Context, mediaType string, tmdbID, tvdbID int) (string, error) {
+	var title string
+	var err error
+	if mediaType == mediaTypeMovie {
+		_, title, err = s.movieInLibrary(ctx, tmdbID)
+	} else {
+		_, title, err = s.seriesInLibrary(ctx, tvdbID, tmdbID)
+	}
+	return title, err
+}
+
+func (s *Service) movieInLibrary(ctx context.Context, tmdbID int) (int64, string, error) {
+	if tmdbID <= 0 {
+		return 0, "", nil
+	}
+	movie, err := s.queries.GetMovieByTmdbID(ctx, sql.NullInt64{Int64: int64(tmdbID), Valid: true})
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, "", nil
+	}
+	if err != nil {
+		return 0, "", err
+	}
+	return movie.ID, movie.Title, nil
+}
+
+func (s *Service) seriesInLibrary(ctx context.Context, tvdbID, tmdbID int) (int64, string, error) {
+	if tvdbID > 0 {
+		series, err := s.queries.GetSeriesByTvdbID(ctx, sql.NullInt64{Int64: int64(tvdbID), Valid: true})
+		if err == nil {
+			return series.ID, series.Title, nil
+		}
+		if !errors.Is(err, sql.ErrNoRows) {
+			return 0, "", err
+		}
+	}
+	if tmdbID > 0 {
+		series, err := s.queries.GetSeriesByTmdbID(ctx, sql.NullInt64{Int64: int64(tmdbID), Valid: true})
+		if err == nil {
+			return series.ID, series.Title, nil
+		}
+		if !errors.Is(err, sql.ErrNoRows) {
+			return 0, "", err
+		}
+	}
+	return 0, "", nil
+}
+
+// volumeFreeSpace maps root folder IDs to the free space on their volume.
+// Root folders on volumes the storage service cannot read are left out.
+func (s *Service) volumeFreeSpace(ctx context.Context) map[int64]int64 {
+	free := map[int64]int64{}
+	if s.storageInfo == nil {
+		return free
+	}
+	volumes, err := s.storageInfo.GetStorageInfo(ctx)
+	if err != nil {
+		s.logger.Warn().Err(err).Msg("Failed to read storage info for add options")
+		return free
+	}
+	for _, v := range volumes {
+		if v.TotalSpace <= 0 {
+			continue
+		}
+		for _, rf := range v.RootFolders {
+			free[rf.ID] = v.FreeSpace
+		}
+	}
+	return free
+}
+
+func (s *Service) defaultEntityID(ctx context.Context, entityType defaults.EntityType, moduleType string) int64 {
+	if s.defaults == nil {
+		return 0
+	}
+	entry, err := s.defaults.GetDefault(ctx, entityType, defaults.MediaType(moduleType))
+	if err != nil || entry == nil {
+		return 0
+	}
+	return entry.EntityID
+}
+
+func rootFolderExists(path string) bool {
+	if mock.IsMockPath(path) {
+		return mock.GetInstance().IsDirectory(path)
+	}
+	info, err := os.Stat(path)
+	return err == nil && info.IsDir()
+}
+
+func addModuleType(mediaType string) (string, error) {
+	switch mediaType {
+	case mediaTypeMovie:
+		return mediaTypeMovie, nil
+	case mediaTypeSeries:
+		return "tv", nil
+	default:
+		return "", ErrInvalidAddMediaType
+	}
+}
+
+func hasBlockingCheck(checks []AddCheck) bool {
+	for _, c := range checks {
+		if c.Severity == AddCheckError {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,94 @@
+package librarymanager
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"testing"
+
+	"github.com/slipstream/slipstream/internal/library/movies"
+	"github.com/slipstream/slipstream/internal/library/quality"
+	"github.com/slipstream/slipstream/internal/library/rootfolder"
+	"github.com/slipstream/slipstream/internal/testutil"
+)
+
+func TestAddWizardChecks(t *testing.T) {
+	tdb := testutil.NewTestDB(t)
+	defer tdb.Close()
+	ctx := context.Background()
+
+	qualitySvc := quality.NewService(tdb.Conn, &tdb.Logger)
+	moviesSvc := movies.NewService(tdb.Conn, nil, &tdb.Logger, qualitySvc, nil)
+	rootfolderSvc := rootfolder.NewService(tdb.Conn, &tdb.Logger, nil, nil)
+	svc := NewService(tdb.Conn, nil, moviesSvc, nil, nil, nil, rootfolderSvc, qualitySvc, nil, &tdb.Logger, nil, nil, nil)
+
+	movieRoot, err := rootfolderSvc.Create(ctx, rootfolder.CreateRootFolderInput{Path: t.TempDir(), MediaType: "movie"})
+	if err != nil {
+		t.Fatalf("Create movie root folder: %v", err)
+	}
+	tvRoot, err := rootfolderSvc.Create(ctx, rootfolder.CreateRootFolderInput{Path: t.TempDir(), MediaType: "tv"})
+	if err != nil {
+		t.Fatalf("Create tv root folder: %v", err)
+	}
+	base := quality.DefaultProfile()
+	profile, err := qualitySvc.Create(ctx, &quality.CreateProfileInput{Name: "Wizard", ModuleType: "movie", Cutoff: base.Cutoff, Items: base.Items})
+	if err != nil {
+		t.Fatalf("Create quality profile: %v", err)
+	}
+	if _, err := moviesSvc.Create(ctx, &movies.CreateMovieInput{Title: "Heat", Year: 1995, TmdbID: 949, RootFolderID: movieRoot.ID, QualityProfileID: profile.ID}); err != nil {
+		t.Fatalf("Create movie: %v", err)
+	}
+
+	codes := func(checks []AddCheck) map[string]bool {
+		out := map[string]bool{}
+		for _, c := range checks {
+			out[c.Code] = true
+		}
+		return out
+	}
+
+	req := &AddOptionsRequest{Title: "Dune", Year: 2021, TmdbID: 438631, RootFolderID: movieRoot.ID, QualityProfileID: profile.ID}
+	opts, err := svc.AddOptions(ctx, mediaTypeMovie, req)
+	if err != nil {
+		t.Fatalf("AddOptions() error = %v", err)
+	}
+	if !opts.Valid || len(opts.Checks) != 0 {
+		t.Errorf("valid selection: valid=%v checks=%+v", opts.Valid, opts.Checks)
+	}
+	if len(opts.RootFolders) != 1 || opts.RootFolders[0].ID != movieRoot.ID || !opts.RootFolders[0].Valid {
+		t.Errorf("root folder options = %+v, want the movie root folder only", opts.RootFolders)
+	}
+
+	dup := &AddOptionsRequest{Title: "Heat", Year: 1995, TmdbID: 949, RootFolderID: tvRoot.ID}
+	err = svc.ValidateAdd(ctx, mediaTypeMovie, dup)
+	var invalid *AddValidationError
+	if !errors.As(err, &invalid) {
+		t.Fatalf("ValidateAdd() error = %v, want *AddValidationError", err)
+	}
+	got := codes(invalid.Checks)
+	for _, code := range []string{AddCheckDuplicate, AddCheckRootFolderWrongType, AddCheckProfileUnspecified} {
+		if !got[code] {
+			t.Errorf("checks %v missing %s", got, code)
+		}
+	}
+
+	if err := svc.ValidateAdd(ctx, mediaTypeSeries, &AddOptionsRequest{Title: "Show", RootFolderID: tvRoot.ID, QualityProfileID: profile.ID}); !errors.As(err, &invalid) || !codes(invalid.Checks)[AddCheckProfileWrongType] {
+		t.Errorf("movie profile for series: err = %v, want %s", err, AddCheckProfileWrongType)
+	}
+
+	if _, err := svc.AddOptions(ctx, "music", req); !errors.Is(err, ErrInvalidAddMediaType) {
+		t.Errorf("AddOptions(music) error = %v, want ErrInvalidAddMediaType", err)
+	}
+
+	outside := &AddOptionsRequest{Title: "Dune", Year: 2021, TmdbID: 438631, Path: filepath.Join(tvRoot.Path, "Dune (2021)"),
+		RootFolderID: movieRoot.ID, QualityProfileID: profile.ID}
+	if err := svc.ValidateAdd(ctx, mediaTypeMovie, outside); !errors.As(err, &invalid) || !codes(invalid.Checks)[AddCheckPathOutsideRoot] {
+		t.Errorf("path outside root: err = %v, want %s", err, AddCheckPathOutsideRoot)
+	}
+	if err := svc.checkPathInRoot(ctx, mediaTypeMovie, outside.Path, 0); !errors.Is(err, ErrPathOutsideRoot) {
+		t.Errorf("checkPathInRoot() error = %v, want ErrPathOutsideRoot", err)
+	}
+	if err := svc.checkPathInRoot(ctx, mediaTypeMovie, filepath.Join(movieRoot.Path, "Dune (2021)"), 0); err != nil {
+		t.Errorf("checkPathInRoot() inside movie root error = %v", err)
+	}
+}
@@ -0,0 +1,40 @@
+package librarymanager
+
+import (
+	"context"
+)
+
+// updateMovieAlternateTitles stores a movie's AKA titles and translations so
+// releases and files named in another language still match it. Failures are
+// logged; matching then falls back to the primary title alone.
+func (s *Service) updateMovieAlternateTitles(ctx context.Context, movieID int64, title string, tmdbID int) {
+	if tmdbID == 0 || !s.metadata.HasMovieProvider() {
+		return
+	}
+
+	titles, err := s.metadata.GetMovieAlternateTitles(ctx, tmdbID)
+	if err != nil {
+		s.logger.Warn().Err(err).Int("tmdbId", tmdbID).Msg("Failed to fetch movie alternate titles")
+		return
+	}
+	if err := s.movies.SetAlternateTitles(ctx, movieID, title, titles); err != nil {
+		s.logger.Warn().Err(err).Int64("movieId", movieID).Msg("Failed to store movie alternate titles")
+	}
+}
+
+// updateSeriesAlternateTitles stores a series' TVDB aliases and TMDB
+// alternative titles and translations.
+func (s *Service) updateSeriesAlternateTitles(ctx context.Context, seriesID int64, title string, tvdbID, tmdbID int) {
+	if tvdbID == 0 && tmdbID == 0 {
+		return
+	}
+
+	titles, err := s.metadata.GetSeriesAlternateTitles(ctx, tvdbID, tmdbID)
+	if err != nil {
+		s.logger.Warn().Err(err).Int("tvdbId", tvdbID).Int("tmdbId", tmdbID).Msg("Failed to fetch series alternate titles")
+		return
+	}
+	if err := s.tv.SetAlternateTitles(ctx, seriesID, title, titles); err != nil {
+		s.logger.Warn().Err(err).Int64("seriesId", seriesID).Msg("Failed to store series alternate titles")
+	}
+}
@@ -0,0 +1,48 @@
+package librarymanager
+
+import (
+	"context"
+
+	"github.com/slipstream/slipstream/internal/library/movies"
+	"github.com/slipstream/slipstream/internal/library/tv"
+	"github.com/slipstream/slipstream/internal/metadata"
+)
+
+// ReleaseOrphanedArtwork drops artwork references for movies and series that
+// are no longer in the library. Artwork is keyed by TMDB ID, with series
+// falling back to their TVDB ID when no TMDB ID was known at download time.
+func (s *Service) ReleaseOrphanedArtwork(ctx context.Context) error {
+	movieList, err := s.movies.List(ctx, movies.ListMoviesOptions{})
+	if err != nil {
+		return err
+	}
+	ownedMovies := make(map[int]bool, len(movieList))
+	for _, m := range movieList {
+		ownedMovies[m.TmdbID] = true
+	}
+
+	seriesList, err := s.tv.ListSeries(ctx, tv.ListSeriesOptions{})
+	if err != nil {
+		return err
+	}
+	ownedSeries := make(map[int]bool, 2*len(seriesList))
+	for _, series := range seriesList {
+		ownedSeries[series.TmdbID] = true
+		ownedSeries[series.TvdbID] = true
+	}
+
+	releasedMovies, err := s.artwork.ReleaseUnowned(metadata.MediaTypeMovie, ownedMovies)
+	if err != nil {
+		return err
+	}
+	releasedSeries, err := s.artwork.ReleaseUnowned(metadata.MediaTypeSeries, ownedSeries)
+	if err != nil {
+		return err
+	}
+
+	s.logger.Info().
+		Int("movies", releasedMovies).
+		Int("series", releasedSeries).
+		Msg("Released artwork for items no longer in the library")
+	return nil
+}
@@ -0,0 +1,610 @@
+package librarymanager
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/slipstream/slipstream/internal/library/movies"
+	"github.com/slipstream/slipstream/internal/library/rootfolder"
+	"github.com/slipstream/slipstream/internal/library/scanner"
+	"github.com/slipstream/slipstream/internal/library/tv"
+	"github.com/slipstream/slipstream/internal/metadata"
+	"github.com/slipstream/slipstream/internal/pathutil"
+)
+
+const (
+	// maxImportAlternatives caps the runner-up matches offered for correction.
+	maxImportAlternatives = 5
+
+	importMatchSourceNFO    = "nfo"
+	importMatchSourceSearch = "search"
+
+	ImportStatusCreated = "created"
+	ImportStatusLinked  = "linked" // files attached to a movie/series already in the library
+	ImportStatusFailed  = "failed"
+)
+
+var ErrImportPathOutsideRoot = errors.New("path is not inside the root folder")
+
+// ImportMatch is a metadata match proposed for an import candidate.
+type ImportMatch struct {
+	TmdbID     int     `json:"tmdbId,omitempty"`
+	TvdbID     int     `json:"tvdbId,omitempty"`
+	ImdbID     string  `json:"imdbId,omitempty"`
+	Title      string  `json:"title"`
+	Year       int     `json:"year,omitempty"`
+	Overview   string  `json:"overview,omitempty"`
+	PosterURL  string  `json:"posterUrl,omitempty"`
+	Confidence float64 `json:"confidence"` // 0-1, 1 for IDs read from an .nfo
+	Source     string  `json:"source"`     // "nfo" or "search"
+}
+
+// ImportCandidate is an untracked folder (or loose file) found under a root
+// folder, together with its proposed match.
+type ImportCandidate struct {
+	Path         string        `json:"path"`
+	Name         string        `json:"name"`
+	ParsedTitle  string        `json:"parsedTitle"`
+	ParsedYear   int           `json:"parsedYear,omitempty"`
+	FileCount    int           `json:"fileCount"`
+	Size         int64         `json:"size"`
+	Match        *ImportMatch  `json:"match,omitempty"`
+	Alternatives []ImportMatch `json:"alternatives"`
+}
+
+// ImportPreview lists the candidates found in a root folder.
+type ImportPreview struct {
+	RootFolderID int64             `json:"rootFolderId"`
+	MediaType    string            `json:"mediaType"`
+	Candidates   []ImportCandidate `json:"candidates"`
+	Errors       []string          `json:"errors,omitempty"`
+}
+
+// ImportDecision confirms or corrects the match for one candidate.
+type ImportDecision struct {
+	Path             string `json:"path"`
+	TmdbID           int    `json:"tmdbId,omitempty"`
+	TvdbID           int    `json:"tvdbId,omitempty"`
+	QualityProfileID int64  `json:"qualityProfileId,omitempty"`
+	Monitored        *bool  `json:"monitored,omitempty"`
+}
+
+// ImportRequest is the body of a bulk import. QualityProfileID applies to
+// decisions that do not set their own.
+type ImportRequest struct {
+	QualityProfileID int64            `json:"qualityProfileId,omitempty"`
+	Monitored        bool             `json:"monitored"`
+	Items            []ImportDecision `json:"items"`
+}
+
+// ImportItemResult reports the outcome of one import decision.
+type ImportItemResult struct {
+	Path        string `json:"path"`
+	Status      string `json:"status"`
+	MovieID     int64  `json:"movieId,omitempty"`
+	SeriesID    int64  `json:"seriesId,omitempty"`
+	Title       string `json:"title,omitempty"`
+	FilesLinked int    `json:"filesLinked"`
+	Error       string `json:"error,omitempty"`
+}
+
+// PreviewImport scans a root folder for media not yet in the library, groups
+// files by their top-level folder and proposes a metadata match for each
+// group. Nothing is written; see Import.
+func (s *Service) PreviewImport(ctx context.Context, rootFolderID int64) (*ImportPreview, error) {
+	folder, err := s.rootfolders.Get(ctx, rootFolderID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get root folder: %w", err)
+	}
+
+	scanResult, err := s.scanner.ScanFolder(ctx, folder.Path, folder.MediaType, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to scan root folder: %w", err)
+	}
+
+	preview := &ImportPreview{
+		RootFolderID: folder.ID,
+		MediaType:    folder.MediaType,
+		Candidates:   []ImportCandidate{},
+	}
+	for _, scanErr := range scanResult.Errors {
+		preview.Errors = append(preview.Errors, fmt.Sprintf("%s: %s", scanErr.Path, scanErr.Error))
+	}
+
+	files := scanResult.Movies
+	if folder.MediaType == "tv" {
+		files = scanResult.Episodes
+	}
+
+	for _, group := range s.groupUntrackedFiles(ctx, folder, files) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		preview.Candidates = append(preview.Candidates, s.buildImportCandidate(ctx, folder, group))
+	}
+	return preview, nil
+}
+
+// importGroup is the set of scanned files that belong to one candidate.
+type importGroup struct {
+	path  string
+	files []scanner.ParsedMedia
+}
+
+// groupUntrackedFiles groups files by their top-level folder under the root.
+// Files sitting directly in the root form a group of their own. Groups whose
+// files are all already tracked are dropped.
+func (s *Service) groupUntrackedFiles(ctx context.Context, folder *rootfolder.RootFolder, files []scanner.ParsedMedia) []importGroup {
+	byPath := make(map[string]*importGroup)
+	var order []string
+	for i := range files {
+		parsed := &files[i]
+		if s.isImportFileTracked(ctx, folder, parsed.FilePath) {
+			continue
+		}
+		key := seriesFolderFromScan(folder, parsed)
+		if key == "" {
+			key = parsed.FilePath
+		}
+		group, ok := byPath[key]
+		if !ok {
+			group = &importGroup{path: key}
+			byPath[key] = group
+			order = append(order, key)
+		}
+		group.files = append(group.files, *parsed)
+	}
+
+	sort.Strings(order)
+	groups := make([]importGroup, 0, len(order))
+	for _, key := range order {
+		groups = append(groups, *byPath[key])
+	}
+	return groups
+}
+
+func (s *Service) isImportFileTracked(ctx context.Context, folder *rootfolder.RootFolder, filePath string) bool {
+	if folder.MediaType == "tv" {
+		return s.isEpisodeFileTracked(ctx, filePath)
+	}
+	return s.isMovieFileTracked(ctx, filePath)
+}
+
+func (s *Service) buildImportCandidate(ctx context.Context, folder *rootfolder.RootFolder, group importGroup) ImportCandidate {
+	parsed := importGroupParsed(group)
+
+	candidate := ImportCandidate{
+		Path:         group.path,
+		Name:         filepath.Base(group.path),
+		ParsedTitle:  parsed.Title,
+		ParsedYear:   parsed.Year,
+		FileCount:    len(group.files),
+		Alternatives: []ImportMatch{},
+	}
+	for i := range group.files {
+		candidate.Size += group.files[i].FileSize
+	}
+
+	var matches []ImportMatch
+	if folder.MediaType == "tv" {
+		matches = s.proposeSeriesMatches(ctx, folder, parsed)
+	} else {
+		matches = s.proposeMovieMatches(ctx, parsed)
+	}
+	if len(matches) > 0 {
+		candidate.Match = &matches[0]
+		for i := 1; i < len(matches) && len(candidate.Alternatives) < maxImportAlternatives; i++ {
+			candidate.Alternatives = append(candidate.Alternatives, matches[i])
+		}
+	}
+	return candidate
+}
+
+// importGroupParsed returns the parsed media used to identify a group. The
+// folder name is preferred over file names since legacy libraries are usually
+// organized as "Title (Year)" folders around arbitrarily named files.
+func importGroupParsed(group importGroup) *scanner.ParsedMedia {
+	parsed := group.files[0]
+	if !pathutil.PathsEqual(group.path, parsed.FilePath) {
+		if fromFolder := scanner.ParseFolderName(filepath.Base(group.path)); fromFolder != nil && fromFolder.Title != "" {
+			parsed.Title = fromFolder.Title
+			if fromFolder.Year > 0 {
+				parsed.Year = fromFolder.Year
+			}
+		}
+	}
+	return &parsed
+}
+
+// proposeMovieMatches returns candidate movie matches, best first.
+func (s *Service) proposeMovieMatches(ctx context.Context, parsed *scanner.ParsedMedia) []ImportMatch {
+	if !s.metadata.HasMovieProvider() {
+		return nil
+	}
+	if nfoMatch := s.matchMovieFromNFO(ctx, parsed); nfoMatch != nil {
+		match := movieImportMatch(nfoMatch, 1)
+		match.Source = importMatchSourceNFO
+		return []ImportMatch{match}
+	}
+
+	results, err := s.metadata.SearchMovies(ctx, parsed.Title, parsed.Year)
+	if err != nil {
+		s.logger.Warn().Err(err).Str("title", parsed.Title).Msg("Metadata search failed during import preview")
+		return nil
+	}
+	matches := make([]ImportMatch, 0, len(results))
+	for i := range results {
+		confidence := matchConfidence(parsed.Title, parsed.Year, results[i].Title, results[i].Year)
+		matches = append(matches, movieImportMatch(&results[i], confidence))
+	}
+	sortImportMatches(matches)
+	return matches
+}
+
+// proposeSeriesMatches returns candidate series matches, best first.
+func (s *Service) proposeSeriesMatches(ctx context.Context, folder *rootfolder.RootFolder, parsed *scanner.ParsedMedia) []ImportMatch {
+	if !s.metadata.HasSeriesProvider() {
+		return nil
+	}
+	if nfoMatch := s.matchSeriesFromNFO(ctx, folder, parsed); nfoMatch != nil {
+		match := seriesImportMatch(nfoMatch, 1)
+		match.Source = importMatchSourceNFO
+		return []ImportMatch{match}
+	}
+
+	results, err := s.metadata.SearchSeries(ctx, parsed.Title)
+	if err != nil {
+		s.logger.Warn().Err(err).Str("title", parsed.Title).Msg("Metadata search failed during import preview")
+		return nil
+	}
+	matches := make([]ImportMatch, 0, len(results))
+	for i := range results {
+		confidence := matchConfidence(parsed.Title, parsed.Year, results[i].Title, results[i].Year)
+		matches = append(matches, seriesImportMatch(&results[i], confidence))
+	}
+	sortImportMatches(matches)
+	return matches
+}
+
+func movieImportMatch(m *metadata.MovieResult, confidence float64) ImportMatch {
+	return ImportMatch{
+		TmdbID:     m.ID,
+		ImdbID:     m.ImdbID,
+		Title:      m.Title,
+		Year:       m.Year,
+		Overview:   m.Overview,
+		PosterURL:  m.PosterURL,
+		Confidence: confidence,
+		Source:     importMatchSourceSearch,
+	}
+}
+
+func seriesImportMatch(m *metadata.SeriesResult, confidence float64) ImportMatch {
+	return ImportMatch{
+		TmdbID:     m.TmdbID,
+		TvdbID:     m.TvdbID,
+		ImdbID:     m.ImdbID,
+		Title:      m.Title,
+		Year:       m.Year,
+		Overview:   m.Overview,
+		PosterURL:  m.PosterURL,
+		Confidence: confidence,
+		Source:     importMatchSourceSearch,
+	}
+}
+
+// sortImportMatches orders matches by confidence, keeping the provider's
+// relevance order for ties.
+func sortImportMatches(matches []ImportMatch) {
+	sort.SliceStable(matches, func(i, j int) bool {
+		return matches[i].Confidence > matches[j].Confidence
+	})
+}
+
+// matchConfidence scores how well a metadata result matches a parsed title
+// and year, from 0 to 1.
+func matchConfidence(parsedTitle string, parsedYear int, title string, year int) float64 {
+	parsedNorm := normalizeTitle(parsedTitle)
+	resultNorm := normalizeTitle(title)
+
+	var score float64
+	switch {
+	case parsedNorm == "" || resultNorm == "":
+		score = 0
+	case parsedNorm == resultNorm:
+		score = 1
+	case strings.HasPrefix(resultNorm, parsedNorm) || strings.HasPrefix(parsedNorm, resultNorm):
+		score = 0.85
+	default:
+		score = 0.8 * wordOverlap(parsedNorm, resultNorm)
+	}
+
+	switch {
+	case parsedYear == 0 || year == 0:
+		score *= 0.9
+	case parsedYear == year:
+	case parsedYear-year == 1 || year-parsedYear == 1:
+		score *= 0.9
+	default:
+		score *= 0.6
+	}
+
+	return math.Round(score*100) / 100
+}
+
+// wordOverlap returns the Dice coefficient of the word sets of a and b.
+func wordOverlap(a, b string) float64 {
+	aWords := strings.Fields(a)
+	bWords := strings.Fields(b)
+	if len(aWords) == 0 || len(bWords) == 0 {
+		return 0
+	}
+
+	bSet := make(map[string]bool, len(bWords))
+	for _, w := range bWords {
+		bSet[w] = true
+	}
+	shared := 0
+	for _, w := range aWords {
+		if bSet[w] {
+			shared++
+			delete(bSet, w)
+		}
+	}
+	return 2 * float64(shared) / float64(len(aWords)+len(bWords))
+}
+
+// Import creates movies or series for the confirmed candidates of a root
+// folder and attaches their existing files. Candidates matching an item
+// already in the library have their files linked to it instead. Each decision
+// is applied independently; failures are reported per item.
+func (s *Service) Import(ctx context.Context, rootFolderID int64, req *ImportRequest) ([]ImportItemResult, error) {
+	folder, err := s.rootfolders.Get(ctx, rootFolderID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get root folder: %w", err)
+	}
+
+	defaultProfileID := req.QualityProfileID
+	if defaultProfileID == 0 {
+		profile, err := s.getDefaultQualityProfile(ctx)
+		if err != nil {
+			return nil, err
+		}
+		defaultProfileID = profile.ID
+	}
+
+	results := make([]ImportItemResult, 0, len(req.Items))
+	for i := range req.Items {
+		decision := req.Items[i]
+		if decision.QualityProfileID == 0 {
+			decision.QualityProfileID = defaultProfileID
+		}
+		monitored := req.Monitored
+		if decision.Monitored != nil {
+			monitored = *decision.Monitored
+		}
+
+		result, err := s.importCandidate(ctx, folder, &decision, monitored)
+		if err != nil {
+			s.logger.Warn().Err(err).Str("path", decision.Path).Msg("Failed to import library item")
+			result = ImportItemResult{Path: decision.Path, Status: ImportStatusFailed, Error: err.Error()}
+		}
+		results = append(results, result)
+	}
+	return results, nil
+}
+
+func (s *Service) importCandidate(ctx context.Context, folder *rootfolder.RootFolder, decision *ImportDecision, monitored bool) (ImportItemResult, error) {
+	files, err := s.scanImportPath(ctx, folder, decision.Path)
+	if err != nil {
+		return ImportItemResult{}, err
+	}
+	if len(files) == 0 {
+		return ImportItemResult{}, errors.New("no untracked media files found")
+	}
+
+	if folder.MediaType == "tv" {
+		return s.importSeries(ctx, folder, decision, monitored, files)
+	}
+	return s.importMovie(ctx, folder, decision, monitored, files)
+}
+
+// scanImportPath re-scans a candidate path and returns its untracked files.
+func (s *Service) scanImportPath(ctx context.Context, folder *rootfolder.RootFolder, path string) ([]scanner.ParsedMedia, error) {
+	if path == "" {
+		return nil, ErrImportPathOutsideRoot
+	}
+	path = filepath.Clean(path)
+	if extractRelativePath(path, folder.Path) == "" {
+		return nil, ErrImportPathOutsideRoot
+	}
+
+	scanResult, err := s.scanner.ScanFolder(ctx, path, folder.MediaType, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to scan %s: %w", path, err)
+	}
+	files := scanResult.Movies
+	if folder.MediaType == "tv" {
+		files = scanResult.Episodes
+	}
+
+	untracked := make([]scanner.ParsedMedia, 0, len(files))
+	for i := range files {
+		if !s.isImportFileTracked(ctx, folder, files[i].FilePath) {
+			untracked = append(untracked, files[i])
+		}
+	}
+	return untracked, nil
+}
+
+// importFolderPath returns the folder a candidate's item is stored in, or
+// empty for a loose file in the root so the default folder is used.
+func importFolderPath(folder *rootfolder.RootFolder, files []scanner.ParsedMedia) string {
+	return seriesFolderFromScan(folder, &files[0])
+}
+
+func (s *Service) importMovie(ctx context.Context, folder *rootfolder.RootFolder, decision *ImportDecision, monitored bool, files []scanner.ParsedMedia) (ImportItemResult, error) {
+	result := ImportItemResult{Path: decision.Path}
+
+	var movie *movies.Movie
+	if decision.TmdbID > 0 {
+		if existing, err := s.movies.GetByTmdbID(ctx, decision.TmdbID); err == nil && existing != nil {
+			movie = existing
+			result.Status = ImportStatusLinked
+		}
+	}
+
+	if movie == nil {
+		input, err := s.movieImportInput(ctx, folder, decision, monitored, files)
+		if err != nil {
+			return result, err
+		}
+		if err := s.checkImportPathInUse(ctx, mediaTypeMovie, folder, input.Path); err != nil {
+			return result, err
+		}
+		movie, err = s.addMovie(ctx, input)
+		if err != nil {
+			return result, err
+		}
+		result.Status = ImportStatusCreated
+	}
+
+	result.MovieID = movie.ID
+	result.Title = movie.Title
+	for i := range files {
+		if err := s.addMovieFile(ctx, movie.ID, &files[i]); err != nil {
+			s.logger.Warn().Err(err).Str("path", files[i].FilePath).Msg("Failed to link movie file during import")
+			continue
+		}
+		result.FilesLinked++
+	}
+	return result, nil
+}
+
+func (s *Service) movieImportInput(ctx context.Context, folder *rootfolder.RootFolder, decision *ImportDecision, monitored bool, files []scanner.ParsedMedia) (*AddMovieInput, error) {
+	input := &AddMovieInput{
+		Path:             importFolderPath(folder, files),
+		RootFolderID:     folder.ID,
+		QualityProfileID: decision.QualityProfileID,
+		Monitored:        monitored,
+	}
+
+	if decision.TmdbID == 0 {
+		parsed := importGroupParsed(importGroup{path: decision.Path, files: files})
+		input.Title = parsed.Title
+		input.Year = parsed.Year
+		return input, nil
+	}
+
+	meta, err := s.metadata.GetMovie(ctx, decision.TmdbID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch movie %d: %w", decision.TmdbID, err)
+	}
+	input.Title = meta.Title
+	input.Year = meta.Year
+	input.TmdbID = meta.ID
+	input.ImdbID = meta.ImdbID
+	input.Overview = meta.Overview
+	input.Runtime = meta.Runtime
+	input.Studio = meta.Studio
+	input.PosterURL = meta.PosterURL
+	input.BackdropURL = meta.BackdropURL
+	return input, nil
+}
+
+func (s *Service) importSeries(ctx context.Context, folder *rootfolder.RootFolder, decision *ImportDecision, monitored bool, files []scanner.ParsedMedia) (ImportItemResult, error) {
+	result := ImportItemResult{Path: decision.Path}
+
+	var meta *metadata.SeriesResult
+	if decision.TvdbID > 0 || decision.TmdbID > 0 {
+		var err error
+		meta, err = s.metadata.GetSeries(ctx, decision.TvdbID, decision.TmdbID)
+		if err != nil {
+			return result, fmt.Errorf("failed to fetch series: %w", err)
+		}
+	}
+
+	var series *tv.Series
+	if meta != nil {
+		series = s.checkExistingSeries(ctx, meta.TvdbID)
+	}
+	if series != nil {
+		result.Status = ImportStatusLinked
+	} else {
+		input := seriesImportInput(folder, decision, monitored, files, meta)
+		if err := s.checkImportPathInUse(ctx, mediaTypeSeries, folder, input.Path); err != nil {
+			return result, err
+		}
+		var err error
+		series, err = s.addSeries(ctx, input)
+		if err != nil {
+			return result, err
+		}
+		result.Status = ImportStatusCreated
+	}
+
+	result.SeriesID = series.ID
+	result.Title = series.Title
+	for i := range files {
+		if err := s.addEpisodeFile(ctx, series.ID, &files[i]); err != nil {
+			s.logger.Warn().Err(err).Str("path", files[i].FilePath).Msg("Failed to link episode file during import")
+			continue
+		}
+		result.FilesLinked++
+	}
+	return result, nil
+}
+
+func seriesImportInput(folder *rootfolder.RootFolder, decision *ImportDecision, monitored bool, files []scanner.ParsedMedia, meta *metadata.SeriesResult) *AddSeriesInput {
+	input := &AddSeriesInput{
+		Path:             importFolderPath(folder, files),
+		RootFolderID:     folder.ID,
+		QualityProfileID: decision.QualityProfileID,
+		Monitored:        monitored,
+		SeasonFolder:     true,
+	}
+
+	if meta == nil {
+		parsed := importGroupParsed(importGroup{path: decision.Path, files: files})
+		input.Title = parsed.Title
+		input.Year = parsed.Year
+		return input
+	}
+
+	input.Title = meta.Title
+	input.Year = meta.Year
+	input.TvdbID = meta.TvdbID
+	input.TmdbID = meta.TmdbID
+	input.ImdbID = meta.ImdbID
+	input.Overview = meta.Overview
+	input.Runtime = meta.Runtime
+	input.ProductionStatus = meta.Status
+	input.Network = meta.Network
+	input.NetworkLogoURL = meta.NetworkLogoURL
+	input.PosterURL = meta.PosterURL
+	input.BackdropURL = meta.BackdropURL
+	return input
+}
+
+// checkImportPathInUse rejects a folder already used by another library item.
+// Unlike checkPathConflict it does not inspect the files in the folder: the
+// user has confirmed they belong to the item being imported.
+func (s *Service) checkImportPathInUse(ctx context.Context, mediaType string, folder *rootfolder.RootFolder, path string) error {
+	if path == "" {
+		return nil
+	}
+	c := &pathCandidate{mediaType: mediaType, path: path, rootFolderID: folder.ID}
+	title, err := s.findItemAtPath(ctx, c, folder.Path, path)
+	if err != nil {
+		return err
+	}
+	if title != "" {
+		return &PathConflictError{Path: path, Reason: PathConflictInUse, ConflictingTitle: title, Suggestions: []string{}}
+	}
+	return nil
+}
@@ -0,0 +1,77 @@
+package librarymanager
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/rs/zerolog"
+
+	"github.com/slipstream/slipstream/internal/library/rootfolder"
+	"github.com/slipstream/slipstream/internal/library/scanner"
+	"github.com/slipstream/slipstream/internal/module"
+	"github.com/slipstream/slipstream/internal/modules/movie"
+)
+
+func TestMatchConfidence(t *testing.T) {
+	tests := []struct {
+		name        string
+		parsedTitle string
+		parsedYear  int
+		title       string
+		year        int
+		want        float64
+	}{
+		{"exact title and year", "Top Gun Maverick", 2022, "Top Gun: Maverick", 2022, 1},
+		{"exact title, off by one year", "The Matrix", 1999, "The Matrix", 2000, 0.9},
+		{"exact title, unknown year", "The Matrix", 0, "The Matrix", 1999, 0.9},
+		{"exact title, wrong year", "Dune", 2021, "Dune", 1984, 0.6},
+		{"prefix", "Alien", 1979, "Alien Romulus", 1979, 0.85},
+		{"partial overlap", "Lord of the Rings Fellowship", 2001, "The Lord of the Rings The Fellowship of the Ring", 2001, 0.53},
+		{"unrelated", "Heat", 1995, "Casino", 1995, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := matchConfidence(tt.parsedTitle, tt.parsedYear, tt.title, tt.year)
+			if got != tt.want {
+				t.Errorf("matchConfidence() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSortImportMatches(t *testing.T) {
+	matches := []ImportMatch{
+		{Title: "a", Confidence: 0.5},
+		{Title: "b", Confidence: 0.9},
+		{Title: "c", Confidence: 0.5},
+	}
+	sortImportMatches(matches)
+
+	got := []string{matches[0].Title, matches[1].Title, matches[2].Title}
+	if want := []string{"b", "a", "c"}; !slices.Equal(got, want) {
+		t.Errorf("sortImportMatches() order = %v, want %v", got, want)
+	}
+}
+
+func TestImportGroupParsed(t *testing.T) {
+	logger := zerolog.Nop()
+	reg := module.NewRegistry()
+	reg.Register(movie.NewModule(nil, nil, nil, nil, nil, &logger))
+	scanner.SetGlobalRegistry(module.NewScannerRegistryAdapter(reg))
+	t.Cleanup(func() { scanner.SetGlobalRegistry(nil) })
+
+	folder := &rootfolder.RootFolder{Path: "/movies"}
+	files := []scanner.ParsedMedia{{Title: "mtrx", FilePath: "/movies/The Matrix (1999)/mtrx.mkv"}}
+
+	parsed := importGroupParsed(importGroup{path: seriesFolderFromScan(folder, &files[0]), files: files})
+	if parsed.Title != "The Matrix" || parsed.Year != 1999 {
+		t.Errorf("folder group parsed = %q (%d), want The Matrix (1999)", parsed.Title, parsed.Year)
+	}
+
+	loose := []scanner.ParsedMedia{{Title: "Heat", Year: 1995, FilePath: "/movies/Heat.1995.mkv"}}
+	parsed = importGroupParsed(importGroup{path: loose[0].FilePath, files: loose})
+	if parsed.Title != "Heat" || parsed.Year != 1995 {
+		t.Errorf("loose file parsed = %q (%d), want Heat (1995)", parsed.Title, parsed.Year)
+	}
+}
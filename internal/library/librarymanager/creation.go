@@ -204,12 +204,27 @@ func (s *Service) fetchAndLinkSeasonMetadata(ctx context.Context, series *tv.Ser
 		Int("episodes", totalEpisodes).
 		Msg("Updated seasons and episodes from metadata during scan")
 
+	s.downloadSeasonArtworkAsync(tmdbID, tvdbID, seasonResults)
+
 	if updatedSeries, err := s.tv.GetSeries(ctx, series.ID); err == nil {
 		return updatedSeries
 	}
 	return series
 }
 
+// coveredEpisodeIDs returns the episodes a scanned file covers, starting with
+// its first episode. Later episodes of a multi-episode file are only linked
+// when they exist.
+func (s *Service) coveredEpisodeIDs(ctx context.Context, seriesID, firstEpisodeID int64, parsed *scanner.ParsedMedia) []int64 {
+	ids := []int64{firstEpisodeID}
+	for epNum := parsed.Episode + 1; epNum <= parsed.EndEpisode; epNum++ {
+		if ep, err := s.tv.GetEpisodeByNumber(ctx, seriesID, parsed.Season, epNum); err == nil {
+			ids = append(ids, ep.ID)
+		}
+	}
+	return ids
+}
+
 // addEpisodeFile adds a file to an episode, creating the season/episode if needed.
 // Req 13.1.2: Auto-assign files to best matching slot based on quality profile matching
 // Req 13.1.3: Extra files (more than slot count) queued for user review (slot_id = NULL)
@@ -228,7 +243,7 @@ func (s *Service) addEpisodeFile(ctx context.Context, seriesID int64, parsed *sc
 		Resolution: parsed.Quality,
 	}
 
-	file, err := s.tv.AddEpisodeFile(ctx, episode.ID, &input)
+	file, err := s.tv.AddMultiEpisodeFile(ctx, s.coveredEpisodeIDs(ctx, seriesID, episode.ID, parsed), &input)
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,39 @@
+package librarymanager
+
+import (
+	"context"
+)
+
+// updateMovieCredits stores a movie's directors, writers and billed cast as
+// people so their other library works can be listed. Failures are logged.
+func (s *Service) updateMovieCredits(ctx context.Context, movieID int64, tmdbID int) {
+	if tmdbID == 0 || !s.metadata.HasMovieProvider() {
+		return
+	}
+
+	credits, err := s.metadata.GetMovieCredits(ctx, tmdbID)
+	if err != nil {
+		s.logger.Warn().Err(err).Int("tmdbId", tmdbID).Msg("Failed to fetch movie credits")
+		return
+	}
+	if err := s.movies.SetCredits(ctx, movieID, credits); err != nil {
+		s.logger.Warn().Err(err).Int64("movieId", movieID).Msg("Failed to store movie credits")
+	}
+}
+
+// updateSeriesCredits stores a series' creators and billed cast. Credits come
+// from TMDB, so series without a TMDB ID have none.
+func (s *Service) updateSeriesCredits(ctx context.Context, seriesID int64, tmdbID int) {
+	if tmdbID == 0 || !s.metadata.HasMovieProvider() {
+		return
+	}
+
+	credits, err := s.metadata.GetSeriesCredits(ctx, tmdbID)
+	if err != nil {
+		s.logger.Warn().Err(err).Int("tmdbId", tmdbID).Msg("Failed to fetch series credits")
+		return
+	}
+	if err := s.tv.SetCredits(ctx, seriesID, credits); err != nil {
+		s.logger.Warn().Err(err).Int64("seriesId", seriesID).Msg("Failed to store series credits")
+	}
+}
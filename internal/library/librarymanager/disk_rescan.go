@@ -0,0 +1,274 @@
+package librarymanager
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/slipstream/slipstream/internal/database/sqlc"
+	"github.com/slipstream/slipstream/internal/library/movies"
+	"github.com/slipstream/slipstream/internal/library/tv"
+)
+
+// DiskRescanResult summarizes a library-wide disk rescan.
+type DiskRescanResult struct {
+	FilesChecked int `json:"filesChecked"`
+	FilesUpdated int `json:"filesUpdated"`
+	FilesMissing int `json:"filesMissing"`
+	FilesAdded   int `json:"filesAdded"`
+	Skipped      int `json:"skipped"`
+}
+
+// fileDiskInfo holds the file columns a disk rescan keeps in sync.
+type fileDiskInfo struct {
+	Size          int64
+	VideoCodec    sql.NullString
+	AudioCodec    sql.NullString
+	Resolution    sql.NullString
+	AudioChannels sql.NullString
+	DynamicRange  sql.NullString
+}
+
+// diskState describes what a rescan found on disk for a tracked file.
+type diskState int
+
+const (
+	diskUnchanged diskState = iota
+	diskChanged
+	diskMissing
+)
+
+// RescanDisk walks every movie and series folder to reconcile the database with
+// what is actually on disk. Tracked files that vanished are removed and their
+// media marked missing, files whose size changed are re-probed, and untracked
+// video files in the folders are linked. Media under a root folder that cannot
+// be reached is skipped so an unmounted drive does not wipe the library.
+func (s *Service) RescanDisk(ctx context.Context) (*DiskRescanResult, error) {
+	reachable, err := s.reachableRootFolders(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &DiskRescanResult{}
+
+	movieList, err := s.movies.List(ctx, movies.ListMoviesOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list movies: %w", err)
+	}
+	for _, movie := range movieList {
+		if ctx.Err() != nil {
+			return result, ctx.Err()
+		}
+		if !reachable[movie.RootFolderID] || !isRescannablePath(movie.Path) {
+			result.Skipped++
+			continue
+		}
+		s.rescanMovie(ctx, movie, result)
+	}
+
+	seriesList, err := s.tv.ListSeries(ctx, tv.ListSeriesOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list series: %w", err)
+	}
+	for _, series := range seriesList {
+		if ctx.Err() != nil {
+			return result, ctx.Err()
+		}
+		if !reachable[series.RootFolderID] || !isRescannablePath(series.Path) {
+			result.Skipped++
+			continue
+		}
+		s.rescanSeries(ctx, series, result)
+	}
+
+	s.logger.Info().
+		Int("filesChecked", result.FilesChecked).
+		Int("filesUpdated", result.FilesUpdated).
+		Int("filesMissing", result.FilesMissing).
+		Int("filesAdded", result.FilesAdded).
+		Int("skipped", result.Skipped).
+		Msg("Disk rescan completed")
+
+	return result, nil
+}
+
+// reachableRootFolders returns the IDs of root folders whose paths can be stat'ed.
+func (s *Service) reachableRootFolders(ctx context.Context) (map[int64]bool, error) {
+	folders, err := s.rootfolders.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list root folders: %w", err)
+	}
+
+	reachable := make(map[int64]bool, len(folders))
+	for _, folder := range folders {
+		if _, err := os.Stat(folder.Path); err != nil {
+			s.logger.Warn().Err(err).Int64("rootFolderId", folder.ID).Str("path", folder.Path).
+				Msg("Root folder unreachable, skipping its media during disk rescan")
+			continue
+		}
+		reachable[folder.ID] = true
+	}
+	return reachable, nil
+}
+
+func isRescannablePath(path string) bool {
+	return path != "" && !strings.HasPrefix(path, "/mock/")
+}
+
+func (s *Service) rescanMovie(ctx context.Context, movie *movies.Movie, result *DiskRescanResult) {
+	files, err := s.queries.ListMovieFiles(ctx, movie.ID)
+	if err != nil {
+		s.logger.Warn().Err(err).Int64("movieId", movie.ID).Msg("Failed to list movie files for disk rescan")
+		return
+	}
+
+	stale := false
+	for _, f := range files {
+		result.FilesChecked++
+		state, size := checkDiskFile(f.Path, f.Size)
+		switch state {
+		case diskMissing:
+			result.FilesMissing++
+			s.logger.Warn().Str("path", f.Path).Int64("movieId", movie.ID).Msg("Movie file disappeared from disk")
+			if delErr := s.queries.DeleteMovieFile(ctx, f.ID); delErr != nil {
+				s.logger.Warn().Err(delErr).Int64("fileId", f.ID).Msg("Failed to delete stale movie file record")
+				continue
+			}
+			stale = true
+			if s.healthSvc != nil {
+				healthID := fmt.Sprintf("missing-file-movie-%d", f.ID)
+				s.healthSvc.SetWarningStr("storage", healthID, fmt.Sprintf("Movie file not found: %s", f.Path))
+			}
+		case diskChanged:
+			info := s.probeDiskInfo(ctx, f.Path, fileDiskInfo{
+				Size: size, VideoCodec: f.VideoCodec, AudioCodec: f.AudioCodec,
+				Resolution: f.Resolution, AudioChannels: f.AudioChannels, DynamicRange: f.DynamicRange,
+			})
+			if err := s.queries.UpdateMovieFileDiskInfo(ctx, sqlc.UpdateMovieFileDiskInfoParams{
+				Size:          info.Size,
+				VideoCodec:    info.VideoCodec,
+				AudioCodec:    info.AudioCodec,
+				Resolution:    info.Resolution,
+				AudioChannels: info.AudioChannels,
+				DynamicRange:  info.DynamicRange,
+				ID:            f.ID,
+			}); err != nil {
+				s.logger.Warn().Err(err).Int64("fileId", f.ID).Msg("Failed to update movie file disk info")
+				continue
+			}
+			result.FilesUpdated++
+		case diskUnchanged:
+		}
+	}
+
+	if scanResult, err := s.scanner.ScanFolder(ctx, movie.Path, "movie", nil); err == nil {
+		result.FilesAdded += s.linkNewMovieFiles(ctx, movie.ID, scanResult.Movies)
+	} else if !errors.Is(err, os.ErrNotExist) {
+		s.logger.Warn().Err(err).Str("path", movie.Path).Int64("movieId", movie.ID).Msg("Failed to scan movie folder")
+	}
+
+	if stale {
+		s.recomputeMovieStatus(ctx, movie.ID)
+	}
+}
+
+func (s *Service) rescanSeries(ctx context.Context, series *tv.Series, result *DiskRescanResult) {
+	files, err := s.queries.ListEpisodeFilesBySeries(ctx, series.ID)
+	if err != nil {
+		s.logger.Warn().Err(err).Int64("seriesId", series.ID).Msg("Failed to list episode files for disk rescan")
+		return
+	}
+
+	staleEpisodes := make(map[int64]struct{})
+	for _, f := range files {
+		result.FilesChecked++
+		state, size := checkDiskFile(f.Path, f.Size)
+		switch state {
+		case diskMissing:
+			result.FilesMissing++
+			s.logger.Warn().Str("path", f.Path).Int64("episodeId", f.EpisodeID).Msg("Episode file disappeared from disk")
+			if delErr := s.queries.DeleteEpisodeFile(ctx, f.ID); delErr != nil {
+				s.logger.Warn().Err(delErr).Int64("fileId", f.ID).Msg("Failed to delete stale episode file record")
+				continue
+			}
+			staleEpisodes[f.EpisodeID] = struct{}{}
+			if s.healthSvc != nil {
+				healthID := fmt.Sprintf("missing-file-episode-%d", f.ID)
+				s.healthSvc.SetWarningStr("storage", healthID, fmt.Sprintf("Episode file not found: %s", f.Path))
+			}
+		case diskChanged:
+			info := s.probeDiskInfo(ctx, f.Path, fileDiskInfo{
+				Size: size, VideoCodec: f.VideoCodec, AudioCodec: f.AudioCodec,
+				Resolution: f.Resolution, AudioChannels: f.AudioChannels, DynamicRange: f.DynamicRange,
+			})
+			if err := s.queries.UpdateEpisodeFileDiskInfo(ctx, sqlc.UpdateEpisodeFileDiskInfoParams{
+				Size:          info.Size,
+				VideoCodec:    info.VideoCodec,
+				AudioCodec:    info.AudioCodec,
+				Resolution:    info.Resolution,
+				AudioChannels: info.AudioChannels,
+				DynamicRange:  info.DynamicRange,
+				ID:            f.ID,
+			}); err != nil {
+				s.logger.Warn().Err(err).Int64("fileId", f.ID).Msg("Failed to update episode file disk info")
+				continue
+			}
+			result.FilesUpdated++
+		case diskUnchanged:
+		}
+	}
+
+	if scanResult, err := s.scanner.ScanFolder(ctx, series.Path, "tv", nil); err == nil {
+		result.FilesAdded += s.linkNewEpisodeFiles(ctx, series.ID, scanResult.Episodes)
+	} else if !errors.Is(err, os.ErrNotExist) {
+		s.logger.Warn().Err(err).Str("path", series.Path).Int64("seriesId", series.ID).Msg("Failed to scan series folder")
+	}
+
+	for episodeID := range staleEpisodes {
+		s.recomputeEpisodeStatus(ctx, episodeID)
+	}
+}
+
+// checkDiskFile compares a tracked file against disk. Only a definite
+// not-exist counts as missing; other stat errors leave the record alone.
+func checkDiskFile(path string, trackedSize int64) (state diskState, size int64) {
+	info, err := os.Stat(path)
+	if os.IsNotExist(err) {
+		return diskMissing, 0
+	}
+	if err != nil || info.Size() == trackedSize {
+		return diskUnchanged, trackedSize
+	}
+	return diskChanged, info.Size()
+}
+
+// probeDiskInfo refreshes the codec columns for a file whose size changed.
+// Without a MediaInfo tool, or if probing fails, the existing values are kept.
+func (s *Service) probeDiskInfo(ctx context.Context, path string, current fileDiskInfo) fileDiskInfo {
+	if s.mediainfo == nil || !s.mediainfo.IsAvailable() {
+		return current
+	}
+	info, err := s.mediainfo.Probe(ctx, path)
+	if err != nil {
+		s.logger.Warn().Err(err).Str("path", path).Msg("Failed to probe changed file during disk rescan")
+		return current
+	}
+	return fileDiskInfo{
+		Size:          current.Size,
+		VideoCodec:    keepIfEmpty(info.VideoCodec, current.VideoCodec),
+		AudioCodec:    keepIfEmpty(info.AudioCodec, current.AudioCodec),
+		Resolution:    keepIfEmpty(info.VideoResolution, current.Resolution),
+		AudioChannels: keepIfEmpty(info.AudioChannels, current.AudioChannels),
+		DynamicRange:  keepIfEmpty(info.DynamicRange, current.DynamicRange),
+	}
+}
+
+func keepIfEmpty(probed string, existing sql.NullString) sql.NullString {
+	if probed == "" {
+		return existing
+	}
+	return sql.NullString{String: probed, Valid: true}
+}
@@ -0,0 +1,25 @@
+package librarymanager
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestCheckDiskFile(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "Inception.2010.1080p.mkv")
+	if err := os.WriteFile(path, []byte("12345"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	if state, size := checkDiskFile(path, 5); state != diskUnchanged || size != 5 {
+		t.Errorf("same size = %v, %d, want unchanged, 5", state, size)
+	}
+	if state, size := checkDiskFile(path, 3); state != diskChanged || size != 5 {
+		t.Errorf("replaced file = %v, %d, want changed, 5", state, size)
+	}
+	if state, _ := checkDiskFile(filepath.Join(dir, "gone.mkv"), 5); state != diskMissing {
+		t.Errorf("deleted file = %v, want missing", state)
+	}
+}
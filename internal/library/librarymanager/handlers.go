@@ -2,12 +2,18 @@ package librarymanager
 
 import (
 	"context"
+	"database/sql"
 	"errors"
 	"net/http"
 	"strconv"
 
 	"github.com/labstack/echo/v4"
 
+	"github.com/slipstream/slipstream/internal/apierror"
+	"github.com/slipstream/slipstream/internal/autosearch"
+	"github.com/slipstream/slipstream/internal/library/movies"
+	"github.com/slipstream/slipstream/internal/library/tv"
+	"github.com/slipstream/slipstream/internal/logger"
 	"github.com/slipstream/slipstream/internal/module"
 	portalmw "github.com/slipstream/slipstream/internal/portal/middleware"
 )
@@ -32,18 +38,17 @@ func (h *Handlers) ScanRootFolder(c echo.Context) error {
 
 	// Check if already scanning
 	if h.service.IsScanActive(id) {
-		return c.JSON(http.StatusConflict, map[string]interface{}{
-			"message":      "Scan already in progress",
-			"rootFolderId": id,
-		})
+		return apierror.New(http.StatusConflict, apierror.CodeScanInProgress, "Scan already in progress").
+			WithDetails(map[string]any{"rootFolderId": id})
 	}
 
 	// Run scan asynchronously
+	// Detach from the request so the scan outlives it, keeping its correlation ID
+	ctx := context.WithoutCancel(c.Request().Context())
 	go func() {
-		ctx := context.Background()
 		_, err := h.service.ScanRootFolder(ctx, id)
 		if err != nil {
-			h.service.logger.Error().Err(err).Int64("rootFolderId", id).Msg("Async scan failed")
+			logger.FromContext(ctx, h.service.logger).Error().Err(err).Int64("rootFolderId", id).Msg("Async scan failed")
 		}
 	}()
 
@@ -134,13 +139,13 @@ func (h *Handlers) ScanAllRootFolders(c echo.Context) error {
 
 	// Start scan for each folder
 	startedScans := make([]int64, 0)
+	scanCtx := context.WithoutCancel(c.Request().Context())
 	for _, folder := range folders {
 		if !h.service.IsScanActive(folder.ID) {
 			go func(id int64) {
-				scanCtx := context.Background()
 				_, err := h.service.ScanRootFolder(scanCtx, id)
 				if err != nil {
-					h.service.logger.Error().Err(err).Int64("rootFolderId", id).Msg("Async scan failed")
+					logger.FromContext(scanCtx, h.service.logger).Error().Err(err).Int64("rootFolderId", id).Msg("Async scan failed")
 				}
 			}(folder.ID)
 			startedScans = append(startedScans, folder.ID)
@@ -213,13 +218,55 @@ func (h *Handlers) RefreshSeries(c echo.Context) error {
 	return c.JSON(http.StatusOK, series)
 }
 
+// MoveMovie handles POST /api/v1/movies/:id/move
+// Starts moving a movie's folder to a new path or root folder.
+func (h *Handlers) MoveMovie(c echo.Context) error {
+	return h.moveItem(c, mediaTypeMovie)
+}
+
+// MoveSeries handles POST /api/v1/series/:id/move
+// Starts moving a series' folder to a new path or root folder.
+func (h *Handlers) MoveSeries(c echo.Context) error {
+	return h.moveItem(c, mediaTypeSeries)
+}
+
+func (h *Handlers) moveItem(c echo.Context, mediaType string) error {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid "+mediaType+" ID")
+	}
+
+	var input MoveInput
+	if err := c.Bind(&input); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid request body")
+	}
+
+	result, err := h.service.StartMove(c.Request().Context(), mediaType, id, &input)
+	if err != nil {
+		switch {
+		case errors.Is(err, movies.ErrMovieNotFound), errors.Is(err, tv.ErrSeriesNotFound):
+			return echo.NewHTTPError(http.StatusNotFound, err.Error())
+		case errors.Is(err, ErrMoveInProgress), errors.Is(err, ErrMoveTargetExists), errors.Is(err, ErrMoveTargetInUse):
+			return echo.NewHTTPError(http.StatusConflict, err.Error())
+		case errors.Is(err, ErrPathOutsideRoot), errors.Is(err, ErrMoveNoFolder), errors.Is(err, ErrMoveSameFolder),
+			errors.Is(err, ErrMoveDestinationRequired), errors.Is(err, ErrMoveIntoSelf):
+			return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+		case errors.Is(err, ErrMoveUnavailable):
+			return echo.NewHTTPError(http.StatusServiceUnavailable, err.Error())
+		}
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	return c.JSON(http.StatusAccepted, result)
+}
+
 // RefreshAllMovies handles POST /api/v1/movies/refresh
 // Scans all movie root folders and refreshes metadata for all movies.
 func (h *Handlers) RefreshAllMovies(c echo.Context) error {
+	ctx := context.WithoutCancel(c.Request().Context())
 	go func() {
-		ctx := context.Background()
 		if err := h.service.RefreshAllMovies(ctx); err != nil {
-			h.service.logger.Error().Err(err).Msg("Refresh all movies failed")
+			logger.FromContext(ctx, h.service.logger).Error().Err(err).Msg("Refresh all movies failed")
 		}
 	}()
 
@@ -231,10 +278,10 @@ func (h *Handlers) RefreshAllMovies(c echo.Context) error {
 // RefreshAllSeries handles POST /api/v1/series/refresh
 // Scans all TV root folders and refreshes metadata for all series.
 func (h *Handlers) RefreshAllSeries(c echo.Context) error {
+	ctx := context.WithoutCancel(c.Request().Context())
 	go func() {
-		ctx := context.Background()
 		if err := h.service.RefreshAllSeries(ctx); err != nil {
-			h.service.logger.Error().Err(err).Msg("Refresh all series failed")
+			logger.FromContext(ctx, h.service.logger).Error().Err(err).Msg("Refresh all series failed")
 		}
 	}()
 
@@ -257,6 +304,13 @@ func (h *Handlers) AddMovie(c echo.Context) error {
 
 	movie, err := h.service.AddMovie(c.Request().Context(), &input)
 	if err != nil {
+		var conflict *PathConflictError
+		if errors.As(err, &conflict) {
+			return apierror.New(http.StatusConflict, apierror.CodePathConflict, conflict.Error()).WithDetails(conflict)
+		}
+		if errors.Is(err, ErrPathOutsideRoot) || errors.Is(err, movies.ErrInvalidMovie) {
+			return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+		}
 		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
 	}
 
@@ -277,8 +331,268 @@ func (h *Handlers) AddSeries(c echo.Context) error {
 
 	series, err := h.service.AddSeries(c.Request().Context(), &input)
 	if err != nil {
+		var conflict *PathConflictError
+		if errors.As(err, &conflict) {
+			return apierror.New(http.StatusConflict, apierror.CodePathConflict, conflict.Error()).WithDetails(conflict)
+		}
+		if errors.Is(err, ErrPathOutsideRoot) || errors.Is(err, tv.ErrInvalidSeries) {
+			return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+		}
 		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
 	}
 
 	return c.JSON(http.StatusCreated, series)
 }
+
+// AddLookup handles GET /api/v1/library/add/:mediaType/lookup
+// Searches metadata for the add wizard, flagging titles already in the library.
+func (h *Handlers) AddLookup(c echo.Context) error {
+	term := c.QueryParam("term")
+	year, _ := strconv.Atoi(c.QueryParam("year"))
+	tmdbID, _ := strconv.Atoi(c.QueryParam("tmdbId"))
+	tvdbID, _ := strconv.Atoi(c.QueryParam("tvdbId"))
+	if term == "" && tmdbID == 0 && tvdbID == 0 {
+		return echo.NewHTTPError(http.StatusBadRequest, "term, tmdbId or tvdbId is required")
+	}
+
+	results, err := h.service.LookupForAdd(c.Request().Context(), c.Param("mediaType"), term, year, tmdbID, tvdbID)
+	if err != nil {
+		return addWizardError(err)
+	}
+	return c.JSON(http.StatusOK, results)
+}
+
+// AddOptions handles POST /api/v1/library/add/:mediaType/options
+// Returns root folder and quality profile options with per-option checks and
+// validates the current selection.
+func (h *Handlers) AddOptions(c echo.Context) error {
+	var req AddOptionsRequest
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	opts, err := h.service.AddOptions(c.Request().Context(), c.Param("mediaType"), &req)
+	if err != nil {
+		return addWizardError(err)
+	}
+	return c.JSON(http.StatusOK, opts)
+}
+
+// AddFromWizard handles POST /api/v1/library/add/:mediaType
+// Runs the add wizard checks, then adds the movie or series. The body is the
+// same as POST /movies or POST /series, including searchOnAdd.
+func (h *Handlers) AddFromWizard(c echo.Context) error {
+	ctx := c.Request().Context()
+	var addedBy *int64
+	if claims := portalmw.GetPortalUser(c); claims != nil {
+		addedBy = &claims.UserID
+	}
+
+	switch c.Param("mediaType") {
+	case mediaTypeMovie:
+		var input AddMovieInput
+		if err := c.Bind(&input); err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+		}
+		input.AddedBy = addedBy
+		req := &AddOptionsRequest{
+			Title: input.Title, Year: input.Year, TmdbID: input.TmdbID, Path: input.Path,
+			RootFolderID: input.RootFolderID, QualityProfileID: input.QualityProfileID,
+		}
+		if err := h.service.ValidateAdd(ctx, mediaTypeMovie, req); err != nil {
+			return addWizardError(err)
+		}
+		movie, err := h.service.AddMovie(ctx, &input)
+		if err != nil {
+			return addWizardError(err)
+		}
+		return c.JSON(http.StatusCreated, movie)
+
+	case mediaTypeSeries:
+		var input AddSeriesInput
+		if err := c.Bind(&input); err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+		}
+		input.AddedBy = addedBy
+		req := &AddOptionsRequest{
+			Title: input.Title, Year: input.Year, TmdbID: input.TmdbID, TvdbID: input.TvdbID, Path: input.Path,
+			RootFolderID: input.RootFolderID, QualityProfileID: input.QualityProfileID,
+		}
+		if err := h.service.ValidateAdd(ctx, mediaTypeSeries, req); err != nil {
+			return addWizardError(err)
+		}
+		series, err := h.service.AddSeries(ctx, &input)
+		if err != nil {
+			return addWizardError(err)
+		}
+		return c.JSON(http.StatusCreated, series)
+
+	default:
+		return addWizardError(ErrInvalidAddMediaType)
+	}
+}
+
+// addWizardError maps add wizard errors to API errors. A duplicate is a
+// conflict; other failed checks are unprocessable.
+func addWizardError(err error) error {
+	var invalid *AddValidationError
+	if errors.As(err, &invalid) {
+		for _, check := range invalid.Checks {
+			if check.Code == AddCheckDuplicate {
+				return apierror.New(http.StatusConflict, apierror.CodeConflict, check.Message).WithDetails(invalid)
+			}
+		}
+		return apierror.New(http.StatusUnprocessableEntity, apierror.CodeUnprocessable, invalid.Error()).WithDetails(invalid)
+	}
+	var conflict *PathConflictError
+	if errors.As(err, &conflict) {
+		return apierror.New(http.StatusConflict, apierror.CodePathConflict, conflict.Error()).WithDetails(conflict)
+	}
+	switch {
+	case errors.Is(err, ErrInvalidAddMediaType), errors.Is(err, ErrPathOutsideRoot):
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	case errors.Is(err, ErrNoMetadataProvider):
+		return echo.NewHTTPError(http.StatusServiceUnavailable, "no metadata provider configured")
+	}
+	return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+}
+
+// PreviewImport handles GET /api/v1/rootfolders/:id/import
+// Lists untracked folders in the root folder with proposed metadata matches.
+func (h *Handlers) PreviewImport(c echo.Context) error {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid root folder ID")
+	}
+
+	if h.service.IsScanActive(id) {
+		return apierror.New(http.StatusConflict, apierror.CodeScanInProgress, ErrScanInProgress.Error())
+	}
+
+	preview, err := h.service.PreviewImport(c.Request().Context(), id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, preview)
+}
+
+// Import handles POST /api/v1/rootfolders/:id/import
+// Creates library items for the confirmed candidates and links their files.
+func (h *Handlers) Import(c echo.Context) error {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid root folder ID")
+	}
+
+	var req ImportRequest
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+	if len(req.Items) == 0 {
+		return echo.NewHTTPError(http.StatusBadRequest, "no items to import")
+	}
+
+	if h.service.IsScanActive(id) {
+		return apierror.New(http.StatusConflict, apierror.CodeScanInProgress, ErrScanInProgress.Error())
+	}
+
+	results, err := h.service.Import(c.Request().Context(), id, &req)
+	if err != nil {
+		if errors.Is(err, ErrNoQualityProfile) {
+			return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+		}
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, results)
+}
+
+// GetOrphanReport handles GET /api/v1/library/orphans
+// Returns the latest orphaned file and missing record report.
+func (h *Handlers) GetOrphanReport(c echo.Context) error {
+	report, err := h.service.GetOrphanReport(c.Request().Context())
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	return c.JSON(http.StatusOK, report)
+}
+
+// BuildOrphanReport handles POST /api/v1/library/orphans
+// Walks all root folders and returns a fresh report.
+func (h *Handlers) BuildOrphanReport(c echo.Context) error {
+	report, err := h.service.BuildOrphanReport(c.Request().Context())
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	return c.JSON(http.StatusOK, report)
+}
+
+// FixOrphan handles POST /api/v1/library/orphans/fix
+// Applies one import, delete-record or relink action from the report.
+func (h *Handlers) FixOrphan(c echo.Context) error {
+	var req OrphanFixRequest
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	result, err := h.service.FixOrphan(c.Request().Context(), &req)
+	if err != nil {
+		switch {
+		case errors.Is(err, ErrUnknownOrphanAction), errors.Is(err, ErrImportPathOutsideRoot),
+			errors.Is(err, ErrOrphanFileNotFound), errors.Is(err, ErrNoQualityProfile):
+			return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+		case errors.Is(err, ErrOrphanFileTracked):
+			return echo.NewHTTPError(http.StatusConflict, err.Error())
+		case errors.Is(err, sql.ErrNoRows):
+			return echo.NewHTTPError(http.StatusNotFound, "file record not found")
+		}
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, result)
+}
+
+// GetQualityAuditReport handles GET /api/v1/library/quality-audit
+// Returns the latest report of files whose media info contradicts their quality.
+func (h *Handlers) GetQualityAuditReport(c echo.Context) error {
+	report, err := h.service.GetQualityAuditReport(c.Request().Context())
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	return c.JSON(http.StatusOK, report)
+}
+
+// BuildQualityAuditReport handles POST /api/v1/library/quality-audit
+// Audits every library file and returns a fresh report.
+func (h *Handlers) BuildQualityAuditReport(c echo.Context) error {
+	report, err := h.service.BuildQualityAuditReport(c.Request().Context())
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	return c.JSON(http.StatusOK, report)
+}
+
+// SearchAuditedFile handles POST /api/v1/library/quality-audit/search
+// Re-searches the movie or episode of a flagged file.
+func (h *Handlers) SearchAuditedFile(c echo.Context) error {
+	var req QualityAuditSearchRequest
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	result, err := h.service.SearchAuditedFile(c.Request().Context(), &req)
+	if err != nil {
+		switch {
+		case errors.Is(err, ErrInvalidAuditRecordType):
+			return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+		case errors.Is(err, ErrAuditSearchUnavailable):
+			return echo.NewHTTPError(http.StatusServiceUnavailable, err.Error())
+		case errors.Is(err, sql.ErrNoRows), errors.Is(err, autosearch.ErrItemNotFound):
+			return echo.NewHTTPError(http.StatusNotFound, "file record not found")
+		}
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, result)
+}
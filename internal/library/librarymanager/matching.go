@@ -26,15 +26,17 @@ func (s *Service) matchOrCreateMovie(
 		return movie, created, nil, err
 	}
 
-	results, err := s.metadata.SearchMovies(ctx, parsed.Title, parsed.Year)
-	if err != nil {
-		s.logger.Warn().Err(err).Str("title", parsed.Title).Int("year", parsed.Year).Msg("Metadata search failed, creating movie without metadata")
-		movie, created, err := s.createMovieFromParsed(ctx, folder, parsed, qualityProfileID, nil)
-		return movie, created, nil, err
+	bestMatch := s.matchMovieFromNFO(ctx, parsed)
+	if bestMatch == nil {
+		results, err := s.metadata.SearchMovies(ctx, parsed.Title, parsed.Year)
+		if err != nil {
+			s.logger.Warn().Err(err).Str("title", parsed.Title).Int("year", parsed.Year).Msg("Metadata search failed, creating movie without metadata")
+			movie, created, err := s.createMovieFromParsed(ctx, folder, parsed, qualityProfileID, nil)
+			return movie, created, nil, err
+		}
+		bestMatch = s.findBestMovieMatch(results, parsed)
 	}
 
-	bestMatch := s.findBestMovieMatch(results, parsed)
-
 	if bestMatch != nil && bestMatch.ID > 0 {
 		if existing, existErr := s.movies.GetByTmdbID(ctx, bestMatch.ID); existErr == nil && existing != nil {
 			return existing, false, nil, nil
@@ -45,6 +47,40 @@ func (s *Service) matchOrCreateMovie(
 	return movie, created, bestMatch, err
 }
 
+// matchMovieFromNFO resolves a movie from the IDs in a Kodi .nfo next to the
+// file. NFO IDs are authoritative, so this beats any title search on messy
+// legacy libraries. Returns nil when there is no usable .nfo.
+func (s *Service) matchMovieFromNFO(ctx context.Context, parsed *scanner.ParsedMedia) *metadata.MovieResult {
+	if parsed.FilePath == "" {
+		return nil
+	}
+	ids := scanner.FindMovieNFO(parsed.FilePath)
+	if ids.IsEmpty() {
+		return nil
+	}
+
+	tmdbID := ids.TmdbID
+	if tmdbID == 0 && ids.ImdbID != "" {
+		movieID, _, err := s.metadata.FindByIMDbID(ctx, ids.ImdbID)
+		if err != nil {
+			s.logger.Debug().Err(err).Str("imdbId", ids.ImdbID).Msg("Failed to resolve IMDb ID from NFO")
+			return nil
+		}
+		tmdbID = movieID
+	}
+	if tmdbID == 0 {
+		return nil
+	}
+
+	result, err := s.metadata.GetMovie(ctx, tmdbID)
+	if err != nil {
+		s.logger.Debug().Err(err).Int("tmdbId", tmdbID).Msg("Failed to fetch movie from NFO ID")
+		return nil
+	}
+	s.logger.Debug().Str("path", parsed.FilePath).Int("tmdbId", tmdbID).Msg("Matched movie from NFO")
+	return result
+}
+
 func (s *Service) findBestMovieMatch(
 	results []metadata.MovieResult,
 	parsed *scanner.ParsedMedia,
@@ -161,15 +197,17 @@ func (s *Service) matchOrCreateSeries(
 		return series, created, nil, err
 	}
 
-	results, err := s.metadata.SearchSeries(ctx, parsed.Title)
-	if err != nil {
-		s.logger.Warn().Err(err).Str("title", parsed.Title).Msg("Metadata search failed, creating series without metadata")
-		series, created, err := s.createSeriesFromParsed(ctx, folder, parsed, qualityProfileID, nil)
-		return series, created, nil, err
+	bestMatch := s.matchSeriesFromNFO(ctx, folder, parsed)
+	if bestMatch == nil {
+		results, err := s.metadata.SearchSeries(ctx, parsed.Title)
+		if err != nil {
+			s.logger.Warn().Err(err).Str("title", parsed.Title).Msg("Metadata search failed, creating series without metadata")
+			series, created, err := s.createSeriesFromParsed(ctx, folder, parsed, qualityProfileID, nil)
+			return series, created, nil, err
+		}
+		bestMatch = s.findBestSeriesMatch(ctx, results, parsed)
 	}
 
-	bestMatch := s.findBestSeriesMatch(ctx, results, parsed)
-
 	if bestMatch != nil && bestMatch.TvdbID > 0 {
 		if existing := s.checkExistingSeries(ctx, bestMatch.TvdbID); existing != nil {
 			return existing, false, nil, nil
@@ -180,6 +218,37 @@ func (s *Service) matchOrCreateSeries(
 	return series, created, bestMatch, err
 }
 
+// matchSeriesFromNFO resolves a series from the IDs in the tvshow.nfo of the
+// scanned file's series folder. Returns nil when there is no usable .nfo.
+func (s *Service) matchSeriesFromNFO(ctx context.Context, folder *rootfolder.RootFolder, parsed *scanner.ParsedMedia) *metadata.SeriesResult {
+	seriesDir := seriesFolderFromScan(folder, parsed)
+	if seriesDir == "" {
+		return nil
+	}
+	ids := scanner.FindSeriesNFO(seriesDir)
+	if ids.IsEmpty() {
+		return nil
+	}
+
+	tmdbID := ids.TmdbID
+	if ids.TvdbID == 0 && tmdbID == 0 && ids.ImdbID != "" {
+		_, seriesID, err := s.metadata.FindByIMDbID(ctx, ids.ImdbID)
+		if err != nil {
+			s.logger.Debug().Err(err).Str("imdbId", ids.ImdbID).Msg("Failed to resolve IMDb ID from NFO")
+			return nil
+		}
+		tmdbID = seriesID
+	}
+
+	result, err := s.metadata.GetSeries(ctx, ids.TvdbID, tmdbID)
+	if err != nil {
+		s.logger.Debug().Err(err).Int("tvdbId", ids.TvdbID).Int("tmdbId", tmdbID).Msg("Failed to fetch series from NFO IDs")
+		return nil
+	}
+	s.logger.Debug().Str("path", seriesDir).Int("tvdbId", result.TvdbID).Int("tmdbId", result.TmdbID).Msg("Matched series from NFO")
+	return result
+}
+
 // tryMatchExistingSeries checks the local DB for an existing series that matches the parsed media,
 // avoiding unnecessary external metadata searches. Uses two strategies:
 // 1. Path match: derive the series folder from the file path and query by path
@@ -207,6 +207,8 @@ func (s *Service) RefreshMovieMetadata(ctx context.Context, movieID int64) (*mov
 		return nil, err
 	}
 
+	s.updateMovieAlternateTitles(ctx, updatedMovie.ID, updatedMovie.Title, bestMatch.ID)
+	s.updateMovieCredits(ctx, updatedMovie.ID, bestMatch.ID)
 	s.downloadMovieArtworkAsync(bestMatch)
 	s.scanMovieFolder(ctx, updatedMovie)
 
@@ -379,6 +381,8 @@ func (s *Service) RefreshSeriesMetadata(ctx context.Context, seriesID int64) (*t
 	}
 
 	s.updateSeriesSeasons(ctx, seriesID, bestMatch.TmdbID, bestMatch.TvdbID)
+	s.updateSeriesAlternateTitles(ctx, seriesID, bestMatch.Title, bestMatch.TvdbID, bestMatch.TmdbID)
+	s.updateSeriesCredits(ctx, seriesID, bestMatch.TmdbID)
 	s.downloadSeriesArtworkFromResult(bestMatch)
 
 	s.logger.Info().
@@ -466,6 +470,8 @@ func (s *Service) updateSeriesSeasons(ctx context.Context, seriesID int64, tmdbI
 		Int("seasons", len(seasonMeta)).
 		Int("episodes", totalEpisodes).
 		Msg("Updated seasons and episodes from metadata")
+
+	s.downloadSeasonArtworkAsync(tmdbID, tvdbID, seasonResults)
 }
 
 func (s *Service) downloadSeriesArtworkFromResult(bestMatch *metadata.SeriesResult) {
@@ -0,0 +1,570 @@
+package librarymanager
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/slipstream/slipstream/internal/database/sqlc"
+	"github.com/slipstream/slipstream/internal/library/movies"
+	"github.com/slipstream/slipstream/internal/library/rootfolder"
+	"github.com/slipstream/slipstream/internal/library/scanner"
+	"github.com/slipstream/slipstream/internal/library/tv"
+	"github.com/slipstream/slipstream/internal/pathutil"
+)
+
+const (
+	OrphanActionImport       = "import"
+	OrphanActionDeleteRecord = "delete-record"
+	OrphanActionRelink       = "relink"
+
+	orphanRecordMovie   = "movie"
+	orphanRecordEpisode = "episode"
+
+	orphanHealthID = "orphan-report"
+)
+
+var (
+	ErrUnknownOrphanAction = errors.New("unknown orphan fix action")
+	ErrOrphanFileNotFound  = errors.New("file not found on disk")
+	ErrOrphanFileTracked   = errors.New("file is already linked to a library record")
+)
+
+// OrphanedFile is a video file under a root folder that no movie or episode
+// file record points at.
+type OrphanedFile struct {
+	Path         string `json:"path"`
+	RootFolderID int64  `json:"rootFolderId"`
+	MediaType    string `json:"mediaType"`
+	Size         int64  `json:"size"`
+	ParsedTitle  string `json:"parsedTitle"`
+	ParsedYear   int    `json:"parsedYear,omitempty"`
+	Season       int    `json:"season,omitempty"`
+	Episode      int    `json:"episode,omitempty"`
+	// MovieID or SeriesID is set when the file sits in the folder of media
+	// already in the library; importing then links it directly.
+	MovieID  int64 `json:"movieId,omitempty"`
+	SeriesID int64 `json:"seriesId,omitempty"`
+}
+
+// MissingRecord is a movie or episode file record whose path is gone from disk.
+type MissingRecord struct {
+	FileID       int64  `json:"fileId"`
+	RecordType   string `json:"recordType"` // "movie" or "episode"
+	MovieID      int64  `json:"movieId,omitempty"`
+	EpisodeID    int64  `json:"episodeId,omitempty"`
+	SeriesID     int64  `json:"seriesId,omitempty"`
+	Path         string `json:"path"`
+	RootFolderID int64  `json:"rootFolderId"`
+	// RelinkPath is an orphaned file that most likely is this record's file
+	// after a rename or move.
+	RelinkPath string `json:"relinkPath,omitempty"`
+}
+
+// OrphanReport lists orphaned files and missing-path records across all
+// reachable root folders.
+type OrphanReport struct {
+	GeneratedAt    time.Time       `json:"generatedAt"`
+	OrphanedFiles  []OrphanedFile  `json:"orphanedFiles"`
+	MissingRecords []MissingRecord `json:"missingRecords"`
+	SkippedFolders []int64         `json:"skippedFolders,omitempty"`
+	Errors         []string        `json:"errors,omitempty"`
+}
+
+// OrphanFixRequest applies one fix from an orphan report. Import takes the
+// orphaned file's Path (and optionally a TmdbID/TvdbID for media not yet in
+// the library). Delete-record takes RecordType and FileID. Relink takes
+// RecordType, FileID and the new Path.
+type OrphanFixRequest struct {
+	Action     string `json:"action"`
+	Path       string `json:"path,omitempty"`
+	RecordType string `json:"recordType,omitempty"`
+	FileID     int64  `json:"fileId,omitempty"`
+	TmdbID     int    `json:"tmdbId,omitempty"`
+	TvdbID     int    `json:"tvdbId,omitempty"`
+}
+
+// OrphanFixResult reports the outcome of a fix.
+type OrphanFixResult struct {
+	Action   string `json:"action"`
+	Path     string `json:"path,omitempty"`
+	FileID   int64  `json:"fileId,omitempty"`
+	MovieID  int64  `json:"movieId,omitempty"`
+	SeriesID int64  `json:"seriesId,omitempty"`
+}
+
+// libraryFolder maps a movie or series folder to its library ID.
+type libraryFolder struct {
+	path string
+	id   int64
+}
+
+// GetOrphanReport returns the latest orphan report, building one if none exists.
+func (s *Service) GetOrphanReport(ctx context.Context) (*OrphanReport, error) {
+	s.orphanMu.Lock()
+	report := s.orphanReport
+	s.orphanMu.Unlock()
+	if report != nil {
+		return report, nil
+	}
+	return s.BuildOrphanReport(ctx)
+}
+
+// BuildOrphanReport walks every reachable root folder for video files not
+// linked to any record, and checks every file record for a missing path.
+// Nothing is changed; fixes are applied one at a time via FixOrphan.
+func (s *Service) BuildOrphanReport(ctx context.Context) (*OrphanReport, error) {
+	folders, err := s.rootfolders.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list root folders: %w", err)
+	}
+	movieFolders, seriesFolders, err := s.libraryFolders(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &OrphanReport{
+		GeneratedAt:    time.Now(),
+		OrphanedFiles:  []OrphanedFile{},
+		MissingRecords: []MissingRecord{},
+	}
+	for _, folder := range folders {
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		if _, err := os.Stat(folder.Path); err != nil {
+			s.logger.Warn().Err(err).Int64("rootFolderId", folder.ID).Str("path", folder.Path).
+				Msg("Root folder unreachable, skipping it in orphan report")
+			report.SkippedFolders = append(report.SkippedFolders, folder.ID)
+			continue
+		}
+		s.collectOrphanedFiles(ctx, folder, movieFolders, seriesFolders, report)
+		s.collectMissingRecords(ctx, folder, report)
+	}
+	suggestRelinks(report)
+
+	s.orphanMu.Lock()
+	s.orphanReport = report
+	s.orphanMu.Unlock()
+	s.updateOrphanHealth(report)
+
+	s.logger.Info().
+		Int("orphanedFiles", len(report.OrphanedFiles)).
+		Int("missingRecords", len(report.MissingRecords)).
+		Msg("Orphan report completed")
+
+	return report, nil
+}
+
+func (s *Service) libraryFolders(ctx context.Context) (movieFolders, seriesFolders []libraryFolder, err error) {
+	movieList, err := s.movies.List(ctx, movies.ListMoviesOptions{})
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to list movies: %w", err)
+	}
+	for _, m := range movieList {
+		if m.Path != "" {
+			movieFolders = append(movieFolders, libraryFolder{path: m.Path, id: m.ID})
+		}
+	}
+
+	seriesList, err := s.tv.ListSeries(ctx, tv.ListSeriesOptions{})
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to list series: %w", err)
+	}
+	for _, series := range seriesList {
+		if series.Path != "" {
+			seriesFolders = append(seriesFolders, libraryFolder{path: series.Path, id: series.ID})
+		}
+	}
+	return movieFolders, seriesFolders, nil
+}
+
+// folderOwner returns the ID of the library folder containing path, or 0.
+func folderOwner(folders []libraryFolder, path string) int64 {
+	for _, f := range folders {
+		if pathutil.HasPathPrefix(path, f.path) {
+			return f.id
+		}
+	}
+	return 0
+}
+
+func (s *Service) collectOrphanedFiles(ctx context.Context, folder *rootfolder.RootFolder, movieFolders, seriesFolders []libraryFolder, report *OrphanReport) {
+	scanResult, err := s.scanner.ScanFolder(ctx, folder.Path, folder.MediaType, nil)
+	if err != nil {
+		report.Errors = append(report.Errors, fmt.Sprintf("%s: %s", folder.Path, err))
+		return
+	}
+	for _, scanErr := range scanResult.Errors {
+		report.Errors = append(report.Errors, fmt.Sprintf("%s: %s", scanErr.Path, scanErr.Error))
+	}
+
+	files := scanResult.Movies
+	if folder.MediaType == "tv" {
+		files = scanResult.Episodes
+	}
+	for i := range files {
+		f := &files[i]
+		if s.isImportFileTracked(ctx, folder, f.FilePath) {
+			continue
+		}
+		orphan := OrphanedFile{
+			Path:         f.FilePath,
+			RootFolderID: folder.ID,
+			MediaType:    folder.MediaType,
+			Size:         f.FileSize,
+			ParsedTitle:  f.Title,
+			ParsedYear:   f.Year,
+			Season:       f.Season,
+			Episode:      f.Episode,
+		}
+		if folder.MediaType == "tv" {
+			orphan.SeriesID = folderOwner(seriesFolders, f.FilePath)
+		} else {
+			orphan.MovieID = folderOwner(movieFolders, f.FilePath)
+		}
+		report.OrphanedFiles = append(report.OrphanedFiles, orphan)
+	}
+}
+
+func (s *Service) collectMissingRecords(ctx context.Context, folder *rootfolder.RootFolder, report *OrphanReport) {
+	rfID := sql.NullInt64{Int64: folder.ID, Valid: true}
+
+	if folder.MediaType == "tv" {
+		rows, err := s.queries.ListEpisodeFilesForRootFolder(ctx, rfID)
+		if err != nil {
+			report.Errors = append(report.Errors, fmt.Sprintf("%s: %s", folder.Path, err))
+			return
+		}
+		for _, row := range rows {
+			if state, _ := checkDiskFile(row.Path, 0); state != diskMissing {
+				continue
+			}
+			record := MissingRecord{
+				FileID:       row.FileID,
+				RecordType:   orphanRecordEpisode,
+				EpisodeID:    row.EpisodeID,
+				Path:         row.Path,
+				RootFolderID: folder.ID,
+			}
+			if ep, err := s.queries.GetEpisode(ctx, row.EpisodeID); err == nil {
+				record.SeriesID = ep.SeriesID
+			}
+			report.MissingRecords = append(report.MissingRecords, record)
+		}
+		return
+	}
+
+	rows, err := s.queries.ListMovieFilesForRootFolder(ctx, rfID)
+	if err != nil {
+		report.Errors = append(report.Errors, fmt.Sprintf("%s: %s", folder.Path, err))
+		return
+	}
+	for _, row := range rows {
+		if state, _ := checkDiskFile(row.Path, 0); state != diskMissing {
+			continue
+		}
+		report.MissingRecords = append(report.MissingRecords, MissingRecord{
+			FileID:       row.FileID,
+			RecordType:   orphanRecordMovie,
+			MovieID:      row.MovieID,
+			Path:         row.Path,
+			RootFolderID: folder.ID,
+		})
+	}
+}
+
+// suggestRelinks pairs missing records with the orphaned file they most likely
+// became: first a file with the same name, then the only orphan in the same
+// movie's folder. Each orphan is suggested at most once.
+func suggestRelinks(report *OrphanReport) {
+	used := make(map[string]bool)
+	byName := make(map[string][]string)
+	byMovie := make(map[int64][]string)
+	for i := range report.OrphanedFiles {
+		o := &report.OrphanedFiles[i]
+		byName[filepath.Base(o.Path)] = append(byName[filepath.Base(o.Path)], o.Path)
+		if o.MovieID != 0 {
+			byMovie[o.MovieID] = append(byMovie[o.MovieID], o.Path)
+		}
+	}
+
+	for i := range report.MissingRecords {
+		r := &report.MissingRecords[i]
+		if paths := byName[filepath.Base(r.Path)]; len(paths) == 1 && !used[paths[0]] {
+			r.RelinkPath = paths[0]
+			used[paths[0]] = true
+		}
+	}
+	for i := range report.MissingRecords {
+		r := &report.MissingRecords[i]
+		if r.RelinkPath != "" || r.RecordType != orphanRecordMovie {
+			continue
+		}
+		if paths := byMovie[r.MovieID]; len(paths) == 1 && !used[paths[0]] {
+			r.RelinkPath = paths[0]
+			used[paths[0]] = true
+		}
+	}
+}
+
+func (s *Service) updateOrphanHealth(report *OrphanReport) {
+	if s.healthSvc == nil {
+		return
+	}
+	if len(report.OrphanedFiles) == 0 && len(report.MissingRecords) == 0 {
+		s.healthSvc.ClearStatusStr("storage", orphanHealthID)
+		return
+	}
+	s.healthSvc.SetWarningStr("storage", orphanHealthID, fmt.Sprintf(
+		"%d orphaned files and %d records with missing files found in the library",
+		len(report.OrphanedFiles), len(report.MissingRecords)))
+}
+
+// FixOrphan applies one fix action from the orphan report and drops the fixed
+// entry from the cached report.
+func (s *Service) FixOrphan(ctx context.Context, req *OrphanFixRequest) (*OrphanFixResult, error) {
+	var (
+		result *OrphanFixResult
+		err    error
+	)
+	switch req.Action {
+	case OrphanActionImport:
+		result, err = s.importOrphan(ctx, req)
+	case OrphanActionDeleteRecord:
+		result, err = s.deleteMissingRecord(ctx, req)
+	case OrphanActionRelink:
+		result, err = s.relinkMissingRecord(ctx, req)
+	default:
+		return nil, fmt.Errorf("%w: %q", ErrUnknownOrphanAction, req.Action)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	s.forgetOrphan(req)
+	return result, nil
+}
+
+func (s *Service) importOrphan(ctx context.Context, req *OrphanFixRequest) (*OrphanFixResult, error) {
+	path := filepath.Clean(req.Path)
+	folder, err := s.rootFolderForPath(ctx, path)
+	if err != nil {
+		return nil, err
+	}
+	parsed, err := s.scanOrphanFile(ctx, folder, path)
+	if err != nil {
+		return nil, err
+	}
+
+	movieFolders, seriesFolders, err := s.libraryFolders(ctx)
+	if err != nil {
+		return nil, err
+	}
+	result := &OrphanFixResult{Action: req.Action, Path: path}
+
+	if folder.MediaType == "tv" {
+		if seriesID := folderOwner(seriesFolders, path); seriesID != 0 {
+			if err := s.addEpisodeFile(ctx, seriesID, parsed); err != nil {
+				return nil, err
+			}
+			result.SeriesID = seriesID
+			return result, nil
+		}
+	} else if movieID := folderOwner(movieFolders, path); movieID != 0 {
+		if err := s.addMovieFile(ctx, movieID, parsed); err != nil {
+			return nil, err
+		}
+		result.MovieID = movieID
+		return result, nil
+	}
+
+	// Not inside any library folder: import its folder as new media.
+	importPath := importFolderPath(folder, []scanner.ParsedMedia{*parsed})
+	if importPath == "" {
+		importPath = path
+	}
+	items, err := s.Import(ctx, folder.ID, &ImportRequest{
+		Monitored: true,
+		Items:     []ImportDecision{{Path: importPath, TmdbID: req.TmdbID, TvdbID: req.TvdbID}},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if items[0].Status == ImportStatusFailed {
+		return nil, errors.New(items[0].Error)
+	}
+	result.MovieID = items[0].MovieID
+	result.SeriesID = items[0].SeriesID
+	return result, nil
+}
+
+func (s *Service) rootFolderForPath(ctx context.Context, path string) (*rootfolder.RootFolder, error) {
+	folders, err := s.rootfolders.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list root folders: %w", err)
+	}
+	for _, folder := range folders {
+		if extractRelativePath(path, folder.Path) != "" {
+			return folder, nil
+		}
+	}
+	return nil, ErrImportPathOutsideRoot
+}
+
+// scanOrphanFile parses an orphaned file the same way a library scan would.
+func (s *Service) scanOrphanFile(ctx context.Context, folder *rootfolder.RootFolder, path string) (*scanner.ParsedMedia, error) {
+	if _, err := os.Stat(path); err != nil {
+		return nil, ErrOrphanFileNotFound
+	}
+	if s.isImportFileTracked(ctx, folder, path) {
+		return nil, ErrOrphanFileTracked
+	}
+
+	scanResult, err := s.scanner.ScanFolder(ctx, filepath.Dir(path), folder.MediaType, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to scan %s: %w", filepath.Dir(path), err)
+	}
+	files := scanResult.Movies
+	if folder.MediaType == "tv" {
+		files = scanResult.Episodes
+	}
+	for i := range files {
+		if pathutil.PathsEqual(files[i].FilePath, path) {
+			return &files[i], nil
+		}
+	}
+	return nil, fmt.Errorf("%s is not a recognized video file", path)
+}
+
+func (s *Service) deleteMissingRecord(ctx context.Context, req *OrphanFixRequest) (*OrphanFixResult, error) {
+	result := &OrphanFixResult{Action: req.Action, FileID: req.FileID}
+
+	switch req.RecordType {
+	case orphanRecordMovie:
+		file, err := s.queries.GetMovieFile(ctx, req.FileID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get movie file: %w", err)
+		}
+		if err := s.queries.DeleteMovieFile(ctx, file.ID); err != nil {
+			return nil, fmt.Errorf("failed to delete movie file: %w", err)
+		}
+		s.recomputeMovieStatus(ctx, file.MovieID)
+		result.Path = file.Path
+		result.MovieID = file.MovieID
+	case orphanRecordEpisode:
+		file, err := s.queries.GetEpisodeFile(ctx, req.FileID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get episode file: %w", err)
+		}
+		if err := s.queries.DeleteEpisodeFile(ctx, file.ID); err != nil {
+			return nil, fmt.Errorf("failed to delete episode file: %w", err)
+		}
+		s.recomputeEpisodeStatus(ctx, file.EpisodeID)
+		result.Path = file.Path
+	default:
+		return nil, fmt.Errorf("invalid record type %q", req.RecordType)
+	}
+	return result, nil
+}
+
+func (s *Service) relinkMissingRecord(ctx context.Context, req *OrphanFixRequest) (*OrphanFixResult, error) {
+	path := filepath.Clean(req.Path)
+	stat, err := os.Stat(path)
+	if err != nil {
+		return nil, ErrOrphanFileNotFound
+	}
+	result := &OrphanFixResult{Action: req.Action, FileID: req.FileID, Path: path}
+
+	switch req.RecordType {
+	case orphanRecordMovie:
+		if s.isMovieFileTracked(ctx, path) {
+			return nil, ErrOrphanFileTracked
+		}
+		file, err := s.queries.GetMovieFile(ctx, req.FileID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get movie file: %w", err)
+		}
+		if err := s.queries.UpdateMovieFilePath(ctx, sqlc.UpdateMovieFilePathParams{Path: path, ID: file.ID}); err != nil {
+			return nil, fmt.Errorf("failed to update movie file path: %w", err)
+		}
+		info := s.probeDiskInfo(ctx, path, fileDiskInfo{
+			Size: stat.Size(), VideoCodec: file.VideoCodec, AudioCodec: file.AudioCodec,
+			Resolution: file.Resolution, AudioChannels: file.AudioChannels, DynamicRange: file.DynamicRange,
+		})
+		if err := s.queries.UpdateMovieFileDiskInfo(ctx, sqlc.UpdateMovieFileDiskInfoParams{
+			Size:          info.Size,
+			VideoCodec:    info.VideoCodec,
+			AudioCodec:    info.AudioCodec,
+			Resolution:    info.Resolution,
+			AudioChannels: info.AudioChannels,
+			DynamicRange:  info.DynamicRange,
+			ID:            file.ID,
+		}); err != nil {
+			s.logger.Warn().Err(err).Int64("fileId", file.ID).Msg("Failed to update relinked movie file disk info")
+		}
+		result.MovieID = file.MovieID
+	case orphanRecordEpisode:
+		if s.isEpisodeFileTracked(ctx, path) {
+			return nil, ErrOrphanFileTracked
+		}
+		file, err := s.queries.GetEpisodeFile(ctx, req.FileID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get episode file: %w", err)
+		}
+		if err := s.queries.UpdateEpisodeFilePath(ctx, sqlc.UpdateEpisodeFilePathParams{Path: path, ID: file.ID}); err != nil {
+			return nil, fmt.Errorf("failed to update episode file path: %w", err)
+		}
+		info := s.probeDiskInfo(ctx, path, fileDiskInfo{
+			Size: stat.Size(), VideoCodec: file.VideoCodec, AudioCodec: file.AudioCodec,
+			Resolution: file.Resolution, AudioChannels: file.AudioChannels, DynamicRange: file.DynamicRange,
+		})
+		if err := s.queries.UpdateEpisodeFileDiskInfo(ctx, sqlc.UpdateEpisodeFileDiskInfoParams{
+			Size:          info.Size,
+			VideoCodec:    info.VideoCodec,
+			AudioCodec:    info.AudioCodec,
+			Resolution:    info.Resolution,
+			AudioChannels: info.AudioChannels,
+			DynamicRange:  info.DynamicRange,
+			ID:            file.ID,
+		}); err != nil {
+			s.logger.Warn().Err(err).Int64("fileId", file.ID).Msg("Failed to update relinked episode file disk info")
+		}
+	default:
+		return nil, fmt.Errorf("invalid record type %q", req.RecordType)
+	}
+	return result, nil
+}
+
+// forgetOrphan removes the entries a fix resolved from the cached report.
+func (s *Service) forgetOrphan(req *OrphanFixRequest) {
+	s.orphanMu.Lock()
+	defer s.orphanMu.Unlock()
+	if s.orphanReport == nil {
+		return
+	}
+
+	report := *s.orphanReport
+	path := filepath.Clean(req.Path)
+	orphans := make([]OrphanedFile, 0, len(report.OrphanedFiles))
+	for _, o := range report.OrphanedFiles {
+		if req.Path != "" && pathutil.PathsEqual(o.Path, path) {
+			continue
+		}
+		orphans = append(orphans, o)
+	}
+	records := make([]MissingRecord, 0, len(report.MissingRecords))
+	for _, r := range report.MissingRecords {
+		if req.FileID != 0 && r.FileID == req.FileID && r.RecordType == req.RecordType {
+			continue
+		}
+		records = append(records, r)
+	}
+	report.OrphanedFiles = orphans
+	report.MissingRecords = records
+	s.orphanReport = &report
+}
@@ -0,0 +1,54 @@
+package librarymanager
+
+import "testing"
+
+func TestFolderOwner(t *testing.T) {
+	folders := []libraryFolder{
+		{path: "/movies/Dune (2021)", id: 1},
+		{path: "/movies/Heat (1995)", id: 2},
+	}
+
+	tests := []struct {
+		path string
+		want int64
+	}{
+		{"/movies/Dune (2021)/Dune.2021.1080p.mkv", 1},
+		{"/movies/Heat (1995)/Extras/Heat.mkv", 2},
+		{"/movies/Dune (2021) Part Two/Dune.Part.Two.mkv", 0},
+		{"/movies/loose.mkv", 0},
+	}
+	for _, tt := range tests {
+		if got := folderOwner(folders, tt.path); got != tt.want {
+			t.Errorf("folderOwner(%q) = %d, want %d", tt.path, got, tt.want)
+		}
+	}
+}
+
+func TestSuggestRelinks(t *testing.T) {
+	report := &OrphanReport{
+		OrphanedFiles: []OrphanedFile{
+			{Path: "/tv/Show/Season 1/Show.S01E01.mkv", SeriesID: 5},
+			{Path: "/movies/Heat (1995)/Heat.1995.2160p.mkv", MovieID: 2},
+			{Path: "/movies/Alien (1979)/a.mkv", MovieID: 3},
+			{Path: "/movies/Alien (1979)/b.mkv", MovieID: 3},
+		},
+		MissingRecords: []MissingRecord{
+			{FileID: 10, RecordType: orphanRecordEpisode, Path: "/tv/Show/Show.S01E01.mkv"},
+			{FileID: 11, RecordType: orphanRecordMovie, MovieID: 2, Path: "/movies/Heat (1995)/Heat.1995.1080p.mkv"},
+			{FileID: 12, RecordType: orphanRecordMovie, MovieID: 3, Path: "/movies/Alien (1979)/c.mkv"},
+		},
+	}
+
+	suggestRelinks(report)
+
+	want := map[int64]string{
+		10: "/tv/Show/Season 1/Show.S01E01.mkv",
+		11: "/movies/Heat (1995)/Heat.1995.2160p.mkv",
+		12: "", // two candidates in the folder, too ambiguous to suggest
+	}
+	for _, r := range report.MissingRecords {
+		if r.RelinkPath != want[r.FileID] {
+			t.Errorf("record %d RelinkPath = %q, want %q", r.FileID, r.RelinkPath, want[r.FileID])
+		}
+	}
+}
@@ -0,0 +1,242 @@
+package librarymanager
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"github.com/slipstream/slipstream/internal/library/movies"
+	"github.com/slipstream/slipstream/internal/library/scanner"
+	"github.com/slipstream/slipstream/internal/library/tv"
+	"github.com/slipstream/slipstream/internal/pathutil"
+)
+
+// PathConflictReason identifies why the folder for a new item cannot be used.
+type PathConflictReason string
+
+const (
+	// PathConflictInUse means another library item resolves to the same folder.
+	PathConflictInUse PathConflictReason = "in_use"
+	// PathConflictUnrelatedFiles means the folder already holds media for a different title.
+	PathConflictUnrelatedFiles PathConflictReason = "unrelated_files"
+)
+
+// PathConflictError is returned when adding media whose folder collides with
+// an existing library item or already contains unrelated files.
+type PathConflictError struct {
+	Path             string             `json:"path"`
+	Reason           PathConflictReason `json:"reason"`
+	ConflictingTitle string             `json:"conflictingTitle,omitempty"`
+	Suggestions      []string           `json:"suggestions"`
+}
+
+func (e *PathConflictError) Error() string {
+	if e.Reason == PathConflictInUse {
+		return fmt.Sprintf("%s is already used by %s", e.Path, e.ConflictingTitle)
+	}
+	return fmt.Sprintf("%s already contains files for another title", e.Path)
+}
+
+// pathCandidate describes a movie or series about to be added.
+type pathCandidate struct {
+	mediaType    string
+	title        string
+	year         int
+	tmdbID       int
+	tvdbID       int
+	path         string
+	rootFolderID int64
+}
+
+// defaultPath returns the folder the candidate is stored in under rootPath.
+func (c *pathCandidate) defaultPath(rootPath string) string {
+	if c.mediaType == mediaTypeMovie {
+		return movies.GenerateMoviePath(rootPath, c.title, c.year)
+	}
+	return tv.GenerateSeriesPath(rootPath, c.title)
+}
+
+// checkPathConflict returns a *PathConflictError with disambiguation
+// suggestions when the candidate's folder is unusable.
+func (s *Service) checkPathConflict(ctx context.Context, c *pathCandidate) error {
+	rootPath := ""
+	if c.rootFolderID > 0 {
+		rf, err := s.rootfolders.Get(ctx, c.rootFolderID)
+		if err != nil {
+			return fmt.Errorf("failed to get root folder: %w", err)
+		}
+		rootPath = rf.Path
+	}
+
+	path := c.path
+	if path == "" {
+		if rootPath == "" {
+			return nil
+		}
+		path = c.defaultPath(rootPath)
+	}
+
+	conflict, err := s.findPathConflict(ctx, c, rootPath, path)
+	if err != nil || conflict == nil {
+		return err
+	}
+
+	conflict.Suggestions = []string{}
+	for _, suggestion := range disambiguatedPaths(c, filepath.Dir(path)) {
+		if pathutil.PathsEqual(suggestion, path) {
+			continue
+		}
+		other, err := s.findPathConflict(ctx, c, rootPath, suggestion)
+		if err != nil {
+			return err
+		}
+		if other == nil {
+			conflict.Suggestions = append(conflict.Suggestions, suggestion)
+		}
+	}
+	return conflict
+}
+
+// checkPathInRoot verifies an explicitly chosen item folder lies inside its
+// root folder, or inside any root folder of the media type when none is given.
+func (s *Service) checkPathInRoot(ctx context.Context, mediaType, path string, rootFolderID int64) error {
+	if path == "" {
+		return nil
+	}
+	if rootFolderID > 0 {
+		rf, err := s.rootfolders.Get(ctx, rootFolderID)
+		if err != nil {
+			return fmt.Errorf("failed to get root folder: %w", err)
+		}
+		if !pathInside(path, rf.Path) {
+			return fmt.Errorf("%w: %s is not inside %s", ErrPathOutsideRoot, path, rf.Path)
+		}
+		return nil
+	}
+
+	moduleType, err := addModuleType(mediaType)
+	if err != nil {
+		return err
+	}
+	folders, err := s.rootfolders.ListByType(ctx, moduleType)
+	if err != nil {
+		return err
+	}
+	for _, rf := range folders {
+		if pathInside(path, rf.Path) {
+			return nil
+		}
+	}
+	return fmt.Errorf("%w: %s", ErrPathOutsideRoot, path)
+}
+
+// pathInside reports whether path is a folder below root, not root itself.
+func pathInside(path, root string) bool {
+	rest, ok := pathutil.TrimPathPrefix(path, root)
+	return ok && rest != ""
+}
+
+func (s *Service) findPathConflict(ctx context.Context, c *pathCandidate, rootPath, path string) (*PathConflictError, error) {
+	title, err := s.findItemAtPath(ctx, c, rootPath, path)
+	if err != nil {
+		return nil, err
+	}
+	if title != "" {
+		return &PathConflictError{Path: path, Reason: PathConflictInUse, ConflictingTitle: title}, nil
+	}
+
+	unrelated, err := folderHasUnrelatedMedia(path, c.title)
+	if err != nil {
+		return nil, err
+	}
+	if unrelated {
+		return &PathConflictError{Path: path, Reason: PathConflictUnrelatedFiles}, nil
+	}
+	return nil, nil //nolint:nilnil // nil conflict means the path is free
+}
+
+// findItemAtPath returns the title of the library item of the same media type
+// whose folder is path. Items without a stored path resolve to their default
+// folder when they share the candidate's root folder.
+func (s *Service) findItemAtPath(ctx context.Context, c *pathCandidate, rootPath, path string) (string, error) {
+	if c.mediaType == mediaTypeMovie {
+		rows, err := s.queries.ListMovies(ctx)
+		if err != nil {
+			return "", fmt.Errorf("failed to list movies: %w", err)
+		}
+		for _, m := range rows {
+			folder := m.Path.String
+			if folder == "" && rootPath != "" && m.RootFolderID.Int64 == c.rootFolderID {
+				folder = movies.GenerateMoviePath(rootPath, m.Title, int(m.Year.Int64))
+			}
+			if folder != "" && pathutil.PathsEqual(folder, path) {
+				return m.Title, nil
+			}
+		}
+		return "", nil
+	}
+
+	rows, err := s.queries.ListSeries(ctx)
+	if err != nil {
+		return "", fmt.Errorf("failed to list series: %w", err)
+	}
+	for _, sr := range rows {
+		folder := sr.Path.String
+		if folder == "" && rootPath != "" && sr.RootFolderID.Int64 == c.rootFolderID {
+			folder = tv.GenerateSeriesPath(rootPath, sr.Title)
+		}
+		if folder != "" && pathutil.PathsEqual(folder, path) {
+			return sr.Title, nil
+		}
+	}
+	return "", nil
+}
+
+// folderHasUnrelatedMedia reports whether path holds a video file whose parsed
+// title differs from title. A missing folder holds nothing.
+func folderHasUnrelatedMedia(path, title string) (bool, error) {
+	want := normalizeTitle(title)
+	unrelated := false
+	err := filepath.WalkDir(path, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() || !scanner.IsVideoFile(d.Name()) || scanner.IsSampleFile(d.Name()) {
+			return nil
+		}
+		parsed := scanner.ParsePath(p)
+		if parsed.Title != "" && normalizeTitle(parsed.Title) != want {
+			unrelated = true
+			return filepath.SkipAll
+		}
+		return nil
+	})
+	if errors.Is(err, os.ErrNotExist) {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("failed to inspect %s: %w", path, err)
+	}
+	return unrelated, nil
+}
+
+// disambiguatedPaths returns alternative folders under parent that append the
+// year and then the metadata ID to the title.
+func disambiguatedPaths(c *pathCandidate, parent string) []string {
+	name := c.title
+	if c.year > 0 {
+		name = fmt.Sprintf("%s (%d)", c.title, c.year)
+	}
+
+	paths := []string{filepath.ToSlash(filepath.Join(parent, name))}
+	switch {
+	case c.tmdbID > 0:
+		paths = append(paths, filepath.ToSlash(filepath.Join(parent, fmt.Sprintf("%s {tmdb-%d}", name, c.tmdbID))))
+	case c.tvdbID > 0:
+		paths = append(paths, filepath.ToSlash(filepath.Join(parent, fmt.Sprintf("%s {tvdb-%d}", name, c.tvdbID))))
+	}
+	return paths
+}
@@ -0,0 +1,63 @@
+package librarymanager
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+
+	"github.com/rs/zerolog"
+
+	"github.com/slipstream/slipstream/internal/library/scanner"
+	"github.com/slipstream/slipstream/internal/module"
+	"github.com/slipstream/slipstream/internal/modules/movie"
+)
+
+func TestFolderHasUnrelatedMedia(t *testing.T) {
+	logger := zerolog.Nop()
+	reg := module.NewRegistry()
+	reg.Register(movie.NewModule(nil, nil, nil, nil, nil, &logger))
+	scanner.SetGlobalRegistry(module.NewScannerRegistryAdapter(reg))
+	t.Cleanup(func() { scanner.SetGlobalRegistry(nil) })
+
+	dir := t.TempDir()
+
+	unrelated, err := folderHasUnrelatedMedia(filepath.Join(dir, "missing"), "Inception")
+	if err != nil || unrelated {
+		t.Fatalf("missing folder = %v, %v, want false, nil", unrelated, err)
+	}
+
+	write := func(name string) {
+		t.Helper()
+		if err := os.WriteFile(filepath.Join(dir, name), []byte("x"), 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	write("Inception.2010.1080p.BluRay.x264-GRP.mkv")
+	write("poster.jpg")
+	unrelated, err = folderHasUnrelatedMedia(dir, "Inception")
+	if err != nil || unrelated {
+		t.Errorf("matching files = %v, %v, want false, nil", unrelated, err)
+	}
+
+	write("Interstellar.2014.1080p.BluRay.x264-GRP.mkv")
+	unrelated, err = folderHasUnrelatedMedia(dir, "Inception")
+	if err != nil || !unrelated {
+		t.Errorf("unrelated file = %v, %v, want true, nil", unrelated, err)
+	}
+}
+
+func TestDisambiguatedPaths(t *testing.T) {
+	got := disambiguatedPaths(&pathCandidate{mediaType: mediaTypeSeries, title: "The Office", year: 2005, tmdbID: 2316}, "/tv")
+	want := []string{"/tv/The Office (2005)", "/tv/The Office (2005) {tmdb-2316}"}
+	if !slices.Equal(got, want) {
+		t.Errorf("disambiguatedPaths() = %v, want %v", got, want)
+	}
+
+	got = disambiguatedPaths(&pathCandidate{mediaType: mediaTypeSeries, title: "The Office", tvdbID: 73244}, "/tv")
+	want = []string{"/tv/The Office", "/tv/The Office {tvdb-73244}"}
+	if !slices.Equal(got, want) {
+		t.Errorf("disambiguatedPaths() without year = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,404 @@
+package librarymanager
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/slipstream/slipstream/internal/autosearch"
+	"github.com/slipstream/slipstream/internal/database/sqlc"
+	"github.com/slipstream/slipstream/internal/library/quality"
+)
+
+const (
+	AuditReasonResolution = "resolution"
+	AuditReasonBitrate    = "bitrate"
+	AuditReasonCodec      = "codec"
+
+	qualityAuditHealthID = "quality-audit"
+)
+
+var (
+	ErrAuditSearchUnavailable = errors.New("automatic search is not available")
+	ErrInvalidAuditRecordType = errors.New("invalid record type")
+)
+
+// auditMinBitrateKbps is the overall bitrate below which a file claiming the
+// resolution is most likely a re-encode or mislabelled upscale.
+var auditMinBitrateKbps = map[int]int{
+	2160: 4000,
+	1080: 1500,
+	720:  700,
+	480:  300,
+}
+
+// auditLegacyCodecs are codecs no genuine HD release is encoded with.
+var auditLegacyCodecs = map[string]bool{
+	"XviD":  true,
+	"DivX":  true,
+	"MPEG2": true,
+}
+
+// SuspiciousFile is a library file whose actual media info does not back up
+// its recorded quality.
+type SuspiciousFile struct {
+	FileID     int64  `json:"fileId"`
+	RecordType string `json:"recordType"` // "movie" or "episode"
+	MovieID    int64  `json:"movieId,omitempty"`
+	EpisodeID  int64  `json:"episodeId,omitempty"`
+	SeriesID   int64  `json:"seriesId,omitempty"`
+	Title      string `json:"title"`
+	Path       string `json:"path"`
+	QualityID  int    `json:"qualityId"`
+	Quality    string `json:"quality"`
+	// Actual media info; BitrateKbps is 0 when the duration is unknown.
+	Resolution  string `json:"resolution,omitempty"`
+	VideoCodec  string `json:"videoCodec,omitempty"`
+	BitrateKbps int    `json:"bitrateKbps,omitempty"`
+	// ActualQualityID is the quality of the same source matching the file's
+	// real resolution, set when it differs from the recorded one.
+	ActualQualityID int      `json:"actualQualityId,omitempty"`
+	Reasons         []string `json:"reasons"`
+	Details         []string `json:"details"`
+}
+
+// QualityAuditReport lists files flagged by the quality audit.
+type QualityAuditReport struct {
+	GeneratedAt time.Time        `json:"generatedAt"`
+	Checked     int              `json:"checked"`
+	Files       []SuspiciousFile `json:"files"`
+	Errors      []string         `json:"errors,omitempty"`
+}
+
+// QualityAuditSearchRequest re-searches the media of one flagged file.
+type QualityAuditSearchRequest struct {
+	RecordType string `json:"recordType"`
+	FileID     int64  `json:"fileId"`
+}
+
+// QualityAuditSearchResult reports the outcome of a re-search.
+type QualityAuditSearchResult struct {
+	FileID      int64                    `json:"fileId"`
+	Requalified bool                     `json:"requalified"`
+	Search      *autosearch.SearchResult `json:"search"`
+}
+
+// auditFile is the input to a single file check.
+type auditFile struct {
+	qualityID      int
+	size           int64
+	width, height  int
+	videoCodec     string
+	duration       time.Duration
+	runtimeMinutes int
+}
+
+// GetQualityAuditReport returns the latest quality audit report, building one
+// if none exists.
+func (s *Service) GetQualityAuditReport(ctx context.Context) (*QualityAuditReport, error) {
+	s.auditMu.Lock()
+	report := s.auditReport
+	s.auditMu.Unlock()
+	if report != nil {
+		return report, nil
+	}
+	return s.BuildQualityAuditReport(ctx)
+}
+
+// BuildQualityAuditReport checks every movie and episode file's resolution,
+// bitrate and codec against its recorded quality. Files are probed when
+// MediaInfo is available; otherwise the stored media info and the metadata
+// runtime are used. Nothing is changed.
+func (s *Service) BuildQualityAuditReport(ctx context.Context) (*QualityAuditReport, error) {
+	movieRows, err := s.queries.ListMovieFilesForQualityAudit(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list movie files: %w", err)
+	}
+	episodeRows, err := s.queries.ListEpisodeFilesForQualityAudit(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list episode files: %w", err)
+	}
+
+	qualities := s.qualities()
+	report := &QualityAuditReport{GeneratedAt: time.Now(), Files: []SuspiciousFile{}}
+	for _, row := range movieRows {
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		file, ok := s.auditFileInfo(ctx, row.Path, row.QualityID, row.VideoCodec, row.Resolution, row.Runtime, report)
+		if !ok {
+			continue
+		}
+		if flagged := auditQuality(qualities, file); flagged != nil {
+			flagged.FileID = row.FileID
+			flagged.RecordType = orphanRecordMovie
+			flagged.MovieID = row.MovieID
+			flagged.Title = row.Title
+			flagged.Path = row.Path
+			report.Files = append(report.Files, *flagged)
+		}
+	}
+	for _, row := range episodeRows {
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		file, ok := s.auditFileInfo(ctx, row.Path, row.QualityID, row.VideoCodec, row.Resolution, row.Runtime, report)
+		if !ok {
+			continue
+		}
+		if flagged := auditQuality(qualities, file); flagged != nil {
+			flagged.FileID = row.FileID
+			flagged.RecordType = orphanRecordEpisode
+			flagged.EpisodeID = row.EpisodeID
+			flagged.SeriesID = row.SeriesID
+			flagged.Title = fmt.Sprintf("%s S%02dE%02d", row.Title, row.SeasonNumber, row.EpisodeNumber)
+			flagged.Path = row.Path
+			report.Files = append(report.Files, *flagged)
+		}
+	}
+
+	s.auditMu.Lock()
+	s.auditReport = report
+	s.auditMu.Unlock()
+	s.updateQualityAuditHealth(report)
+
+	s.logger.Info().
+		Int("checked", report.Checked).
+		Int("flagged", len(report.Files)).
+		Msg("Quality audit completed")
+
+	return report, nil
+}
+
+// auditFileInfo gathers the actual media info of a file. Files missing from
+// disk are skipped; the orphan report covers them.
+func (s *Service) auditFileInfo(ctx context.Context, path string, qualityID sql.NullInt64, videoCodec, resolution sql.NullString, runtime sql.NullInt64, report *QualityAuditReport) (*auditFile, bool) {
+	stat, err := os.Stat(path)
+	if err != nil {
+		return nil, false
+	}
+	report.Checked++
+
+	file := &auditFile{
+		qualityID:      int(qualityID.Int64),
+		size:           stat.Size(),
+		videoCodec:     videoCodec.String,
+		runtimeMinutes: int(runtime.Int64),
+	}
+	file.width, file.height = parseResolution(resolution.String)
+
+	if s.mediainfo == nil || !s.mediainfo.IsAvailable() {
+		return file, true
+	}
+	info, err := s.mediainfo.Probe(ctx, path)
+	if err != nil {
+		report.Errors = append(report.Errors, fmt.Sprintf("%s: %s", path, err))
+		return file, true
+	}
+	if info.Width > 0 && info.Height > 0 {
+		file.width, file.height = info.Width, info.Height
+	}
+	if info.VideoCodec != "" {
+		file.videoCodec = info.VideoCodec
+	}
+	file.duration = info.Duration
+	return file, true
+}
+
+// auditQuality returns the file as a SuspiciousFile when its media info
+// contradicts its recorded quality, or nil when it looks genuine.
+func auditQuality(qualities *quality.Registry, file *auditFile) *SuspiciousFile {
+	recorded, ok := qualities.GetQualityByID(file.qualityID)
+	if !ok || recorded.Resolution == 0 {
+		return nil
+	}
+
+	flagged := &SuspiciousFile{
+		QualityID:  recorded.ID,
+		Quality:    recorded.Name,
+		VideoCodec: file.videoCodec,
+	}
+	if file.width > 0 && file.height > 0 {
+		flagged.Resolution = fmt.Sprintf("%dx%d", file.width, file.height)
+	}
+
+	if actual := resolutionClass(file.width, file.height); actual > 0 && actual < recorded.Resolution {
+		flagged.Reasons = append(flagged.Reasons, AuditReasonResolution)
+		flagged.Details = append(flagged.Details, fmt.Sprintf("%s is %dp, not %dp", flagged.Resolution, actual, recorded.Resolution))
+		flagged.ActualQualityID = qualityForResolution(qualities, recorded.Source, actual)
+	}
+
+	duration := file.duration
+	if duration <= 0 && file.runtimeMinutes > 0 {
+		duration = time.Duration(file.runtimeMinutes) * time.Minute
+	}
+	if duration > 0 && file.size > 0 {
+		flagged.BitrateKbps = int(float64(file.size) * 8 / duration.Seconds() / 1000)
+		if minKbps := auditMinBitrateKbps[recorded.Resolution]; flagged.BitrateKbps < minKbps {
+			flagged.Reasons = append(flagged.Reasons, AuditReasonBitrate)
+			flagged.Details = append(flagged.Details, fmt.Sprintf("%.1f Mbps is below %.1f Mbps expected for %dp",
+				float64(flagged.BitrateKbps)/1000, float64(minKbps)/1000, recorded.Resolution))
+		}
+	}
+
+	if recorded.Resolution >= 720 && auditLegacyCodecs[file.videoCodec] {
+		flagged.Reasons = append(flagged.Reasons, AuditReasonCodec)
+		flagged.Details = append(flagged.Details, fmt.Sprintf("%s is not used for %dp releases", file.videoCodec, recorded.Resolution))
+	}
+
+	if len(flagged.Reasons) == 0 {
+		return nil
+	}
+	return flagged
+}
+
+// parseResolution parses a stored "1920x1080" resolution.
+func parseResolution(resolution string) (width, height int) {
+	w, h, ok := strings.Cut(strings.ToLower(resolution), "x")
+	if !ok {
+		return 0, 0
+	}
+	width, _ = strconv.Atoi(strings.TrimSpace(w))
+	height, _ = strconv.Atoi(strings.TrimSpace(h))
+	return width, height
+}
+
+// resolutionClass maps frame dimensions to a quality resolution. Width is
+// checked too so letterboxed films (1920x800) still count as 1080p.
+func resolutionClass(width, height int) int {
+	switch {
+	case width <= 0 || height <= 0:
+		return 0
+	case width >= 3200 || height >= 2000:
+		return 2160
+	case width >= 1800 || height >= 1000:
+		return 1080
+	case width >= 1200 || height >= 700:
+		return 720
+	default:
+		return 480
+	}
+}
+
+// qualityForResolution returns the quality of the given source and
+// resolution, or 0 when there is none.
+func qualityForResolution(qualities *quality.Registry, source string, resolution int) int {
+	for _, q := range qualities.Qualities() {
+		if q.Source == source && q.Resolution == resolution && !quality.IsCustomQualityID(q.ID) {
+			return q.ID
+		}
+	}
+	return 0
+}
+
+func (s *Service) updateQualityAuditHealth(report *QualityAuditReport) {
+	if s.healthSvc == nil {
+		return
+	}
+	if len(report.Files) == 0 {
+		s.healthSvc.ClearStatusStr("storage", qualityAuditHealthID)
+		return
+	}
+	s.healthSvc.SetWarningStr("storage", qualityAuditHealthID, fmt.Sprintf(
+		"%d files look lower quality than recorded", len(report.Files)))
+}
+
+// SearchAuditedFile re-searches the media of a flagged file. When the audit
+// found the file's real resolution below the recorded one, the recorded
+// quality is corrected first so the search treats a genuine release of the
+// recorded quality as an upgrade.
+func (s *Service) SearchAuditedFile(ctx context.Context, req *QualityAuditSearchRequest) (*QualityAuditSearchResult, error) {
+	if s.autosearchSvc == nil {
+		return nil, ErrAuditSearchUnavailable
+	}
+	flagged := s.findAuditedFile(req)
+	result := &QualityAuditSearchResult{FileID: req.FileID}
+
+	var err error
+	switch req.RecordType {
+	case orphanRecordMovie:
+		file, getErr := s.queries.GetMovieFile(ctx, req.FileID)
+		if getErr != nil {
+			return nil, getErr
+		}
+		if flagged != nil && flagged.ActualQualityID != 0 {
+			if err := s.queries.UpdateMovieFileQualityID(ctx, sqlc.UpdateMovieFileQualityIDParams{
+				QualityID: sql.NullInt64{Int64: int64(flagged.ActualQualityID), Valid: true},
+				ID:        file.ID,
+			}); err != nil {
+				return nil, fmt.Errorf("failed to update movie file quality: %w", err)
+			}
+			s.recomputeMovieStatus(ctx, file.MovieID)
+			result.Requalified = true
+		}
+		result.Search, err = s.autosearchSvc.SearchMovie(ctx, file.MovieID, autosearch.SearchSourceManual)
+	case orphanRecordEpisode:
+		file, getErr := s.queries.GetEpisodeFile(ctx, req.FileID)
+		if getErr != nil {
+			return nil, getErr
+		}
+		if flagged != nil && flagged.ActualQualityID != 0 {
+			if err := s.queries.UpdateEpisodeFileQualityID(ctx, sqlc.UpdateEpisodeFileQualityIDParams{
+				QualityID: sql.NullInt64{Int64: int64(flagged.ActualQualityID), Valid: true},
+				ID:        file.ID,
+			}); err != nil {
+				return nil, fmt.Errorf("failed to update episode file quality: %w", err)
+			}
+			s.recomputeEpisodeStatus(ctx, file.EpisodeID)
+			result.Requalified = true
+		}
+		result.Search, err = s.autosearchSvc.SearchEpisode(ctx, file.EpisodeID, autosearch.SearchSourceManual)
+	default:
+		return nil, fmt.Errorf("%w %q", ErrInvalidAuditRecordType, req.RecordType)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if result.Search != nil && result.Search.Downloaded {
+		s.forgetAuditedFile(req)
+	}
+	return result, nil
+}
+
+// findAuditedFile returns the file's entry in the cached report, or nil.
+func (s *Service) findAuditedFile(req *QualityAuditSearchRequest) *SuspiciousFile {
+	s.auditMu.Lock()
+	defer s.auditMu.Unlock()
+	if s.auditReport == nil {
+		return nil
+	}
+	for i := range s.auditReport.Files {
+		f := &s.auditReport.Files[i]
+		if f.FileID == req.FileID && f.RecordType == req.RecordType {
+			found := *f
+			return &found
+		}
+	}
+	return nil
+}
+
+// forgetAuditedFile drops a file from the cached report once a replacement
+// has been grabbed.
+func (s *Service) forgetAuditedFile(req *QualityAuditSearchRequest) {
+	s.auditMu.Lock()
+	defer s.auditMu.Unlock()
+	if s.auditReport == nil {
+		return
+	}
+	report := *s.auditReport
+	files := make([]SuspiciousFile, 0, len(report.Files))
+	for _, f := range report.Files {
+		if f.FileID == req.FileID && f.RecordType == req.RecordType {
+			continue
+		}
+		files = append(files, f)
+	}
+	report.Files = files
+	s.auditReport = &report
+}
@@ -0,0 +1,94 @@
+package librarymanager
+
+import (
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestAuditQuality(t *testing.T) {
+	const gb = int64(1000 * 1000 * 1000)
+
+	tests := []struct {
+		name        string
+		file        auditFile
+		wantReasons []string
+		wantActual  int
+	}{
+		{
+			name: "genuine 2160p",
+			file: auditFile{qualityID: 16, size: 40 * gb, width: 3840, height: 2160, videoCodec: "HEVC", duration: 2 * time.Hour},
+		},
+		{
+			name:        "2160p under 4 Mbps",
+			file:        auditFile{qualityID: 15, size: 2 * gb, width: 3840, height: 2160, videoCodec: "HEVC", duration: 2 * time.Hour},
+			wantReasons: []string{AuditReasonBitrate},
+		},
+		{
+			name:        "1080p labelled 2160p",
+			file:        auditFile{qualityID: 15, size: 10 * gb, width: 1920, height: 1080, videoCodec: "H.264", duration: 2 * time.Hour},
+			wantReasons: []string{AuditReasonResolution},
+			wantActual:  10,
+		},
+		{
+			name: "letterboxed 1080p",
+			file: auditFile{qualityID: 11, size: 8 * gb, width: 1920, height: 800, videoCodec: "x264", duration: 2 * time.Hour},
+		},
+		{
+			name:        "runtime fallback and legacy codec",
+			file:        auditFile{qualityID: 6, size: gb / 4, width: 1280, height: 720, videoCodec: "XviD", runtimeMinutes: 60},
+			wantReasons: []string{AuditReasonBitrate, AuditReasonCodec},
+		},
+		{
+			name: "unknown duration skips bitrate",
+			file: auditFile{qualityID: 15, size: gb / 10, width: 3840, height: 2160},
+		},
+		{
+			name: "unknown quality",
+			file: auditFile{qualityID: 999, size: gb / 10, width: 640, height: 360, duration: time.Hour},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := auditQuality(nil, &tt.file)
+			if len(tt.wantReasons) == 0 {
+				if got != nil {
+					t.Fatalf("auditQuality() flagged %v, want nil", got.Reasons)
+				}
+				return
+			}
+			if got == nil {
+				t.Fatalf("auditQuality() = nil, want reasons %v", tt.wantReasons)
+			}
+			if !slices.Equal(got.Reasons, tt.wantReasons) {
+				t.Errorf("Reasons = %v, want %v", got.Reasons, tt.wantReasons)
+			}
+			if got.ActualQualityID != tt.wantActual {
+				t.Errorf("ActualQualityID = %d, want %d", got.ActualQualityID, tt.wantActual)
+			}
+			if len(got.Details) != len(got.Reasons) {
+				t.Errorf("Details = %v, want one per reason", got.Details)
+			}
+		})
+	}
+}
+
+func TestParseResolution(t *testing.T) {
+	tests := []struct {
+		in         string
+		wantWidth  int
+		wantHeight int
+	}{
+		{"1920x1080", 1920, 1080},
+		{"3840X2160", 3840, 2160},
+		{"1080p", 0, 0},
+		{"", 0, 0},
+	}
+	for _, tt := range tests {
+		w, h := parseResolution(tt.in)
+		if w != tt.wantWidth || h != tt.wantHeight {
+			t.Errorf("parseResolution(%q) = %dx%d, want %dx%d", tt.in, w, h, tt.wantWidth, tt.wantHeight)
+		}
+	}
+}
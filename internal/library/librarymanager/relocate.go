@@ -0,0 +1,546 @@
+package librarymanager
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/slipstream/slipstream/internal/database/sqlc"
+	"github.com/slipstream/slipstream/internal/library/organizer"
+	"github.com/slipstream/slipstream/internal/pathutil"
+	"github.com/slipstream/slipstream/internal/progress"
+)
+
+var (
+	ErrMoveInProgress          = errors.New("a move is already running for this item")
+	ErrMoveNoFolder            = errors.New("item has no folder to move")
+	ErrMoveSameFolder          = errors.New("item is already in that folder")
+	ErrMoveDestinationRequired = errors.New("a path or root folder is required")
+	ErrMoveIntoSelf            = errors.New("cannot move a folder inside itself")
+	ErrMoveTargetExists        = errors.New("destination folder already exists")
+	ErrMoveTargetInUse         = errors.New("destination folder belongs to another item")
+	ErrMoveUnavailable         = errors.New("folder moves are not available")
+)
+
+// MoveInput chooses where a movie or series folder moves to. An explicit Path
+// wins; with only a RootFolderID the folder keeps its name under that root.
+type MoveInput struct {
+	RootFolderID int64  `json:"rootFolderId,omitempty"`
+	Path         string `json:"path,omitempty"`
+}
+
+// MoveResult describes a started or finished folder move.
+type MoveResult struct {
+	MediaType     string `json:"mediaType"`
+	ID            int64  `json:"id"`
+	OldPath       string `json:"oldPath"`
+	NewPath       string `json:"newPath"`
+	RootFolderID  int64  `json:"rootFolderId"`
+	ActivityID    string `json:"activityId,omitempty"`
+	FilesMoved    int    `json:"filesMoved"`
+	FilesRelinked int    `json:"filesRelinked"`
+	// LinksBroken counts files that were hardlinked outside the folder, such as
+	// to a seeding download, and became separate copies on the new filesystem.
+	LinksBroken   int  `json:"linksBroken"`
+	RenamedFolder bool `json:"renamedFolder"`
+}
+
+// folderMove is a validated move waiting to run.
+type folderMove struct {
+	mediaType    string
+	id           int64
+	title        string
+	oldPath      string
+	newPath      string
+	rootFolderID int64
+}
+
+// moveTracker keeps one move per library item running at a time.
+type moveTracker struct {
+	mu     sync.Mutex
+	active map[string]bool
+}
+
+func (t *moveTracker) start(key string) bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.active == nil {
+		t.active = make(map[string]bool)
+	}
+	if t.active[key] {
+		return false
+	}
+	t.active[key] = true
+	return true
+}
+
+func (t *moveTracker) finish(key string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	delete(t.active, key)
+}
+
+// SetFileOrganizer sets the organizer used to move files between filesystems.
+func (s *Service) SetFileOrganizer(svc *organizer.Service) {
+	s.organizer = svc
+}
+
+// StartMove validates a move of a movie or series folder and runs it in the
+// background, reporting progress through an activity.
+func (s *Service) StartMove(ctx context.Context, mediaType string, id int64, input *MoveInput) (*MoveResult, error) {
+	m, err := s.planMove(ctx, mediaType, id, input)
+	if err != nil {
+		return nil, err
+	}
+	key := fmt.Sprintf("%s-%d", m.mediaType, m.id)
+	if !s.moves.start(key) {
+		return nil, ErrMoveInProgress
+	}
+
+	activityID := fmt.Sprintf("move-%s-%d", key, time.Now().UnixNano())
+	activity := s.initMoveActivity(activityID, m)
+
+	go func() {
+		defer s.moves.finish(key)
+		result, err := s.runMove(context.Background(), m, activity)
+		if err != nil {
+			s.logger.Error().Err(err).Str("mediaType", m.mediaType).Int64("id", m.id).Msg("Folder move failed")
+			s.failActivity(activity, err.Error())
+			return
+		}
+		if activity != nil {
+			activity.Complete(fmt.Sprintf("Moved %d files to %s", result.FilesMoved, result.NewPath))
+		}
+	}()
+
+	return &MoveResult{
+		MediaType:    m.mediaType,
+		ID:           m.id,
+		OldPath:      m.oldPath,
+		NewPath:      m.newPath,
+		RootFolderID: m.rootFolderID,
+		ActivityID:   activityID,
+	}, nil
+}
+
+// planMove resolves the destination of a move and checks it can be used.
+func (s *Service) planMove(ctx context.Context, mediaType string, id int64, input *MoveInput) (*folderMove, error) {
+	if s.organizer == nil {
+		return nil, ErrMoveUnavailable
+	}
+	m, err := s.loadMoveItem(ctx, mediaType, id)
+	if err != nil {
+		return nil, err
+	}
+	if m.oldPath == "" {
+		return nil, ErrMoveNoFolder
+	}
+
+	m.newPath = filepath.Clean(input.Path)
+	if input.Path == "" {
+		if input.RootFolderID == 0 {
+			return nil, ErrMoveDestinationRequired
+		}
+		rf, err := s.rootfolders.Get(ctx, input.RootFolderID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get root folder: %w", err)
+		}
+		m.newPath = filepath.Join(rf.Path, filepath.Base(m.oldPath))
+	}
+
+	m.rootFolderID, err = s.moveRootFolder(ctx, m.mediaType, m.newPath, input.RootFolderID)
+	if err != nil {
+		return nil, err
+	}
+	if pathutil.PathsEqual(m.oldPath, m.newPath) {
+		return nil, ErrMoveSameFolder
+	}
+	if pathutil.HasPathPrefix(m.newPath, m.oldPath) {
+		return nil, ErrMoveIntoSelf
+	}
+	if _, err := os.Stat(m.newPath); err == nil {
+		return nil, fmt.Errorf("%w: %s", ErrMoveTargetExists, m.newPath)
+	}
+	title, err := s.findItemAtPath(ctx, &pathCandidate{mediaType: m.mediaType, rootFolderID: m.rootFolderID}, "", m.newPath)
+	if err != nil {
+		return nil, err
+	}
+	if title != "" {
+		return nil, fmt.Errorf("%w: %s", ErrMoveTargetInUse, title)
+	}
+	return m, nil
+}
+
+func (s *Service) loadMoveItem(ctx context.Context, mediaType string, id int64) (*folderMove, error) {
+	switch mediaType {
+	case mediaTypeMovie:
+		movie, err := s.movies.Get(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		return &folderMove{mediaType: mediaType, id: id, title: movie.Title, oldPath: movie.Path}, nil
+	case mediaTypeSeries:
+		series, err := s.tv.GetSeries(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		return &folderMove{mediaType: mediaType, id: id, title: series.Title, oldPath: series.Path}, nil
+	default:
+		return nil, ErrInvalidAddMediaType
+	}
+}
+
+// moveRootFolder returns the root folder the destination lies in, checking it
+// against rootFolderID when one was given.
+func (s *Service) moveRootFolder(ctx context.Context, mediaType, path string, rootFolderID int64) (int64, error) {
+	if err := s.checkPathInRoot(ctx, mediaType, path, rootFolderID); err != nil {
+		return 0, err
+	}
+	if rootFolderID > 0 {
+		return rootFolderID, nil
+	}
+	moduleType, err := addModuleType(mediaType)
+	if err != nil {
+		return 0, err
+	}
+	folders, err := s.rootfolders.ListByType(ctx, moduleType)
+	if err != nil {
+		return 0, err
+	}
+	var best int64
+	bestLen := -1
+	for _, rf := range folders {
+		if pathInside(path, rf.Path) && len(rf.Path) > bestLen {
+			best, bestLen = rf.ID, len(rf.Path)
+		}
+	}
+	return best, nil
+}
+
+func (s *Service) initMoveActivity(activityID string, m *folderMove) *progress.ActivityBuilder {
+	if s.progress == nil {
+		return nil
+	}
+	activity := s.progress.NewActivityBuilder(activityID, progress.ActivityTypeFileOperation, fmt.Sprintf("Moving %s", m.title))
+	activity.SetMetadata("mediaType", m.mediaType)
+	activity.SetMetadata("id", m.id)
+	activity.SetMetadata("oldPath", m.oldPath)
+	activity.SetMetadata("newPath", m.newPath)
+	return activity
+}
+
+// runMove places the folder at its new location, then points the item and its
+// file records at it. Until the records are updated the old folder stays
+// intact, so a failure at any step leaves the item where it was.
+func (s *Service) runMove(ctx context.Context, m *folderMove, activity *progress.ActivityBuilder) (*MoveResult, error) {
+	result := &MoveResult{
+		MediaType:    m.mediaType,
+		ID:           m.id,
+		OldPath:      m.oldPath,
+		NewPath:      m.newPath,
+		RootFolderID: m.rootFolderID,
+	}
+	if activity != nil {
+		result.ActivityID = activity.ID()
+	}
+
+	if err := s.placeFolder(m.oldPath, m.newPath, result, activity); err != nil {
+		return nil, err
+	}
+
+	if err := s.repointMovedItem(ctx, m); err != nil {
+		s.undoPlaceFolder(m, result.RenamedFolder)
+		return nil, err
+	}
+
+	if !result.RenamedFolder {
+		if err := os.RemoveAll(m.oldPath); err != nil {
+			s.logger.Warn().Err(err).Str("path", m.oldPath).Msg("Failed to remove old folder after move")
+		}
+	}
+
+	s.logger.Info().
+		Str("mediaType", m.mediaType).
+		Int64("id", m.id).
+		Str("from", m.oldPath).
+		Str("to", m.newPath).
+		Int("files", result.FilesMoved).
+		Int("linksBroken", result.LinksBroken).
+		Msg("Moved library folder")
+	return result, nil
+}
+
+// undoPlaceFolder reverts placeFolder after the records could not be updated:
+// a renamed folder is renamed back and a copy is deleted.
+func (s *Service) undoPlaceFolder(m *folderMove, renamed bool) {
+	var err error
+	if renamed {
+		err = os.Rename(m.newPath, m.oldPath)
+	} else {
+		err = os.RemoveAll(m.newPath)
+	}
+	if err != nil {
+		s.logger.Error().Err(err).Str("path", m.newPath).Msg("Failed to undo folder move after failed record update")
+	}
+}
+
+// placeFolder renames the folder when it stays on one filesystem, keeping every
+// hardlink intact. Otherwise the folder is copied entry by entry and the old
+// one is left for the caller to remove once the move is recorded; a failed
+// copy is deleted so nothing is left split between the two roots. Files
+// hardlinked to each other inside the folder are linked again at the
+// destination instead of being copied twice, and symlinks are recreated.
+func (s *Service) placeFolder(oldPath, newPath string, result *MoveResult, activity *progress.ActivityBuilder) error {
+	if err := os.MkdirAll(filepath.Dir(newPath), 0o755); err != nil {
+		return fmt.Errorf("failed to create destination parent: %w", err)
+	}
+	if err := os.Rename(oldPath, newPath); err == nil {
+		result.RenamedFolder = true
+		result.FilesMoved = countFiles(newPath)
+		return nil
+	}
+
+	entries, totalBytes, err := listFolderEntries(oldPath)
+	if err != nil {
+		return err
+	}
+	if err := s.copyFolder(oldPath, newPath, entries, totalBytes, result, activity); err != nil {
+		if rerr := os.RemoveAll(newPath); rerr != nil {
+			s.logger.Error().Err(rerr).Str("path", newPath).Msg("Failed to remove partial folder copy")
+		}
+		return err
+	}
+	return nil
+}
+
+func (s *Service) copyFolder(oldPath, newPath string, entries []folderEntry, totalBytes int64, result *MoveResult, activity *progress.ActivityBuilder) error {
+	var doneBytes int64
+	copiedInodes := make(map[fileKey]string)
+	linksInFolder := countLinksInFolder(entries)
+	for i, entry := range entries {
+		rel, _ := filepath.Rel(oldPath, entry.path)
+		dest := filepath.Join(newPath, rel)
+
+		switch {
+		case entry.info.IsDir():
+			if err := os.MkdirAll(dest, entry.info.Mode().Perm()); err != nil {
+				return fmt.Errorf("failed to create folder: %w", err)
+			}
+			continue
+		case entry.info.Mode()&fs.ModeSymlink != 0:
+			if err := copySymlink(entry.path, dest, oldPath, newPath); err != nil {
+				return err
+			}
+		default:
+			key, links, ok := fileIdentity(entry.info)
+			if first, seen := copiedInodes[key]; ok && seen {
+				if err := s.organizer.CreateHardlink(first, dest); err != nil {
+					return err
+				}
+				result.FilesRelinked++
+				break
+			}
+			base := doneBytes
+			err := s.organizer.CopyFileWithProgress(entry.path, dest, func(p organizer.CopyProgress) {
+				updateMoveProgress(activity, rel, base+p.BytesCopied, totalBytes)
+			})
+			if err != nil {
+				return err
+			}
+			if ok {
+				copiedInodes[key] = dest
+				// A file also linked from outside the folder loses that link.
+				if links > uint64(linksInFolder[key]) {
+					result.LinksBroken++
+				}
+			}
+			doneBytes += entry.info.Size()
+		}
+		result.FilesMoved++
+		updateMoveProgress(activity, fmt.Sprintf("%d of %d entries", i+1, len(entries)), doneBytes, totalBytes)
+	}
+	return nil
+}
+
+// copySymlink recreates a symlink at dest. Absolute targets inside the moved
+// folder are rewritten to follow it; other targets are kept as they are.
+func copySymlink(path, dest, oldRoot, newRoot string) error {
+	target, err := os.Readlink(path)
+	if err != nil {
+		return fmt.Errorf("failed to read symlink: %w", err)
+	}
+	if filepath.IsAbs(target) {
+		if moved, ok := movedPath(target, oldRoot, newRoot); ok {
+			target = moved
+		}
+	}
+	if err := os.MkdirAll(filepath.Dir(dest), 0o755); err != nil {
+		return fmt.Errorf("failed to create folder: %w", err)
+	}
+	if err := os.Symlink(target, dest); err != nil {
+		return fmt.Errorf("failed to recreate symlink: %w", err)
+	}
+	return nil
+}
+
+// repointMovedItem updates the item's folder and root folder and every file
+// record under the old folder in one transaction.
+func (s *Service) repointMovedItem(ctx context.Context, m *folderMove) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+	q := s.queries.WithTx(tx)
+
+	path := sql.NullString{String: m.newPath, Valid: true}
+	rootFolderID := sql.NullInt64{Int64: m.rootFolderID, Valid: m.rootFolderID > 0}
+	if m.mediaType == mediaTypeMovie {
+		err = repointMovieFiles(ctx, q, m)
+		if err == nil {
+			err = q.UpdateMovieFolder(ctx, sqlc.UpdateMovieFolderParams{Path: path, RootFolderID: rootFolderID, ID: m.id})
+		}
+	} else {
+		err = repointEpisodeFiles(ctx, q, m)
+		if err == nil {
+			err = q.UpdateSeriesFolder(ctx, sqlc.UpdateSeriesFolderParams{Path: path, RootFolderID: rootFolderID, ID: m.id})
+		}
+	}
+	if err != nil {
+		return fmt.Errorf("failed to update moved records: %w", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit moved paths: %w", err)
+	}
+
+	if m.mediaType == mediaTypeMovie {
+		if movie, err := s.movies.Get(ctx, m.id); err == nil {
+			s.movies.BroadcastEntity("movie", "movie", m.id, "updated", movie)
+		}
+	} else if series, err := s.tv.GetSeries(ctx, m.id); err == nil {
+		s.tv.BroadcastEntity("tv", "series", m.id, "updated", series)
+	}
+	return nil
+}
+
+func repointMovieFiles(ctx context.Context, q *sqlc.Queries, m *folderMove) error {
+	files, err := q.ListMovieFiles(ctx, m.id)
+	if err != nil {
+		return fmt.Errorf("failed to list movie files: %w", err)
+	}
+	for _, f := range files {
+		if newPath, ok := movedPath(f.Path, m.oldPath, m.newPath); ok {
+			if err := q.UpdateMovieFilePath(ctx, sqlc.UpdateMovieFilePathParams{Path: newPath, ID: f.ID}); err != nil {
+				return fmt.Errorf("failed to update movie file path: %w", err)
+			}
+		}
+	}
+	return nil
+}
+
+func repointEpisodeFiles(ctx context.Context, q *sqlc.Queries, m *folderMove) error {
+	files, err := q.ListEpisodeFilesBySeries(ctx, m.id)
+	if err != nil {
+		return fmt.Errorf("failed to list episode files: %w", err)
+	}
+	for _, f := range files {
+		if newPath, ok := movedPath(f.Path, m.oldPath, m.newPath); ok {
+			if err := q.UpdateEpisodeFilePath(ctx, sqlc.UpdateEpisodeFilePathParams{Path: newPath, ID: f.ID}); err != nil {
+				return fmt.Errorf("failed to update episode file path: %w", err)
+			}
+		}
+	}
+	return nil
+}
+
+// movedPath rewrites a file path under oldRoot to the same place under newRoot.
+func movedPath(path, oldRoot, newRoot string) (string, bool) {
+	rest, ok := pathutil.TrimPathPrefix(path, oldRoot)
+	if !ok {
+		return "", false
+	}
+	return filepath.Join(newRoot, filepath.FromSlash(rest)), true
+}
+
+func updateMoveProgress(activity *progress.ActivityBuilder, subtitle string, done, total int64) {
+	if activity == nil {
+		return
+	}
+	pct := 100
+	if total > 0 {
+		pct = int(done * 100 / total)
+	}
+	activity.Update(subtitle, pct)
+}
+
+type folderEntry struct {
+	path string
+	info fs.FileInfo
+}
+
+// listFolderEntries returns the folders, files and symlinks below root, parents
+// before children, and the total size of the files. Other entries such as
+// sockets and devices cannot be copied, so they fail the listing before
+// anything has moved.
+func listFolderEntries(root string) ([]folderEntry, int64, error) {
+	var entries []folderEntry
+	var total int64
+	err := filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if path == root {
+			return nil
+		}
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		mode := info.Mode()
+		if !mode.IsDir() && !mode.IsRegular() && mode&fs.ModeSymlink == 0 {
+			return fmt.Errorf("cannot move %s: unsupported file type", path)
+		}
+		entries = append(entries, folderEntry{path: path, info: info})
+		if mode.IsRegular() {
+			total += info.Size()
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list folder: %w", err)
+	}
+	return entries, total, nil
+}
+
+// countLinksInFolder counts, for each file, the links to it that lie inside
+// the folder being moved.
+func countLinksInFolder(entries []folderEntry) map[fileKey]int {
+	counts := make(map[fileKey]int)
+	for _, e := range entries {
+		if !e.info.Mode().IsRegular() {
+			continue
+		}
+		if key, _, ok := fileIdentity(e.info); ok {
+			counts[key]++
+		}
+	}
+	return counts
+}
+
+// countFiles counts the entries below root other than folders.
+func countFiles(root string) int {
+	n := 0
+	_ = filepath.WalkDir(root, func(_ string, d fs.DirEntry, err error) error {
+		if err == nil && !d.IsDir() {
+			n++
+		}
+		return nil
+	})
+	return n
+}
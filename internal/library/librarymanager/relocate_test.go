@@ -0,0 +1,172 @@
+package librarymanager
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/slipstream/slipstream/internal/library/movies"
+	"github.com/slipstream/slipstream/internal/library/organizer"
+	"github.com/slipstream/slipstream/internal/library/quality"
+	"github.com/slipstream/slipstream/internal/library/rootfolder"
+	"github.com/slipstream/slipstream/internal/testutil"
+)
+
+func TestMoveMovieFolder(t *testing.T) {
+	tdb := testutil.NewTestDB(t)
+	defer tdb.Close()
+	ctx := context.Background()
+
+	qualitySvc := quality.NewService(tdb.Conn, &tdb.Logger)
+	moviesSvc := movies.NewService(tdb.Conn, nil, &tdb.Logger, qualitySvc, nil)
+	rootfolderSvc := rootfolder.NewService(tdb.Conn, &tdb.Logger, nil, nil)
+	svc := NewService(tdb.Conn, nil, moviesSvc, nil, nil, nil, rootfolderSvc, qualitySvc, nil, &tdb.Logger, nil, nil, nil)
+
+	oldRoot, err := rootfolderSvc.Create(ctx, rootfolder.CreateRootFolderInput{Path: t.TempDir(), MediaType: "movie"})
+	if err != nil {
+		t.Fatalf("Create old root folder: %v", err)
+	}
+	newRoot, err := rootfolderSvc.Create(ctx, rootfolder.CreateRootFolderInput{Path: t.TempDir(), MediaType: "movie"})
+	if err != nil {
+		t.Fatalf("Create new root folder: %v", err)
+	}
+
+	oldPath := filepath.Join(oldRoot.Path, "Heat (1995)")
+	filePath := filepath.Join(oldPath, "Heat (1995).mkv")
+	if err := os.MkdirAll(oldPath, 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filePath, []byte("movie"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	movie, err := moviesSvc.Create(ctx, &movies.CreateMovieInput{Title: "Heat", Year: 1995, Path: oldPath, RootFolderID: oldRoot.ID})
+	if err != nil {
+		t.Fatalf("Create movie: %v", err)
+	}
+	file, err := moviesSvc.AddFile(ctx, movie.ID, &movies.CreateMovieFileInput{Path: filePath, Size: 5})
+	if err != nil {
+		t.Fatalf("AddFile: %v", err)
+	}
+
+	if _, err := svc.StartMove(ctx, mediaTypeMovie, movie.ID, &MoveInput{RootFolderID: newRoot.ID}); !errors.Is(err, ErrMoveUnavailable) {
+		t.Errorf("StartMove without organizer error = %v, want ErrMoveUnavailable", err)
+	}
+	svc.SetFileOrganizer(organizer.NewService(&tdb.Logger))
+
+	if _, err := svc.planMove(ctx, mediaTypeMovie, movie.ID, &MoveInput{Path: oldPath}); !errors.Is(err, ErrMoveSameFolder) {
+		t.Errorf("move to same folder error = %v, want ErrMoveSameFolder", err)
+	}
+	if _, err := svc.planMove(ctx, mediaTypeMovie, movie.ID, &MoveInput{Path: filepath.Join(t.TempDir(), "Heat")}); !errors.Is(err, ErrPathOutsideRoot) {
+		t.Errorf("move outside root folders error = %v, want ErrPathOutsideRoot", err)
+	}
+	if _, err := svc.planMove(ctx, mediaTypeMovie, movie.ID, &MoveInput{}); !errors.Is(err, ErrMoveDestinationRequired) {
+		t.Errorf("move without destination error = %v, want ErrMoveDestinationRequired", err)
+	}
+	if _, err := svc.planMove(ctx, mediaTypeMovie, movie.ID, &MoveInput{Path: filepath.Join(oldPath, "Heat")}); !errors.Is(err, ErrMoveIntoSelf) {
+		t.Errorf("move into own folder error = %v, want ErrMoveIntoSelf", err)
+	}
+	taken := filepath.Join(newRoot.Path, "Taken")
+	if err := os.Mkdir(taken, 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := svc.planMove(ctx, mediaTypeMovie, movie.ID, &MoveInput{Path: taken}); !errors.Is(err, ErrMoveTargetExists) {
+		t.Errorf("move onto existing folder error = %v, want ErrMoveTargetExists", err)
+	}
+
+	plan, err := svc.planMove(ctx, mediaTypeMovie, movie.ID, &MoveInput{RootFolderID: newRoot.ID})
+	if err != nil {
+		t.Fatalf("planMove() error = %v", err)
+	}
+	result, err := svc.runMove(ctx, plan, nil)
+	if err != nil {
+		t.Fatalf("runMove() error = %v", err)
+	}
+
+	newPath := filepath.Join(newRoot.Path, "Heat (1995)")
+	if result.NewPath != newPath || result.FilesMoved != 1 {
+		t.Errorf("result = %+v, want 1 file moved to %s", result, newPath)
+	}
+	if _, err := os.Stat(oldPath); !os.IsNotExist(err) {
+		t.Errorf("old folder still exists: %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(newPath, "Heat (1995).mkv")); err != nil {
+		t.Errorf("moved file missing: %v", err)
+	}
+
+	got, err := moviesSvc.Get(ctx, movie.ID)
+	if err != nil {
+		t.Fatalf("Get movie: %v", err)
+	}
+	if got.Path != newPath || got.RootFolderID != newRoot.ID {
+		t.Errorf("movie path = %s in root %d, want %s in root %d", got.Path, got.RootFolderID, newPath, newRoot.ID)
+	}
+	files, err := moviesSvc.GetFiles(ctx, movie.ID)
+	if err != nil {
+		t.Fatalf("GetFiles: %v", err)
+	}
+	if len(files) != 1 || files[0].ID != file.ID || files[0].Path != filepath.Join(newPath, "Heat (1995).mkv") {
+		t.Errorf("movie files = %+v, want the file under %s", files, newPath)
+	}
+}
+
+func TestMovedPath(t *testing.T) {
+	got, ok := movedPath("/media/old/Show/Season 1/e1.mkv", "/media/old/Show", "/media/new/Show")
+	if !ok || got != filepath.Join("/media/new/Show", "Season 1", "e1.mkv") {
+		t.Errorf("movedPath() = %q, %v", got, ok)
+	}
+	if _, ok := movedPath("/media/old/Other/e1.mkv", "/media/old/Show", "/media/new/Show"); ok {
+		t.Error("movedPath() rewrote a file outside the folder")
+	}
+}
+
+func TestCopyFolder(t *testing.T) {
+	tdb := testutil.NewTestDB(t)
+	defer tdb.Close()
+	svc := &Service{organizer: organizer.NewService(&tdb.Logger)}
+
+	base := t.TempDir()
+	oldPath := filepath.Join(base, "old", "Show")
+	newPath := filepath.Join(base, "new", "Show")
+	season := filepath.Join(oldPath, "Season 1")
+	if err := os.MkdirAll(filepath.Join(oldPath, "Extras"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.MkdirAll(season, 0o755); err != nil {
+		t.Fatal(err)
+	}
+	episode := filepath.Join(season, "e1.mkv")
+	if err := os.WriteFile(episode, []byte("episode"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Link(episode, filepath.Join(season, "e1-link.mkv")); err != nil {
+		t.Skipf("hardlinks unsupported: %v", err)
+	}
+	if err := os.Symlink(episode, filepath.Join(oldPath, "latest.mkv")); err != nil {
+		t.Skipf("symlinks unsupported: %v", err)
+	}
+
+	entries, total, err := listFolderEntries(oldPath)
+	if err != nil {
+		t.Fatalf("listFolderEntries() error = %v", err)
+	}
+	result := &MoveResult{}
+	if err := svc.copyFolder(oldPath, newPath, entries, total, result, nil); err != nil {
+		t.Fatalf("copyFolder() error = %v", err)
+	}
+
+	if result.FilesMoved != 3 || result.FilesRelinked != 1 {
+		t.Errorf("result = %+v, want 3 entries moved with 1 relinked", result)
+	}
+	if _, err := os.Stat(filepath.Join(newPath, "Extras")); err != nil {
+		t.Errorf("empty folder not recreated: %v", err)
+	}
+	target, err := os.Readlink(filepath.Join(newPath, "latest.mkv"))
+	if err != nil || target != filepath.Join(newPath, "Season 1", "e1.mkv") {
+		t.Errorf("symlink target = %q (%v), want it to follow the folder", target, err)
+	}
+	if _, err := os.Stat(episode); err != nil {
+		t.Errorf("source removed by copy: %v", err)
+	}
+}
@@ -0,0 +1,23 @@
+//go:build !windows
+
+package librarymanager
+
+import (
+	"io/fs"
+	"syscall"
+)
+
+// fileKey identifies a file's data on disk, shared by all of its hardlinks.
+type fileKey struct {
+	dev uint64
+	ino uint64
+}
+
+// fileIdentity returns the file's identity and hardlink count.
+func fileIdentity(info fs.FileInfo) (key fileKey, links uint64, ok bool) {
+	st, ok := info.Sys().(*syscall.Stat_t)
+	if !ok {
+		return fileKey{}, 0, false
+	}
+	return fileKey{dev: uint64(st.Dev), ino: st.Ino}, uint64(st.Nlink), true //nolint:unconvert // field widths differ by platform
+}
@@ -0,0 +1,16 @@
+//go:build windows
+
+package librarymanager
+
+import "io/fs"
+
+// fileKey identifies a file's data on disk, shared by all of its hardlinks.
+type fileKey struct {
+	dev uint64
+	ino uint64
+}
+
+// fileIdentity is not available on Windows; every file is moved on its own.
+func fileIdentity(fs.FileInfo) (key fileKey, links uint64, ok bool) {
+	return fileKey{}, 0, false
+}
@@ -455,7 +455,8 @@ func (s *Service) scanMovieFolder(ctx context.Context, movie *movies.Movie) {
 	s.verifyMovieFilesExist(ctx, movie.ID)
 }
 
-func (s *Service) linkNewMovieFiles(ctx context.Context, movieID int64, parsedMovies []scanner.ParsedMedia) {
+func (s *Service) linkNewMovieFiles(ctx context.Context, movieID int64, parsedMovies []scanner.ParsedMedia) int {
+	linked := 0
 	for i := range parsedMovies {
 		parsed := &parsedMovies[i]
 		if s.isMovieFileTracked(ctx, parsed.FilePath) {
@@ -463,8 +464,11 @@ func (s *Service) linkNewMovieFiles(ctx context.Context, movieID int64, parsedMo
 		}
 		if err := s.addMovieFile(ctx, movieID, parsed); err != nil {
 			s.logger.Warn().Err(err).Str("path", parsed.FilePath).Msg("Failed to add movie file during refresh")
+			continue
 		}
+		linked++
 	}
+	return linked
 }
 
 func (s *Service) verifyMovieFilesExist(ctx context.Context, movieID int64) {
@@ -506,7 +510,8 @@ func (s *Service) scanSeriesFolder(ctx context.Context, series *tv.Series) {
 	s.verifyEpisodeFilesExist(ctx, series.ID)
 }
 
-func (s *Service) linkNewEpisodeFiles(ctx context.Context, seriesID int64, parsedEpisodes []scanner.ParsedMedia) {
+func (s *Service) linkNewEpisodeFiles(ctx context.Context, seriesID int64, parsedEpisodes []scanner.ParsedMedia) int {
+	linked := 0
 	for i := range parsedEpisodes {
 		parsed := &parsedEpisodes[i]
 		if s.isEpisodeFileTracked(ctx, parsed.FilePath) {
@@ -514,8 +519,11 @@ func (s *Service) linkNewEpisodeFiles(ctx context.Context, seriesID int64, parse
 		}
 		if err := s.addEpisodeFile(ctx, seriesID, parsed); err != nil {
 			s.logger.Warn().Err(err).Str("path", parsed.FilePath).Msg("Failed to add episode file during refresh")
+			continue
 		}
+		linked++
 	}
+	return linked
 }
 
 func (s *Service) verifyEpisodeFilesExist(ctx context.Context, seriesID int64) {
@@ -11,13 +11,16 @@ import (
 
 	"github.com/slipstream/slipstream/internal/autosearch"
 	"github.com/slipstream/slipstream/internal/database/sqlc"
+	"github.com/slipstream/slipstream/internal/defaults"
 	"github.com/slipstream/slipstream/internal/domain/contracts"
 	"github.com/slipstream/slipstream/internal/library/movies"
+	"github.com/slipstream/slipstream/internal/library/organizer"
 	"github.com/slipstream/slipstream/internal/library/quality"
 	"github.com/slipstream/slipstream/internal/library/rootfolder"
 	"github.com/slipstream/slipstream/internal/library/scanner"
 	"github.com/slipstream/slipstream/internal/library/slots"
 	"github.com/slipstream/slipstream/internal/library/tv"
+	"github.com/slipstream/slipstream/internal/mediainfo"
 	"github.com/slipstream/slipstream/internal/metadata"
 	"github.com/slipstream/slipstream/internal/module"
 	"github.com/slipstream/slipstream/internal/preferences"
@@ -27,13 +30,15 @@ import (
 )
 
 const (
-	mediaTypeMovie = "movie"
+	mediaTypeMovie  = "movie"
+	mediaTypeSeries = "series"
 )
 
 var (
 	ErrNoMetadataProvider = errors.New("no metadata provider configured")
 	ErrNoQualityProfile   = errors.New("no quality profile available")
 	ErrScanInProgress     = errors.New("scan already in progress for this folder")
+	ErrPathOutsideRoot    = errors.New("path is not inside a registered root folder")
 )
 
 // ScanResult represents the final result of a scan operation.
@@ -78,12 +83,32 @@ type Service struct {
 	// Optional health service for file verification alerts
 	healthSvc contracts.HealthService
 
+	// Optional MediaInfo service for re-probing files changed on disk
+	mediainfo *mediainfo.Service
+
 	// Optional module registry for dispatching through module providers
 	registry *module.Registry
 
 	// Track active scans by root folder ID
 	activeScans map[int64]string // maps folderID -> activityID
 	scanMu      sync.RWMutex
+
+	// Optional add wizard dependencies for defaults and free space checks
+	defaults           *defaults.Service
+	storageInfo        StorageInfoProvider
+	freeSpaceThreshold func() int64
+
+	// Latest orphan report, kept for the UI between scheduled runs
+	orphanReport *OrphanReport
+	orphanMu     sync.Mutex
+
+	// Latest quality audit report, kept for the UI between scheduled runs
+	auditReport *QualityAuditReport
+	auditMu     sync.Mutex
+
+	// Optional organizer for folder moves, and the moves currently running
+	organizer *organizer.Service
+	moves     moveTracker
 }
 
 // NewService creates a new library manager service.
@@ -139,6 +164,21 @@ func (s *Service) SetRegistry(reg *module.Registry) {
 	s.registry = reg
 }
 
+// SetMediaInfoService sets the optional MediaInfo service used to re-probe files
+// whose size changed on disk.
+func (s *Service) SetMediaInfoService(svc *mediainfo.Service) {
+	s.mediainfo = svc
+}
+
+// qualities returns the active quality definitions, or nil (predefined
+// only) when no quality service is wired.
+func (s *Service) qualities() *quality.Registry {
+	if s.qualityProfiles == nil {
+		return nil
+	}
+	return s.qualityProfiles.Registry()
+}
+
 // getDefaultQualityProfile returns the first available quality profile.
 func (s *Service) getDefaultQualityProfile(ctx context.Context) (*quality.Profile, error) {
 	profiles, err := s.qualityProfiles.List(ctx)
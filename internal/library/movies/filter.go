@@ -0,0 +1,101 @@
+package movies
+
+import (
+	"database/sql"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/slipstream/slipstream/internal/database/sqlc"
+	"github.com/slipstream/slipstream/internal/pagination"
+)
+
+// SortKeys lists the keys the movie list can be sorted by. The first is the default.
+var SortKeys = []string{"title", "year", "added", "releaseDate", "status"}
+
+// ParseListOptions parses the list endpoint's query grammar:
+//
+//	search=<text>  monitored=true|false  rootFolderId=<id>
+//	qualityProfileId=<id>  tag=<id>  status=<status>[,<status>...]
+//	sort=<key>  order=asc|desc
+//
+// Unknown keys are rejected so saved filters fail loudly instead of matching everything.
+func ParseListOptions(q url.Values) (ListMoviesOptions, error) {
+	var opts ListMoviesOptions
+	var sortKey, order string
+	for key := range q {
+		value := q.Get(key)
+		switch key {
+		case "search":
+			opts.Search = value
+		case "monitored":
+			m, err := strconv.ParseBool(value)
+			if err != nil {
+				return opts, fmt.Errorf("%w: monitored must be true or false", ErrInvalidFilter)
+			}
+			opts.Monitored = &m
+		case "rootFolderId":
+			id, err := strconv.ParseInt(value, 10, 64)
+			if err != nil {
+				return opts, fmt.Errorf("%w: invalid rootFolderId", ErrInvalidFilter)
+			}
+			opts.RootFolderID = &id
+		case "qualityProfileId":
+			id, err := strconv.ParseInt(value, 10, 64)
+			if err != nil {
+				return opts, fmt.Errorf("%w: invalid qualityProfileId", ErrInvalidFilter)
+			}
+			opts.QualityProfileID = &id
+		case "tag":
+			id, err := strconv.ParseInt(value, 10, 64)
+			if err != nil {
+				return opts, fmt.Errorf("%w: invalid tag", ErrInvalidFilter)
+			}
+			opts.TagID = &id
+		case "status":
+			opts.Statuses = strings.Split(value, ",")
+		case "sort":
+			sortKey = value
+		case "order":
+			order = value
+		default:
+			return opts, fmt.Errorf("%w: unknown key %q", ErrInvalidFilter, key)
+		}
+	}
+	sort, err := pagination.ParseSort(sortKey, order, SortKeys...)
+	if err != nil {
+		return opts, fmt.Errorf("%w: %w", ErrInvalidFilter, err)
+	}
+	opts.Sort, opts.Descending = sort.Key, sort.Descending
+	return opts, nil
+}
+
+// filterParams converts the options into query arguments, leaving the page unbounded.
+func (o ListMoviesOptions) filterParams() sqlc.ListMoviesFilteredParams {
+	return sqlc.ListMoviesFilteredParams{
+		SearchTerm:       o.Search,
+		Statuses:         strings.Join(o.Statuses, ","),
+		Monitored:        nullBool(o.Monitored),
+		RootFolderID:     nullInt64(o.RootFolderID),
+		QualityProfileID: nullInt64(o.QualityProfileID),
+		TagID:            nullInt64(o.TagID),
+		SortKey:          o.Sort,
+		Descending:       o.Descending,
+		Lim:              -1,
+	}
+}
+
+func nullBool(b *bool) sql.NullBool {
+	if b == nil {
+		return sql.NullBool{}
+	}
+	return sql.NullBool{Bool: *b, Valid: true}
+}
+
+func nullInt64(n *int64) sql.NullInt64 {
+	if n == nil {
+		return sql.NullInt64{}
+	}
+	return sql.NullInt64{Int64: *n, Valid: true}
+}
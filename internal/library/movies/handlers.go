@@ -6,6 +6,10 @@ import (
 	"strconv"
 
 	"github.com/labstack/echo/v4"
+
+	"github.com/slipstream/slipstream/internal/library"
+	"github.com/slipstream/slipstream/internal/pagination"
+	"github.com/slipstream/slipstream/internal/tags"
 )
 
 // Handlers provides HTTP handlers for movie operations.
@@ -45,20 +49,25 @@ func (h *Handlers) BulkMonitor(c echo.Context) error {
 	return c.JSON(http.StatusOK, map[string]string{"status": "ok"})
 }
 
-// List returns all movies with optional filtering.
+// List returns movies with optional filtering and sorting. Passing page or
+// pageSize returns one page wrapped with the total count instead of a bare array.
 // GET /api/v1/movies
 func (h *Handlers) List(c echo.Context) error {
-	opts := ListMoviesOptions{
-		Search: c.QueryParam("search"),
+	page, paged, err := pagination.FromQuery(c.QueryParams())
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
 	}
-
-	if monitored := c.QueryParam("monitored"); monitored == "true" {
-		m := true
-		opts.Monitored = &m
+	opts, err := ParseListOptions(pagination.Without(c.QueryParams()))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
 	}
-	if rootFolder := c.QueryParam("rootFolderId"); rootFolder != "" {
-		id, _ := strconv.ParseInt(rootFolder, 10, 64)
-		opts.RootFolderID = &id
+
+	if paged {
+		result, err := h.service.ListPage(c.Request().Context(), opts, page)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+		}
+		return c.JSON(http.StatusOK, result)
 	}
 
 	movies, err := h.service.List(c.Request().Context(), opts)
@@ -127,6 +136,9 @@ func (h *Handlers) Update(c echo.Context) error {
 		if errors.Is(err, ErrMovieNotFound) {
 			return echo.NewHTTPError(http.StatusNotFound, err.Error())
 		}
+		if errors.Is(err, tags.ErrTagNotFound) || errors.Is(err, ErrInvalidMovie) {
+			return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+		}
 		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
 	}
 	return c.JSON(http.StatusOK, movie)
@@ -140,9 +152,12 @@ func (h *Handlers) Delete(c echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, "invalid id")
 	}
 
-	deleteFiles := c.QueryParam("deleteFiles") == "true"
+	opts := library.DeleteOptions{
+		DeleteFiles:  c.QueryParam("deleteFiles") == "true",
+		AddExclusion: c.QueryParam("addExclusion") == "true",
+	}
 
-	if err := h.service.Delete(c.Request().Context(), id, deleteFiles); err != nil {
+	if err := h.service.Delete(c.Request().Context(), id, opts); err != nil {
 		if errors.Is(err, ErrMovieNotFound) {
 			return echo.NewHTTPError(http.StatusNotFound, err.Error())
 		}
@@ -3,34 +3,48 @@ package movies
 import (
 	"strconv"
 	"time"
+
+	"github.com/slipstream/slipstream/internal/mediainfo"
+	"github.com/slipstream/slipstream/internal/metadata"
 )
 
 // Movie represents a movie in the library.
 type Movie struct {
-	ID               int64       `json:"id"`
-	Title            string      `json:"title"`
-	SortTitle        string      `json:"sortTitle"`
-	Year             int         `json:"year,omitempty"`
-	TmdbID           int         `json:"tmdbId,omitempty"`
-	ImdbID           string      `json:"imdbId,omitempty"`
-	Overview         string      `json:"overview,omitempty"`
-	Runtime          int         `json:"runtime,omitempty"`
-	Path             string      `json:"path,omitempty"`
-	RootFolderID     int64       `json:"rootFolderId"`
-	QualityProfileID int64       `json:"qualityProfileId"`
-	Monitored        bool        `json:"monitored"`
-	Status           string      `json:"status"`
-	StatusMessage    *string     `json:"statusMessage"`
-	ActiveDownloadID *string     `json:"activeDownloadId"`
-	AddedAt          time.Time   `json:"addedAt"`
-	UpdatedAt        time.Time   `json:"updatedAt,omitempty"`
-	SizeOnDisk       int64       `json:"sizeOnDisk"`
-	MovieFiles       []MovieFile `json:"movieFiles"`
+	ID                int64       `json:"id"`
+	Title             string      `json:"title"`
+	SortTitle         string      `json:"sortTitle"`
+	Year              int         `json:"year,omitempty"`
+	TmdbID            int         `json:"tmdbId,omitempty"`
+	ImdbID            string      `json:"imdbId,omitempty"`
+	Overview          string      `json:"overview,omitempty"`
+	Runtime           int         `json:"runtime,omitempty"`
+	Path              string      `json:"path,omitempty"`
+	RootFolderID      int64       `json:"rootFolderId"`
+	QualityProfileID  int64       `json:"qualityProfileId"`
+	LanguageProfileID int64       `json:"languageProfileId,omitempty"`
+	Monitored         bool        `json:"monitored"`
+	Status            string      `json:"status"`
+	StatusMessage     *string     `json:"statusMessage"`
+	ActiveDownloadID  *string     `json:"activeDownloadId"`
+	AddedAt           time.Time   `json:"addedAt"`
+	UpdatedAt         time.Time   `json:"updatedAt,omitempty"`
+	SizeOnDisk        int64       `json:"sizeOnDisk"`
+	MovieFiles        []MovieFile `json:"movieFiles"`
+	Tags              []int64     `json:"tags"`
+
+	// AKA titles and translations from TMDB, set by Get
+	AlternateTitles []metadata.AlternateTitle `json:"alternateTitles,omitempty"`
 
 	ReleaseDate           *time.Time `json:"releaseDate,omitempty"`
 	PhysicalReleaseDate   *time.Time `json:"physicalReleaseDate,omitempty"`
 	TheatricalReleaseDate *time.Time `json:"theatricalReleaseDate,omitempty"`
 
+	// Release stage required before automatic searches; empty uses the global default
+	MinimumAvailability string `json:"minimumAvailability,omitempty"`
+
+	// Edition cut searched for (Theatrical, Director's Cut, ...); empty accepts any
+	EditionPreference string `json:"editionPreference,omitempty"`
+
 	Studio        string `json:"studio,omitempty"`
 	TvdbID        int    `json:"tvdbId,omitempty"`
 	ContentRating string `json:"contentRating,omitempty"`
@@ -66,22 +80,27 @@ type MovieFile struct {
 	AudioChannels string    `json:"audioChannels,omitempty"`
 	DynamicRange  string    `json:"dynamicRange,omitempty"`
 	Resolution    string    `json:"resolution,omitempty"`
+	Edition       string    `json:"edition,omitempty"`
 	CreatedAt     time.Time `json:"createdAt"`
 	SlotID        *int64    `json:"slotId,omitempty"`
+
+	DolbyVisionProfile string                 `json:"dolbyVisionProfile,omitempty"`
+	AudioTracks        []mediainfo.AudioTrack `json:"audioTracks,omitempty"`
 }
 
 // CreateMovieInput contains fields for creating a movie.
 type CreateMovieInput struct {
-	Title            string `json:"title"`
-	Year             int    `json:"year,omitempty"`
-	TmdbID           int    `json:"tmdbId,omitempty"`
-	ImdbID           string `json:"imdbId,omitempty"`
-	Overview         string `json:"overview,omitempty"`
-	Runtime          int    `json:"runtime,omitempty"`
-	Path             string `json:"path,omitempty"`
-	RootFolderID     int64  `json:"rootFolderId"`
-	QualityProfileID int64  `json:"qualityProfileId"`
-	Monitored        bool   `json:"monitored"`
+	Title             string `json:"title"`
+	Year              int    `json:"year,omitempty"`
+	TmdbID            int    `json:"tmdbId,omitempty"`
+	ImdbID            string `json:"imdbId,omitempty"`
+	Overview          string `json:"overview,omitempty"`
+	Runtime           int    `json:"runtime,omitempty"`
+	Path              string `json:"path,omitempty"`
+	RootFolderID      int64  `json:"rootFolderId"`
+	QualityProfileID  int64  `json:"qualityProfileId"`
+	LanguageProfileID int64  `json:"languageProfileId,omitempty"`
+	Monitored         bool   `json:"monitored"`
 
 	Studio        string `json:"studio,omitempty"`
 	TvdbID        int    `json:"tvdbId,omitempty"`
@@ -92,21 +111,24 @@ type CreateMovieInput struct {
 	PhysicalReleaseDate   string `json:"physicalReleaseDate,omitempty"`   // Bluray release date
 	TheatricalReleaseDate string `json:"theatricalReleaseDate,omitempty"` // Theatrical release date
 
+	Tags []int64 `json:"tags,omitempty"`
+
 	AddedBy *int64 `json:"-"`
 }
 
 // UpdateMovieInput contains fields for updating a movie.
 type UpdateMovieInput struct {
-	Title            *string `json:"title,omitempty"`
-	Year             *int    `json:"year,omitempty"`
-	TmdbID           *int    `json:"tmdbId,omitempty"`
-	ImdbID           *string `json:"imdbId,omitempty"`
-	Overview         *string `json:"overview,omitempty"`
-	Runtime          *int    `json:"runtime,omitempty"`
-	Path             *string `json:"path,omitempty"`
-	RootFolderID     *int64  `json:"rootFolderId,omitempty"`
-	QualityProfileID *int64  `json:"qualityProfileId,omitempty"`
-	Monitored        *bool   `json:"monitored,omitempty"`
+	Title             *string `json:"title,omitempty"`
+	Year              *int    `json:"year,omitempty"`
+	TmdbID            *int    `json:"tmdbId,omitempty"`
+	ImdbID            *string `json:"imdbId,omitempty"`
+	Overview          *string `json:"overview,omitempty"`
+	Runtime           *int    `json:"runtime,omitempty"`
+	Path              *string `json:"path,omitempty"`
+	RootFolderID      *int64  `json:"rootFolderId,omitempty"`
+	QualityProfileID  *int64  `json:"qualityProfileId,omitempty"`
+	LanguageProfileID *int64  `json:"languageProfileId,omitempty"` // 0 clears the language profile
+	Monitored         *bool   `json:"monitored,omitempty"`
 
 	Studio        *string `json:"studio,omitempty"`
 	ContentRating *string `json:"contentRating,omitempty"`
@@ -115,6 +137,11 @@ type UpdateMovieInput struct {
 	ReleaseDate           *string `json:"releaseDate,omitempty"`           // Digital/streaming release date
 	PhysicalReleaseDate   *string `json:"physicalReleaseDate,omitempty"`   // Bluray release date
 	TheatricalReleaseDate *string `json:"theatricalReleaseDate,omitempty"` // Theatrical release date
+
+	MinimumAvailability *string `json:"minimumAvailability,omitempty"` // "" uses the global default
+	EditionPreference   *string `json:"editionPreference,omitempty"`   // "" accepts any edition
+
+	Tags *[]int64 `json:"tags,omitempty"`
 }
 
 // BulkMonitorInput contains fields for bulk monitor/unmonitor.
@@ -125,9 +152,14 @@ type BulkMonitorInput struct {
 
 // ListMoviesOptions contains options for listing movies.
 type ListMoviesOptions struct {
-	Search       string `json:"search,omitempty"`
-	Monitored    *bool  `json:"monitored,omitempty"`
-	RootFolderID *int64 `json:"rootFolderId,omitempty"`
+	Search           string   `json:"search,omitempty"`
+	Monitored        *bool    `json:"monitored,omitempty"`
+	RootFolderID     *int64   `json:"rootFolderId,omitempty"`
+	QualityProfileID *int64   `json:"qualityProfileId,omitempty"`
+	TagID            *int64   `json:"tag,omitempty"`
+	Statuses         []string `json:"status,omitempty"`
+	Sort             string   `json:"sort,omitempty"`
+	Descending       bool     `json:"descending,omitempty"`
 }
 
 // CreateMovieFileInput contains fields for creating a movie file.
@@ -141,6 +173,7 @@ type CreateMovieFileInput struct {
 	AudioChannels    string `json:"audioChannels,omitempty"`
 	DynamicRange     string `json:"dynamicRange,omitempty"`
 	Resolution       string `json:"resolution,omitempty"`
+	Edition          string `json:"edition,omitempty"`          // Parsed from the filename when empty
 	OriginalPath     string `json:"originalPath,omitempty"`     // Source path before import
 	OriginalFilename string `json:"originalFilename,omitempty"` // Original filename before rename
 }
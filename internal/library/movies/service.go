@@ -6,6 +6,7 @@ import (
 	"errors"
 	"fmt"
 	"path/filepath"
+	"slices"
 	"time"
 
 	"github.com/rs/zerolog"
@@ -13,12 +14,19 @@ import (
 	"github.com/slipstream/slipstream/internal/database/sqlc"
 	"github.com/slipstream/slipstream/internal/domain/contracts"
 	"github.com/slipstream/slipstream/internal/library"
+	"github.com/slipstream/slipstream/internal/library/alttitles"
+	"github.com/slipstream/slipstream/internal/library/people"
 	"github.com/slipstream/slipstream/internal/library/quality"
+	"github.com/slipstream/slipstream/internal/library/scanner"
 	"github.com/slipstream/slipstream/internal/library/status"
 	"github.com/slipstream/slipstream/internal/mediainfo"
+	"github.com/slipstream/slipstream/internal/metadata"
 	"github.com/slipstream/slipstream/internal/module"
 	"github.com/slipstream/slipstream/internal/module/parseutil"
+	"github.com/slipstream/slipstream/internal/module/titleutil"
+	"github.com/slipstream/slipstream/internal/pagination"
 	"github.com/slipstream/slipstream/internal/pathutil"
+	"github.com/slipstream/slipstream/internal/tags"
 	"github.com/slipstream/slipstream/internal/websocket"
 )
 
@@ -44,6 +52,7 @@ var (
 	ErrMovieFileNotFound = errors.New("movie file not found")
 	ErrInvalidMovie      = errors.New("invalid movie data")
 	ErrDuplicateTmdbID   = errors.New("movie with this TMDB ID already exists")
+	ErrInvalidFilter     = errors.New("invalid movie filter")
 )
 
 // Service provides movie library operations.
@@ -52,6 +61,8 @@ type Service struct {
 	fileDeleteHandler contracts.FileDeleteHandler
 	notifier          NotificationDispatcher
 	registry          *module.Registry
+	tagStore          contracts.TagStore
+	recycler          library.FileRecycler
 }
 
 // SetRegistry sets the module registry (reserved for future use; movies have no cascade hierarchy).
@@ -64,6 +75,16 @@ func (s *Service) SetNotificationDispatcher(n NotificationDispatcher) {
 	s.notifier = n
 }
 
+// SetFileRecycler sets where files of deleted movies go when a recycle bin is set.
+func (s *Service) SetFileRecycler(r library.FileRecycler) {
+	s.recycler = r
+}
+
+// SetTagStore sets the store movie tags are read from and written to.
+func (s *Service) SetTagStore(store contracts.TagStore) {
+	s.tagStore = store
+}
+
 // SetFileDeleteHandler sets the handler for file deletion events.
 // Req 12.1.1: Deleting file from slot does NOT trigger automatic search
 func (s *Service) SetFileDeleteHandler(handler contracts.FileDeleteHandler) {
@@ -73,17 +94,7 @@ func (s *Service) SetFileDeleteHandler(handler contracts.FileDeleteHandler) {
 // isMovieReleased determines if a movie should be considered released based on
 // the priority chain: digital → physical → theatrical + 90 days.
 func isMovieReleased(digital, physical, theatrical sql.NullTime) bool {
-	now := time.Now()
-	if digital.Valid && !digital.Time.After(now) {
-		return true
-	}
-	if physical.Valid && !physical.Time.After(now) {
-		return true
-	}
-	if theatrical.Valid && !theatrical.Time.AddDate(0, 0, 90).After(now) {
-		return true
-	}
-	return false
+	return status.MovieAvailable(status.AvailabilityReleased, theatrical, digital, physical, time.Now())
 }
 
 // NewService creates a new movie service.
@@ -116,6 +127,11 @@ func (s *Service) Get(ctx context.Context, id int64) (*Movie, error) {
 			movie.SizeOnDisk += f.Size
 		}
 	}
+	s.attachTags(ctx, []*Movie{movie})
+
+	if movie.AlternateTitles, err = alttitles.List(ctx, s.Queries, alttitles.MediaMovie, id); err != nil {
+		s.Logger.Warn().Err(err).Int64("movieId", id).Msg("Failed to get alternate titles")
+	}
 
 	return movie, nil
 }
@@ -132,27 +148,37 @@ func (s *Service) GetByTmdbID(ctx context.Context, tmdbID int) (*Movie, error) {
 	return s.rowToMovie(row), nil
 }
 
-// List returns movies with optional filtering.
+// List returns every movie matching opts, in the order it asks for.
 func (s *Service) List(ctx context.Context, opts ListMoviesOptions) ([]*Movie, error) {
-	var rows []*sqlc.Movie
-	var err error
-
-	switch {
-	case opts.Search != "":
-		searchTerm := "%" + opts.Search + "%"
-		rows, err = s.Queries.SearchMovies(ctx, sqlc.SearchMoviesParams{
-			SearchTerm: searchTerm,
-			Lim:        1000,
-			Off:        0,
-		})
-	case opts.RootFolderID != nil:
-		rows, err = s.Queries.ListMoviesByRootFolder(ctx, sql.NullInt64{Int64: *opts.RootFolderID, Valid: true})
-	case opts.Monitored != nil && *opts.Monitored:
-		rows, err = s.Queries.ListMonitoredMovies(ctx)
-	default:
-		rows, err = s.Queries.ListMovies(ctx)
+	return s.list(ctx, opts, -1, 0)
+}
+
+// ListPage returns one page of the movies matching opts.
+func (s *Service) ListPage(ctx context.Context, opts ListMoviesOptions, page pagination.Params) (*pagination.Page[*Movie], error) {
+	movies, err := s.list(ctx, opts, page.Limit(), page.Offset())
+	if err != nil {
+		return nil, err
+	}
+
+	filter := opts.filterParams()
+	total, err := s.Queries.CountMoviesFiltered(ctx, sqlc.CountMoviesFilteredParams{
+		SearchTerm:       filter.SearchTerm,
+		Statuses:         filter.Statuses,
+		Monitored:        filter.Monitored,
+		RootFolderID:     filter.RootFolderID,
+		QualityProfileID: filter.QualityProfileID,
+		TagID:            filter.TagID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to count movies: %w", err)
 	}
+	return pagination.NewPage(movies, page, total), nil
+}
 
+func (s *Service) list(ctx context.Context, opts ListMoviesOptions, limit, offset int64) ([]*Movie, error) {
+	params := opts.filterParams()
+	params.Lim, params.Off = limit, offset
+	rows, err := s.Queries.ListMoviesFiltered(ctx, params)
 	if err != nil {
 		return nil, fmt.Errorf("failed to list movies: %w", err)
 	}
@@ -161,6 +187,7 @@ func (s *Service) List(ctx context.Context, opts ListMoviesOptions) ([]*Movie, e
 	for i, row := range rows {
 		movies[i] = s.rowToMovie(row)
 	}
+	s.attachTags(ctx, movies)
 	return movies, nil
 }
 
@@ -187,6 +214,9 @@ func (s *Service) Create(ctx context.Context, input *CreateMovieInput) (*Movie,
 	if err := s.checkDuplicateTmdbID(ctx, input.TmdbID); err != nil {
 		return nil, err
 	}
+	if err := s.checkLanguageProfile(ctx, input.LanguageProfileID); err != nil {
+		return nil, err
+	}
 
 	sortTitle := module.GenerateSortTitle(input.Title)
 	path := pathutil.NormalizePath(input.Path)
@@ -223,6 +253,7 @@ func (s *Service) Create(ctx context.Context, input *CreateMovieInput) (*Movie,
 		TvdbID:                sql.NullInt64{Int64: int64(input.TvdbID), Valid: input.TvdbID > 0},
 		ContentRating:         sql.NullString{String: input.ContentRating, Valid: input.ContentRating != ""},
 		AddedBy:               addedBy,
+		LanguageProfileID:     sql.NullInt64{Int64: input.LanguageProfileID, Valid: input.LanguageProfileID > 0},
 	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to create movie: %w", err)
@@ -231,6 +262,12 @@ func (s *Service) Create(ctx context.Context, input *CreateMovieInput) (*Movie,
 	movie := s.rowToMovie(row)
 	s.Logger.Info().Int64("id", movie.ID).Str("title", movie.Title).Msg("Created movie")
 
+	if len(input.Tags) > 0 {
+		if err := s.saveTags(ctx, movie, input.Tags); err != nil {
+			s.Logger.Warn().Err(err).Int64("id", movie.ID).Msg("Failed to tag movie")
+		}
+	}
+
 	s.BroadcastEntity("movie", "movie", movie.ID, "added", movie)
 
 	if s.notifier != nil {
@@ -256,6 +293,17 @@ func (s *Service) Update(ctx context.Context, id int64, input *UpdateMovieInput)
 		s.Logger.Error().Err(err).Int64("id", id).Msg("[UPDATE] Failed to get current movie")
 		return nil, err
 	}
+	if v := input.MinimumAvailability; v != nil && *v != "" && !status.ValidAvailability(*v) {
+		return nil, fmt.Errorf("%w: unknown minimum availability %q", ErrInvalidMovie, *v)
+	}
+	if v := input.EditionPreference; v != nil && *v != "" && !parseutil.IsEditionCut(*v) {
+		return nil, fmt.Errorf("%w: unknown edition %q", ErrInvalidMovie, *v)
+	}
+	if input.LanguageProfileID != nil {
+		if err := s.checkLanguageProfile(ctx, *input.LanguageProfileID); err != nil {
+			return nil, err
+		}
+	}
 
 	params := s.buildMovieUpdateParams(id, current, input)
 
@@ -266,6 +314,12 @@ func (s *Service) Update(ctx context.Context, id int64, input *UpdateMovieInput)
 	}
 
 	movie := s.rowToMovie(row)
+	movie.Tags = current.Tags
+	if input.Tags != nil {
+		if err := s.saveTags(ctx, movie, *input.Tags); err != nil {
+			return nil, err
+		}
+	}
 	s.Logger.Info().
 		Int64("id", id).
 		Str("title", movie.Title).
@@ -278,6 +332,43 @@ func (s *Service) Update(ctx context.Context, id int64, input *UpdateMovieInput)
 	return movie, nil
 }
 
+// attachTags fills in the tags of each movie. Movies are left untagged if no
+// tag store is configured or the lookup fails.
+func (s *Service) attachTags(ctx context.Context, movies []*Movie) {
+	for _, m := range movies {
+		m.Tags = []int64{}
+	}
+	if s.tagStore == nil || len(movies) == 0 {
+		return
+	}
+	tagMap, err := s.tagStore.EntityTagMap(ctx, tags.EntityMovie)
+	if err != nil {
+		s.Logger.Warn().Err(err).Msg("Failed to load movie tags")
+		return
+	}
+	for _, m := range movies {
+		if ids, ok := tagMap[m.ID]; ok {
+			m.Tags = ids
+		}
+	}
+}
+
+// saveTags replaces a movie's tags.
+func (s *Service) saveTags(ctx context.Context, movie *Movie, tagIDs []int64) error {
+	if s.tagStore == nil {
+		return nil
+	}
+	if err := s.tagStore.SetEntityTags(ctx, tags.EntityMovie, movie.ID, tagIDs); err != nil {
+		return fmt.Errorf("failed to set movie tags: %w", err)
+	}
+	ids, err := s.tagStore.EntityTags(ctx, tags.EntityMovie, movie.ID)
+	if err != nil {
+		return fmt.Errorf("failed to get movie tags: %w", err)
+	}
+	movie.Tags = ids
+	return nil
+}
+
 // BulkUpdateMonitored updates the monitored flag for multiple movies at once.
 func (s *Service) BulkUpdateMonitored(ctx context.Context, input BulkMonitorInput) error {
 	if len(input.IDs) == 0 {
@@ -299,7 +390,7 @@ func (s *Service) BulkUpdateMonitored(ctx context.Context, input BulkMonitorInpu
 }
 
 // Delete deletes a movie.
-func (s *Service) Delete(ctx context.Context, id int64, deleteFiles bool) error {
+func (s *Service) Delete(ctx context.Context, id int64, opts library.DeleteOptions) error {
 	movie, err := s.Get(ctx, id)
 	if err != nil {
 		return err
@@ -312,11 +403,14 @@ func (s *Service) Delete(ctx context.Context, id int64, deleteFiles bool) error
 	}
 
 	// Delete files from disk before removing DB records
-	if deleteFiles {
+	if opts.DeleteFiles {
 		if err := s.deleteMovieFilesFromDisk(ctx, id); err != nil {
 			return err
 		}
 	}
+	if opts.AddExclusion {
+		s.excludeMovie(ctx, movie)
+	}
 
 	// Delete movie files from database
 	if err := s.Queries.DeleteMovieFilesByMovie(ctx, id); err != nil {
@@ -340,12 +434,28 @@ func (s *Service) Delete(ctx context.Context, id int64, deleteFiles bool) error
 			TmdbID:   movie.TmdbID,
 			ImdbID:   movie.ImdbID,
 			Overview: movie.Overview,
-		}, deleteFiles, time.Now())
+		}, opts.DeleteFiles, time.Now())
 	}
 
 	return nil
 }
 
+// excludeMovie keeps automated additions from adding a deleted movie back.
+func (s *Service) excludeMovie(ctx context.Context, movie *Movie) {
+	if movie.TmdbID == 0 {
+		return
+	}
+	_, err := s.Queries.CreateLibraryExclusion(ctx, sqlc.CreateLibraryExclusionParams{
+		MediaType:  "movie",
+		ExternalID: int64(movie.TmdbID),
+		Title:      movie.Title,
+		Year:       int64(movie.Year),
+	})
+	if err != nil {
+		s.Logger.Warn().Err(err).Int64("movieId", movie.ID).Msg("Failed to exclude deleted movie")
+	}
+}
+
 func (s *Service) deleteMovieFilesFromDisk(ctx context.Context, movieID int64) error {
 	files, err := s.Queries.ListMovieFiles(ctx, movieID)
 	if err != nil {
@@ -363,7 +473,7 @@ func (s *Service) deleteMovieFilesFromDisk(ctx context.Context, movieID int64) e
 		return fmt.Errorf("cannot delete movie files: %w", err)
 	}
 
-	deleted, err := library.DeleteFiles(paths)
+	deleted, err := library.RemoveFiles(ctx, s.recycler, paths)
 	if err != nil {
 		return fmt.Errorf("failed to delete movie files from disk: %w", err)
 	}
@@ -408,6 +518,9 @@ func (s *Service) AddFile(ctx context.Context, movieID int64, input *CreateMovie
 	}
 
 	input.Path = pathutil.NormalizePath(input.Path)
+	if input.Edition == "" {
+		input.Edition = fileEdition(input)
+	}
 
 	qualityID := sql.NullInt64{}
 	if input.QualityID != nil {
@@ -432,6 +545,7 @@ func (s *Service) AddFile(ctx context.Context, movieID int64, input *CreateMovie
 			OriginalPath:     sql.NullString{String: input.OriginalPath, Valid: true},
 			OriginalFilename: sql.NullString{String: input.OriginalFilename, Valid: input.OriginalFilename != ""},
 			ImportedAt:       sql.NullTime{Time: time.Now(), Valid: true},
+			Edition:          input.Edition,
 		})
 	} else {
 		row, err = s.Queries.CreateMovieFile(ctx, sqlc.CreateMovieFileParams{
@@ -445,6 +559,7 @@ func (s *Service) AddFile(ctx context.Context, movieID int64, input *CreateMovie
 			AudioChannels: sql.NullString{String: input.AudioChannels, Valid: input.AudioChannels != ""},
 			DynamicRange:  sql.NullString{String: input.DynamicRange, Valid: input.DynamicRange != ""},
 			Resolution:    sql.NullString{String: input.Resolution, Valid: input.Resolution != ""},
+			Edition:       input.Edition,
 		})
 	}
 	if err != nil {
@@ -468,6 +583,16 @@ func (s *Service) AddFile(ctx context.Context, movieID int64, input *CreateMovie
 	return &file, nil
 }
 
+// fileEdition parses the edition from the original filename, which keeps the
+// release tags a renamed file may have lost, falling back to the file path.
+func fileEdition(input *CreateMovieFileInput) string {
+	name := input.OriginalFilename
+	if name == "" {
+		name = filepath.Base(input.Path)
+	}
+	return scanner.ParseFilename(name).Edition
+}
+
 // GetFileByPath retrieves a movie file by its path.
 // Returns sql.ErrNoRows if the file doesn't exist.
 func (s *Service) GetFileByPath(ctx context.Context, path string) (*MovieFile, error) {
@@ -539,14 +664,20 @@ func (s *Service) UpdateMovieFilePath(ctx context.Context, fileID int64, newPath
 // UpdateFileMediaInfo updates the MediaInfo fields of a movie's primary file.
 func (s *Service) UpdateFileMediaInfo(ctx context.Context, movieID int64, info *mediainfo.MediaInfo) error {
 	return s.Queries.UpdateMovieFileMediaInfo(ctx, sqlc.UpdateMovieFileMediaInfoParams{
-		VideoCodec: sql.NullString{String: info.VideoCodec, Valid: info.VideoCodec != ""},
-		AudioCodec: sql.NullString{String: info.AudioCodec, Valid: info.AudioCodec != ""},
-		Resolution: sql.NullString{String: info.VideoResolution, Valid: info.VideoResolution != ""},
-		MovieID:    movieID,
+		VideoCodec:         sql.NullString{String: info.VideoCodec, Valid: info.VideoCodec != ""},
+		AudioCodec:         sql.NullString{String: info.AudioCodec, Valid: info.AudioCodec != ""},
+		Resolution:         sql.NullString{String: info.VideoResolution, Valid: info.VideoResolution != ""},
+		AudioChannels:      sql.NullString{String: info.AudioChannels, Valid: info.AudioChannels != ""},
+		DynamicRange:       sql.NullString{String: info.DynamicRangeType, Valid: info.DynamicRangeType != ""},
+		DolbyVisionProfile: info.DolbyVisionProfile,
+		AudioTracks:        mediainfo.EncodeAudioTracks(info.AudioTracks),
+		MovieID:            movieID,
 	})
 }
 
 // FindByTitleAndYear finds a movie by normalized title and optional year.
+// Among same-titled movies (remakes) the closest year within tolerance wins;
+// without a year, several same-titled movies are ambiguous and match nothing.
 // Returns nil if no match is found.
 func (s *Service) FindByTitleAndYear(ctx context.Context, title string, year int) (*Movie, error) {
 	allMovies, err := s.List(ctx, ListMoviesOptions{})
@@ -555,16 +686,46 @@ func (s *Service) FindByTitleAndYear(ctx context.Context, title string, year int
 	}
 
 	normalizedSearch := parseutil.NormalizeTitle(title)
+	var matches []*Movie
+	var years []int
 	for _, m := range allMovies {
 		if parseutil.NormalizeTitle(m.Title) == normalizedSearch {
-			if year == 0 || m.Year == year {
-				return m, nil
+			matches = append(matches, m)
+			years = append(years, m.Year)
+		}
+	}
+
+	// Fall back to AKA titles and translations, e.g. a file named after the
+	// French title of a movie added under its English one
+	if len(matches) == 0 {
+		ids, err := alttitles.MediaIDs(ctx, s.Queries, alttitles.MediaMovie, title)
+		if err != nil {
+			return nil, err
+		}
+		for _, m := range allMovies {
+			if slices.Contains(ids, m.ID) {
+				matches = append(matches, m)
+				years = append(years, m.Year)
 			}
 		}
 	}
+
+	if i := titleutil.ClosestYear(year, years); i >= 0 {
+		return matches[i], nil
+	}
 	return nil, nil //nolint:nilnil // nil movie with no error means "no match found"
 }
 
+// SetAlternateTitles replaces a movie's stored AKA titles and translations.
+func (s *Service) SetAlternateTitles(ctx context.Context, id int64, title string, titles []metadata.AlternateTitle) error {
+	return alttitles.Replace(ctx, s.DB, alttitles.MediaMovie, id, title, titles)
+}
+
+// SetCredits replaces a movie's stored cast and crew.
+func (s *Service) SetCredits(ctx context.Context, id int64, credits *metadata.Credits) error {
+	return people.ReplaceCredits(ctx, s.DB, people.MediaMovie, id, credits)
+}
+
 // Count returns the total number of movies.
 func (s *Service) Count(ctx context.Context) (int64, error) {
 	return s.Queries.CountMovies(ctx)
@@ -616,6 +777,15 @@ func (s *Service) mapMovieCoreFields(m *Movie, row *sqlc.Movie) {
 	if row.QualityProfileID.Valid {
 		m.QualityProfileID = row.QualityProfileID.Int64
 	}
+	if row.LanguageProfileID.Valid {
+		m.LanguageProfileID = row.LanguageProfileID.Int64
+	}
+	if row.MinimumAvailability.Valid {
+		m.MinimumAvailability = row.MinimumAvailability.String
+	}
+	if row.EditionPreference.Valid {
+		m.EditionPreference = row.EditionPreference.String
+	}
 }
 
 func (s *Service) mapMovieMetadataFields(m *Movie, row *sqlc.Movie) {
@@ -672,6 +842,8 @@ func (s *Service) getMovieRowToMovie(row *sqlc.GetMovieWithAddedByRow) *Movie {
 		Path:                  row.Path,
 		RootFolderID:          row.RootFolderID,
 		QualityProfileID:      row.QualityProfileID,
+		LanguageProfileID:     row.LanguageProfileID,
+		MinimumAvailability:   row.MinimumAvailability,
 		Monitored:             row.Monitored,
 		Status:                row.Status,
 		ActiveDownloadID:      row.ActiveDownloadID,
@@ -685,6 +857,7 @@ func (s *Service) getMovieRowToMovie(row *sqlc.GetMovieWithAddedByRow) *Movie {
 		TvdbID:                row.TvdbID,
 		ContentRating:         row.ContentRating,
 		AddedBy:               row.AddedBy,
+		EditionPreference:     row.EditionPreference,
 	})
 	if row.AddedByUsername.Valid {
 		m.AddedByUsername = row.AddedByUsername.String
@@ -706,6 +879,21 @@ func (s *Service) checkDuplicateTmdbID(ctx context.Context, tmdbID int) error {
 	return nil
 }
 
+// checkLanguageProfile returns ErrInvalidMovie when id is set but names no
+// language profile.
+func (s *Service) checkLanguageProfile(ctx context.Context, id int64) error {
+	if id <= 0 {
+		return nil
+	}
+	if _, err := s.Queries.GetLanguageProfile(ctx, id); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return fmt.Errorf("%w: language profile %d not found", ErrInvalidMovie, id)
+		}
+		return fmt.Errorf("failed to get language profile: %w", err)
+	}
+	return nil
+}
+
 func parseReleaseDates(release, physical, theatrical string) (releaseDate, physicalReleaseDate, theatricalReleaseDate sql.NullTime) {
 	if release != "" {
 		if t, err := time.Parse("2006-01-02", release); err == nil {
@@ -735,6 +923,9 @@ func (s *Service) buildMovieUpdateParams(id int64, current *Movie, input *Update
 	path := module.ResolveField(current.Path, input.Path)
 	rootFolderID := module.ResolveField(current.RootFolderID, input.RootFolderID)
 	qualityProfileID := module.ResolveField(current.QualityProfileID, input.QualityProfileID)
+	languageProfileID := module.ResolveField(current.LanguageProfileID, input.LanguageProfileID)
+	minimumAvailability := module.ResolveField(current.MinimumAvailability, input.MinimumAvailability)
+	editionPreference := module.ResolveField(current.EditionPreference, input.EditionPreference)
 	monitored := module.ResolveField(current.Monitored, input.Monitored)
 	studio := module.ResolveField(current.Studio, input.Studio)
 
@@ -762,6 +953,8 @@ func (s *Service) buildMovieUpdateParams(id int64, current *Movie, input *Update
 		Path:                  sql.NullString{String: path, Valid: path != ""},
 		RootFolderID:          sql.NullInt64{Int64: rootFolderID, Valid: rootFolderID > 0},
 		QualityProfileID:      sql.NullInt64{Int64: qualityProfileID, Valid: qualityProfileID > 0},
+		LanguageProfileID:     sql.NullInt64{Int64: languageProfileID, Valid: languageProfileID > 0},
+		MinimumAvailability:   sql.NullString{String: minimumAvailability, Valid: minimumAvailability != ""},
 		Monitored:             monitored,
 		Status:                st,
 		ReleaseDate:           releaseDate,
@@ -770,6 +963,7 @@ func (s *Service) buildMovieUpdateParams(id int64, current *Movie, input *Update
 		Studio:                sql.NullString{String: studio, Valid: studio != ""},
 		TvdbID:                sql.NullInt64{Int64: int64(current.TvdbID), Valid: current.TvdbID > 0},
 		ContentRating:         sql.NullString{String: current.ContentRating, Valid: current.ContentRating != ""},
+		EditionPreference:     sql.NullString{String: editionPreference, Valid: editionPreference != ""},
 	}
 }
 
@@ -843,8 +1037,12 @@ func (s *Service) rowToMovieFile(row *sqlc.MovieFile) MovieFile {
 		AudioChannels: module.NullStr(row.AudioChannels),
 		DynamicRange:  module.NullStr(row.DynamicRange),
 		Resolution:    module.NullStr(row.Resolution),
+		Edition:       row.Edition,
 		CreatedAt:     module.NullTime(row.CreatedAt),
 		SlotID:        module.NullInt64Ptr(row.SlotID),
+
+		DolbyVisionProfile: row.DolbyVisionProfile,
+		AudioTracks:        mediainfo.DecodeAudioTracks(row.AudioTracks),
 	}
 }
 
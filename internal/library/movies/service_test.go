@@ -3,9 +3,15 @@ package movies
 import (
 	"context"
 	"errors"
+	"net/url"
 	"testing"
 
+	"github.com/slipstream/slipstream/internal/database/sqlc"
+	"github.com/slipstream/slipstream/internal/library"
+	"github.com/slipstream/slipstream/internal/metadata"
 	"github.com/slipstream/slipstream/internal/module"
+	"github.com/slipstream/slipstream/internal/pagination"
+	"github.com/slipstream/slipstream/internal/tags"
 	"github.com/slipstream/slipstream/internal/testutil"
 )
 
@@ -252,6 +258,101 @@ func TestMovieService_List_Search(t *testing.T) {
 	}
 }
 
+func TestMovieService_List_Tag(t *testing.T) {
+	tdb := testutil.NewTestDB(t)
+	defer tdb.Close()
+
+	service := NewService(tdb.Conn, nil, &tdb.Logger, nil, nil)
+	tagService := tags.NewService(tdb.Conn, &tdb.Logger)
+	service.SetTagStore(tagService)
+	ctx := context.Background()
+
+	anime, err := tagService.Create(ctx, &tags.Input{Label: "anime"})
+	if err != nil {
+		t.Fatalf("Create tag error = %v", err)
+	}
+
+	akira, err := service.Create(ctx, &CreateMovieInput{Title: "Akira", Year: 1988, Tags: []int64{anime.ID}})
+	if err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+	if len(akira.Tags) != 1 || akira.Tags[0] != anime.ID {
+		t.Errorf("Create() movie.Tags = %v, want [%d]", akira.Tags, anime.ID)
+	}
+	heat, _ := service.Create(ctx, &CreateMovieInput{Title: "Heat", Year: 1995})
+
+	list, err := service.List(ctx, ListMoviesOptions{TagID: &anime.ID})
+	if err != nil {
+		t.Fatalf("List() tag error = %v", err)
+	}
+	if len(list) != 1 || list[0].ID != akira.ID {
+		t.Errorf("List() tag returned %d movies, want only %q", len(list), akira.Title)
+	}
+
+	// Clearing tags on update removes the movie from the filter
+	updated, err := service.Update(ctx, akira.ID, &UpdateMovieInput{Tags: &[]int64{}})
+	if err != nil {
+		t.Fatalf("Update() error = %v", err)
+	}
+	if len(updated.Tags) != 0 {
+		t.Errorf("Update() movie.Tags = %v, want none", updated.Tags)
+	}
+	if _, err := service.Update(ctx, heat.ID, &UpdateMovieInput{Tags: &[]int64{999}}); !errors.Is(err, tags.ErrTagNotFound) {
+		t.Errorf("Update() unknown tag error = %v, want ErrTagNotFound", err)
+	}
+}
+
+func TestMovieService_ListPage(t *testing.T) {
+	tdb := testutil.NewTestDB(t)
+	defer tdb.Close()
+
+	service := NewService(tdb.Conn, nil, &tdb.Logger, nil, nil)
+	ctx := context.Background()
+
+	for i, title := range []string{"Alien", "Brazil", "Casablanca", "Dune", "Eraserhead"} {
+		_, err := service.Create(ctx, &CreateMovieInput{Title: title, Year: 1980 + i, Monitored: i%2 == 0})
+		if err != nil {
+			t.Fatalf("Create() error = %v", err)
+		}
+	}
+
+	opts, err := ParseListOptions(url.Values{"sort": {"year"}, "order": {"desc"}})
+	if err != nil {
+		t.Fatalf("ParseListOptions() error = %v", err)
+	}
+	page, err := service.ListPage(ctx, opts, pagination.Params{Page: 2, PageSize: 2})
+	if err != nil {
+		t.Fatalf("ListPage() error = %v", err)
+	}
+	if page.TotalCount != 5 || page.TotalPages != 3 {
+		t.Errorf("ListPage() total = %d in %d pages, want 5 in 3", page.TotalCount, page.TotalPages)
+	}
+	if len(page.Items) != 2 || page.Items[0].Title != "Casablanca" || page.Items[1].Title != "Brazil" {
+		t.Errorf("ListPage() page 2 = %v, want Casablanca, Brazil", titles(page.Items))
+	}
+
+	monitored := true
+	page, err = service.ListPage(ctx, ListMoviesOptions{Monitored: &monitored}, pagination.Params{Page: 1, PageSize: 10})
+	if err != nil {
+		t.Fatalf("ListPage() monitored error = %v", err)
+	}
+	if page.TotalCount != 3 || len(page.Items) != 3 || page.Items[0].Title != "Alien" {
+		t.Errorf("ListPage() monitored = %v, want Alien, Casablanca, Eraserhead", titles(page.Items))
+	}
+
+	if _, err := ParseListOptions(url.Values{"sort": {"runtime"}}); !errors.Is(err, ErrInvalidFilter) {
+		t.Errorf("ParseListOptions() unknown sort error = %v, want ErrInvalidFilter", err)
+	}
+}
+
+func titles(movies []*Movie) []string {
+	out := make([]string, len(movies))
+	for i, m := range movies {
+		out[i] = m.Title
+	}
+	return out
+}
+
 func TestMovieService_Update(t *testing.T) {
 	tdb := testutil.NewTestDB(t)
 	defer tdb.Close()
@@ -360,7 +461,7 @@ func TestMovieService_Delete(t *testing.T) {
 	}
 
 	// Delete the movie
-	err = service.Delete(ctx, created.ID, false)
+	err = service.Delete(ctx, created.ID, library.DeleteOptions{})
 	if err != nil {
 		t.Fatalf("Delete() error = %v", err)
 	}
@@ -372,6 +473,30 @@ func TestMovieService_Delete(t *testing.T) {
 	}
 }
 
+func TestMovieService_Delete_AddsExclusion(t *testing.T) {
+	tdb := testutil.NewTestDB(t)
+	defer tdb.Close()
+
+	service := NewService(tdb.Conn, nil, &tdb.Logger, nil, nil)
+	ctx := context.Background()
+
+	created, err := service.Create(ctx, &CreateMovieInput{Title: "Unwanted", Year: 2021, TmdbID: 550})
+	if err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+	if err := service.Delete(ctx, created.ID, library.DeleteOptions{AddExclusion: true}); err != nil {
+		t.Fatalf("Delete() error = %v", err)
+	}
+
+	exclusion, err := sqlc.New(tdb.Conn).GetLibraryExclusion(ctx, sqlc.GetLibraryExclusionParams{MediaType: "movie", ExternalID: 550})
+	if err != nil {
+		t.Fatalf("expected an exclusion for the deleted movie: %v", err)
+	}
+	if exclusion.Title != "Unwanted" || exclusion.Year != 2021 {
+		t.Errorf("exclusion = %+v, want title and year of the deleted movie", exclusion)
+	}
+}
+
 func TestMovieService_Delete_NotFound(t *testing.T) {
 	tdb := testutil.NewTestDB(t)
 	defer tdb.Close()
@@ -379,7 +504,7 @@ func TestMovieService_Delete_NotFound(t *testing.T) {
 	service := NewService(tdb.Conn, nil, &tdb.Logger, nil, nil)
 	ctx := context.Background()
 
-	err := service.Delete(ctx, 99999, false)
+	err := service.Delete(ctx, 99999, library.DeleteOptions{})
 	if !errors.Is(err, ErrMovieNotFound) {
 		t.Errorf("Delete() non-existent movie error = %v, want %v", err, ErrMovieNotFound)
 	}
@@ -432,6 +557,106 @@ func TestMovieService_AddFile(t *testing.T) {
 	}
 }
 
+func TestMovieService_AddFile_ParsesEdition(t *testing.T) {
+	tdb := testutil.NewTestDB(t)
+	defer tdb.Close()
+
+	service := NewService(tdb.Conn, nil, &tdb.Logger, nil, nil)
+	ctx := context.Background()
+
+	movie, err := service.Create(ctx, &CreateMovieInput{Title: "Blade Runner", Year: 1982})
+	if err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+
+	file, err := service.AddFile(ctx, movie.ID, &CreateMovieFileInput{
+		Path:             "/movies/Blade Runner (1982)/Blade Runner (1982).mkv",
+		Size:             1500000000,
+		OriginalPath:     "/downloads/Blade.Runner.1982.Final.Cut.1080p.BluRay.x264-GROUP.mkv",
+		OriginalFilename: "Blade.Runner.1982.Final.Cut.1080p.BluRay.x264-GROUP.mkv",
+	})
+	if err != nil {
+		t.Fatalf("AddFile() error = %v", err)
+	}
+	if file.Edition != "Final Cut" {
+		t.Errorf("AddFile() file.Edition = %q, want %q", file.Edition, "Final Cut")
+	}
+}
+
+func TestMovieService_Update_EditionPreference(t *testing.T) {
+	tdb := testutil.NewTestDB(t)
+	defer tdb.Close()
+
+	service := NewService(tdb.Conn, nil, &tdb.Logger, nil, nil)
+	ctx := context.Background()
+
+	created, err := service.Create(ctx, &CreateMovieInput{Title: "Blade Runner", Year: 1982})
+	if err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+
+	edition := "Director's Cut"
+	updated, err := service.Update(ctx, created.ID, &UpdateMovieInput{EditionPreference: &edition})
+	if err != nil {
+		t.Fatalf("Update() error = %v", err)
+	}
+	if updated.EditionPreference != edition {
+		t.Errorf("Update() movie.EditionPreference = %q, want %q", updated.EditionPreference, edition)
+	}
+
+	// Unrelated updates keep the preference
+	title := "Blade Runner: The Final Cut"
+	updated, err = service.Update(ctx, created.ID, &UpdateMovieInput{Title: &title})
+	if err != nil {
+		t.Fatalf("Update() error = %v", err)
+	}
+	if updated.EditionPreference != edition {
+		t.Errorf("Update() movie.EditionPreference = %q, want %q", updated.EditionPreference, edition)
+	}
+
+	unknown := "Fan Edit"
+	if _, err := service.Update(ctx, created.ID, &UpdateMovieInput{EditionPreference: &unknown}); !errors.Is(err, ErrInvalidMovie) {
+		t.Errorf("Update() with unknown edition error = %v, want ErrInvalidMovie", err)
+	}
+}
+
+func TestMovieService_LanguageProfile(t *testing.T) {
+	tdb := testutil.NewTestDB(t)
+	defer tdb.Close()
+
+	service := NewService(tdb.Conn, nil, &tdb.Logger, nil, nil)
+	ctx := context.Background()
+
+	profile, err := sqlc.New(tdb.Conn).CreateLanguageProfile(ctx, sqlc.CreateLanguageProfileParams{Name: "English", Languages: `["en"]`})
+	if err != nil {
+		t.Fatalf("CreateLanguageProfile() error = %v", err)
+	}
+
+	if _, err := service.Create(ctx, &CreateMovieInput{Title: "Heat", LanguageProfileID: 999}); !errors.Is(err, ErrInvalidMovie) {
+		t.Errorf("Create() with unknown language profile error = %v, want ErrInvalidMovie", err)
+	}
+	created, err := service.Create(ctx, &CreateMovieInput{Title: "Heat", LanguageProfileID: profile.ID})
+	if err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+	if created.LanguageProfileID != profile.ID {
+		t.Errorf("Create() movie.LanguageProfileID = %d, want %d", created.LanguageProfileID, profile.ID)
+	}
+
+	unknown := int64(999)
+	if _, err := service.Update(ctx, created.ID, &UpdateMovieInput{LanguageProfileID: &unknown}); !errors.Is(err, ErrInvalidMovie) {
+		t.Errorf("Update() with unknown language profile error = %v, want ErrInvalidMovie", err)
+	}
+	none := int64(0)
+	updated, err := service.Update(ctx, created.ID, &UpdateMovieInput{LanguageProfileID: &none})
+	if err != nil {
+		t.Fatalf("Update() error = %v", err)
+	}
+	if updated.LanguageProfileID != 0 {
+		t.Errorf("Update() movie.LanguageProfileID = %d, want cleared", updated.LanguageProfileID)
+	}
+}
+
 func TestMovieService_GetFiles(t *testing.T) {
 	tdb := testutil.NewTestDB(t)
 	defer tdb.Close()
@@ -575,3 +800,81 @@ func TestGenerateMoviePath(t *testing.T) {
 		})
 	}
 }
+
+func TestMovieService_FindByTitleAndYear_Remakes(t *testing.T) {
+	tdb := testutil.NewTestDB(t)
+	defer tdb.Close()
+
+	service := NewService(tdb.Conn, nil, &tdb.Logger, nil, nil)
+	ctx := context.Background()
+
+	original, err := service.Create(ctx, &CreateMovieInput{Title: "Dune", Year: 1984, TmdbID: 841})
+	if err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+	remake, err := service.Create(ctx, &CreateMovieInput{Title: "Dune", Year: 2021, TmdbID: 438631})
+	if err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+
+	tests := []struct {
+		year   int
+		wantID int64
+	}{
+		{1984, original.ID},
+		{2021, remake.ID},
+		{2020, remake.ID},
+		{2000, 0},
+		{0, 0},
+	}
+	for _, tt := range tests {
+		got, err := service.FindByTitleAndYear(ctx, "Dune", tt.year)
+		if err != nil {
+			t.Fatalf("FindByTitleAndYear(%d) error = %v", tt.year, err)
+		}
+		var gotID int64
+		if got != nil {
+			gotID = got.ID
+		}
+		if gotID != tt.wantID {
+			t.Errorf("FindByTitleAndYear(%d) = movie %d, want %d", tt.year, gotID, tt.wantID)
+		}
+	}
+}
+
+func TestMovieService_FindByTitleAndYear_AlternateTitle(t *testing.T) {
+	tdb := testutil.NewTestDB(t)
+	defer tdb.Close()
+
+	service := NewService(tdb.Conn, nil, &tdb.Logger, nil, nil)
+	ctx := context.Background()
+
+	amelie, err := service.Create(ctx, &CreateMovieInput{Title: "Amélie", Year: 2001, TmdbID: 194})
+	if err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+	if err := service.SetAlternateTitles(ctx, amelie.ID, amelie.Title, []metadata.AlternateTitle{
+		{Title: "Le Fabuleux Destin d'Amélie Poulain", Locale: "fr-FR", Kind: "translation", Source: "tmdb"},
+		{Title: "Amelie", Locale: "US", Kind: "alternative", Source: "tmdb"},
+	}); err != nil {
+		t.Fatalf("SetAlternateTitles() error = %v", err)
+	}
+
+	// Parsed from Le.Fabuleux.Destin.d.Amelie.Poulain.2001.1080p.BluRay.x264
+	got, err := service.FindByTitleAndYear(ctx, "Le Fabuleux Destin d Amelie Poulain", 2001)
+	if err != nil {
+		t.Fatalf("FindByTitleAndYear() error = %v", err)
+	}
+	if got == nil || got.ID != amelie.ID {
+		t.Fatalf("FindByTitleAndYear() = %v, want movie %d", got, amelie.ID)
+	}
+
+	movie, err := service.Get(ctx, amelie.ID)
+	if err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	// "Amelie" reduces to the movie's own title and is not stored
+	if len(movie.AlternateTitles) != 1 || movie.AlternateTitles[0].Locale != "fr-FR" {
+		t.Errorf("AlternateTitles = %+v, want only the French translation", movie.AlternateTitles)
+	}
+}
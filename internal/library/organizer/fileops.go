@@ -34,16 +34,17 @@ func (s *Service) CreateHardlink(source, dest string) error {
 		Str("dest", dest).
 		Msg("Creating hardlink")
 
-	if err := s.ensureDestDir(dest); err != nil {
+	st := s.StorageFor(dest)
+	if err := s.ensureDestDir(st, dest); err != nil {
 		return err
 	}
 
 	// Remove existing file if present (overwrite behavior)
-	if err := s.removeIfExists(dest); err != nil {
+	if err := s.removeIfExists(st, dest); err != nil {
 		return err
 	}
 
-	if err := os.Link(source, dest); err != nil {
+	if err := st.Link(source, dest); err != nil {
 		if isCrossDeviceError(err) {
 			return fmt.Errorf("%w: %w", ErrCrossDevice, err)
 		}
@@ -64,11 +65,12 @@ func (s *Service) CreateSymlink(source, dest string) error {
 		Str("dest", dest).
 		Msg("Creating symlink")
 
-	if err := s.ensureDestDir(dest); err != nil {
+	st := s.StorageFor(dest)
+	if err := s.ensureDestDir(st, dest); err != nil {
 		return err
 	}
 
-	if err := s.removeIfExists(dest); err != nil {
+	if err := s.removeIfExists(st, dest); err != nil {
 		return err
 	}
 
@@ -78,7 +80,7 @@ func (s *Service) CreateSymlink(source, dest string) error {
 		return fmt.Errorf("%w: failed to resolve source path: %w", ErrSymlinkFailed, err)
 	}
 
-	if err := os.Symlink(absSource, dest); err != nil {
+	if err := st.Symlink(absSource, dest); err != nil {
 		return fmt.Errorf("%w: %w", ErrSymlinkFailed, err)
 	}
 
@@ -89,46 +91,114 @@ func (s *Service) CreateSymlink(source, dest string) error {
 	return nil
 }
 
+// ImportOutcome reports how a file was placed in the library.
+type ImportOutcome struct {
+	Mode LinkMode
+	// FallbackReason explains why the file was not hardlinked. Empty when
+	// Mode is LinkModeHardlink.
+	FallbackReason string
+}
+
 // LinkOrCopy attempts to create a hardlink, falls back to symlink, then copy.
+// Link modes the destination's storage backend does not support are skipped.
+// onProgress, if non-nil, receives updates when the copy fallback is used.
 // Returns the mode that was used and any error.
-func (s *Service) LinkOrCopy(source, dest string) (LinkMode, error) {
-	// Try hardlink first
-	err := s.CreateHardlink(source, dest)
-	if err == nil {
-		return LinkModeHardlink, nil
-	}
+func (s *Service) LinkOrCopy(source, dest string, onProgress CopyProgressFunc) (LinkMode, error) {
+	outcome, err := s.PlaceFile(source, dest, true, onProgress)
+	return outcome.Mode, err
+}
 
-	// If cross-device, try symlink
-	if errors.Is(err, ErrCrossDevice) {
-		s.logger.Debug().Msg("Hardlink failed (cross-device), trying symlink")
-		if err := s.CreateSymlink(source, dest); err == nil {
-			return LinkModeSymlink, nil
+// HardlinkOrCopy creates a hardlink, falling back to a copy. Unlike LinkOrCopy
+// it never symlinks, so the library file survives the source being removed,
+// as happens when a torrent that was imported while seeding is cleaned up.
+func (s *Service) HardlinkOrCopy(source, dest string, onProgress CopyProgressFunc) (LinkMode, error) {
+	outcome, err := s.PlaceFile(source, dest, false, onProgress)
+	return outcome.Mode, err
+}
+
+// PlaceFile hardlinks source to dest, falling back to a symlink when
+// allowSymlink is set and the paths are on different filesystems, and to a
+// copy otherwise. The outcome records why a hardlink was not used.
+func (s *Service) PlaceFile(source, dest string, allowSymlink bool, onProgress CopyProgressFunc) (ImportOutcome, error) {
+	st := s.StorageFor(dest)
+	caps := st.Capabilities()
+
+	var reason string
+	if caps.Hardlinks {
+		err := s.CreateHardlink(source, dest)
+		if err == nil {
+			return ImportOutcome{Mode: LinkModeHardlink}, nil
+		}
+		reason = LinkFallbackReason(err)
+
+		// If cross-device, try symlink
+		if errors.Is(err, ErrCrossDevice) && allowSymlink && caps.Symlinks {
+			s.logger.Debug().Msg("Hardlink failed (cross-device), trying symlink")
+			if err := s.CreateSymlink(source, dest); err == nil {
+				return ImportOutcome{Mode: LinkModeSymlink, FallbackReason: reason}, nil
+			}
+			s.logger.Debug().Err(err).Msg("Symlink failed, falling back to copy")
+		} else {
+			s.logger.Debug().Err(err).Msg("Hardlink failed, falling back to copy")
 		}
-		s.logger.Debug().Err(err).Msg("Symlink failed, falling back to copy")
 	} else {
-		s.logger.Debug().Err(err).Msg("Hardlink failed, falling back to copy")
+		reason = fmt.Sprintf("%s storage does not support hardlinks", st.Name())
+		s.logger.Debug().Str("storage", st.Name()).Str("dest", dest).Msg("Storage does not support links, copying")
 	}
 
 	// Fall back to copy
-	if err := s.CopyFile(source, dest); err != nil {
-		return "", err
+	if err := s.copyFile(source, dest, onProgress); err != nil {
+		return ImportOutcome{}, err
 	}
-	return LinkModeCopy, nil
+	return ImportOutcome{Mode: LinkModeCopy, FallbackReason: reason}, nil
+}
+
+// LinkFallbackReason describes a failed hardlink in terms a user can act on.
+func LinkFallbackReason(err error) string {
+	switch {
+	case errors.Is(err, ErrCrossDevice):
+		return "download and library folders are on different filesystems or volumes"
+	case errors.Is(err, os.ErrPermission):
+		return "permission denied creating a hardlink"
+	default:
+		return err.Error()
+	}
+}
+
+// ProbeHardlink checks whether a file in srcDir can be hardlinked into
+// destDir by linking a temporary file and removing both afterwards.
+func ProbeHardlink(srcDir, destDir string) error {
+	src, err := os.CreateTemp(srcDir, ".slipstream_hardlink_check_*")
+	if err != nil {
+		return err
+	}
+	srcPath := src.Name()
+	src.Close()
+	defer os.Remove(srcPath)
+
+	destPath := filepath.Join(destDir, filepath.Base(srcPath))
+	if err := os.Link(srcPath, destPath); err != nil {
+		if isCrossDeviceError(err) {
+			return fmt.Errorf("%w: %w", ErrCrossDevice, err)
+		}
+		return fmt.Errorf("%w: %w", ErrHardlinkFailed, err)
+	}
+	return os.Remove(destPath)
 }
 
 // ImportFile imports a file to the library using hardlinks when possible.
 // This is the primary method for importing downloaded files.
 // - Source file is preserved (for torrent seeding)
 // - Destination gets the renamed file via hardlink (same file, different name)
-func (s *Service) ImportFile(source, dest string) (LinkMode, error) {
-	return s.LinkOrCopy(source, dest)
+func (s *Service) ImportFile(source, dest string, onProgress CopyProgressFunc) (LinkMode, error) {
+	return s.LinkOrCopy(source, dest, onProgress)
 }
 
 // DeleteFile removes a file if it exists.
 func (s *Service) DeleteFile(path string) error {
 	s.logger.Debug().Str("path", path).Msg("Deleting file")
 
-	if err := os.Remove(path); err != nil {
+	if err := s.StorageFor(path).Remove(path); err != nil {
 		if os.IsNotExist(err) {
 			return nil // Already gone
 		}
@@ -147,7 +217,7 @@ func (s *Service) DeleteUpgradedFile(oldPath, newPath string) error {
 	}
 
 	// Check if old file still exists
-	if _, err := os.Stat(oldPath); os.IsNotExist(err) {
+	if _, err := s.StorageFor(oldPath).Stat(oldPath); os.IsNotExist(err) {
 		return nil
 	}
 
@@ -159,12 +229,46 @@ func (s *Service) DeleteUpgradedFile(oldPath, newPath string) error {
 	return s.DeleteFile(oldPath)
 }
 
+// MoveFile moves a file, renaming it in place when source and destination are
+// on the same local filesystem and copying then removing the source otherwise.
+func (s *Service) MoveFile(source, dest string) error {
+	return s.MoveFileWithProgress(source, dest, nil)
+}
+
+// MoveFileWithProgress is MoveFile reporting copy progress when the move has
+// to fall back to copying.
+func (s *Service) MoveFileWithProgress(source, dest string, onProgress CopyProgressFunc) error {
+	st := s.StorageFor(dest)
+	if err := s.ensureDestDir(st, dest); err != nil {
+		return err
+	}
+
+	_, srcLocal := s.StorageFor(source).(LocalStorage)
+	if _, destLocal := st.(LocalStorage); srcLocal && destLocal {
+		if err := os.Rename(source, dest); err == nil {
+			s.logger.Info().Str("source", source).Str("dest", dest).Msg("Moved file")
+			return nil
+		} else if !isCrossDeviceError(err) {
+			return fmt.Errorf("failed to move file: %w", err)
+		}
+	}
+
+	if err := s.copyFile(source, dest, onProgress); err != nil {
+		return err
+	}
+	if err := s.StorageFor(source).Remove(source); err != nil {
+		return fmt.Errorf("failed to remove moved file: %w", err)
+	}
+	s.logger.Info().Str("source", source).Str("dest", dest).Msg("Moved file")
+	return nil
+}
+
 // ensureDestDir creates the destination directory if needed, inheriting permissions.
-func (s *Service) ensureDestDir(destPath string) error {
+func (s *Service) ensureDestDir(st Storage, destPath string) error {
 	destDir := filepath.Dir(destPath)
 
 	// Check if directory exists
-	info, err := os.Stat(destDir)
+	info, err := st.Stat(destDir)
 	if err == nil && info.IsDir() {
 		return nil
 	}
@@ -173,11 +277,11 @@ func (s *Service) ensureDestDir(destPath string) error {
 	parentDir := filepath.Dir(destDir)
 	perm := os.FileMode(0o755) // Default
 
-	if parentInfo, err := os.Stat(parentDir); err == nil {
+	if parentInfo, err := st.Stat(parentDir); err == nil {
 		perm = parentInfo.Mode().Perm()
 	}
 
-	if err := os.MkdirAll(destDir, perm); err != nil {
+	if err := st.MkdirAll(destDir, perm); err != nil {
 		return fmt.Errorf("failed to create destination directory: %w", err)
 	}
 
@@ -185,10 +289,10 @@ func (s *Service) ensureDestDir(destPath string) error {
 }
 
 // removeIfExists removes a file if it exists (for overwrite behavior).
-func (s *Service) removeIfExists(path string) error {
-	if _, err := os.Stat(path); err == nil {
+func (s *Service) removeIfExists(st Storage, path string) error {
+	if _, err := st.Stat(path); err == nil {
 		s.logger.Debug().Str("path", path).Msg("Removing existing file for overwrite")
-		if err := os.Remove(path); err != nil {
+		if err := st.Remove(path); err != nil {
 			return fmt.Errorf("failed to remove existing file: %w", err)
 		}
 	}
@@ -0,0 +1,38 @@
+//go:build linux
+
+package organizer
+
+import (
+	"bufio"
+	"os"
+	"strings"
+)
+
+// detectRcloneMounts reads the mount table and returns rclone FUSE mounts.
+func detectRcloneMounts() []storageMount {
+	f, err := os.Open("/proc/self/mounts")
+	if err != nil {
+		return nil
+	}
+	defer f.Close()
+	return parseRcloneMounts(bufio.NewScanner(f))
+}
+
+// parseRcloneMounts extracts rclone mounts from fstab-formatted lines.
+func parseRcloneMounts(sc *bufio.Scanner) []storageMount {
+	var mounts []storageMount
+	for sc.Scan() {
+		fields := strings.Fields(sc.Text())
+		if len(fields) < 3 || fields[2] != "fuse.rclone" {
+			continue
+		}
+		mounts = append(mounts, storageMount{root: unescapeMountPath(fields[1]), storage: RcloneMountStorage{}})
+	}
+	return mounts
+}
+
+// unescapeMountPath decodes the octal escapes the kernel uses for whitespace
+// and backslashes in mount points.
+func unescapeMountPath(s string) string {
+	return strings.NewReplacer(`\040`, " ", `\011`, "\t", `\012`, "\n", `\134`, `\`).Replace(s)
+}
@@ -0,0 +1,27 @@
+//go:build linux
+
+package organizer
+
+import (
+	"bufio"
+	"strings"
+	"testing"
+)
+
+func TestParseRcloneMounts(t *testing.T) {
+	table := `sysfs /sys sysfs rw,nosuid 0 0
+/dev/sda1 /data ext4 rw,relatime 0 0
+gdrive: /mnt/g\040drive fuse.rclone rw,nosuid,nodev 0 0
+sshfs#host: /mnt/ssh fuse.sshfs rw 0 0
+`
+	mounts := parseRcloneMounts(bufio.NewScanner(strings.NewReader(table)))
+	if len(mounts) != 1 {
+		t.Fatalf("parseRcloneMounts() returned %d mounts, want 1", len(mounts))
+	}
+	if mounts[0].root != "/mnt/g drive" {
+		t.Errorf("root = %q, want %q", mounts[0].root, "/mnt/g drive")
+	}
+	if mounts[0].storage.Capabilities().Hardlinks {
+		t.Error("rclone mount reports hardlink support")
+	}
+}
@@ -0,0 +1,8 @@
+//go:build !linux
+
+package organizer
+
+// detectRcloneMounts returns nil; mount detection needs the Linux mount table.
+func detectRcloneMounts() []storageMount {
+	return nil
+}
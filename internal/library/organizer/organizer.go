@@ -11,27 +11,48 @@ import (
 
 // Service provides file organization operations.
 type Service struct {
-	logger *zerolog.Logger
+	logger  *zerolog.Logger
+	storage *storageRegistry
 }
 
 // NewService creates a new organizer service.
 func NewService(logger *zerolog.Logger) *Service {
 	subLogger := logger.With().Str("component", "organizer").Logger()
 	return &Service{
-		logger: &subLogger,
+		logger:  &subLogger,
+		storage: newStorageRegistry(),
 	}
 }
 
+// RegisterStorage routes file operations for paths under root to st.
+// The most specific registered root wins.
+func (s *Service) RegisterStorage(root string, st Storage) {
+	s.storage.register(root, st)
+}
+
+// StorageFor returns the storage backend that handles path.
+func (s *Service) StorageFor(path string) Storage {
+	return s.storage.resolve(path)
+}
+
 // CopyFile copies a file from source to destination.
 func (s *Service) CopyFile(sourcePath, destPath string) error {
-	return s.copyFile(sourcePath, destPath)
+	return s.copyFile(sourcePath, destPath, nil)
+}
+
+// CopyFileWithProgress is CopyFile reporting progress as bytes are written.
+func (s *Service) CopyFileWithProgress(sourcePath, destPath string, onProgress CopyProgressFunc) error {
+	return s.copyFile(sourcePath, destPath, onProgress)
 }
 
-// copyFile performs the actual file copy.
-func (s *Service) copyFile(sourcePath, destPath string) error {
+// copyFile performs the actual file copy and verifies the destination size.
+// onProgress may be nil.
+func (s *Service) copyFile(sourcePath, destPath string, onProgress CopyProgressFunc) error {
+	st := s.StorageFor(destPath)
+
 	// Create destination directory if needed
 	destDir := filepath.Dir(destPath)
-	if err := os.MkdirAll(destDir, 0o750); err != nil {
+	if err := st.MkdirAll(destDir, 0o750); err != nil {
 		return fmt.Errorf("failed to create destination directory: %w", err)
 	}
 
@@ -41,25 +62,53 @@ func (s *Service) copyFile(sourcePath, destPath string) error {
 	}
 	defer source.Close()
 
-	dest, err := os.Create(destPath)
+	dest, err := st.Create(destPath)
 	if err != nil {
 		return fmt.Errorf("failed to create destination file: %w", err)
 	}
 	defer dest.Close()
 
-	_, err = io.Copy(dest, source)
+	sourceInfo, err := source.Stat()
 	if err != nil {
-		os.Remove(destPath) // Clean up on failure
+		return fmt.Errorf("failed to stat source file: %w", err)
+	}
+
+	var w io.Writer = dest
+	if onProgress != nil {
+		w = &progressWriter{w: dest, total: sourceInfo.Size(), onProgress: onProgress}
+	}
+	if _, err := io.Copy(w, source); err != nil {
+		st.Remove(destPath) // Clean up on failure
 		return fmt.Errorf("failed to copy file: %w", err)
 	}
 
+	if onProgress != nil {
+		onProgress(CopyProgress{Stage: CopyStageVerifying, BytesCopied: sourceInfo.Size(), TotalBytes: sourceInfo.Size()})
+	}
+	if err := verifyCopy(dest, sourceInfo.Size()); err != nil {
+		st.Remove(destPath)
+		return err
+	}
+
 	// Copy file permissions
-	sourceInfo, err := os.Stat(sourcePath)
-	if err == nil {
-		if err := os.Chmod(destPath, sourceInfo.Mode()); err != nil {
-			s.logger.Warn().Err(err).Str("path", destPath).Msg("Failed to set file permissions")
-		}
+	if err := st.Chmod(destPath, sourceInfo.Mode()); err != nil {
+		s.logger.Warn().Err(err).Str("path", destPath).Msg("Failed to set file permissions")
 	}
 
 	return nil
 }
+
+// verifyCopy flushes the destination and checks its size against the source.
+func verifyCopy(dest WritableFile, wantSize int64) error {
+	if err := dest.Sync(); err != nil {
+		return fmt.Errorf("failed to flush copied file: %w", err)
+	}
+	info, err := dest.Stat()
+	if err != nil {
+		return fmt.Errorf("failed to stat copied file: %w", err)
+	}
+	if info.Size() != wantSize {
+		return fmt.Errorf("%w: wrote %d of %d bytes", ErrCopyVerificationFailed, info.Size(), wantSize)
+	}
+	return nil
+}
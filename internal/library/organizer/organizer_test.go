@@ -76,3 +76,32 @@ func TestService_CopyFile_NonExistentSource(t *testing.T) {
 		t.Error("CopyFile() with non-existent source should return error")
 	}
 }
+
+func TestService_CopyFile_ReportsProgress(t *testing.T) {
+	service := newTestService()
+	tmpDir := t.TempDir()
+
+	content := make([]byte, 256*1024)
+	srcPath := filepath.Join(tmpDir, "source.mkv")
+	if err := os.WriteFile(srcPath, content, 0o644); err != nil {
+		t.Fatalf("Failed to create source file: %v", err)
+	}
+
+	var updates []CopyProgress
+	destPath := filepath.Join(tmpDir, "dest.mkv")
+	if err := service.copyFile(srcPath, destPath, func(p CopyProgress) { updates = append(updates, p) }); err != nil {
+		t.Fatalf("copyFile() error = %v", err)
+	}
+
+	if len(updates) < 2 {
+		t.Fatalf("copyFile() reported %d updates, want at least 2", len(updates))
+	}
+	last := updates[len(updates)-1]
+	if last.Stage != CopyStageVerifying || last.BytesCopied != int64(len(content)) {
+		t.Errorf("last update = %+v, want verifying with all bytes copied", last)
+	}
+	copying := updates[len(updates)-2]
+	if copying.Stage != CopyStageCopying || copying.BytesCopied != copying.TotalBytes {
+		t.Errorf("final copy update = %+v, want all bytes copied", copying)
+	}
+}
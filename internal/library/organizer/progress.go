@@ -0,0 +1,43 @@
+package organizer
+
+import (
+	"errors"
+	"io"
+)
+
+// ErrCopyVerificationFailed is returned when a copied file does not match its source.
+var ErrCopyVerificationFailed = errors.New("copied file does not match source")
+
+// CopyStage identifies the phase of a copy reported through a CopyProgressFunc.
+type CopyStage string
+
+const (
+	CopyStageCopying   CopyStage = "copying"
+	CopyStageVerifying CopyStage = "verifying"
+)
+
+// CopyProgress describes how far a copy has progressed.
+type CopyProgress struct {
+	Stage       CopyStage
+	BytesCopied int64
+	TotalBytes  int64
+}
+
+// CopyProgressFunc receives progress updates during a copy. It is called on every
+// write, so implementations should throttle anything expensive.
+type CopyProgressFunc func(CopyProgress)
+
+// progressWriter reports bytes written to the wrapped writer.
+type progressWriter struct {
+	w          io.Writer
+	copied     int64
+	total      int64
+	onProgress CopyProgressFunc
+}
+
+func (pw *progressWriter) Write(p []byte) (int, error) {
+	n, err := pw.w.Write(p)
+	pw.copied += int64(n)
+	pw.onProgress(CopyProgress{Stage: CopyStageCopying, BytesCopied: pw.copied, TotalBytes: pw.total})
+	return n, err
+}
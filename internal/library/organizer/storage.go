@@ -0,0 +1,138 @@
+package organizer
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/slipstream/slipstream/internal/pathutil"
+)
+
+// Capabilities describes which link operations a storage backend supports.
+// Link-mode selection skips operations a backend cannot perform.
+type Capabilities struct {
+	Hardlinks bool
+	Symlinks  bool
+}
+
+// WritableFile is a file opened for writing on a storage backend.
+type WritableFile interface {
+	io.WriteCloser
+	Sync() error
+	Stat() (os.FileInfo, error)
+}
+
+// Storage abstracts the file operations the organizer performs on a library
+// destination so remote or mount-backed targets can be supported.
+type Storage interface {
+	Name() string
+	Capabilities() Capabilities
+	Stat(path string) (os.FileInfo, error)
+	MkdirAll(path string, perm os.FileMode) error
+	Create(path string) (WritableFile, error)
+	Chmod(path string, mode os.FileMode) error
+	Remove(path string) error
+	Link(source, dest string) error
+	Symlink(source, dest string) error
+}
+
+// LocalStorage operates on the local filesystem.
+type LocalStorage struct{}
+
+func (LocalStorage) Name() string { return "local" }
+
+func (LocalStorage) Capabilities() Capabilities {
+	return Capabilities{Hardlinks: true, Symlinks: true}
+}
+
+func (LocalStorage) Stat(path string) (os.FileInfo, error) { return os.Stat(path) }
+
+func (LocalStorage) MkdirAll(path string, perm os.FileMode) error { return os.MkdirAll(path, perm) }
+
+func (LocalStorage) Create(path string) (WritableFile, error) { return os.Create(path) }
+
+func (LocalStorage) Chmod(path string, mode os.FileMode) error { return os.Chmod(path, mode) }
+
+func (LocalStorage) Remove(path string) error { return os.Remove(path) }
+
+func (LocalStorage) Link(source, dest string) error { return os.Link(source, dest) }
+
+func (LocalStorage) Symlink(source, dest string) error { return os.Symlink(source, dest) }
+
+// RcloneMountStorage operates on an rclone FUSE mount. File operations go
+// through the mount, but rclone cannot create hardlinks or symlinks, so
+// imports onto the mount always copy.
+type RcloneMountStorage struct {
+	LocalStorage
+}
+
+func (RcloneMountStorage) Name() string { return "rclone" }
+
+func (RcloneMountStorage) Capabilities() Capabilities { return Capabilities{} }
+
+// storageMount binds a storage backend to every path under root.
+type storageMount struct {
+	root    string
+	storage Storage
+}
+
+// storageRegistry resolves the storage backend for a destination path.
+// Explicitly registered backends win; otherwise rclone FUSE mounts found in
+// the system mount table use RcloneMountStorage and everything else is local.
+type storageRegistry struct {
+	mu       sync.RWMutex
+	mounts   []storageMount
+	detected func() []storageMount
+}
+
+func newStorageRegistry() *storageRegistry {
+	return &storageRegistry{detected: sync.OnceValue(detectRcloneMounts)}
+}
+
+func (r *storageRegistry) register(root string, st Storage) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.mounts = append(r.mounts, storageMount{root: filepath.Clean(root), storage: st})
+}
+
+func (r *storageRegistry) resolve(path string) Storage {
+	r.mu.RLock()
+	registered := r.mounts
+	r.mu.RUnlock()
+
+	if st := longestMount(registered, path); st != nil {
+		return st
+	}
+	if st := longestMount(r.detected(), path); st != nil {
+		return st
+	}
+	return LocalStorage{}
+}
+
+// longestMount returns the storage of the most specific mount containing path.
+func longestMount(mounts []storageMount, path string) Storage {
+	var best *storageMount
+	for i := range mounts {
+		m := &mounts[i]
+		if !pathUnder(path, m.root) {
+			continue
+		}
+		if best == nil || len(m.root) > len(best.root) {
+			best = m
+		}
+	}
+	if best == nil {
+		return nil
+	}
+	return best.storage
+}
+
+func pathUnder(path, root string) bool {
+	if pathutil.PathsEqual(path, root) {
+		return true
+	}
+	rel, err := filepath.Rel(root, path)
+	return err == nil && rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator))
+}
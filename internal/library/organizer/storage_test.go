@@ -0,0 +1,155 @@
+package organizer
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestService_LinkOrCopy_CopiesWhenStorageLacksLinks(t *testing.T) {
+	service := newTestService()
+	tmpDir := t.TempDir()
+
+	srcPath := filepath.Join(tmpDir, "downloads", "movie.mkv")
+	if err := os.MkdirAll(filepath.Dir(srcPath), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(srcPath, []byte("content"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	libraryRoot := filepath.Join(tmpDir, "library")
+	service.RegisterStorage(libraryRoot, RcloneMountStorage{})
+
+	destPath := filepath.Join(libraryRoot, "Movie (2020)", "Movie (2020).mkv")
+	mode, err := service.LinkOrCopy(srcPath, destPath, nil)
+	if err != nil {
+		t.Fatalf("LinkOrCopy() error = %v", err)
+	}
+	if mode != LinkModeCopy {
+		t.Errorf("LinkOrCopy() mode = %q, want %q", mode, LinkModeCopy)
+	}
+
+	srcInfo, _ := os.Stat(srcPath)
+	destInfo, err := os.Stat(destPath)
+	if err != nil {
+		t.Fatalf("dest file not found: %v", err)
+	}
+	if os.SameFile(srcInfo, destInfo) {
+		t.Error("LinkOrCopy() hardlinked onto storage without link support")
+	}
+}
+
+func TestService_PlaceFile_ReportsFallbackReason(t *testing.T) {
+	service := newTestService()
+	tmpDir := t.TempDir()
+
+	srcPath := filepath.Join(tmpDir, "downloads", "movie.mkv")
+	if err := os.MkdirAll(filepath.Dir(srcPath), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(srcPath, []byte("content"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	linked, err := service.PlaceFile(srcPath, filepath.Join(tmpDir, "library", "movie.mkv"), false, nil)
+	if err != nil {
+		t.Fatalf("PlaceFile() error = %v", err)
+	}
+	if linked.Mode != LinkModeHardlink || linked.FallbackReason != "" {
+		t.Errorf("PlaceFile() = %+v, want hardlink without reason", linked)
+	}
+
+	cloudRoot := filepath.Join(tmpDir, "cloud")
+	service.RegisterStorage(cloudRoot, RcloneMountStorage{})
+	copied, err := service.PlaceFile(srcPath, filepath.Join(cloudRoot, "movie.mkv"), false, nil)
+	if err != nil {
+		t.Fatalf("PlaceFile() error = %v", err)
+	}
+	if copied.Mode != LinkModeCopy || copied.FallbackReason != "rclone storage does not support hardlinks" {
+		t.Errorf("PlaceFile() = %+v, want copy explaining the storage limit", copied)
+	}
+}
+
+func TestService_HardlinkOrCopy(t *testing.T) {
+	service := newTestService()
+	tmpDir := t.TempDir()
+
+	srcPath := filepath.Join(tmpDir, "downloads", "movie.mkv")
+	if err := os.MkdirAll(filepath.Dir(srcPath), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(srcPath, []byte("content"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	destPath := filepath.Join(tmpDir, "library", "Movie (2020)", "Movie (2020).mkv")
+	mode, err := service.HardlinkOrCopy(srcPath, destPath, nil)
+	if err != nil {
+		t.Fatalf("HardlinkOrCopy() error = %v", err)
+	}
+	if mode != LinkModeHardlink {
+		t.Errorf("HardlinkOrCopy() mode = %q, want %q", mode, LinkModeHardlink)
+	}
+
+	// The library file must outlive the seeding source.
+	if err := os.Remove(srcPath); err != nil {
+		t.Fatal(err)
+	}
+	if content, err := os.ReadFile(destPath); err != nil || string(content) != "content" {
+		t.Errorf("dest after source removal = %q, %v; want %q", content, err, "content")
+	}
+}
+
+func TestService_StorageFor(t *testing.T) {
+	service := newTestService()
+	service.RegisterStorage("/mnt/cloud", RcloneMountStorage{})
+	service.RegisterStorage("/mnt/cloud/local-cache", LocalStorage{})
+
+	tests := []struct {
+		path string
+		want string
+	}{
+		{"/mnt/cloud/Movies/Dune (2021)/Dune.mkv", "rclone"},
+		{"/mnt/cloud", "rclone"},
+		{"/mnt/cloud/local-cache/file.mkv", "local"},
+		{"/mnt/cloudy/file.mkv", "local"},
+		{"/data/file.mkv", "local"},
+	}
+	for _, tt := range tests {
+		if got := service.StorageFor(tt.path).Name(); got != tt.want {
+			t.Errorf("StorageFor(%q) = %q, want %q", tt.path, got, tt.want)
+		}
+	}
+}
+
+func TestService_MoveFile(t *testing.T) {
+	service := newTestService()
+	tmpDir := t.TempDir()
+
+	srcPath := filepath.Join(tmpDir, "library", "Movie (2020).mkv")
+	if err := os.MkdirAll(filepath.Dir(srcPath), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(srcPath, []byte("content"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, storage := range []Storage{LocalStorage{}, RcloneMountStorage{}} {
+		destRoot := filepath.Join(tmpDir, storage.Name())
+		service.RegisterStorage(destRoot, storage)
+		destPath := filepath.Join(destRoot, "recycle", "Movie (2020).mkv")
+
+		if err := service.MoveFile(srcPath, destPath); err != nil {
+			t.Fatalf("MoveFile() onto %s error = %v", storage.Name(), err)
+		}
+		if _, err := os.Stat(srcPath); !os.IsNotExist(err) {
+			t.Errorf("MoveFile() onto %s left the source behind", storage.Name())
+		}
+		data, err := os.ReadFile(destPath)
+		if err != nil || string(data) != "content" {
+			t.Fatalf("MoveFile() onto %s dest = %q, %v", storage.Name(), data, err)
+		}
+		srcPath = destPath
+	}
+}
@@ -0,0 +1,133 @@
+package people
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/slipstream/slipstream/internal/metadata"
+)
+
+// Handlers provides HTTP handlers for people.
+type Handlers struct {
+	service *Service
+}
+
+// NewHandlers creates new people handlers.
+func NewHandlers(service *Service) *Handlers {
+	return &Handlers{service: service}
+}
+
+// RegisterRoutes registers the people routes. People are addressed by their
+// TMDB person ID so credits from metadata responses link straight to them.
+func (h *Handlers) RegisterRoutes(g *echo.Group) {
+	g.GET("", h.Search)
+	g.GET("/:id", h.Get)
+	g.GET("/:id/works", h.Works)
+	g.GET("/:id/movies", h.Filmography)
+	g.POST("/:id/movies", h.AddMovies)
+}
+
+// Search lists people credited in the library.
+// GET /api/v1/people?search=&department=&limit=
+func (h *Handlers) Search(c echo.Context) error {
+	limit := 0
+	if v := c.QueryParam("limit"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, "invalid limit")
+		}
+		limit = n
+	}
+
+	results, err := h.service.Search(c.Request().Context(), c.QueryParam("search"), c.QueryParam("department"), limit)
+	if err != nil {
+		return mapError(err)
+	}
+	return c.JSON(http.StatusOK, results)
+}
+
+// Get returns a person with their biography and library works.
+// GET /api/v1/people/:id
+func (h *Handlers) Get(c echo.Context) error {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid id")
+	}
+
+	person, err := h.service.Get(c.Request().Context(), id)
+	if err != nil {
+		return mapError(err)
+	}
+	return c.JSON(http.StatusOK, person)
+}
+
+// Works returns the library movies and series a person is credited on.
+// GET /api/v1/people/:id/works
+func (h *Handlers) Works(c echo.Context) error {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid id")
+	}
+
+	works, err := h.service.Works(c.Request().Context(), id)
+	if err != nil {
+		return mapError(err)
+	}
+	return c.JSON(http.StatusOK, works)
+}
+
+// Filmography returns a person's movies flagged with their library state.
+// GET /api/v1/people/:id/movies?department=
+func (h *Handlers) Filmography(c echo.Context) error {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid id")
+	}
+
+	entries, err := h.service.Filmography(c.Request().Context(), id, c.QueryParam("department"))
+	if err != nil {
+		return mapError(err)
+	}
+	return c.JSON(http.StatusOK, entries)
+}
+
+// AddMovies adds every movie of a person's department missing from the library.
+// POST /api/v1/people/:id/movies
+func (h *Handlers) AddMovies(c echo.Context) error {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid id")
+	}
+
+	var input AddMoviesInput
+	if err := c.Bind(&input); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+	if input.RootFolderID == 0 || input.QualityProfileID == 0 {
+		return echo.NewHTTPError(http.StatusBadRequest, "rootFolderId and qualityProfileId are required")
+	}
+
+	result, err := h.service.AddMovies(c.Request().Context(), id, &input)
+	if err != nil {
+		return mapError(err)
+	}
+	return c.JSON(http.StatusOK, result)
+}
+
+func mapError(err error) error {
+	switch {
+	case errors.Is(err, ErrPersonNotFound):
+		return echo.NewHTTPError(http.StatusNotFound, err.Error())
+	case errors.Is(err, ErrInvalidDepartment):
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	case errors.Is(err, ErrNoMovieAdder):
+		return echo.NewHTTPError(http.StatusServiceUnavailable, err.Error())
+	case errors.Is(err, metadata.ErrNoProvidersConfigured):
+		return echo.NewHTTPError(http.StatusServiceUnavailable, "no metadata providers configured")
+	default:
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+}
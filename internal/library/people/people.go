@@ -0,0 +1,152 @@
+// Package people stores the cast and crew metadata providers report for
+// library movies and series as person entities, so a person's works in the
+// library can be listed and their missing movies added in one action.
+package people
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/slipstream/slipstream/internal/database/sqlc"
+	"github.com/slipstream/slipstream/internal/metadata"
+)
+
+// Media types credits are stored for.
+const (
+	MediaMovie  = "movie"
+	MediaSeries = "series"
+)
+
+// Credit departments.
+const (
+	DepartmentCast     = "cast"
+	DepartmentDirector = "director"
+	DepartmentWriter   = "writer"
+	DepartmentCreator  = "creator"
+)
+
+// maxCast caps the billed cast stored per item; TMDB lists every extra.
+const maxCast = 20
+
+// Person is a cast or crew member credited on at least one library item.
+type Person struct {
+	ID        int64  `json:"id"`
+	TmdbID    int    `json:"tmdbId"`
+	Name      string `json:"name"`
+	PhotoURL  string `json:"photoUrl,omitempty"`
+	WorkCount int    `json:"workCount,omitempty"`
+}
+
+// Work is one library movie or series a person is credited on.
+type Work struct {
+	MediaType  string `json:"mediaType"`
+	MediaID    int64  `json:"mediaId"`
+	Title      string `json:"title"`
+	Year       int    `json:"year,omitempty"`
+	TmdbID     int    `json:"tmdbId,omitempty"`
+	TvdbID     int    `json:"tvdbId,omitempty"`
+	Department string `json:"department"`
+	Role       string `json:"role,omitempty"`
+}
+
+// PersonDetail is a person with their TMDB biography and library works.
+// Details is nil when no movie provider is configured.
+type PersonDetail struct {
+	Person
+	Details *metadata.PersonDetails `json:"details,omitempty"`
+	Works   []Work                  `json:"works"`
+}
+
+// FilmographyEntry is a movie from a person's TMDB filmography annotated with
+// its library state.
+type FilmographyEntry struct {
+	metadata.PersonCredit
+	InLibrary bool  `json:"inLibrary"`
+	MovieID   int64 `json:"movieId,omitempty"`
+}
+
+// AddMoviesInput selects which of a person's movies to add and how.
+type AddMoviesInput struct {
+	Department       string `json:"department"`
+	RootFolderID     int64  `json:"rootFolderId"`
+	QualityProfileID int64  `json:"qualityProfileId"`
+	Monitored        bool   `json:"monitored"`
+	SearchOnAdd      *bool  `json:"searchOnAdd,omitempty"`
+}
+
+// AddMoviesResult reports the outcome of adding a person's movies.
+type AddMoviesResult struct {
+	Added   []FilmographyEntry `json:"added"`
+	Skipped []FilmographyEntry `json:"skipped"`
+	Failed  []AddFailure       `json:"failed"`
+}
+
+// AddFailure is a movie that could not be added.
+type AddFailure struct {
+	FilmographyEntry
+	Error string `json:"error"`
+}
+
+// ReplaceCredits swaps the stored credits of one movie or series for credits,
+// creating or refreshing the credited people. People left without any credit
+// are removed.
+func ReplaceCredits(ctx context.Context, db *sql.DB, mediaType string, mediaID int64, credits *metadata.Credits) error {
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	q := sqlc.New(tx)
+	if err := q.DeleteMediaCredits(ctx, sqlc.DeleteMediaCreditsParams{MediaType: mediaType, MediaID: mediaID}); err != nil {
+		return fmt.Errorf("failed to clear credits: %w", err)
+	}
+
+	if credits != nil {
+		groups := []struct {
+			department string
+			people     []metadata.Person
+		}{
+			{DepartmentDirector, credits.Directors},
+			{DepartmentWriter, credits.Writers},
+			{DepartmentCreator, credits.Creators},
+			{DepartmentCast, credits.Cast[:min(len(credits.Cast), maxCast)]},
+		}
+		for _, g := range groups {
+			for i, p := range g.people {
+				if p.ID == 0 || p.Name == "" {
+					continue
+				}
+				row, err := q.UpsertPerson(ctx, sqlc.UpsertPersonParams{TmdbID: int64(p.ID), Name: p.Name, PhotoUrl: p.PhotoURL})
+				if err != nil {
+					return fmt.Errorf("failed to store person: %w", err)
+				}
+				if err := q.CreateMediaCredit(ctx, sqlc.CreateMediaCreditParams{
+					PersonID:   row.ID,
+					MediaType:  mediaType,
+					MediaID:    mediaID,
+					Department: g.department,
+					Role:       p.Role,
+					SortOrder:  int64(i),
+				}); err != nil {
+					return fmt.Errorf("failed to add credit: %w", err)
+				}
+			}
+		}
+	}
+
+	if _, err := q.DeleteOrphanedPeople(ctx); err != nil {
+		return fmt.Errorf("failed to remove uncredited people: %w", err)
+	}
+	return tx.Commit()
+}
+
+// ValidDepartment reports whether department is a known credit department.
+func ValidDepartment(department string) bool {
+	switch department {
+	case DepartmentCast, DepartmentDirector, DepartmentWriter, DepartmentCreator:
+		return true
+	}
+	return false
+}
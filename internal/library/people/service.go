@@ -0,0 +1,261 @@
+package people
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/rs/zerolog"
+
+	"github.com/slipstream/slipstream/internal/database/sqlc"
+	"github.com/slipstream/slipstream/internal/metadata"
+)
+
+var (
+	ErrPersonNotFound    = errors.New("person not found")
+	ErrInvalidDepartment = errors.New("department must be cast, director, writer or creator")
+	ErrNoMovieAdder      = errors.New("adding movies is not available")
+)
+
+const (
+	defaultSearchLimit = 50
+	maxSearchLimit     = 500
+)
+
+// MetadataSource looks up people on TMDB.
+type MetadataSource interface {
+	GetPerson(ctx context.Context, personID int) (*metadata.PersonDetails, error)
+	GetPersonMovieCredits(ctx context.Context, personID int) ([]metadata.PersonCredit, error)
+}
+
+// MovieAdder adds a movie to the library by TMDB ID and returns its library ID.
+type MovieAdder func(ctx context.Context, tmdbID int, input *AddMoviesInput) (int64, error)
+
+// Service provides person lookups and add-all actions.
+type Service struct {
+	queries  *sqlc.Queries
+	metadata MetadataSource
+	addMovie MovieAdder
+	logger   *zerolog.Logger
+}
+
+// NewService creates a new people service.
+func NewService(db *sql.DB, metadataSource MetadataSource, logger *zerolog.Logger) *Service {
+	subLogger := logger.With().Str("component", "people").Logger()
+	return &Service{
+		queries:  sqlc.New(db),
+		metadata: metadataSource,
+		logger:   &subLogger,
+	}
+}
+
+// SetDB updates the database connection used by this service.
+func (s *Service) SetDB(db *sql.DB) {
+	s.queries = sqlc.New(db)
+}
+
+// SetMovieAdder sets the function used to add a person's movies.
+func (s *Service) SetMovieAdder(adder MovieAdder) {
+	s.addMovie = adder
+}
+
+// Search returns people credited in the library whose name contains query,
+// most prolific first. An empty department matches every department.
+func (s *Service) Search(ctx context.Context, query, department string, limit int) ([]*Person, error) {
+	if department != "" && !ValidDepartment(department) {
+		return nil, ErrInvalidDepartment
+	}
+	if limit <= 0 {
+		limit = defaultSearchLimit
+	}
+	limit = min(limit, maxSearchLimit)
+
+	rows, err := s.queries.SearchPeople(ctx, sqlc.SearchPeopleParams{
+		NamePattern: "%" + strings.TrimSpace(query) + "%",
+		Department:  department,
+		RowLimit:    int64(limit),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to search people: %w", err)
+	}
+	people := make([]*Person, len(rows))
+	for i, row := range rows {
+		people[i] = &Person{
+			ID:        row.ID,
+			TmdbID:    int(row.TmdbID),
+			Name:      row.Name,
+			PhotoURL:  row.PhotoUrl,
+			WorkCount: int(row.WorkCount),
+		}
+	}
+	return people, nil
+}
+
+// Get returns a person by TMDB person ID with their library works and, when
+// available, their TMDB biography. People not credited in the library are
+// still returned when TMDB knows them.
+func (s *Service) Get(ctx context.Context, tmdbID int) (*PersonDetail, error) {
+	detail := &PersonDetail{Person: Person{TmdbID: tmdbID}, Works: []Work{}}
+
+	row, err := s.queries.GetPersonByTmdbID(ctx, int64(tmdbID))
+	stored := err == nil
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+	case err != nil:
+		return nil, fmt.Errorf("failed to get person: %w", err)
+	default:
+		detail.ID = row.ID
+		detail.Name = row.Name
+		detail.PhotoURL = row.PhotoUrl
+		if detail.Works, err = s.works(ctx, row.ID); err != nil {
+			return nil, err
+		}
+		detail.WorkCount = len(detail.Works)
+	}
+
+	details, err := s.metadata.GetPerson(ctx, tmdbID)
+	switch {
+	case err == nil:
+		detail.Details = details
+		if detail.Name == "" {
+			detail.Name = details.Name
+			detail.PhotoURL = details.PhotoURL
+		}
+	case !stored && errors.Is(err, metadata.ErrNotFound):
+		return nil, ErrPersonNotFound
+	case !stored:
+		return nil, err
+	default:
+		s.logger.Warn().Err(err).Int("tmdbId", tmdbID).Msg("Failed to fetch person details")
+	}
+	return detail, nil
+}
+
+// Works returns the library movies and series a person is credited on.
+func (s *Service) Works(ctx context.Context, tmdbID int) ([]Work, error) {
+	row, err := s.queries.GetPersonByTmdbID(ctx, int64(tmdbID))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrPersonNotFound
+		}
+		return nil, fmt.Errorf("failed to get person: %w", err)
+	}
+	return s.works(ctx, row.ID)
+}
+
+func (s *Service) works(ctx context.Context, personID int64) ([]Work, error) {
+	movieRows, err := s.queries.ListPersonMovieCredits(ctx, personID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list movie credits: %w", err)
+	}
+	seriesRows, err := s.queries.ListPersonSeriesCredits(ctx, personID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list series credits: %w", err)
+	}
+
+	works := make([]Work, 0, len(movieRows)+len(seriesRows))
+	for _, r := range movieRows {
+		works = append(works, Work{
+			MediaType:  MediaMovie,
+			MediaID:    r.MovieID,
+			Title:      r.Title,
+			Year:       int(r.Year.Int64),
+			TmdbID:     int(r.TmdbID.Int64),
+			Department: r.Department,
+			Role:       r.Role,
+		})
+	}
+	for _, r := range seriesRows {
+		works = append(works, Work{
+			MediaType:  MediaSeries,
+			MediaID:    r.SeriesID,
+			Title:      r.Title,
+			Year:       int(r.Year.Int64),
+			TmdbID:     int(r.TmdbID.Int64),
+			TvdbID:     int(r.TvdbID.Int64),
+			Department: r.Department,
+			Role:       r.Role,
+		})
+	}
+	return works, nil
+}
+
+// Filmography returns a person's TMDB movie credits, optionally limited to
+// one department, flagging the movies already in the library.
+func (s *Service) Filmography(ctx context.Context, tmdbID int, department string) ([]FilmographyEntry, error) {
+	if department != "" && department != DepartmentCast && department != DepartmentDirector && department != DepartmentWriter {
+		return nil, ErrInvalidDepartment
+	}
+	credits, err := s.metadata.GetPersonMovieCredits(ctx, tmdbID)
+	if err != nil {
+		if errors.Is(err, metadata.ErrNotFound) {
+			return nil, ErrPersonNotFound
+		}
+		return nil, err
+	}
+
+	entries := make([]FilmographyEntry, 0, len(credits))
+	for _, c := range credits {
+		if department != "" && c.Department != department {
+			continue
+		}
+		entry := FilmographyEntry{PersonCredit: c}
+		movie, err := s.queries.GetMovieByTmdbID(ctx, sql.NullInt64{Int64: int64(c.TmdbID), Valid: true})
+		switch {
+		case err == nil:
+			entry.InLibrary = true
+			entry.MovieID = movie.ID
+		case !errors.Is(err, sql.ErrNoRows):
+			return nil, fmt.Errorf("failed to look up movie: %w", err)
+		}
+		entries = append(entries, entry)
+	}
+	return entries, nil
+}
+
+// AddMovies adds every movie in a person's filmography for the department
+// (director when empty) that is not yet in the library. A movie credited
+// several times in the department is added once; failures do not stop the
+// remaining adds.
+func (s *Service) AddMovies(ctx context.Context, tmdbID int, input *AddMoviesInput) (*AddMoviesResult, error) {
+	if s.addMovie == nil {
+		return nil, ErrNoMovieAdder
+	}
+	if input.Department == "" {
+		input.Department = DepartmentDirector
+	}
+	entries, err := s.Filmography(ctx, tmdbID, input.Department)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &AddMoviesResult{Added: []FilmographyEntry{}, Skipped: []FilmographyEntry{}, Failed: []AddFailure{}}
+	seen := make(map[int]bool, len(entries))
+	for _, entry := range entries {
+		if seen[entry.TmdbID] {
+			continue
+		}
+		seen[entry.TmdbID] = true
+
+		if entry.InLibrary {
+			result.Skipped = append(result.Skipped, entry)
+			continue
+		}
+		movieID, err := s.addMovie(ctx, entry.TmdbID, input)
+		if err != nil {
+			s.logger.Warn().Err(err).Int("tmdbId", entry.TmdbID).Str("title", entry.Title).Msg("Failed to add movie")
+			result.Failed = append(result.Failed, AddFailure{FilmographyEntry: entry, Error: err.Error()})
+			continue
+		}
+		entry.InLibrary = true
+		entry.MovieID = movieID
+		result.Added = append(result.Added, entry)
+	}
+
+	s.logger.Info().Int("personTmdbId", tmdbID).Str("department", input.Department).
+		Int("added", len(result.Added)).Int("skipped", len(result.Skipped)).Int("failed", len(result.Failed)).
+		Msg("Added movies by person")
+	return result, nil
+}
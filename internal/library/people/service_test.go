@@ -0,0 +1,165 @@
+package people
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+
+	"github.com/slipstream/slipstream/internal/database/sqlc"
+	"github.com/slipstream/slipstream/internal/metadata"
+	"github.com/slipstream/slipstream/internal/testutil"
+)
+
+type fakeMetadata struct {
+	details *metadata.PersonDetails
+	credits []metadata.PersonCredit
+}
+
+func (f *fakeMetadata) GetPerson(_ context.Context, personID int) (*metadata.PersonDetails, error) {
+	if f.details == nil || f.details.ID != personID {
+		return nil, metadata.ErrNotFound
+	}
+	return f.details, nil
+}
+
+func (f *fakeMetadata) GetPersonMovieCredits(_ context.Context, personID int) ([]metadata.PersonCredit, error) {
+	if f.details == nil || f.details.ID != personID {
+		return nil, metadata.ErrNotFound
+	}
+	return f.credits, nil
+}
+
+func newTestService(t *testing.T, meta *fakeMetadata) (*Service, *sql.DB, *sqlc.Queries) {
+	t.Helper()
+	tdb := testutil.NewTestDB(t)
+	t.Cleanup(tdb.Close)
+	return NewService(tdb.Conn, meta, &tdb.Logger), tdb.Conn, sqlc.New(tdb.Conn)
+}
+
+func createMovie(t *testing.T, q *sqlc.Queries, title string, year, tmdbID int64) int64 {
+	t.Helper()
+	m, err := q.CreateMovie(context.Background(), sqlc.CreateMovieParams{
+		Title:     title,
+		SortTitle: title,
+		Year:      sql.NullInt64{Int64: year, Valid: true},
+		TmdbID:    sql.NullInt64{Int64: tmdbID, Valid: true},
+		Status:    "missing",
+		Monitored: true,
+	})
+	if err != nil {
+		t.Fatalf("CreateMovie() error = %v", err)
+	}
+	return m.ID
+}
+
+func TestReplaceCredits_WorksAndSearch(t *testing.T) {
+	svc, db, q := newTestService(t, &fakeMetadata{})
+	ctx := context.Background()
+
+	alien := createMovie(t, q, "Alien", 1979, 348)
+	bladeRunner := createMovie(t, q, "Blade Runner", 1982, 78)
+
+	scott := metadata.Person{ID: 578, Name: "Ridley Scott"}
+	if err := ReplaceCredits(ctx, db, MediaMovie, alien, &metadata.Credits{
+		Directors: []metadata.Person{scott},
+		Cast:      []metadata.Person{{ID: 10205, Name: "Sigourney Weaver", Role: "Ripley"}},
+	}); err != nil {
+		t.Fatalf("ReplaceCredits() error = %v", err)
+	}
+	if err := ReplaceCredits(ctx, db, MediaMovie, bladeRunner, &metadata.Credits{
+		Directors: []metadata.Person{scott},
+		Cast:      []metadata.Person{{ID: 3, Name: "Harrison Ford", Role: "Deckard"}},
+	}); err != nil {
+		t.Fatalf("ReplaceCredits() error = %v", err)
+	}
+
+	works, err := svc.Works(ctx, 578)
+	if err != nil {
+		t.Fatalf("Works() error = %v", err)
+	}
+	if len(works) != 2 || works[0].Title != "Blade Runner" || works[1].Title != "Alien" {
+		t.Fatalf("Works() = %+v, want Blade Runner then Alien", works)
+	}
+	if works[0].Department != DepartmentDirector || works[0].MediaType != MediaMovie {
+		t.Errorf("Works()[0] = %+v, want a movie directing credit", works[0])
+	}
+
+	found, err := svc.Search(ctx, "", DepartmentDirector, 0)
+	if err != nil {
+		t.Fatalf("Search() error = %v", err)
+	}
+	if len(found) != 1 || found[0].Name != "Ridley Scott" || found[0].WorkCount != 2 {
+		t.Fatalf("Search(director) = %+v, want Ridley Scott with 2 works", found)
+	}
+
+	// Replacing Alien's credits drops Sigourney Weaver entirely
+	if err := ReplaceCredits(ctx, db, MediaMovie, alien, &metadata.Credits{Directors: []metadata.Person{scott}}); err != nil {
+		t.Fatalf("ReplaceCredits() error = %v", err)
+	}
+	if _, err := svc.Works(ctx, 10205); !errors.Is(err, ErrPersonNotFound) {
+		t.Errorf("Works(uncredited) error = %v, want ErrPersonNotFound", err)
+	}
+
+	// Deleting a movie removes its credits
+	if err := q.DeleteMovie(ctx, bladeRunner); err != nil {
+		t.Fatalf("DeleteMovie() error = %v", err)
+	}
+	works, err = svc.Works(ctx, 578)
+	if err != nil {
+		t.Fatalf("Works() error = %v", err)
+	}
+	if len(works) != 1 {
+		t.Errorf("Works() after delete = %+v, want only Alien", works)
+	}
+}
+
+func TestService_AddMovies(t *testing.T) {
+	meta := &fakeMetadata{
+		details: &metadata.PersonDetails{ID: 578, Name: "Ridley Scott"},
+		credits: []metadata.PersonCredit{
+			{TmdbID: 78, Title: "Blade Runner", Year: 1982, Department: DepartmentDirector},
+			{TmdbID: 348, Title: "Alien", Year: 1979, Department: DepartmentDirector},
+			{TmdbID: 348, Title: "Alien", Year: 1979, Department: DepartmentDirector},
+			{TmdbID: 600, Title: "The Duellists", Year: 1977, Department: DepartmentDirector},
+			{TmdbID: 999, Title: "Cameo", Year: 1990, Department: DepartmentCast},
+		},
+	}
+	svc, _, q := newTestService(t, meta)
+	ctx := context.Background()
+
+	if _, err := svc.AddMovies(ctx, 578, &AddMoviesInput{}); !errors.Is(err, ErrNoMovieAdder) {
+		t.Fatalf("AddMovies() without adder error = %v, want ErrNoMovieAdder", err)
+	}
+
+	createMovie(t, q, "Alien", 1979, 348)
+	var added []int
+	svc.SetMovieAdder(func(_ context.Context, tmdbID int, _ *AddMoviesInput) (int64, error) {
+		if tmdbID == 600 {
+			return 0, errors.New("root folder missing")
+		}
+		added = append(added, tmdbID)
+		return int64(100 + len(added)), nil
+	})
+
+	result, err := svc.AddMovies(ctx, 578, &AddMoviesInput{RootFolderID: 1, QualityProfileID: 1})
+	if err != nil {
+		t.Fatalf("AddMovies() error = %v", err)
+	}
+	if len(added) != 1 || added[0] != 78 {
+		t.Errorf("added = %v, want only Blade Runner", added)
+	}
+	if len(result.Added) != 1 || result.Added[0].MovieID != 101 {
+		t.Errorf("Added = %+v, want Blade Runner as movie 101", result.Added)
+	}
+	if len(result.Skipped) != 1 || result.Skipped[0].TmdbID != 348 {
+		t.Errorf("Skipped = %+v, want Alien once", result.Skipped)
+	}
+	if len(result.Failed) != 1 || result.Failed[0].TmdbID != 600 {
+		t.Errorf("Failed = %+v, want The Duellists", result.Failed)
+	}
+
+	if _, err := svc.AddMovies(ctx, 1, &AddMoviesInput{}); !errors.Is(err, ErrPersonNotFound) {
+		t.Errorf("AddMovies(unknown) error = %v, want ErrPersonNotFound", err)
+	}
+}
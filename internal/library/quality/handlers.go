@@ -7,6 +7,8 @@ import (
 	"strconv"
 
 	"github.com/labstack/echo/v4"
+
+	"github.com/slipstream/slipstream/internal/logger"
 )
 
 // Handlers provides HTTP handlers for quality profile operations.
@@ -24,6 +26,9 @@ func (h *Handlers) RegisterRoutes(g *echo.Group) {
 	g.GET("", h.List)
 	g.POST("", h.Create)
 	g.GET("/qualities", h.ListQualities)
+	g.POST("/qualities", h.CreateQuality)
+	g.PUT("/qualities/:id", h.UpdateQuality)
+	g.DELETE("/qualities/:id", h.DeleteQuality)
 	g.GET("/attributes", h.ListAttributes)
 	g.POST("/check-exclusivity", h.CheckExclusivity)
 	g.GET("/:id", h.Get)
@@ -115,7 +120,7 @@ func (h *Handlers) Update(c echo.Context) error {
 
 	// Recalculate status for all media using this profile (cutoff/upgrades may have changed)
 	if _, err := h.service.RecalculateStatusForProfile(c.Request().Context(), id); err != nil {
-		h.service.logger.Warn().Err(err).Int64("profileId", id).Msg("Failed to recalculate status after profile update")
+		logger.FromContext(c.Request().Context(), h.service.logger).Warn().Err(err).Int64("profileId", id).Msg("Failed to recalculate status after profile update")
 	}
 
 	return c.JSON(http.StatusOK, profile)
@@ -141,7 +146,7 @@ func (h *Handlers) Delete(c echo.Context) error {
 	return c.NoContent(http.StatusNoContent)
 }
 
-// ListQualities returns the predefined quality definitions, optionally filtered by moduleType.
+// ListQualities returns the quality definitions, optionally filtered by moduleType.
 // GET /api/v1/qualityprofiles/qualities
 func (h *Handlers) ListQualities(c echo.Context) error {
 	moduleType := c.QueryParam("moduleType")
@@ -151,6 +156,68 @@ func (h *Handlers) ListQualities(c echo.Context) error {
 	return c.JSON(http.StatusOK, h.service.GetQualities())
 }
 
+// CreateQuality creates a custom quality definition.
+// POST /api/v1/qualityprofiles/qualities
+func (h *Handlers) CreateQuality(c echo.Context) error {
+	var input QualityInput
+	if err := c.Bind(&input); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	q, err := h.service.CreateQuality(c.Request().Context(), &input)
+	if err != nil {
+		return qualityError(err)
+	}
+	return c.JSON(http.StatusCreated, q)
+}
+
+// UpdateQuality updates a custom quality definition.
+// PUT /api/v1/qualityprofiles/qualities/:id
+func (h *Handlers) UpdateQuality(c echo.Context) error {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid id")
+	}
+
+	var input QualityInput
+	if err := c.Bind(&input); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	q, err := h.service.UpdateQuality(c.Request().Context(), id, &input)
+	if err != nil {
+		return qualityError(err)
+	}
+	return c.JSON(http.StatusOK, q)
+}
+
+// DeleteQuality deletes a custom quality definition.
+// DELETE /api/v1/qualityprofiles/qualities/:id
+func (h *Handlers) DeleteQuality(c echo.Context) error {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid id")
+	}
+
+	if err := h.service.DeleteQuality(c.Request().Context(), id); err != nil {
+		return qualityError(err)
+	}
+	return c.NoContent(http.StatusNoContent)
+}
+
+func qualityError(err error) error {
+	switch {
+	case errors.Is(err, ErrQualityNotFound):
+		return echo.NewHTTPError(http.StatusNotFound, err.Error())
+	case errors.Is(err, ErrQualityInUse), errors.Is(err, ErrDuplicateQuality):
+		return echo.NewHTTPError(http.StatusConflict, err.Error())
+	case errors.Is(err, ErrInvalidQuality), errors.Is(err, ErrBuiltinQuality):
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	default:
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+}
+
 // ListAttributes returns the supported attribute values for quality profiles.
 // GET /api/v1/qualityprofiles/attributes
 func (h *Handlers) ListAttributes(c echo.Context) error {
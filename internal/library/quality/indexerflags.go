@@ -0,0 +1,88 @@
+package quality
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// FlagPreference is how a profile treats an indexer flag on a release.
+type FlagPreference string
+
+const (
+	FlagIgnore  FlagPreference = ""
+	FlagPrefer  FlagPreference = "prefer"
+	FlagRequire FlagPreference = "require"
+)
+
+// IndexerFlagSettings sets how a profile treats the freeleech and internal
+// flags private trackers put on releases. Preferred flags earn a scoring
+// bonus; releases missing a required flag are rejected during selection.
+type IndexerFlagSettings struct {
+	Freeleech FlagPreference `json:"freeleech"`
+	Internal  FlagPreference `json:"internal"`
+}
+
+// IsEmpty reports whether every flag is ignored.
+func (s IndexerFlagSettings) IsEmpty() bool {
+	return s.Freeleech == FlagIgnore && s.Internal == FlagIgnore
+}
+
+// MissingRequired returns the first flag the profile requires that a release
+// with the given flags lacks, or "" if it has them all.
+func (s IndexerFlagSettings) MissingRequired(freeleech, internal bool) string {
+	if s.Freeleech == FlagRequire && !freeleech {
+		return "freeleech"
+	}
+	if s.Internal == FlagRequire && !internal {
+		return "internal"
+	}
+	return ""
+}
+
+// PreferredCount returns how many of the given flags the profile prefers.
+func (s IndexerFlagSettings) PreferredCount(freeleech, internal bool) int {
+	count := 0
+	if s.Freeleech == FlagPrefer && freeleech {
+		count++
+	}
+	if s.Internal == FlagPrefer && internal {
+		count++
+	}
+	return count
+}
+
+func validateIndexerFlags(s IndexerFlagSettings) error {
+	for name, pref := range map[string]FlagPreference{"freeleech": s.Freeleech, "internal": s.Internal} {
+		switch pref {
+		case FlagIgnore, FlagPrefer, FlagRequire:
+		default:
+			return fmt.Errorf("%w: invalid %s preference %q", ErrInvalidProfile, name, pref)
+		}
+	}
+	return nil
+}
+
+// SerializeIndexerFlags converts indexer flag settings to JSON for database
+// storage. Settings that ignore every flag serialize to an empty string.
+func SerializeIndexerFlags(s IndexerFlagSettings) (string, error) {
+	if s.IsEmpty() {
+		return "", nil
+	}
+	data, err := json.Marshal(s)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+// DeserializeIndexerFlags parses JSON indexer flag settings from database.
+func DeserializeIndexerFlags(data string) (IndexerFlagSettings, error) {
+	var s IndexerFlagSettings
+	if data == "" {
+		return s, nil
+	}
+	if err := json.Unmarshal([]byte(data), &s); err != nil {
+		return IndexerFlagSettings{}, err
+	}
+	return s, nil
+}
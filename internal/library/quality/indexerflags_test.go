@@ -0,0 +1,62 @@
+package quality
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/slipstream/slipstream/internal/testutil"
+)
+
+func TestIndexerFlagSettings_Matching(t *testing.T) {
+	s := IndexerFlagSettings{Freeleech: FlagRequire, Internal: FlagPrefer}
+
+	if got := s.MissingRequired(false, true); got != "freeleech" {
+		t.Errorf("MissingRequired(false, true) = %q, want freeleech", got)
+	}
+	if got := s.MissingRequired(true, false); got != "" {
+		t.Errorf("MissingRequired(true, false) = %q, want none", got)
+	}
+	if got := s.PreferredCount(true, true); got != 1 {
+		t.Errorf("PreferredCount(true, true) = %d, want 1", got)
+	}
+	if got := (IndexerFlagSettings{}).MissingRequired(false, false); got != "" {
+		t.Errorf("ignored flags: MissingRequired = %q, want none", got)
+	}
+}
+
+func TestService_IndexerFlagsRoundTrip(t *testing.T) {
+	tdb := testutil.NewTestDB(t)
+	defer tdb.Close()
+	qs := NewService(tdb.Conn, &tdb.Logger)
+	ctx := context.Background()
+
+	p := HD1080pProfile()
+	created, err := qs.Create(ctx, &CreateProfileInput{
+		Name:         "Ratio",
+		ModuleType:   "movie",
+		Cutoff:       p.Cutoff,
+		Items:        p.Items,
+		IndexerFlags: IndexerFlagSettings{Freeleech: FlagRequire, Internal: FlagPrefer},
+	})
+	if err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+	got, err := qs.Get(ctx, created.ID)
+	if err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	if got.IndexerFlags.Freeleech != FlagRequire || got.IndexerFlags.Internal != FlagPrefer {
+		t.Errorf("IndexerFlags = %+v, want freeleech required and internal preferred", got.IndexerFlags)
+	}
+
+	_, err = qs.Update(ctx, created.ID, &UpdateProfileInput{
+		Name:         "Ratio",
+		Cutoff:       p.Cutoff,
+		Items:        p.Items,
+		IndexerFlags: IndexerFlagSettings{Internal: "always"},
+	})
+	if !errors.Is(err, ErrInvalidProfile) {
+		t.Errorf("unknown preference: error = %v, want ErrInvalidProfile", err)
+	}
+}
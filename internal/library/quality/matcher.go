@@ -264,6 +264,28 @@ func MatchQuality(resolution, source string, profile *Profile) QualityMatchResul
 	}
 }
 
+// MatchReleaseQuality matches a release against the profile, giving custom
+// qualities in the registry whose pattern matches the release title
+// precedence over the resolution and source match.
+func MatchReleaseQuality(registry *Registry, title, resolution, source string, profile *Profile) QualityMatchResult {
+	q, ok := registry.MatchCustomQuality(title)
+	if !ok {
+		return MatchQuality(resolution, source, profile)
+	}
+	if !profile.IsAcceptable(q.ID) {
+		return QualityMatchResult{
+			Matches: false,
+			Reason:  "Quality " + q.Name + " is not allowed in profile",
+		}
+	}
+	return QualityMatchResult{
+		Matches:          true,
+		MatchedQualityID: q.ID,
+		MatchedQuality:   q.Name,
+		Score:            float64(q.Weight),
+	}
+}
+
 func parseResolution(resolution string) int {
 	switch resolution {
 	case "2160p", "4K", "UHD":
@@ -2,6 +2,8 @@ package quality
 
 import (
 	"encoding/json"
+	"fmt"
+	"slices"
 	"time"
 
 	"github.com/slipstream/slipstream/internal/library/status"
@@ -58,12 +60,36 @@ type Quality struct {
 	Source     string `json:"source"`     // "bluray", "webdl", "hdtv", etc.
 	Resolution int    `json:"resolution"` // 480, 720, 1080, 2160
 	Weight     int    `json:"weight"`     // Higher = better quality
+
+	// MatchPattern is a case-insensitive regular expression matched against
+	// release titles. Only custom qualities set it; they take precedence over
+	// the source/resolution match when it hits.
+	MatchPattern string `json:"matchPattern,omitempty"`
 }
 
 // QualityItem represents a quality in a profile with its allowed status.
+// MinSize and MaxSize bound the file size in megabytes per minute of runtime;
+// zero leaves that side unbounded.
 type QualityItem struct {
 	Quality Quality `json:"quality"`
 	Allowed bool    `json:"allowed"`
+	MinSize float64 `json:"minSize,omitempty"`
+	MaxSize float64 `json:"maxSize,omitempty"`
+}
+
+const bytesPerMB = 1 << 20
+
+// validateItemSizes rejects negative or inverted size limits.
+func validateItemSizes(items []QualityItem) error {
+	for _, item := range items {
+		if item.MinSize < 0 || item.MaxSize < 0 {
+			return fmt.Errorf("%w: size limits for %s must not be negative", ErrInvalidProfile, item.Quality.Name)
+		}
+		if item.MaxSize > 0 && item.MinSize > item.MaxSize {
+			return fmt.Errorf("%w: minimum size for %s exceeds maximum size", ErrInvalidProfile, item.Quality.Name)
+		}
+	}
+	return nil
 }
 
 // Profile represents a quality profile.
@@ -76,7 +102,7 @@ type Profile struct {
 	UpgradeStrategy         UpgradeStrategy `json:"upgradeStrategy"`         // How upgrades are evaluated
 	CutoffOverridesStrategy bool            `json:"cutoffOverridesStrategy"` // Always grab cutoff quality even if strategy would block it
 	AllowAutoApprove        bool            `json:"allowAutoApprove"`        // Whether requests using this profile can be auto-approved
-	Items                   []QualityItem   `json:"items"`                   // Ordered list of qualities
+	Items                   []QualityItem   `json:"items"`                   // Qualities ranked worst to best
 	CreatedAt               time.Time       `json:"createdAt"`
 	UpdatedAt               time.Time       `json:"updatedAt"`
 
@@ -85,6 +111,9 @@ type Profile struct {
 	VideoCodecSettings   AttributeSettings `json:"videoCodecSettings"`
 	AudioCodecSettings   AttributeSettings `json:"audioCodecSettings"`
 	AudioChannelSettings AttributeSettings `json:"audioChannelSettings"`
+
+	ReleaseGroups ReleaseGroupSettings `json:"releaseGroups"`
+	IndexerFlags  IndexerFlagSettings  `json:"indexerFlags"`
 }
 
 // CreateProfileInput is used when creating a new profile.
@@ -103,6 +132,9 @@ type CreateProfileInput struct {
 	VideoCodecSettings   AttributeSettings `json:"videoCodecSettings"`
 	AudioCodecSettings   AttributeSettings `json:"audioCodecSettings"`
 	AudioChannelSettings AttributeSettings `json:"audioChannelSettings"`
+
+	ReleaseGroups ReleaseGroupSettings `json:"releaseGroups"`
+	IndexerFlags  IndexerFlagSettings  `json:"indexerFlags"`
 }
 
 // UpdateProfileInput is used when updating a profile.
@@ -120,14 +152,20 @@ type UpdateProfileInput struct {
 	VideoCodecSettings   AttributeSettings `json:"videoCodecSettings"`
 	AudioCodecSettings   AttributeSettings `json:"audioCodecSettings"`
 	AudioChannelSettings AttributeSettings `json:"audioChannelSettings"`
+
+	ReleaseGroups ReleaseGroupSettings `json:"releaseGroups"`
+	IndexerFlags  IndexerFlagSettings  `json:"indexerFlags"`
 }
 
-// PredefinedQualities are the standard quality definitions.
+// PredefinedQualities are the built-in quality definitions, listed in ascending
+// weight. They seed the qualities table and back the registry until the
+// database copy is loaded.
 // CAM (ID 18) covers cinema-recorded sources (CAM, HDCAM, TS, TELESYNC, HDTS).
 // Its resolution tag is intentionally ignored — a "1080p TELESYNC" is still a
 // camcorder recording — so it stays a single tier rather than per-resolution
 // variants. Weight 0 keeps it strictly below SDTV in any scoring decision.
 var PredefinedQualities = []Quality{
+	{ID: 18, Name: "CAM", Source: "cam", Resolution: 0, Weight: 0},
 	{ID: 1, Name: "SDTV", Source: "tv", Resolution: 480, Weight: 1},
 	{ID: 2, Name: "DVD", Source: "dvd", Resolution: 480, Weight: 2},
 	{ID: 3, Name: "WEBRip-480p", Source: "webrip", Resolution: 480, Weight: 3},
@@ -145,43 +183,17 @@ var PredefinedQualities = []Quality{
 	{ID: 15, Name: "WEBDL-2160p", Source: "webdl", Resolution: 2160, Weight: 15},
 	{ID: 16, Name: "Bluray-2160p", Source: "bluray", Resolution: 2160, Weight: 16},
 	{ID: 17, Name: "Remux-2160p", Source: "remux", Resolution: 2160, Weight: 17},
-	{ID: 18, Name: "CAM", Source: "cam", Resolution: 0, Weight: 0},
 }
 
 // CAMQualityID is the predefined ID for the CAM tier.
 const CAMQualityID = 18
 
-// qualityByID is a lookup map for qualities by ID.
-var qualityByID map[int]Quality
-
-func init() {
-	qualityByID = make(map[int]Quality)
-	for _, q := range PredefinedQualities {
-		qualityByID[q.ID] = q
-	}
-}
-
-// GetQualityByID returns a quality by its ID.
-func GetQualityByID(id int) (Quality, bool) {
-	q, ok := qualityByID[id]
-	return q, ok
-}
-
-// GetQualityByName finds a quality by name.
-func GetQualityByName(name string) (Quality, bool) {
-	for _, q := range PredefinedQualities {
-		if q.Name == name {
-			return q, true
-		}
-	}
-	return Quality{}, false
-}
-
 // DefaultProfile returns a default "Any" profile that accepts all qualities
 // except CAM, which must be opted into explicitly.
 func DefaultProfile() Profile {
-	items := make([]QualityItem, len(PredefinedQualities))
-	for i, q := range PredefinedQualities {
+	qualities := PredefinedQualities
+	items := make([]QualityItem, len(qualities))
+	for i, q := range qualities {
 		items[i] = QualityItem{
 			Quality: q,
 			Allowed: q.ID != CAMQualityID,
@@ -202,8 +214,9 @@ func DefaultProfile() Profile {
 
 // HD1080pProfile returns a profile targeting 1080p content.
 func HD1080pProfile() Profile {
-	items := make([]QualityItem, len(PredefinedQualities))
-	for i, q := range PredefinedQualities {
+	qualities := PredefinedQualities
+	items := make([]QualityItem, len(qualities))
+	for i, q := range qualities {
 		items[i] = QualityItem{
 			Quality: q,
 			Allowed: q.Resolution >= 720 && q.Resolution <= 1080,
@@ -224,8 +237,9 @@ func HD1080pProfile() Profile {
 
 // Ultra4KProfile returns a profile targeting 4K content.
 func Ultra4KProfile() Profile {
-	items := make([]QualityItem, len(PredefinedQualities))
-	for i, q := range PredefinedQualities {
+	qualities := PredefinedQualities
+	items := make([]QualityItem, len(qualities))
+	for i, q := range qualities {
 		items[i] = QualityItem{
 			Quality: q,
 			Allowed: q.Resolution >= 1080,
@@ -263,13 +277,31 @@ func DeserializeItems(data string) ([]QualityItem, error) {
 }
 
 // IsAtOrAboveCutoff checks if a quality meets or exceeds the profile cutoff.
-// Returns true if the quality weight >= cutoff weight, meaning no upgrade is needed.
+// Returns true if the quality ranks at or above the cutoff, meaning no upgrade is needed.
 func (p *Profile) IsAtOrAboveCutoff(qualityID int) bool {
-	q, ok := GetQualityByID(qualityID)
+	q, ok := p.qualityByID(qualityID)
 	if !ok {
 		return false
 	}
-	return q.Weight >= p.getCutoffWeight()
+	return p.RankWeight(q) >= p.getCutoffWeight()
+}
+
+// RankWeight returns the weight a quality carries within this profile. The
+// weights of the listed qualities are redistributed in item order, so moving
+// an item up or down re-ranks it while keeping the scale that scoring and
+// cutoff checks use. Qualities the profile does not list keep their own weight.
+func (p *Profile) RankWeight(q Quality) int {
+	weights := make([]int, len(p.Items))
+	for i := range p.Items {
+		weights[i] = p.Items[i].Quality.Weight
+	}
+	slices.Sort(weights)
+	for i := range p.Items {
+		if p.Items[i].Quality.ID == q.ID {
+			return weights[i]
+		}
+	}
+	return q.Weight
 }
 
 // StatusForQuality returns the appropriate media status based on a file's quality.
@@ -295,18 +327,49 @@ func (p *Profile) IsAcceptable(qualityID int) bool {
 	return false
 }
 
+// qualityByID looks a quality up among the profile's items, which carry the
+// definitions current when the profile was loaded, falling back to the
+// predefined tiers for qualities the profile does not list.
+func (p *Profile) qualityByID(id int) (Quality, bool) {
+	for i := range p.Items {
+		if p.Items[i].Quality.ID == id {
+			return p.Items[i].Quality, true
+		}
+	}
+	return PredefinedQualityByID(id)
+}
+
+// IsSizeAcceptable checks a file size against the quality's MB-per-minute limits.
+// Sizes are always acceptable when the runtime is unknown or the quality has no limits.
+func (p *Profile) IsSizeAcceptable(qualityID int, sizeBytes int64, runtimeMinutes int) bool {
+	if runtimeMinutes <= 0 || sizeBytes <= 0 {
+		return true
+	}
+	for _, item := range p.Items {
+		if item.Quality.ID != qualityID {
+			continue
+		}
+		mbPerMinute := float64(sizeBytes) / bytesPerMB / float64(runtimeMinutes)
+		if item.MinSize > 0 && mbPerMinute < item.MinSize {
+			return false
+		}
+		return item.MaxSize == 0 || mbPerMinute <= item.MaxSize
+	}
+	return true
+}
+
 // IsUpgrade checks if candidate quality is an upgrade over current quality.
 func (p *Profile) IsUpgrade(currentQualityID, candidateQualityID int) bool {
-	currentQuality, ok := GetQualityByID(currentQualityID)
+	currentQuality, ok := p.qualityByID(currentQualityID)
 	if !ok {
 		return false
 	}
 
-	if currentQuality.Weight >= p.getCutoffWeight() {
+	if p.RankWeight(currentQuality) >= p.getCutoffWeight() {
 		return false
 	}
 
-	candidateQuality, ok := GetQualityByID(candidateQualityID)
+	candidateQuality, ok := p.qualityByID(candidateQualityID)
 	if !ok || !p.IsAcceptable(candidateQualityID) {
 		return false
 	}
@@ -325,7 +388,7 @@ func (p *Profile) isStrategyUpgrade(current, candidate Quality) bool {
 	case StrategyBalanced:
 		return isBalancedUpgrade(current, candidate)
 	default:
-		return candidate.Weight > current.Weight
+		return p.RankWeight(candidate) > p.RankWeight(current)
 	}
 }
 
@@ -339,10 +402,10 @@ func isBalancedUpgrade(current, candidate Quality) bool {
 	return false
 }
 
-// getCutoffWeight returns the weight of the cutoff quality.
+// getCutoffWeight returns the profile-ranked weight of the cutoff quality.
 func (p *Profile) getCutoffWeight() int {
-	if q, ok := GetQualityByID(p.Cutoff); ok {
-		return q.Weight
+	if q, ok := p.qualityByID(p.Cutoff); ok {
+		return p.RankWeight(q)
 	}
 	return 0
 }
@@ -2,6 +2,7 @@ package quality
 
 import (
 	"encoding/json"
+	"errors"
 	"testing"
 )
 
@@ -36,7 +37,7 @@ func TestGetQualityByID(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.wantName, func(t *testing.T) {
-			q, ok := GetQualityByID(tt.id)
+			q, ok := NewRegistry().GetQualityByID(tt.id)
 			if ok != tt.wantOK {
 				t.Errorf("GetQualityByID(%d) ok = %v, want %v", tt.id, ok, tt.wantOK)
 			}
@@ -63,7 +64,7 @@ func TestGetQualityByName(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			q, ok := GetQualityByName(tt.name)
+			q, ok := NewRegistry().GetQualityByName(tt.name)
 			if ok != tt.wantOK {
 				t.Errorf("GetQualityByName(%q) ok = %v, want %v", tt.name, ok, tt.wantOK)
 			}
@@ -216,13 +217,59 @@ func TestProfile_IsAcceptable(t *testing.T) {
 		t.Run("", func(t *testing.T) {
 			got := profile.IsAcceptable(tt.qualityID)
 			if got != tt.want {
-				q, _ := GetQualityByID(tt.qualityID)
+				q, _ := PredefinedQualityByID(tt.qualityID)
 				t.Errorf("IsAcceptable(%d/%s) = %v, want %v", tt.qualityID, q.Name, got, tt.want)
 			}
 		})
 	}
 }
 
+func TestProfile_IsSizeAcceptable(t *testing.T) {
+	profile := HD1080pProfile()
+	for i := range profile.Items {
+		if profile.Items[i].Quality.ID == 10 {
+			profile.Items[i].MinSize = 10
+			profile.Items[i].MaxSize = 100
+		}
+	}
+
+	const mb = 1 << 20
+	tests := []struct {
+		name      string
+		qualityID int
+		size      int64
+		runtime   int
+		want      bool
+	}{
+		{"within limits", 10, 4000 * mb, 100, true},
+		{"below minimum", 10, 400 * mb, 100, false},
+		{"above maximum", 10, 80000 * mb, 100, false},
+		{"unknown runtime", 10, 400 * mb, 0, true},
+		{"quality without limits", 11, 400 * mb, 100, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := profile.IsSizeAcceptable(tt.qualityID, tt.size, tt.runtime); got != tt.want {
+				t.Errorf("IsSizeAcceptable(%d, %d, %d) = %v, want %v", tt.qualityID, tt.size, tt.runtime, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidateItemSizes(t *testing.T) {
+	q, _ := PredefinedQualityByID(10)
+	valid := []QualityItem{{Quality: q, Allowed: true, MinSize: 5, MaxSize: 50}}
+	if err := validateItemSizes(valid); err != nil {
+		t.Errorf("validateItemSizes() error = %v", err)
+	}
+
+	inverted := []QualityItem{{Quality: q, Allowed: true, MinSize: 50, MaxSize: 5}}
+	if err := validateItemSizes(inverted); !errors.Is(err, ErrInvalidProfile) {
+		t.Errorf("validateItemSizes() error = %v, want ErrInvalidProfile", err)
+	}
+}
+
 func TestProfile_IsUpgrade(t *testing.T) {
 	profile := HD1080pProfile()
 
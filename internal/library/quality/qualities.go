@@ -0,0 +1,382 @@
+package quality
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/slipstream/slipstream/internal/database/sqlc"
+)
+
+// FirstCustomQualityID is the lowest ID assigned to admin-defined qualities.
+// Lower IDs are reserved for built-in tiers so their IDs stay stable.
+const FirstCustomQualityID = 100
+
+var (
+	ErrQualityNotFound  = errors.New("quality not found")
+	ErrQualityInUse     = errors.New("quality is in use")
+	ErrInvalidQuality   = errors.New("invalid quality")
+	ErrBuiltinQuality   = errors.New("built-in qualities cannot be modified")
+	ErrDuplicateQuality = errors.New("a quality with this name already exists")
+)
+
+// IsCustomQualityID reports whether id belongs to an admin-defined quality.
+func IsCustomQualityID(id int) bool {
+	return id >= FirstCustomQualityID
+}
+
+// QualityInput is used when creating or updating a custom quality.
+type QualityInput struct {
+	Name         string `json:"name"`
+	Source       string `json:"source"`
+	Resolution   int    `json:"resolution"`
+	Weight       int    `json:"weight"`
+	MatchPattern string `json:"matchPattern"`
+}
+
+// Registry holds the active quality definitions. Each quality Service owns
+// one, which starts out with the predefined tiers and is replaced with the
+// database copy by LoadQualities. A nil Registry holds the predefined tiers.
+type Registry struct {
+	mu        sync.RWMutex
+	qualities []Quality
+	byID      map[int]Quality
+	patterns  map[int]*regexp.Regexp
+}
+
+// NewRegistry returns a registry holding the predefined qualities.
+func NewRegistry() *Registry {
+	r := &Registry{}
+	if err := r.set(PredefinedQualities); err != nil {
+		panic(err)
+	}
+	return r
+}
+
+func (r *Registry) set(qualities []Quality) error {
+	sorted := slices.Clone(qualities)
+	slices.SortStableFunc(sorted, func(a, b Quality) int {
+		if a.Weight != b.Weight {
+			return a.Weight - b.Weight
+		}
+		return a.ID - b.ID
+	})
+
+	byID := make(map[int]Quality, len(sorted))
+	patterns := make(map[int]*regexp.Regexp)
+	for _, q := range sorted {
+		byID[q.ID] = q
+		if q.MatchPattern == "" {
+			continue
+		}
+		re, err := compileMatchPattern(q.MatchPattern)
+		if err != nil {
+			return err
+		}
+		patterns[q.ID] = re
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.qualities = sorted
+	r.byID = byID
+	r.patterns = patterns
+	return nil
+}
+
+func compileMatchPattern(pattern string) (*regexp.Regexp, error) {
+	re, err := regexp.Compile("(?i)" + pattern)
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid match pattern: %w", ErrInvalidQuality, err)
+	}
+	return re, nil
+}
+
+// Qualities returns all quality definitions in ascending weight.
+func (r *Registry) Qualities() []Quality {
+	if r == nil {
+		return slices.Clone(PredefinedQualities)
+	}
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return slices.Clone(r.qualities)
+}
+
+// GetQualityByID returns a quality by its ID.
+func (r *Registry) GetQualityByID(id int) (Quality, bool) {
+	if r == nil {
+		return PredefinedQualityByID(id)
+	}
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	q, ok := r.byID[id]
+	return q, ok
+}
+
+// GetQualityByName finds a quality by name.
+func (r *Registry) GetQualityByName(name string) (Quality, bool) {
+	if r == nil {
+		return PredefinedQualityByName(name)
+	}
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return findQualityByName(r.qualities, name)
+}
+
+// MatchCustomQuality returns the highest-weighted custom quality whose match
+// pattern matches the release title.
+func (r *Registry) MatchCustomQuality(title string) (Quality, bool) {
+	if r == nil {
+		return Quality{}, false
+	}
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	for i := len(r.qualities) - 1; i >= 0; i-- {
+		q := r.qualities[i]
+		if re, ok := r.patterns[q.ID]; ok && re.MatchString(title) {
+			return q, true
+		}
+	}
+	return Quality{}, false
+}
+
+// PredefinedQualityByID returns a built-in quality by its ID. Built-in
+// qualities cannot be modified, so this needs no registry.
+func PredefinedQualityByID(id int) (Quality, bool) {
+	for _, q := range PredefinedQualities {
+		if q.ID == id {
+			return q, true
+		}
+	}
+	return Quality{}, false
+}
+
+// PredefinedQualityByName finds a built-in quality by name.
+func PredefinedQualityByName(name string) (Quality, bool) {
+	return findQualityByName(PredefinedQualities, name)
+}
+
+func findQualityByName(qualities []Quality, name string) (Quality, bool) {
+	for _, q := range qualities {
+		if q.Name == name {
+			return q, true
+		}
+	}
+	return Quality{}, false
+}
+
+// normalizeItems brings profile items in line with the registry: quality
+// definitions are refreshed, deleted qualities are dropped and qualities added
+// since the profile was saved are inserted, disallowed, before the first item
+// that outweighs them.
+func (r *Registry) normalizeItems(items []QualityItem) []QualityItem {
+	qualities := r.Qualities()
+	result := make([]QualityItem, 0, len(qualities))
+	seen := make(map[int]bool, len(items))
+	for _, item := range items {
+		q, ok := r.GetQualityByID(item.Quality.ID)
+		if !ok || seen[q.ID] {
+			continue
+		}
+		seen[q.ID] = true
+		item.Quality = q
+		result = append(result, item)
+	}
+
+	for _, q := range qualities {
+		if seen[q.ID] {
+			continue
+		}
+		pos := slices.IndexFunc(result, func(item QualityItem) bool { return item.Quality.Weight > q.Weight })
+		if pos < 0 {
+			pos = len(result)
+		}
+		result = slices.Insert(result, pos, QualityItem{Quality: q})
+	}
+	return result
+}
+
+// validateItemQualities rejects profile items that reference unknown qualities.
+func (r *Registry) validateItemQualities(items []QualityItem) error {
+	for _, item := range items {
+		if _, ok := r.GetQualityByID(item.Quality.ID); !ok {
+			return fmt.Errorf("%w: unknown quality %d", ErrInvalidProfile, item.Quality.ID)
+		}
+	}
+	return nil
+}
+
+// Registry returns the quality definitions this service keeps current, for
+// services that look qualities up or match releases against custom ones.
+func (s *Service) Registry() *Registry {
+	return s.qualities
+}
+
+// LoadQualities replaces the active quality definitions with the database copy.
+func (s *Service) LoadQualities(ctx context.Context) error {
+	rows, err := s.queries.ListQualities(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to list qualities: %w", err)
+	}
+	qualities := make([]Quality, len(rows))
+	for i, row := range rows {
+		qualities[i] = rowToQuality(row)
+	}
+	if err := s.qualities.set(qualities); err != nil {
+		return err
+	}
+	s.logger.Debug().Int("count", len(qualities)).Msg("Loaded quality definitions")
+	return nil
+}
+
+// CreateQuality adds a custom quality definition.
+func (s *Service) CreateQuality(ctx context.Context, input *QualityInput) (*Quality, error) {
+	if err := validateQualityInput(input); err != nil {
+		return nil, err
+	}
+	if err := s.checkDuplicateQualityName(ctx, input.Name, 0); err != nil {
+		return nil, err
+	}
+
+	row, err := s.queries.CreateQuality(ctx, sqlc.CreateQualityParams{
+		Name:         input.Name,
+		Source:       input.Source,
+		Resolution:   int64(input.Resolution),
+		Weight:       int64(input.Weight),
+		MatchPattern: input.MatchPattern,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create quality: %w", err)
+	}
+	if err := s.LoadQualities(ctx); err != nil {
+		return nil, err
+	}
+
+	s.logger.Info().Int64("id", row.ID).Str("name", row.Name).Msg("Created custom quality")
+	q := rowToQuality(row)
+	return &q, nil
+}
+
+// UpdateQuality updates a custom quality definition.
+func (s *Service) UpdateQuality(ctx context.Context, id int, input *QualityInput) (*Quality, error) {
+	if !IsCustomQualityID(id) {
+		return nil, ErrBuiltinQuality
+	}
+	if err := validateQualityInput(input); err != nil {
+		return nil, err
+	}
+	if err := s.checkDuplicateQualityName(ctx, input.Name, id); err != nil {
+		return nil, err
+	}
+
+	row, err := s.queries.UpdateQuality(ctx, sqlc.UpdateQualityParams{
+		ID:           int64(id),
+		Name:         input.Name,
+		Source:       input.Source,
+		Resolution:   int64(input.Resolution),
+		Weight:       int64(input.Weight),
+		MatchPattern: input.MatchPattern,
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrQualityNotFound
+		}
+		return nil, fmt.Errorf("failed to update quality: %w", err)
+	}
+	if err := s.LoadQualities(ctx); err != nil {
+		return nil, err
+	}
+
+	s.logger.Info().Int("id", id).Str("name", row.Name).Msg("Updated custom quality")
+	q := rowToQuality(row)
+	return &q, nil
+}
+
+// checkDuplicateQualityName returns ErrDuplicateQuality when a quality other
+// than exceptID already uses name.
+func (s *Service) checkDuplicateQualityName(ctx context.Context, name string, exceptID int) error {
+	row, err := s.queries.GetQualityByName(ctx, name)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to check quality name: %w", err)
+	}
+	if int(row.ID) != exceptID {
+		return ErrDuplicateQuality
+	}
+	return nil
+}
+
+// DeleteQuality removes a custom quality that no file or profile cutoff references.
+func (s *Service) DeleteQuality(ctx context.Context, id int) error {
+	if !IsCustomQualityID(id) {
+		return ErrBuiltinQuality
+	}
+	if _, err := s.queries.GetQuality(ctx, int64(id)); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return ErrQualityNotFound
+		}
+		return fmt.Errorf("failed to get quality: %w", err)
+	}
+
+	nullID := sql.NullInt64{Int64: int64(id), Valid: true}
+	files, err := s.queries.CountFilesUsingQuality(ctx, sqlc.CountFilesUsingQualityParams{QualityID: nullID, QualityID_2: nullID})
+	if err != nil {
+		return fmt.Errorf("failed to check file usage: %w", err)
+	}
+	cutoffs, err := s.queries.CountQualityProfilesWithCutoff(ctx, int64(id))
+	if err != nil {
+		return fmt.Errorf("failed to check profile usage: %w", err)
+	}
+	if files > 0 || cutoffs > 0 {
+		return ErrQualityInUse
+	}
+
+	if err := s.queries.DeleteQuality(ctx, int64(id)); err != nil {
+		return fmt.Errorf("failed to delete quality: %w", err)
+	}
+	if err := s.LoadQualities(ctx); err != nil {
+		return err
+	}
+
+	s.logger.Info().Int("id", id).Msg("Deleted custom quality")
+	return nil
+}
+
+func validateQualityInput(input *QualityInput) error {
+	input.Name = strings.TrimSpace(input.Name)
+	input.Source = strings.ToLower(strings.TrimSpace(input.Source))
+	input.MatchPattern = strings.TrimSpace(input.MatchPattern)
+
+	if input.Name == "" {
+		return fmt.Errorf("%w: name is required", ErrInvalidQuality)
+	}
+	if input.Source == "" {
+		return fmt.Errorf("%w: source is required", ErrInvalidQuality)
+	}
+	if input.Resolution < 0 || input.Weight < 0 {
+		return fmt.Errorf("%w: resolution and weight must not be negative", ErrInvalidQuality)
+	}
+	if input.MatchPattern == "" {
+		return fmt.Errorf("%w: match pattern is required", ErrInvalidQuality)
+	}
+	_, err := compileMatchPattern(input.MatchPattern)
+	return err
+}
+
+func rowToQuality(row *sqlc.Quality) Quality {
+	return Quality{
+		ID:           int(row.ID),
+		Name:         row.Name,
+		Source:       row.Source,
+		Resolution:   int(row.Resolution),
+		Weight:       int(row.Weight),
+		MatchPattern: row.MatchPattern,
+	}
+}
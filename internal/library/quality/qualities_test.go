@@ -0,0 +1,153 @@
+package quality
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/slipstream/slipstream/internal/testutil"
+)
+
+// registryWith returns a registry holding the predefined qualities plus q.
+func registryWith(t *testing.T, q Quality) *Registry {
+	t.Helper()
+	r := NewRegistry()
+	if err := r.set(append(PredefinedQualities[:len(PredefinedQualities):len(PredefinedQualities)], q)); err != nil {
+		t.Fatalf("set() error = %v", err)
+	}
+	return r
+}
+
+func TestMatchCustomQuality(t *testing.T) {
+	r := registryWith(t, Quality{ID: 100, Name: "Remux-1080p 3D", Source: "remux", Resolution: 1080, Weight: 12, MatchPattern: `\b3D\b`})
+
+	q, ok := r.MatchCustomQuality("Avatar.2009.1080p.3D.BluRay.REMUX-GRP")
+	if !ok || q.ID != 100 {
+		t.Fatalf("MatchCustomQuality() = %+v, %v, want custom quality 100", q, ok)
+	}
+	if _, ok := r.MatchCustomQuality("Avatar.2009.1080p.BluRay.REMUX-GRP"); ok {
+		t.Error("MatchCustomQuality() matched a release without the pattern")
+	}
+	if q, ok := r.GetQualityByName("Remux-1080p 3D"); !ok || q.ID != 100 {
+		t.Errorf("GetQualityByName() = %+v, %v", q, ok)
+	}
+}
+
+func TestNormalizeItems(t *testing.T) {
+	r := registryWith(t, Quality{ID: 100, Name: "Remux-1080p 3D", Source: "remux", Resolution: 1080, Weight: 12, MatchPattern: `3D`})
+
+	items := []QualityItem{
+		{Quality: Quality{ID: 11, Name: "Old Name"}, Allowed: true},
+		{Quality: Quality{ID: 99}, Allowed: true},
+		{Quality: Quality{ID: 10}, Allowed: true},
+	}
+	got := r.normalizeItems(items)
+
+	if len(got) != len(PredefinedQualities)+1 {
+		t.Fatalf("normalizeItems() returned %d items, want %d", len(got), len(PredefinedQualities)+1)
+	}
+	bluray, webdl := -1, -1
+	for i, item := range got {
+		switch item.Quality.ID {
+		case 11:
+			bluray = i
+			if item.Quality.Name != "Bluray-1080p" || !item.Allowed {
+				t.Errorf("Bluray-1080p item = %+v, want refreshed and allowed", item)
+			}
+		case 10:
+			webdl = i
+		}
+	}
+	if bluray > webdl {
+		t.Errorf("saved order lost: Bluray-1080p at %d, WEBDL-1080p at %d", bluray, webdl)
+	}
+	for _, item := range got {
+		if item.Quality.ID == 99 {
+			t.Error("unknown quality should be dropped")
+		}
+		if item.Quality.ID == 100 && item.Allowed {
+			t.Error("added quality should be disallowed")
+		}
+	}
+}
+
+func TestProfile_RankWeightFollowsItemOrder(t *testing.T) {
+	// WEBDL-1080p is ranked above Bluray-1080p in this profile.
+	profile := Profile{
+		Cutoff:          10,
+		UpgradesEnabled: true,
+		UpgradeStrategy: StrategyAggressive,
+		Items: []QualityItem{
+			{Quality: Quality{ID: 8, Weight: 8}, Allowed: true},
+			{Quality: Quality{ID: 11, Weight: 11}, Allowed: true},
+			{Quality: Quality{ID: 10, Weight: 10}, Allowed: true},
+		},
+	}
+
+	if !profile.IsUpgrade(11, 10) {
+		t.Error("WEBDL-1080p should upgrade Bluray-1080p when ranked above it")
+	}
+	if !profile.IsUpgrade(8, 11) {
+		t.Error("Bluray-1080p should still upgrade HDTV-1080p")
+	}
+	if profile.IsAtOrAboveCutoff(11) {
+		t.Error("Bluray-1080p ranks below the WEBDL-1080p cutoff")
+	}
+	if got := profile.RankWeight(Quality{ID: 4, Weight: 4}); got != 4 {
+		t.Errorf("RankWeight() of unlisted quality = %d, want its own weight", got)
+	}
+}
+
+func TestValidateQualityInput(t *testing.T) {
+	valid := QualityInput{Name: " Remux-1080p 3D ", Source: "Remux", Resolution: 1080, Weight: 12, MatchPattern: `\b3D\b`}
+	if err := validateQualityInput(&valid); err != nil {
+		t.Fatalf("validateQualityInput() error = %v", err)
+	}
+	if valid.Name != "Remux-1080p 3D" || valid.Source != "remux" {
+		t.Errorf("input not normalized: %+v", valid)
+	}
+
+	for _, input := range []QualityInput{
+		{Source: "remux", MatchPattern: "3D"},
+		{Name: "3D", MatchPattern: "3D"},
+		{Name: "3D", Source: "remux"},
+		{Name: "3D", Source: "remux", MatchPattern: "("},
+		{Name: "3D", Source: "remux", MatchPattern: "3D", Weight: -1},
+	} {
+		if err := validateQualityInput(&input); !errors.Is(err, ErrInvalidQuality) {
+			t.Errorf("validateQualityInput(%+v) error = %v, want ErrInvalidQuality", input, err)
+		}
+	}
+}
+
+func TestService_CreateQualityDuplicateName(t *testing.T) {
+	tdb := testutil.NewTestDB(t)
+	defer tdb.Close()
+	qs := NewService(tdb.Conn, &tdb.Logger)
+	ctx := context.Background()
+
+	created, err := qs.CreateQuality(ctx, &QualityInput{Name: "Remux-1080p 3D", Source: "remux", Resolution: 1080, Weight: 12, MatchPattern: `\b3D\b`})
+	if err != nil {
+		t.Fatalf("CreateQuality() error = %v", err)
+	}
+	if _, ok := qs.Registry().GetQualityByName("Remux-1080p 3D"); !ok {
+		t.Error("created quality missing from the service registry")
+	}
+	if _, ok := NewService(tdb.Conn, &tdb.Logger).Registry().GetQualityByName("Remux-1080p 3D"); ok {
+		t.Error("created quality leaked into another service's registry")
+	}
+
+	for _, name := range []string{"Remux-1080p 3D", "Bluray-1080p"} {
+		_, err := qs.CreateQuality(ctx, &QualityInput{Name: name, Source: "remux", Resolution: 1080, Weight: 12, MatchPattern: "3D"})
+		if !errors.Is(err, ErrDuplicateQuality) {
+			t.Errorf("CreateQuality(%q) error = %v, want ErrDuplicateQuality", name, err)
+		}
+	}
+
+	if _, err := qs.UpdateQuality(ctx, created.ID, &QualityInput{Name: "Remux-1080p 3D", Source: "remux", Resolution: 1080, Weight: 13, MatchPattern: "3D"}); err != nil {
+		t.Errorf("UpdateQuality() keeping its own name error = %v", err)
+	}
+	if _, err := qs.UpdateQuality(ctx, created.ID, &QualityInput{Name: "Bluray-1080p", Source: "remux", Resolution: 1080, Weight: 13, MatchPattern: "3D"}); !errors.Is(err, ErrDuplicateQuality) {
+		t.Errorf("UpdateQuality() to a taken name error = %v, want ErrDuplicateQuality", err)
+	}
+}
@@ -0,0 +1,88 @@
+package quality
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// ReleaseGroupSettings lists the release groups a profile prefers or forbids.
+// Forbidden groups are rejected during release selection and automatic import;
+// preferred groups earn a scoring bonus. Names match case-insensitively.
+type ReleaseGroupSettings struct {
+	Preferred []string `json:"preferred"`
+	Forbidden []string `json:"forbidden"`
+}
+
+// IsPreferred reports whether group is on the preferred list.
+func (s ReleaseGroupSettings) IsPreferred(group string) bool {
+	return containsGroup(s.Preferred, group)
+}
+
+// IsForbidden reports whether group is on the forbidden list.
+func (s ReleaseGroupSettings) IsForbidden(group string) bool {
+	return containsGroup(s.Forbidden, group)
+}
+
+func containsGroup(groups []string, group string) bool {
+	if group == "" {
+		return false
+	}
+	return slices.ContainsFunc(groups, func(g string) bool { return strings.EqualFold(g, group) })
+}
+
+// normalizeReleaseGroups trims names and drops blanks and case-insensitive
+// duplicates, rejecting a group listed as both preferred and forbidden.
+func normalizeReleaseGroups(s ReleaseGroupSettings) (ReleaseGroupSettings, error) {
+	s.Preferred = dedupeGroups(s.Preferred)
+	s.Forbidden = dedupeGroups(s.Forbidden)
+	for _, g := range s.Preferred {
+		if containsGroup(s.Forbidden, g) {
+			return s, fmt.Errorf("%w: release group %s is both preferred and forbidden", ErrInvalidProfile, g)
+		}
+	}
+	return s, nil
+}
+
+func dedupeGroups(groups []string) []string {
+	result := make([]string, 0, len(groups))
+	for _, g := range groups {
+		g = strings.TrimSpace(g)
+		if g != "" && !containsGroup(result, g) {
+			result = append(result, g)
+		}
+	}
+	return result
+}
+
+// SerializeReleaseGroups converts release group settings to JSON for database
+// storage. Empty settings serialize to an empty string.
+func SerializeReleaseGroups(s ReleaseGroupSettings) (string, error) {
+	if len(s.Preferred) == 0 && len(s.Forbidden) == 0 {
+		return "", nil
+	}
+	data, err := json.Marshal(s)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+// DeserializeReleaseGroups parses JSON release group settings from database.
+func DeserializeReleaseGroups(data string) (ReleaseGroupSettings, error) {
+	s := ReleaseGroupSettings{Preferred: []string{}, Forbidden: []string{}}
+	if data == "" {
+		return s, nil
+	}
+	if err := json.Unmarshal([]byte(data), &s); err != nil {
+		return ReleaseGroupSettings{Preferred: []string{}, Forbidden: []string{}}, err
+	}
+	if s.Preferred == nil {
+		s.Preferred = []string{}
+	}
+	if s.Forbidden == nil {
+		s.Forbidden = []string{}
+	}
+	return s, nil
+}
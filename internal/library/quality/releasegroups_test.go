@@ -0,0 +1,79 @@
+package quality
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/slipstream/slipstream/internal/testutil"
+)
+
+func TestReleaseGroupSettings_Matching(t *testing.T) {
+	s := ReleaseGroupSettings{Preferred: []string{"FraMeSToR"}, Forbidden: []string{"YIFY"}}
+
+	if !s.IsPreferred("framestor") {
+		t.Error("IsPreferred should match case-insensitively")
+	}
+	if !s.IsForbidden("yify") || s.IsForbidden("YTS") {
+		t.Error("IsForbidden should match only listed groups")
+	}
+	if s.IsPreferred("") || s.IsForbidden("") {
+		t.Error("a release without a group should match neither list")
+	}
+}
+
+func TestNormalizeReleaseGroups(t *testing.T) {
+	s, err := normalizeReleaseGroups(ReleaseGroupSettings{
+		Preferred: []string{" NTb ", "ntb", ""},
+		Forbidden: []string{"YIFY"},
+	})
+	if err != nil {
+		t.Fatalf("normalizeReleaseGroups() error = %v", err)
+	}
+	if len(s.Preferred) != 1 || s.Preferred[0] != "NTb" {
+		t.Errorf("Preferred = %v, want [NTb]", s.Preferred)
+	}
+
+	_, err = normalizeReleaseGroups(ReleaseGroupSettings{Preferred: []string{"YIFY"}, Forbidden: []string{"yify"}})
+	if !errors.Is(err, ErrInvalidProfile) {
+		t.Errorf("group both preferred and forbidden: error = %v, want ErrInvalidProfile", err)
+	}
+}
+
+func TestService_ReleaseGroupsRoundTrip(t *testing.T) {
+	tdb := testutil.NewTestDB(t)
+	defer tdb.Close()
+	qs := NewService(tdb.Conn, &tdb.Logger)
+	ctx := context.Background()
+
+	p := HD1080pProfile()
+	created, err := qs.Create(ctx, &CreateProfileInput{
+		Name:          "Groups",
+		ModuleType:    "movie",
+		Cutoff:        p.Cutoff,
+		Items:         p.Items,
+		ReleaseGroups: ReleaseGroupSettings{Preferred: []string{"NTb"}, Forbidden: []string{"YIFY", "yify"}},
+	})
+	if err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+	got, err := qs.Get(ctx, created.ID)
+	if err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	if !got.ReleaseGroups.IsPreferred("NTb") || len(got.ReleaseGroups.Forbidden) != 1 {
+		t.Errorf("ReleaseGroups = %+v, want NTb preferred and YIFY forbidden once", got.ReleaseGroups)
+	}
+
+	updated, err := qs.Update(ctx, created.ID, &UpdateProfileInput{
+		Name:   "Groups",
+		Cutoff: p.Cutoff,
+		Items:  p.Items,
+	})
+	if err != nil {
+		t.Fatalf("Update() error = %v", err)
+	}
+	if len(updated.ReleaseGroups.Preferred) != 0 || len(updated.ReleaseGroups.Forbidden) != 0 {
+		t.Errorf("ReleaseGroups after clearing = %+v, want empty lists", updated.ReleaseGroups)
+	}
+}
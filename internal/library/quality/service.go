@@ -43,14 +43,16 @@ type Service struct {
 	logger                *zerolog.Logger
 	importDecisionCleaner ImportDecisionCleaner
 	moduleQualities       moduleQualityRegistry
+	qualities             *Registry
 }
 
 // NewService creates a new quality profile service.
 func NewService(db *sql.DB, logger *zerolog.Logger) *Service {
 	subLogger := logger.With().Str("component", "quality").Logger()
 	return &Service{
-		queries: sqlc.New(db),
-		logger:  &subLogger,
+		queries:   sqlc.New(db),
+		logger:    &subLogger,
+		qualities: NewRegistry(),
 	}
 }
 
@@ -77,17 +79,24 @@ func (s *Service) RegisterModuleQualities(moduleType string, items []QualityItem
 		Msg("Registered quality items for module")
 }
 
-// GetQualitiesForModule returns quality items for a specific module.
+// GetQualitiesForModule returns the qualities a module declares, as currently
+// defined, followed by every custom quality.
 func (s *Service) GetQualitiesForModule(moduleType string) []Quality {
 	s.moduleQualities.mu.RLock()
 	defer s.moduleQualities.mu.RUnlock()
 	items, ok := s.moduleQualities.items[moduleType]
 	if !ok || len(items) == 0 {
-		return PredefinedQualities
+		return s.qualities.Qualities()
 	}
-	result := make([]Quality, len(items))
-	for i, item := range items {
-		result[i] = Quality(item)
+	declared := make(map[int]bool, len(items))
+	for _, item := range items {
+		declared[item.ID] = true
+	}
+	result := make([]Quality, 0, len(items))
+	for _, q := range s.qualities.Qualities() {
+		if declared[q.ID] || IsCustomQualityID(q.ID) {
+			result = append(result, q)
+		}
 	}
 	return result
 }
@@ -104,6 +113,15 @@ func (s *Service) Get(ctx context.Context, id int64) (*Profile, error) {
 	return s.rowToProfile(row)
 }
 
+// StatusForQuality returns the status a file of qualityID earns under the profile.
+func (s *Service) StatusForQuality(ctx context.Context, profileID int64, qualityID int) (string, error) {
+	profile, err := s.Get(ctx, profileID)
+	if err != nil {
+		return "", err
+	}
+	return profile.StatusForQuality(qualityID), nil
+}
+
 // GetByName retrieves a quality profile by name and module type.
 func (s *Service) GetByName(ctx context.Context, name, moduleType string) (*Profile, error) {
 	row, err := s.queries.GetQualityProfileByName(ctx, sqlc.GetQualityProfileByNameParams{
@@ -165,7 +183,22 @@ func (s *Service) Create(ctx context.Context, input *CreateProfileInput) (*Profi
 		return nil, fmt.Errorf("%w: moduleType is required", ErrInvalidProfile)
 	}
 
-	serialized, err := s.serializeProfileSettings(input.Items, input.HDRSettings, input.VideoCodecSettings, input.AudioCodecSettings, input.AudioChannelSettings)
+	if err := s.qualities.validateItemQualities(input.Items); err != nil {
+		return nil, err
+	}
+	if err := validateItemSizes(input.Items); err != nil {
+		return nil, err
+	}
+	input.Items = s.qualities.normalizeItems(input.Items)
+	releaseGroups, err := normalizeReleaseGroups(input.ReleaseGroups)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateIndexerFlags(input.IndexerFlags); err != nil {
+		return nil, err
+	}
+
+	serialized, err := s.serializeProfileSettings(input.Items, input.HDRSettings, input.VideoCodecSettings, input.AudioCodecSettings, input.AudioChannelSettings, releaseGroups, input.IndexerFlags)
 	if err != nil {
 		return nil, err
 	}
@@ -190,6 +223,8 @@ func (s *Service) Create(ctx context.Context, input *CreateProfileInput) (*Profi
 		AllowAutoApprove:        input.AllowAutoApprove,
 		UpgradeStrategy:         upgradeStrategy,
 		CutoffOverridesStrategy: boolToInt64(input.CutoffOverridesStrategy),
+		ReleaseGroups:           serialized.releaseGroups,
+		IndexerFlags:            serialized.indexerFlags,
 	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to create quality profile: %w", err)
@@ -200,10 +235,10 @@ func (s *Service) Create(ctx context.Context, input *CreateProfileInput) (*Profi
 }
 
 type serializedSettings struct {
-	items, hdr, videoCodec, audioCodec, audioChannel string
+	items, hdr, videoCodec, audioCodec, audioChannel, releaseGroups, indexerFlags string
 }
 
-func (s *Service) serializeProfileSettings(items []QualityItem, hdr, videoCodec, audioCodec, audioChannel AttributeSettings) (*serializedSettings, error) {
+func (s *Service) serializeProfileSettings(items []QualityItem, hdr, videoCodec, audioCodec, audioChannel AttributeSettings, releaseGroups ReleaseGroupSettings, indexerFlags IndexerFlagSettings) (*serializedSettings, error) {
 	itemsJSON, err := SerializeItems(items)
 	if err != nil {
 		return nil, fmt.Errorf("failed to serialize items: %w", err)
@@ -224,12 +259,22 @@ func (s *Service) serializeProfileSettings(items []QualityItem, hdr, videoCodec,
 	if err != nil {
 		return nil, fmt.Errorf("failed to serialize audio channel settings: %w", err)
 	}
+	releaseGroupsJSON, err := SerializeReleaseGroups(releaseGroups)
+	if err != nil {
+		return nil, fmt.Errorf("failed to serialize release groups: %w", err)
+	}
+	indexerFlagsJSON, err := SerializeIndexerFlags(indexerFlags)
+	if err != nil {
+		return nil, fmt.Errorf("failed to serialize indexer flags: %w", err)
+	}
 	return &serializedSettings{
-		items:        itemsJSON,
-		hdr:          hdrJSON,
-		videoCodec:   videoCodecJSON,
-		audioCodec:   audioCodecJSON,
-		audioChannel: audioChannelJSON,
+		items:         itemsJSON,
+		hdr:           hdrJSON,
+		videoCodec:    videoCodecJSON,
+		audioCodec:    audioCodecJSON,
+		audioChannel:  audioChannelJSON,
+		releaseGroups: releaseGroupsJSON,
+		indexerFlags:  indexerFlagsJSON,
 	}, nil
 }
 
@@ -246,7 +291,22 @@ func (s *Service) Update(ctx context.Context, id int64, input *UpdateProfileInpu
 		return nil, ErrInvalidProfile
 	}
 
-	serialized, err := s.serializeProfileSettings(input.Items, input.HDRSettings, input.VideoCodecSettings, input.AudioCodecSettings, input.AudioChannelSettings)
+	if err := s.qualities.validateItemQualities(input.Items); err != nil {
+		return nil, err
+	}
+	if err := validateItemSizes(input.Items); err != nil {
+		return nil, err
+	}
+	input.Items = s.qualities.normalizeItems(input.Items)
+	releaseGroups, err := normalizeReleaseGroups(input.ReleaseGroups)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateIndexerFlags(input.IndexerFlags); err != nil {
+		return nil, err
+	}
+
+	serialized, err := s.serializeProfileSettings(input.Items, input.HDRSettings, input.VideoCodecSettings, input.AudioCodecSettings, input.AudioChannelSettings, releaseGroups, input.IndexerFlags)
 	if err != nil {
 		return nil, err
 	}
@@ -269,6 +329,8 @@ func (s *Service) Update(ctx context.Context, id int64, input *UpdateProfileInpu
 		AllowAutoApprove:        input.AllowAutoApprove,
 		UpgradeStrategy:         upgradeStrategy,
 		CutoffOverridesStrategy: boolToInt64(input.CutoffOverridesStrategy),
+		ReleaseGroups:           serialized.releaseGroups,
+		IndexerFlags:            serialized.indexerFlags,
 	})
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
@@ -341,9 +403,9 @@ func (s *Service) checkProfileUsage(ctx context.Context, id int64, moduleType st
 	return nil
 }
 
-// GetQualities returns the list of predefined qualities.
+// GetQualities returns all quality definitions in ascending weight.
 func (s *Service) GetQualities() []Quality {
-	return PredefinedQualities
+	return s.qualities.Qualities()
 }
 
 // EnsureDefaults creates default profiles if none exist for the given module type.
@@ -389,6 +451,7 @@ func (s *Service) rowToProfile(row *sqlc.QualityProfile) (*Profile, error) {
 	if err != nil {
 		return nil, fmt.Errorf("failed to deserialize items: %w", err)
 	}
+	items = s.qualities.normalizeItems(items)
 
 	hdrSettings, err := DeserializeAttributeSettings(row.HdrSettings.String)
 	if err != nil {
@@ -414,6 +477,16 @@ func (s *Service) rowToProfile(row *sqlc.QualityProfile) (*Profile, error) {
 		audioChannelSettings = DefaultAttributeSettings()
 	}
 
+	releaseGroups, err := DeserializeReleaseGroups(row.ReleaseGroups)
+	if err != nil {
+		s.logger.Warn().Err(err).Int64("id", row.ID).Msg("Failed to deserialize release groups, using defaults")
+	}
+
+	indexerFlags, err := DeserializeIndexerFlags(row.IndexerFlags)
+	if err != nil {
+		s.logger.Warn().Err(err).Int64("id", row.ID).Msg("Failed to deserialize indexer flags, using defaults")
+	}
+
 	upgradeStrategy := UpgradeStrategy(row.UpgradeStrategy)
 	if !IsValidUpgradeStrategy(string(upgradeStrategy)) {
 		upgradeStrategy = StrategyBalanced
@@ -433,6 +506,8 @@ func (s *Service) rowToProfile(row *sqlc.QualityProfile) (*Profile, error) {
 		VideoCodecSettings:      videoCodecSettings,
 		AudioCodecSettings:      audioCodecSettings,
 		AudioChannelSettings:    audioChannelSettings,
+		ReleaseGroups:           releaseGroups,
+		IndexerFlags:            indexerFlags,
 	}
 
 	if row.CreatedAt.Valid {
@@ -0,0 +1,32 @@
+//go:build windows
+
+package rootfolder
+
+import (
+	"syscall"
+	"unsafe"
+)
+
+// diskSpace returns the space available to this process and the total size
+// of the volume holding path.
+func diskSpace(path string) (free, total int64, err error) {
+	kernel32 := syscall.NewLazyDLL("kernel32.dll")
+	getDiskFreeSpaceEx := kernel32.NewProc("GetDiskFreeSpaceExW")
+
+	pathPtr, err := syscall.UTF16PtrFromString(path)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	var freeBytesAvailable, totalBytes, totalFreeBytes uint64
+	ret, _, callErr := getDiskFreeSpaceEx.Call(
+		uintptr(unsafe.Pointer(pathPtr)),
+		uintptr(unsafe.Pointer(&freeBytesAvailable)),
+		uintptr(unsafe.Pointer(&totalBytes)),
+		uintptr(unsafe.Pointer(&totalFreeBytes)),
+	)
+	if ret == 0 {
+		return 0, 0, callErr
+	}
+	return int64(freeBytesAvailable), int64(totalBytes), nil
+}
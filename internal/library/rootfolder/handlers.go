@@ -33,6 +33,8 @@ func (h *Handlers) RegisterRoutes(g *echo.Group) {
 	g.POST("", h.Create)
 	g.GET("/:id", h.Get)
 	g.DELETE("/:id", h.Delete)
+	g.PUT("/:id/default", h.SetDefault)
+	g.POST("/:id/refresh", h.Refresh)
 }
 
 // List returns all root folders.
@@ -121,3 +123,54 @@ func (h *Handlers) Delete(c echo.Context) error {
 	}
 	return c.NoContent(http.StatusNoContent)
 }
+
+// SetDefault makes a root folder the default for its media type.
+// PUT /api/v1/rootfolders/:id/default
+func (h *Handlers) SetDefault(c echo.Context) error {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid id")
+	}
+
+	ctx := c.Request().Context()
+	if err := h.service.SetDefault(ctx, id); err != nil {
+		if errors.Is(err, ErrRootFolderNotFound) {
+			return echo.NewHTTPError(http.StatusNotFound, err.Error())
+		}
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	folders, err := h.service.List(ctx)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	for _, folder := range folders {
+		if folder.ID == id {
+			return c.JSON(http.StatusOK, folder)
+		}
+	}
+	return echo.NewHTTPError(http.StatusNotFound, ErrRootFolderNotFound.Error())
+}
+
+// Refresh re-checks a root folder's free space and reachability.
+// POST /api/v1/rootfolders/:id/refresh
+func (h *Handlers) Refresh(c echo.Context) error {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid id")
+	}
+
+	ctx := c.Request().Context()
+	if err := h.service.UpdateFreeSpace(ctx, id); err != nil {
+		if errors.Is(err, ErrRootFolderNotFound) {
+			return echo.NewHTTPError(http.StatusNotFound, err.Error())
+		}
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	folder, err := h.service.Get(ctx, id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	return c.JSON(http.StatusOK, folder)
+}
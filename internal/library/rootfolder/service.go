@@ -28,17 +28,23 @@ var (
 	ErrPathNotDirectory   = errors.New("path is not a directory")
 	ErrPathAlreadyExists  = errors.New("root folder path already exists")
 	ErrInvalidMediaType   = errors.New("invalid media type (must be 'movie' or 'tv')")
+	ErrRootFolderOffline  = errors.New("root folder is unreachable")
 )
 
+// probeTimeout bounds how long a root folder check waits on a stalled mount.
+const probeTimeout = 10 * time.Second
+
 // RootFolder represents a root folder for media storage.
 type RootFolder struct {
-	ID        int64     `json:"id"`
-	Path      string    `json:"path"`
-	Name      string    `json:"name"`
-	MediaType string    `json:"mediaType"` // "movie" or "tv"
-	FreeSpace int64     `json:"freeSpace"`
-	CreatedAt time.Time `json:"createdAt"`
-	IsDefault bool      `json:"isDefault"`
+	ID          int64     `json:"id"`
+	Path        string    `json:"path"`
+	Name        string    `json:"name"`
+	MediaType   string    `json:"mediaType"` // "movie" or "tv"
+	FreeSpace   int64     `json:"freeSpace"`
+	TotalSpace  int64     `json:"totalSpace"`
+	Unreachable bool      `json:"unreachable"` // The folder could not be reached at the last check
+	CreatedAt   time.Time `json:"createdAt"`
+	IsDefault   bool      `json:"isDefault"`
 }
 
 // CreateRootFolderInput contains fields for creating a root folder.
@@ -158,7 +164,7 @@ func (s *Service) Create(ctx context.Context, input CreateRootFolderInput) (*Roo
 		return nil, ErrInvalidMediaType
 	}
 
-	absPath, freeSpace, err := s.resolveAndValidatePath(input.Path)
+	absPath, space, err := s.resolveAndValidatePath(input.Path)
 	if err != nil {
 		return nil, err
 	}
@@ -176,7 +182,8 @@ func (s *Service) Create(ctx context.Context, input CreateRootFolderInput) (*Roo
 		Path:       absPath,
 		Name:       name,
 		ModuleType: input.MediaType,
-		FreeSpace:  sql.NullInt64{Int64: freeSpace, Valid: true},
+		FreeSpace:  sql.NullInt64{Int64: space.free, Valid: true},
+		TotalSpace: sql.NullInt64{Int64: space.total, Valid: space.total > 0},
 	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to create root folder: %w", err)
@@ -195,40 +202,50 @@ func (s *Service) Create(ctx context.Context, input CreateRootFolderInput) (*Roo
 	return s.rowToRootFolder(row), nil
 }
 
-func (s *Service) resolveAndValidatePath(inputPath string) (absPath string, freeSpace int64, err error) {
+// volumeSpace is the free and total space of the volume holding a folder.
+type volumeSpace struct {
+	free  int64
+	total int64
+}
+
+func (s *Service) resolveAndValidatePath(inputPath string) (absPath string, space volumeSpace, err error) {
 	if mock.IsMockPath(inputPath) {
 		return s.resolveMockPath(inputPath)
 	}
 	return s.resolveRealPath(inputPath)
 }
 
-func (s *Service) resolveMockPath(inputPath string) (absPath string, freeSpace int64, err error) {
+func (s *Service) resolveMockPath(inputPath string) (absPath string, space volumeSpace, err error) {
 	absPath = strings.TrimSuffix(inputPath, "/")
 	vfs := mock.GetInstance()
 	if !vfs.IsDirectory(absPath) {
-		return "", 0, ErrPathNotFound
+		return "", volumeSpace{}, ErrPathNotFound
 	}
-	return absPath, 1000 * 1024 * 1024 * 1024, nil
+	return absPath, mockVolumeSpace, nil
 }
 
-func (s *Service) resolveRealPath(inputPath string) (absPath string, freeSpace int64, err error) {
+func (s *Service) resolveRealPath(inputPath string) (absPath string, space volumeSpace, err error) {
 	absPath, err = filepath.Abs(inputPath)
 	if err != nil {
-		return "", 0, fmt.Errorf("failed to get absolute path: %w", err)
+		return "", volumeSpace{}, fmt.Errorf("failed to get absolute path: %w", err)
 	}
 
 	info, err := os.Stat(absPath)
 	if err != nil {
 		if os.IsNotExist(err) {
-			return "", 0, ErrPathNotFound
+			return "", volumeSpace{}, ErrPathNotFound
 		}
-		return "", 0, fmt.Errorf("failed to check path: %w", err)
+		return "", volumeSpace{}, fmt.Errorf("failed to check path: %w", err)
 	}
 	if !info.IsDir() {
-		return "", 0, ErrPathNotDirectory
+		return "", volumeSpace{}, ErrPathNotDirectory
 	}
 
-	return absPath, s.getFreeSpace(absPath), nil
+	space.free, space.total, err = diskSpace(absPath)
+	if err != nil {
+		s.logger.Warn().Err(err).Str("path", absPath).Msg("Failed to read disk space")
+	}
+	return absPath, space, nil
 }
 
 func (s *Service) checkPathNotDuplicate(ctx context.Context, absPath string) error {
@@ -291,21 +308,45 @@ func (s *Service) Delete(ctx context.Context, id int64) error {
 	return nil
 }
 
-// UpdateFreeSpace updates the free space for a root folder.
+// UpdateFreeSpace re-checks a root folder: it records free and total space,
+// marks the folder unreachable when its path cannot be read, and reports the
+// result to the health service.
 func (s *Service) UpdateFreeSpace(ctx context.Context, id int64) error {
 	folder, err := s.Get(ctx, id)
 	if err != nil {
 		return err
 	}
 
-	freeSpace := s.getFreeSpace(folder.Path)
-	return s.queries.UpdateRootFolderFreeSpace(ctx, sqlc.UpdateRootFolderFreeSpaceParams{
-		ID:        id,
-		FreeSpace: sql.NullInt64{Int64: freeSpace, Valid: true},
-	})
+	space, probeErr := s.probe(ctx, folder.Path)
+	params := sqlc.UpdateRootFolderSpaceParams{ID: id, Unreachable: probeErr != nil}
+	if probeErr == nil {
+		params.FreeSpace = sql.NullInt64{Int64: space.free, Valid: true}
+		params.TotalSpace = sql.NullInt64{Int64: space.total, Valid: space.total > 0}
+	} else {
+		// Keep the last known space while the folder is offline.
+		params.FreeSpace = sql.NullInt64{Int64: folder.FreeSpace, Valid: true}
+		params.TotalSpace = sql.NullInt64{Int64: folder.TotalSpace, Valid: folder.TotalSpace > 0}
+	}
+	if err := s.queries.UpdateRootFolderSpace(ctx, params); err != nil {
+		return fmt.Errorf("failed to update root folder space: %w", err)
+	}
+
+	key := fmt.Sprintf("%d", id)
+	switch {
+	case probeErr != nil:
+		if !folder.Unreachable {
+			s.logger.Warn().Err(probeErr).Int64("id", id).Str("path", folder.Path).Msg("Root folder is unreachable")
+		}
+		if s.healthService != nil {
+			s.healthService.SetErrorStr("rootFolders", key, fmt.Sprintf("Root folder is unreachable: %v", probeErr))
+		}
+	case s.healthService != nil:
+		s.healthService.ClearStatusStr("rootFolders", key)
+	}
+	return nil
 }
 
-// RefreshAllFreeSpace updates free space for all root folders.
+// RefreshAllFreeSpace re-checks space and reachability for all root folders.
 func (s *Service) RefreshAllFreeSpace(ctx context.Context) error {
 	folders, err := s.List(ctx)
 	if err != nil {
@@ -320,13 +361,47 @@ func (s *Service) RefreshAllFreeSpace(ctx context.Context) error {
 	return nil
 }
 
-// getFreeSpace returns the free space in bytes for a path.
-func (s *Service) getFreeSpace(path string) int64 {
-	// This is a simplified implementation
-	// On Windows, we'd use syscall.GetDiskFreeSpaceEx
-	// On Unix, we'd use syscall.Statfs
-	// For now, return 0 to indicate unknown
-	return 0
+// mockVolumeSpace is reported for folders on the development mock filesystem.
+var mockVolumeSpace = volumeSpace{free: 1000 * 1024 * 1024 * 1024, total: 2000 * 1024 * 1024 * 1024}
+
+// probe checks that path is a readable directory and returns its volume's
+// space. A mount that does not answer within probeTimeout counts as unreachable.
+func (s *Service) probe(ctx context.Context, path string) (volumeSpace, error) {
+	if mock.IsMockPath(path) {
+		if !mock.GetInstance().IsDirectory(path) {
+			return volumeSpace{}, ErrPathNotFound
+		}
+		return mockVolumeSpace, nil
+	}
+
+	type result struct {
+		space volumeSpace
+		err   error
+	}
+	done := make(chan result, 1)
+	go func() {
+		info, err := os.Stat(path)
+		if err != nil {
+			done <- result{err: err}
+			return
+		}
+		if !info.IsDir() {
+			done <- result{err: ErrPathNotDirectory}
+			return
+		}
+		var r result
+		r.space.free, r.space.total, r.err = diskSpace(path)
+		done <- r
+	}()
+
+	ctx, cancel := context.WithTimeout(ctx, probeTimeout)
+	defer cancel()
+	select {
+	case r := <-done:
+		return r.space, r.err
+	case <-ctx.Done():
+		return volumeSpace{}, fmt.Errorf("%w: no response within %s", ErrRootFolderOffline, probeTimeout)
+	}
 }
 
 // rowToRootFolder converts a database row to a RootFolder.
@@ -340,6 +415,10 @@ func (s *Service) rowToRootFolder(row *sqlc.RootFolder) *RootFolder {
 	if row.FreeSpace.Valid {
 		rf.FreeSpace = row.FreeSpace.Int64
 	}
+	if row.TotalSpace.Valid {
+		rf.TotalSpace = row.TotalSpace.Int64
+	}
+	rf.Unreachable = row.Unreachable
 	if row.CreatedAt.Valid {
 		rf.CreatedAt = row.CreatedAt.Time
 	}
@@ -0,0 +1,57 @@
+package rootfolder
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/slipstream/slipstream/internal/testutil"
+)
+
+func TestService_UpdateFreeSpace(t *testing.T) {
+	tdb := testutil.NewTestDB(t)
+	defer tdb.Close()
+
+	ctx := context.Background()
+	svc := NewService(tdb.Conn, &tdb.Logger, nil, nil)
+
+	dir := filepath.Join(t.TempDir(), "movies")
+	if err := os.Mkdir(dir, 0o755); err != nil {
+		t.Fatal(err)
+	}
+	folder, err := svc.Create(ctx, CreateRootFolderInput{Path: dir, MediaType: "movie"})
+	if err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+	if folder.TotalSpace <= 0 || folder.FreeSpace > folder.TotalSpace {
+		t.Errorf("Create() space = %d free of %d, want a measured volume", folder.FreeSpace, folder.TotalSpace)
+	}
+
+	if err := os.Remove(dir); err != nil {
+		t.Fatal(err)
+	}
+	if err := svc.UpdateFreeSpace(ctx, folder.ID); err != nil {
+		t.Fatalf("UpdateFreeSpace() error = %v", err)
+	}
+	got, err := svc.Get(ctx, folder.ID)
+	if err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	if !got.Unreachable {
+		t.Error("missing folder not marked unreachable")
+	}
+	if got.TotalSpace != folder.TotalSpace {
+		t.Errorf("TotalSpace = %d, want last known %d", got.TotalSpace, folder.TotalSpace)
+	}
+
+	if err := os.Mkdir(dir, 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := svc.UpdateFreeSpace(ctx, folder.ID); err != nil {
+		t.Fatalf("UpdateFreeSpace() error = %v", err)
+	}
+	if got, _ := svc.Get(ctx, folder.ID); got.Unreachable {
+		t.Error("folder still unreachable after it came back")
+	}
+}
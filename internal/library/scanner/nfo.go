@@ -0,0 +1,135 @@
+package scanner
+
+import (
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// maxNFOSize caps how much of an .nfo file is read. Kodi exports are a few KB;
+// anything far larger is not metadata.
+const maxNFOSize = 1 << 20
+
+// NFOIDs holds the external IDs found in a Kodi-style .nfo file.
+type NFOIDs struct {
+	TmdbID int
+	TvdbID int
+	ImdbID string
+}
+
+// IsEmpty reports whether no IDs were found.
+func (n *NFOIDs) IsEmpty() bool {
+	return n == nil || (n.TmdbID == 0 && n.TvdbID == 0 && n.ImdbID == "")
+}
+
+var (
+	// <uniqueid type="tmdb" default="true">603</uniqueid>
+	nfoUniqueIDPattern = regexp.MustCompile(`(?is)<uniqueid[^>]*\btype\s*=\s*["']?(tmdb|imdb|tvdb)["']?[^>]*>\s*([^<\s]+)\s*</uniqueid>`)
+	// Legacy tags: <tmdbid>, <imdbid>, <imdb_id>, <tvdbid>
+	nfoLegacyTagPattern = regexp.MustCompile(`(?is)<(tmdbid|imdbid|imdb_id|tvdbid)>\s*([^<\s]+)\s*</`)
+	// Bare <id>, which holds whatever the scraper used (IMDb for movies, TVDB for shows).
+	nfoIDTagPattern = regexp.MustCompile(`(?is)<id>\s*([^<\s]+)\s*</id>`)
+
+	// URL-only .nfo files, as written by many release groups and older scrapers.
+	nfoTmdbURLPattern = regexp.MustCompile(`(?i)themoviedb\.org/(?:movie|tv)/(\d+)`)
+	nfoTvdbURLPattern = regexp.MustCompile(`(?i)thetvdb\.com/.*?[?&](?:id|seriesid)=(\d+)`)
+	nfoImdbPattern    = regexp.MustCompile(`\btt\d{7,8}\b`)
+)
+
+// ParseNFO extracts external IDs from the contents of an .nfo file. Parsing is
+// deliberately lenient: Kodi exports are XML, but hand-edited files are often
+// malformed or hold nothing but a URL, so tags and URLs are matched directly.
+func ParseNFO(data []byte) NFOIDs {
+	var ids NFOIDs
+	text := string(data)
+
+	for _, m := range nfoUniqueIDPattern.FindAllStringSubmatch(text, -1) {
+		ids.set(strings.ToLower(m[1]), m[2])
+	}
+	for _, m := range nfoLegacyTagPattern.FindAllStringSubmatch(text, -1) {
+		ids.set(strings.TrimSuffix(strings.TrimSuffix(strings.ToLower(m[1]), "_id"), "id"), m[2])
+	}
+	for _, m := range nfoIDTagPattern.FindAllStringSubmatch(text, -1) {
+		if nfoImdbPattern.MatchString(m[1]) {
+			ids.set("imdb", m[1])
+		}
+	}
+
+	if m := nfoTmdbURLPattern.FindStringSubmatch(text); m != nil {
+		ids.set("tmdb", m[1])
+	}
+	if m := nfoTvdbURLPattern.FindStringSubmatch(text); m != nil {
+		ids.set("tvdb", m[1])
+	}
+	if m := nfoImdbPattern.FindString(text); m != "" {
+		ids.set("imdb", m)
+	}
+
+	return ids
+}
+
+// set records an ID unless one of that kind was already found, so explicit
+// tags win over IDs scraped from URLs later in the file.
+func (n *NFOIDs) set(kind, value string) {
+	switch kind {
+	case "tmdb":
+		if id, err := strconv.Atoi(value); err == nil && id > 0 && n.TmdbID == 0 {
+			n.TmdbID = id
+		}
+	case "tvdb":
+		if id, err := strconv.Atoi(value); err == nil && id > 0 && n.TvdbID == 0 {
+			n.TvdbID = id
+		}
+	case "imdb":
+		if nfoImdbPattern.MatchString(value) && n.ImdbID == "" {
+			n.ImdbID = strings.ToLower(value)
+		}
+	}
+}
+
+// ReadNFO reads and parses an .nfo file. A missing file returns nil, nil.
+func ReadNFO(path string) (*NFOIDs, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	defer f.Close()
+
+	data, err := io.ReadAll(io.LimitReader(f, maxNFOSize))
+	if err != nil {
+		return nil, err
+	}
+	ids := ParseNFO(data)
+	return &ids, nil
+}
+
+// FindMovieNFO returns the IDs from the .nfo next to a movie file, checking
+// "<video name>.nfo" before the folder-level "movie.nfo" Kodi also writes.
+func FindMovieNFO(videoPath string) *NFOIDs {
+	dir := filepath.Dir(videoPath)
+	base := strings.TrimSuffix(filepath.Base(videoPath), filepath.Ext(videoPath))
+	return firstNFO(filepath.Join(dir, base+".nfo"), filepath.Join(dir, "movie.nfo"))
+}
+
+// FindSeriesNFO returns the IDs from the tvshow.nfo in a series folder.
+func FindSeriesNFO(seriesDir string) *NFOIDs {
+	return firstNFO(filepath.Join(seriesDir, "tvshow.nfo"))
+}
+
+func firstNFO(paths ...string) *NFOIDs {
+	for _, path := range paths {
+		ids, err := ReadNFO(path)
+		if err != nil || ids.IsEmpty() {
+			continue
+		}
+		return ids
+	}
+	return nil
+}
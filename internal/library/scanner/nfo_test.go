@@ -0,0 +1,106 @@
+package scanner
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestParseNFO(t *testing.T) {
+	tests := []struct {
+		name string
+		nfo  string
+		want NFOIDs
+	}{
+		{
+			name: "kodi movie export",
+			nfo: `<?xml version="1.0" encoding="UTF-8" standalone="yes" ?>
+<movie>
+    <title>The Matrix</title>
+    <uniqueid type="imdb">tt0133093</uniqueid>
+    <uniqueid type="tmdb" default="true">603</uniqueid>
+</movie>`,
+			want: NFOIDs{TmdbID: 603, ImdbID: "tt0133093"},
+		},
+		{
+			name: "kodi tvshow export",
+			nfo: `<tvshow>
+    <title>Breaking Bad</title>
+    <uniqueid type="tvdb" default="true">81189</uniqueid>
+    <uniqueid type="tmdb">1396</uniqueid>
+    <uniqueid type="imdb">tt0903747</uniqueid>
+</tvshow>`,
+			want: NFOIDs{TmdbID: 1396, TvdbID: 81189, ImdbID: "tt0903747"},
+		},
+		{
+			name: "legacy tags",
+			nfo:  `<movie><id>tt0133093</id><tmdbid>603</tmdbid></movie>`,
+			want: NFOIDs{TmdbID: 603, ImdbID: "tt0133093"},
+		},
+		{
+			name: "legacy tvshow id is not imdb",
+			nfo:  `<tvshow><id>81189</id><tvdbid>81189</tvdbid></tvshow>`,
+			want: NFOIDs{TvdbID: 81189},
+		},
+		{
+			name: "url only",
+			nfo:  "https://www.imdb.com/title/tt0133093/\r\n",
+			want: NFOIDs{ImdbID: "tt0133093"},
+		},
+		{
+			name: "xml followed by tmdb url",
+			nfo:  "<movie><title>The Matrix</title></movie>\nhttps://www.themoviedb.org/movie/603-the-matrix",
+			want: NFOIDs{TmdbID: 603},
+		},
+		{
+			name: "tvdb url",
+			nfo:  "http://thetvdb.com/?tab=series&id=81189",
+			want: NFOIDs{TvdbID: 81189},
+		},
+		{
+			name: "explicit tag wins over url",
+			nfo:  `<movie><tmdbid>603</tmdbid></movie> https://www.themoviedb.org/movie/604`,
+			want: NFOIDs{TmdbID: 603},
+		},
+		{
+			name: "release info nfo",
+			nfo:  "RELEASE.NAME.2020.1080p.BluRay.x264\nSize: 8.2 GB\nGreetings to all",
+			want: NFOIDs{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ParseNFO([]byte(tt.nfo))
+			if got != tt.want {
+				t.Errorf("ParseNFO() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFindMovieNFO(t *testing.T) {
+	dir := t.TempDir()
+	video := filepath.Join(dir, "The Matrix (1999).mkv")
+
+	if got := FindMovieNFO(video); got != nil {
+		t.Fatalf("FindMovieNFO() with no nfo = %+v, want nil", got)
+	}
+
+	write := func(name, content string) {
+		t.Helper()
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0o600); err != nil {
+			t.Fatalf("WriteFile error = %v", err)
+		}
+	}
+
+	write("movie.nfo", `<movie><uniqueid type="tmdb">603</uniqueid></movie>`)
+	if got := FindMovieNFO(video); got == nil || got.TmdbID != 603 {
+		t.Errorf("FindMovieNFO() = %+v, want movie.nfo IDs", got)
+	}
+
+	write("The Matrix (1999).nfo", `<movie><uniqueid type="imdb">tt0133093</uniqueid></movie>`)
+	if got := FindMovieNFO(video); got == nil || got.ImdbID != "tt0133093" || got.TmdbID != 0 {
+		t.Errorf("FindMovieNFO() = %+v, want per-file nfo to take precedence", got)
+	}
+}
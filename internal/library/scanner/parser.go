@@ -18,6 +18,8 @@ type ParsedMedia struct {
 	EndSeason         int      `json:"endSeason,omitempty"`         // For multi-season packs (S01-S04)
 	Episode           int      `json:"episode,omitempty"`           // 0 for movies or season packs
 	EndEpisode        int      `json:"endEpisode,omitempty"`        // For multi-episode files
+	AbsoluteEpisode   int      `json:"absoluteEpisode,omitempty"`   // Anime absolute number, when no season/episode
+	EndAbsolute       int      `json:"endAbsolute,omitempty"`       // For absolute ranges (01-12)
 	IsSeasonPack      bool     `json:"isSeasonPack"`                // True for season packs (S01 without episode)
 	IsCompleteSeries  bool     `json:"isCompleteSeries,omitempty"`  // True for complete series boxsets
 	Quality           string   `json:"quality,omitempty"`           // "720p", "1080p", "2160p"
@@ -32,6 +34,7 @@ type ParsedMedia struct {
 	Revision          string   `json:"revision,omitempty"`          // "Proper", "REPACK", "REAL"
 	Edition           string   `json:"edition,omitempty"`           // "Directors Cut", "Extended", "Theatrical"
 	Languages         []string `json:"languages,omitempty"`         // "German", "French", "Spanish", etc. Empty means English assumed
+	SubtitleLanguages []string `json:"subtitleLanguages,omitempty"` // Subtitle-only tags, e.g. "French" for VOSTFR
 	IsTV              bool     `json:"isTv"`
 	FilePath          string   `json:"filePath"`
 	FileSize          int64    `json:"fileSize"`
@@ -40,20 +43,21 @@ type ParsedMedia struct {
 // ParseResultData is a local representation of module.ParseResult, defined here to
 // avoid importing the module package (which would create an import cycle).
 type ParseResultData struct {
-	Title         string
-	Year          int
-	Quality       string
-	Source        string
-	Codec         string
-	HDRFormats    []string
-	AudioCodecs   []string
-	AudioChannels []string
-	ReleaseGroup  string
-	Revision      string
-	Edition       string
-	Languages     []string
-	IsTV          bool
-	Extra         any
+	Title             string
+	Year              int
+	Quality           string
+	Source            string
+	Codec             string
+	HDRFormats        []string
+	AudioCodecs       []string
+	AudioChannels     []string
+	ReleaseGroup      string
+	Revision          string
+	Edition           string
+	Languages         []string
+	SubtitleLanguages []string
+	IsTV              bool
+	Extra             any
 }
 
 // tvExtraAccessor extracts TV-specific fields from ParseResultData.Extra.
@@ -66,6 +70,12 @@ type tvExtraAccessor interface {
 	TVIsCompleteSeries() bool
 }
 
+// tvAbsoluteExtraAccessor extracts anime absolute numbers from ParseResultData.Extra.
+type tvAbsoluteExtraAccessor interface {
+	TVAbsoluteEpisode() int
+	TVEndAbsoluteEpisode() int
+}
+
 // ModuleFileParser is the scanner's view of a module's file parsing capability.
 type ModuleFileParser interface {
 	ID() string
@@ -152,19 +162,20 @@ func tryMatchAllParsers(filename string, reg ModuleParserRegistry) *ParseResultD
 // resultToParsedMedia converts a ParseResultData to ParsedMedia for backward compatibility.
 func resultToParsedMedia(result *ParseResultData) *ParsedMedia {
 	parsed := &ParsedMedia{
-		Title:         result.Title,
-		Year:          result.Year,
-		Quality:       result.Quality,
-		Source:        result.Source,
-		Codec:         result.Codec,
-		HDRFormats:    result.HDRFormats,
-		AudioCodecs:   result.AudioCodecs,
-		AudioChannels: result.AudioChannels,
-		ReleaseGroup:  result.ReleaseGroup,
-		Revision:      result.Revision,
-		Edition:       result.Edition,
-		Languages:     result.Languages,
-		IsTV:          result.IsTV,
+		Title:             result.Title,
+		Year:              result.Year,
+		Quality:           result.Quality,
+		Source:            result.Source,
+		Codec:             result.Codec,
+		HDRFormats:        result.HDRFormats,
+		AudioCodecs:       result.AudioCodecs,
+		AudioChannels:     result.AudioChannels,
+		ReleaseGroup:      result.ReleaseGroup,
+		Revision:          result.Revision,
+		Edition:           result.Edition,
+		Languages:         result.Languages,
+		SubtitleLanguages: result.SubtitleLanguages,
+		IsTV:              result.IsTV,
 	}
 
 	// Extract TV-specific fields from Extra if present.
@@ -187,6 +198,10 @@ func populateTVFields(parsed *ParsedMedia, extra any) {
 		parsed.IsSeasonPack = accessor.TVIsSeasonPack()
 		parsed.IsCompleteSeries = accessor.TVIsCompleteSeries()
 	}
+	if accessor, ok := extra.(tvAbsoluteExtraAccessor); ok {
+		parsed.AbsoluteEpisode = accessor.TVAbsoluteEpisode()
+		parsed.EndAbsolute = accessor.TVEndAbsoluteEpisode()
+	}
 }
 
 // computeAttributes builds the Attributes display field from HDRFormats and Source.
@@ -285,6 +300,7 @@ func parseRevisionAndEdition(text string, parsed *ParsedMedia) {
 
 func parseLanguages(text string, parsed *ParsedMedia) {
 	parsed.Languages = parseutil.ParseLanguages(text)
+	parsed.SubtitleLanguages = parseutil.ParseSubtitleLanguages(text)
 }
 
 // parseFolderName parses a folder name for year/quality extraction only.
@@ -298,6 +314,11 @@ func parseFolderName(name string) *ParsedMedia {
 	return parseFallback(name)
 }
 
+// ParseFolderName parses a bare media folder name such as "The Matrix (1999)".
+func ParseFolderName(name string) *ParsedMedia {
+	return parseFolderName(name)
+}
+
 // parseNameViaModules tries to parse a bare name (no extension) via module FileParsers.
 // Adds a dummy extension so TryMatch can pass its IsVideoFile check.
 func parseNameViaModules(name string, reg ModuleParserRegistry) *ParsedMedia {
@@ -436,6 +457,9 @@ func inheritMetadataFields(dst, src *ParsedMedia) {
 	if len(dst.Languages) == 0 {
 		dst.Languages = src.Languages
 	}
+	if len(dst.SubtitleLanguages) == 0 {
+		dst.SubtitleLanguages = src.SubtitleLanguages
+	}
 }
 
 // ToReleaseAttributes converts ParsedMedia to quality.ReleaseAttributes for profile matching.
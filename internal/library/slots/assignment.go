@@ -344,8 +344,15 @@ func (s *Service) ListEnabledWithProfiles(ctx context.Context) ([]*SlotWithProfi
 	return slots, nil
 }
 
-// AssignFileToSlot assigns a file to a specific slot for a media item.
+// AssignFileToSlot assigns a file to a specific slot for a media item. The
+// slot becomes available, or upgradable when the file is below the slot
+// profile's cutoff.
 func (s *Service) AssignFileToSlot(ctx context.Context, mediaType string, mediaID, slotID, fileID int64) error {
+	var profileID *int64
+	if slot, err := s.Get(ctx, slotID); err == nil {
+		profileID = slot.QualityProfileID
+	}
+
 	switch mediaType {
 	case mediaTypeMovie:
 		_, err := s.queries.UpsertMovieSlotAssignment(ctx, sqlc.UpsertMovieSlotAssignmentParams{
@@ -353,6 +360,7 @@ func (s *Service) AssignFileToSlot(ctx context.Context, mediaType string, mediaI
 			SlotID:    slotID,
 			FileID:    sql.NullInt64{Int64: fileID, Valid: true},
 			Monitored: true,
+			Status:    s.slotFileStatus(ctx, profileID, s.getMovieFileQuality(ctx, fileID)),
 		})
 		if err != nil {
 			return err
@@ -369,6 +377,7 @@ func (s *Service) AssignFileToSlot(ctx context.Context, mediaType string, mediaI
 			SlotID:    slotID,
 			FileID:    sql.NullInt64{Int64: fileID, Valid: true},
 			Monitored: true,
+			Status:    s.slotFileStatus(ctx, profileID, s.getEpisodeFileQuality(ctx, fileID)),
 		})
 		if err != nil {
 			return err
@@ -383,16 +392,30 @@ func (s *Service) AssignFileToSlot(ctx context.Context, mediaType string, mediaI
 	return nil
 }
 
-// UnassignFileFromSlot removes a file from a slot.
+// UnassignFileFromSlot removes a file from a slot, leaving the slot missing.
 func (s *Service) UnassignFileFromSlot(ctx context.Context, mediaType string, mediaID, slotID int64) error {
 	switch mediaType {
 	case mediaTypeMovie:
-		return s.queries.ClearMovieSlotFile(ctx, sqlc.ClearMovieSlotFileParams{
+		if err := s.queries.ClearMovieSlotFile(ctx, sqlc.ClearMovieSlotFileParams{
+			MovieID: mediaID,
+			SlotID:  slotID,
+		}); err != nil {
+			return err
+		}
+		return s.queries.UpdateMovieSlotStatus(ctx, sqlc.UpdateMovieSlotStatusParams{
+			Status:  statusMissing,
 			MovieID: mediaID,
 			SlotID:  slotID,
 		})
 	case mediaTypeEpisode:
-		return s.queries.ClearEpisodeSlotFile(ctx, sqlc.ClearEpisodeSlotFileParams{
+		if err := s.queries.ClearEpisodeSlotFile(ctx, sqlc.ClearEpisodeSlotFileParams{
+			EpisodeID: mediaID,
+			SlotID:    slotID,
+		}); err != nil {
+			return err
+		}
+		return s.queries.UpdateEpisodeSlotStatus(ctx, sqlc.UpdateEpisodeSlotStatusParams{
+			Status:    statusMissing,
 			EpisodeID: mediaID,
 			SlotID:    slotID,
 		})
@@ -450,6 +473,38 @@ func (s *Service) GetEpisodeSlotAssignments(ctx context.Context, episodeID int64
 	return result, nil
 }
 
+// GetSlotForFile returns the slot a library file is assigned to, or nil when
+// the file is not assigned to any slot.
+func (s *Service) GetSlotForFile(ctx context.Context, mediaType string, fileID int64) (*Slot, error) {
+	fileRef := sql.NullInt64{Int64: fileID, Valid: true}
+
+	var slotID int64
+	switch mediaType {
+	case mediaTypeMovie:
+		assignment, err := s.queries.GetMovieSlotAssignmentByFileID(ctx, fileRef)
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		slotID = assignment.SlotID
+	case mediaTypeEpisode:
+		assignment, err := s.queries.GetEpisodeSlotAssignmentByFileID(ctx, fileRef)
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		slotID = assignment.SlotID
+	default:
+		return nil, nil
+	}
+
+	return s.Get(ctx, slotID)
+}
+
 // MovieSlotAssignmentRow represents a movie slot assignment with slot info.
 type MovieSlotAssignmentRow struct {
 	ID               int64
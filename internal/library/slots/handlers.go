@@ -91,7 +91,7 @@ func (h *Handlers) UpdateSettings(c echo.Context) error {
 
 	settings, err := h.service.UpdateSettings(c.Request().Context(), input)
 	if err != nil {
-		if errors.Is(err, ErrDryRunRequired) {
+		if errors.Is(err, ErrDryRunRequired) || errors.Is(err, ErrInvalidVersionNaming) {
 			return echo.NewHTTPError(http.StatusBadRequest, err.Error())
 		}
 		if errors.Is(err, ErrProfilesNotExclusive) || errors.Is(err, ErrMissingProfileForSlot) {
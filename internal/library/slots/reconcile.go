@@ -0,0 +1,330 @@
+package slots
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/slipstream/slipstream/internal/database/sqlc"
+	"github.com/slipstream/slipstream/internal/filesystem/mock"
+	"github.com/slipstream/slipstream/internal/library/quality"
+	"github.com/slipstream/slipstream/internal/library/scanner"
+)
+
+// SlotSearcher searches for a release to fill one slot of a movie or episode.
+// Reconciliation uses it to refill slots whose assignment was cleared.
+type SlotSearcher interface {
+	SearchMovieSlot(ctx context.Context, movieID, slotID int64) error
+	SearchEpisodeSlot(ctx context.Context, episodeID, slotID int64) error
+}
+
+// SetSlotSearcher sets the searcher used to refill cleared slots.
+func (s *Service) SetSlotSearcher(searcher SlotSearcher) {
+	s.slotSearcher = searcher
+}
+
+// Reconcile actions for a slot assignment.
+const (
+	ReconcileKeep   = "keep"
+	ReconcileClear  = "clear"  // File is gone; the slot is emptied and refilled
+	ReconcileReview = "review" // File no longer matches the slot profile; moved to the review queue
+	ReconcileRelink = "relink" // File's slot column disagrees with the assignment; repaired
+)
+
+const (
+	reconcileMsgNoRecord = "file record no longer exists"
+	reconcileMsgNoDisk   = "file missing on disk"
+	reconcileMsgOwner    = "file belongs to another item"
+	reconcileMsgProfile  = "file no longer matches slot profile"
+	reconcileMsgSlot     = "file slot does not match assignment"
+)
+
+// ReconcileIssue is one stale slot assignment found by reconciliation.
+type ReconcileIssue struct {
+	MediaType string `json:"mediaType"`
+	MediaID   int64  `json:"mediaId"`
+	SlotID    int64  `json:"slotId"`
+	FileID    int64  `json:"fileId"`
+	Path      string `json:"path,omitempty"`
+	Action    string `json:"action"`
+	Reason    string `json:"reason"`
+}
+
+// ReconcileResult summarizes a reconciliation run.
+type ReconcileResult struct {
+	Checked           int              `json:"checked"`
+	Cleared           int              `json:"cleared"`
+	Flagged           int              `json:"flagged"`
+	Relinked          int              `json:"relinked"`
+	SearchesTriggered int              `json:"searchesTriggered"`
+	Issues            []ReconcileIssue `json:"issues"`
+}
+
+// assignedFile is the file behind a slot assignment, nil when the record is gone.
+type assignedFile struct {
+	mediaID int64
+	path    string
+	slotID  sql.NullInt64
+}
+
+// classifyAssignment decides what to do with a slot assignment. exists reports
+// whether the file is on disk; profile is nil when the slot has none.
+func classifyAssignment(mediaID, slotID int64, file *assignedFile, exists func(string) bool, profile *quality.Profile) (action, reason string) {
+	switch {
+	case file == nil:
+		return ReconcileClear, reconcileMsgNoRecord
+	case file.mediaID != mediaID:
+		return ReconcileClear, reconcileMsgOwner
+	case !exists(file.path):
+		return ReconcileClear, reconcileMsgNoDisk
+	}
+
+	if profile != nil {
+		parsed := scanner.ParsePath(file.path)
+		if parsed == nil {
+			return ReconcileReview, reconcileMsgProfile
+		}
+		attrs := parsed.ToReleaseAttributes()
+		if !quality.MatchProfileAttributes(&attrs, profile).AllMatch {
+			return ReconcileReview, reconcileMsgProfile
+		}
+	}
+
+	if !file.slotID.Valid || file.slotID.Int64 != slotID {
+		return ReconcileRelink, reconcileMsgSlot
+	}
+	return ReconcileKeep, ""
+}
+
+// fileExistsOnDisk treats only a definite not-exist as missing so transient
+// stat errors, such as an unmounted share, do not clear assignments.
+func fileExistsOnDisk(path string) bool {
+	if mock.IsMockPath(path) {
+		return true
+	}
+	_, err := os.Stat(path)
+	return !os.IsNotExist(err)
+}
+
+// ReconcileAssignments verifies that every slot assignment points at an
+// existing file that still matches the slot profile. Assignments whose file is
+// gone are cleared and, when monitored, a refill search is started; files that
+// no longer match are moved to the review queue.
+func (s *Service) ReconcileAssignments(ctx context.Context) (*ReconcileResult, error) {
+	result := &ReconcileResult{Issues: []ReconcileIssue{}}
+	if !s.IsMultiVersionEnabled(ctx) {
+		return result, nil
+	}
+
+	slots, err := s.ListEnabled(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, slot := range slots {
+		var profile *quality.Profile
+		if slot.QualityProfileID != nil {
+			if profile, err = s.qualityService.Get(ctx, *slot.QualityProfileID); err != nil {
+				s.logger.Warn().Err(err).Int64("slotId", slot.ID).Msg("Failed to load slot profile for reconciliation")
+				profile = nil
+			}
+		}
+		if err := s.reconcileMovieSlot(ctx, slot.ID, profile, result); err != nil {
+			return nil, err
+		}
+		if err := s.reconcileEpisodeSlot(ctx, slot.ID, profile, result); err != nil {
+			return nil, err
+		}
+	}
+
+	s.logger.Info().
+		Int("checked", result.Checked).
+		Int("cleared", result.Cleared).
+		Int("flagged", result.Flagged).
+		Int("relinked", result.Relinked).
+		Int("searches", result.SearchesTriggered).
+		Msg("Slot assignment reconciliation complete")
+	return result, nil
+}
+
+func (s *Service) reconcileMovieSlot(ctx context.Context, slotID int64, profile *quality.Profile, result *ReconcileResult) error {
+	rows, err := s.queries.ListMovieSlotAssignmentsForSlot(ctx, slotID)
+	if err != nil {
+		return fmt.Errorf("failed to list movie slot assignments: %w", err)
+	}
+
+	for _, row := range rows {
+		if !row.FileID.Valid {
+			continue
+		}
+		result.Checked++
+
+		var file *assignedFile
+		mf, err := s.queries.GetMovieFile(ctx, row.FileID.Int64)
+		switch {
+		case err == nil:
+			file = &assignedFile{mediaID: mf.MovieID, path: mf.Path, slotID: mf.SlotID}
+		case !errors.Is(err, sql.ErrNoRows):
+			return fmt.Errorf("failed to get movie file: %w", err)
+		}
+
+		action, reason := classifyAssignment(row.MovieID, slotID, file, fileExistsOnDisk, profile)
+		if action == ReconcileKeep {
+			continue
+		}
+		issue := ReconcileIssue{MediaType: mediaTypeMovie, MediaID: row.MovieID, SlotID: slotID, FileID: row.FileID.Int64, Action: action, Reason: reason}
+		if file != nil {
+			issue.Path = file.path
+		}
+
+		switch action {
+		case ReconcileClear:
+			err = s.clearStaleMovieSlot(ctx, row.MovieID, slotID, file != nil && file.mediaID == row.MovieID, row.FileID.Int64, reason)
+		case ReconcileReview:
+			err = s.unassignFileByID(ctx, mediaTypeMovie, row.FileID.Int64)
+			if err == nil {
+				err = s.markMovieSlotMissing(ctx, row.MovieID, slotID, reason)
+			}
+		case ReconcileRelink:
+			_, err = s.queries.UpdateMovieFileSlot(ctx, sqlc.UpdateMovieFileSlotParams{SlotID: sql.NullInt64{Int64: slotID, Valid: true}, ID: row.FileID.Int64})
+		}
+		if err != nil {
+			s.logger.Warn().Err(err).Int64("movieId", row.MovieID).Int64("slotId", slotID).Msg("Failed to reconcile movie slot")
+			continue
+		}
+
+		s.recordReconcileIssue(result, &issue)
+		if action != ReconcileRelink && row.Monitored && s.slotSearcher != nil {
+			if err := s.slotSearcher.SearchMovieSlot(ctx, row.MovieID, slotID); err != nil {
+				s.logger.Warn().Err(err).Int64("movieId", row.MovieID).Int64("slotId", slotID).Msg("Refill search failed")
+			} else {
+				result.SearchesTriggered++
+			}
+		}
+	}
+	return nil
+}
+
+func (s *Service) reconcileEpisodeSlot(ctx context.Context, slotID int64, profile *quality.Profile, result *ReconcileResult) error {
+	rows, err := s.queries.ListEpisodeSlotAssignmentsForSlot(ctx, slotID)
+	if err != nil {
+		return fmt.Errorf("failed to list episode slot assignments: %w", err)
+	}
+
+	for _, row := range rows {
+		if !row.FileID.Valid {
+			continue
+		}
+		result.Checked++
+
+		var file *assignedFile
+		ef, err := s.queries.GetEpisodeFile(ctx, row.FileID.Int64)
+		switch {
+		case err == nil:
+			file = &assignedFile{mediaID: ef.EpisodeID, path: ef.Path, slotID: ef.SlotID}
+		case !errors.Is(err, sql.ErrNoRows):
+			return fmt.Errorf("failed to get episode file: %w", err)
+		}
+
+		action, reason := classifyAssignment(row.EpisodeID, slotID, file, fileExistsOnDisk, profile)
+		if action == ReconcileKeep {
+			continue
+		}
+		issue := ReconcileIssue{MediaType: mediaTypeEpisode, MediaID: row.EpisodeID, SlotID: slotID, FileID: row.FileID.Int64, Action: action, Reason: reason}
+		if file != nil {
+			issue.Path = file.path
+		}
+
+		switch action {
+		case ReconcileClear:
+			err = s.clearStaleEpisodeSlot(ctx, row.EpisodeID, slotID, file != nil && file.mediaID == row.EpisodeID, row.FileID.Int64, reason)
+		case ReconcileReview:
+			err = s.unassignFileByID(ctx, mediaTypeEpisode, row.FileID.Int64)
+			if err == nil {
+				err = s.markEpisodeSlotMissing(ctx, row.EpisodeID, slotID, reason)
+			}
+		case ReconcileRelink:
+			_, err = s.queries.UpdateEpisodeFileSlot(ctx, sqlc.UpdateEpisodeFileSlotParams{SlotID: sql.NullInt64{Int64: slotID, Valid: true}, ID: row.FileID.Int64})
+		}
+		if err != nil {
+			s.logger.Warn().Err(err).Int64("episodeId", row.EpisodeID).Int64("slotId", slotID).Msg("Failed to reconcile episode slot")
+			continue
+		}
+
+		s.recordReconcileIssue(result, &issue)
+		if action != ReconcileRelink && row.Monitored && s.slotSearcher != nil {
+			if err := s.slotSearcher.SearchEpisodeSlot(ctx, row.EpisodeID, slotID); err != nil {
+				s.logger.Warn().Err(err).Int64("episodeId", row.EpisodeID).Int64("slotId", slotID).Msg("Refill search failed")
+			} else {
+				result.SearchesTriggered++
+			}
+		}
+	}
+	return nil
+}
+
+func (s *Service) recordReconcileIssue(result *ReconcileResult, issue *ReconcileIssue) {
+	switch issue.Action {
+	case ReconcileClear:
+		result.Cleared++
+	case ReconcileReview:
+		result.Flagged++
+	case ReconcileRelink:
+		result.Relinked++
+	}
+	result.Issues = append(result.Issues, *issue)
+	s.logger.Info().
+		Str("mediaType", issue.MediaType).
+		Int64("mediaId", issue.MediaID).
+		Int64("slotId", issue.SlotID).
+		Int64("fileId", issue.FileID).
+		Str("action", issue.Action).
+		Str("reason", issue.Reason).
+		Msg("Reconciled stale slot assignment")
+}
+
+// clearStaleMovieSlot empties the slot. The file's own slot column is cleared
+// only when the file still belongs to the movie.
+func (s *Service) clearStaleMovieSlot(ctx context.Context, movieID, slotID int64, ownFile bool, fileID int64, reason string) error {
+	if err := s.queries.ClearMovieSlotFile(ctx, sqlc.ClearMovieSlotFileParams{MovieID: movieID, SlotID: slotID}); err != nil {
+		return err
+	}
+	if ownFile {
+		if err := s.queries.ClearMovieFileSlot(ctx, fileID); err != nil {
+			return err
+		}
+	}
+	return s.markMovieSlotMissing(ctx, movieID, slotID, reason)
+}
+
+func (s *Service) clearStaleEpisodeSlot(ctx context.Context, episodeID, slotID int64, ownFile bool, fileID int64, reason string) error {
+	if err := s.queries.ClearEpisodeSlotFile(ctx, sqlc.ClearEpisodeSlotFileParams{EpisodeID: episodeID, SlotID: slotID}); err != nil {
+		return err
+	}
+	if ownFile {
+		if err := s.queries.ClearEpisodeFileSlot(ctx, fileID); err != nil {
+			return err
+		}
+	}
+	return s.markEpisodeSlotMissing(ctx, episodeID, slotID, reason)
+}
+
+func (s *Service) markMovieSlotMissing(ctx context.Context, movieID, slotID int64, reason string) error {
+	return s.queries.UpdateMovieSlotStatusWithDetails(ctx, sqlc.UpdateMovieSlotStatusWithDetailsParams{
+		Status:        statusMissing,
+		StatusMessage: sql.NullString{String: reason, Valid: true},
+		MovieID:       movieID,
+		SlotID:        slotID,
+	})
+}
+
+func (s *Service) markEpisodeSlotMissing(ctx context.Context, episodeID, slotID int64, reason string) error {
+	return s.queries.UpdateEpisodeSlotStatusWithDetails(ctx, sqlc.UpdateEpisodeSlotStatusWithDetailsParams{
+		Status:        statusMissing,
+		StatusMessage: sql.NullString{String: reason, Valid: true},
+		EpisodeID:     episodeID,
+		SlotID:        slotID,
+	})
+}
@@ -0,0 +1,45 @@
+package slots
+
+import (
+	"database/sql"
+	"testing"
+
+	"github.com/slipstream/slipstream/internal/library/quality"
+)
+
+func TestClassifyAssignment(t *testing.T) {
+	dvProfile := &quality.Profile{
+		HDRSettings:          makeAttrSettingsWithMode(quality.AttributeModeRequired, []string{"DV"}),
+		VideoCodecSettings:   quality.DefaultAttributeSettings(),
+		AudioCodecSettings:   quality.DefaultAttributeSettings(),
+		AudioChannelSettings: quality.DefaultAttributeSettings(),
+	}
+	onDisk := func(string) bool { return true }
+	gone := func(string) bool { return false }
+	inSlot := sql.NullInt64{Int64: 2, Valid: true}
+	dvFile := "/movies/Dune (2021)/Dune.2021.2160p.UHD.BluRay.DV.HDR10.x265-GRP.mkv"
+	sdrFile := "/movies/Dune (2021)/Dune.2021.1080p.BluRay.x264-GRP.mkv"
+
+	tests := []struct {
+		name    string
+		file    *assignedFile
+		exists  func(string) bool
+		profile *quality.Profile
+		want    string
+	}{
+		{"record deleted", nil, onDisk, nil, ReconcileClear},
+		{"file of another item", &assignedFile{mediaID: 9, path: dvFile, slotID: inSlot}, onDisk, nil, ReconcileClear},
+		{"missing on disk", &assignedFile{mediaID: 1, path: dvFile, slotID: inSlot}, gone, dvProfile, ReconcileClear},
+		{"no longer matches profile", &assignedFile{mediaID: 1, path: sdrFile, slotID: inSlot}, onDisk, dvProfile, ReconcileReview},
+		{"file slot column drifted", &assignedFile{mediaID: 1, path: dvFile}, onDisk, dvProfile, ReconcileRelink},
+		{"healthy", &assignedFile{mediaID: 1, path: dvFile, slotID: inSlot}, onDisk, dvProfile, ReconcileKeep},
+		{"slot without profile", &assignedFile{mediaID: 1, path: sdrFile, slotID: inSlot}, onDisk, nil, ReconcileKeep},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got, reason := classifyAssignment(1, 2, tt.file, tt.exists, tt.profile); got != tt.want {
+				t.Errorf("classifyAssignment() = %s (%s), want %s", got, reason, tt.want)
+			}
+		})
+	}
+}
@@ -7,8 +7,10 @@ import (
 )
 
 const (
-	statusMissing    = "missing"
-	statusUpgradable = "upgradable"
+	statusMissing     = "missing"
+	statusUpgradable  = "upgradable"
+	statusAvailable   = "available"
+	statusDownloading = "downloading"
 )
 
 // SlotSearchInfo represents information about a slot needed for search operations.
@@ -27,7 +29,7 @@ type SlotSearchInfo struct {
 
 // HasFile returns true if this slot has a file assigned.
 func (s *SlotSearchInfo) HasFile() bool {
-	return s.Status == "available" || s.Status == statusUpgradable
+	return s.Status == statusAvailable || s.Status == statusUpgradable
 }
 
 // NeedsUpgrade returns true if this slot's file is below cutoff.
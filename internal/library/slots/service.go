@@ -21,6 +21,7 @@ var (
 	ErrDryRunRequired        = errors.New("dry-run preview is required before enabling multi-version")
 	ErrProfilesNotExclusive  = errors.New("assigned profiles are not mutually exclusive")
 	ErrMissingProfileForSlot = errors.New("enabled slot must have a quality profile assigned")
+	ErrInvalidVersionNaming  = errors.New("invalid version naming mode")
 )
 
 // FileDeleter deletes files from disk and database.
@@ -41,6 +42,7 @@ type Service struct {
 	rootFolderProvider RootFolderProvider
 	logger             zerolog.Logger
 	fileDeleter        FileDeleter
+	slotSearcher       SlotSearcher
 }
 
 // SetFileDeleter sets the file deleter for slot disable operations.
@@ -81,6 +83,10 @@ func (s *Service) UpdateSettings(ctx context.Context, input UpdateMultiVersionSe
 		return nil, err
 	}
 
+	if input.VersionNaming != nil && !input.VersionNaming.IsValid() {
+		return nil, ErrInvalidVersionNaming
+	}
+
 	// Req 1.2.3: Enabling requires completing dry-run first
 	if input.Enabled && !current.Enabled && !current.DryRunCompleted {
 		return nil, ErrDryRunRequired
@@ -98,7 +104,14 @@ func (s *Service) UpdateSettings(ctx context.Context, input UpdateMultiVersionSe
 		return nil, fmt.Errorf("failed to update multi-version settings: %w", err)
 	}
 
-	s.logger.Info().Bool("enabled", input.Enabled).Msg("Updated multi-version settings")
+	if input.VersionNaming != nil {
+		row, err = s.queries.SetMultiVersionNaming(ctx, string(*input.VersionNaming))
+		if err != nil {
+			return nil, fmt.Errorf("failed to update version naming: %w", err)
+		}
+	}
+
+	s.logger.Info().Bool("enabled", input.Enabled).Str("versionNaming", row.VersionNaming).Msg("Updated multi-version settings")
 
 	// Clear all import decisions — mode change invalidates all previous evaluations
 	if err := s.queries.CleanupAllImportDecisions(ctx); err != nil {
@@ -119,6 +132,16 @@ func (s *Service) IsMultiVersionEnabled(ctx context.Context) bool {
 	return settings.Enabled
 }
 
+// GetVersionNaming returns how slot versions are separated on disk.
+// It is always VersionNamingNone while multi-version is disabled.
+func (s *Service) GetVersionNaming(ctx context.Context) VersionNaming {
+	settings, err := s.GetSettings(ctx)
+	if err != nil || !settings.Enabled {
+		return VersionNamingNone
+	}
+	return settings.VersionNaming
+}
+
 // Get retrieves a slot by ID.
 func (s *Service) Get(ctx context.Context, id int64) (*Slot, error) {
 	row, err := s.queries.GetVersionSlot(ctx, id)
@@ -661,6 +684,7 @@ func (s *Service) rowToSettings(row *sqlc.MultiVersionSetting) *MultiVersionSett
 	settings := &MultiVersionSettings{
 		Enabled:         row.Enabled,
 		DryRunCompleted: row.DryRunCompleted,
+		VersionNaming:   VersionNaming(row.VersionNaming),
 	}
 
 	if row.LastMigrationAt.Valid {
@@ -49,11 +49,35 @@ type SlotRootFolderDetail struct {
 // Req 1.2.1: Global master toggle
 // Req 1.2.2: When disabled, system behaves as single-version
 type MultiVersionSettings struct {
-	Enabled         bool       `json:"enabled"`
-	DryRunCompleted bool       `json:"dryRunCompleted"`
-	LastMigrationAt *time.Time `json:"lastMigrationAt,omitempty"`
-	CreatedAt       time.Time  `json:"createdAt"`
-	UpdatedAt       time.Time  `json:"updatedAt"`
+	Enabled         bool          `json:"enabled"`
+	DryRunCompleted bool          `json:"dryRunCompleted"`
+	VersionNaming   VersionNaming `json:"versionNaming"`
+	LastMigrationAt *time.Time    `json:"lastMigrationAt,omitempty"`
+	CreatedAt       time.Time     `json:"createdAt"`
+	UpdatedAt       time.Time     `json:"updatedAt"`
+}
+
+// VersionNaming controls how files of different slots are kept apart on disk
+// so media servers group them as versions of the same item.
+type VersionNaming string
+
+const (
+	// VersionNamingNone relies on the naming format alone (e.g. {Slot Tag}).
+	VersionNamingNone VersionNaming = "none"
+	// VersionNamingSuffix appends " - [Slot Name]" to the filename.
+	VersionNamingSuffix VersionNaming = "suffix"
+	// VersionNamingSubfolder places each slot's file in a folder named after the slot.
+	VersionNamingSubfolder VersionNaming = "subfolder"
+)
+
+// IsValid reports whether the naming mode is one of the known modes.
+func (n VersionNaming) IsValid() bool {
+	switch n {
+	case VersionNamingNone, VersionNamingSuffix, VersionNamingSubfolder:
+		return true
+	default:
+		return false
+	}
 }
 
 // UpdateSlotInput is the input for updating a slot.
@@ -67,7 +91,8 @@ type UpdateSlotInput struct {
 
 // UpdateMultiVersionSettingsInput is the input for updating multi-version settings.
 type UpdateMultiVersionSettingsInput struct {
-	Enabled bool `json:"enabled"`
+	Enabled       bool           `json:"enabled"`
+	VersionNaming *VersionNaming `json:"versionNaming,omitempty"` // nil keeps the current mode
 }
 
 // SlotWithProfile extends Slot with full profile information for matching operations.
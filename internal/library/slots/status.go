@@ -75,8 +75,15 @@ func (s *Service) buildSlotStatus(ctx context.Context, slot *Slot, assignment *M
 			slotStatus.CurrentQuality = fileQuality.Quality
 			slotStatus.CurrentQualityID = &fileQuality.QualityID
 		}
+		if assignment.Status == "" || assignment.Status == statusMissing {
+			slotStatus.Status = s.slotFileStatus(ctx, slot.QualityProfileID, fileQuality)
+		}
 	} else {
 		mediaStatus.EmptySlots++
+		if assignment.Status == "" || assignment.Status == statusAvailable || assignment.Status == statusUpgradable {
+			// The file was deleted out from under the assignment
+			slotStatus.Status = statusMissing
+		}
 	}
 
 	if slotStatus.Monitored {
@@ -118,8 +125,15 @@ func (s *Service) buildEpisodeSlotStatus(ctx context.Context, slot *Slot, assign
 			slotStatus.CurrentQuality = fileQuality.Quality
 			slotStatus.CurrentQualityID = &fileQuality.QualityID
 		}
+		if assignment.Status == "" || assignment.Status == statusMissing {
+			slotStatus.Status = s.slotFileStatus(ctx, slot.QualityProfileID, fileQuality)
+		}
 	} else {
 		mediaStatus.EmptySlots++
+		if assignment.Status == "" || assignment.Status == statusAvailable || assignment.Status == statusUpgradable {
+			// The file was deleted out from under the assignment
+			slotStatus.Status = statusMissing
+		}
 	}
 
 	if slotStatus.Monitored {
@@ -315,6 +329,20 @@ func (s *Service) getEpisodeFileQuality(ctx context.Context, fileID int64) *file
 	}
 }
 
+// slotFileStatus returns the status of a slot holding a file of the given
+// quality: upgradable when it is below the slot profile's cutoff and the
+// profile allows upgrades, otherwise available.
+func (s *Service) slotFileStatus(ctx context.Context, profileID *int64, file *fileQualityInfo) string {
+	if profileID == nil || file == nil || s.qualityService == nil {
+		return statusAvailable
+	}
+	profile, err := s.qualityService.Get(ctx, *profileID)
+	if err != nil {
+		return statusAvailable
+	}
+	return profile.StatusForQuality(int(file.QualityID))
+}
+
 // InitializeSlotAssignments creates slot assignments for a media item if they don't exist.
 // This ensures all enabled slots have assignment rows for proper monitoring/status tracking.
 // initializeMovieSlot creates a slot assignment for a movie if it doesn't exist
@@ -1,6 +1,7 @@
 package slots
 
 import (
+	"context"
 	"testing"
 )
 
@@ -224,3 +225,50 @@ func TestPerSlotMonitoringIndependence(t *testing.T) {
 func intPtr(i int64) *int64 {
 	return &i
 }
+
+func TestBuildSlotStatus_EmptySlotDerivation(t *testing.T) {
+	svc := testService()
+	slot := &Slot{ID: 1, SlotNumber: 1, Name: "4K"}
+
+	tests := []struct {
+		name       string
+		assignment *MovieSlotAssignmentRow
+		expected   string
+	}{
+		{"no assignment", nil, statusMissing},
+		{"unset status", &MovieSlotAssignmentRow{Monitored: true}, statusMissing},
+		{"stale available", &MovieSlotAssignmentRow{Monitored: true, Status: statusAvailable}, statusMissing},
+		{"stale upgradable", &MovieSlotAssignmentRow{Monitored: true, Status: statusUpgradable}, statusMissing},
+		{"downloading kept", &MovieSlotAssignmentRow{Monitored: true, Status: statusDownloading}, statusDownloading},
+		{"failed kept", &MovieSlotAssignmentRow{Monitored: true, Status: "failed"}, "failed"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mediaStatus := &MediaStatus{}
+			got := svc.buildSlotStatus(context.Background(), slot, tt.assignment, mediaStatus)
+			if got.Status != tt.expected {
+				t.Errorf("Status = %q, want %q", got.Status, tt.expected)
+			}
+			if mediaStatus.EmptySlots != 1 {
+				t.Errorf("EmptySlots = %d, want 1", mediaStatus.EmptySlots)
+			}
+		})
+	}
+}
+
+func TestSlotFileStatus_FallsBackToAvailable(t *testing.T) {
+	svc := testService()
+	profileID := int64(1)
+	file := &fileQualityInfo{QualityID: 8, Quality: "WEBDL-1080p"}
+
+	if got := svc.slotFileStatus(context.Background(), nil, file); got != statusAvailable {
+		t.Errorf("no profile: got %q, want %q", got, statusAvailable)
+	}
+	if got := svc.slotFileStatus(context.Background(), &profileID, nil); got != statusAvailable {
+		t.Errorf("no file: got %q, want %q", got, statusAvailable)
+	}
+	if got := svc.slotFileStatus(context.Background(), &profileID, file); got != statusAvailable {
+		t.Errorf("no quality service: got %q, want %q", got, statusAvailable)
+	}
+}
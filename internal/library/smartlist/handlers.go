@@ -0,0 +1,150 @@
+package smartlist
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+)
+
+// Handlers provides HTTP handlers for smart list operations.
+type Handlers struct {
+	service *Service
+}
+
+// NewHandlers creates new smart list handlers.
+func NewHandlers(service *Service) *Handlers {
+	return &Handlers{service: service}
+}
+
+// RegisterRoutes registers the smart list routes.
+func (h *Handlers) RegisterRoutes(g *echo.Group) {
+	g.GET("", h.List)
+	g.POST("", h.Create)
+	g.GET("/:id", h.Get)
+	g.PUT("/:id", h.Update)
+	g.DELETE("/:id", h.Delete)
+	g.GET("/:id/members", h.Members)
+	g.PUT("/:id/monitor", h.Monitor)
+}
+
+// List returns all smart lists.
+// GET /api/v1/smartlists
+func (h *Handlers) List(c echo.Context) error {
+	lists, err := h.service.List(c.Request().Context())
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	return c.JSON(http.StatusOK, lists)
+}
+
+// Get returns a single smart list.
+// GET /api/v1/smartlists/:id
+func (h *Handlers) Get(c echo.Context) error {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid id")
+	}
+
+	list, err := h.service.Get(c.Request().Context(), id)
+	if err != nil {
+		return mapError(err)
+	}
+	return c.JSON(http.StatusOK, list)
+}
+
+// Create creates a new smart list.
+// POST /api/v1/smartlists
+func (h *Handlers) Create(c echo.Context) error {
+	var input Input
+	if err := c.Bind(&input); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	list, err := h.service.Create(c.Request().Context(), &input)
+	if err != nil {
+		return mapError(err)
+	}
+	return c.JSON(http.StatusCreated, list)
+}
+
+// Update updates an existing smart list.
+// PUT /api/v1/smartlists/:id
+func (h *Handlers) Update(c echo.Context) error {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid id")
+	}
+
+	var input Input
+	if err := c.Bind(&input); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	list, err := h.service.Update(c.Request().Context(), id, &input)
+	if err != nil {
+		return mapError(err)
+	}
+	return c.JSON(http.StatusOK, list)
+}
+
+// Delete deletes a smart list.
+// DELETE /api/v1/smartlists/:id
+func (h *Handlers) Delete(c echo.Context) error {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid id")
+	}
+
+	if err := h.service.Delete(c.Request().Context(), id); err != nil {
+		return mapError(err)
+	}
+	return c.NoContent(http.StatusNoContent)
+}
+
+// Members returns the movies or series currently matching the smart list.
+// GET /api/v1/smartlists/:id/members
+func (h *Handlers) Members(c echo.Context) error {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid id")
+	}
+
+	members, err := h.service.Members(c.Request().Context(), id)
+	if err != nil {
+		return mapError(err)
+	}
+	return c.JSON(http.StatusOK, members)
+}
+
+// Monitor monitors or unmonitors every current member of the smart list.
+// PUT /api/v1/smartlists/:id/monitor
+func (h *Handlers) Monitor(c echo.Context) error {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid id")
+	}
+
+	var input MonitorInput
+	if err := c.Bind(&input); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	updated, err := h.service.SetMonitored(c.Request().Context(), id, input.Monitored)
+	if err != nil {
+		return mapError(err)
+	}
+	return c.JSON(http.StatusOK, map[string]int{"updated": updated})
+}
+
+func mapError(err error) error {
+	switch {
+	case errors.Is(err, ErrSmartListNotFound):
+		return echo.NewHTTPError(http.StatusNotFound, err.Error())
+	case errors.Is(err, ErrInvalidSmartList):
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	default:
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+}
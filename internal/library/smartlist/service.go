@@ -0,0 +1,207 @@
+package smartlist
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"net/url"
+
+	"github.com/rs/zerolog"
+
+	"github.com/slipstream/slipstream/internal/database/sqlc"
+	"github.com/slipstream/slipstream/internal/library/movies"
+	"github.com/slipstream/slipstream/internal/library/tv"
+)
+
+var (
+	ErrSmartListNotFound = errors.New("smart list not found")
+	ErrInvalidSmartList  = errors.New("invalid smart list")
+)
+
+// Service provides smart list operations.
+type Service struct {
+	queries *sqlc.Queries
+	movies  *movies.Service
+	tv      *tv.Service
+	logger  *zerolog.Logger
+}
+
+// NewService creates a new smart list service.
+func NewService(db *sql.DB, movieService *movies.Service, tvService *tv.Service, logger *zerolog.Logger) *Service {
+	subLogger := logger.With().Str("component", "smartlist").Logger()
+	return &Service{
+		queries: sqlc.New(db),
+		movies:  movieService,
+		tv:      tvService,
+		logger:  &subLogger,
+	}
+}
+
+// SetDB updates the database connection used by this service.
+func (s *Service) SetDB(db *sql.DB) {
+	s.queries = sqlc.New(db)
+}
+
+// Get retrieves a smart list by ID.
+func (s *Service) Get(ctx context.Context, id int64) (*SmartList, error) {
+	row, err := s.queries.GetSmartList(ctx, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrSmartListNotFound
+		}
+		return nil, fmt.Errorf("failed to get smart list: %w", err)
+	}
+	return rowToSmartList(row), nil
+}
+
+// List returns all smart lists.
+func (s *Service) List(ctx context.Context) ([]*SmartList, error) {
+	rows, err := s.queries.ListSmartLists(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list smart lists: %w", err)
+	}
+	lists := make([]*SmartList, len(rows))
+	for i, row := range rows {
+		lists[i] = rowToSmartList(row)
+	}
+	return lists, nil
+}
+
+// Create creates a new smart list.
+func (s *Service) Create(ctx context.Context, input *Input) (*SmartList, error) {
+	if err := input.Validate(); err != nil {
+		return nil, err
+	}
+	row, err := s.queries.CreateSmartList(ctx, sqlc.CreateSmartListParams{
+		Name:      input.Name,
+		MediaType: input.MediaType,
+		Filter:    input.Filter,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create smart list: %w", err)
+	}
+
+	s.logger.Info().Int64("id", row.ID).Str("name", input.Name).Msg("Created smart list")
+	return rowToSmartList(row), nil
+}
+
+// Update updates an existing smart list.
+func (s *Service) Update(ctx context.Context, id int64, input *Input) (*SmartList, error) {
+	if err := input.Validate(); err != nil {
+		return nil, err
+	}
+	row, err := s.queries.UpdateSmartList(ctx, sqlc.UpdateSmartListParams{
+		Name:      input.Name,
+		MediaType: input.MediaType,
+		Filter:    input.Filter,
+		ID:        id,
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrSmartListNotFound
+		}
+		return nil, fmt.Errorf("failed to update smart list: %w", err)
+	}
+
+	s.logger.Info().Int64("id", id).Str("name", input.Name).Msg("Updated smart list")
+	return rowToSmartList(row), nil
+}
+
+// Delete deletes a smart list.
+func (s *Service) Delete(ctx context.Context, id int64) error {
+	if _, err := s.Get(ctx, id); err != nil {
+		return err
+	}
+	if err := s.queries.DeleteSmartList(ctx, id); err != nil {
+		return fmt.Errorf("failed to delete smart list: %w", err)
+	}
+	s.logger.Info().Int64("id", id).Msg("Deleted smart list")
+	return nil
+}
+
+// Members evaluates the smart list's filter against the current library.
+func (s *Service) Members(ctx context.Context, id int64) (*Members, error) {
+	list, err := s.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	q, err := url.ParseQuery(list.Filter)
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid filter: %w", ErrInvalidSmartList, err)
+	}
+
+	members := &Members{
+		MediaType: list.MediaType,
+		Movies:    []*movies.Movie{},
+		Series:    []*tv.Series{},
+	}
+	if list.MediaType == MediaTypeMovie {
+		opts, err := movies.ParseListOptions(q)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrInvalidSmartList, err)
+		}
+		if members.Movies, err = s.movies.List(ctx, opts); err != nil {
+			return nil, err
+		}
+		return members, nil
+	}
+
+	opts, err := tv.ParseListOptions(q)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidSmartList, err)
+	}
+	if members.Series, err = s.tv.ListSeries(ctx, opts); err != nil {
+		return nil, err
+	}
+	return members, nil
+}
+
+// MemberIDs returns the media type and IDs of the smart list's current members.
+func (s *Service) MemberIDs(ctx context.Context, id int64) (mediaType string, ids []int64, err error) {
+	members, err := s.Members(ctx, id)
+	if err != nil {
+		return "", nil, err
+	}
+	return members.MediaType, members.IDs(), nil
+}
+
+// SetMonitored monitors or unmonitors every current member. Returns the number of items updated.
+func (s *Service) SetMonitored(ctx context.Context, id int64, monitored bool) (int, error) {
+	members, err := s.Members(ctx, id)
+	if err != nil {
+		return 0, err
+	}
+	ids := members.IDs()
+	if len(ids) == 0 {
+		return 0, nil
+	}
+
+	if members.MediaType == MediaTypeMovie {
+		err = s.movies.BulkUpdateMonitored(ctx, movies.BulkMonitorInput{IDs: ids, Monitored: monitored})
+	} else {
+		err = s.tv.BulkUpdateSeriesMonitored(ctx, tv.BulkSeriesMonitorInput{IDs: ids, Monitored: monitored})
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	s.logger.Info().Int64("id", id).Int("count", len(ids)).Bool("monitored", monitored).Msg("Updated smart list members")
+	return len(ids), nil
+}
+
+func rowToSmartList(row *sqlc.SmartList) *SmartList {
+	l := &SmartList{
+		ID:        row.ID,
+		Name:      row.Name,
+		MediaType: row.MediaType,
+		Filter:    row.Filter,
+	}
+	if row.CreatedAt.Valid {
+		l.CreatedAt = row.CreatedAt.Time
+	}
+	if row.UpdatedAt.Valid {
+		l.UpdatedAt = row.UpdatedAt.Time
+	}
+	return l
+}
@@ -0,0 +1,118 @@
+package smartlist
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/slipstream/slipstream/internal/database/sqlc"
+	"github.com/slipstream/slipstream/internal/library/movies"
+	"github.com/slipstream/slipstream/internal/library/tv"
+	"github.com/slipstream/slipstream/internal/testutil"
+)
+
+func newTestService(t *testing.T) (*Service, *sqlc.Queries) {
+	t.Helper()
+	tdb := testutil.NewTestDB(t)
+	t.Cleanup(tdb.Close)
+
+	movieService := movies.NewService(tdb.Conn, nil, &tdb.Logger, nil, nil)
+	tvService := tv.NewService(tdb.Conn, nil, &tdb.Logger, nil, nil)
+	return NewService(tdb.Conn, movieService, tvService, &tdb.Logger), sqlc.New(tdb.Conn)
+}
+
+func TestInput_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   Input
+		wantErr bool
+	}{
+		{"movie filter", Input{Name: "Missing", MediaType: MediaTypeMovie, Filter: "status=missing&monitored=true"}, false},
+		{"series filter", Input{Name: "Ended incomplete", MediaType: MediaTypeSeries, Filter: "productionStatus=ended&missing=true"}, false},
+		{"empty filter", Input{Name: "Everything", MediaType: MediaTypeMovie}, false},
+		{"missing name", Input{MediaType: MediaTypeMovie}, true},
+		{"unknown media type", Input{Name: "x", MediaType: "episode"}, true},
+		{"unknown key", Input{Name: "x", MediaType: MediaTypeMovie, Filter: "productionStatus=ended"}, true},
+		{"bad value", Input{Name: "x", MediaType: MediaTypeSeries, Filter: "qualityProfileId=abc"}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.input.Validate()
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil && !errors.Is(err, ErrInvalidSmartList) {
+				t.Errorf("Validate() error = %v, want ErrInvalidSmartList", err)
+			}
+		})
+	}
+}
+
+func TestService_MembersAndMonitor(t *testing.T) {
+	svc, queries := newTestService(t)
+	ctx := context.Background()
+
+	create := func(title, status string, monitored bool) int64 {
+		m, err := queries.CreateMovie(ctx, sqlc.CreateMovieParams{
+			Title:     title,
+			SortTitle: title,
+			Status:    status,
+			Monitored: monitored,
+		})
+		if err != nil {
+			t.Fatalf("CreateMovie() error = %v", err)
+		}
+		return m.ID
+	}
+	dune := create("Dune", "missing", true)
+	create("Heat", "available", true)
+	create("Alien", "missing", false)
+
+	list, err := svc.Create(ctx, &Input{Name: "Wanted", MediaType: MediaTypeMovie, Filter: "status=missing&monitored=true"})
+	if err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+
+	mediaType, ids, err := svc.MemberIDs(ctx, list.ID)
+	if err != nil {
+		t.Fatalf("MemberIDs() error = %v", err)
+	}
+	if mediaType != MediaTypeMovie || len(ids) != 1 || ids[0] != dune {
+		t.Fatalf("MemberIDs() = %s %v, want movie [%d]", mediaType, ids, dune)
+	}
+
+	updated, err := svc.SetMonitored(ctx, list.ID, false)
+	if err != nil {
+		t.Fatalf("SetMonitored() error = %v", err)
+	}
+	if updated != 1 {
+		t.Errorf("SetMonitored() updated %d, want 1", updated)
+	}
+
+	// Membership is re-evaluated on every call, so the unmonitored movie drops out
+	_, ids, err = svc.MemberIDs(ctx, list.ID)
+	if err != nil {
+		t.Fatalf("MemberIDs() error = %v", err)
+	}
+	if len(ids) != 0 {
+		t.Errorf("MemberIDs() after unmonitor = %v, want none", ids)
+	}
+
+	if _, err := svc.Update(ctx, list.ID, &Input{Name: "Unwanted", MediaType: MediaTypeMovie, Filter: "status=missing&monitored=false"}); err != nil {
+		t.Fatalf("Update() error = %v", err)
+	}
+	_, ids, err = svc.MemberIDs(ctx, list.ID)
+	if err != nil {
+		t.Fatalf("MemberIDs() error = %v", err)
+	}
+	if len(ids) != 2 {
+		t.Errorf("MemberIDs() after update = %v, want both missing movies", ids)
+	}
+
+	if err := svc.Delete(ctx, list.ID); err != nil {
+		t.Fatalf("Delete() error = %v", err)
+	}
+	if _, err := svc.Members(ctx, list.ID); !errors.Is(err, ErrSmartListNotFound) {
+		t.Errorf("Members() after delete error = %v, want ErrSmartListNotFound", err)
+	}
+}
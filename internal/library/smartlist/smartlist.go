@@ -0,0 +1,87 @@
+// Package smartlist manages saved library filters whose membership is evaluated on demand.
+package smartlist
+
+import (
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/slipstream/slipstream/internal/library/movies"
+	"github.com/slipstream/slipstream/internal/library/tv"
+)
+
+const (
+	MediaTypeMovie  = "movie"
+	MediaTypeSeries = "series"
+)
+
+// SmartList is a named filter over movies or series. Filter uses the query grammar of
+// the matching list endpoint (GET /movies or GET /series), so membership changes as
+// the library does.
+type SmartList struct {
+	ID        int64     `json:"id"`
+	Name      string    `json:"name"`
+	MediaType string    `json:"mediaType"`
+	Filter    string    `json:"filter"`
+	CreatedAt time.Time `json:"createdAt"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// Input is the request body for creating or updating a smart list.
+type Input struct {
+	Name      string `json:"name"`
+	MediaType string `json:"mediaType"`
+	Filter    string `json:"filter"`
+}
+
+// Validate checks the input and returns ErrInvalidSmartList on failure.
+func (in *Input) Validate() error {
+	if in.Name == "" {
+		return fmt.Errorf("%w: name is required", ErrInvalidSmartList)
+	}
+	if in.MediaType != MediaTypeMovie && in.MediaType != MediaTypeSeries {
+		return fmt.Errorf("%w: mediaType must be %q or %q", ErrInvalidSmartList, MediaTypeMovie, MediaTypeSeries)
+	}
+	if err := validateFilter(in.MediaType, in.Filter); err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidSmartList, err)
+	}
+	return nil
+}
+
+func validateFilter(mediaType, filter string) error {
+	q, err := url.ParseQuery(filter)
+	if err != nil {
+		return fmt.Errorf("invalid filter: %w", err)
+	}
+	if mediaType == MediaTypeMovie {
+		_, err = movies.ParseListOptions(q)
+	} else {
+		_, err = tv.ParseListOptions(q)
+	}
+	return err
+}
+
+// Members is the current membership of a smart list. Only the slice matching
+// MediaType is populated.
+type Members struct {
+	MediaType string          `json:"mediaType"`
+	Movies    []*movies.Movie `json:"movies"`
+	Series    []*tv.Series    `json:"series"`
+}
+
+// IDs returns the IDs of the current members.
+func (m *Members) IDs() []int64 {
+	ids := make([]int64, 0, len(m.Movies)+len(m.Series))
+	for _, movie := range m.Movies {
+		ids = append(ids, movie.ID)
+	}
+	for _, series := range m.Series {
+		ids = append(ids, series.ID)
+	}
+	return ids
+}
+
+// MonitorInput is the request body for the bulk monitor action.
+type MonitorInput struct {
+	Monitored bool `json:"monitored"`
+}
@@ -0,0 +1,59 @@
+package status
+
+import (
+	"database/sql"
+	"time"
+)
+
+// Minimum availability levels: how far along its release a movie or series
+// must be before automatic searches look for it.
+const (
+	AvailabilityAnnounced = "announced"  // search as soon as the item is added
+	AvailabilityInCinemas = "in_cinemas" // theatrical release
+	AvailabilityReleased  = "released"   // digital or physical release
+	AvailabilityPhysical  = "physical"   // physical (Blu-ray) release
+
+	DefaultAvailability = AvailabilityReleased
+)
+
+// theatricalWindow is how long after a theatrical release a movie with no
+// digital or physical date is assumed to be released.
+const theatricalWindow = 90 * 24 * time.Hour
+
+// ValidAvailability reports whether level is a known minimum availability.
+func ValidAvailability(level string) bool {
+	switch level {
+	case AvailabilityAnnounced, AvailabilityInCinemas, AvailabilityReleased, AvailabilityPhysical:
+		return true
+	}
+	return false
+}
+
+// MovieAvailable reports whether a movie with the given release dates has
+// reached level by now. When TMDB has no physical date, the physical level
+// falls back to the released check so older movies stay searchable.
+func MovieAvailable(level string, theatrical, digital, physical sql.NullTime, now time.Time) bool {
+	passed := func(t sql.NullTime) bool { return t.Valid && !t.Time.After(now) }
+	released := passed(digital) || passed(physical) ||
+		(theatrical.Valid && !theatrical.Time.Add(theatricalWindow).After(now))
+
+	switch level {
+	case AvailabilityAnnounced:
+		return true
+	case AvailabilityInCinemas:
+		return passed(theatrical) || released
+	case AvailabilityPhysical:
+		if physical.Valid {
+			return passed(physical)
+		}
+		return released
+	default:
+		return released
+	}
+}
+
+// EpisodeAvailable reports whether an episode has reached level. Episodes have
+// a single air date, so every level other than announced means aired.
+func EpisodeAvailable(level, episodeStatus string) bool {
+	return level == AvailabilityAnnounced || episodeStatus != Unreleased
+}
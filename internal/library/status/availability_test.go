@@ -0,0 +1,49 @@
+package status
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+)
+
+func TestMovieAvailable(t *testing.T) {
+	now := time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC)
+	at := func(days int) sql.NullTime { return sql.NullTime{Time: now.AddDate(0, 0, days), Valid: true} }
+	none := sql.NullTime{}
+
+	tests := []struct {
+		name                          string
+		level                         string
+		theatrical, digital, physical sql.NullTime
+		want                          bool
+	}{
+		{"announced without dates", AvailabilityAnnounced, none, none, none, true},
+		{"in cinemas before theatrical", AvailabilityInCinemas, at(5), none, none, false},
+		{"in cinemas after theatrical", AvailabilityInCinemas, at(-5), none, none, true},
+		{"released while only in cinemas", AvailabilityReleased, at(-5), at(30), none, false},
+		{"released after digital", AvailabilityReleased, at(-60), at(-1), none, true},
+		{"released after theatrical window", AvailabilityReleased, at(-91), none, none, true},
+		{"physical before physical date", AvailabilityPhysical, at(-60), at(-1), at(10), false},
+		{"physical after physical date", AvailabilityPhysical, at(-60), at(-30), at(-1), true},
+		{"physical without physical date", AvailabilityPhysical, at(-60), at(-1), none, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := MovieAvailable(tt.level, tt.theatrical, tt.digital, tt.physical, now); got != tt.want {
+				t.Errorf("MovieAvailable() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEpisodeAvailable(t *testing.T) {
+	if !EpisodeAvailable(AvailabilityAnnounced, Unreleased) {
+		t.Error("announced level should allow unreleased episodes")
+	}
+	if EpisodeAvailable(AvailabilityReleased, Unreleased) {
+		t.Error("released level should skip unreleased episodes")
+	}
+	if !EpisodeAvailable(AvailabilityPhysical, Missing) {
+		t.Error("aired episodes should be available at every level")
+	}
+}
@@ -0,0 +1,194 @@
+package tv
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/slipstream/slipstream/internal/database/sqlc"
+	"github.com/slipstream/slipstream/internal/module"
+)
+
+// CompletenessReport is a per-season grid of episode availability for a series.
+type CompletenessReport struct {
+	SeriesID int64                `json:"seriesId"`
+	Title    string               `json:"title"`
+	Totals   StatusCounts         `json:"totals"`
+	Seasons  []SeasonCompleteness `json:"seasons"`
+}
+
+// SeasonCompleteness holds the episode cells and status counts for one season.
+type SeasonCompleteness struct {
+	SeasonNumber int                   `json:"seasonNumber"`
+	Monitored    bool                  `json:"monitored"`
+	Counts       StatusCounts          `json:"counts"`
+	Episodes     []CompletenessEpisode `json:"episodes"`
+}
+
+// CompletenessEpisode is a single cell in the completeness grid.
+type CompletenessEpisode struct {
+	ID            int64             `json:"id"`
+	EpisodeNumber int               `json:"episodeNumber"`
+	Title         string            `json:"title"`
+	AirDate       *time.Time        `json:"airDate,omitempty"`
+	Monitored     bool              `json:"monitored"`
+	Status        string            `json:"status"`
+	File          *CompletenessFile `json:"file,omitempty"`
+}
+
+// CompletenessFile describes the best file held for an episode.
+type CompletenessFile struct {
+	ID         int64  `json:"id"`
+	Quality    string `json:"quality,omitempty"`
+	QualityID  *int64 `json:"qualityId,omitempty"`
+	Resolution string `json:"resolution,omitempty"`
+	Size       int64  `json:"size"`
+}
+
+// SeriesGaps lists seasons of a series that have some aired episodes on disk
+// but are missing others.
+type SeriesGaps struct {
+	SeriesID int64       `json:"seriesId"`
+	Title    string      `json:"title"`
+	Seasons  []SeasonGap `json:"seasons"`
+}
+
+// SeasonGap lists the aired episodes missing from an otherwise populated season.
+type SeasonGap struct {
+	SeasonNumber    int     `json:"seasonNumber"`
+	AiredEpisodes   int     `json:"airedEpisodes"`
+	MissingEpisodes []int   `json:"missingEpisodes"`
+	EpisodeIDs      []int64 `json:"episodeIds"`
+}
+
+// GetCompletenessReport builds the per-season availability grid for a series
+// from a single episode query.
+func (s *Service) GetCompletenessReport(ctx context.Context, seriesID int64) (*CompletenessReport, error) {
+	series, err := s.Queries.GetSeries(ctx, seriesID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrSeriesNotFound
+		}
+		return nil, fmt.Errorf("failed to get series: %w", err)
+	}
+
+	seasonRows, err := s.Queries.ListSeasonsBySeries(ctx, seriesID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list seasons: %w", err)
+	}
+
+	rows, err := s.Queries.ListSeriesCompletenessEpisodes(ctx, seriesID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list episodes: %w", err)
+	}
+
+	report := &CompletenessReport{
+		SeriesID: series.ID,
+		Title:    series.Title,
+		Seasons:  make([]SeasonCompleteness, 0, len(seasonRows)),
+	}
+
+	seasonIndex := make(map[int64]int, len(seasonRows))
+	for _, row := range seasonRows {
+		seasonIndex[row.SeasonNumber] = len(report.Seasons)
+		report.Seasons = append(report.Seasons, SeasonCompleteness{
+			SeasonNumber: int(row.SeasonNumber),
+			Monitored:    row.Monitored,
+			Episodes:     []CompletenessEpisode{},
+		})
+	}
+
+	for _, row := range rows {
+		idx, ok := seasonIndex[row.SeasonNumber]
+		if !ok {
+			idx = len(report.Seasons)
+			seasonIndex[row.SeasonNumber] = idx
+			report.Seasons = append(report.Seasons, SeasonCompleteness{
+				SeasonNumber: int(row.SeasonNumber),
+				Episodes:     []CompletenessEpisode{},
+			})
+		}
+		season := &report.Seasons[idx]
+		season.Episodes = append(season.Episodes, completenessRowToEpisode(row))
+		addStatusCount(&season.Counts, row.Status)
+		addStatusCount(&report.Totals, row.Status)
+	}
+
+	return report, nil
+}
+
+// ListSeasonGaps returns every series with aired episodes missing from seasons
+// that otherwise have files on disk. Specials are excluded.
+func (s *Service) ListSeasonGaps(ctx context.Context) ([]SeriesGaps, error) {
+	rows, err := s.Queries.ListSeasonGapEpisodes(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list season gaps: %w", err)
+	}
+
+	result := []SeriesGaps{}
+	for _, row := range rows {
+		if len(result) == 0 || result[len(result)-1].SeriesID != row.SeriesID {
+			result = append(result, SeriesGaps{SeriesID: row.SeriesID, Title: row.SeriesTitle})
+		}
+		series := &result[len(result)-1]
+
+		if len(series.Seasons) == 0 || series.Seasons[len(series.Seasons)-1].SeasonNumber != int(row.SeasonNumber) {
+			series.Seasons = append(series.Seasons, SeasonGap{
+				SeasonNumber:  int(row.SeasonNumber),
+				AiredEpisodes: int(row.AiredEpisodes),
+			})
+		}
+		season := &series.Seasons[len(series.Seasons)-1]
+		season.MissingEpisodes = append(season.MissingEpisodes, int(row.EpisodeNumber))
+		season.EpisodeIDs = append(season.EpisodeIDs, row.EpisodeID)
+	}
+	return result, nil
+}
+
+func completenessRowToEpisode(row *sqlc.ListSeriesCompletenessEpisodesRow) CompletenessEpisode {
+	ep := CompletenessEpisode{
+		ID:            row.ID,
+		EpisodeNumber: int(row.EpisodeNumber),
+		Monitored:     row.Monitored,
+		Status:        row.Status,
+	}
+	if row.Title.Valid {
+		ep.Title = row.Title.String
+	}
+	if row.AirDate.Valid {
+		ep.AirDate = &row.AirDate.Time
+	}
+	if row.FileID.Valid {
+		file := &CompletenessFile{
+			ID:         row.FileID.Int64,
+			Quality:    row.Quality.String,
+			Resolution: row.Resolution.String,
+			Size:       row.Size.Int64,
+		}
+		if row.QualityID.Valid {
+			file.QualityID = &row.QualityID.Int64
+		}
+		ep.File = file
+	}
+	return ep
+}
+
+func addStatusCount(counts *StatusCounts, status string) {
+	counts.Total++
+	switch status {
+	case module.StatusUnreleased:
+		counts.Unreleased++
+	case module.StatusMissing:
+		counts.Missing++
+	case module.StatusDownloading:
+		counts.Downloading++
+	case module.StatusFailed:
+		counts.Failed++
+	case module.StatusUpgradable:
+		counts.Upgradable++
+	case module.StatusAvailable:
+		counts.Available++
+	}
+}
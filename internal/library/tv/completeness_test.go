@@ -0,0 +1,134 @@
+package tv
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/slipstream/slipstream/internal/testutil"
+)
+
+func createCompletenessSeries(t *testing.T, service *Service, title string, tvdbID int) (*Series, []Episode) {
+	t.Helper()
+	ctx := context.Background()
+	aired := time.Now().AddDate(-1, 0, 0)
+
+	episodes := func(n int) []EpisodeInput {
+		eps := make([]EpisodeInput, n)
+		for i := range eps {
+			eps[i] = EpisodeInput{EpisodeNumber: i + 1, Title: fmt.Sprintf("Episode %d", i+1), AirDate: &aired, Monitored: true}
+		}
+		return eps
+	}
+
+	series, err := service.CreateSeries(ctx, &CreateSeriesInput{
+		Title:     title,
+		TvdbID:    tvdbID,
+		Monitored: true,
+		Seasons: []SeasonInput{
+			{SeasonNumber: 1, Monitored: true, Episodes: episodes(4)},
+			{SeasonNumber: 2, Monitored: true, Episodes: episodes(2)},
+		},
+	})
+	if err != nil {
+		t.Fatalf("CreateSeries() error = %v", err)
+	}
+
+	all, err := service.ListEpisodes(ctx, series.ID, nil)
+	if err != nil {
+		t.Fatalf("ListEpisodes() error = %v", err)
+	}
+	return series, all
+}
+
+func addTestEpisodeFile(t *testing.T, service *Service, ep *Episode) {
+	t.Helper()
+	_, err := service.AddEpisodeFile(context.Background(), ep.ID, &CreateEpisodeFileInput{
+		Path:       fmt.Sprintf("/tv/S%02dE%02d.mkv", ep.SeasonNumber, ep.EpisodeNumber),
+		Size:       1000,
+		Quality:    "WEBDL-1080p",
+		Resolution: "1080p",
+	})
+	if err != nil {
+		t.Fatalf("AddEpisodeFile() error = %v", err)
+	}
+}
+
+func TestTVService_GetCompletenessReport(t *testing.T) {
+	tdb := testutil.NewTestDB(t)
+	defer tdb.Close()
+
+	service := NewService(tdb.Conn, nil, &tdb.Logger, nil, nil)
+	ctx := context.Background()
+
+	series, episodes := createCompletenessSeries(t, service, "Gap Show", 1001)
+	addTestEpisodeFile(t, service, &episodes[0])
+	addTestEpisodeFile(t, service, &episodes[2])
+
+	report, err := service.GetCompletenessReport(ctx, series.ID)
+	if err != nil {
+		t.Fatalf("GetCompletenessReport() error = %v", err)
+	}
+
+	if len(report.Seasons) != 2 {
+		t.Fatalf("Seasons = %d, want 2", len(report.Seasons))
+	}
+	s1 := report.Seasons[0]
+	if s1.SeasonNumber != 1 || len(s1.Episodes) != 4 {
+		t.Fatalf("season 1 = %d with %d episodes, want 1 with 4", s1.SeasonNumber, len(s1.Episodes))
+	}
+	if s1.Counts.Total != 4 || s1.Counts.Available != 2 || s1.Counts.Missing != 2 {
+		t.Errorf("season 1 counts = %+v, want total 4, available 2, missing 2", s1.Counts)
+	}
+	if s1.Episodes[0].File == nil || s1.Episodes[0].File.Quality != "WEBDL-1080p" {
+		t.Errorf("E01 file = %+v, want WEBDL-1080p", s1.Episodes[0].File)
+	}
+	if s1.Episodes[1].File != nil {
+		t.Errorf("E02 file = %+v, want nil", s1.Episodes[1].File)
+	}
+	if report.Totals.Total != 6 || report.Totals.Available != 2 {
+		t.Errorf("totals = %+v, want total 6, available 2", report.Totals)
+	}
+
+	if _, err := service.GetCompletenessReport(ctx, 9999); !errors.Is(err, ErrSeriesNotFound) {
+		t.Errorf("GetCompletenessReport(missing) error = %v, want ErrSeriesNotFound", err)
+	}
+}
+
+func TestTVService_ListSeasonGaps(t *testing.T) {
+	tdb := testutil.NewTestDB(t)
+	defer tdb.Close()
+
+	service := NewService(tdb.Conn, nil, &tdb.Logger, nil, nil)
+	ctx := context.Background()
+
+	gapSeries, gapEpisodes := createCompletenessSeries(t, service, "Gap Show", 1001)
+	addTestEpisodeFile(t, service, &gapEpisodes[0])
+	addTestEpisodeFile(t, service, &gapEpisodes[2])
+
+	// A series without any files has no gaps, only wholly missing seasons.
+	createCompletenessSeries(t, service, "Empty Show", 1002)
+
+	gaps, err := service.ListSeasonGaps(ctx)
+	if err != nil {
+		t.Fatalf("ListSeasonGaps() error = %v", err)
+	}
+	if len(gaps) != 1 {
+		t.Fatalf("ListSeasonGaps() returned %d series, want 1", len(gaps))
+	}
+	if gaps[0].SeriesID != gapSeries.ID {
+		t.Errorf("SeriesID = %d, want %d", gaps[0].SeriesID, gapSeries.ID)
+	}
+	if len(gaps[0].Seasons) != 1 {
+		t.Fatalf("Seasons = %+v, want only season 1", gaps[0].Seasons)
+	}
+	season := gaps[0].Seasons[0]
+	if season.SeasonNumber != 1 || season.AiredEpisodes != 4 {
+		t.Errorf("season = %+v, want season 1 with 4 aired", season)
+	}
+	if fmt.Sprint(season.MissingEpisodes) != "[2 4]" {
+		t.Errorf("MissingEpisodes = %v, want [2 4]", season.MissingEpisodes)
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"sort"
 	"time"
 
 	"github.com/slipstream/slipstream/internal/database/sqlc"
@@ -90,6 +91,43 @@ func (s *Service) GetEpisodeByNumber(ctx context.Context, seriesID int64, season
 	return &episode, nil
 }
 
+// GetEpisodeByAbsoluteNumber retrieves an episode by its absolute number, the
+// 1-based position among the series' regular-season episodes.
+func (s *Service) GetEpisodeByAbsoluteNumber(ctx context.Context, seriesID int64, absoluteNumber int) (*Episode, error) {
+	if absoluteNumber < 1 {
+		return nil, ErrEpisodeNotFound
+	}
+	row, err := s.Queries.GetEpisodeByAbsoluteNumber(ctx, sqlc.GetEpisodeByAbsoluteNumberParams{
+		SeriesID: seriesID,
+		Offset:   int64(absoluteNumber - 1),
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrEpisodeNotFound
+		}
+		return nil, fmt.Errorf("failed to get episode by absolute number: %w", err)
+	}
+
+	episode := s.rowToEpisode(row)
+	return &episode, nil
+}
+
+// GetAbsoluteNumber returns an episode's absolute number, or 0 for specials.
+func (s *Service) GetAbsoluteNumber(ctx context.Context, seriesID int64, seasonNumber, episodeNumber int) (int, error) {
+	if seasonNumber < 1 {
+		return 0, nil
+	}
+	n, err := s.Queries.GetEpisodeAbsoluteNumber(ctx, sqlc.GetEpisodeAbsoluteNumberParams{
+		SeriesID:      seriesID,
+		SeasonNumber:  int64(seasonNumber),
+		EpisodeNumber: int64(episodeNumber),
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to get absolute episode number: %w", err)
+	}
+	return int(n), nil
+}
+
 // UpdateEpisode updates an episode.
 func (s *Service) UpdateEpisode(ctx context.Context, id int64, input UpdateEpisodeInput) (*Episode, error) {
 	current, err := s.GetEpisode(ctx, id)
@@ -255,6 +293,88 @@ func (s *Service) AddEpisodeFile(ctx context.Context, episodeID int64, input *Cr
 	return &file, nil
 }
 
+// AddMultiEpisodeFile adds a file covering several episodes (S01E01-E02). Each
+// episode gets its own record so status and quality apply to all of them; the
+// records after the first are linked to it and follow it on rename and removal.
+func (s *Service) AddMultiEpisodeFile(ctx context.Context, episodeIDs []int64, input *CreateEpisodeFileInput) (*EpisodeFile, error) {
+	if len(episodeIDs) == 0 {
+		return nil, ErrEpisodeNotFound
+	}
+
+	primary, err := s.AddEpisodeFile(ctx, episodeIDs[0], input)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, episodeID := range episodeIDs[1:] {
+		if episodeID == primary.EpisodeID {
+			continue
+		}
+		linked, err := s.AddEpisodeFile(ctx, episodeID, input)
+		if err != nil {
+			s.Logger.Warn().Err(err).Int64("episodeId", episodeID).Msg("Failed to add multi-episode file record")
+			continue
+		}
+		if err := s.Queries.LinkEpisodeFile(ctx, sqlc.LinkEpisodeFileParams{
+			PrimaryFileID: sql.NullInt64{Int64: primary.ID, Valid: true},
+			ID:            linked.ID,
+		}); err != nil {
+			s.Logger.Warn().Err(err).Int64("fileId", linked.ID).Msg("Failed to link multi-episode file record")
+		}
+	}
+
+	return primary, nil
+}
+
+// FileEpisodes returns the episodes covered by a file, in episode order. A
+// single-episode file returns just its episode.
+func (s *Service) FileEpisodes(ctx context.Context, fileID int64) ([]*Episode, error) {
+	primaryID, err := s.primaryFileID(ctx, fileID)
+	if err != nil {
+		return nil, err
+	}
+	primary, err := s.Queries.GetEpisodeFile(ctx, primaryID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get episode file: %w", err)
+	}
+	linked, err := s.Queries.ListLinkedEpisodeFiles(ctx, sql.NullInt64{Int64: primaryID, Valid: true})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list linked episode files: %w", err)
+	}
+
+	episodes := make([]*Episode, 0, len(linked)+1)
+	for _, row := range append([]*sqlc.EpisodeFile{primary}, linked...) {
+		episode, err := s.GetEpisode(ctx, row.EpisodeID)
+		if err != nil {
+			return nil, err
+		}
+		episodes = append(episodes, episode)
+	}
+	sort.Slice(episodes, func(i, j int) bool {
+		if episodes[i].SeasonNumber != episodes[j].SeasonNumber {
+			return episodes[i].SeasonNumber < episodes[j].SeasonNumber
+		}
+		return episodes[i].EpisodeNumber < episodes[j].EpisodeNumber
+	})
+	return episodes, nil
+}
+
+// primaryFileID resolves a file record to the primary record of its
+// multi-episode group, or to itself for a single-episode file.
+func (s *Service) primaryFileID(ctx context.Context, fileID int64) (int64, error) {
+	row, err := s.Queries.GetEpisodeFile(ctx, fileID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return 0, ErrEpisodeFileNotFound
+		}
+		return 0, fmt.Errorf("failed to get episode file: %w", err)
+	}
+	if row.PrimaryFileID.Valid {
+		return row.PrimaryFileID.Int64, nil
+	}
+	return row.ID, nil
+}
+
 // GetEpisodeFileByPath retrieves an episode file by its path.
 // Returns sql.ErrNoRows if the file doesn't exist.
 func (s *Service) GetEpisodeFileByPath(ctx context.Context, path string) (*EpisodeFile, error) {
@@ -281,17 +401,35 @@ func (s *Service) GetEpisodeFile(ctx context.Context, episodeID int64) (*Episode
 	return &file, nil
 }
 
-// RemoveEpisodeFile removes a file from an episode.
+// RemoveEpisodeFile removes a file from an episode. For a multi-episode file
+// the records of all its episodes are removed.
 // Req 12.1.1: Deleting file from slot does NOT trigger automatic search
 // Req 12.1.2: Slot becomes empty; waits for next scheduled search
 func (s *Service) RemoveEpisodeFile(ctx context.Context, fileID int64) error {
-	row, err := s.Queries.GetEpisodeFile(ctx, fileID)
+	primaryID, err := s.primaryFileID(ctx, fileID)
 	if err != nil {
-		if errors.Is(err, sql.ErrNoRows) {
-			return ErrEpisodeFileNotFound
+		return err
+	}
+
+	linked, err := s.Queries.ListLinkedEpisodeFiles(ctx, sql.NullInt64{Int64: primaryID, Valid: true})
+	if err != nil {
+		return fmt.Errorf("failed to list linked episode files: %w", err)
+	}
+	for _, row := range linked {
+		if err := s.removeEpisodeFileRecord(ctx, row); err != nil {
+			return err
 		}
+	}
+
+	row, err := s.Queries.GetEpisodeFile(ctx, primaryID)
+	if err != nil {
 		return fmt.Errorf("failed to get episode file: %w", err)
 	}
+	return s.removeEpisodeFileRecord(ctx, row)
+}
+
+func (s *Service) removeEpisodeFileRecord(ctx context.Context, row *sqlc.EpisodeFile) error {
+	fileID := row.ID
 
 	if s.fileDeleteHandler != nil {
 		if err := s.fileDeleteHandler.OnFileDeleted(ctx, "episode", fileID); err != nil {
@@ -329,21 +467,56 @@ func (s *Service) GetEpisodeFileByID(ctx context.Context, fileID int64) (*Episod
 	return &file, nil
 }
 
-// UpdateEpisodeFilePath updates the path of an episode file.
+// UpdateEpisodeFilePath updates the path of an episode file, including the
+// linked records of a multi-episode file.
 func (s *Service) UpdateEpisodeFilePath(ctx context.Context, fileID int64, newPath string) error {
-	return s.Queries.UpdateEpisodeFilePath(ctx, sqlc.UpdateEpisodeFilePathParams{
-		Path: pathutil.NormalizePath(newPath),
-		ID:   fileID,
+	primaryID, err := s.primaryFileID(ctx, fileID)
+	if err != nil {
+		return err
+	}
+	newPath = pathutil.NormalizePath(newPath)
+	if err := s.Queries.UpdateEpisodeFilePath(ctx, sqlc.UpdateEpisodeFilePathParams{
+		Path: newPath,
+		ID:   primaryID,
+	}); err != nil {
+		return err
+	}
+	return s.Queries.UpdateLinkedEpisodeFilesPath(ctx, sqlc.UpdateLinkedEpisodeFilesPathParams{
+		Path:          newPath,
+		PrimaryFileID: sql.NullInt64{Int64: primaryID, Valid: true},
 	})
 }
 
-// UpdateEpisodeFileMediaInfo updates the MediaInfo fields of an episode file.
+// UpdateEpisodeFileMediaInfo updates the MediaInfo fields of an episode file,
+// including the linked records of a multi-episode file.
 func (s *Service) UpdateEpisodeFileMediaInfo(ctx context.Context, episodeID int64, info *mediainfo.MediaInfo) error {
-	return s.Queries.UpdateEpisodeFileMediaInfo(ctx, sqlc.UpdateEpisodeFileMediaInfoParams{
-		VideoCodec: sql.NullString{String: info.VideoCodec, Valid: info.VideoCodec != ""},
-		AudioCodec: sql.NullString{String: info.AudioCodec, Valid: info.AudioCodec != ""},
-		Resolution: sql.NullString{String: info.VideoResolution, Valid: info.VideoResolution != ""},
-		EpisodeID:  episodeID,
+	videoCodec := sql.NullString{String: info.VideoCodec, Valid: info.VideoCodec != ""}
+	audioCodec := sql.NullString{String: info.AudioCodec, Valid: info.AudioCodec != ""}
+	resolution := sql.NullString{String: info.VideoResolution, Valid: info.VideoResolution != ""}
+	audioChannels := sql.NullString{String: info.AudioChannels, Valid: info.AudioChannels != ""}
+	dynamicRange := sql.NullString{String: info.DynamicRangeType, Valid: info.DynamicRangeType != ""}
+	audioTracks := mediainfo.EncodeAudioTracks(info.AudioTracks)
+	if err := s.Queries.UpdateEpisodeFileMediaInfo(ctx, sqlc.UpdateEpisodeFileMediaInfoParams{
+		VideoCodec:         videoCodec,
+		AudioCodec:         audioCodec,
+		Resolution:         resolution,
+		AudioChannels:      audioChannels,
+		DynamicRange:       dynamicRange,
+		DolbyVisionProfile: info.DolbyVisionProfile,
+		AudioTracks:        audioTracks,
+		EpisodeID:          episodeID,
+	}); err != nil {
+		return err
+	}
+	return s.Queries.UpdateLinkedEpisodeFilesMediaInfo(ctx, sqlc.UpdateLinkedEpisodeFilesMediaInfoParams{
+		VideoCodec:         videoCodec,
+		AudioCodec:         audioCodec,
+		Resolution:         resolution,
+		AudioChannels:      audioChannels,
+		DynamicRange:       dynamicRange,
+		DolbyVisionProfile: info.DolbyVisionProfile,
+		AudioTracks:        audioTracks,
+		EpisodeID:          episodeID,
 	})
 }
 
@@ -440,6 +613,9 @@ func (s *Service) rowToEpisode(row *sqlc.Episode) Episode {
 	if row.AirDate.Valid {
 		ep.AirDate = &row.AirDate.Time
 	}
+	if row.StillUrl.Valid {
+		ep.StillURL = row.StillUrl.String
+	}
 	if row.StatusMessage.Valid {
 		msg := row.StatusMessage.String
 		ep.StatusMessage = &msg
@@ -469,5 +645,9 @@ func (s *Service) rowToEpisodeFile(row *sqlc.EpisodeFile) EpisodeFile {
 		Resolution:    module.NullStr(row.Resolution),
 		CreatedAt:     module.NullTime(row.CreatedAt),
 		SlotID:        module.NullInt64Ptr(row.SlotID),
+		PrimaryFileID: module.NullInt64Ptr(row.PrimaryFileID),
+
+		DolbyVisionProfile: row.DolbyVisionProfile,
+		AudioTracks:        mediainfo.DecodeAudioTracks(row.AudioTracks),
 	}
 }
@@ -0,0 +1,107 @@
+package tv
+
+import (
+	"database/sql"
+	"fmt"
+	"net/url"
+	"strconv"
+
+	"github.com/slipstream/slipstream/internal/database/sqlc"
+	"github.com/slipstream/slipstream/internal/pagination"
+)
+
+// SortKeys lists the keys the series list can be sorted by. The first is the default.
+var SortKeys = []string{"title", "year", "added", "network"}
+
+// ParseListOptions parses the series list endpoint's query grammar:
+//
+//	search=<text>  monitored=true|false  rootFolderId=<id>  qualityProfileId=<id>
+//	productionStatus=<status>  missing=true|false  tag=<id>
+//	sort=<key>  order=asc|desc
+//
+// Unknown keys are rejected so saved filters fail loudly instead of matching everything.
+func ParseListOptions(q url.Values) (ListSeriesOptions, error) {
+	var opts ListSeriesOptions
+	var sortKey, order string
+	for key := range q {
+		value := q.Get(key)
+		switch key {
+		case "search":
+			opts.Search = value
+		case "monitored":
+			m, err := strconv.ParseBool(value)
+			if err != nil {
+				return opts, fmt.Errorf("%w: monitored must be true or false", ErrInvalidFilter)
+			}
+			opts.Monitored = &m
+		case "rootFolderId":
+			id, err := strconv.ParseInt(value, 10, 64)
+			if err != nil {
+				return opts, fmt.Errorf("%w: invalid rootFolderId", ErrInvalidFilter)
+			}
+			opts.RootFolderID = &id
+		case "qualityProfileId":
+			id, err := strconv.ParseInt(value, 10, 64)
+			if err != nil {
+				return opts, fmt.Errorf("%w: invalid qualityProfileId", ErrInvalidFilter)
+			}
+			opts.QualityProfileID = &id
+		case "productionStatus":
+			opts.ProductionStatus = value
+		case "missing":
+			m, err := strconv.ParseBool(value)
+			if err != nil {
+				return opts, fmt.Errorf("%w: missing must be true or false", ErrInvalidFilter)
+			}
+			opts.Missing = &m
+		case "tag":
+			id, err := strconv.ParseInt(value, 10, 64)
+			if err != nil {
+				return opts, fmt.Errorf("%w: invalid tag", ErrInvalidFilter)
+			}
+			opts.TagID = &id
+		case "sort":
+			sortKey = value
+		case "order":
+			order = value
+		default:
+			return opts, fmt.Errorf("%w: unknown key %q", ErrInvalidFilter, key)
+		}
+	}
+	sort, err := pagination.ParseSort(sortKey, order, SortKeys...)
+	if err != nil {
+		return opts, fmt.Errorf("%w: %w", ErrInvalidFilter, err)
+	}
+	opts.Sort, opts.Descending = sort.Key, sort.Descending
+	return opts, nil
+}
+
+// filterParams converts the options into query arguments, leaving the page unbounded.
+func (o ListSeriesOptions) filterParams() sqlc.ListSeriesFilteredParams {
+	return sqlc.ListSeriesFilteredParams{
+		SearchTerm:       o.Search,
+		ProductionStatus: o.ProductionStatus,
+		Monitored:        nullBool(o.Monitored),
+		RootFolderID:     nullInt64(o.RootFolderID),
+		QualityProfileID: nullInt64(o.QualityProfileID),
+		Missing:          nullBool(o.Missing),
+		TagID:            nullInt64(o.TagID),
+		SortKey:          o.Sort,
+		Descending:       o.Descending,
+		Lim:              -1,
+	}
+}
+
+func nullBool(b *bool) sql.NullBool {
+	if b == nil {
+		return sql.NullBool{}
+	}
+	return sql.NullBool{Bool: *b, Valid: true}
+}
+
+func nullInt64(n *int64) sql.NullInt64 {
+	if n == nil {
+		return sql.NullInt64{}
+	}
+	return sql.NullInt64{Int64: *n, Valid: true}
+}
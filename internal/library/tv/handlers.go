@@ -6,6 +6,10 @@ import (
 	"strconv"
 
 	"github.com/labstack/echo/v4"
+
+	"github.com/slipstream/slipstream/internal/library"
+	"github.com/slipstream/slipstream/internal/pagination"
+	"github.com/slipstream/slipstream/internal/tags"
 )
 
 // Handlers provides HTTP handlers for TV operations.
@@ -23,11 +27,13 @@ func (h *Handlers) RegisterRoutes(g *echo.Group) {
 	g.GET("", h.ListSeries)
 	g.POST("", h.CreateSeries)
 	g.PUT("/monitor", h.BulkMonitorSeries)
+	g.GET("/gaps", h.ListSeasonGaps)
 	g.GET("/:id", h.GetSeries)
 	g.PUT("/:id", h.UpdateSeries)
 	g.DELETE("/:id", h.DeleteSeries)
 	g.PUT("/:id/monitor", h.BulkMonitor)
 	g.GET("/:id/monitor/stats", h.GetMonitoringStats)
+	g.GET("/:id/completeness", h.GetCompleteness)
 	g.GET("/:id/seasons", h.ListSeasons)
 	g.PUT("/:id/seasons/:seasonNumber", h.UpdateSeason)
 	g.GET("/:id/episodes", h.ListEpisodes)
@@ -52,20 +58,25 @@ func (h *Handlers) BulkMonitorSeries(c echo.Context) error {
 	return c.JSON(http.StatusOK, map[string]string{"status": "ok"})
 }
 
-// ListSeries returns all series with optional filtering.
+// ListSeries returns series with optional filtering and sorting. Passing page or
+// pageSize returns one page wrapped with the total count instead of a bare array.
 // GET /api/v1/series
 func (h *Handlers) ListSeries(c echo.Context) error {
-	opts := ListSeriesOptions{
-		Search: c.QueryParam("search"),
+	page, paged, err := pagination.FromQuery(c.QueryParams())
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
 	}
-
-	if monitored := c.QueryParam("monitored"); monitored == "true" {
-		m := true
-		opts.Monitored = &m
+	opts, err := ParseListOptions(pagination.Without(c.QueryParams()))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
 	}
-	if rootFolder := c.QueryParam("rootFolderId"); rootFolder != "" {
-		id, _ := strconv.ParseInt(rootFolder, 10, 64)
-		opts.RootFolderID = &id
+
+	if paged {
+		result, err := h.service.ListSeriesPage(c.Request().Context(), opts, page)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+		}
+		return c.JSON(http.StatusOK, result)
 	}
 
 	series, err := h.service.ListSeries(c.Request().Context(), opts)
@@ -133,6 +144,9 @@ func (h *Handlers) UpdateSeries(c echo.Context) error {
 		if errors.Is(err, ErrSeriesNotFound) {
 			return echo.NewHTTPError(http.StatusNotFound, err.Error())
 		}
+		if errors.Is(err, tags.ErrTagNotFound) || errors.Is(err, ErrInvalidSeries) {
+			return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+		}
 		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
 	}
 	return c.JSON(http.StatusOK, series)
@@ -146,9 +160,12 @@ func (h *Handlers) DeleteSeries(c echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, "invalid id")
 	}
 
-	deleteFiles := c.QueryParam("deleteFiles") == "true"
+	opts := library.DeleteOptions{
+		DeleteFiles:  c.QueryParam("deleteFiles") == "true",
+		AddExclusion: c.QueryParam("addExclusion") == "true",
+	}
 
-	if err := h.service.DeleteSeries(c.Request().Context(), id, deleteFiles); err != nil {
+	if err := h.service.DeleteSeries(c.Request().Context(), id, opts); err != nil {
 		if errors.Is(err, ErrSeriesNotFound) {
 			return echo.NewHTTPError(http.StatusNotFound, err.Error())
 		}
@@ -196,6 +213,35 @@ func (h *Handlers) GetMonitoringStats(c echo.Context) error {
 	return c.JSON(http.StatusOK, stats)
 }
 
+// GetCompleteness returns the per-season availability grid for a series.
+// GET /api/v1/series/:id/completeness
+func (h *Handlers) GetCompleteness(c echo.Context) error {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid id")
+	}
+
+	report, err := h.service.GetCompletenessReport(c.Request().Context(), id)
+	if err != nil {
+		if errors.Is(err, ErrSeriesNotFound) {
+			return echo.NewHTTPError(http.StatusNotFound, "series not found")
+		}
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, report)
+}
+
+// ListSeasonGaps returns series with missing episodes in otherwise populated seasons.
+// GET /api/v1/series/gaps
+func (h *Handlers) ListSeasonGaps(c echo.Context) error {
+	gaps, err := h.service.ListSeasonGaps(c.Request().Context())
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	return c.JSON(http.StatusOK, gaps)
+}
+
 // BulkMonitorEpisodes updates the monitored status of multiple episodes.
 // PUT /api/v1/series/:id/episodes/monitor
 func (h *Handlers) BulkMonitorEpisodes(c echo.Context) error {
@@ -90,6 +90,8 @@ func (s *Service) BulkMonitor(ctx context.Context, seriesID int64, input BulkMon
 		err = s.applyMonitorLatest(ctx, seriesID)
 	case MonitorTypeExisting:
 		err = s.applyMonitorExisting(ctx, seriesID)
+	case MonitorTypePilot:
+		err = s.applyMonitorPilot(ctx, seriesID)
 	default:
 		return fmt.Errorf("unknown monitor type: %s", input.MonitorType)
 	}
@@ -255,6 +257,45 @@ func (s *Service) applyMonitorExisting(ctx context.Context, seriesID int64) erro
 	return nil
 }
 
+// applyMonitorPilot monitors only S01E01 and its season.
+func (s *Service) applyMonitorPilot(ctx context.Context, seriesID int64) error {
+	if err := s.Queries.UpdateAllEpisodesMonitoredBySeries(ctx, sqlc.UpdateAllEpisodesMonitoredBySeriesParams{
+		Monitored: false,
+		SeriesID:  seriesID,
+	}); err != nil {
+		return fmt.Errorf("failed to unmonitor episodes: %w", err)
+	}
+	if err := s.Queries.UpdateSeasonMonitoredBySeries(ctx, sqlc.UpdateSeasonMonitoredBySeriesParams{
+		Monitored: false,
+		SeriesID:  seriesID,
+	}); err != nil {
+		return fmt.Errorf("failed to unmonitor seasons: %w", err)
+	}
+
+	pilot, err := s.Queries.GetEpisodeByNumber(ctx, sqlc.GetEpisodeByNumberParams{
+		SeriesID:      seriesID,
+		SeasonNumber:  1,
+		EpisodeNumber: 1,
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil
+		}
+		return fmt.Errorf("failed to get pilot episode: %w", err)
+	}
+	if err := s.Queries.UpdateEpisodeMonitored(ctx, sqlc.UpdateEpisodeMonitoredParams{Monitored: true, ID: pilot.ID}); err != nil {
+		return fmt.Errorf("failed to monitor pilot episode: %w", err)
+	}
+	if err := s.Queries.UpdateSeasonMonitoredByNumber(ctx, sqlc.UpdateSeasonMonitoredByNumberParams{
+		Monitored:    true,
+		SeriesID:     seriesID,
+		SeasonNumber: 1,
+	}); err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return fmt.Errorf("failed to monitor pilot season: %w", err)
+	}
+	return nil
+}
+
 func (s *Service) unmonitorSpecials(ctx context.Context, seriesID int64) error {
 	if err := s.Queries.UpdateSeasonMonitoredByNumber(ctx, sqlc.UpdateSeasonMonitoredByNumberParams{
 		Monitored:    false,
@@ -3,6 +3,9 @@ package tv
 import (
 	"strconv"
 	"time"
+
+	"github.com/slipstream/slipstream/internal/mediainfo"
+	"github.com/slipstream/slipstream/internal/metadata"
 )
 
 // StatusCounts holds episode status counts for a series or season.
@@ -18,32 +21,40 @@ type StatusCounts struct {
 
 // Series represents a TV series in the library.
 type Series struct {
-	ID               int64        `json:"id"`
-	Title            string       `json:"title"`
-	SortTitle        string       `json:"sortTitle"`
-	Year             int          `json:"year,omitempty"`
-	TvdbID           int          `json:"tvdbId,omitempty"`
-	TmdbID           int          `json:"tmdbId,omitempty"`
-	ImdbID           string       `json:"imdbId,omitempty"`
-	Overview         string       `json:"overview,omitempty"`
-	Runtime          int          `json:"runtime,omitempty"`
-	Path             string       `json:"path,omitempty"`
-	RootFolderID     int64        `json:"rootFolderId"`
-	QualityProfileID int64        `json:"qualityProfileId"`
-	Monitored        bool         `json:"monitored"`
-	SeasonFolder     bool         `json:"seasonFolder"`
-	ProductionStatus string       `json:"productionStatus"`
-	Network          string       `json:"network,omitempty"`
-	NetworkLogoURL   string       `json:"networkLogoUrl,omitempty"`
-	AddedAt          time.Time    `json:"addedAt"`
-	UpdatedAt        time.Time    `json:"updatedAt,omitempty"`
-	SizeOnDisk       int64        `json:"sizeOnDisk"`
-	Seasons          []Season     `json:"seasons"`
-	StatusCounts     StatusCounts `json:"statusCounts"`
-	FormatType       string       `json:"formatType,omitempty"`
-	FirstAired       *time.Time   `json:"firstAired,omitempty"`
-	LastAired        *time.Time   `json:"lastAired,omitempty"`
-	NextAiring       *time.Time   `json:"nextAiring,omitempty"`
+	ID                int64        `json:"id"`
+	Title             string       `json:"title"`
+	SortTitle         string       `json:"sortTitle"`
+	Year              int          `json:"year,omitempty"`
+	TvdbID            int          `json:"tvdbId,omitempty"`
+	TmdbID            int          `json:"tmdbId,omitempty"`
+	ImdbID            string       `json:"imdbId,omitempty"`
+	Overview          string       `json:"overview,omitempty"`
+	Runtime           int          `json:"runtime,omitempty"`
+	Path              string       `json:"path,omitempty"`
+	RootFolderID      int64        `json:"rootFolderId"`
+	QualityProfileID  int64        `json:"qualityProfileId"`
+	LanguageProfileID int64        `json:"languageProfileId,omitempty"`
+	Monitored         bool         `json:"monitored"`
+	SeasonFolder      bool         `json:"seasonFolder"`
+	ProductionStatus  string       `json:"productionStatus"`
+	Network           string       `json:"network,omitempty"`
+	NetworkLogoURL    string       `json:"networkLogoUrl,omitempty"`
+	AddedAt           time.Time    `json:"addedAt"`
+	UpdatedAt         time.Time    `json:"updatedAt,omitempty"`
+	SizeOnDisk        int64        `json:"sizeOnDisk"`
+	Seasons           []Season     `json:"seasons"`
+	StatusCounts      StatusCounts `json:"statusCounts"`
+	FormatType        string       `json:"formatType,omitempty"`
+	FirstAired        *time.Time   `json:"firstAired,omitempty"`
+	LastAired         *time.Time   `json:"lastAired,omitempty"`
+	NextAiring        *time.Time   `json:"nextAiring,omitempty"`
+	Tags              []int64      `json:"tags"`
+
+	// AKA titles and translations from TVDB and TMDB, set by GetSeries
+	AlternateTitles []metadata.AlternateTitle `json:"alternateTitles,omitempty"`
+
+	// Release stage required before automatic searches; empty uses the global default
+	MinimumAvailability string `json:"minimumAvailability,omitempty"`
 
 	AddedBy         *int64 `json:"addedBy,omitempty"`
 	AddedByUsername string `json:"addedByUsername,omitempty"`
@@ -76,6 +87,14 @@ type Season struct {
 	StatusCounts StatusCounts `json:"statusCounts"`
 }
 
+// FormatTypeAnime marks a series whose releases use absolute episode numbering.
+const FormatTypeAnime = "anime"
+
+// IsAnime reports whether the series uses absolute episode numbering.
+func (s *Series) IsAnime() bool {
+	return s.FormatType == FormatTypeAnime
+}
+
 // Episode represents an episode of a TV series.
 type Episode struct {
 	ID               int64        `json:"id"`
@@ -85,6 +104,7 @@ type Episode struct {
 	Title            string       `json:"title"`
 	Overview         string       `json:"overview,omitempty"`
 	AirDate          *time.Time   `json:"airDate,omitempty"`
+	StillURL         string       `json:"stillUrl,omitempty"`
 	Monitored        bool         `json:"monitored"`
 	Status           string       `json:"status"`
 	StatusMessage    *string      `json:"statusMessage"`
@@ -106,27 +126,36 @@ type EpisodeFile struct {
 	Resolution    string    `json:"resolution,omitempty"`
 	CreatedAt     time.Time `json:"createdAt"`
 	SlotID        *int64    `json:"slotId,omitempty"`
+	// PrimaryFileID is set on the extra records of a multi-episode file and
+	// points at the record of its first episode.
+	PrimaryFileID *int64 `json:"primaryFileId,omitempty"`
+
+	DolbyVisionProfile string                 `json:"dolbyVisionProfile,omitempty"`
+	AudioTracks        []mediainfo.AudioTrack `json:"audioTracks,omitempty"`
 }
 
 // CreateSeriesInput contains fields for creating a series.
 type CreateSeriesInput struct {
-	Title            string        `json:"title"`
-	Year             int           `json:"year,omitempty"`
-	TvdbID           int           `json:"tvdbId,omitempty"`
-	TmdbID           int           `json:"tmdbId,omitempty"`
-	ImdbID           string        `json:"imdbId,omitempty"`
-	Overview         string        `json:"overview,omitempty"`
-	Runtime          int           `json:"runtime,omitempty"`
-	Path             string        `json:"path,omitempty"`
-	RootFolderID     int64         `json:"rootFolderId"`
-	QualityProfileID int64         `json:"qualityProfileId"`
-	Monitored        bool          `json:"monitored"`
-	SeasonFolder     bool          `json:"seasonFolder"`
-	Network          string        `json:"network,omitempty"`
-	NetworkLogoURL   string        `json:"networkLogoUrl,omitempty"`
-	FormatType       string        `json:"formatType,omitempty"`
-	ProductionStatus string        `json:"productionStatus,omitempty"`
-	Seasons          []SeasonInput `json:"seasons,omitempty"`
+	Title             string        `json:"title"`
+	Year              int           `json:"year,omitempty"`
+	TvdbID            int           `json:"tvdbId,omitempty"`
+	TmdbID            int           `json:"tmdbId,omitempty"`
+	ImdbID            string        `json:"imdbId,omitempty"`
+	Overview          string        `json:"overview,omitempty"`
+	Runtime           int           `json:"runtime,omitempty"`
+	Path              string        `json:"path,omitempty"`
+	RootFolderID      int64         `json:"rootFolderId"`
+	QualityProfileID  int64         `json:"qualityProfileId"`
+	LanguageProfileID int64         `json:"languageProfileId,omitempty"`
+	Monitored         bool          `json:"monitored"`
+	SeasonFolder      bool          `json:"seasonFolder"`
+	Network           string        `json:"network,omitempty"`
+	NetworkLogoURL    string        `json:"networkLogoUrl,omitempty"`
+	FormatType        string        `json:"formatType,omitempty"`
+	ProductionStatus  string        `json:"productionStatus,omitempty"`
+	Seasons           []SeasonInput `json:"seasons,omitempty"`
+
+	Tags []int64 `json:"tags,omitempty"`
 
 	AddedBy *int64 `json:"-"`
 }
@@ -149,22 +178,27 @@ type EpisodeInput struct {
 
 // UpdateSeriesInput contains fields for updating a series.
 type UpdateSeriesInput struct {
-	Title            *string `json:"title,omitempty"`
-	Year             *int    `json:"year,omitempty"`
-	TvdbID           *int    `json:"tvdbId,omitempty"`
-	TmdbID           *int    `json:"tmdbId,omitempty"`
-	ImdbID           *string `json:"imdbId,omitempty"`
-	Overview         *string `json:"overview,omitempty"`
-	Runtime          *int    `json:"runtime,omitempty"`
-	Path             *string `json:"path,omitempty"`
-	RootFolderID     *int64  `json:"rootFolderId,omitempty"`
-	QualityProfileID *int64  `json:"qualityProfileId,omitempty"`
-	Monitored        *bool   `json:"monitored,omitempty"`
-	SeasonFolder     *bool   `json:"seasonFolder,omitempty"`
-	ProductionStatus *string `json:"productionStatus,omitempty"`
-	FormatType       *string `json:"formatType,omitempty"`
-	Network          *string `json:"network,omitempty"`
-	NetworkLogoURL   *string `json:"networkLogoUrl,omitempty"`
+	Title             *string `json:"title,omitempty"`
+	Year              *int    `json:"year,omitempty"`
+	TvdbID            *int    `json:"tvdbId,omitempty"`
+	TmdbID            *int    `json:"tmdbId,omitempty"`
+	ImdbID            *string `json:"imdbId,omitempty"`
+	Overview          *string `json:"overview,omitempty"`
+	Runtime           *int    `json:"runtime,omitempty"`
+	Path              *string `json:"path,omitempty"`
+	RootFolderID      *int64  `json:"rootFolderId,omitempty"`
+	QualityProfileID  *int64  `json:"qualityProfileId,omitempty"`
+	LanguageProfileID *int64  `json:"languageProfileId,omitempty"` // 0 clears the language profile
+	Monitored         *bool   `json:"monitored,omitempty"`
+	SeasonFolder      *bool   `json:"seasonFolder,omitempty"`
+	ProductionStatus  *string `json:"productionStatus,omitempty"`
+	FormatType        *string `json:"formatType,omitempty"`
+	Network           *string `json:"network,omitempty"`
+	NetworkLogoURL    *string `json:"networkLogoUrl,omitempty"`
+
+	MinimumAvailability *string `json:"minimumAvailability,omitempty"` // "" uses the global default
+
+	Tags *[]int64 `json:"tags,omitempty"`
 }
 
 // UpdateEpisodeInput contains fields for updating an episode.
@@ -183,9 +217,15 @@ type BulkSeriesMonitorInput struct {
 
 // ListSeriesOptions contains options for listing series.
 type ListSeriesOptions struct {
-	Search       string `json:"search,omitempty"`
-	Monitored    *bool  `json:"monitored,omitempty"`
-	RootFolderID *int64 `json:"rootFolderId,omitempty"`
+	Search           string `json:"search,omitempty"`
+	Monitored        *bool  `json:"monitored,omitempty"`
+	RootFolderID     *int64 `json:"rootFolderId,omitempty"`
+	QualityProfileID *int64 `json:"qualityProfileId,omitempty"`
+	ProductionStatus string `json:"productionStatus,omitempty"`
+	Missing          *bool  `json:"missing,omitempty"`
+	TagID            *int64 `json:"tag,omitempty"`
+	Sort             string `json:"sort,omitempty"`
+	Descending       bool   `json:"descending,omitempty"`
 }
 
 // CreateEpisodeFileInput contains fields for creating an episode file.
@@ -213,6 +253,7 @@ const (
 	MonitorTypeFirstSeason MonitorType = "first_season"
 	MonitorTypeLatest      MonitorType = "latest_season"
 	MonitorTypeExisting    MonitorType = "existing"
+	MonitorTypePilot       MonitorType = "pilot"
 )
 
 // BulkMonitorInput contains fields for bulk monitoring operations.
@@ -6,6 +6,7 @@ import (
 	"errors"
 	"fmt"
 	"path/filepath"
+	"slices"
 	"time"
 
 	"github.com/rs/zerolog"
@@ -13,11 +14,17 @@ import (
 	"github.com/slipstream/slipstream/internal/database/sqlc"
 	"github.com/slipstream/slipstream/internal/domain/contracts"
 	"github.com/slipstream/slipstream/internal/library"
+	"github.com/slipstream/slipstream/internal/library/alttitles"
+	"github.com/slipstream/slipstream/internal/library/people"
 	"github.com/slipstream/slipstream/internal/library/quality"
+	"github.com/slipstream/slipstream/internal/library/status"
 	"github.com/slipstream/slipstream/internal/metadata"
 	"github.com/slipstream/slipstream/internal/module"
 	"github.com/slipstream/slipstream/internal/module/parseutil"
+	"github.com/slipstream/slipstream/internal/module/titleutil"
+	"github.com/slipstream/slipstream/internal/pagination"
 	"github.com/slipstream/slipstream/internal/pathutil"
+	"github.com/slipstream/slipstream/internal/tags"
 	"github.com/slipstream/slipstream/internal/websocket"
 )
 
@@ -46,6 +53,7 @@ var (
 	ErrEpisodeFileNotFound = errors.New("episode file not found")
 	ErrInvalidSeries       = errors.New("invalid series data")
 	ErrDuplicateTvdbID     = errors.New("series with this TVDB ID already exists")
+	ErrInvalidFilter       = errors.New("invalid series filter")
 )
 
 // Service provides TV library operations.
@@ -54,6 +62,8 @@ type Service struct {
 	fileDeleteHandler contracts.FileDeleteHandler
 	notifier          NotificationDispatcher
 	registry          *module.Registry
+	tagStore          contracts.TagStore
+	recycler          library.FileRecycler
 }
 
 // SetRegistry sets the module registry for cascading monitoring changes.
@@ -66,6 +76,16 @@ func (s *Service) SetNotificationDispatcher(n NotificationDispatcher) {
 	s.notifier = n
 }
 
+// SetFileRecycler sets where files of deleted series go when a recycle bin is set.
+func (s *Service) SetFileRecycler(r library.FileRecycler) {
+	s.recycler = r
+}
+
+// SetTagStore sets the store series tags are read from and written to.
+func (s *Service) SetTagStore(store contracts.TagStore) {
+	s.tagStore = store
+}
+
 // SetFileDeleteHandler sets the handler for file deletion events.
 // Req 12.1.1: Deleting file from slot does NOT trigger automatic search
 func (s *Service) SetFileDeleteHandler(handler contracts.FileDeleteHandler) {
@@ -98,6 +118,11 @@ func (s *Service) GetSeries(ctx context.Context, id int64) (*Series, error) {
 	}
 
 	s.enrichSeriesWithCounts(ctx, series)
+	s.attachTags(ctx, []*Series{series})
+
+	if series.AlternateTitles, err = alttitles.List(ctx, s.Queries, alttitles.MediaSeries, id); err != nil {
+		s.Logger.Warn().Err(err).Int64("seriesId", id).Msg("Failed to get alternate titles")
+	}
 
 	return series, nil
 }
@@ -127,6 +152,8 @@ func (s *Service) GetSeriesByPath(ctx context.Context, path string) (*Series, er
 }
 
 // FindByTitle searches for a series by normalized title and optional year.
+// Among same-titled series (remakes) the closest year within tolerance wins;
+// without a year, several same-titled series are ambiguous and match nothing.
 // Returns nil, nil if no match is found.
 func (s *Service) FindByTitle(ctx context.Context, title string, year int) (*Series, error) {
 	allSeries, err := s.ListSeries(ctx, ListSeriesOptions{})
@@ -135,37 +162,77 @@ func (s *Service) FindByTitle(ctx context.Context, title string, year int) (*Ser
 	}
 
 	normalizedSearch := parseutil.NormalizeTitle(title)
+	var matches []*Series
+	var years []int
 	for _, series := range allSeries {
 		if parseutil.NormalizeTitle(series.Title) == normalizedSearch {
-			if year == 0 || series.Year == year {
-				return series, nil
+			matches = append(matches, series)
+			years = append(years, series.Year)
+		}
+	}
+
+	// Fall back to AKA titles and translations
+	if len(matches) == 0 {
+		ids, err := alttitles.MediaIDs(ctx, s.Queries, alttitles.MediaSeries, title)
+		if err != nil {
+			return nil, err
+		}
+		for _, series := range allSeries {
+			if slices.Contains(ids, series.ID) {
+				matches = append(matches, series)
+				years = append(years, series.Year)
 			}
 		}
 	}
+
+	if i := titleutil.ClosestYear(year, years); i >= 0 {
+		return matches[i], nil
+	}
 	return nil, nil //nolint:nilnil // nil means no match found
 }
 
-// ListSeries returns series with optional filtering.
+// SetAlternateTitles replaces a series' stored AKA titles and translations.
+func (s *Service) SetAlternateTitles(ctx context.Context, id int64, title string, titles []metadata.AlternateTitle) error {
+	return alttitles.Replace(ctx, s.DB, alttitles.MediaSeries, id, title, titles)
+}
+
+// SetCredits replaces a series' stored creators and cast.
+func (s *Service) SetCredits(ctx context.Context, id int64, credits *metadata.Credits) error {
+	return people.ReplaceCredits(ctx, s.DB, people.MediaSeries, id, credits)
+}
+
+// ListSeries returns every series matching opts, in the order it asks for.
 func (s *Service) ListSeries(ctx context.Context, opts ListSeriesOptions) ([]*Series, error) {
-	var rows []*sqlc.Series
-	var err error
-
-	switch {
-	case opts.Search != "":
-		searchTerm := "%" + opts.Search + "%"
-		rows, err = s.Queries.SearchSeries(ctx, sqlc.SearchSeriesParams{
-			SearchTerm: searchTerm,
-			Lim:        1000,
-			Off:        0,
-		})
-	case opts.RootFolderID != nil:
-		rows, err = s.Queries.ListSeriesByRootFolder(ctx, sql.NullInt64{Int64: *opts.RootFolderID, Valid: true})
-	case opts.Monitored != nil && *opts.Monitored:
-		rows, err = s.Queries.ListMonitoredSeries(ctx)
-	default:
-		rows, err = s.Queries.ListSeries(ctx)
+	return s.listSeries(ctx, opts, -1, 0)
+}
+
+// ListSeriesPage returns one page of the series matching opts.
+func (s *Service) ListSeriesPage(ctx context.Context, opts ListSeriesOptions, page pagination.Params) (*pagination.Page[*Series], error) {
+	seriesList, err := s.listSeries(ctx, opts, page.Limit(), page.Offset())
+	if err != nil {
+		return nil, err
 	}
 
+	filter := opts.filterParams()
+	total, err := s.Queries.CountSeriesFiltered(ctx, sqlc.CountSeriesFilteredParams{
+		SearchTerm:       filter.SearchTerm,
+		ProductionStatus: filter.ProductionStatus,
+		Monitored:        filter.Monitored,
+		RootFolderID:     filter.RootFolderID,
+		QualityProfileID: filter.QualityProfileID,
+		Missing:          filter.Missing,
+		TagID:            filter.TagID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to count series: %w", err)
+	}
+	return pagination.NewPage(seriesList, page, total), nil
+}
+
+func (s *Service) listSeries(ctx context.Context, opts ListSeriesOptions, limit, offset int64) ([]*Series, error) {
+	params := opts.filterParams()
+	params.Lim, params.Off = limit, offset
+	rows, err := s.Queries.ListSeriesFiltered(ctx, params)
 	if err != nil {
 		return nil, fmt.Errorf("failed to list series: %w", err)
 	}
@@ -175,6 +242,7 @@ func (s *Service) ListSeries(ctx context.Context, opts ListSeriesOptions) ([]*Se
 		seriesList[i] = s.rowToSeries(row)
 		s.enrichSeriesWithCounts(ctx, seriesList[i])
 	}
+	s.attachTags(ctx, seriesList)
 	return seriesList, nil
 }
 
@@ -210,6 +278,9 @@ func (s *Service) CreateSeries(ctx context.Context, input *CreateSeriesInput) (*
 			return nil, err
 		}
 	}
+	if err := s.checkLanguageProfile(ctx, input.LanguageProfileID); err != nil {
+		return nil, err
+	}
 
 	sortTitle := module.GenerateSortTitle(input.Title)
 
@@ -224,24 +295,25 @@ func (s *Service) CreateSeries(ctx context.Context, input *CreateSeriesInput) (*
 	}
 
 	row, err := s.Queries.CreateSeries(ctx, sqlc.CreateSeriesParams{
-		Title:            input.Title,
-		SortTitle:        sortTitle,
-		Year:             sql.NullInt64{Int64: int64(input.Year), Valid: input.Year > 0},
-		TvdbID:           sql.NullInt64{Int64: int64(input.TvdbID), Valid: input.TvdbID > 0},
-		TmdbID:           sql.NullInt64{Int64: int64(input.TmdbID), Valid: input.TmdbID > 0},
-		ImdbID:           sql.NullString{String: input.ImdbID, Valid: input.ImdbID != ""},
-		Overview:         sql.NullString{String: input.Overview, Valid: input.Overview != ""},
-		Runtime:          sql.NullInt64{Int64: int64(input.Runtime), Valid: input.Runtime > 0},
-		Path:             sql.NullString{String: input.Path, Valid: input.Path != ""},
-		RootFolderID:     sql.NullInt64{Int64: input.RootFolderID, Valid: input.RootFolderID > 0},
-		QualityProfileID: sql.NullInt64{Int64: input.QualityProfileID, Valid: input.QualityProfileID > 0},
-		Monitored:        input.Monitored,
-		SeasonFolder:     input.SeasonFolder,
-		ProductionStatus: productionStatus,
-		Network:          sql.NullString{String: input.Network, Valid: input.Network != ""},
-		FormatType:       sql.NullString{String: input.FormatType, Valid: input.FormatType != ""},
-		NetworkLogoUrl:   sql.NullString{String: input.NetworkLogoURL, Valid: input.NetworkLogoURL != ""},
-		AddedBy:          addedBy,
+		Title:             input.Title,
+		SortTitle:         sortTitle,
+		Year:              sql.NullInt64{Int64: int64(input.Year), Valid: input.Year > 0},
+		TvdbID:            sql.NullInt64{Int64: int64(input.TvdbID), Valid: input.TvdbID > 0},
+		TmdbID:            sql.NullInt64{Int64: int64(input.TmdbID), Valid: input.TmdbID > 0},
+		ImdbID:            sql.NullString{String: input.ImdbID, Valid: input.ImdbID != ""},
+		Overview:          sql.NullString{String: input.Overview, Valid: input.Overview != ""},
+		Runtime:           sql.NullInt64{Int64: int64(input.Runtime), Valid: input.Runtime > 0},
+		Path:              sql.NullString{String: input.Path, Valid: input.Path != ""},
+		RootFolderID:      sql.NullInt64{Int64: input.RootFolderID, Valid: input.RootFolderID > 0},
+		QualityProfileID:  sql.NullInt64{Int64: input.QualityProfileID, Valid: input.QualityProfileID > 0},
+		Monitored:         input.Monitored,
+		SeasonFolder:      input.SeasonFolder,
+		ProductionStatus:  productionStatus,
+		Network:           sql.NullString{String: input.Network, Valid: input.Network != ""},
+		FormatType:        sql.NullString{String: input.FormatType, Valid: input.FormatType != ""},
+		NetworkLogoUrl:    sql.NullString{String: input.NetworkLogoURL, Valid: input.NetworkLogoURL != ""},
+		AddedBy:           addedBy,
+		LanguageProfileID: sql.NullInt64{Int64: input.LanguageProfileID, Valid: input.LanguageProfileID > 0},
 	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to create series: %w", err)
@@ -254,6 +326,12 @@ func (s *Service) CreateSeries(ctx context.Context, input *CreateSeriesInput) (*
 
 	s.Logger.Info().Int64("id", series.ID).Str("title", series.Title).Msg("Created series")
 
+	if len(input.Tags) > 0 {
+		if err := s.saveTags(ctx, series, input.Tags); err != nil {
+			s.Logger.Warn().Err(err).Int64("id", series.ID).Msg("Failed to tag series")
+		}
+	}
+
 	s.BroadcastEntity("tv", "series", series.ID, "added", series)
 
 	// Dispatch notification
@@ -272,12 +350,35 @@ func (s *Service) CreateSeries(ctx context.Context, input *CreateSeriesInput) (*
 	return series, nil
 }
 
+// checkLanguageProfile returns ErrInvalidSeries when id is set but names no
+// language profile.
+func (s *Service) checkLanguageProfile(ctx context.Context, id int64) error {
+	if id <= 0 {
+		return nil
+	}
+	if _, err := s.Queries.GetLanguageProfile(ctx, id); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return fmt.Errorf("%w: language profile %d not found", ErrInvalidSeries, id)
+		}
+		return fmt.Errorf("failed to get language profile: %w", err)
+	}
+	return nil
+}
+
 // UpdateSeries updates an existing series.
 func (s *Service) UpdateSeries(ctx context.Context, id int64, input *UpdateSeriesInput) (*Series, error) {
 	current, err := s.GetSeries(ctx, id)
 	if err != nil {
 		return nil, err
 	}
+	if v := input.MinimumAvailability; v != nil && *v != "" && !status.ValidAvailability(*v) {
+		return nil, fmt.Errorf("%w: unknown minimum availability %q", ErrInvalidSeries, *v)
+	}
+	if input.LanguageProfileID != nil {
+		if err := s.checkLanguageProfile(ctx, *input.LanguageProfileID); err != nil {
+			return nil, err
+		}
+	}
 
 	params := s.buildSeriesUpdateParams(id, current, input)
 
@@ -293,6 +394,12 @@ func (s *Service) UpdateSeries(ctx context.Context, id int64, input *UpdateSerie
 	}
 
 	series := s.rowToSeries(row)
+	series.Tags = current.Tags
+	if input.Tags != nil {
+		if err := s.saveTags(ctx, series, *input.Tags); err != nil {
+			return nil, err
+		}
+	}
 	s.Logger.Info().Int64("id", id).Str("title", series.Title).Msg("Updated series")
 
 	s.BroadcastEntity("tv", "series", series.ID, "updated", series)
@@ -300,6 +407,43 @@ func (s *Service) UpdateSeries(ctx context.Context, id int64, input *UpdateSerie
 	return series, nil
 }
 
+// attachTags fills in the tags of each series. Series are left untagged if no
+// tag store is configured or the lookup fails.
+func (s *Service) attachTags(ctx context.Context, seriesList []*Series) {
+	for _, series := range seriesList {
+		series.Tags = []int64{}
+	}
+	if s.tagStore == nil || len(seriesList) == 0 {
+		return
+	}
+	tagMap, err := s.tagStore.EntityTagMap(ctx, tags.EntitySeries)
+	if err != nil {
+		s.Logger.Warn().Err(err).Msg("Failed to load series tags")
+		return
+	}
+	for _, series := range seriesList {
+		if ids, ok := tagMap[series.ID]; ok {
+			series.Tags = ids
+		}
+	}
+}
+
+// saveTags replaces a series' tags.
+func (s *Service) saveTags(ctx context.Context, series *Series, tagIDs []int64) error {
+	if s.tagStore == nil {
+		return nil
+	}
+	if err := s.tagStore.SetEntityTags(ctx, tags.EntitySeries, series.ID, tagIDs); err != nil {
+		return fmt.Errorf("failed to set series tags: %w", err)
+	}
+	ids, err := s.tagStore.EntityTags(ctx, tags.EntitySeries, series.ID)
+	if err != nil {
+		return fmt.Errorf("failed to get series tags: %w", err)
+	}
+	series.Tags = ids
+	return nil
+}
+
 // BulkUpdateSeriesMonitored updates the monitored flag for multiple series,
 // cascading the change to all their seasons and episodes.
 func (s *Service) BulkUpdateSeriesMonitored(ctx context.Context, input BulkSeriesMonitorInput) error {
@@ -330,7 +474,7 @@ func (s *Service) BulkUpdateSeriesMonitored(ctx context.Context, input BulkSerie
 }
 
 // DeleteSeries deletes a series and all its seasons/episodes.
-func (s *Service) DeleteSeries(ctx context.Context, id int64, deleteFiles bool) error {
+func (s *Service) DeleteSeries(ctx context.Context, id int64, opts library.DeleteOptions) error {
 	series, err := s.GetSeries(ctx, id)
 	if err != nil {
 		return err
@@ -339,11 +483,14 @@ func (s *Service) DeleteSeries(ctx context.Context, id int64, deleteFiles bool)
 	s.cleanupSeriesRelatedData(ctx, id)
 
 	// Delete files from disk before removing DB records
-	if deleteFiles {
+	if opts.DeleteFiles {
 		if err := s.deleteSeriesFilesFromDisk(ctx, id); err != nil {
 			return err
 		}
 	}
+	if opts.AddExclusion {
+		s.excludeSeries(ctx, series)
+	}
 
 	if err := s.deleteSeriesDBRecords(ctx, id); err != nil {
 		return err
@@ -362,12 +509,28 @@ func (s *Service) DeleteSeries(ctx context.Context, id int64, deleteFiles bool)
 			TmdbID:   series.TmdbID,
 			ImdbID:   series.ImdbID,
 			Overview: series.Overview,
-		}, deleteFiles, time.Now())
+		}, opts.DeleteFiles, time.Now())
 	}
 
 	return nil
 }
 
+// excludeSeries keeps automated additions from adding a deleted series back.
+func (s *Service) excludeSeries(ctx context.Context, series *Series) {
+	if series.TvdbID == 0 {
+		return
+	}
+	_, err := s.Queries.CreateLibraryExclusion(ctx, sqlc.CreateLibraryExclusionParams{
+		MediaType:  "series",
+		ExternalID: int64(series.TvdbID),
+		Title:      series.Title,
+		Year:       int64(series.Year),
+	})
+	if err != nil {
+		s.Logger.Warn().Err(err).Int64("seriesId", series.ID).Msg("Failed to exclude deleted series")
+	}
+}
+
 func (s *Service) cleanupSeriesRelatedData(ctx context.Context, id int64) {
 	// Episode-level autosearch uses a subquery on the episodes table, so it must
 	// run before episodes are deleted from the DB.
@@ -429,7 +592,7 @@ func (s *Service) deleteSeriesFilesFromDisk(ctx context.Context, seriesID int64)
 		return fmt.Errorf("cannot delete episode files: %w", err)
 	}
 
-	deleted, err := library.DeleteFiles(paths)
+	deleted, err := library.RemoveFiles(ctx, s.recycler, paths)
 	if err != nil {
 		return fmt.Errorf("failed to delete episode files from disk: %w", err)
 	}
@@ -502,6 +665,12 @@ func (s *Service) mapSeriesCoreFields(series *Series, row *sqlc.Series) {
 	if row.QualityProfileID.Valid {
 		series.QualityProfileID = row.QualityProfileID.Int64
 	}
+	if row.LanguageProfileID.Valid {
+		series.LanguageProfileID = row.LanguageProfileID.Int64
+	}
+	if row.MinimumAvailability.Valid {
+		series.MinimumAvailability = row.MinimumAvailability.String
+	}
 }
 
 func (s *Service) mapSeriesDisplayFields(series *Series, row *sqlc.Series) {
@@ -529,27 +698,29 @@ func (s *Service) mapSeriesDisplayFields(series *Series, row *sqlc.Series) {
 // getSeriesRowToSeries converts a GetSeriesWithAddedByRow (with JOIN) to a Series.
 func (s *Service) getSeriesRowToSeries(row *sqlc.GetSeriesWithAddedByRow) *Series {
 	series := s.rowToSeries(&sqlc.Series{
-		ID:               row.ID,
-		Title:            row.Title,
-		SortTitle:        row.SortTitle,
-		Year:             row.Year,
-		TvdbID:           row.TvdbID,
-		TmdbID:           row.TmdbID,
-		ImdbID:           row.ImdbID,
-		Overview:         row.Overview,
-		Runtime:          row.Runtime,
-		Path:             row.Path,
-		RootFolderID:     row.RootFolderID,
-		QualityProfileID: row.QualityProfileID,
-		Monitored:        row.Monitored,
-		SeasonFolder:     row.SeasonFolder,
-		ProductionStatus: row.ProductionStatus,
-		Network:          row.Network,
-		FormatType:       row.FormatType,
-		AddedAt:          row.AddedAt,
-		UpdatedAt:        row.UpdatedAt,
-		NetworkLogoUrl:   row.NetworkLogoUrl,
-		AddedBy:          row.AddedBy,
+		ID:                  row.ID,
+		Title:               row.Title,
+		SortTitle:           row.SortTitle,
+		Year:                row.Year,
+		TvdbID:              row.TvdbID,
+		TmdbID:              row.TmdbID,
+		ImdbID:              row.ImdbID,
+		Overview:            row.Overview,
+		Runtime:             row.Runtime,
+		Path:                row.Path,
+		RootFolderID:        row.RootFolderID,
+		QualityProfileID:    row.QualityProfileID,
+		LanguageProfileID:   row.LanguageProfileID,
+		MinimumAvailability: row.MinimumAvailability,
+		Monitored:           row.Monitored,
+		SeasonFolder:        row.SeasonFolder,
+		ProductionStatus:    row.ProductionStatus,
+		Network:             row.Network,
+		FormatType:          row.FormatType,
+		AddedAt:             row.AddedAt,
+		UpdatedAt:           row.UpdatedAt,
+		NetworkLogoUrl:      row.NetworkLogoUrl,
+		AddedBy:             row.AddedBy,
 	})
 	if row.AddedByUsername.Valid {
 		series.AddedByUsername = row.AddedByUsername.String
@@ -663,6 +834,7 @@ type EpisodeMetadata struct {
 	Overview      string
 	AirDate       string
 	Runtime       int
+	StillURL      string
 }
 
 // ConvertSeasonResults converts metadata season results to the library SeasonMetadata type.
@@ -678,6 +850,7 @@ func ConvertSeasonResults(seasonResults []metadata.SeasonResult) []SeasonMetadat
 				Overview:      ep.Overview,
 				AirDate:       ep.AirDate,
 				Runtime:       ep.Runtime,
+				StillURL:      ep.StillURL,
 			}
 		}
 		seasonMeta[i] = SeasonMetadata{
@@ -746,6 +919,7 @@ func (s *Service) upsertEpisodesForSeason(ctx context.Context, seriesID int64, e
 			AirDate:       airDate,
 			Monitored:     true,
 			Status:        status,
+			StillUrl:      sql.NullString{String: epMeta.StillURL, Valid: epMeta.StillURL != ""},
 		})
 		if err != nil {
 			s.Logger.Warn().
@@ -804,6 +978,8 @@ func (s *Service) buildSeriesUpdateParams(id int64, current *Series, input *Upda
 	path := module.ResolveField(current.Path, input.Path)
 	rootFolderID := module.ResolveField(current.RootFolderID, input.RootFolderID)
 	qualityProfileID := module.ResolveField(current.QualityProfileID, input.QualityProfileID)
+	languageProfileID := module.ResolveField(current.LanguageProfileID, input.LanguageProfileID)
+	minimumAvailability := module.ResolveField(current.MinimumAvailability, input.MinimumAvailability)
 	monitored := module.ResolveField(current.Monitored, input.Monitored)
 	seasonFolder := module.ResolveField(current.SeasonFolder, input.SeasonFolder)
 	productionStatus := module.ResolveField(current.ProductionStatus, input.ProductionStatus)
@@ -812,24 +988,26 @@ func (s *Service) buildSeriesUpdateParams(id int64, current *Series, input *Upda
 	networkLogoURL := module.ResolveField(current.NetworkLogoURL, input.NetworkLogoURL)
 
 	return sqlc.UpdateSeriesParams{
-		ID:               id,
-		Title:            title,
-		SortTitle:        module.GenerateSortTitle(title),
-		Year:             sql.NullInt64{Int64: int64(year), Valid: year > 0},
-		TvdbID:           sql.NullInt64{Int64: int64(tvdbID), Valid: tvdbID > 0},
-		TmdbID:           sql.NullInt64{Int64: int64(tmdbID), Valid: tmdbID > 0},
-		ImdbID:           sql.NullString{String: imdbID, Valid: imdbID != ""},
-		Overview:         sql.NullString{String: overview, Valid: overview != ""},
-		Runtime:          sql.NullInt64{Int64: int64(runtime), Valid: runtime > 0},
-		Path:             sql.NullString{String: path, Valid: path != ""},
-		RootFolderID:     sql.NullInt64{Int64: rootFolderID, Valid: rootFolderID > 0},
-		QualityProfileID: sql.NullInt64{Int64: qualityProfileID, Valid: qualityProfileID > 0},
-		Monitored:        monitored,
-		SeasonFolder:     seasonFolder,
-		ProductionStatus: productionStatus,
-		Network:          sql.NullString{String: network, Valid: network != ""},
-		FormatType:       sql.NullString{String: formatType, Valid: formatType != ""},
-		NetworkLogoUrl:   sql.NullString{String: networkLogoURL, Valid: networkLogoURL != ""},
+		ID:                  id,
+		Title:               title,
+		SortTitle:           module.GenerateSortTitle(title),
+		Year:                sql.NullInt64{Int64: int64(year), Valid: year > 0},
+		TvdbID:              sql.NullInt64{Int64: int64(tvdbID), Valid: tvdbID > 0},
+		TmdbID:              sql.NullInt64{Int64: int64(tmdbID), Valid: tmdbID > 0},
+		ImdbID:              sql.NullString{String: imdbID, Valid: imdbID != ""},
+		Overview:            sql.NullString{String: overview, Valid: overview != ""},
+		Runtime:             sql.NullInt64{Int64: int64(runtime), Valid: runtime > 0},
+		Path:                sql.NullString{String: path, Valid: path != ""},
+		RootFolderID:        sql.NullInt64{Int64: rootFolderID, Valid: rootFolderID > 0},
+		QualityProfileID:    sql.NullInt64{Int64: qualityProfileID, Valid: qualityProfileID > 0},
+		LanguageProfileID:   sql.NullInt64{Int64: languageProfileID, Valid: languageProfileID > 0},
+		MinimumAvailability: sql.NullString{String: minimumAvailability, Valid: minimumAvailability != ""},
+		Monitored:           monitored,
+		SeasonFolder:        seasonFolder,
+		ProductionStatus:    productionStatus,
+		Network:             sql.NullString{String: network, Valid: network != ""},
+		FormatType:          sql.NullString{String: formatType, Valid: formatType != ""},
+		NetworkLogoUrl:      sql.NullString{String: networkLogoURL, Valid: networkLogoURL != ""},
 	}
 }
 
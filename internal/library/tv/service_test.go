@@ -3,10 +3,14 @@ package tv
 import (
 	"context"
 	"errors"
+	"net/url"
 	"testing"
 	"time"
 
+	"github.com/slipstream/slipstream/internal/database/sqlc"
+	"github.com/slipstream/slipstream/internal/library"
 	"github.com/slipstream/slipstream/internal/module"
+	"github.com/slipstream/slipstream/internal/pagination"
 	"github.com/slipstream/slipstream/internal/testutil"
 )
 
@@ -249,6 +253,53 @@ func TestTVService_ListSeries_Search(t *testing.T) {
 	}
 }
 
+func TestTVService_ListSeriesPage(t *testing.T) {
+	tdb := testutil.NewTestDB(t)
+	defer tdb.Close()
+
+	service := NewService(tdb.Conn, nil, &tdb.Logger, nil, nil)
+	ctx := context.Background()
+
+	var ids []int64
+	for i, title := range []string{"Atlanta", "Barry", "Chernobyl"} {
+		created, err := service.CreateSeries(ctx, &CreateSeriesInput{
+			Title: title, Year: 2016 + i, Monitored: true,
+			Seasons: []SeasonInput{{SeasonNumber: 1, Monitored: true, Episodes: []EpisodeInput{{EpisodeNumber: 1, Title: "Pilot", Monitored: true}}}},
+		})
+		if err != nil {
+			t.Fatalf("CreateSeries() error = %v", err)
+		}
+		ids = append(ids, created.ID)
+	}
+	if _, err := tdb.Conn.ExecContext(ctx, "UPDATE episodes SET status = 'missing' WHERE series_id = ?", ids[1]); err != nil {
+		t.Fatalf("failed to mark episode missing: %v", err)
+	}
+
+	opts, err := ParseListOptions(url.Values{"sort": {"year"}, "order": {"desc"}})
+	if err != nil {
+		t.Fatalf("ParseListOptions() error = %v", err)
+	}
+	page, err := service.ListSeriesPage(ctx, opts, pagination.Params{Page: 1, PageSize: 2})
+	if err != nil {
+		t.Fatalf("ListSeriesPage() error = %v", err)
+	}
+	if page.TotalCount != 3 || page.TotalPages != 2 {
+		t.Errorf("ListSeriesPage() total = %d in %d pages, want 3 in 2", page.TotalCount, page.TotalPages)
+	}
+	if len(page.Items) != 2 || page.Items[0].Title != "Chernobyl" || page.Items[1].Title != "Barry" {
+		t.Errorf("ListSeriesPage() = %d items, want Chernobyl then Barry", len(page.Items))
+	}
+
+	missing := true
+	list, err := service.ListSeries(ctx, ListSeriesOptions{Missing: &missing})
+	if err != nil {
+		t.Fatalf("ListSeries() missing error = %v", err)
+	}
+	if len(list) != 1 || list[0].ID != ids[1] || list[0].StatusCounts.Missing != 1 {
+		t.Errorf("ListSeries() missing returned %d series, want only Barry", len(list))
+	}
+}
+
 func TestTVService_UpdateSeries(t *testing.T) {
 	tdb := testutil.NewTestDB(t)
 	defer tdb.Close()
@@ -300,6 +351,35 @@ func TestTVService_UpdateSeries_NotFound(t *testing.T) {
 	}
 }
 
+func TestTVService_LanguageProfile(t *testing.T) {
+	tdb := testutil.NewTestDB(t)
+	defer tdb.Close()
+
+	service := NewService(tdb.Conn, nil, &tdb.Logger, nil, nil)
+	ctx := context.Background()
+
+	profile, err := sqlc.New(tdb.Conn).CreateLanguageProfile(ctx, sqlc.CreateLanguageProfileParams{Name: "Japanese", Languages: `["ja"]`})
+	if err != nil {
+		t.Fatalf("CreateLanguageProfile() error = %v", err)
+	}
+
+	if _, err := service.CreateSeries(ctx, &CreateSeriesInput{Title: "Shogun", LanguageProfileID: 999}); !errors.Is(err, ErrInvalidSeries) {
+		t.Errorf("CreateSeries() with unknown language profile error = %v, want ErrInvalidSeries", err)
+	}
+	created, err := service.CreateSeries(ctx, &CreateSeriesInput{Title: "Shogun", LanguageProfileID: profile.ID})
+	if err != nil {
+		t.Fatalf("CreateSeries() error = %v", err)
+	}
+	if created.LanguageProfileID != profile.ID {
+		t.Errorf("CreateSeries() series.LanguageProfileID = %d, want %d", created.LanguageProfileID, profile.ID)
+	}
+
+	unknown := int64(999)
+	if _, err := service.UpdateSeries(ctx, created.ID, &UpdateSeriesInput{LanguageProfileID: &unknown}); !errors.Is(err, ErrInvalidSeries) {
+		t.Errorf("UpdateSeries() with unknown language profile error = %v, want ErrInvalidSeries", err)
+	}
+}
+
 func TestTVService_DeleteSeries(t *testing.T) {
 	tdb := testutil.NewTestDB(t)
 	defer tdb.Close()
@@ -309,7 +389,7 @@ func TestTVService_DeleteSeries(t *testing.T) {
 
 	created, _ := service.CreateSeries(ctx, &CreateSeriesInput{Title: "To Delete"})
 
-	err := service.DeleteSeries(ctx, created.ID, false)
+	err := service.DeleteSeries(ctx, created.ID, library.DeleteOptions{})
 	if err != nil {
 		t.Fatalf("DeleteSeries() error = %v", err)
 	}
@@ -327,7 +407,7 @@ func TestTVService_DeleteSeries_NotFound(t *testing.T) {
 	service := NewService(tdb.Conn, nil, &tdb.Logger, nil, nil)
 	ctx := context.Background()
 
-	err := service.DeleteSeries(ctx, 99999, false)
+	err := service.DeleteSeries(ctx, 99999, library.DeleteOptions{})
 	if !errors.Is(err, ErrSeriesNotFound) {
 		t.Errorf("DeleteSeries() non-existent error = %v, want %v", err, ErrSeriesNotFound)
 	}
@@ -487,6 +567,48 @@ func TestTVService_GetEpisode_NotFound(t *testing.T) {
 	}
 }
 
+func TestTVService_AbsoluteEpisodeNumbers(t *testing.T) {
+	tdb := testutil.NewTestDB(t)
+	defer tdb.Close()
+
+	service := NewService(tdb.Conn, nil, &tdb.Logger, nil, nil)
+	ctx := context.Background()
+
+	series, _ := service.CreateSeries(ctx, &CreateSeriesInput{
+		Title:      "Anime",
+		FormatType: FormatTypeAnime,
+		Seasons: []SeasonInput{
+			{SeasonNumber: 0, Episodes: []EpisodeInput{{EpisodeNumber: 1, Title: "OVA"}}},
+			{SeasonNumber: 1, Episodes: []EpisodeInput{{EpisodeNumber: 1, Title: "S1E1"}, {EpisodeNumber: 2, Title: "S1E2"}}},
+			{SeasonNumber: 2, Episodes: []EpisodeInput{{EpisodeNumber: 1, Title: "S2E1"}}},
+		},
+	})
+
+	episode, err := service.GetEpisodeByAbsoluteNumber(ctx, series.ID, 3)
+	if err != nil {
+		t.Fatalf("GetEpisodeByAbsoluteNumber() error = %v", err)
+	}
+	if episode.SeasonNumber != 2 || episode.EpisodeNumber != 1 {
+		t.Errorf("absolute 3 = S%02dE%02d, want S02E01", episode.SeasonNumber, episode.EpisodeNumber)
+	}
+
+	if _, err := service.GetEpisodeByAbsoluteNumber(ctx, series.ID, 4); !errors.Is(err, ErrEpisodeNotFound) {
+		t.Errorf("GetEpisodeByAbsoluteNumber() past end error = %v, want %v", err, ErrEpisodeNotFound)
+	}
+
+	abs, err := service.GetAbsoluteNumber(ctx, series.ID, 1, 2)
+	if err != nil {
+		t.Fatalf("GetAbsoluteNumber() error = %v", err)
+	}
+	if abs != 2 {
+		t.Errorf("GetAbsoluteNumber(S01E02) = %d, want 2", abs)
+	}
+
+	if abs, _ := service.GetAbsoluteNumber(ctx, series.ID, 0, 1); abs != 0 {
+		t.Errorf("GetAbsoluteNumber(special) = %d, want 0", abs)
+	}
+}
+
 func TestTVService_UpdateEpisode(t *testing.T) {
 	tdb := testutil.NewTestDB(t)
 	defer tdb.Close()
@@ -599,6 +721,63 @@ func TestTVService_RemoveEpisodeFile(t *testing.T) {
 	}
 }
 
+func TestTVService_MultiEpisodeFile(t *testing.T) {
+	tdb := testutil.NewTestDB(t)
+	defer tdb.Close()
+
+	service := NewService(tdb.Conn, nil, &tdb.Logger, nil, nil)
+	ctx := context.Background()
+
+	series, _ := service.CreateSeries(ctx, &CreateSeriesInput{
+		Title: "Test",
+		Seasons: []SeasonInput{
+			{SeasonNumber: 1, Episodes: []EpisodeInput{{EpisodeNumber: 1, Title: "Part 1"}, {EpisodeNumber: 2, Title: "Part 2"}}},
+		},
+	})
+	episodes, _ := service.ListEpisodes(ctx, series.ID, nil)
+	ids := []int64{episodes[0].ID, episodes[1].ID}
+
+	file, err := service.AddMultiEpisodeFile(ctx, ids, &CreateEpisodeFileInput{Path: "/S01E01E02.mkv", Size: 1000})
+	if err != nil {
+		t.Fatalf("AddMultiEpisodeFile() error = %v", err)
+	}
+
+	second, _ := service.GetEpisode(ctx, ids[1])
+	if second.EpisodeFile == nil || second.EpisodeFile.PrimaryFileID == nil || *second.EpisodeFile.PrimaryFileID != file.ID {
+		t.Fatalf("second episode file = %+v, want linked to %d", second.EpisodeFile, file.ID)
+	}
+	if second.Status != "available" {
+		t.Errorf("second episode status = %q, want available", second.Status)
+	}
+
+	covered, err := service.FileEpisodes(ctx, second.EpisodeFile.ID)
+	if err != nil {
+		t.Fatalf("FileEpisodes() error = %v", err)
+	}
+	if len(covered) != 2 || covered[0].EpisodeNumber != 1 || covered[1].EpisodeNumber != 2 {
+		t.Errorf("FileEpisodes() = %d episodes, want E01 and E02", len(covered))
+	}
+
+	if err := service.UpdateEpisodeFilePath(ctx, second.EpisodeFile.ID, "/S01E01-E02.mkv"); err != nil {
+		t.Fatalf("UpdateEpisodeFilePath() error = %v", err)
+	}
+	for _, id := range ids {
+		ep, _ := service.GetEpisode(ctx, id)
+		if ep.EpisodeFile == nil || ep.EpisodeFile.Path != "/S01E01-E02.mkv" {
+			t.Errorf("episode %d file = %+v, want renamed path", id, ep.EpisodeFile)
+		}
+	}
+
+	if err := service.RemoveEpisodeFile(ctx, file.ID); err != nil {
+		t.Fatalf("RemoveEpisodeFile() error = %v", err)
+	}
+	for _, id := range ids {
+		if ep, _ := service.GetEpisode(ctx, id); ep.EpisodeFile != nil {
+			t.Errorf("episode %d still has a file after removing the multi-episode file", id)
+		}
+	}
+}
+
 func TestTVService_RemoveEpisodeFile_NotFound(t *testing.T) {
 	tdb := testutil.NewTestDB(t)
 	defer tdb.Close()
@@ -971,6 +1150,64 @@ func TestGenerateSeriesPath(t *testing.T) {
 	}
 }
 
+func TestTVService_BulkMonitor_Pilot(t *testing.T) {
+	tdb := testutil.NewTestDB(t)
+	defer tdb.Close()
+
+	service := NewService(tdb.Conn, nil, &tdb.Logger, nil, nil)
+	ctx := context.Background()
+
+	series, err := service.CreateSeries(ctx, &CreateSeriesInput{
+		Title:     "Pilot Test",
+		Monitored: true,
+		Seasons: []SeasonInput{
+			{
+				SeasonNumber: 1,
+				Monitored:    true,
+				Episodes: []EpisodeInput{
+					{EpisodeNumber: 1, Title: "S1E1", Monitored: true},
+					{EpisodeNumber: 2, Title: "S1E2", Monitored: true},
+				},
+			},
+			{
+				SeasonNumber: 2,
+				Monitored:    true,
+				Episodes: []EpisodeInput{
+					{EpisodeNumber: 1, Title: "S2E1", Monitored: true},
+				},
+			},
+		},
+	})
+	if err != nil {
+		t.Fatalf("CreateSeries() error = %v", err)
+	}
+
+	if err := service.BulkMonitor(ctx, series.ID, BulkMonitorInput{MonitorType: MonitorTypePilot}); err != nil {
+		t.Fatalf("BulkMonitor(pilot) error = %v", err)
+	}
+
+	episodes, err := service.ListEpisodes(ctx, series.ID, nil)
+	if err != nil {
+		t.Fatalf("ListEpisodes() error = %v", err)
+	}
+	for _, ep := range episodes {
+		want := ep.SeasonNumber == 1 && ep.EpisodeNumber == 1
+		if ep.Monitored != want {
+			t.Errorf("S%02dE%02d monitored = %v, want %v", ep.SeasonNumber, ep.EpisodeNumber, ep.Monitored, want)
+		}
+	}
+
+	seasons, err := service.ListSeasons(ctx, series.ID)
+	if err != nil {
+		t.Fatalf("ListSeasons() error = %v", err)
+	}
+	for _, season := range seasons {
+		if want := season.SeasonNumber == 1; season.Monitored != want {
+			t.Errorf("season %d monitored = %v, want %v", season.SeasonNumber, season.Monitored, want)
+		}
+	}
+}
+
 func TestGenerateSeasonPath(t *testing.T) {
 	got := GenerateSeasonPath("/tv/Breaking Bad", 1)
 	want := "/tv/Breaking Bad/Season 01"
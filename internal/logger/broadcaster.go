@@ -24,6 +24,9 @@ type LogBroadcaster struct {
 	buffer     *RingBuffer[LogEntry]
 	bufferSize int
 	mu         sync.RWMutex
+
+	subscribers map[int]chan LogEntry
+	nextSubID   int
 }
 
 // NewLogBroadcaster creates a new log broadcaster.
@@ -59,6 +62,13 @@ func (b *LogBroadcaster) Write(p []byte) (n int, err error) {
 
 	b.mu.RLock()
 	hub := b.hub
+	for _, ch := range b.subscribers {
+		// Slow tail readers miss entries rather than stall logging
+		select {
+		case ch <- entry:
+		default:
+		}
+	}
 	b.mu.RUnlock()
 
 	if hub != nil {
@@ -73,6 +83,31 @@ func (b *LogBroadcaster) GetRecentLogs() []LogEntry {
 	return b.buffer.GetAll()
 }
 
+// Subscribe returns a channel receiving every new log entry and a function
+// that ends the subscription. Entries are dropped while the channel is full.
+func (b *LogBroadcaster) Subscribe(buffer int) (entries <-chan LogEntry, unsubscribe func()) {
+	ch := make(chan LogEntry, buffer)
+
+	b.mu.Lock()
+	if b.subscribers == nil {
+		b.subscribers = make(map[int]chan LogEntry)
+	}
+	id := b.nextSubID
+	b.nextSubID++
+	b.subscribers[id] = ch
+	b.mu.Unlock()
+
+	var once sync.Once
+	return ch, func() {
+		once.Do(func() {
+			b.mu.Lock()
+			delete(b.subscribers, id)
+			b.mu.Unlock()
+			close(ch)
+		})
+	}
+}
+
 // parseLogEntry parses a zerolog JSON entry into a LogEntry.
 func (b *LogBroadcaster) parseLogEntry(data []byte) (LogEntry, error) {
 	var raw map[string]any
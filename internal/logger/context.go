@@ -0,0 +1,33 @@
+package logger
+
+import (
+	"context"
+
+	"github.com/rs/zerolog"
+)
+
+type correlationIDKey struct{}
+
+// WithCorrelationID returns a copy of ctx carrying the ID of the API request
+// it belongs to.
+func WithCorrelationID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, correlationIDKey{}, id)
+}
+
+// CorrelationID returns the request ID carried by ctx, if any.
+func CorrelationID(ctx context.Context) string {
+	id, _ := ctx.Value(correlationIDKey{}).(string)
+	return id
+}
+
+// FromContext returns base tagged with the correlation ID carried by ctx, so
+// a component's log lines can be matched to the request that caused them.
+// Outside a request it returns base unchanged.
+func FromContext(ctx context.Context, base *zerolog.Logger) *zerolog.Logger {
+	id := CorrelationID(ctx)
+	if id == "" {
+		return base
+	}
+	l := base.With().Str("correlationId", id).Logger()
+	return &l
+}
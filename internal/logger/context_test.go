@@ -0,0 +1,26 @@
+package logger
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/rs/zerolog"
+)
+
+func TestFromContext(t *testing.T) {
+	var buf bytes.Buffer
+	base := zerolog.New(&buf).With().Str("component", "grab").Logger()
+
+	if got := FromContext(context.Background(), &base); got != &base {
+		t.Error("FromContext without a correlation ID should return the base logger")
+	}
+
+	ctx := WithCorrelationID(context.Background(), "req-1")
+	FromContext(ctx, &base).Info().Msg("grabbed")
+	out := buf.String()
+	if !strings.Contains(out, `"correlationId":"req-1"`) || !strings.Contains(out, `"component":"grab"`) {
+		t.Errorf("expected component and correlation ID in output:\n%s", out)
+	}
+}
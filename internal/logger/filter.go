@@ -0,0 +1,40 @@
+package logger
+
+import "strings"
+
+// Filter selects log entries by minimum level, component and message text.
+// Zero values match everything.
+type Filter struct {
+	Level     string
+	Component string
+	Search    string
+	Limit     int
+}
+
+// Matches reports whether entry passes the filter.
+func (f *Filter) Matches(entry *LogEntry) bool {
+	if f.Level != "" && parseLevel(entry.Level) < parseLevel(f.Level) {
+		return false
+	}
+	if f.Component != "" && !strings.EqualFold(entry.Component, f.Component) {
+		return false
+	}
+	if f.Search != "" && !strings.Contains(strings.ToLower(entry.Message), strings.ToLower(f.Search)) {
+		return false
+	}
+	return true
+}
+
+// Apply returns the matching entries, keeping only the newest Limit when set.
+func (f *Filter) Apply(entries []LogEntry) []LogEntry {
+	matched := make([]LogEntry, 0, len(entries))
+	for i := range entries {
+		if f.Matches(&entries[i]) {
+			matched = append(matched, entries[i])
+		}
+	}
+	if f.Limit > 0 && len(matched) > f.Limit {
+		matched = matched[len(matched)-f.Limit:]
+	}
+	return matched
+}
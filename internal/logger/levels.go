@@ -0,0 +1,132 @@
+package logger
+
+import (
+	"bytes"
+	"io"
+	"sync/atomic"
+
+	"github.com/rs/zerolog"
+)
+
+// levelState is the default level plus per-component overrides.
+type levelState struct {
+	base       string
+	components map[string]string
+	def        zerolog.Level
+	overrides  map[string]zerolog.Level
+	max        zerolog.Level
+}
+
+func newLevelState(base string, components map[string]string) *levelState {
+	st := &levelState{
+		base:       base,
+		components: make(map[string]string, len(components)),
+		def:        effectiveLevel(base),
+		overrides:  make(map[string]zerolog.Level, len(components)),
+	}
+	st.max = st.def
+	for component, level := range components {
+		if component == "" || level == "" {
+			continue
+		}
+		lvl := parseLevel(level)
+		st.components[component] = level
+		st.overrides[component] = lvl
+		st.max = max(st.max, lvl)
+	}
+	return st
+}
+
+// lowest returns the most verbose level any component needs, which zerolog
+// must allow globally so those events are produced at all.
+func (st *levelState) lowest() zerolog.Level {
+	lowest := st.def
+	for _, lvl := range st.overrides {
+		lowest = min(lowest, lvl)
+	}
+	return lowest
+}
+
+// levelFilter drops events below their component's level. zerolog's global
+// level is set to the most verbose override, so the filter only has to
+// inspect events that some component would discard.
+type levelFilter struct {
+	out   io.Writer
+	state atomic.Pointer[levelState]
+}
+
+func newLevelFilter(out io.Writer, base string, components map[string]string) *levelFilter {
+	f := &levelFilter{out: out}
+	f.set(newLevelState(base, components))
+	return f
+}
+
+func (f *levelFilter) set(st *levelState) {
+	f.state.Store(st)
+	zerolog.SetGlobalLevel(st.lowest())
+}
+
+// Write implements io.Writer for events without a level.
+func (f *levelFilter) Write(p []byte) (int, error) {
+	return f.out.Write(p)
+}
+
+// WriteLevel implements zerolog.LevelWriter.
+func (f *levelFilter) WriteLevel(level zerolog.Level, p []byte) (int, error) {
+	st := f.state.Load()
+	if level < st.max {
+		threshold := st.def
+		if lvl, ok := st.overrides[componentOf(p)]; ok {
+			threshold = lvl
+		}
+		if level < threshold {
+			return len(p), nil
+		}
+	}
+	return f.out.Write(p)
+}
+
+var componentKey = []byte(`"component":"`)
+
+// componentOf extracts the component field from a JSON log line without a
+// full parse.
+func componentOf(p []byte) string {
+	i := bytes.Index(p, componentKey)
+	if i < 0 {
+		return ""
+	}
+	rest := p[i+len(componentKey):]
+	end := bytes.IndexByte(rest, '"')
+	if end < 0 {
+		return ""
+	}
+	return string(rest[:end])
+}
+
+// LevelConfig is the default log level and its per-component overrides.
+type LevelConfig struct {
+	Level      string            `json:"level"`
+	Components map[string]string `json:"components"`
+}
+
+// Levels returns the current level configuration.
+func (l *Logger) Levels() LevelConfig {
+	if l.levels == nil {
+		return LevelConfig{Level: zerolog.GlobalLevel().String(), Components: map[string]string{}}
+	}
+	st := l.levels.state.Load()
+	components := make(map[string]string, len(st.components))
+	for component, level := range st.components {
+		components[component] = level
+	}
+	return LevelConfig{Level: st.base, Components: components}
+}
+
+// SetComponentLevels replaces the per-component level overrides, e.g.
+// {"indexer": "debug"} to debug indexers without flooding the log.
+func (l *Logger) SetComponentLevels(components map[string]string) {
+	if l.levels == nil {
+		return
+	}
+	l.levels.set(newLevelState(l.levels.state.Load().base, components))
+}
@@ -0,0 +1,93 @@
+package logger
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/rs/zerolog"
+)
+
+func TestLevelFilter_ComponentOverrides(t *testing.T) {
+	defer zerolog.SetGlobalLevel(zerolog.TraceLevel)
+
+	var buf bytes.Buffer
+	// Tests run as a "go run"-style build, which never logs above debug by default
+	filter := newLevelFilter(&buf, "debug", map[string]string{"indexer": "trace", "noisy": "error"})
+	log := zerolog.New(filter)
+	indexer := log.With().Str("component", "indexer").Logger()
+	noisy := log.With().Str("component", "noisy").Logger()
+
+	log.Trace().Msg("default-trace")
+	log.Debug().Msg("default-debug")
+	indexer.Trace().Msg("indexer-trace")
+	noisy.Warn().Msg("noisy-warn")
+	noisy.Error().Msg("noisy-error")
+
+	out := buf.String()
+	for _, want := range []string{"default-debug", "indexer-trace", "noisy-error"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("expected %q in output:\n%s", want, out)
+		}
+	}
+	for _, unwanted := range []string{"default-trace", "noisy-warn"} {
+		if strings.Contains(out, unwanted) {
+			t.Errorf("did not expect %q in output:\n%s", unwanted, out)
+		}
+	}
+}
+
+func TestLogger_SetComponentLevels(t *testing.T) {
+	defer zerolog.SetGlobalLevel(zerolog.TraceLevel)
+
+	l := New(&Config{Level: "error", Format: "json"})
+	l.SetComponentLevels(map[string]string{"indexer": "debug"})
+
+	levels := l.Levels()
+	if levels.Level != "error" || levels.Components["indexer"] != "debug" {
+		t.Errorf("Levels() = %+v", levels)
+	}
+	if zerolog.GlobalLevel() != zerolog.DebugLevel {
+		t.Errorf("global level = %s, want debug so overrides can log", zerolog.GlobalLevel())
+	}
+
+	l.SetLevel("warn")
+	if got := l.Levels(); got.Level != "warn" || got.Components["indexer"] != "debug" {
+		t.Errorf("SetLevel dropped overrides: %+v", got)
+	}
+}
+
+func TestFilter_Apply(t *testing.T) {
+	entries := []LogEntry{
+		{Level: "debug", Component: "indexer", Message: "query sent"},
+		{Level: "info", Component: "indexer", Message: "Search done"},
+		{Level: "error", Component: "downloader", Message: "search failed"},
+		{Level: "warn", Component: "indexer", Message: "rate limited"},
+	}
+
+	tests := []struct {
+		name   string
+		filter Filter
+		want   int
+	}{
+		{"no filter", Filter{}, 4},
+		{"min level", Filter{Level: "info"}, 3},
+		{"component", Filter{Component: "Indexer"}, 3},
+		{"search is case-insensitive", Filter{Search: "SEARCH"}, 2},
+		{"combined", Filter{Level: "info", Component: "indexer", Search: "search"}, 1},
+		{"limit keeps newest", Filter{Limit: 2}, 2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.filter.Apply(entries)
+			if len(got) != tt.want {
+				t.Errorf("Apply() returned %d entries, want %d", len(got), tt.want)
+			}
+		})
+	}
+
+	limited := (&Filter{Limit: 1}).Apply(entries)
+	if limited[0].Message != "rate limited" {
+		t.Errorf("limit kept %q, want newest entry", limited[0].Message)
+	}
+}
@@ -19,19 +19,21 @@ type Logger struct {
 	zerolog.Logger
 	rotator     *lumberjack.Logger
 	broadcaster *LogBroadcaster
+	levels      *levelFilter
 }
 
 // Config holds logger configuration.
 type Config struct {
 	Level           string
-	Format          string // "console" or "json"
-	Path            string // directory for log files
-	MaxSizeMB       int    // max size in MB before rotation (default: 10)
-	MaxBackups      int    // max number of old log files to keep (default: 5)
-	MaxAgeDays      int    // max age in days to keep old files (default: 30)
-	Compress        bool   // compress rotated files (default: true)
-	EnableStreaming bool   // enable log streaming with ring buffer
-	BufferSize      int    // ring buffer size for recent logs (default: 1000)
+	Components      map[string]string // per-component level overrides
+	Format          string            // "console" or "json"
+	Path            string            // directory for log files
+	MaxSizeMB       int               // max size in MB before rotation (default: 10)
+	MaxBackups      int               // max number of old log files to keep (default: 5)
+	MaxAgeDays      int               // max age in days to keep old files (default: 30)
+	Compress        bool              // compress rotated files (default: true)
+	EnableStreaming bool              // enable log streaming with ring buffer
+	BufferSize      int               // ring buffer size for recent logs (default: 1000)
 }
 
 // IsDevBuild returns true if running via "go run" (development mode).
@@ -50,7 +52,6 @@ func IsDevBuild() bool {
 // unless a more verbose level (trace) is explicitly configured.
 func New(cfg *Config) *Logger {
 	consoleOutput := newConsoleOutput(cfg.Format)
-	level := effectiveLevel(cfg.Level)
 
 	output := consoleOutput
 	var rotator *lumberjack.Logger
@@ -65,13 +66,25 @@ func New(cfg *Config) *Logger {
 		output = io.MultiWriter(output, logBroadcaster)
 	}
 
-	logger := zerolog.New(output).
-		Level(level).
+	// Levels are enforced globally and by the filter rather than on the
+	// logger, so changes also reach sub-loggers created from this one.
+	levels := newLevelFilter(output, cfg.Level, cfg.Components)
+	logger := zerolog.New(levels).
 		With().
 		Timestamp().
 		Logger()
 
-	return &Logger{Logger: logger, rotator: rotator, broadcaster: logBroadcaster}
+	return &Logger{Logger: logger, rotator: rotator, broadcaster: logBroadcaster, levels: levels}
+}
+
+// SetLevel changes the log level at runtime, e.g. after a config reload.
+// Per-component overrides are kept.
+func (l *Logger) SetLevel(level string) {
+	if l.levels == nil {
+		zerolog.SetGlobalLevel(effectiveLevel(level))
+		return
+	}
+	l.levels.set(newLevelState(level, l.levels.state.Load().components))
 }
 
 func newConsoleOutput(format string) io.Writer {
@@ -142,6 +155,15 @@ func (l *Logger) GetRecentLogs() []LogEntry {
 	return l.broadcaster.GetRecentLogs()
 }
 
+// SubscribeLogs streams new log entries until unsubscribe is called.
+// It returns a nil channel when streaming is disabled.
+func (l *Logger) SubscribeLogs(buffer int) (entries <-chan LogEntry, unsubscribe func()) {
+	if l.broadcaster == nil {
+		return nil, func() {}
+	}
+	return l.broadcaster.Subscribe(buffer)
+}
+
 // GetLogFilePath returns the path to the current log file, if any.
 func (l *Logger) GetLogFilePath() string {
 	if l.rotator == nil {
@@ -173,6 +195,8 @@ func parseLevel(level string) zerolog.Level {
 		return zerolog.ErrorLevel
 	case "fatal":
 		return zerolog.FatalLevel
+	case "panic":
+		return zerolog.PanicLevel
 	default:
 		return zerolog.InfoLevel
 	}
@@ -131,7 +131,9 @@ type mediaInfoTrack struct {
 	TransferCharacteristics string `json:"transfer_characteristics"`
 	MatrixCoefficients      string `json:"matrix_coefficients"`
 	HDRFormat               string `json:"HDR_Format"`
+	HDRFormatProfile        string `json:"HDR_Format_Profile"`
 	HDRFormatCompatibility  string `json:"HDR_Format_Compatibility"`
+	FormatCommercial        string `json:"Format_Commercial_IfAny"`
 	Channels                string `json:"Channels"`
 	ChannelLayout           string `json:"ChannelLayout"`
 	Language                string `json:"Language"`
@@ -161,10 +163,13 @@ func parseMediaInfoJSON(data []byte) (*MediaInfo, error) {
 				parseMediaInfoVideo(info, track)
 			}
 		case "Audio":
+			audioTrack := mediaInfoAudioTrack(track)
 			if !firstAudio {
 				firstAudio = true
-				parseMediaInfoFirstAudio(info, track)
+				info.AudioCodec = audioTrack.Codec
+				info.AudioChannels = audioTrack.Channels
 			}
+			info.AudioTracks = append(info.AudioTracks, audioTrack)
 			audioLangs = collectLanguage(audioLangs, track.Language)
 		case "Text":
 			subLangs = collectLanguage(subLangs, track.Language)
@@ -226,13 +231,27 @@ func parseMediaInfoVideo(info *MediaInfo, track *mediaInfoTrack) {
 		HDRFormat:      track.HDRFormat + " " + track.HDRFormatCompatibility,
 	}
 	info.DynamicRange, info.DynamicRangeType = DetectHDRType(&hdrInfo)
+	if containsDolbyVision(track.HDRFormat) {
+		info.DolbyVisionProfile = parseDolbyVisionProfile(track.HDRFormatProfile, track.HDRFormatCompatibility)
+	}
 }
 
-func parseMediaInfoFirstAudio(info *MediaInfo, track *mediaInfoTrack) {
-	hasAtmos := strings.Contains(strings.ToLower(track.Format+track.FormatProfile), "atmos")
-	info.AudioCodec = NormalizeAudioCodec(track.Format, hasAtmos)
+func mediaInfoAudioTrack(track *mediaInfoTrack) AudioTrack {
+	hasAtmos := strings.Contains(strings.ToLower(track.Format+track.FormatProfile+track.FormatCommercial), "atmos")
 	channels, _ := parseInt(track.Channels)
-	info.AudioChannels = FormatChannels(channels, track.ChannelLayout)
+	return AudioTrack{
+		Codec:    NormalizeAudioCodec(track.Format, hasAtmos),
+		Channels: FormatChannels(channels, track.ChannelLayout),
+		Language: trackLanguage(track.Language),
+	}
+}
+
+// trackLanguage normalizes a track language, dropping undetermined ones.
+func trackLanguage(language string) string {
+	if language == "" || language == undeterminedLanguage {
+		return ""
+	}
+	return normalizeLanguage(language)
 }
 
 // ffprobeOutput represents the JSON output from ffprobe.
@@ -252,6 +271,7 @@ type ffprobeStream struct {
 	CodecType      string            `json:"codec_type"`
 	CodecName      string            `json:"codec_name"`
 	CodecTagString string            `json:"codec_tag_string"`
+	Profile        string            `json:"profile"`
 	Width          int               `json:"width"`
 	Height         int               `json:"height"`
 	PixFmt         string            `json:"pix_fmt"`
@@ -266,10 +286,13 @@ type ffprobeStream struct {
 
 type ffprobeTags struct {
 	Language string `json:"language"`
+	Title    string `json:"title"`
 }
 
 type ffprobeSideData struct {
-	SideDataType string `json:"side_data_type"`
+	SideDataType            string `json:"side_data_type"`
+	DVProfile               int    `json:"dv_profile"`
+	DVBLSignalCompatibility int    `json:"dv_bl_signal_compatibility_id"`
 }
 
 // parseFFprobeJSON parses ffprobe JSON output.
@@ -294,10 +317,13 @@ func parseFFprobeJSON(data []byte) (*MediaInfo, error) {
 				parseFFprobeVideo(info, stream)
 			}
 		case "audio":
+			audioTrack := ffprobeAudioTrack(stream)
 			if !firstAudio {
 				firstAudio = true
-				parseFFprobeFirstAudio(info, stream)
+				info.AudioCodec = audioTrack.Codec
+				info.AudioChannels = audioTrack.Channels
 			}
+			info.AudioTracks = append(info.AudioTracks, audioTrack)
 			audioLangs = collectLanguage(audioLangs, stream.Tags.Language)
 		case "subtitle":
 			subLangs = collectLanguage(subLangs, stream.Tags.Language)
@@ -310,9 +336,15 @@ func parseFFprobeJSON(data []byte) (*MediaInfo, error) {
 	return info, nil
 }
 
-func parseFFprobeFirstAudio(info *MediaInfo, stream *ffprobeStream) {
-	info.AudioCodec = NormalizeAudioCodec(stream.CodecName, false)
-	info.AudioChannels = FormatChannels(stream.Channels, stream.ChannelLayout)
+// ffprobeAudioTrack describes an audio stream. ffprobe reports Atmos only
+// in the profile or the track title.
+func ffprobeAudioTrack(stream *ffprobeStream) AudioTrack {
+	hasAtmos := strings.Contains(strings.ToLower(stream.Profile+" "+stream.Tags.Title), "atmos")
+	return AudioTrack{
+		Codec:    NormalizeAudioCodec(stream.CodecName, hasAtmos),
+		Channels: FormatChannels(stream.Channels, stream.ChannelLayout),
+		Language: trackLanguage(stream.Tags.Language),
+	}
 }
 
 func parseFFprobeFormat(info *MediaInfo, format *ffprobeFormat) {
@@ -347,8 +379,13 @@ func parseFFprobeVideo(info *MediaInfo, stream *ffprobeStream) {
 	}
 
 	for _, sd := range stream.SideDataList {
-		if strings.Contains(strings.ToLower(sd.SideDataType), "dolby vision") {
+		sideData := strings.ToLower(sd.SideDataType)
+		switch {
+		case strings.Contains(sideData, "dolby vision"), strings.Contains(sideData, "dovi"):
 			hdrInfo.HasDolbyVision = true
+			info.DolbyVisionProfile = FormatDolbyVisionProfile(sd.DVProfile, sd.DVBLSignalCompatibility)
+		case strings.Contains(sideData, "2094-40"), strings.Contains(sideData, "hdr10+"):
+			hdrInfo.HasHDR10Plus = true
 		}
 	}
 
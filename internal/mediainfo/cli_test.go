@@ -0,0 +1,114 @@
+package mediainfo
+
+import "testing"
+
+func TestParseFFprobeJSON_DolbyVisionAndAudioTracks(t *testing.T) {
+	data := []byte(`{
+		"format": {"format_name": "matroska,webm", "duration": "5400.5", "size": "1000"},
+		"streams": [
+			{
+				"codec_type": "video", "codec_name": "hevc", "width": 3840, "height": 2160,
+				"pix_fmt": "yuv420p10le", "color_primaries": "bt2020", "color_transfer": "smpte2084",
+				"side_data_list": [{"side_data_type": "DOVI configuration record", "dv_profile": 8, "dv_bl_signal_compatibility_id": 1}]
+			},
+			{"codec_type": "audio", "codec_name": "truehd", "channels": 8, "channel_layout": "7.1", "tags": {"language": "eng", "title": "TrueHD Atmos 7.1"}},
+			{"codec_type": "audio", "codec_name": "ac3", "channels": 6, "channel_layout": "5.1(side)", "tags": {"language": "ger"}},
+			{"codec_type": "subtitle", "codec_name": "subrip", "tags": {"language": "eng"}}
+		]
+	}`)
+
+	info, err := parseFFprobeJSON(data)
+	if err != nil {
+		t.Fatalf("parseFFprobeJSON() error = %v", err)
+	}
+	if info.DolbyVisionProfile != "8.1" {
+		t.Errorf("DolbyVisionProfile = %q, want %q", info.DolbyVisionProfile, "8.1")
+	}
+	if info.DynamicRangeType != "DV HDR10" {
+		t.Errorf("DynamicRangeType = %q, want %q", info.DynamicRangeType, "DV HDR10")
+	}
+	if len(info.AudioTracks) != 2 {
+		t.Fatalf("AudioTracks = %v, want 2 tracks", info.AudioTracks)
+	}
+	if got := info.AudioTracks[0].String(); got != "TrueHD Atmos 7.1 ENG" {
+		t.Errorf("AudioTracks[0] = %q, want %q", got, "TrueHD Atmos 7.1 ENG")
+	}
+	if got := info.AudioTracks[1].String(); got != "AC3 5.1 GER" {
+		t.Errorf("AudioTracks[1] = %q, want %q", got, "AC3 5.1 GER")
+	}
+	if info.AudioCodec != info.AudioTracks[0].Codec {
+		t.Errorf("AudioCodec = %q, want first track codec %q", info.AudioCodec, info.AudioTracks[0].Codec)
+	}
+	if len(info.SubtitleLanguages) != 1 || info.SubtitleLanguages[0] != "ENG" {
+		t.Errorf("SubtitleLanguages = %v, want [ENG]", info.SubtitleLanguages)
+	}
+}
+
+func TestParseMediaInfoJSON_DolbyVisionAndAudioTracks(t *testing.T) {
+	data := []byte(`{"media": {"track": [
+		{"@type": "General", "Format": "Matroska", "Duration": "5400.5", "FileSize": "1000"},
+		{
+			"@type": "Video", "Format": "HEVC", "Width": "3840", "Height": "2160", "BitDepth": "10",
+			"colour_primaries": "BT.2020", "transfer_characteristics": "PQ",
+			"HDR_Format": "Dolby Vision, Version 1.0", "HDR_Format_Profile": "dvhe.08.06",
+			"HDR_Format_Compatibility": "HDR10"
+		},
+		{"@type": "Audio", "Format": "MLP FBA", "Format_Commercial_IfAny": "Dolby TrueHD with Dolby Atmos", "Channels": "8", "ChannelLayout": "L R C LFE Ls Rs Lb Rb", "Language": "en"},
+		{"@type": "Audio", "Format": "AC-3", "Channels": "6", "Language": "und"},
+		{"@type": "Text", "Language": "en"}
+	]}}`)
+
+	info, err := parseMediaInfoJSON(data)
+	if err != nil {
+		t.Fatalf("parseMediaInfoJSON() error = %v", err)
+	}
+	if info.DolbyVisionProfile != "8.1" {
+		t.Errorf("DolbyVisionProfile = %q, want %q", info.DolbyVisionProfile, "8.1")
+	}
+	if len(info.AudioTracks) != 2 {
+		t.Fatalf("AudioTracks = %v, want 2 tracks", info.AudioTracks)
+	}
+	if info.AudioTracks[0].Language != "EN" {
+		t.Errorf("AudioTracks[0].Language = %q, want %q", info.AudioTracks[0].Language, "EN")
+	}
+	if info.AudioTracks[1].Language != "" {
+		t.Errorf("AudioTracks[1].Language = %q, want empty for undetermined", info.AudioTracks[1].Language)
+	}
+}
+
+func TestParseDolbyVisionProfile(t *testing.T) {
+	tests := []struct {
+		profile       string
+		compatibility string
+		want          string
+	}{
+		{"dvhe.08.06", "HDR10", "8.1"},
+		{"dvhe.08.06", "SDR", "8.2"},
+		{"dvhe.08.06", "HLG", "8.4"},
+		{"dvhe.05.06", "", "5"},
+		{"dvhe.07.06 / Main 10", "Blu-ray / HDR10", "7"},
+		{"Main 10", "HDR10", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.profile+"/"+tt.compatibility, func(t *testing.T) {
+			if got := parseDolbyVisionProfile(tt.profile, tt.compatibility); got != tt.want {
+				t.Errorf("parseDolbyVisionProfile(%q, %q) = %q, want %q", tt.profile, tt.compatibility, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAudioTracksRoundTrip(t *testing.T) {
+	if got := EncodeAudioTracks(nil); got != "[]" {
+		t.Errorf("EncodeAudioTracks(nil) = %q, want %q", got, "[]")
+	}
+	tracks := []AudioTrack{{Codec: "DTS-HD MA", Channels: "5.1", Language: "EN"}}
+	decoded := DecodeAudioTracks(EncodeAudioTracks(tracks))
+	if len(decoded) != 1 || decoded[0] != tracks[0] {
+		t.Errorf("DecodeAudioTracks() = %v, want %v", decoded, tracks)
+	}
+	if got := DecodeAudioTracks("not json"); got != nil {
+		t.Errorf("DecodeAudioTracks(invalid) = %v, want nil", got)
+	}
+}
@@ -1,6 +1,9 @@
 package mediainfo
 
-import "strings"
+import (
+	"strconv"
+	"strings"
+)
 
 // HDRInfo contains parsed HDR information.
 type HDRInfo struct {
@@ -63,7 +66,8 @@ func containsHDR10(transfer, primaries string) bool {
 	// HDR10 uses PQ (SMPTE 2084) transfer + BT.2020 color space
 	hasPQ := strings.Contains(lower, "smpte st 2084") ||
 		strings.Contains(lower, "pq") ||
-		strings.Contains(lower, "st2084")
+		strings.Contains(lower, "st2084") ||
+		strings.Contains(lower, "smpte2084")
 	hasBT2020 := strings.Contains(lower, "bt.2020") ||
 		strings.Contains(lower, "bt2020") ||
 		strings.Contains(lower, "rec.2020")
@@ -86,6 +90,52 @@ func containsHLG(transfer string) bool {
 		strings.Contains(lower, "arib std-b67")
 }
 
+// FormatDolbyVisionProfile formats a Dolby Vision profile and base layer
+// signal compatibility ID as "8.1". Only profile 8 carries a meaningful
+// compatibility ID; 0 means unknown.
+func FormatDolbyVisionProfile(profile, compatibilityID int) string {
+	if profile <= 0 {
+		return ""
+	}
+	if profile == 8 && compatibilityID > 0 {
+		return strconv.Itoa(profile) + "." + strconv.Itoa(compatibilityID)
+	}
+	return strconv.Itoa(profile)
+}
+
+// parseDolbyVisionProfile reads the profile from a mediainfo HDR format
+// profile such as "dvhe.08.06" and the compatibility from its HDR format
+// compatibility ("HDR10", "SDR", "HLG"), returning e.g. "8.1".
+func parseDolbyVisionProfile(formatProfile, compatibility string) string {
+	lower := strings.ToLower(formatProfile)
+	idx := -1
+	for _, prefix := range []string{"dvhe.", "dvh1.", "dvav.", "dva1.", "dav1."} {
+		if i := strings.Index(lower, prefix); i >= 0 {
+			idx = i + len(prefix)
+			break
+		}
+	}
+	if idx < 0 || idx+2 > len(lower) {
+		return ""
+	}
+	profile, err := strconv.Atoi(lower[idx : idx+2])
+	if err != nil {
+		return ""
+	}
+
+	compat := strings.ToLower(compatibility)
+	compatibilityID := 0
+	switch {
+	case strings.Contains(compat, "hdr10"):
+		compatibilityID = 1
+	case strings.Contains(compat, "sdr"):
+		compatibilityID = 2
+	case strings.Contains(compat, "hlg"):
+		compatibilityID = 4
+	}
+	return FormatDolbyVisionProfile(profile, compatibilityID)
+}
+
 // isHDRColorSpace checks if the color properties indicate an HDR color space.
 func isHDRColorSpace(primaries, transfer string) bool {
 	lower := strings.ToLower(primaries + " " + transfer)
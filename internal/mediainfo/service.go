@@ -33,6 +33,13 @@ type cacheEntry struct {
 	modTime   time.Time
 }
 
+// prober is one probe tool. Tools are tried in priority order until one
+// returns a usable result.
+type prober struct {
+	name  string
+	probe func(ctx context.Context, path string) (*MediaInfo, error)
+}
+
 // Service provides media file information extraction.
 type Service struct {
 	config Config
@@ -41,7 +48,8 @@ type Service struct {
 	mu     sync.RWMutex
 
 	// Probe methods in priority order
-	probeFunc func(ctx context.Context, path string) (*MediaInfo, error)
+	probers         []prober
+	warnedNoProbers sync.Once
 }
 
 // NewService creates a new MediaInfo service.
@@ -53,32 +61,43 @@ func NewService(config Config, logger *zerolog.Logger) *Service {
 		cache:  make(map[string]*cacheEntry),
 	}
 
-	// Determine which probe method to use
-	s.probeFunc = s.selectProbeMethod()
+	s.probers = s.selectProbers()
 
 	return s
 }
 
-// selectProbeMethod determines the best available probe method.
-func (s *Service) selectProbeMethod() func(context.Context, string) (*MediaInfo, error) {
-	// Try mediainfo first
+// selectProbers finds the available probe tools: mediainfo first, with
+// ffprobe as fallback for when mediainfo is missing or fails on a file.
+func (s *Service) selectProbers() []prober {
+	var probers []prober
+
 	if path := findExecutable("mediainfo", s.config.MediaInfoPath, s.logger); path != "" {
 		s.logger.Info().Str("path", path).Msg("Using mediainfo CLI")
-		return func(ctx context.Context, p string) (*MediaInfo, error) {
+		probers = append(probers, prober{name: "mediainfo", probe: func(ctx context.Context, p string) (*MediaInfo, error) {
 			return s.probeWithMediaInfo(ctx, p, path)
-		}
+		}})
 	}
 
-	// Try ffprobe as fallback
 	if path := findExecutable("ffprobe", s.config.FFprobePath, s.logger); path != "" {
 		s.logger.Info().Str("path", path).Msg("Using ffprobe CLI")
-		return func(ctx context.Context, p string) (*MediaInfo, error) {
+		probers = append(probers, prober{name: "ffprobe", probe: func(ctx context.Context, p string) (*MediaInfo, error) {
 			return s.probeWithFFprobe(ctx, p, path)
-		}
+		}})
 	}
 
-	s.logger.Warn().Msg("No media probe tool found (mediainfo or ffprobe)")
-	return nil
+	if len(probers) == 0 {
+		s.logger.Warn().Msg("No media probe tool found (mediainfo or ffprobe)")
+	}
+	return probers
+}
+
+// Tools returns the names of the available probe tools in priority order.
+func (s *Service) Tools() []string {
+	names := make([]string, 0, len(s.probers))
+	for _, p := range s.probers {
+		names = append(names, p.name)
+	}
+	return names
 }
 
 // Probe extracts media information from a file.
@@ -94,13 +113,14 @@ func (s *Service) Probe(ctx context.Context, path string) (*MediaInfo, error) {
 	}
 
 	// No probe tool available
-	if s.probeFunc == nil {
-		s.logger.Debug().Str("path", path).Msg("No probe tool available, returning empty")
+	if len(s.probers) == 0 {
+		s.warnedNoProbers.Do(func() {
+			s.logger.Warn().Msg("Media files are not being probed; install mediainfo or ffprobe")
+		})
 		return &MediaInfo{}, nil
 	}
 
-	// Probe the file
-	info, err := s.probeFunc(ctx, path)
+	info, err := s.probeChain(ctx, path)
 	if err != nil {
 		return nil, err
 	}
@@ -113,6 +133,32 @@ func (s *Service) Probe(ctx context.Context, path string) (*MediaInfo, error) {
 	return info, nil
 }
 
+// probeChain runs the probe tools in order. A tool that fails, or finds no
+// video stream, hands over to the next one; the last error is returned when
+// no tool succeeds.
+func (s *Service) probeChain(ctx context.Context, path string) (*MediaInfo, error) {
+	var lastErr error
+	var partial *MediaInfo
+	for _, p := range s.probers {
+		info, err := p.probe(ctx, path)
+		if err != nil {
+			s.logger.Debug().Err(err).Str("tool", p.name).Str("path", path).Msg("Probe failed, trying next tool")
+			lastErr = err
+			continue
+		}
+		if info.VideoCodec != "" {
+			return info, nil
+		}
+		if partial == nil {
+			partial = info
+		}
+	}
+	if partial != nil {
+		return partial, nil
+	}
+	return nil, lastErr
+}
+
 // ProbeWithFallback probes a file, falling back to parsed info on error.
 func (s *Service) ProbeWithFallback(ctx context.Context, path string, fallback *MediaInfo) *MediaInfo {
 	info, err := s.Probe(ctx, path)
@@ -134,7 +180,7 @@ func (s *Service) ProbeWithFallback(ctx context.Context, path string, fallback *
 
 // IsAvailable returns true if a probe tool is available.
 func (s *Service) IsAvailable() bool {
-	return s.probeFunc != nil
+	return len(s.probers) > 0
 }
 
 // getCached retrieves a cached result if valid.
@@ -219,4 +265,10 @@ func mergeMediaInfo(info, fallback *MediaInfo) {
 	if len(info.SubtitleLanguages) == 0 {
 		info.SubtitleLanguages = fallback.SubtitleLanguages
 	}
+	if info.DolbyVisionProfile == "" {
+		info.DolbyVisionProfile = fallback.DolbyVisionProfile
+	}
+	if len(info.AudioTracks) == 0 {
+		info.AudioTracks = fallback.AudioTracks
+	}
 }
@@ -1,6 +1,7 @@
 package mediainfo
 
 import (
+	"encoding/json"
 	"strings"
 	"time"
 )
@@ -21,11 +22,15 @@ type MediaInfo struct {
 	Height           int    `json:"height"`
 	DynamicRange     string `json:"dynamicRange"`
 	DynamicRangeType string `json:"dynamicRangeType"`
+	// Dolby Vision profile such as "5" or "8.1"; the part after the dot is the
+	// base layer compatibility (1 = HDR10, 2 = SDR, 4 = HLG).
+	DolbyVisionProfile string `json:"dolbyVisionProfile,omitempty"`
 
-	// Audio
-	AudioCodec     string   `json:"audioCodec"`
-	AudioChannels  string   `json:"audioChannels"`
-	AudioLanguages []string `json:"audioLanguages"`
+	// Audio (first track)
+	AudioCodec     string       `json:"audioCodec"`
+	AudioChannels  string       `json:"audioChannels"`
+	AudioLanguages []string     `json:"audioLanguages"`
+	AudioTracks    []AudioTrack `json:"audioTracks,omitempty"`
 
 	// Subtitles
 	SubtitleLanguages []string `json:"subtitleLanguages"`
@@ -36,6 +41,45 @@ type MediaInfo struct {
 	FileSize        int64         `json:"fileSize"`
 }
 
+// AudioTrack is one audio stream of a file.
+type AudioTrack struct {
+	Codec    string `json:"codec"`
+	Channels string `json:"channels,omitempty"`
+	Language string `json:"language,omitempty"`
+}
+
+// String formats the track as "TrueHD Atmos 7.1 EN".
+func (t AudioTrack) String() string {
+	parts := make([]string, 0, 3)
+	for _, p := range []string{t.Codec, t.Channels, t.Language} {
+		if p != "" {
+			parts = append(parts, p)
+		}
+	}
+	return strings.Join(parts, " ")
+}
+
+// EncodeAudioTracks serializes audio tracks for storage as a JSON array.
+func EncodeAudioTracks(tracks []AudioTrack) string {
+	if len(tracks) == 0 {
+		return "[]"
+	}
+	data, err := json.Marshal(tracks)
+	if err != nil {
+		return "[]"
+	}
+	return string(data)
+}
+
+// DecodeAudioTracks parses stored audio tracks; invalid data yields none.
+func DecodeAudioTracks(data string) []AudioTrack {
+	var tracks []AudioTrack
+	if err := json.Unmarshal([]byte(data), &tracks); err != nil {
+		return nil
+	}
+	return tracks
+}
+
 // VideoCodecMap maps raw codec names to standard display names.
 var VideoCodecMap = map[string]string{
 	"hevc":   "HEVC",
@@ -4,16 +4,18 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"io"
 	"net/http"
 	"os"
 	"path/filepath"
+	"regexp"
+	"slices"
 	"strings"
 	"time"
 
 	"github.com/rs/zerolog"
 
 	"github.com/slipstream/slipstream/internal/domain/contracts"
+	"github.com/slipstream/slipstream/internal/metadata/fanarttv"
 )
 
 var (
@@ -30,8 +32,43 @@ const (
 	ArtworkTypeBackdrop   ArtworkType = "backdrop"
 	ArtworkTypeLogo       ArtworkType = "logo"
 	ArtworkTypeStudioLogo ArtworkType = "studio_logo"
+	ArtworkTypeClearArt   ArtworkType = "clearart"
+	ArtworkTypeBanner     ArtworkType = "banner"
+	ArtworkTypeDisc       ArtworkType = "disc"
 )
 
+// itemArtworkTypes lists the per-item artwork types, excluding season
+// posters and episode stills.
+var itemArtworkTypes = []ArtworkType{
+	ArtworkTypePoster,
+	ArtworkTypeBackdrop,
+	ArtworkTypeLogo,
+	ArtworkTypeStudioLogo,
+	ArtworkTypeClearArt,
+	ArtworkTypeBanner,
+	ArtworkTypeDisc,
+}
+
+// SeasonPosterArtworkType is the artwork type a season poster is stored
+// under. Season and episode artwork is keyed by the owning series, so it is
+// released together with the series artwork.
+func SeasonPosterArtworkType(seasonNumber int) ArtworkType {
+	return ArtworkType(fmt.Sprintf("season%02d_poster", seasonNumber))
+}
+
+// EpisodeStillArtworkType is the artwork type an episode still is stored under.
+func EpisodeStillArtworkType(seasonNumber, episodeNumber int) ArtworkType {
+	return ArtworkType(fmt.Sprintf("s%02de%02d_still", seasonNumber, episodeNumber))
+}
+
+var seasonArtworkTypeRe = regexp.MustCompile(`^(season\d{2,}_poster|s\d{2,}e\d{2,}_still)$`)
+
+// isSeasonArtworkType reports whether artworkType names a season poster or
+// episode still.
+func isSeasonArtworkType(artworkType string) bool {
+	return seasonArtworkTypeRe.MatchString(artworkType)
+}
+
 // MediaType represents the type of media.
 type MediaType string
 
@@ -40,6 +77,28 @@ const (
 	MediaTypeSeries MediaType = "series"
 )
 
+// ParseMediaType parses "movie" or "series".
+func ParseMediaType(s string) (MediaType, bool) {
+	switch MediaType(s) {
+	case MediaTypeMovie, MediaTypeSeries:
+		return MediaType(s), true
+	default:
+		return "", false
+	}
+}
+
+// ParseArtworkType parses an artwork type served for mediaType. Season
+// posters and episode stills are only valid for series.
+func ParseArtworkType(mediaType MediaType, s string) (ArtworkType, bool) {
+	if slices.Contains(itemArtworkTypes, ArtworkType(s)) {
+		return ArtworkType(s), true
+	}
+	if mediaType == MediaTypeSeries && isSeasonArtworkType(s) {
+		return ArtworkType(s), true
+	}
+	return "", false
+}
+
 // ArtworkConfig holds configuration for artwork downloading.
 type ArtworkConfig struct {
 	// BaseDir is the base directory for storing artwork.
@@ -64,12 +123,22 @@ type ArtworkReadyPayload struct {
 	ArtworkType string `json:"artworkType"`
 }
 
+// FanartSource supplies Fanart.tv artwork and the preferred artwork source.
+// It is implemented by Service.
+type FanartSource interface {
+	GetMovieFanart(ctx context.Context, tmdbID int) (*fanarttv.NormalizedArtwork, error)
+	GetSeriesFanart(ctx context.Context, tvdbID int) (*fanarttv.NormalizedArtwork, error)
+	PreferFanartArtwork() bool
+}
+
 // ArtworkDownloader handles downloading and storing artwork images.
 type ArtworkDownloader struct {
 	config      ArtworkConfig
 	httpClient  *http.Client
 	logger      *zerolog.Logger
 	broadcaster contracts.Broadcaster
+	store       *artworkStore
+	fanart      FanartSource
 }
 
 // NewArtworkDownloader creates a new ArtworkDownloader.
@@ -82,9 +151,16 @@ func NewArtworkDownloader(cfg ArtworkConfig, logger *zerolog.Logger, broadcaster
 		},
 		logger:      &subLogger,
 		broadcaster: broadcaster,
+		store:       newArtworkStore(cfg.BaseDir),
 	}
 }
 
+// SetFanartSource sets where clearart, banners, disc art and alternative
+// posters, backdrops and logos are fetched from.
+func (d *ArtworkDownloader) SetFanartSource(source FanartSource) {
+	d.fanart = source
+}
+
 // notifyArtworkReady broadcasts that artwork is ready.
 func (d *ArtworkDownloader) notifyArtworkReady(mediaType MediaType, mediaID int, artworkType ArtworkType) {
 	if d.broadcaster == nil {
@@ -98,7 +174,8 @@ func (d *ArtworkDownloader) notifyArtworkReady(mediaType MediaType, mediaID int,
 	d.broadcaster.Broadcast("artwork:ready", payload)
 }
 
-// Download downloads artwork from a URL and saves it locally.
+// Download downloads artwork from a URL and stores it content-addressed, so
+// identical images shared across items are kept once on disk.
 // Returns the local file path on success.
 func (d *ArtworkDownloader) Download(ctx context.Context, url string, mediaType MediaType, mediaID int, artworkType ArtworkType) (string, error) {
 	if url == "" {
@@ -111,18 +188,6 @@ func (d *ArtworkDownloader) Download(ctx context.Context, url string, mediaType
 		ext = ".jpg" // Default to jpg
 	}
 
-	// Build destination path: {baseDir}/{mediaType}/{id}_{artworkType}{ext}
-	// e.g., data/artwork/movie/603_poster.jpg
-	dir := filepath.Join(d.config.BaseDir, string(mediaType))
-	filename := fmt.Sprintf("%d_%s%s", mediaID, artworkType, ext)
-	destPath := filepath.Join(dir, filename)
-
-	// Create directory if needed
-	if err := os.MkdirAll(dir, 0o750); err != nil {
-		d.logger.Error().Err(err).Str("dir", dir).Msg("Failed to create artwork directory")
-		return "", fmt.Errorf("failed to create directory: %w", err)
-	}
-
 	// Download the file
 	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, http.NoBody)
 	if err != nil {
@@ -141,21 +206,18 @@ func (d *ArtworkDownloader) Download(ctx context.Context, url string, mediaType
 		return "", fmt.Errorf("%w: status %d", ErrDownloadFailed, resp.StatusCode)
 	}
 
-	// Create destination file
-	file, err := os.Create(destPath)
+	tmpPath, hash, written, err := d.store.stage(resp.Body)
 	if err != nil {
-		d.logger.Error().Err(err).Str("path", destPath).Msg("Failed to create artwork file")
-		return "", fmt.Errorf("failed to create file: %w", err)
+		d.logger.Error().Err(err).Str("url", url).Msg("Failed to write artwork file")
+		return "", err
 	}
-	defer file.Close()
 
-	// Copy response body to file
-	written, err := io.Copy(file, resp.Body)
+	destPath, err := d.store.commit(artworkRef(mediaType, mediaID, artworkType), tmpPath, hash, ext)
 	if err != nil {
-		d.logger.Error().Err(err).Str("path", destPath).Msg("Failed to write artwork file")
-		os.Remove(destPath) // Clean up partial file
-		return "", fmt.Errorf("failed to write file: %w", err)
+		d.logger.Error().Err(err).Str("url", url).Msg("Failed to store artwork file")
+		return "", err
 	}
+	d.removeLegacyArtwork(mediaType, mediaID, string(artworkType))
 
 	d.logger.Info().
 		Str("url", url).
@@ -166,20 +228,60 @@ func (d *ArtworkDownloader) Download(ctx context.Context, url string, mediaType
 	return destPath, nil
 }
 
-// DownloadMovieArtwork downloads both poster and backdrop for a movie.
+// DownloadMovieArtwork downloads the poster, backdrop, logos and any
+// Fanart.tv artwork for a movie.
 func (d *ArtworkDownloader) DownloadMovieArtwork(ctx context.Context, movie *MovieResult) error {
 	if movie == nil {
 		return ErrInvalidMediaType
 	}
 
-	d.downloadArtworkIfExists(ctx, movie.PosterURL, MediaTypeMovie, movie.ID, ArtworkTypePoster, "movie poster")
-	d.downloadArtworkIfExists(ctx, movie.BackdropURL, MediaTypeMovie, movie.ID, ArtworkTypeBackdrop, "movie backdrop")
-	d.downloadArtworkIfExists(ctx, movie.LogoURL, MediaTypeMovie, movie.ID, ArtworkTypeLogo, "movie logo")
+	urls := artworkURLs{poster: movie.PosterURL, backdrop: movie.BackdropURL, logo: movie.LogoURL}
+	if d.fanart != nil {
+		extra, err := d.fanart.GetMovieFanart(ctx, movie.ID)
+		if err != nil {
+			d.logger.Warn().Err(err).Int("movieId", movie.ID).Msg("Failed to fetch Fanart.tv artwork")
+		}
+		urls.merge(extra, d.fanart.PreferFanartArtwork())
+	}
+
+	d.downloadArtworkIfExists(ctx, urls.poster, MediaTypeMovie, movie.ID, ArtworkTypePoster, "movie poster")
+	d.downloadArtworkIfExists(ctx, urls.backdrop, MediaTypeMovie, movie.ID, ArtworkTypeBackdrop, "movie backdrop")
+	d.downloadArtworkIfExists(ctx, urls.logo, MediaTypeMovie, movie.ID, ArtworkTypeLogo, "movie logo")
 	d.downloadArtworkIfExists(ctx, movie.StudioLogoURL, MediaTypeMovie, movie.ID, ArtworkTypeStudioLogo, "studio logo")
+	d.downloadArtworkIfExists(ctx, urls.clearArt, MediaTypeMovie, movie.ID, ArtworkTypeClearArt, "movie clearart")
+	d.downloadArtworkIfExists(ctx, urls.banner, MediaTypeMovie, movie.ID, ArtworkTypeBanner, "movie banner")
+	d.downloadArtworkIfExists(ctx, urls.disc, MediaTypeMovie, movie.ID, ArtworkTypeDisc, "movie disc art")
 
 	return nil
 }
 
+// artworkURLs holds the image URLs chosen for an item's artwork types.
+type artworkURLs struct {
+	poster, backdrop, logo string
+	clearArt, banner, disc string
+}
+
+// merge adds Fanart.tv artwork. Posters, backdrops and logos from Fanart.tv
+// replace the provider's only when preferFanart is set, and otherwise just
+// fill gaps.
+func (u *artworkURLs) merge(extra *fanarttv.NormalizedArtwork, preferFanart bool) {
+	if extra == nil {
+		return
+	}
+	pick := func(current, candidate string) string {
+		if candidate != "" && (preferFanart || current == "") {
+			return candidate
+		}
+		return current
+	}
+	u.poster = pick(u.poster, extra.PosterURL)
+	u.backdrop = pick(u.backdrop, extra.BackdropURL)
+	u.logo = pick(u.logo, extra.LogoURL)
+	u.clearArt = extra.ClearArtURL
+	u.banner = extra.BannerURL
+	u.disc = extra.DiscURL
+}
+
 func (d *ArtworkDownloader) downloadArtworkIfExists(ctx context.Context, url string, mediaType MediaType, mediaID int, artworkType ArtworkType, logName string) {
 	if url == "" {
 		return
@@ -193,7 +295,8 @@ func (d *ArtworkDownloader) downloadArtworkIfExists(ctx context.Context, url str
 	d.notifyArtworkReady(mediaType, mediaID, artworkType)
 }
 
-// DownloadSeriesArtwork downloads both poster and backdrop for a series.
+// DownloadSeriesArtwork downloads the poster, backdrop, logos and any
+// Fanart.tv artwork for a series.
 // Uses TmdbID for storage since the frontend expects artwork keyed by TMDB ID.
 func (d *ArtworkDownloader) DownloadSeriesArtwork(ctx context.Context, series *SeriesResult) error {
 	if series == nil {
@@ -209,17 +312,75 @@ func (d *ArtworkDownloader) DownloadSeriesArtwork(ctx context.Context, series *S
 		return ErrInvalidMediaType
 	}
 
-	d.downloadArtworkIfExists(ctx, series.PosterURL, MediaTypeSeries, artworkID, ArtworkTypePoster, "series poster")
-	d.downloadArtworkIfExists(ctx, series.BackdropURL, MediaTypeSeries, artworkID, ArtworkTypeBackdrop, "series backdrop")
-	d.downloadArtworkIfExists(ctx, series.LogoURL, MediaTypeSeries, artworkID, ArtworkTypeLogo, "series logo")
+	urls := artworkURLs{poster: series.PosterURL, backdrop: series.BackdropURL, logo: series.LogoURL}
+	if d.fanart != nil && series.TvdbID > 0 {
+		extra, err := d.fanart.GetSeriesFanart(ctx, series.TvdbID)
+		if err != nil {
+			d.logger.Warn().Err(err).Int("tvdbId", series.TvdbID).Msg("Failed to fetch Fanart.tv artwork")
+		}
+		urls.merge(extra, d.fanart.PreferFanartArtwork())
+	}
+
+	d.downloadArtworkIfExists(ctx, urls.poster, MediaTypeSeries, artworkID, ArtworkTypePoster, "series poster")
+	d.downloadArtworkIfExists(ctx, urls.backdrop, MediaTypeSeries, artworkID, ArtworkTypeBackdrop, "series backdrop")
+	d.downloadArtworkIfExists(ctx, urls.logo, MediaTypeSeries, artworkID, ArtworkTypeLogo, "series logo")
 	d.downloadArtworkIfExists(ctx, series.NetworkLogoURL, MediaTypeSeries, artworkID, ArtworkTypeStudioLogo, "network logo")
+	d.downloadArtworkIfExists(ctx, urls.clearArt, MediaTypeSeries, artworkID, ArtworkTypeClearArt, "series clearart")
+	d.downloadArtworkIfExists(ctx, urls.banner, MediaTypeSeries, artworkID, ArtworkTypeBanner, "series banner")
 
 	return nil
 }
 
+// DownloadSeasonArtwork caches season posters and episode stills for a
+// series. Images already cached are skipped, so refreshing a long-running
+// show only fetches artwork for new seasons and episodes.
+func (d *ArtworkDownloader) DownloadSeasonArtwork(ctx context.Context, tmdbID, tvdbID int, seasons []SeasonResult) error {
+	artworkID := tmdbID
+	if artworkID == 0 {
+		artworkID = tvdbID
+	}
+	if artworkID == 0 {
+		return ErrInvalidMediaType
+	}
+
+	downloaded := 0
+	fetch := func(url string, artworkType ArtworkType) {
+		if url == "" || d.HasArtwork(MediaTypeSeries, artworkID, artworkType) {
+			return
+		}
+		if _, err := d.Download(ctx, url, MediaTypeSeries, artworkID, artworkType); err != nil {
+			d.logger.Debug().Err(err).Int("seriesId", artworkID).Str("artworkType", string(artworkType)).Msg("Failed to download season artwork")
+			return
+		}
+		downloaded++
+	}
+
+	for i := range seasons {
+		season := &seasons[i]
+		fetch(season.PosterURL, SeasonPosterArtworkType(season.SeasonNumber))
+		for j := range season.Episodes {
+			ep := &season.Episodes[j]
+			fetch(ep.StillURL, EpisodeStillArtworkType(ep.SeasonNumber, ep.EpisodeNumber))
+		}
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+	}
+
+	if downloaded > 0 {
+		d.logger.Info().Int("seriesId", artworkID).Int("downloaded", downloaded).Msg("Downloaded season artwork")
+	}
+	return nil
+}
+
 // GetArtworkPath returns the local path for artwork if it exists.
 func (d *ArtworkDownloader) GetArtworkPath(mediaType MediaType, mediaID int, artworkType ArtworkType) string {
-	// Try common extensions
+	if path := d.store.resolve(artworkRef(mediaType, mediaID, artworkType)); path != "" {
+		return path
+	}
+
+	// Fall back to the per-item layout used before content-addressed storage;
+	// these files are adopted into the store by the next garbage collection.
 	extensions := []string{".jpg", ".jpeg", ".png", ".webp", ".svg"}
 
 	for _, ext := range extensions {
@@ -233,17 +394,63 @@ func (d *ArtworkDownloader) GetArtworkPath(mediaType MediaType, mediaID int, art
 	return ""
 }
 
+// AvailableArtwork returns the per-item artwork types cached locally for a
+// media item, in a stable order.
+func (d *ArtworkDownloader) AvailableArtwork(mediaType MediaType, mediaID int) []ArtworkType {
+	available := make([]ArtworkType, 0, len(itemArtworkTypes))
+	for _, artworkType := range itemArtworkTypes {
+		if d.HasArtwork(mediaType, mediaID, artworkType) {
+			available = append(available, artworkType)
+		}
+	}
+	return available
+}
+
 // HasArtwork checks if artwork exists locally.
 func (d *ArtworkDownloader) HasArtwork(mediaType MediaType, mediaID int, artworkType ArtworkType) bool {
 	return d.GetArtworkPath(mediaType, mediaID, artworkType) != ""
 }
 
-// DeleteArtwork removes artwork for a media item.
+// DeleteArtwork releases all artwork references for a media item. Shared
+// images stay on disk until garbage collection finds them unreferenced.
 func (d *ArtworkDownloader) DeleteArtwork(mediaType MediaType, mediaID int) error {
-	dir := filepath.Join(d.config.BaseDir, string(mediaType))
+	if err := d.store.release(fmt.Sprintf("%s/%d_", mediaType, mediaID)); err != nil {
+		return fmt.Errorf("failed to release artwork: %w", err)
+	}
+	return d.removeLegacyArtwork(mediaType, mediaID, "*")
+}
+
+// ReleaseUnowned drops artwork references for media IDs of mediaType that are
+// not in owned, so their images become eligible for garbage collection.
+func (d *ArtworkDownloader) ReleaseUnowned(mediaType MediaType, owned map[int]bool) (int, error) {
+	released, err := d.store.releaseUnowned(mediaType, owned)
+	if err != nil {
+		return released, fmt.Errorf("failed to release artwork: %w", err)
+	}
+	return released, nil
+}
+
+// CollectGarbage removes stored artwork that no media item references and
+// migrates any remaining per-item files into the content-addressed store.
+func (d *ArtworkDownloader) CollectGarbage() (ArtworkGCResult, error) {
+	result, err := d.store.collectGarbage()
+	if err != nil {
+		d.logger.Error().Err(err).Msg("Artwork garbage collection failed")
+		return result, err
+	}
+
+	d.logger.Info().
+		Int("adopted", result.Adopted).
+		Int("removed", result.Removed).
+		Int64("bytesFreed", result.BytesFreed).
+		Msg("Artwork garbage collection completed")
+	return result, nil
+}
 
-	// Find and delete all artwork files for this media ID
-	pattern := filepath.Join(dir, fmt.Sprintf("%d_*", mediaID))
+// removeLegacyArtwork deletes per-item artwork files from the old layout
+// matching artworkType, which may be a glob.
+func (d *ArtworkDownloader) removeLegacyArtwork(mediaType MediaType, mediaID int, artworkType string) error {
+	pattern := filepath.Join(d.config.BaseDir, string(mediaType), fmt.Sprintf("%d_%s.*", mediaID, artworkType))
 	matches, err := filepath.Glob(pattern)
 	if err != nil {
 		return fmt.Errorf("failed to find artwork files: %w", err)
@@ -0,0 +1,368 @@
+package metadata
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	blobDirName      = "blobs"
+	artworkIndexName = "index.json"
+	blobTempPrefix   = ".tmp-"
+	staleTempFileAge = time.Hour
+)
+
+// legacyArtworkPattern matches the pre-deduplication per-item layout,
+// e.g. movie/603_poster.jpg.
+var legacyArtworkPattern = regexp.MustCompile(`^(\d+)_([a-z_]+)(\.[a-z]+)$`)
+
+// ArtworkGCResult summarizes a garbage collection pass over the artwork cache.
+type ArtworkGCResult struct {
+	Adopted    int   `json:"adopted"`
+	Removed    int   `json:"removed"`
+	BytesFreed int64 `json:"bytesFreed"`
+}
+
+// artworkStore keeps artwork content-addressed by SHA-256 so identical images
+// (shared studio logos, backdrops) are stored once. Each media item's artwork
+// slot is a reference into the store; blobs whose reference count drops to
+// zero are removed by the next garbage collection pass.
+type artworkStore struct {
+	baseDir string
+
+	mu     sync.Mutex
+	loaded bool
+	refs   map[string]string // ref key -> blob name
+	counts map[string]int    // blob name -> reference count
+}
+
+func newArtworkStore(baseDir string) *artworkStore {
+	return &artworkStore{baseDir: baseDir}
+}
+
+func artworkRef(mediaType MediaType, mediaID int, artworkType ArtworkType) string {
+	return fmt.Sprintf("%s/%d_%s", mediaType, mediaID, artworkType)
+}
+
+func (s *artworkStore) blobDir() string {
+	return filepath.Join(s.baseDir, blobDirName)
+}
+
+func (s *artworkStore) blobPath(name string) string {
+	return filepath.Join(s.blobDir(), name[:2], name)
+}
+
+func (s *artworkStore) indexPath() string {
+	return filepath.Join(s.blobDir(), artworkIndexName)
+}
+
+// load reads the reference index from disk once. Callers must hold s.mu.
+func (s *artworkStore) load() error {
+	if s.loaded {
+		return nil
+	}
+
+	refs := make(map[string]string)
+	data, err := os.ReadFile(s.indexPath())
+	switch {
+	case errors.Is(err, fs.ErrNotExist):
+	case err != nil:
+		return fmt.Errorf("failed to read artwork index: %w", err)
+	default:
+		if err := json.Unmarshal(data, &refs); err != nil {
+			return fmt.Errorf("failed to parse artwork index: %w", err)
+		}
+	}
+
+	s.refs = refs
+	s.counts = make(map[string]int, len(refs))
+	for _, name := range refs {
+		s.counts[name]++
+	}
+	s.loaded = true
+	return nil
+}
+
+// save writes the reference index atomically. Callers must hold s.mu.
+func (s *artworkStore) save() error {
+	data, err := json.Marshal(s.refs)
+	if err != nil {
+		return fmt.Errorf("failed to encode artwork index: %w", err)
+	}
+	tmp, err := os.CreateTemp(s.blobDir(), blobTempPrefix+"index-*")
+	if err != nil {
+		return fmt.Errorf("failed to write artwork index: %w", err)
+	}
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		os.Remove(tmp.Name())
+		return fmt.Errorf("failed to write artwork index: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		os.Remove(tmp.Name())
+		return fmt.Errorf("failed to write artwork index: %w", err)
+	}
+	return os.Rename(tmp.Name(), s.indexPath())
+}
+
+// stage streams r into a temporary file inside the store and returns the
+// temp path together with the content hash and size.
+func (s *artworkStore) stage(r io.Reader) (tmpPath, hash string, size int64, err error) {
+	if err := os.MkdirAll(s.blobDir(), 0o750); err != nil {
+		return "", "", 0, fmt.Errorf("failed to create directory: %w", err)
+	}
+	tmp, err := os.CreateTemp(s.blobDir(), blobTempPrefix+"*")
+	if err != nil {
+		return "", "", 0, fmt.Errorf("failed to create file: %w", err)
+	}
+
+	hasher := sha256.New()
+	size, err = io.Copy(io.MultiWriter(tmp, hasher), r)
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		os.Remove(tmp.Name())
+		return "", "", 0, fmt.Errorf("failed to write file: %w", err)
+	}
+	return tmp.Name(), hex.EncodeToString(hasher.Sum(nil)), size, nil
+}
+
+// commit moves a staged file into the store under its content hash and
+// points ref at it. If the blob already exists the staged copy is discarded.
+// Returns the blob path.
+func (s *artworkStore) commit(ref, tmpPath, hash, ext string) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.load(); err != nil {
+		os.Remove(tmpPath)
+		return "", err
+	}
+
+	name := hash + ext
+	path := s.blobPath(name)
+	if _, err := os.Stat(path); err == nil {
+		os.Remove(tmpPath)
+	} else {
+		if err := os.MkdirAll(filepath.Dir(path), 0o750); err != nil {
+			os.Remove(tmpPath)
+			return "", fmt.Errorf("failed to create directory: %w", err)
+		}
+		if err := os.Rename(tmpPath, path); err != nil {
+			os.Remove(tmpPath)
+			return "", fmt.Errorf("failed to store artwork: %w", err)
+		}
+	}
+
+	s.setRef(ref, name)
+	if err := s.save(); err != nil {
+		return "", err
+	}
+	return path, nil
+}
+
+// setRef points ref at a blob, adjusting reference counts. Callers must hold s.mu.
+func (s *artworkStore) setRef(ref, name string) {
+	if prev, ok := s.refs[ref]; ok {
+		if prev == name {
+			return
+		}
+		s.counts[prev]--
+	}
+	s.refs[ref] = name
+	s.counts[name]++
+}
+
+// resolve returns the blob path for ref, or "" when it has no stored artwork.
+func (s *artworkStore) resolve(ref string) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.load(); err != nil {
+		return ""
+	}
+	name, ok := s.refs[ref]
+	if !ok {
+		return ""
+	}
+	path := s.blobPath(name)
+	if _, err := os.Stat(path); err != nil {
+		return ""
+	}
+	return path
+}
+
+// release drops every reference with the given prefix. The blobs themselves
+// are left for garbage collection.
+func (s *artworkStore) release(prefix string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.load(); err != nil {
+		return err
+	}
+	changed := false
+	for ref, name := range s.refs {
+		if strings.HasPrefix(ref, prefix) {
+			delete(s.refs, ref)
+			s.counts[name]--
+			changed = true
+		}
+	}
+	if !changed {
+		return nil
+	}
+	return s.save()
+}
+
+// releaseUnowned drops references of mediaType whose media ID is not in
+// owned, returning how many were released.
+func (s *artworkStore) releaseUnowned(mediaType MediaType, owned map[int]bool) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.load(); err != nil {
+		return 0, err
+	}
+	prefix := string(mediaType) + "/"
+	released := 0
+	for ref, name := range s.refs {
+		rest, ok := strings.CutPrefix(ref, prefix)
+		if !ok {
+			continue
+		}
+		idStr, _, _ := strings.Cut(rest, "_")
+		id, err := strconv.Atoi(idStr)
+		if err != nil || owned[id] {
+			continue
+		}
+		delete(s.refs, ref)
+		s.counts[name]--
+		released++
+	}
+	if released == 0 {
+		return 0, nil
+	}
+	return released, s.save()
+}
+
+// collectGarbage adopts legacy per-item files into the store, then removes
+// blobs that are no longer referenced along with abandoned temp files.
+func (s *artworkStore) collectGarbage() (ArtworkGCResult, error) {
+	var result ArtworkGCResult
+
+	adopted, err := s.adoptLegacy()
+	if err != nil {
+		return result, err
+	}
+	result.Adopted = adopted
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.load(); err != nil {
+		return result, err
+	}
+
+	err = filepath.WalkDir(s.blobDir(), func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			if errors.Is(err, fs.ErrNotExist) {
+				return nil
+			}
+			return err
+		}
+		if d.IsDir() || path == s.indexPath() {
+			return nil
+		}
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+
+		name := d.Name()
+		if strings.HasPrefix(name, blobTempPrefix) {
+			if time.Since(info.ModTime()) < staleTempFileAge {
+				return nil
+			}
+		} else if s.counts[name] > 0 {
+			return nil
+		}
+
+		if err := os.Remove(path); err != nil {
+			return err
+		}
+		delete(s.counts, name)
+		result.Removed++
+		result.BytesFreed += info.Size()
+		return nil
+	})
+	if err != nil {
+		return result, fmt.Errorf("failed to collect artwork garbage: %w", err)
+	}
+	return result, nil
+}
+
+// adoptLegacy moves files from the old {mediaType}/{id}_{type}{ext} layout
+// into the store. Legacy files whose slot already has stored artwork are
+// simply removed.
+func (s *artworkStore) adoptLegacy() (int, error) {
+	adopted := 0
+	for _, mediaDir := range []string{string(MediaTypeMovie), string(MediaTypeSeries)} {
+		dir := filepath.Join(s.baseDir, mediaDir)
+		entries, err := os.ReadDir(dir)
+		if errors.Is(err, fs.ErrNotExist) {
+			continue
+		}
+		if err != nil {
+			return adopted, fmt.Errorf("failed to read artwork directory: %w", err)
+		}
+
+		for _, entry := range entries {
+			m := legacyArtworkPattern.FindStringSubmatch(entry.Name())
+			if entry.IsDir() || m == nil {
+				continue
+			}
+			path := filepath.Join(dir, entry.Name())
+			ref := mediaDir + "/" + m[1] + "_" + m[2]
+
+			if s.resolve(ref) == "" {
+				if err := s.adoptFile(ref, path, m[3]); err != nil {
+					return adopted, err
+				}
+				adopted++
+			}
+			if err := os.Remove(path); err != nil && !errors.Is(err, fs.ErrNotExist) {
+				return adopted, fmt.Errorf("failed to remove legacy artwork: %w", err)
+			}
+		}
+	}
+	return adopted, nil
+}
+
+func (s *artworkStore) adoptFile(ref, path, ext string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("failed to open legacy artwork: %w", err)
+	}
+	defer f.Close()
+
+	tmpPath, hash, _, err := s.stage(f)
+	if err != nil {
+		return err
+	}
+	_, err = s.commit(ref, tmpPath, hash, ext)
+	return err
+}
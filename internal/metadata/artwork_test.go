@@ -7,10 +7,13 @@ import (
 	"net/http/httptest"
 	"os"
 	"path/filepath"
+	"strconv"
 	"testing"
 	"time"
 
 	"github.com/rs/zerolog"
+
+	"github.com/slipstream/slipstream/internal/metadata/fanarttv"
 )
 
 func newTestLogger() *zerolog.Logger {
@@ -56,11 +59,14 @@ func TestArtworkDownloader_Download(t *testing.T) {
 		t.Errorf("File content = %q, want %q", string(content), "fake image data")
 	}
 
-	// Verify path format
-	expectedPath := filepath.Join(tempDir, "movie", "603_poster.jpg")
+	// Verify path format: content-addressed by SHA-256 of the image data
+	expectedPath := filepath.Join(tempDir, "blobs", "5b", "5b3397652358a6663a0225ee76466d4e4fd6c58d484d1aa25170bb617d6bb086.jpg")
 	if path != expectedPath {
 		t.Errorf("Path = %q, want %q", path, expectedPath)
 	}
+	if got := downloader.GetArtworkPath(MediaTypeMovie, 603, ArtworkTypePoster); got != expectedPath {
+		t.Errorf("GetArtworkPath() = %q, want %q", got, expectedPath)
+	}
 }
 
 func TestArtworkDownloader_Download_InvalidURL(t *testing.T) {
@@ -154,15 +160,85 @@ func TestArtworkDownloader_DownloadMovieArtwork(t *testing.T) {
 		t.Errorf("Expected 2 downloads, got %d", callCount)
 	}
 
-	// Verify files exist
-	posterPath := filepath.Join(tempDir, "movie", "603_poster.jpg")
-	backdropPath := filepath.Join(tempDir, "movie", "603_backdrop.jpg")
-
-	if _, err := os.Stat(posterPath); os.IsNotExist(err) {
-		t.Errorf("Poster file does not exist: %s", posterPath)
+	// Verify artwork is stored
+	if !downloader.HasArtwork(MediaTypeMovie, 603, ArtworkTypePoster) {
+		t.Error("Poster artwork was not stored")
 	}
-	if _, err := os.Stat(backdropPath); os.IsNotExist(err) {
-		t.Errorf("Backdrop file does not exist: %s", backdropPath)
+	if !downloader.HasArtwork(MediaTypeMovie, 603, ArtworkTypeBackdrop) {
+		t.Error("Backdrop artwork was not stored")
+	}
+}
+
+type fakeFanartSource struct {
+	artwork *fanarttv.NormalizedArtwork
+	prefer  bool
+}
+
+func (f *fakeFanartSource) GetMovieFanart(context.Context, int) (*fanarttv.NormalizedArtwork, error) {
+	return f.artwork, nil
+}
+
+func (f *fakeFanartSource) GetSeriesFanart(context.Context, int) (*fanarttv.NormalizedArtwork, error) {
+	return f.artwork, nil
+}
+
+func (f *fakeFanartSource) PreferFanartArtwork() bool {
+	return f.prefer
+}
+
+func TestArtworkDownloader_DownloadMovieArtwork_Fanart(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(r.URL.Path))
+	}))
+	defer server.Close()
+
+	readArtwork := func(t *testing.T, d *ArtworkDownloader, artworkType ArtworkType) string {
+		t.Helper()
+		data, err := os.ReadFile(d.GetArtworkPath(MediaTypeMovie, 603, artworkType))
+		if err != nil {
+			t.Fatalf("read %s: %v", artworkType, err)
+		}
+		return string(data)
+	}
+
+	for _, prefer := range []bool{false, true} {
+		t.Run("prefer="+strconv.FormatBool(prefer), func(t *testing.T) {
+			downloader := NewArtworkDownloader(ArtworkConfig{BaseDir: t.TempDir(), Timeout: 5 * time.Second}, newTestLogger(), nil)
+			downloader.SetFanartSource(&fakeFanartSource{prefer: prefer, artwork: &fanarttv.NormalizedArtwork{
+				LogoURL:     server.URL + "/fanart-logo.png",
+				PosterURL:   server.URL + "/fanart-poster.jpg",
+				ClearArtURL: server.URL + "/clearart.png",
+				DiscURL:     server.URL + "/disc.png",
+			}})
+
+			movie := &MovieResult{ID: 603, PosterURL: server.URL + "/tmdb-poster.jpg"}
+			if err := downloader.DownloadMovieArtwork(context.Background(), movie); err != nil {
+				t.Fatalf("DownloadMovieArtwork() error = %v", err)
+			}
+
+			wantPoster := "/tmdb-poster.jpg"
+			if prefer {
+				wantPoster = "/fanart-poster.jpg"
+			}
+			if got := readArtwork(t, downloader, ArtworkTypePoster); got != wantPoster {
+				t.Errorf("poster = %q, want %q", got, wantPoster)
+			}
+			// TMDB had no logo, so Fanart.tv fills the gap either way
+			if got := readArtwork(t, downloader, ArtworkTypeLogo); got != "/fanart-logo.png" {
+				t.Errorf("logo = %q, want /fanart-logo.png", got)
+			}
+
+			got := downloader.AvailableArtwork(MediaTypeMovie, 603)
+			want := []ArtworkType{ArtworkTypePoster, ArtworkTypeLogo, ArtworkTypeClearArt, ArtworkTypeDisc}
+			if len(got) != len(want) {
+				t.Fatalf("AvailableArtwork() = %v, want %v", got, want)
+			}
+			for i := range want {
+				if got[i] != want[i] {
+					t.Errorf("AvailableArtwork()[%d] = %q, want %q", i, got[i], want[i])
+				}
+			}
+		})
 	}
 }
 
@@ -205,15 +281,12 @@ func TestArtworkDownloader_DownloadSeriesArtwork(t *testing.T) {
 		t.Errorf("Expected 2 downloads, got %d", callCount)
 	}
 
-	// Verify files exist
-	posterPath := filepath.Join(tempDir, "series", "1396_poster.jpg")
-	backdropPath := filepath.Join(tempDir, "series", "1396_backdrop.jpg")
-
-	if _, err := os.Stat(posterPath); os.IsNotExist(err) {
-		t.Errorf("Poster file does not exist: %s", posterPath)
+	// Verify artwork is stored
+	if !downloader.HasArtwork(MediaTypeSeries, 1396, ArtworkTypePoster) {
+		t.Error("Poster artwork was not stored")
 	}
-	if _, err := os.Stat(backdropPath); os.IsNotExist(err) {
-		t.Errorf("Backdrop file does not exist: %s", backdropPath)
+	if !downloader.HasArtwork(MediaTypeSeries, 1396, ArtworkTypeBackdrop) {
+		t.Error("Backdrop artwork was not stored")
 	}
 }
 
@@ -263,6 +336,75 @@ func TestArtworkDownloader_HasArtwork(t *testing.T) {
 	}
 }
 
+func TestArtworkDownloader_DownloadSeasonArtwork(t *testing.T) {
+	callCount := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		callCount++
+		w.Write([]byte("image " + r.URL.Path))
+	}))
+	defer server.Close()
+
+	tempDir := t.TempDir()
+	cfg := ArtworkConfig{BaseDir: tempDir, Timeout: 5 * time.Second}
+	downloader := NewArtworkDownloader(cfg, newTestLogger(), nil)
+
+	seasons := []SeasonResult{{
+		SeasonNumber: 1,
+		PosterURL:    server.URL + "/season1.jpg",
+		Episodes: []EpisodeResult{
+			{SeasonNumber: 1, EpisodeNumber: 1, StillURL: server.URL + "/s01e01.jpg"},
+			{SeasonNumber: 1, EpisodeNumber: 2},
+		},
+	}}
+
+	if err := downloader.DownloadSeasonArtwork(context.Background(), 1396, 81189, seasons); err != nil {
+		t.Fatalf("DownloadSeasonArtwork() error = %v", err)
+	}
+	if callCount != 2 {
+		t.Errorf("Expected 2 downloads, got %d", callCount)
+	}
+	if !downloader.HasArtwork(MediaTypeSeries, 1396, SeasonPosterArtworkType(1)) {
+		t.Error("Season poster was not stored")
+	}
+	if !downloader.HasArtwork(MediaTypeSeries, 1396, EpisodeStillArtworkType(1, 1)) {
+		t.Error("Episode still was not stored")
+	}
+
+	// Cached artwork is not fetched again
+	if err := downloader.DownloadSeasonArtwork(context.Background(), 1396, 81189, seasons); err != nil {
+		t.Fatalf("DownloadSeasonArtwork() error = %v", err)
+	}
+	if callCount != 2 {
+		t.Errorf("Expected cached artwork to be skipped, got %d downloads", callCount)
+	}
+
+	// Season artwork is released with the series
+	if err := downloader.DeleteArtwork(MediaTypeSeries, 1396); err != nil {
+		t.Fatalf("DeleteArtwork() error = %v", err)
+	}
+	if downloader.HasArtwork(MediaTypeSeries, 1396, EpisodeStillArtworkType(1, 1)) {
+		t.Error("Episode still should be released with the series")
+	}
+}
+
+func TestIsSeasonArtworkType(t *testing.T) {
+	tests := []struct {
+		artworkType string
+		want        bool
+	}{
+		{string(SeasonPosterArtworkType(3)), true},
+		{string(EpisodeStillArtworkType(1, 12)), true},
+		{"season1_poster", false},
+		{"poster", false},
+		{"../s01e01_still", false},
+	}
+	for _, tt := range tests {
+		if got := isSeasonArtworkType(tt.artworkType); got != tt.want {
+			t.Errorf("isSeasonArtworkType(%q) = %v, want %v", tt.artworkType, got, tt.want)
+		}
+	}
+}
+
 func TestArtworkDownloader_DeleteArtwork(t *testing.T) {
 	tempDir := t.TempDir()
 	cfg := ArtworkConfig{BaseDir: tempDir, Timeout: 5 * time.Second}
@@ -291,6 +433,129 @@ func TestArtworkDownloader_DeleteArtwork(t *testing.T) {
 	}
 }
 
+func TestArtworkDownloader_DeduplicatesAndCollectsGarbage(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("shared studio logo"))
+	}))
+	defer server.Close()
+
+	tempDir := t.TempDir()
+	cfg := ArtworkConfig{BaseDir: tempDir, Timeout: 5 * time.Second}
+	downloader := NewArtworkDownloader(cfg, newTestLogger(), nil)
+	ctx := context.Background()
+
+	first, err := downloader.Download(ctx, server.URL+"/logo.png", MediaTypeMovie, 1, ArtworkTypeStudioLogo)
+	if err != nil {
+		t.Fatalf("Download() error = %v", err)
+	}
+	second, err := downloader.Download(ctx, server.URL+"/logo.png", MediaTypeMovie, 2, ArtworkTypeStudioLogo)
+	if err != nil {
+		t.Fatalf("Download() error = %v", err)
+	}
+	if first != second {
+		t.Fatalf("identical artwork stored twice: %q and %q", first, second)
+	}
+
+	// One reference remains, so the blob must survive collection
+	if err := downloader.DeleteArtwork(MediaTypeMovie, 1); err != nil {
+		t.Fatalf("DeleteArtwork() error = %v", err)
+	}
+	result, err := downloader.CollectGarbage()
+	if err != nil {
+		t.Fatalf("CollectGarbage() error = %v", err)
+	}
+	if result.Removed != 0 {
+		t.Errorf("Removed = %d, want 0", result.Removed)
+	}
+	if got := downloader.GetArtworkPath(MediaTypeMovie, 2, ArtworkTypeStudioLogo); got != second {
+		t.Errorf("GetArtworkPath() = %q, want %q", got, second)
+	}
+
+	// Last reference dropped: the blob is collected
+	if err := downloader.DeleteArtwork(MediaTypeMovie, 2); err != nil {
+		t.Fatalf("DeleteArtwork() error = %v", err)
+	}
+	result, err = downloader.CollectGarbage()
+	if err != nil {
+		t.Fatalf("CollectGarbage() error = %v", err)
+	}
+	if result.Removed != 1 || result.BytesFreed != int64(len("shared studio logo")) {
+		t.Errorf("result = %+v, want 1 removed blob", result)
+	}
+	if _, err := os.Stat(second); !os.IsNotExist(err) {
+		t.Error("unreferenced blob should be deleted")
+	}
+}
+
+func TestArtworkDownloader_ReleaseUnowned(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(r.URL.Path))
+	}))
+	defer server.Close()
+
+	tempDir := t.TempDir()
+	cfg := ArtworkConfig{BaseDir: tempDir, Timeout: 5 * time.Second}
+	downloader := NewArtworkDownloader(cfg, newTestLogger(), nil)
+	ctx := context.Background()
+
+	for _, id := range []int{1, 2} {
+		if _, err := downloader.Download(ctx, server.URL+"/"+strconv.Itoa(id)+".jpg", MediaTypeMovie, id, ArtworkTypePoster); err != nil {
+			t.Fatalf("Download() error = %v", err)
+		}
+	}
+	if _, err := downloader.Download(ctx, server.URL+"/s.jpg", MediaTypeSeries, 2, ArtworkTypePoster); err != nil {
+		t.Fatalf("Download() error = %v", err)
+	}
+
+	released, err := downloader.ReleaseUnowned(MediaTypeMovie, map[int]bool{1: true})
+	if err != nil {
+		t.Fatalf("ReleaseUnowned() error = %v", err)
+	}
+	if released != 1 {
+		t.Errorf("released = %d, want 1", released)
+	}
+	if !downloader.HasArtwork(MediaTypeMovie, 1, ArtworkTypePoster) {
+		t.Error("owned movie artwork should be kept")
+	}
+	if downloader.HasArtwork(MediaTypeMovie, 2, ArtworkTypePoster) {
+		t.Error("unowned movie artwork should be released")
+	}
+	if !downloader.HasArtwork(MediaTypeSeries, 2, ArtworkTypePoster) {
+		t.Error("series artwork should be unaffected")
+	}
+}
+
+func TestArtworkDownloader_CollectGarbage_AdoptsLegacyFiles(t *testing.T) {
+	tempDir := t.TempDir()
+	cfg := ArtworkConfig{BaseDir: tempDir, Timeout: 5 * time.Second}
+	downloader := NewArtworkDownloader(cfg, newTestLogger(), nil)
+
+	dir := filepath.Join(tempDir, "series")
+	os.MkdirAll(dir, 0o755)
+	os.WriteFile(filepath.Join(dir, "10_studio_logo.png"), []byte("network"), 0o644)
+	os.WriteFile(filepath.Join(dir, "11_studio_logo.png"), []byte("network"), 0o644)
+
+	result, err := downloader.CollectGarbage()
+	if err != nil {
+		t.Fatalf("CollectGarbage() error = %v", err)
+	}
+	if result.Adopted != 2 {
+		t.Errorf("Adopted = %d, want 2", result.Adopted)
+	}
+
+	a := downloader.GetArtworkPath(MediaTypeSeries, 10, ArtworkTypeStudioLogo)
+	b := downloader.GetArtworkPath(MediaTypeSeries, 11, ArtworkTypeStudioLogo)
+	if a == "" || a != b {
+		t.Errorf("adopted paths = %q, %q, want one shared blob", a, b)
+	}
+	if filepath.Ext(a) != ".png" {
+		t.Errorf("adopted blob ext = %q, want .png", filepath.Ext(a))
+	}
+	if entries, _ := os.ReadDir(dir); len(entries) != 0 {
+		t.Errorf("legacy files left behind: %d", len(entries))
+	}
+}
+
 func TestArtworkDownloader_getExtension(t *testing.T) {
 	downloader := &ArtworkDownloader{}
 
@@ -0,0 +1,169 @@
+package metadata
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/slipstream/slipstream/internal/metadata/tvmaze"
+)
+
+// Series provider names, as used in metadata.series_providers and as health item IDs.
+const (
+	providerTVDB   = "tvdb"
+	providerTMDB   = "tmdb"
+	providerTVMaze = "tvmaze"
+)
+
+var defaultSeriesProviders = []string{providerTVDB, providerTMDB, providerTVMaze}
+
+var providerNames = map[string]string{
+	providerTVDB:   "TVDB",
+	providerTMDB:   "TMDB",
+	providerTVMaze: "TVMaze",
+}
+
+// providerFailure is a provider request that failed before another provider
+// was tried.
+type providerFailure struct {
+	name string
+	err  error
+}
+
+// SetSeriesProviders sets the order series providers are tried in. Unknown
+// names are ignored; an empty list restores the default order.
+func (s *Service) SetSeriesProviders(names []string) {
+	s.seriesProviders = names
+	s.cache.Clear()
+}
+
+// seriesProviderOrder returns the configured provider priority with unknown
+// and duplicate names dropped.
+func (s *Service) seriesProviderOrder() []string {
+	order := make([]string, 0, len(defaultSeriesProviders))
+	for _, name := range s.seriesProviders {
+		name = strings.ToLower(strings.TrimSpace(name))
+		if _, ok := providerNames[name]; !ok {
+			s.logger.Warn().Str("provider", name).Msg("Ignoring unknown series metadata provider")
+			continue
+		}
+		if !slices.Contains(order, name) {
+			order = append(order, name)
+		}
+	}
+	if len(order) == 0 {
+		return defaultSeriesProviders
+	}
+	return order
+}
+
+// recordFailover reports provider health after a lookup. Providers that
+// failed are marked with a warning when used served the request in their
+// place, or an error when nothing could; used, if any, is marked healthy.
+func (s *Service) recordFailover(failed []providerFailure, used string) {
+	if s.healthService == nil {
+		return
+	}
+	for _, f := range failed {
+		if used == "" {
+			s.healthService.SetErrorStr("metadata", f.name, f.err.Error())
+			continue
+		}
+		s.healthService.SetWarningStr("metadata", f.name,
+			fmt.Sprintf("%s request failed, fell back to %s: %v", providerNames[f.name], providerNames[used], f.err))
+		s.logger.Warn().Err(f.err).Str("provider", f.name).Str("fallback", used).Msg("Metadata provider failed over")
+	}
+	if used != "" {
+		s.healthService.ClearStatusStr("metadata", used)
+	}
+}
+
+func (s *Service) tvmazeConfigured() bool {
+	return s.tvmaze != nil && s.tvmaze.IsConfigured()
+}
+
+func (s *Service) tryTVMazeSearch(ctx context.Context, query string) ([]SeriesResult, error) {
+	if !s.tvmazeConfigured() {
+		return nil, nil
+	}
+
+	tvmazeResults, err := s.tvmaze.SearchSeries(ctx, query)
+	if err != nil {
+		s.logger.Warn().Err(err).Str("query", query).Msg("TVMaze series search failed")
+		return nil, err
+	}
+
+	// Shows without a TVDB ID can't be added to the library
+	results := make([]SeriesResult, 0, len(tvmazeResults))
+	for i := range tvmazeResults {
+		if tvmazeResults[i].TvdbID > 0 {
+			results = append(results, tvmazeSeriesToResult(&tvmazeResults[i]))
+		}
+	}
+	s.enrichWithNetworkLogos(ctx, results)
+	return results, nil
+}
+
+func (s *Service) tryTVMazeSeasons(ctx context.Context, tvdbID int) ([]SeasonResult, error) {
+	if tvdbID <= 0 || !s.tvmazeConfigured() {
+		return nil, nil
+	}
+
+	show, err := s.tvmaze.LookupByTVDB(ctx, tvdbID)
+	if err != nil {
+		s.logger.Warn().Err(err).Int("tvdbId", tvdbID).Msg("TVMaze show lookup failed")
+		return nil, err
+	}
+
+	tvmazeResults, err := s.tvmaze.GetSeriesEpisodes(ctx, show.ID)
+	if err != nil {
+		s.logger.Warn().Err(err).Int("tvmazeId", show.ID).Msg("TVMaze get episodes failed")
+		return nil, err
+	}
+
+	results := make([]SeasonResult, len(tvmazeResults))
+	for i := range tvmazeResults {
+		results[i] = tvmazeSeasonToResult(&tvmazeResults[i])
+	}
+	return results, nil
+}
+
+// tvmazeSeriesToResult converts a TVMaze show to metadata.SeriesResult. The
+// TVMaze ID is not kept: series are keyed by TVDB and TMDB IDs.
+func tvmazeSeriesToResult(s *tvmaze.NormalizedSeriesResult) SeriesResult {
+	return SeriesResult{
+		ID:        s.TvdbID,
+		Title:     s.Title,
+		Year:      s.Year,
+		Overview:  s.Overview,
+		PosterURL: s.PosterURL,
+		ImdbID:    s.ImdbID,
+		TvdbID:    s.TvdbID,
+		Genres:    s.Genres,
+		Status:    s.Status,
+		Runtime:   s.Runtime,
+		Network:   s.Network,
+	}
+}
+
+func tvmazeSeasonToResult(s *tvmaze.NormalizedSeasonResult) SeasonResult {
+	episodes := make([]EpisodeResult, len(s.Episodes))
+	for i, ep := range s.Episodes {
+		episodes[i] = EpisodeResult{
+			EpisodeNumber: ep.EpisodeNumber,
+			SeasonNumber:  ep.SeasonNumber,
+			Title:         ep.Title,
+			Overview:      ep.Overview,
+			AirDate:       ep.AirDate,
+			Runtime:       ep.Runtime,
+			StillURL:      ep.StillURL,
+		}
+	}
+	return SeasonResult{
+		SeasonNumber: s.SeasonNumber,
+		Name:         s.Name,
+		AirDate:      s.AirDate,
+		Episodes:     episodes,
+	}
+}
@@ -0,0 +1,67 @@
+package metadata
+
+import (
+	"context"
+	"errors"
+
+	"github.com/slipstream/slipstream/internal/metadata/fanarttv"
+)
+
+// Artwork source names, as used in metadata.artwork_source.
+const (
+	ArtworkSourceTMDB   = "tmdb"
+	ArtworkSourceFanart = "fanart"
+)
+
+// SetFanartClient sets the Fanart.tv client.
+func (s *Service) SetFanartClient(client FanartClient) {
+	s.fanart = client
+}
+
+// SetArtworkSource sets the preferred source for posters, backdrops and logos.
+func (s *Service) SetArtworkSource(source string) {
+	s.artworkSource = source
+}
+
+// PreferFanartArtwork reports whether Fanart.tv images should replace TMDB
+// posters, backdrops and logos when both are available.
+func (s *Service) PreferFanartArtwork() bool {
+	return s.artworkSource == ArtworkSourceFanart
+}
+
+// IsFanartConfigured returns true if a Fanart.tv API key is set.
+func (s *Service) IsFanartConfigured() bool {
+	return s.fanart != nil && s.fanart.IsConfigured()
+}
+
+// GetMovieFanart fetches Fanart.tv artwork for a movie by TMDB ID. It returns
+// nil without an error when Fanart.tv is not configured or has no entry.
+func (s *Service) GetMovieFanart(ctx context.Context, tmdbID int) (*fanarttv.NormalizedArtwork, error) {
+	if !s.IsFanartConfigured() || tmdbID == 0 {
+		return nil, nil
+	}
+	return s.fanartResult(s.fanart.GetMovieArtwork(ctx, tmdbID))
+}
+
+// GetSeriesFanart fetches Fanart.tv artwork for a series by TVDB ID. It
+// returns nil without an error when Fanart.tv is not configured or has no entry.
+func (s *Service) GetSeriesFanart(ctx context.Context, tvdbID int) (*fanarttv.NormalizedArtwork, error) {
+	if !s.IsFanartConfigured() || tvdbID == 0 {
+		return nil, nil
+	}
+	return s.fanartResult(s.fanart.GetSeriesArtwork(ctx, tvdbID))
+}
+
+func (s *Service) fanartResult(artwork *fanarttv.NormalizedArtwork, err error) (*fanarttv.NormalizedArtwork, error) {
+	if errors.Is(err, fanarttv.ErrNotFound) {
+		return nil, nil
+	}
+	if s.healthService != nil {
+		if err != nil {
+			s.healthService.SetErrorStr("metadata", "fanarttv", err.Error())
+		} else {
+			s.healthService.ClearStatusStr("metadata", "fanarttv")
+		}
+	}
+	return artwork, err
+}
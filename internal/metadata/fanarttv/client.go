@@ -0,0 +1,174 @@
+// Package fanarttv is a client for the Fanart.tv API, which supplies logos,
+// clearart, banners and disc art that TMDB does not carry.
+package fanarttv
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/rs/zerolog"
+
+	"github.com/slipstream/slipstream/internal/config"
+)
+
+var (
+	ErrAPIKeyMissing = errors.New("Fanart.tv API key is not configured")
+	ErrNotFound      = errors.New("not found on Fanart.tv")
+	ErrAPIError      = errors.New("Fanart.tv API error")
+)
+
+// Client is a Fanart.tv API client.
+type Client struct {
+	httpClient *http.Client
+	config     config.FanartConfig
+	logger     *zerolog.Logger
+}
+
+// NewClient creates a new Fanart.tv client.
+func NewClient(cfg config.FanartConfig, logger *zerolog.Logger) *Client {
+	subLogger := logger.With().Str("component", "fanarttv").Logger()
+	return &Client{
+		httpClient: &http.Client{
+			Timeout: time.Duration(cfg.Timeout) * time.Second,
+		},
+		config: cfg,
+		logger: &subLogger,
+	}
+}
+
+// Name returns the provider name.
+func (c *Client) Name() string {
+	return "fanarttv"
+}
+
+// IsConfigured returns true if the API key is set.
+func (c *Client) IsConfigured() bool {
+	return c.config.APIKey != ""
+}
+
+// Test verifies connectivity to the Fanart.tv API.
+func (c *Client) Test(ctx context.Context) error {
+	if !c.IsConfigured() {
+		return ErrAPIKeyMissing
+	}
+
+	_, err := c.GetMovieArtwork(ctx, 603) // The Matrix
+	return err
+}
+
+// GetMovieArtwork fetches the best artwork of each kind for a movie by TMDB ID.
+func (c *Client) GetMovieArtwork(ctx context.Context, tmdbID int) (*NormalizedArtwork, error) {
+	if !c.IsConfigured() {
+		return nil, ErrAPIKeyMissing
+	}
+
+	var images MovieImages
+	if err := c.doRequest(ctx, fmt.Sprintf("%s/movies/%d", c.config.BaseURL, tmdbID), &images); err != nil {
+		return nil, err
+	}
+
+	return &NormalizedArtwork{
+		LogoURL:     bestImage(images.HDMovieLogo, images.MovieLogo),
+		ClearArtURL: bestImage(images.HDMovieClearArt, images.MovieArt),
+		PosterURL:   bestImage(images.MoviePoster),
+		BackdropURL: bestImage(images.MovieBackground),
+		BannerURL:   bestImage(images.MovieBanner),
+		DiscURL:     bestImage(images.MovieDisc),
+	}, nil
+}
+
+// GetSeriesArtwork fetches the best artwork of each kind for a series by
+// TVDB ID. Fanart.tv has no disc art for series.
+func (c *Client) GetSeriesArtwork(ctx context.Context, tvdbID int) (*NormalizedArtwork, error) {
+	if !c.IsConfigured() {
+		return nil, ErrAPIKeyMissing
+	}
+
+	var images SeriesImages
+	if err := c.doRequest(ctx, fmt.Sprintf("%s/tv/%d", c.config.BaseURL, tvdbID), &images); err != nil {
+		return nil, err
+	}
+
+	return &NormalizedArtwork{
+		LogoURL:     bestImage(images.HDTVLogo, images.ClearLogo),
+		ClearArtURL: bestImage(images.HDClearArt, images.ClearArt),
+		PosterURL:   bestImage(images.TVPoster),
+		BackdropURL: bestImage(images.ShowBackground),
+		BannerURL:   bestImage(images.TVBanner),
+	}, nil
+}
+
+// doRequest performs an authenticated GET request and decodes the JSON response.
+func (c *Client) doRequest(ctx context.Context, endpoint string, result any) error {
+	params := url.Values{}
+	params.Set("api_key", c.config.APIKey)
+	reqURL := fmt.Sprintf("%s?%s", endpoint, params.Encode())
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, reqURL, http.NoBody)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		c.logger.Error().Err(err).Str("url", endpoint).Msg("HTTP request failed")
+		return fmt.Errorf("HTTP request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotFound:
+		return ErrNotFound
+	default:
+		return fmt.Errorf("%w: status %d", ErrAPIError, resp.StatusCode)
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(result); err != nil {
+		return fmt.Errorf("failed to decode response: %w", err)
+	}
+	return nil
+}
+
+// bestImage picks an image from the first non-empty group, preferring English
+// and then language-neutral images, and within those the most liked one.
+// Groups are ordered by preference, e.g. HD logos before standard logos.
+func bestImage(groups ...[]Image) string {
+	for _, group := range groups {
+		var best *Image
+		bestRank, bestLikes := -1, -1
+		for i := range group {
+			img := &group[i]
+			if img.URL == "" {
+				continue
+			}
+			rank := langRank(img.Lang)
+			likes, _ := strconv.Atoi(img.Likes)
+			if rank > bestRank || (rank == bestRank && likes > bestLikes) {
+				best, bestRank, bestLikes = img, rank, likes
+			}
+		}
+		if best != nil {
+			return best.URL
+		}
+	}
+	return ""
+}
+
+func langRank(lang string) int {
+	switch lang {
+	case "en":
+		return 2
+	case "", "00":
+		return 1
+	default:
+		return 0
+	}
+}
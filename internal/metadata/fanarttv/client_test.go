@@ -0,0 +1,86 @@
+package fanarttv
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/rs/zerolog"
+
+	"github.com/slipstream/slipstream/internal/config"
+)
+
+func newTestClient(server *httptest.Server) *Client {
+	logger := zerolog.Nop()
+	return NewClient(config.FanartConfig{APIKey: "key", BaseURL: server.URL, Timeout: 5}, &logger)
+}
+
+func TestClient_GetMovieArtwork(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/movies/603" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		if got := r.URL.Query().Get("api_key"); got != "key" {
+			t.Errorf("api_key = %q, want key", got)
+		}
+		w.Write([]byte(`{
+			"name": "The Matrix", "tmdb_id": "603",
+			"hdmovielogo": [
+				{"id": "1", "url": "https://assets/logo-de.png", "lang": "de", "likes": "9"},
+				{"id": "2", "url": "https://assets/logo-en-low.png", "lang": "en", "likes": "1"},
+				{"id": "3", "url": "https://assets/logo-en.png", "lang": "en", "likes": "5"}
+			],
+			"movielogo": [{"id": "4", "url": "https://assets/sd-logo.png", "lang": "en", "likes": "20"}],
+			"movieart": [{"id": "5", "url": "https://assets/clearart.png", "lang": "00", "likes": "0"}],
+			"moviedisc": [{"id": "6", "url": "https://assets/disc.png", "lang": "en", "likes": "2"}],
+			"moviebanner": [{"id": "7", "url": "https://assets/banner.jpg", "lang": "en", "likes": "1"}]
+		}`))
+	}))
+	defer server.Close()
+
+	artwork, err := newTestClient(server).GetMovieArtwork(context.Background(), 603)
+	if err != nil {
+		t.Fatalf("GetMovieArtwork() error = %v", err)
+	}
+
+	// HD logos win over standard ones, English over other languages, then likes
+	if artwork.LogoURL != "https://assets/logo-en.png" {
+		t.Errorf("LogoURL = %q", artwork.LogoURL)
+	}
+	if artwork.ClearArtURL != "https://assets/clearart.png" {
+		t.Errorf("ClearArtURL = %q", artwork.ClearArtURL)
+	}
+	if artwork.DiscURL != "https://assets/disc.png" || artwork.BannerURL != "https://assets/banner.jpg" {
+		t.Errorf("DiscURL = %q, BannerURL = %q", artwork.DiscURL, artwork.BannerURL)
+	}
+	if artwork.PosterURL != "" {
+		t.Errorf("PosterURL = %q, want empty", artwork.PosterURL)
+	}
+}
+
+func TestClient_GetSeriesArtwork_NotFound(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	_, err := newTestClient(server).GetSeriesArtwork(context.Background(), 81189)
+	if !errors.Is(err, ErrNotFound) {
+		t.Errorf("GetSeriesArtwork() error = %v, want ErrNotFound", err)
+	}
+}
+
+func TestClient_NotConfigured(t *testing.T) {
+	logger := zerolog.Nop()
+	client := NewClient(config.FanartConfig{}, &logger)
+
+	if client.IsConfigured() {
+		t.Error("IsConfigured() = true without an API key")
+	}
+	if _, err := client.GetMovieArtwork(context.Background(), 603); !errors.Is(err, ErrAPIKeyMissing) {
+		t.Errorf("GetMovieArtwork() error = %v, want ErrAPIKeyMissing", err)
+	}
+}
@@ -0,0 +1,48 @@
+package fanarttv
+
+// Image is a single artwork entry in a Fanart.tv response.
+type Image struct {
+	ID    string `json:"id"`
+	URL   string `json:"url"`
+	Lang  string `json:"lang"`
+	Likes string `json:"likes"`
+}
+
+// MovieImages is the response from /movies/{id}.
+type MovieImages struct {
+	Name            string  `json:"name"`
+	TmdbID          string  `json:"tmdb_id"`
+	ImdbID          string  `json:"imdb_id"`
+	HDMovieLogo     []Image `json:"hdmovielogo"`
+	MovieLogo       []Image `json:"movielogo"`
+	HDMovieClearArt []Image `json:"hdmovieclearart"`
+	MovieArt        []Image `json:"movieart"`
+	MoviePoster     []Image `json:"movieposter"`
+	MovieBackground []Image `json:"moviebackground"`
+	MovieBanner     []Image `json:"moviebanner"`
+	MovieDisc       []Image `json:"moviedisc"`
+}
+
+// SeriesImages is the response from /tv/{id}.
+type SeriesImages struct {
+	Name           string  `json:"name"`
+	TheTVDBID      string  `json:"thetvdb_id"`
+	HDTVLogo       []Image `json:"hdtvlogo"`
+	ClearLogo      []Image `json:"clearlogo"`
+	HDClearArt     []Image `json:"hdclearart"`
+	ClearArt       []Image `json:"clearart"`
+	TVPoster       []Image `json:"tvposter"`
+	ShowBackground []Image `json:"showbackground"`
+	TVBanner       []Image `json:"tvbanner"`
+}
+
+// NormalizedArtwork holds the best image URL of each kind for an item.
+// Empty fields mean Fanart.tv has no image of that kind.
+type NormalizedArtwork struct {
+	LogoURL     string `json:"logoUrl,omitempty"`
+	ClearArtURL string `json:"clearArtUrl,omitempty"`
+	PosterURL   string `json:"posterUrl,omitempty"`
+	BackdropURL string `json:"backdropUrl,omitempty"`
+	BannerURL   string `json:"bannerUrl,omitempty"`
+	DiscURL     string `json:"discUrl,omitempty"`
+}
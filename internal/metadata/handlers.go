@@ -29,6 +29,7 @@ func (h *Handlers) RegisterRoutes(g *echo.Group) {
 	g.GET("/movie/:id", h.GetMovie)
 	g.GET("/movie/:id/extended", h.GetExtendedMovie)
 	g.POST("/movie/:id/artwork", h.DownloadMovieArtwork)
+	g.GET("/movie/:id/artwork", h.ListMovieArtwork)
 
 	// Series metadata
 	g.GET("/series/search", h.SearchSeries)
@@ -37,6 +38,7 @@ func (h *Handlers) RegisterRoutes(g *echo.Group) {
 	g.GET("/series/:id", h.GetSeries)
 	g.GET("/series/:id/extended", h.GetExtendedSeries)
 	g.POST("/series/:id/artwork", h.DownloadSeriesArtwork)
+	g.GET("/series/:id/artwork", h.ListSeriesArtwork)
 
 	// Note: Artwork serving route is registered separately via RegisterArtworkRoutes
 	// to allow public access (images loaded via <img> tags don't include auth headers)
@@ -160,6 +162,9 @@ func (h *Handlers) DownloadMovieArtwork(c echo.Context) error {
 		"poster":   h.artwork.GetArtworkPath(MediaTypeMovie, id, ArtworkTypePoster),
 		"backdrop": h.artwork.GetArtworkPath(MediaTypeMovie, id, ArtworkTypeBackdrop),
 		"logo":     h.artwork.GetArtworkPath(MediaTypeMovie, id, ArtworkTypeLogo),
+		"clearart": h.artwork.GetArtworkPath(MediaTypeMovie, id, ArtworkTypeClearArt),
+		"banner":   h.artwork.GetArtworkPath(MediaTypeMovie, id, ArtworkTypeBanner),
+		"disc":     h.artwork.GetArtworkPath(MediaTypeMovie, id, ArtworkTypeDisc),
 	}
 
 	return c.JSON(http.StatusOK, response)
@@ -334,11 +339,51 @@ func (h *Handlers) DownloadSeriesArtwork(c echo.Context) error {
 		"poster":   h.artwork.GetArtworkPath(MediaTypeSeries, id, ArtworkTypePoster),
 		"backdrop": h.artwork.GetArtworkPath(MediaTypeSeries, id, ArtworkTypeBackdrop),
 		"logo":     h.artwork.GetArtworkPath(MediaTypeSeries, id, ArtworkTypeLogo),
+		"clearart": h.artwork.GetArtworkPath(MediaTypeSeries, id, ArtworkTypeClearArt),
+		"banner":   h.artwork.GetArtworkPath(MediaTypeSeries, id, ArtworkTypeBanner),
 	}
 
 	return c.JSON(http.StatusOK, response)
 }
 
+// ArtworkListResponse lists the artwork cached locally for a media item.
+type ArtworkListResponse struct {
+	MediaType string   `json:"mediaType"`
+	MediaID   int      `json:"mediaId"`
+	Available []string `json:"available"`
+}
+
+// ListMovieArtwork lists the artwork types cached for a movie.
+// GET /api/v1/metadata/movie/:id/artwork
+func (h *Handlers) ListMovieArtwork(c echo.Context) error {
+	return h.listArtwork(c, MediaTypeMovie)
+}
+
+// ListSeriesArtwork lists the artwork types cached for a series.
+// GET /api/v1/metadata/series/:id/artwork
+func (h *Handlers) ListSeriesArtwork(c echo.Context) error {
+	return h.listArtwork(c, MediaTypeSeries)
+}
+
+func (h *Handlers) listArtwork(c echo.Context, mediaType MediaType) error {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid id")
+	}
+
+	types := h.artwork.AvailableArtwork(mediaType, id)
+	available := make([]string, len(types))
+	for i, t := range types {
+		available[i] = string(t)
+	}
+
+	return c.JSON(http.StatusOK, ArtworkListResponse{
+		MediaType: string(mediaType),
+		MediaID:   id,
+		Available: available,
+	})
+}
+
 // ClearCache clears the metadata cache.
 // DELETE /api/v1/metadata/cache
 func (h *Handlers) ClearCache(c echo.Context) error {
@@ -354,8 +399,9 @@ type ProviderStatus struct {
 
 // StatusResponse represents the metadata service status.
 type StatusResponse struct {
-	Movie  []ProviderStatus `json:"movie"`
-	Series []ProviderStatus `json:"series"`
+	Movie   []ProviderStatus `json:"movie"`
+	Series  []ProviderStatus `json:"series"`
+	Artwork []ProviderStatus `json:"artwork"`
 }
 
 // GetStatus returns the status of configured metadata providers.
@@ -369,6 +415,9 @@ func (h *Handlers) GetStatus(c echo.Context) error {
 			{Name: "tmdb", Configured: h.service.IsTMDBConfigured()},
 			{Name: "tvdb", Configured: h.service.IsTVDBConfigured()},
 		},
+		Artwork: []ProviderStatus{
+			{Name: "fanarttv", Configured: h.service.IsFanartConfigured()},
+		},
 	}
 
 	return c.JSON(http.StatusOK, response)
@@ -377,20 +426,15 @@ func (h *Handlers) GetStatus(c echo.Context) error {
 // GetArtwork serves artwork images from local storage.
 // GET /api/v1/metadata/artwork/:type/:id/:artworkType
 // :type is "movie" or "series"
-// :artworkType is "poster" or "backdrop"
+// :artworkType is "poster", "backdrop", "logo", "studio_logo", "clearart",
+// "banner" or "disc"; series also serve "seasonNN_poster" and "sNNeNN_still"
 func (h *Handlers) GetArtwork(c echo.Context) error {
 	mediaTypeStr := c.Param("type")
 	idStr := c.Param("id")
 	artworkTypeStr := c.Param("artworkType")
 
-	// Validate media type
-	var mediaType MediaType
-	switch mediaTypeStr {
-	case "movie":
-		mediaType = MediaTypeMovie
-	case "series":
-		mediaType = MediaTypeSeries
-	default:
+	mediaType, ok := ParseMediaType(mediaTypeStr)
+	if !ok {
 		return echo.NewHTTPError(http.StatusBadRequest, "invalid media type, must be 'movie' or 'series'")
 	}
 
@@ -400,18 +444,8 @@ func (h *Handlers) GetArtwork(c echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, "invalid id")
 	}
 
-	// Validate artwork type
-	var artworkType ArtworkType
-	switch artworkTypeStr {
-	case "poster":
-		artworkType = ArtworkTypePoster
-	case "backdrop":
-		artworkType = ArtworkTypeBackdrop
-	case "logo":
-		artworkType = ArtworkTypeLogo
-	case "studio_logo":
-		artworkType = ArtworkTypeStudioLogo
-	default:
+	artworkType, ok := ParseArtworkType(mediaType, artworkTypeStr)
+	if !ok {
 		return echo.NewHTTPError(http.StatusBadRequest, "invalid artwork type")
 	}
 
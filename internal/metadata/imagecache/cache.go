@@ -0,0 +1,392 @@
+// Package imagecache keeps local copies of remote artwork and resized
+// variants of it, so the UI can load images without reaching image.tmdb.org
+// or other providers directly.
+package imagecache
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"image"
+	"image/jpeg"
+	"image/png"
+	"io"
+	"io/fs"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/rs/zerolog"
+	xdraw "golang.org/x/image/draw"
+	_ "golang.org/x/image/webp" // register the WebP decoder
+	"golang.org/x/sync/singleflight"
+)
+
+var (
+	ErrHostNotAllowed = errors.New("image host is not allowed")
+	ErrInvalidURL     = errors.New("invalid image URL")
+	ErrFetchFailed    = errors.New("image fetch failed")
+)
+
+const (
+	remoteDirName  = "remote"
+	resizedDirName = "resized"
+
+	// maxImageBytes caps a single remote download.
+	maxImageBytes = 20 << 20
+	// maxImagePixels caps the decoded size of an image being resized, since
+	// a small compressed file can declare enormous dimensions.
+	maxImagePixels = 50_000_000
+	// maxRedirects matches the net/http default.
+	maxRedirects = 10
+	jpegQuality  = 85
+)
+
+// Widths are the resized variants the cache produces. Requested widths are
+// rounded up to the nearest one so the number of variants per image stays small.
+var Widths = []int{92, 154, 185, 300, 342, 500, 780, 1280}
+
+// DefaultAllowedHosts are the metadata provider image hosts the cache will fetch from.
+var DefaultAllowedHosts = []string{
+	"image.tmdb.org",
+	"artworks.thetvdb.com",
+	"assets.fanart.tv",
+	"static.tvmaze.com",
+}
+
+// Config holds image cache configuration.
+type Config struct {
+	// Dir is the directory cached images are stored under.
+	Dir string
+
+	// Timeout is the HTTP request timeout for remote images.
+	Timeout time.Duration
+
+	// AllowedHosts lists the hosts remote images may be fetched from.
+	AllowedHosts []string
+}
+
+// PruneResult summarizes a prune pass over the image cache.
+type PruneResult struct {
+	Removed    int   `json:"removed"`
+	BytesFreed int64 `json:"bytesFreed"`
+}
+
+// Cache downloads remote images on first use and serves resized copies from disk.
+type Cache struct {
+	config     Config
+	httpClient *http.Client
+	logger     *zerolog.Logger
+	group      singleflight.Group
+}
+
+// New creates a new image cache.
+func New(cfg Config, logger *zerolog.Logger) *Cache {
+	if len(cfg.AllowedHosts) == 0 {
+		cfg.AllowedHosts = DefaultAllowedHosts
+	}
+	subLogger := logger.With().Str("component", "imagecache").Logger()
+	c := &Cache{
+		config: cfg,
+		logger: &subLogger,
+	}
+	c.httpClient = &http.Client{
+		Timeout: cfg.Timeout,
+		// Redirects must stay on allowed hosts too, or any of them could
+		// bounce a fetch to an internal address.
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			if len(via) >= maxRedirects {
+				return fmt.Errorf("%w: too many redirects", ErrFetchFailed)
+			}
+			return c.checkURL(req.URL)
+		},
+	}
+	return c
+}
+
+// NormalizeWidth rounds width up to the nearest supported variant. Zero or
+// negative widths mean the original image and are returned as 0; widths past
+// the largest variant are clamped to it.
+func NormalizeWidth(width int) int {
+	if width <= 0 {
+		return 0
+	}
+	for _, w := range Widths {
+		if width <= w {
+			return w
+		}
+	}
+	return Widths[len(Widths)-1]
+}
+
+// Remote returns the local path of a remote image, downloading it on first use.
+func (c *Cache) Remote(ctx context.Context, rawURL string) (string, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return "", ErrInvalidURL
+	}
+	if err := c.checkURL(u); err != nil {
+		return "", err
+	}
+
+	name := hashKey(u.String()) + imageExt(u.Path)
+	dest := filepath.Join(c.config.Dir, remoteDirName, name)
+	if touch(dest) {
+		return dest, nil
+	}
+
+	_, err, _ = c.group.Do(dest, func() (any, error) {
+		if touch(dest) {
+			return nil, nil
+		}
+		return nil, c.download(ctx, u.String(), dest)
+	})
+	if err != nil {
+		return "", err
+	}
+	return dest, nil
+}
+
+// checkURL rejects URLs that are not http(s) or point outside the allowed hosts.
+func (c *Cache) checkURL(u *url.URL) error {
+	if (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return ErrInvalidURL
+	}
+	if !slices.Contains(c.config.AllowedHosts, strings.ToLower(u.Hostname())) {
+		return fmt.Errorf("%w: %s", ErrHostNotAllowed, u.Hostname())
+	}
+	return nil
+}
+
+// Resize returns the path of src scaled down to width, creating the variant
+// on first use. Images already narrower than width, formats that cannot be
+// decoded (such as SVG) and a width of 0 return src unchanged.
+func (c *Cache) Resize(src string, width int) (string, error) {
+	width = NormalizeWidth(width)
+	if width == 0 {
+		return src, nil
+	}
+
+	info, err := os.Stat(src)
+	if err != nil {
+		return "", err
+	}
+
+	// The modification time is left out of the key: serving a cached remote
+	// image touches it, which would otherwise create a new variant each time.
+	key := hashKey(fmt.Sprintf("%s|%d", src, info.Size()))
+	base := filepath.Join(c.config.Dir, resizedDirName, fmt.Sprintf("%s_w%d", key, width))
+	for _, ext := range []string{".jpg", ".png"} {
+		if touch(base + ext) {
+			return base + ext, nil
+		}
+	}
+
+	result, err, _ := c.group.Do(base, func() (any, error) {
+		return c.resize(src, base, width)
+	})
+	if err != nil {
+		return "", err
+	}
+	return result.(string), nil
+}
+
+// Prune removes cached images that have not been served for maxAge.
+func (c *Cache) Prune(maxAge time.Duration) (PruneResult, error) {
+	var result PruneResult
+	cutoff := time.Now().Add(-maxAge)
+
+	err := filepath.WalkDir(c.config.Dir, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			if errors.Is(err, fs.ErrNotExist) {
+				return nil
+			}
+			return err
+		}
+		if d.IsDir() {
+			return nil
+		}
+		info, err := d.Info()
+		if err != nil || info.ModTime().After(cutoff) {
+			return nil //nolint:nilerr // a file removed mid-walk is not an error
+		}
+		if err := os.Remove(p); err == nil {
+			result.Removed++
+			result.BytesFreed += info.Size()
+		}
+		return nil
+	})
+	if err != nil {
+		return result, fmt.Errorf("failed to prune image cache: %w", err)
+	}
+
+	if result.Removed > 0 {
+		c.logger.Info().
+			Int("removed", result.Removed).
+			Int64("bytesFreed", result.BytesFreed).
+			Msg("Pruned image cache")
+	}
+	return result, nil
+}
+
+func (c *Cache) download(ctx context.Context, rawURL, dest string) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, rawURL, http.NoBody)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		c.logger.Warn().Err(err).Str("url", rawURL).Msg("Image fetch failed")
+		return fmt.Errorf("%w: %w", ErrFetchFailed, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("%w: status %d", ErrFetchFailed, resp.StatusCode)
+	}
+	if ct := resp.Header.Get("Content-Type"); ct != "" && !strings.HasPrefix(ct, "image/") {
+		return fmt.Errorf("%w: unexpected content type %q", ErrFetchFailed, ct)
+	}
+
+	if err := writeAtomic(dest, func(w io.Writer) error {
+		n, err := io.Copy(w, io.LimitReader(resp.Body, maxImageBytes+1))
+		if err == nil && n > maxImageBytes {
+			return fmt.Errorf("%w: image larger than %d bytes", ErrFetchFailed, maxImageBytes)
+		}
+		return err
+	}); err != nil {
+		return err
+	}
+
+	c.logger.Debug().Str("url", rawURL).Str("path", dest).Msg("Cached remote image")
+	return nil
+}
+
+func (c *Cache) resize(src, base string, width int) (string, error) {
+	f, err := os.Open(src)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	// Check the declared dimensions before decoding, so an oversized image is
+	// never allocated in full
+	cfg, _, err := image.DecodeConfig(f)
+	if err != nil {
+		// Formats without a decoder are served as they are
+		return src, nil //nolint:nilerr // not an error for the caller
+	}
+	if cfg.Width <= width {
+		return src, nil
+	}
+	if int64(cfg.Width)*int64(cfg.Height) > maxImagePixels {
+		c.logger.Warn().Str("path", src).Int("width", cfg.Width).Int("height", cfg.Height).Msg("Image too large to resize, serving original")
+		return src, nil
+	}
+	if _, err := f.Seek(0, io.SeekStart); err != nil {
+		return "", err
+	}
+
+	img, format, err := image.Decode(f)
+	if err != nil {
+		return src, nil //nolint:nilerr // not an error for the caller
+	}
+
+	bounds := img.Bounds()
+	if bounds.Dx() <= width {
+		return src, nil
+	}
+	height := max(1, bounds.Dy()*width/bounds.Dx())
+
+	// JPEG sources stay JPEG; everything else may carry transparency
+	var dst xdraw.Image
+	if format == "jpeg" {
+		dst = image.NewRGBA(image.Rect(0, 0, width, height))
+	} else {
+		dst = image.NewNRGBA(image.Rect(0, 0, width, height))
+	}
+	xdraw.CatmullRom.Scale(dst, dst.Bounds(), img, bounds, xdraw.Over, nil)
+
+	dest := base + ".png"
+	encode := func(w io.Writer) error { return png.Encode(w, dst) }
+	if format == "jpeg" {
+		dest = base + ".jpg"
+		encode = func(w io.Writer) error { return jpeg.Encode(w, dst, &jpeg.Options{Quality: jpegQuality}) }
+	}
+	if err := writeAtomic(dest, encode); err != nil {
+		return "", err
+	}
+	return dest, nil
+}
+
+// writeAtomic writes a file through a temp file in the same directory, so
+// concurrent readers never see a partial image.
+func writeAtomic(dest string, write func(io.Writer) error) error {
+	dir := filepath.Dir(dest)
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return fmt.Errorf("failed to create cache directory: %w", err)
+	}
+	tmp, err := os.CreateTemp(dir, ".tmp-*")
+	if err != nil {
+		return fmt.Errorf("failed to create temp file: %w", err)
+	}
+	tmpPath := tmp.Name()
+
+	if err := write(tmp); err != nil {
+		tmp.Close()
+		os.Remove(tmpPath)
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		os.Remove(tmpPath)
+		return fmt.Errorf("failed to write cached image: %w", err)
+	}
+	if err := os.Rename(tmpPath, dest); err != nil {
+		os.Remove(tmpPath)
+		return fmt.Errorf("failed to store cached image: %w", err)
+	}
+	return nil
+}
+
+// touch reports whether p exists and bumps its modification time, which
+// Prune treats as the last time the image was served.
+func touch(p string) bool {
+	if _, err := os.Stat(p); err != nil {
+		return false
+	}
+	now := time.Now()
+	_ = os.Chtimes(p, now, now)
+	return true
+}
+
+func hashKey(s string) string {
+	sum := sha256.Sum256([]byte(s))
+	return hex.EncodeToString(sum[:16])
+}
+
+func imageExt(p string) string {
+	switch ext := strings.ToLower(path.Ext(p)); ext {
+	case ".jpg", ".jpeg", ".png", ".webp", ".svg", ".gif":
+		return ext
+	default:
+		return ".jpg"
+	}
+}
+
+// widthParam parses the w query parameter; anything unparseable means the original.
+func widthParam(raw string) int {
+	w, err := strconv.Atoi(raw)
+	if err != nil {
+		return 0
+	}
+	return NormalizeWidth(w)
+}
@@ -0,0 +1,259 @@
+package imagecache
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"errors"
+	"hash/crc32"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"image/png"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+)
+
+func newTestCache(t *testing.T, hosts ...string) *Cache {
+	t.Helper()
+	logger := zerolog.Nop()
+	return New(Config{Dir: t.TempDir(), Timeout: 5 * time.Second, AllowedHosts: hosts}, &logger)
+}
+
+func testJPEG(t *testing.T, width, height int) []byte {
+	t.Helper()
+	img := image.NewRGBA(image.Rect(0, 0, width, height))
+	for x := 0; x < width; x++ {
+		for y := 0; y < height; y++ {
+			img.Set(x, y, color.RGBA{R: uint8(x), G: uint8(y), B: 128, A: 255})
+		}
+	}
+	var buf bytes.Buffer
+	if err := jpeg.Encode(&buf, img, nil); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func decodeSize(t *testing.T, path string) (width, height int) {
+	t.Helper()
+	f, err := os.Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	cfg, _, err := image.DecodeConfig(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return cfg.Width, cfg.Height
+}
+
+func TestNormalizeWidth(t *testing.T) {
+	tests := []struct{ in, want int }{
+		{0, 0},
+		{-5, 0},
+		{50, 92},
+		{185, 185},
+		{200, 300},
+		{5000, 1280},
+	}
+	for _, tt := range tests {
+		if got := NormalizeWidth(tt.in); got != tt.want {
+			t.Errorf("NormalizeWidth(%d) = %d, want %d", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestCache_Remote(t *testing.T) {
+	calls := 0
+	body := testJPEG(t, 400, 600)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.Header().Set("Content-Type", "image/jpeg")
+		w.Write(body)
+	}))
+	defer server.Close()
+
+	cache := newTestCache(t, "127.0.0.1")
+	url := server.URL + "/t/p/w500/poster.jpg"
+
+	for range 2 {
+		path, err := cache.Remote(context.Background(), url)
+		if err != nil {
+			t.Fatalf("Remote() error = %v", err)
+		}
+		if filepath.Ext(path) != ".jpg" {
+			t.Errorf("Remote() path = %q, want .jpg", path)
+		}
+	}
+	if calls != 1 {
+		t.Errorf("server calls = %d, want 1 (second request served from cache)", calls)
+	}
+}
+
+func TestCache_Remote_Rejects(t *testing.T) {
+	cache := newTestCache(t, "image.tmdb.org")
+
+	if _, err := cache.Remote(context.Background(), "http://169.254.169.254/latest"); !errors.Is(err, ErrHostNotAllowed) {
+		t.Errorf("Remote(other host) error = %v, want ErrHostNotAllowed", err)
+	}
+	if _, err := cache.Remote(context.Background(), "file:///etc/passwd"); !errors.Is(err, ErrInvalidURL) {
+		t.Errorf("Remote(file URL) error = %v, want ErrInvalidURL", err)
+	}
+}
+
+func TestCache_Remote_RejectsRedirect(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, "http://169.254.169.254/latest/poster.jpg", http.StatusFound)
+	}))
+	defer server.Close()
+
+	cache := newTestCache(t, "127.0.0.1")
+	if _, err := cache.Remote(context.Background(), server.URL+"/poster.jpg"); !errors.Is(err, ErrHostNotAllowed) {
+		t.Errorf("Remote(redirect to other host) error = %v, want ErrHostNotAllowed", err)
+	}
+}
+
+func TestCache_Remote_NonImage(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/html")
+		w.Write([]byte("<html></html>"))
+	}))
+	defer server.Close()
+
+	cache := newTestCache(t, "127.0.0.1")
+	if _, err := cache.Remote(context.Background(), server.URL+"/page"); !errors.Is(err, ErrFetchFailed) {
+		t.Errorf("Remote() error = %v, want ErrFetchFailed", err)
+	}
+}
+
+func TestCache_Resize(t *testing.T) {
+	cache := newTestCache(t)
+	src := filepath.Join(t.TempDir(), "poster.jpg")
+	if err := os.WriteFile(src, testJPEG(t, 400, 600), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	resized, err := cache.Resize(src, 150)
+	if err != nil {
+		t.Fatalf("Resize() error = %v", err)
+	}
+	if w, h := decodeSize(t, resized); w != 154 || h != 231 {
+		t.Errorf("resized to %dx%d, want 154x231", w, h)
+	}
+
+	again, err := cache.Resize(src, 154)
+	if err != nil || again != resized {
+		t.Errorf("Resize() again = %q, %v; want cached %q", again, err, resized)
+	}
+
+	// Never upscale
+	if got, err := cache.Resize(src, 780); err != nil || got != src {
+		t.Errorf("Resize(780) = %q, %v; want original", got, err)
+	}
+	if got, err := cache.Resize(src, 0); err != nil || got != src {
+		t.Errorf("Resize(0) = %q, %v; want original", got, err)
+	}
+}
+
+func TestCache_Resize_RemoteReusesVariant(t *testing.T) {
+	body := testJPEG(t, 400, 600)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "image/jpeg")
+		w.Write(body)
+	}))
+	defer server.Close()
+
+	cache := newTestCache(t, "127.0.0.1")
+	for i := range 3 {
+		// Each cache hit touches the source; that must not produce a new variant.
+		src, err := cache.Remote(context.Background(), server.URL+"/poster.jpg")
+		if err != nil {
+			t.Fatalf("Remote() error = %v", err)
+		}
+		if err := os.Chtimes(src, time.Now(), time.Now().Add(time.Duration(i)*time.Second)); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := cache.Resize(src, 185); err != nil {
+			t.Fatalf("Resize() error = %v", err)
+		}
+	}
+
+	variants, err := os.ReadDir(filepath.Join(cache.config.Dir, resizedDirName))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(variants) != 1 {
+		t.Errorf("resized variants = %d, want 1", len(variants))
+	}
+}
+
+func TestCache_Resize_Undecodable(t *testing.T) {
+	cache := newTestCache(t)
+	src := filepath.Join(t.TempDir(), "logo.svg")
+	if err := os.WriteFile(src, []byte("<svg></svg>"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	if got, err := cache.Resize(src, 185); err != nil || got != src {
+		t.Errorf("Resize(svg) = %q, %v; want original", got, err)
+	}
+}
+
+func TestCache_Resize_TooLarge(t *testing.T) {
+	// A tiny PNG whose header claims 100000x100000 pixels
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, image.NewGray(image.Rect(0, 0, 1, 1))); err != nil {
+		t.Fatal(err)
+	}
+	data := buf.Bytes()
+	ihdr := data[8+8 : 8+8+13] // after the signature and chunk length/type
+	binary.BigEndian.PutUint32(ihdr[0:4], 100000)
+	binary.BigEndian.PutUint32(ihdr[4:8], 100000)
+	binary.BigEndian.PutUint32(data[8+8+13:], crc32.ChecksumIEEE(data[8+4:8+8+13]))
+
+	cache := newTestCache(t)
+	src := filepath.Join(t.TempDir(), "bomb.png")
+	if err := os.WriteFile(src, data, 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	if got, err := cache.Resize(src, 185); err != nil || got != src {
+		t.Errorf("Resize(oversized) = %q, %v; want original", got, err)
+	}
+}
+
+func TestCache_Prune(t *testing.T) {
+	cache := newTestCache(t)
+	src := filepath.Join(t.TempDir(), "poster.jpg")
+	if err := os.WriteFile(src, testJPEG(t, 400, 600), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	resized, err := cache.Resize(src, 185)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if result, err := cache.Prune(time.Hour); err != nil || result.Removed != 0 {
+		t.Fatalf("Prune() fresh = %+v, %v; want nothing removed", result, err)
+	}
+
+	old := time.Now().Add(-2 * time.Hour)
+	if err := os.Chtimes(resized, old, old); err != nil {
+		t.Fatal(err)
+	}
+	result, err := cache.Prune(time.Hour)
+	if err != nil || result.Removed != 1 {
+		t.Fatalf("Prune() stale = %+v, %v; want 1 removed", result, err)
+	}
+	if _, err := os.Stat(resized); !os.IsNotExist(err) {
+		t.Error("stale variant still on disk")
+	}
+}
@@ -0,0 +1,93 @@
+package imagecache
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/slipstream/slipstream/internal/metadata"
+)
+
+// Handlers serves cached and resized images.
+type Handlers struct {
+	cache   *Cache
+	artwork *metadata.ArtworkDownloader
+}
+
+// NewHandlers creates new image cache handlers.
+func NewHandlers(cache *Cache, artwork *metadata.ArtworkDownloader) *Handlers {
+	return &Handlers{
+		cache:   cache,
+		artwork: artwork,
+	}
+}
+
+// RegisterRoutes registers the image routes. They are public for the same
+// reason as the artwork routes: <img> requests carry no Authorization header.
+func (h *Handlers) RegisterRoutes(g *echo.Group) {
+	g.GET("/remote", h.GetRemote)
+	g.GET("/:type/:id/:artworkType", h.GetArtwork)
+}
+
+// GetArtwork serves an item's downloaded artwork, resized when w is given.
+// GET /api/v1/media/:type/:id/:artworkType?w=342
+func (h *Handlers) GetArtwork(c echo.Context) error {
+	mediaType, ok := metadata.ParseMediaType(c.Param("type"))
+	if !ok {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid media type, must be 'movie' or 'series'")
+	}
+
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid id")
+	}
+
+	artworkType, ok := metadata.ParseArtworkType(mediaType, c.Param("artworkType"))
+	if !ok {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid artwork type")
+	}
+
+	path := h.artwork.GetArtworkPath(mediaType, id, artworkType)
+	if path == "" {
+		return echo.NewHTTPError(http.StatusNotFound, "artwork not found")
+	}
+
+	return h.serve(c, path)
+}
+
+// GetRemote serves a provider image through the local cache, resized when w is given.
+// GET /api/v1/media/remote?url=https://image.tmdb.org/t/p/w500/abc.jpg&w=342
+func (h *Handlers) GetRemote(c echo.Context) error {
+	rawURL := c.QueryParam("url")
+	if rawURL == "" {
+		return echo.NewHTTPError(http.StatusBadRequest, "url is required")
+	}
+
+	path, err := h.cache.Remote(c.Request().Context(), rawURL)
+	if err != nil {
+		switch {
+		case errors.Is(err, ErrInvalidURL), errors.Is(err, ErrHostNotAllowed):
+			return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+		case errors.Is(err, ErrFetchFailed):
+			return echo.NewHTTPError(http.StatusBadGateway, err.Error())
+		default:
+			return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+		}
+	}
+
+	return h.serve(c, path)
+}
+
+func (h *Handlers) serve(c echo.Context, path string) error {
+	resized, err := h.cache.Resize(path, widthParam(c.QueryParam("w")))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	// Set immutable cache headers — frontend uses ?v= query params for cache busting
+	c.Response().Header().Set("Cache-Control", "public, max-age=31536000, immutable")
+
+	return c.File(resized)
+}
@@ -3,9 +3,11 @@ package metadata
 import (
 	"context"
 
+	"github.com/slipstream/slipstream/internal/metadata/fanarttv"
 	"github.com/slipstream/slipstream/internal/metadata/omdb"
 	"github.com/slipstream/slipstream/internal/metadata/tmdb"
 	"github.com/slipstream/slipstream/internal/metadata/tvdb"
+	"github.com/slipstream/slipstream/internal/metadata/tvmaze"
 )
 
 // NetworkLogoStore provides cached network name → logo URL lookups.
@@ -23,12 +25,15 @@ type TMDBClient interface {
 	SearchMovies(ctx context.Context, query string, year int) ([]tmdb.NormalizedMovieResult, error)
 	GetMovie(ctx context.Context, id int) (*tmdb.NormalizedMovieResult, error)
 	GetMovieReleaseDates(ctx context.Context, id int) (digital, physical, theatrical string, err error)
+	FindByIMDbID(ctx context.Context, imdbID string) (movieID, seriesID int, err error)
 	SearchSeries(ctx context.Context, query string) ([]tmdb.NormalizedSeriesResult, error)
 	GetSeries(ctx context.Context, id int) (*tmdb.NormalizedSeriesResult, error)
 	GetAllSeasons(ctx context.Context, seriesID int) ([]tmdb.NormalizedSeasonResult, error)
 	GetImageURL(path string, size string) string
 	GetMovieCredits(ctx context.Context, id int) (*tmdb.NormalizedCredits, error)
 	GetSeriesCredits(ctx context.Context, id int) (*tmdb.NormalizedCredits, error)
+	GetPerson(ctx context.Context, id int) (*tmdb.NormalizedPersonDetails, error)
+	GetPersonMovieCredits(ctx context.Context, id int) ([]tmdb.NormalizedPersonCredit, error)
 	GetMovieContentRating(ctx context.Context, id int) (string, error)
 	GetSeriesContentRating(ctx context.Context, id int) (string, error)
 	GetMovieStudio(ctx context.Context, id int) (string, error)
@@ -36,6 +41,10 @@ type TMDBClient interface {
 	GetSeriesLogoURL(ctx context.Context, id int) (string, error)
 	GetMovieTrailerURL(ctx context.Context, id int) (string, error)
 	GetSeriesTrailerURL(ctx context.Context, id int) (string, error)
+	GetTrendingMovies(ctx context.Context) ([]tmdb.NormalizedMovieResult, error)
+	GetTrendingSeries(ctx context.Context) ([]tmdb.NormalizedSeriesResult, error)
+	GetMovieAlternateTitles(ctx context.Context, id int) ([]tmdb.NormalizedAlternateTitle, error)
+	GetSeriesAlternateTitles(ctx context.Context, id int) ([]tmdb.NormalizedAlternateTitle, error)
 }
 
 // TVDBClient defines the interface for TVDB API operations.
@@ -48,6 +57,25 @@ type TVDBClient interface {
 	GetSeriesEpisodes(ctx context.Context, id int) ([]tvdb.NormalizedSeasonResult, error)
 }
 
+// TVMazeClient defines the interface for TVMaze API operations.
+type TVMazeClient interface {
+	Name() string
+	IsConfigured() bool
+	Test(ctx context.Context) error
+	SearchSeries(ctx context.Context, query string) ([]tvmaze.NormalizedSeriesResult, error)
+	LookupByTVDB(ctx context.Context, tvdbID int) (*tvmaze.NormalizedSeriesResult, error)
+	GetSeriesEpisodes(ctx context.Context, id int) ([]tvmaze.NormalizedSeasonResult, error)
+}
+
+// FanartClient defines the interface for Fanart.tv API operations.
+type FanartClient interface {
+	Name() string
+	IsConfigured() bool
+	Test(ctx context.Context) error
+	GetMovieArtwork(ctx context.Context, tmdbID int) (*fanarttv.NormalizedArtwork, error)
+	GetSeriesArtwork(ctx context.Context, tvdbID int) (*fanarttv.NormalizedArtwork, error)
+}
+
 // OMDBClient defines the interface for OMDb API operations.
 type OMDBClient interface {
 	Name() string
@@ -83,6 +83,20 @@ func (c *TMDBClient) GetMovieReleaseDates(ctx context.Context, id int) (digital,
 	return "2024-06-15", "2024-08-20", "2024-03-01", nil
 }
 
+func (c *TMDBClient) FindByIMDbID(ctx context.Context, imdbID string) (movieID, seriesID int, err error) {
+	for i := range mockMovies {
+		if mockMovies[i].ImdbID == imdbID {
+			return mockMovies[i].ID, 0, nil
+		}
+	}
+	for i := range mockSeries {
+		if mockSeries[i].ImdbID == imdbID {
+			return 0, mockSeries[i].ID, nil
+		}
+	}
+	return 0, 0, nil
+}
+
 func (c *TMDBClient) SearchSeries(ctx context.Context, query string) ([]tmdb.NormalizedSeriesResult, error) {
 	query = strings.ToLower(query)
 	var results []tmdb.NormalizedSeriesResult
@@ -183,6 +197,62 @@ func (c *TMDBClient) GetSeriesTrailerURL(ctx context.Context, id int) (string, e
 	return fmt.Sprintf("https://www.youtube.com/watch?v=mock_series_%d", id), nil
 }
 
+func (c *TMDBClient) GetTrendingMovies(ctx context.Context) ([]tmdb.NormalizedMovieResult, error) {
+	return mockMovies[:min(20, len(mockMovies))], nil
+}
+
+func (c *TMDBClient) GetTrendingSeries(ctx context.Context) ([]tmdb.NormalizedSeriesResult, error) {
+	return mockSeries[:min(20, len(mockSeries))], nil
+}
+
+func (c *TMDBClient) GetMovieAlternateTitles(ctx context.Context, id int) ([]tmdb.NormalizedAlternateTitle, error) {
+	return nil, nil
+}
+
+func (c *TMDBClient) GetSeriesAlternateTitles(ctx context.Context, id int) ([]tmdb.NormalizedAlternateTitle, error) {
+	return nil, nil
+}
+
+func (c *TMDBClient) GetPerson(ctx context.Context, id int) (*tmdb.NormalizedPersonDetails, error) {
+	for _, credits := range []map[int]tmdb.NormalizedCredits{mockMovieCredits, mockSeriesCredits} {
+		for _, cr := range credits {
+			for _, group := range [][]tmdb.NormalizedPerson{cr.Directors, cr.Writers, cr.Creators, cr.Cast} {
+				for _, p := range group {
+					if p.ID == id {
+						return &tmdb.NormalizedPersonDetails{ID: p.ID, Name: p.Name, PhotoURL: p.PhotoURL}, nil
+					}
+				}
+			}
+		}
+	}
+	return nil, tmdb.ErrPersonNotFound
+}
+
+func (c *TMDBClient) GetPersonMovieCredits(ctx context.Context, id int) ([]tmdb.NormalizedPersonCredit, error) {
+	var results []tmdb.NormalizedPersonCredit
+	for i := range mockMovies {
+		movie := &mockMovies[i]
+		cr, ok := mockMovieCredits[movie.ID]
+		if !ok {
+			continue
+		}
+		add := func(people []tmdb.NormalizedPerson, department string) {
+			for _, p := range people {
+				if p.ID == id {
+					results = append(results, tmdb.NormalizedPersonCredit{
+						TmdbID: movie.ID, Title: movie.Title, Year: movie.Year,
+						Department: department, Role: p.Role, PosterURL: movie.PosterURL,
+					})
+				}
+			}
+		}
+		add(cr.Directors, "director")
+		add(cr.Writers, "writer")
+		add(cr.Cast, "cast")
+	}
+	return results, nil
+}
+
 var defaultMovieCredits = tmdb.NormalizedCredits{
 	Directors: []tmdb.NormalizedPerson{{ID: 1, Name: "Christopher Nolan", PhotoURL: "https://image.tmdb.org/t/p/w185/xuAIuYSmsUzKlUMBFGVZaWsY3DZ.jpg"}},
 	Writers:   []tmdb.NormalizedPerson{{ID: 2, Name: "Jonathan Nolan", Role: "Screenplay", PhotoURL: "https://image.tmdb.org/t/p/w185/dummy.jpg"}},
@@ -41,21 +41,31 @@ type MovieResult struct {
 
 // SeriesResult represents a TV series from a metadata provider.
 type SeriesResult struct {
-	ID             int      `json:"id"`
-	Title          string   `json:"title"`
-	Year           int      `json:"year"`
-	Overview       string   `json:"overview"`
-	PosterURL      string   `json:"posterUrl,omitempty"`
-	BackdropURL    string   `json:"backdropUrl,omitempty"`
-	LogoURL        string   `json:"logoUrl,omitempty"`
-	ImdbID         string   `json:"imdbId,omitempty"`
-	TvdbID         int      `json:"tvdbId,omitempty"`
-	TmdbID         int      `json:"tmdbId,omitempty"`
-	Genres         []string `json:"genres,omitempty"`
-	Status         string   `json:"status,omitempty"`
-	Runtime        int      `json:"runtime,omitempty"`
-	Network        string   `json:"network,omitempty"`
-	NetworkLogoURL string   `json:"networkLogoUrl,omitempty"`
+	ID             int              `json:"id"`
+	Title          string           `json:"title"`
+	Year           int              `json:"year"`
+	Overview       string           `json:"overview"`
+	PosterURL      string           `json:"posterUrl,omitempty"`
+	BackdropURL    string           `json:"backdropUrl,omitempty"`
+	LogoURL        string           `json:"logoUrl,omitempty"`
+	ImdbID         string           `json:"imdbId,omitempty"`
+	TvdbID         int              `json:"tvdbId,omitempty"`
+	TmdbID         int              `json:"tmdbId,omitempty"`
+	Genres         []string         `json:"genres,omitempty"`
+	Status         string           `json:"status,omitempty"`
+	Runtime        int              `json:"runtime,omitempty"`
+	Network        string           `json:"network,omitempty"`
+	NetworkLogoURL string           `json:"networkLogoUrl,omitempty"`
+	Aliases        []AlternateTitle `json:"aliases,omitempty"`
+}
+
+// AlternateTitle is another name a movie or series is released under, either
+// an AKA title or a translation.
+type AlternateTitle struct {
+	Title  string `json:"title"`
+	Locale string `json:"locale,omitempty"`
+	Kind   string `json:"kind"`   // "alternative" or "translation"
+	Source string `json:"source"` // "tmdb" or "tvdb"
 }
 
 // SeasonResult represents a TV season with episodes from a metadata provider.
@@ -77,6 +87,7 @@ type EpisodeResult struct {
 	AirDate       string  `json:"airDate,omitempty"`
 	Runtime       int     `json:"runtime,omitempty"`
 	ImdbRating    float64 `json:"imdbRating,omitempty"`
+	StillURL      string  `json:"stillUrl,omitempty"`
 }
 
 // Person represents a person (actor, director, writer, etc.) from metadata.
@@ -87,6 +98,30 @@ type Person struct {
 	PhotoURL string `json:"photoUrl,omitempty"`
 }
 
+// PersonDetails is a person's biography from TMDB.
+type PersonDetails struct {
+	ID                 int    `json:"id"`
+	Name               string `json:"name"`
+	Biography          string `json:"biography,omitempty"`
+	Birthday           string `json:"birthday,omitempty"`
+	Deathday           string `json:"deathday,omitempty"`
+	PlaceOfBirth       string `json:"placeOfBirth,omitempty"`
+	KnownForDepartment string `json:"knownForDepartment,omitempty"`
+	PhotoURL           string `json:"photoUrl,omitempty"`
+	ImdbID             string `json:"imdbId,omitempty"`
+}
+
+// PersonCredit is one movie in a person's filmography. Department is "cast",
+// "director" or "writer"; Role is the character or writing job.
+type PersonCredit struct {
+	TmdbID     int    `json:"tmdbId"`
+	Title      string `json:"title"`
+	Year       int    `json:"year,omitempty"`
+	Department string `json:"department"`
+	Role       string `json:"role,omitempty"`
+	PosterURL  string `json:"posterUrl,omitempty"`
+}
+
 // Credits represents cast and crew information.
 type Credits struct {
 	Directors []Person `json:"directors,omitempty"`
@@ -9,9 +9,11 @@ import (
 
 	"github.com/slipstream/slipstream/internal/config"
 	"github.com/slipstream/slipstream/internal/domain/contracts"
+	"github.com/slipstream/slipstream/internal/metadata/fanarttv"
 	"github.com/slipstream/slipstream/internal/metadata/omdb"
 	"github.com/slipstream/slipstream/internal/metadata/tmdb"
 	"github.com/slipstream/slipstream/internal/metadata/tvdb"
+	"github.com/slipstream/slipstream/internal/metadata/tvmaze"
 )
 
 var (
@@ -24,6 +26,10 @@ type Service struct {
 	tmdb             TMDBClient
 	tvdb             TVDBClient
 	omdb             OMDBClient
+	tvmaze           TVMazeClient
+	fanart           FanartClient
+	seriesProviders  []string
+	artworkSource    string
 	cache            *Cache
 	logger           zerolog.Logger
 	healthService    contracts.HealthService
@@ -37,6 +43,10 @@ func NewService(cfg *config.MetadataConfig, logger *zerolog.Logger, healthServic
 		tmdb:             tmdb.NewClient(cfg.TMDB, logger),
 		tvdb:             tvdb.NewClient(cfg.TVDB, logger),
 		omdb:             omdb.NewClient(cfg.OMDB, logger),
+		tvmaze:           tvmaze.NewClient(cfg.TVMaze, logger),
+		fanart:           fanarttv.NewClient(cfg.Fanart, logger),
+		seriesProviders:  cfg.SeriesProviders,
+		artworkSource:    cfg.ArtworkSource,
 		cache:            NewCache(DefaultCacheConfig()),
 		logger:           subLogger,
 		healthService:    healthService,
@@ -57,6 +67,12 @@ func (s *Service) SetOMDBClient(client OMDBClient) {
 	s.omdb = client
 }
 
+// SetTVMazeClient sets the TVMaze client.
+func (s *Service) SetTVMazeClient(client TVMazeClient) {
+	s.tvmaze = client
+	s.cache.Clear()
+}
+
 // RegisterMetadataProviders registers configured metadata providers with the health service.
 func (s *Service) RegisterMetadataProviders() {
 	if s.healthService == nil {
@@ -80,6 +96,22 @@ func (s *Service) RegisterMetadataProviders() {
 		s.healthService.RegisterItemStr("metadata", "omdb", "OMDb")
 		s.logger.Debug().Msg("Registered OMDb with health service")
 	}
+
+	// Register TVMaze if enabled
+	if s.tvmaze != nil && s.tvmaze.IsConfigured() {
+		s.healthService.RegisterItemStr("metadata", "tvmaze", "TVMaze")
+		s.logger.Debug().Msg("Registered TVMaze with health service")
+	} else {
+		s.healthService.UnregisterItemStr("metadata", "tvmaze")
+	}
+
+	// Register Fanart.tv if configured
+	if s.fanart != nil && s.fanart.IsConfigured() {
+		s.healthService.RegisterItemStr("metadata", "fanarttv", "Fanart.tv")
+		s.logger.Debug().Msg("Registered Fanart.tv with health service")
+	} else {
+		s.healthService.UnregisterItemStr("metadata", "fanarttv")
+	}
 }
 
 // tmdbMovieToResult converts a TMDB movie result to metadata.MovieResult.
@@ -135,7 +167,29 @@ func tvdbSeriesToResult(s *tvdb.NormalizedSeriesResult) SeriesResult {
 		Status:      s.Status,
 		Runtime:     s.Runtime,
 		Network:     s.Network,
+		Aliases:     tvdbAliasesToAlternateTitles(s.Aliases),
+	}
+}
+
+func tvdbAliasesToAlternateTitles(aliases []tvdb.Alias) []AlternateTitle {
+	if len(aliases) == 0 {
+		return nil
+	}
+	titles := make([]AlternateTitle, 0, len(aliases))
+	for _, a := range aliases {
+		if a.Name != "" {
+			titles = append(titles, AlternateTitle{Title: a.Name, Locale: a.Language, Kind: "alternative", Source: "tvdb"})
+		}
+	}
+	return titles
+}
+
+func tmdbAlternateTitles(titles []tmdb.NormalizedAlternateTitle) []AlternateTitle {
+	out := make([]AlternateTitle, 0, len(titles))
+	for _, t := range titles {
+		out = append(out, AlternateTitle{Title: t.Title, Locale: t.Locale, Kind: t.Kind, Source: "tmdb"})
 	}
+	return out
 }
 
 // HasMovieProvider returns true if a movie metadata provider is configured.
@@ -145,7 +199,7 @@ func (s *Service) HasMovieProvider() bool {
 
 // HasSeriesProvider returns true if a series metadata provider is configured.
 func (s *Service) HasSeriesProvider() bool {
-	return s.tmdb.IsConfigured() || s.tvdb.IsConfigured()
+	return s.tmdb.IsConfigured() || s.tvdb.IsConfigured() || s.tvmazeConfigured()
 }
 
 // SearchMovies searches for movies using available providers.
@@ -246,20 +300,36 @@ func (s *Service) SearchSeries(ctx context.Context, query string) ([]SeriesResul
 	return results, nil
 }
 
+// searchSeriesFromProviders tries each series provider in priority order
+// until one returns results.
 func (s *Service) searchSeriesFromProviders(ctx context.Context, query string) ([]SeriesResult, error) {
-	results, err := s.tryTVDBSearch(ctx, query)
-	if len(results) > 0 {
-		return results, nil
-	}
-
-	tmdbResults, tmdbErr := s.tryTMDBSeriesSearch(ctx, query)
-	if tmdbErr != nil {
+	var failed []providerFailure
+	for _, provider := range s.seriesProviderOrder() {
+		var results []SeriesResult
+		var err error
+		switch provider {
+		case providerTVDB:
+			results, err = s.tryTVDBSearch(ctx, query)
+		case providerTMDB:
+			results, err = s.tryTMDBSeriesSearch(ctx, query)
+		case providerTVMaze:
+			results, err = s.tryTVMazeSearch(ctx, query)
+		}
 		if err != nil {
-			return nil, fmt.Errorf("series search failed: %w", err)
+			failed = append(failed, providerFailure{name: provider, err: err})
+			continue
+		}
+		if len(results) > 0 {
+			s.recordFailover(failed, provider)
+			return results, nil
 		}
-		return nil, fmt.Errorf("series search failed: %w", tmdbErr)
 	}
-	return tmdbResults, nil
+
+	if len(failed) > 0 {
+		s.recordFailover(failed, "")
+		return nil, fmt.Errorf("series search failed: %w", failed[len(failed)-1].err)
+	}
+	return []SeriesResult{}, nil
 }
 
 func (s *Service) tryTVDBSearch(ctx context.Context, query string) ([]SeriesResult, error) {
@@ -269,7 +339,7 @@ func (s *Service) tryTVDBSearch(ctx context.Context, query string) ([]SeriesResu
 
 	tvdbResults, err := s.tvdb.SearchSeries(ctx, query)
 	if err != nil {
-		s.logger.Warn().Err(err).Str("query", query).Msg("TVDB series search failed, trying TMDB")
+		s.logger.Warn().Err(err).Str("query", query).Msg("TVDB series search failed")
 		return nil, err
 	}
 
@@ -283,7 +353,7 @@ func (s *Service) tryTVDBSearch(ctx context.Context, query string) ([]SeriesResu
 
 func (s *Service) tryTMDBSeriesSearch(ctx context.Context, query string) ([]SeriesResult, error) {
 	if !s.tmdb.IsConfigured() {
-		return nil, ErrNoProvidersConfigured
+		return nil, nil
 	}
 
 	tmdbResults, err := s.tmdb.SearchSeries(ctx, query)
@@ -299,6 +369,56 @@ func (s *Service) tryTMDBSeriesSearch(ctx context.Context, query string) ([]Seri
 	return results, nil
 }
 
+// GetTrendingMovies returns this week's trending movies from TMDB.
+func (s *Service) GetTrendingMovies(ctx context.Context) ([]MovieResult, error) {
+	if !s.tmdb.IsConfigured() {
+		return nil, ErrNoProvidersConfigured
+	}
+
+	cacheKey := "movie:trending"
+	if results, ok := s.cache.GetMovieResults(cacheKey); ok {
+		return results, nil
+	}
+
+	tmdbResults, err := s.tmdb.GetTrendingMovies(ctx)
+	if err != nil {
+		s.logger.Error().Err(err).Msg("TMDB trending movies failed")
+		return nil, fmt.Errorf("trending movies failed: %w", err)
+	}
+
+	results := make([]MovieResult, len(tmdbResults))
+	for i := range tmdbResults {
+		results[i] = tmdbMovieToResult(&tmdbResults[i])
+	}
+	s.cache.Set(cacheKey, results)
+	return results, nil
+}
+
+// GetTrendingSeries returns this week's trending series from TMDB.
+func (s *Service) GetTrendingSeries(ctx context.Context) ([]SeriesResult, error) {
+	if !s.tmdb.IsConfigured() {
+		return nil, ErrNoProvidersConfigured
+	}
+
+	cacheKey := "series:trending"
+	if results, ok := s.cache.GetSeriesResults(cacheKey); ok {
+		return results, nil
+	}
+
+	tmdbResults, err := s.tmdb.GetTrendingSeries(ctx)
+	if err != nil {
+		s.logger.Error().Err(err).Msg("TMDB trending series failed")
+		return nil, fmt.Errorf("trending series failed: %w", err)
+	}
+
+	results := make([]SeriesResult, len(tmdbResults))
+	for i := range tmdbResults {
+		results[i] = tmdbSeriesToResult(&tmdbResults[i])
+	}
+	s.cache.Set(cacheKey, results)
+	return results, nil
+}
+
 // GetSeriesByTMDB gets detailed series info by TMDB ID.
 func (s *Service) GetSeriesByTMDB(ctx context.Context, tmdbID int) (*SeriesResult, error) {
 	if !s.tmdb.IsConfigured() {
@@ -377,6 +497,21 @@ func (s *Service) GetSeriesByTVDB(ctx context.Context, tvdbID int) (*SeriesResul
 	return &result, nil
 }
 
+// FindByIMDbID resolves an IMDb ID to a TMDB movie or series ID. Either
+// returned ID is zero when there is no match of that kind.
+func (s *Service) FindByIMDbID(ctx context.Context, imdbID string) (movieTmdbID, seriesTmdbID int, err error) {
+	if !s.tmdb.IsConfigured() {
+		return 0, 0, ErrNoProvidersConfigured
+	}
+
+	movieTmdbID, seriesTmdbID, err = s.tmdb.FindByIMDbID(ctx, imdbID)
+	if err != nil {
+		s.logger.Error().Err(err).Str("imdbId", imdbID).Msg("TMDB find by IMDb ID failed")
+		return 0, 0, fmt.Errorf("find by imdb id failed: %w", err)
+	}
+	return movieTmdbID, seriesTmdbID, nil
+}
+
 // GetSeries gets series details, trying TVDB first (primary for TV), then TMDB.
 func (s *Service) GetSeries(ctx context.Context, tvdbID, tmdbID int) (*SeriesResult, error) {
 	// Try TVDB first if we have an ID
@@ -474,6 +609,123 @@ func (s *Service) GetMovieContentRating(ctx context.Context, tmdbID int) (string
 	return s.tmdb.GetMovieContentRating(ctx, tmdbID)
 }
 
+// GetMovieCredits fetches a movie's cast and crew from TMDB.
+func (s *Service) GetMovieCredits(ctx context.Context, tmdbID int) (*Credits, error) {
+	if !s.tmdb.IsConfigured() {
+		return nil, ErrNoProvidersConfigured
+	}
+	credits, err := s.tmdb.GetMovieCredits(ctx, tmdbID)
+	if err != nil {
+		return nil, fmt.Errorf("get movie credits failed: %w", err)
+	}
+	return tmdbCreditsToCredits(credits), nil
+}
+
+// GetSeriesCredits fetches a series' creators and cast from TMDB.
+func (s *Service) GetSeriesCredits(ctx context.Context, tmdbID int) (*Credits, error) {
+	if !s.tmdb.IsConfigured() {
+		return nil, ErrNoProvidersConfigured
+	}
+	credits, err := s.tmdb.GetSeriesCredits(ctx, tmdbID)
+	if err != nil {
+		return nil, fmt.Errorf("get series credits failed: %w", err)
+	}
+	return tmdbCreditsToCredits(credits), nil
+}
+
+// GetPerson fetches a person's details from TMDB by TMDB person ID.
+func (s *Service) GetPerson(ctx context.Context, personID int) (*PersonDetails, error) {
+	if !s.tmdb.IsConfigured() {
+		return nil, ErrNoProvidersConfigured
+	}
+	p, err := s.tmdb.GetPerson(ctx, personID)
+	if err != nil {
+		if errors.Is(err, tmdb.ErrPersonNotFound) {
+			return nil, ErrNotFound
+		}
+		return nil, fmt.Errorf("get person failed: %w", err)
+	}
+	return &PersonDetails{
+		ID:                 p.ID,
+		Name:               p.Name,
+		Biography:          p.Biography,
+		Birthday:           p.Birthday,
+		Deathday:           p.Deathday,
+		PlaceOfBirth:       p.PlaceOfBirth,
+		KnownForDepartment: p.KnownForDepartment,
+		PhotoURL:           p.PhotoURL,
+		ImdbID:             p.ImdbID,
+	}, nil
+}
+
+// GetPersonMovieCredits fetches the movies a person acted in, directed or wrote.
+func (s *Service) GetPersonMovieCredits(ctx context.Context, personID int) ([]PersonCredit, error) {
+	if !s.tmdb.IsConfigured() {
+		return nil, ErrNoProvidersConfigured
+	}
+	credits, err := s.tmdb.GetPersonMovieCredits(ctx, personID)
+	if err != nil {
+		if errors.Is(err, tmdb.ErrPersonNotFound) {
+			return nil, ErrNotFound
+		}
+		return nil, fmt.Errorf("get person movie credits failed: %w", err)
+	}
+	results := make([]PersonCredit, len(credits))
+	for i := range credits {
+		c := &credits[i]
+		results[i] = PersonCredit{
+			TmdbID:     c.TmdbID,
+			Title:      c.Title,
+			Year:       c.Year,
+			Department: c.Department,
+			Role:       c.Role,
+			PosterURL:  c.PosterURL,
+		}
+	}
+	return results, nil
+}
+
+// GetMovieAlternateTitles fetches a movie's AKA titles and translations from TMDB.
+func (s *Service) GetMovieAlternateTitles(ctx context.Context, tmdbID int) ([]AlternateTitle, error) {
+	if !s.tmdb.IsConfigured() {
+		return nil, ErrNoProvidersConfigured
+	}
+	titles, err := s.tmdb.GetMovieAlternateTitles(ctx, tmdbID)
+	if err != nil {
+		return nil, fmt.Errorf("get movie alternate titles failed: %w", err)
+	}
+	return tmdbAlternateTitles(titles), nil
+}
+
+// GetSeriesAlternateTitles merges a series' TVDB aliases with its TMDB
+// alternative titles and translations. Either ID may be zero. An error is
+// returned only when no provider could be queried.
+func (s *Service) GetSeriesAlternateTitles(ctx context.Context, tvdbID, tmdbID int) ([]AlternateTitle, error) {
+	var titles []AlternateTitle
+	var errs []error
+
+	if tvdbID > 0 && s.tvdb.IsConfigured() {
+		if series, err := s.GetSeriesByTVDB(ctx, tvdbID); err != nil {
+			errs = append(errs, err)
+		} else {
+			titles = append(titles, series.Aliases...)
+		}
+	}
+
+	if tmdbID > 0 && s.tmdb.IsConfigured() {
+		if tmdbTitles, err := s.tmdb.GetSeriesAlternateTitles(ctx, tmdbID); err != nil {
+			errs = append(errs, fmt.Errorf("get series alternate titles failed: %w", err))
+		} else {
+			titles = append(titles, tmdbAlternateTitles(tmdbTitles)...)
+		}
+	}
+
+	if len(titles) == 0 && len(errs) > 0 {
+		return nil, errors.Join(errs...)
+	}
+	return titles, nil
+}
+
 // GetSeriesLogoURL fetches the title treatment logo URL for a series from TMDB.
 func (s *Service) GetSeriesLogoURL(ctx context.Context, tmdbID int) (string, error) {
 	if !s.tmdb.IsConfigured() {
@@ -523,6 +775,7 @@ func tmdbSeasonToResult(s *tmdb.NormalizedSeasonResult) SeasonResult {
 			Overview:      ep.Overview,
 			AirDate:       ep.AirDate,
 			Runtime:       ep.Runtime,
+			StillURL:      ep.StillURL,
 		}
 	}
 	return SeasonResult{
@@ -546,6 +799,7 @@ func tvdbSeasonToResult(s *tvdb.NormalizedSeasonResult) SeasonResult {
 			Overview:      ep.Overview,
 			AirDate:       ep.AirDate,
 			Runtime:       ep.Runtime,
+			StillURL:      ep.StillURL,
 		}
 	}
 	return SeasonResult{
@@ -558,8 +812,8 @@ func tvdbSeasonToResult(s *tvdb.NormalizedSeasonResult) SeasonResult {
 	}
 }
 
-// GetSeriesSeasons gets all seasons and episodes for a series.
-// Uses TMDB as primary, TVDB as fallback.
+// GetSeriesSeasons gets all seasons and episodes for a series from the first
+// series provider in priority order that has them.
 func (s *Service) GetSeriesSeasons(ctx context.Context, tmdbID, tvdbID int) ([]SeasonResult, error) {
 	if !s.HasSeriesProvider() {
 		return nil, ErrNoProvidersConfigured
@@ -584,20 +838,36 @@ func (s *Service) GetSeriesSeasons(ctx context.Context, tmdbID, tvdbID int) ([]S
 	return results, nil
 }
 
+// fetchSeasonsFromProviders tries each series provider in priority order
+// until one returns seasons. TVMaze is looked up by TVDB ID.
 func (s *Service) fetchSeasonsFromProviders(ctx context.Context, tmdbID, tvdbID int) ([]SeasonResult, error) {
-	results, err := s.tryTVDBSeasons(ctx, tvdbID)
-	if len(results) > 0 {
-		return results, nil
-	}
-
-	tmdbResults, tmdbErr := s.tryTMDBSeasons(ctx, tmdbID)
-	if tmdbErr != nil {
+	var failed []providerFailure
+	for _, provider := range s.seriesProviderOrder() {
+		var results []SeasonResult
+		var err error
+		switch provider {
+		case providerTVDB:
+			results, err = s.tryTVDBSeasons(ctx, tvdbID)
+		case providerTMDB:
+			results, err = s.tryTMDBSeasons(ctx, tmdbID)
+		case providerTVMaze:
+			results, err = s.tryTVMazeSeasons(ctx, tvdbID)
+		}
 		if err != nil {
-			return nil, fmt.Errorf("get series seasons failed: %w", err)
+			failed = append(failed, providerFailure{name: provider, err: err})
+			continue
+		}
+		if len(results) > 0 {
+			s.recordFailover(failed, provider)
+			return results, nil
 		}
-		return nil, fmt.Errorf("get series seasons failed: %w", tmdbErr)
 	}
-	return tmdbResults, nil
+
+	if len(failed) > 0 {
+		s.recordFailover(failed, "")
+		return nil, fmt.Errorf("get series seasons failed: %w", failed[len(failed)-1].err)
+	}
+	return nil, fmt.Errorf("get series seasons failed: %w", ErrNoProvidersConfigured)
 }
 
 func (s *Service) tryTVDBSeasons(ctx context.Context, tvdbID int) ([]SeasonResult, error) {
@@ -607,7 +877,7 @@ func (s *Service) tryTVDBSeasons(ctx context.Context, tvdbID int) ([]SeasonResul
 
 	tvdbResults, err := s.tvdb.GetSeriesEpisodes(ctx, tvdbID)
 	if err != nil {
-		s.logger.Warn().Err(err).Int("tvdbId", tvdbID).Msg("TVDB get episodes failed, trying TMDB")
+		s.logger.Warn().Err(err).Int("tvdbId", tvdbID).Msg("TVDB get episodes failed")
 		return nil, err
 	}
 
@@ -620,7 +890,7 @@ func (s *Service) tryTVDBSeasons(ctx context.Context, tvdbID int) ([]SeasonResul
 
 func (s *Service) tryTMDBSeasons(ctx context.Context, tmdbID int) ([]SeasonResult, error) {
 	if tmdbID <= 0 || !s.tmdb.IsConfigured() {
-		return nil, ErrNoProvidersConfigured
+		return nil, nil
 	}
 
 	tmdbResults, err := s.tmdb.GetAllSeasons(ctx, tmdbID)
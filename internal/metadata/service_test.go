@@ -6,10 +6,13 @@ import (
 	"errors"
 	"net/http"
 	"net/http/httptest"
+	"slices"
+	"strings"
 	"testing"
 
 	"github.com/slipstream/slipstream/internal/config"
 	"github.com/slipstream/slipstream/internal/metadata/tmdb"
+	"github.com/slipstream/slipstream/internal/metadata/tvdb"
 )
 
 func setupTestServer(t *testing.T) *httptest.Server {
@@ -264,3 +267,83 @@ func TestService_ClearCache(t *testing.T) {
 	// Verify cache is empty (would need to expose cache for proper test)
 	// For now, just verify it doesn't panic
 }
+
+type failingTVDB struct{ err error }
+
+func (f failingTVDB) Name() string                   { return "tvdb" }
+func (f failingTVDB) IsConfigured() bool             { return true }
+func (f failingTVDB) Test(ctx context.Context) error { return f.err }
+func (f failingTVDB) SearchSeries(ctx context.Context, query string) ([]tvdb.NormalizedSeriesResult, error) {
+	return nil, f.err
+}
+func (f failingTVDB) GetSeries(ctx context.Context, id int) (*tvdb.NormalizedSeriesResult, error) {
+	return nil, f.err
+}
+func (f failingTVDB) GetSeriesEpisodes(ctx context.Context, id int) ([]tvdb.NormalizedSeasonResult, error) {
+	return nil, f.err
+}
+
+type recordingHealth struct {
+	warnings map[string]string
+	errors   map[string]string
+	cleared  []string
+}
+
+func newRecordingHealth() *recordingHealth {
+	return &recordingHealth{warnings: map[string]string{}, errors: map[string]string{}}
+}
+
+func (h *recordingHealth) RegisterItemStr(category, id, name string)  {}
+func (h *recordingHealth) UnregisterItemStr(category, id string)      {}
+func (h *recordingHealth) SetErrorStr(category, id, message string)   { h.errors[id] = message }
+func (h *recordingHealth) SetWarningStr(category, id, message string) { h.warnings[id] = message }
+func (h *recordingHealth) ClearStatusStr(category, id string)         { h.cleared = append(h.cleared, id) }
+
+func TestService_GetSeriesSeasons_FailsOverToTVMaze(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/lookup/shows":
+			w.Write([]byte(`{"id": 169, "name": "Breaking Bad", "externals": {"thetvdb": 81189}}`))
+		case "/shows/169/episodes":
+			w.Write([]byte(`[{"id": 1, "name": "Pilot", "season": 1, "number": 1, "airdate": "2008-01-20"}]`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	health := newRecordingHealth()
+	cfg := config.MetadataConfig{
+		TVMaze: config.TVMazeConfig{Enabled: true, BaseURL: server.URL, Timeout: 5},
+	}
+	svc := NewService(&cfg, newTestLogger(), health, nil)
+	svc.tvdb = failingTVDB{err: tvdb.ErrRateLimited}
+
+	seasons, err := svc.GetSeriesSeasons(context.Background(), 0, 81189)
+	if err != nil {
+		t.Fatalf("GetSeriesSeasons() error = %v", err)
+	}
+	if len(seasons) != 1 || seasons[0].Episodes[0].Title != "Pilot" {
+		t.Errorf("seasons = %+v, want the TVMaze episode", seasons)
+	}
+	if msg := health.warnings["tvdb"]; !strings.Contains(msg, "fell back to TVMaze") {
+		t.Errorf("tvdb health warning = %q, want a failover note", msg)
+	}
+	if !slices.Contains(health.cleared, "tvmaze") {
+		t.Errorf("cleared = %v, want tvmaze marked healthy", health.cleared)
+	}
+}
+
+func TestService_GetSeriesSeasons_AllProvidersFail(t *testing.T) {
+	health := newRecordingHealth()
+	cfg := config.MetadataConfig{SeriesProviders: []string{"tvdb", "bogus"}}
+	svc := NewService(&cfg, newTestLogger(), health, nil)
+	svc.tvdb = failingTVDB{err: tvdb.ErrRateLimited}
+
+	if _, err := svc.GetSeriesSeasons(context.Background(), 0, 81189); !errors.Is(err, tvdb.ErrRateLimited) {
+		t.Fatalf("GetSeriesSeasons() error = %v, want %v", err, tvdb.ErrRateLimited)
+	}
+	if health.errors["tvdb"] == "" {
+		t.Error("tvdb health error not recorded")
+	}
+}
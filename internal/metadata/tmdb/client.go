@@ -21,6 +21,7 @@ var (
 	ErrAPIKeyMissing  = errors.New("TMDB API key is not configured")
 	ErrMovieNotFound  = errors.New("movie not found")
 	ErrSeriesNotFound = errors.New("series not found")
+	ErrPersonNotFound = errors.New("person not found")
 	ErrAPIError       = errors.New("TMDB API error")
 	ErrRateLimited    = errors.New("TMDB API rate limited")
 )
@@ -175,6 +176,39 @@ func (c *Client) GetMovie(ctx context.Context, id int) (*NormalizedMovieResult,
 	return &result, nil
 }
 
+// FindByIMDbID resolves an IMDb ID to TMDB IDs. Either returned ID is zero when
+// TMDB has no movie or series for it.
+func (c *Client) FindByIMDbID(ctx context.Context, imdbID string) (movieID, seriesID int, err error) {
+	if !c.IsConfigured() {
+		return 0, 0, ErrAPIKeyMissing
+	}
+
+	endpoint := fmt.Sprintf("%s/find/%s", c.config.BaseURL, url.PathEscape(imdbID))
+	params := url.Values{}
+	params.Set("api_key", c.config.APIKey)
+	params.Set("external_source", "imdb_id")
+
+	var resp FindResponse
+	if err := c.doRequest(ctx, endpoint, params, &resp); err != nil {
+		return 0, 0, err
+	}
+
+	if len(resp.MovieResults) > 0 {
+		movieID = resp.MovieResults[0].ID
+	}
+	if len(resp.TVResults) > 0 {
+		seriesID = resp.TVResults[0].ID
+	}
+
+	c.logger.Debug().
+		Str("imdbId", imdbID).
+		Int("movieId", movieID).
+		Int("seriesId", seriesID).
+		Msg("Resolved IMDb ID")
+
+	return movieID, seriesID, nil
+}
+
 // SearchSeries searches for TV series by query.
 func (c *Client) SearchSeries(ctx context.Context, query string) ([]NormalizedSeriesResult, error) {
 	if !c.IsConfigured() {
@@ -203,6 +237,53 @@ func (c *Client) SearchSeries(ctx context.Context, query string) ([]NormalizedSe
 	return c.convertSeriesResultsScored(response.Results, query), nil
 }
 
+// GetTrendingMovies returns this week's trending movies.
+func (c *Client) GetTrendingMovies(ctx context.Context) ([]NormalizedMovieResult, error) {
+	if !c.IsConfigured() {
+		return nil, ErrAPIKeyMissing
+	}
+
+	endpoint := fmt.Sprintf("%s/trending/movie/week", c.config.BaseURL)
+	params := url.Values{}
+	params.Set("api_key", c.config.APIKey)
+
+	var response SearchMoviesResponse
+	if err := c.doRequest(ctx, endpoint, params, &response); err != nil {
+		return nil, err
+	}
+
+	results := make([]NormalizedMovieResult, 0, len(response.Results))
+	for i := range response.Results {
+		if response.Results[i].Adult {
+			continue
+		}
+		results = append(results, c.toMovieResult(&response.Results[i]))
+	}
+	return results, nil
+}
+
+// GetTrendingSeries returns this week's trending TV series.
+func (c *Client) GetTrendingSeries(ctx context.Context) ([]NormalizedSeriesResult, error) {
+	if !c.IsConfigured() {
+		return nil, ErrAPIKeyMissing
+	}
+
+	endpoint := fmt.Sprintf("%s/trending/tv/week", c.config.BaseURL)
+	params := url.Values{}
+	params.Set("api_key", c.config.APIKey)
+
+	var response SearchTVResponse
+	if err := c.doRequest(ctx, endpoint, params, &response); err != nil {
+		return nil, err
+	}
+
+	results := make([]NormalizedSeriesResult, len(response.Results))
+	for i := range response.Results {
+		results[i] = c.toSeriesResult(&response.Results[i])
+	}
+	return results, nil
+}
+
 func (c *Client) convertSeriesResultsUnscored(series []TVResult, query string) []NormalizedSeriesResult {
 	results := make([]NormalizedSeriesResult, len(series))
 	for i := range series {
@@ -600,6 +681,9 @@ func (c *Client) seasonDetailsToResult(details *SeasonDetails) NormalizedSeasonR
 			AirDate:       ep.AirDate,
 			Runtime:       ep.Runtime,
 		}
+		if ep.StillPath != nil {
+			episodes[i].StillURL = c.GetImageURL(*ep.StillPath, "w300")
+		}
 	}
 
 	result := NormalizedSeasonResult{
@@ -833,6 +917,103 @@ func (c *Client) GetSeriesCredits(ctx context.Context, id int) (*NormalizedCredi
 	return c.normalizeCredits(creditsResponse, details.CreatedBy), nil
 }
 
+// GetPerson fetches a person's details by TMDB person ID.
+func (c *Client) GetPerson(ctx context.Context, id int) (*NormalizedPersonDetails, error) {
+	if !c.IsConfigured() {
+		return nil, ErrAPIKeyMissing
+	}
+
+	endpoint := fmt.Sprintf("%s/person/%d", c.config.BaseURL, id)
+	params := url.Values{}
+	params.Set("api_key", c.config.APIKey)
+
+	var response PersonResponse
+	if err := c.doRequest(ctx, endpoint, params, &response); err != nil {
+		if errors.Is(err, ErrMovieNotFound) {
+			return nil, ErrPersonNotFound
+		}
+		return nil, err
+	}
+
+	result := &NormalizedPersonDetails{
+		ID:                 response.ID,
+		Name:               response.Name,
+		Biography:          response.Biography,
+		KnownForDepartment: response.KnownForDepartment,
+		ImdbID:             response.ImdbID,
+	}
+	if response.Birthday != nil {
+		result.Birthday = *response.Birthday
+	}
+	if response.Deathday != nil {
+		result.Deathday = *response.Deathday
+	}
+	if response.PlaceOfBirth != nil {
+		result.PlaceOfBirth = *response.PlaceOfBirth
+	}
+	if response.ProfilePath != nil {
+		result.PhotoURL = c.GetImageURL(*response.ProfilePath, "w185")
+	}
+	return result, nil
+}
+
+// GetPersonMovieCredits fetches the movies a person acted in, directed or
+// wrote, newest first. Other crew jobs are left out.
+func (c *Client) GetPersonMovieCredits(ctx context.Context, id int) ([]NormalizedPersonCredit, error) {
+	if !c.IsConfigured() {
+		return nil, ErrAPIKeyMissing
+	}
+
+	endpoint := fmt.Sprintf("%s/person/%d/movie_credits", c.config.BaseURL, id)
+	params := url.Values{}
+	params.Set("api_key", c.config.APIKey)
+
+	var response PersonMovieCreditsResponse
+	if err := c.doRequest(ctx, endpoint, params, &response); err != nil {
+		if errors.Is(err, ErrMovieNotFound) {
+			return nil, ErrPersonNotFound
+		}
+		return nil, err
+	}
+
+	credits := make([]NormalizedPersonCredit, 0, len(response.Cast)+len(response.Crew))
+	for _, cast := range response.Cast {
+		credits = append(credits, c.personCredit(cast.ID, cast.Title, cast.ReleaseDate, cast.PosterPath, "cast", cast.Character))
+	}
+	for _, crew := range response.Crew {
+		switch crew.Job {
+		case "Director":
+			credits = append(credits, c.personCredit(crew.ID, crew.Title, crew.ReleaseDate, crew.PosterPath, "director", ""))
+		case "Writer", "Screenplay", "Story":
+			credits = append(credits, c.personCredit(crew.ID, crew.Title, crew.ReleaseDate, crew.PosterPath, "writer", crew.Job))
+		}
+	}
+
+	sort.SliceStable(credits, func(i, j int) bool {
+		if credits[i].Year != credits[j].Year {
+			return credits[i].Year > credits[j].Year
+		}
+		return credits[i].Title < credits[j].Title
+	})
+	return credits, nil
+}
+
+func (c *Client) personCredit(id int, title, releaseDate string, posterPath *string, department, role string) NormalizedPersonCredit {
+	credit := NormalizedPersonCredit{
+		TmdbID:     id,
+		Title:      title,
+		Department: department,
+		Role:       role,
+	}
+	if len(releaseDate) >= 4 {
+		credit.Year, _ = strconv.Atoi(releaseDate[:4])
+	}
+	if posterPath != nil {
+		credit.PosterURL = c.GetImageURL(*posterPath, "w500")
+	}
+	return credit
+}
+
 // GetMovieContentRating fetches the US content rating for a movie.
 func (c *Client) GetMovieContentRating(ctx context.Context, id int) (string, error) {
 	if !c.IsConfigured() {
@@ -1121,3 +1302,63 @@ func (c *Client) GetMovieStudio(ctx context.Context, id int) (string, error) {
 
 	return "", nil
 }
+
+// GetMovieAlternateTitles returns a movie's alternative and translated titles.
+func (c *Client) GetMovieAlternateTitles(ctx context.Context, id int) ([]NormalizedAlternateTitle, error) {
+	if !c.IsConfigured() {
+		return nil, ErrAPIKeyMissing
+	}
+
+	endpoint := fmt.Sprintf("%s/movie/%d", c.config.BaseURL, id)
+	params := url.Values{}
+	params.Set("api_key", c.config.APIKey)
+	params.Set("append_to_response", "alternative_titles,translations")
+
+	var response TitlesResponse
+	if err := c.doRequest(ctx, endpoint, params, &response); err != nil {
+		return nil, err
+	}
+
+	return normalizeAlternateTitles(&response, response.AlternativeTitles.Titles), nil
+}
+
+// GetSeriesAlternateTitles returns a TV series' alternative and translated titles.
+func (c *Client) GetSeriesAlternateTitles(ctx context.Context, id int) ([]NormalizedAlternateTitle, error) {
+	if !c.IsConfigured() {
+		return nil, ErrAPIKeyMissing
+	}
+
+	endpoint := fmt.Sprintf("%s/tv/%d", c.config.BaseURL, id)
+	params := url.Values{}
+	params.Set("api_key", c.config.APIKey)
+	params.Set("append_to_response", "alternative_titles,translations")
+
+	var response TitlesResponse
+	if err := c.doRequest(ctx, endpoint, params, &response); err != nil {
+		if errors.Is(err, ErrMovieNotFound) {
+			return nil, ErrSeriesNotFound
+		}
+		return nil, err
+	}
+
+	return normalizeAlternateTitles(&response, response.AlternativeTitles.Results), nil
+}
+
+func normalizeAlternateTitles(response *TitlesResponse, alternatives []AlternativeTitle) []NormalizedAlternateTitle {
+	titles := make([]NormalizedAlternateTitle, 0, len(alternatives)+len(response.Translations.Translations))
+	for _, alt := range alternatives {
+		if alt.Title != "" {
+			titles = append(titles, NormalizedAlternateTitle{Title: alt.Title, Locale: alt.Iso31661, Kind: "alternative"})
+		}
+	}
+	for _, tr := range response.Translations.Translations {
+		title := tr.Data.Title
+		if title == "" {
+			title = tr.Data.Name
+		}
+		if title != "" {
+			titles = append(titles, NormalizedAlternateTitle{Title: title, Locale: tr.Iso6391 + "-" + tr.Iso31661, Kind: "translation"})
+		}
+	}
+	return titles
+}
@@ -292,6 +292,37 @@ func TestClient_SearchSeries(t *testing.T) {
 	}
 }
 
+func TestClient_GetTrendingMovies(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/trending/movie/week" {
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+
+		response := SearchMoviesResponse{
+			Page: 1,
+			Results: []MovieResult{
+				{ID: 27205, Title: "Inception", ReleaseDate: "2010-07-15"},
+				{ID: 1, Title: "Adult Title", ReleaseDate: "2020-01-01", Adult: true},
+			},
+		}
+		json.NewEncoder(w).Encode(response)
+	}))
+	defer server.Close()
+
+	client := newTestClient(server)
+	results, err := client.GetTrendingMovies(context.Background())
+	if err != nil {
+		t.Fatalf("GetTrendingMovies() error = %v", err)
+	}
+
+	if len(results) != 1 {
+		t.Fatalf("GetTrendingMovies() returned %d results, want 1", len(results))
+	}
+	if results[0].Title != "Inception" || results[0].Year != 2010 {
+		t.Errorf("results[0] = %q (%d), want Inception (2010)", results[0].Title, results[0].Year)
+	}
+}
+
 func TestClient_GetSeries(t *testing.T) {
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		if r.URL.Path != "/tv/1396" {
@@ -345,6 +376,44 @@ func TestClient_GetSeries(t *testing.T) {
 	}
 }
 
+func TestClient_GetMovieAlternateTitles(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/movie/194" {
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+		if got := r.URL.Query().Get("append_to_response"); got != "alternative_titles,translations" {
+			t.Errorf("append_to_response = %q", got)
+		}
+		w.Write([]byte(`{
+			"alternative_titles": {"titles": [{"iso_3166_1": "US", "title": "Amelie", "type": ""}]},
+			"translations": {"translations": [
+				{"iso_3166_1": "FR", "iso_639_1": "fr", "data": {"title": "Le Fabuleux Destin d'Amélie Poulain"}},
+				{"iso_3166_1": "DE", "iso_639_1": "de", "data": {"title": ""}}
+			]}
+		}`))
+	}))
+	defer server.Close()
+
+	client := newTestClient(server)
+	titles, err := client.GetMovieAlternateTitles(context.Background(), 194)
+	if err != nil {
+		t.Fatalf("GetMovieAlternateTitles() error = %v", err)
+	}
+
+	want := []NormalizedAlternateTitle{
+		{Title: "Amelie", Locale: "US", Kind: "alternative"},
+		{Title: "Le Fabuleux Destin d'Amélie Poulain", Locale: "fr-FR", Kind: "translation"},
+	}
+	if len(titles) != len(want) {
+		t.Fatalf("got %d titles, want %d: %+v", len(titles), len(want), titles)
+	}
+	for i := range want {
+		if titles[i] != want[i] {
+			t.Errorf("titles[%d] = %+v, want %+v", i, titles[i], want[i])
+		}
+	}
+}
+
 func TestClient_GetImageURL(t *testing.T) {
 	client := NewClient(config.TMDBConfig{
 		ImageBaseURL: "https://image.tmdb.org/t/p",
@@ -57,6 +57,12 @@ type SearchTVResponse struct {
 	TotalResults int        `json:"total_results"`
 }
 
+// FindResponse is the response from a TMDB lookup by external ID.
+type FindResponse struct {
+	MovieResults []MovieResult `json:"movie_results"`
+	TVResults    []TVResult    `json:"tv_results"`
+}
+
 // TVResult is a TV series from TMDB search results.
 type TVResult struct {
 	ID               int      `json:"id"`
@@ -220,6 +226,7 @@ type NormalizedEpisodeResult struct {
 	Overview      string `json:"overview,omitempty"`
 	AirDate       string `json:"airDate,omitempty"`
 	Runtime       int    `json:"runtime,omitempty"`
+	StillURL      string `json:"stillUrl,omitempty"`
 }
 
 // ReleaseDatesResponse is the response from TMDB /movie/{id}/release_dates endpoint.
@@ -348,3 +355,107 @@ type ImageResult struct {
 	VoteAverage float64 `json:"vote_average"`
 	Iso6391     string  `json:"iso_639_1"`
 }
+
+// TitlesResponse holds the alternative titles and translations appended to a
+// /movie/{id} or /tv/{id} request. Movies list alternatives under "titles",
+// series under "results"; translated titles are "title" for movies and "name"
+// for series.
+type TitlesResponse struct {
+	AlternativeTitles struct {
+		Titles  []AlternativeTitle `json:"titles"`
+		Results []AlternativeTitle `json:"results"`
+	} `json:"alternative_titles"`
+	Translations struct {
+		Translations []Translation `json:"translations"`
+	} `json:"translations"`
+}
+
+// AlternativeTitle is an AKA title used in one country.
+type AlternativeTitle struct {
+	Iso31661 string `json:"iso_3166_1"`
+	Title    string `json:"title"`
+	Type     string `json:"type"`
+}
+
+// Translation is a localized version of a movie's or series' details.
+type Translation struct {
+	Iso31661 string `json:"iso_3166_1"`
+	Iso6391  string `json:"iso_639_1"`
+	Data     struct {
+		Title string `json:"title"`
+		Name  string `json:"name"`
+	} `json:"data"`
+}
+
+// NormalizedAlternateTitle is an alternative or translated title. Locale is a
+// country code for alternatives and a language-country pair for translations.
+type NormalizedAlternateTitle struct {
+	Title  string `json:"title"`
+	Locale string `json:"locale,omitempty"`
+	Kind   string `json:"kind"` // "alternative" or "translation"
+}
+
+// PersonResponse is the response from the /person/{id} endpoint.
+type PersonResponse struct {
+	ID                 int     `json:"id"`
+	Name               string  `json:"name"`
+	Biography          string  `json:"biography"`
+	Birthday           *string `json:"birthday"`
+	Deathday           *string `json:"deathday"`
+	PlaceOfBirth       *string `json:"place_of_birth"`
+	KnownForDepartment string  `json:"known_for_department"`
+	ProfilePath        *string `json:"profile_path"`
+	ImdbID             string  `json:"imdb_id"`
+}
+
+// PersonMovieCreditsResponse is the response from /person/{id}/movie_credits.
+type PersonMovieCreditsResponse struct {
+	ID   int               `json:"id"`
+	Cast []PersonMovieCast `json:"cast"`
+	Crew []PersonMovieCrew `json:"crew"`
+}
+
+// PersonMovieCast is a movie a person acted in.
+type PersonMovieCast struct {
+	ID          int     `json:"id"`
+	Title       string  `json:"title"`
+	ReleaseDate string  `json:"release_date"`
+	Character   string  `json:"character"`
+	PosterPath  *string `json:"poster_path"`
+	Popularity  float64 `json:"popularity"`
+}
+
+// PersonMovieCrew is a movie a person worked on behind the camera.
+type PersonMovieCrew struct {
+	ID          int     `json:"id"`
+	Title       string  `json:"title"`
+	ReleaseDate string  `json:"release_date"`
+	Job         string  `json:"job"`
+	Department  string  `json:"department"`
+	PosterPath  *string `json:"poster_path"`
+	Popularity  float64 `json:"popularity"`
+}
+
+// NormalizedPersonDetails is the normalized person output.
+type NormalizedPersonDetails struct {
+	ID                 int    `json:"id"`
+	Name               string `json:"name"`
+	Biography          string `json:"biography,omitempty"`
+	Birthday           string `json:"birthday,omitempty"`
+	Deathday           string `json:"deathday,omitempty"`
+	PlaceOfBirth       string `json:"placeOfBirth,omitempty"`
+	KnownForDepartment string `json:"knownForDepartment,omitempty"`
+	PhotoURL           string `json:"photoUrl,omitempty"`
+	ImdbID             string `json:"imdbId,omitempty"`
+}
+
+// NormalizedPersonCredit is one movie in a person's filmography. Department
+// is "cast", "director" or "writer"; Role is the character or writing job.
+type NormalizedPersonCredit struct {
+	TmdbID     int    `json:"tmdbId"`
+	Title      string `json:"title"`
+	Year       int    `json:"year,omitempty"`
+	Department string `json:"department"`
+	Role       string `json:"role,omitempty"`
+	PosterURL  string `json:"posterUrl,omitempty"`
+}
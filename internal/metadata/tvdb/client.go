@@ -242,6 +242,7 @@ func (c *Client) GetSeriesEpisodes(ctx context.Context, id int) ([]NormalizedSea
 			Overview:      ep.Overview,
 			AirDate:       ep.Aired,
 			Runtime:       ep.Runtime,
+			StillURL:      ep.Image,
 		}
 		season.Episodes = append(season.Episodes, episode)
 	}
@@ -361,6 +362,7 @@ func (c *Client) seriesDetailToResult(detail *SeriesDetail) NormalizedSeriesResu
 		Genres:      genres,
 		Status:      mapTVDBStatus(detail.Status.Name),
 		Runtime:     detail.AverageRuntime,
+		Aliases:     detail.Aliases,
 	}
 }
 
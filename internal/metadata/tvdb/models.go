@@ -189,6 +189,7 @@ type NormalizedSeriesResult struct {
 	Status      string   `json:"status,omitempty"`
 	Runtime     int      `json:"runtime,omitempty"`
 	Network     string   `json:"network,omitempty"`
+	Aliases     []Alias  `json:"aliases,omitempty"`
 }
 
 // NormalizedSeasonResult is the normalized season result with episodes.
@@ -209,4 +210,5 @@ type NormalizedEpisodeResult struct {
 	Overview      string `json:"overview,omitempty"`
 	AirDate       string `json:"airDate,omitempty"`
 	Runtime       int    `json:"runtime,omitempty"`
+	StillURL      string `json:"stillUrl,omitempty"`
 }
@@ -0,0 +1,251 @@
+// Package tvmaze is a client for the TVMaze API, used as a keyless fallback
+// for series and episode metadata when TVDB is unavailable.
+package tvmaze
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"html"
+	"net/http"
+	"net/url"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/rs/zerolog"
+
+	"github.com/slipstream/slipstream/internal/config"
+)
+
+var (
+	ErrDisabled       = errors.New("TVMaze is disabled")
+	ErrSeriesNotFound = errors.New("series not found")
+	ErrAPIError       = errors.New("TVMaze API error")
+	ErrRateLimited    = errors.New("TVMaze API rate limited")
+)
+
+var htmlTagPattern = regexp.MustCompile(`<[^>]*>`)
+
+// Client is a TVMaze API client. The API needs no key.
+type Client struct {
+	httpClient *http.Client
+	config     config.TVMazeConfig
+	logger     *zerolog.Logger
+}
+
+// NewClient creates a new TVMaze client.
+func NewClient(cfg config.TVMazeConfig, logger *zerolog.Logger) *Client {
+	subLogger := logger.With().Str("component", "tvmaze").Logger()
+	return &Client{
+		httpClient: &http.Client{
+			Timeout: time.Duration(cfg.Timeout) * time.Second,
+		},
+		config: cfg,
+		logger: &subLogger,
+	}
+}
+
+// Name returns the provider name.
+func (c *Client) Name() string {
+	return "tvmaze"
+}
+
+// IsConfigured returns true if TVMaze is enabled.
+func (c *Client) IsConfigured() bool {
+	return c.config.Enabled
+}
+
+// Test verifies connectivity to the TVMaze API.
+func (c *Client) Test(ctx context.Context) error {
+	if !c.IsConfigured() {
+		return ErrDisabled
+	}
+
+	var show Show
+	return c.doRequest(ctx, fmt.Sprintf("%s/shows/1", c.config.BaseURL), nil, &show)
+}
+
+// SearchSeries searches for shows by name.
+func (c *Client) SearchSeries(ctx context.Context, query string) ([]NormalizedSeriesResult, error) {
+	if !c.IsConfigured() {
+		return nil, ErrDisabled
+	}
+
+	params := url.Values{}
+	params.Set("q", query)
+
+	var response []SearchResult
+	if err := c.doRequest(ctx, fmt.Sprintf("%s/search/shows", c.config.BaseURL), params, &response); err != nil {
+		return nil, err
+	}
+
+	results := make([]NormalizedSeriesResult, len(response))
+	for i := range response {
+		results[i] = showToResult(&response[i].Show)
+	}
+	return results, nil
+}
+
+// LookupByTVDB resolves a TVDB ID to a TVMaze show.
+func (c *Client) LookupByTVDB(ctx context.Context, tvdbID int) (*NormalizedSeriesResult, error) {
+	if !c.IsConfigured() {
+		return nil, ErrDisabled
+	}
+
+	params := url.Values{}
+	params.Set("thetvdb", strconv.Itoa(tvdbID))
+
+	// The lookup endpoint redirects to the show, which the HTTP client follows
+	var show Show
+	if err := c.doRequest(ctx, fmt.Sprintf("%s/lookup/shows", c.config.BaseURL), params, &show); err != nil {
+		return nil, err
+	}
+
+	result := showToResult(&show)
+	return &result, nil
+}
+
+// GetSeriesEpisodes fetches a show's numbered episodes grouped by season.
+// Specials are skipped: TVMaze lists them inside regular seasons without an
+// episode number.
+func (c *Client) GetSeriesEpisodes(ctx context.Context, id int) ([]NormalizedSeasonResult, error) {
+	if !c.IsConfigured() {
+		return nil, ErrDisabled
+	}
+
+	var episodes []Episode
+	if err := c.doRequest(ctx, fmt.Sprintf("%s/shows/%d/episodes", c.config.BaseURL, id), nil, &episodes); err != nil {
+		return nil, err
+	}
+
+	seasonMap := make(map[int]*NormalizedSeasonResult)
+	for i := range episodes {
+		ep := &episodes[i]
+		if ep.Number == nil {
+			continue
+		}
+		season, exists := seasonMap[ep.Season]
+		if !exists {
+			season = &NormalizedSeasonResult{
+				SeasonNumber: ep.Season,
+				Name:         fmt.Sprintf("Season %d", ep.Season),
+				AirDate:      ep.Airdate,
+				Episodes:     []NormalizedEpisodeResult{},
+			}
+			seasonMap[ep.Season] = season
+		}
+
+		episode := NormalizedEpisodeResult{
+			EpisodeNumber: *ep.Number,
+			SeasonNumber:  ep.Season,
+			Title:         ep.Name,
+			Overview:      stripHTML(ep.Summary),
+			AirDate:       ep.Airdate,
+			Runtime:       ep.Runtime,
+		}
+		if ep.Image != nil {
+			episode.StillURL = ep.Image.Original
+		}
+		season.Episodes = append(season.Episodes, episode)
+	}
+
+	results := make([]NormalizedSeasonResult, 0, len(seasonMap))
+	for _, season := range seasonMap {
+		results = append(results, *season)
+	}
+	sort.Slice(results, func(i, j int) bool { return results[i].SeasonNumber < results[j].SeasonNumber })
+
+	c.logger.Debug().
+		Int("id", id).
+		Int("seasons", len(results)).
+		Msg("Got series episodes")
+
+	return results, nil
+}
+
+// doRequest performs a GET request and decodes the JSON response.
+func (c *Client) doRequest(ctx context.Context, endpoint string, params url.Values, result any) error {
+	reqURL := endpoint
+	if len(params) > 0 {
+		reqURL = fmt.Sprintf("%s?%s", endpoint, params.Encode())
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, reqURL, http.NoBody)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		c.logger.Error().Err(err).Str("url", endpoint).Msg("HTTP request failed")
+		return fmt.Errorf("HTTP request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		switch resp.StatusCode {
+		case http.StatusNotFound:
+			return ErrSeriesNotFound
+		case http.StatusTooManyRequests:
+			return ErrRateLimited
+		default:
+			return fmt.Errorf("%w: status %d", ErrAPIError, resp.StatusCode)
+		}
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(result); err != nil {
+		return fmt.Errorf("failed to decode response: %w", err)
+	}
+	return nil
+}
+
+func showToResult(show *Show) NormalizedSeriesResult {
+	result := NormalizedSeriesResult{
+		ID:       show.ID,
+		Title:    show.Name,
+		Overview: stripHTML(show.Summary),
+		ImdbID:   show.Externals.IMDb,
+		Genres:   show.Genres,
+		Status:   mapStatus(show.Status),
+		Runtime:  show.Runtime,
+	}
+	if result.Runtime == 0 {
+		result.Runtime = show.AverageRuntime
+	}
+	if len(show.Premiered) >= 4 {
+		result.Year, _ = strconv.Atoi(show.Premiered[:4])
+	}
+	if show.Image != nil {
+		result.PosterURL = show.Image.Original
+	}
+	if show.Externals.TheTVDB != nil {
+		result.TvdbID = *show.Externals.TheTVDB
+	}
+	switch {
+	case show.Network != nil:
+		result.Network = show.Network.Name
+	case show.WebChannel != nil:
+		result.Network = show.WebChannel.Name
+	}
+	return result
+}
+
+func mapStatus(status string) string {
+	switch status {
+	case "Ended":
+		return "ended"
+	case "In Development":
+		return "upcoming"
+	default:
+		return "continuing"
+	}
+}
+
+func stripHTML(s string) string {
+	return strings.TrimSpace(html.UnescapeString(htmlTagPattern.ReplaceAllString(s, "")))
+}
@@ -0,0 +1,99 @@
+package tvmaze
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/rs/zerolog"
+
+	"github.com/slipstream/slipstream/internal/config"
+)
+
+func newTestClient(server *httptest.Server) *Client {
+	logger := zerolog.Nop()
+	return NewClient(config.TVMazeConfig{Enabled: true, BaseURL: server.URL, Timeout: 5}, &logger)
+}
+
+func TestClient_LookupByTVDB(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/lookup/shows":
+			if got := r.URL.Query().Get("thetvdb"); got != "81189" {
+				t.Errorf("thetvdb = %q, want 81189", got)
+			}
+			http.Redirect(w, r, "/shows/169", http.StatusMovedPermanently)
+		case "/shows/169":
+			w.Write([]byte(`{
+				"id": 169, "name": "Breaking Bad", "premiered": "2008-01-20",
+				"summary": "<p>A high school chemistry teacher &amp; his former student.</p>",
+				"status": "Ended", "runtime": 60, "genres": ["Drama", "Crime"],
+				"network": {"name": "AMC"},
+				"externals": {"thetvdb": 81189, "imdb": "tt0903747"}
+			}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	show, err := newTestClient(server).LookupByTVDB(context.Background(), 81189)
+	if err != nil {
+		t.Fatalf("LookupByTVDB() error = %v", err)
+	}
+	if show.ID != 169 || show.TvdbID != 81189 || show.ImdbID != "tt0903747" {
+		t.Errorf("IDs = %d/%d/%s, want 169/81189/tt0903747", show.ID, show.TvdbID, show.ImdbID)
+	}
+	if show.Year != 2008 || show.Status != "ended" || show.Network != "AMC" {
+		t.Errorf("show = %+v", show)
+	}
+	if show.Overview != "A high school chemistry teacher & his former student." {
+		t.Errorf("Overview = %q, want HTML stripped", show.Overview)
+	}
+}
+
+func TestClient_GetSeriesEpisodes(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`[
+			{"id": 1, "name": "Pilot", "season": 1, "number": 1, "airdate": "2008-01-20", "runtime": 58},
+			{"id": 2, "name": "Cat's in the Bag...", "season": 1, "number": 2, "airdate": "2008-01-27"},
+			{"id": 3, "name": "Minisode", "season": 1, "number": null, "airdate": "2009-02-17"},
+			{"id": 4, "name": "Seven Thirty-Seven", "season": 2, "number": 1, "airdate": "2009-03-08",
+			 "image": {"medium": "m.jpg", "original": "o.jpg"}}
+		]`))
+	}))
+	defer server.Close()
+
+	seasons, err := newTestClient(server).GetSeriesEpisodes(context.Background(), 169)
+	if err != nil {
+		t.Fatalf("GetSeriesEpisodes() error = %v", err)
+	}
+	if len(seasons) != 2 {
+		t.Fatalf("got %d seasons, want 2", len(seasons))
+	}
+	if seasons[0].SeasonNumber != 1 || len(seasons[0].Episodes) != 2 {
+		t.Errorf("season 1 = %+v, want 2 numbered episodes", seasons[0])
+	}
+	if ep := seasons[1].Episodes[0]; ep.EpisodeNumber != 1 || ep.StillURL != "o.jpg" {
+		t.Errorf("season 2 episode = %+v", ep)
+	}
+}
+
+func TestClient_Errors(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer server.Close()
+
+	if _, err := newTestClient(server).SearchSeries(context.Background(), "x"); !errors.Is(err, ErrRateLimited) {
+		t.Errorf("SearchSeries() error = %v, want %v", err, ErrRateLimited)
+	}
+
+	logger := zerolog.Nop()
+	disabled := NewClient(config.TVMazeConfig{}, &logger)
+	if _, err := disabled.SearchSeries(context.Background(), "x"); !errors.Is(err, ErrDisabled) {
+		t.Errorf("disabled SearchSeries() error = %v, want %v", err, ErrDisabled)
+	}
+}
@@ -0,0 +1,86 @@
+package tvmaze
+
+// Show is a TVMaze show.
+type Show struct {
+	ID             int       `json:"id"`
+	Name           string    `json:"name"`
+	Premiered      string    `json:"premiered"`
+	Summary        string    `json:"summary"`
+	Status         string    `json:"status"`
+	Runtime        int       `json:"runtime"`
+	AverageRuntime int       `json:"averageRuntime"`
+	Genres         []string  `json:"genres"`
+	Image          *Image    `json:"image"`
+	Network        *Network  `json:"network"`
+	WebChannel     *Network  `json:"webChannel"`
+	Externals      Externals `json:"externals"`
+}
+
+// SearchResult is one hit from /search/shows.
+type SearchResult struct {
+	Score float64 `json:"score"`
+	Show  Show    `json:"show"`
+}
+
+// Image holds the medium and original sizes of a poster or still.
+type Image struct {
+	Medium   string `json:"medium"`
+	Original string `json:"original"`
+}
+
+// Network is a broadcast network or streaming web channel.
+type Network struct {
+	Name string `json:"name"`
+}
+
+// Externals holds a show's IDs on other sites.
+type Externals struct {
+	TheTVDB *int   `json:"thetvdb"`
+	IMDb    string `json:"imdb"`
+}
+
+// Episode is a TVMaze episode. Specials have no number.
+type Episode struct {
+	ID      int    `json:"id"`
+	Name    string `json:"name"`
+	Season  int    `json:"season"`
+	Number  *int   `json:"number"`
+	Airdate string `json:"airdate"`
+	Runtime int    `json:"runtime"`
+	Summary string `json:"summary"`
+	Image   *Image `json:"image"`
+}
+
+// NormalizedSeriesResult is the normalized series result returned by the client.
+type NormalizedSeriesResult struct {
+	ID        int      `json:"id"`
+	Title     string   `json:"title"`
+	Year      int      `json:"year"`
+	Overview  string   `json:"overview"`
+	PosterURL string   `json:"posterUrl,omitempty"`
+	ImdbID    string   `json:"imdbId,omitempty"`
+	TvdbID    int      `json:"tvdbId,omitempty"`
+	Genres    []string `json:"genres,omitempty"`
+	Status    string   `json:"status,omitempty"`
+	Runtime   int      `json:"runtime,omitempty"`
+	Network   string   `json:"network,omitempty"`
+}
+
+// NormalizedSeasonResult is the normalized season result with episodes.
+type NormalizedSeasonResult struct {
+	SeasonNumber int                       `json:"seasonNumber"`
+	Name         string                    `json:"name"`
+	AirDate      string                    `json:"airDate,omitempty"`
+	Episodes     []NormalizedEpisodeResult `json:"episodes"`
+}
+
+// NormalizedEpisodeResult is the normalized episode result.
+type NormalizedEpisodeResult struct {
+	EpisodeNumber int    `json:"episodeNumber"`
+	SeasonNumber  int    `json:"seasonNumber"`
+	Title         string `json:"title"`
+	Overview      string `json:"overview,omitempty"`
+	AirDate       string `json:"airDate,omitempty"`
+	Runtime       int    `json:"runtime,omitempty"`
+	StillURL      string `json:"stillUrl,omitempty"`
+}
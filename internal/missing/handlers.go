@@ -4,6 +4,8 @@ import (
 	"net/http"
 
 	"github.com/labstack/echo/v4"
+
+	"github.com/slipstream/slipstream/internal/pagination"
 )
 
 // Handlers provides HTTP handlers for missing media operations.
@@ -27,6 +29,12 @@ func (h *Handlers) RegisterRoutes(g *echo.Group) {
 	g.GET("/upgradable/counts", h.GetUpgradableCounts)
 }
 
+// RegisterWantedRoutes registers the paginated wanted list routes.
+func (h *Handlers) RegisterWantedRoutes(g *echo.Group) {
+	g.GET("/missing", h.GetWantedMissing)
+	g.GET("/cutoff", h.GetWantedCutoff)
+}
+
 // GetMovies returns all missing movies.
 // GET /api/v1/missing/movies
 func (h *Handlers) GetMovies(c echo.Context) error {
@@ -86,3 +94,55 @@ func (h *Handlers) GetUpgradableCounts(c echo.Context) error {
 	}
 	return c.JSON(http.StatusOK, counts)
 }
+
+// GetWantedMissing returns a page of released, monitored movies and episodes
+// without files, newest first unless order=asc.
+// GET /api/v1/wanted/missing?mediaType=episode&sort=date&order=desc&page=1&pageSize=50
+func (h *Handlers) GetWantedMissing(c echo.Context) error {
+	opts, page, err := parseWantedQuery(c)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+	result, err := h.service.GetWantedMissing(c.Request().Context(), opts, page)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	return c.JSON(http.StatusOK, result)
+}
+
+// GetWantedCutoff returns a page of monitored movies and episodes with files
+// below the quality cutoff, newest first unless order=asc.
+// GET /api/v1/wanted/cutoff?mediaType=movie&sort=title&page=1&pageSize=50
+func (h *Handlers) GetWantedCutoff(c echo.Context) error {
+	opts, page, err := parseWantedQuery(c)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+	result, err := h.service.GetWantedCutoff(c.Request().Context(), opts, page)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	return c.JSON(http.StatusOK, result)
+}
+
+func parseWantedQuery(c echo.Context) (WantedOptions, pagination.Params, error) {
+	page, _, err := pagination.FromQuery(c.QueryParams())
+	if err != nil {
+		return WantedOptions{}, page, err
+	}
+
+	mediaType := c.QueryParam("mediaType")
+	if mediaType != "" && mediaType != wantedMovie && mediaType != wantedEpisode {
+		return WantedOptions{}, page, ErrInvalidWantedMediaType
+	}
+
+	sortKey, order := c.QueryParam("sort"), c.QueryParam("order")
+	if order == "" && (sortKey == "" || sortKey == "date") {
+		order = "desc"
+	}
+	sort, err := pagination.ParseSort(sortKey, order, WantedSortKeys...)
+	if err != nil {
+		return WantedOptions{}, page, err
+	}
+	return WantedOptions{MediaType: mediaType, Sort: sort.Key, Descending: sort.Descending}, page, nil
+}
@@ -16,6 +16,8 @@ type Service struct {
 	db      *sql.DB
 	queries *sqlc.Queries
 	logger  *zerolog.Logger
+
+	defaultAvailability func() string
 }
 
 // NewService creates a new missing service.
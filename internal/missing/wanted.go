@@ -0,0 +1,246 @@
+package missing
+
+import (
+	"cmp"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/slipstream/slipstream/internal/library/status"
+	"github.com/slipstream/slipstream/internal/pagination"
+)
+
+// WantedSortKeys lists the keys the wanted lists can be sorted by. The first is the default.
+var WantedSortKeys = []string{"date", "title"}
+
+var ErrInvalidWantedMediaType = errors.New("mediaType must be 'movie' or 'episode'")
+
+const (
+	wantedMovie   = "movie"
+	wantedEpisode = "episode"
+)
+
+// WantedItem is a monitored movie or episode on the wanted list: released
+// without a file, or with a file below its profile's cutoff.
+type WantedItem struct {
+	MediaType        string     `json:"mediaType"` // "movie" or "episode"
+	ID               int64      `json:"id"`
+	Title            string     `json:"title"`
+	Year             int        `json:"year,omitempty"`
+	Date             *time.Time `json:"date,omitempty"` // Release date for movies, air date for episodes
+	QualityProfileID int64      `json:"qualityProfileId"`
+	CurrentQualityID int        `json:"currentQualityId,omitempty"` // Cutoff list only
+	SeriesID         int64      `json:"seriesId,omitempty"`
+	SeriesTitle      string     `json:"seriesTitle,omitempty"`
+	SeasonNumber     *int       `json:"seasonNumber,omitempty"`
+	EpisodeNumber    *int       `json:"episodeNumber,omitempty"`
+}
+
+// WantedOptions filters and orders a wanted list.
+type WantedOptions struct {
+	MediaType  string // "", "movie" or "episode"
+	Sort       string // One of WantedSortKeys
+	Descending bool
+}
+
+// SetDefaultMinimumAvailability sets the source of the global minimum
+// availability applied to movies that do not override it.
+func (s *Service) SetDefaultMinimumAvailability(fn func() string) {
+	s.defaultAvailability = fn
+}
+
+func (s *Service) minimumAvailability(override sql.NullString) string {
+	if override.Valid && override.String != "" {
+		return override.String
+	}
+	if s.defaultAvailability != nil {
+		if level := s.defaultAvailability(); level != "" {
+			return level
+		}
+	}
+	return status.DefaultAvailability
+}
+
+// GetWantedMissing returns one page of monitored movies and episodes without
+// files, leaving out episodes that have not aired and movies that have not
+// reached their minimum availability.
+func (s *Service) GetWantedMissing(ctx context.Context, opts WantedOptions, page pagination.Params) (*pagination.Page[*WantedItem], error) {
+	now := time.Now()
+	items := []*WantedItem{}
+
+	if opts.MediaType != wantedEpisode {
+		rows, err := s.queries.ListMissingMovies(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list missing movies: %w", err)
+		}
+		for _, row := range rows {
+			if !status.MovieAvailable(s.minimumAvailability(row.MinimumAvailability), row.TheatricalReleaseDate, row.ReleaseDate, row.PhysicalReleaseDate, now) {
+				continue
+			}
+			items = append(items, &WantedItem{
+				MediaType:        wantedMovie,
+				ID:               row.ID,
+				Title:            row.Title,
+				Year:             int(row.Year.Int64),
+				Date:             movieDate(row.TheatricalReleaseDate, row.ReleaseDate, row.PhysicalReleaseDate),
+				QualityProfileID: row.QualityProfileID.Int64,
+			})
+		}
+	}
+
+	if opts.MediaType != wantedMovie {
+		rows, err := s.queries.ListMissingEpisodes(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list missing episodes: %w", err)
+		}
+		for _, row := range rows {
+			if !aired(row.AirDate, now) {
+				continue
+			}
+			items = append(items, wantedEpisodeItem(row.ID, row.SeriesID, row.SeasonNumber, row.EpisodeNumber,
+				row.Title, row.AirDate, row.SeriesTitle, row.SeriesYear, row.SeriesQualityProfileID))
+		}
+	}
+
+	return pageWanted(items, opts, page), nil
+}
+
+// GetWantedCutoff returns one page of monitored movies and episodes whose
+// files are below their quality profile's cutoff.
+func (s *Service) GetWantedCutoff(ctx context.Context, opts WantedOptions, page pagination.Params) (*pagination.Page[*WantedItem], error) {
+	now := time.Now()
+	items := []*WantedItem{}
+
+	if opts.MediaType != wantedEpisode {
+		rows, err := s.queries.ListUpgradableMoviesWithQuality(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list upgradable movies: %w", err)
+		}
+		for _, row := range rows {
+			if !status.MovieAvailable(s.minimumAvailability(row.MinimumAvailability), row.TheatricalReleaseDate, row.ReleaseDate, row.PhysicalReleaseDate, now) {
+				continue
+			}
+			items = append(items, &WantedItem{
+				MediaType:        wantedMovie,
+				ID:               row.ID,
+				Title:            row.Title,
+				Year:             int(row.Year.Int64),
+				Date:             movieDate(row.TheatricalReleaseDate, row.ReleaseDate, row.PhysicalReleaseDate),
+				QualityProfileID: row.QualityProfileID.Int64,
+				CurrentQualityID: int(row.CurrentQualityID.Int64),
+			})
+		}
+	}
+
+	if opts.MediaType != wantedMovie {
+		rows, err := s.queries.ListUpgradableEpisodesWithQuality(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list upgradable episodes: %w", err)
+		}
+		for _, row := range rows {
+			if !aired(row.AirDate, now) {
+				continue
+			}
+			item := wantedEpisodeItem(row.ID, row.SeriesID, row.SeasonNumber, row.EpisodeNumber,
+				row.Title, row.AirDate, row.SeriesTitle, row.SeriesYear, row.SeriesQualityProfileID)
+			item.CurrentQualityID = int(row.CurrentQualityID.Int64)
+			items = append(items, item)
+		}
+	}
+
+	return pageWanted(items, opts, page), nil
+}
+
+func wantedEpisodeItem(id, seriesID, season, episode int64, title sql.NullString, airDate sql.NullTime,
+	seriesTitle string, seriesYear, profileID sql.NullInt64,
+) *WantedItem {
+	seasonNumber, episodeNumber := int(season), int(episode)
+	item := &WantedItem{
+		MediaType:        wantedEpisode,
+		ID:               id,
+		Title:            title.String,
+		Year:             int(seriesYear.Int64),
+		QualityProfileID: profileID.Int64,
+		SeriesID:         seriesID,
+		SeriesTitle:      seriesTitle,
+		SeasonNumber:     &seasonNumber,
+		EpisodeNumber:    &episodeNumber,
+	}
+	if airDate.Valid {
+		item.Date = &airDate.Time
+	}
+	return item
+}
+
+// aired reports whether an episode has an air date that has passed. Episodes
+// without one are treated as unaired, matching the status refresh.
+func aired(airDate sql.NullTime, now time.Time) bool {
+	return airDate.Valid && !airDate.Time.After(now)
+}
+
+// movieDate picks the date a movie was released for sorting: the earlier of
+// its digital and physical releases, or its theatrical release without either.
+func movieDate(theatrical, digital, physical sql.NullTime) *time.Time {
+	var date *time.Time
+	for _, t := range []sql.NullTime{digital, physical} {
+		if t.Valid && (date == nil || t.Time.Before(*date)) {
+			date = &t.Time
+		}
+	}
+	if date == nil && theatrical.Valid {
+		date = &theatrical.Time
+	}
+	return date
+}
+
+// pageWanted sorts items as opts asks and slices out the requested page.
+// Items without a date sort last in either direction.
+func pageWanted(items []*WantedItem, opts WantedOptions, page pagination.Params) *pagination.Page[*WantedItem] {
+	slices.SortStableFunc(items, func(a, b *WantedItem) int {
+		if opts.Sort == "date" {
+			switch {
+			case a.Date == nil && b.Date != nil:
+				return 1
+			case a.Date != nil && b.Date == nil:
+				return -1
+			case a.Date != nil && b.Date != nil:
+				if c := a.Date.Compare(*b.Date); c != 0 {
+					if opts.Descending {
+						return -c
+					}
+					return c
+				}
+			}
+			return compareTitles(a, b, false)
+		}
+		return compareTitles(a, b, opts.Descending)
+	})
+
+	total := int64(len(items))
+	start := min(page.Offset(), total)
+	end := min(start+page.Limit(), total)
+	return pagination.NewPage(items[start:end], page, total)
+}
+
+// compareTitles orders movies by title and episodes by series, season and number.
+func compareTitles(a, b *WantedItem, descending bool) int {
+	c := cmp.Compare(strings.ToLower(sortTitle(a)), strings.ToLower(sortTitle(b)))
+	if c == 0 && a.SeasonNumber != nil && b.SeasonNumber != nil {
+		c = cmp.Or(cmp.Compare(*a.SeasonNumber, *b.SeasonNumber), cmp.Compare(*a.EpisodeNumber, *b.EpisodeNumber))
+	}
+	if descending {
+		return -c
+	}
+	return c
+}
+
+func sortTitle(item *WantedItem) string {
+	if item.MediaType == wantedEpisode {
+		return item.SeriesTitle
+	}
+	return item.Title
+}
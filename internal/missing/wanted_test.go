@@ -0,0 +1,115 @@
+package missing
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/slipstream/slipstream/internal/pagination"
+	"github.com/slipstream/slipstream/internal/testutil"
+)
+
+func TestGetWantedMissing(t *testing.T) {
+	tdb := testutil.NewTestDB(t)
+	defer tdb.Close()
+	ctx := context.Background()
+
+	exec := func(query string, args ...any) {
+		t.Helper()
+		if _, err := tdb.Conn.ExecContext(ctx, query, args...); err != nil {
+			t.Fatalf("exec %q: %v", query, err)
+		}
+	}
+
+	past := time.Now().AddDate(0, -1, 0)
+	older := time.Now().AddDate(-1, 0, 0)
+	future := time.Now().AddDate(0, 1, 0)
+
+	// A released movie, and one still marked missing although its release moved out.
+	exec(`INSERT INTO movies (id, title, sort_title, monitored, status, release_date) VALUES (1, 'Released', 'released', 1, 'missing', ?)`, older)
+	exec(`INSERT INTO movies (id, title, sort_title, monitored, status, release_date) VALUES (2, 'Delayed', 'delayed', 1, 'missing', ?)`, future)
+
+	exec(`INSERT INTO series (id, title, sort_title, monitored) VALUES (1, 'Show', 'show', 1)`)
+	exec(`INSERT INTO seasons (series_id, season_number, monitored) VALUES (1, 1, 1)`)
+	exec(`INSERT INTO episodes (series_id, season_number, episode_number, title, monitored, status, air_date) VALUES (1, 1, 1, 'Pilot', 1, 'missing', ?)`, past)
+	exec(`INSERT INTO episodes (series_id, season_number, episode_number, title, monitored, status, air_date) VALUES (1, 1, 2, 'Next', 1, 'missing', ?)`, future)
+	exec(`INSERT INTO episodes (series_id, season_number, episode_number, title, monitored, status) VALUES (1, 1, 3, 'TBA', 1, 'missing')`)
+
+	svc := NewService(tdb.Conn, &tdb.Logger)
+	page := pagination.Params{Page: 1, PageSize: 10}
+
+	result, err := svc.GetWantedMissing(ctx, WantedOptions{Sort: "date", Descending: true}, page)
+	if err != nil {
+		t.Fatalf("GetWantedMissing() error = %v", err)
+	}
+	if result.TotalCount != 2 {
+		t.Fatalf("TotalCount = %d, want 2", result.TotalCount)
+	}
+	if got := result.Items[0]; got.MediaType != "episode" || got.Title != "Pilot" {
+		t.Errorf("Items[0] = %+v, want the aired episode first", got)
+	}
+	if got := result.Items[1]; got.MediaType != "movie" || got.Title != "Released" {
+		t.Errorf("Items[1] = %+v, want the released movie second", got)
+	}
+
+	movies, err := svc.GetWantedMissing(ctx, WantedOptions{MediaType: "movie", Sort: "date"}, page)
+	if err != nil {
+		t.Fatalf("GetWantedMissing(movie) error = %v", err)
+	}
+	if movies.TotalCount != 1 || movies.Items[0].ID != 1 {
+		t.Errorf("movie filter = %+v, want only the released movie", movies.Items)
+	}
+}
+
+func TestPageWanted(t *testing.T) {
+	day := func(d int) *time.Time {
+		t := time.Date(2024, 1, d, 0, 0, 0, 0, time.UTC)
+		return &t
+	}
+	season, e1, e2 := 1, 1, 2
+	newItems := func() []*WantedItem {
+		return []*WantedItem{
+			{MediaType: wantedMovie, ID: 1, Title: "Beta", Date: day(3)},
+			{MediaType: wantedMovie, ID: 2, Title: "Alpha"},
+			{MediaType: wantedEpisode, ID: 3, SeriesTitle: "Alpha", SeasonNumber: &season, EpisodeNumber: &e2, Date: day(1)},
+			{MediaType: wantedEpisode, ID: 4, SeriesTitle: "Alpha", SeasonNumber: &season, EpisodeNumber: &e1, Date: day(2)},
+		}
+	}
+	ids := func(p *pagination.Page[*WantedItem]) []int64 {
+		out := make([]int64, len(p.Items))
+		for i, item := range p.Items {
+			out[i] = item.ID
+		}
+		return out
+	}
+
+	tests := []struct {
+		name string
+		opts WantedOptions
+		page pagination.Params
+		want []int64
+	}{
+		{"date descending, undated last", WantedOptions{Sort: "date", Descending: true}, pagination.Params{Page: 1, PageSize: 10}, []int64{1, 4, 3, 2}},
+		{"date ascending, undated last", WantedOptions{Sort: "date"}, pagination.Params{Page: 1, PageSize: 10}, []int64{3, 4, 1, 2}},
+		{"title orders episodes by number", WantedOptions{Sort: "title"}, pagination.Params{Page: 1, PageSize: 10}, []int64{2, 4, 3, 1}},
+		{"second page", WantedOptions{Sort: "title"}, pagination.Params{Page: 2, PageSize: 3}, []int64{1}},
+		{"page past the end", WantedOptions{Sort: "title"}, pagination.Params{Page: 3, PageSize: 3}, []int64{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := pageWanted(newItems(), tt.opts, tt.page)
+			got := ids(result)
+			if len(got) != len(tt.want) {
+				t.Fatalf("ids = %v, want %v", got, tt.want)
+			}
+			for i := range got {
+				if got[i] != tt.want[i] {
+					t.Fatalf("ids = %v, want %v", got, tt.want)
+				}
+			}
+			if result.TotalCount != 4 {
+				t.Errorf("TotalCount = %d, want 4", result.TotalCount)
+			}
+		})
+	}
+}
@@ -333,6 +333,7 @@ func parseResultToReleaseForFilter(result *ParseResult, isTV bool, size int64, c
 		IsTV:       isTV,
 		Quality:    result.Quality,
 		Source:     result.Source,
+		Edition:    result.Edition,
 		Languages:  result.Languages,
 		Size:       size,
 		Categories: categories,
@@ -346,6 +347,10 @@ func parseResultToReleaseForFilter(result *ParseResult, isTV bool, size int64, c
 			rff.IsSeasonPack = accessor.TVIsSeasonPack()
 			rff.IsCompleteSeries = accessor.TVIsCompleteSeries()
 		}
+		if accessor, ok := result.Extra.(TVAbsoluteExtraAccessor); ok {
+			rff.AbsoluteEpisode = accessor.TVAbsoluteEpisode()
+			rff.EndAbsolute = accessor.TVEndAbsoluteEpisode()
+		}
 	}
 	return rff
 }
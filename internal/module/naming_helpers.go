@@ -24,5 +24,16 @@ func MetadataVariables() []TemplateVariable {
 	return []TemplateVariable{
 		{Name: "Release Group", Description: "Release group name", Example: "SPARKS", DataKey: "ReleaseGroup"},
 		{Name: "Edition Tags", Description: "Edition info", Example: "Director's Cut", DataKey: "EditionTags"},
+		{Name: "Edition Plex", Description: "Edition as a Plex/Jellyfin tag", Example: "{edition-Director's Cut}", DataKey: "EditionTags"},
+	}
+}
+
+// SlotVariables returns the template variables for the version slot a file
+// is imported into. They resolve empty when multi-version is disabled.
+func SlotVariables() []TemplateVariable {
+	return []TemplateVariable{
+		{Name: "Slot Name", Description: "Version slot name", Example: "4K", DataKey: "SlotName"},
+		{Name: "Slot Number", Description: "Version slot number", Example: "1", DataKey: "SlotNumber"},
+		{Name: "Slot Tag", Description: "Slot name as a Plex/Jellyfin version tag", Example: "[4K]", DataKey: "SlotName"},
 	}
 }
@@ -2,6 +2,8 @@ package parseutil
 
 import (
 	"regexp"
+	"slices"
+	"sort"
 	"strings"
 )
 
@@ -22,7 +24,8 @@ var (
 		"REAL":   regexp.MustCompile(`(?i)(^|[.\s\-_])real([.\s\-_]|$)`),
 		"RERIP":  regexp.MustCompile(`(?i)(^|[.\s\-_])rerip([.\s\-_]|$)`),
 	}
-	revisionOrder = []string{"Proper", "REPACK", "REAL", "RERIP"}
+	// REAL is checked first: "REAL.PROPER" fixes an earlier PROPER.
+	revisionOrder = []string{"REAL", "Proper", "REPACK", "RERIP"}
 
 	// Edition patterns
 	editionPatterns = map[string]*regexp.Regexp{
@@ -49,11 +52,17 @@ var (
 		"Special Edition", "Collector's Edition", "Anniversary Edition",
 		"Criterion", "IMAX", "3D", "Remastered", "Restored",
 	}
+	// editionCuts are the editions that are a different cut of the film, as
+	// opposed to tags like Remastered or Criterion that describe the release.
+	editionCuts = []string{
+		"Theatrical", "Director's Cut", "Extended", "Unrated", "Uncut",
+		"Ultimate Cut", "Final Cut", "Special Edition", "IMAX",
+	}
 
 	// Language patterns - detect non-English releases
 	languagePatterns = map[string]*regexp.Regexp{
 		"German":     regexp.MustCompile(`(?i)(^|[.\s\-_])(german|deutsch|ger|deu)([.\s\-_]|$)`),
-		"French":     regexp.MustCompile(`(?i)(^|[.\s\-_])(french|français|fra|fre)([.\s\-_]|$)`),
+		"French":     regexp.MustCompile(`(?i)(^|[.\s\-_])(french|truefrench|français|fra|fre|vff|vfq|vfi|vf2)([.\s\-_]|$)`),
 		"Spanish":    regexp.MustCompile(`(?i)(^|[.\s\-_])(spanish|español|spa|esp)([.\s\-_]|$)`),
 		"Italian":    regexp.MustCompile(`(?i)(^|[.\s\-_])(italian|italiano|ita)([.\s\-_]|$)`),
 		"Portuguese": regexp.MustCompile(`(?i)(^|[.\s\-_])(portuguese|português|por|pt-br)([.\s\-_]|$)`),
@@ -82,6 +91,24 @@ var (
 	}
 )
 
+// Pseudo-languages reported for releases carrying several audio tracks.
+const (
+	LanguageMulti     = "Multi"
+	LanguageDualAudio = "Dual Audio"
+)
+
+var (
+	multiLanguagePattern = regexp.MustCompile(`(?i)(^|[.\s\-_])multi([.\s\-_]?audio)?([.\s\-_]|$)`)
+	dualAudioPattern     = regexp.MustCompile(`(?i)(^|[.\s\-_])dual([.\s\-_]?audio)?([.\s\-_]|$)`)
+
+	// Subtitle-only tags: the audio stays in the original language.
+	subtitleLanguagePatterns = map[string]*regexp.Regexp{
+		"French":  regexp.MustCompile(`(?i)(^|[.\s\-_])(vostfr|subfrench|stfr)([.\s\-_]|$)`),
+		"Dutch":   regexp.MustCompile(`(?i)(^|[.\s\-_])(nlsubs?|nlsubbed)([.\s\-_]|$)`),
+		"Swedish": regexp.MustCompile(`(?i)(^|[.\s\-_])(swesubs?)([.\s\-_]|$)`),
+	}
+)
+
 // ParseReleaseGroup extracts the release group from a filename (e.g., "-SPARKS" -> "SPARKS").
 func ParseReleaseGroup(filename string) string {
 	match := releaseGroupPattern.FindStringSubmatch(filename)
@@ -104,11 +131,29 @@ func ParseRevision(filename string) string {
 	return ""
 }
 
+// RevisionRank orders revisions so a release can be compared with the file it
+// would replace: none < PROPER/REPACK/RERIP < REAL. REAL marks a fix of an
+// earlier PROPER, so it outranks one.
+func RevisionRank(revision string) int {
+	switch revision {
+	case "":
+		return 0
+	case "REAL":
+		return 2
+	default:
+		return 1
+	}
+}
+
 // ParseEdition detects edition tags (Director's Cut, Extended, etc.) in a filename.
 func ParseEdition(filename string) string {
 	var editions []string
 	for _, ed := range editionOrder {
 		if pattern, ok := editionPatterns[ed]; ok && pattern.MatchString(filename) {
+			// "Extended.Cut" also matches Extended; keep only the longer tag
+			if slices.ContainsFunc(editions, func(e string) bool { return strings.HasPrefix(e, ed) }) {
+				continue
+			}
 			editions = append(editions, ed)
 		}
 	}
@@ -118,7 +163,52 @@ func ParseEdition(filename string) string {
 	return ""
 }
 
-// ParseLanguages detects non-English language tags in a filename.
+// EditionCuts returns the editions a movie can prefer.
+func EditionCuts() []string {
+	return slices.Clone(editionCuts)
+}
+
+// IsEditionCut reports whether edition is one of EditionCuts.
+func IsEditionCut(edition string) bool {
+	return slices.Contains(editionCuts, edition)
+}
+
+// EditionCut returns the cut named in an edition string from ParseEdition, or
+// "" when it names none. "Extended Cut" is reported as Extended.
+func EditionCut(edition string) string {
+	if edition == "" {
+		return ""
+	}
+	for _, ed := range editionOrder {
+		if !strings.Contains(edition, ed) {
+			continue
+		}
+		if ed == "Extended Cut" {
+			return "Extended"
+		}
+		if slices.Contains(editionCuts, ed) {
+			return ed
+		}
+	}
+	return ""
+}
+
+// EditionMatches reports whether a release or file edition satisfies an
+// edition preference. An empty preference accepts anything, and a Theatrical
+// preference also accepts releases that name no cut.
+func EditionMatches(preference, edition string) bool {
+	if preference == "" {
+		return true
+	}
+	cut := EditionCut(edition)
+	if preference == "Theatrical" {
+		return cut == "" || cut == "Theatrical"
+	}
+	return cut == preference
+}
+
+// ParseLanguages detects non-English audio language tags in a filename.
+// MULTI and DUAL releases additionally report LanguageMulti or LanguageDualAudio.
 func ParseLanguages(filename string) []string {
 	var languages []string
 	for lang, pattern := range languagePatterns {
@@ -126,5 +216,39 @@ func ParseLanguages(filename string) []string {
 			languages = append(languages, lang)
 		}
 	}
+	sort.Strings(languages)
+	if multiLanguagePattern.MatchString(filename) {
+		languages = append(languages, LanguageMulti)
+	}
+	if dualAudioPattern.MatchString(filename) {
+		languages = append(languages, LanguageDualAudio)
+	}
+	return languages
+}
+
+// KnownLanguages returns every audio language ParseLanguages can detect, plus English.
+func KnownLanguages() []string {
+	languages := []string{"English"}
+	for lang := range languagePatterns {
+		languages = append(languages, lang)
+	}
+	sort.Strings(languages)
+	return languages
+}
+
+// ParseSubtitleLanguages detects subtitle-only language tags such as VOSTFR.
+func ParseSubtitleLanguages(filename string) []string {
+	var languages []string
+	for lang, pattern := range subtitleLanguagePatterns {
+		if pattern.MatchString(filename) {
+			languages = append(languages, lang)
+		}
+	}
+	sort.Strings(languages)
 	return languages
 }
+
+// IsMultiAudio reports whether parsed languages mark a release as MULTI or DUAL audio.
+func IsMultiAudio(languages []string) bool {
+	return slices.Contains(languages, LanguageMulti) || slices.Contains(languages, LanguageDualAudio)
+}
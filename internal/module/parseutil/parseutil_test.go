@@ -380,6 +380,7 @@ func TestParseRevision(t *testing.T) {
 	}{
 		{"Movie.2020.PROPER.1080p", "Proper"},
 		{"Movie.2020.REPACK.1080p", "REPACK"},
+		{"Movie.2020.REAL.PROPER.1080p", "REAL"},
 		{"Movie.2020.1080p", ""},
 	}
 
@@ -393,6 +394,18 @@ func TestParseRevision(t *testing.T) {
 	}
 }
 
+func TestRevisionRank(t *testing.T) {
+	if RevisionRank("") >= RevisionRank("Proper") {
+		t.Error("a PROPER should outrank no revision")
+	}
+	if RevisionRank("Proper") != RevisionRank("REPACK") {
+		t.Error("PROPER and REPACK should rank equally")
+	}
+	if RevisionRank("REAL") <= RevisionRank("Proper") {
+		t.Error("REAL should outrank a PROPER")
+	}
+}
+
 func TestParseEdition(t *testing.T) {
 	tests := []struct {
 		input string
@@ -401,6 +414,7 @@ func TestParseEdition(t *testing.T) {
 		{"Movie.Directors.Cut.1080p", "Director's Cut"},
 		{"Movie.Extended.1080p", "Extended"},
 		{"Movie.IMAX.1080p", "IMAX"},
+		{"Movie.Extended.Cut.1080p", "Extended Cut"},
 		{"Movie.1080p", ""},
 	}
 
@@ -414,6 +428,48 @@ func TestParseEdition(t *testing.T) {
 	}
 }
 
+func TestEditionCut(t *testing.T) {
+	tests := []struct {
+		edition string
+		want    string
+	}{
+		{"", ""},
+		{"Extended Cut", "Extended"},
+		{"Director's Cut IMAX", "Director's Cut"},
+		{"Remastered", ""},
+		{"IMAX Remastered", "IMAX"},
+	}
+
+	for _, tt := range tests {
+		if got := EditionCut(tt.edition); got != tt.want {
+			t.Errorf("EditionCut(%q) = %q, want %q", tt.edition, got, tt.want)
+		}
+	}
+}
+
+func TestEditionMatches(t *testing.T) {
+	tests := []struct {
+		preference string
+		edition    string
+		want       bool
+	}{
+		{"", "Extended", true},
+		{"Theatrical", "", true},
+		{"Theatrical", "Remastered", true},
+		{"Theatrical", "Extended Cut", false},
+		{"Extended", "Extended Cut", true},
+		{"Extended", "", false},
+		{"Director's Cut", "Director's Cut Remastered", true},
+		{"IMAX", "Director's Cut", false},
+	}
+
+	for _, tt := range tests {
+		if got := EditionMatches(tt.preference, tt.edition); got != tt.want {
+			t.Errorf("EditionMatches(%q, %q) = %v, want %v", tt.preference, tt.edition, got, tt.want)
+		}
+	}
+}
+
 func TestParseLanguages(t *testing.T) {
 	tests := []struct {
 		name  string
@@ -423,6 +479,12 @@ func TestParseLanguages(t *testing.T) {
 		{"German release", "Movie.2024.German.1080p.BluRay.mkv", []string{"German"}},
 		{"French release", "Movie.2024.FRENCH.1080p.BluRay.mkv", []string{"French"}},
 		{"no language", "Movie.2024.1080p.BluRay.mkv", nil},
+		{"truefrench release", "Movie.2024.TRUEFRENCH.1080p.BluRay.mkv", []string{"French"}},
+		{"multi release", "Movie.2024.MULTi.1080p.BluRay.mkv", []string{LanguageMulti}},
+		{"multi with language", "Movie.2024.MULTI.VFF.1080p.BluRay.mkv", []string{"French", LanguageMulti}},
+		{"dual audio release", "Movie.2024.1080p.BluRay.Dual-Audio.mkv", []string{LanguageDualAudio}},
+		{"several languages sorted", "Movie.2024.Italian.German.1080p.mkv", []string{"German", "Italian"}},
+		{"subtitles are not audio", "Movie.2024.VOSTFR.1080p.WEB.mkv", nil},
 	}
 
 	for _, tt := range tests {
@@ -433,6 +495,27 @@ func TestParseLanguages(t *testing.T) {
 	}
 }
 
+func TestParseSubtitleLanguages(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  []string
+	}{
+		{"vostfr", "Movie.2024.VOSTFR.1080p.WEB.mkv", []string{"French"}},
+		{"subfrench", "Movie.2024.SUBFRENCH.1080p.WEB.mkv", []string{"French"}},
+		{"nlsubs", "Show.S01E01.NLSubs.720p.HDTV.mkv", []string{"Dutch"}},
+		{"swesub", "Movie.2024.SWESUB.1080p.BluRay.mkv", []string{"Swedish"}},
+		{"no subtitles", "Movie.2024.FRENCH.1080p.BluRay.mkv", nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ParseSubtitleLanguages(tt.input)
+			assertSliceEqual(t, "subtitle languages", got, tt.want)
+		})
+	}
+}
+
 func assertSliceEqual(t *testing.T, label string, got, want []string) {
 	t.Helper()
 	if len(got) != len(want) {
@@ -22,20 +22,21 @@ func (a *scannerModuleParser) TryMatch(filename string) (confidence float64, res
 	isTV := a.mod.ID() == TypeTV
 
 	return conf, &scanner.ParseResultData{
-		Title:         parseResult.Title,
-		Year:          parseResult.Year,
-		Quality:       parseResult.Quality,
-		Source:        parseResult.Source,
-		Codec:         parseResult.Codec,
-		HDRFormats:    parseResult.HDRFormats,
-		AudioCodecs:   parseResult.AudioCodecs,
-		AudioChannels: parseResult.AudioChannels,
-		ReleaseGroup:  parseResult.ReleaseGroup,
-		Revision:      parseResult.Revision,
-		Edition:       parseResult.Edition,
-		Languages:     parseResult.Languages,
-		IsTV:          isTV,
-		Extra:         parseResult.Extra,
+		Title:             parseResult.Title,
+		Year:              parseResult.Year,
+		Quality:           parseResult.Quality,
+		Source:            parseResult.Source,
+		Codec:             parseResult.Codec,
+		HDRFormats:        parseResult.HDRFormats,
+		AudioCodecs:       parseResult.AudioCodecs,
+		AudioChannels:     parseResult.AudioChannels,
+		ReleaseGroup:      parseResult.ReleaseGroup,
+		Revision:          parseResult.Revision,
+		Edition:           parseResult.Edition,
+		Languages:         parseResult.Languages,
+		SubtitleLanguages: parseResult.SubtitleLanguages,
+		IsTV:              isTV,
+		Extra:             parseResult.Extra,
 	}
 }
 
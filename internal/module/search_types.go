@@ -57,11 +57,14 @@ type ReleaseForFilter struct {
 	EndSeason        int
 	Episode          int
 	EndEpisode       int
+	AbsoluteEpisode  int // Anime absolute number; set only when Season is 0
+	EndAbsolute      int
 	IsTV             bool
 	IsSeasonPack     bool
 	IsCompleteSeries bool
 	Quality          string
 	Source           string
+	Edition          string
 	Languages        []string
 	Size             int64
 	Categories       []int
@@ -100,4 +103,8 @@ type SearchCriteria struct {
 	Year    int
 	Season  int
 	Episode int
+
+	// AbsoluteEpisode is set for anime episodes, whose releases are usually
+	// numbered across the whole series rather than per season.
+	AbsoluteEpisode int
 }
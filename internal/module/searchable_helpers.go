@@ -1,6 +1,11 @@
 package module
 
-import "strconv"
+import (
+	"path/filepath"
+	"strconv"
+
+	"github.com/slipstream/slipstream/internal/library/scanner"
+)
 
 // Accessor helpers for SearchableItem — extract commonly needed fields
 // from the generic interface without callers needing to know about
@@ -12,6 +17,13 @@ func ItemYear(item SearchableItem) int {
 	return v
 }
 
+// ItemRuntime returns the runtime in minutes from a SearchableItem's search params.
+// Season pack items carry no runtime, so this returns 0 for them.
+func ItemRuntime(item SearchableItem) int {
+	v, _ := item.GetSearchParams().Extra["runtime"].(int)
+	return v
+}
+
 // ItemSeriesID returns the series ID from a SearchableItem's search params.
 func ItemSeriesID(item SearchableItem) int64 {
 	v, _ := item.GetSearchParams().Extra["seriesId"].(int64)
@@ -43,6 +55,27 @@ func ItemCurrentQualityID(item SearchableItem) int {
 	return 0
 }
 
+// ItemCurrentRevision returns the PROPER/REPACK revision of the item's current
+// file. ok is false when the collector did not look it up, in which case a
+// same-quality release can never be an upgrade.
+func ItemCurrentRevision(item SearchableItem) (revision string, ok bool) {
+	revision, ok = item.GetSearchParams().Extra["currentRevision"].(string)
+	return revision, ok
+}
+
+// FileRevision returns the revision of a library file, read from the name it
+// was imported under when known since renaming may drop the tag.
+func FileRevision(originalFilename, path string) string {
+	name := originalFilename
+	if name == "" {
+		name = filepath.Base(path)
+	}
+	if name == "" || name == "." {
+		return ""
+	}
+	return scanner.ParseFilename(name).Revision
+}
+
 // ItemTargetSlotID returns the target slot ID from search params, or nil.
 func ItemTargetSlotID(item SearchableItem) *int64 {
 	v, _ := item.GetSearchParams().Extra["targetSlotId"].(*int64)
@@ -50,8 +83,10 @@ func ItemTargetSlotID(item SearchableItem) *int64 {
 }
 
 // CloneWithSlotOverrides creates a new WantedItem based on an existing SearchableItem
-// but with the quality profile ID and target slot ID overridden for slot-specific searching.
-func CloneWithSlotOverrides(item SearchableItem, qualityProfileID int64, targetSlotID *int64) SearchableItem {
+// but with the quality profile ID, target slot ID and current quality overridden for
+// slot-specific searching. currentQualityID is the quality of the slot's own file, nil
+// when the slot is empty, so files in other slots never block a grab.
+func CloneWithSlotOverrides(item SearchableItem, qualityProfileID int64, targetSlotID, currentQualityID *int64) SearchableItem {
 	sp := item.GetSearchParams()
 	extra := make(map[string]any, len(sp.Extra)+1)
 	for k, v := range sp.Extra {
@@ -60,6 +95,8 @@ func CloneWithSlotOverrides(item SearchableItem, qualityProfileID int64, targetS
 	if targetSlotID != nil {
 		extra["targetSlotId"] = targetSlotID
 	}
+	// The revision belongs to the item's best file, not necessarily the slot's
+	delete(extra, "currentRevision")
 	return NewWantedItem(
 		Type(item.GetModuleType()),
 		item.GetMediaType(),
@@ -67,7 +104,7 @@ func CloneWithSlotOverrides(item SearchableItem, qualityProfileID int64, targetS
 		item.GetTitle(),
 		item.GetExternalIDs(),
 		qualityProfileID,
-		item.GetCurrentQualityID(),
+		currentQualityID,
 		SearchParams{Extra: extra},
 	)
 }
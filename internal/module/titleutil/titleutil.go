@@ -3,6 +3,11 @@ package titleutil
 import (
 	"regexp"
 	"strings"
+	"unicode"
+
+	"golang.org/x/text/runes"
+	"golang.org/x/text/transform"
+	"golang.org/x/text/unicode/norm"
 )
 
 var (
@@ -12,13 +17,24 @@ var (
 	trailingYearRegex  = regexp.MustCompile(`\s+(19|20)\d{2}$`)
 )
 
+// FoldAccents strips diacritics so "Amélie" compares equal to the "Amelie"
+// release groups write.
+func FoldAccents(s string) string {
+	t := transform.Chain(norm.NFD, runes.Remove(runes.In(unicode.Mn)), norm.NFC)
+	folded, _, err := transform.String(t, s)
+	if err != nil {
+		return s
+	}
+	return folded
+}
+
 // NormalizeTitle converts a title to a normalized form for comparison.
-// It converts to lowercase, strips apostrophes (within-word punctuation),
+// It folds accents, converts to lowercase, strips apostrophes (within-word punctuation),
 // replaces remaining special characters with spaces, and collapses multiple spaces.
 // Apostrophes are stripped rather than replaced with spaces so that titles like
 // "Schitt's Creek" and "Schitts Creek" both normalize to "schitts creek".
 func NormalizeTitle(title string) string {
-	normalized := strings.ToLower(title)
+	normalized := strings.ToLower(FoldAccents(title))
 	normalized = apostropheRegex.ReplaceAllString(normalized, "")
 	normalized = specialCharsRegex.ReplaceAllString(normalized, " ")
 	normalized = multipleSpaceRegex.ReplaceAllString(normalized, " ")
@@ -55,3 +71,46 @@ func stripTrailingYear(normalized string) string {
 	}
 	return stripped
 }
+
+// YearTolerance is the largest difference between a parsed release year and
+// a library year still treated as the same title. Release years commonly drift
+// by one from metadata (festival vs. theatrical release), while remakes such
+// as "Dune (1984)" and "Dune (2021)" are years apart.
+const YearTolerance = 1
+
+// YearsMatch reports whether two years are within YearTolerance of each other.
+// An unknown year (0) matches any year.
+func YearsMatch(a, b int) bool {
+	if a <= 0 || b <= 0 {
+		return true
+	}
+	return max(a-b, b-a) <= YearTolerance
+}
+
+// ClosestYear returns the index of the candidate year that best matches year,
+// or -1 if none does. Exact matches win over matches within YearTolerance,
+// which win over candidates with an unknown year. When year is unknown, a lone
+// candidate matches and several candidates (remakes) are ambiguous.
+func ClosestYear(year int, candidates []int) int {
+	if year <= 0 {
+		if len(candidates) == 1 {
+			return 0
+		}
+		return -1
+	}
+
+	best, bestDiff := -1, YearTolerance+2
+	for i, c := range candidates {
+		if !YearsMatch(year, c) {
+			continue
+		}
+		diff := YearTolerance + 1
+		if c > 0 {
+			diff = max(year-c, c-year)
+		}
+		if diff < bestDiff {
+			best, bestDiff = i, diff
+		}
+	}
+	return best
+}
@@ -0,0 +1,67 @@
+package titleutil
+
+import "testing"
+
+func TestYearsMatch(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b int
+		want bool
+	}{
+		{"same year", 2021, 2021, true},
+		{"within tolerance", 2021, 2020, true},
+		{"remake", 2021, 1984, false},
+		{"two years apart", 2021, 2019, false},
+		{"unknown year", 0, 1984, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := YearsMatch(tt.a, tt.b); got != tt.want {
+				t.Errorf("YearsMatch(%d, %d) = %v, want %v", tt.a, tt.b, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestClosestYear(t *testing.T) {
+	tests := []struct {
+		name       string
+		year       int
+		candidates []int
+		want       int
+	}{
+		{"exact match among remakes", 1984, []int{2021, 1984}, 1},
+		{"exact wins over tolerance", 2021, []int{2022, 2021}, 1},
+		{"within tolerance", 2020, []int{1984, 2021}, 1},
+		{"known year wins over unknown", 2021, []int{0, 2021}, 1},
+		{"unknown candidate year", 2021, []int{0}, 0},
+		{"no year match", 2000, []int{1984, 2021}, -1},
+		{"unknown year single candidate", 0, []int{2021}, 0},
+		{"unknown year ambiguous", 0, []int{1984, 2021}, -1},
+		{"no candidates", 2021, nil, -1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ClosestYear(tt.year, tt.candidates); got != tt.want {
+				t.Errorf("ClosestYear(%d, %v) = %d, want %d", tt.year, tt.candidates, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNormalizeTitle_FoldsAccents(t *testing.T) {
+	tests := []struct {
+		title string
+		want  string
+	}{
+		{"Amélie", "amelie"},
+		{"Le Fabuleux Destin d'Amélie Poulain", "le fabuleux destin damelie poulain"},
+		{"Pokémon: Detective Pikachu", "pokemon detective pikachu"},
+		{"Schitt's Creek", "schitts creek"},
+	}
+	for _, tt := range tests {
+		if got := NormalizeTitle(tt.title); got != tt.want {
+			t.Errorf("NormalizeTitle(%q) = %q, want %q", tt.title, got, tt.want)
+		}
+	}
+}
@@ -251,7 +251,7 @@ func mapRadarrQualityID(sourceQualityID int, sourceQualityName string) *int64 {
 	if ssID, ok := radarrQualityMap[sourceQualityID]; ok {
 		return &ssID
 	}
-	if q, ok := quality.GetQualityByName(sourceQualityName); ok {
+	if q, ok := quality.PredefinedQualityByName(sourceQualityName); ok {
 		id := int64(q.ID)
 		return &id
 	}
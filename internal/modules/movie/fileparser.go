@@ -163,6 +163,7 @@ func buildMovieParseResult(rawTitle string, year int, qualityText string) *modul
 		result.Revision = parseutil.ParseRevision(qualityText)
 		result.Edition = parseutil.ParseEdition(qualityText)
 		result.Languages = parseutil.ParseLanguages(qualityText)
+		result.SubtitleLanguages = parseutil.ParseSubtitleLanguages(qualityText)
 	}
 
 	return result
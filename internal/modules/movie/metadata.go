@@ -132,6 +132,8 @@ func (p *metadataProvider) RefreshMetadata(ctx context.Context, entityID int64)
 		return nil, err
 	}
 
+	p.refreshAlternateTitles(ctx, movie.ID, bestMatch)
+	p.refreshCredits(ctx, movie.ID, bestMatch)
 	p.downloadArtworkAsync(bestMatch)
 
 	return &module.RefreshResult{
@@ -141,6 +143,38 @@ func (p *metadataProvider) RefreshMetadata(ctx context.Context, entityID int64)
 	}, nil
 }
 
+// refreshAlternateTitles stores the movie's AKA titles and translations for
+// release and import matching. Failures are logged and leave the old titles.
+func (p *metadataProvider) refreshAlternateTitles(ctx context.Context, movieID int64, match *metadata.MovieResult) {
+	if match.ID == 0 {
+		return
+	}
+	titles, err := p.metadataSvc.GetMovieAlternateTitles(ctx, match.ID)
+	if err != nil {
+		p.logger.Warn().Err(err).Int("tmdbId", match.ID).Msg("Failed to fetch alternate titles")
+		return
+	}
+	if err := p.movieSvc.SetAlternateTitles(ctx, movieID, match.Title, titles); err != nil {
+		p.logger.Warn().Err(err).Int64("movieId", movieID).Msg("Failed to store alternate titles")
+	}
+}
+
+// refreshCredits stores the movie's directors, writers and billed cast.
+// Failures are logged and leave the old credits.
+func (p *metadataProvider) refreshCredits(ctx context.Context, movieID int64, match *metadata.MovieResult) {
+	if match.ID == 0 {
+		return
+	}
+	credits, err := p.metadataSvc.GetMovieCredits(ctx, match.ID)
+	if err != nil {
+		p.logger.Warn().Err(err).Int("tmdbId", match.ID).Msg("Failed to fetch credits")
+		return
+	}
+	if err := p.movieSvc.SetCredits(ctx, movieID, credits); err != nil {
+		p.logger.Warn().Err(err).Int64("movieId", movieID).Msg("Failed to store credits")
+	}
+}
+
 func (p *metadataProvider) selectBestMatch(movie *movies.Movie, results []metadata.MovieResult) *metadata.MovieResult {
 	movieTitleLower := strings.ToLower(movie.Title)
 
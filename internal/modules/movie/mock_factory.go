@@ -154,7 +154,7 @@ func (m *Module) createMockMovieFiles(ctx context.Context, movieID int64, movieP
 			Size:    f.Size,
 			Quality: qualityName,
 		}
-		if q, ok := quality.GetQualityByName(qualityName); ok {
+		if q, ok := quality.PredefinedQualityByName(qualityName); ok {
 			qid := int64(q.ID)
 			input.QualityID = &qid
 		}
@@ -1,8 +1,10 @@
 package movie_test
 
 import (
+	"context"
 	"testing"
 
+	"github.com/slipstream/slipstream/internal/module"
 	"github.com/slipstream/slipstream/internal/module/moduletest"
 	"github.com/slipstream/slipstream/internal/modules/movie"
 	"github.com/slipstream/slipstream/internal/testutil"
@@ -47,3 +49,20 @@ func TestMovieModuleLifecycle(t *testing.T) {
 	mod := newTestModule(t)
 	moduletest.RunLifecycleTest(t, mod)
 }
+
+func TestMovieFilterRelease_Edition(t *testing.T) {
+	mod := newTestModule(t)
+	ctx := context.Background()
+	theatrical := module.NewWantedItem(module.TypeMovie, "movie", 1, "Blade Runner", nil, 1, nil,
+		module.SearchParams{Extra: map[string]any{"year": 1982, "editionPreference": "Theatrical"}})
+
+	extended := &module.ReleaseForFilter{Title: "Blade Runner", Year: 1982, Edition: "Extended Cut"}
+	if reject, _ := mod.FilterRelease(ctx, extended, theatrical); !reject {
+		t.Error("Extended release should be rejected for a Theatrical preference")
+	}
+
+	plain := &module.ReleaseForFilter{Title: "Blade Runner", Year: 1982}
+	if reject, reason := mod.FilterRelease(ctx, plain, theatrical); reject {
+		t.Errorf("release without a cut rejected for a Theatrical preference: %s", reason)
+	}
+}
@@ -36,6 +36,7 @@ func (p *pathGenerator) AvailableVariables(contextName string) []module.Template
 		common = append(common, module.QualityVariables()...)
 		common = append(common, module.MediaInfoVariables()...)
 		common = append(common, module.MetadataVariables()...)
+		common = append(common, module.SlotVariables()...)
 	}
 
 	return common
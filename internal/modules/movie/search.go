@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/slipstream/slipstream/internal/module"
+	"github.com/slipstream/slipstream/internal/module/parseutil"
 	"github.com/slipstream/slipstream/internal/module/titleutil"
 )
 
@@ -27,15 +28,11 @@ func (m *Module) FilterRelease(_ context.Context, release *module.ReleaseForFilt
 	if !titleutil.TitlesMatch(release.Title, item.GetTitle()) {
 		return true, "title mismatch"
 	}
-	year := item.GetSearchParams().Extra["year"]
-	if yearInt, ok := year.(int); ok && yearInt > 0 && release.Year > 0 {
-		diff := yearInt - release.Year
-		if diff < 0 {
-			diff = -diff
-		}
-		if diff > 1 {
-			return true, "year mismatch (>1 year difference)"
-		}
+	if year, ok := item.GetSearchParams().Extra["year"].(int); ok && !titleutil.YearsMatch(year, release.Year) {
+		return true, "year mismatch (>1 year difference)"
+	}
+	if preference, _ := item.GetSearchParams().Extra["editionPreference"].(string); !parseutil.EditionMatches(preference, release.Edition) {
+		return true, "edition mismatch"
 	}
 	return false, ""
 }
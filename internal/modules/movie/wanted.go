@@ -31,9 +31,15 @@ func (m *Module) CollectMissing(ctx context.Context) ([]module.SearchableItem, e
 			profileID = row.QualityProfileID.Int64
 		}
 
-		params := module.SearchParams{}
+		params := module.SearchParams{Extra: map[string]any{}}
 		if row.Year.Valid {
-			params.Extra = map[string]any{"year": int(row.Year.Int64)}
+			params.Extra["year"] = int(row.Year.Int64)
+		}
+		if row.Runtime.Valid {
+			params.Extra["runtime"] = int(row.Runtime.Int64)
+		}
+		if row.EditionPreference.Valid {
+			params.Extra["editionPreference"] = row.EditionPreference.String
 		}
 
 		items = append(items, module.NewWantedItem(
@@ -75,10 +81,17 @@ func (m *Module) CollectUpgradable(ctx context.Context) ([]module.SearchableItem
 			currentQIDPtr = &v
 		}
 
-		params := module.SearchParams{}
+		params := module.SearchParams{Extra: map[string]any{}}
 		if row.Year.Valid {
-			params.Extra = map[string]any{"year": int(row.Year.Int64)}
+			params.Extra["year"] = int(row.Year.Int64)
+		}
+		if row.Runtime.Valid {
+			params.Extra["runtime"] = int(row.Runtime.Int64)
+		}
+		if row.EditionPreference.Valid {
+			params.Extra["editionPreference"] = row.EditionPreference.String
 		}
+		params.Extra["currentRevision"] = module.FileRevision(row.CurrentOriginalFilename.String, row.CurrentFilePath)
 
 		items = append(items, module.NewWantedItem(
 			module.TypeMovie, "movie", row.ID, row.Title,
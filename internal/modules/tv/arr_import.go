@@ -345,7 +345,7 @@ func mapSonarrQualityID(sourceQualityID int, sourceQualityName string) *int64 {
 	if ssID, ok := sonarrQualityMap[sourceQualityID]; ok {
 		return &ssID
 	}
-	if q, ok := quality.GetQualityByName(sourceQualityName); ok {
+	if q, ok := quality.PredefinedQualityByName(sourceQualityName); ok {
 		id := int64(q.ID)
 		return &id
 	}
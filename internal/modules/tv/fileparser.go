@@ -29,6 +29,9 @@ var (
 	tvPatternSeasonSpelled        = regexp.MustCompile(`(?i)^(.+?)[.\s_-]+[Ss]eason[.\s_-]+(\d{1,2})(?:[.\s_-]|$)(.*)$`)
 	tvPatternSeasonRange          = regexp.MustCompile(`(?i)^(.+?)[.\s_-]+[Ss](\d{1,2})-s?(\d{1,2})[.\s_-]+(.*)$`)
 	tvPatternComplete             = regexp.MustCompile(`(?i)^(.+?)[.\s_-]+(?:complete[.\s_-]*(?:series)?|the[.\s_-]+complete[.\s_-]+series)[.\s_-]+(.*)$`)
+
+	// Anime releases number episodes across the whole series: "[Group] Title - 012v2 (1080p)", "Title - 01-12".
+	tvPatternAnimeAbsolute = regexp.MustCompile(`(?i)^(?:\[([^\]]+)\][\s._]*)?(.+?)[\s._]+-[\s._]+(\d{2,4})(?:v\d)?(?:-(\d{2,4})(?:v\d)?)?(?:[\s._]+(.*))?$`)
 )
 
 // TVParseExtra carries TV-specific parsed data in ParseResult.Extra.
@@ -39,17 +42,26 @@ type TVParseExtra struct {
 	EndEpisode       int
 	IsSeasonPack     bool
 	IsCompleteSeries bool
+
+	// Absolute numbering (anime), set only when no season/episode was found
+	AbsoluteEpisode    int
+	EndAbsoluteEpisode int
 }
 
-// Compile-time check that TVParseExtra implements module.TVExtraAccessor.
-var _ module.TVExtraAccessor = (*TVParseExtra)(nil)
+// Compile-time checks that TVParseExtra implements the TV extra accessors.
+var (
+	_ module.TVExtraAccessor         = (*TVParseExtra)(nil)
+	_ module.TVAbsoluteExtraAccessor = (*TVParseExtra)(nil)
+)
 
-func (e *TVParseExtra) TVSeason() int            { return e.Season }
-func (e *TVParseExtra) TVEndSeason() int         { return e.EndSeason }
-func (e *TVParseExtra) TVEpisode() int           { return e.Episode }
-func (e *TVParseExtra) TVEndEpisode() int        { return e.EndEpisode }
-func (e *TVParseExtra) TVIsSeasonPack() bool     { return e.IsSeasonPack }
-func (e *TVParseExtra) TVIsCompleteSeries() bool { return e.IsCompleteSeries }
+func (e *TVParseExtra) TVSeason() int             { return e.Season }
+func (e *TVParseExtra) TVEndSeason() int          { return e.EndSeason }
+func (e *TVParseExtra) TVEpisode() int            { return e.Episode }
+func (e *TVParseExtra) TVEndEpisode() int         { return e.EndEpisode }
+func (e *TVParseExtra) TVIsSeasonPack() bool      { return e.IsSeasonPack }
+func (e *TVParseExtra) TVIsCompleteSeries() bool  { return e.IsCompleteSeries }
+func (e *TVParseExtra) TVAbsoluteEpisode() int    { return e.AbsoluteEpisode }
+func (e *TVParseExtra) TVEndAbsoluteEpisode() int { return e.EndAbsoluteEpisode }
 
 type fileParser struct {
 	tvSvc         *tvlib.Service
@@ -91,6 +103,9 @@ func (p *fileParser) TryMatch(filename string) (confidence float64, match *modul
 	if extra.Season > 0 && extra.Episode > 0 {
 		return 0.9, result
 	}
+	if extra.AbsoluteEpisode > 0 {
+		return 0.85, result
+	}
 	if extra.IsSeasonPack {
 		return 0.85, result
 	}
@@ -110,9 +125,37 @@ func parseTVName(name string) *module.ParseResult {
 	if result := tryTVEpisodePatterns(name); result != nil {
 		return result
 	}
+	if result := tryAnimeAbsolutePattern(name); result != nil {
+		return result
+	}
 	return tryTVPackPatterns(name)
 }
 
+// tryAnimeAbsolutePattern parses absolute-numbered anime names. A leading
+// bracketed tag is the release group; four-digit numbers that look like years
+// are left to the other patterns.
+func tryAnimeAbsolutePattern(name string) *module.ParseResult {
+	match := tvPatternAnimeAbsolute.FindStringSubmatch(name)
+	if match == nil {
+		return nil
+	}
+
+	extra := &TVParseExtra{}
+	extra.AbsoluteEpisode, _ = strconv.Atoi(match[3])
+	if len(match[3]) == 4 && extra.AbsoluteEpisode >= 1900 && extra.AbsoluteEpisode <= 2100 {
+		return nil
+	}
+	if match[4] != "" {
+		extra.EndAbsoluteEpisode, _ = strconv.Atoi(match[4])
+	}
+
+	result := buildTVParseResult(match[2], match[5], extra)
+	if match[1] != "" {
+		result.ReleaseGroup = match[1]
+	}
+	return result
+}
+
 func tryTVEpisodePatterns(name string) *module.ParseResult {
 	if match := tvPatternSE.FindStringSubmatch(name); match != nil {
 		extra := &TVParseExtra{}
@@ -198,19 +241,20 @@ func buildTVParseResult(rawTitle, qualityText string, extra *TVParseExtra) *modu
 	}
 
 	return &module.ParseResult{
-		Title:         title,
-		Year:          year,
-		Quality:       attrs.Quality,
-		Source:        attrs.Source,
-		Codec:         codec,
-		HDRFormats:    hdrFormats,
-		AudioCodecs:   audioCodecs,
-		AudioChannels: audioChannels,
-		ReleaseGroup:  parseutil.ParseReleaseGroup(qualityText),
-		Revision:      parseutil.ParseRevision(qualityText),
-		Edition:       parseutil.ParseEdition(qualityText),
-		Languages:     parseutil.ParseLanguages(qualityText),
-		Extra:         extra,
+		Title:             title,
+		Year:              year,
+		Quality:           attrs.Quality,
+		Source:            attrs.Source,
+		Codec:             codec,
+		HDRFormats:        hdrFormats,
+		AudioCodecs:       audioCodecs,
+		AudioChannels:     audioChannels,
+		ReleaseGroup:      parseutil.ParseReleaseGroup(qualityText),
+		Revision:          parseutil.ParseRevision(qualityText),
+		Edition:           parseutil.ParseEdition(qualityText),
+		Languages:         parseutil.ParseLanguages(qualityText),
+		SubtitleLanguages: parseutil.ParseSubtitleLanguages(qualityText),
+		Extra:             extra,
 	}
 }
 
@@ -237,6 +281,10 @@ func (p *fileParser) MatchToEntity(ctx context.Context, parseResult *module.Pars
 		return p.matchSeasonPack(series, rf.Path, tvExtra), nil
 	}
 
+	if tvExtra.Season == 0 && tvExtra.AbsoluteEpisode > 0 {
+		return p.matchAbsoluteEpisode(ctx, series, rf.Path, tvExtra)
+	}
+
 	return p.matchEpisode(ctx, series, rf.Path, tvExtra)
 }
 
@@ -269,6 +317,9 @@ func (p *fileParser) matchEpisode(ctx context.Context, series *tvlib.Series, roo
 		return nil, err
 	}
 
+	tokenData := buildTVTokenData(series, episode)
+	addAbsoluteNumberToken(ctx, p.tvSvc, series, episode, tokenData)
+
 	entity := &module.MatchedEntity{
 		ModuleType:       module.TypeTV,
 		EntityType:       module.EntityEpisode,
@@ -278,23 +329,49 @@ func (p *fileParser) matchEpisode(ctx context.Context, series *tvlib.Series, roo
 		Confidence:       0.8,
 		Source:           "parse",
 		QualityProfileID: series.QualityProfileID,
-		TokenData:        buildTVTokenData(series, episode),
+		TokenData:        tokenData,
 	}
 
 	if extra.EndEpisode > extra.Episode {
-		entity.EntityIDs = p.collectEpisodeIDs(ctx, series.ID, extra.Season, extra.Episode, extra.EndEpisode)
+		entity.EntityIDs = collectEpisodeRange(ctx, p.tvSvc, series.ID, extra.Season, extra.Episode, extra.EndEpisode, tokenData)
 	}
 
 	return entity, nil
 }
 
-func (p *fileParser) collectEpisodeIDs(ctx context.Context, seriesID int64, season, startEp, endEp int) []int64 {
-	var ids []int64
-	for epNum := startEp; epNum <= endEp; epNum++ {
-		ep, err := p.tvSvc.GetEpisodeByNumber(ctx, seriesID, season, epNum)
-		if err == nil && ep != nil {
-			ids = append(ids, ep.ID)
+// matchAbsoluteEpisode maps an absolute episode number to the series' season
+// and episode. Only anime series are matched this way.
+func (p *fileParser) matchAbsoluteEpisode(ctx context.Context, series *tvlib.Series, rootFolder string, extra *TVParseExtra) (*module.MatchedEntity, error) {
+	if !series.IsAnime() {
+		return nil, nil //nolint:nilnil // nil means no match found
+	}
+
+	episode, err := p.tvSvc.GetEpisodeByAbsoluteNumber(ctx, series.ID, extra.AbsoluteEpisode)
+	if err != nil {
+		if errors.Is(err, tvlib.ErrEpisodeNotFound) {
+			return nil, nil //nolint:nilnil // nil means no match found
 		}
+		return nil, err
+	}
+
+	tokenData := buildTVTokenData(series, episode)
+	tokenData["AbsoluteNumber"] = extra.AbsoluteEpisode
+
+	entity := &module.MatchedEntity{
+		ModuleType:       module.TypeTV,
+		EntityType:       module.EntityEpisode,
+		EntityID:         episode.ID,
+		Title:            fmt.Sprintf("%s - S%02dE%02d - %s", series.Title, episode.SeasonNumber, episode.EpisodeNumber, episode.Title),
+		RootFolder:       rootFolder,
+		Confidence:       0.8,
+		Source:           "parse",
+		QualityProfileID: series.QualityProfileID,
+		TokenData:        tokenData,
+	}
+
+	if extra.EndAbsoluteEpisode > extra.AbsoluteEpisode {
+		entity.EntityIDs = collectAbsoluteEpisodeIDs(ctx, p.tvSvc, series.ID, extra.AbsoluteEpisode, extra.EndAbsoluteEpisode)
 	}
-	return ids
+
+	return entity, nil
 }
@@ -83,6 +83,9 @@ func (h *importHandler) matchSingleEpisode(ctx context.Context, download *module
 		return nil, fmt.Errorf("root folder %d not found: %w", series.RootFolderID, err)
 	}
 
+	tokenData := buildTVTokenData(series, episode)
+	addAbsoluteNumberToken(ctx, h.tvSvc, series, episode, tokenData)
+
 	return []module.MatchedEntity{{
 		ModuleType:       module.TypeTV,
 		EntityType:       module.EntityEpisode,
@@ -92,7 +95,7 @@ func (h *importHandler) matchSingleEpisode(ctx context.Context, download *module
 		Confidence:       1.0,
 		Source:           "queue",
 		QualityProfileID: series.QualityProfileID,
-		TokenData:        buildTVTokenData(series, episode),
+		TokenData:        tokenData,
 	}}, nil
 }
 
@@ -100,17 +103,21 @@ func (h *importHandler) MatchIndividualFile(ctx context.Context, filePath string
 	filename := filepath.Base(filePath)
 	parsed := scanner.ParseFilename(filename)
 
-	if !parsed.IsTV || parsed.Episode == 0 {
+	if !parsed.IsTV || (parsed.Episode == 0 && parsed.AbsoluteEpisode == 0) {
 		return nil, fmt.Errorf("cannot parse TV episode from %q", filename)
 	}
 
-	seasonNum := resolveSeasonNumber(parsed.Season, parentEntity)
-
 	series, err := h.tvSvc.GetSeries(ctx, parentEntity.EntityID)
 	if err != nil {
 		return nil, err
 	}
 
+	if parsed.Episode == 0 {
+		return h.matchAbsoluteFile(ctx, series, parsed)
+	}
+
+	seasonNum := resolveSeasonNumber(parsed.Season, parentEntity)
+
 	episode, err := h.tvSvc.GetEpisodeByNumber(ctx, series.ID, seasonNum, parsed.Episode)
 	if err != nil {
 		return nil, fmt.Errorf("episode S%02dE%02d not found for series %d: %w", seasonNum, parsed.Episode, series.ID, err)
@@ -121,6 +128,9 @@ func (h *importHandler) MatchIndividualFile(ctx context.Context, filePath string
 		return nil, fmt.Errorf("root folder %d not found: %w", series.RootFolderID, err)
 	}
 
+	tokenData := buildTVTokenData(series, episode)
+	addAbsoluteNumberToken(ctx, h.tvSvc, series, episode, tokenData)
+
 	entity := &module.MatchedEntity{
 		ModuleType:       module.TypeTV,
 		EntityType:       module.EntityEpisode,
@@ -130,11 +140,50 @@ func (h *importHandler) MatchIndividualFile(ctx context.Context, filePath string
 		Confidence:       0.9,
 		Source:           "parse",
 		QualityProfileID: series.QualityProfileID,
-		TokenData:        buildTVTokenData(series, episode),
+		TokenData:        tokenData,
 	}
 
 	if parsed.EndEpisode > parsed.Episode {
-		entity.EntityIDs = h.collectMultiEpisodeIDs(ctx, series.ID, seasonNum, parsed.Episode, parsed.EndEpisode)
+		entity.EntityIDs = collectEpisodeRange(ctx, h.tvSvc, series.ID, seasonNum, parsed.Episode, parsed.EndEpisode, tokenData)
+	}
+
+	return entity, nil
+}
+
+// matchAbsoluteFile maps an absolute-numbered file (anime batch releases) to
+// its season/episode within the series.
+func (h *importHandler) matchAbsoluteFile(ctx context.Context, series *tvlib.Series, parsed *scanner.ParsedMedia) (*module.MatchedEntity, error) {
+	if !series.IsAnime() {
+		return nil, fmt.Errorf("absolute episode %d found but series %d is not an anime series", parsed.AbsoluteEpisode, series.ID)
+	}
+
+	episode, err := h.tvSvc.GetEpisodeByAbsoluteNumber(ctx, series.ID, parsed.AbsoluteEpisode)
+	if err != nil {
+		return nil, fmt.Errorf("absolute episode %d not found for series %d: %w", parsed.AbsoluteEpisode, series.ID, err)
+	}
+
+	rf, err := h.rootFolderSvc.Get(ctx, series.RootFolderID)
+	if err != nil {
+		return nil, fmt.Errorf("root folder %d not found: %w", series.RootFolderID, err)
+	}
+
+	tokenData := buildTVTokenData(series, episode)
+	tokenData["AbsoluteNumber"] = parsed.AbsoluteEpisode
+
+	entity := &module.MatchedEntity{
+		ModuleType:       module.TypeTV,
+		EntityType:       module.EntityEpisode,
+		EntityID:         episode.ID,
+		Title:            fmt.Sprintf("%s - S%02dE%02d", series.Title, episode.SeasonNumber, episode.EpisodeNumber),
+		RootFolder:       rf.Path,
+		Confidence:       0.9,
+		Source:           "parse",
+		QualityProfileID: series.QualityProfileID,
+		TokenData:        tokenData,
+	}
+
+	if parsed.EndAbsolute > parsed.AbsoluteEpisode {
+		entity.EntityIDs = collectAbsoluteEpisodeIDs(ctx, h.tvSvc, series.ID, parsed.AbsoluteEpisode, parsed.EndAbsolute)
 	}
 
 	return entity, nil
@@ -152,10 +201,29 @@ func resolveSeasonNumber(parsedSeason int, parentEntity *module.MatchedEntity) i
 	return 0
 }
 
-func (h *importHandler) collectMultiEpisodeIDs(ctx context.Context, seriesID int64, seasonNum, startEp, endEp int) []int64 {
+// collectEpisodeRange returns the IDs of the episodes a multi-episode file
+// covers and records their numbers as the EpisodeNumbers naming token so the
+// file is named for the whole range.
+func collectEpisodeRange(ctx context.Context, tvSvc *tvlib.Service, seriesID int64, seasonNum, startEp, endEp int, tokenData map[string]any) []int64 {
 	var ids []int64
+	var numbers []int
 	for epNum := startEp; epNum <= endEp; epNum++ {
-		ep, err := h.tvSvc.GetEpisodeByNumber(ctx, seriesID, seasonNum, epNum)
+		ep, err := tvSvc.GetEpisodeByNumber(ctx, seriesID, seasonNum, epNum)
+		if err == nil && ep != nil {
+			ids = append(ids, ep.ID)
+			numbers = append(numbers, epNum)
+		}
+	}
+	if len(numbers) > 1 {
+		tokenData["EpisodeNumbers"] = numbers
+	}
+	return ids
+}
+
+func collectAbsoluteEpisodeIDs(ctx context.Context, tvSvc *tvlib.Service, seriesID int64, start, end int) []int64 {
+	var ids []int64
+	for abs := start; abs <= end; abs++ {
+		ep, err := tvSvc.GetEpisodeByAbsoluteNumber(ctx, seriesID, abs)
 		if err == nil {
 			ids = append(ids, ep.ID)
 		}
@@ -170,7 +238,11 @@ func (h *importHandler) IsGroupImportReady(_ context.Context, _ *module.MatchedE
 func (h *importHandler) ImportFile(ctx context.Context, filePath string, entity *module.MatchedEntity, qi *module.QualityInfo) (*module.ImportResult, error) {
 	qid := int64(qi.QualityID)
 	originalPath, _ := entity.TokenData["OriginalPath"].(string)
-	epFile, err := h.tvSvc.AddEpisodeFile(ctx, entity.EntityID, &tvlib.CreateEpisodeFileInput{
+	episodeIDs := []int64{entity.EntityID}
+	if len(entity.EntityIDs) > 1 {
+		episodeIDs = entity.EntityIDs
+	}
+	epFile, err := h.tvSvc.AddMultiEpisodeFile(ctx, episodeIDs, &tvlib.CreateEpisodeFileInput{
 		Path:         filePath,
 		QualityID:    &qid,
 		Quality:      qi.Quality,
@@ -180,19 +252,6 @@ func (h *importHandler) ImportFile(ctx context.Context, filePath string, entity
 		return nil, fmt.Errorf("create episode file record: %w", err)
 	}
 
-	if len(entity.EntityIDs) > 1 {
-		for _, epID := range entity.EntityIDs[1:] {
-			_, err := h.tvSvc.AddEpisodeFile(ctx, epID, &tvlib.CreateEpisodeFileInput{
-				Path:      filePath,
-				QualityID: &qid,
-				Quality:   qi.Quality,
-			})
-			if err != nil {
-				h.logger.Warn().Err(err).Int64("episodeID", epID).Msg("Failed to create multi-episode file record")
-			}
-		}
-	}
-
 	return &module.ImportResult{
 		FileID:          epFile.ID,
 		DestinationPath: filePath,
@@ -227,6 +286,16 @@ func buildSeriesTokenData(series *tvlib.Series) map[string]any {
 	}
 }
 
+// addAbsoluteNumberToken sets the {absolute} naming token for anime series.
+func addAbsoluteNumberToken(ctx context.Context, tvSvc *tvlib.Service, series *tvlib.Series, episode *tvlib.Episode, data map[string]any) {
+	if !series.IsAnime() || episode.SeasonNumber == 0 {
+		return
+	}
+	if abs, err := tvSvc.GetAbsoluteNumber(ctx, series.ID, episode.SeasonNumber, episode.EpisodeNumber); err == nil && abs > 0 {
+		data["AbsoluteNumber"] = abs
+	}
+}
+
 func buildTVTokenData(series *tvlib.Series, episode *tvlib.Episode) map[string]any {
 	data := map[string]any{
 		"SeriesID":      series.ID,
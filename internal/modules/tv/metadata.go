@@ -152,6 +152,8 @@ func (p *metadataProvider) RefreshMetadata(ctx context.Context, entityID int64)
 	}
 
 	childDiff := p.refreshSeasons(ctx, series, bestMatch.TmdbID, bestMatch.TvdbID)
+	p.refreshAlternateTitles(ctx, series.ID, bestMatch)
+	p.refreshCredits(ctx, series.ID, bestMatch)
 
 	p.downloadArtworkAsync(bestMatch)
 
@@ -165,6 +167,35 @@ func (p *metadataProvider) RefreshMetadata(ctx context.Context, entityID int64)
 	}, nil
 }
 
+// refreshAlternateTitles stores the series' AKA titles and translations for
+// release and import matching. Failures are logged and leave the old titles.
+func (p *metadataProvider) refreshAlternateTitles(ctx context.Context, seriesID int64, match *metadata.SeriesResult) {
+	titles, err := p.metadataSvc.GetSeriesAlternateTitles(ctx, match.TvdbID, match.TmdbID)
+	if err != nil {
+		p.logger.Warn().Err(err).Int("tvdbId", match.TvdbID).Int("tmdbId", match.TmdbID).Msg("Failed to fetch alternate titles")
+		return
+	}
+	if err := p.tvSvc.SetAlternateTitles(ctx, seriesID, match.Title, titles); err != nil {
+		p.logger.Warn().Err(err).Int64("seriesId", seriesID).Msg("Failed to store alternate titles")
+	}
+}
+
+// refreshCredits stores the series' creators and billed cast. Failures are
+// logged and leave the old credits.
+func (p *metadataProvider) refreshCredits(ctx context.Context, seriesID int64, match *metadata.SeriesResult) {
+	if match.TmdbID == 0 {
+		return
+	}
+	credits, err := p.metadataSvc.GetSeriesCredits(ctx, match.TmdbID)
+	if err != nil {
+		p.logger.Warn().Err(err).Int("tmdbId", match.TmdbID).Msg("Failed to fetch credits")
+		return
+	}
+	if err := p.tvSvc.SetCredits(ctx, seriesID, credits); err != nil {
+		p.logger.Warn().Err(err).Int64("seriesId", seriesID).Msg("Failed to store credits")
+	}
+}
+
 type seasonEpisodeDiff struct {
 	added   []module.RefreshChildEntry
 	updated []module.RefreshChildEntry
@@ -194,6 +225,8 @@ func (p *metadataProvider) refreshSeasons(ctx context.Context, series *tvlib.Ser
 		return seasonEpisodeDiff{}
 	}
 
+	p.downloadSeasonArtworkAsync(tmdbID, tvdbID, seasonResults)
+
 	return p.computeEpisodeDiff(seasonMeta, existingEpisodes)
 }
 
@@ -264,6 +297,17 @@ func (p *metadataProvider) downloadArtworkAsync(match *metadata.SeriesResult) {
 	}()
 }
 
+func (p *metadataProvider) downloadSeasonArtworkAsync(tmdbID, tvdbID int, seasons []metadata.SeasonResult) {
+	if p.artworkDl == nil {
+		return
+	}
+	go func() {
+		if err := p.artworkDl.DownloadSeasonArtwork(context.Background(), tmdbID, tvdbID, seasons); err != nil {
+			p.logger.Warn().Err(err).Int("tmdbId", tmdbID).Int("tvdbId", tvdbID).Msg("Failed to download season artwork after refresh")
+		}
+	}()
+}
+
 func (p *metadataProvider) enrichSeriesDetails(ctx context.Context, match *metadata.SeriesResult) *metadata.SeriesResult {
 	if match.TmdbID > 0 {
 		if detail, err := p.metadataSvc.GetSeriesByTMDB(ctx, match.TmdbID); err == nil {
@@ -261,7 +261,7 @@ func processEpisodeFile(ctx context.Context, _ *zerolog.Logger, queries *sqlc.Qu
 
 	qualityName := parseQualityFromFilename(f.Name)
 	qualityID := sql.NullInt64{}
-	if q, ok := quality.GetQualityByName(qualityName); ok {
+	if q, ok := quality.PredefinedQualityByName(qualityName); ok {
 		qualityID = sql.NullInt64{Int64: int64(q.ID), Valid: true}
 	}
 
@@ -120,6 +120,7 @@ type Module struct {
 	db                *sql.DB
 	queries           *sqlc.Queries
 	slotsService      module.ArrImportSlotsService
+	seasonPackPercent func() int
 	logger            *zerolog.Logger
 }
 
@@ -148,6 +149,12 @@ func (m *Module) SetSlotsService(svc module.ArrImportSlotsService) {
 	m.slotsService = svc
 }
 
+// SetSeasonPackMissingPercent sets the source of the share of a season's
+// monitored episodes that must be missing before it is searched as a pack.
+func (m *Module) SetSeasonPackMissingPercent(fn func() int) {
+	m.seasonPackPercent = fn
+}
+
 // --- Descriptor delegation ---
 
 func (m *Module) ID() module.Type                  { return m.descriptor.ID() }
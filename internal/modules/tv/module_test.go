@@ -28,11 +28,58 @@ func TestTVModuleParsing(t *testing.T) {
 		{Filename: "The Boys (2019) - S05E01.mkv", ExpectedTitle: "The Boys", ExpectedYear: 2019, ExpectMatch: true, MinConfidence: 0.8},
 		{Filename: "The.Boys.2019.S05E01.1080p.WEB-DL.mkv", ExpectedTitle: "The Boys", ExpectedYear: 2019, ExpectMatch: true, MinConfidence: 0.8},
 		{Filename: "1923.S01E01.mkv", ExpectedTitle: "1923", ExpectMatch: true, MinConfidence: 0.8},
+		{Filename: "[SubsPlease] Frieren - 12 (1080p) [ABCD1234].mkv", ExpectedTitle: "Frieren", ExpectMatch: true, MinConfidence: 0.8},
 		{Filename: "The Matrix (1999).mkv", ExpectMatch: false},
 		{Filename: "random_document.pdf", ExpectMatch: false},
 	})
 }
 
+func TestTVModuleAnimeAbsoluteParsing(t *testing.T) {
+	mod := newTestModule(t)
+
+	tests := []struct {
+		filename  string
+		wantTitle string
+		wantGroup string
+		wantAbs   int
+		wantEnd   int
+	}{
+		{"[SubsPlease] Frieren - 12 (1080p) [ABCD1234].mkv", "Frieren", "SubsPlease", 12, 0},
+		{"[Erai-raws] One Piece - 1085v2 [1080p].mkv", "One Piece", "Erai-raws", 1085, 0},
+		{"Cowboy Bebop - 01-26 [BD 1080p].mkv", "Cowboy Bebop", "", 1, 26},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.filename, func(t *testing.T) {
+			_, result := mod.TryMatch(tt.filename)
+			if result == nil {
+				t.Fatal("expected a match")
+			}
+			if result.Title != tt.wantTitle {
+				t.Errorf("Title = %q, want %q", result.Title, tt.wantTitle)
+			}
+			if tt.wantGroup != "" && result.ReleaseGroup != tt.wantGroup {
+				t.Errorf("ReleaseGroup = %q, want %q", result.ReleaseGroup, tt.wantGroup)
+			}
+			extra, ok := result.Extra.(*tv.TVParseExtra)
+			if !ok {
+				t.Fatalf("Extra = %T, want *tv.TVParseExtra", result.Extra)
+			}
+			if extra.Season != 0 || extra.AbsoluteEpisode != tt.wantAbs || extra.EndAbsoluteEpisode != tt.wantEnd {
+				t.Errorf("season=%d abs=%d end=%d, want season=0 abs=%d end=%d",
+					extra.Season, extra.AbsoluteEpisode, extra.EndAbsoluteEpisode, tt.wantAbs, tt.wantEnd)
+			}
+		})
+	}
+
+	// Year-like numbers are not treated as absolute episodes.
+	if _, result := mod.TryMatch("Some Show - 2019 [1080p].mkv"); result != nil {
+		if extra, ok := result.Extra.(*tv.TVParseExtra); ok && extra.AbsoluteEpisode != 0 {
+			t.Errorf("AbsoluteEpisode = %d for a year, want 0", extra.AbsoluteEpisode)
+		}
+	}
+}
+
 func TestTVModuleNaming(t *testing.T) {
 	mod := newTestModule(t)
 	moduletest.RunNamingTest(t, mod, mod, []moduletest.NamingFixture{})
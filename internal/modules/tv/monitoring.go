@@ -15,6 +15,7 @@ var tvMonitoringPresets = []module.MonitoringPreset{
 	{ID: "first_season", Label: "First Season", Description: "Only monitor season 1 episodes"},
 	{ID: "latest_season", Label: "Latest Season", Description: "Only monitor the most recent season"},
 	{ID: "existing", Label: "Existing Episodes", Description: "Monitor only episodes that already have files"},
+	{ID: "pilot", Label: "Pilot Only", Description: "Only monitor the first episode of season 1"},
 }
 
 func (m *Module) AvailablePresets() []module.MonitoringPreset {
@@ -65,6 +66,8 @@ func toMonitorType(presetID string) (tvlib.MonitorType, error) {
 		return tvlib.MonitorTypeLatest, nil
 	case "existing":
 		return tvlib.MonitorTypeExisting, nil
+	case "pilot":
+		return tvlib.MonitorTypePilot, nil
 	default:
 		return "", fmt.Errorf("unknown TV monitoring preset: %s", presetID)
 	}
@@ -20,7 +20,7 @@ type pathGenerator struct {
 
 func (p *pathGenerator) DefaultTemplates() map[string]string {
 	return map[string]string{
-		"series-folder":         "{Series Title}",
+		"series-folder":         "{Series TitleYear}",
 		"season-folder":         "Season {season}",
 		"specials-folder":       "Specials",
 		"episode-file.standard": "{Series Title} - S{season:00}E{episode:00} - {Quality Title}",
@@ -53,6 +53,7 @@ func (p *pathGenerator) AvailableVariables(contextName string) []module.Template
 		vars = append(vars, module.QualityVariables()...)
 		vars = append(vars, module.MediaInfoVariables()...)
 		vars = append(vars, module.MetadataVariables()...)
+		vars = append(vars, module.SlotVariables()...)
 		return vars
 	}
 }
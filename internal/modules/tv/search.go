@@ -5,6 +5,7 @@ import (
 
 	"github.com/slipstream/slipstream/internal/database/sqlc"
 	"github.com/slipstream/slipstream/internal/decisioning"
+	tvlib "github.com/slipstream/slipstream/internal/library/tv"
 	"github.com/slipstream/slipstream/internal/module"
 	"github.com/slipstream/slipstream/internal/module/titleutil"
 )
@@ -22,7 +23,7 @@ func (m *Module) DefaultSearchCategories() []int {
 }
 
 // FilterRelease rejects releases that don't match the target episode/season.
-func (m *Module) FilterRelease(_ context.Context, release *module.ReleaseForFilter, item module.SearchableItem) (reject bool, reason string) {
+func (m *Module) FilterRelease(ctx context.Context, release *module.ReleaseForFilter, item module.SearchableItem) (reject bool, reason string) {
 	if !release.IsTV {
 		return true, "not TV content"
 	}
@@ -30,6 +31,10 @@ func (m *Module) FilterRelease(_ context.Context, release *module.ReleaseForFilt
 		return true, "title mismatch"
 	}
 
+	if release.Season == 0 && release.AbsoluteEpisode > 0 && item.GetMediaType() == string(module.EntityEpisode) {
+		return m.checkAbsoluteMatch(release, m.absoluteEpisodeNumber(ctx, item))
+	}
+
 	seasonNumber := m.extractSeasonNumber(item)
 	if reject, reason := m.checkSeasonMatch(release, seasonNumber); reject {
 		return true, reason
@@ -85,6 +90,21 @@ func (m *Module) checkEpisodeMatch(release *module.ReleaseForFilter, episodeNumb
 	return false, ""
 }
 
+// checkAbsoluteMatch compares an absolute-numbered (anime) release against the
+// episode's absolute number. Absolute releases never match non-anime series.
+func (m *Module) checkAbsoluteMatch(release *module.ReleaseForFilter, absoluteNumber int) (reject bool, reason string) {
+	if absoluteNumber <= 0 {
+		return true, "absolute numbering for non-anime series"
+	}
+	if release.AbsoluteEpisode == absoluteNumber {
+		return false, ""
+	}
+	if release.EndAbsolute > 0 && absoluteNumber >= release.AbsoluteEpisode && absoluteNumber <= release.EndAbsolute {
+		return false, ""
+	}
+	return true, "wrong absolute episode"
+}
+
 // TitlesMatch checks if a release title matches a series title using TV-specific matching.
 func (m *Module) TitlesMatch(releaseTitle, mediaTitle string) bool {
 	return titleutil.TVTitlesMatch(releaseTitle, mediaTitle)
@@ -108,6 +128,7 @@ func (m *Module) BuildSearchCriteria(item module.SearchableItem) module.SearchCr
 		criteria.Categories = m.Categories()
 		criteria.Season = seasonNumber
 		criteria.Episode = episodeNumber
+		criteria.AbsoluteEpisode = m.absoluteEpisodeNumber(context.Background(), item)
 	case string(module.EntitySeason):
 		// Don't set categories or season for season pack searches
 	}
@@ -120,7 +141,11 @@ func (m *Module) IsGroupSearchEligible(ctx context.Context, _ module.EntityType,
 	if forUpgrade {
 		return decisioning.IsSeasonPackUpgradeEligible(ctx, m.queries, m.logger, seriesID, seasonNumber)
 	}
-	return decisioning.IsSeasonPackEligible(ctx, m.queries, m.logger, seriesID, seasonNumber)
+	percent := decisioning.DefaultSeasonPackMissingPercent
+	if m.seasonPackPercent != nil {
+		percent = m.seasonPackPercent()
+	}
+	return decisioning.IsSeasonPackEligible(ctx, m.queries, m.logger, seriesID, seasonNumber, percent)
 }
 
 // SuppressChildSearches returns episode IDs covered by a season pack grab.
@@ -146,6 +171,32 @@ func (m *Module) SuppressChildSearches(_ module.EntityType, parentID int64, seas
 	return ids
 }
 
+// absoluteEpisodeNumber returns the absolute number of an episode item when
+// its series is anime, or 0 otherwise.
+func (m *Module) absoluteEpisodeNumber(ctx context.Context, item module.SearchableItem) int {
+	seriesID := module.ItemSeriesID(item)
+	seasonNumber := m.extractSeasonNumber(item)
+	if m.db == nil || seriesID == 0 || seasonNumber <= 0 {
+		return 0
+	}
+
+	formatType, err := m.queries.GetSeriesFormatType(ctx, seriesID)
+	if err != nil || formatType.String != tvlib.FormatTypeAnime {
+		return 0
+	}
+
+	n, err := m.queries.GetEpisodeAbsoluteNumber(ctx, sqlc.GetEpisodeAbsoluteNumberParams{
+		SeriesID:      seriesID,
+		SeasonNumber:  int64(seasonNumber),
+		EpisodeNumber: int64(m.extractEpisodeNumber(item)),
+	})
+	if err != nil {
+		m.logger.Warn().Err(err).Int64("seriesID", seriesID).Msg("Failed to resolve absolute episode number")
+		return 0
+	}
+	return int(n)
+}
+
 func (m *Module) extractSeasonNumber(item module.SearchableItem) int {
 	if v, ok := item.GetSearchParams().Extra["seasonNumber"].(int); ok {
 		return v
@@ -31,16 +31,19 @@ func (m *Module) CollectMissing(ctx context.Context) ([]module.SearchableItem, e
 			profileID = row.SeriesQualityProfileID.Int64
 		}
 
+		extra := map[string]any{
+			"seriesId":      row.SeriesID,
+			"seasonNumber":  int(row.SeasonNumber),
+			"episodeNumber": int(row.EpisodeNumber),
+		}
+		if row.SeriesRuntime.Valid {
+			extra["runtime"] = int(row.SeriesRuntime.Int64)
+		}
+
 		items = append(items, module.NewWantedItem(
 			module.TypeTV, "episode", row.ID, row.SeriesTitle,
 			externalIDs, profileID, nil,
-			module.SearchParams{
-				Extra: map[string]any{
-					"seriesId":      row.SeriesID,
-					"seasonNumber":  int(row.SeasonNumber),
-					"episodeNumber": int(row.EpisodeNumber),
-				},
-			},
+			module.SearchParams{Extra: extra},
 		))
 	}
 
@@ -77,16 +80,20 @@ func (m *Module) CollectUpgradable(ctx context.Context) ([]module.SearchableItem
 			currentQIDPtr = &v
 		}
 
+		extra := map[string]any{
+			"seriesId":      row.SeriesID,
+			"seasonNumber":  int(row.SeasonNumber),
+			"episodeNumber": int(row.EpisodeNumber),
+		}
+		if row.SeriesRuntime.Valid {
+			extra["runtime"] = int(row.SeriesRuntime.Int64)
+		}
+		extra["currentRevision"] = module.FileRevision(row.CurrentOriginalFilename.String, row.CurrentFilePath)
+
 		items = append(items, module.NewWantedItem(
 			module.TypeTV, "episode", row.ID, row.SeriesTitle,
 			externalIDs, profileID, currentQIDPtr,
-			module.SearchParams{
-				Extra: map[string]any{
-					"seriesId":      row.SeriesID,
-					"seasonNumber":  int(row.SeasonNumber),
-					"episodeNumber": int(row.EpisodeNumber),
-				},
-			},
+			module.SearchParams{Extra: extra},
 		))
 	}
 
@@ -7,6 +7,8 @@ import (
 
 	"github.com/labstack/echo/v4"
 	"github.com/rs/zerolog"
+
+	"github.com/slipstream/slipstream/internal/logger"
 )
 
 // Handlers provides HTTP handlers for Plex OAuth and server discovery
@@ -48,7 +50,7 @@ func (h *Handlers) StartAuth(c echo.Context) error {
 
 	result, err := h.oauth.StartAuth(ctx)
 	if err != nil {
-		h.logger.Error().Err(err).Msg("Failed to start OAuth")
+		logger.FromContext(c.Request().Context(), h.logger).Error().Err(err).Msg("Failed to start OAuth")
 		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to start Plex authentication")
 	}
 
@@ -80,7 +82,7 @@ func (h *Handlers) CheckAuthStatus(c echo.Context) error {
 		if errors.Is(err, ErrPINExpired) {
 			return echo.NewHTTPError(http.StatusGone, "PIN has expired")
 		}
-		h.logger.Error().Err(err).Int("pinId", pinID).Msg("Failed to check auth status")
+		logger.FromContext(c.Request().Context(), h.logger).Error().Err(err).Int("pinId", pinID).Msg("Failed to check auth status")
 		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to check authentication status")
 	}
 
@@ -110,7 +112,7 @@ func (h *Handlers) ListServers(c echo.Context) error {
 
 	servers, err := h.client.GetResources(ctx, token)
 	if err != nil {
-		h.logger.Error().Err(err).Msg("Failed to get servers")
+		logger.FromContext(c.Request().Context(), h.logger).Error().Err(err).Msg("Failed to get servers")
 		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to get Plex servers")
 	}
 
@@ -168,13 +170,13 @@ func (h *Handlers) ListSections(c echo.Context) error {
 
 	serverURL, err := h.client.FindServerURL(ctx, targetServer, token)
 	if err != nil {
-		h.logger.Error().Err(err).Str("serverId", serverID).Msg("Failed to connect to server")
+		logger.FromContext(c.Request().Context(), h.logger).Error().Err(err).Str("serverId", serverID).Msg("Failed to connect to server")
 		return echo.NewHTTPError(http.StatusServiceUnavailable, "Failed to connect to Plex server")
 	}
 
 	sections, err := h.client.GetLibrarySections(ctx, serverURL, token)
 	if err != nil {
-		h.logger.Error().Err(err).Str("serverId", serverID).Msg("Failed to get library sections")
+		logger.FromContext(c.Request().Context(), h.logger).Error().Err(err).Str("serverId", serverID).Msg("Failed to get library sections")
 		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to get library sections")
 	}
 
@@ -184,7 +186,7 @@ func (h *Handlers) ListSections(c echo.Context) error {
 func (h *Handlers) findServer(c echo.Context, serverID, token string) (*PlexServer, error) {
 	servers, err := h.client.GetResources(c.Request().Context(), token)
 	if err != nil {
-		h.logger.Error().Err(err).Msg("Failed to get servers")
+		logger.FromContext(c.Request().Context(), h.logger).Error().Err(err).Msg("Failed to get servers")
 		return nil, echo.NewHTTPError(http.StatusInternalServerError, "Failed to get Plex servers")
 	}
 
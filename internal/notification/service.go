@@ -11,6 +11,8 @@ import (
 	"github.com/rs/zerolog"
 
 	"github.com/slipstream/slipstream/internal/database/sqlc"
+	"github.com/slipstream/slipstream/internal/domain/contracts"
+	"github.com/slipstream/slipstream/internal/tags"
 )
 
 var (
@@ -31,6 +33,8 @@ type Service struct {
 	factory *Factory
 	logger  *zerolog.Logger
 	mu      sync.RWMutex
+
+	tagStore contracts.TagStore
 }
 
 // NewService creates a new notification service
@@ -57,6 +61,12 @@ func (s *Service) SetDB(db *sql.DB) {
 	s.factory.SetQueries(s.queries)
 }
 
+// SetTagStore sets the store event items' tags are read from. Tagged
+// notifications only fire for items sharing one of their tags.
+func (s *Service) SetTagStore(store contracts.TagStore) {
+	s.tagStore = store
+}
+
 // List returns all configured notifications
 func (s *Service) List(ctx context.Context) ([]Config, error) {
 	rows, err := s.queries.ListNotifications(ctx)
@@ -90,7 +100,7 @@ func (s *Service) Create(ctx context.Context, input *CreateInput) (*Config, erro
 		settings = json.RawMessage("{}")
 	}
 
-	tags, _ := json.Marshal(input.Tags)
+	tagsJSON, _ := json.Marshal(input.Tags)
 	eventToggles, _ := json.Marshal(input.EventToggles)
 	if input.EventToggles == nil {
 		eventToggles = []byte("{}")
@@ -103,7 +113,7 @@ func (s *Service) Create(ctx context.Context, input *CreateInput) (*Config, erro
 		Settings:              string(settings),
 		EventToggles:          string(eventToggles),
 		IncludeHealthWarnings: input.IncludeHealthWarnings,
-		Tags:                  string(tags),
+		Tags:                  string(tagsJSON),
 	})
 	if err != nil {
 		return nil, err
@@ -165,11 +175,11 @@ func (s *Service) buildUpdateParams(existing *Config, input *UpdateInput, id int
 		ihw = *input.IncludeHealthWarnings
 	}
 
-	tags := existing.Tags
+	tagIDs := existing.Tags
 	if input.Tags != nil {
-		tags = *input.Tags
+		tagIDs = *input.Tags
 	}
-	tagsJSON, _ := json.Marshal(tags)
+	tagsJSON, _ := json.Marshal(tagIDs)
 	togglesJSON, _ := json.Marshal(mergedToggles)
 
 	return sqlc.UpdateNotificationParams{
@@ -230,7 +240,7 @@ func (s *Service) TestConfig(ctx context.Context, input *CreateInput) (*TestResu
 
 // Dispatch sends an event to all enabled notifications that subscribe to it
 func (s *Service) Dispatch(ctx context.Context, eventType EventType, event any) {
-	configs, err := s.getEnabledConfigs(ctx, eventType)
+	configs, err := s.getEnabledConfigs(ctx, eventType, s.eventItemTags(ctx, event))
 	if err != nil {
 		s.logger.Error().Err(err).Str("event", eventType).Msg("Failed to get enabled notifications")
 		return
@@ -390,7 +400,9 @@ func (s *Service) handleNotificationResult(ctx context.Context, cfg *Config, eve
 	s.clearFailure(ctx, cfg.ID)
 }
 
-func (s *Service) getEnabledConfigs(ctx context.Context, eventType EventType) ([]Config, error) {
+// getEnabledConfigs returns the enabled notifications subscribed to eventType.
+// When itemTags is non-nil, tagged notifications sharing none of them are skipped.
+func (s *Service) getEnabledConfigs(ctx context.Context, eventType EventType, itemTags []int64) ([]Config, error) {
 	rows, err := s.queries.ListEnabledNotifications(ctx)
 	if err != nil {
 		return nil, err
@@ -404,6 +416,10 @@ func (s *Service) getEnabledConfigs(ctx context.Context, eventType EventType) ([
 			continue
 		}
 
+		if itemTags != nil && !tags.Matches(cfg.Tags, itemTags) {
+			continue
+		}
+
 		if s.isDisabled(ctx, cfg.ID) {
 			continue
 		}
@@ -414,6 +430,36 @@ func (s *Service) getEnabledConfigs(ctx context.Context, eventType EventType) ([
 	return configs, nil
 }
 
+// eventItemTags returns the tags of the library item an event is about, or nil
+// for events not tied to an item. Deleted items have already lost their tags,
+// so delete events are not filtered.
+func (s *Service) eventItemTags(ctx context.Context, event any) []int64 {
+	if s.tagStore == nil {
+		return nil
+	}
+	var movie *MediaInfo
+	var episode *EpisodeInfo
+	switch e := event.(type) {
+	case *GrabEvent:
+		movie, episode = e.Movie, e.Episode
+	case *ImportEvent:
+		movie, episode = e.Movie, e.Episode
+	case *UpgradeEvent:
+		movie, episode = e.Movie, e.Episode
+	case *MovieAddedEvent:
+		movie = &e.Movie
+	case *SeriesAddedEvent:
+		return s.tagStore.ItemTags(ctx, tags.EntitySeries, e.Series.ID, 0)
+	}
+	switch {
+	case movie != nil && movie.ID > 0:
+		return s.tagStore.ItemTags(ctx, tags.EntityMovie, movie.ID, 0)
+	case episode != nil && episode.SeriesID > 0:
+		return s.tagStore.ItemTags(ctx, tags.EntitySeries, episode.SeriesID, 0)
+	}
+	return nil
+}
+
 func (s *Service) configSubscribesToEvent(cfg *Config, eventType EventType) bool {
 	return cfg.EventToggles[eventType]
 }
@@ -475,8 +521,8 @@ func (s *Service) clearFailure(ctx context.Context, id int64) {
 }
 
 func (s *Service) rowToConfig(row *sqlc.Notification) Config {
-	var tags []int64
-	if err := json.Unmarshal([]byte(row.Tags), &tags); err != nil {
+	var tagIDs []int64
+	if err := json.Unmarshal([]byte(row.Tags), &tagIDs); err != nil {
 		s.logger.Warn().Err(err).Int64("notificationID", row.ID).Msg("Failed to unmarshal notification tags")
 	}
 
@@ -493,7 +539,7 @@ func (s *Service) rowToConfig(row *sqlc.Notification) Config {
 		Settings:              json.RawMessage(row.Settings),
 		EventToggles:          eventToggles,
 		IncludeHealthWarnings: row.IncludeHealthWarnings,
-		Tags:                  tags,
+		Tags:                  tagIDs,
 		CreatedAt:             row.CreatedAt,
 		UpdatedAt:             row.UpdatedAt,
 	}
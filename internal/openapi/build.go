@@ -0,0 +1,167 @@
+package openapi
+
+import (
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+)
+
+// Documented reports whether a route belongs in the document. The SPA
+// fallback and Echo's internal not-found routes are left out.
+func Documented(r *echo.Route) bool {
+	switch r.Method {
+	case http.MethodGet, http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete:
+	default:
+		return false
+	}
+	return r.Path != "/*"
+}
+
+// Build assembles the document for the given routes. Routes whose handler
+// is missing from the catalog are still listed, without schemas.
+func Build(routes []*echo.Route, cat *Catalog, info Info) *Document {
+	doc := &Document{
+		OpenAPI: Version,
+		Info:    info,
+		Paths:   make(map[string]PathItem),
+		Components: Components{
+			Schemas: cat.Schemas,
+			SecuritySchemes: map[string]SecurityScheme{
+				"bearerAuth": {Type: "http", Scheme: "bearer", BearerFormat: "JWT"},
+				"apiKeyAuth": {Type: "apiKey", Name: "X-Api-Key", In: "header"},
+			},
+		},
+		Security: []SecurityRequirement{{"bearerAuth": {}}, {"apiKeyAuth": {}}},
+	}
+
+	tags := make(map[string]bool)
+	for _, r := range routes {
+		if !Documented(r) {
+			continue
+		}
+		path, params := convertPath(r.Path)
+		op := buildOperation(r.Method, path, params, cat.Handlers[r.Name])
+		tags[op.Tags[0]] = true
+
+		item := doc.Paths[path]
+		if item == nil {
+			item = make(PathItem)
+			doc.Paths[path] = item
+		}
+		item[strings.ToLower(r.Method)] = op
+	}
+
+	names := make([]string, 0, len(tags))
+	for name := range tags {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		doc.Tags = append(doc.Tags, Tag{Name: name})
+	}
+	return doc
+}
+
+// convertPath turns an Echo path into an OpenAPI path template and returns
+// its parameter names.
+func convertPath(path string) (string, []string) {
+	segments := strings.Split(path, "/")
+	var params []string
+	for i, seg := range segments {
+		switch {
+		case strings.HasPrefix(seg, ":"):
+			params = append(params, seg[1:])
+			segments[i] = "{" + seg[1:] + "}"
+		case seg == "*":
+			params = append(params, "path")
+			segments[i] = "{path}"
+		}
+	}
+	return strings.Join(segments, "/"), params
+}
+
+// tagFor groups an operation by its first path segment below the API root.
+func tagFor(path string) string {
+	rest := strings.TrimPrefix(path, "/api/v1/")
+	if rest == path {
+		// Compatibility APIs such as /radarr/api/v3 are grouped by app
+		rest = strings.TrimPrefix(path, "/")
+	}
+	tag, _, _ := strings.Cut(rest, "/")
+	if tag == "" || strings.HasPrefix(tag, "{") {
+		return "root"
+	}
+	return tag
+}
+
+// operationID derives a unique ID from the method and path, e.g.
+// GET /api/v1/movies/{id} becomes getMoviesById.
+func operationID(method, path string) string {
+	var b strings.Builder
+	b.WriteString(strings.ToLower(method))
+	rest := strings.TrimPrefix(path, "/api/v1")
+	for _, seg := range strings.FieldsFunc(rest, func(r rune) bool { return r == '/' || r == '-' || r == '_' || r == '.' }) {
+		if strings.HasPrefix(seg, "{") {
+			b.WriteString("By")
+			seg = strings.Trim(seg, "{}")
+		}
+		b.WriteString(strings.ToUpper(seg[:1]) + seg[1:])
+	}
+	return b.String()
+}
+
+func buildOperation(method, path string, params []string, h *HandlerDoc) *Operation {
+	op := &Operation{
+		OperationID: operationID(method, path),
+		Tags:        []string{tagFor(path)},
+		Responses:   make(map[string]*Response),
+	}
+	for _, p := range params {
+		op.Parameters = append(op.Parameters, Parameter{Name: p, In: "path", Required: true, Schema: &Schema{Type: "string"}})
+	}
+
+	if h == nil {
+		op.Responses["default"] = &Response{Description: "Response"}
+		return op
+	}
+
+	op.Summary = h.Summary
+	for _, q := range h.Query {
+		op.Parameters = append(op.Parameters, Parameter{Name: q, In: "query", Schema: &Schema{Type: "string"}})
+	}
+	if h.Request != nil && method != http.MethodGet {
+		op.RequestBody = &RequestBody{
+			Required: true,
+			Content:  map[string]MediaType{h.Request.ContentType: {Schema: h.Request.Schema}},
+		}
+	}
+
+	for status, body := range h.Responses {
+		resp := &Response{Description: responseDescription(status)}
+		if body != nil && body.ContentType != "" {
+			resp.Content = map[string]MediaType{body.ContentType: {Schema: body.Schema}}
+		}
+		op.Responses[status] = resp
+	}
+	for _, status := range h.ErrorStatus {
+		op.Responses[strconv.Itoa(status)] = &Response{
+			Description: responseDescription(strconv.Itoa(status)),
+			Content:     map[string]MediaType{ContentJSON: {Schema: &Schema{Ref: ComponentRef(ErrorSchema)}}},
+		}
+	}
+	if len(op.Responses) == 0 {
+		op.Responses["default"] = &Response{Description: "Response"}
+	}
+	return op
+}
+
+func responseDescription(status string) string {
+	code, err := strconv.Atoi(status)
+	if err != nil || http.StatusText(code) == "" {
+		return "Response"
+	}
+	return http.StatusText(code)
+}
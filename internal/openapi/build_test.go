@@ -0,0 +1,111 @@
+package openapi
+
+import (
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+)
+
+func TestConvertPath(t *testing.T) {
+	tests := []struct {
+		path       string
+		want       string
+		wantParams []string
+	}{
+		{"/api/v1/movies", "/api/v1/movies", nil},
+		{"/api/v1/movies/:id", "/api/v1/movies/{id}", []string{"id"}},
+		{"/api/v1/series/:id/seasons/:seasonNumber", "/api/v1/series/{id}/seasons/{seasonNumber}", []string{"id", "seasonNumber"}},
+		{"/api/v1/images/*", "/api/v1/images/{path}", []string{"path"}},
+	}
+	for _, tt := range tests {
+		got, params := convertPath(tt.path)
+		if got != tt.want || !reflect.DeepEqual(params, tt.wantParams) {
+			t.Errorf("convertPath(%q) = %q, %v, want %q, %v", tt.path, got, params, tt.want, tt.wantParams)
+		}
+	}
+}
+
+func TestOperationID(t *testing.T) {
+	tests := []struct {
+		method, path, want string
+	}{
+		{http.MethodGet, "/api/v1/movies", "getMovies"},
+		{http.MethodGet, "/api/v1/movies/{id}", "getMoviesById"},
+		{http.MethodPost, "/api/v1/download-clients/{id}/test", "postDownloadClientsByIdTest"},
+		{http.MethodGet, "/radarr/api/v3/movie", "getRadarrApiV3Movie"},
+	}
+	for _, tt := range tests {
+		if got := operationID(tt.method, tt.path); got != tt.want {
+			t.Errorf("operationID(%s, %q) = %q, want %q", tt.method, tt.path, got, tt.want)
+		}
+	}
+}
+
+func TestTagFor(t *testing.T) {
+	tests := map[string]string{
+		"/api/v1/movies/{id}":  "movies",
+		"/api/v1/status":       "status",
+		"/radarr/api/v3/movie": "radarr",
+		"/health":              "health",
+	}
+	for path, want := range tests {
+		if got := tagFor(path); got != want {
+			t.Errorf("tagFor(%q) = %q, want %q", path, got, want)
+		}
+	}
+}
+
+func TestBuild(t *testing.T) {
+	cat := &Catalog{
+		Handlers: map[string]*HandlerDoc{
+			"create": {
+				Summary: "Create creates a movie.",
+				Query:   []string{"search"},
+				Request: &Body{ContentType: ContentJSON, Schema: &Schema{Ref: ComponentRef("movies.Input")}},
+				Responses: map[string]*Body{
+					"201": {ContentType: ContentJSON, Schema: &Schema{Ref: ComponentRef("movies.Movie")}},
+				},
+				ErrorStatus: []int{http.StatusBadRequest},
+			},
+		},
+		Schemas: map[string]*Schema{},
+	}
+	routes := []*echo.Route{
+		{Method: http.MethodPost, Path: "/api/v1/movies", Name: "create"},
+		{Method: http.MethodGet, Path: "/api/v1/movies/:id", Name: "unknown"},
+		{Method: http.MethodGet, Path: "/*", Name: "spa"},
+		{Method: echo.RouteNotFound, Path: "/api/v1/*", Name: "echo_route_not_found"},
+	}
+
+	doc := Build(routes, cat, Info{Title: "Test", Version: "1.0.0"})
+
+	if len(doc.Paths) != 2 {
+		t.Fatalf("len(Paths) = %d, want 2", len(doc.Paths))
+	}
+	create := doc.Paths["/api/v1/movies"]["post"]
+	if create == nil {
+		t.Fatal("POST /api/v1/movies missing")
+	}
+	if create.RequestBody == nil || create.RequestBody.Content[ContentJSON].Schema.Ref != ComponentRef("movies.Input") {
+		t.Errorf("RequestBody = %+v, want movies.Input", create.RequestBody)
+	}
+	if create.Responses["201"] == nil || create.Responses["400"] == nil {
+		t.Errorf("Responses = %v, want 201 and 400", create.Responses)
+	}
+	if ref := create.Responses["400"].Content[ContentJSON].Schema.Ref; ref != ComponentRef(ErrorSchema) {
+		t.Errorf("400 schema = %q, want %q", ref, ComponentRef(ErrorSchema))
+	}
+	if len(create.Parameters) != 1 || create.Parameters[0].In != "query" {
+		t.Errorf("Parameters = %+v, want one query parameter", create.Parameters)
+	}
+
+	get := doc.Paths["/api/v1/movies/{id}"]["get"]
+	if get == nil || get.Responses["default"] == nil {
+		t.Fatalf("GET /api/v1/movies/{id} = %+v, want a default response", get)
+	}
+	if len(get.Parameters) != 1 || get.Parameters[0].Name != "id" || !get.Parameters[0].Required {
+		t.Errorf("Parameters = %+v, want required path parameter id", get.Parameters)
+	}
+}
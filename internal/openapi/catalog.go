@@ -0,0 +1,65 @@
+package openapi
+
+import (
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"sync"
+)
+
+//go:generate go run gen_catalog.go
+
+// ErrorSchema is the component every error response refers to.
+const ErrorSchema = "apierror.Envelope"
+
+// Content types used in the catalog.
+const (
+	ContentJSON      = "application/json"
+	ContentText      = "text/plain"
+	ContentBinary    = "application/octet-stream"
+	ContentMultipart = "multipart/form-data"
+)
+
+// Catalog is the generated description of every handler, keyed by the
+// function name Echo records for a route (see echo.Route.Name).
+type Catalog struct {
+	Handlers map[string]*HandlerDoc `json:"handlers"`
+	Schemas  map[string]*Schema     `json:"schemas"`
+}
+
+// HandlerDoc describes what a handler reads and writes.
+type HandlerDoc struct {
+	Summary     string           `json:"summary,omitempty"`
+	Query       []string         `json:"query,omitempty"`
+	Request     *Body            `json:"request,omitempty"`
+	Responses   map[string]*Body `json:"responses,omitempty"`
+	ErrorStatus []int            `json:"errorStatus,omitempty"`
+}
+
+// Body is a request or response body.
+type Body struct {
+	ContentType string  `json:"contentType,omitempty"`
+	Schema      *Schema `json:"schema,omitempty"`
+}
+
+//go:embed catalog.json
+var catalogJSON []byte
+
+var (
+	catalogOnce sync.Once
+	catalog     *Catalog
+	catalogErr  error
+)
+
+// LoadCatalog returns the embedded handler catalog.
+func LoadCatalog() (*Catalog, error) {
+	catalogOnce.Do(func() {
+		var c Catalog
+		if err := json.Unmarshal(catalogJSON, &c); err != nil {
+			catalogErr = fmt.Errorf("failed to parse OpenAPI catalog: %w", err)
+			return
+		}
+		catalog = &c
+	})
+	return catalog, catalogErr
+}
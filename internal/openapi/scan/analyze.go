@@ -0,0 +1,470 @@
+package scan
+
+import (
+	"fmt"
+	"go/ast"
+	"go/constant"
+	"go/types"
+	"net/http"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/slipstream/slipstream/internal/openapi"
+)
+
+// maxDepth bounds how far the analyzer follows helper calls from a handler.
+const maxDepth = 4
+
+type generator struct {
+	module   string
+	funcs    map[string]*funcSource
+	handlers map[string]*openapi.HandlerDoc
+	schemas  map[string]*openapi.Schema
+}
+
+func newGenerator(module string) *generator {
+	return &generator{
+		module:   module,
+		funcs:    make(map[string]*funcSource),
+		handlers: make(map[string]*openapi.HandlerDoc),
+		schemas:  make(map[string]*openapi.Schema),
+	}
+}
+
+// index records every function body so handlers can be followed into
+// helpers from any handler package.
+func (g *generator) index(cp *checkedPackage) {
+	for _, f := range cp.files {
+		for _, decl := range f.Decls {
+			fd, ok := decl.(*ast.FuncDecl)
+			if !ok || fd.Body == nil {
+				continue
+			}
+			if fn, ok := cp.info.Defs[fd.Name].(*types.Func); ok {
+				g.funcs[fn.FullName()] = &funcSource{decl: fd, info: cp.info}
+			}
+		}
+	}
+}
+
+// collect documents the handlers declared in a package, including handler
+// closures, which the runtime names after their enclosing function.
+func (g *generator) collect(cp *checkedPackage) {
+	for _, f := range cp.files {
+		for _, decl := range f.Decls {
+			fd, ok := decl.(*ast.FuncDecl)
+			if !ok || fd.Body == nil {
+				continue
+			}
+			fn, ok := cp.info.Defs[fd.Name].(*types.Func)
+			if !ok {
+				continue
+			}
+			if isHandler(fn.Type().(*types.Signature)) {
+				g.handlers[handlerName(fn)] = g.analyze(fd.Body, cp.info, summary(fd.Doc))
+			}
+			g.collectClosures(fd.Body, funcName(fn)+".func", cp.info)
+		}
+	}
+}
+
+// collectInterfaces documents handler methods called through an interface,
+// such as a dependency passed to RegisterRoutes, by borrowing the entry of
+// the concrete type the handler packages pass for that interface.
+func (g *generator) collectInterfaces(checked []*checkedPackage) {
+	impls := make(map[*types.TypeName]map[*types.Named]bool)
+	for _, cp := range checked {
+		for _, f := range cp.files {
+			ast.Inspect(f, func(node ast.Node) bool {
+				call, ok := node.(*ast.CallExpr)
+				if !ok {
+					return true
+				}
+				sig, ok := cp.info.Types[call.Fun].Type.(*types.Signature)
+				if !ok {
+					return true
+				}
+				for i, arg := range call.Args {
+					if i >= sig.Params().Len() {
+						break
+					}
+					iface, ok := types.Unalias(sig.Params().At(i).Type()).(*types.Named)
+					if !ok || !types.IsInterface(iface) {
+						continue
+					}
+					if impl := concreteNamed(cp.info.Types[arg].Type); impl != nil {
+						if impls[iface.Obj()] == nil {
+							impls[iface.Obj()] = make(map[*types.Named]bool)
+						}
+						impls[iface.Obj()][impl] = true
+					}
+				}
+				return true
+			})
+		}
+	}
+
+	for obj, set := range impls {
+		if len(set) != 1 {
+			continue
+		}
+		iface := obj.Type().Underlying().(*types.Interface)
+		for impl := range set {
+			for i := 0; i < iface.NumMethods(); i++ {
+				m := iface.Method(i)
+				if !isHandler(m.Type().(*types.Signature)) {
+					continue
+				}
+				sel := types.NewMethodSet(types.NewPointer(impl)).Lookup(m.Pkg(), m.Name())
+				if sel == nil {
+					continue
+				}
+				if doc, ok := g.handlers[handlerName(sel.Obj().(*types.Func))]; ok {
+					g.handlers[handlerName(m)] = doc
+				}
+			}
+		}
+	}
+}
+
+// concreteNamed returns the named non-interface type of t or *t.
+func concreteNamed(t types.Type) *types.Named {
+	if t == nil {
+		return nil
+	}
+	if p, ok := types.Unalias(t).(*types.Pointer); ok {
+		t = p.Elem()
+	}
+	named, ok := types.Unalias(t).(*types.Named)
+	if !ok || types.IsInterface(named) {
+		return nil
+	}
+	return named
+}
+
+// collectClosures numbers function literals in source order the way the
+// compiler names them: outer.func1, outer.func2, and outer.func1.1 for a
+// literal nested in the first.
+func (g *generator) collectClosures(body ast.Node, prefix string, info *types.Info) {
+	n := 0
+	ast.Inspect(body, func(node ast.Node) bool {
+		lit, ok := node.(*ast.FuncLit)
+		if !ok {
+			return true
+		}
+		n++
+		name := prefix + strconv.Itoa(n)
+		sig, _ := info.Types[lit].Type.(*types.Signature)
+		if sig != nil && isHandler(sig) {
+			g.handlers[name] = g.analyze(lit.Body, info, "")
+		}
+		if sig == nil || !isMiddleware(sig) {
+			// The handlers a middleware wraps around next are never routes
+			g.collectClosures(lit.Body, name+".", info)
+		}
+		return false
+	})
+}
+
+// binding is what a helper's parameter is known to hold at a call site.
+type binding struct {
+	typ types.Type
+	val constant.Value
+}
+
+type analysis struct {
+	g       *generator
+	doc     *openapi.HandlerDoc
+	query   map[string]bool
+	errors  map[int]bool
+	visited map[*ast.FuncDecl]bool
+}
+
+func (g *generator) analyze(body *ast.BlockStmt, info *types.Info, summary string) *openapi.HandlerDoc {
+	a := &analysis{
+		g:       g,
+		doc:     &openapi.HandlerDoc{Summary: summary, Responses: make(map[string]*openapi.Body)},
+		query:   make(map[string]bool),
+		errors:  make(map[int]bool),
+		visited: make(map[*ast.FuncDecl]bool),
+	}
+	a.walk(body, info, nil, 0)
+
+	for q := range a.query {
+		a.doc.Query = append(a.doc.Query, q)
+	}
+	sort.Strings(a.doc.Query)
+	for status := range a.errors {
+		if _, ok := a.doc.Responses[strconv.Itoa(status)]; !ok {
+			a.doc.ErrorStatus = append(a.doc.ErrorStatus, status)
+		}
+	}
+	sort.Ints(a.doc.ErrorStatus)
+	if len(a.doc.Responses) == 0 {
+		a.doc.Responses = nil
+	}
+	return a.doc
+}
+
+func (a *analysis) walk(body ast.Node, info *types.Info, bind map[types.Object]binding, depth int) {
+	ast.Inspect(body, func(node ast.Node) bool {
+		switch n := node.(type) {
+		case *ast.FuncLit:
+			// Handler closures built inside a handler are documented separately
+			sig, ok := info.Types[n].Type.(*types.Signature)
+			return !ok || !isHandler(sig)
+		case *ast.CallExpr:
+			a.call(n, info, bind, depth)
+		}
+		return true
+	})
+}
+
+func callee(call *ast.CallExpr, info *types.Info) *types.Func {
+	var id *ast.Ident
+	switch fun := ast.Unparen(call.Fun).(type) {
+	case *ast.Ident:
+		id = fun
+	case *ast.SelectorExpr:
+		id = fun.Sel
+	case *ast.IndexExpr:
+		if sel, ok := fun.X.(*ast.SelectorExpr); ok {
+			id = sel.Sel
+		} else if ident, ok := fun.X.(*ast.Ident); ok {
+			id = ident
+		}
+	}
+	if id == nil {
+		return nil
+	}
+	fn, _ := info.Uses[id].(*types.Func)
+	return fn
+}
+
+func (a *analysis) call(call *ast.CallExpr, info *types.Info, bind map[types.Object]binding, depth int) {
+	fn := callee(call, info)
+	if fn == nil || fn.Pkg() == nil {
+		return
+	}
+	sig := fn.Type().(*types.Signature)
+	arg := func(i int) binding {
+		if i >= len(call.Args) {
+			return binding{}
+		}
+		return resolve(call.Args[i], info, bind)
+	}
+
+	if sig.Recv() != nil && isContext(sig.Recv().Type()) {
+		a.contextCall(fn.Name(), call, info, bind, arg)
+		return
+	}
+
+	switch {
+	case fn.Pkg().Path() == echoPath && fn.Name() == "NewHTTPError",
+		strings.HasSuffix(fn.Pkg().Path(), apierrorPath) && fn.Name() == "New":
+		if status, ok := intValue(arg(0).val); ok {
+			a.errors[status] = true
+		}
+		return
+	case fn.Pkg().Path() == "encoding/json" && fn.Name() == "Decode":
+		a.request(arg(0).typ, openapi.ContentJSON)
+		return
+	}
+
+	src, ok := a.g.funcs[fn.FullName()]
+	if !ok || depth >= maxDepth || a.visited[src.decl] || !takesContext(sig) {
+		return
+	}
+	calleeFn, ok := src.info.Defs[src.decl.Name].(*types.Func)
+	if !ok {
+		return
+	}
+	params := calleeFn.Type().(*types.Signature).Params()
+	next := make(map[types.Object]binding)
+	for i := 0; i < params.Len() && i < len(call.Args); i++ {
+		if b := arg(i); b.typ != nil {
+			next[params.At(i)] = b
+		}
+	}
+	a.visited[src.decl] = true
+	a.walk(src.decl.Body, src.info, next, depth+1)
+	delete(a.visited, src.decl)
+}
+
+func takesContext(sig *types.Signature) bool {
+	for i := 0; i < sig.Params().Len(); i++ {
+		if isContext(sig.Params().At(i).Type()) {
+			return true
+		}
+	}
+	return false
+}
+
+func (a *analysis) contextCall(method string, call *ast.CallExpr, info *types.Info, bind map[types.Object]binding, arg func(int) binding) {
+	status := func() string {
+		if code, ok := intValue(arg(0).val); ok {
+			return strconv.Itoa(code)
+		}
+		return strconv.Itoa(http.StatusOK)
+	}
+
+	switch method {
+	case "Bind":
+		a.request(arg(0).typ, openapi.ContentJSON)
+	case "QueryParam":
+		if name, ok := stringValue(arg(0).val); ok {
+			a.query[name] = true
+		}
+	case "FormFile", "MultipartForm":
+		a.doc.Request = &openapi.Body{ContentType: openapi.ContentMultipart, Schema: &openapi.Schema{Type: "object"}}
+	case "JSON", "JSONPretty":
+		var schema *openapi.Schema
+		if len(call.Args) > 1 {
+			schema = a.g.bodySchema(call.Args[1], info, bind)
+		}
+		a.respond(status(), openapi.ContentJSON, schema)
+	case "JSONBlob":
+		a.respond(status(), openapi.ContentJSON, &openapi.Schema{})
+	case "NoContent", "Redirect":
+		a.respond(status(), "", nil)
+	case "String":
+		a.respond(status(), openapi.ContentText, &openapi.Schema{Type: "string"})
+	case "HTML", "HTMLBlob":
+		a.respond(status(), "text/html", &openapi.Schema{Type: "string"})
+	case "XML", "XMLPretty", "XMLBlob":
+		a.respond(status(), "application/xml", &openapi.Schema{Type: "string"})
+	case "Blob", "Stream":
+		contentType, ok := stringValue(arg(1).val)
+		if !ok {
+			contentType = openapi.ContentBinary
+		}
+		a.respond(status(), contentType, &openapi.Schema{Type: "string", Format: "binary"})
+	case "File", "Attachment", "Inline":
+		a.respond(strconv.Itoa(http.StatusOK), openapi.ContentBinary, &openapi.Schema{Type: "string", Format: "binary"})
+	}
+}
+
+func (a *analysis) request(t types.Type, contentType string) {
+	if t == nil || a.doc.Request != nil {
+		return
+	}
+	if p, ok := t.(*types.Pointer); ok {
+		t = p.Elem()
+	}
+	for _, name := range queryFields(t) {
+		a.query[name] = true
+	}
+	a.doc.Request = &openapi.Body{ContentType: contentType, Schema: a.g.schema(t)}
+}
+
+// respond records a response, keeping the most specific schema when a
+// status is written in several places.
+func (a *analysis) respond(status, contentType string, schema *openapi.Schema) {
+	existing, ok := a.doc.Responses[status]
+	if ok && (existing.Schema != nil && !isAny(existing.Schema) || schema == nil) {
+		return
+	}
+	a.doc.Responses[status] = &openapi.Body{ContentType: contentType, Schema: schema}
+}
+
+func isAny(s *openapi.Schema) bool {
+	return s.Ref == "" && s.Type == ""
+}
+
+// resolve returns the type and constant value of an expression, seen
+// through the bindings of the helper it appears in.
+func resolve(expr ast.Expr, info *types.Info, bind map[types.Object]binding) binding {
+	if id, ok := ast.Unparen(expr).(*ast.Ident); ok {
+		if b, ok := bind[info.Uses[id]]; ok {
+			return b
+		}
+	}
+	tv := info.Types[expr]
+	return binding{typ: tv.Type, val: tv.Value}
+}
+
+// bodySchema returns the schema of a response body expression. Map
+// literals with constant keys, common for small ad hoc responses, become
+// objects with those properties.
+func (g *generator) bodySchema(expr ast.Expr, info *types.Info, bind map[types.Object]binding) *openapi.Schema {
+	if lit, ok := ast.Unparen(expr).(*ast.CompositeLit); ok {
+		if _, isMap := info.Types[lit].Type.Underlying().(*types.Map); isMap {
+			props := make(map[string]*openapi.Schema)
+			for _, elt := range lit.Elts {
+				kv, ok := elt.(*ast.KeyValueExpr)
+				if !ok {
+					continue
+				}
+				key, ok := stringValue(info.Types[kv.Key].Value)
+				if !ok {
+					props = nil
+					break
+				}
+				props[key] = g.bodySchema(kv.Value, info, bind)
+			}
+			if props != nil {
+				return &openapi.Schema{Type: "object", Properties: props}
+			}
+		}
+	}
+
+	b := resolve(expr, info, bind)
+	if b.typ == nil || b.typ == types.Typ[types.UntypedNil] {
+		return nil
+	}
+	return g.schema(types.Default(b.typ))
+}
+
+// queryFields returns the names of struct fields bound from query params.
+func queryFields(t types.Type) []string {
+	st, ok := t.Underlying().(*types.Struct)
+	if !ok {
+		return nil
+	}
+	var names []string
+	for i := 0; i < st.NumFields(); i++ {
+		f := st.Field(i)
+		if name, _, _ := strings.Cut(reflect.StructTag(st.Tag(i)).Get("query"), ","); name != "" {
+			names = append(names, name)
+		} else if f.Embedded() {
+			names = append(names, queryFields(f.Type())...)
+		}
+	}
+	return names
+}
+
+func intValue(v constant.Value) (int, bool) {
+	if v == nil || v.Kind() != constant.Int {
+		return 0, false
+	}
+	i, ok := constant.Int64Val(v)
+	return int(i), ok
+}
+
+func stringValue(v constant.Value) (string, bool) {
+	if v == nil || v.Kind() != constant.String {
+		return "", false
+	}
+	return constant.StringVal(v), true
+}
+
+// addErrorSchema adds the error envelope every error response refers to.
+func (g *generator) addErrorSchema(checked []*checkedPackage) error {
+	for _, cp := range checked {
+		if !strings.HasSuffix(cp.path, apierrorPath) {
+			continue
+		}
+		obj := cp.pkg.Scope().Lookup("Envelope")
+		if obj == nil {
+			break
+		}
+		ref := g.schema(obj.Type())
+		if ref.Ref != openapi.ComponentRef(openapi.ErrorSchema) {
+			return fmt.Errorf("error envelope registered as %s, want %s", ref.Ref, openapi.ErrorSchema)
+		}
+		return nil
+	}
+	return fmt.Errorf("%s.Envelope not found", apierrorPath)
+}
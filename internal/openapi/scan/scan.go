@@ -0,0 +1,271 @@
+// Package scan generates the OpenAPI handler catalog by type-checking the
+// packages that register Echo handlers. Each handler's request body, query
+// parameters and responses are read from its calls on echo.Context.
+package scan
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/importer"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/slipstream/slipstream/internal/openapi"
+)
+
+const (
+	echoPath     = "github.com/labstack/echo/v4"
+	apierrorPath = "/internal/apierror"
+)
+
+// listedPackage is the subset of `go list -json` output the scanner uses.
+type listedPackage struct {
+	ImportPath string
+	Name       string
+	Dir        string
+	GoFiles    []string
+	Export     string
+	Imports    []string
+	DepOnly    bool
+	Module     *struct{ Path string }
+}
+
+// checkedPackage is a handler package type-checked from source.
+type checkedPackage struct {
+	path  string
+	files []*ast.File
+	info  *types.Info
+	pkg   *types.Package
+}
+
+// funcSource is the declaration and type information of a function whose
+// body the analyzer may follow into.
+type funcSource struct {
+	decl *ast.FuncDecl
+	info *types.Info
+}
+
+// Generate builds the catalog for the module rooted at dir.
+func Generate(dir string) (*openapi.Catalog, error) {
+	listed, err := goList(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	exports := make(map[string]string, len(listed))
+	var targets []*listedPackage
+	for _, p := range listed {
+		exports[p.ImportPath] = p.Export
+		if !p.DepOnly && p.Module != nil && p.Name != "main" && importsEcho(p) {
+			targets = append(targets, p)
+		}
+	}
+	sort.Slice(targets, func(i, j int) bool { return targets[i].ImportPath < targets[j].ImportPath })
+
+	fset := token.NewFileSet()
+	imp := importer.ForCompiler(fset, "gc", func(path string) (io.ReadCloser, error) {
+		file, ok := exports[path]
+		if !ok || file == "" {
+			return nil, fmt.Errorf("no export data for %s", path)
+		}
+		return os.Open(file)
+	})
+
+	checked := make([]*checkedPackage, 0, len(targets))
+	for _, p := range targets {
+		cp, err := check(fset, imp, p)
+		if err != nil {
+			return nil, err
+		}
+		checked = append(checked, cp)
+	}
+
+	g := newGenerator(modulePath(targets))
+	for _, cp := range checked {
+		g.index(cp)
+	}
+	for _, cp := range checked {
+		g.collect(cp)
+	}
+	g.collectInterfaces(checked)
+	if err := g.addErrorSchema(checked); err != nil {
+		return nil, err
+	}
+	return &openapi.Catalog{Handlers: g.handlers, Schemas: g.schemas}, nil
+}
+
+// Marshal renders a catalog the way it is stored in catalog.json.
+func Marshal(c *openapi.Catalog) ([]byte, error) {
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(c); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// ModuleRoot walks up from dir to the directory holding go.mod.
+func ModuleRoot(dir string) (string, error) {
+	dir, err := filepath.Abs(dir)
+	if err != nil {
+		return "", err
+	}
+	for {
+		if _, err := os.Stat(filepath.Join(dir, "go.mod")); err == nil {
+			return dir, nil
+		}
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return "", errors.New("go.mod not found")
+		}
+		dir = parent
+	}
+}
+
+func goList(dir string) ([]*listedPackage, error) {
+	cmd := exec.Command("go", "list", "-export", "-deps", "-json", "./...")
+	cmd.Dir = dir
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	out, err := cmd.Output()
+	if err != nil {
+		return nil, fmt.Errorf("go list failed: %w: %s", err, stderr.String())
+	}
+
+	var pkgs []*listedPackage
+	dec := json.NewDecoder(bytes.NewReader(out))
+	for dec.More() {
+		var p listedPackage
+		if err := dec.Decode(&p); err != nil {
+			return nil, fmt.Errorf("failed to decode go list output: %w", err)
+		}
+		pkgs = append(pkgs, &p)
+	}
+	return pkgs, nil
+}
+
+func importsEcho(p *listedPackage) bool {
+	for _, imp := range p.Imports {
+		if imp == echoPath {
+			return true
+		}
+	}
+	return false
+}
+
+func modulePath(pkgs []*listedPackage) string {
+	for _, p := range pkgs {
+		if p.Module != nil {
+			return p.Module.Path
+		}
+	}
+	return ""
+}
+
+func check(fset *token.FileSet, imp types.Importer, p *listedPackage) (*checkedPackage, error) {
+	files := make([]*ast.File, 0, len(p.GoFiles))
+	for _, name := range p.GoFiles {
+		f, err := parser.ParseFile(fset, filepath.Join(p.Dir, name), nil, parser.ParseComments)
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, f)
+	}
+
+	info := &types.Info{
+		Types: make(map[ast.Expr]types.TypeAndValue),
+		Defs:  make(map[*ast.Ident]types.Object),
+		Uses:  make(map[*ast.Ident]types.Object),
+	}
+	conf := types.Config{Importer: imp}
+	pkg, err := conf.Check(p.ImportPath, fset, files, info)
+	if err != nil {
+		return nil, fmt.Errorf("failed to type-check %s: %w", p.ImportPath, err)
+	}
+	return &checkedPackage{path: p.ImportPath, files: files, info: info, pkg: pkg}, nil
+}
+
+// isContext reports whether t is echo.Context.
+func isContext(t types.Type) bool {
+	named, ok := types.Unalias(t).(*types.Named)
+	return ok && named.Obj().Pkg() != nil && named.Obj().Pkg().Path() == echoPath && named.Obj().Name() == "Context"
+}
+
+// isHandler reports whether sig is echo.HandlerFunc's signature.
+func isHandler(sig *types.Signature) bool {
+	if sig.Params().Len() != 1 || sig.Results().Len() != 1 {
+		return false
+	}
+	return isContext(sig.Params().At(0).Type()) && sig.Results().At(0).Type().String() == "error"
+}
+
+// isMiddleware reports whether sig is echo.MiddlewareFunc's signature.
+func isMiddleware(sig *types.Signature) bool {
+	if sig.Params().Len() != 1 || sig.Results().Len() != 1 {
+		return false
+	}
+	in, ok := sig.Params().At(0).Type().Underlying().(*types.Signature)
+	return ok && isHandler(in) && types.Identical(sig.Params().At(0).Type(), sig.Results().At(0).Type())
+}
+
+// funcName returns the name the runtime gives a function, e.g.
+// "pkg.(*Handlers).List". Closures append ".funcN" to it.
+func funcName(fn *types.Func) string {
+	sig, _ := fn.Type().(*types.Signature)
+	if sig == nil || sig.Recv() == nil {
+		return fn.Pkg().Path() + "." + fn.Name()
+	}
+	recv := sig.Recv().Type()
+	ptr := false
+	if p, ok := recv.(*types.Pointer); ok {
+		recv, ptr = p.Elem(), true
+	}
+	named := recv.(*types.Named)
+	name := named.Obj().Name()
+	if named.TypeParams().Len() > 0 {
+		name += "[...]"
+	}
+	if ptr {
+		name = "(*" + name + ")"
+	}
+	return fn.Pkg().Path() + "." + name + "." + fn.Name()
+}
+
+// handlerName returns the name Echo records for a route using fn. Method
+// values carry the runtime's -fm suffix.
+func handlerName(fn *types.Func) string {
+	if sig, _ := fn.Type().(*types.Signature); sig != nil && sig.Recv() != nil {
+		return funcName(fn) + "-fm"
+	}
+	return funcName(fn)
+}
+
+// summary returns the first line of a doc comment, skipping route lines.
+func summary(doc *ast.CommentGroup) string {
+	if doc == nil {
+		return ""
+	}
+	for _, line := range strings.Split(doc.Text(), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+		if method, _, ok := strings.Cut(line, " /"); ok && method == strings.ToUpper(method) {
+			continue
+		}
+		return line
+	}
+	return ""
+}
@@ -0,0 +1,63 @@
+package scan
+
+import (
+	"bytes"
+	"go/ast"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestCatalogUpToDate(t *testing.T) {
+	if testing.Short() {
+		t.Skip("type-checks every handler package")
+	}
+
+	root, err := ModuleRoot(".")
+	if err != nil {
+		t.Fatalf("ModuleRoot() error = %v", err)
+	}
+	cat, err := Generate(root)
+	if err != nil {
+		t.Fatalf("Generate() error = %v", err)
+	}
+	got, err := Marshal(cat)
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+
+	want, err := os.ReadFile(filepath.Join(root, "internal", "openapi", "catalog.json"))
+	if err != nil {
+		t.Fatalf("failed to read catalog.json: %v", err)
+	}
+	if !bytes.Equal(got, want) {
+		t.Error("catalog.json is stale; run make openapi")
+	}
+}
+
+func TestSummary(t *testing.T) {
+	tests := []struct {
+		name string
+		doc  string
+		want string
+	}{
+		{"first line", "List returns all movies.\nMore detail.", "List returns all movies."},
+		{"route line skipped", "GET /api/v1/movies\nList returns all movies.", "List returns all movies."},
+		{"empty", "", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var doc *ast.CommentGroup
+			if tt.doc != "" {
+				doc = &ast.CommentGroup{}
+				for _, line := range strings.Split(tt.doc, "\n") {
+					doc.List = append(doc.List, &ast.Comment{Text: "// " + line})
+				}
+			}
+			if got := summary(doc); got != tt.want {
+				t.Errorf("summary() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,260 @@
+package scan
+
+import (
+	"fmt"
+	"go/constant"
+	"go/types"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/slipstream/slipstream/internal/openapi"
+)
+
+// wellKnown maps types with custom JSON encodings to their schemas.
+var wellKnown = map[string]openapi.Schema{
+	"time.Time":                {Type: "string", Format: "date-time"},
+	"time.Duration":            {Type: "integer", Format: "int64"},
+	"encoding/json.RawMessage": {},
+	"database/sql.NullString":  {Type: "string", Nullable: true},
+	"database/sql.NullInt64":   {Type: "integer", Format: "int64", Nullable: true},
+	"database/sql.NullInt32":   {Type: "integer", Format: "int32", Nullable: true},
+	"database/sql.NullFloat64": {Type: "number", Nullable: true},
+	"database/sql.NullBool":    {Type: "boolean", Nullable: true},
+	"database/sql.NullTime":    {Type: "string", Format: "date-time", Nullable: true},
+}
+
+// schema converts a Go type to the schema encoding/json would produce for
+// it. Named structs become components referenced by $ref.
+func (g *generator) schema(t types.Type) *openapi.Schema {
+	switch t := types.Unalias(t).(type) {
+	case *types.Pointer:
+		return g.schema(t.Elem())
+	case *types.Named:
+		return g.namedSchema(t)
+	case *types.Basic:
+		return basicSchema(t)
+	case *types.Slice:
+		return g.listSchema(t.Elem())
+	case *types.Array:
+		return g.listSchema(t.Elem())
+	case *types.Map:
+		return &openapi.Schema{Type: "object", AdditionalProperties: g.schema(t.Elem())}
+	case *types.Struct:
+		return g.structSchema(t)
+	default:
+		// Interfaces, type parameters and anything encoding/json cannot
+		// describe statically accept any value
+		return &openapi.Schema{}
+	}
+}
+
+func (g *generator) listSchema(elem types.Type) *openapi.Schema {
+	if b, ok := types.Unalias(elem).(*types.Basic); ok && b.Kind() == types.Byte {
+		return &openapi.Schema{Type: "string", Format: "byte"}
+	}
+	return &openapi.Schema{Type: "array", Items: g.schema(elem)}
+}
+
+func (g *generator) namedSchema(t *types.Named) *openapi.Schema {
+	obj := t.Obj()
+	if obj.Pkg() == nil {
+		// The predeclared error type encodes as an object without fields
+		return &openapi.Schema{Type: "object"}
+	}
+	if known, ok := wellKnown[obj.Pkg().Path()+"."+obj.Name()]; ok {
+		return &known
+	}
+	if implements(t, "MarshalJSON") {
+		return &openapi.Schema{}
+	}
+	if implements(t, "MarshalText") {
+		return &openapi.Schema{Type: "string"}
+	}
+
+	switch u := t.Underlying().(type) {
+	case *types.Struct:
+		name := g.componentName(t)
+		if _, ok := g.schemas[name]; !ok {
+			// Reserve the name first so recursive types terminate
+			g.schemas[name] = &openapi.Schema{Type: "object"}
+			g.schemas[name] = g.structSchema(u)
+		}
+		return &openapi.Schema{Ref: openapi.ComponentRef(name)}
+	case *types.Basic:
+		s := basicSchema(u)
+		s.Enum = enumValues(t)
+		return s
+	default:
+		return g.schema(u)
+	}
+}
+
+// implements reports whether t or *t has the named method.
+func implements(t *types.Named, method string) bool {
+	for _, typ := range []types.Type{t, types.NewPointer(t)} {
+		if sel := types.NewMethodSet(typ).Lookup(t.Obj().Pkg(), method); sel != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// componentName names a component after its package path below the module,
+// e.g. library.movies.Movie, so equally named packages do not collide.
+func (g *generator) componentName(t *types.Named) string {
+	obj := t.Obj()
+	pkg := obj.Pkg().Name()
+	if rel, ok := strings.CutPrefix(obj.Pkg().Path(), g.module+"/internal/"); ok {
+		pkg = strings.ReplaceAll(rel, "/", ".")
+	}
+	name := pkg + "." + obj.Name()
+	if args := t.TypeArgs(); args != nil {
+		for i := 0; i < args.Len(); i++ {
+			name += "_" + typeArgName(args.At(i))
+		}
+	}
+	return name
+}
+
+func typeArgName(t types.Type) string {
+	switch t := types.Unalias(t).(type) {
+	case *types.Named:
+		return t.Obj().Name()
+	case *types.Basic:
+		return t.Name()
+	case *types.Pointer:
+		return typeArgName(t.Elem())
+	case *types.Slice:
+		return typeArgName(t.Elem()) + "List"
+	default:
+		return "Any"
+	}
+}
+
+func basicSchema(t *types.Basic) *openapi.Schema {
+	info := t.Info()
+	switch {
+	case info&types.IsBoolean != 0:
+		return &openapi.Schema{Type: "boolean"}
+	case info&types.IsInteger != 0:
+		switch t.Kind() {
+		case types.Int64, types.Uint64, types.Int, types.Uint:
+			return &openapi.Schema{Type: "integer", Format: "int64"}
+		default:
+			return &openapi.Schema{Type: "integer", Format: "int32"}
+		}
+	case info&types.IsFloat != 0:
+		return &openapi.Schema{Type: "number", Format: "double"}
+	case info&types.IsString != 0:
+		return &openapi.Schema{Type: "string"}
+	default:
+		return &openapi.Schema{}
+	}
+}
+
+// enumValues lists the constants of a named basic type declared in its
+// package, which is how the codebase spells enums.
+func enumValues(t *types.Named) []any {
+	scope := t.Obj().Pkg().Scope()
+	var values []any
+	for _, name := range scope.Names() {
+		c, ok := scope.Lookup(name).(*types.Const)
+		if !ok || !types.Identical(c.Type(), t) {
+			continue
+		}
+		switch c.Val().Kind() {
+		case constant.String:
+			values = append(values, constant.StringVal(c.Val()))
+		case constant.Int:
+			if v, ok := constant.Int64Val(c.Val()); ok {
+				values = append(values, v)
+			}
+		}
+	}
+	sort.Slice(values, func(i, j int) bool {
+		a, aInt := values[i].(int64)
+		b, bInt := values[j].(int64)
+		if aInt && bInt {
+			return a < b
+		}
+		return fmt.Sprint(values[i]) < fmt.Sprint(values[j])
+	})
+	return dedupe(values)
+}
+
+func dedupe(values []any) []any {
+	out := values[:0]
+	for i, v := range values {
+		if i == 0 || v != values[i-1] {
+			out = append(out, v)
+		}
+	}
+	if len(out) == 0 {
+		return nil
+	}
+	return out
+}
+
+// structSchema follows encoding/json's field rules: exported fields only,
+// json tag names and "-", ",string", and embedded structs promoted inline.
+func (g *generator) structSchema(st *types.Struct) *openapi.Schema {
+	s := &openapi.Schema{Type: "object", Properties: make(map[string]*openapi.Schema)}
+	g.addFields(s, st)
+	if len(s.Properties) == 0 {
+		s.Properties = nil
+	}
+	return s
+}
+
+func (g *generator) addFields(s *openapi.Schema, st *types.Struct) {
+	var embedded []*types.Struct
+	for i := 0; i < st.NumFields(); i++ {
+		f := st.Field(i)
+		tag := reflect.StructTag(st.Tag(i)).Get("json")
+		name, opts, _ := strings.Cut(tag, ",")
+		if name == "-" && opts == "" {
+			continue
+		}
+
+		if f.Embedded() && name == "" {
+			ft := f.Type()
+			if p, ok := ft.(*types.Pointer); ok {
+				ft = p.Elem()
+			}
+			if inner, ok := ft.Underlying().(*types.Struct); ok {
+				embedded = append(embedded, inner)
+				continue
+			}
+		}
+		if !f.Exported() {
+			continue
+		}
+		if name == "" {
+			name = f.Name()
+		}
+		if _, ok := f.Type().Underlying().(*types.Signature); ok {
+			continue
+		}
+		if _, ok := f.Type().Underlying().(*types.Chan); ok {
+			continue
+		}
+
+		if strings.Contains(","+opts+",", ",string,") {
+			s.Properties[name] = &openapi.Schema{Type: "string"}
+		} else {
+			s.Properties[name] = g.schema(f.Type())
+		}
+	}
+
+	// Promoted fields lose to fields declared on the outer struct
+	for _, inner := range embedded {
+		promoted := &openapi.Schema{Properties: make(map[string]*openapi.Schema)}
+		g.addFields(promoted, inner)
+		for name, prop := range promoted.Properties {
+			if _, ok := s.Properties[name]; !ok {
+				s.Properties[name] = prop
+			}
+		}
+	}
+}
@@ -0,0 +1,124 @@
+// Package pagination parses the page and sort parameters shared by list
+// endpoints and shapes their paged responses.
+package pagination
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+const (
+	// DefaultPageSize is used when a page is requested without a size.
+	DefaultPageSize = 50
+	// MaxPageSize caps a single page.
+	MaxPageSize = 500
+)
+
+// ErrInvalid is returned for malformed page or sort parameters.
+var ErrInvalid = errors.New("invalid pagination")
+
+// Params selects one page of a list. Pages are numbered from 1.
+type Params struct {
+	Page     int
+	PageSize int
+}
+
+// FromQuery reads page and pageSize. ok is false when neither is present,
+// which list endpoints treat as a request for the whole list.
+func FromQuery(q url.Values) (p Params, ok bool, err error) {
+	p = Params{Page: 1, PageSize: DefaultPageSize}
+	if v := q.Get("page"); v != "" {
+		ok = true
+		if p.Page, err = strconv.Atoi(v); err != nil || p.Page < 1 {
+			return p, ok, fmt.Errorf("%w: page must be a positive integer", ErrInvalid)
+		}
+	}
+	if v := q.Get("pageSize"); v != "" {
+		ok = true
+		if p.PageSize, err = strconv.Atoi(v); err != nil || p.PageSize < 1 {
+			return p, ok, fmt.Errorf("%w: pageSize must be a positive integer", ErrInvalid)
+		}
+		p.PageSize = min(p.PageSize, MaxPageSize)
+	}
+	return p, ok, nil
+}
+
+// Without returns a copy of q minus the page parameters, for handing the rest
+// to a filter parser that rejects unknown keys.
+func Without(q url.Values) url.Values {
+	rest := make(url.Values, len(q))
+	for k, v := range q {
+		if k != "page" && k != "pageSize" {
+			rest[k] = v
+		}
+	}
+	return rest
+}
+
+// Limit returns the SQL LIMIT for the page.
+func (p Params) Limit() int64 {
+	return int64(p.PageSize)
+}
+
+// Offset returns the SQL OFFSET for the page.
+func (p Params) Offset() int64 {
+	return int64(p.Page-1) * int64(p.PageSize)
+}
+
+// Page is one page of a list along with the size of the whole list.
+type Page[T any] struct {
+	Items      []T   `json:"items"`
+	Page       int   `json:"page"`
+	PageSize   int   `json:"pageSize"`
+	TotalCount int64 `json:"totalCount"`
+	TotalPages int   `json:"totalPages"`
+}
+
+// NewPage wraps items fetched for p.
+func NewPage[T any](items []T, p Params, totalCount int64) *Page[T] {
+	return &Page[T]{
+		Items:      items,
+		Page:       p.Page,
+		PageSize:   p.PageSize,
+		TotalCount: totalCount,
+		TotalPages: TotalPages(totalCount, p.PageSize),
+	}
+}
+
+// TotalPages returns how many pages of pageSize hold totalCount items.
+func TotalPages(totalCount int64, pageSize int) int {
+	if pageSize < 1 {
+		return 0
+	}
+	return int((totalCount + int64(pageSize) - 1) / int64(pageSize))
+}
+
+// Sort orders a list by one of its sortable keys.
+type Sort struct {
+	Key        string
+	Descending bool
+}
+
+// ParseSort validates a sort key against the keys a list supports and an
+// order of asc or desc. An empty key selects the first allowed key. Errors
+// carry no sentinel so callers can wrap them in their own filter error.
+func ParseSort(key, order string, allowed ...string) (Sort, error) {
+	s := Sort{Key: key}
+	if s.Key == "" {
+		s.Key = allowed[0]
+	} else if !slices.Contains(allowed, s.Key) {
+		return s, fmt.Errorf("sort must be one of %s", strings.Join(allowed, ", "))
+	}
+	switch strings.ToLower(order) {
+	case "", "asc":
+	case "desc":
+		s.Descending = true
+	default:
+		return s, errors.New("order must be asc or desc")
+	}
+	return s, nil
+}
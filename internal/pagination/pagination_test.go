@@ -0,0 +1,81 @@
+package pagination
+
+import (
+	"errors"
+	"net/url"
+	"testing"
+)
+
+func TestFromQuery(t *testing.T) {
+	tests := []struct {
+		query   string
+		want    Params
+		ok      bool
+		wantErr bool
+	}{
+		{query: "", want: Params{Page: 1, PageSize: DefaultPageSize}},
+		{query: "page=3", want: Params{Page: 3, PageSize: DefaultPageSize}, ok: true},
+		{query: "pageSize=20&monitored=true", want: Params{Page: 1, PageSize: 20}, ok: true},
+		{query: "pageSize=100000", want: Params{Page: 1, PageSize: MaxPageSize}, ok: true},
+		{query: "page=0", ok: true, wantErr: true},
+		{query: "pageSize=ten", ok: true, wantErr: true},
+	}
+	for _, tt := range tests {
+		q, _ := url.ParseQuery(tt.query)
+		got, ok, err := FromQuery(q)
+		if tt.wantErr {
+			if !errors.Is(err, ErrInvalid) {
+				t.Errorf("FromQuery(%q) error = %v, want ErrInvalid", tt.query, err)
+			}
+			continue
+		}
+		if err != nil || ok != tt.ok || got != tt.want {
+			t.Errorf("FromQuery(%q) = %+v, %v, %v; want %+v, %v", tt.query, got, ok, err, tt.want, tt.ok)
+		}
+	}
+}
+
+func TestWithout(t *testing.T) {
+	q := url.Values{"page": {"2"}, "pageSize": {"10"}, "tag": {"4"}}
+	rest := Without(q)
+	if len(rest) != 1 || rest.Get("tag") != "4" {
+		t.Errorf("Without() = %v, want only tag", rest)
+	}
+	if q.Get("page") != "2" {
+		t.Error("Without() modified its argument")
+	}
+}
+
+func TestParamsOffset(t *testing.T) {
+	p := Params{Page: 3, PageSize: 25}
+	if p.Limit() != 25 || p.Offset() != 50 {
+		t.Errorf("Limit(), Offset() = %d, %d; want 25, 50", p.Limit(), p.Offset())
+	}
+}
+
+func TestNewPage(t *testing.T) {
+	page := NewPage([]int{1, 2}, Params{Page: 1, PageSize: 2}, 5)
+	if page.TotalPages != 3 {
+		t.Errorf("TotalPages = %d, want 3", page.TotalPages)
+	}
+	if TotalPages(0, 50) != 0 || TotalPages(50, 50) != 1 {
+		t.Error("TotalPages() miscounted an empty or exactly full list")
+	}
+}
+
+func TestParseSort(t *testing.T) {
+	got, err := ParseSort("", "", "title", "year")
+	if err != nil || got != (Sort{Key: "title"}) {
+		t.Errorf("ParseSort() default = %+v, %v; want title ascending", got, err)
+	}
+	got, err = ParseSort("year", "DESC", "title", "year")
+	if err != nil || got != (Sort{Key: "year", Descending: true}) {
+		t.Errorf("ParseSort(year, DESC) = %+v, %v; want year descending", got, err)
+	}
+	if _, err := ParseSort("size", "", "title", "year"); err == nil {
+		t.Error("ParseSort() accepted an unknown key")
+	}
+	if _, err := ParseSort("title", "up", "title", "year"); err == nil {
+		t.Error("ParseSort() accepted an unknown order")
+	}
+}
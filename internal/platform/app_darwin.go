@@ -43,10 +43,11 @@ type macApp struct {
 	running     bool
 	mu          sync.Mutex
 	stopOnce    sync.Once
+	done        chan struct{}
 }
 
 func NewApp(cfg AppConfig) App {
-	return &macApp{config: cfg}
+	return &macApp{config: cfg, done: make(chan struct{})}
 }
 
 func (a *macApp) Run() error {
@@ -54,7 +55,8 @@ func (a *macApp) Run() error {
 		a.mu.Lock()
 		a.running = true
 		a.mu.Unlock()
-		select {}
+		<-a.done
+		return nil
 	}
 
 	objc.WithAutoreleasePool(func() {
@@ -136,12 +138,13 @@ func (a *macApp) OpenBrowser(url string) error {
 
 func (a *macApp) Stop() {
 	a.stopOnce.Do(func() {
+		close(a.done)
 		a.mu.Lock()
 		wasRunning := a.running
 		a.running = false
 		a.mu.Unlock()
 
-		if wasRunning {
+		if wasRunning && !a.config.NoTray {
 			// Dispatch terminate to the main thread via performSelectorOnMainThread
 			// This is required because NSApplication.terminate: must be called from the main thread
 			objc.WithAutoreleasePool(func() {
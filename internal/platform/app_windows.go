@@ -23,10 +23,11 @@ type windowsApp struct {
 	running    bool
 	mu         sync.Mutex
 	stopOnce   sync.Once
+	done       chan struct{}
 }
 
 func NewApp(cfg AppConfig) App {
-	return &windowsApp{config: cfg}
+	return &windowsApp{config: cfg, done: make(chan struct{})}
 }
 
 func (a *windowsApp) Run() error {
@@ -34,7 +35,8 @@ func (a *windowsApp) Run() error {
 		a.mu.Lock()
 		a.running = true
 		a.mu.Unlock()
-		select {}
+		<-a.done
+		return nil
 	}
 
 	var err error
@@ -103,6 +105,7 @@ func (a *windowsApp) OpenBrowser(url string) error {
 
 func (a *windowsApp) Stop() {
 	a.stopOnce.Do(func() {
+		close(a.done)
 		a.mu.Lock()
 		if a.running {
 			if a.notifyIcon != nil {
@@ -0,0 +1,100 @@
+package platform
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"runtime"
+
+	"github.com/slipstream/slipstream/internal/config"
+)
+
+const (
+	// ServiceName identifies the background service to the OS service manager.
+	ServiceName        = "SlipStream"
+	serviceDisplayName = "SlipStream"
+	serviceDescription = "SlipStream media management server"
+	// serviceFlag is passed to the managed binary so it runs headless under
+	// the service manager.
+	serviceFlag = "--service"
+)
+
+var ErrServiceUnsupported = errors.New("background service install is not supported on this platform")
+
+// ServiceHost connects the app to the OS service manager when running with
+// --service.
+type ServiceHost interface {
+	// Stopped is closed when the service manager asks the app to stop.
+	Stopped() <-chan struct{}
+	// Exit reports the app's exit code to the service manager. A non-zero
+	// code makes the manager relaunch the app, which is how restarts and
+	// in-place updates take effect.
+	Exit(code uint32)
+}
+
+// serviceArgs returns the arguments the service manager starts the managed
+// binary with.
+func serviceArgs(configPath string) []string {
+	args := []string{serviceFlag}
+	if configPath != "" {
+		args = append(args, "--config", configPath)
+	}
+	return args
+}
+
+// installManagedBinary copies the running binary into the user-writable
+// managed bin directory, the same layout the Linux package stub uses, so the
+// self-updater can replace it in place. Returns the installed path.
+func installManagedBinary() (string, error) {
+	binDir := config.ManagedBinDir()
+	if binDir == "" {
+		return "", ErrServiceUnsupported
+	}
+
+	exe, err := os.Executable()
+	if err != nil {
+		return "", fmt.Errorf("get executable path: %w", err)
+	}
+	exe, err = filepath.EvalSymlinks(exe)
+	if err != nil {
+		return "", fmt.Errorf("resolve executable path: %w", err)
+	}
+
+	name := "slipstream"
+	if runtime.GOOS == "windows" {
+		name += ".exe"
+	}
+	target := filepath.Join(binDir, name)
+	if exe == target {
+		return target, nil
+	}
+
+	if err := os.MkdirAll(binDir, 0o750); err != nil {
+		return "", fmt.Errorf("create bin directory: %w", err)
+	}
+	if err := copyExecutable(exe, target); err != nil {
+		return "", fmt.Errorf("copy binary: %w", err)
+	}
+	return target, nil
+}
+
+func copyExecutable(src, dst string) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+
+	//nolint:gosec // Executable must be group-executable
+	out, err := os.OpenFile(dst, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0o750)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(out, in); err != nil {
+		out.Close()
+		return err
+	}
+	return out.Close()
+}
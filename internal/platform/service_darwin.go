@@ -0,0 +1,78 @@
+//go:build darwin
+
+package platform
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+)
+
+func launchdServicePath() (string, error) {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("get home dir: %w", err)
+	}
+	return filepath.Join(home, "Library", "LaunchAgents", launchdServiceLabel+".plist"), nil
+}
+
+// InstallService copies the binary into the managed bin directory and loads
+// a launchd agent that keeps it running headless.
+func InstallService(configPath string) error {
+	binPath, err := installManagedBinary()
+	if err != nil {
+		return err
+	}
+
+	plistPath, err := launchdServicePath()
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(plistPath), 0o750); err != nil {
+		return fmt.Errorf("create LaunchAgents dir: %w", err)
+	}
+
+	home, _ := os.UserHomeDir()
+	logDir := filepath.Join(home, "Library", "Logs", "SlipStream")
+	if err := os.MkdirAll(logDir, 0o750); err != nil {
+		return fmt.Errorf("create log dir: %w", err)
+	}
+
+	plist, err := launchdPlist(binPath, serviceArgs(configPath), filepath.Join(logDir, "service.log"))
+	if err != nil {
+		return err
+	}
+
+	// Reload cleanly when reinstalling over an existing agent
+	_ = exec.CommandContext(context.Background(), "launchctl", "unload", plistPath).Run()
+
+	if err := os.WriteFile(plistPath, []byte(plist), 0o600); err != nil {
+		return fmt.Errorf("write plist: %w", err)
+	}
+
+	if err := exec.CommandContext(context.Background(), "launchctl", "load", "-w", plistPath).Run(); err != nil {
+		return fmt.Errorf("launchctl load: %w", err)
+	}
+	return nil
+}
+
+// UninstallService unloads and removes the launchd agent. The managed binary
+// and data are left in place.
+func UninstallService() error {
+	plistPath, err := launchdServicePath()
+	if err != nil {
+		return err
+	}
+	if _, err := os.Stat(plistPath); os.IsNotExist(err) {
+		return nil
+	}
+
+	_ = exec.CommandContext(context.Background(), "launchctl", "unload", "-w", plistPath).Run()
+
+	if err := os.Remove(plistPath); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("remove plist: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,16 @@
+//go:build !windows
+
+package platform
+
+// StartServiceHost returns a host for launchd and systemd, which manage the
+// process directly: they stop it with SIGTERM and relaunch it on exit
+// (KeepAlive / Restart=always), so there is nothing to report back.
+func StartServiceHost() (ServiceHost, error) {
+	return signalHost{}, nil
+}
+
+type signalHost struct{}
+
+func (signalHost) Stopped() <-chan struct{} { return nil }
+
+func (signalHost) Exit(uint32) {}
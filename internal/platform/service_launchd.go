@@ -0,0 +1,68 @@
+package platform
+
+import (
+	"bytes"
+	"fmt"
+	"text/template"
+)
+
+const (
+	launchdServiceLabel = "com.slipstream.server"
+	// KeepAlive relaunches the agent whenever it exits, which is how
+	// restarts and in-place updates take effect under launchd.
+	launchdServicePlist = `<?xml version="1.0" encoding="UTF-8"?>
+<!DOCTYPE plist PUBLIC "-//Apple//DTD PLIST 1.0//EN" "http://www.apple.com/DTDs/PropertyList-1.0.dtd">
+<plist version="1.0">
+<dict>
+    <key>Label</key>
+    <string>{{.Label | xml}}</string>
+    <key>ProgramArguments</key>
+    <array>
+        <string>{{.BinPath | xml}}</string>
+{{- range .Args}}
+        <string>{{. | xml}}</string>
+{{- end}}
+    </array>
+    <key>RunAtLoad</key>
+    <true/>
+    <key>KeepAlive</key>
+    <true/>
+    <key>ThrottleInterval</key>
+    <integer>10</integer>
+    <key>StandardOutPath</key>
+    <string>{{.LogPath | xml}}</string>
+    <key>StandardErrorPath</key>
+    <string>{{.LogPath | xml}}</string>
+</dict>
+</plist>
+`
+)
+
+// launchdPlist renders the launch agent that runs the managed binary
+// headless under launchd.
+func launchdPlist(binPath string, args []string, logPath string) (string, error) {
+	tmpl, err := template.New("plist").
+		Funcs(template.FuncMap{"xml": template.HTMLEscapeString}).
+		Parse(launchdServicePlist)
+	if err != nil {
+		return "", fmt.Errorf("parse plist template: %w", err)
+	}
+
+	data := struct {
+		Label   string
+		BinPath string
+		Args    []string
+		LogPath string
+	}{
+		Label:   launchdServiceLabel,
+		BinPath: binPath,
+		Args:    args,
+		LogPath: logPath,
+	}
+
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, data); err != nil {
+		return "", fmt.Errorf("write plist: %w", err)
+	}
+	return buf.String(), nil
+}
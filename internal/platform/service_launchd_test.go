@@ -0,0 +1,33 @@
+package platform
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestLaunchdPlist(t *testing.T) {
+	plist, err := launchdPlist(
+		"/Users/a&b/Library/Application Support/SlipStream/bin/slipstream",
+		serviceArgs("/Users/a&b/config.yaml"),
+		"/Users/a&b/Library/Logs/SlipStream/service.log",
+	)
+	if err != nil {
+		t.Fatalf("launchdPlist error = %v", err)
+	}
+
+	for _, want := range []string{
+		"<string>" + launchdServiceLabel + "</string>",
+		"<string>/Users/a&amp;b/Library/Application Support/SlipStream/bin/slipstream</string>",
+		"<string>--service</string>",
+		"<string>--config</string>",
+		"<string>/Users/a&amp;b/config.yaml</string>",
+		"<key>KeepAlive</key>\n    <true/>",
+	} {
+		if !strings.Contains(plist, want) {
+			t.Errorf("plist missing %q:\n%s", want, plist)
+		}
+	}
+	if strings.Contains(plist, "a&b") {
+		t.Error("plist contains unescaped ampersand")
+	}
+}
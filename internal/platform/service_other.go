@@ -0,0 +1,13 @@
+//go:build !windows && !darwin
+
+package platform
+
+// InstallService is not supported here; Linux packages ship a systemd unit
+// (slipstream.service) that runs the stub-managed binary instead.
+func InstallService(_ string) error {
+	return ErrServiceUnsupported
+}
+
+func UninstallService() error {
+	return ErrServiceUnsupported
+}
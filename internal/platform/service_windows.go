@@ -0,0 +1,180 @@
+//go:build windows
+
+package platform
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"golang.org/x/sys/windows/registry"
+	"golang.org/x/sys/windows/svc"
+	"golang.org/x/sys/windows/svc/mgr"
+)
+
+const serviceRestartDelay = 5 * time.Second
+
+// InstallService copies the binary into the managed bin directory and
+// registers it as an auto-start Windows service. Requires an elevated prompt.
+//
+// The service runs as LocalSystem but is pointed at the installing user's
+// %LOCALAPPDATA%, so it shares their data, logs and managed bin directory
+// with the tray app and the self-updater keeps working.
+func InstallService(configPath string) error {
+	binPath, err := installManagedBinary()
+	if err != nil {
+		return err
+	}
+
+	m, err := mgr.Connect()
+	if err != nil {
+		return fmt.Errorf("connect to service manager (run as administrator): %w", err)
+	}
+	defer m.Disconnect()
+
+	if existing, err := m.OpenService(ServiceName); err == nil {
+		existing.Close()
+		return fmt.Errorf("service %s is already installed", ServiceName)
+	}
+
+	s, err := m.CreateService(ServiceName, binPath, mgr.Config{
+		DisplayName:      serviceDisplayName,
+		Description:      serviceDescription,
+		StartType:        mgr.StartAutomatic,
+		DelayedAutoStart: true,
+	}, serviceArgs(configPath)...)
+	if err != nil {
+		return fmt.Errorf("create service: %w", err)
+	}
+	defer s.Close()
+
+	// Relaunch on any non-zero exit, which is how restarts and in-place
+	// updates take effect under the service manager.
+	actions := []mgr.RecoveryAction{
+		{Type: mgr.ServiceRestart, Delay: serviceRestartDelay},
+		{Type: mgr.ServiceRestart, Delay: serviceRestartDelay},
+		{Type: mgr.ServiceRestart, Delay: serviceRestartDelay},
+	}
+	if err := s.SetRecoveryActions(actions, uint32((24 * time.Hour).Seconds())); err != nil {
+		return fmt.Errorf("set recovery actions: %w", err)
+	}
+	if err := s.SetRecoveryActionsOnNonCrashFailures(true); err != nil {
+		return fmt.Errorf("set recovery on exit codes: %w", err)
+	}
+
+	if err := setServiceEnvironment(); err != nil {
+		return err
+	}
+
+	if err := s.Start(); err != nil {
+		return fmt.Errorf("start service: %w", err)
+	}
+	return nil
+}
+
+func setServiceEnvironment() error {
+	localAppData := os.Getenv("LOCALAPPDATA")
+	if localAppData == "" {
+		return nil
+	}
+	key, err := registry.OpenKey(registry.LOCAL_MACHINE, `SYSTEM\CurrentControlSet\Services\`+ServiceName, registry.SET_VALUE)
+	if err != nil {
+		return fmt.Errorf("open service registry key: %w", err)
+	}
+	defer key.Close()
+
+	if err := key.SetStringsValue("Environment", []string{"LOCALAPPDATA=" + localAppData}); err != nil {
+		return fmt.Errorf("set service environment: %w", err)
+	}
+	return nil
+}
+
+// UninstallService stops and removes the Windows service. The managed binary
+// and data are left in place.
+func UninstallService() error {
+	m, err := mgr.Connect()
+	if err != nil {
+		return fmt.Errorf("connect to service manager (run as administrator): %w", err)
+	}
+	defer m.Disconnect()
+
+	s, err := m.OpenService(ServiceName)
+	if err != nil {
+		return nil
+	}
+	defer s.Close()
+
+	_, _ = s.Control(svc.Stop)
+
+	if err := s.Delete(); err != nil {
+		return fmt.Errorf("delete service: %w", err)
+	}
+	return nil
+}
+
+// StartServiceHost hands the process to the Windows service control manager.
+// Outside the SCM (e.g. --service run from a console) it returns a host that
+// never reports a stop.
+func StartServiceHost() (ServiceHost, error) {
+	isService, err := svc.IsWindowsService()
+	if err != nil {
+		return nil, fmt.Errorf("detect service mode: %w", err)
+	}
+	if !isService {
+		return &scmHost{stopped: make(chan struct{})}, nil
+	}
+
+	host := &scmHost{
+		stopped:  make(chan struct{}),
+		exitCode: make(chan uint32, 1),
+		finished: make(chan struct{}),
+	}
+	go func() {
+		_ = svc.Run(ServiceName, host)
+		close(host.finished)
+	}()
+	return host, nil
+}
+
+type scmHost struct {
+	stopped  chan struct{}
+	exitCode chan uint32
+	finished chan struct{}
+}
+
+func (h *scmHost) Stopped() <-chan struct{} {
+	return h.stopped
+}
+
+func (h *scmHost) Exit(code uint32) {
+	if h.exitCode == nil {
+		return
+	}
+	h.exitCode <- code
+	<-h.finished
+}
+
+// Execute implements svc.Handler.
+func (h *scmHost) Execute(_ []string, requests <-chan svc.ChangeRequest, status chan<- svc.Status) (bool, uint32) {
+	status <- svc.Status{State: svc.Running, Accepts: svc.AcceptStop | svc.AcceptShutdown}
+
+	stopRequested := false
+	for {
+		select {
+		case req := <-requests:
+			switch req.Cmd {
+			case svc.Interrogate:
+				status <- req.CurrentStatus
+			case svc.Stop, svc.Shutdown:
+				if !stopRequested {
+					stopRequested = true
+					status <- svc.Status{State: svc.StopPending}
+					close(h.stopped)
+				}
+			}
+		case code := <-h.exitCode:
+			status <- svc.Status{State: svc.StopPending}
+			return false, code
+		}
+	}
+}
@@ -210,6 +210,9 @@ func (h *RequestsHandlers) Approve(c echo.Context) error {
 		if errors.Is(err, requests.ErrRequestNotFound) {
 			return echo.NewHTTPError(http.StatusNotFound, err.Error())
 		}
+		if errors.Is(err, requests.ErrInvalidStatus) {
+			return echo.NewHTTPError(http.StatusConflict, err.Error())
+		}
 		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
 	}
 
@@ -243,6 +246,9 @@ func (h *RequestsHandlers) Deny(c echo.Context) error {
 		if errors.Is(err, requests.ErrRequestNotFound) {
 			return echo.NewHTTPError(http.StatusNotFound, err.Error())
 		}
+		if errors.Is(err, requests.ErrInvalidStatus) {
+			return echo.NewHTTPError(http.StatusConflict, err.Error())
+		}
 		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
 	}
 
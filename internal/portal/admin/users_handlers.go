@@ -27,6 +27,7 @@ type UpdateUserRequest struct {
 	Username       *string           `json:"username"`
 	ModuleSettings map[string]*int64 `json:"moduleSettings"` // module_type -> quality_profile_id
 	AutoApprove    *bool             `json:"autoApprove"`
+	CanStream      *bool             `json:"canStream"`
 }
 
 type UpdateQuotaRequest struct {
@@ -176,6 +177,14 @@ func (h *UsersHandlers) applyUserUpdates(ctx context.Context, id int64, req *Upd
 		user = u
 	}
 
+	if req.CanStream != nil {
+		u, err := h.usersService.SetCanStream(ctx, id, *req.CanStream)
+		if err != nil {
+			return nil, mapUserError(err)
+		}
+		user = u
+	}
+
 	return user, nil
 }
 
@@ -0,0 +1,101 @@
+package admin
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+	portalmw "github.com/slipstream/slipstream/internal/portal/middleware"
+	"github.com/slipstream/slipstream/internal/portal/widget"
+)
+
+type WidgetKeysHandlers struct {
+	widgetService *widget.Service
+}
+
+func NewWidgetKeysHandlers(widgetService *widget.Service) *WidgetKeysHandlers {
+	return &WidgetKeysHandlers{
+		widgetService: widgetService,
+	}
+}
+
+func (h *WidgetKeysHandlers) RegisterRoutes(g *echo.Group, authMiddleware *portalmw.AuthMiddleware) {
+	protected := g.Group("")
+	protected.Use(authMiddleware.AdminAuth())
+
+	protected.GET("", h.List)
+	protected.POST("", h.Create)
+	protected.PUT("/:id", h.Update)
+	protected.DELETE("/:id", h.Delete)
+}
+
+// List returns all widget keys without their secrets
+// GET /api/v1/admin/requests/widget-keys
+func (h *WidgetKeysHandlers) List(c echo.Context) error {
+	keys, err := h.widgetService.List(c.Request().Context())
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	return c.JSON(http.StatusOK, keys)
+}
+
+// Create creates a widget key; the response is the only time the key is shown
+// POST /api/v1/admin/requests/widget-keys
+func (h *WidgetKeysHandlers) Create(c echo.Context) error {
+	var input widget.KeyInput
+	if err := c.Bind(&input); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid request body")
+	}
+
+	key, err := h.widgetService.Create(c.Request().Context(), &input)
+	if err != nil {
+		return widgetKeyError(err)
+	}
+	return c.JSON(http.StatusCreated, key)
+}
+
+// Update changes a widget key's settings
+// PUT /api/v1/admin/requests/widget-keys/:id
+func (h *WidgetKeysHandlers) Update(c echo.Context) error {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid id")
+	}
+
+	var input widget.KeyInput
+	if err := c.Bind(&input); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid request body")
+	}
+
+	key, err := h.widgetService.Update(c.Request().Context(), id, &input)
+	if err != nil {
+		return widgetKeyError(err)
+	}
+	return c.JSON(http.StatusOK, key)
+}
+
+// Delete revokes a widget key
+// DELETE /api/v1/admin/requests/widget-keys/:id
+func (h *WidgetKeysHandlers) Delete(c echo.Context) error {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid id")
+	}
+
+	if err := h.widgetService.Delete(c.Request().Context(), id); err != nil {
+		return widgetKeyError(err)
+	}
+	return c.NoContent(http.StatusNoContent)
+}
+
+func widgetKeyError(err error) error {
+	switch {
+	case errors.Is(err, widget.ErrKeyNotFound):
+		return echo.NewHTTPError(http.StatusNotFound, err.Error())
+	case errors.Is(err, widget.ErrInvalidInput):
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	default:
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+}
@@ -3,6 +3,7 @@ package autoapprove
 import (
 	"context"
 	"errors"
+	"time"
 
 	"github.com/rs/zerolog"
 	"github.com/slipstream/slipstream/internal/database/sqlc"
@@ -115,7 +116,8 @@ func (s *Service) ProcessAutoApprove(ctx context.Context, request *requests.Requ
 		return result, nil
 	}
 
-	canConsume, err := s.quotaService.CheckQuota(ctx, request.UserID, moduleType)
+	cost := s.requestsService.QuotaCost(ctx, request)
+	canConsume, err := s.quotaService.CheckQuota(ctx, request.UserID, moduleType, cost)
 	if err != nil {
 		return nil, err
 	}
@@ -130,11 +132,15 @@ func (s *Service) ProcessAutoApprove(ctx context.Context, request *requests.Requ
 		return result, nil
 	}
 
-	if err := s.quotaService.ConsumeQuota(ctx, request.UserID, moduleType); err != nil {
+	chargedAt := time.Now()
+	if err := s.quotaService.ConsumeQuota(ctx, request.UserID, moduleType, cost); err != nil {
 		return nil, err
 	}
 
 	if _, err := s.requestsService.AutoApprove(ctx, request.ID); err != nil {
+		if refundErr := s.quotaService.RefundQuota(ctx, request.UserID, moduleType, cost, chargedAt); refundErr != nil {
+			s.logger.Warn().Err(refundErr).Int64("requestID", request.ID).Msg("failed to refund quota after auto-approve failed")
+		}
 		return nil, err
 	}
 
@@ -99,9 +99,24 @@ func (m *AuthMiddleware) AdminAuth() echo.MiddlewareFunc {
 }
 
 func (m *AuthMiddleware) AnyAuth() echo.MiddlewareFunc {
+	return m.anyAuth(extractBearerToken)
+}
+
+// MediaAuth is AnyAuth that also accepts the token as a "token" query
+// parameter, for media players that cannot set an Authorization header.
+func (m *AuthMiddleware) MediaAuth() echo.MiddlewareFunc {
+	return m.anyAuth(func(c echo.Context) string {
+		if token := extractBearerToken(c); token != "" {
+			return token
+		}
+		return c.QueryParam("token")
+	})
+}
+
+func (m *AuthMiddleware) anyAuth(extractToken func(echo.Context) string) echo.MiddlewareFunc {
 	return func(next echo.HandlerFunc) echo.HandlerFunc {
 		return func(c echo.Context) error {
-			token := extractBearerToken(c)
+			token := extractToken(c)
 			if token == "" {
 				return echo.NewHTTPError(http.StatusUnauthorized, "missing authorization token")
 			}
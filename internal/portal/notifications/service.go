@@ -524,6 +524,9 @@ func formatStatusMessage(event *RequestStatusEvent) string {
 		msg += " has been approved"
 	case "denied":
 		msg += " has been denied"
+		if reason := event.Request.DeniedReason; reason != nil && *reason != "" {
+			msg += ": " + *reason
+		}
 	}
 	return msg
 }
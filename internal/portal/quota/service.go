@@ -57,14 +57,17 @@ func (s *Service) SetRegistry(r *module.Registry) {
 	s.registry = r
 }
 
-func (s *Service) CheckQuota(ctx context.Context, userID int64, moduleType string) (bool, error) {
+// CheckQuota reports whether userID has room for amount more requests of
+// moduleType in the current weekly period. A limit of 0 means unlimited.
+func (s *Service) CheckQuota(ctx context.Context, userID int64, moduleType string, amount int64) (bool, error) {
 	setting, err := s.queries.GetUserModuleSettings(ctx, sqlc.GetUserModuleSettingsParams{
 		UserID:     userID,
 		ModuleType: moduleType,
 	})
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			return true, nil
+			limit := s.getDefaultQuota(ctx, moduleType)
+			return limit == 0 || amount <= limit, nil
 		}
 		return false, err
 	}
@@ -80,23 +83,74 @@ func (s *Service) CheckQuota(ctx context.Context, userID int64, moduleType strin
 	if limit == 0 {
 		return true, nil
 	}
-	return setting.QuotaUsed < limit, nil
+	return setting.QuotaUsed+amount <= limit, nil
 }
 
-func (s *Service) ConsumeQuota(ctx context.Context, userID int64, moduleType string) error {
-	canConsume, err := s.CheckQuota(ctx, userID, moduleType)
+// ConsumeQuota charges amount against the user's quota, failing with
+// ErrQuotaExceeded when there is not enough room left.
+func (s *Service) ConsumeQuota(ctx context.Context, userID int64, moduleType string, amount int64) error {
+	canConsume, err := s.CheckQuota(ctx, userID, moduleType, amount)
 	if err != nil {
 		return err
 	}
 	if !canConsume {
 		return ErrQuotaExceeded
 	}
+	return s.ChargeQuota(ctx, userID, moduleType, amount)
+}
 
+// ChargeQuota records amount against the user's quota without checking the
+// limit. Used when an admin approves a request, which overrides the quota.
+func (s *Service) ChargeQuota(ctx context.Context, userID int64, moduleType string, amount int64) error {
 	if ensureErr := s.ensureModuleSettings(ctx, userID, moduleType); ensureErr != nil {
 		return ensureErr
 	}
 
+	setting, err := s.queries.GetUserModuleSettings(ctx, sqlc.GetUserModuleSettingsParams{
+		UserID:     userID,
+		ModuleType: moduleType,
+	})
+	if err != nil {
+		return err
+	}
+	if s.shouldResetQuota(setting.PeriodStart) {
+		if _, err := s.resetModuleQuota(ctx, userID, moduleType); err != nil {
+			return err
+		}
+	}
+
 	_, err = s.queries.IncrementUserModuleQuota(ctx, sqlc.IncrementUserModuleQuotaParams{
+		Amount:     amount,
+		UserID:     userID,
+		ModuleType: moduleType,
+	})
+	return err
+}
+
+// RefundQuota gives back amount the user was charged at chargedAt. Charges
+// made before the current weekly period began have already been reset away.
+func (s *Service) RefundQuota(ctx context.Context, userID int64, moduleType string, amount int64, chargedAt time.Time) error {
+	setting, err := s.queries.GetUserModuleSettings(ctx, sqlc.GetUserModuleSettingsParams{
+		UserID:     userID,
+		ModuleType: moduleType,
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil
+		}
+		return err
+	}
+	if s.shouldResetQuota(setting.PeriodStart) {
+		_, err := s.resetModuleQuota(ctx, userID, moduleType)
+		return err
+	}
+	// PeriodStart holds when the current period ends and the next one starts.
+	if chargedAt.Before(setting.PeriodStart.AddDate(0, 0, -7)) {
+		return nil
+	}
+
+	_, err = s.queries.DecrementUserModuleQuota(ctx, sqlc.DecrementUserModuleQuotaParams{
+		Amount:     amount,
 		UserID:     userID,
 		ModuleType: moduleType,
 	})
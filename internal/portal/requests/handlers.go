@@ -8,6 +8,7 @@ import (
 
 	"github.com/labstack/echo/v4"
 	"github.com/rs/zerolog"
+	"github.com/slipstream/slipstream/internal/logger"
 	portalmw "github.com/slipstream/slipstream/internal/portal/middleware"
 	"github.com/slipstream/slipstream/internal/portal/users"
 )
@@ -33,9 +34,12 @@ type RequestUser struct {
 
 type RequestWithWatchStatus struct {
 	*Request
-	User         *RequestUser `json:"user,omitempty"`
-	IsWatching   bool         `json:"isWatching"`
-	WatcherCount int64        `json:"watcherCount"`
+	User         *RequestUser   `json:"user,omitempty"`
+	IsWatching   bool           `json:"isWatching"`
+	WatcherCount int64          `json:"watcherCount"`
+	Requesters   []*RequestUser `json:"requesters"`
+	VoteCount    int            `json:"voteCount"`
+	HasVoted     bool           `json:"hasVoted"`
 }
 
 type AutoApproveProcessor interface {
@@ -109,6 +113,7 @@ func (h *Handlers) RegisterRoutes(g *echo.Group, authMiddleware *portalmw.AuthMi
 	protected.GET("", h.List)
 	protected.POST("", h.Create)
 	protected.GET("/downloads", h.Downloads)
+	protected.GET("/slots", h.Slots)
 	protected.GET("/:id", h.Get)
 	protected.DELETE("/:id", h.Cancel)
 	protected.POST("/:id/watch", h.Watch)
@@ -184,10 +189,39 @@ func (h *Handlers) enrichRequestsWithWatchStatus(ctx context.Context, requests [
 			IsWatching:   isWatching,
 			WatcherCount: watcherCount,
 		}
+		h.populateRequesters(ctx, results[i], userMap, currentUserID)
 	}
 	return results
 }
 
+// populateRequesters fills in everyone who asked for the request, the vote
+// count and whether the current user joined it.
+func (h *Handlers) populateRequesters(ctx context.Context, result *RequestWithWatchStatus, userMap map[int64]*RequestUser, currentUserID int64) {
+	ids, err := h.service.GetRequesterIDs(ctx, result.Request)
+	if err != nil {
+		logger.FromContext(ctx, h.logger).Warn().Err(err).Int64("requestID", result.ID).Msg("failed to get requesters")
+		ids = []int64{result.UserID}
+	}
+
+	result.Requesters = make([]*RequestUser, 0, len(ids))
+	for _, id := range ids {
+		if id == currentUserID && id != result.UserID {
+			result.HasVoted = true
+		}
+		user, ok := userMap[id]
+		if !ok {
+			if u, err := h.usersService.Get(ctx, id); err == nil && u != nil {
+				user = &RequestUser{ID: u.ID, Username: u.Username}
+			}
+			userMap[id] = user
+		}
+		if user != nil {
+			result.Requesters = append(result.Requesters, user)
+		}
+	}
+	result.VoteCount = len(ids)
+}
+
 // Create creates a new request
 // POST /api/v1/requests
 func (h *Handlers) Create(c echo.Context) error {
@@ -201,16 +235,30 @@ func (h *Handlers) Create(c echo.Context) error {
 		return err
 	}
 
-	request, err := h.createRequest(c.Request().Context(), claims.UserID, input)
+	request, err := h.CreateForUser(c.Request().Context(), claims.UserID, input)
 	if err != nil {
 		return err
 	}
 
-	h.processAutoApprove(c.Request().Context(), claims.UserID, request)
-
+	if request.Merged {
+		return c.JSON(http.StatusOK, request)
+	}
 	return c.JSON(http.StatusCreated, request)
 }
 
+// CreateForUser files a request as userID and runs auto-approve on new
+// requests. Errors are echo HTTP errors.
+func (h *Handlers) CreateForUser(ctx context.Context, userID int64, input *CreateInput) (*Request, error) {
+	request, err := h.createRequest(ctx, userID, input)
+	if err != nil {
+		return nil, err
+	}
+	if !request.Merged {
+		h.processAutoApprove(ctx, userID, request)
+	}
+	return request, nil
+}
+
 func (h *Handlers) parseAndValidateCreateInput(c echo.Context) (*CreateInput, error) {
 	var input CreateRequestInput
 	if err := c.Bind(&input); err != nil {
@@ -234,8 +282,10 @@ func (h *Handlers) createRequest(ctx context.Context, userID int64, input *Creat
 		switch {
 		case errors.Is(err, ErrAlreadyRequested):
 			return nil, echo.NewHTTPError(http.StatusConflict, err.Error())
-		case errors.Is(err, ErrInvalidMediaType):
+		case errors.Is(err, ErrInvalidMediaType), errors.Is(err, ErrInvalidSlot):
 			return nil, echo.NewHTTPError(http.StatusBadRequest, err.Error())
+		case errors.Is(err, ErrQuotaExceeded):
+			return nil, echo.NewHTTPError(http.StatusTooManyRequests, err.Error())
 		default:
 			return nil, echo.NewHTTPError(http.StatusInternalServerError, err.Error())
 		}
@@ -250,7 +300,7 @@ func (h *Handlers) processAutoApprove(ctx context.Context, userID int64, request
 
 	user, err := h.usersService.Get(ctx, userID)
 	if err != nil {
-		h.logger.Warn().Err(err).Int64("userID", userID).Msg("failed to get user for auto-approve")
+		logger.FromContext(ctx, h.logger).Warn().Err(err).Int64("userID", userID).Msg("failed to get user for auto-approve")
 		return
 	}
 	if user == nil {
@@ -258,7 +308,7 @@ func (h *Handlers) processAutoApprove(ctx context.Context, userID int64, request
 	}
 
 	if err := h.autoApprove.ProcessAutoApprove(request, user); err != nil {
-		h.logger.Warn().Err(err).Int64("requestID", request.ID).Msg("auto-approve processing failed")
+		logger.FromContext(ctx, h.logger).Warn().Err(err).Int64("requestID", request.ID).Msg("auto-approve processing failed")
 		return
 	}
 
@@ -267,6 +317,16 @@ func (h *Handlers) processAutoApprove(ctx context.Context, userID int64, request
 	}
 }
 
+// Slots returns the version slots a request can target
+// GET /api/v1/requests/slots
+func (h *Handlers) Slots(c echo.Context) error {
+	slots, err := h.service.ListRequestableSlots(c.Request().Context())
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	return c.JSON(http.StatusOK, slots)
+}
+
 // Get returns a single request
 // GET /api/v1/requests/:id
 func (h *Handlers) Get(c echo.Context) error {
@@ -299,12 +359,19 @@ func (h *Handlers) Get(c echo.Context) error {
 		}
 	}
 
-	return c.JSON(http.StatusOK, &RequestWithWatchStatus{
+	result := &RequestWithWatchStatus{
 		Request:      request,
 		User:         reqUser,
 		IsWatching:   isWatching,
 		WatcherCount: watcherCount,
-	})
+	}
+	userMap := make(map[int64]*RequestUser)
+	if reqUser != nil {
+		userMap[reqUser.ID] = reqUser
+	}
+	h.populateRequesters(c.Request().Context(), result, userMap, claims.UserID)
+
+	return c.JSON(http.StatusOK, result)
 }
 
 // Cancel cancels a pending request
@@ -420,7 +487,7 @@ func (h *Handlers) Downloads(c echo.Context) error {
 	}
 
 	if h.queueGetter == nil {
-		h.logger.Warn().Msg("queueGetter is nil")
+		logger.FromContext(c.Request().Context(), h.logger).Warn().Msg("queueGetter is nil")
 		return c.JSON(http.StatusOK, []PortalDownload{})
 	}
 
@@ -440,7 +507,7 @@ func (h *Handlers) Downloads(c echo.Context) error {
 
 	downloads := h.matchDownloadsToRequests(ctx, queue, requestMaps)
 
-	h.logger.Debug().Int("requestCount", len(userRequests)).Int("queueSize", len(queue)).Int("matched", len(downloads)).Msg("downloads matched")
+	logger.FromContext(c.Request().Context(), h.logger).Debug().Int("requestCount", len(userRequests)).Int("queueSize", len(queue)).Int("matched", len(downloads)).Msg("downloads matched")
 
 	return c.JSON(http.StatusOK, downloads)
 }
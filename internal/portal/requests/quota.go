@@ -0,0 +1,106 @@
+package requests
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// ErrQuotaExceeded is returned when a user has used up their weekly quota.
+var ErrQuotaExceeded = errors.New("weekly request quota reached")
+
+// QuotaEnforcer checks and records per-user weekly request quotas.
+type QuotaEnforcer interface {
+	CheckQuota(ctx context.Context, userID int64, moduleType string, amount int64) (bool, error)
+	ChargeQuota(ctx context.Context, userID int64, moduleType string, amount int64) error
+	RefundQuota(ctx context.Context, userID int64, moduleType string, amount int64, chargedAt time.Time) error
+}
+
+// SeasonCounter reports how many regular seasons a series has, so a request
+// for a whole series can be charged per season.
+type SeasonCounter interface {
+	CountSeasons(ctx context.Context, tmdbID, tvdbID int64) (int64, error)
+}
+
+func (s *Service) SetQuotaEnforcer(q QuotaEnforcer) {
+	s.quota = q
+}
+
+func (s *Service) SetSeasonCounter(c SeasonCounter) {
+	s.seasons = c
+}
+
+// QuotaCost returns how much of the weekly quota a request uses.
+func (s *Service) QuotaCost(ctx context.Context, req *Request) int64 {
+	return s.quotaCost(ctx, req.MediaType, req.TmdbID, req.TvdbID, req.RequestedSeasons)
+}
+
+// quotaCost prices a request. Movie quotas count titles; TV quotas count
+// seasons, so a request for several seasons costs one per season and a
+// request for a whole series costs one per season the series has.
+func (s *Service) quotaCost(ctx context.Context, mediaType string, tmdbID, tvdbID *int64, requestedSeasons []int64) int64 {
+	if mediaType != MediaTypeSeries && mediaType != MediaTypeSeason {
+		return 1
+	}
+	if len(requestedSeasons) > 0 {
+		return int64(len(requestedSeasons))
+	}
+	if mediaType == MediaTypeSeason || s.seasons == nil {
+		return 1
+	}
+
+	var tmdb, tvdb int64
+	if tmdbID != nil {
+		tmdb = *tmdbID
+	}
+	if tvdbID != nil {
+		tvdb = *tvdbID
+	}
+	count, err := s.seasons.CountSeasons(ctx, tmdb, tvdb)
+	if err != nil || count < 1 {
+		s.log(ctx).Warn().Err(err).Int64("tmdbID", tmdb).Int64("tvdbID", tvdb).
+			Msg("failed to count seasons for series request, charging one season")
+		return 1
+	}
+	return count
+}
+
+// checkQuota rejects a new request the user has no quota left for.
+func (s *Service) checkQuota(ctx context.Context, userID int64, input *CreateInput) error {
+	if s.quota == nil {
+		return nil
+	}
+	cost := s.quotaCost(ctx, input.MediaType, input.TmdbID, input.TvdbID, input.RequestedSeasons)
+	ok, err := s.quota.CheckQuota(ctx, userID, moduleTypeForMediaType(input.MediaType), cost)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return ErrQuotaExceeded
+	}
+	return nil
+}
+
+// chargeQuota records a manually approved request against its requester's
+// quota. An admin approval always goes through, even past the limit.
+func (s *Service) chargeQuota(ctx context.Context, req *Request) {
+	if s.quota == nil {
+		return
+	}
+	err := s.quota.ChargeQuota(ctx, req.UserID, moduleTypeForMediaType(req.MediaType), s.QuotaCost(ctx, req))
+	if err != nil {
+		s.log(ctx).Warn().Err(err).Int64("requestID", req.ID).Int64("userID", req.UserID).Msg("failed to charge request quota")
+	}
+}
+
+// refundQuota gives back what an approved request was charged when it is
+// denied. Charges from an earlier weekly period have already expired.
+func (s *Service) refundQuota(ctx context.Context, req *Request, approvedAt time.Time) {
+	if s.quota == nil {
+		return
+	}
+	err := s.quota.RefundQuota(ctx, req.UserID, moduleTypeForMediaType(req.MediaType), s.QuotaCost(ctx, req), approvedAt)
+	if err != nil {
+		s.log(ctx).Warn().Err(err).Int64("requestID", req.ID).Int64("userID", req.UserID).Msg("failed to refund request quota")
+	}
+}
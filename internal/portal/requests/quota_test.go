@@ -0,0 +1,188 @@
+package requests
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/rs/zerolog"
+	"github.com/slipstream/slipstream/internal/database/sqlc"
+	"github.com/slipstream/slipstream/internal/portal/quota"
+	"github.com/slipstream/slipstream/internal/testutil"
+)
+
+type fakeSeasonCounter struct {
+	count int64
+	err   error
+}
+
+func (f *fakeSeasonCounter) CountSeasons(context.Context, int64, int64) (int64, error) {
+	return f.count, f.err
+}
+
+func TestQuotaCost(t *testing.T) {
+	tests := []struct {
+		name      string
+		mediaType string
+		seasons   []int64
+		counter   SeasonCounter
+		want      int64
+	}{
+		{"movie", MediaTypeMovie, nil, nil, 1},
+		{"whole series", MediaTypeSeries, nil, &fakeSeasonCounter{count: 5}, 5},
+		{"whole series without metadata", MediaTypeSeries, nil, &fakeSeasonCounter{err: errors.New("offline")}, 1},
+		{"series with seasons", MediaTypeSeries, []int64{1, 2, 3}, &fakeSeasonCounter{count: 5}, 3},
+		{"season", MediaTypeSeason, []int64{2}, nil, 1},
+		{"episode", MediaTypeEpisode, nil, nil, 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			logger := zerolog.Nop()
+			svc := NewService(nil, &logger, nil, nil, nil)
+			if tt.counter != nil {
+				svc.SetSeasonCounter(tt.counter)
+			}
+			req := &Request{MediaType: tt.mediaType, TvdbID: testutil.Int64Ptr(7), RequestedSeasons: tt.seasons}
+			if got := svc.QuotaCost(context.Background(), req); got != tt.want {
+				t.Errorf("QuotaCost() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestService_Approve_ChargesSeasonQuota(t *testing.T) {
+	tdb := testutil.NewTestDB(t)
+	defer tdb.Close()
+
+	queries := sqlc.New(tdb.Conn)
+	ctx := context.Background()
+	svc := NewService(queries, &tdb.Logger, nil, nil, nil)
+	quotaSvc := quota.NewService(queries, &tdb.Logger)
+	svc.SetQuotaEnforcer(quotaSvc)
+
+	userID := createTestUser(t, queries)
+	adminID := createNamedTestUser(t, queries, "admin")
+	if err := quotaSvc.SetUserOverride(ctx, userID, "tv", 3); err != nil {
+		t.Fatalf("SetUserOverride error = %v", err)
+	}
+
+	req, err := svc.Create(ctx, userID, &CreateInput{
+		MediaType:        MediaTypeSeries,
+		TvdbID:           testutil.Int64Ptr(7),
+		Title:            "Test Series",
+		RequestedSeasons: []int64{1, 2},
+	})
+	if err != nil {
+		t.Fatalf("Create error = %v", err)
+	}
+	if _, err := svc.Approve(ctx, req.ID, adminID, ApprovalActionOnly); err != nil {
+		t.Fatalf("Approve error = %v", err)
+	}
+
+	status, err := quotaSvc.GetQuotaStatus(ctx, userID)
+	if err != nil {
+		t.Fatalf("GetQuotaStatus error = %v", err)
+	}
+	if len(status.Modules) != 1 || status.Modules[0].QuotaUsed != 2 {
+		t.Fatalf("quota modules = %+v, want tv used 2", status.Modules)
+	}
+
+	_, err = svc.Create(ctx, userID, &CreateInput{
+		MediaType:        MediaTypeSeries,
+		TvdbID:           testutil.Int64Ptr(8),
+		Title:            "Other Series",
+		RequestedSeasons: []int64{1, 2},
+	})
+	if !errors.Is(err, ErrQuotaExceeded) {
+		t.Errorf("Create over quota error = %v, want ErrQuotaExceeded", err)
+	}
+
+	// Movies have their own quota
+	if _, err := svc.Create(ctx, userID, movieRequestInput()); err != nil {
+		t.Errorf("Create movie error = %v", err)
+	}
+}
+
+func TestService_DenyApproved_RefundsQuota(t *testing.T) {
+	tdb := testutil.NewTestDB(t)
+	defer tdb.Close()
+
+	queries := sqlc.New(tdb.Conn)
+	ctx := context.Background()
+	svc := NewService(queries, &tdb.Logger, nil, nil, nil)
+	quotaSvc := quota.NewService(queries, &tdb.Logger)
+	svc.SetQuotaEnforcer(quotaSvc)
+
+	userID := createTestUser(t, queries)
+	adminID := createNamedTestUser(t, queries, "admin")
+
+	req, err := svc.Create(ctx, userID, &CreateInput{
+		MediaType:        MediaTypeSeries,
+		TvdbID:           testutil.Int64Ptr(7),
+		Title:            "Test Series",
+		RequestedSeasons: []int64{1, 2},
+	})
+	if err != nil {
+		t.Fatalf("Create error = %v", err)
+	}
+
+	// Approve, deny, approve again: only the final approval should count.
+	if _, err := svc.Approve(ctx, req.ID, adminID, ApprovalActionOnly); err != nil {
+		t.Fatalf("Approve error = %v", err)
+	}
+	if _, err := svc.Deny(ctx, req.ID, nil); err != nil {
+		t.Fatalf("Deny error = %v", err)
+	}
+	status, err := quotaSvc.GetQuotaStatus(ctx, userID)
+	if err != nil {
+		t.Fatalf("GetQuotaStatus error = %v", err)
+	}
+	if len(status.Modules) != 1 || status.Modules[0].QuotaUsed != 0 {
+		t.Fatalf("quota after deny = %+v, want tv used 0", status.Modules)
+	}
+
+	if _, err := svc.Approve(ctx, req.ID, adminID, ApprovalActionOnly); err != nil {
+		t.Fatalf("re-approve error = %v", err)
+	}
+	status, err = quotaSvc.GetQuotaStatus(ctx, userID)
+	if err != nil {
+		t.Fatalf("GetQuotaStatus error = %v", err)
+	}
+	if status.Modules[0].QuotaUsed != 2 {
+		t.Errorf("quota after re-approve = %d, want 2", status.Modules[0].QuotaUsed)
+	}
+}
+
+func TestService_ApproveDeny_Transitions(t *testing.T) {
+	tdb := testutil.NewTestDB(t)
+	defer tdb.Close()
+
+	queries := sqlc.New(tdb.Conn)
+	ctx := context.Background()
+	svc := NewService(queries, &tdb.Logger, nil, nil, nil)
+	userID := createTestUser(t, queries)
+
+	req := createApprovedRequest(t, svc, userID, movieRequestInput())
+	if _, err := svc.Approve(ctx, req.ID, userID, ApprovalActionOnly); !errors.Is(err, ErrInvalidStatus) {
+		t.Errorf("re-approve error = %v, want ErrInvalidStatus", err)
+	}
+
+	reason := "not available in your region"
+	denied, err := svc.Deny(ctx, req.ID, &reason)
+	if err != nil {
+		t.Fatalf("Deny error = %v", err)
+	}
+	if denied.DeniedReason == nil || *denied.DeniedReason != reason {
+		t.Errorf("DeniedReason = %v, want %q", denied.DeniedReason, reason)
+	}
+	if _, err := svc.Deny(ctx, req.ID, nil); !errors.Is(err, ErrInvalidStatus) {
+		t.Errorf("re-deny error = %v, want ErrInvalidStatus", err)
+	}
+	if _, err := svc.Approve(ctx, req.ID, userID, ApprovalActionOnly); err != nil {
+		t.Errorf("approve after deny error = %v", err)
+	}
+
+	if _, err := svc.Approve(ctx, 9999, userID, ApprovalActionOnly); !errors.Is(err, ErrRequestNotFound) {
+		t.Errorf("approve missing error = %v, want ErrRequestNotFound", err)
+	}
+}
@@ -179,6 +179,18 @@ func (s *RequestSearcher) executeSearch(ctx context.Context, requestID int64, re
 }
 
 func (s *RequestSearcher) searchMovie(ctx context.Context, requestID int64, request *Request, result *SearchForRequestResult) {
+	if request.TargetSlotID != nil {
+		slotResult, err := s.autosearchSvc.SearchMovieSlot(ctx, *request.MediaID, *request.TargetSlotID, autosearch.SearchSourceRequest)
+		if err != nil {
+			result.Error = err.Error()
+			s.logger.Warn().Err(err).Int64("requestID", requestID).Int64("slotID", *request.TargetSlotID).Msg("movie slot search failed")
+			return
+		}
+		result.Found = slotResult.Found
+		result.Downloaded = slotResult.Downloaded
+		return
+	}
+
 	searchResult, err := s.autosearchSvc.SearchMovie(ctx, *request.MediaID, autosearch.SearchSourceRequest)
 	if err != nil {
 		result.Error = err.Error()
@@ -190,6 +202,10 @@ func (s *RequestSearcher) searchMovie(ctx context.Context, requestID int64, requ
 }
 
 func (s *RequestSearcher) searchSeries(ctx context.Context, requestID int64, request *Request, result *SearchForRequestResult) {
+	if request.TargetSlotID != nil {
+		s.searchSeasonsInSlot(ctx, requestID, request, request.RequestedSeasons, result)
+		return
+	}
 	if len(request.RequestedSeasons) > 0 {
 		s.searchSpecificSeasons(ctx, requestID, request, result)
 		return
@@ -225,6 +241,10 @@ func (s *RequestSearcher) searchSeason(ctx context.Context, requestID int64, req
 		result.Error = "season number not specified"
 		return
 	}
+	if request.TargetSlotID != nil {
+		s.searchSeasonsInSlot(ctx, requestID, request, []int64{*request.SeasonNumber}, result)
+		return
+	}
 	batchResult, err := s.autosearchSvc.SearchSeason(ctx, *request.MediaID, int(*request.SeasonNumber), autosearch.SearchSourceRequest)
 	if err != nil {
 		result.Error = err.Error()
@@ -235,7 +255,58 @@ func (s *RequestSearcher) searchSeason(ctx context.Context, requestID int64, req
 	result.Downloaded = batchResult.Downloaded > 0
 }
 
+// searchSeasonsInSlot searches every aired episode of the given seasons (all
+// seasons when empty) for the request's target slot, skipping episodes that
+// already have a file in that slot.
+func (s *RequestSearcher) searchSeasonsInSlot(ctx context.Context, requestID int64, request *Request, seasons []int64, result *SearchForRequestResult) {
+	slotID := *request.TargetSlotID
+	episodes, err := s.queries.ListEpisodesBySeries(ctx, *request.MediaID)
+	if err != nil {
+		result.Error = err.Error()
+		s.logger.Warn().Err(err).Int64("requestID", requestID).Msg("failed to list episodes for slot search")
+		return
+	}
+
+	wanted := make(map[int64]bool, len(seasons))
+	for _, sn := range seasons {
+		wanted[sn] = true
+	}
+
+	now := time.Now()
+	for _, ep := range episodes {
+		if len(wanted) > 0 && !wanted[ep.SeasonNumber] {
+			continue
+		}
+		if !ep.AirDate.Valid || ep.AirDate.Time.After(now) {
+			continue
+		}
+		if a, err := s.queries.GetEpisodeSlotAssignment(ctx, sqlc.GetEpisodeSlotAssignmentParams{EpisodeID: ep.ID, SlotID: slotID}); err == nil && a.FileID.Valid {
+			continue
+		}
+
+		slotResult, err := s.autosearchSvc.SearchEpisodeSlot(ctx, ep.ID, slotID, autosearch.SearchSourceRequest)
+		if err != nil {
+			s.logger.Warn().Err(err).Int64("requestID", requestID).Int64("episodeID", ep.ID).Int64("slotID", slotID).Msg("episode slot search failed")
+			continue
+		}
+		result.Found = result.Found || slotResult.Found
+		result.Downloaded = result.Downloaded || slotResult.Downloaded
+	}
+}
+
 func (s *RequestSearcher) searchEpisode(ctx context.Context, requestID int64, request *Request, result *SearchForRequestResult) {
+	if request.TargetSlotID != nil {
+		slotResult, err := s.autosearchSvc.SearchEpisodeSlot(ctx, *request.MediaID, *request.TargetSlotID, autosearch.SearchSourceRequest)
+		if err != nil {
+			result.Error = err.Error()
+			s.logger.Warn().Err(err).Int64("requestID", requestID).Int64("slotID", *request.TargetSlotID).Msg("episode slot search failed")
+			return
+		}
+		result.Found = slotResult.Found
+		result.Downloaded = slotResult.Downloaded
+		return
+	}
+
 	searchResult, err := s.autosearchSvc.SearchEpisode(ctx, *request.MediaID, autosearch.SearchSourceRequest)
 	if err != nil {
 		result.Error = err.Error()
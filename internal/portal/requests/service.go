@@ -9,6 +9,7 @@ import (
 
 	"github.com/rs/zerolog"
 	"github.com/slipstream/slipstream/internal/database/sqlc"
+	"github.com/slipstream/slipstream/internal/logger"
 )
 
 var (
@@ -56,6 +57,8 @@ type Request struct {
 	RequestedSeasons []int64    `json:"requestedSeasons"`
 	CreatedAt        time.Time  `json:"createdAt"`
 	UpdatedAt        time.Time  `json:"updatedAt"`
+	// Merged is set when a create call joined an existing request for the same title.
+	Merged bool `json:"merged,omitempty"`
 }
 
 type CreateInput struct {
@@ -99,6 +102,8 @@ type Service struct {
 	broadcaster     *EventBroadcaster
 	notifDispatcher NotificationDispatcher
 	watchersService *WatchersService
+	quota           QuotaEnforcer
+	seasons         SeasonCounter
 }
 
 func NewService(queries *sqlc.Queries, logger *zerolog.Logger, broadcaster *EventBroadcaster, notifDispatcher NotificationDispatcher, watchersService *WatchersService) *Service {
@@ -112,18 +117,34 @@ func NewService(queries *sqlc.Queries, logger *zerolog.Logger, broadcaster *Even
 	}
 }
 
+// log returns the service logger tagged with the correlation ID of the API
+// request ctx belongs to, if any.
+func (s *Service) log(ctx context.Context) *zerolog.Logger {
+	return logger.FromContext(ctx, s.logger)
+}
+
 func (s *Service) SetDB(queries *sqlc.Queries) {
 	s.queries = queries
 }
 
+// getWatcherUserIDs returns the users to notify about a request besides its
+// original requester: everyone watching it and everyone who joined it.
 func (s *Service) getWatcherUserIDs(ctx context.Context, requestID int64) []int64 {
-	if s.watchersService == nil {
-		return nil
+	var ids []int64
+	if s.watchersService != nil {
+		watcherIDs, err := s.watchersService.GetWatcherUserIDs(ctx, requestID)
+		if err != nil {
+			s.log(ctx).Warn().Err(err).Int64("requestID", requestID).Msg("failed to get watcher user IDs")
+		}
+		ids = append(ids, watcherIDs...)
 	}
-	ids, err := s.watchersService.GetWatcherUserIDs(ctx, requestID)
+
+	votes, err := s.queries.ListRequestVotes(ctx, requestID)
 	if err != nil {
-		s.logger.Warn().Err(err).Int64("requestID", requestID).Msg("failed to get watcher user IDs")
-		return nil
+		s.log(ctx).Warn().Err(err).Int64("requestID", requestID).Msg("failed to get request voter IDs")
+	}
+	for _, v := range votes {
+		ids = append(ids, v.UserID)
 	}
 	return ids
 }
@@ -132,13 +153,19 @@ func (s *Service) Create(ctx context.Context, userID int64, input *CreateInput)
 	if !isValidMediaType(input.MediaType) {
 		return nil, ErrInvalidMediaType
 	}
+	if err := s.validateTargetSlot(ctx, input.TargetSlotID); err != nil {
+		return nil, err
+	}
 
 	existing, err := s.checkExistingRequest(ctx, input)
 	if err != nil && !errors.Is(err, sql.ErrNoRows) {
 		return nil, err
 	}
 	if existing != nil {
-		return nil, ErrAlreadyRequested
+		return s.mergeDuplicate(ctx, existing, userID)
+	}
+	if err := s.checkQuota(ctx, userID, input); err != nil {
+		return nil, err
 	}
 
 	req, err := s.queries.CreateRequest(ctx, sqlc.CreateRequestParams{
@@ -158,7 +185,7 @@ func (s *Service) Create(ctx context.Context, userID int64, input *CreateInput)
 		RequestedSeasons: seasonsToJSON(input.RequestedSeasons),
 	})
 	if err != nil {
-		s.logger.Error().Err(err).Int64("userID", userID).Str("title", input.Title).Msg("failed to create request")
+		s.log(ctx).Error().Err(err).Int64("userID", userID).Str("title", input.Title).Msg("failed to create request")
 		return nil, err
 	}
 
@@ -262,6 +289,13 @@ func (s *Service) List(ctx context.Context, filters ListFilters) ([]*Request, er
 		return nil, err
 	}
 
+	if filters.UserID != nil {
+		requests, err = s.appendVotedRequests(ctx, requests, filters)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	result := make([]*Request, len(requests))
 	for i, r := range requests {
 		result[i] = toRequest(r)
@@ -296,13 +330,28 @@ func (s *Service) Cancel(ctx context.Context, id, userID int64) error {
 	}
 
 	if req.UserID != userID {
-		return ErrNotOwner
+		voted, err := s.HasVoted(ctx, id, userID)
+		if err != nil {
+			return err
+		}
+		if !voted {
+			return ErrNotOwner
+		}
+		return s.withdrawVote(ctx, req, userID)
 	}
 
 	if req.Status != StatusPending {
 		return ErrCannotCancel
 	}
 
+	handedOver, err := s.handOverRequest(ctx, req)
+	if err != nil {
+		return err
+	}
+	if handedOver {
+		return nil
+	}
+
 	if err := s.queries.DeleteRequest(ctx, id); err != nil {
 		return err
 	}
@@ -314,21 +363,31 @@ func (s *Service) Cancel(ctx context.Context, id, userID int64) error {
 	return nil
 }
 
+// Approve moves a pending (or previously denied) request to approved and
+// charges it against the requester's quota.
 func (s *Service) Approve(ctx context.Context, id, approverID int64, _ ApprovalAction) (*Request, error) {
+	existing, err := s.checkTransition(ctx, id, StatusPending, StatusDenied)
+	if err != nil {
+		return nil, err
+	}
+
 	req, err := s.queries.ApproveRequest(ctx, sqlc.ApproveRequestParams{
 		ID:         id,
 		ApprovedBy: sql.NullInt64{Int64: approverID, Valid: true},
+		FromStatus: existing.Status,
 	})
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			return nil, ErrRequestNotFound
+			// Someone else moved the request since it was read.
+			return nil, ErrInvalidStatus
 		}
 		return nil, err
 	}
 
 	result := toRequest(req)
+	s.chargeQuota(ctx, result)
 	if s.broadcaster != nil {
-		s.broadcaster.BroadcastRequestUpdated(result, StatusPending)
+		s.broadcaster.BroadcastRequestUpdated(result, existing.Status)
 	}
 
 	// Dispatch notification
@@ -337,7 +396,7 @@ func (s *Service) Approve(ctx context.Context, id, approverID int64, _ ApprovalA
 		go s.notifDispatcher.NotifyRequestApproved(context.Background(), result, watcherIDs)
 	}
 
-	s.logger.Info().Int64("requestID", id).Int64("approverID", approverID).Msg("request approved")
+	s.log(ctx).Info().Int64("requestID", id).Int64("approverID", approverID).Msg("request approved")
 	return result, nil
 }
 
@@ -345,10 +404,14 @@ func (s *Service) AutoApprove(ctx context.Context, id int64) (*Request, error) {
 	req, err := s.queries.ApproveRequest(ctx, sqlc.ApproveRequestParams{
 		ID:         id,
 		ApprovedBy: sql.NullInt64{Valid: false},
+		FromStatus: StatusPending,
 	})
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			return nil, ErrRequestNotFound
+			if _, err := s.checkTransition(ctx, id, StatusPending); err != nil {
+				return nil, err
+			}
+			return nil, ErrInvalidStatus
 		}
 		return nil, err
 	}
@@ -364,25 +427,37 @@ func (s *Service) AutoApprove(ctx context.Context, id int64) (*Request, error) {
 		go s.notifDispatcher.NotifyRequestApproved(context.Background(), result, watcherIDs)
 	}
 
-	s.logger.Info().Int64("requestID", id).Msg("request auto-approved")
+	s.log(ctx).Info().Int64("requestID", id).Msg("request auto-approved")
 	return result, nil
 }
 
+// Deny rejects a pending or approved request, recording the reason shown to
+// the requester. Denying an approved request refunds its quota charge.
 func (s *Service) Deny(ctx context.Context, id int64, reason *string) (*Request, error) {
+	existing, err := s.checkTransition(ctx, id, StatusPending, StatusApproved)
+	if err != nil {
+		return nil, err
+	}
+
 	req, err := s.queries.DenyRequest(ctx, sqlc.DenyRequestParams{
 		ID:           id,
 		DeniedReason: toNullString(reason),
+		FromStatus:   existing.Status,
 	})
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			return nil, ErrRequestNotFound
+			// Someone else moved the request since it was read.
+			return nil, ErrInvalidStatus
 		}
 		return nil, err
 	}
 
 	result := toRequest(req)
+	if existing.Status == StatusApproved && existing.ApprovedAt.Valid {
+		s.refundQuota(ctx, result, existing.ApprovedAt.Time)
+	}
 	if s.broadcaster != nil {
-		s.broadcaster.BroadcastRequestUpdated(result, StatusPending)
+		s.broadcaster.BroadcastRequestUpdated(result, existing.Status)
 	}
 
 	// Dispatch notification
@@ -391,10 +466,29 @@ func (s *Service) Deny(ctx context.Context, id int64, reason *string) (*Request,
 		go s.notifDispatcher.NotifyRequestDenied(context.Background(), result, watcherIDs)
 	}
 
-	s.logger.Info().Int64("requestID", id).Msg("request denied")
+	s.log(ctx).Info().Int64("requestID", id).Msg("request denied")
 	return result, nil
 }
 
+// checkTransition returns the request, or ErrInvalidStatus unless it is
+// currently in one of the given states. The update that follows must be
+// conditioned on the returned status, since it can change in between.
+func (s *Service) checkTransition(ctx context.Context, id int64, from ...string) (*sqlc.Request, error) {
+	existing, err := s.queries.GetRequest(ctx, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrRequestNotFound
+		}
+		return nil, err
+	}
+	for _, status := range from {
+		if existing.Status == status {
+			return existing, nil
+		}
+	}
+	return nil, ErrInvalidStatus
+}
+
 func (s *Service) UpdateStatus(ctx context.Context, id int64, status string) (*Request, error) {
 	if !isValidStatus(status) {
 		return nil, ErrInvalidStatus
@@ -440,7 +534,7 @@ func (s *Service) LinkMedia(ctx context.Context, id, mediaID int64) (*Request, e
 		return nil, err
 	}
 
-	s.logger.Info().Int64("requestID", id).Int64("mediaID", mediaID).Msg("request linked to media")
+	s.log(ctx).Info().Int64("requestID", id).Int64("mediaID", mediaID).Msg("request linked to media")
 	return toRequest(req), nil
 }
 
@@ -449,7 +543,7 @@ func (s *Service) BatchApprove(ctx context.Context, ids []int64, approverID int6
 	for _, id := range ids {
 		req, err := s.Approve(ctx, id, approverID, action)
 		if err != nil {
-			s.logger.Warn().Err(err).Int64("requestID", id).Msg("failed to approve request in batch")
+			s.log(ctx).Warn().Err(err).Int64("requestID", id).Msg("failed to approve request in batch")
 			continue
 		}
 		results = append(results, req)
@@ -462,7 +556,7 @@ func (s *Service) BatchDeny(ctx context.Context, ids []int64, reason *string) ([
 	for _, id := range ids {
 		req, err := s.Deny(ctx, id, reason)
 		if err != nil {
-			s.logger.Warn().Err(err).Int64("requestID", id).Msg("failed to deny request in batch")
+			s.log(ctx).Warn().Err(err).Int64("requestID", id).Msg("failed to deny request in batch")
 			continue
 		}
 		results = append(results, req)
@@ -497,8 +591,9 @@ func (s *Service) checkExistingMovie(ctx context.Context, input *CreateInput) (*
 		return nil, sql.ErrNoRows
 	}
 	return s.queries.GetRequestByTmdbID(ctx, sqlc.GetRequestByTmdbIDParams{
-		TmdbID:     sql.NullInt64{Int64: *input.TmdbID, Valid: true},
-		EntityType: MediaTypeMovie,
+		TmdbID:       sql.NullInt64{Int64: *input.TmdbID, Valid: true},
+		EntityType:   MediaTypeMovie,
+		TargetSlotID: toNullInt64(input.TargetSlotID),
 	})
 }
 
@@ -509,8 +604,9 @@ func (s *Service) checkExistingSeries(ctx context.Context, input *CreateInput) (
 
 	// Same-type check first
 	req, err := s.queries.GetRequestByTvdbID(ctx, sqlc.GetRequestByTvdbIDParams{
-		TvdbID:     sql.NullInt64{Int64: *input.TvdbID, Valid: true},
-		EntityType: MediaTypeSeries,
+		TvdbID:       sql.NullInt64{Int64: *input.TvdbID, Valid: true},
+		EntityType:   MediaTypeSeries,
+		TargetSlotID: toNullInt64(input.TargetSlotID),
 	})
 	if err == nil && req != nil {
 		return req, nil
@@ -518,7 +614,7 @@ func (s *Service) checkExistingSeries(ctx context.Context, input *CreateInput) (
 
 	// Cross-type check: if ALL requested seasons are already covered by existing requests
 	if len(input.RequestedSeasons) > 0 {
-		coveredReq, err := s.checkCrossTypeCoverage(ctx, *input.TvdbID, input.RequestedSeasons)
+		coveredReq, err := s.checkCrossTypeCoverage(ctx, *input.TvdbID, input.TargetSlotID, input.RequestedSeasons)
 		if err == nil && coveredReq != nil {
 			return coveredReq, nil
 		}
@@ -536,13 +632,14 @@ func (s *Service) checkExistingSeason(ctx context.Context, input *CreateInput) (
 	req, err := s.queries.GetRequestByTvdbIDAndSeason(ctx, sqlc.GetRequestByTvdbIDAndSeasonParams{
 		TvdbID:       sql.NullInt64{Int64: *input.TvdbID, Valid: true},
 		SeasonNumber: sql.NullInt64{Int64: *input.SeasonNumber, Valid: true},
+		TargetSlotID: toNullInt64(input.TargetSlotID),
 	})
 	if err == nil && req != nil {
 		return req, nil
 	}
 
 	// Cross-type check: is this season covered by an existing series request?
-	coveredReq, err := s.checkCrossTypeCoverage(ctx, *input.TvdbID, []int64{*input.SeasonNumber})
+	coveredReq, err := s.checkCrossTypeCoverage(ctx, *input.TvdbID, input.TargetSlotID, []int64{*input.SeasonNumber})
 	if err == nil && coveredReq != nil {
 		return coveredReq, nil
 	}
@@ -558,14 +655,16 @@ func (s *Service) checkExistingEpisode(ctx context.Context, input *CreateInput)
 		TvdbID:        sql.NullInt64{Int64: *input.TvdbID, Valid: true},
 		SeasonNumber:  sql.NullInt64{Int64: *input.SeasonNumber, Valid: true},
 		EpisodeNumber: sql.NullInt64{Int64: *input.EpisodeNumber, Valid: true},
+		TargetSlotID:  toNullInt64(input.TargetSlotID),
 	})
 }
 
-func (s *Service) checkCrossTypeCoverage(ctx context.Context, tvdbID int64, requestedSeasons []int64) (*sqlc.Request, error) {
+func (s *Service) checkCrossTypeCoverage(ctx context.Context, tvdbID int64, targetSlotID *int64, requestedSeasons []int64) (*sqlc.Request, error) {
 	reqs, err := s.queries.FindRequestsCoveringSeasons(ctx, sql.NullInt64{Int64: tvdbID, Valid: true})
 	if err != nil {
 		return nil, err
 	}
+	reqs = filterBySlot(reqs, targetSlotID)
 
 	coveredSeasons, coveringReq := buildCoveredSeasonsMap(reqs)
 
@@ -702,6 +801,19 @@ func toRequest(r *sqlc.Request) *Request {
 	return req
 }
 
+// filterBySlot keeps requests targeting the given slot. A nil slot matches
+// requests without a target slot.
+func filterBySlot(reqs []*sqlc.Request, targetSlotID *int64) []*sqlc.Request {
+	filtered := make([]*sqlc.Request, 0, len(reqs))
+	for _, r := range reqs {
+		if (targetSlotID == nil && !r.TargetSlotID.Valid) ||
+			(targetSlotID != nil && r.TargetSlotID.Valid && r.TargetSlotID.Int64 == *targetSlotID) {
+			filtered = append(filtered, r)
+		}
+	}
+	return filtered
+}
+
 func moduleTypeForMediaType(mediaType string) string {
 	if mediaType == MediaTypeMovie {
 		return "movie"
@@ -0,0 +1,68 @@
+package requests
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+)
+
+// ErrInvalidSlot is returned when a request targets a version slot that does
+// not exist, is disabled, or multi-version mode is off.
+var ErrInvalidSlot = errors.New("invalid target slot")
+
+// RequestableSlot is a version slot portal users can target with a request,
+// e.g. a "4K" slot alongside a "1080p" slot.
+type RequestableSlot struct {
+	ID                 int64  `json:"id"`
+	Name               string `json:"name"`
+	QualityProfileID   *int64 `json:"qualityProfileId,omitempty"`
+	QualityProfileName string `json:"qualityProfileName,omitempty"`
+}
+
+// ListRequestableSlots returns the enabled version slots, or an empty list
+// when multi-version mode is off.
+func (s *Service) ListRequestableSlots(ctx context.Context) ([]RequestableSlot, error) {
+	slots := make([]RequestableSlot, 0)
+
+	settings, err := s.queries.GetMultiVersionSettings(ctx)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return slots, nil
+		}
+		return nil, err
+	}
+	if !settings.Enabled {
+		return slots, nil
+	}
+
+	rows, err := s.queries.ListEnabledVersionSlotsWithProfiles(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, row := range rows {
+		slot := RequestableSlot{ID: row.ID, Name: row.Name}
+		assignNullableInt64(&slot.QualityProfileID, row.QualityProfileID)
+		if row.ProfileName.Valid {
+			slot.QualityProfileName = row.ProfileName.String
+		}
+		slots = append(slots, slot)
+	}
+	return slots, nil
+}
+
+// validateTargetSlot checks that a requested slot is currently requestable.
+func (s *Service) validateTargetSlot(ctx context.Context, slotID *int64) error {
+	if slotID == nil {
+		return nil
+	}
+	slots, err := s.ListRequestableSlots(ctx)
+	if err != nil {
+		return err
+	}
+	for _, slot := range slots {
+		if slot.ID == *slotID {
+			return nil
+		}
+	}
+	return ErrInvalidSlot
+}
@@ -0,0 +1,75 @@
+package requests
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/slipstream/slipstream/internal/database/sqlc"
+	"github.com/slipstream/slipstream/internal/testutil"
+)
+
+func TestService_Create_TargetSlot(t *testing.T) {
+	tdb := testutil.NewTestDB(t)
+	defer tdb.Close()
+
+	queries := sqlc.New(tdb.Conn)
+	ctx := context.Background()
+	svc := NewService(queries, &tdb.Logger, nil, nil, nil)
+
+	alice := createNamedTestUser(t, queries, "alice")
+	bob := createNamedTestUser(t, queries, "bob")
+
+	slotInput := func(slotID int64) *CreateInput {
+		input := movieRequestInput()
+		input.TargetSlotID = &slotID
+		return input
+	}
+
+	if _, err := svc.Create(ctx, alice, slotInput(1)); !errors.Is(err, ErrInvalidSlot) {
+		t.Fatalf("Create with multi-version off error = %v, want ErrInvalidSlot", err)
+	}
+
+	if _, err := queries.SetMultiVersionEnabled(ctx, true); err != nil {
+		t.Fatalf("SetMultiVersionEnabled error = %v", err)
+	}
+	slots, err := svc.ListRequestableSlots(ctx)
+	if err != nil {
+		t.Fatalf("ListRequestableSlots error = %v", err)
+	}
+	if len(slots) != 2 {
+		t.Fatalf("ListRequestableSlots returned %d slots, want 2", len(slots))
+	}
+
+	primary, err := svc.Create(ctx, alice, slotInput(slots[0].ID))
+	if err != nil {
+		t.Fatalf("Create primary error = %v", err)
+	}
+	secondary, err := svc.Create(ctx, alice, slotInput(slots[1].ID))
+	if err != nil {
+		t.Fatalf("Create secondary error = %v", err)
+	}
+	if secondary.Merged || secondary.ID == primary.ID {
+		t.Error("requests for different slots should not merge")
+	}
+
+	plain, err := svc.Create(ctx, alice, movieRequestInput())
+	if err != nil {
+		t.Fatalf("Create without slot error = %v", err)
+	}
+	if plain.Merged {
+		t.Error("request without a slot should not merge into a slot request")
+	}
+
+	joined, err := svc.Create(ctx, bob, slotInput(slots[1].ID))
+	if err != nil {
+		t.Fatalf("Create same slot error = %v", err)
+	}
+	if !joined.Merged || joined.ID != secondary.ID {
+		t.Errorf("same-slot request merged = %v into %d, want merge into %d", joined.Merged, joined.ID, secondary.ID)
+	}
+
+	if _, err := svc.Create(ctx, bob, slotInput(9999)); !errors.Is(err, ErrInvalidSlot) {
+		t.Errorf("Create with unknown slot error = %v, want ErrInvalidSlot", err)
+	}
+}
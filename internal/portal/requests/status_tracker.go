@@ -117,6 +117,11 @@ func (t *StatusTracker) processRequestCompletion(ctx context.Context, req *Reque
 		return
 	}
 
+	// A request for a specific version is only satisfied by a file in that slot
+	if req.TargetSlotID != nil && !t.hasFileInSlot(ctx, availableEntityType, availableEntityID, *req.TargetSlotID) {
+		return
+	}
+
 	// For direct entity matches (e.g., movie request + movie available), mark available immediately
 	if req.MediaType == availableEntityType {
 		t.tryMarkAvailable(ctx, req)
@@ -166,6 +171,29 @@ func (t *StatusTracker) collectExternalIDs(req *Request) map[string]int64 {
 	return ids
 }
 
+// hasFileInSlot reports whether a movie or episode has a file assigned to
+// the given version slot. Other entity types have no slot assignments.
+func (t *StatusTracker) hasFileInSlot(ctx context.Context, entityType string, entityID, slotID int64) bool {
+	var fileID sql.NullInt64
+	switch entityType {
+	case MediaTypeMovie:
+		a, err := t.queries.GetMovieSlotAssignment(ctx, sqlc.GetMovieSlotAssignmentParams{MovieID: entityID, SlotID: slotID})
+		if err != nil {
+			return false
+		}
+		fileID = a.FileID
+	case MediaTypeEpisode:
+		a, err := t.queries.GetEpisodeSlotAssignment(ctx, sqlc.GetEpisodeSlotAssignmentParams{EpisodeID: entityID, SlotID: slotID})
+		if err != nil {
+			return false
+		}
+		fileID = a.FileID
+	default:
+		return true
+	}
+	return fileID.Valid
+}
+
 func (t *StatusTracker) tryMarkAvailable(ctx context.Context, req *Request) {
 	if err := t.markAvailable(ctx, req); err != nil {
 		t.logger.Warn().Err(err).Int64("requestID", req.ID).Msg("failed to mark request as available")
@@ -369,7 +397,7 @@ func (t *StatusTracker) markAvailable(ctx context.Context, req *Request) error {
 		Msg("request marked as available")
 
 	if t.notifDispatcher != nil {
-		watcherIDs, _ := t.watchersService.GetWatcherUserIDs(ctx, req.ID)
+		watcherIDs := t.requestsService.getWatcherUserIDs(ctx, req.ID)
 		go t.notifDispatcher.NotifyRequestAvailable(context.Background(), req, watcherIDs)
 	}
 
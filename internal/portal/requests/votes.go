@@ -0,0 +1,169 @@
+package requests
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"sort"
+
+	"github.com/slipstream/slipstream/internal/database/sqlc"
+)
+
+// mergeDuplicate records userID as an additional requester of an existing
+// request for the same title instead of creating a second request.
+func (s *Service) mergeDuplicate(ctx context.Context, existing *Request, userID int64) (*Request, error) {
+	if existing.UserID == userID {
+		return nil, ErrAlreadyRequested
+	}
+	voted, err := s.HasVoted(ctx, existing.ID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if voted {
+		return nil, ErrAlreadyRequested
+	}
+
+	if err := s.queries.CreateRequestVote(ctx, sqlc.CreateRequestVoteParams{
+		RequestID: existing.ID,
+		UserID:    userID,
+	}); err != nil {
+		s.log(ctx).Error().Err(err).Int64("requestID", existing.ID).Int64("userID", userID).Msg("failed to add request vote")
+		return nil, err
+	}
+
+	s.log(ctx).Info().Int64("requestID", existing.ID).Int64("userID", userID).Str("title", existing.Title).Msg("merged duplicate request")
+
+	existing.Merged = true
+	if s.broadcaster != nil {
+		s.broadcaster.BroadcastRequestUpdated(existing, existing.Status)
+	}
+	return existing, nil
+}
+
+// HasVoted reports whether userID joined the request as an additional requester.
+func (s *Service) HasVoted(ctx context.Context, requestID, userID int64) (bool, error) {
+	voted, err := s.queries.HasVotedForRequest(ctx, sqlc.HasVotedForRequestParams{
+		RequestID: requestID,
+		UserID:    userID,
+	})
+	if err != nil {
+		return false, err
+	}
+	return voted == 1, nil
+}
+
+// GetRequesterIDs returns the original requester followed by every user who
+// joined the request, in the order they asked for it.
+func (s *Service) GetRequesterIDs(ctx context.Context, request *Request) ([]int64, error) {
+	votes, err := s.queries.ListRequestVotes(ctx, request.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]int64, 0, len(votes)+1)
+	ids = append(ids, request.UserID)
+	for _, v := range votes {
+		ids = append(ids, v.UserID)
+	}
+	return ids, nil
+}
+
+// appendVotedRequests adds the requests a user joined to a list of their own
+// requests, honouring the status filter, newest first.
+func (s *Service) appendVotedRequests(ctx context.Context, requests []*sqlc.Request, filters ListFilters) ([]*sqlc.Request, error) {
+	voted, err := s.queries.ListUserVotedRequests(ctx, *filters.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if len(voted) == 0 {
+		return requests, nil
+	}
+
+	for _, r := range voted {
+		if filters.Status != nil && r.Status != *filters.Status {
+			continue
+		}
+		requests = append(requests, r)
+	}
+	sort.SliceStable(requests, func(i, j int) bool {
+		return requests[i].CreatedAt.After(requests[j].CreatedAt)
+	})
+	return requests, nil
+}
+
+// withdrawVote removes userID's vote from a request they joined.
+func (s *Service) withdrawVote(ctx context.Context, req *sqlc.Request, userID int64) error {
+	if err := s.queries.DeleteRequestVote(ctx, sqlc.DeleteRequestVoteParams{
+		RequestID: req.ID,
+		UserID:    userID,
+	}); err != nil {
+		return err
+	}
+
+	if s.broadcaster != nil {
+		s.broadcaster.BroadcastRequestUpdated(toRequest(req), req.Status)
+	}
+	return nil
+}
+
+// handOverRequest passes a request to its earliest additional requester when
+// the original requester cancels it. It returns false when nobody else is
+// waiting on the request.
+func (s *Service) handOverRequest(ctx context.Context, req *sqlc.Request) (bool, error) {
+	votes, err := s.queries.ListRequestVotes(ctx, req.ID)
+	if err != nil {
+		return false, err
+	}
+	if len(votes) == 0 {
+		return false, nil
+	}
+
+	next := votes[0].UserID
+	updated, err := s.queries.UpdateRequestOwner(ctx, sqlc.UpdateRequestOwnerParams{
+		UserID: next,
+		ID:     req.ID,
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return false, ErrRequestNotFound
+		}
+		return false, err
+	}
+	if err := s.queries.DeleteRequestVote(ctx, sqlc.DeleteRequestVoteParams{
+		RequestID: req.ID,
+		UserID:    next,
+	}); err != nil {
+		return false, err
+	}
+
+	s.log(ctx).Info().Int64("requestID", req.ID).Int64("previousUserID", req.UserID).Int64("userID", next).Msg("handed request over to next requester")
+
+	if s.broadcaster != nil {
+		s.broadcaster.BroadcastRequestUpdated(toRequest(updated), updated.Status)
+	}
+	return true, nil
+}
+
+// VoteCounts returns the number of users waiting on each library item with an
+// active request, keyed by module type and library media ID (the movie ID, or
+// the series ID for TV requests of any scope).
+func (s *Service) VoteCounts(ctx context.Context) (map[string]map[int64]int64, error) {
+	rows, err := s.queries.ListActiveRequestVoteCounts(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	counts := make(map[string]map[int64]int64)
+	for _, row := range rows {
+		if !row.MediaID.Valid {
+			continue
+		}
+		byID, ok := counts[row.ModuleType]
+		if !ok {
+			byID = make(map[int64]int64)
+			counts[row.ModuleType] = byID
+		}
+		byID[row.MediaID.Int64] += row.Votes
+	}
+	return counts, nil
+}
@@ -0,0 +1,163 @@
+package requests
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/slipstream/slipstream/internal/database/sqlc"
+	"github.com/slipstream/slipstream/internal/testutil"
+)
+
+func createNamedTestUser(t *testing.T, queries *sqlc.Queries, username string) int64 {
+	t.Helper()
+	user, err := queries.CreatePortalUser(context.Background(), sqlc.CreatePortalUserParams{
+		Username:     username,
+		PasswordHash: "fakehash",
+		Enabled:      true,
+	})
+	if err != nil {
+		t.Fatalf("CreatePortalUser error = %v", err)
+	}
+	return user.ID
+}
+
+func movieRequestInput() *CreateInput {
+	return &CreateInput{
+		MediaType: MediaTypeMovie,
+		TmdbID:    testutil.Int64Ptr(42),
+		Title:     "Test Movie",
+	}
+}
+
+func TestService_Create_MergesDuplicates(t *testing.T) {
+	tdb := testutil.NewTestDB(t)
+	defer tdb.Close()
+
+	queries := sqlc.New(tdb.Conn)
+	ctx := context.Background()
+	svc := NewService(queries, &tdb.Logger, nil, nil, nil)
+
+	alice := createNamedTestUser(t, queries, "alice")
+	bob := createNamedTestUser(t, queries, "bob")
+
+	original, err := svc.Create(ctx, alice, movieRequestInput())
+	if err != nil {
+		t.Fatalf("Create error = %v", err)
+	}
+	if original.Merged {
+		t.Error("first request should not be marked merged")
+	}
+
+	merged, err := svc.Create(ctx, bob, movieRequestInput())
+	if err != nil {
+		t.Fatalf("Create duplicate error = %v", err)
+	}
+	if !merged.Merged || merged.ID != original.ID {
+		t.Errorf("expected merge into request %d, got id=%d merged=%v", original.ID, merged.ID, merged.Merged)
+	}
+
+	for _, userID := range []int64{alice, bob} {
+		if _, err := svc.Create(ctx, userID, movieRequestInput()); !errors.Is(err, ErrAlreadyRequested) {
+			t.Errorf("repeat request by user %d: err = %v, want ErrAlreadyRequested", userID, err)
+		}
+	}
+
+	ids, err := svc.GetRequesterIDs(ctx, original)
+	if err != nil {
+		t.Fatalf("GetRequesterIDs error = %v", err)
+	}
+	if len(ids) != 2 || ids[0] != alice || ids[1] != bob {
+		t.Errorf("requesters = %v, want [%d %d]", ids, alice, bob)
+	}
+
+	bobRequests, err := svc.ListByUser(ctx, bob)
+	if err != nil {
+		t.Fatalf("ListByUser error = %v", err)
+	}
+	if len(bobRequests) != 1 || bobRequests[0].ID != original.ID {
+		t.Errorf("expected joined request in bob's list, got %d requests", len(bobRequests))
+	}
+}
+
+func TestService_Cancel_WithVotes(t *testing.T) {
+	tdb := testutil.NewTestDB(t)
+	defer tdb.Close()
+
+	queries := sqlc.New(tdb.Conn)
+	ctx := context.Background()
+	svc := NewService(queries, &tdb.Logger, nil, nil, nil)
+
+	alice := createNamedTestUser(t, queries, "alice")
+	bob := createNamedTestUser(t, queries, "bob")
+	carol := createNamedTestUser(t, queries, "carol")
+
+	req, err := svc.Create(ctx, alice, movieRequestInput())
+	if err != nil {
+		t.Fatalf("Create error = %v", err)
+	}
+	for _, userID := range []int64{bob, carol} {
+		if _, err := svc.Create(ctx, userID, movieRequestInput()); err != nil {
+			t.Fatalf("Create duplicate error = %v", err)
+		}
+	}
+
+	// A joined user cancelling only withdraws their vote.
+	if err := svc.Cancel(ctx, req.ID, carol); err != nil {
+		t.Fatalf("Cancel by voter error = %v", err)
+	}
+	if voted, _ := svc.HasVoted(ctx, req.ID, carol); voted {
+		t.Error("expected carol's vote to be withdrawn")
+	}
+
+	// The original requester cancelling hands the request to the next requester.
+	if err := svc.Cancel(ctx, req.ID, alice); err != nil {
+		t.Fatalf("Cancel by owner error = %v", err)
+	}
+	updated, err := svc.Get(ctx, req.ID)
+	if err != nil {
+		t.Fatalf("Get error = %v", err)
+	}
+	if updated.UserID != bob {
+		t.Errorf("owner = %d, want %d", updated.UserID, bob)
+	}
+	if voted, _ := svc.HasVoted(ctx, req.ID, bob); voted {
+		t.Error("new owner should no longer be counted as a voter")
+	}
+
+	// With nobody else waiting, cancelling deletes the request.
+	if err := svc.Cancel(ctx, req.ID, bob); err != nil {
+		t.Fatalf("Cancel by last requester error = %v", err)
+	}
+	if _, err := svc.Get(ctx, req.ID); !errors.Is(err, ErrRequestNotFound) {
+		t.Errorf("Get after cancel: err = %v, want ErrRequestNotFound", err)
+	}
+}
+
+func TestService_VoteCounts(t *testing.T) {
+	tdb := testutil.NewTestDB(t)
+	defer tdb.Close()
+
+	queries := sqlc.New(tdb.Conn)
+	ctx := context.Background()
+	svc := NewService(queries, &tdb.Logger, nil, nil, nil)
+
+	alice := createNamedTestUser(t, queries, "alice")
+	bob := createNamedTestUser(t, queries, "bob")
+
+	req := createApprovedRequest(t, svc, alice, movieRequestInput())
+	if _, err := svc.Create(ctx, bob, movieRequestInput()); err != nil {
+		t.Fatalf("Create duplicate error = %v", err)
+	}
+	if _, err := svc.LinkMedia(ctx, req.ID, 100); err != nil {
+		t.Fatalf("LinkMedia error = %v", err)
+	}
+
+	counts, err := svc.VoteCounts(ctx)
+	if err != nil {
+		t.Fatalf("VoteCounts error = %v", err)
+	}
+	if got := counts["movie"][100]; got != 2 {
+		t.Errorf("votes for movie 100 = %d, want 2", got)
+	}
+}
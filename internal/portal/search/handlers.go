@@ -18,6 +18,8 @@ type MetadataService interface {
 	SearchMovies(ctx context.Context, query string, year int) ([]metadata.MovieResult, error)
 	SearchSeries(ctx context.Context, query string) ([]metadata.SeriesResult, error)
 	GetSeriesSeasons(ctx context.Context, tmdbID, tvdbID int) ([]metadata.SeasonResult, error)
+	GetTrendingMovies(ctx context.Context) ([]metadata.MovieResult, error)
+	GetTrendingSeries(ctx context.Context) ([]metadata.SeriesResult, error)
 }
 
 type MovieSearchResult struct {
@@ -77,6 +79,8 @@ func (h *Handlers) RegisterRoutes(g *echo.Group, authMiddleware *portalmw.AuthMi
 	protected.GET("/movie", h.SearchMovies)
 	protected.GET("/series", h.SearchSeries)
 	protected.GET("/series/seasons", h.GetSeriesSeasons)
+	protected.GET("/trending/movie", h.TrendingMovies)
+	protected.GET("/trending/series", h.TrendingSeries)
 }
 
 // SearchMovies searches for movies and enriches with availability
@@ -193,6 +197,63 @@ func (h *Handlers) SearchSeries(c echo.Context) error {
 	return c.JSON(http.StatusOK, enriched)
 }
 
+// TrendingMovies returns this week's trending movies for discovery. Titles
+// already in the library are left out unless includeLibrary=true.
+// GET /api/v1/requests/search/trending/movie?includeLibrary=...
+func (h *Handlers) TrendingMovies(c echo.Context) error {
+	claims := portalmw.GetPortalUser(c)
+	if claims == nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, "not authenticated")
+	}
+
+	results, err := h.metadataService.GetTrendingMovies(c.Request().Context())
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	profileID := h.getProfileIDForUser(c.Request().Context(), claims.UserID, string(module.TypeMovie))
+	enriched := h.enrichMovieResults(c.Request().Context(), results, profileID, claims.UserID)
+	if c.QueryParam("includeLibrary") != "true" {
+		enriched = excludeInLibrary(enriched, func(r *MovieSearchResult) *requests.AvailabilityResult { return r.Availability })
+	}
+	return c.JSON(http.StatusOK, enriched)
+}
+
+// TrendingSeries returns this week's trending series for discovery. Series
+// already in the library are left out unless includeLibrary=true.
+// GET /api/v1/requests/search/trending/series?includeLibrary=...
+func (h *Handlers) TrendingSeries(c echo.Context) error {
+	claims := portalmw.GetPortalUser(c)
+	if claims == nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, "not authenticated")
+	}
+
+	results, err := h.metadataService.GetTrendingSeries(c.Request().Context())
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	profileID := h.getProfileIDForUser(c.Request().Context(), claims.UserID, string(module.TypeTV))
+	enriched := h.enrichSeriesResults(c.Request().Context(), results, profileID)
+	if c.QueryParam("includeLibrary") != "true" {
+		enriched = excludeInLibrary(enriched, func(r *SeriesSearchResult) *requests.AvailabilityResult { return r.Availability })
+	}
+	return c.JSON(http.StatusOK, enriched)
+}
+
+// excludeInLibrary drops results whose availability marks them as already
+// in the library.
+func excludeInLibrary[T any](results []T, availability func(*T) *requests.AvailabilityResult) []T {
+	filtered := make([]T, 0, len(results))
+	for i := range results {
+		if a := availability(&results[i]); a != nil && a.InLibrary {
+			continue
+		}
+		filtered = append(filtered, results[i])
+	}
+	return filtered
+}
+
 // GetSeriesSeasons returns the seasons for a series enriched with library availability data
 // GET /api/v1/requests/search/series/seasons?tmdbId=...&tvdbId=...
 func (h *Handlers) GetSeriesSeasons(c echo.Context) error {
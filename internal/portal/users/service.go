@@ -27,6 +27,7 @@ type User struct {
 	AutoApprove bool      `json:"autoApprove"`
 	IsAdmin     bool      `json:"isAdmin"`
 	Enabled     bool      `json:"enabled"`
+	CanStream   bool      `json:"canStream"`
 	CreatedAt   time.Time `json:"createdAt"`
 	UpdatedAt   time.Time `json:"updatedAt"`
 }
@@ -316,6 +317,21 @@ func (s *Service) SetAutoApprove(ctx context.Context, id int64, enabled bool) (*
 	return toUser(user), nil
 }
 
+// SetCanStream grants or revokes direct file streaming for a user.
+func (s *Service) SetCanStream(ctx context.Context, id int64, enabled bool) (*User, error) {
+	user, err := s.queries.UpdatePortalUserCanStream(ctx, sqlc.UpdatePortalUserCanStreamParams{
+		ID:        id,
+		CanStream: enabled,
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrUserNotFound
+		}
+		return nil, err
+	}
+	return toUser(user), nil
+}
+
 func (s *Service) Delete(ctx context.Context, id int64) error {
 	return s.queries.DeletePortalUser(ctx, id)
 }
@@ -427,6 +443,7 @@ func toUser(u *sqlc.PortalUser) *User {
 		AutoApprove: u.AutoApprove,
 		IsAdmin:     u.IsAdmin,
 		Enabled:     u.Enabled,
+		CanStream:   u.CanStream,
 		CreatedAt:   u.CreatedAt,
 		UpdatedAt:   u.UpdatedAt,
 	}
@@ -0,0 +1,228 @@
+package widget
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/slipstream/slipstream/internal/portal"
+	portalmw "github.com/slipstream/slipstream/internal/portal/middleware"
+	"github.com/slipstream/slipstream/internal/portal/requests"
+)
+
+// KeyHeader carries the widget key on every widget call.
+const KeyHeader = "X-Widget-Key"
+
+// SearchHandlers are the portal search endpoints the widget exposes.
+type SearchHandlers interface {
+	SearchMovies(c echo.Context) error
+	SearchSeries(c echo.Context) error
+}
+
+// RequestCreator files portal requests, including auto-approve.
+type RequestCreator interface {
+	CreateForUser(ctx context.Context, userID int64, input *requests.CreateInput) (*requests.Request, error)
+}
+
+// CreateRequestInput is the subset of request fields a widget may set.
+type CreateRequestInput struct {
+	MediaType        string  `json:"mediaType"`
+	TmdbID           *int64  `json:"tmdbId,omitempty"`
+	TvdbID           *int64  `json:"tvdbId,omitempty"`
+	Title            string  `json:"title"`
+	Year             *int64  `json:"year,omitempty"`
+	PosterURL        *string `json:"posterUrl,omitempty"`
+	RequestedSeasons []int64 `json:"requestedSeasons,omitempty"`
+}
+
+// RequestStatus is the public view of a request returned to widgets.
+type RequestStatus struct {
+	ID        int64     `json:"id"`
+	MediaType string    `json:"mediaType"`
+	Title     string    `json:"title"`
+	Year      *int64    `json:"year,omitempty"`
+	Status    string    `json:"status"`
+	CreatedAt time.Time `json:"createdAt"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+type Handlers struct {
+	service  *Service
+	limiter  *Limiter
+	requests *requests.Service
+}
+
+func NewHandlers(service *Service, limiter *Limiter, requestsService *requests.Service) *Handlers {
+	return &Handlers{
+		service:  service,
+		limiter:  limiter,
+		requests: requestsService,
+	}
+}
+
+// RegisterRoutes registers the widget API on g. Every route requires a
+// widget key and is CORS-enabled for that key's allowed origins.
+func (h *Handlers) RegisterRoutes(g *echo.Group, search SearchHandlers, creator RequestCreator) {
+	g.Use(h.Middleware())
+
+	g.GET("/search/movie", search.SearchMovies)
+	g.GET("/search/series", search.SearchSeries)
+	g.POST("/requests", h.createRequest(creator))
+	g.GET("/requests/:id", h.Status)
+}
+
+// Middleware answers CORS preflights, authenticates the widget key, checks
+// the caller's origin and rate limit, and acts as the key's portal user.
+func (h *Handlers) Middleware() echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			ctx := c.Request().Context()
+			origin := c.Request().Header.Get("Origin")
+
+			if c.Request().Method == http.MethodOptions {
+				if origin != "" && h.service.OriginAllowedByAnyKey(ctx, origin) {
+					setCORSHeaders(c, origin)
+				}
+				return c.NoContent(http.StatusNoContent)
+			}
+
+			secret := c.Request().Header.Get(KeyHeader)
+			if secret == "" {
+				return echo.NewHTTPError(http.StatusUnauthorized, "missing widget key")
+			}
+			key, user, err := h.service.Authenticate(ctx, secret)
+			if err != nil {
+				if errors.Is(err, ErrInvalidKey) || errors.Is(err, ErrKeyDisabled) ||
+					errors.Is(err, ErrUserDisabled) || errors.Is(err, ErrUserNotFound) {
+					return echo.NewHTTPError(http.StatusUnauthorized, "invalid widget key")
+				}
+				return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+			}
+
+			// Browsers always send Origin on cross-origin calls; without one
+			// the caller is not a browser and CORS does not apply.
+			if origin != "" {
+				if !key.AllowsOrigin(origin) {
+					return echo.NewHTTPError(http.StatusForbidden, ErrOriginBlocked.Error())
+				}
+				setCORSHeaders(c, origin)
+			}
+
+			if !h.limiter.Allow(key.ID, c.RealIP(), key.RateLimitPerMinute) {
+				c.Response().Header().Set("Retry-After", strconv.Itoa(int(limiterWindow.Seconds())))
+				return echo.NewHTTPError(http.StatusTooManyRequests, "rate limit exceeded")
+			}
+
+			c.Set(portalmw.PortalUserKey, &portal.Claims{
+				UserID:   user.ID,
+				Username: user.Username,
+				Role:     portal.RoleUser,
+				Audience: portal.AudiencePortal,
+			})
+			return next(c)
+		}
+	}
+}
+
+// createRequest files a request as the key's user.
+// POST /api/v1/widget/requests
+func (h *Handlers) createRequest(creator RequestCreator) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		claims := portalmw.GetPortalUser(c)
+		if claims == nil {
+			return echo.NewHTTPError(http.StatusUnauthorized, "not authenticated")
+		}
+
+		var input CreateRequestInput
+		if err := c.Bind(&input); err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, "invalid request body")
+		}
+		if input.Title == "" {
+			return echo.NewHTTPError(http.StatusBadRequest, "title is required")
+		}
+		if input.MediaType != requests.MediaTypeMovie && input.MediaType != requests.MediaTypeSeries {
+			return echo.NewHTTPError(http.StatusBadRequest, "mediaType must be movie or series")
+		}
+
+		request, err := creator.CreateForUser(c.Request().Context(), claims.UserID, &requests.CreateInput{
+			MediaType:        input.MediaType,
+			TmdbID:           input.TmdbID,
+			TvdbID:           input.TvdbID,
+			Title:            input.Title,
+			Year:             input.Year,
+			PosterURL:        input.PosterURL,
+			RequestedSeasons: input.RequestedSeasons,
+		})
+		if err != nil {
+			return err
+		}
+
+		status := http.StatusCreated
+		if request.Merged {
+			status = http.StatusOK
+		}
+		return c.JSON(status, toRequestStatus(request))
+	}
+}
+
+// Status returns the status of a request the widget's user asked for.
+// Besides its own requests, the user may poll one it joined when its request
+// was merged into an existing request for the same title.
+// GET /api/v1/widget/requests/:id
+func (h *Handlers) Status(c echo.Context) error {
+	claims := portalmw.GetPortalUser(c)
+	if claims == nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, "not authenticated")
+	}
+
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid id")
+	}
+
+	ctx := c.Request().Context()
+	request, err := h.requests.Get(ctx, id)
+	if err != nil {
+		if errors.Is(err, requests.ErrRequestNotFound) {
+			return echo.NewHTTPError(http.StatusNotFound, err.Error())
+		}
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	if request.UserID != claims.UserID {
+		voted, err := h.requests.HasVoted(ctx, request.ID, claims.UserID)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+		}
+		if !voted {
+			return echo.NewHTTPError(http.StatusNotFound, requests.ErrRequestNotFound.Error())
+		}
+	}
+
+	return c.JSON(http.StatusOK, toRequestStatus(request))
+}
+
+func toRequestStatus(r *requests.Request) RequestStatus {
+	return RequestStatus{
+		ID:        r.ID,
+		MediaType: r.MediaType,
+		Title:     r.Title,
+		Year:      r.Year,
+		Status:    r.Status,
+		CreatedAt: r.CreatedAt,
+		UpdatedAt: r.UpdatedAt,
+	}
+}
+
+func setCORSHeaders(c echo.Context, origin string) {
+	h := c.Response().Header()
+	h.Set("Access-Control-Allow-Origin", origin)
+	h.Set("Access-Control-Allow-Methods", "GET, POST, OPTIONS")
+	h.Set("Access-Control-Allow-Headers", "Content-Type, Accept, "+KeyHeader)
+	h.Set("Access-Control-Max-Age", "600")
+	h.Del("Access-Control-Allow-Credentials")
+	h.Add("Vary", "Origin")
+}
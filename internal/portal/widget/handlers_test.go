@@ -0,0 +1,67 @@
+package widget
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/slipstream/slipstream/internal/database/sqlc"
+	"github.com/slipstream/slipstream/internal/portal"
+	portalmw "github.com/slipstream/slipstream/internal/portal/middleware"
+	"github.com/slipstream/slipstream/internal/portal/requests"
+	"github.com/slipstream/slipstream/internal/testutil"
+)
+
+func TestHandlers_StatusOfMergedRequest(t *testing.T) {
+	tdb := testutil.NewTestDB(t)
+	defer tdb.Close()
+
+	q := sqlc.New(tdb.Conn)
+	ctx := context.Background()
+	newUser := func(name string) int64 {
+		user, err := q.CreatePortalUser(ctx, sqlc.CreatePortalUserParams{Username: name, PasswordHash: "x", Enabled: true})
+		if err != nil {
+			t.Fatalf("CreatePortalUser(%s) error = %v", name, err)
+		}
+		return user.ID
+	}
+	owner, widgetUser, stranger := newUser("owner"), newUser("widget"), newUser("stranger")
+
+	requestsSvc := requests.NewService(q, &tdb.Logger, nil, nil, nil)
+	tmdbID := int64(603)
+	input := &requests.CreateInput{MediaType: requests.MediaTypeMovie, TmdbID: &tmdbID, Title: "The Matrix"}
+	request, err := requestsSvc.Create(ctx, owner, input)
+	if err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+	merged, err := requestsSvc.Create(ctx, widgetUser, input)
+	if err != nil || !merged.Merged || merged.ID != request.ID {
+		t.Fatalf("Create() as widget user = %+v, %v; want merged into %d", merged, err, request.ID)
+	}
+
+	h := NewHandlers(NewService(q, &tdb.Logger), NewLimiter(), requestsSvc)
+	status := func(userID int64) error {
+		e := echo.New()
+		c := e.NewContext(httptest.NewRequest(http.MethodGet, "/api/v1/widget/requests/"+strconv.FormatInt(request.ID, 10), http.NoBody), httptest.NewRecorder())
+		c.SetParamNames("id")
+		c.SetParamValues(strconv.FormatInt(request.ID, 10))
+		c.Set(portalmw.PortalUserKey, &portal.Claims{UserID: userID, Role: portal.RoleUser, Audience: portal.AudiencePortal})
+		return h.Status(c)
+	}
+
+	if err := status(owner); err != nil {
+		t.Errorf("Status() as owner error = %v", err)
+	}
+	if err := status(widgetUser); err != nil {
+		t.Errorf("Status() as merged requester error = %v", err)
+	}
+	var httpErr *echo.HTTPError
+	if err := status(stranger); !errors.As(err, &httpErr) || httpErr.Code != http.StatusNotFound {
+		t.Errorf("Status() as unrelated user error = %v, want 404", err)
+	}
+}
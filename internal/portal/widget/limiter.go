@@ -0,0 +1,81 @@
+package widget
+
+import (
+	"strconv"
+	"sync"
+	"time"
+)
+
+const limiterWindow = time.Minute
+
+type bucket struct {
+	count     int64
+	resetTime time.Time
+}
+
+// Limiter applies each key's per-minute limit separately to every client IP,
+// and ten times that limit to the key as a whole, so one visitor cannot
+// exhaust a site's widget and a leaked key cannot be used for bulk lookups.
+type Limiter struct {
+	mu      sync.Mutex
+	buckets map[string]*bucket
+	now     func() time.Time
+}
+
+func NewLimiter() *Limiter {
+	return &Limiter{
+		buckets: make(map[string]*bucket),
+		now:     time.Now,
+	}
+}
+
+// Allow records a call and reports whether it is within the limits.
+func (l *Limiter) Allow(keyID int64, clientIP string, perMinute int64) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	id := strconv.FormatInt(keyID, 10)
+	client := l.bucket("client:"+id+":"+clientIP, now)
+	key := l.bucket("key:"+id, now)
+
+	if client.count >= perMinute || key.count >= perMinute*10 {
+		return false
+	}
+	client.count++
+	key.count++
+	return true
+}
+
+func (l *Limiter) bucket(name string, now time.Time) *bucket {
+	b, ok := l.buckets[name]
+	if !ok || now.After(b.resetTime) {
+		b = &bucket{resetTime: now.Add(limiterWindow)}
+		l.buckets[name] = b
+	}
+	return b
+}
+
+// Cleanup drops expired buckets.
+func (l *Limiter) Cleanup() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	for name, b := range l.buckets {
+		if now.After(b.resetTime) {
+			delete(l.buckets, name)
+		}
+	}
+}
+
+func (l *Limiter) StartCleanup(interval time.Duration) {
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for range ticker.C {
+			l.Cleanup()
+		}
+	}()
+}
@@ -0,0 +1,320 @@
+package widget
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/rs/zerolog"
+
+	"github.com/slipstream/slipstream/internal/database/sqlc"
+)
+
+const (
+	keyPrefix     = "ssw_"
+	keyRandomSize = 24
+
+	DefaultRateLimitPerMinute = 10
+	MaxRateLimitPerMinute     = 60
+)
+
+var (
+	ErrKeyNotFound   = errors.New("widget key not found")
+	ErrInvalidKey    = errors.New("invalid widget key")
+	ErrKeyDisabled   = errors.New("widget key is disabled")
+	ErrInvalidInput  = errors.New("invalid widget key settings")
+	ErrUserDisabled  = errors.New("widget user is disabled")
+	ErrUserNotFound  = errors.New("widget user not found")
+	ErrOriginBlocked = errors.New("origin is not allowed for this widget key")
+)
+
+// Key is a widget API key as shown to admins. The secret is only returned
+// once, when the key is created.
+type Key struct {
+	ID                 int64      `json:"id"`
+	Name               string     `json:"name"`
+	KeyPrefix          string     `json:"keyPrefix"`
+	Secret             string     `json:"key,omitempty"`
+	UserID             int64      `json:"userId"`
+	AllowedOrigins     []string   `json:"allowedOrigins"`
+	RateLimitPerMinute int64      `json:"rateLimitPerMinute"`
+	Enabled            bool       `json:"enabled"`
+	LastUsedAt         *time.Time `json:"lastUsedAt,omitempty"`
+	CreatedAt          time.Time  `json:"createdAt"`
+}
+
+// KeyInput configures a widget key. Requests made through the key are filed
+// as UserID, so that user's quotas and auto-approve setting apply.
+type KeyInput struct {
+	Name               string   `json:"name"`
+	UserID             int64    `json:"userId"`
+	AllowedOrigins     []string `json:"allowedOrigins"`
+	RateLimitPerMinute int64    `json:"rateLimitPerMinute"`
+	Enabled            *bool    `json:"enabled,omitempty"`
+}
+
+// Service manages widget keys and authenticates widget calls.
+type Service struct {
+	queries *sqlc.Queries
+	logger  *zerolog.Logger
+}
+
+func NewService(queries *sqlc.Queries, logger *zerolog.Logger) *Service {
+	subLogger := logger.With().Str("component", "portal-widget").Logger()
+	return &Service{
+		queries: queries,
+		logger:  &subLogger,
+	}
+}
+
+func (s *Service) SetDB(queries *sqlc.Queries) {
+	s.queries = queries
+}
+
+func (s *Service) List(ctx context.Context) ([]*Key, error) {
+	rows, err := s.queries.ListPortalWidgetKeys(ctx)
+	if err != nil {
+		return nil, err
+	}
+	keys := make([]*Key, 0, len(rows))
+	for _, row := range rows {
+		keys = append(keys, toKey(row))
+	}
+	return keys, nil
+}
+
+// Create adds a key and returns it with its secret.
+func (s *Service) Create(ctx context.Context, input *KeyInput) (*Key, error) {
+	origins, err := s.validateInput(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+
+	secret, err := generateKey()
+	if err != nil {
+		return nil, err
+	}
+
+	row, err := s.queries.CreatePortalWidgetKey(ctx, sqlc.CreatePortalWidgetKeyParams{
+		Name:               strings.TrimSpace(input.Name),
+		KeyHash:            hashKey(secret),
+		KeyPrefix:          secret[:len(keyPrefix)+6],
+		UserID:             input.UserID,
+		AllowedOrigins:     origins,
+		RateLimitPerMinute: normalizeRateLimit(input.RateLimitPerMinute),
+		Enabled:            input.Enabled == nil || *input.Enabled,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	key := toKey(row)
+	key.Secret = secret
+	return key, nil
+}
+
+func (s *Service) Update(ctx context.Context, id int64, input *KeyInput) (*Key, error) {
+	existing, err := s.get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	origins, err := s.validateInput(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+
+	enabled := existing.Enabled
+	if input.Enabled != nil {
+		enabled = *input.Enabled
+	}
+
+	row, err := s.queries.UpdatePortalWidgetKey(ctx, sqlc.UpdatePortalWidgetKeyParams{
+		Name:               strings.TrimSpace(input.Name),
+		UserID:             input.UserID,
+		AllowedOrigins:     origins,
+		RateLimitPerMinute: normalizeRateLimit(input.RateLimitPerMinute),
+		Enabled:            enabled,
+		ID:                 id,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return toKey(row), nil
+}
+
+func (s *Service) Delete(ctx context.Context, id int64) error {
+	if _, err := s.get(ctx, id); err != nil {
+		return err
+	}
+	return s.queries.DeletePortalWidgetKey(ctx, id)
+}
+
+// Authenticate resolves a raw key to an enabled key whose user is enabled.
+func (s *Service) Authenticate(ctx context.Context, secret string) (*Key, *sqlc.PortalUser, error) {
+	if !strings.HasPrefix(secret, keyPrefix) {
+		return nil, nil, ErrInvalidKey
+	}
+	row, err := s.queries.GetPortalWidgetKeyByHash(ctx, hashKey(secret))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil, ErrInvalidKey
+		}
+		return nil, nil, err
+	}
+	if !row.Enabled {
+		return nil, nil, ErrKeyDisabled
+	}
+
+	user, err := s.queries.GetPortalUser(ctx, row.UserID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil, ErrUserNotFound
+		}
+		return nil, nil, err
+	}
+	if !user.Enabled {
+		return nil, nil, ErrUserDisabled
+	}
+
+	if err := s.queries.TouchPortalWidgetKey(ctx, row.ID); err != nil {
+		s.logger.Debug().Err(err).Int64("keyId", row.ID).Msg("failed to record widget key use")
+	}
+	return toKey(row), user, nil
+}
+
+// OriginAllowedByAnyKey reports whether any enabled key allows the origin.
+// CORS preflights carry no key, so they are answered from this.
+func (s *Service) OriginAllowedByAnyKey(ctx context.Context, origin string) bool {
+	rows, err := s.queries.ListEnabledPortalWidgetKeys(ctx)
+	if err != nil {
+		return false
+	}
+	for _, row := range rows {
+		if toKey(row).AllowsOrigin(origin) {
+			return true
+		}
+	}
+	return false
+}
+
+// AllowsOrigin reports whether a browser on origin may use the key.
+func (k *Key) AllowsOrigin(origin string) bool {
+	origin = normalizeOrigin(origin)
+	if origin == "" {
+		return false
+	}
+	for _, allowed := range k.AllowedOrigins {
+		if allowed == origin {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *Service) get(ctx context.Context, id int64) (*Key, error) {
+	row, err := s.queries.GetPortalWidgetKey(ctx, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrKeyNotFound
+		}
+		return nil, err
+	}
+	return toKey(row), nil
+}
+
+// validateInput checks the settings and returns the origins encoded for storage.
+func (s *Service) validateInput(ctx context.Context, input *KeyInput) (string, error) {
+	if strings.TrimSpace(input.Name) == "" {
+		return "", fmt.Errorf("%w: name is required", ErrInvalidInput)
+	}
+	if input.RateLimitPerMinute < 0 || input.RateLimitPerMinute > MaxRateLimitPerMinute {
+		return "", fmt.Errorf("%w: rate limit must be between 1 and %d per minute", ErrInvalidInput, MaxRateLimitPerMinute)
+	}
+	if _, err := s.queries.GetPortalUser(ctx, input.UserID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return "", fmt.Errorf("%w: user not found", ErrInvalidInput)
+		}
+		return "", err
+	}
+
+	origins := make([]string, 0, len(input.AllowedOrigins))
+	for _, o := range input.AllowedOrigins {
+		if strings.TrimSpace(o) == "" {
+			continue
+		}
+		normalized := normalizeOrigin(o)
+		if normalized == "" {
+			return "", fmt.Errorf("%w: %q is not an origin like https://example.com", ErrInvalidInput, o)
+		}
+		origins = append(origins, normalized)
+	}
+	if len(origins) == 0 {
+		return "", fmt.Errorf("%w: at least one allowed origin is required", ErrInvalidInput)
+	}
+
+	encoded, err := json.Marshal(origins)
+	if err != nil {
+		return "", err
+	}
+	return string(encoded), nil
+}
+
+// normalizeOrigin reduces an origin to scheme://host[:port] in lower case, or
+// "" when it is not an http(s) origin.
+func normalizeOrigin(origin string) string {
+	u, err := url.Parse(strings.TrimSpace(origin))
+	if err != nil || u.Host == "" || (u.Scheme != "http" && u.Scheme != "https") {
+		return ""
+	}
+	if u.Path != "" && u.Path != "/" {
+		return ""
+	}
+	return strings.ToLower(u.Scheme + "://" + u.Host)
+}
+
+func normalizeRateLimit(limit int64) int64 {
+	if limit <= 0 {
+		return DefaultRateLimitPerMinute
+	}
+	return min(limit, MaxRateLimitPerMinute)
+}
+
+func generateKey() (string, error) {
+	b := make([]byte, keyRandomSize)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return keyPrefix + hex.EncodeToString(b), nil
+}
+
+func hashKey(secret string) string {
+	sum := sha256.Sum256([]byte(secret))
+	return hex.EncodeToString(sum[:])
+}
+
+func toKey(row *sqlc.PortalWidgetKey) *Key {
+	key := &Key{
+		ID:                 row.ID,
+		Name:               row.Name,
+		KeyPrefix:          row.KeyPrefix,
+		UserID:             row.UserID,
+		AllowedOrigins:     []string{},
+		RateLimitPerMinute: row.RateLimitPerMinute,
+		Enabled:            row.Enabled,
+		CreatedAt:          row.CreatedAt,
+	}
+	_ = json.Unmarshal([]byte(row.AllowedOrigins), &key.AllowedOrigins)
+	if row.LastUsedAt.Valid {
+		t := row.LastUsedAt.Time
+		key.LastUsedAt = &t
+	}
+	return key
+}
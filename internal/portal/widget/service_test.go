@@ -0,0 +1,111 @@
+package widget
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/slipstream/slipstream/internal/database/sqlc"
+	"github.com/slipstream/slipstream/internal/testutil"
+)
+
+func TestServiceKeyLifecycle(t *testing.T) {
+	tdb := testutil.NewTestDB(t)
+	defer tdb.Close()
+
+	q := sqlc.New(tdb.Conn)
+	ctx := context.Background()
+	user, err := q.CreatePortalUser(ctx, sqlc.CreatePortalUserParams{Username: "widget", PasswordHash: "x", Enabled: true})
+	if err != nil {
+		t.Fatalf("CreatePortalUser error = %v", err)
+	}
+
+	svc := NewService(q, &tdb.Logger)
+
+	if _, err := svc.Create(ctx, &KeyInput{Name: "Site", UserID: user.ID, AllowedOrigins: []string{"not a url"}}); !errors.Is(err, ErrInvalidInput) {
+		t.Fatalf("Create with bad origin error = %v, want ErrInvalidInput", err)
+	}
+
+	key, err := svc.Create(ctx, &KeyInput{
+		Name:           "Site",
+		UserID:         user.ID,
+		AllowedOrigins: []string{"https://Example.com/"},
+	})
+	if err != nil {
+		t.Fatalf("Create error = %v", err)
+	}
+	if key.Secret == "" || key.RateLimitPerMinute != DefaultRateLimitPerMinute {
+		t.Fatalf("Create() = %+v", key)
+	}
+
+	got, gotUser, err := svc.Authenticate(ctx, key.Secret)
+	if err != nil {
+		t.Fatalf("Authenticate error = %v", err)
+	}
+	if gotUser.ID != user.ID || !got.AllowsOrigin("https://example.com") || got.AllowsOrigin("https://evil.example") {
+		t.Errorf("Authenticate() = %+v", got)
+	}
+	if !svc.OriginAllowedByAnyKey(ctx, "https://example.com") {
+		t.Error("OriginAllowedByAnyKey() = false, want true")
+	}
+
+	if _, _, err := svc.Authenticate(ctx, "ssw_wrong"); !errors.Is(err, ErrInvalidKey) {
+		t.Errorf("Authenticate(wrong) error = %v, want ErrInvalidKey", err)
+	}
+
+	disabled := false
+	if _, err := svc.Update(ctx, key.ID, &KeyInput{
+		Name:           "Site",
+		UserID:         user.ID,
+		AllowedOrigins: []string{"https://example.com"},
+		Enabled:        &disabled,
+	}); err != nil {
+		t.Fatalf("Update error = %v", err)
+	}
+	if _, _, err := svc.Authenticate(ctx, key.Secret); !errors.Is(err, ErrKeyDisabled) {
+		t.Errorf("Authenticate(disabled) error = %v, want ErrKeyDisabled", err)
+	}
+	if svc.OriginAllowedByAnyKey(ctx, "https://example.com") {
+		t.Error("OriginAllowedByAnyKey() = true for disabled key")
+	}
+
+	if err := svc.Delete(ctx, key.ID); err != nil {
+		t.Fatalf("Delete error = %v", err)
+	}
+	if err := svc.Delete(ctx, key.ID); !errors.Is(err, ErrKeyNotFound) {
+		t.Errorf("Delete again error = %v, want ErrKeyNotFound", err)
+	}
+}
+
+func TestLimiter(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	l := NewLimiter()
+	l.now = func() time.Time { return now }
+
+	for i := 0; i < 2; i++ {
+		if !l.Allow(1, "10.0.0.1", 2) {
+			t.Fatalf("call %d denied", i)
+		}
+	}
+	if l.Allow(1, "10.0.0.1", 2) {
+		t.Error("third call from the same client allowed")
+	}
+	if !l.Allow(1, "10.0.0.2", 2) {
+		t.Error("call from another client denied")
+	}
+
+	// The key as a whole is capped at ten times the per-client limit.
+	for i := 3; i < 20; i++ {
+		l.Allow(1, fmt.Sprintf("10.0.1.%d", i), 2)
+	}
+	if l.Allow(1, "10.0.2.1", 2) {
+		t.Error("call over the key-wide limit allowed")
+	}
+
+	now = now.Add(limiterWindow + time.Second)
+	if !l.Allow(1, "10.0.0.1", 2) {
+		t.Error("call after the window reset denied")
+	}
+}
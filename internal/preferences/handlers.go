@@ -1,9 +1,12 @@
 package preferences
 
 import (
+	"errors"
 	"net/http"
 
 	"github.com/labstack/echo/v4"
+
+	portalmw "github.com/slipstream/slipstream/internal/portal/middleware"
 )
 
 type Handlers struct {
@@ -19,6 +22,13 @@ func (h *Handlers) RegisterRoutes(g *echo.Group) {
 	g.PUT("/addflow", h.SetAddFlowPreferences)
 }
 
+// RegisterUserRoutes registers per-user preference routes.
+// The group must authenticate the user so their claims are available.
+func (h *Handlers) RegisterUserRoutes(g *echo.Group) {
+	g.GET("", h.GetUserPreferences)
+	g.PUT("", h.SetUserPreferences)
+}
+
 // GetAddFlowPreferences returns the add-flow preferences
 // GET /api/v1/preferences/addflow
 func (h *Handlers) GetAddFlowPreferences(c echo.Context) error {
@@ -48,3 +58,41 @@ func (h *Handlers) SetAddFlowPreferences(c echo.Context) error {
 
 	return c.JSON(http.StatusOK, updated)
 }
+
+// GetUserPreferences returns the authenticated user's display preferences
+// GET /api/v1/preferences/user
+func (h *Handlers) GetUserPreferences(c echo.Context) error {
+	claims := portalmw.GetPortalUser(c)
+	if claims == nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, "not authenticated")
+	}
+
+	prefs, err := h.service.GetUserPreferences(c.Request().Context(), claims.UserID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	return c.JSON(http.StatusOK, prefs)
+}
+
+// SetUserPreferences updates the authenticated user's display preferences
+// PUT /api/v1/preferences/user
+func (h *Handlers) SetUserPreferences(c echo.Context) error {
+	claims := portalmw.GetPortalUser(c)
+	if claims == nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, "not authenticated")
+	}
+
+	var prefs UserPreferences
+	if err := c.Bind(&prefs); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid request body")
+	}
+
+	updated, err := h.service.SetUserPreferences(c.Request().Context(), claims.UserID, &prefs)
+	if err != nil {
+		if errors.Is(err, ErrInvalidPreferences) {
+			return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+		}
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	return c.JSON(http.StatusOK, updated)
+}
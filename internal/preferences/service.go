@@ -135,6 +135,46 @@ func (s *Service) SetSeriesIncludeSpecials(ctx context.Context, value bool) erro
 	return s.setString(ctx, KeySeriesIncludeSpecials, strconv.FormatBool(value))
 }
 
+// GetUserPreferences returns a user's display preferences, or defaults if none are saved
+func (s *Service) GetUserPreferences(ctx context.Context, userID int64) (*UserPreferences, error) {
+	row, err := s.queries.GetUserPreferences(ctx, userID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			prefs := DefaultUserPreferences()
+			return &prefs, nil
+		}
+		return nil, err
+	}
+	return rowToUserPreferences(row), nil
+}
+
+// SetUserPreferences validates and saves a user's display preferences
+func (s *Service) SetUserPreferences(ctx context.Context, userID int64, prefs *UserPreferences) (*UserPreferences, error) {
+	if err := prefs.Validate(); err != nil {
+		return nil, err
+	}
+	row, err := s.queries.UpsertUserPreferences(ctx, sqlc.UpsertUserPreferencesParams{
+		UserID:             userID,
+		Locale:             prefs.Locale,
+		TimeFormat:         string(prefs.TimeFormat),
+		WeekStart:          string(prefs.WeekStart),
+		DefaultLibraryView: string(prefs.DefaultLibraryView),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return rowToUserPreferences(row), nil
+}
+
+func rowToUserPreferences(row *sqlc.UserPreference) *UserPreferences {
+	return &UserPreferences{
+		Locale:             row.Locale,
+		TimeFormat:         TimeFormat(row.TimeFormat),
+		WeekStart:          WeekStart(row.WeekStart),
+		DefaultLibraryView: LibraryView(row.DefaultLibraryView),
+	}
+}
+
 func (s *Service) getString(ctx context.Context, key string) (string, error) {
 	setting, err := s.queries.GetSetting(ctx, key)
 	if err != nil {
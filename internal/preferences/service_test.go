@@ -0,0 +1,78 @@
+package preferences
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/slipstream/slipstream/internal/database/sqlc"
+	"github.com/slipstream/slipstream/internal/testutil"
+)
+
+func TestService_UserPreferences(t *testing.T) {
+	tdb := testutil.NewTestDB(t)
+	defer tdb.Close()
+
+	ctx := context.Background()
+	queries := sqlc.New(tdb.Conn)
+	svc := NewService(queries)
+
+	user, err := queries.CreatePortalUser(ctx, sqlc.CreatePortalUserParams{
+		Username:     "testuser",
+		PasswordHash: "fakehash",
+		Enabled:      true,
+	})
+	if err != nil {
+		t.Fatalf("CreatePortalUser() error = %v", err)
+	}
+
+	got, err := svc.GetUserPreferences(ctx, user.ID)
+	if err != nil {
+		t.Fatalf("GetUserPreferences() error = %v", err)
+	}
+	if *got != DefaultUserPreferences() {
+		t.Errorf("GetUserPreferences() = %+v, want defaults", got)
+	}
+
+	saved, err := svc.SetUserPreferences(ctx, user.ID, &UserPreferences{
+		Locale:             "de-de",
+		TimeFormat:         TimeFormat24h,
+		WeekStart:          WeekStartMonday,
+		DefaultLibraryView: LibraryViewTable,
+	})
+	if err != nil {
+		t.Fatalf("SetUserPreferences() error = %v", err)
+	}
+	if saved.Locale != "de-DE" {
+		t.Errorf("SetUserPreferences() locale = %q, want canonical %q", saved.Locale, "de-DE")
+	}
+
+	got, err = svc.GetUserPreferences(ctx, user.ID)
+	if err != nil {
+		t.Fatalf("GetUserPreferences() error = %v", err)
+	}
+	if *got != *saved {
+		t.Errorf("GetUserPreferences() = %+v, want %+v", got, saved)
+	}
+}
+
+func TestUserPreferences_Validate(t *testing.T) {
+	tests := []struct {
+		name   string
+		modify func(p *UserPreferences)
+	}{
+		{"invalid locale", func(p *UserPreferences) { p.Locale = "not a locale" }},
+		{"invalid time format", func(p *UserPreferences) { p.TimeFormat = "36h" }},
+		{"invalid week start", func(p *UserPreferences) { p.WeekStart = "friday" }},
+		{"invalid library view", func(p *UserPreferences) { p.DefaultLibraryView = "carousel" }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			prefs := DefaultUserPreferences()
+			tt.modify(&prefs)
+			if err := prefs.Validate(); !errors.Is(err, ErrInvalidPreferences) {
+				t.Errorf("Validate() error = %v, want ErrInvalidPreferences", err)
+			}
+		})
+	}
+}
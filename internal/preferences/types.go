@@ -1,5 +1,14 @@
 package preferences
 
+import (
+	"errors"
+	"fmt"
+
+	"golang.org/x/text/language"
+)
+
+var ErrInvalidPreferences = errors.New("invalid preferences")
+
 // SeriesSearchOnAdd defines options for searching when adding a series
 type SeriesSearchOnAdd string
 
@@ -20,6 +29,7 @@ const (
 	SeriesMonitorOnAddLatestSeason SeriesMonitorOnAdd = "latest_season"
 	SeriesMonitorOnAddFuture       SeriesMonitorOnAdd = "future"
 	SeriesMonitorOnAddAll          SeriesMonitorOnAdd = "all"
+	SeriesMonitorOnAddPilot        SeriesMonitorOnAdd = "pilot"
 )
 
 // Setting keys for add-flow preferences
@@ -60,8 +70,79 @@ func ValidSeriesSearchOnAdd(s string) bool {
 // ValidSeriesMonitorOnAdd checks if a value is a valid SeriesMonitorOnAdd option
 func ValidSeriesMonitorOnAdd(s string) bool {
 	switch SeriesMonitorOnAdd(s) {
-	case SeriesMonitorOnAddNone, SeriesMonitorOnAddFirstSeason, SeriesMonitorOnAddLatestSeason, SeriesMonitorOnAddFuture, SeriesMonitorOnAddAll:
+	case SeriesMonitorOnAddNone, SeriesMonitorOnAddFirstSeason, SeriesMonitorOnAddLatestSeason, SeriesMonitorOnAddFuture, SeriesMonitorOnAddAll, SeriesMonitorOnAddPilot:
 		return true
 	}
 	return false
 }
+
+// TimeFormat defines how times are displayed
+type TimeFormat string
+
+const (
+	TimeFormat12h TimeFormat = "12h"
+	TimeFormat24h TimeFormat = "24h"
+)
+
+// WeekStart defines the first day of the week in calendars
+type WeekStart string
+
+const (
+	WeekStartSunday WeekStart = "sunday"
+	WeekStartMonday WeekStart = "monday"
+)
+
+// LibraryView defines the default layout for library pages
+type LibraryView string
+
+const (
+	LibraryViewGrid  LibraryView = "grid"
+	LibraryViewTable LibraryView = "table"
+)
+
+// UserPreferences contains per-user display preferences
+type UserPreferences struct {
+	Locale             string      `json:"locale"`
+	TimeFormat         TimeFormat  `json:"timeFormat"`
+	WeekStart          WeekStart   `json:"weekStart"`
+	DefaultLibraryView LibraryView `json:"defaultLibraryView"`
+}
+
+// DefaultUserPreferences returns the preferences used for users who have not saved any
+func DefaultUserPreferences() UserPreferences {
+	return UserPreferences{
+		Locale:             "en-US",
+		TimeFormat:         TimeFormat12h,
+		WeekStart:          WeekStartSunday,
+		DefaultLibraryView: LibraryViewGrid,
+	}
+}
+
+// Validate checks all fields and canonicalizes the locale tag
+func (p *UserPreferences) Validate() error {
+	tag, err := language.Parse(p.Locale)
+	if err != nil {
+		return fmt.Errorf("%w: invalid locale %q", ErrInvalidPreferences, p.Locale)
+	}
+	p.Locale = tag.String()
+
+	switch p.TimeFormat {
+	case TimeFormat12h, TimeFormat24h:
+	default:
+		return fmt.Errorf("%w: invalid time format %q", ErrInvalidPreferences, p.TimeFormat)
+	}
+
+	switch p.WeekStart {
+	case WeekStartSunday, WeekStartMonday:
+	default:
+		return fmt.Errorf("%w: invalid week start %q", ErrInvalidPreferences, p.WeekStart)
+	}
+
+	switch p.DefaultLibraryView {
+	case LibraryViewGrid, LibraryViewTable:
+	default:
+		return fmt.Errorf("%w: invalid library view %q", ErrInvalidPreferences, p.DefaultLibraryView)
+	}
+
+	return nil
+}
@@ -234,15 +234,17 @@ func (c *Client) GetIndexers(ctx context.Context) ([]Indexer, error) {
 
 // prowlarrIndexerResponse represents the Prowlarr API response for indexers.
 type prowlarrIndexerResponse struct {
-	ID           int                    `json:"id"`
-	Name         string                 `json:"name"`
-	Protocol     string                 `json:"protocol"`
-	Privacy      string                 `json:"privacy,omitempty"`
-	Priority     int                    `json:"priority"`
-	Enable       bool                   `json:"enable"`
-	Status       *prowlarrIndexerStatus `json:"status,omitempty"`
-	Capabilities *prowlarrIndexerCaps   `json:"capabilities,omitempty"`
-	Fields       []prowlarrIndexerField `json:"fields,omitempty"`
+	ID             int                    `json:"id"`
+	Name           string                 `json:"name"`
+	Implementation string                 `json:"implementation"`
+	DefinitionName string                 `json:"definitionName"`
+	Protocol       string                 `json:"protocol"`
+	Privacy        string                 `json:"privacy,omitempty"`
+	Priority       int                    `json:"priority"`
+	Enable         bool                   `json:"enable"`
+	Status         *prowlarrIndexerStatus `json:"status,omitempty"`
+	Capabilities   *prowlarrIndexerCaps   `json:"capabilities,omitempty"`
+	Fields         []prowlarrIndexerField `json:"fields,omitempty"`
 }
 
 type prowlarrIndexerStatus struct {
@@ -292,14 +294,16 @@ func (c *Client) convertIndexer(idx *prowlarrIndexerResponse) Indexer {
 	}
 
 	return Indexer{
-		ID:           idx.ID,
-		Name:         idx.Name,
-		Protocol:     protocolFromString(idx.Protocol),
-		Priority:     idx.Priority,
-		Enable:       idx.Enable,
-		Status:       status,
-		Capabilities: caps,
-		Fields:       fields,
+		ID:             idx.ID,
+		Name:           idx.Name,
+		Implementation: idx.Implementation,
+		DefinitionName: idx.DefinitionName,
+		Protocol:       protocolFromString(idx.Protocol),
+		Priority:       idx.Priority,
+		Enable:         idx.Enable,
+		Status:         status,
+		Capabilities:   caps,
+		Fields:         fields,
 	}
 }
 
@@ -328,9 +332,10 @@ type ProwlarrSearchResult struct {
 		ID   int    `json:"id"`
 		Name string `json:"name"`
 	} `json:"categories"`
-	DownloadVolumeFactor float64 `json:"downloadVolumeFactor"`
-	UploadVolumeFactor   float64 `json:"uploadVolumeFactor"`
-	InfoHash             string  `json:"infoHash"`
+	DownloadVolumeFactor float64  `json:"downloadVolumeFactor"`
+	UploadVolumeFactor   float64  `json:"uploadVolumeFactor"`
+	InfoHash             string   `json:"infoHash"`
+	IndexerFlags         []string `json:"indexerFlags"`
 }
 
 // Search executes a search query through Prowlarr's REST API.
@@ -419,6 +424,9 @@ func resultToTorznabItem(r *ProwlarrSearchResult) TorznabItem {
 	if r.ImdbID > 0 {
 		item.Attributes = append(item.Attributes, TorznabAttribute{Name: "imdb", Value: fmt.Sprintf("tt%07d", r.ImdbID)})
 	}
+	for _, flag := range r.IndexerFlags {
+		item.Attributes = append(item.Attributes, TorznabAttribute{Name: "tag", Value: strings.ToLower(flag)})
+	}
 
 	return item
 }
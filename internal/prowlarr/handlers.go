@@ -12,13 +12,15 @@ import (
 type Handlers struct {
 	service     *Service
 	modeManager *ModeManager
+	sync        *IndexerSync
 }
 
 // NewHandlers creates new Prowlarr handlers.
-func NewHandlers(service *Service, modeManager *ModeManager) *Handlers {
+func NewHandlers(service *Service, modeManager *ModeManager, indexerSync *IndexerSync) *Handlers {
 	return &Handlers{
 		service:     service,
 		modeManager: modeManager,
+		sync:        indexerSync,
 	}
 }
 
@@ -41,6 +43,11 @@ func (h *Handlers) RegisterRoutes(g *echo.Group) {
 	prowlarr.DELETE("/indexers/:id/settings", h.DeleteIndexerSettings)
 	prowlarr.POST("/indexers/:id/reset-stats", h.ResetIndexerStats)
 
+	// Indexer sync endpoints
+	prowlarr.GET("/sync", h.GetSyncStatus)
+	prowlarr.PUT("/sync", h.UpdateSync)
+	prowlarr.POST("/sync/run", h.RunSync)
+
 	// Mode management endpoints
 	g.GET("/mode", h.GetMode)
 	g.PUT("/mode", h.SetMode)
@@ -352,3 +359,50 @@ func (h *Handlers) ResetIndexerStats(c echo.Context) error {
 
 	return c.JSON(http.StatusOK, settings)
 }
+
+// GetSyncStatus returns whether indexer sync is enabled and the last run's result.
+// GET /api/v1/indexers/prowlarr/sync
+func (h *Handlers) GetSyncStatus(c echo.Context) error {
+	status, err := h.sync.Status(c.Request().Context())
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	return c.JSON(http.StatusOK, status)
+}
+
+// UpdateSyncRequest represents a request to change indexer sync settings.
+type UpdateSyncRequest struct {
+	Enabled bool `json:"enabled"`
+}
+
+// UpdateSync turns scheduled indexer sync on or off.
+// PUT /api/v1/indexers/prowlarr/sync
+func (h *Handlers) UpdateSync(c echo.Context) error {
+	var input UpdateSyncRequest
+	if err := c.Bind(&input); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	ctx := c.Request().Context()
+	if err := h.sync.SetEnabled(ctx, input.Enabled); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	status, err := h.sync.Status(ctx)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	return c.JSON(http.StatusOK, status)
+}
+
+// RunSync imports indexers from Prowlarr immediately.
+// POST /api/v1/indexers/prowlarr/sync/run
+func (h *Handlers) RunSync(c echo.Context) error {
+	result, err := h.sync.Sync(c.Request().Context())
+	if err != nil {
+		if errors.Is(err, ErrNotConfigured) {
+			return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+		}
+		return echo.NewHTTPError(http.StatusBadGateway, err.Error())
+	}
+	return c.JSON(http.StatusOK, result)
+}
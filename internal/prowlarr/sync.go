@@ -0,0 +1,430 @@
+package prowlarr
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog"
+
+	"github.com/slipstream/slipstream/internal/database/sqlc"
+	"github.com/slipstream/slipstream/internal/indexer"
+	"github.com/slipstream/slipstream/internal/indexer/cardigann"
+	"github.com/slipstream/slipstream/internal/indexer/types"
+)
+
+const (
+	indexerSyncEnabledKey = "prowlarr_indexer_sync_enabled"
+
+	// maskedFieldValue is what Prowlarr returns in place of stored secrets.
+	maskedFieldValue = "********"
+
+	cardigannImplementation = "Cardigann"
+)
+
+// Outcomes recorded for each Prowlarr indexer during a sync.
+const (
+	SyncActionCreated  = "created"
+	SyncActionUpdated  = "updated"
+	SyncActionDisabled = "disabled"
+	SyncActionSkipped  = "skipped"
+)
+
+// IndexerSyncItem records what a sync did with one Prowlarr indexer.
+type IndexerSyncItem struct {
+	ProwlarrID int    `json:"prowlarrId"`
+	IndexerID  int64  `json:"indexerId,omitempty"`
+	Name       string `json:"name"`
+	Action     string `json:"action"`
+	Reason     string `json:"reason,omitempty"`
+}
+
+// IndexerSyncResult summarizes a sync run.
+type IndexerSyncResult struct {
+	StartedAt  time.Time         `json:"startedAt"`
+	FinishedAt time.Time         `json:"finishedAt"`
+	Created    int               `json:"created"`
+	Updated    int               `json:"updated"`
+	Disabled   int               `json:"disabled"`
+	Skipped    int               `json:"skipped"`
+	Items      []IndexerSyncItem `json:"items"`
+	Error      string            `json:"error,omitempty"`
+}
+
+// IndexerSyncStatus is the sync configuration and the outcome of the last run.
+type IndexerSyncStatus struct {
+	Enabled  bool               `json:"enabled"`
+	LastSync *IndexerSyncResult `json:"lastSync,omitempty"`
+}
+
+// IndexerSync imports Cardigann indexers and their credentials from Prowlarr
+// into SlipStream's own indexer list and keeps them in step on a schedule.
+// Prowlarr is the source of truth for linked indexers: names, settings,
+// priority, categories, and enabled state are overwritten on every run, and
+// indexers removed from Prowlarr are disabled locally.
+type IndexerSync struct {
+	queries  *sqlc.Queries
+	prowlarr *Service
+	indexers *indexer.Service
+	logger   *zerolog.Logger
+
+	mu       sync.Mutex
+	lastSync *IndexerSyncResult
+}
+
+// NewIndexerSync creates a new Prowlarr indexer sync.
+func NewIndexerSync(db *sql.DB, prowlarrService *Service, indexerService *indexer.Service, logger *zerolog.Logger) *IndexerSync {
+	subLogger := logger.With().Str("component", "prowlarr-sync").Logger()
+	return &IndexerSync{
+		queries:  sqlc.New(db),
+		prowlarr: prowlarrService,
+		indexers: indexerService,
+		logger:   &subLogger,
+	}
+}
+
+// SetDB updates the database connection used by this service.
+func (s *IndexerSync) SetDB(db *sql.DB) {
+	s.queries = sqlc.New(db)
+}
+
+// IsEnabled reports whether scheduled syncing is turned on.
+func (s *IndexerSync) IsEnabled(ctx context.Context) (bool, error) {
+	setting, err := s.queries.GetSetting(ctx, indexerSyncEnabledKey)
+	if errors.Is(err, sql.ErrNoRows) {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("failed to get sync setting: %w", err)
+	}
+	return setting.Value == "true", nil
+}
+
+// SetEnabled turns scheduled syncing on or off.
+func (s *IndexerSync) SetEnabled(ctx context.Context, enabled bool) error {
+	if _, err := s.queries.SetSetting(ctx, sqlc.SetSettingParams{
+		Key:   indexerSyncEnabledKey,
+		Value: strconv.FormatBool(enabled),
+	}); err != nil {
+		return fmt.Errorf("failed to save sync setting: %w", err)
+	}
+	return nil
+}
+
+// Status returns the sync configuration and the last run's result.
+func (s *IndexerSync) Status(ctx context.Context) (*IndexerSyncStatus, error) {
+	enabled, err := s.IsEnabled(ctx)
+	if err != nil {
+		return nil, err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return &IndexerSyncStatus{Enabled: enabled, LastSync: s.lastSync}, nil
+}
+
+// RunScheduled syncs when scheduled syncing is enabled and does nothing otherwise.
+func (s *IndexerSync) RunScheduled(ctx context.Context) error {
+	enabled, err := s.IsEnabled(ctx)
+	if err != nil || !enabled {
+		return err
+	}
+	_, err = s.Sync(ctx)
+	return err
+}
+
+// Sync pulls the indexer list from Prowlarr and creates, updates, or disables
+// the linked SlipStream indexers.
+func (s *IndexerSync) Sync(ctx context.Context) (*IndexerSyncResult, error) {
+	result := &IndexerSyncResult{StartedAt: time.Now(), Items: []IndexerSyncItem{}}
+
+	err := s.sync(ctx, result)
+	result.FinishedAt = time.Now()
+	if err != nil {
+		result.Error = err.Error()
+	}
+
+	s.mu.Lock()
+	s.lastSync = result
+	s.mu.Unlock()
+
+	if err != nil {
+		s.logger.Error().Err(err).Msg("Prowlarr indexer sync failed")
+		return result, err
+	}
+
+	s.logger.Info().
+		Int("created", result.Created).
+		Int("updated", result.Updated).
+		Int("disabled", result.Disabled).
+		Int("skipped", result.Skipped).
+		Msg("Prowlarr indexer sync completed")
+	return result, nil
+}
+
+func (s *IndexerSync) sync(ctx context.Context, result *IndexerSyncResult) error {
+	client, err := s.newClient(ctx)
+	if err != nil {
+		return err
+	}
+	remote, err := client.GetIndexers(ctx)
+	if err != nil {
+		return err
+	}
+
+	linkRows, err := s.queries.ListProwlarrIndexerLinks(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to list indexer links: %w", err)
+	}
+	links := make(map[int64]int64, len(linkRows))
+	for _, link := range linkRows {
+		links[link.ProwlarrIndexerID] = link.IndexerID
+	}
+
+	seen := make(map[int64]bool, len(remote))
+	for i := range remote {
+		seen[int64(remote[i].ID)] = true
+		item := s.syncIndexer(ctx, &remote[i], links)
+		result.add(item)
+	}
+
+	for prowlarrID, indexerID := range links {
+		if seen[prowlarrID] {
+			continue
+		}
+		result.add(s.disableRemoved(ctx, prowlarrID, indexerID))
+	}
+	return nil
+}
+
+// newClient builds a client from the stored connection settings. Unlike
+// Service.GetClient it does not require Prowlarr mode to be active, since
+// syncing is how users move off Prowlarr.
+func (s *IndexerSync) newClient(ctx context.Context) (*Client, error) {
+	config, err := s.prowlarr.GetConfig(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if config.URL == "" || config.APIKey == "" {
+		return nil, ErrNotConfigured
+	}
+	return NewClient(ClientConfig{
+		URL:           config.URL,
+		APIKey:        config.APIKey,
+		Timeout:       config.Timeout,
+		SkipSSLVerify: config.SkipSSLVerify,
+		Logger:        s.logger,
+	})
+}
+
+func (s *IndexerSync) syncIndexer(ctx context.Context, remote *Indexer, links map[int64]int64) IndexerSyncItem {
+	item := IndexerSyncItem{ProwlarrID: remote.ID, Name: remote.Name, Action: SyncActionSkipped}
+
+	switch {
+	case remote.Implementation != cardigannImplementation:
+		item.Reason = "only Cardigann indexers can be synced (implementation: " + remote.Implementation + ")"
+		return item
+	case remote.Protocol != types.ProtocolTorrent:
+		item.Reason = "only torrent indexers are supported"
+		return item
+	}
+
+	schema, err := s.indexers.GetDefinitionSchema(remote.DefinitionName)
+	if err != nil {
+		item.Reason = "definition " + remote.DefinitionName + " is not available in SlipStream"
+		return item
+	}
+	settings, missing := convertFields(remote.Fields, schema)
+
+	if indexerID, linked := links[int64(remote.ID)]; linked {
+		existing, err := s.indexers.Get(ctx, indexerID)
+		if err != nil {
+			item.Reason = err.Error()
+			return item
+		}
+		return s.updateIndexer(ctx, remote, existing, settings, item)
+	}
+
+	// A new indexer whose secrets Prowlarr withheld is created disabled so
+	// the user can fill them in without it failing every search meanwhile.
+	enabled := remote.Enable && len(missing) == 0
+	settingsJSON, err := json.Marshal(settings)
+	if err != nil {
+		item.Reason = err.Error()
+		return item
+	}
+	created, err := s.indexers.Create(ctx, &indexer.CreateIndexerInput{
+		Name:           remote.Name,
+		DefinitionID:   remote.DefinitionName,
+		Settings:       settingsJSON,
+		Categories:     categoriesOrEmpty(remote.Capabilities.Categories),
+		SupportsMovies: remote.Capabilities.SupportsMovies,
+		SupportsTV:     remote.Capabilities.SupportsTV,
+		Priority:       remote.Priority,
+		Enabled:        enabled,
+	})
+	if err != nil {
+		item.Reason = err.Error()
+		return item
+	}
+	if err := s.link(ctx, remote.ID, created.ID); err != nil {
+		item.Reason = err.Error()
+		return item
+	}
+
+	item.IndexerID = created.ID
+	item.Action = SyncActionCreated
+	if len(missing) > 0 {
+		item.Reason = fmt.Sprintf("created disabled: Prowlarr did not return %v", missing)
+	}
+	return item
+}
+
+func (s *IndexerSync) updateIndexer(ctx context.Context, remote *Indexer, existing *indexer.IndexerDefinition, settings map[string]string, item IndexerSyncItem) IndexerSyncItem {
+	// Keep locally stored values for any secrets Prowlarr masked.
+	merged := make(map[string]string)
+	if len(existing.Settings) > 0 {
+		if err := json.Unmarshal(existing.Settings, &merged); err != nil {
+			item.Reason = err.Error()
+			return item
+		}
+	}
+	for k, v := range settings {
+		merged[k] = v
+	}
+	settingsJSON, err := json.Marshal(merged)
+	if err != nil {
+		item.Reason = err.Error()
+		return item
+	}
+
+	name := remote.Name
+	priority := remote.Priority
+	supportsMovies := remote.Capabilities.SupportsMovies
+	supportsTV := remote.Capabilities.SupportsTV
+	enabled := remote.Enable
+	if _, err := s.indexers.Update(ctx, existing.ID, &indexer.UpdateIndexerInput{
+		Name:           &name,
+		Settings:       settingsJSON,
+		Categories:     remote.Capabilities.Categories,
+		SupportsMovies: &supportsMovies,
+		SupportsTV:     &supportsTV,
+		Priority:       &priority,
+		Enabled:        &enabled,
+	}); err != nil {
+		item.Reason = err.Error()
+		return item
+	}
+	if err := s.link(ctx, remote.ID, existing.ID); err != nil {
+		item.Reason = err.Error()
+		return item
+	}
+
+	item.IndexerID = existing.ID
+	item.Action = SyncActionUpdated
+	return item
+}
+
+func (s *IndexerSync) disableRemoved(ctx context.Context, prowlarrID, indexerID int64) IndexerSyncItem {
+	item := IndexerSyncItem{ProwlarrID: int(prowlarrID), IndexerID: indexerID, Action: SyncActionSkipped}
+
+	existing, err := s.indexers.Get(ctx, indexerID)
+	if err != nil {
+		item.Reason = err.Error()
+		return item
+	}
+	item.Name = existing.Name
+
+	if err := s.queries.DeleteProwlarrIndexerLink(ctx, prowlarrID); err != nil {
+		item.Reason = err.Error()
+		return item
+	}
+	disabled := false
+	if _, err := s.indexers.Update(ctx, indexerID, &indexer.UpdateIndexerInput{Enabled: &disabled}); err != nil {
+		item.Reason = err.Error()
+		return item
+	}
+
+	item.Action = SyncActionDisabled
+	item.Reason = "removed from Prowlarr"
+	return item
+}
+
+func (s *IndexerSync) link(ctx context.Context, prowlarrID int, indexerID int64) error {
+	if err := s.queries.UpsertProwlarrIndexerLink(ctx, sqlc.UpsertProwlarrIndexerLinkParams{
+		ProwlarrIndexerID: int64(prowlarrID),
+		IndexerID:         indexerID,
+	}); err != nil {
+		return fmt.Errorf("failed to link indexer: %w", err)
+	}
+	return nil
+}
+
+func (r *IndexerSyncResult) add(item IndexerSyncItem) {
+	switch item.Action {
+	case SyncActionCreated:
+		r.Created++
+	case SyncActionUpdated:
+		r.Updated++
+	case SyncActionDisabled:
+		r.Disabled++
+	default:
+		r.Skipped++
+	}
+	r.Items = append(r.Items, item)
+}
+
+// convertFields maps Prowlarr's Cardigann fields onto the definition's
+// settings. Prowlarr names Cardigann fields after the definition settings, so
+// only names in the schema are taken. Select values are imported only when
+// they name an option directly; Prowlarr may send an option index instead,
+// in which case the definition default applies. Returns the converted
+// settings and the names of secrets Prowlarr masked.
+func convertFields(fields []IndexerField, schema []cardigann.Setting) (settings map[string]string, masked []string) {
+	byName := make(map[string]cardigann.Setting, len(schema))
+	for _, setting := range schema {
+		byName[setting.Name] = setting
+	}
+
+	settings = make(map[string]string)
+	masked = []string{}
+	for _, field := range fields {
+		setting, ok := byName[field.Name]
+		if !ok {
+			continue
+		}
+
+		switch v := field.Value.(type) {
+		case string:
+			if v == maskedFieldValue {
+				masked = append(masked, field.Name)
+				continue
+			}
+			if setting.Type == "select" {
+				if _, ok := setting.Options[v]; !ok {
+					continue
+				}
+			}
+			settings[field.Name] = v
+		case bool:
+			settings[field.Name] = strconv.FormatBool(v)
+		case float64:
+			if setting.Type == "select" {
+				continue
+			}
+			settings[field.Name] = strconv.FormatFloat(v, 'f', -1, 64)
+		}
+	}
+	return settings, masked
+}
+
+func categoriesOrEmpty(categories []int) []int {
+	if categories == nil {
+		return []int{}
+	}
+	return categories
+}
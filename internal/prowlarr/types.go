@@ -58,15 +58,17 @@ func DefaultTVCategories() []int {
 
 // Indexer represents an indexer configured in Prowlarr.
 type Indexer struct {
-	ID           int            `json:"id"`
-	Name         string         `json:"name"`
-	Protocol     types.Protocol `json:"protocol"`
-	Privacy      types.Privacy  `json:"privacy,omitempty"`
-	Priority     int            `json:"priority"`
-	Enable       bool           `json:"enable"`
-	Status       IndexerStatus  `json:"status"`
-	Capabilities IndexerCaps    `json:"capabilities,omitempty"`
-	Fields       []IndexerField `json:"fields,omitempty"`
+	ID             int            `json:"id"`
+	Name           string         `json:"name"`
+	Implementation string         `json:"implementation,omitempty"`
+	DefinitionName string         `json:"definitionName,omitempty"`
+	Protocol       types.Protocol `json:"protocol"`
+	Privacy        types.Privacy  `json:"privacy,omitempty"`
+	Priority       int            `json:"priority"`
+	Enable         bool           `json:"enable"`
+	Status         IndexerStatus  `json:"status"`
+	Capabilities   IndexerCaps    `json:"capabilities,omitempty"`
+	Fields         []IndexerField `json:"fields,omitempty"`
 }
 
 // IndexerStatus represents the health status of a Prowlarr indexer.
@@ -251,6 +253,17 @@ func (item *TorznabItem) GetAttribute(name string) string {
 	return ""
 }
 
+// HasTag reports whether the item carries a torznab tag attribute, such as
+// "freeleech" or "internal". Unlike other attributes, tags may repeat.
+func (item *TorznabItem) HasTag(tag string) bool {
+	for _, attr := range item.Attributes {
+		if attr.Name == "tag" && strings.EqualFold(attr.Value, tag) {
+			return true
+		}
+	}
+	return false
+}
+
 // GetIntAttribute returns the integer value of a torznab attribute.
 func (item *TorznabItem) GetIntAttribute(name string, defaultVal int) int {
 	val := item.GetAttribute(name)
@@ -328,6 +341,7 @@ func (item *TorznabItem) ToReleaseInfo(indexerName string) types.ReleaseInfo {
 // ToTorrentInfo converts a TorznabItem to a TorrentInfo with extended attributes.
 func (item *TorznabItem) ToTorrentInfo(indexerName string) types.TorrentInfo {
 	release := item.ToReleaseInfo(indexerName)
+	downloadVolumeFactor := item.GetFloatAttribute("downloadvolumefactor", 1)
 
 	return types.TorrentInfo{
 		ReleaseInfo:          release,
@@ -336,8 +350,10 @@ func (item *TorznabItem) ToTorrentInfo(indexerName string) types.TorrentInfo {
 		InfoHash:             item.GetAttribute("infohash"),
 		MinimumRatio:         item.GetFloatAttribute("minimumratio", 0),
 		MinimumSeedTime:      int64(item.GetIntAttribute("minimumseedtime", 0)),
-		DownloadVolumeFactor: item.GetFloatAttribute("downloadvolumefactor", 1),
+		DownloadVolumeFactor: downloadVolumeFactor,
 		UploadVolumeFactor:   item.GetFloatAttribute("uploadvolumefactor", 1),
+		Freeleech:            downloadVolumeFactor == 0 || item.HasTag("freeleech"),
+		Internal:             item.HasTag("internal"),
 	}
 }
 
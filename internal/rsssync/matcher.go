@@ -11,25 +11,30 @@ import (
 	"github.com/slipstream/slipstream/internal/decisioning"
 	"github.com/slipstream/slipstream/internal/indexer/search"
 	"github.com/slipstream/slipstream/internal/indexer/types"
+	"github.com/slipstream/slipstream/internal/library/alttitles"
 	"github.com/slipstream/slipstream/internal/library/scanner"
 	"github.com/slipstream/slipstream/internal/module"
 )
 
 // WantedIndex provides fast lookup of wanted media items by various keys.
 type WantedIndex struct {
-	byTitle  map[string][]module.SearchableItem
-	byImdbID map[string][]module.SearchableItem
-	byTmdbID map[int][]module.SearchableItem
-	byTvdbID map[int][]module.SearchableItem
+	items      []module.SearchableItem
+	byTitle    map[string][]module.SearchableItem
+	byAltTitle map[string][]module.SearchableItem
+	byImdbID   map[string][]module.SearchableItem
+	byTmdbID   map[int][]module.SearchableItem
+	byTvdbID   map[int][]module.SearchableItem
 }
 
 // BuildWantedIndex creates a WantedIndex from module SearchableItems.
 func BuildWantedIndex(items []module.SearchableItem) *WantedIndex {
 	idx := &WantedIndex{
-		byTitle:  make(map[string][]module.SearchableItem),
-		byImdbID: make(map[string][]module.SearchableItem),
-		byTmdbID: make(map[int][]module.SearchableItem),
-		byTvdbID: make(map[int][]module.SearchableItem),
+		items:      items,
+		byTitle:    make(map[string][]module.SearchableItem),
+		byAltTitle: make(map[string][]module.SearchableItem),
+		byImdbID:   make(map[string][]module.SearchableItem),
+		byTmdbID:   make(map[int][]module.SearchableItem),
+		byTvdbID:   make(map[int][]module.SearchableItem),
 	}
 
 	for _, item := range items {
@@ -60,6 +65,23 @@ func (idx *WantedIndex) addItem(item module.SearchableItem) {
 	}
 }
 
+// AddAlternateTitles indexes wanted items under the alternate title keys of
+// their movie or series, as returned by alttitles.KeysByMedia. Movies are
+// keyed by movie ID, episodes and seasons by series ID.
+func (idx *WantedIndex) AddAlternateTitles(movieKeys, seriesKeys map[int64][]string) {
+	for _, item := range idx.items {
+		var keys []string
+		if item.GetMediaType() == mediaTypeMovie {
+			keys = movieKeys[item.GetEntityID()]
+		} else {
+			keys = seriesKeys[module.ItemSeriesID(item)]
+		}
+		for _, key := range keys {
+			idx.byAltTitle[key] = append(idx.byAltTitle[key], item)
+		}
+	}
+}
+
 // MatchResult pairs a release with the wanted item it matched.
 type MatchResult struct {
 	Release    types.TorrentInfo
@@ -147,6 +169,11 @@ func (m *Matcher) findCandidates(release *types.TorrentInfo, parsed *scanner.Par
 		return items
 	}
 
+	// AKA titles and translations, e.g. a release named after the French title
+	if items, ok := m.index.byAltTitle[alttitles.Key(parsed.Title)]; ok {
+		return items
+	}
+
 	return nil
 }
 
@@ -158,7 +185,7 @@ func (m *Matcher) matchMovie(release *types.TorrentInfo, parsed *scanner.ParsedM
 			continue
 		}
 		itemYear := module.ItemYear(item)
-		if !matchedByID && parsed.Year > 0 && itemYear > 0 && parsed.Year != itemYear {
+		if !matchedByID && !search.YearsMatch(parsed.Year, itemYear) {
 			continue
 		}
 		results = append(results, MatchResult{
@@ -275,7 +302,7 @@ func (m *Matcher) resolveSeasonEligibility(ctx context.Context, episodeItem modu
 	}
 
 	// Fall back to legacy decisioning checks
-	allMissing = decisioning.IsSeasonPackEligible(ctx, m.queries, m.logger, seriesID, season)
+	allMissing = decisioning.IsSeasonPackEligible(ctx, m.queries, m.logger, seriesID, season, decisioning.DefaultSeasonPackMissingPercent)
 	if !allMissing {
 		allUpgradable = decisioning.IsSeasonPackUpgradeEligible(ctx, m.queries, m.logger, seriesID, season)
 	}
@@ -5,6 +5,8 @@ import (
 	"testing"
 
 	"github.com/slipstream/slipstream/internal/indexer/types"
+	"github.com/slipstream/slipstream/internal/library/alttitles"
+	"github.com/slipstream/slipstream/internal/metadata"
 	"github.com/slipstream/slipstream/internal/module"
 )
 
@@ -87,6 +89,38 @@ func TestMatcher_Scenario1B_MissingMovie(t *testing.T) {
 	}
 }
 
+// A release named after a movie's translated title matches by title alone
+func TestMatcher_AlternateTitle(t *testing.T) {
+	env := setupTestEnv(t)
+	defer env.close()
+
+	ctx := context.Background()
+	movieID := env.createMovie(t, "Amélie", 194, 2001, "missing")
+	if err := alttitles.Replace(ctx, env.tdb.Conn, alttitles.MediaMovie, movieID, "Amélie", []metadata.AlternateTitle{
+		{Title: "Le Fabuleux Destin d'Amélie Poulain", Locale: "fr-FR", Kind: "translation", Source: "tmdb"},
+	}); err != nil {
+		t.Fatalf("Replace() error = %v", err)
+	}
+	movieKeys, err := alttitles.KeysByMedia(ctx, env.queries, alttitles.MediaMovie)
+	if err != nil {
+		t.Fatalf("KeysByMedia() error = %v", err)
+	}
+
+	idx := BuildWantedIndex(env.collectWantedItems(t))
+	idx.AddAlternateTitles(movieKeys, nil)
+	matcher := NewMatcher(idx, env.queries, nil, &env.tdb.Logger)
+
+	r1 := makeTorrentRelease("Le.Fabuleux.Destin.d.Amelie.Poulain.2001.1080p.BluRay.x264", "BluRay", 1080, 50)
+	if results := matcher.Match(ctx, &r1); len(results) != 1 || results[0].WantedItem.GetEntityID() != movieID {
+		t.Errorf("translated title should match the movie, got %d results", len(results))
+	}
+
+	r2 := makeTorrentRelease("Le.Fabuleux.Destin.d.Amelie.Poulain.1985.1080p.BluRay.x264", "BluRay", 1080, 50)
+	if results := matcher.Match(ctx, &r2); len(results) != 0 {
+		t.Errorf("wrong year should not match, got %d results", len(results))
+	}
+}
+
 // Scenario 4B: Missing episode — exact episode match required
 func TestMatcher_Scenario4B_MissingEpisode(t *testing.T) {
 	env := setupTestEnv(t)
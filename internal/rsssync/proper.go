@@ -0,0 +1,129 @@
+package rsssync
+
+import (
+	"context"
+	"database/sql"
+	"strconv"
+	"time"
+
+	"github.com/slipstream/slipstream/internal/decisioning"
+	"github.com/slipstream/slipstream/internal/indexer/types"
+	"github.com/slipstream/slipstream/internal/module"
+)
+
+// properWindow bounds how long after import a file that met its cutoff is
+// still watched for a PROPER or REPACK. Fixed releases follow within days.
+const properWindow = 14 * 24 * time.Hour
+
+// extraProperOnly marks wanted items that are only in the index so a PROPER
+// or REPACK of their current quality can replace the file.
+const extraProperOnly = "properOnly"
+
+// SetAutoReplaceProper sets the function reporting whether RSS sync replaces
+// files that met their cutoff when a PROPER or REPACK of their quality appears.
+func (s *Service) SetAutoReplaceProper(enabled func() bool) {
+	s.autoReplaceProper = enabled
+}
+
+// collectProperCandidates returns the recently imported movies and episodes
+// whose file met the cutoff, when PROPER replacement is enabled.
+func (s *Service) collectProperCandidates(ctx context.Context) []module.SearchableItem {
+	if s.autoReplaceProper == nil || !s.autoReplaceProper() {
+		return nil
+	}
+	since := sql.NullTime{Time: time.Now().Add(-properWindow), Valid: true}
+	var items []module.SearchableItem
+
+	movies, err := s.queries.ListProperCandidateMovies(ctx, since)
+	if err != nil {
+		s.logger.Warn().Err(err).Msg("failed to collect movies for PROPER replacement")
+	}
+	for _, row := range movies {
+		extIDs := map[string]string{}
+		if row.TmdbID.Valid {
+			extIDs["tmdbId"] = strconv.FormatInt(row.TmdbID.Int64, 10)
+		}
+		if row.ImdbID.Valid && row.ImdbID.String != "" {
+			extIDs["imdbId"] = row.ImdbID.String
+		}
+		extra := map[string]any{
+			"currentRevision": module.FileRevision(row.CurrentOriginalFilename.String, row.CurrentFilePath),
+			extraProperOnly:   true,
+		}
+		if row.Year.Valid {
+			extra["year"] = int(row.Year.Int64)
+		}
+		if row.Runtime.Valid {
+			extra["runtime"] = int(row.Runtime.Int64)
+		}
+		if row.EditionPreference.Valid {
+			extra["editionPreference"] = row.EditionPreference.String
+		}
+		qid := row.CurrentQualityID.Int64
+		items = append(items, module.NewWantedItem(module.TypeMovie, mediaTypeMovie, row.ID, row.Title,
+			extIDs, row.QualityProfileID.Int64, &qid, module.SearchParams{Extra: extra}))
+	}
+
+	episodes, err := s.queries.ListProperCandidateEpisodes(ctx, since)
+	if err != nil {
+		s.logger.Warn().Err(err).Msg("failed to collect episodes for PROPER replacement")
+	}
+	for _, row := range episodes {
+		extIDs := map[string]string{}
+		if row.SeriesTvdbID.Valid {
+			extIDs["tvdbId"] = strconv.FormatInt(row.SeriesTvdbID.Int64, 10)
+		}
+		if row.SeriesTmdbID.Valid {
+			extIDs["tmdbId"] = strconv.FormatInt(row.SeriesTmdbID.Int64, 10)
+		}
+		if row.SeriesImdbID.Valid && row.SeriesImdbID.String != "" {
+			extIDs["imdbId"] = row.SeriesImdbID.String
+		}
+		extra := map[string]any{
+			"seriesId":        row.SeriesID,
+			"seasonNumber":    int(row.SeasonNumber),
+			"episodeNumber":   int(row.EpisodeNumber),
+			"currentRevision": module.FileRevision(row.CurrentOriginalFilename.String, row.CurrentFilePath),
+			extraProperOnly:   true,
+		}
+		if row.SeriesYear.Valid {
+			extra["year"] = int(row.SeriesYear.Int64)
+		}
+		if row.SeriesRuntime.Valid {
+			extra["runtime"] = int(row.SeriesRuntime.Int64)
+		}
+		qid := row.CurrentQualityID.Int64
+		items = append(items, module.NewWantedItem(module.TypeTV, mediaTypeEpisode, row.ID, row.SeriesTitle,
+			extIDs, row.SeriesQualityProfileID.Int64, &qid, module.SearchParams{Extra: extra}))
+	}
+
+	if len(items) > 0 {
+		s.logger.Debug().Int("items", len(items)).Msg("watching files for PROPER replacement")
+	}
+	return items
+}
+
+func isProperOnly(item module.SearchableItem) bool {
+	v, _ := item.GetSearchParams().Extra[extraProperOnly].(bool)
+	return v
+}
+
+// properReleases keeps the scored releases that are a PROPER or REPACK of the
+// item's current quality, so a file that met its cutoff is never replaced by
+// a different quality.
+func properReleases(item module.SearchableItem, releases []types.TorrentInfo) []types.TorrentInfo {
+	currentRevision, _ := module.ItemCurrentRevision(item)
+	currentQualityID := module.ItemCurrentQualityID(item)
+
+	kept := releases[:0]
+	for i := range releases {
+		r := &releases[i]
+		if r.ScoreBreakdown == nil {
+			continue
+		}
+		if decisioning.IsRevisionUpgrade(r.Title, r.ScoreBreakdown.QualityID, currentQualityID, currentRevision) {
+			kept = append(kept, *r)
+		}
+	}
+	return kept
+}
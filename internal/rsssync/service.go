@@ -2,6 +2,7 @@ package rsssync
 
 import (
 	"context"
+	"errors"
 	"sort"
 	"sync"
 	"sync/atomic"
@@ -16,6 +17,8 @@ import (
 	"github.com/slipstream/slipstream/internal/indexer/grab"
 	"github.com/slipstream/slipstream/internal/indexer/scoring"
 	"github.com/slipstream/slipstream/internal/indexer/types"
+	"github.com/slipstream/slipstream/internal/library/alttitles"
+	"github.com/slipstream/slipstream/internal/library/language"
 	"github.com/slipstream/slipstream/internal/library/quality"
 	"github.com/slipstream/slipstream/internal/module"
 	"github.com/slipstream/slipstream/internal/websocket"
@@ -39,18 +42,28 @@ type SyncStatus struct {
 	Error         string    `json:"error,omitempty"`
 }
 
+// GrabDeferrer queues an item whose grab was deferred by a grab cap so it is
+// searched again once the cap allows.
+type GrabDeferrer interface {
+	DeferGrab(item module.SearchableItem)
+}
+
 // Service orchestrates RSS sync operations.
 type Service struct {
-	queries        *sqlc.Queries
-	fetcher        *FeedFetcher
-	grabService    *grab.Service
-	qualityService *quality.Service
-	historyService *history.Service
-	grabLock       *decisioning.GrabLock
-	healthService  *health.Service
-	hub            *websocket.Hub
-	logger         *zerolog.Logger
-	registry       *module.Registry
+	queries         *sqlc.Queries
+	fetcher         *FeedFetcher
+	grabService     *grab.Service
+	qualityService  *quality.Service
+	languageService *language.Service
+	historyService  *history.Service
+	grabLock        *decisioning.GrabLock
+	healthService   *health.Service
+	hub             *websocket.Hub
+	logger          *zerolog.Logger
+	registry        *module.Registry
+	grabDeferrer    GrabDeferrer
+
+	autoReplaceProper func() bool
 
 	running atomic.Bool
 	mu      sync.RWMutex
@@ -93,6 +106,16 @@ func (s *Service) SetRegistry(r *module.Registry) {
 	s.registry = r
 }
 
+// SetGrabDeferrer sets where grabs deferred by a grab cap are queued.
+func (s *Service) SetGrabDeferrer(d GrabDeferrer) {
+	s.grabDeferrer = d
+}
+
+// SetLanguageService sets the language profile service used to filter and score releases.
+func (s *Service) SetLanguageService(languageService *language.Service) {
+	s.languageService = languageService
+}
+
 // IsRunning returns whether an RSS sync is currently running.
 func (s *Service) IsRunning() bool {
 	return s.running.Load()
@@ -135,6 +158,7 @@ func (s *Service) Run(ctx context.Context) error {
 	}
 
 	index := BuildWantedIndex(wantedItems)
+	s.addAlternateTitles(ctx, index)
 	s.logger.Info().Int("wantedItems", len(wantedItems)).Msg("built wanted index")
 
 	totalReleases, allMatches := s.matchFeeds(ctx, feeds, index)
@@ -147,6 +171,20 @@ func (s *Service) Run(ctx context.Context) error {
 	return nil
 }
 
+// addAlternateTitles lets releases named after a movie's or series' AKA title
+// or translation match. Without them the index still matches primary titles.
+func (s *Service) addAlternateTitles(ctx context.Context, index *WantedIndex) {
+	movieKeys, err := alttitles.KeysByMedia(ctx, s.queries, alttitles.MediaMovie)
+	if err != nil {
+		s.logger.Warn().Err(err).Msg("failed to load movie alternate titles")
+	}
+	seriesKeys, err := alttitles.KeysByMedia(ctx, s.queries, alttitles.MediaSeries)
+	if err != nil {
+		s.logger.Warn().Err(err).Msg("failed to load series alternate titles")
+	}
+	index.AddAlternateTitles(movieKeys, seriesKeys)
+}
+
 func (s *Service) collectWantedItems(ctx context.Context, start time.Time) ([]module.SearchableItem, error) {
 	if s.registry != nil && len(s.registry.Enabled()) > 0 {
 		return s.collectWantedFromModules(ctx, start)
@@ -180,6 +218,7 @@ func (s *Service) collectWantedFromModules(ctx context.Context, start time.Time)
 		}
 		allItems = append(allItems, upgradable...)
 	}
+	allItems = append(allItems, s.collectProperCandidates(ctx)...)
 
 	if len(allItems) == 0 {
 		s.logger.Info().Msg("no wanted items from modules, skipping RSS sync")
@@ -202,6 +241,7 @@ func (s *Service) collectWantedLegacy(ctx context.Context, start time.Time) ([]m
 		s.failSync(start, err.Error())
 		return nil, err
 	}
+	legacyItems = append(legacyItems, s.collectProperCandidates(ctx)...)
 	if len(legacyItems) == 0 {
 		s.logger.Info().Msg("no wanted items, skipping RSS sync")
 		s.setStatus(&SyncStatus{LastRun: start})
@@ -389,9 +429,15 @@ func (s *Service) processGroup(ctx context.Context, scorer *scoring.Scorer, g *m
 		return false
 	}
 
-	s.scoreReleases(scorer, g, profile)
+	languageProfile := s.languageProfileForItem(ctx, g.item)
+	s.scoreReleases(scorer, g, profile, languageProfile.PreferredLanguage())
+	if isProperOnly(g.item) {
+		if g.releases = properReleases(g.item, g.releases); len(g.releases) == 0 {
+			return false
+		}
+	}
 
-	strategy := s.strategyForItem(g.item)
+	strategy := decisioning.WithLanguageProfile(s.strategyForItem(g.item), languageProfile)
 	parser := s.releaseParser()
 	best := decisioning.SelectBestRelease(g.releases, profile, g.item, strategy, parser, s.logger)
 	if best == nil {
@@ -428,6 +474,28 @@ func (s *Service) strategyForItem(item module.SearchableItem) module.SearchStrat
 	return decisioning.DefaultStrategy{}
 }
 
+// languageProfileForItem returns the language profile of the item's movie or series,
+// or nil when none is assigned or the lookup fails.
+func (s *Service) languageProfileForItem(ctx context.Context, item module.SearchableItem) *language.Profile {
+	if s.languageService == nil {
+		return nil
+	}
+
+	var profile *language.Profile
+	var err error
+	if item.GetMediaType() == mediaTypeMovie {
+		profile, err = s.languageService.ForMovie(ctx, item.GetEntityID())
+	} else {
+		profile, err = s.languageService.ForSeries(ctx, module.ItemSeriesID(item))
+	}
+	if err != nil {
+		s.logger.Warn().Err(err).Str("mediaType", item.GetMediaType()).Int64("mediaID", item.GetEntityID()).
+			Msg("failed to load language profile")
+		return nil
+	}
+	return profile
+}
+
 // releaseParser returns a function that converts release titles to ReleaseForFilter.
 func (s *Service) releaseParser() decisioning.ReleaseParser {
 	if s.registry != nil {
@@ -436,8 +504,8 @@ func (s *Service) releaseParser() decisioning.ReleaseParser {
 	return decisioning.FallbackReleaseParser
 }
 
-func (s *Service) scoreReleases(scorer *scoring.Scorer, g *matchGroup, profile *quality.Profile) {
-	scoringCtx := scoring.ScoringContext{QualityProfile: profile}
+func (s *Service) scoreReleases(scorer *scoring.Scorer, g *matchGroup, profile *quality.Profile, preferredLanguage string) {
+	scoringCtx := scoring.ScoringContext{QualityProfile: profile, Qualities: s.qualityService.Registry(), PreferredLanguage: preferredLanguage}
 	sp := g.item.GetSearchParams()
 	if g.item.GetMediaType() == mediaTypeMovie {
 		if y, ok := sp.Extra["year"].(int); ok {
@@ -501,6 +569,21 @@ func (s *Service) executeGrab(ctx context.Context, g *matchGroup, best *types.To
 	req := s.buildGrabRequest(g, best)
 
 	result, err := s.grabService.Grab(ctx, req)
+	if errors.Is(err, grab.ErrAutoGrabCapReached) {
+		s.logger.Info().Str("title", best.Title).Msg("RSS grab deferred by grab cap")
+		if s.grabDeferrer != nil {
+			s.grabDeferrer.DeferGrab(g.item)
+		}
+		return false
+	}
+	if errors.Is(err, grab.ErrLowFreeSpace) {
+		s.logger.Info().Str("title", best.Title).Msg("RSS grab skipped: library free space below threshold")
+		return false
+	}
+	if errors.Is(err, grab.ErrPendingApproval) {
+		s.logger.Info().Str("title", best.Title).Msg("RSS grab held for approval")
+		return false
+	}
 	if err != nil {
 		s.logger.Warn().Err(err).Str("title", best.Title).Msg("RSS grab failed")
 		s.logGrabFailed(ctx, g.item, err.Error())
@@ -536,6 +619,7 @@ func (s *Service) buildGrabRequest(g *matchGroup, best *types.TorrentInfo) *grab
 		MediaType: g.item.GetMediaType(),
 		MediaID:   g.item.GetEntityID(),
 	}
+	req.SetScore(best)
 
 	// TargetSlotID from search params (if present)
 	if slotID, ok := sp.Extra["targetSlotId"].(*int64); ok {
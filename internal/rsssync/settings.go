@@ -16,8 +16,9 @@ const settingsKey = "rsssync_settings"
 
 // Settings represents user-configurable RSS sync settings.
 type Settings struct {
-	Enabled     bool `json:"enabled"`
-	IntervalMin int  `json:"intervalMin"`
+	Enabled           bool `json:"enabled"`
+	IntervalMin       int  `json:"intervalMin"`
+	AutoReplaceProper bool `json:"autoReplaceProper"`
 }
 
 // ScheduleUpdater is a function that updates the RSS sync task schedule.
@@ -81,6 +82,7 @@ func (h *SettingsHandler) UpdateSettings(c echo.Context) error {
 
 	h.config.Enabled = input.Enabled
 	h.config.IntervalMin = input.IntervalMin
+	h.config.AutoReplaceProper = input.AutoReplaceProper
 
 	if h.scheduler != nil && h.scheduleUpdater != nil {
 		if err := h.scheduleUpdater(h.scheduler, h.service, h.config); err != nil {
@@ -95,16 +97,18 @@ func (h *SettingsHandler) loadSettings(ctx context.Context) *Settings {
 	row, err := h.queries.GetSetting(ctx, settingsKey)
 	if err != nil {
 		return &Settings{
-			Enabled:     h.config.Enabled,
-			IntervalMin: h.config.IntervalMin,
+			Enabled:           h.config.Enabled,
+			IntervalMin:       h.config.IntervalMin,
+			AutoReplaceProper: h.config.AutoReplaceProper,
 		}
 	}
 
 	var settings Settings
 	if err := json.Unmarshal([]byte(row.Value), &settings); err != nil {
 		return &Settings{
-			Enabled:     h.config.Enabled,
-			IntervalMin: h.config.IntervalMin,
+			Enabled:           h.config.Enabled,
+			IntervalMin:       h.config.IntervalMin,
+			AutoReplaceProper: h.config.AutoReplaceProper,
 		}
 	}
 
@@ -132,6 +136,7 @@ func LoadSettingsIntoConfig(ctx context.Context, queries *sqlc.Queries, cfg *con
 		if err = json.Unmarshal([]byte(row.Value), &settings); err == nil {
 			cfg.Enabled = settings.Enabled
 			cfg.IntervalMin = settings.IntervalMin
+			cfg.AutoReplaceProper = settings.AutoReplaceProper
 		}
 	}
 	return nil
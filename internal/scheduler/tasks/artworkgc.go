@@ -0,0 +1,49 @@
+package tasks
+
+import (
+	"context"
+	"time"
+
+	"github.com/slipstream/slipstream/internal/library/librarymanager"
+	"github.com/slipstream/slipstream/internal/metadata"
+	"github.com/slipstream/slipstream/internal/metadata/imagecache"
+	"github.com/slipstream/slipstream/internal/scheduler"
+)
+
+// ArtworkGCTaskID identifies the weekly artwork cache garbage collection task.
+const ArtworkGCTaskID = "artwork-gc"
+
+// imageCacheMaxAge is how long a cached or resized image may go unserved
+// before the cleanup removes it.
+const imageCacheMaxAge = 30 * 24 * time.Hour
+
+// RegisterArtworkGCTask registers a weekly task that releases artwork held by
+// items no longer in the library, deletes images nothing references and
+// prunes image cache entries that have not been served recently.
+// Dev mode shares the artwork cache with production, so library-based release
+// is skipped while it is active.
+func RegisterArtworkGCTask(sched *scheduler.Scheduler, lm *librarymanager.Service, artwork *metadata.ArtworkDownloader, images *imagecache.Cache, isDevMode func() bool) error {
+	return sched.RegisterTask(&scheduler.TaskConfig{
+		ID:          ArtworkGCTaskID,
+		Name:        "Artwork Cleanup",
+		Description: "Removes cached artwork that no library item references",
+		Cron:        "0 5 * * 0", // 5:00 AM every Sunday
+		RunOnStart:  false,
+		Func: func(ctx context.Context) error {
+			if !isDevMode() {
+				if err := lm.ReleaseOrphanedArtwork(ctx); err != nil {
+					return err
+				}
+			}
+			if _, err := artwork.CollectGarbage(); err != nil {
+				return err
+			}
+			if images != nil {
+				if _, err := images.Prune(imageCacheMaxAge); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+	})
+}
@@ -0,0 +1,77 @@
+package tasks
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/slipstream/slipstream/internal/autosearch"
+	"github.com/slipstream/slipstream/internal/config"
+	"github.com/slipstream/slipstream/internal/scheduler"
+)
+
+const (
+	// SearchMissingTaskID identifies the paced search for all missing items.
+	SearchMissingTaskID = "search-missing"
+	// SearchCutoffUnmetTaskID identifies the paced search for items below their quality cutoff.
+	SearchCutoffUnmetTaskID = "search-cutoff-unmet"
+)
+
+// defaultCutoffUnmetHour is when the cutoff unmet search starts without an upgrade window.
+const defaultCutoffUnmetHour = 3
+
+// buildCutoffUnmetCronExpr starts the cutoff unmet search when the upgrade window opens.
+func buildCutoffUnmetCronExpr(cfg *config.AutoSearchConfig) string {
+	hour := defaultCutoffUnmetHour
+	if cfg.UpgradeWindowStartHour != cfg.UpgradeWindowEndHour {
+		hour = cfg.UpgradeWindowStartHour
+	}
+	return fmt.Sprintf("0 %d * * *", hour)
+}
+
+// RegisterBatchSearchTasks registers the daily paced library searches. Both walk the
+// library in batches and resume from their saved position after a restart.
+func RegisterBatchSearchTasks(sched *scheduler.Scheduler, searcher *autosearch.ScheduledSearcher, cfg *config.AutoSearchConfig) error {
+	if err := sched.RegisterTask(&scheduler.TaskConfig{
+		ID:          SearchMissingTaskID,
+		Name:        "Search All Missing",
+		Description: "Searches every missing monitored movie and episode in paced batches",
+		Cron:        "0 2 * * *", // 2:00 AM daily
+		RunOnStart:  false,
+		Func: func(ctx context.Context) error {
+			return searcher.RunMissingBatch(ctx)
+		},
+	}); err != nil {
+		return err
+	}
+
+	return registerCutoffUnmetTask(sched, searcher, cfg)
+}
+
+func registerCutoffUnmetTask(sched *scheduler.Scheduler, searcher *autosearch.ScheduledSearcher, cfg *config.AutoSearchConfig) error {
+	return sched.RegisterTask(&scheduler.TaskConfig{
+		ID:          SearchCutoffUnmetTaskID,
+		Name:        "Search Cutoff Unmet",
+		Description: "Searches for upgrades to every item below its quality cutoff in paced batches",
+		Cron:        buildCutoffUnmetCronExpr(cfg),
+		RunOnStart:  false,
+		Func: func(ctx context.Context) error {
+			return searcher.RunCutoffUnmetBatch(ctx)
+		},
+	})
+}
+
+// UpdateCutoffUnmetTask reschedules the cutoff unmet search for a changed upgrade window.
+func UpdateCutoffUnmetTask(sched *scheduler.Scheduler, searcher *autosearch.ScheduledSearcher, cfg *config.AutoSearchConfig) error {
+	if err := sched.UnregisterTask(SearchCutoffUnmetTaskID); err != nil {
+		return fmt.Errorf("failed to unregister cutoff unmet task: %w", err)
+	}
+	return registerCutoffUnmetTask(sched, searcher, cfg)
+}
+
+// UpdateAutoSearchTasks applies changed autosearch settings to every task they schedule.
+func UpdateAutoSearchTasks(sched *scheduler.Scheduler, searcher *autosearch.ScheduledSearcher, cfg *config.AutoSearchConfig) error {
+	if err := UpdateAutoSearchTask(sched, searcher, cfg); err != nil {
+		return err
+	}
+	return UpdateCutoffUnmetTask(sched, searcher, cfg)
+}
@@ -0,0 +1,21 @@
+package tasks
+
+import (
+	"github.com/slipstream/slipstream/internal/backup"
+	"github.com/slipstream/slipstream/internal/scheduler"
+)
+
+const DatabaseBackupTaskID = "database-backup"
+
+// RegisterDatabaseBackupTask registers the database backup task with the scheduler.
+// The task runs daily at 3 AM and keeps the configured number of scheduled backups.
+func RegisterDatabaseBackupTask(sched *scheduler.Scheduler, backupService *backup.Service) error {
+	return sched.RegisterTask(&scheduler.TaskConfig{
+		ID:          DatabaseBackupTaskID,
+		Name:        "Database Backup",
+		Description: "Backs up the database and config file, keeping recent rotations",
+		Cron:        "0 3 * * *",
+		RunOnStart:  false,
+		Func:        backupService.RunScheduledBackup,
+	})
+}
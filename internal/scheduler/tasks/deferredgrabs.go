@@ -0,0 +1,22 @@
+package tasks
+
+import (
+	"github.com/slipstream/slipstream/internal/autosearch"
+	"github.com/slipstream/slipstream/internal/scheduler"
+)
+
+const DeferredGrabsTaskID = "deferred-grabs"
+
+// RegisterDeferredGrabsTask registers the deferred grab retry task with the scheduler.
+// The task runs every 15 minutes and searches again for items whose automatic
+// grab was held back by a grab cap, once the cap allows it.
+func RegisterDeferredGrabsTask(sched *scheduler.Scheduler, searcher *autosearch.ScheduledSearcher) error {
+	return sched.RegisterTask(&scheduler.TaskConfig{
+		ID:          DeferredGrabsTaskID,
+		Name:        "Deferred Grabs",
+		Description: "Retries automatic grabs that were deferred by grab caps",
+		Cron:        "*/15 * * * *",
+		RunOnStart:  false,
+		Func:        searcher.RunDeferred,
+	})
+}
@@ -0,0 +1,27 @@
+package tasks
+
+import (
+	"context"
+
+	"github.com/slipstream/slipstream/internal/library/librarymanager"
+	"github.com/slipstream/slipstream/internal/scheduler"
+)
+
+const DiskRescanTaskID = "disk-rescan"
+
+// RegisterDiskRescanTask registers the disk rescan task with the scheduler.
+// The task runs daily at 4 AM and reconciles tracked files with what is on disk,
+// catching deletions, replacements and new files made outside SlipStream.
+func RegisterDiskRescanTask(sched *scheduler.Scheduler, lm *librarymanager.Service) error {
+	return sched.RegisterTask(&scheduler.TaskConfig{
+		ID:          DiskRescanTaskID,
+		Name:        "Disk Rescan",
+		Description: "Verifies library files on disk, refreshes changed files and links untracked ones",
+		Cron:        "0 4 * * *",
+		RunOnStart:  false,
+		Func: func(ctx context.Context) error {
+			_, err := lm.RescanDisk(ctx)
+			return err
+		},
+	})
+}
@@ -2,6 +2,7 @@ package tasks
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
@@ -18,19 +19,25 @@ type DownloadClientHealthTask struct {
 	downloader *downloader.Service
 	health     *health.Service
 	logger     *zerolog.Logger
+
+	freeSpaceWarning int64
+	freeSpaceError   int64
 }
 
 // NewDownloadClientHealthTask creates a new download client health check task.
 func NewDownloadClientHealthTask(
 	downloaderSvc *downloader.Service,
 	healthSvc *health.Service,
+	cfg *config.HealthConfig,
 	logger *zerolog.Logger,
 ) *DownloadClientHealthTask {
 	subLogger := logger.With().Str("component", "scheduler").Str("task", "download-client-health").Logger()
 	return &DownloadClientHealthTask{
-		downloader: downloaderSvc,
-		health:     healthSvc,
-		logger:     &subLogger,
+		downloader:       downloaderSvc,
+		health:           healthSvc,
+		logger:           &subLogger,
+		freeSpaceWarning: cfg.DownloadClientFreeSpaceWarningBytes(),
+		freeSpaceError:   cfg.DownloadClientFreeSpaceErrorBytes(),
 	}
 }
 
@@ -56,40 +63,74 @@ func (t *DownloadClientHealthTask) Run(ctx context.Context) error {
 			continue
 		}
 
-		clientIDStr := fmt.Sprintf("%d", client.ID)
-
-		// Check if downloads are in progress - skip test and mark OK if so
-		hasActive, err := t.downloader.HasActiveDownloads(ctx, client.ID)
-		if err != nil {
-			t.logger.Debug().Err(err).Int64("clientId", client.ID).Str("name", client.Name).Msg("Could not check for active downloads, proceeding with test")
-		} else if hasActive {
-			// Client is working since downloads are active - mark OK and skip test
-			t.health.ClearStatus(health.CategoryDownloadClients, clientIDStr)
-			t.logger.Debug().Int64("clientId", client.ID).Str("name", client.Name).Msg("Skipping health check - downloads in progress")
+		if t.checkClient(ctx, client) {
+			checkedCount++
+		} else {
 			skippedCount++
-			continue
 		}
+	}
 
-		// Test the connection
-		result, err := t.downloader.Test(ctx, client.ID)
-		if err != nil {
-			t.health.SetError(health.CategoryDownloadClients, clientIDStr, err.Error())
-			t.logger.Warn().Err(err).Int64("clientId", client.ID).Str("name", client.Name).Msg("Download client health check failed")
-			continue
-		}
+	t.logger.Info().Int("checked", checkedCount).Int("skipped", skippedCount).Int("total", len(clients)).Msg("Download client health check completed")
+	return nil
+}
 
-		if result.Success {
-			t.health.ClearStatus(health.CategoryDownloadClients, clientIDStr)
-			t.logger.Debug().Int64("clientId", client.ID).Str("name", client.Name).Msg("Download client health check passed")
-		} else {
-			t.health.SetError(health.CategoryDownloadClients, clientIDStr, result.Message)
-			t.logger.Warn().Int64("clientId", client.ID).Str("name", client.Name).Str("message", result.Message).Msg("Download client health check failed")
+// checkClient tests a single client and updates its health status.
+// Returns false if the connection test was skipped because downloads are active.
+func (t *DownloadClientHealthTask) checkClient(ctx context.Context, client *downloader.DownloadClient) bool {
+	clientIDStr := fmt.Sprintf("%d", client.ID)
+
+	// Check if downloads are in progress - skip test and mark OK if so
+	hasActive, err := t.downloader.HasActiveDownloads(ctx, client.ID)
+	if err != nil {
+		t.logger.Debug().Err(err).Int64("clientId", client.ID).Str("name", client.Name).Msg("Could not check for active downloads, proceeding with test")
+	} else if hasActive {
+		// Client is working since downloads are active - only disk space needs checking
+		t.logger.Debug().Int64("clientId", client.ID).Str("name", client.Name).Msg("Skipping connection test - downloads in progress")
+		t.checkFreeSpace(ctx, client, clientIDStr)
+		return false
+	}
+
+	// Test the connection
+	result, err := t.downloader.Test(ctx, client.ID)
+	if err != nil {
+		t.health.SetError(health.CategoryDownloadClients, clientIDStr, err.Error())
+		t.logger.Warn().Err(err).Int64("clientId", client.ID).Str("name", client.Name).Msg("Download client health check failed")
+		return true
+	}
+
+	if result.Success {
+		t.logger.Debug().Int64("clientId", client.ID).Str("name", client.Name).Msg("Download client health check passed")
+		t.checkFreeSpace(ctx, client, clientIDStr)
+	} else {
+		t.health.SetError(health.CategoryDownloadClients, clientIDStr, result.Message)
+		t.logger.Warn().Int64("clientId", client.ID).Str("name", client.Name).Str("message", result.Message).Msg("Download client health check failed")
+	}
+	return true
+}
+
+// checkFreeSpace sets a warning or error when the client's disk is nearly full,
+// and clears the status otherwise. Clients that cannot report free space are marked OK.
+func (t *DownloadClientHealthTask) checkFreeSpace(ctx context.Context, client *downloader.DownloadClient, clientIDStr string) {
+	free, err := t.downloader.GetFreeSpace(ctx, client.ID)
+	if err != nil {
+		if !errors.Is(err, downloader.ErrNotImplemented) {
+			t.logger.Debug().Err(err).Int64("clientId", client.ID).Str("name", client.Name).Msg("Could not get download client free space")
 		}
-		checkedCount++
+		t.health.ClearStatus(health.CategoryDownloadClients, clientIDStr)
+		return
 	}
 
-	t.logger.Info().Int("checked", checkedCount).Int("skipped", skippedCount).Int("total", len(clients)).Msg("Download client health check completed")
-	return nil
+	freeGB := float64(free) / (1 << 30)
+	switch {
+	case free < t.freeSpaceError:
+		t.health.SetError(health.CategoryDownloadClients, clientIDStr, fmt.Sprintf("Critically low disk space: %.1f GB free", freeGB))
+		t.logger.Warn().Int64("clientId", client.ID).Str("name", client.Name).Float64("freeGB", freeGB).Msg("Download client disk space critically low")
+	case free < t.freeSpaceWarning:
+		t.health.SetWarning(health.CategoryDownloadClients, clientIDStr, fmt.Sprintf("Low disk space: %.1f GB free", freeGB))
+		t.logger.Info().Int64("clientId", client.ID).Str("name", client.Name).Float64("freeGB", freeGB).Msg("Download client disk space low")
+	default:
+		t.health.ClearStatus(health.CategoryDownloadClients, clientIDStr)
+	}
 }
 
 // RegisterDownloadClientHealthTask registers the download client health check task with the scheduler.
@@ -100,7 +141,7 @@ func RegisterDownloadClientHealthTask(
 	cfg *config.HealthConfig,
 	logger *zerolog.Logger,
 ) error {
-	task := NewDownloadClientHealthTask(downloaderSvc, healthSvc, logger)
+	task := NewDownloadClientHealthTask(downloaderSvc, healthSvc, cfg, logger)
 
 	interval := cfg.DownloadClientCheckInterval
 	if interval == 0 {
@@ -113,7 +154,7 @@ func RegisterDownloadClientHealthTask(
 	return sched.RegisterTask(&scheduler.TaskConfig{
 		ID:          "download-client-health",
 		Name:        "Download Client Health Check",
-		Description: "Tests connectivity to all download clients",
+		Description: "Tests connectivity and free disk space of all download clients",
 		Cron:        cronExpr,
 		RunOnStart:  true,
 		Func:        task.Run,
@@ -0,0 +1,35 @@
+package tasks
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/slipstream/slipstream/internal/config"
+	"github.com/slipstream/slipstream/internal/health"
+	"github.com/slipstream/slipstream/internal/scheduler"
+)
+
+const HealthChecksTaskID = "health-checks"
+
+// RegisterHealthChecksTask registers the task that runs every check
+// registered with the health service, such as import folder permissions,
+// hardlink support, definition freshness and stalled downloads.
+func RegisterHealthChecksTask(sched *scheduler.Scheduler, healthSvc *health.Service, cfg *config.HealthConfig) error {
+	interval := cfg.ChecksInterval
+	if interval == 0 {
+		interval = 15 * time.Minute
+	}
+
+	return sched.RegisterTask(&scheduler.TaskConfig{
+		ID:          HealthChecksTaskID,
+		Name:        "Health Checks",
+		Description: "Checks import folders, hardlink support, indexer definitions and stalled downloads",
+		Cron:        fmt.Sprintf("@every %s", interval.String()),
+		RunOnStart:  true,
+		Func: func(ctx context.Context) error {
+			healthSvc.RunChecks(ctx)
+			return nil
+		},
+	})
+}
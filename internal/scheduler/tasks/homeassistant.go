@@ -0,0 +1,21 @@
+package tasks
+
+import (
+	"github.com/slipstream/slipstream/internal/homeassistant"
+	"github.com/slipstream/slipstream/internal/scheduler"
+)
+
+const HomeAssistantMQTTTaskID = "homeassistant-mqtt"
+
+// RegisterHomeAssistantMQTTTask registers the MQTT state publishing task.
+// The task is a no-op unless MQTT publishing is enabled in settings.
+func RegisterHomeAssistantMQTTTask(sched *scheduler.Scheduler, haService *homeassistant.Service) error {
+	return sched.RegisterTask(&scheduler.TaskConfig{
+		ID:          HomeAssistantMQTTTaskID,
+		Name:        "Home Assistant MQTT",
+		Description: "Publishes changed library, queue, and health state to MQTT",
+		Cron:        "* * * * *",
+		RunOnStart:  false,
+		Func:        haService.PublishChanges,
+	})
+}
@@ -0,0 +1,21 @@
+package tasks
+
+import (
+	"github.com/slipstream/slipstream/internal/indexer/status"
+	"github.com/slipstream/slipstream/internal/scheduler"
+)
+
+const IndexerHistoryCleanupTaskID = "indexer-history-cleanup"
+
+// RegisterIndexerHistoryCleanupTask registers the indexer history cleanup task with the scheduler.
+// The task runs daily at 2:30 AM to delete query and grab history older than the retention period.
+func RegisterIndexerHistoryCleanupTask(sched *scheduler.Scheduler, statusService *status.Service) error {
+	return sched.RegisterTask(&scheduler.TaskConfig{
+		ID:          IndexerHistoryCleanupTaskID,
+		Name:        "Indexer History Cleanup",
+		Description: "Deletes indexer query and grab history older than the retention period",
+		Cron:        "30 2 * * *",
+		RunOnStart:  false,
+		Func:        statusService.PruneHistory,
+	})
+}
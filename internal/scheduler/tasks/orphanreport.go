@@ -0,0 +1,27 @@
+package tasks
+
+import (
+	"context"
+
+	"github.com/slipstream/slipstream/internal/library/librarymanager"
+	"github.com/slipstream/slipstream/internal/scheduler"
+)
+
+const OrphanReportTaskID = "orphan-report"
+
+// RegisterOrphanReportTask registers the orphan report task with the scheduler.
+// The task runs weekly on Sunday at 5 AM and lists untracked video files and
+// file records whose paths are missing, without changing anything.
+func RegisterOrphanReportTask(sched *scheduler.Scheduler, lm *librarymanager.Service) error {
+	return sched.RegisterTask(&scheduler.TaskConfig{
+		ID:          OrphanReportTaskID,
+		Name:        "Orphan Report",
+		Description: "Finds video files not linked to the library and records pointing at missing files",
+		Cron:        "0 5 * * 0",
+		RunOnStart:  false,
+		Func: func(ctx context.Context) error {
+			_, err := lm.BuildOrphanReport(ctx)
+			return err
+		},
+	})
+}
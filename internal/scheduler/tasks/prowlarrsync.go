@@ -0,0 +1,22 @@
+package tasks
+
+import (
+	"github.com/slipstream/slipstream/internal/prowlarr"
+	"github.com/slipstream/slipstream/internal/scheduler"
+)
+
+// ProwlarrSyncTaskID identifies the Prowlarr indexer sync task.
+const ProwlarrSyncTaskID = "prowlarr-indexer-sync"
+
+// RegisterProwlarrSyncTask registers the task that pulls indexer definitions and
+// credentials from Prowlarr every six hours. It is a no-op until sync is enabled.
+func RegisterProwlarrSyncTask(sched *scheduler.Scheduler, indexerSync *prowlarr.IndexerSync) error {
+	return sched.RegisterTask(&scheduler.TaskConfig{
+		ID:          ProwlarrSyncTaskID,
+		Name:        "Prowlarr Indexer Sync",
+		Description: "Imports indexers and their credentials from Prowlarr",
+		Cron:        "0 */6 * * *",
+		RunOnStart:  false,
+		Func:        indexerSync.RunScheduled,
+	})
+}
@@ -0,0 +1,27 @@
+package tasks
+
+import (
+	"context"
+
+	"github.com/slipstream/slipstream/internal/library/librarymanager"
+	"github.com/slipstream/slipstream/internal/scheduler"
+)
+
+const QualityAuditTaskID = "quality-audit"
+
+// RegisterQualityAuditTask registers the quality audit task with the scheduler.
+// The task runs weekly on Sunday at 6 AM and flags files whose resolution,
+// bitrate or codec contradicts their recorded quality, without changing anything.
+func RegisterQualityAuditTask(sched *scheduler.Scheduler, lm *librarymanager.Service) error {
+	return sched.RegisterTask(&scheduler.TaskConfig{
+		ID:          QualityAuditTaskID,
+		Name:        "Quality Audit",
+		Description: "Flags files that look like transcodes or upscales of their recorded quality",
+		Cron:        "0 6 * * 0",
+		RunOnStart:  false,
+		Func: func(ctx context.Context) error {
+			_, err := lm.BuildQualityAuditReport(ctx)
+			return err
+		},
+	})
+}
@@ -0,0 +1,31 @@
+package tasks
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/slipstream/slipstream/internal/config"
+	"github.com/slipstream/slipstream/internal/library/rootfolder"
+	"github.com/slipstream/slipstream/internal/scheduler"
+)
+
+const RootFolderHealthTaskID = "root-folder-health"
+
+// RegisterRootFolderHealthTask registers the root folder check with the
+// scheduler. It runs on the storage check interval, refreshing each root
+// folder's free and total space and flagging folders whose mount is unreachable.
+func RegisterRootFolderHealthTask(sched *scheduler.Scheduler, rf *rootfolder.Service, cfg *config.HealthConfig) error {
+	interval := cfg.StorageCheckInterval
+	if interval == 0 {
+		interval = 1 * time.Hour
+	}
+
+	return sched.RegisterTask(&scheduler.TaskConfig{
+		ID:          RootFolderHealthTaskID,
+		Name:        "Root Folder Check",
+		Description: "Refreshes root folder free space and detects unreachable mounts",
+		Cron:        fmt.Sprintf("@every %s", interval.String()),
+		RunOnStart:  true,
+		Func:        rf.RefreshAllFreeSpace,
+	})
+}
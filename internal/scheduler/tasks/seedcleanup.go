@@ -0,0 +1,22 @@
+package tasks
+
+import (
+	"github.com/slipstream/slipstream/internal/downloader"
+	"github.com/slipstream/slipstream/internal/scheduler"
+)
+
+const SeedCleanupTaskID = "seed-cleanup"
+
+// RegisterSeedCleanupTask registers the seed cleanup task with the scheduler.
+// The task runs every 30 minutes and removes imported torrents that have reached
+// their client or indexer seeding goal.
+func RegisterSeedCleanupTask(sched *scheduler.Scheduler, downloaderService *downloader.Service) error {
+	return sched.RegisterTask(&scheduler.TaskConfig{
+		ID:          SeedCleanupTaskID,
+		Name:        "Seed Cleanup",
+		Description: "Removes imported torrents that have reached their seeding goals",
+		Cron:        "*/30 * * * *",
+		RunOnStart:  false,
+		Func:        downloaderService.CleanupSeededDownloads,
+	})
+}
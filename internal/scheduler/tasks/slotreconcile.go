@@ -0,0 +1,28 @@
+package tasks
+
+import (
+	"context"
+
+	"github.com/slipstream/slipstream/internal/library/slots"
+	"github.com/slipstream/slipstream/internal/scheduler"
+)
+
+const SlotReconcileTaskID = "slot-reconcile"
+
+// RegisterSlotReconcileTask registers the slot reconciliation task with the scheduler.
+// The task runs daily at 4:30 AM, after the disk rescan, and clears or flags
+// multi-version slot assignments whose files are gone or no longer match the
+// slot profile. It does nothing while multi-version mode is off.
+func RegisterSlotReconcileTask(sched *scheduler.Scheduler, slotsSvc *slots.Service) error {
+	return sched.RegisterTask(&scheduler.TaskConfig{
+		ID:          SlotReconcileTaskID,
+		Name:        "Slot Reconciliation",
+		Description: "Verifies version slot assignments against files and refills stale slots",
+		Cron:        "30 4 * * *",
+		RunOnStart:  false,
+		Func: func(ctx context.Context) error {
+			_, err := slotsSvc.ReconcileAssignments(ctx)
+			return err
+		},
+	})
+}
@@ -0,0 +1,22 @@
+package tasks
+
+import (
+	"github.com/slipstream/slipstream/internal/downloader"
+	"github.com/slipstream/slipstream/internal/scheduler"
+)
+
+const StalledDownloadsTaskID = "stalled-downloads"
+
+// RegisterStalledDownloadsTask registers the stalled downloads task with the
+// scheduler. The task runs every 5 minutes, re-announcing grabbed torrents that
+// stopped making progress and failing those that stay stalled.
+func RegisterStalledDownloadsTask(sched *scheduler.Scheduler, downloaderService *downloader.Service) error {
+	return sched.RegisterTask(&scheduler.TaskConfig{
+		ID:          StalledDownloadsTaskID,
+		Name:        "Stalled Downloads",
+		Description: "Re-announces stalled torrents and fails those that make no progress",
+		Cron:        "*/5 * * * *",
+		RunOnStart:  false,
+		Func:        downloaderService.NudgeStalledDownloads,
+	})
+}
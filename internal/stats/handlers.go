@@ -0,0 +1,46 @@
+package stats
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+)
+
+// maxDays bounds the activity window a request can ask for.
+const maxDays = 365
+
+// Handlers provides HTTP handlers for statistics.
+type Handlers struct {
+	service *Service
+}
+
+// NewHandlers creates new stats handlers.
+func NewHandlers(service *Service) *Handlers {
+	return &Handlers{service: service}
+}
+
+// RegisterRoutes registers the stats routes.
+func (h *Handlers) RegisterRoutes(g *echo.Group) {
+	g.GET("", h.Get)
+}
+
+// Get returns library totals, disk usage by root folder and quality, daily
+// grabs and imports, and the indexers with the most grabs.
+// GET /api/v1/stats?days=30
+func (h *Handlers) Get(c echo.Context) error {
+	days := 30
+	if v := c.QueryParam("days"); v != "" {
+		parsed, err := strconv.Atoi(v)
+		if err != nil || parsed <= 0 || parsed > maxDays {
+			return echo.NewHTTPError(http.StatusBadRequest, "invalid days")
+		}
+		days = parsed
+	}
+
+	stats, err := h.service.GetStats(c.Request().Context(), days)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	return c.JSON(http.StatusOK, stats)
+}
@@ -0,0 +1,210 @@
+// Package stats aggregates library and download statistics for the dashboard.
+package stats
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/rs/zerolog"
+
+	"github.com/slipstream/slipstream/internal/database/sqlc"
+)
+
+// topIndexerLimit caps how many indexers are ranked by grabs.
+const topIndexerLimit = 10
+
+const dayLayout = "2006-01-02"
+
+// Stats is an aggregate snapshot of the library and its download history.
+type Stats struct {
+	Totals      Totals             `json:"totals"`
+	RootFolders []*RootFolderUsage `json:"rootFolders"`
+	Qualities   []*QualityUsage    `json:"qualities"`
+	Daily       []*DailyActivity   `json:"daily"`
+	TopIndexers []*IndexerGrabs    `json:"topIndexers"`
+	Since       time.Time          `json:"since"`
+}
+
+// Totals counts titles, episodes and files in the library.
+type Totals struct {
+	Movies       int64 `json:"movies"`
+	Series       int64 `json:"series"`
+	Episodes     int64 `json:"episodes"`
+	MovieFiles   int64 `json:"movieFiles"`
+	EpisodeFiles int64 `json:"episodeFiles"`
+	Files        int64 `json:"files"`
+	SizeBytes    int64 `json:"sizeBytes"`
+}
+
+// RootFolderUsage is the library's footprint in one root folder.
+type RootFolderUsage struct {
+	ID         int64  `json:"id"`
+	Name       string `json:"name"`
+	Path       string `json:"path"`
+	Files      int64  `json:"files"`
+	UsedBytes  int64  `json:"usedBytes"`
+	FreeSpace  *int64 `json:"freeSpace,omitempty"`
+	TotalSpace *int64 `json:"totalSpace,omitempty"`
+}
+
+// QualityUsage is the number and size of files at one quality.
+type QualityUsage struct {
+	Quality string `json:"quality"`
+	Files   int64  `json:"files"`
+	Bytes   int64  `json:"bytes"`
+}
+
+// DailyActivity counts grabs and imports on one day (UTC, YYYY-MM-DD).
+type DailyActivity struct {
+	Date    string `json:"date"`
+	Grabs   int64  `json:"grabs"`
+	Imports int64  `json:"imports"`
+}
+
+// IndexerGrabs is the number of releases grabbed from one indexer.
+type IndexerGrabs struct {
+	Indexer string `json:"indexer"`
+	Grabs   int64  `json:"grabs"`
+}
+
+// Service provides aggregate statistics.
+type Service struct {
+	queries *sqlc.Queries
+	logger  *zerolog.Logger
+}
+
+// NewService creates a new stats service.
+func NewService(db *sql.DB, logger *zerolog.Logger) *Service {
+	subLogger := logger.With().Str("component", "stats").Logger()
+	return &Service{
+		queries: sqlc.New(db),
+		logger:  &subLogger,
+	}
+}
+
+// SetDB updates the database connection used by this service.
+func (s *Service) SetDB(db *sql.DB) {
+	s.queries = sqlc.New(db)
+}
+
+// GetStats returns library totals, disk usage and download activity over the
+// last days days, including today.
+func (s *Service) GetStats(ctx context.Context, days int) (*Stats, error) {
+	now := time.Now().UTC()
+	start := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC).AddDate(0, 0, -(days - 1))
+	since := sql.NullTime{Time: start, Valid: true}
+
+	result := &Stats{Since: start}
+
+	totals, err := s.queries.GetLibraryTotals(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get library totals: %w", err)
+	}
+	result.Totals = Totals{
+		Movies:       totals.Movies,
+		Series:       totals.Series,
+		Episodes:     totals.Episodes,
+		MovieFiles:   totals.MovieFiles,
+		EpisodeFiles: totals.EpisodeFiles,
+		Files:        totals.MovieFiles + totals.EpisodeFiles,
+		SizeBytes:    totals.MovieBytes + totals.EpisodeBytes,
+	}
+
+	if result.RootFolders, err = s.rootFolderUsage(ctx); err != nil {
+		return nil, err
+	}
+	if result.Qualities, err = s.qualityUsage(ctx); err != nil {
+		return nil, err
+	}
+	if result.Daily, err = s.dailyActivity(ctx, since, days); err != nil {
+		return nil, err
+	}
+	if result.TopIndexers, err = s.topIndexers(ctx, since); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (s *Service) rootFolderUsage(ctx context.Context) ([]*RootFolderUsage, error) {
+	rows, err := s.queries.GetDiskUsageByRootFolder(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get root folder usage: %w", err)
+	}
+	usage := make([]*RootFolderUsage, len(rows))
+	for i, row := range rows {
+		usage[i] = &RootFolderUsage{
+			ID:        row.RootFolderID,
+			Name:      row.Name,
+			Path:      row.Path,
+			Files:     row.Files,
+			UsedBytes: row.UsedBytes,
+		}
+		if row.FreeSpace.Valid {
+			usage[i].FreeSpace = &row.FreeSpace.Int64
+		}
+		if row.TotalSpace.Valid {
+			usage[i].TotalSpace = &row.TotalSpace.Int64
+		}
+	}
+	return usage, nil
+}
+
+func (s *Service) qualityUsage(ctx context.Context) ([]*QualityUsage, error) {
+	rows, err := s.queries.GetDiskUsageByQuality(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get quality usage: %w", err)
+	}
+	usage := make([]*QualityUsage, len(rows))
+	for i, row := range rows {
+		usage[i] = &QualityUsage{Quality: row.Quality, Files: row.Files, Bytes: row.Bytes}
+	}
+	return usage, nil
+}
+
+// dailyActivity returns one entry per day from since, so days without any
+// activity still appear in charts.
+func (s *Service) dailyActivity(ctx context.Context, since sql.NullTime, days int) ([]*DailyActivity, error) {
+	rows, err := s.queries.GetHistoryCountsByDay(ctx, since)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get daily activity: %w", err)
+	}
+
+	daily := make([]*DailyActivity, days)
+	byDate := make(map[string]*DailyActivity, days)
+	for i := range daily {
+		date := since.Time.AddDate(0, 0, i).Format(dayLayout)
+		daily[i] = &DailyActivity{Date: date}
+		byDate[date] = daily[i]
+	}
+
+	for _, row := range rows {
+		day, ok := byDate[row.Day]
+		if !ok {
+			continue
+		}
+		switch row.EventType {
+		case "grabbed", "autosearch_download":
+			day.Grabs += row.Count
+		case "imported":
+			day.Imports += row.Count
+		}
+	}
+	return daily, nil
+}
+
+func (s *Service) topIndexers(ctx context.Context, since sql.NullTime) ([]*IndexerGrabs, error) {
+	rows, err := s.queries.GetTopGrabIndexers(ctx, sqlc.GetTopGrabIndexersParams{
+		Since:    since,
+		RowLimit: topIndexerLimit,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get top indexers: %w", err)
+	}
+	indexers := make([]*IndexerGrabs, len(rows))
+	for i, row := range rows {
+		indexers[i] = &IndexerGrabs{Indexer: row.Indexer, Grabs: row.Grabs}
+	}
+	return indexers, nil
+}
@@ -0,0 +1,71 @@
+package stats
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/slipstream/slipstream/internal/testutil"
+)
+
+func TestGetStats(t *testing.T) {
+	tdb := testutil.NewTestDB(t)
+	defer tdb.Close()
+	ctx := context.Background()
+
+	exec := func(query string, args ...any) {
+		t.Helper()
+		if _, err := tdb.Conn.ExecContext(ctx, query, args...); err != nil {
+			t.Fatalf("exec %q: %v", query, err)
+		}
+	}
+
+	exec(`INSERT INTO root_folders (id, path, name, module_type) VALUES (1, '/movies', 'Movies', 'movie')`)
+	exec(`INSERT INTO movies (id, title, sort_title, root_folder_id) VALUES (1, 'A', 'a', 1), (2, 'B', 'b', 1)`)
+	exec(`INSERT INTO movie_files (movie_id, path, size, quality) VALUES (1, '/movies/a.mkv', 100, 'Bluray-1080p')`)
+	exec(`INSERT INTO movie_files (movie_id, path, size, quality) VALUES (2, '/movies/b.mkv', 50, '')`)
+
+	// History rows are stamped by CURRENT_TIMESTAMP, which stores UTC text.
+	today := time.Now().UTC()
+	now := today.Format(time.DateTime)
+	old := today.AddDate(0, 0, -30).Format(time.DateTime)
+	exec(`INSERT INTO history (event_type, module_type, entity_type, entity_id, data, created_at) VALUES ('grabbed', 'movie', 'movie', 1, '{"indexer":"Alpha"}', ?)`, now)
+	exec(`INSERT INTO history (event_type, module_type, entity_type, entity_id, data, created_at) VALUES ('autosearch_download', 'movie', 'movie', 2, '{"indexer":"Alpha"}', ?)`, now)
+	exec(`INSERT INTO history (event_type, module_type, entity_type, entity_id, data, created_at) VALUES ('imported', 'movie', 'movie', 1, '{}', ?)`, now)
+	exec(`INSERT INTO history (event_type, module_type, entity_type, entity_id, data, created_at) VALUES ('grabbed', 'movie', 'movie', 1, '{"indexer":"Beta"}', ?)`, old)
+
+	svc := NewService(tdb.Conn, &tdb.Logger)
+	stats, err := svc.GetStats(ctx, 7)
+	if err != nil {
+		t.Fatalf("GetStats() error = %v", err)
+	}
+
+	if stats.Totals.Movies != 2 || stats.Totals.Files != 2 || stats.Totals.SizeBytes != 150 {
+		t.Errorf("Totals = %+v, want 2 movies, 2 files, 150 bytes", stats.Totals)
+	}
+
+	if len(stats.RootFolders) != 1 || stats.RootFolders[0].UsedBytes != 150 || stats.RootFolders[0].Files != 2 {
+		t.Errorf("RootFolders = %+v, want one folder using 150 bytes in 2 files", stats.RootFolders)
+	}
+
+	if len(stats.Qualities) != 2 || stats.Qualities[0].Quality != "Bluray-1080p" || stats.Qualities[1].Quality != "Unknown" {
+		t.Errorf("Qualities = %+v, want Bluray-1080p then Unknown", stats.Qualities)
+	}
+
+	if len(stats.Daily) != 7 {
+		t.Fatalf("len(Daily) = %d, want 7", len(stats.Daily))
+	}
+	last := stats.Daily[6]
+	if last.Date != today.Format(dayLayout) || last.Grabs != 2 || last.Imports != 1 {
+		t.Errorf("Daily[6] = %+v, want today with 2 grabs and 1 import", last)
+	}
+	for _, day := range stats.Daily[:6] {
+		if day.Grabs != 0 || day.Imports != 0 {
+			t.Errorf("Daily %s = %+v, want no activity", day.Date, day)
+		}
+	}
+
+	if len(stats.TopIndexers) != 1 || stats.TopIndexers[0].Indexer != "Alpha" || stats.TopIndexers[0].Grabs != 2 {
+		t.Errorf("TopIndexers = %+v, want only Alpha with 2 grabs", stats.TopIndexers)
+	}
+}
@@ -0,0 +1,119 @@
+package stream
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/slipstream/slipstream/internal/portal/middleware"
+)
+
+// Handlers provides HTTP handlers for direct file streaming.
+type Handlers struct {
+	service *Service
+}
+
+// NewHandlers creates new stream handlers.
+func NewHandlers(service *Service) *Handlers {
+	return &Handlers{service: service}
+}
+
+// RegisterRoutes registers the file streaming routes. The group must be
+// authenticated so the portal claims are available.
+func (h *Handlers) RegisterRoutes(g *echo.Group) {
+	g.GET("/:mediaType/:id", h.ServeFile)
+	g.HEAD("/:mediaType/:id", h.ServeFile)
+}
+
+// RegisterAdminRoutes registers the bandwidth report on an admin-only group.
+func (h *Handlers) RegisterAdminRoutes(g *echo.Group) {
+	g.GET("/bandwidth", h.GetBandwidth)
+}
+
+// ServeFile streams a library file with HTTP range support.
+// GET /api/v1/stream/:mediaType/:id
+func (h *Handlers) ServeFile(c echo.Context) error {
+	ctx := c.Request().Context()
+	claims := middleware.GetPortalUser(c)
+
+	if err := h.service.Authorize(ctx, claims); err != nil {
+		if errors.Is(err, ErrForbidden) {
+			return echo.NewHTTPError(http.StatusForbidden, err.Error())
+		}
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid id")
+	}
+
+	file, err := h.service.ResolveFile(ctx, MediaType(c.Param("mediaType")), id)
+	if err != nil {
+		switch {
+		case errors.Is(err, ErrInvalidMediaType):
+			return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+		case errors.Is(err, ErrFileNotFound):
+			return echo.NewHTTPError(http.StatusNotFound, err.Error())
+		}
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	f, err := os.Open(file.Path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return echo.NewHTTPError(http.StatusNotFound, "file missing from disk")
+		}
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	defer f.Close()
+
+	info, err := f.Stat()
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	started := time.Now()
+	http.ServeContent(c.Response(), c.Request(), filepath.Base(file.Path), info.ModTime(), f)
+
+	// Response.Size counts the body bytes actually written, which is what
+	// a player that seeks or disconnects early really consumed.
+	if c.Request().Method == http.MethodGet {
+		h.service.RecordSession(context.WithoutCancel(ctx), &Session{
+			UserID:     claims.UserID,
+			MediaType:  file.MediaType,
+			FileID:     file.ID,
+			BytesSent:  c.Response().Size,
+			RemoteAddr: c.RealIP(),
+			StartedAt:  started,
+			EndedAt:    time.Now(),
+		})
+	}
+	return nil
+}
+
+// GetBandwidth returns streamed bytes per user.
+// GET /api/v1/stream/bandwidth?days=30
+func (h *Handlers) GetBandwidth(c echo.Context) error {
+	days := 30
+	if v := c.QueryParam("days"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n <= 0 {
+			return echo.NewHTTPError(http.StatusBadRequest, "days must be a positive integer")
+		}
+		days = n
+	}
+
+	since := time.Now().AddDate(0, 0, -days)
+	usage, err := h.service.BandwidthSince(c.Request().Context(), since)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	return c.JSON(http.StatusOK, usage)
+}
@@ -0,0 +1,169 @@
+package stream
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/rs/zerolog"
+
+	"github.com/slipstream/slipstream/internal/database/sqlc"
+	"github.com/slipstream/slipstream/internal/portal"
+)
+
+// MediaType identifies which library table a streamed file belongs to.
+type MediaType string
+
+const (
+	MediaTypeMovie   MediaType = "movie"
+	MediaTypeEpisode MediaType = "episode"
+)
+
+var (
+	ErrInvalidMediaType = errors.New("invalid media type")
+	ErrFileNotFound     = errors.New("file not found")
+	ErrForbidden        = errors.New("streaming is not permitted for this user")
+)
+
+// File is a library file resolved for streaming.
+type File struct {
+	MediaType MediaType
+	ID        int64
+	Path      string
+	Size      int64
+}
+
+// Session records a single served stream response.
+type Session struct {
+	UserID     int64
+	MediaType  MediaType
+	FileID     int64
+	BytesSent  int64
+	RemoteAddr string
+	StartedAt  time.Time
+	EndedAt    time.Time
+}
+
+// UserBandwidth summarizes streamed bytes for one user.
+type UserBandwidth struct {
+	UserID    *int64 `json:"userId"`
+	Username  string `json:"username"`
+	Sessions  int64  `json:"sessions"`
+	BytesSent int64  `json:"bytesSent"`
+}
+
+// Service resolves library files for direct streaming and accounts bandwidth.
+type Service struct {
+	db      *sql.DB
+	queries *sqlc.Queries
+	logger  *zerolog.Logger
+}
+
+// NewService creates a new stream service.
+func NewService(db *sql.DB, logger *zerolog.Logger) *Service {
+	subLogger := logger.With().Str("component", "stream").Logger()
+	return &Service{
+		db:      db,
+		queries: sqlc.New(db),
+		logger:  &subLogger,
+	}
+}
+
+// SetDB updates the database connection used by this service.
+func (s *Service) SetDB(db *sql.DB) {
+	s.db = db
+	s.queries = sqlc.New(db)
+}
+
+// Authorize reports whether the authenticated user may stream files.
+// Admins always may; portal users need can_stream and an enabled account.
+func (s *Service) Authorize(ctx context.Context, claims *portal.Claims) error {
+	if claims == nil {
+		return ErrForbidden
+	}
+	if claims.Role == portal.RoleAdmin {
+		return nil
+	}
+	user, err := s.queries.GetPortalUser(ctx, claims.UserID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return ErrForbidden
+		}
+		return err
+	}
+	if user.IsAdmin || (user.Enabled && user.CanStream) {
+		return nil
+	}
+	return ErrForbidden
+}
+
+// ResolveFile looks up the on-disk path of a movie or episode file.
+func (s *Service) ResolveFile(ctx context.Context, mediaType MediaType, fileID int64) (*File, error) {
+	switch mediaType {
+	case MediaTypeMovie:
+		f, err := s.queries.GetMovieFile(ctx, fileID)
+		if err != nil {
+			return nil, notFound(err)
+		}
+		return &File{MediaType: mediaType, ID: f.ID, Path: f.Path, Size: f.Size}, nil
+	case MediaTypeEpisode:
+		f, err := s.queries.GetEpisodeFile(ctx, fileID)
+		if err != nil {
+			return nil, notFound(err)
+		}
+		return &File{MediaType: mediaType, ID: f.ID, Path: f.Path, Size: f.Size}, nil
+	default:
+		return nil, ErrInvalidMediaType
+	}
+}
+
+// RecordSession logs a served response. Failures are logged, not returned,
+// since the bytes have already been delivered.
+func (s *Service) RecordSession(ctx context.Context, session *Session) {
+	var userID sql.NullInt64
+	if session.UserID > 0 {
+		userID = sql.NullInt64{Int64: session.UserID, Valid: true}
+	}
+	err := s.queries.CreateStreamSession(ctx, sqlc.CreateStreamSessionParams{
+		UserID:     userID,
+		MediaType:  string(session.MediaType),
+		FileID:     session.FileID,
+		BytesSent:  session.BytesSent,
+		RemoteAddr: session.RemoteAddr,
+		StartedAt:  session.StartedAt,
+		EndedAt:    session.EndedAt,
+	})
+	if err != nil {
+		s.logger.Warn().Err(err).Int64("fileId", session.FileID).Msg("Failed to record stream session")
+	}
+}
+
+// BandwidthSince returns per-user streamed bytes for sessions started at or after since.
+func (s *Service) BandwidthSince(ctx context.Context, since time.Time) ([]UserBandwidth, error) {
+	rows, err := s.queries.ListStreamBandwidthByUser(ctx, since)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]UserBandwidth, 0, len(rows))
+	for _, row := range rows {
+		entry := UserBandwidth{
+			Username:  row.Username,
+			Sessions:  row.Sessions,
+			BytesSent: row.BytesSent,
+		}
+		if row.UserID.Valid {
+			id := row.UserID.Int64
+			entry.UserID = &id
+		}
+		result = append(result, entry)
+	}
+	return result, nil
+}
+
+func notFound(err error) error {
+	if errors.Is(err, sql.ErrNoRows) {
+		return ErrFileNotFound
+	}
+	return err
+}
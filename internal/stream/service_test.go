@@ -0,0 +1,125 @@
+package stream
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/slipstream/slipstream/internal/database/sqlc"
+	"github.com/slipstream/slipstream/internal/portal"
+	"github.com/slipstream/slipstream/internal/testutil"
+)
+
+func TestService_Authorize(t *testing.T) {
+	tdb := testutil.NewTestDB(t)
+	defer tdb.Close()
+
+	service := NewService(tdb.Conn, &tdb.Logger)
+	ctx := context.Background()
+	queries := sqlc.New(tdb.Conn)
+
+	user, err := queries.CreatePortalUser(ctx, sqlc.CreatePortalUserParams{
+		Username:     "viewer",
+		PasswordHash: "x",
+		Enabled:      true,
+	})
+	if err != nil {
+		t.Fatalf("CreatePortalUser() error = %v", err)
+	}
+	claims := &portal.Claims{UserID: user.ID, Role: portal.RoleUser}
+
+	if err := service.Authorize(ctx, claims); !errors.Is(err, ErrForbidden) {
+		t.Errorf("Authorize() without can_stream error = %v, want ErrForbidden", err)
+	}
+
+	if _, err := queries.UpdatePortalUserCanStream(ctx, sqlc.UpdatePortalUserCanStreamParams{ID: user.ID, CanStream: true}); err != nil {
+		t.Fatalf("UpdatePortalUserCanStream() error = %v", err)
+	}
+	if err := service.Authorize(ctx, claims); err != nil {
+		t.Errorf("Authorize() with can_stream error = %v, want nil", err)
+	}
+
+	if _, err := queries.UpdatePortalUserEnabled(ctx, sqlc.UpdatePortalUserEnabledParams{ID: user.ID, Enabled: false}); err != nil {
+		t.Fatalf("UpdatePortalUserEnabled() error = %v", err)
+	}
+	if err := service.Authorize(ctx, claims); !errors.Is(err, ErrForbidden) {
+		t.Errorf("Authorize() for disabled user error = %v, want ErrForbidden", err)
+	}
+
+	if err := service.Authorize(ctx, &portal.Claims{UserID: 999, Role: portal.RoleAdmin}); err != nil {
+		t.Errorf("Authorize() for admin error = %v, want nil", err)
+	}
+	if err := service.Authorize(ctx, nil); !errors.Is(err, ErrForbidden) {
+		t.Errorf("Authorize(nil) error = %v, want ErrForbidden", err)
+	}
+}
+
+func TestService_ResolveFile_InvalidMediaType(t *testing.T) {
+	tdb := testutil.NewTestDB(t)
+	defer tdb.Close()
+
+	service := NewService(tdb.Conn, &tdb.Logger)
+
+	if _, err := service.ResolveFile(context.Background(), "album", 1); !errors.Is(err, ErrInvalidMediaType) {
+		t.Errorf("ResolveFile() error = %v, want ErrInvalidMediaType", err)
+	}
+	if _, err := service.ResolveFile(context.Background(), MediaTypeMovie, 42); !errors.Is(err, ErrFileNotFound) {
+		t.Errorf("ResolveFile() error = %v, want ErrFileNotFound", err)
+	}
+}
+
+func TestService_BandwidthSince(t *testing.T) {
+	tdb := testutil.NewTestDB(t)
+	defer tdb.Close()
+
+	service := NewService(tdb.Conn, &tdb.Logger)
+	ctx := context.Background()
+
+	user, err := sqlc.New(tdb.Conn).CreatePortalUser(ctx, sqlc.CreatePortalUserParams{
+		Username:     "viewer",
+		PasswordHash: "x",
+		Enabled:      true,
+	})
+	if err != nil {
+		t.Fatalf("CreatePortalUser() error = %v", err)
+	}
+
+	now := time.Now()
+	for _, sent := range []int64{1000, 500} {
+		service.RecordSession(ctx, &Session{
+			UserID:    user.ID,
+			MediaType: MediaTypeMovie,
+			FileID:    1,
+			BytesSent: sent,
+			StartedAt: now,
+			EndedAt:   now,
+		})
+	}
+	service.RecordSession(ctx, &Session{
+		UserID:    user.ID,
+		MediaType: MediaTypeEpisode,
+		FileID:    2,
+		BytesSent: 9999,
+		StartedAt: now.AddDate(0, 0, -60),
+		EndedAt:   now.AddDate(0, 0, -60),
+	})
+
+	usage, err := service.BandwidthSince(ctx, now.AddDate(0, 0, -30))
+	if err != nil {
+		t.Fatalf("BandwidthSince() error = %v", err)
+	}
+	if len(usage) != 1 {
+		t.Fatalf("BandwidthSince() returned %d rows, want 1", len(usage))
+	}
+	got := usage[0]
+	if got.UserID == nil || *got.UserID != user.ID {
+		t.Errorf("UserID = %v, want %d", got.UserID, user.ID)
+	}
+	if got.Username != "viewer" {
+		t.Errorf("Username = %q, want %q", got.Username, "viewer")
+	}
+	if got.Sessions != 2 || got.BytesSent != 1500 {
+		t.Errorf("Sessions/BytesSent = %d/%d, want 2/1500", got.Sessions, got.BytesSent)
+	}
+}
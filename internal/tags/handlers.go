@@ -0,0 +1,142 @@
+package tags
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+)
+
+// Handlers provides HTTP handlers for tag operations.
+type Handlers struct {
+	service *Service
+}
+
+// NewHandlers creates new tag handlers.
+func NewHandlers(service *Service) *Handlers {
+	return &Handlers{service: service}
+}
+
+// RegisterRoutes registers the tag routes.
+func (h *Handlers) RegisterRoutes(g *echo.Group) {
+	g.GET("", h.List)
+	g.POST("", h.Create)
+	g.GET("/detail", h.ListUsage)
+	g.GET("/:id", h.Get)
+	g.GET("/:id/detail", h.Usage)
+	g.PUT("/:id", h.Update)
+	g.DELETE("/:id", h.Delete)
+}
+
+// List returns all tags.
+// GET /api/v1/tags
+func (h *Handlers) List(c echo.Context) error {
+	tags, err := h.service.List(c.Request().Context())
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	return c.JSON(http.StatusOK, tags)
+}
+
+// ListUsage returns all tags with what each is applied to.
+// GET /api/v1/tags/detail
+func (h *Handlers) ListUsage(c echo.Context) error {
+	usage, err := h.service.ListUsage(c.Request().Context())
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	return c.JSON(http.StatusOK, usage)
+}
+
+// Get returns a single tag.
+// GET /api/v1/tags/:id
+func (h *Handlers) Get(c echo.Context) error {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid id")
+	}
+
+	tag, err := h.service.Get(c.Request().Context(), id)
+	if err != nil {
+		return mapError(err)
+	}
+	return c.JSON(http.StatusOK, tag)
+}
+
+// Usage returns what a tag is applied to.
+// GET /api/v1/tags/:id/detail
+func (h *Handlers) Usage(c echo.Context) error {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid id")
+	}
+
+	usage, err := h.service.Usage(c.Request().Context(), id)
+	if err != nil {
+		return mapError(err)
+	}
+	return c.JSON(http.StatusOK, usage)
+}
+
+// Create creates a new tag.
+// POST /api/v1/tags
+func (h *Handlers) Create(c echo.Context) error {
+	var input Input
+	if err := c.Bind(&input); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	tag, err := h.service.Create(c.Request().Context(), &input)
+	if err != nil {
+		return mapError(err)
+	}
+	return c.JSON(http.StatusCreated, tag)
+}
+
+// Update renames a tag.
+// PUT /api/v1/tags/:id
+func (h *Handlers) Update(c echo.Context) error {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid id")
+	}
+
+	var input Input
+	if err := c.Bind(&input); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	tag, err := h.service.Update(c.Request().Context(), id, &input)
+	if err != nil {
+		return mapError(err)
+	}
+	return c.JSON(http.StatusOK, tag)
+}
+
+// Delete deletes a tag that is not in use.
+// DELETE /api/v1/tags/:id
+func (h *Handlers) Delete(c echo.Context) error {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid id")
+	}
+
+	if err := h.service.Delete(c.Request().Context(), id); err != nil {
+		return mapError(err)
+	}
+	return c.NoContent(http.StatusNoContent)
+}
+
+func mapError(err error) error {
+	switch {
+	case errors.Is(err, ErrTagNotFound):
+		return echo.NewHTTPError(http.StatusNotFound, err.Error())
+	case errors.Is(err, ErrTagExists), errors.Is(err, ErrTagInUse):
+		return echo.NewHTTPError(http.StatusConflict, err.Error())
+	case errors.Is(err, ErrInvalidTag), errors.Is(err, ErrInvalidEntityTag):
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	default:
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+}
@@ -0,0 +1,333 @@
+package tags
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/rs/zerolog"
+
+	"github.com/slipstream/slipstream/internal/database/sqlc"
+)
+
+// Service provides tag operations and tag assignments for other entities.
+type Service struct {
+	db      *sql.DB
+	queries *sqlc.Queries
+	logger  *zerolog.Logger
+}
+
+// NewService creates a new tag service.
+func NewService(db *sql.DB, logger *zerolog.Logger) *Service {
+	subLogger := logger.With().Str("component", "tags").Logger()
+	return &Service{
+		db:      db,
+		queries: sqlc.New(db),
+		logger:  &subLogger,
+	}
+}
+
+// SetDB updates the database connection used by this service.
+func (s *Service) SetDB(db *sql.DB) {
+	s.db = db
+	s.queries = sqlc.New(db)
+}
+
+// Get retrieves a tag by ID.
+func (s *Service) Get(ctx context.Context, id int64) (*Tag, error) {
+	row, err := s.queries.GetTag(ctx, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrTagNotFound
+		}
+		return nil, fmt.Errorf("failed to get tag: %w", err)
+	}
+	return rowToTag(row), nil
+}
+
+// List returns all tags ordered by label.
+func (s *Service) List(ctx context.Context) ([]*Tag, error) {
+	rows, err := s.queries.ListTags(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list tags: %w", err)
+	}
+	tags := make([]*Tag, len(rows))
+	for i, row := range rows {
+		tags[i] = rowToTag(row)
+	}
+	return tags, nil
+}
+
+// Create creates a tag. Labels are unique regardless of case.
+func (s *Service) Create(ctx context.Context, input *Input) (*Tag, error) {
+	label, err := s.validateLabel(ctx, input.Label, 0)
+	if err != nil {
+		return nil, err
+	}
+	row, err := s.queries.CreateTag(ctx, label)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create tag: %w", err)
+	}
+	s.logger.Info().Int64("id", row.ID).Str("label", row.Label).Msg("Created tag")
+	return rowToTag(row), nil
+}
+
+// Update renames a tag.
+func (s *Service) Update(ctx context.Context, id int64, input *Input) (*Tag, error) {
+	if _, err := s.Get(ctx, id); err != nil {
+		return nil, err
+	}
+	label, err := s.validateLabel(ctx, input.Label, id)
+	if err != nil {
+		return nil, err
+	}
+	row, err := s.queries.UpdateTag(ctx, sqlc.UpdateTagParams{Label: label, ID: id})
+	if err != nil {
+		return nil, fmt.Errorf("failed to update tag: %w", err)
+	}
+	return rowToTag(row), nil
+}
+
+// Delete deletes a tag that is not applied to anything.
+func (s *Service) Delete(ctx context.Context, id int64) error {
+	usage, err := s.Usage(ctx, id)
+	if err != nil {
+		return err
+	}
+	if usage.InUse() {
+		return ErrTagInUse
+	}
+	if err := s.queries.DeleteTag(ctx, id); err != nil {
+		return fmt.Errorf("failed to delete tag: %w", err)
+	}
+	return nil
+}
+
+// Usage returns what a single tag is applied to.
+func (s *Service) Usage(ctx context.Context, id int64) (*Usage, error) {
+	tag, err := s.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	all, err := s.ListUsage(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, u := range all {
+		if u.ID == id {
+			return u, nil
+		}
+	}
+	return newUsage(tag), nil
+}
+
+// ListUsage returns every tag with what it is applied to.
+func (s *Service) ListUsage(ctx context.Context) ([]*Usage, error) {
+	tags, err := s.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	byID := make(map[int64]*Usage, len(tags))
+	result := make([]*Usage, len(tags))
+	for i, tag := range tags {
+		result[i] = newUsage(tag)
+		byID[tag.ID] = result[i]
+	}
+
+	links, err := s.queries.ListAllEntityTags(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list tag assignments: %w", err)
+	}
+	for _, link := range links {
+		u, ok := byID[link.TagID]
+		if !ok {
+			continue
+		}
+		switch link.EntityType {
+		case EntityMovie:
+			u.MovieIDs = append(u.MovieIDs, link.EntityID)
+		case EntitySeries:
+			u.SeriesIDs = append(u.SeriesIDs, link.EntityID)
+		case EntityIndexer:
+			u.IndexerIDs = append(u.IndexerIDs, link.EntityID)
+		case EntityDownloadClient:
+			u.DownloadClientIDs = append(u.DownloadClientIDs, link.EntityID)
+		}
+	}
+
+	notifications, err := s.queries.ListNotifications(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list notifications: %w", err)
+	}
+	for _, n := range notifications {
+		for _, tagID := range decodeTagIDs(n.Tags) {
+			if u, ok := byID[tagID]; ok {
+				u.NotificationIDs = append(u.NotificationIDs, n.ID)
+			}
+		}
+	}
+	return result, nil
+}
+
+// EntityTags returns the tag IDs applied to an entity.
+func (s *Service) EntityTags(ctx context.Context, entityType string, entityID int64) ([]int64, error) {
+	ids, err := s.queries.ListEntityTags(ctx, sqlc.ListEntityTagsParams{EntityType: entityType, EntityID: entityID})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list entity tags: %w", err)
+	}
+	return ids, nil
+}
+
+// EntityTagMap returns the tag IDs of every tagged entity of entityType,
+// keyed by entity ID.
+func (s *Service) EntityTagMap(ctx context.Context, entityType string) (map[int64][]int64, error) {
+	rows, err := s.queries.ListEntityTagsByType(ctx, entityType)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list entity tags: %w", err)
+	}
+	result := make(map[int64][]int64)
+	for _, row := range rows {
+		result[row.EntityID] = append(result[row.EntityID], row.TagID)
+	}
+	return result, nil
+}
+
+// SetEntityTags replaces the tags applied to an entity.
+func (s *Service) SetEntityTags(ctx context.Context, entityType string, entityID int64, tagIDs []int64) error {
+	if !isTaggableEntity(entityType) {
+		return fmt.Errorf("%w: %s", ErrInvalidEntityTag, entityType)
+	}
+	tagIDs = dedupe(tagIDs)
+	for _, id := range tagIDs {
+		if _, err := s.Get(ctx, id); err != nil {
+			return err
+		}
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	q := s.queries.WithTx(tx)
+	if err := q.DeleteEntityTags(ctx, sqlc.DeleteEntityTagsParams{EntityType: entityType, EntityID: entityID}); err != nil {
+		return fmt.Errorf("failed to clear entity tags: %w", err)
+	}
+	for _, id := range tagIDs {
+		if err := q.AddEntityTag(ctx, sqlc.AddEntityTagParams{TagID: id, EntityType: entityType, EntityID: entityID}); err != nil {
+			return fmt.Errorf("failed to add entity tag: %w", err)
+		}
+	}
+	return tx.Commit()
+}
+
+// ItemTags returns the tags of the library item a search or grab is for.
+// Movies carry their own tags; episodes and seasons use their series' tags.
+// A resolved item always gets a non-nil slice; nil means the item could not
+// be resolved, and callers skip tag filtering.
+func (s *Service) ItemTags(ctx context.Context, mediaType string, mediaID, seriesID int64) []int64 {
+	entityType := EntitySeries
+	entityID := seriesID
+	switch mediaType {
+	case EntityMovie:
+		entityType, entityID = EntityMovie, mediaID
+	case EntitySeries:
+		entityID = mediaID
+	case "season":
+		if entityID == 0 {
+			entityID = mediaID
+		}
+	case "episode":
+		if entityID == 0 {
+			if ep, err := s.queries.GetEpisode(ctx, mediaID); err == nil {
+				entityID = ep.SeriesID
+			}
+		}
+	default:
+		return nil
+	}
+	if entityID == 0 {
+		return nil
+	}
+
+	ids, err := s.EntityTags(ctx, entityType, entityID)
+	if err != nil {
+		s.logger.Warn().Err(err).Str("entityType", entityType).Int64("entityId", entityID).Msg("Failed to look up item tags")
+		return nil
+	}
+	if ids == nil {
+		ids = []int64{}
+	}
+	return ids
+}
+
+func (s *Service) validateLabel(ctx context.Context, label string, id int64) (string, error) {
+	label = strings.TrimSpace(label)
+	if label == "" {
+		return "", fmt.Errorf("%w: label is required", ErrInvalidTag)
+	}
+	existing, err := s.queries.GetTagByLabel(ctx, label)
+	if err == nil && existing.ID != id {
+		return "", ErrTagExists
+	}
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return "", fmt.Errorf("failed to check tag label: %w", err)
+	}
+	return label, nil
+}
+
+func isTaggableEntity(entityType string) bool {
+	switch entityType {
+	case EntityMovie, EntitySeries, EntityIndexer, EntityDownloadClient:
+		return true
+	}
+	return false
+}
+
+func dedupe(ids []int64) []int64 {
+	seen := make(map[int64]bool, len(ids))
+	out := make([]int64, 0, len(ids))
+	for _, id := range ids {
+		if !seen[id] {
+			seen[id] = true
+			out = append(out, id)
+		}
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i] < out[j] })
+	return out
+}
+
+func decodeTagIDs(raw string) []int64 {
+	var ids []int64
+	if raw == "" {
+		return nil
+	}
+	if err := json.Unmarshal([]byte(raw), &ids); err != nil {
+		return nil
+	}
+	return ids
+}
+
+func newUsage(tag *Tag) *Usage {
+	return &Usage{
+		Tag:               *tag,
+		MovieIDs:          []int64{},
+		SeriesIDs:         []int64{},
+		IndexerIDs:        []int64{},
+		DownloadClientIDs: []int64{},
+		NotificationIDs:   []int64{},
+	}
+}
+
+func rowToTag(row *sqlc.Tag) *Tag {
+	tag := &Tag{ID: row.ID, Label: row.Label}
+	if row.CreatedAt.Valid {
+		tag.CreatedAt = row.CreatedAt.Time
+	}
+	return tag
+}
@@ -0,0 +1,102 @@
+package tags
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/slipstream/slipstream/internal/database/sqlc"
+	"github.com/slipstream/slipstream/internal/testutil"
+)
+
+func newTestService(t *testing.T) (*Service, *sqlc.Queries) {
+	t.Helper()
+	tdb := testutil.NewTestDB(t)
+	t.Cleanup(tdb.Close)
+	return NewService(tdb.Conn, &tdb.Logger), sqlc.New(tdb.Conn)
+}
+
+func TestMatches(t *testing.T) {
+	tests := []struct {
+		name     string
+		resource []int64
+		item     []int64
+		want     bool
+	}{
+		{"untagged resource serves untagged item", nil, nil, true},
+		{"untagged resource serves tagged item", nil, []int64{1}, true},
+		{"tagged resource skips untagged item", []int64{1}, nil, false},
+		{"shared tag", []int64{1, 2}, []int64{2, 3}, true},
+		{"no shared tag", []int64{1}, []int64{2}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Matches(tt.resource, tt.item); got != tt.want {
+				t.Errorf("Matches(%v, %v) = %v, want %v", tt.resource, tt.item, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestService_CreateRejectsDuplicateLabel(t *testing.T) {
+	svc, _ := newTestService(t)
+	ctx := context.Background()
+
+	if _, err := svc.Create(ctx, &Input{Label: "4k"}); err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+	if _, err := svc.Create(ctx, &Input{Label: " 4K "}); !errors.Is(err, ErrTagExists) {
+		t.Errorf("Create() duplicate error = %v, want ErrTagExists", err)
+	}
+	if _, err := svc.Create(ctx, &Input{Label: "  "}); !errors.Is(err, ErrInvalidTag) {
+		t.Errorf("Create() blank error = %v, want ErrInvalidTag", err)
+	}
+}
+
+func TestService_EntityTagsAndUsage(t *testing.T) {
+	svc, queries := newTestService(t)
+	ctx := context.Background()
+
+	anime, err := svc.Create(ctx, &Input{Label: "anime"})
+	if err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+	kids, err := svc.Create(ctx, &Input{Label: "kids"})
+	if err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+
+	movie, err := queries.CreateMovie(ctx, sqlc.CreateMovieParams{Title: "Akira", SortTitle: "Akira", Status: "missing"})
+	if err != nil {
+		t.Fatalf("CreateMovie() error = %v", err)
+	}
+
+	if err := svc.SetEntityTags(ctx, EntityMovie, movie.ID, []int64{kids.ID, anime.ID, anime.ID}); err != nil {
+		t.Fatalf("SetEntityTags() error = %v", err)
+	}
+	if got := svc.ItemTags(ctx, EntityMovie, movie.ID, 0); len(got) != 2 || got[0] != anime.ID || got[1] != kids.ID {
+		t.Errorf("ItemTags() = %v, want [%d %d]", got, anime.ID, kids.ID)
+	}
+	if err := svc.SetEntityTags(ctx, EntityMovie, movie.ID, []int64{999}); !errors.Is(err, ErrTagNotFound) {
+		t.Errorf("SetEntityTags() unknown tag error = %v, want ErrTagNotFound", err)
+	}
+
+	if err := svc.Delete(ctx, anime.ID); !errors.Is(err, ErrTagInUse) {
+		t.Errorf("Delete() in-use error = %v, want ErrTagInUse", err)
+	}
+	usage, err := svc.Usage(ctx, anime.ID)
+	if err != nil {
+		t.Fatalf("Usage() error = %v", err)
+	}
+	if len(usage.MovieIDs) != 1 || usage.MovieIDs[0] != movie.ID {
+		t.Errorf("Usage().MovieIDs = %v, want [%d]", usage.MovieIDs, movie.ID)
+	}
+
+	// Deleting the movie drops its assignments, freeing the tag
+	if err := queries.DeleteMovie(ctx, movie.ID); err != nil {
+		t.Fatalf("DeleteMovie() error = %v", err)
+	}
+	if err := svc.Delete(ctx, anime.ID); err != nil {
+		t.Errorf("Delete() after movie removal error = %v", err)
+	}
+}
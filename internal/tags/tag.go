@@ -0,0 +1,69 @@
+package tags
+
+import (
+	"errors"
+	"time"
+)
+
+var (
+	ErrTagNotFound      = errors.New("tag not found")
+	ErrInvalidTag       = errors.New("invalid tag")
+	ErrTagExists        = errors.New("tag label already exists")
+	ErrTagInUse         = errors.New("tag is in use")
+	ErrInvalidEntityTag = errors.New("invalid tag entity type")
+)
+
+// Entity types a tag can be applied to. Notifications store their tag IDs on
+// the notification itself.
+const (
+	EntityMovie          = "movie"
+	EntitySeries         = "series"
+	EntityIndexer        = "indexer"
+	EntityDownloadClient = "download_client"
+	EntityNotification   = "notification"
+)
+
+// Tag is a label that can be applied to library items and to indexers,
+// download clients and notifications to restrict which items they serve.
+type Tag struct {
+	ID        int64     `json:"id"`
+	Label     string    `json:"label"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+// Input contains fields for creating or renaming a tag.
+type Input struct {
+	Label string `json:"label"`
+}
+
+// Usage lists what a tag is applied to.
+type Usage struct {
+	Tag
+	MovieIDs          []int64 `json:"movieIds"`
+	SeriesIDs         []int64 `json:"seriesIds"`
+	IndexerIDs        []int64 `json:"indexerIds"`
+	DownloadClientIDs []int64 `json:"downloadClientIds"`
+	NotificationIDs   []int64 `json:"notificationIds"`
+}
+
+// InUse reports whether the tag is applied to anything.
+func (u *Usage) InUse() bool {
+	return len(u.MovieIDs)+len(u.SeriesIDs)+len(u.IndexerIDs)+len(u.DownloadClientIDs)+len(u.NotificationIDs) > 0
+}
+
+// Matches reports whether a resource with resourceTags may be used for an item
+// with itemTags. Untagged resources serve every item; tagged resources only
+// serve items sharing at least one of their tags.
+func Matches(resourceTags, itemTags []int64) bool {
+	if len(resourceTags) == 0 {
+		return true
+	}
+	for _, r := range resourceTags {
+		for _, i := range itemTags {
+			if r == i {
+				return true
+			}
+		}
+	}
+	return false
+}
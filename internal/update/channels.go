@@ -0,0 +1,96 @@
+package update
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/slipstream/slipstream/internal/config"
+)
+
+// Channel selects which GitHub releases the updater follows.
+type Channel string
+
+const (
+	// ChannelStable follows the latest full release.
+	ChannelStable Channel = "stable"
+	// ChannelBeta also follows prereleases.
+	ChannelBeta Channel = "beta"
+	// ChannelNightly follows the rolling "nightly" release, which is
+	// re-published under the same tag for every build.
+	ChannelNightly Channel = "nightly"
+)
+
+const (
+	githubReleasesURL = "https://api.github.com/repos/jatassi/SlipStream/releases"
+	nightlyTag        = "nightly"
+)
+
+var ErrInvalidChannel = errors.New("invalid update channel")
+
+func (c Channel) Valid() bool {
+	switch c {
+	case ChannelStable, ChannelBeta, ChannelNightly:
+		return true
+	}
+	return false
+}
+
+func (s *Service) fetchChannelRelease(ctx context.Context, channel Channel) (*githubRelease, error) {
+	switch channel {
+	case ChannelBeta:
+		var releases []githubRelease
+		if err := s.getGitHubJSON(ctx, githubReleasesURL+"?per_page=20", &releases); err != nil {
+			return nil, err
+		}
+		for i := range releases {
+			if !releases[i].Draft && releases[i].TagName != nightlyTag {
+				return &releases[i], nil
+			}
+		}
+		return nil, fmt.Errorf("no beta or stable release found")
+
+	case ChannelNightly:
+		var release githubRelease
+		if err := s.getGitHubJSON(ctx, githubReleasesURL+"/tags/"+nightlyTag, &release); err != nil {
+			return nil, err
+		}
+		return &release, nil
+
+	default:
+		var release githubRelease
+		if err := s.getGitHubJSON(ctx, githubReleasesURL+"/latest", &release); err != nil {
+			return nil, err
+		}
+		if release.Draft || release.Prerelease {
+			return nil, fmt.Errorf("latest release is draft or prerelease")
+		}
+		return &release, nil
+	}
+}
+
+func (s *Service) getGitHubJSON(ctx context.Context, url string, dest any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, http.NoBody)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Accept", "application/vnd.github.v3+json")
+	req.Header.Set("User-Agent", "SlipStream/"+config.Version)
+
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to fetch release: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("GitHub API returned status %d", resp.StatusCode)
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(dest); err != nil {
+		return fmt.Errorf("failed to decode release: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,70 @@
+package update
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/slipstream/slipstream/internal/config"
+	"github.com/slipstream/slipstream/internal/testutil"
+)
+
+func TestService_Settings_Channel(t *testing.T) {
+	tdb := testutil.NewTestDB(t)
+	defer tdb.Close()
+
+	ctx := context.Background()
+	svc := NewService(tdb.Conn, &tdb.Logger, nil)
+
+	settings, err := svc.GetSettings(ctx)
+	if err != nil {
+		t.Fatalf("GetSettings error = %v", err)
+	}
+	if !settings.AutoInstall || settings.Channel != ChannelStable {
+		t.Errorf("default settings = %+v, want auto-install on stable", settings)
+	}
+
+	if err := svc.UpdateSettings(ctx, &Settings{AutoInstall: false, Channel: ChannelBeta}); err != nil {
+		t.Fatalf("UpdateSettings error = %v", err)
+	}
+	if err := svc.UpdateSettings(ctx, &Settings{AutoInstall: false, Channel: "canary"}); !errors.Is(err, ErrInvalidChannel) {
+		t.Errorf("UpdateSettings invalid channel error = %v, want ErrInvalidChannel", err)
+	}
+
+	settings, err = svc.GetSettings(ctx)
+	if err != nil {
+		t.Fatalf("GetSettings error = %v", err)
+	}
+	if settings.AutoInstall || settings.Channel != ChannelBeta {
+		t.Errorf("saved settings = %+v, want auto-install off on beta", settings)
+	}
+}
+
+func TestService_IsNewerRelease_Nightly(t *testing.T) {
+	tdb := testutil.NewTestDB(t)
+	defer tdb.Close()
+
+	ctx := context.Background()
+	svc := NewService(tdb.Conn, &tdb.Logger, nil)
+	published := time.Date(2026, 3, 1, 4, 0, 0, 0, time.UTC)
+	nightly := &githubRelease{TagName: nightlyTag, PublishedAt: published}
+
+	if !svc.isNewerRelease(ctx, nightly, config.Version) {
+		t.Error("nightly should be newer when nothing has been installed")
+	}
+
+	svc.recordInstall(ctx, &ReleaseInfo{TagName: nightlyTag, PublishedAt: published})
+	if svc.isNewerRelease(ctx, nightly, config.Version) {
+		t.Error("installed nightly should not be offered again")
+	}
+
+	nightly.PublishedAt = published.Add(24 * time.Hour)
+	if !svc.isNewerRelease(ctx, nightly, config.Version) {
+		t.Error("a later nightly should be newer")
+	}
+
+	if !svc.isNewerRelease(ctx, &githubRelease{TagName: "v2.0.0"}, "1.9.0") {
+		t.Error("v2.0.0 should be newer than 1.9.0")
+	}
+}
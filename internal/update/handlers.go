@@ -2,9 +2,12 @@ package update
 
 import (
 	"context"
+	"errors"
 	"net/http"
 
 	"github.com/labstack/echo/v4"
+
+	"github.com/slipstream/slipstream/internal/logger"
 )
 
 type Handlers struct {
@@ -20,6 +23,7 @@ func (h *Handlers) RegisterRoutes(g *echo.Group) {
 	g.POST("/check", h.CheckForUpdate)
 	g.POST("/install", h.Install)
 	g.POST("/cancel", h.Cancel)
+	g.POST("/rollback", h.Rollback)
 	g.GET("/settings", h.GetSettings)
 	g.PUT("/settings", h.UpdateSettings)
 }
@@ -37,9 +41,7 @@ func (h *Handlers) CheckForUpdate(c echo.Context) error {
 	ctx := c.Request().Context()
 	release, err := h.service.CheckForUpdate(ctx)
 	if err != nil {
-		return c.JSON(http.StatusInternalServerError, map[string]string{
-			"error": err.Error(),
-		})
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
 	}
 
 	status := h.service.GetStatus()
@@ -57,16 +59,18 @@ func (h *Handlers) CheckForUpdate(c echo.Context) error {
 // Install starts the download and installation process.
 // POST /api/v1/update/install
 func (h *Handlers) Install(c echo.Context) error {
-	h.service.logger.Info().Msg("Install endpoint called - starting update goroutine")
+	// Detach from the request since this runs after the HTTP response is sent.
+	// The service manages its own cancellation via Cancel().
+	ctx := context.WithoutCancel(c.Request().Context())
+	log := logger.FromContext(ctx, &h.service.logger)
+	log.Info().Msg("Install endpoint called - starting update goroutine")
 
 	go func() {
-		h.service.logger.Info().Msg("Update goroutine started with background context")
-		// Use Background context since this runs after the HTTP response is sent.
-		// The service manages its own cancellation via Cancel().
-		if err := h.service.DownloadAndInstall(context.Background()); err != nil {
-			h.service.logger.Error().Err(err).Msg("Update installation failed")
+		log.Info().Msg("Update goroutine started with background context")
+		if err := h.service.DownloadAndInstall(ctx); err != nil {
+			log.Error().Err(err).Msg("Update installation failed")
 		} else {
-			h.service.logger.Info().Msg("Update installation completed successfully")
+			log.Info().Msg("Update installation completed successfully")
 		}
 	}()
 
@@ -78,13 +82,12 @@ func (h *Handlers) Install(c echo.Context) error {
 // Cancel stops an in-progress update.
 // POST /api/v1/update/cancel
 func (h *Handlers) Cancel(c echo.Context) error {
-	h.service.logger.Info().Msg("Cancel endpoint called")
+	log := logger.FromContext(c.Request().Context(), &h.service.logger)
+	log.Info().Msg("Cancel endpoint called")
 
 	if err := h.service.Cancel(); err != nil {
-		h.service.logger.Error().Err(err).Msg("Cancel endpoint failed")
-		return c.JSON(http.StatusInternalServerError, map[string]string{
-			"error": err.Error(),
-		})
+		log.Error().Err(err).Msg("Cancel endpoint failed")
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
 	}
 	return c.JSON(http.StatusOK, map[string]string{
 		"message": "Update cancelled",
@@ -97,9 +100,7 @@ func (h *Handlers) GetSettings(c echo.Context) error {
 	ctx := c.Request().Context()
 	settings, err := h.service.GetSettings(ctx)
 	if err != nil {
-		return c.JSON(http.StatusInternalServerError, map[string]string{
-			"error": err.Error(),
-		})
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
 	}
 	return c.JSON(http.StatusOK, settings)
 }
@@ -111,16 +112,38 @@ func (h *Handlers) UpdateSettings(c echo.Context) error {
 
 	var settings Settings
 	if err := c.Bind(&settings); err != nil {
-		return c.JSON(http.StatusBadRequest, map[string]string{
-			"error": "Invalid request body",
-		})
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid request body")
 	}
 
 	if err := h.service.UpdateSettings(ctx, &settings); err != nil {
-		return c.JSON(http.StatusInternalServerError, map[string]string{
-			"error": err.Error(),
-		})
+		if errors.Is(err, ErrInvalidChannel) {
+			return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+		}
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
 	}
 
-	return c.JSON(http.StatusOK, settings)
+	saved, err := h.service.GetSettings(ctx)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	return c.JSON(http.StatusOK, saved)
+}
+
+// Rollback reverts to the previous version and restarts.
+// POST /api/v1/update/rollback
+func (h *Handlers) Rollback(c echo.Context) error {
+	version, err := h.service.Rollback(c.Request().Context())
+	if err != nil {
+		switch {
+		case errors.Is(err, ErrRollbackUnsupported):
+			return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+		case errors.Is(err, ErrNoRollback):
+			return echo.NewHTTPError(http.StatusConflict, err.Error())
+		}
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	return c.JSON(http.StatusOK, map[string]string{
+		"message": "Rolling back to " + version,
+		"version": version,
+	})
 }
@@ -0,0 +1,101 @@
+package update
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/slipstream/slipstream/internal/config"
+)
+
+var (
+	ErrRollbackUnsupported = errors.New("rollback is only supported for managed installs")
+	ErrNoRollback          = errors.New("no previous version to roll back to")
+)
+
+// canRollback reports whether a previous binary is on disk. Only managed
+// installs swap binaries in place, so they are the only ones that keep one.
+func canRollback() bool {
+	if !isManagedInstall() {
+		return false
+	}
+	exe, err := currentBinaryPath()
+	if err != nil {
+		return false
+	}
+	_, err = os.Stat(exe + previousSuffix)
+	return err == nil
+}
+
+func currentBinaryPath() (string, error) {
+	exe, err := os.Executable()
+	if err != nil {
+		return "", fmt.Errorf("failed to get executable path: %w", err)
+	}
+	return filepath.EvalSymlinks(exe)
+}
+
+// recordInstall remembers what was just installed: the publish time tells
+// nightly builds apart, and the version being replaced is what a rollback
+// reverts to. A successful install also clears any rollback pin.
+func (s *Service) recordInstall(ctx context.Context, release *ReleaseInfo) {
+	values := map[string]string{
+		settingInstalledAt:   release.PublishedAt.UTC().Format(time.RFC3339),
+		settingPinnedVersion: "",
+	}
+	if isManagedInstall() {
+		values[settingPreviousVersion] = config.Version
+	}
+	for key, value := range values {
+		if err := s.setSetting(ctx, key, value); err != nil {
+			s.logger.Warn().Err(err).Str("key", key).Msg("Failed to record installed update")
+		}
+	}
+}
+
+// Rollback restores the previous binary, pins its version so auto-install
+// does not bring the bad release straight back, and restarts. The database
+// is left as-is; the pre-update backup can be restored separately if the
+// older version cannot read it.
+func (s *Service) Rollback(ctx context.Context) (string, error) {
+	if !isManagedInstall() {
+		return "", ErrRollbackUnsupported
+	}
+
+	previousVersion, err := s.getSetting(ctx, settingPreviousVersion)
+	if err != nil {
+		return "", err
+	}
+	if previousVersion == "" || !canRollback() {
+		return "", ErrNoRollback
+	}
+
+	exe, err := currentBinaryPath()
+	if err != nil {
+		return "", err
+	}
+
+	s.logger.Info().Str("from", config.Version).Str("to", previousVersion).Msg("Rolling back update")
+
+	if err := replaceRunningBinary(exe+previousSuffix, exe); err != nil {
+		return "", fmt.Errorf("failed to restore previous binary: %w", err)
+	}
+
+	if err := s.setSetting(ctx, settingPinnedVersion, previousVersion); err != nil {
+		s.logger.Warn().Err(err).Msg("Failed to pin rolled back version")
+	}
+	if err := s.setSetting(ctx, settingPreviousVersion, ""); err != nil {
+		s.logger.Warn().Err(err).Msg("Failed to clear previous version")
+	}
+
+	s.setState(StateRestarting, nil)
+	go func() {
+		time.Sleep(2 * time.Second)
+		s.requestRestart(true)
+	}()
+
+	return previousVersion, nil
+}
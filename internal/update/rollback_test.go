@@ -0,0 +1,27 @@
+package update
+
+import (
+	"testing"
+
+	"github.com/rs/zerolog"
+)
+
+func TestService_RequestRestartDoesNotBlock(t *testing.T) {
+	logger := zerolog.Nop()
+	restartChan := make(chan bool, 1)
+	svc := NewService(nil, &logger, restartChan)
+
+	svc.requestRestart(true)
+	// The first request is still pending, so this one must be dropped rather
+	// than block the caller.
+	svc.requestRestart(false)
+
+	if relaunch := <-restartChan; !relaunch {
+		t.Errorf("restart request relaunch = %v, want the first request", relaunch)
+	}
+	select {
+	case <-restartChan:
+		t.Error("expected the second restart request to be dropped")
+	default:
+	}
+}
@@ -6,7 +6,6 @@ import (
 	"compress/gzip"
 	"context"
 	"database/sql"
-	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
@@ -26,9 +25,12 @@ import (
 )
 
 const (
-	githubAPIURL       = "https://api.github.com/repos/jatassi/SlipStream/releases/latest"
-	settingAutoInstall = "update_auto_install"
-	osLinux            = "linux"
+	settingAutoInstall     = "update_auto_install"
+	settingChannel         = "update_channel"
+	settingPinnedVersion   = "update_pinned_version"
+	settingPreviousVersion = "update_previous_version"
+	settingInstalledAt     = "update_installed_published_at"
+	osLinux                = "linux"
 )
 
 type State string
@@ -55,6 +57,8 @@ type ReleaseInfo struct {
 	AssetName    string    `json:"assetName"`
 	AssetSize    int64     `json:"assetSize"`
 	PublishedAt  time.Time `json:"publishedAt"`
+	ChecksumURL  string    `json:"-"`
+	SignatureURL string    `json:"-"`
 }
 
 type Status struct {
@@ -69,7 +73,13 @@ type Status struct {
 }
 
 type Settings struct {
-	AutoInstall bool `json:"autoInstall"`
+	AutoInstall bool    `json:"autoInstall"`
+	Channel     Channel `json:"channel"`
+	// PinnedVersion is set by a rollback and holds auto-install back until
+	// the next manual install. Read-only through the settings API.
+	PinnedVersion string `json:"pinnedVersion,omitempty"`
+	// PreviousVersion is the version a rollback would revert to.
+	PreviousVersion string `json:"previousVersion,omitempty"`
 }
 
 type githubRelease struct {
@@ -101,6 +111,7 @@ type Service struct {
 	downloadClient *http.Client
 	restartChan    chan<- bool
 	port           int
+	backuper       DatabaseBackuper
 
 	mu           sync.RWMutex
 	status       Status
@@ -134,6 +145,15 @@ func (s *Service) SetPort(port int) {
 	s.port = port
 }
 
+// DatabaseBackuper takes a database backup before an update is installed.
+type DatabaseBackuper interface {
+	BackupBeforeUpdate(ctx context.Context) error
+}
+
+func (s *Service) SetDatabaseBackuper(backuper DatabaseBackuper) {
+	s.backuper = backuper
+}
+
 func (s *Service) GetStatus() Status {
 	s.mu.RLock()
 	defer s.mu.RUnlock()
@@ -141,29 +161,70 @@ func (s *Service) GetStatus() Status {
 }
 
 func (s *Service) GetSettings(ctx context.Context) (*Settings, error) {
-	queries := sqlc.New(s.db)
-	setting, err := queries.GetSetting(ctx, settingAutoInstall)
+	settings := &Settings{AutoInstall: true, Channel: ChannelStable}
+
+	autoInstall, err := s.getSetting(ctx, settingAutoInstall)
 	if err != nil {
-		if errors.Is(err, sql.ErrNoRows) {
-			return &Settings{AutoInstall: true}, nil
-		}
 		return nil, err
 	}
-	return &Settings{
-		AutoInstall: setting.Value == "true" || setting.Value == "1",
-	}, nil
+	if autoInstall != "" {
+		settings.AutoInstall = autoInstall == "true" || autoInstall == "1"
+	}
+
+	channel, err := s.getSetting(ctx, settingChannel)
+	if err != nil {
+		return nil, err
+	}
+	if Channel(channel).Valid() {
+		settings.Channel = Channel(channel)
+	}
+
+	if settings.PinnedVersion, err = s.getSetting(ctx, settingPinnedVersion); err != nil {
+		return nil, err
+	}
+	if settings.PreviousVersion, err = s.getSetting(ctx, settingPreviousVersion); err != nil {
+		return nil, err
+	}
+	if settings.PreviousVersion != "" && !canRollback() {
+		settings.PreviousVersion = ""
+	}
+	return settings, nil
 }
 
+// UpdateSettings saves the auto-install flag and channel. An empty channel
+// keeps the current one.
 func (s *Service) UpdateSettings(ctx context.Context, settings *Settings) error {
-	queries := sqlc.New(s.db)
+	if settings.Channel != "" && !settings.Channel.Valid() {
+		return ErrInvalidChannel
+	}
+
 	value := "false"
 	if settings.AutoInstall {
 		value = "true"
 	}
-	_, err := queries.SetSetting(ctx, sqlc.SetSettingParams{
-		Key:   settingAutoInstall,
-		Value: value,
-	})
+	if err := s.setSetting(ctx, settingAutoInstall, value); err != nil {
+		return err
+	}
+	if settings.Channel != "" {
+		return s.setSetting(ctx, settingChannel, string(settings.Channel))
+	}
+	return nil
+}
+
+// getSetting returns a stored setting, or "" when it has never been set.
+func (s *Service) getSetting(ctx context.Context, key string) (string, error) {
+	setting, err := sqlc.New(s.db).GetSetting(ctx, key)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return "", nil
+		}
+		return "", err
+	}
+	return setting.Value, nil
+}
+
+func (s *Service) setSetting(ctx context.Context, key, value string) error {
+	_, err := sqlc.New(s.db).SetSetting(ctx, sqlc.SetSettingParams{Key: key, Value: value})
 	return err
 }
 
@@ -201,7 +262,13 @@ func (s *Service) broadcast(status *Status) {
 func (s *Service) CheckForUpdate(ctx context.Context) (*ReleaseInfo, error) {
 	s.setState(StateChecking, nil)
 
-	release, err := s.fetchLatestRelease(ctx)
+	settings, err := s.GetSettings(ctx)
+	if err != nil {
+		s.logger.Warn().Err(err).Msg("Failed to get update settings, using stable channel")
+		settings = &Settings{Channel: ChannelStable}
+	}
+
+	release, err := s.fetchChannelRelease(ctx, settings.Channel)
 	if err != nil {
 		s.setState(StateError, err)
 		return nil, err
@@ -219,11 +286,7 @@ func (s *Service) CheckForUpdate(ctx context.Context) (*ReleaseInfo, error) {
 		return nil, nil //nolint:nilnil // nil release with no error means "no update available"
 	}
 
-	isNewer, err := IsNewerThan(release.TagName, currentVersion)
-	if err != nil {
-		s.logger.Warn().Err(err).Str("tagName", release.TagName).Str("currentVersion", currentVersion).Msg("Failed to compare versions")
-		isNewer = release.TagName != currentVersion && release.TagName != "v"+currentVersion
-	}
+	isNewer := s.isNewerRelease(ctx, release, currentVersion)
 
 	now := time.Now()
 	s.mu.Lock()
@@ -251,34 +314,23 @@ func (s *Service) CheckForUpdate(ctx context.Context) (*ReleaseInfo, error) {
 	return nil, nil //nolint:nilnil // nil release with no error means "no update available"
 }
 
-func (s *Service) fetchLatestRelease(ctx context.Context) (*githubRelease, error) {
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, githubAPIURL, http.NoBody)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create request: %w", err)
+func (s *Service) isNewerRelease(ctx context.Context, release *githubRelease, currentVersion string) bool {
+	// Nightly builds share one tag, so they are told apart by publish time.
+	if release.TagName == nightlyTag {
+		installedAt, err := s.getSetting(ctx, settingInstalledAt)
+		if err != nil || installedAt == "" {
+			return true
+		}
+		installed, err := time.Parse(time.RFC3339, installedAt)
+		return err != nil || release.PublishedAt.After(installed)
 	}
-	req.Header.Set("Accept", "application/vnd.github.v3+json")
-	req.Header.Set("User-Agent", "SlipStream/"+config.Version)
 
-	resp, err := s.httpClient.Do(req)
+	isNewer, err := IsNewerThan(release.TagName, currentVersion)
 	if err != nil {
-		return nil, fmt.Errorf("failed to fetch release: %w", err)
-	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("GitHub API returned status %d", resp.StatusCode)
-	}
-
-	var release githubRelease
-	if err := json.NewDecoder(resp.Body).Decode(&release); err != nil {
-		return nil, fmt.Errorf("failed to decode release: %w", err)
-	}
-
-	if release.Draft || release.Prerelease {
-		return nil, fmt.Errorf("latest release is draft or prerelease")
+		s.logger.Warn().Err(err).Str("tagName", release.TagName).Str("currentVersion", currentVersion).Msg("Failed to compare versions")
+		isNewer = release.TagName != currentVersion && release.TagName != "v"+currentVersion
 	}
-
-	return &release, nil
+	return isNewer
 }
 
 func (s *Service) buildReleaseInfo(release *githubRelease) *ReleaseInfo {
@@ -295,11 +347,22 @@ func (s *Service) buildReleaseInfo(release *githubRelease) *ReleaseInfo {
 		info.DownloadURL = asset.BrowserDownloadURL
 		info.AssetName = asset.Name
 		info.AssetSize = asset.Size
+		info.ChecksumURL = findAssetURL(release.Assets, asset.Name+checksumSuffix)
+		info.SignatureURL = findAssetURL(release.Assets, asset.Name+signatureSuffix)
 	}
 
 	return info
 }
 
+func findAssetURL(assets []githubAsset, name string) string {
+	for i := range assets {
+		if assets[i].Name == name {
+			return assets[i].BrowserDownloadURL
+		}
+	}
+	return ""
+}
+
 func (s *Service) findPlatformAsset(assets []githubAsset) *githubAsset {
 	goos := runtime.GOOS
 	goarch := runtime.GOARCH
@@ -312,11 +375,18 @@ func (s *Service) findPlatformAsset(assets []githubAsset) *githubAsset {
 			fmt.Sprintf("_windows_%s.zip", goarch),
 		}
 	case "darwin":
-		patterns = []string{
-			fmt.Sprintf("_darwin_%s.dmg", goarch),
+		if isManagedInstall() {
+			// The launchd agent runs a bare binary rather than an app bundle
+			patterns = []string{
+				fmt.Sprintf("_darwin_%s.tar.gz", goarch),
+			}
+		} else {
+			patterns = []string{
+				fmt.Sprintf("_darwin_%s.dmg", goarch),
+			}
 		}
 	case osLinux:
-		if isLinuxStubPattern() {
+		if isManagedInstall() {
 			// For deb/rpm stub pattern, prefer tarball (direct binary, user-writable)
 			patterns = []string{
 				fmt.Sprintf("_linux_%s.tar.gz", goarch),
@@ -340,29 +410,11 @@ func (s *Service) findPlatformAsset(assets []githubAsset) *githubAsset {
 	return nil
 }
 
-// isLinuxStubPattern checks if running from the deb/rpm stub launcher pattern
-// where the binary lives in ~/.local/share/slipstream/bin/
-func isLinuxStubPattern() bool {
-	if runtime.GOOS != "linux" {
-		return false
-	}
-
-	exe, err := os.Executable()
-	if err != nil {
-		return false
-	}
-	exe, err = filepath.EvalSymlinks(exe)
-	if err != nil {
-		return false
-	}
-
-	home, err := os.UserHomeDir()
-	if err != nil {
-		return false
-	}
-
-	expectedBinDir := filepath.Join(home, ".local", "share", "slipstream", "bin")
-	return filepath.Dir(exe) == expectedBinDir
+// isManagedInstall reports whether this binary runs from the user-writable
+// managed bin directory (Linux package stub, Windows service, macOS launchd
+// agent), where updates are swapped in place.
+func isManagedInstall() bool {
+	return config.IsManagedInstall()
 }
 
 func (s *Service) DownloadAndInstall(ctx context.Context) error {
@@ -406,19 +458,32 @@ func (s *Service) DownloadAndInstall(ctx context.Context) error {
 		return err
 	}
 
-	s.logger.Info().Str("downloadPath", downloadPath).Msg("Download completed, proceeding to install")
+	s.logger.Info().Str("downloadPath", downloadPath).Msg("Download completed, verifying")
 
 	s.mu.Lock()
 	s.downloadPath = downloadPath
 	s.mu.Unlock()
 
-	s.backupDatabase()
+	if err := s.verifyDownload(ctx, release, downloadPath); err != nil {
+		s.logger.Error().Err(err).Str("asset", release.AssetName).Msg("Update verification failed")
+		os.Remove(downloadPath)
+		s.setState(StateFailed, err)
+		return err
+	}
+
+	// The new version migrates the database on first start, so never
+	// install without a backup to roll back to.
+	if err := s.backupDatabase(ctx); err != nil {
+		s.setState(StateFailed, err)
+		return err
+	}
 
 	if err := s.installUpdate(ctx, downloadPath); err != nil {
 		s.setState(StateFailed, err)
 		return err
 	}
 
+	s.recordInstall(ctx, release)
 	return nil
 }
 
@@ -589,8 +654,16 @@ func (s *Service) logDownloadError(ctx context.Context, err error, downloaded in
 		Msg("Error reading from response body")
 }
 
-func (s *Service) backupDatabase() {
+func (s *Service) backupDatabase(ctx context.Context) error {
+	if s.backuper == nil {
+		return nil
+	}
 	s.logger.Info().Msg("Creating database backup before update")
+	if err := s.backuper.BackupBeforeUpdate(ctx); err != nil {
+		s.logger.Error().Err(err).Msg("Database backup before update failed, aborting install")
+		return fmt.Errorf("database backup before update failed: %w", err)
+	}
+	return nil
 }
 
 func (s *Service) installUpdate(ctx context.Context, downloadPath string) error {
@@ -600,14 +673,17 @@ func (s *Service) installUpdate(ctx context.Context, downloadPath string) error
 	goos := runtime.GOOS
 	var err error
 
-	switch goos {
-	case "windows":
+	switch {
+	case isManagedInstall():
+		s.logger.Info().Msg("Installing managed update in place")
+		err = s.installManaged(ctx, downloadPath)
+	case goos == "windows":
 		s.logger.Info().Msg("Installing Windows update")
 		err = s.installWindows(ctx, downloadPath)
-	case "darwin":
+	case goos == "darwin":
 		s.logger.Info().Msg("Installing macOS update")
 		err = s.installMacOS(ctx, downloadPath)
-	case osLinux:
+	case goos == osLinux:
 		s.logger.Info().Msg("Installing Linux update")
 		err = s.installLinux(ctx, downloadPath)
 	default:
@@ -619,19 +695,34 @@ func (s *Service) installUpdate(ctx context.Context, downloadPath string) error
 		return err
 	}
 
+	// Managed installs are swapped in place, so this process relaunches
+	// itself; every other install hands off to a --complete-update helper.
+	relaunch := isManagedInstall()
+
 	s.setState(StateRestarting, nil)
-	s.logger.Info().Msg("Update installed, triggering restart")
+	s.logger.Info().Bool("relaunch", relaunch).Msg("Update installed, triggering restart")
 
 	go func() {
 		time.Sleep(2 * time.Second)
-		if s.restartChan != nil {
-			s.restartChan <- false // false = don't spawn new process, updater handles it
-		}
+		s.requestRestart(relaunch)
 	}()
 
 	return nil
 }
 
+// requestRestart asks the server to restart without blocking, so a restart
+// that is already pending does not strand the caller.
+func (s *Service) requestRestart(relaunch bool) {
+	if s.restartChan == nil {
+		return
+	}
+	select {
+	case s.restartChan <- relaunch:
+	default:
+		s.logger.Warn().Msg("Restart already pending, skipping restart request")
+	}
+}
+
 func (s *Service) installWindows(_ context.Context, downloadPath string) error {
 	if !strings.HasSuffix(downloadPath, ".zip") {
 		s.logger.Error().Str("ext", filepath.Ext(downloadPath)).Msg("Unsupported Windows update format")
@@ -828,7 +919,7 @@ func (s *Service) installLinux(ctx context.Context, downloadPath string) error {
 	switch ext {
 	case ".gz":
 		// Tarball for stub pattern - extract and update user binary directly
-		return s.installLinuxTarball(ctx, downloadPath)
+		return s.installManaged(ctx, downloadPath)
 
 	case ".AppImage":
 		currentExe, err := os.Executable()
@@ -871,50 +962,41 @@ func (s *Service) installLinux(ctx context.Context, downloadPath string) error {
 	}
 }
 
-func (s *Service) installLinuxTarball(_ctx context.Context, downloadPath string) error {
-	s.logger.Info().Str("tarball", downloadPath).Msg("Extracting tarball for stub pattern update")
-
-	currentExe, err := os.Executable()
-	if err != nil {
-		return fmt.Errorf("failed to get executable path: %w", err)
-	}
-	currentExe, err = filepath.EvalSymlinks(currentExe)
+// installManaged extracts the new binary from a tarball or portable ZIP and
+// swaps it over the running one.
+func (s *Service) installManaged(_ context.Context, downloadPath string) error {
+	currentExe, err := currentBinaryPath()
 	if err != nil {
-		return fmt.Errorf("failed to resolve executable path: %w", err)
-	}
-
-	// Extract binary from tarball to temp location
-	extractDir := filepath.Dir(downloadPath)
-	newExePath := filepath.Join(extractDir, "slipstream")
-
-	if err := extractTarGz(downloadPath, extractDir); err != nil {
-		return fmt.Errorf("failed to extract tarball: %w", err)
-	}
-
-	if _, err := os.Stat(newExePath); os.IsNotExist(err) {
-		return fmt.Errorf("slipstream binary not found in tarball")
+		return err
 	}
 
-	// Make executable
-	//nolint:gosec // Executable must be group-executable
-	if err := os.Chmod(newExePath, 0o750); err != nil {
-		return fmt.Errorf("failed to make binary executable: %w", err)
+	var newExePath string
+	switch {
+	case strings.HasSuffix(downloadPath, ".zip"):
+		if newExePath, err = s.extractWindowsUpdate(downloadPath); err != nil {
+			return err
+		}
+	case strings.HasSuffix(downloadPath, ".tar.gz"):
+		s.logger.Info().Str("tarball", downloadPath).Msg("Extracting tarball for managed update")
+		extractDir := filepath.Dir(downloadPath)
+		newExePath = filepath.Join(extractDir, "slipstream")
+		if err := extractTarGz(downloadPath, extractDir); err != nil {
+			return fmt.Errorf("failed to extract tarball: %w", err)
+		}
+		if _, err := os.Stat(newExePath); os.IsNotExist(err) {
+			return fmt.Errorf("slipstream binary not found in tarball")
+		}
+	default:
+		return fmt.Errorf("unsupported managed update format: %s", filepath.Ext(downloadPath))
 	}
 
-	// Launch the new binary with --complete-update to finish the update
-	s.logger.Info().
-		Str("newExe", newExePath).
-		Str("currentExe", currentExe).
-		Int("port", s.port).
-		Msg("Launching updater to replace binary")
+	s.logger.Info().Str("newExe", newExePath).Str("currentExe", currentExe).Msg("Swapping in new binary")
 
-	cmd := exec.Command(newExePath, "--complete-update", currentExe, fmt.Sprintf("%d", s.port)) //nolint:gosec,noctx // Fire-and-forget detached process
-	cmd.Dir = extractDir
-	if err := cmd.Start(); err != nil {
-		return fmt.Errorf("failed to launch updater: %w", err)
+	if err := swapBinary(newExePath, currentExe); err != nil {
+		return fmt.Errorf("failed to swap binary: %w", err)
 	}
 
-	s.logger.Info().Int("pid", cmd.Process.Pid).Msg("Updater process started")
+	s.logger.Info().Str("previous", currentExe+previousSuffix).Msg("Binary swapped, previous version kept for rollback")
 	return nil
 }
 
@@ -1024,6 +1106,11 @@ func (s *Service) CheckAndAutoInstall(ctx context.Context) error {
 
 	s.logger.Info().Str("version", release.Version).Bool("autoInstall", settings.AutoInstall).Msg("Update available")
 
+	if settings.PinnedVersion != "" {
+		s.logger.Info().Str("pinnedVersion", settings.PinnedVersion).Msg("Version pinned after rollback, skipping auto-install")
+		return nil
+	}
+
 	if settings.AutoInstall {
 		return s.DownloadAndInstall(ctx)
 	}
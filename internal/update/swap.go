@@ -0,0 +1,84 @@
+package update
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"runtime"
+)
+
+const (
+	stagedSuffix   = ".new"
+	previousSuffix = ".prev"
+	retiredSuffix  = ".old"
+)
+
+// swapBinary atomically replaces targetPath with newExePath. The new binary
+// is staged next to the target first so the final rename stays on one
+// filesystem; the stub launcher therefore only ever sees the old or the new
+// binary, never a partial copy. The replaced binary is kept as
+// "<target>.prev" so a bad release can be rolled back by hand.
+func swapBinary(newExePath, targetPath string) error {
+	staged := targetPath + stagedSuffix
+	if err := copyExecutable(newExePath, staged); err != nil {
+		os.Remove(staged)
+		return fmt.Errorf("stage new binary: %w", err)
+	}
+
+	previous := targetPath + previousSuffix
+	os.Remove(previous)
+	if err := os.Link(targetPath, previous); err != nil && !os.IsNotExist(err) {
+		os.Remove(staged)
+		return fmt.Errorf("keep previous binary: %w", err)
+	}
+
+	if err := replaceRunningBinary(staged, targetPath); err != nil {
+		os.Remove(staged)
+		return fmt.Errorf("replace binary: %w", err)
+	}
+	return nil
+}
+
+// replaceRunningBinary renames src over the binary at dst, which may be the
+// running executable. Windows refuses to replace a running executable but
+// does allow renaming it, so there the old binary is moved aside first and
+// cleaned up on the next swap.
+func replaceRunningBinary(src, dst string) error {
+	if runtime.GOOS != "windows" {
+		return os.Rename(src, dst)
+	}
+
+	aside := dst + retiredSuffix
+	os.Remove(aside)
+	if err := os.Rename(dst, aside); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	if err := os.Rename(src, dst); err != nil {
+		_ = os.Rename(aside, dst)
+		return err
+	}
+	return nil
+}
+
+func copyExecutable(src, dst string) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+
+	//nolint:gosec // Executable must be group-executable
+	out, err := os.OpenFile(dst, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0o750)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(out, in); err != nil {
+		out.Close()
+		return err
+	}
+	if err := out.Sync(); err != nil {
+		out.Close()
+		return err
+	}
+	return out.Close()
+}
@@ -0,0 +1,153 @@
+package update
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/ed25519"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+
+	"github.com/slipstream/slipstream/internal/config"
+)
+
+// Release assets are published with two sidecars: "<asset>.sha256" holding
+// the sha256sum line for the asset, and "<asset>.sha256.sig" holding the raw
+// ed25519 signature of that checksum file.
+const (
+	checksumSuffix  = ".sha256"
+	signatureSuffix = ".sha256.sig"
+
+	maxSidecarSize = 64 * 1024
+)
+
+var (
+	ErrChecksumMissing  = errors.New("release does not publish a checksum for this asset")
+	ErrChecksumMismatch = errors.New("downloaded update does not match its published checksum")
+	ErrSignatureInvalid = errors.New("update checksum signature is invalid")
+)
+
+// requiresVerification reports whether an asset must be verified before it is
+// installed. Managed installs swap the binary in place with no installer in
+// between, so they never install anything unverified.
+func requiresVerification(release *ReleaseInfo) bool {
+	return isManagedInstall() || strings.HasSuffix(release.AssetName, ".tar.gz")
+}
+
+// verifyDownload checks the downloaded asset against its published checksum,
+// and the checksum against the embedded release key when one is configured.
+func (s *Service) verifyDownload(ctx context.Context, release *ReleaseInfo, path string) error {
+	if release.ChecksumURL == "" {
+		if requiresVerification(release) {
+			return ErrChecksumMissing
+		}
+		s.logger.Warn().Str("asset", release.AssetName).Msg("Release has no checksum for this asset, skipping verification")
+		return nil
+	}
+
+	checksumFile, err := s.fetchSidecar(ctx, release.ChecksumURL)
+	if err != nil {
+		return fmt.Errorf("failed to fetch checksum: %w", err)
+	}
+
+	publicKey, err := releasePublicKey()
+	if err != nil {
+		return err
+	}
+	if publicKey != nil {
+		if release.SignatureURL == "" {
+			return fmt.Errorf("%w: release is unsigned", ErrSignatureInvalid)
+		}
+		signature, err := s.fetchSidecar(ctx, release.SignatureURL)
+		if err != nil {
+			return fmt.Errorf("failed to fetch signature: %w", err)
+		}
+		if !ed25519.Verify(publicKey, checksumFile, signature) {
+			return ErrSignatureInvalid
+		}
+	}
+
+	expected, err := parseChecksum(checksumFile, release.AssetName)
+	if err != nil {
+		return err
+	}
+	actual, err := fileSHA256(path)
+	if err != nil {
+		return fmt.Errorf("failed to hash download: %w", err)
+	}
+	if !strings.EqualFold(expected, actual) {
+		return ErrChecksumMismatch
+	}
+
+	s.logger.Info().Str("asset", release.AssetName).Bool("signed", publicKey != nil).Msg("Update verified")
+	return nil
+}
+
+func (s *Service) fetchSidecar(ctx context.Context, url string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, http.NoBody)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("User-Agent", "SlipStream/"+config.Version)
+
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("server returned status %d", resp.StatusCode)
+	}
+	return io.ReadAll(io.LimitReader(resp.Body, maxSidecarSize))
+}
+
+// releasePublicKey returns the embedded release signing key, or nil for
+// builds without one.
+func releasePublicKey() (ed25519.PublicKey, error) {
+	if config.EmbeddedUpdateKey == "" {
+		return nil, nil //nolint:nilnil // nil key with no error means "unsigned build"
+	}
+	key, err := base64.StdEncoding.DecodeString(config.EmbeddedUpdateKey)
+	if err != nil || len(key) != ed25519.PublicKeySize {
+		return nil, fmt.Errorf("embedded update key is not a base64 ed25519 public key")
+	}
+	return ed25519.PublicKey(key), nil
+}
+
+// parseChecksum finds the hex digest for assetName in sha256sum output. A
+// file holding a single bare digest is also accepted.
+func parseChecksum(data []byte, assetName string) (string, error) {
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		switch {
+		case len(fields) == 1 && len(fields[0]) == sha256.Size*2:
+			return fields[0], nil
+		case len(fields) == 2 && strings.TrimPrefix(fields[1], "*") == assetName:
+			return fields[0], nil
+		}
+	}
+	return "", ErrChecksumMissing
+}
+
+func fileSHA256(path string) (string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+
+	hash := sha256.New()
+	if _, err := io.Copy(hash, file); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
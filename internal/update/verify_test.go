@@ -0,0 +1,146 @@
+package update
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/rs/zerolog"
+
+	"github.com/slipstream/slipstream/internal/config"
+)
+
+const testAsset = "slipstream_1.2.3_linux_amd64.tar.gz"
+
+func TestParseChecksum(t *testing.T) {
+	digest := hex.EncodeToString(make([]byte, sha256.Size))
+
+	tests := []struct {
+		name    string
+		data    string
+		want    string
+		wantErr bool
+	}{
+		{"sha256sum line", digest + "  " + testAsset + "\n", digest, false},
+		{"binary mode line", digest + " *" + testAsset, digest, false},
+		{"bare digest", digest + "\n", digest, false},
+		{"other asset only", digest + "  other.tar.gz", "", true},
+		{"empty", "", "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseChecksum([]byte(tt.data), testAsset)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parseChecksum() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("parseChecksum() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestVerifyDownload(t *testing.T) {
+	payload := []byte("new slipstream binary")
+	sum := sha256.Sum256(payload)
+	checksumFile := []byte(hex.EncodeToString(sum[:]) + "  " + testAsset + "\n")
+
+	publicKey, privateKey, err := ed25519.GenerateKey(nil)
+	if err != nil {
+		t.Fatalf("GenerateKey error = %v", err)
+	}
+	signature := ed25519.Sign(privateKey, checksumFile)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/sum":
+			_, _ = w.Write(checksumFile)
+		case "/sig":
+			_, _ = w.Write(signature)
+		case "/bad-sig":
+			_, _ = w.Write(make([]byte, ed25519.SignatureSize))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	path := filepath.Join(t.TempDir(), testAsset)
+	if err := os.WriteFile(path, payload, 0o600); err != nil {
+		t.Fatalf("WriteFile error = %v", err)
+	}
+
+	logger := zerolog.Nop()
+	svc := NewService(nil, &logger, nil)
+
+	originalKey := config.EmbeddedUpdateKey
+	defer func() { config.EmbeddedUpdateKey = originalKey }()
+	config.EmbeddedUpdateKey = base64.StdEncoding.EncodeToString(publicKey)
+
+	release := func(checksum, sig string) *ReleaseInfo {
+		info := &ReleaseInfo{AssetName: testAsset}
+		if checksum != "" {
+			info.ChecksumURL = server.URL + checksum
+		}
+		if sig != "" {
+			info.SignatureURL = server.URL + sig
+		}
+		return info
+	}
+
+	ctx := context.Background()
+	if err := svc.verifyDownload(ctx, release("/sum", "/sig"), path); err != nil {
+		t.Errorf("verifyDownload signed error = %v", err)
+	}
+	if err := svc.verifyDownload(ctx, release("/sum", "/bad-sig"), path); !errors.Is(err, ErrSignatureInvalid) {
+		t.Errorf("verifyDownload bad signature error = %v, want ErrSignatureInvalid", err)
+	}
+	if err := svc.verifyDownload(ctx, release("/sum", ""), path); !errors.Is(err, ErrSignatureInvalid) {
+		t.Errorf("verifyDownload unsigned error = %v, want ErrSignatureInvalid", err)
+	}
+	if err := svc.verifyDownload(ctx, release("", ""), path); !errors.Is(err, ErrChecksumMissing) {
+		t.Errorf("verifyDownload without checksum error = %v, want ErrChecksumMissing", err)
+	}
+
+	if err := os.WriteFile(path, []byte("tampered"), 0o600); err != nil {
+		t.Fatalf("WriteFile error = %v", err)
+	}
+	if err := svc.verifyDownload(ctx, release("/sum", "/sig"), path); !errors.Is(err, ErrChecksumMismatch) {
+		t.Errorf("verifyDownload tampered error = %v, want ErrChecksumMismatch", err)
+	}
+}
+
+func TestSwapBinary(t *testing.T) {
+	dir := t.TempDir()
+	target := filepath.Join(dir, "slipstream")
+	newExe := filepath.Join(t.TempDir(), "slipstream")
+
+	if err := os.WriteFile(target, []byte("old"), 0o600); err != nil {
+		t.Fatalf("WriteFile error = %v", err)
+	}
+	if err := os.WriteFile(newExe, []byte("new"), 0o600); err != nil {
+		t.Fatalf("WriteFile error = %v", err)
+	}
+
+	if err := swapBinary(newExe, target); err != nil {
+		t.Fatalf("swapBinary error = %v", err)
+	}
+
+	if got, _ := os.ReadFile(target); string(got) != "new" {
+		t.Errorf("target = %q, want new", got)
+	}
+	if got, _ := os.ReadFile(target + previousSuffix); string(got) != "old" {
+		t.Errorf("previous = %q, want old", got)
+	}
+	if _, err := os.Stat(target + stagedSuffix); !os.IsNotExist(err) {
+		t.Errorf("staged binary left behind: %v", err)
+	}
+}
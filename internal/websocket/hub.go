@@ -26,8 +26,9 @@ const (
 	// Send pings to peer with this period. Must be less than pongWait.
 	pingPeriod = (pongWait * 9) / 10
 
-	// Maximum message size allowed from peer.
-	maxMessageSize = 512
+	// Maximum message size allowed from peer. Large enough for a
+	// subscribe message carrying a full topic list.
+	maxMessageSize = 4096
 )
 
 var upgrader = websocket.Upgrader{
@@ -71,7 +72,8 @@ type DevModePayload struct {
 // Hub manages WebSocket connections and broadcasts.
 type Hub struct {
 	clients       map[*Client]bool
-	broadcast     chan []byte
+	sseClients    map[string]*Client
+	broadcast     chan outbound
 	register      chan *Client
 	unregister    chan *Client
 	incoming      chan incomingMessage
@@ -81,11 +83,17 @@ type Hub struct {
 	logger        *zerolog.Logger
 }
 
-// Client represents a WebSocket connection.
+// Client represents a WebSocket connection or, when conn is nil, a
+// Server-Sent Events stream identified by id.
 type Client struct {
 	hub  *Hub
 	conn *websocket.Conn
+	id   string
 	send chan []byte
+
+	// topics is only touched from the hub goroutine, or before the client
+	// is registered.
+	topics topicSet
 }
 
 // Message represents a WebSocket message.
@@ -104,7 +112,8 @@ func NewHub(logger *zerolog.Logger) *Hub {
 	subLogger := logger.With().Str("component", "websocket").Logger()
 	return &Hub{
 		clients:    make(map[*Client]bool),
-		broadcast:  make(chan []byte, 256),
+		sseClients: make(map[string]*Client),
+		broadcast:  make(chan outbound, 256),
 		register:   make(chan *Client),
 		unregister: make(chan *Client),
 		incoming:   make(chan incomingMessage, 256),
@@ -129,12 +138,16 @@ func (h *Hub) Run() {
 		case client := <-h.register:
 			h.mu.Lock()
 			h.clients[client] = true
+			if client.id != "" {
+				h.sseClients[client.id] = client
+			}
 			h.mu.Unlock()
 
 		case client := <-h.unregister:
 			h.mu.Lock()
 			if _, ok := h.clients[client]; ok {
 				delete(h.clients, client)
+				delete(h.sseClients, client.id)
 				close(client.send)
 			}
 			h.mu.Unlock()
@@ -148,13 +161,17 @@ func (h *Hub) Run() {
 	}
 }
 
-// broadcastMessage sends a message to all clients, removing any that can't keep up.
-func (h *Hub) broadcastMessage(message []byte) {
+// broadcastMessage sends a message to all subscribed clients, removing any
+// that can't keep up.
+func (h *Hub) broadcastMessage(message outbound) {
 	var stale []*Client
 	h.mu.RLock()
 	for client := range h.clients {
+		if !client.topics.matches(&message) {
+			continue
+		}
 		select {
-		case client.send <- message:
+		case client.send <- message.data:
 		default:
 			stale = append(stale, client)
 		}
@@ -166,12 +183,27 @@ func (h *Hub) broadcastMessage(message []byte) {
 			if _, ok := h.clients[client]; ok {
 				close(client.send)
 				delete(h.clients, client)
+				delete(h.sseClients, client.id)
 			}
 		}
 		h.mu.Unlock()
 	}
 }
 
+// sendTo queues a message for a single client, dropping it if the client's
+// buffer is full.
+func (h *Hub) sendTo(client *Client, data []byte) {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	if _, ok := h.clients[client]; !ok {
+		return
+	}
+	select {
+	case client.send <- data:
+	default:
+	}
+}
+
 // handleIncoming processes messages received from clients.
 func (h *Hub) handleIncoming(incoming incomingMessage) {
 	var msg Message
@@ -179,6 +211,11 @@ func (h *Hub) handleIncoming(incoming incomingMessage) {
 		return
 	}
 
+	if msg.Type == msgTypeSubscribe || msg.Type == msgTypeUnsubscribe {
+		h.handleSubscription(incoming.client, &msg)
+		return
+	}
+
 	if msg.Type == "devmode:set" {
 		if h.onDevModeSet == nil {
 			return
@@ -208,14 +245,29 @@ func (h *Hub) handleIncoming(incoming incomingMessage) {
 	}
 }
 
-// Broadcast sends a message to all connected clients.
-func (h *Hub) Broadcast(msgType string, payload interface{}) {
-	msg := Message{
-		Type:      msgType,
-		Payload:   payload,
-		Timestamp: time.Now().UTC().Format(time.RFC3339Nano),
+// newOutbound stamps and marshals a message for delivery.
+func newOutbound(msg *Message) (outbound, error) {
+	if msg.Timestamp == "" {
+		msg.Timestamp = time.Now().UTC().Format(time.RFC3339Nano)
 	}
 	data, err := json.Marshal(msg)
+	if err != nil {
+		return outbound{}, err
+	}
+	return outbound{
+		data:       data,
+		msgType:    msg.Type,
+		entityType: msg.EntityType,
+		entityID:   msg.EntityID,
+	}, nil
+}
+
+// Broadcast sends a message to all connected clients subscribed to its type.
+func (h *Hub) Broadcast(msgType string, payload interface{}) {
+	out, err := newOutbound(&Message{
+		Type:    msgType,
+		Payload: payload,
+	})
 	if err != nil {
 		h.logger.Error().
 			Err(err).
@@ -223,22 +275,20 @@ func (h *Hub) Broadcast(msgType string, payload interface{}) {
 			Msg("Failed to marshal WebSocket message")
 		return
 	}
-	h.broadcast <- data
+	h.broadcast <- out
 }
 
-// BroadcastEntity sends an entity lifecycle event to all connected clients.
+// BroadcastEntity sends an entity lifecycle event to all subscribed clients.
 // The type field is derived from entityType:action for backward compatibility.
 func (h *Hub) BroadcastEntity(moduleType, entityType string, entityID int64, action string, payload interface{}) {
-	msg := Message{
+	out, err := newOutbound(&Message{
 		Type:       entityType + ":" + action,
 		Module:     moduleType,
 		EntityType: entityType,
 		EntityID:   entityID,
 		Action:     action,
 		Payload:    payload,
-		Timestamp:  time.Now().UTC().Format(time.RFC3339Nano),
-	}
-	data, err := json.Marshal(msg)
+	})
 	if err != nil {
 		h.logger.Error().
 			Err(err).
@@ -248,7 +298,7 @@ func (h *Hub) BroadcastEntity(moduleType, entityType string, entityID int64, act
 			Msg("Failed to marshal entity WebSocket message")
 		return
 	}
-	h.broadcast <- data
+	h.broadcast <- out
 }
 
 // ClientCount returns the number of connected clients.
@@ -286,6 +336,10 @@ func (h *Hub) HandleWebSocket(c echo.Context) error {
 		conn: conn,
 		send: make(chan []byte, 256),
 	}
+	// Clients may subscribe up front with ?topics=import:*,queue:updated
+	if topics := parseTopics(c.QueryParam("topics")); len(topics) > 0 {
+		client.subscribe(topics)
+	}
 
 	h.register <- client
 
@@ -0,0 +1,135 @@
+package websocket
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+)
+
+// Server-Sent Events fallback for networks and proxies that break WebSockets.
+// The stream carries the same message envelope as the WebSocket and honours
+// the same ?topics= subscription. Since SSE is one-way, messages a client
+// would normally send over the socket (subscribe, devmode:set) are POSTed to
+// /events/:id using the id announced in the initial "ready" event.
+
+// sseReadyPayload is the payload of the first event on an SSE stream.
+type sseReadyPayload struct {
+	ClientID string `json:"clientId"`
+}
+
+// authenticate checks the admin token passed as ?token= or a bearer header.
+// EventSource cannot set headers, so the query parameter is the normal path.
+func (h *Hub) authenticate(c echo.Context) error {
+	token := c.QueryParam("token")
+	if token == "" {
+		token = strings.TrimPrefix(c.Request().Header.Get("Authorization"), "Bearer ")
+	}
+	if token == "" {
+		return echo.NewHTTPError(http.StatusUnauthorized, "missing authentication token")
+	}
+	if h.validateToken != nil {
+		if err := h.validateToken(token); err != nil {
+			return echo.NewHTTPError(http.StatusUnauthorized, "invalid or expired token")
+		}
+	}
+	return nil
+}
+
+// HandleSSE streams hub broadcasts as Server-Sent Events.
+func (h *Hub) HandleSSE(c echo.Context) error {
+	if err := h.authenticate(c); err != nil {
+		return err
+	}
+
+	client := &Client{
+		hub:  h,
+		id:   uuid.NewString(),
+		send: make(chan []byte, 256),
+	}
+	if topics := parseTopics(c.QueryParam("topics")); len(topics) > 0 {
+		client.subscribe(topics)
+	}
+
+	resp := c.Response()
+	resp.Header().Set(echo.HeaderContentType, "text/event-stream")
+	resp.Header().Set("Cache-Control", "no-cache")
+	resp.Header().Set("Connection", "keep-alive")
+	// Stop nginx from buffering the stream
+	resp.Header().Set("X-Accel-Buffering", "no")
+	resp.WriteHeader(http.StatusOK)
+
+	ready, err := newOutbound(&Message{Type: "sse:ready", Payload: sseReadyPayload{ClientID: client.id}})
+	if err != nil {
+		return err
+	}
+	if err := writeSSE(resp, ready.data); err != nil {
+		return nil
+	}
+
+	h.register <- client
+	defer func() { h.unregister <- client }()
+
+	ticker := time.NewTicker(pingPeriod)
+	defer ticker.Stop()
+	ctx := c.Request().Context()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case message, ok := <-client.send:
+			if !ok {
+				return nil
+			}
+			if err := writeSSE(resp, message); err != nil {
+				return nil
+			}
+		case <-ticker.C:
+			// Comment lines keep idle proxies from closing the stream
+			if _, err := io.WriteString(resp, ": ping\n\n"); err != nil {
+				return nil
+			}
+			resp.Flush()
+		}
+	}
+}
+
+// HandleSSEMessage accepts a client-to-server message for an SSE client.
+// POST /events/:id
+func (h *Hub) HandleSSEMessage(c echo.Context) error {
+	if err := h.authenticate(c); err != nil {
+		return err
+	}
+
+	h.mu.RLock()
+	client := h.sseClients[c.Param("id")]
+	h.mu.RUnlock()
+	if client == nil {
+		return echo.NewHTTPError(http.StatusNotFound, "event stream not found")
+	}
+
+	body, err := io.ReadAll(io.LimitReader(c.Request().Body, maxMessageSize+1))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+	if len(body) > maxMessageSize {
+		return echo.NewHTTPError(http.StatusRequestEntityTooLarge, "message too large")
+	}
+
+	h.incoming <- incomingMessage{client: client, message: body}
+	return c.NoContent(http.StatusAccepted)
+}
+
+// writeSSE writes one message as an SSE data event and flushes it.
+func writeSSE(resp *echo.Response, data []byte) error {
+	if _, err := fmt.Fprintf(resp, "data: %s\n\n", data); err != nil {
+		return err
+	}
+	resp.Flush()
+	return nil
+}
@@ -0,0 +1,87 @@
+package websocket
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/labstack/echo/v4"
+)
+
+// ServeStream upgrades a dedicated connection, authenticated like
+// HandleWebSocket, and writes each value from source as a Message of
+// msgType. It blocks until source closes or the client disconnects.
+// Unlike hub broadcasts the stream is private to this connection, so the
+// caller can filter it per client.
+func (h *Hub) ServeStream(c echo.Context, msgType string, source <-chan any) error {
+	token := c.Request().Header.Get("Sec-WebSocket-Protocol")
+	if token == "" {
+		return echo.NewHTTPError(http.StatusUnauthorized, "missing authentication token")
+	}
+	if h.validateToken != nil {
+		if err := h.validateToken(token); err != nil {
+			return echo.NewHTTPError(http.StatusUnauthorized, "invalid or expired token")
+		}
+	}
+
+	responseHeader := http.Header{}
+	responseHeader.Set("Sec-WebSocket-Protocol", token)
+	conn, err := upgrader.Upgrade(c.Response(), c.Request(), responseHeader)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	// Read only to notice the client going away and to answer pings
+	closed := make(chan struct{})
+	go func() {
+		defer close(closed)
+		conn.SetReadLimit(maxMessageSize)
+		_ = conn.SetReadDeadline(time.Now().Add(pongWait))
+		conn.SetPongHandler(func(string) error {
+			return conn.SetReadDeadline(time.Now().Add(pongWait))
+		})
+		for {
+			if _, _, err := conn.ReadMessage(); err != nil {
+				return
+			}
+		}
+	}()
+
+	ticker := time.NewTicker(pingPeriod)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-closed:
+			return nil
+		case payload, ok := <-source:
+			if err := conn.SetWriteDeadline(time.Now().Add(writeWait)); err != nil {
+				return nil
+			}
+			if !ok {
+				_ = conn.WriteMessage(websocket.CloseMessage, []byte{})
+				return nil
+			}
+			data, err := json.Marshal(Message{
+				Type:      msgType,
+				Payload:   payload,
+				Timestamp: time.Now().UTC().Format(time.RFC3339Nano),
+			})
+			if err != nil {
+				continue
+			}
+			if err := conn.WriteMessage(websocket.TextMessage, data); err != nil {
+				return nil
+			}
+		case <-ticker.C:
+			if err := conn.SetWriteDeadline(time.Now().Add(writeWait)); err != nil {
+				return nil
+			}
+			if err := conn.WriteMessage(websocket.PingMessage, nil); err != nil {
+				return nil
+			}
+		}
+	}
+}
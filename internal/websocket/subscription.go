@@ -0,0 +1,135 @@
+package websocket
+
+import (
+	"encoding/json"
+	"strconv"
+	"strings"
+)
+
+// Subscription topics let a client receive only the events it cares about.
+// A client without a subscription receives every event, so existing clients
+// keep working unchanged. Supported topic forms:
+//
+//	"*"            every event
+//	"queue:updated" an exact message type
+//	"import:*"     every message type with the "import:" prefix
+//	"movie:42"     entity events (BroadcastEntity) for movie 42, any action
+const (
+	msgTypeSubscribe          = "subscribe"
+	msgTypeUnsubscribe        = "unsubscribe"
+	msgTypeSubscriptionStatus = "subscription:updated"
+
+	// maxTopics bounds the topics a single client can hold.
+	maxTopics = 64
+)
+
+// SubscriptionPayload is the payload for subscribe and unsubscribe messages.
+type SubscriptionPayload struct {
+	Topics []string `json:"topics"`
+}
+
+// outbound is a marshaled message together with the fields used to match it
+// against client subscriptions.
+type outbound struct {
+	data       []byte
+	msgType    string
+	entityType string
+	entityID   int64
+}
+
+// topicSet holds a client's subscribed topics. A nil set matches everything.
+type topicSet map[string]bool
+
+// matches reports whether a message is covered by any subscribed topic.
+func (t topicSet) matches(msg *outbound) bool {
+	if t == nil || t["*"] {
+		return true
+	}
+	if t[msg.msgType] {
+		return true
+	}
+	if msg.entityType != "" && msg.entityID != 0 &&
+		t[msg.entityType+":"+strconv.FormatInt(msg.entityID, 10)] {
+		return true
+	}
+	for topic := range t {
+		if prefix, ok := strings.CutSuffix(topic, "*"); ok && prefix != "" && strings.HasPrefix(msg.msgType, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// topics returns the subscribed topics, or ["*"] when unfiltered.
+func (t topicSet) topics() []string {
+	if t == nil {
+		return []string{"*"}
+	}
+	topics := make([]string, 0, len(t))
+	for topic := range t {
+		topics = append(topics, topic)
+	}
+	return topics
+}
+
+// parseTopics splits a comma-separated topic list, dropping blanks.
+func parseTopics(raw string) []string {
+	var topics []string
+	for _, topic := range strings.Split(raw, ",") {
+		if topic = strings.TrimSpace(topic); topic != "" {
+			topics = append(topics, topic)
+		}
+	}
+	return topics
+}
+
+// subscribe adds topics to the client's subscription. The first subscribe
+// switches the client from receiving everything to receiving only its topics.
+func (c *Client) subscribe(topics []string) {
+	if c.topics == nil {
+		c.topics = make(topicSet)
+	}
+	for _, topic := range topics {
+		topic = strings.TrimSpace(topic)
+		if topic == "" || len(c.topics) >= maxTopics {
+			continue
+		}
+		c.topics[topic] = true
+	}
+}
+
+// unsubscribe removes topics. Removing the last topic leaves the client
+// subscribed to nothing; subscribing to "*" restores the full stream.
+func (c *Client) unsubscribe(topics []string) {
+	for _, topic := range topics {
+		delete(c.topics, strings.TrimSpace(topic))
+	}
+}
+
+// handleSubscription applies a subscribe or unsubscribe request and
+// acknowledges the resulting topic list to that client only.
+func (h *Hub) handleSubscription(client *Client, msg *Message) {
+	payloadBytes, err := json.Marshal(msg.Payload)
+	if err != nil {
+		return
+	}
+	var payload SubscriptionPayload
+	if err := json.Unmarshal(payloadBytes, &payload); err != nil {
+		return
+	}
+
+	if msg.Type == msgTypeSubscribe {
+		client.subscribe(payload.Topics)
+	} else {
+		client.unsubscribe(payload.Topics)
+	}
+
+	ack, err := newOutbound(&Message{
+		Type:    msgTypeSubscriptionStatus,
+		Payload: SubscriptionPayload{Topics: client.topics.topics()},
+	})
+	if err != nil {
+		return
+	}
+	h.sendTo(client, ack.data)
+}
@@ -0,0 +1,50 @@
+package websocket
+
+import "testing"
+
+func TestTopicSetMatches(t *testing.T) {
+	importDone := &outbound{msgType: "import:completed"}
+	queue := &outbound{msgType: "queue:updated"}
+	movie := &outbound{msgType: "movie:updated", entityType: "movie", entityID: 42}
+	otherMovie := &outbound{msgType: "movie:updated", entityType: "movie", entityID: 7}
+
+	tests := []struct {
+		name   string
+		topics topicSet
+		msg    *outbound
+		want   bool
+	}{
+		{"unsubscribed receives everything", nil, queue, true},
+		{"wildcard", topicSet{"*": true}, movie, true},
+		{"exact type", topicSet{"queue:updated": true}, queue, true},
+		{"exact type miss", topicSet{"queue:updated": true}, importDone, false},
+		{"prefix", topicSet{"import:*": true}, importDone, true},
+		{"prefix miss", topicSet{"import:*": true}, queue, false},
+		{"entity", topicSet{"movie:42": true}, movie, true},
+		{"entity miss", topicSet{"movie:42": true}, otherMovie, false},
+		{"empty subscription", topicSet{}, queue, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.topics.matches(tt.msg); got != tt.want {
+				t.Errorf("matches() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestClientSubscribe(t *testing.T) {
+	c := &Client{}
+	c.subscribe(parseTopics(" import:* , movie:42,, "))
+	if len(c.topics) != 2 || !c.topics["import:*"] || !c.topics["movie:42"] {
+		t.Fatalf("unexpected topics %v", c.topics)
+	}
+
+	c.unsubscribe([]string{"import:*"})
+	if !c.topics.matches(&outbound{msgType: "movie:updated", entityType: "movie", entityID: 42}) {
+		t.Error("remaining entity topic should still match")
+	}
+	if c.topics.matches(&outbound{msgType: "import:completed"}) {
+		t.Error("unsubscribed topic should no longer match")
+	}
+}
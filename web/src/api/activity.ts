@@ -0,0 +1,14 @@
+import type { ActivityListOptions, ActivityListResponse } from '@/types/activity'
+
+import { apiFetch, buildQueryString } from './client'
+
+export const activityApi = {
+  list: (options: ActivityListOptions = {}) =>
+    apiFetch<ActivityListResponse>(
+      `/activity${buildQueryString({
+        kind: options.kind?.join(','),
+        page: options.page,
+        pageSize: options.pageSize,
+      })}`,
+    ),
+}
@@ -15,3 +15,4 @@ export {
 } from './requests'
 export { getRequestSettings, updateRequestSettings } from './settings'
 export { deleteUser,disableUser, enableUser, listUsers, updateUser } from './users'
+export { createWidgetKey, deleteWidgetKey, listWidgetKeys, updateWidgetKey } from './widget-keys'
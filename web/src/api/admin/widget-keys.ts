@@ -0,0 +1,29 @@
+import type { WidgetKey, WidgetKeyInput } from '@/types'
+
+import { apiFetch } from '../client'
+
+const BASE_PATH = '/admin/requests/widget-keys'
+
+export async function listWidgetKeys(): Promise<WidgetKey[]> {
+  return apiFetch<WidgetKey[]>(BASE_PATH)
+}
+
+export async function createWidgetKey(input: WidgetKeyInput): Promise<WidgetKey> {
+  return apiFetch<WidgetKey>(BASE_PATH, {
+    method: 'POST',
+    body: JSON.stringify(input),
+  })
+}
+
+export async function updateWidgetKey(id: number, input: WidgetKeyInput): Promise<WidgetKey> {
+  return apiFetch<WidgetKey>(`${BASE_PATH}/${id}`, {
+    method: 'PUT',
+    body: JSON.stringify(input),
+  })
+}
+
+export async function deleteWidgetKey(id: number): Promise<undefined> {
+  return apiFetch<undefined>(`${BASE_PATH}/${id}`, {
+    method: 'DELETE',
+  })
+}
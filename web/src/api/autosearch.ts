@@ -36,6 +36,14 @@ export const autosearchApi = {
   searchSeries: (seriesId: number) =>
     apiFetch<BatchAutoSearchResult>(`/autosearch/series/${seriesId}`, { method: 'POST' }),
 
+  cancelSearch: (mediaType: AutoSearchMediaType, mediaId: number, seasonNumber?: number) =>
+    apiFetch<undefined>(
+      seasonNumber === undefined
+        ? `/autosearch/cancel/${mediaType}/${mediaId}`
+        : `/autosearch/cancel/${mediaType}/${mediaId}?seasonNumber=${seasonNumber}`,
+      { method: 'POST' },
+    ),
+
   getStatus: (mediaType: AutoSearchMediaType, mediaId: number) =>
     apiFetch<AutoSearchStatus>(`/autosearch/status/${mediaType}/${mediaId}`),
 
@@ -0,0 +1,43 @@
+import type { Backup } from '@/types/backup'
+
+import { apiFetch, getAdminAuthToken } from './client'
+
+export const backupApi = {
+  list: () => apiFetch<Backup[]>('/system/backup'),
+
+  create: () => apiFetch<Backup>('/system/backup', { method: 'POST' }),
+
+  delete: (name: string) =>
+    apiFetch<undefined>(`/system/backup/${encodeURIComponent(name)}`, { method: 'DELETE' }),
+
+  restore: (name: string) =>
+    apiFetch<{ message: string } | undefined>(
+      `/system/backup/${encodeURIComponent(name)}/restore`,
+      { method: 'POST' },
+    ),
+
+  download: (name: string) => {
+    const token = getAdminAuthToken()
+    const headers: Record<string, string> = {}
+    if (token) {
+      headers.Authorization = `Bearer ${token}`
+    }
+    return fetch(`/api/v1/system/backup/${encodeURIComponent(name)}`, { headers })
+      .then((res) => {
+        if (!res.ok) {
+          throw new Error('Failed to download backup')
+        }
+        return res.blob()
+      })
+      .then((blob) => {
+        const url = globalThis.URL.createObjectURL(blob)
+        const a = document.createElement('a')
+        a.href = url
+        a.download = name
+        document.body.append(a)
+        a.click()
+        globalThis.URL.revokeObjectURL(url)
+        a.remove()
+      })
+  },
+}
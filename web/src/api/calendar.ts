@@ -1,8 +1,9 @@
-import type { CalendarEvent, CalendarRequest } from '@/types/calendar'
+import type { CalendarEvent, CalendarRequest, ComingSoonItem } from '@/types/calendar'
 
 import { apiFetch, buildQueryString } from './client'
 
 export const calendarApi = {
   getEvents: (params: CalendarRequest) =>
     apiFetch<CalendarEvent[]>(`/calendar${buildQueryString(params)}`),
+  getComingSoon: () => apiFetch<ComingSoonItem[]>('/calendar/coming-soon'),
 }
@@ -111,3 +111,8 @@ export function buildQueryString(params: object): string {
   const queryString = searchParams.toString()
   return queryString ? `?${queryString}` : ''
 }
+
+/** Query string for deleting a movie or series from the library. */
+export function deleteMediaQuery(deleteFiles?: boolean, addExclusion?: boolean): string {
+  return buildQueryString({ deleteFiles: deleteFiles || undefined, addExclusion: addExclusion || undefined })
+}
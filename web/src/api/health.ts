@@ -1,5 +1,6 @@
 import type {
   HealthCategory,
+  HealthIssue,
   HealthItem,
   HealthResponse,
   HealthSummary,
@@ -19,6 +20,29 @@ export const healthApi = {
   // Get items for a specific category
   getCategory: (category: HealthCategory) => apiFetch<HealthItem[]>(`/system/health/${category}`),
 
+  // Get active issues, optionally including dismissed and muted ones
+  getIssues: (includeHidden = false) =>
+    apiFetch<HealthIssue[]>(`/system/health/issues${includeHidden ? '?includeHidden=true' : ''}`),
+
+  // Hide an issue until it changes
+  dismissIssue: (category: HealthCategory, id: string) =>
+    apiFetch<undefined>(`/system/health/issues/${category}/${id}/dismiss`, { method: 'POST' }),
+
+  // Undo a dismissal
+  restoreIssue: (category: HealthCategory, id: string) =>
+    apiFetch<undefined>(`/system/health/issues/${category}/${id}/dismiss`, { method: 'DELETE' }),
+
+  // Hide an item's issues and silence its notifications for a number of hours
+  muteIssue: (category: HealthCategory, id: string, hours: number) =>
+    apiFetch<{ mutedUntil: string }>(`/system/health/issues/${category}/${id}/mute`, {
+      method: 'POST',
+      body: JSON.stringify({ hours }),
+    }),
+
+  // Remove a mute
+  unmuteIssue: (category: HealthCategory, id: string) =>
+    apiFetch<undefined>(`/system/health/issues/${category}/${id}/mute`, { method: 'DELETE' }),
+
   // Test all items in a category
   testCategory: (category: HealthCategory) =>
     apiFetch<TestCategoryResult>(`/system/health/${category}/test`, {
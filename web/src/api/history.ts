@@ -1,4 +1,4 @@
-import type { HistoryResponse, ListHistoryOptions } from '@/types'
+import type { HistoryResponse, HistorySnapshot, ListHistoryOptions } from '@/types'
 
 import { apiFetch, buildQueryString } from './client'
 
@@ -11,6 +11,9 @@ export const historyApi = {
   list: (options?: ListHistoryOptions) =>
     apiFetch<HistoryResponse>(`/history${buildQueryString(options ?? {})}`),
 
+  snapshot: (mediaType: 'movie' | 'episode', mediaId: number, at?: string) =>
+    apiFetch<HistorySnapshot>(`/history/snapshot${buildQueryString({ mediaType, mediaId, at })}`),
+
   clear: () => apiFetch<undefined>('/history', { method: 'DELETE' }),
 
   getSettings: () => apiFetch<HistoryRetentionSettings>('/history/settings'),
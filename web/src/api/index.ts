@@ -1,4 +1,6 @@
+export { activityApi } from './activity'
 export { autosearchApi } from './autosearch'
+export { backupApi } from './backup'
 export { calendarApi } from './calendar'
 export { defaultsApi } from './defaults'
 export { downloadClientsApi } from './download-clients'
@@ -18,7 +20,9 @@ export { rssSyncApi } from './rsssync'
 export { schedulerApi } from './scheduler'
 export { seriesApi } from './series'
 export { slotsApi } from './slots'
+export { statsApi } from './stats'
 export { systemApi } from './system'
+export { tagsApi } from './tags'
 export { updateApi } from './update'
 
 // Portal APIs
@@ -5,8 +5,12 @@ import type {
   DefinitionMetadata,
   DefinitionSetting,
   Indexer,
+  IndexerStats,
   IndexerStatus,
+  IndexerGrabCap,
+  IndexerSeedGoal,
   IndexerTestResult,
+  QuarantinedRow,
   TestConfigInput,
   UpdateIndexerInput,
 } from '@/types'
@@ -48,6 +52,33 @@ export const indexersApi = {
   getAllStatuses: () =>
     apiFetch<{ indexers: IndexerStatus[]; stats?: Record<string, number> }>('/indexers/status'),
 
+  getStats: (days?: number) =>
+    apiFetch<{ indexers: IndexerStats[]; since?: string }>(
+      `/indexers/stats${buildQueryString({ days })}`,
+    ),
+
+  listQuarantine: (id: number, limit?: number) =>
+    apiFetch<QuarantinedRow[]>(`/indexers/${id}/quarantine${buildQueryString({ limit })}`),
+
+  clearQuarantine: (id: number) =>
+    apiFetch<undefined>(`/indexers/${id}/quarantine`, { method: 'DELETE' }),
+
+  getSeedGoal: (id: number) => apiFetch<IndexerSeedGoal>(`/indexers/${id}/seed-goal`),
+
+  updateSeedGoal: (id: number, data: Omit<IndexerSeedGoal, 'indexerId'>) =>
+    apiFetch<IndexerSeedGoal>(`/indexers/${id}/seed-goal`, {
+      method: 'PUT',
+      body: JSON.stringify(data),
+    }),
+
+  getGrabCap: (id: number) => apiFetch<IndexerGrabCap>(`/indexers/${id}/grab-cap`),
+
+  updateGrabCap: (id: number, data: Omit<IndexerGrabCap, 'indexerId'>) =>
+    apiFetch<IndexerGrabCap>(`/indexers/${id}/grab-cap`, {
+      method: 'PUT',
+      body: JSON.stringify(data),
+    }),
+
   // Definition operations
   listDefinitions: () => apiFetch<DefinitionMetadata[]>('/indexers/definitions'),
 
@@ -1,4 +1,19 @@
-import type { AddMovieInput, AddSeriesInput, Movie, Series } from '@/types'
+import type {
+  AddLookupResult,
+  AddMovieInput,
+  AddOptions,
+  AddOptionsRequest,
+  AddSeriesInput,
+  AddWizardMediaType,
+  Movie,
+  OrphanFixInput,
+  OrphanFixResult,
+  OrphanReport,
+  QualityAuditReport,
+  QualityAuditSearchInput,
+  QualityAuditSearchResult,
+  Series,
+} from '@/types'
 
 import { apiFetch } from './client'
 
@@ -56,4 +71,61 @@ export const libraryApi = {
       method: 'POST',
       body: JSON.stringify(data),
     }),
+
+  /** Add wizard: search metadata, flagging titles already in the library */
+  addLookup: (mediaType: AddWizardMediaType, params: { term?: string; year?: number; tmdbId?: number; tvdbId?: number }) => {
+    const query = new URLSearchParams()
+    for (const [key, value] of Object.entries(params)) {
+      if (value) {query.set(key, String(value))}
+    }
+    return apiFetch<AddLookupResult[]>(`/library/add/${mediaType}/lookup?${query.toString()}`)
+  },
+
+  /** Add wizard: root folder and profile options with per-option checks */
+  addOptions: (mediaType: AddWizardMediaType, data: AddOptionsRequest) =>
+    apiFetch<AddOptions>(`/library/add/${mediaType}/options`, {
+      method: 'POST',
+      body: JSON.stringify(data),
+    }),
+
+  /** Add wizard: validate the selection and add the movie */
+  addMovieFromWizard: (data: AddMovieInput) =>
+    apiFetch<Movie>('/library/add/movie', {
+      method: 'POST',
+      body: JSON.stringify(data),
+    }),
+
+  /** Add wizard: validate the selection and add the series */
+  addSeriesFromWizard: (data: AddSeriesInput) =>
+    apiFetch<Series>('/library/add/series', {
+      method: 'POST',
+      body: JSON.stringify(data),
+    }),
+
+  /** Get the latest orphaned file and missing record report */
+  getOrphanReport: () => apiFetch<OrphanReport>('/library/orphans'),
+
+  /** Walk all root folders and build a fresh orphan report */
+  rebuildOrphanReport: () => apiFetch<OrphanReport>('/library/orphans', { method: 'POST' }),
+
+  /** Apply one fix action from the orphan report */
+  fixOrphan: (data: OrphanFixInput) =>
+    apiFetch<OrphanFixResult>('/library/orphans/fix', {
+      method: 'POST',
+      body: JSON.stringify(data),
+    }),
+
+  /** Get the latest report of files that look lower quality than recorded */
+  getQualityAuditReport: () => apiFetch<QualityAuditReport>('/library/quality-audit'),
+
+  /** Audit every library file and build a fresh quality audit report */
+  rebuildQualityAuditReport: () =>
+    apiFetch<QualityAuditReport>('/library/quality-audit', { method: 'POST' }),
+
+  /** Re-search the movie or episode of a flagged file */
+  searchAuditedFile: (data: QualityAuditSearchInput) =>
+    apiFetch<QualityAuditSearchResult>('/library/quality-audit/search', {
+      method: 'POST',
+      body: JSON.stringify(data),
+    }),
 }
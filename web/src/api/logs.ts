@@ -1,10 +1,29 @@
-import type { LogEntry } from '@/types/logs'
+import type { LogEntry, LogLevels, LogQuery } from '@/types/logs'
 
 import { apiFetch, getAdminAuthToken } from './client'
 
 export const logsApi = {
   getRecent: () => apiFetch<LogEntry[]>('/system/logs'),
 
+  query: (params: LogQuery) => {
+    const search = new URLSearchParams()
+    for (const [key, value] of Object.entries(params)) {
+      if (value !== undefined && value !== '') {
+        search.set(key, String(value))
+      }
+    }
+    const qs = search.toString()
+    return apiFetch<LogEntry[]>(qs ? `/log?${qs}` : '/log')
+  },
+
+  getLevels: () => apiFetch<LogLevels>('/log/levels'),
+
+  updateLevels: (data: LogLevels) =>
+    apiFetch<LogLevels>('/log/levels', {
+      method: 'PUT',
+      body: JSON.stringify(data),
+    }),
+
   downloadLogFile: () => {
     const token = getAdminAuthToken()
     const headers: Record<string, string> = {}
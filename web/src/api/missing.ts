@@ -5,9 +5,11 @@ import type {
   UpgradableCounts,
   UpgradableMovie,
   UpgradableSeries,
+  WantedOptions,
+  WantedResponse,
 } from '@/types/missing'
 
-import { apiFetch } from './client'
+import { apiFetch, buildQueryString } from './client'
 
 export const missingApi = {
   getMovies: () => apiFetch<MissingMovie[]>('/missing/movies'),
@@ -17,4 +19,9 @@ export const missingApi = {
   getUpgradableMovies: () => apiFetch<UpgradableMovie[]>('/missing/upgradable/movies'),
   getUpgradableSeries: () => apiFetch<UpgradableSeries[]>('/missing/upgradable/series'),
   getUpgradableCounts: () => apiFetch<UpgradableCounts>('/missing/upgradable/counts'),
+
+  getWantedMissing: (options?: WantedOptions) =>
+    apiFetch<WantedResponse>(`/wanted/missing${buildQueryString(options ?? {})}`),
+  getWantedCutoff: (options?: WantedOptions) =>
+    apiFetch<WantedResponse>(`/wanted/cutoff${buildQueryString(options ?? {})}`),
 }
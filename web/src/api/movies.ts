@@ -1,6 +1,13 @@
-import type { CreateMovieInput, ListMoviesOptions, Movie, UpdateMovieInput } from '@/types'
+import type {
+  CreateMovieInput,
+  ListMoviesOptions,
+  Movie,
+  MoveFolderInput,
+  MoveFolderResult,
+  UpdateMovieInput,
+} from '@/types'
 
-import { apiFetch, buildQueryString } from './client'
+import { apiFetch, buildQueryString, deleteMediaQuery } from './client'
 
 export const moviesApi = {
   list: (options?: ListMoviesOptions) =>
@@ -20,13 +27,13 @@ export const moviesApi = {
       body: JSON.stringify(data),
     }),
 
-  delete: (id: number, deleteFiles?: boolean) =>
-    apiFetch<undefined>(`/movies/${id}${deleteFiles ? '?deleteFiles=true' : ''}`, { method: 'DELETE' }),
+  delete: (id: number, deleteFiles?: boolean, addExclusion?: boolean) =>
+    apiFetch<undefined>(`/movies/${id}${deleteMediaQuery(deleteFiles, addExclusion)}`, { method: 'DELETE' }),
 
-  bulkDelete: (ids: number[], deleteFiles?: boolean) =>
+  bulkDelete: (ids: number[], deleteFiles?: boolean, addExclusion?: boolean) =>
     Promise.all(
       ids.map((id) =>
-        apiFetch<undefined>(`/movies/${id}${deleteFiles ? '?deleteFiles=true' : ''}`, {
+        apiFetch<undefined>(`/movies/${id}${deleteMediaQuery(deleteFiles, addExclusion)}`, {
           method: 'DELETE',
         }),
       ),
@@ -51,5 +58,11 @@ export const moviesApi = {
 
   refresh: (id: number) => apiFetch<Movie>(`/movies/${id}/refresh`, { method: 'POST' }),
 
+  move: (id: number, input: MoveFolderInput) =>
+    apiFetch<MoveFolderResult>(`/movies/${id}/move`, {
+      method: 'POST',
+      body: JSON.stringify(input),
+    }),
+
   refreshAll: () => apiFetch<{ message: string }>('/movies/refresh', { method: 'POST' }),
 }
@@ -1,8 +1,9 @@
-import type { PortalMovieSearchResult, PortalSeriesSearchResult } from '@/types'
+import type { ComingSoonItem, PortalMovieSearchResult, PortalSeriesSearchResult } from '@/types'
 
 import { portalFetch } from './client'
 
 export const portalLibraryApi = {
   getMovies: () => portalFetch<PortalMovieSearchResult[]>('/library/movies'),
   getSeries: () => portalFetch<PortalSeriesSearchResult[]>('/library/series'),
+  getComingSoon: () => portalFetch<ComingSoonItem[]>('/coming-soon'),
 }
@@ -1,4 +1,10 @@
-import type { CreateRequestInput, PortalDownload, Request, RequestListFilters } from '@/types'
+import type {
+  CreateRequestInput,
+  PortalDownload,
+  Request,
+  RequestableSlot,
+  RequestListFilters,
+} from '@/types'
 
 import { buildQueryString, portalFetch } from './client'
 
@@ -22,4 +28,6 @@ export const portalRequestsApi = {
   getWatchers: (id: number) => portalFetch<number[]>(`/${id}/watchers`),
 
   downloads: () => portalFetch<PortalDownload[]>('/downloads'),
+
+  slots: () => portalFetch<RequestableSlot[]>('/slots'),
 }
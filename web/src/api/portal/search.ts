@@ -14,4 +14,16 @@ export const portalSearchApi = {
       `/search/series/seasons${buildQueryString({ tmdbId, tvdbId })}`,
       init,
     ),
+
+  trendingMovies: (includeLibrary = false, init?: RequestInit) =>
+    portalFetch<PortalMovieSearchResult[]>(
+      `/search/trending/movie${buildQueryString({ includeLibrary: includeLibrary || undefined })}`,
+      init,
+    ),
+
+  trendingSeries: (includeLibrary = false, init?: RequestInit) =>
+    portalFetch<PortalSeriesSearchResult[]>(
+      `/search/trending/series${buildQueryString({ includeLibrary: includeLibrary || undefined })}`,
+      init,
+    ),
 }
@@ -8,7 +8,7 @@ type AddFlowPreferences = {
 }
 
 type SeriesSearchOnAdd = 'no' | 'first_episode' | 'first_season' | 'latest_season' | 'all'
-type SeriesMonitorOnAdd = 'none' | 'first_season' | 'latest_season' | 'future' | 'all'
+type SeriesMonitorOnAdd = 'none' | 'first_season' | 'latest_season' | 'future' | 'pilot' | 'all'
 
 export const preferencesApi = {
   getAddFlowPreferences: () => apiFetch<AddFlowPreferences>('/preferences/addflow'),
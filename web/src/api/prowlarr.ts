@@ -5,6 +5,9 @@ import type {
   ProwlarrConfigInput,
   ProwlarrConnectionStatus,
   ProwlarrIndexer,
+  ProwlarrIndexerSyncInput,
+  ProwlarrIndexerSyncResult,
+  ProwlarrIndexerSyncStatus,
   ProwlarrIndexerSettings,
   ProwlarrIndexerSettingsInput,
   ProwlarrIndexerWithSettings,
@@ -79,4 +82,18 @@ export const prowlarrApi = {
     apiFetch<ProwlarrIndexerSettings>(`/indexers/prowlarr/indexers/${indexerId}/reset-stats`, {
       method: 'POST',
     }),
+
+  // Indexer sync operations
+  getSyncStatus: () => apiFetch<ProwlarrIndexerSyncStatus>('/indexers/prowlarr/sync'),
+
+  updateSync: (data: ProwlarrIndexerSyncInput) =>
+    apiFetch<ProwlarrIndexerSyncStatus>('/indexers/prowlarr/sync', {
+      method: 'PUT',
+      body: JSON.stringify(data),
+    }),
+
+  runSync: () =>
+    apiFetch<ProwlarrIndexerSyncResult>('/indexers/prowlarr/sync/run', {
+      method: 'POST',
+    }),
 }
@@ -2,6 +2,8 @@ import type {
   AttributeOptions,
   CheckExclusivityResponse,
   CreateQualityProfileInput,
+  Quality,
+  QualityInput,
   QualityProfile,
   UpdateQualityProfileInput,
 } from '@/types'
@@ -30,6 +32,23 @@ export const qualityProfilesApi = {
 
   delete: (id: number) => apiFetch<undefined>(`/qualityprofiles/${id}`, { method: 'DELETE' }),
 
+  listQualities: () => apiFetch<Quality[]>('/qualityprofiles/qualities'),
+
+  createQuality: (data: QualityInput) =>
+    apiFetch<Quality>('/qualityprofiles/qualities', {
+      method: 'POST',
+      body: JSON.stringify(data),
+    }),
+
+  updateQuality: (id: number, data: QualityInput) =>
+    apiFetch<Quality>(`/qualityprofiles/qualities/${id}`, {
+      method: 'PUT',
+      body: JSON.stringify(data),
+    }),
+
+  deleteQuality: (id: number) =>
+    apiFetch<undefined>(`/qualityprofiles/qualities/${id}`, { method: 'DELETE' }),
+
   getAttributes: () => apiFetch<AttributeOptions>('/qualityprofiles/attributes'),
 
   checkExclusivity: (profileIds: number[]) =>
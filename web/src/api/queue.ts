@@ -1,4 +1,10 @@
-import type { QueueResponse, QueueStats } from '@/types'
+import type {
+  QueueResponse,
+  QueueStats,
+  RemapDownloadInput,
+  StalledDownloadResult,
+  StalledDownloadSettings,
+} from '@/types'
 
 import { apiFetch } from './client'
 
@@ -23,10 +29,26 @@ export const queueApi = {
       body: JSON.stringify({ clientId }),
     }),
 
+  remap: (id: string, input: RemapDownloadInput) =>
+    apiFetch<{ status: string }>(`/queue/${id}/remap`, {
+      method: 'POST',
+      body: JSON.stringify(input),
+    }),
+
   remove: (clientId: number, id: string, deleteFiles = false) =>
     apiFetch<undefined>(`/queue/${id}?clientId=${clientId}&deleteFiles=${deleteFiles}`, {
       method: 'DELETE',
     }),
 
   stats: () => apiFetch<QueueStats>('/queue/stats'),
+
+  getStalledSettings: () => apiFetch<StalledDownloadSettings>('/queue/stalled/settings'),
+
+  updateStalledSettings: (settings: StalledDownloadSettings) =>
+    apiFetch<StalledDownloadSettings>('/queue/stalled/settings', {
+      method: 'PUT',
+      body: JSON.stringify(settings),
+    }),
+
+  checkStalled: () => apiFetch<StalledDownloadResult>('/queue/stalled/check', { method: 'POST' }),
 }
@@ -1,4 +1,10 @@
-import type { CreateRootFolderInput, RootFolder } from '@/types'
+import type {
+  CreateRootFolderInput,
+  LibraryImportInput,
+  LibraryImportPreview,
+  LibraryImportResult,
+  RootFolder,
+} from '@/types'
 
 import { apiFetch } from './client'
 
@@ -19,4 +25,14 @@ export const rootFoldersApi = {
   delete: (id: number) => apiFetch<undefined>(`/rootfolders/${id}`, { method: 'DELETE' }),
 
   refresh: (id: number) => apiFetch<RootFolder>(`/rootfolders/${id}/refresh`, { method: 'POST' }),
+
+  setDefault: (id: number) => apiFetch<RootFolder>(`/rootfolders/${id}/default`, { method: 'PUT' }),
+
+  previewImport: (id: number) => apiFetch<LibraryImportPreview>(`/rootfolders/${id}/import`),
+
+  import: (id: number, data: LibraryImportInput) =>
+    apiFetch<LibraryImportResult[]>(`/rootfolders/${id}/import`, {
+      method: 'POST',
+      body: JSON.stringify(data),
+    }),
 }
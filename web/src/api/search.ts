@@ -1,13 +1,17 @@
 import type {
   BulkGrabRequest,
   BulkGrabResult,
+  CrossSeedResult,
+  CrossSeedSettings,
   GrabHistoryItem,
   GrabRequest,
   GrabResult,
   IndexerStatus,
+  PendingGrab,
   ScoredSearchCriteria,
   SearchCriteria,
   SearchResult,
+  SpaceForecast,
   TorrentSearchResult,
 } from '@/types'
 
@@ -82,6 +86,33 @@ export const searchApi = {
   getGrabHistory: (limit = 50, offset = 0) =>
     apiFetch<GrabHistoryItem[]>(`/search/grab/history?limit=${limit}&offset=${offset}`),
 
+  // Grabs held for approval
+  getPendingGrabs: () => apiFetch<PendingGrab[]>('/search/grab/pending'),
+
+  approvePendingGrab: (id: number) =>
+    apiFetch<GrabResult>(`/search/grab/pending/${id}/approve`, { method: 'POST' }),
+
+  rejectPendingGrab: (id: number) =>
+    apiFetch<undefined>(`/search/grab/pending/${id}`, { method: 'DELETE' }),
+
+  // Projected disk usage of queued and missing items per volume
+  getSpaceForecast: () => apiFetch<SpaceForecast>('/search/grab/space-forecast'),
+
+  // Cross-seeding of imported torrents on other indexers
+  getCrossSeedSettings: () => apiFetch<CrossSeedSettings>('/search/grab/cross-seed'),
+
+  updateCrossSeedSettings: (settings: CrossSeedSettings) =>
+    apiFetch<CrossSeedSettings>('/search/grab/cross-seed', {
+      method: 'PUT',
+      body: JSON.stringify(settings),
+    }),
+
+  crossSeedDownload: (clientId: number, downloadId: string) =>
+    apiFetch<CrossSeedResult>(
+      `/search/grab/cross-seed/${clientId}/${encodeURIComponent(downloadId)}`,
+      { method: 'POST' },
+    ),
+
   // Get indexer statuses
   getIndexerStatuses: () => apiFetch<IndexerStatus[]>('/indexers/status'),
 }
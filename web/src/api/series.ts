@@ -1,17 +1,21 @@
 import type {
   BulkEpisodeMonitorInput,
   BulkMonitorInput,
+  CompletenessReport,
   CreateSeriesInput,
   Episode,
   ListSeriesOptions,
   MonitoringStats,
+  MoveFolderInput,
+  MoveFolderResult,
   Season,
   Series,
+  SeriesGaps,
   UpdateEpisodeInput,
   UpdateSeriesInput,
 } from '@/types'
 
-import { apiFetch, buildQueryString } from './client'
+import { apiFetch, buildQueryString, deleteMediaQuery } from './client'
 
 export const seriesApi = {
   list: (options?: ListSeriesOptions) =>
@@ -31,13 +35,13 @@ export const seriesApi = {
       body: JSON.stringify(data),
     }),
 
-  delete: (id: number, deleteFiles?: boolean) =>
-    apiFetch<undefined>(`/series/${id}${deleteFiles ? '?deleteFiles=true' : ''}`, { method: 'DELETE' }),
+  delete: (id: number, deleteFiles?: boolean, addExclusion?: boolean) =>
+    apiFetch<undefined>(`/series/${id}${deleteMediaQuery(deleteFiles, addExclusion)}`, { method: 'DELETE' }),
 
-  bulkDelete: (ids: number[], deleteFiles?: boolean) =>
+  bulkDelete: (ids: number[], deleteFiles?: boolean, addExclusion?: boolean) =>
     Promise.all(
       ids.map((id) =>
-        apiFetch<undefined>(`/series/${id}${deleteFiles ? '?deleteFiles=true' : ''}`, {
+        apiFetch<undefined>(`/series/${id}${deleteMediaQuery(deleteFiles, addExclusion)}`, {
           method: 'DELETE',
         }),
       ),
@@ -62,6 +66,12 @@ export const seriesApi = {
 
   refresh: (id: number) => apiFetch<Series>(`/series/${id}/refresh`, { method: 'POST' }),
 
+  move: (id: number, input: MoveFolderInput) =>
+    apiFetch<MoveFolderResult>(`/series/${id}/move`, {
+      method: 'POST',
+      body: JSON.stringify(input),
+    }),
+
   refreshAll: () => apiFetch<{ message: string }>('/series/refresh', { method: 'POST' }),
 
   // Season operations
@@ -128,4 +138,9 @@ export const seriesApi = {
 
   getMonitoringStats: (seriesId: number) =>
     apiFetch<MonitoringStats>(`/series/${seriesId}/monitor/stats`),
+
+  getCompleteness: (seriesId: number) =>
+    apiFetch<CompletenessReport>(`/series/${seriesId}/completeness`),
+
+  listGaps: () => apiFetch<SeriesGaps[]>('/series/gaps'),
 }
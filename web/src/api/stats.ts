@@ -0,0 +1,7 @@
+import type { LibraryStats } from '@/types/stats'
+
+import { apiFetch } from './client'
+
+export const statsApi = {
+  get: (days = 30) => apiFetch<LibraryStats>(`/stats?days=${days}`),
+}
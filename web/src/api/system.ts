@@ -1,8 +1,10 @@
 import type {
   FirewallStatus,
   HealthCheck,
+  HostSettings,
   Settings,
   SystemStatus,
+  UpdateHostSettingsInput,
   UpdateSettingsInput,
 } from '@/types'
 
@@ -40,4 +42,12 @@ export const systemApi = {
       method: 'PUT',
       body: JSON.stringify(data),
     }),
+
+  getHostSettings: () => apiFetch<HostSettings>('/settings/host'),
+
+  updateHostSettings: (data: UpdateHostSettingsInput) =>
+    apiFetch<HostSettings>('/settings/host', {
+      method: 'PUT',
+      body: JSON.stringify(data),
+    }),
 }
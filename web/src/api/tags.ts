@@ -0,0 +1,27 @@
+import type { Tag, TagDetail, TagInput } from '@/types'
+
+import { apiFetch } from './client'
+
+export const tagsApi = {
+  list: () => apiFetch<Tag[]>('/tags'),
+
+  listDetail: () => apiFetch<TagDetail[]>('/tags/detail'),
+
+  get: (id: number) => apiFetch<Tag>(`/tags/${id}`),
+
+  getDetail: (id: number) => apiFetch<TagDetail>(`/tags/${id}/detail`),
+
+  create: (data: TagInput) =>
+    apiFetch<Tag>('/tags', {
+      method: 'POST',
+      body: JSON.stringify(data),
+    }),
+
+  update: (id: number, data: TagInput) =>
+    apiFetch<Tag>(`/tags/${id}`, {
+      method: 'PUT',
+      body: JSON.stringify(data),
+    }),
+
+  delete: (id: number) => apiFetch<undefined>(`/tags/${id}`, { method: 'DELETE' }),
+}
@@ -16,9 +16,12 @@ export const updateApi = {
 
   cancel: () => apiFetch<{ message: string }>('/update/cancel', { method: 'POST' }),
 
+  rollback: () =>
+    apiFetch<{ message: string; version: string }>('/update/rollback', { method: 'POST' }),
+
   getSettings: () => apiFetch<UpdateSettings>('/update/settings'),
 
-  updateSettings: (settings: UpdateSettings) =>
+  updateSettings: (settings: Pick<UpdateSettings, 'autoInstall' | 'channel'>) =>
     apiFetch<UpdateSettings>('/update/settings', {
       method: 'PUT',
       body: JSON.stringify(settings),
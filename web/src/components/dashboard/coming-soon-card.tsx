@@ -0,0 +1,102 @@
+import { PosterImage } from '@/components/media/poster-image'
+import { ProgressBar } from '@/components/media/progress-bar'
+import { Badge } from '@/components/ui/badge'
+import { Card, CardContent, CardHeader, CardTitle } from '@/components/ui/card'
+import { Skeleton } from '@/components/ui/skeleton'
+import { formatDate } from '@/lib/formatters'
+import type { ComingSoonItem } from '@/types/calendar'
+
+type ComingSoonCardProps = {
+  items?: ComingSoonItem[]
+  loading?: boolean
+  limit?: number
+}
+
+function ComingSoonSkeleton() {
+  return (
+    <Card>
+      <CardHeader>
+        <CardTitle className="text-base">Coming Soon</CardTitle>
+      </CardHeader>
+      <CardContent className="flex gap-3 overflow-hidden">
+        {[1, 2, 3, 4, 5].map((i) => (
+          <div key={i} className="w-24 shrink-0 space-y-2">
+            <Skeleton className="aspect-[2/3] w-full rounded" />
+            <Skeleton className="h-3 w-20" />
+          </div>
+        ))}
+      </CardContent>
+    </Card>
+  )
+}
+
+function itemTitle(item: ComingSoonItem): string {
+  const seriesTitle = item.extra?.seriesTitle
+  if (item.mediaType === 'episode' && typeof seriesTitle === 'string') {
+    return seriesTitle
+  }
+  return item.title
+}
+
+function itemSubtitle(item: ComingSoonItem): string {
+  if (item.mediaType !== 'episode') {
+    return 'Digital release'
+  }
+  const season = item.extra?.seasonNumber
+  const episode = item.extra?.episodeNumber
+  if (typeof season === 'number' && typeof episode === 'number') {
+    return `S${String(season).padStart(2, '0')}E${String(episode).padStart(2, '0')}`
+  }
+  return item.title
+}
+
+function ComingSoonTile({ item }: { item: ComingSoonItem }) {
+  const type = item.mediaType === 'movie' ? 'movie' : 'series'
+  const title = itemTitle(item)
+
+  return (
+    <div className="w-24 shrink-0 space-y-1">
+      <div className="relative">
+        <PosterImage
+          url={item.posterUrl}
+          alt={title}
+          type={type}
+          className="aspect-[2/3] w-full rounded"
+        />
+        {item.status === 'available' ? (
+          <Badge className="absolute top-1 right-1 px-1 py-0 text-[10px]">In library</Badge>
+        ) : null}
+      </div>
+      <p className="truncate text-xs font-medium">{title}</p>
+      <p className="text-muted-foreground truncate text-[10px]">
+        {itemSubtitle(item)} · {formatDate(item.date, { month: 'short', day: 'numeric' })}
+      </p>
+      {item.download ? <ProgressBar value={item.download.progress} size="sm" /> : null}
+    </div>
+  )
+}
+
+export function ComingSoonCard({ items, loading, limit = 12 }: ComingSoonCardProps) {
+  if (loading) {
+    return <ComingSoonSkeleton />
+  }
+
+  return (
+    <Card>
+      <CardHeader>
+        <CardTitle className="text-base">Coming Soon</CardTitle>
+      </CardHeader>
+      <CardContent>
+        {items?.length ? (
+          <div className="flex gap-3 overflow-x-auto pb-1">
+            {items.slice(0, limit).map((item) => (
+              <ComingSoonTile key={`${item.mediaType}-${item.id}`} item={item} />
+            ))}
+          </div>
+        ) : (
+          <p className="text-muted-foreground text-sm">Nothing scheduled this week</p>
+        )}
+      </CardContent>
+    </Card>
+  )
+}
@@ -1,4 +1,4 @@
-import { Bug, TestTube } from 'lucide-react'
+import { Bug, Plus, TestTube, Trash2 } from 'lucide-react'
 
 import { Button } from '@/components/ui/button'
 import {
@@ -21,7 +21,14 @@ import {
   SelectValue,
 } from '@/components/ui/select'
 import { Switch } from '@/components/ui/switch'
-import type { DownloadClient, DownloadClientType } from '@/types'
+import type {
+  CleanupMode,
+  DownloadClient,
+  DownloadClientType,
+  ImportDelayOverride,
+  PathMapping,
+  SeedingImportMode,
+} from '@/types'
 
 import { clientTypeConfigs, useDownloadClientDialog } from './use-download-client-dialog'
 
@@ -57,6 +64,9 @@ export function DownloadClientDialog({ open, onOpenChange, client }: DownloadCli
           {hook.config.supportsApiKey ? <ApiKeyInput hook={hook} /> : null}
           {hook.config.supportsCategory ? <CategoryInput hook={hook} /> : null}
           <PriorityInput hook={hook} />
+          <PathMappingsInput hook={hook} />
+          <ImportDelayInputs hook={hook} />
+          <CleanupInputs hook={hook} />
           <EnabledToggle hook={hook} />
         </DialogBody>
 
@@ -259,6 +269,197 @@ function PriorityInput({ hook }: { hook: HookValues }) {
   )
 }
 
+function PathMappingsInput({ hook }: { hook: HookValues }) {
+  const mappings = hook.formData.pathMappings ?? []
+  const setMappings = (next: PathMapping[]) =>
+    hook.setFormData((prev) => ({ ...prev, pathMappings: next }))
+  const updateMapping = (index: number, patch: Partial<PathMapping>) =>
+    setMappings(mappings.map((m, i) => (i === index ? { ...m, ...patch } : m)))
+
+  return (
+    <div className="space-y-2">
+      <div className="flex items-center justify-between">
+        <Label>Remote Path Mappings</Label>
+        <Button
+          variant="ghost"
+          size="sm"
+          onClick={() => setMappings([...mappings, { remote: '', local: '' }])}
+        >
+          <Plus className="mr-1 size-4" />
+          Add
+        </Button>
+      </div>
+      {mappings.map((mapping, index) => (
+        <div key={index} className="flex items-center gap-2">
+          <Input
+            placeholder="/downloads"
+            value={mapping.remote}
+            onChange={(e) => updateMapping(index, { remote: e.target.value })}
+          />
+          <Input
+            placeholder="/mnt/downloads"
+            value={mapping.local}
+            onChange={(e) => updateMapping(index, { local: e.target.value })}
+          />
+          <Button
+            variant="ghost"
+            size="icon"
+            onClick={() => setMappings(mappings.filter((_, i) => i !== index))}
+          >
+            <Trash2 className="size-4" />
+          </Button>
+        </div>
+      ))}
+      <p className="text-muted-foreground text-xs">
+        Translate paths reported by the client (left) to where SlipStream sees them (right)
+      </p>
+    </div>
+  )
+}
+
+const seedingImportModeLabels: Record<SeedingImportMode, string> = {
+  delay: 'Wait for import delay',
+  hardlink: 'Import immediately (hardlink)',
+}
+
+const delayCategoryLabels: Record<string, string> = {
+  movie: 'Movies',
+  tv: 'Series',
+}
+
+function ImportDelayInputs({ hook }: { hook: HookValues }) {
+  const mode = hook.formData.seedingImportMode ?? 'delay'
+  const overrides = hook.formData.importDelayOverrides ?? []
+  const setOverrides = (next: ImportDelayOverride[]) =>
+    hook.setFormData((prev) => ({ ...prev, importDelayOverrides: next }))
+  const overrideFor = (category: string) => overrides.find((o) => o.category === category)
+  const setOverride = (category: string, value: string) => {
+    const rest = overrides.filter((o) => o.category !== category)
+    const delaySeconds = Number.parseInt(value)
+    setOverrides(Number.isNaN(delaySeconds) ? rest : [...rest, { category, delaySeconds }])
+  }
+
+  return (
+    <div className="space-y-2">
+      <Label htmlFor="importDelaySeconds">Import Delay (seconds)</Label>
+      <Input
+        id="importDelaySeconds"
+        type="number"
+        min={0}
+        value={hook.formData.importDelaySeconds ?? 0}
+        onChange={(e) =>
+          hook.setFormData((prev) => ({
+            ...prev,
+            importDelaySeconds: Math.max(Number.parseInt(e.target.value) || 0, 0),
+          }))
+        }
+      />
+      <div className="grid grid-cols-2 gap-4">
+        {Object.entries(delayCategoryLabels).map(([category, label]) => (
+          <Input
+            key={category}
+            type="number"
+            min={0}
+            placeholder={`${label} override`}
+            value={overrideFor(category)?.delaySeconds ?? ''}
+            onChange={(e) => setOverride(category, e.target.value)}
+          />
+        ))}
+      </div>
+      <Label htmlFor="seedingImportMode">Seeding Torrents</Label>
+      <Select
+        value={mode}
+        onValueChange={(v) =>
+          v && hook.setFormData((prev) => ({ ...prev, seedingImportMode: v as SeedingImportMode }))
+        }
+      >
+        <SelectTrigger>
+          <SelectValue>{seedingImportModeLabels[mode]}</SelectValue>
+        </SelectTrigger>
+        <SelectContent>
+          {(Object.entries(seedingImportModeLabels) as [SeedingImportMode, string][]).map(
+            ([value, label]) => (
+              <SelectItem key={value} value={value}>
+                {label}
+              </SelectItem>
+            ),
+          )}
+        </SelectContent>
+      </Select>
+      <p className="text-muted-foreground text-xs">
+        Hardlink mode imports completed torrents right away and leaves them seeding. Torrents the client is still verifying are never imported.
+      </p>
+    </div>
+  )
+}
+
+const cleanupModeLabels: Record<CleanupMode, string> = {
+  leave: 'Leave in client',
+  delete_after_import: 'Remove after import',
+  delete_after_seed_ratio: 'Remove after seeding goal',
+}
+
+function parseOptionalNumber(value: string): number | undefined {
+  const parsed = Number.parseFloat(value)
+  return Number.isNaN(parsed) || parsed <= 0 ? undefined : parsed
+}
+
+function CleanupInputs({ hook }: { hook: HookValues }) {
+  const mode = hook.formData.cleanupMode ?? 'leave'
+
+  return (
+    <div className="space-y-2">
+      <Label htmlFor="cleanupMode">Completed Torrents</Label>
+      <Select
+        value={mode}
+        onValueChange={(v) => v && hook.setFormData((prev) => ({ ...prev, cleanupMode: v as CleanupMode }))}
+      >
+        <SelectTrigger>
+          <SelectValue>{cleanupModeLabels[mode]}</SelectValue>
+        </SelectTrigger>
+        <SelectContent>
+          {(Object.entries(cleanupModeLabels) as [CleanupMode, string][]).map(([value, label]) => (
+            <SelectItem key={value} value={value}>
+              {label}
+            </SelectItem>
+          ))}
+        </SelectContent>
+      </Select>
+      {mode === 'delete_after_seed_ratio' ? (
+        <div className="grid grid-cols-2 gap-4">
+          <Input
+            id="seedRatioTarget"
+            type="number"
+            min={0}
+            step={0.1}
+            placeholder="Seed ratio"
+            value={hook.formData.seedRatioTarget ?? ''}
+            onChange={(e) =>
+              hook.setFormData((prev) => ({ ...prev, seedRatioTarget: parseOptionalNumber(e.target.value) }))
+            }
+          />
+          <Input
+            id="seedTimeTargetMinutes"
+            type="number"
+            min={0}
+            placeholder="Seed minutes"
+            value={hook.formData.seedTimeTargetMinutes ?? ''}
+            onChange={(e) =>
+              hook.setFormData((prev) => ({
+                ...prev,
+                seedTimeTargetMinutes: parseOptionalNumber(e.target.value),
+              }))
+            }
+          />
+        </div>
+      ) : null}
+      <p className="text-muted-foreground text-xs">
+        Imported torrents are removed with their data once either goal is reached. Indexer seed goals take precedence.
+      </p>
+    </div>
+  )
+}
+
 function EnabledToggle({ hook }: { hook: HookValues }) {
   return (
     <div className="flex items-center justify-between">
@@ -39,7 +39,7 @@ function cfg(overrides: Partial<ClientTypeConfig> & Pick<ClientTypeConfig, 'labe
 }
 
 export const clientTypeConfigs: Record<DownloadClientType, ClientTypeConfig> = {
-  transmission: cfg({ label: 'Transmission', defaultPort: 9091, defaultUrlBase: '/transmission/' }),
+  transmission: cfg({ label: 'Transmission', defaultPort: 9091, defaultUrlBase: '/transmission/', supportsCategory: true }),
   qbittorrent: cfg({ label: 'qBittorrent', defaultPort: 8080, supportsCategory: true, supportsApiKey: true, apiKeyLabel: 'API Key' }),
   deluge: cfg({ label: 'Deluge', defaultPort: 8112, supportsCategory: true, supportsUsername: false, passwordRequired: true }),
   rtorrent: cfg({ label: 'rTorrent', defaultPort: 8080, defaultUrlBase: '/RPC2', supportsCategory: true }),
@@ -67,6 +67,11 @@ const defaultFormData: CreateDownloadClientInput = {
   urlBase: '/transmission/',
   priority: 50,
   enabled: true,
+  importDelaySeconds: 0,
+  importDelayOverrides: [],
+  seedingImportMode: 'delay',
+  cleanupMode: 'leave',
+  pathMappings: [],
 }
 
 function createFormDataFromClient(client: DownloadClient): CreateDownloadClientInput {
@@ -83,6 +88,13 @@ function createFormDataFromClient(client: DownloadClient): CreateDownloadClientI
     urlBase: client.urlBase ?? '',
     priority: client.priority,
     enabled: client.enabled,
+    importDelaySeconds: client.importDelaySeconds,
+    importDelayOverrides: client.importDelayOverrides,
+    seedingImportMode: client.seedingImportMode,
+    cleanupMode: client.cleanupMode,
+    seedRatioTarget: client.seedRatioTarget,
+    seedTimeTargetMinutes: client.seedTimeTargetMinutes,
+    pathMappings: client.pathMappings,
   }
 }
 
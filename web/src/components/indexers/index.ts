@@ -2,3 +2,4 @@ export { IndexerDialog } from './indexer-dialog'
 export { IndexerModeToggle } from './indexer-mode-toggle'
 export { ProwlarrConfigForm } from './prowlarr-config-form'
 export { ProwlarrIndexerList } from './prowlarr-indexer-list'
+export { ProwlarrSyncCard } from './prowlarr-sync-card'
@@ -0,0 +1,76 @@
+import { Loader2, RefreshCw } from 'lucide-react'
+import { toast } from 'sonner'
+
+import { Button } from '@/components/ui/button'
+import { Card, CardContent, CardDescription, CardHeader, CardTitle } from '@/components/ui/card'
+import { Label } from '@/components/ui/label'
+import { Switch } from '@/components/ui/switch'
+import { useProwlarrSyncStatus, useRunProwlarrSync, useUpdateProwlarrSync } from '@/hooks'
+import { formatRelativeTime } from '@/lib/formatters'
+import { withToast } from '@/lib/with-toast'
+import type { ProwlarrIndexerSyncResult } from '@/types'
+
+export function ProwlarrSyncCard() {
+  const { data: status } = useProwlarrSyncStatus()
+  const updateMutation = useUpdateProwlarrSync()
+  const runMutation = useRunProwlarrSync()
+
+  const handleToggle = withToast(async (enabled: boolean) => {
+    await updateMutation.mutateAsync({ enabled })
+  })
+
+  const handleRun = withToast(async () => {
+    const result = await runMutation.mutateAsync()
+    toast.success(`Synced from Prowlarr: ${summarize(result)}`)
+  })
+
+  return (
+    <Card>
+      <CardHeader className="flex flex-row items-start justify-between">
+        <div className="space-y-1.5">
+          <CardTitle>Sync from Prowlarr</CardTitle>
+          <CardDescription>
+            Import Cardigann indexers and their credentials from the configured Prowlarr instance.
+          </CardDescription>
+        </div>
+        <Button variant="outline" size="sm" onClick={handleRun} disabled={runMutation.isPending}>
+          {runMutation.isPending ? (
+            <Loader2 className="mr-2 size-4 animate-spin" />
+          ) : (
+            <RefreshCw className="mr-2 size-4" />
+          )}
+          Sync now
+        </Button>
+      </CardHeader>
+      <CardContent className="space-y-3">
+        <div className="flex items-center justify-between">
+          <Label htmlFor="prowlarr-sync-enabled">Sync every 6 hours</Label>
+          <Switch
+            id="prowlarr-sync-enabled"
+            checked={status?.enabled ?? false}
+            onCheckedChange={handleToggle}
+            disabled={updateMutation.isPending}
+          />
+        </div>
+        {status?.lastSync ? <LastSync result={status.lastSync} /> : null}
+      </CardContent>
+    </Card>
+  )
+}
+
+function LastSync({ result }: { result: ProwlarrIndexerSyncResult }) {
+  return (
+    <div className="text-muted-foreground text-sm">
+      <span>Last sync {formatRelativeTime(result.finishedAt)}: </span>
+      {result.error ? (
+        <span className="text-destructive">{result.error}</span>
+      ) : (
+        <span>{summarize(result)}</span>
+      )}
+    </div>
+  )
+}
+
+function summarize(result: ProwlarrIndexerSyncResult) {
+  return `${result.created} created, ${result.updated} updated, ${result.disabled} disabled, ${result.skipped} skipped`
+}
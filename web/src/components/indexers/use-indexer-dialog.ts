@@ -25,6 +25,8 @@ export type FormData = {
   enabled: boolean
   autoSearchEnabled: boolean
   rssEnabled: boolean
+  proxyUrl: string
+  flareSolverrUrl: string
 }
 
 const defaultFormData: FormData = {
@@ -36,6 +38,8 @@ const defaultFormData: FormData = {
   enabled: true,
   autoSearchEnabled: true,
   rssEnabled: true,
+  proxyUrl: '',
+  flareSolverrUrl: '',
 }
 
 function createDefinitionFromIndexer(indexer: Indexer): DefinitionMetadata {
@@ -57,17 +61,21 @@ function createFormDataFromIndexer(indexer: Indexer): FormData {
     enabled: indexer.enabled,
     autoSearchEnabled: indexer.autoSearchEnabled,
     rssEnabled: indexer.rssEnabled,
+    proxyUrl: indexer.proxyUrl,
+    flareSolverrUrl: indexer.flareSolverrUrl,
   }
 }
 
 async function testConnection(
   selectedDefinition: DefinitionMetadata,
-  settings: Record<string, string>,
+  formData: FormData,
   testMutation: ReturnType<typeof useTestIndexerConfig>,
 ) {
   const result = await testMutation.mutateAsync({
     definitionId: selectedDefinition.id,
-    settings,
+    settings: formData.settings,
+    proxyUrl: formData.proxyUrl,
+    flareSolverrUrl: formData.flareSolverrUrl,
   })
   if (result.success) {
     toast.success(result.message || 'Connection successful')
@@ -99,6 +107,8 @@ async function submitForm(params: SubmitParams) {
     enabled: formData.enabled,
     autoSearchEnabled: formData.autoSearchEnabled,
     rssEnabled: formData.rssEnabled,
+    proxyUrl: formData.proxyUrl.trim(),
+    flareSolverrUrl: formData.flareSolverrUrl.trim(),
   }
 
   if (isEditing && indexer) {
@@ -208,7 +218,7 @@ function buildHandlers(
     }
     state.setIsTesting(true)
     try {
-      await testConnection(state.selectedDefinition, form.getValues('settings'), testMutation)
+      await testConnection(state.selectedDefinition, form.getValues(), testMutation)
     } catch {
       toast.error('Failed to test connection')
     } finally {
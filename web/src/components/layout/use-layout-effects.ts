@@ -30,6 +30,7 @@ function useWebSocketLifecycle() {
   const { connect, disconnect } = useWebSocketStore()
   const { isAuthenticated, user } = usePortalAuthStore()
   const isAdmin = user?.isAdmin ?? false
+  const transport = useUIStore((s) => s.realtimeTransport)
 
   useEffect(() => {
     if (isAuthenticated && isAdmin) {
@@ -37,7 +38,7 @@ function useWebSocketLifecycle() {
     } else {
       disconnect()
     }
-  }, [isAuthenticated, isAdmin, connect, disconnect])
+  }, [isAuthenticated, isAdmin, transport, connect, disconnect])
 
   useEffect(() => {
     const handleVisibilityChange = () => {
@@ -1,6 +1,12 @@
 import { useState } from 'react'
 
-import { BACKDROP_SIZES, getLocalArtworkUrl } from '@/lib/constants'
+import {
+  BACKDROP_SIZES,
+  getCachedImageUrl,
+  getResizedArtworkUrl,
+  imageSizeWidth,
+  withVersion,
+} from '@/lib/constants'
 import { cn } from '@/lib/utils'
 import { useArtworkStore } from '@/stores/artwork'
 
@@ -33,12 +39,12 @@ function buildImageUrl(
   },
 ): string | null {
   if (params.artworkId) {
-    const baseUrl = getLocalArtworkUrl(params.type, params.artworkId, 'backdrop')
-    if (params.artworkVersion > 0) {return `${baseUrl}?v=${params.artworkVersion}`}
-    if (params.version) {return `${baseUrl}?v=${params.version}`}
+    const baseUrl = getResizedArtworkUrl(params.type, params.artworkId, 'backdrop', imageSizeWidth(params.size))
+    if (params.artworkVersion > 0) {return withVersion(baseUrl, params.artworkVersion)}
+    if (params.version) {return withVersion(baseUrl, params.version)}
     return baseUrl
   }
-  if (params.path) {return `${BACKDROP_SIZES[params.size]}${params.path}`}
+  if (params.path) {return getCachedImageUrl(`${BACKDROP_SIZES[params.size]}${params.path}`)}
   return null
 }
 
@@ -17,6 +17,8 @@ type Props = {
   selectedCount: number
   deleteFiles: boolean
   onDeleteFilesChange: (checked: boolean) => void
+  addExclusion: boolean
+  onAddExclusionChange: (checked: boolean) => void
   onConfirm: () => void
   isPending: boolean
   mediaLabel: string
@@ -29,6 +31,8 @@ export function MediaDeleteDialog({
   selectedCount,
   deleteFiles,
   onDeleteFilesChange,
+  addExclusion,
+  onAddExclusionChange,
   onConfirm,
   isPending,
   mediaLabel,
@@ -47,15 +51,27 @@ export function MediaDeleteDialog({
             This action cannot be undone. The selected {pluralMediaLabel.toLowerCase()} will be removed from your library.
           </AlertDialogDescription>
         </AlertDialogHeader>
-        <AlertDialogBody className="flex items-center gap-2 py-2">
-          <Checkbox
-            id={`delete${mediaLabel}Files`}
-            checked={deleteFiles}
-            onCheckedChange={(checked) => onDeleteFilesChange(checked)}
-          />
-          <label htmlFor={`delete${mediaLabel}Files`} className="cursor-pointer text-sm">
-            Also delete files from disk
-          </label>
+        <AlertDialogBody className="space-y-2 py-2">
+          <div className="flex items-center gap-2">
+            <Checkbox
+              id={`delete${mediaLabel}Files`}
+              checked={deleteFiles}
+              onCheckedChange={(checked) => onDeleteFilesChange(checked)}
+            />
+            <label htmlFor={`delete${mediaLabel}Files`} className="cursor-pointer text-sm">
+              Also delete files from disk (to the recycle bin, if one is set)
+            </label>
+          </div>
+          <div className="flex items-center gap-2">
+            <Checkbox
+              id={`exclude${mediaLabel}`}
+              checked={addExclusion}
+              onCheckedChange={(checked) => onAddExclusionChange(checked)}
+            />
+            <label htmlFor={`exclude${mediaLabel}`} className="cursor-pointer text-sm">
+              Exclude from future imports so it is not added back
+            </label>
+          </div>
         </AlertDialogBody>
         <AlertDialogFooter>
           <AlertDialogCancel>Cancel</AlertDialogCancel>
@@ -13,22 +13,47 @@ import { LoadingButton } from '@/components/ui/loading-button'
 import { Select, SelectContent, SelectItem, SelectTrigger } from '@/components/ui/select'
 import { Switch } from '@/components/ui/switch'
 
-import { useMediaEditDialog } from './use-media-edit-dialog'
+import { MediaMoveSection, type MoveMutation } from './media-move-section'
+import { type MediaEditData, useMediaEditDialog } from './use-media-edit-dialog'
 
-type MediaEditDialogProps<T extends { id: number; title: string; monitored: boolean; qualityProfileId: number }> = {
+type FormatTypeOption = { value: string; label: string; description: string }
+
+type MediaEditDialogProps<
+  T extends {
+    id: number
+    title: string
+    monitored: boolean
+    qualityProfileId: number
+    formatType?: string
+    path?: string
+    rootFolderId: number
+  },
+> = {
   open: boolean
   onOpenChange: (open: boolean) => void
   item: T
   updateMutation: {
-    mutateAsync: (args: { id: number; data: { monitored: boolean; qualityProfileId: number } }) => Promise<unknown>
+    mutateAsync: (args: { id: number; data: MediaEditData }) => Promise<unknown>
     isPending: boolean
   }
   mediaLabel: string
   moduleType: string
   monitoredDescription: string
+  formatTypes?: FormatTypeOption[]
+  moveMutation?: MoveMutation
 }
 
-export function MediaEditDialog<T extends { id: number; title: string; monitored: boolean; qualityProfileId: number }>({
+export function MediaEditDialog<
+  T extends {
+    id: number
+    title: string
+    monitored: boolean
+    qualityProfileId: number
+    formatType?: string
+    path?: string
+    rootFolderId: number
+  },
+>({
   open,
   onOpenChange,
   item,
@@ -36,6 +61,8 @@ export function MediaEditDialog<T extends { id: number; title: string; monitored
   mediaLabel,
   moduleType,
   monitoredDescription,
+  formatTypes,
+  moveMutation,
 }: MediaEditDialogProps<T>) {
   const state = useMediaEditDialog({ item, updateMutation, mediaLabel, moduleType, onOpenChange })
 
@@ -55,6 +82,18 @@ export function MediaEditDialog<T extends { id: number; title: string; monitored
           onMonitoredChange={state.setMonitored}
           monitoredDescription={monitoredDescription}
         />
+          {formatTypes ? (
+            <FormatTypeField options={formatTypes} value={state.formatType} onChange={state.setFormatType} />
+          ) : null}
+          {moveMutation && (moduleType === 'movie' || moduleType === 'tv') ? (
+            <MediaMoveSection
+              key={item.path}
+              item={item}
+              moduleType={moduleType}
+              moveMutation={moveMutation}
+              onMoved={() => onOpenChange(false)}
+            />
+          ) : null}
         </DialogBody>
         <EditFooter onCancel={state.handleCancel} onSubmit={state.handleSubmit} isPending={state.isPending} />
       </DialogContent>
@@ -126,3 +165,31 @@ function EditForm({
     </div>
   )
 }
+
+function FormatTypeField({
+  options,
+  value,
+  onChange,
+}: {
+  options: FormatTypeOption[]
+  value: string
+  onChange: (value: string) => void
+}) {
+  const selected = options.find((o) => o.value === value)
+  return (
+    <div className="space-y-2 pb-4">
+      <Label htmlFor="format-type">Series Type</Label>
+      <Select value={value} onValueChange={(v) => v && onChange(v)}>
+        <SelectTrigger id="format-type">{selected?.label ?? value}</SelectTrigger>
+        <SelectContent>
+          {options.map((option) => (
+            <SelectItem key={option.value} value={option.value}>
+              {option.label}
+            </SelectItem>
+          ))}
+        </SelectContent>
+      </Select>
+      {selected ? <p className="text-muted-foreground text-sm">{selected.description}</p> : null}
+    </div>
+  )
+}
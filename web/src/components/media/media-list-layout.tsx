@@ -61,6 +61,7 @@ export type MediaListLayoutProps<T extends { id: number }, F extends string, S e
   // delete dialog
   showDeleteDialog: boolean
   deleteFiles: boolean
+  addExclusion: boolean
   isBulkDeleting: boolean
   isRefreshing: boolean
 
@@ -89,6 +90,7 @@ export type MediaListLayoutProps<T extends { id: number }, F extends string, S e
   onExitEdit: () => void
   onShowDeleteDialog: (open: boolean) => void
   onDeleteFilesChange: (checked: boolean) => void
+  onAddExclusionChange: (checked: boolean) => void
   onBulkDelete: () => void
 }
 
@@ -190,6 +192,8 @@ function MediaListDeleteDialog<T extends { id: number }, F extends string, S ext
       selectedCount={props.selectedIds.size}
       deleteFiles={props.deleteFiles}
       onDeleteFilesChange={props.onDeleteFilesChange}
+      addExclusion={props.addExclusion}
+      onAddExclusionChange={props.onAddExclusionChange}
       onConfirm={props.onBulkDelete}
       isPending={props.isBulkDeleting}
       mediaLabel={props.mediaLabel}
@@ -0,0 +1,97 @@
+import { useState } from 'react'
+
+import { toast } from 'sonner'
+
+import { Input } from '@/components/ui/input'
+import { Label } from '@/components/ui/label'
+import { LoadingButton } from '@/components/ui/loading-button'
+import { Select, SelectContent, SelectItem, SelectTrigger } from '@/components/ui/select'
+import { useRootFoldersByType } from '@/hooks'
+import type { MoveFolderInput } from '@/types'
+
+export type MoveMutation = {
+  mutateAsync: (args: { id: number; input: MoveFolderInput }) => Promise<unknown>
+  isPending: boolean
+}
+
+type MovableItem = { id: number; path?: string; rootFolderId: number }
+
+function folderName(path: string) {
+  return path.split(/[/\\]/).findLast(Boolean) ?? ''
+}
+
+function joinPath(root: string, name: string) {
+  const sep = root.includes('\\') && !root.includes('/') ? '\\' : '/'
+  return root.endsWith(sep) ? `${root}${name}` : `${root}${sep}${name}`
+}
+
+export function MediaMoveSection({
+  item,
+  moduleType,
+  moveMutation,
+  onMoved,
+}: {
+  item: MovableItem
+  moduleType: 'movie' | 'tv'
+  moveMutation: MoveMutation
+  onMoved: () => void
+}) {
+  const { data: rootFolders } = useRootFoldersByType(moduleType)
+  const [rootFolderId, setRootFolderId] = useState(item.rootFolderId)
+  const [path, setPath] = useState(item.path ?? '')
+
+  const handleRootFolderChange = (value: string) => {
+    const id = Number.parseInt(value, 10)
+    setRootFolderId(id)
+    const root = rootFolders?.find((rf) => rf.id === id)
+    if (root && item.path) {
+      setPath(joinPath(root.path, folderName(item.path)))
+    }
+  }
+
+  const handleMove = async () => {
+    try {
+      await moveMutation.mutateAsync({ id: item.id, input: { rootFolderId, path } })
+      toast.success('Folder move started')
+      onMoved()
+    } catch (error) {
+      toast.error(error instanceof Error ? error.message : 'Failed to move folder')
+    }
+  }
+
+  if (!item.path) {
+    return null
+  }
+
+  const selected = rootFolders?.find((rf) => rf.id === rootFolderId)
+  const unchanged = path.trim() === '' || path === item.path
+
+  return (
+    <div className="space-y-2 border-t pt-4">
+      <Label htmlFor="move-root-folder">Move Folder</Label>
+      <p className="text-muted-foreground text-sm">
+        Moves the folder and its files on disk and updates the library to match.
+      </p>
+      <Select value={rootFolderId.toString()} onValueChange={(v) => v && handleRootFolderChange(v)}>
+        <SelectTrigger id="move-root-folder">{selected?.name ?? 'Select root folder...'}</SelectTrigger>
+        <SelectContent>
+          {rootFolders?.map((rf) => (
+            <SelectItem key={rf.id} value={rf.id.toString()}>
+              {rf.name}
+            </SelectItem>
+          ))}
+        </SelectContent>
+      </Select>
+      <Input value={path} onChange={(e) => setPath(e.target.value)} aria-label="Destination folder" />
+      <LoadingButton
+        variant="outline"
+        className="w-full"
+        loading={moveMutation.isPending}
+        disabled={unchanged || moveMutation.isPending}
+        onClick={() => void handleMove()}
+      >
+        Move
+      </LoadingButton>
+    </div>
+  )
+}
@@ -0,0 +1,65 @@
+import { FolderOpen } from 'lucide-react'
+
+import {
+  AlertDialog,
+  AlertDialogBody,
+  AlertDialogCancel,
+  AlertDialogContent,
+  AlertDialogDescription,
+  AlertDialogFooter,
+  AlertDialogHeader,
+  AlertDialogTitle,
+} from '@/components/ui/alert-dialog'
+import { Button } from '@/components/ui/button'
+import type { PathConflict } from '@/types'
+
+type Props = {
+  conflict: PathConflict | null
+  onCancel: () => void
+  onSelect: (path: string) => void
+  isPending: boolean
+}
+
+function describeConflict(conflict: PathConflict) {
+  if (conflict.reason === 'in_use') {
+    return `${conflict.path} is already used by "${conflict.conflictingTitle}".`
+  }
+  return `${conflict.path} already contains files for another title.`
+}
+
+export function PathConflictDialog({ conflict, onCancel, onSelect, isPending }: Props) {
+  return (
+    <AlertDialog open={conflict !== null} onOpenChange={(open) => !open && onCancel()}>
+      <AlertDialogContent>
+        <AlertDialogHeader>
+          <AlertDialogTitle>Folder conflict</AlertDialogTitle>
+          <AlertDialogDescription>{conflict ? describeConflict(conflict) : null}</AlertDialogDescription>
+        </AlertDialogHeader>
+        <AlertDialogBody className="space-y-2 py-2">
+          {conflict?.suggestions.length ? (
+            <>
+              <p className="text-muted-foreground text-sm">Choose a different folder:</p>
+              {conflict.suggestions.map((path) => (
+                <Button
+                  key={path}
+                  variant="outline"
+                  className="w-full justify-start font-mono text-xs"
+                  disabled={isPending}
+                  onClick={() => onSelect(path)}
+                >
+                  <FolderOpen className="mr-2 size-4" />
+                  {path}
+                </Button>
+              ))}
+            </>
+          ) : (
+            <p className="text-muted-foreground text-sm">No alternative folder is available.</p>
+          )}
+        </AlertDialogBody>
+        <AlertDialogFooter>
+          <AlertDialogCancel>Cancel</AlertDialogCancel>
+        </AlertDialogFooter>
+      </AlertDialogContent>
+    </AlertDialog>
+  )
+}
@@ -2,7 +2,13 @@ import { useEffect, useRef, useState } from 'react'
 
 import { Film, Tv } from 'lucide-react'
 
-import { getLocalArtworkUrl, POSTER_SIZES } from '@/lib/constants'
+import {
+  getCachedImageUrl,
+  getResizedArtworkUrl,
+  imageSizeWidth,
+  POSTER_SIZES,
+  withVersion,
+} from '@/lib/constants'
 import { cn } from '@/lib/utils'
 import { useArtworkStore } from '@/stores/artwork'
 
@@ -39,18 +45,18 @@ function buildImageUrls(
   const urls: string[] = []
 
   if (params.artworkId) {
-    const baseUrl = getLocalArtworkUrl(params.type, params.artworkId, 'poster')
+    const baseUrl = getResizedArtworkUrl(params.type, params.artworkId, 'poster', imageSizeWidth(params.size))
     if (params.artworkVersion > 0) {
-      urls.push(`${baseUrl}?v=${params.artworkVersion}`)
+      urls.push(withVersion(baseUrl, params.artworkVersion))
     } else if (params.version) {
-      urls.push(`${baseUrl}?v=${params.version}`)
+      urls.push(withVersion(baseUrl, params.version))
     } else {
       urls.push(baseUrl)
     }
   }
 
-  if (params.url) {urls.push(params.url)}
-  if (params.path) {urls.push(`${POSTER_SIZES[params.size]}${params.path}`)}
+  if (params.url) {urls.push(getCachedImageUrl(params.url))}
+  if (params.path) {urls.push(getCachedImageUrl(`${POSTER_SIZES[params.size]}${params.path}`))}
 
   return urls
 }
@@ -5,6 +5,7 @@ export const MONITOR_LABELS: Record<SeriesMonitorOnAdd, string> = {
   future: 'Future Episodes Only',
   first_season: 'First Season Only',
   latest_season: 'Latest Season Only',
+  pilot: 'Pilot Only',
   none: 'None',
 }
 
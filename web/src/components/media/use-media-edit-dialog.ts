@@ -4,10 +4,14 @@ import { toast } from 'sonner'
 
 import { useQualityProfiles } from '@/hooks'
 
-type UseMediaEditDialogParams<T extends { id: number; monitored: boolean; qualityProfileId: number }> = {
+export type MediaEditData = { monitored: boolean; qualityProfileId: number; formatType?: string }
+
+type EditableItem = { id: number; monitored: boolean; qualityProfileId: number; formatType?: string }
+
+type UseMediaEditDialogParams<T extends EditableItem> = {
   item: T
   updateMutation: {
-    mutateAsync: (args: { id: number; data: { monitored: boolean; qualityProfileId: number } }) => Promise<unknown>
+    mutateAsync: (args: { id: number; data: MediaEditData }) => Promise<unknown>
     isPending: boolean
   }
   mediaLabel: string
@@ -15,7 +19,7 @@ type UseMediaEditDialogParams<T extends { id: number; monitored: boolean; qualit
   onOpenChange: (open: boolean) => void
 }
 
-export function useMediaEditDialog<T extends { id: number; monitored: boolean; qualityProfileId: number }>({
+export function useMediaEditDialog<T extends EditableItem>({
   item,
   updateMutation,
   mediaLabel,
@@ -24,16 +28,19 @@ export function useMediaEditDialog<T extends { id: number; monitored: boolean; q
 }: UseMediaEditDialogParams<T>) {
   const [monitored, setMonitored] = useState(item.monitored)
   const [qualityProfileId, setQualityProfileId] = useState(item.qualityProfileId)
+  const [formatType, setFormatType] = useState(item.formatType ?? 'standard')
   const [prevItem, setPrevItem] = useState(item)
 
   if (item.id !== prevItem.id) {
     setPrevItem(item)
     setMonitored(item.monitored)
     setQualityProfileId(item.qualityProfileId)
+    setFormatType(item.formatType ?? 'standard')
   }
 
   const { data: profiles } = useQualityProfiles(moduleType)
-  const hasChanges = monitored !== item.monitored || qualityProfileId !== item.qualityProfileId
+  const formatTypeChanged = formatType !== (item.formatType ?? 'standard')
+  const hasChanges = monitored !== item.monitored || qualityProfileId !== item.qualityProfileId || formatTypeChanged
 
   const handleSubmit = async () => {
     if (!hasChanges) {
@@ -41,7 +48,11 @@ export function useMediaEditDialog<T extends { id: number; monitored: boolean; q
       return
     }
     try {
-      await updateMutation.mutateAsync({ id: item.id, data: { monitored, qualityProfileId } })
+      const data: MediaEditData = { monitored, qualityProfileId }
+      if (formatTypeChanged) {
+        data.formatType = formatType
+      }
+      await updateMutation.mutateAsync({ id: item.id, data })
       toast.success(`${mediaLabel} updated`)
       onOpenChange(false)
     } catch {
@@ -63,6 +74,8 @@ export function useMediaEditDialog<T extends { id: number; monitored: boolean; q
     monitored,
     setMonitored,
     qualityProfileId,
+    formatType,
+    setFormatType,
     profiles,
     handleProfileChange,
     handleSubmit,
@@ -6,12 +6,10 @@ import { formatDate } from '@/lib/formatters'
 import { cn } from '@/lib/utils'
 import type { UpgradableEpisode, UpgradableSeries } from '@/types/missing'
 import type { QualityProfile } from '@/types/quality-profile'
-import { PREDEFINED_QUALITIES } from '@/types/quality-profile'
+import { qualityNameFromItems } from '@/types/quality-profile'
 
 import type { EpisodeMonitoredParams } from './use-upgradable-series-list'
 
-const qualityById = new Map(PREDEFINED_QUALITIES.map((q) => [q.id, q.name]))
-
 type UpgradableEpisodeRowProps = {
   series: UpgradableSeries
   episode: UpgradableEpisode
@@ -22,8 +20,8 @@ type UpgradableEpisodeRowProps = {
 
 export function UpgradableEpisodeRow({ series, episode, profile, onMonitored, isDisabled }: UpgradableEpisodeRowProps) {
   const epLabel = `S${episode.seasonNumber.toString().padStart(2, '0')}E${episode.episodeNumber.toString().padStart(2, '0')}`
-  const currentName = qualityById.get(episode.currentQualityId) ?? 'Unknown'
-  const cutoffName = profile ? (qualityById.get(profile.cutoff) ?? 'Unknown') : 'Unknown'
+  const currentName = profile ? qualityNameFromItems(profile.items, episode.currentQualityId) : 'Unknown'
+  const cutoffName = profile ? qualityNameFromItems(profile.items, profile.cutoff) : 'Unknown'
 
   return (
     <div className="hover:bg-muted/50 flex items-center justify-between rounded px-1 py-1.5 text-sm">
@@ -9,9 +9,7 @@ import { Badge } from '@/components/ui/badge'
 import { useUpdateMovie } from '@/hooks'
 import type { UpgradableMovie } from '@/types/missing'
 import type { QualityProfile } from '@/types/quality-profile'
-import { PREDEFINED_QUALITIES } from '@/types/quality-profile'
-
-const qualityById = new Map(PREDEFINED_QUALITIES.map((q) => [q.id, q.name]))
+import { qualityNameFromItems } from '@/types/quality-profile'
 
 type UpgradableMoviesListProps = {
   movies: UpgradableMovie[]
@@ -82,8 +80,8 @@ function UpgradableMovieRow({
   isUpdating: boolean
 }) {
   const profile = qualityProfiles.get(movie.qualityProfileId)
-  const currentName = qualityById.get(movie.currentQualityId) ?? 'Unknown'
-  const cutoffName = profile ? (qualityById.get(profile.cutoff) ?? 'Unknown') : 'Unknown'
+  const currentName = profile ? qualityNameFromItems(profile.items, movie.currentQualityId) : 'Unknown'
+  const cutoffName = profile ? qualityNameFromItems(profile.items, profile.cutoff) : 'Unknown'
 
   return (
     <div className="group border-border bg-card hover:border-movie-500/50 flex items-center gap-4 rounded-lg border px-4 py-3 transition-colors">
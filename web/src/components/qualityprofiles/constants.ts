@@ -1,5 +1,5 @@
-import type { AttributeMode, CreateQualityProfileInput, QualityItem, UpgradeStrategy } from '@/types'
-import { DEFAULT_ATTRIBUTE_SETTINGS, PREDEFINED_QUALITIES } from '@/types'
+import type { AttributeMode, CreateQualityProfileInput, Quality, QualityItem, UpgradeStrategy } from '@/types'
+import { DEFAULT_ATTRIBUTE_SETTINGS, DEFAULT_INDEXER_FLAGS, DEFAULT_RELEASE_GROUPS } from '@/types'
 
 export const MODE_OPTIONS: { value: AttributeMode; label: string }[] = [
   { value: 'required', label: 'Required' },
@@ -28,7 +28,7 @@ export const UPGRADE_STRATEGY_OPTIONS: {
   {
     value: 'aggressive',
     label: 'Aggressive',
-    description: 'Upgrade for any higher quality weight',
+    description: 'Upgrade to any higher-ranked quality',
   },
   {
     value: 'resolution_only',
@@ -41,10 +41,12 @@ export const HDR_FORMATS = ['DV', 'HDR10+', 'HDR10', 'HDR', 'HLG']
 
 export const RESOLUTIONS = [480, 720, 1080, 2160] as const
 
-export const defaultItems: QualityItem[] = PREDEFINED_QUALITIES.map((q) => ({
-  quality: q,
-  allowed: q.weight >= 10,
-}))
+export function buildDefaultItems(qualities: Quality[]): QualityItem[] {
+  return qualities.map((q) => ({
+    quality: q,
+    allowed: q.weight >= 10,
+  }))
+}
 
 export const defaultFormData: CreateQualityProfileInput = {
   name: '',
@@ -54,9 +56,11 @@ export const defaultFormData: CreateQualityProfileInput = {
   upgradeStrategy: 'balanced',
   cutoffOverridesStrategy: false,
   allowAutoApprove: false,
-  items: defaultItems,
+  items: [],
   hdrSettings: { ...DEFAULT_ATTRIBUTE_SETTINGS },
   videoCodecSettings: { ...DEFAULT_ATTRIBUTE_SETTINGS },
   audioCodecSettings: { ...DEFAULT_ATTRIBUTE_SETTINGS },
   audioChannelSettings: { ...DEFAULT_ATTRIBUTE_SETTINGS },
+  releaseGroups: { ...DEFAULT_RELEASE_GROUPS },
+  indexerFlags: { ...DEFAULT_INDEXER_FLAGS },
 }
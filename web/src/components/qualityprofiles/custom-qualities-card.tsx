@@ -0,0 +1,223 @@
+import { useState } from 'react'
+
+import { Edit, Loader2, Plus, Trash2 } from 'lucide-react'
+import { toast } from 'sonner'
+
+import { ConfirmDialog } from '@/components/forms/confirm-dialog'
+import { Button } from '@/components/ui/button'
+import { Card, CardContent, CardDescription, CardHeader, CardTitle } from '@/components/ui/card'
+import {
+  Dialog,
+  DialogBody,
+  DialogContent,
+  DialogDescription,
+  DialogFooter,
+  DialogHeader,
+  DialogTitle,
+} from '@/components/ui/dialog'
+import { Input } from '@/components/ui/input'
+import { Label } from '@/components/ui/label'
+import { useCreateQuality, useDeleteQuality, useQualities, useUpdateQuality } from '@/hooks'
+import { withToast } from '@/lib/with-toast'
+import type { Quality, QualityInput } from '@/types'
+import { isCustomQuality } from '@/types'
+
+const emptyInput: QualityInput = {
+  name: '',
+  source: '',
+  resolution: 1080,
+  weight: 12,
+  matchPattern: '',
+}
+
+function toInput(quality: Quality): QualityInput {
+  return {
+    name: quality.name,
+    source: quality.source,
+    resolution: quality.resolution,
+    weight: quality.weight,
+    matchPattern: quality.matchPattern ?? '',
+  }
+}
+
+export function CustomQualitiesCard() {
+  const { data: qualities = [] } = useQualities()
+  const deleteMutation = useDeleteQuality()
+  const [dialogOpen, setDialogOpen] = useState(false)
+  const [editing, setEditing] = useState<Quality | null>(null)
+
+  const custom = qualities.filter((q) => isCustomQuality(q))
+
+  const handleAdd = () => { setEditing(null); setDialogOpen(true) }
+  const handleEdit = (quality: Quality) => { setEditing(quality); setDialogOpen(true) }
+
+  const handleDelete = withToast(async (id: number) => {
+    await deleteMutation.mutateAsync(id)
+    toast.success('Quality deleted')
+  })
+
+  return (
+    <Card>
+      <CardHeader className="flex flex-row items-start justify-between">
+        <div className="space-y-1.5">
+          <CardTitle>Custom Qualities</CardTitle>
+          <CardDescription>
+            Qualities matched by a pattern in the release title, such as 3D remuxes. New qualities are added to
+            every profile as not allowed.
+          </CardDescription>
+        </div>
+        <Button variant="outline" size="sm" onClick={handleAdd}>
+          <Plus className="mr-2 size-4" />
+          Add Quality
+        </Button>
+      </CardHeader>
+      <CardContent>
+        {custom.length === 0 ? (
+          <p className="text-muted-foreground text-sm">No custom qualities</p>
+        ) : (
+          <div className="divide-y rounded-lg border">
+            {custom.map((quality) => (
+              <div key={quality.id} className="flex items-center justify-between px-3 py-2">
+                <div>
+                  <div className="text-sm font-medium">{quality.name}</div>
+                  <div className="text-muted-foreground font-mono text-xs">{quality.matchPattern}</div>
+                </div>
+                <div className="flex gap-1">
+                  <Button variant="ghost" size="icon" aria-label="Edit" onClick={() => handleEdit(quality)}>
+                    <Edit className="size-4" />
+                  </Button>
+                  <ConfirmDialog
+                    trigger={<Button variant="ghost" size="icon" aria-label="Delete"><Trash2 className="size-4" /></Button>}
+                    title="Delete quality"
+                    description={`Are you sure you want to delete "${quality.name}"?`}
+                    confirmLabel="Delete"
+                    variant="destructive"
+                    onConfirm={() => handleDelete(quality.id)}
+                  />
+                </div>
+              </div>
+            ))}
+          </div>
+        )}
+      </CardContent>
+      <CustomQualityDialog open={dialogOpen} onOpenChange={setDialogOpen} quality={editing} />
+    </Card>
+  )
+}
+
+type CustomQualityDialogProps = {
+  open: boolean
+  onOpenChange: (open: boolean) => void
+  quality: Quality | null
+}
+
+function CustomQualityDialog({ open, onOpenChange, quality }: CustomQualityDialogProps) {
+  const [input, setInput] = useState<QualityInput>(emptyInput)
+  const [prevOpen, setPrevOpen] = useState(open)
+  const createMutation = useCreateQuality()
+  const updateMutation = useUpdateQuality()
+  const isPending = createMutation.isPending || updateMutation.isPending
+
+  if (open !== prevOpen) {
+    setPrevOpen(open)
+    if (open) {
+      setInput(quality ? toInput(quality) : emptyInput)
+    }
+  }
+
+  const update = <K extends keyof QualityInput>(key: K, value: QualityInput[K]) => {
+    setInput((prev) => ({ ...prev, [key]: value }))
+  }
+
+  const handleSubmit = withToast(async () => {
+    if (quality) {
+      await updateMutation.mutateAsync({ id: quality.id, data: input })
+      toast.success('Quality updated')
+    } else {
+      await createMutation.mutateAsync(input)
+      toast.success('Quality created')
+    }
+    onOpenChange(false)
+  })
+
+  return (
+    <Dialog open={open} onOpenChange={onOpenChange}>
+      <DialogContent>
+        <DialogHeader>
+          <DialogTitle>{quality ? 'Edit Custom Quality' : 'Add Custom Quality'}</DialogTitle>
+          <DialogDescription>
+            Releases whose title matches the pattern are assigned this quality.
+          </DialogDescription>
+        </DialogHeader>
+        <DialogBody>
+          <div className="space-y-4 py-4">
+            <div className="space-y-2">
+              <Label htmlFor="quality-name">Name</Label>
+              <Input
+                id="quality-name"
+                placeholder="1080p Remux 3D"
+                value={input.name}
+                onChange={(e) => update('name', e.target.value)}
+              />
+            </div>
+            <div className="space-y-2">
+              <Label htmlFor="quality-pattern">Title Pattern</Label>
+              <Input
+                id="quality-pattern"
+                className="font-mono"
+                placeholder="\b3D\b"
+                value={input.matchPattern}
+                onChange={(e) => update('matchPattern', e.target.value)}
+              />
+              <p className="text-muted-foreground text-xs">Case-insensitive regular expression</p>
+            </div>
+            <div className="grid grid-cols-3 gap-4">
+              <div className="space-y-2">
+                <Label htmlFor="quality-source">Source</Label>
+                <Input
+                  id="quality-source"
+                  placeholder="remux"
+                  value={input.source}
+                  onChange={(e) => update('source', e.target.value)}
+                />
+              </div>
+              <div className="space-y-2">
+                <Label htmlFor="quality-resolution">Resolution</Label>
+                <Input
+                  id="quality-resolution"
+                  type="number"
+                  min={0}
+                  value={input.resolution}
+                  onChange={(e) => update('resolution', Number(e.target.value))}
+                />
+              </div>
+              <div className="space-y-2">
+                <Label htmlFor="quality-weight">Weight</Label>
+                <Input
+                  id="quality-weight"
+                  type="number"
+                  min={0}
+                  value={input.weight}
+                  onChange={(e) => update('weight', Number(e.target.value))}
+                />
+              </div>
+            </div>
+            <p className="text-muted-foreground text-xs">
+              Weight places the quality among the built-in tiers (Remux-1080p is 12, Remux-2160p is 17). Each
+              profile can rank it differently.
+            </p>
+          </div>
+        </DialogBody>
+        <DialogFooter>
+          <Button variant="outline" onClick={() => onOpenChange(false)}>
+            Cancel
+          </Button>
+          <Button onClick={handleSubmit} disabled={isPending}>
+            {isPending ? <Loader2 className="mr-2 size-4 animate-spin" /> : null}
+            {quality ? 'Save' : 'Create'}
+          </Button>
+        </DialogFooter>
+      </DialogContent>
+    </Dialog>
+  )
+}
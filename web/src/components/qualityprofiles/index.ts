@@ -1 +1,2 @@
+export { CustomQualitiesCard } from './custom-qualities-card'
 export { QualityProfileDialog } from './quality-profile-dialog'
@@ -0,0 +1,61 @@
+import { Label } from '@/components/ui/label'
+import { Select, SelectContent, SelectItem, SelectTrigger } from '@/components/ui/select'
+import type { FlagPreference, IndexerFlagSettings } from '@/types'
+
+type IndexerFlagsProps = {
+  indexerFlags: IndexerFlagSettings
+  onChange: (indexerFlags: IndexerFlagSettings) => void
+}
+
+// Select values cannot be empty, so "ignore" stands in for the '' preference.
+const PREFERENCES: { value: string, label: string }[] = [
+  { value: 'ignore', label: 'Ignore' },
+  { value: 'prefer', label: 'Prefer' },
+  { value: 'require', label: 'Require' },
+]
+
+const FLAGS: { key: keyof IndexerFlagSettings, label: string }[] = [
+  { key: 'freeleech', label: 'Freeleech' },
+  { key: 'internal', label: 'Internal' },
+]
+
+function toPreference(value: string): FlagPreference {
+  return value === 'ignore' ? '' : (value as FlagPreference)
+}
+
+export function IndexerFlags({ indexerFlags, onChange }: IndexerFlagsProps) {
+  return (
+    <div className="space-y-3">
+      <h3 className="text-sm font-medium">Indexer Flags</h3>
+
+      <div className="grid grid-cols-2 gap-3">
+        {FLAGS.map((flag) => {
+          const value = indexerFlags[flag.key] || 'ignore'
+          return (
+            <div key={flag.key} className="space-y-2">
+              <Label htmlFor={`flag-${flag.key}`}>{flag.label}</Label>
+              <Select
+                value={value}
+                onValueChange={(v) => v && onChange({ ...indexerFlags, [flag.key]: toPreference(v) })}
+              >
+                <SelectTrigger id={`flag-${flag.key}`}>
+                  {PREFERENCES.find((p) => p.value === value)?.label}
+                </SelectTrigger>
+                <SelectContent>
+                  {PREFERENCES.map((p) => (
+                    <SelectItem key={p.value} value={p.value}>
+                      {p.label}
+                    </SelectItem>
+                  ))}
+                </SelectContent>
+              </Select>
+            </div>
+          )
+        })}
+      </div>
+      <p className="text-muted-foreground text-xs">
+        Preferred flags score higher; torrents missing a required flag are rejected
+      </p>
+    </div>
+  )
+}
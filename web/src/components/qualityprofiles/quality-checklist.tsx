@@ -1,6 +1,7 @@
 import { Checkbox } from '@/components/ui/checkbox'
 import { Label } from '@/components/ui/label'
 import type { QualityItem } from '@/types'
+import { isCustomQuality } from '@/types'
 
 import { RESOLUTIONS } from './constants'
 
@@ -41,14 +42,16 @@ function QualityGroup({ title, description, items, onToggle }: QualityGroupProps
 }
 
 export function QualityChecklist({ items, onToggle }: QualityChecklistProps) {
-  const cinemaSourceItems = items.filter((item) => item.quality.resolution === 0)
+  const builtinItems = items.filter((item) => !isCustomQuality(item.quality))
+  const cinemaSourceItems = builtinItems.filter((item) => item.quality.resolution === 0)
+  const customItems = items.filter((item) => isCustomQuality(item.quality))
 
   return (
     <div className="space-y-2">
       <Label>Allowed Qualities</Label>
       <div className="bg-muted/30 divide-y rounded-lg border">
         {RESOLUTIONS.map((resolution) => {
-          const resolutionItems = items.filter((item) => item.quality.resolution === resolution)
+          const resolutionItems = builtinItems.filter((item) => item.quality.resolution === resolution)
           if (resolutionItems.length === 0) {
             return null
           }
@@ -69,6 +72,9 @@ export function QualityChecklist({ items, onToggle }: QualityChecklistProps) {
             onToggle={onToggle}
           />
         )}
+        {customItems.length > 0 && (
+          <QualityGroup title="Custom" items={customItems} onToggle={onToggle} />
+        )}
       </div>
     </div>
   )
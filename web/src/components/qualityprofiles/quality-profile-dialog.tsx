@@ -18,6 +18,9 @@ import type { QualityProfile } from '@/types'
 
 import { AttributeFilters } from './attribute-filters'
 import { QualityChecklist } from './quality-checklist'
+import { QualityRanking } from './quality-ranking'
+import { IndexerFlags } from './indexer-flags'
+import { ReleaseGroups } from './release-groups'
 import { UpgradeSettings } from './upgrade-settings'
 import { UpgradeStrategyPreview } from './upgrade-strategy-preview'
 import { useQualityProfileDialog } from './use-quality-profile-dialog'
@@ -93,7 +96,7 @@ function ModuleTypeSelector({ value, onChange }: { value: string; onChange: (v:
 }
 
 function ProfileFormBody({ state, showPreview }: ProfileFormBodyProps) {
-  const { formData, cutoffOptions, updateField, toggleQuality, updateItemMode, isEditing } = state
+  const { formData, cutoffOptions, updateField, toggleQuality, moveQuality, updateItemMode, isEditing } = state
 
   return (
     <div className="space-y-6 py-4">
@@ -113,6 +116,8 @@ function ProfileFormBody({ state, showPreview }: ProfileFormBodyProps) {
 
       <QualityChecklist items={formData.items} onToggle={toggleQuality} />
 
+      <QualityRanking items={formData.items} onMove={moveQuality} />
+
       <UpgradeSettings
         upgradesEnabled={formData.upgradesEnabled}
         upgradeStrategy={formData.upgradeStrategy}
@@ -141,6 +146,16 @@ function ProfileFormBody({ state, showPreview }: ProfileFormBodyProps) {
         attributeValidation={state.attributeValidation}
         onItemModeChange={updateItemMode}
       />
+
+      <ReleaseGroups
+        releaseGroups={formData.releaseGroups}
+        onChange={(v) => updateField('releaseGroups', v)}
+      />
+
+      <IndexerFlags
+        indexerFlags={formData.indexerFlags}
+        onChange={(v) => updateField('indexerFlags', v)}
+      />
     </div>
   )
 }
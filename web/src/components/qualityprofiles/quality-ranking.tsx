@@ -0,0 +1,62 @@
+import { ChevronDown, ChevronUp } from 'lucide-react'
+
+import { Badge } from '@/components/ui/badge'
+import { Button } from '@/components/ui/button'
+import { Label } from '@/components/ui/label'
+import type { QualityItem } from '@/types'
+import { isCustomQuality } from '@/types'
+
+type QualityRankingProps = {
+  items: QualityItem[]
+  onMove: (qualityId: number, direction: 'up' | 'down') => void
+}
+
+export function QualityRanking({ items, onMove }: QualityRankingProps) {
+  const ranked = items.filter((item) => item.allowed).toReversed()
+
+  if (ranked.length < 2) {
+    return null
+  }
+
+  return (
+    <div className="space-y-2">
+      <Label>Quality Ranking</Label>
+      <p className="text-muted-foreground text-xs">
+        Best first. Ranking decides which release is preferred, the cutoff, and aggressive upgrades.
+      </p>
+      <div className="bg-muted/30 divide-y rounded-lg border">
+        {ranked.map((item, index) => (
+          <div key={item.quality.id} className="flex items-center justify-between px-3 py-1.5">
+            <div className="flex items-center gap-2">
+              <span className="text-muted-foreground w-5 text-xs tabular-nums">{index + 1}</span>
+              <span className="text-sm">{item.quality.name}</span>
+              {isCustomQuality(item.quality) ? (
+                <Badge variant="outline" className="text-xs">Custom</Badge>
+              ) : null}
+            </div>
+            <div className="flex gap-1">
+              <Button
+                variant="ghost"
+                size="icon-sm"
+                aria-label="Move up"
+                disabled={index === 0}
+                onClick={() => onMove(item.quality.id, 'up')}
+              >
+                <ChevronUp className="size-4" />
+              </Button>
+              <Button
+                variant="ghost"
+                size="icon-sm"
+                aria-label="Move down"
+                disabled={index === ranked.length - 1}
+                onClick={() => onMove(item.quality.id, 'down')}
+              >
+                <ChevronDown className="size-4" />
+              </Button>
+            </div>
+          </div>
+        ))}
+      </div>
+    </div>
+  )
+}
@@ -0,0 +1,45 @@
+import { Input } from '@/components/ui/input'
+import { Label } from '@/components/ui/label'
+import type { ReleaseGroupSettings } from '@/types'
+
+type ReleaseGroupsProps = {
+  releaseGroups: ReleaseGroupSettings
+  onChange: (releaseGroups: ReleaseGroupSettings) => void
+}
+
+// Groups are edited as comma-separated names; the server trims and drops blanks.
+function parseGroups(value: string): string[] {
+  return value.split(',').map((g) => g.trimStart())
+}
+
+export function ReleaseGroups({ releaseGroups, onChange }: ReleaseGroupsProps) {
+  return (
+    <div className="space-y-3">
+      <h3 className="text-sm font-medium">Release Groups</h3>
+
+      <div className="space-y-2">
+        <Label htmlFor="preferred-groups">Preferred</Label>
+        <Input
+          id="preferred-groups"
+          placeholder="FraMeSToR, NTb"
+          value={releaseGroups.preferred.join(', ')}
+          onChange={(e) => onChange({ ...releaseGroups, preferred: parseGroups(e.target.value) })}
+        />
+        <p className="text-muted-foreground text-xs">Releases from these groups score higher</p>
+      </div>
+
+      <div className="space-y-2">
+        <Label htmlFor="forbidden-groups">Forbidden</Label>
+        <Input
+          id="forbidden-groups"
+          placeholder="YIFY, YTS"
+          value={releaseGroups.forbidden.join(', ')}
+          onChange={(e) => onChange({ ...releaseGroups, forbidden: parseGroups(e.target.value) })}
+        />
+        <p className="text-muted-foreground text-xs">
+          Releases and imports from these groups are rejected
+        </p>
+      </div>
+    </div>
+  )
+}
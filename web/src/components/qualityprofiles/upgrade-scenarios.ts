@@ -150,9 +150,17 @@ type GenerateParams = {
   cutoffOverridesStrategy: boolean
 }
 
+// Mirrors Profile.RankWeight: weights are redistributed in item order so the
+// preview follows the profile's ranking.
+function rankedQualities(items: QualityItem[]): Quality[] {
+  const weights = items.map((i) => i.quality.weight).toSorted((a, b) => a - b)
+  return items.map((item, index) => ({ ...item.quality, weight: weights[index] }))
+}
+
 export function generateScenarios(params: GenerateParams): UpgradeScenario[] {
   const { allowedItems, strategy, cutoffId, cutoffOverridesStrategy } = params
-  const allowed = allowedItems.filter((i) => i.allowed).map((i) => i.quality)
+  const ranked = rankedQualities(allowedItems)
+  const allowed = ranked.filter((_, index) => allowedItems[index].allowed)
   if (allowed.length < 2) {
     return []
   }
@@ -4,13 +4,14 @@ import { toast } from 'sonner'
 
 import {
   useCreateQualityProfile,
+  useQualities,
   useQualityProfileAttributes,
   useUpdateQualityProfile,
 } from '@/hooks'
-import type { AttributeMode, CreateQualityProfileInput, QualityProfile } from '@/types'
-import { DEFAULT_ATTRIBUTE_SETTINGS } from '@/types'
+import type { AttributeMode, CreateQualityProfileInput, Quality, QualityItem, QualityProfile } from '@/types'
+import { DEFAULT_ATTRIBUTE_SETTINGS, DEFAULT_INDEXER_FLAGS, DEFAULT_RELEASE_GROUPS } from '@/types'
 
-import { defaultFormData, defaultItems, HDR_FORMATS } from './constants'
+import { buildDefaultItems, defaultFormData, HDR_FORMATS } from './constants'
 import { validateAttributeGroup } from './upgrade-scenarios'
 
 type SettingsField =
@@ -33,18 +34,22 @@ function buildFormFromProfile(profile: QualityProfile): CreateQualityProfileInpu
     videoCodecSettings: profile.videoCodecSettings,
     audioCodecSettings: profile.audioCodecSettings,
     audioChannelSettings: profile.audioChannelSettings,
+    releaseGroups: profile.releaseGroups ?? { ...DEFAULT_RELEASE_GROUPS },
+    indexerFlags: profile.indexerFlags ?? { ...DEFAULT_INDEXER_FLAGS },
   }
 }
 
-function buildFreshForm(defaultModuleType?: string): CreateQualityProfileInput {
+function buildFreshForm(qualities: Quality[], defaultModuleType?: string): CreateQualityProfileInput {
   return {
     ...defaultFormData,
     moduleType: defaultModuleType ?? '',
-    items: defaultItems.map((i) => ({ ...i })),
+    items: buildDefaultItems(qualities),
     hdrSettings: { ...DEFAULT_ATTRIBUTE_SETTINGS },
     videoCodecSettings: { ...DEFAULT_ATTRIBUTE_SETTINGS },
     audioCodecSettings: { ...DEFAULT_ATTRIBUTE_SETTINGS },
     audioChannelSettings: { ...DEFAULT_ATTRIBUTE_SETTINGS },
+    releaseGroups: { ...DEFAULT_RELEASE_GROUPS },
+    indexerFlags: { ...DEFAULT_INDEXER_FLAGS },
   }
 }
 
@@ -98,6 +103,22 @@ function computeValidation(
   }
 }
 
+// Items are ranked worst to best, so moving up swaps with the next allowed item.
+function moveAllowedItem(items: QualityItem[], qualityId: number, direction: 'up' | 'down'): QualityItem[] {
+  const index = items.findIndex((item) => item.quality.id === qualityId)
+  const step = direction === 'up' ? 1 : -1
+  let target = index + step
+  while (target >= 0 && target < items.length && !items[target].allowed) {
+    target += step
+  }
+  if (index < 0 || target < 0 || target >= items.length) {
+    return items
+  }
+  const next = [...items]
+  ;[next[index], next[target]] = [next[target], next[index]]
+  return next
+}
+
 function removeKey(
   obj: Record<string, AttributeMode>,
   key: string,
@@ -114,6 +135,7 @@ function removeKey(
 function useFormSync(
   open: boolean,
   profile: QualityProfile | null | undefined,
+  qualities: Quality[],
   defaultModuleType?: string,
 ) {
   const [formData, setFormData] = useState<CreateQualityProfileInput>(defaultFormData)
@@ -124,7 +146,7 @@ function useFormSync(
     setPrevOpen(open)
     setPrevProfile(profile)
     if (open) {
-      setFormData(profile ? buildFormFromProfile(profile) : buildFreshForm(defaultModuleType))
+      setFormData(profile ? buildFormFromProfile(profile) : buildFreshForm(qualities, defaultModuleType))
     }
   }
 
@@ -139,7 +161,8 @@ type UseQualityProfileDialogOptions = {
 }
 
 export function useQualityProfileDialog({ open, onOpenChange, profile, defaultModuleType }: UseQualityProfileDialogOptions) {
-  const { formData, setFormData } = useFormSync(open, profile, defaultModuleType)
+  const { data: qualities = [] } = useQualities()
+  const { formData, setFormData } = useFormSync(open, profile, qualities, defaultModuleType)
   const createMutation = useCreateQualityProfile()
   const updateMutation = useUpdateQualityProfile()
   const { data: attributeOptions } = useQualityProfileAttributes()
@@ -158,6 +181,10 @@ export function useQualityProfileDialog({ open, onOpenChange, profile, defaultMo
     }))
   }
 
+  const moveQuality = (qualityId: number, direction: 'up' | 'down') => {
+    setFormData((prev) => ({ ...prev, items: moveAllowedItem(prev.items, qualityId, direction) }))
+  }
+
   const updateItemMode = (field: SettingsField, value: string, mode: AttributeMode) => {
     setFormData((prev) => buildUpdatedSettings(prev, { field, value, mode }))
   }
@@ -183,6 +210,7 @@ export function useQualityProfileDialog({ open, onOpenChange, profile, defaultMo
     hasAttributeValidationError: Object.values(attributeValidation).some((v) => v !== null),
     handleSubmit,
     toggleQuality,
+    moveQuality,
     updateItemMode,
     updateField,
   }
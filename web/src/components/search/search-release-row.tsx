@@ -18,11 +18,16 @@ function TitleCell({ release }: { release: TorrentInfo }) {
           <Badge variant="outline" className="text-xs">
             {release.protocol}
           </Badge>
-          {release.downloadVolumeFactor === 0 && (
+          {release.freeleech ? (
             <Badge variant="secondary" className="text-xs">
               Freeleech
             </Badge>
-          )}
+          ) : null}
+          {release.internal ? (
+            <Badge variant="secondary" className="text-xs">
+              Internal
+            </Badge>
+          ) : null}
         </div>
       </div>
     </TableCell>
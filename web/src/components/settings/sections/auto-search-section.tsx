@@ -12,6 +12,10 @@ import { Label } from '@/components/ui/label'
 import { Slider } from '@/components/ui/slider'
 import { Switch } from '@/components/ui/switch'
 import { useAutoSearchSettings, useUpdateAutoSearchSettings } from '@/hooks'
+import type { GrabApprovalMode } from '@/types'
+
+import { GrabApprovalCard, PendingGrabsCard } from './grab-approval-card'
+import { UpgradeSweepCard } from './upgrade-sweep-card'
 
 type SearchSettingsCardProps = {
   enabled: boolean
@@ -95,6 +99,195 @@ function BackoffSettingsCard({
   )
 }
 
+function BatchPacingCard({
+  enabled,
+  batchSize,
+  onBatchSizeChange,
+  batchPauseSeconds,
+  onBatchPauseChange,
+}: {
+  enabled: boolean
+  batchSize: number
+  onBatchSizeChange: (v: number) => void
+  batchPauseSeconds: number
+  onBatchPauseChange: (v: number) => void
+}) {
+  return (
+    <Card>
+      <CardHeader>
+        <CardTitle>Library Search Pacing</CardTitle>
+        <CardDescription>Controls how the Search All Missing and Search Cutoff Unmet tasks walk the library</CardDescription>
+      </CardHeader>
+      <CardContent className="space-y-4">
+        <div className="space-y-2">
+          <Label htmlFor="batchSize">Batch Size</Label>
+          <Input
+            id="batchSize"
+            type="number"
+            value={batchSize}
+            onChange={(e) => onBatchSizeChange(Math.min(500, Math.max(1, Number.parseInt(e.target.value) || 1)))}
+            min={1}
+            max={500}
+            disabled={!enabled}
+          />
+          <p className="text-muted-foreground text-xs">
+            Number of items searched before pausing. Default: 25.
+          </p>
+        </div>
+        <div className="space-y-2">
+          <Label htmlFor="batchPauseSeconds">Pause Between Batches (seconds)</Label>
+          <Input
+            id="batchPauseSeconds"
+            type="number"
+            value={batchPauseSeconds}
+            onChange={(e) => onBatchPauseChange(Math.min(3600, Math.max(0, Number.parseInt(e.target.value) || 0)))}
+            min={0}
+            max={3600}
+            disabled={!enabled}
+          />
+          <p className="text-muted-foreground text-xs">
+            Gives indexers time to recover between batches. Default: 300 seconds.
+          </p>
+        </div>
+      </CardContent>
+    </Card>
+  )
+}
+
+function SeasonPackCard({
+  enabled,
+  seasonPackMissingPercent,
+  onChange,
+}: {
+  enabled: boolean
+  seasonPackMissingPercent: number
+  onChange: (v: number) => void
+}) {
+  return (
+    <Card>
+      <CardHeader>
+        <CardTitle>Season Packs</CardTitle>
+        <CardDescription>Controls when a season is searched as a pack instead of episode by episode</CardDescription>
+      </CardHeader>
+      <CardContent className="space-y-4">
+        <div className="space-y-2">
+          <Label htmlFor="seasonPackMissingPercent">Minimum Missing Episodes (%)</Label>
+          <Input
+            id="seasonPackMissingPercent"
+            type="number"
+            value={seasonPackMissingPercent}
+            onChange={(e) => onChange(Math.min(100, Math.max(50, Number.parseInt(e.target.value) || 100)))}
+            min={50}
+            max={100}
+            disabled={!enabled}
+          />
+          <p className="text-muted-foreground text-xs">
+            Search for a season pack once this share of a season's monitored episodes is missing. Episodes already on
+            disk are replaced when the pack's copy is an upgrade. Default: 100 (only fully missing seasons).
+          </p>
+        </div>
+      </CardContent>
+    </Card>
+  )
+}
+
+function GrabCapsCard({
+  maxGrabsPerHour,
+  onPerHourChange,
+  maxGrabsPerDay,
+  onPerDayChange,
+}: {
+  maxGrabsPerHour: number
+  onPerHourChange: (v: number) => void
+  maxGrabsPerDay: number
+  onPerDayChange: (v: number) => void
+}) {
+  return (
+    <Card>
+      <CardHeader>
+        <CardTitle>Grab Caps</CardTitle>
+        <CardDescription>Limits automatic grabs from autosearch and RSS sync across all indexers</CardDescription>
+      </CardHeader>
+      <CardContent className="space-y-4">
+        <div className="space-y-2">
+          <Label htmlFor="maxGrabsPerHour">Max Grabs Per Hour</Label>
+          <Input
+            id="maxGrabsPerHour"
+            type="number"
+            value={maxGrabsPerHour}
+            onChange={(e) => onPerHourChange(Math.max(0, Number.parseInt(e.target.value) || 0))}
+            min={0}
+          />
+        </div>
+        <div className="space-y-2">
+          <Label htmlFor="maxGrabsPerDay">Max Grabs Per Day</Label>
+          <Input
+            id="maxGrabsPerDay"
+            type="number"
+            value={maxGrabsPerDay}
+            onChange={(e) => onPerDayChange(Math.max(0, Number.parseInt(e.target.value) || 0))}
+            min={0}
+          />
+          <p className="text-muted-foreground text-xs">
+            Grabs over a cap are deferred and retried once the window allows. 0 disables the cap.
+          </p>
+        </div>
+      </CardContent>
+    </Card>
+  )
+}
+
+function FreeSpaceGuardCard({
+  pauseGrabsBelowFreeSpaceGb,
+  onChange,
+  grabFreeSpaceBufferGb,
+  onBufferChange,
+}: {
+  pauseGrabsBelowFreeSpaceGb: number
+  onChange: (v: number) => void
+  grabFreeSpaceBufferGb: number
+  onBufferChange: (v: number) => void
+}) {
+  return (
+    <Card>
+      <CardHeader>
+        <CardTitle>Free Space Guard</CardTitle>
+        <CardDescription>Pauses automatic grabs when a library volume runs low on space</CardDescription>
+      </CardHeader>
+      <CardContent className="space-y-4">
+        <div className="space-y-2">
+          <Label htmlFor="pauseGrabsBelowFreeSpaceGb">Minimum Free Space (GB)</Label>
+          <Input
+            id="pauseGrabsBelowFreeSpaceGb"
+            type="number"
+            value={pauseGrabsBelowFreeSpaceGb}
+            onChange={(e) => onChange(Math.max(0, Number.parseFloat(e.target.value) || 0))}
+            min={0}
+            step={0.5}
+          />
+          <p className="text-muted-foreground text-xs">
+            Autosearch and RSS sync stop grabbing while any root folder volume has less free space, and a health error is shown. Manual grabs are not affected. 0 disables the guard.
+          </p>
+        </div>
+        <div className="space-y-2">
+          <Label htmlFor="grabFreeSpaceBufferGb">Free Space Buffer (GB)</Label>
+          <Input
+            id="grabFreeSpaceBufferGb"
+            type="number"
+            value={grabFreeSpaceBufferGb}
+            onChange={(e) => onBufferChange(Math.max(0, Number.parseFloat(e.target.value) || 0))}
+            min={0}
+            step={0.5}
+          />
+          <p className="text-muted-foreground text-xs">
+            Every grab, manual or automatic, is rejected with a health warning when the release plus this buffer does not fit in the free space of its root folder.
+          </p>
+        </div>
+      </CardContent>
+    </Card>
+  )
+}
+
 export function AutoSearchSection() {
   const { data: settings, isLoading, isError, refetch } = useAutoSearchSettings()
   const updateMutation = useUpdateAutoSearchSettings()
@@ -102,19 +295,31 @@ export function AutoSearchSection() {
   const [enabled, setEnabled] = useState(true)
   const [intervalHours, setIntervalHours] = useState(1)
   const [backoffThreshold, setBackoffThreshold] = useState(12)
+  const [batchSize, setBatchSize] = useState(25)
+  const [batchPauseSeconds, setBatchPauseSeconds] = useState(300)
+  const [maxGrabsPerHour, setMaxGrabsPerHour] = useState(0)
+  const [maxGrabsPerDay, setMaxGrabsPerDay] = useState(0)
+  const [pauseGrabsBelowFreeSpaceGb, setPauseGrabsBelowFreeSpaceGb] = useState(0)
+  const [grabFreeSpaceBufferGb, setGrabFreeSpaceBufferGb] = useState(5)
+  const [grabApprovalMode, setGrabApprovalMode] = useState<GrabApprovalMode>('off')
+  const [grabApprovalTagIds, setGrabApprovalTagIds] = useState<number[]>([])
+  const [seasonPackMissingPercent, setSeasonPackMissingPercent] = useState(100)
+  const [upgradeWindowStartHour, setUpgradeWindowStartHour] = useState(3)
+  const [upgradeWindowEndHour, setUpgradeWindowEndHour] = useState(3)
+  const [upgradeTagIds, setUpgradeTagIds] = useState<number[]>([])
   const [prevSettings, setPrevSettings] = useState<typeof settings>(undefined)
 
   if (settings !== prevSettings) {
     setPrevSettings(settings)
-    if (settings) { setEnabled(settings.enabled); setIntervalHours(settings.intervalHours); setBackoffThreshold(settings.backoffThreshold) }
+    if (settings) { setEnabled(settings.enabled); setIntervalHours(settings.intervalHours); setBackoffThreshold(settings.backoffThreshold); setBatchSize(settings.batchSize); setBatchPauseSeconds(settings.batchPauseSeconds); setMaxGrabsPerHour(settings.maxGrabsPerHour); setMaxGrabsPerDay(settings.maxGrabsPerDay); setPauseGrabsBelowFreeSpaceGb(settings.pauseGrabsBelowFreeSpaceGb); setGrabFreeSpaceBufferGb(settings.grabFreeSpaceBufferGb); setGrabApprovalMode(settings.grabApprovalMode); setGrabApprovalTagIds(settings.grabApprovalTagIds ?? []); setSeasonPackMissingPercent(settings.seasonPackMissingPercent); setUpgradeWindowStartHour(settings.upgradeWindowStartHour); setUpgradeWindowEndHour(settings.upgradeWindowEndHour); setUpgradeTagIds(settings.upgradeTagIds ?? []) }
   }
 
   const handleSave = async () => {
-    try { await updateMutation.mutateAsync({ enabled, intervalHours, backoffThreshold }); toast.success('Settings saved') }
+    try { await updateMutation.mutateAsync({ enabled, intervalHours, backoffThreshold, batchSize, batchPauseSeconds, batchSmartListId: settings?.batchSmartListId ?? 0, maxGrabsPerHour, maxGrabsPerDay, pauseGrabsBelowFreeSpaceGb, grabFreeSpaceBufferGb, grabApprovalMode, grabApprovalTagIds, minimumAvailability: settings?.minimumAvailability ?? 'released', slotAutoFill: settings?.slotAutoFill ?? false, seasonPackMissingPercent, upgradeWindowStartHour, upgradeWindowEndHour, upgradeTagIds }); toast.success('Settings saved') }
     catch { toast.error('Failed to save settings') }
   }
 
-  const hasChanges = settings && (enabled !== settings.enabled || intervalHours !== settings.intervalHours || backoffThreshold !== settings.backoffThreshold)
+  const hasChanges = settings && (enabled !== settings.enabled || intervalHours !== settings.intervalHours || backoffThreshold !== settings.backoffThreshold || batchSize !== settings.batchSize || batchPauseSeconds !== settings.batchPauseSeconds || maxGrabsPerHour !== settings.maxGrabsPerHour || maxGrabsPerDay !== settings.maxGrabsPerDay || pauseGrabsBelowFreeSpaceGb !== settings.pauseGrabsBelowFreeSpaceGb || grabFreeSpaceBufferGb !== settings.grabFreeSpaceBufferGb || grabApprovalMode !== settings.grabApprovalMode || grabApprovalTagIds.join(',') !== (settings.grabApprovalTagIds ?? []).join(',') || seasonPackMissingPercent !== settings.seasonPackMissingPercent || upgradeWindowStartHour !== settings.upgradeWindowStartHour || upgradeWindowEndHour !== settings.upgradeWindowEndHour || upgradeTagIds.join(',') !== (settings.upgradeTagIds ?? []).join(','))
 
   if (isLoading) {return <LoadingState variant="list" count={2} />}
   if (isError) {return <ErrorState onRetry={refetch} />}
@@ -123,12 +328,19 @@ export function AutoSearchSection() {
     <div className="space-y-6">
       <SearchSettingsCard enabled={enabled} onEnabledChange={setEnabled} intervalHours={intervalHours} onIntervalChange={setIntervalHours} />
       <BackoffSettingsCard enabled={enabled} backoffThreshold={backoffThreshold} onBackoffChange={setBackoffThreshold} />
+      <BatchPacingCard enabled={enabled} batchSize={batchSize} onBatchSizeChange={setBatchSize} batchPauseSeconds={batchPauseSeconds} onBatchPauseChange={setBatchPauseSeconds} />
+      <UpgradeSweepCard startHour={upgradeWindowStartHour} onStartHourChange={setUpgradeWindowStartHour} endHour={upgradeWindowEndHour} onEndHourChange={setUpgradeWindowEndHour} tagIds={upgradeTagIds} onTagIdsChange={setUpgradeTagIds} />
+      <SeasonPackCard enabled={enabled} seasonPackMissingPercent={seasonPackMissingPercent} onChange={setSeasonPackMissingPercent} />
+      <GrabCapsCard maxGrabsPerHour={maxGrabsPerHour} onPerHourChange={setMaxGrabsPerHour} maxGrabsPerDay={maxGrabsPerDay} onPerDayChange={setMaxGrabsPerDay} />
+      <FreeSpaceGuardCard pauseGrabsBelowFreeSpaceGb={pauseGrabsBelowFreeSpaceGb} onChange={setPauseGrabsBelowFreeSpaceGb} grabFreeSpaceBufferGb={grabFreeSpaceBufferGb} onBufferChange={setGrabFreeSpaceBufferGb} />
+      <GrabApprovalCard mode={grabApprovalMode} onModeChange={setGrabApprovalMode} tagIds={grabApprovalTagIds} onTagIdsChange={setGrabApprovalTagIds} />
       <div className="flex justify-end">
         <Button onClick={handleSave} disabled={updateMutation.isPending || !hasChanges}>
           <Save className="mr-2 size-4" />
           Save Changes
         </Button>
       </div>
+      <PendingGrabsCard />
     </div>
   )
 }
@@ -109,6 +109,16 @@ export const TOKEN_REFERENCE = {
       description: 'Subtitle language codes',
       example: '[EN+ES]',
     },
+    {
+      token: '{MediaInfo DolbyVisionProfile}',
+      description: 'Dolby Vision profile',
+      example: 'DV P8.1',
+    },
+    {
+      token: '{MediaInfo AudioTracks}',
+      description: 'Every audio track',
+      example: '[TrueHD Atmos 7.1 EN+AC3 5.1 DE]',
+    },
   ],
   other: [
     { token: '{Air-Date}', description: 'Air date with dashes', example: '2024-03-20' },
@@ -0,0 +1,136 @@
+import { useState } from 'react'
+
+import { Trash2 } from 'lucide-react'
+import { toast } from 'sonner'
+
+import { Badge } from '@/components/ui/badge'
+import { Button } from '@/components/ui/button'
+import { Card, CardContent, CardDescription, CardHeader, CardTitle } from '@/components/ui/card'
+import { Input } from '@/components/ui/input'
+import { Label } from '@/components/ui/label'
+import { Select, SelectContent, SelectItem, SelectTrigger } from '@/components/ui/select'
+import { useDeleteFolderHint, useFolderHints, useSaveFolderHint } from '@/hooks'
+import type { FolderHint, FolderHintScope } from '@/types'
+
+const SCOPE_LABELS: Record<FolderHintScope, string> = {
+  indexer: 'Indexer ID',
+  release_group: 'Release Group',
+}
+
+function FolderHintRow({ hint }: { hint: FolderHint }) {
+  const deleteMutation = useDeleteFolderHint()
+
+  const handleDelete = async () => {
+    try {
+      await deleteMutation.mutateAsync(hint.id)
+    } catch {
+      toast.error('Failed to delete folder hint')
+    }
+  }
+
+  return (
+    <div className="flex items-start justify-between gap-4 rounded-md border p-3">
+      <div className="min-w-0 space-y-1 text-sm">
+        <div className="flex items-center gap-2">
+          <span className="font-medium">
+            {SCOPE_LABELS[hint.scope]}: {hint.scopeKey}
+          </span>
+          <Badge variant="secondary">{hint.learned ? `Learned (${hint.hits})` : 'Configured'}</Badge>
+        </div>
+        <p className="text-muted-foreground text-xs">
+          Main files: {hint.contentPath || 'top level'}
+          {hint.skipPatterns.length > 0 ? ` · Skip: ${hint.skipPatterns.join(', ')}` : ''}
+        </p>
+      </div>
+      <Button size="sm" variant="outline" onClick={handleDelete} disabled={deleteMutation.isPending}>
+        <Trash2 className="size-4" />
+      </Button>
+    </div>
+  )
+}
+
+export function FolderHintsCard() {
+  const { data: hints = [] } = useFolderHints()
+  const saveMutation = useSaveFolderHint()
+  const [scope, setScope] = useState<FolderHintScope>('release_group')
+  const [scopeKey, setScopeKey] = useState('')
+  const [contentPath, setContentPath] = useState('')
+  const [skipPatterns, setSkipPatterns] = useState('')
+
+  const handleSave = async () => {
+    try {
+      await saveMutation.mutateAsync({
+        scope,
+        scopeKey,
+        contentPath,
+        skipPatterns: skipPatterns.split(',').map((p) => p.trim()).filter(Boolean),
+      })
+      setScopeKey('')
+      setContentPath('')
+      setSkipPatterns('')
+    } catch (error) {
+      toast.error(error instanceof Error ? error.message : 'Failed to save folder hint')
+    }
+  }
+
+  return (
+    <Card>
+      <CardHeader>
+        <CardTitle>Download Folder Hints</CardTitle>
+        <CardDescription>
+          Point imports at the folder holding the main files for an indexer or release group, and skip known junk.
+          Hints are also learned from successful imports.
+        </CardDescription>
+      </CardHeader>
+      <CardContent className="space-y-4">
+        {hints.length > 0 ? (
+          <div className="space-y-2">
+            {hints.map((hint) => (
+              <FolderHintRow key={hint.id} hint={hint} />
+            ))}
+          </div>
+        ) : (
+          <p className="text-muted-foreground text-sm">No folder hints yet</p>
+        )}
+        <div className="grid gap-3 sm:grid-cols-2">
+          <div className="space-y-2">
+            <Label>Applies To</Label>
+            <Select value={scope} onValueChange={(v) => v && setScope(v as FolderHintScope)}>
+              <SelectTrigger>{SCOPE_LABELS[scope]}</SelectTrigger>
+              <SelectContent>
+                {(Object.keys(SCOPE_LABELS) as FolderHintScope[]).map((s) => (
+                  <SelectItem key={s} value={s}>
+                    {SCOPE_LABELS[s]}
+                  </SelectItem>
+                ))}
+              </SelectContent>
+            </Select>
+          </div>
+          <div className="space-y-2">
+            <Label>{SCOPE_LABELS[scope]}</Label>
+            <Input value={scopeKey} onChange={(e) => setScopeKey(e.target.value)} />
+          </div>
+          <div className="space-y-2">
+            <Label>Main File Folder</Label>
+            <Input
+              value={contentPath}
+              placeholder="Content/Video"
+              onChange={(e) => setContentPath(e.target.value)}
+            />
+          </div>
+          <div className="space-y-2">
+            <Label>Skip Patterns</Label>
+            <Input
+              value={skipPatterns}
+              placeholder="proof, *sample*, extras"
+              onChange={(e) => setSkipPatterns(e.target.value)}
+            />
+          </div>
+        </div>
+        <Button size="sm" onClick={handleSave} disabled={!scopeKey.trim() || saveMutation.isPending}>
+          Save Hint
+        </Button>
+      </CardContent>
+    </Card>
+  )
+}
@@ -0,0 +1,163 @@
+import { Check, X } from 'lucide-react'
+import { toast } from 'sonner'
+
+import { Badge } from '@/components/ui/badge'
+import { Button } from '@/components/ui/button'
+import { Card, CardContent, CardDescription, CardHeader, CardTitle } from '@/components/ui/card'
+import { Checkbox } from '@/components/ui/checkbox'
+import { Label } from '@/components/ui/label'
+import { Select, SelectContent, SelectItem, SelectTrigger } from '@/components/ui/select'
+import { useApprovePendingGrab, usePendingGrabs, useRejectPendingGrab, useTags } from '@/hooks'
+import { formatBytes, formatRelativeTime } from '@/lib/formatters'
+import type { GrabApprovalMode, PendingGrab } from '@/types'
+
+const APPROVAL_MODE_OPTIONS: { value: GrabApprovalMode; label: string; description: string }[] = [
+  { value: 'off', label: 'Off', description: 'Automatic grabs are sent to a download client right away.' },
+  { value: 'all', label: 'All Items', description: 'Every automatic grab waits for approval below.' },
+  { value: 'tagged', label: 'Tagged Items', description: 'Only items with one of the selected tags wait for approval.' },
+]
+
+export function GrabApprovalCard({
+  mode,
+  onModeChange,
+  tagIds,
+  onTagIdsChange,
+}: {
+  mode: GrabApprovalMode
+  onModeChange: (v: GrabApprovalMode) => void
+  tagIds: number[]
+  onTagIdsChange: (v: number[]) => void
+}) {
+  const { data: tags = [] } = useTags()
+
+  const toggleTag = (id: number) =>
+    onTagIdsChange(tagIds.includes(id) ? tagIds.filter((t) => t !== id) : [...tagIds, id])
+
+  return (
+    <Card>
+      <CardHeader>
+        <CardTitle>Grab Approval</CardTitle>
+        <CardDescription>
+          Holds releases picked by autosearch and RSS sync until you approve them, useful while tuning profiles
+        </CardDescription>
+      </CardHeader>
+      <CardContent className="space-y-4">
+        <div className="space-y-2">
+          <Label>Approval Mode</Label>
+          <Select value={mode} onValueChange={(v) => v && onModeChange(v as GrabApprovalMode)}>
+            <SelectTrigger>{APPROVAL_MODE_OPTIONS.find((o) => o.value === mode)?.label}</SelectTrigger>
+            <SelectContent>
+              {APPROVAL_MODE_OPTIONS.map((opt) => (
+                <SelectItem key={opt.value} value={opt.value}>
+                  {opt.label}
+                </SelectItem>
+              ))}
+            </SelectContent>
+          </Select>
+          <p className="text-muted-foreground text-xs">
+            {APPROVAL_MODE_OPTIONS.find((o) => o.value === mode)?.description}
+          </p>
+        </div>
+        {mode === 'tagged' && (
+          <div className="space-y-2">
+            <Label>Tags</Label>
+            {tags.length === 0 ? (
+              <p className="text-muted-foreground text-xs">No tags yet. Create tags to scope approval.</p>
+            ) : (
+              <div className="flex flex-wrap gap-3">
+                {tags.map((tag) => (
+                  <label key={tag.id} className="flex items-center gap-2 text-sm">
+                    <Checkbox checked={tagIds.includes(tag.id)} onCheckedChange={() => toggleTag(tag.id)} />
+                    {tag.label}
+                  </label>
+                ))}
+              </div>
+            )}
+          </div>
+        )}
+      </CardContent>
+    </Card>
+  )
+}
+
+function PendingGrabRow({ pending }: { pending: PendingGrab }) {
+  const approveMutation = useApprovePendingGrab()
+  const rejectMutation = useRejectPendingGrab()
+  const busy = approveMutation.isPending || rejectMutation.isPending
+  const breakdown = pending.scoreBreakdown
+
+  const handleApprove = async () => {
+    try {
+      const result = await approveMutation.mutateAsync(pending.id)
+      if (result.success) {
+        toast.success(`Grabbed ${pending.release.title}`)
+      } else {
+        toast.error(result.error ?? 'Grab failed')
+      }
+    } catch {
+      toast.error('Failed to approve grab')
+    }
+  }
+
+  const handleReject = async () => {
+    try {
+      await rejectMutation.mutateAsync(pending.id)
+    } catch {
+      toast.error('Failed to reject grab')
+    }
+  }
+
+  return (
+    <div className="flex items-start justify-between gap-4 rounded-md border p-3">
+      <div className="min-w-0 space-y-1">
+        <p className="truncate text-sm font-medium">{pending.release.title}</p>
+        <div className="text-muted-foreground flex flex-wrap items-center gap-2 text-xs">
+          <Badge variant="secondary">Score {pending.normalizedScore}</Badge>
+          <span>{pending.release.indexer}</span>
+          <span>{formatBytes(pending.release.size)}</span>
+          <span>{formatRelativeTime(pending.createdAt)}</span>
+        </div>
+        {breakdown ? (
+          <p className="text-muted-foreground text-xs">
+            Quality {breakdown.qualityScore.toFixed(1)}
+            {breakdown.qualityName ? ` (${breakdown.qualityName})` : ''} · Health{' '}
+            {breakdown.healthScore.toFixed(1)} · Indexer {breakdown.indexerScore.toFixed(1)} · Match{' '}
+            {breakdown.matchScore.toFixed(1)} · Age {breakdown.ageScore.toFixed(1)}
+          </p>
+        ) : null}
+      </div>
+      <div className="flex shrink-0 gap-2">
+        <Button size="sm" onClick={handleApprove} disabled={busy}>
+          <Check className="mr-1 size-4" />
+          Approve
+        </Button>
+        <Button size="sm" variant="outline" onClick={handleReject} disabled={busy}>
+          <X className="mr-1 size-4" />
+          Reject
+        </Button>
+      </div>
+    </div>
+  )
+}
+
+export function PendingGrabsCard() {
+  const { data: pending = [] } = usePendingGrabs()
+
+  if (pending.length === 0) {return null}
+
+  return (
+    <Card>
+      <CardHeader>
+        <CardTitle>Pending Approval</CardTitle>
+        <CardDescription>
+          Approve to send a release to a download client. Rejected items stay wanted and are searched again.
+        </CardDescription>
+      </CardHeader>
+      <CardContent className="space-y-2">
+        {pending.map((p) => (
+          <PendingGrabRow key={p.id} pending={p} />
+        ))}
+      </CardContent>
+    </Card>
+  )
+}
@@ -11,6 +11,7 @@ import {
   IndexerModeToggle,
   ProwlarrConfigForm,
   ProwlarrIndexerList,
+  ProwlarrSyncCard,
 } from '@/components/indexers'
 import {
   privacyColorsInteractive,
@@ -26,10 +27,13 @@ import {
   useDeleteIndexer,
   useIndexerMode,
   useIndexers,
+  useIndexerStats,
+  useProwlarrConfig,
   useTestIndexer,
   useUpdateIndexer,
 } from '@/hooks'
-import type { Indexer } from '@/types'
+import { formatDate } from '@/lib/formatters'
+import type { Indexer, IndexerStats } from '@/types'
 
 function useIndexerActions() {
   const deleteMutation = useDeleteIndexer()
@@ -75,8 +79,10 @@ export function IndexersSection() {
   const [editingIndexer, setEditingIndexer] = useState<Indexer | null>(null)
   const { data: modeInfo, isLoading: modeLoading } = useIndexerMode()
   const { data: indexers, isLoading, isError, refetch } = useIndexers()
+  const { data: prowlarrConfig } = useProwlarrConfig()
   const actions = useIndexerActions()
   const isProwlarrMode = modeInfo?.effectiveMode === 'prowlarr'
+  const prowlarrConfigured = Boolean(prowlarrConfig?.url && prowlarrConfig.apiKey)
 
   const handleAdd = () => {
     setEditingIndexer(null)
@@ -98,15 +104,18 @@ export function IndexersSection() {
       {isProwlarrMode ? (
         <ProwlarrModeContent />
       ) : (
-        <SlipStreamModeContent
-          indexers={indexers}
-          isLoading={isLoading}
-          isError={isError}
-          refetch={refetch}
-          onAdd={handleAdd}
-          onEdit={handleEdit}
-          actions={actions}
-        />
+        <>
+          {prowlarrConfigured ? <ProwlarrSyncCard /> : null}
+          <SlipStreamModeContent
+            indexers={indexers}
+            isLoading={isLoading}
+            isError={isError}
+            refetch={refetch}
+            onAdd={handleAdd}
+            onEdit={handleEdit}
+            actions={actions}
+          />
+        </>
       )}
       <IndexerDialog open={dialogOpen} onOpenChange={setDialogOpen} indexer={editingIndexer} />
     </div>
@@ -141,6 +150,8 @@ type SlipStreamModeContentProps = {
 
 function SlipStreamModeContent(props: SlipStreamModeContentProps) {
   const { indexers, isLoading, isError, refetch, onAdd, onEdit, actions } = props
+  const { data: statsData } = useIndexerStats()
+  const statsById = new Map(statsData?.indexers.map((s) => [s.indexerId, s]))
 
   if (isLoading) {
     return <LoadingState variant="list" count={3} />
@@ -155,7 +166,13 @@ function SlipStreamModeContent(props: SlipStreamModeContentProps) {
   return (
     <div className="space-y-4">
       {indexers.map((indexer) => (
-        <IndexerCard key={indexer.id} indexer={indexer} onEdit={onEdit} actions={actions} />
+        <IndexerCard
+          key={indexer.id}
+          indexer={indexer}
+          stats={statsById.get(indexer.id)}
+          onEdit={onEdit}
+          actions={actions}
+        />
       ))}
       <AddPlaceholderCard label="Add Indexer" onClick={onAdd} />
     </div>
@@ -164,11 +181,12 @@ function SlipStreamModeContent(props: SlipStreamModeContentProps) {
 
 type IndexerCardProps = {
   indexer: Indexer
+  stats?: IndexerStats
   onEdit: (indexer: Indexer) => void
   actions: IndexerActions
 }
 
-function IndexerCard({ indexer, onEdit, actions }: IndexerCardProps) {
+function IndexerCard({ indexer, stats, onEdit, actions }: IndexerCardProps) {
   return (
     <Card>
       <CardHeader className="flex flex-row items-center justify-between py-4">
@@ -188,6 +206,7 @@ function IndexerCard({ indexer, onEdit, actions }: IndexerCardProps) {
               </Badge>
             </div>
             <IndexerDescription indexer={indexer} />
+            {stats ? <IndexerStatsLine stats={stats} /> : null}
           </div>
         </div>
         <IndexerActions indexer={indexer} onEdit={onEdit} actions={actions} />
@@ -222,6 +241,36 @@ function IndexerDescription({ indexer }: { indexer: Indexer }) {
   )
 }
 
+function IndexerStatsLine({ stats }: { stats: IndexerStats }) {
+  return (
+    <div className="text-muted-foreground mt-1 flex items-center gap-2 text-xs">
+      <span>{stats.queries} queries (7d)</span>
+      <span className="text-muted-foreground/50">|</span>
+      <span>{Math.round(stats.avgResponseMs)} ms avg</span>
+      <span className="text-muted-foreground/50">|</span>
+      <span className={stats.failureRate >= 0.5 ? 'text-red-500' : undefined}>
+        {Math.round(stats.failureRate * 100)}% failed
+      </span>
+      <span className="text-muted-foreground/50">|</span>
+      <span>{stats.grabs} grabs</span>
+      {stats.quarantinedRows > 0 ? (
+        <>
+          <span className="text-muted-foreground/50">|</span>
+          <span className="text-amber-500">{stats.quarantinedRows} malformed rows</span>
+        </>
+      ) : null}
+      {stats.isDisabled && stats.disabledTill ? (
+        <>
+          <span className="text-muted-foreground/50">|</span>
+          <span className="text-red-500">
+            Paused until {formatDate(stats.disabledTill, { hour: 'numeric', minute: '2-digit' })}
+          </span>
+        </>
+      ) : null}
+    </div>
+  )
+}
+
 function IndexerActions(props: {
   indexer: Indexer
   onEdit: (indexer: Indexer) => void
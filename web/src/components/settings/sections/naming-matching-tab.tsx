@@ -1,9 +1,11 @@
 import { Card, CardContent, CardDescription, CardHeader, CardTitle } from '@/components/ui/card'
 import { Label } from '@/components/ui/label'
+import { useMatchAccuracy } from '@/hooks'
 import { Select, SelectContent, SelectItem, SelectTrigger } from '@/components/ui/select'
 import type { ImportSettings } from '@/types'
 
 import { MATCH_CONFLICT_OPTIONS, UNKNOWN_MEDIA_OPTIONS } from './file-naming-constants'
+import { FolderHintsCard } from './folder-hints-card'
 
 function OptionSelect({
   label,
@@ -38,6 +40,40 @@ function OptionSelect({
   )
 }
 
+const MATCH_SOURCE_LABELS: Record<string, string> = {
+  queue: 'Download queue',
+  parse: 'Filename parsing',
+}
+
+function MatchAccuracyCard() {
+  const { data: stats } = useMatchAccuracy()
+
+  return (
+    <Card>
+      <CardHeader>
+        <CardTitle>Match Accuracy</CardTitle>
+        <CardDescription>Automatic matches from the last 30 days later corrected by a manual import</CardDescription>
+      </CardHeader>
+      <CardContent className="space-y-2">
+        {stats && stats.length > 0 ? (
+          stats.map((stat) => (
+            <div key={stat.source} className="flex items-center justify-between text-sm">
+              <span>{MATCH_SOURCE_LABELS[stat.source] ?? stat.source}</span>
+              <span className="text-muted-foreground">
+                {(stat.accuracy * 100).toFixed(1)}% ({stat.corrected} of {stat.total} corrected)
+              </span>
+            </div>
+          ))
+        ) : (
+          <p className="text-muted-foreground text-sm">No automatic matches recorded yet</p>
+        )}
+      </CardContent>
+    </Card>
+    <MatchAccuracyCard />
+    </>
+  )
+}
+
 export function MatchingTab({
   form,
   updateField,
@@ -46,25 +82,29 @@ export function MatchingTab({
   updateField: <K extends keyof ImportSettings>(field: K, value: ImportSettings[K]) => void
 }) {
   return (
-    <Card>
-      <CardHeader>
-        <CardTitle>Match Behavior</CardTitle>
-        <CardDescription>Configure how files are matched to library items</CardDescription>
-      </CardHeader>
-      <CardContent className="space-y-6">
-        <OptionSelect
-          label="Match Conflict Behavior"
-          value={form.matchConflictBehavior}
-          onChange={(v) => updateField('matchConflictBehavior', v as ImportSettings['matchConflictBehavior'])}
-          options={MATCH_CONFLICT_OPTIONS}
-        />
-        <OptionSelect
-          label="Unknown Media Handling"
-          value={form.unknownMediaBehavior}
-          onChange={(v) => updateField('unknownMediaBehavior', v as ImportSettings['unknownMediaBehavior'])}
-          options={UNKNOWN_MEDIA_OPTIONS}
-        />
-      </CardContent>
-    </Card>
+    <>
+      <Card>
+        <CardHeader>
+          <CardTitle>Match Behavior</CardTitle>
+          <CardDescription>Configure how files are matched to library items</CardDescription>
+        </CardHeader>
+        <CardContent className="space-y-6">
+          <OptionSelect
+            label="Match Conflict Behavior"
+            value={form.matchConflictBehavior}
+            onChange={(v) => updateField('matchConflictBehavior', v as ImportSettings['matchConflictBehavior'])}
+            options={MATCH_CONFLICT_OPTIONS}
+          />
+          <OptionSelect
+            label="Unknown Media Handling"
+            value={form.unknownMediaBehavior}
+            onChange={(v) => updateField('unknownMediaBehavior', v as ImportSettings['unknownMediaBehavior'])}
+            options={UNKNOWN_MEDIA_OPTIONS}
+          />
+        </CardContent>
+      </Card>
+      <FolderHintsCard />
+      <MatchAccuracyCard />
+    </>
   )
 }
@@ -15,12 +15,7 @@ import { useDeleteQualityProfile, useQualityProfiles } from '@/hooks'
 import { withToast } from '@/lib/with-toast'
 import { getEnabledModules, getModule } from '@/modules'
 import type { QualityProfile } from '@/types'
-import { PREDEFINED_QUALITIES } from '@/types'
-
-const getCutoffName = (cutoffId: number) => {
-  const quality = PREDEFINED_QUALITIES.find((q) => q.id === cutoffId)
-  return quality?.name ?? 'Unknown'
-}
+import { qualityNameFromItems } from '@/types'
 
 function QualityBadgeList({ profile }: { profile: QualityProfile }) {
   const allowedQualities = profile.items.filter((item) => item.allowed).map((item) => item.quality.name)
@@ -68,7 +63,7 @@ function ProfileCard({
           </div>
           <CardDescription>
             {profile.upgradesEnabled ? (
-              <>Cutoff: {getCutoffName(profile.cutoff)}</>
+              <>Cutoff: {qualityNameFromItems(profile.items, profile.cutoff)}</>
             ) : (
               <span className="text-muted-foreground/70">Upgrades disabled</span>
             )}
@@ -0,0 +1,95 @@
+import { Card, CardContent, CardDescription, CardHeader, CardTitle } from '@/components/ui/card'
+import { Checkbox } from '@/components/ui/checkbox'
+import { Label } from '@/components/ui/label'
+import { Select, SelectContent, SelectItem, SelectTrigger } from '@/components/ui/select'
+import { useTags } from '@/hooks'
+
+const HOURS = Array.from({ length: 24 }, (_, hour) => hour)
+
+function formatHour(hour: number) {
+  return `${String(hour).padStart(2, '0')}:00`
+}
+
+function HourSelect({ id, value, onChange }: { id: string; value: number; onChange: (v: number) => void }) {
+  return (
+    <Select value={String(value)} onValueChange={(v) => v && onChange(Number.parseInt(v))}>
+      <SelectTrigger id={id}>{formatHour(value)}</SelectTrigger>
+      <SelectContent>
+        {HOURS.map((hour) => (
+          <SelectItem key={hour} value={String(hour)}>
+            {formatHour(hour)}
+          </SelectItem>
+        ))}
+      </SelectContent>
+    </Select>
+  )
+}
+
+export function UpgradeSweepCard({
+  startHour,
+  onStartHourChange,
+  endHour,
+  onEndHourChange,
+  tagIds,
+  onTagIdsChange,
+}: {
+  startHour: number
+  onStartHourChange: (v: number) => void
+  endHour: number
+  onEndHourChange: (v: number) => void
+  tagIds: number[]
+  onTagIdsChange: (v: number[]) => void
+}) {
+  const { data: tags = [] } = useTags()
+
+  const toggleTag = (id: number) =>
+    onTagIdsChange(tagIds.includes(id) ? tagIds.filter((t) => t !== id) : [...tagIds, id])
+
+  return (
+    <Card>
+      <CardHeader>
+        <CardTitle>Upgrade Search</CardTitle>
+        <CardDescription>
+          Limits when and for which items the Search Cutoff Unmet task looks for upgrades
+        </CardDescription>
+      </CardHeader>
+      <CardContent className="space-y-4">
+        <div className="grid grid-cols-2 gap-4">
+          <div className="space-y-2">
+            <Label htmlFor="upgradeWindowStart">Window Start</Label>
+            <HourSelect id="upgradeWindowStart" value={startHour} onChange={onStartHourChange} />
+          </div>
+          <div className="space-y-2">
+            <Label htmlFor="upgradeWindowEnd">Window End</Label>
+            <HourSelect id="upgradeWindowEnd" value={endHour} onChange={onEndHourChange} />
+          </div>
+        </div>
+        <p className="text-muted-foreground text-xs">
+          {startHour === endHour
+            ? 'No window: the search starts at 03:00 and runs until it finishes.'
+            : `The search starts at ${formatHour(startHour)} and stops at ${formatHour(endHour)}, resuming the next night.`}
+        </p>
+        <div className="space-y-2">
+          <Label>Tags</Label>
+          {tags.length === 0 ? (
+            <p className="text-muted-foreground text-xs">No tags yet. Every item below its cutoff is searched.</p>
+          ) : (
+            <>
+              <div className="flex flex-wrap gap-3">
+                {tags.map((tag) => (
+                  <label key={tag.id} className="flex items-center gap-2 text-sm">
+                    <Checkbox checked={tagIds.includes(tag.id)} onCheckedChange={() => toggleTag(tag.id)} />
+                    {tag.label}
+                  </label>
+                ))}
+              </div>
+              <p className="text-muted-foreground text-xs">
+                Only items with one of the selected tags are searched. Select none to search every item.
+              </p>
+            </>
+          )}
+        </div>
+      </CardContent>
+    </Card>
+  )
+}
@@ -196,6 +196,8 @@ function buildInitialForms(
       videoCodecSettings: profile.videoCodecSettings,
       audioCodecSettings: profile.audioCodecSettings,
       audioChannelSettings: profile.audioChannelSettings,
+      releaseGroups: profile.releaseGroups,
+      indexerFlags: profile.indexerFlags,
     }
   }
   return forms
@@ -22,3 +22,9 @@ export {
   useUpdateAdminUser,
 } from './use-admin-users'
 export { useRequestSettings, useUpdateRequestSettings } from './use-request-settings'
+export {
+  useAdminWidgetKeys,
+  useCreateWidgetKey,
+  useDeleteWidgetKey,
+  useUpdateWidgetKey,
+} from './use-admin-widget-keys'
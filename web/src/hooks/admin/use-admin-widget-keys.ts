@@ -0,0 +1,47 @@
+import { useMutation, useQuery, useQueryClient } from '@tanstack/react-query'
+
+import * as adminApi from '@/api/admin'
+import type { WidgetKeyInput } from '@/types'
+
+const adminWidgetKeyKeys = {
+  all: ['admin', 'widgetKeys'] as const,
+  list: () => [...adminWidgetKeyKeys.all, 'list'] as const,
+}
+
+export function useAdminWidgetKeys() {
+  return useQuery({
+    queryKey: adminWidgetKeyKeys.list(),
+    queryFn: () => adminApi.listWidgetKeys(),
+  })
+}
+
+export function useCreateWidgetKey() {
+  const queryClient = useQueryClient()
+  return useMutation({
+    mutationFn: (data: WidgetKeyInput) => adminApi.createWidgetKey(data),
+    onSuccess: () => {
+      void queryClient.invalidateQueries({ queryKey: adminWidgetKeyKeys.all })
+    },
+  })
+}
+
+export function useUpdateWidgetKey() {
+  const queryClient = useQueryClient()
+  return useMutation({
+    mutationFn: ({ id, data }: { id: number; data: WidgetKeyInput }) =>
+      adminApi.updateWidgetKey(id, data),
+    onSuccess: () => {
+      void queryClient.invalidateQueries({ queryKey: adminWidgetKeyKeys.all })
+    },
+  })
+}
+
+export function useDeleteWidgetKey() {
+  const queryClient = useQueryClient()
+  return useMutation({
+    mutationFn: (id: number) => adminApi.deleteWidgetKey(id),
+    onSuccess: () => {
+      void queryClient.invalidateQueries({ queryKey: adminWidgetKeyKeys.all })
+    },
+  })
+}
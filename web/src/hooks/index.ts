@@ -1,3 +1,4 @@
+export { activityKeys, useActivity } from './use-activity'
 export {
   useAutoSearchEpisode,
   useAutoSearchEpisodeSlot,
@@ -14,7 +15,15 @@ export {
   useSearchAllUpgradableSeries,
   useUpdateAutoSearchSettings,
 } from './use-autosearch'
-export { calendarKeys, useCalendarEvents } from './use-calendar'
+export {
+  backupKeys,
+  useBackups,
+  useCreateBackup,
+  useDeleteBackup,
+  useDownloadBackup,
+  useRestoreBackup,
+} from './use-backups'
+export { calendarKeys, useCalendarEvents, useComingSoon } from './use-calendar'
 export { useDebounce } from './use-debounce'
 export { useClearDefault,useDefault, useSetDefault } from './use-defaults'
 export {
@@ -28,33 +37,63 @@ export {
 export { useBrowseDirectory, useBrowseForImport } from './use-filesystem'
 export {
   systemHealthKeys,
+  useDismissHealthIssue,
+  useHealthIssues,
+  useMuteHealthIssue,
+  useRestoreHealthIssue,
   useSystemHealth,
   useSystemHealthSummary,
   useTestHealthCategory,
   useTestHealthItem,
+  useUnmuteHealthIssue,
 } from './use-health'
 export { historyKeys, useClearHistory, useHistory, useHistorySettings, useUpdateHistorySettings } from './use-history'
 export {
+  useAddImportExclusion,
+  renameKeys,
+  useBatchManualImport,
+  useCancelRename,
+  useDeleteFolderHint,
+  useDeleteImportExclusion,
+  useDownloadCleanup,
+  useExecuteRename,
+  useFolderHints,
+  useImportDryRun,
+  useImportExclusions,
   useImportSettings,
   useManualImport,
+  useMatchAccuracy,
   useParseFilename,
   usePendingImports,
   usePreviewNamingPattern,
+  useRecycleBin,
+  useRenameJobs,
   useRetryImport,
+  useSaveFolderHint,
   useScanDirectory,
+  useUndoImport,
+  useUpdateDownloadCleanup,
   useUpdateImportSettings,
+  useUpdateRecycleBin,
 } from './use-import'
 export {
+  useClearIndexerQuarantine,
   useCreateIndexer,
   useDefinitions,
   useDefinitionSchema,
   useDeleteIndexer,
+  useIndexerGrabCap,
+  useIndexerQuarantine,
   useIndexers,
+  useIndexerSeedGoal,
+  useIndexerStats,
   useTestIndexer,
   useTestIndexerConfig,
   useUpdateIndexer,
+  useUpdateIndexerGrabCap,
+  useUpdateIndexerSeedGoal,
 } from './use-indexers'
-export { useDownloadLogFile,useLogs } from './use-logs'
+export { useDownloadLogFile, useLogLevels, useLogs, useUpdateLogLevels } from './use-logs'
 export type { MediaTarget } from './use-media-download-progress'
 export { useMediaDownloadProgress } from './use-media-download-progress'
 export {
@@ -72,6 +111,8 @@ export {
   useMissingSeries,
   useUpgradableMovies,
   useUpgradableSeries,
+  useWantedCutoff,
+  useWantedMissing,
 } from './use-missing'
 export {
   movieKeys,
@@ -80,6 +121,7 @@ export {
   useBulkMonitorMovies,
   useBulkUpdateMovies,
   useDeleteMovie,
+  useMoveMovie,
   useMovie,
   useMovies,
   useRefreshAllMovies,
@@ -109,34 +151,55 @@ export {
   useProwlarrConfig,
   useProwlarrIndexersWithSettings,
   useProwlarrStatus,
+  useProwlarrSyncStatus,
   useRefreshProwlarr,
   useResetProwlarrIndexerStats,
+  useRunProwlarrSync,
   useSetIndexerMode,
   useTestProwlarrConnection,
   useUpdateProwlarrConfig,
   useUpdateProwlarrIndexerSettings,
+  useUpdateProwlarrSync,
 } from './use-prowlarr'
 export {
+  useCreateQuality,
   useCreateQualityProfile,
+  useDeleteQuality,
   useDeleteQualityProfile,
+  useQualities,
   useQualityProfileAttributes,
   useQualityProfiles,
+  useUpdateQuality,
   useUpdateQualityProfile,
 } from './use-quality-profiles'
 export {
   queueKeys,
+  useCheckStalledDownloads,
   useFastForwardQueueItem,
   usePauseQueueItem,
   useQueue,
   useQueueItems,
+  useRemapQueueItem,
   useRemoveFromQueue,
   useResumeQueueItem,
+  useStalledDownloadSettings,
+  useUpdateStalledDownloadSettings,
 } from './use-queue'
 export {
   useCreateRootFolder,
   useDeleteRootFolder,
+  useFixOrphan,
+  useImportLibrary,
+  useLibraryImportPreview,
+  useOrphanReport,
+  useQualityAuditReport,
+  useRebuildOrphanReport,
+  useRebuildQualityAuditReport,
+  useRefreshRootFolder,
   useRootFolders,
   useRootFoldersByType,
+  useSearchAuditedFile,
+  useSetDefaultRootFolder,
 } from './use-root-folders'
 export {
   useRssSyncSettings,
@@ -145,7 +208,18 @@ export {
   useUpdateRssSyncSettings,
 } from './use-rss-sync'
 export { schedulerKeys, useRunTask,useScheduledTasks } from './use-scheduler'
-export { useGrab,useIndexerMovieSearch, useIndexerTVSearch } from './use-search'
+export {
+  useApprovePendingGrab,
+  useCrossSeedDownload,
+  useCrossSeedSettings,
+  useGrab,
+  useIndexerMovieSearch,
+  useIndexerTVSearch,
+  usePendingGrabs,
+  useRejectPendingGrab,
+  useSpaceForecast,
+  useUpdateCrossSeedSettings,
+} from './use-search'
 export {
   seriesKeys,
   useAddSeries,
@@ -154,10 +228,13 @@ export {
   useBulkUpdateSeries,
   useDeleteSeries,
   useEpisodes,
+  useMoveSeries,
   useRefreshAllSeries,
   useRefreshSeries,
   useSeries,
+  useSeriesCompleteness,
   useSeriesDetail,
+  useSeriesGaps,
   useUpdateEpisodeMonitored,
   useUpdateSeasonMonitored,
   useUpdateSeries,
@@ -182,20 +259,38 @@ export {
   useValidateNaming,
   useValidateSlotConfiguration,
 } from './use-slots'
+export { statsKeys, useLibraryStats } from './use-stats'
 export {
   systemKeys,
   useCheckFirewall,
   useDeveloperMode,
   useFirewallStatus,
+  useHostSettings,
   useMediainfoAvailable,
   usePortalEnabled,
   useRestart,
   useSettings,
   useStatus,
+  useUpdateHostSettings,
   useUpdateModuleEnabled,
   useUpdateSettings,
 } from './use-system'
-export { useCheckForUpdate, useInstallUpdate,useUpdateStatus } from './use-update'
+export {
+  tagKeys,
+  useCreateTag,
+  useDeleteTag,
+  useTagDetails,
+  useTags,
+  useUpdateTag,
+} from './use-tags'
+export {
+  useCheckForUpdate,
+  useInstallUpdate,
+  useRollbackUpdate,
+  useSaveUpdaterSettings,
+  useUpdaterSettings,
+  useUpdateStatus,
+} from './use-update'
 
 // Portal hooks
 export {
@@ -212,6 +307,7 @@ export {
   usePasskeyCredentials,
   usePasskeyLogin,
   usePasskeySupport,
+  usePortalComingSoon,
   usePortalDownloads,
   usePortalLibraryMovies,
   usePortalLibrarySeries,
@@ -220,8 +316,11 @@ export {
   usePortalMovieSearch,
   usePortalSeriesSearch,
   usePortalSignup,
+  usePortalTrendingMovies,
+  usePortalTrendingSeries,
   useRegisterPasskey,
   useRequest,
+  useRequestableSlots,
   useRequests,
   useSeriesSeasons,
   useTestUserNotification,
@@ -15,14 +15,21 @@ export {
   useValidateInvitation,
   useVerifyPin,
 } from './use-portal-auth'
-export { usePortalLibraryMovies, usePortalLibrarySeries } from './use-portal-library'
-export { usePortalMovieSearch, usePortalSeriesSearch, useSeriesSeasons } from './use-portal-search'
+export { usePortalComingSoon, usePortalLibraryMovies, usePortalLibrarySeries } from './use-portal-library'
+export {
+  usePortalMovieSearch,
+  usePortalSeriesSearch,
+  usePortalTrendingMovies,
+  usePortalTrendingSeries,
+  useSeriesSeasons,
+} from './use-portal-search'
 export {
   requestKeys,
   useCancelRequest,
   useCreateRequest,
   usePortalDownloads,
   useRequest,
+  useRequestableSlots,
   useRequests,
   useUnwatchRequest,
   useWatchRequest,
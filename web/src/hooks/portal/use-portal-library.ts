@@ -6,6 +6,7 @@ export const portalLibraryKeys = {
   all: ['portalLibrary'] as const,
   movies: () => [...portalLibraryKeys.all, 'movies'] as const,
   series: () => [...portalLibraryKeys.all, 'series'] as const,
+  comingSoon: () => [...portalLibraryKeys.all, 'comingSoon'] as const,
 }
 
 export function usePortalLibraryMovies() {
@@ -23,3 +24,11 @@ export function usePortalLibrarySeries() {
     staleTime: 5 * 60 * 1000,
   })
 }
+
+export function usePortalComingSoon() {
+  return useQuery({
+    queryKey: portalLibraryKeys.comingSoon(),
+    queryFn: () => portalLibraryApi.getComingSoon(),
+    staleTime: 5 * 60 * 1000,
+  })
+}
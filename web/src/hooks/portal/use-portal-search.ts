@@ -8,6 +8,8 @@ const portalSearchKeys = {
   series: (query: string) => [...portalSearchKeys.all, 'series', query] as const,
   seasons: (tmdbId?: number, tvdbId?: number) =>
     [...portalSearchKeys.all, 'seasons', tmdbId, tvdbId] as const,
+  trending: (kind: 'movies' | 'series', includeLibrary: boolean) =>
+    [...portalSearchKeys.all, 'trending', kind, includeLibrary] as const,
 }
 
 export function usePortalMovieSearch(query: string) {
@@ -42,3 +44,21 @@ export function useSeriesSeasons(tmdbId?: number, tvdbId?: number) {
     staleTime: 10 * 60 * 1000, // 10 minutes
   })
 }
+
+export function usePortalTrendingMovies(includeLibrary = false) {
+  return useQuery({
+    queryKey: portalSearchKeys.trending('movies', includeLibrary),
+    queryFn: ({ signal }) => portalSearchApi.trendingMovies(includeLibrary, { signal }),
+    staleTime: 30 * 60 * 1000, // 30 minutes
+    refetchOnWindowFocus: false,
+  })
+}
+
+export function usePortalTrendingSeries(includeLibrary = false) {
+  return useQuery({
+    queryKey: portalSearchKeys.trending('series', includeLibrary),
+    queryFn: ({ signal }) => portalSearchApi.trendingSeries(includeLibrary, { signal }),
+    staleTime: 30 * 60 * 1000, // 30 minutes
+    refetchOnWindowFocus: false,
+  })
+}
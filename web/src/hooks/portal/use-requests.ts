@@ -39,6 +39,14 @@ export function useRequest(id: number) {
   })
 }
 
+export function useRequestableSlots() {
+  return useQuery({
+    queryKey: [...baseKeys.all, 'slots'] as const,
+    queryFn: () => portalRequestsApi.slots(),
+    staleTime: 5 * 60 * 1000, // 5 minutes
+  })
+}
+
 export function useCreateRequest() {
   const queryClient = useQueryClient()
 
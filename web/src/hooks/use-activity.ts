@@ -0,0 +1,17 @@
+import { useQuery } from '@tanstack/react-query'
+
+import { activityApi } from '@/api'
+import type { ActivityListOptions } from '@/types/activity'
+
+export const activityKeys = {
+  all: ['activity'] as const,
+  list: (options: ActivityListOptions) => [...activityKeys.all, 'list', options] as const,
+}
+
+export function useActivity(options: ActivityListOptions = {}) {
+  return useQuery({
+    queryKey: activityKeys.list(options),
+    queryFn: () => activityApi.list(options),
+    refetchInterval: 5000,
+  })
+}
@@ -0,0 +1,47 @@
+import { useMutation, useQuery, useQueryClient } from '@tanstack/react-query'
+
+import { backupApi } from '@/api/backup'
+
+export const backupKeys = {
+  all: ['backups'] as const,
+  list: () => [...backupKeys.all, 'list'] as const,
+}
+
+export function useBackups() {
+  return useQuery({
+    queryKey: backupKeys.list(),
+    queryFn: () => backupApi.list(),
+  })
+}
+
+export function useCreateBackup() {
+  const queryClient = useQueryClient()
+  return useMutation({
+    mutationFn: () => backupApi.create(),
+    onSuccess: () => {
+      void queryClient.invalidateQueries({ queryKey: backupKeys.all })
+    },
+  })
+}
+
+export function useDeleteBackup() {
+  const queryClient = useQueryClient()
+  return useMutation({
+    mutationFn: (name: string) => backupApi.delete(name),
+    onSuccess: () => {
+      void queryClient.invalidateQueries({ queryKey: backupKeys.all })
+    },
+  })
+}
+
+export function useRestoreBackup() {
+  return useMutation({
+    mutationFn: (name: string) => backupApi.restore(name),
+  })
+}
+
+export function useDownloadBackup() {
+  return useMutation({
+    mutationFn: (name: string) => backupApi.download(name),
+  })
+}
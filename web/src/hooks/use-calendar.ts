@@ -6,6 +6,7 @@ import type { CalendarRequest } from '@/types/calendar'
 export const calendarKeys = {
   all: ['calendar'] as const,
   events: (params: CalendarRequest) => [...calendarKeys.all, 'events', params] as const,
+  comingSoon: () => [...calendarKeys.all, 'comingSoon'] as const,
 }
 
 export function useCalendarEvents(params: CalendarRequest) {
@@ -15,3 +16,11 @@ export function useCalendarEvents(params: CalendarRequest) {
     enabled: !!params.start && !!params.end,
   })
 }
+
+export function useComingSoon() {
+  return useQuery({
+    queryKey: calendarKeys.comingSoon(),
+    queryFn: () => calendarApi.getComingSoon(),
+    refetchInterval: 60 * 1000,
+  })
+}
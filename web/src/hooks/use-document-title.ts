@@ -33,6 +33,7 @@ const ROUTE_TITLES: Record<string, string> = {
   '/system/health': 'System - Health',
   '/system/logs': 'System - Logs',
   '/system/tasks': 'System - Tasks',
+  '/system/backups': 'System - Backups',
   '/system/update': 'System - Update',
   '/dev/controls': 'Dev - Controls',
   '/requests/auth/login': 'Login',
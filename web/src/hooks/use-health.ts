@@ -7,6 +7,7 @@ export const systemHealthKeys = {
   all: ['systemHealth'] as const,
   list: () => [...systemHealthKeys.all, 'list'] as const,
   summary: () => [...systemHealthKeys.all, 'summary'] as const,
+  issues: (includeHidden: boolean) => [...systemHealthKeys.all, 'issues', includeHidden] as const,
 }
 
 // Fetch all health items
@@ -25,6 +26,61 @@ export function useSystemHealthSummary() {
   })
 }
 
+// Fetch active health issues
+export function useHealthIssues(includeHidden = false) {
+  return useQuery({
+    queryKey: systemHealthKeys.issues(includeHidden),
+    queryFn: () => healthApi.getIssues(includeHidden),
+  })
+}
+
+type IssueRef = { category: HealthCategory; id: string }
+
+// Dismiss an issue until it changes
+export function useDismissHealthIssue() {
+  const queryClient = useQueryClient()
+  return useMutation({
+    mutationFn: ({ category, id }: IssueRef) => healthApi.dismissIssue(category, id),
+    onSuccess: () => {
+      void queryClient.invalidateQueries({ queryKey: systemHealthKeys.all })
+    },
+  })
+}
+
+// Restore a dismissed issue
+export function useRestoreHealthIssue() {
+  const queryClient = useQueryClient()
+  return useMutation({
+    mutationFn: ({ category, id }: IssueRef) => healthApi.restoreIssue(category, id),
+    onSuccess: () => {
+      void queryClient.invalidateQueries({ queryKey: systemHealthKeys.all })
+    },
+  })
+}
+
+// Mute an issue for a number of hours
+export function useMuteHealthIssue() {
+  const queryClient = useQueryClient()
+  return useMutation({
+    mutationFn: ({ category, id, hours }: IssueRef & { hours: number }) =>
+      healthApi.muteIssue(category, id, hours),
+    onSuccess: () => {
+      void queryClient.invalidateQueries({ queryKey: systemHealthKeys.all })
+    },
+  })
+}
+
+// Remove a mute
+export function useUnmuteHealthIssue() {
+  const queryClient = useQueryClient()
+  return useMutation({
+    mutationFn: ({ category, id }: IssueRef) => healthApi.unmuteIssue(category, id),
+    onSuccess: () => {
+      void queryClient.invalidateQueries({ queryKey: systemHealthKeys.all })
+    },
+  })
+}
+
 // Test all items in a category
 export function useTestHealthCategory() {
   const queryClient = useQueryClient()
@@ -3,23 +3,51 @@ import { useMutation, useQuery, useQueryClient } from '@tanstack/react-query'
 import { apiFetch } from '@/api/client'
 import { createQueryKeys } from '@/lib/query-keys'
 import type {
+  BatchManualImportRequest,
+  BatchManualImportResponse,
+  DownloadCleanupSettings,
+  ExecuteRenameRequest,
+  FolderHint,
+  FolderHintInput,
+  ImportDryRunResult,
+  ImportExclusion,
+  ImportExclusionInput,
   ImportSettings,
   ManualImportRequest,
   ManualImportResponse,
+  MatchAccuracy,
   ParseFilenameResponse,
   PatternPreviewRequest,
   PatternPreviewResponse,
   PendingImport,
+  RecycleBinSettings,
+  RenameProgress,
   ScanDirectoryResponse,
+  UndoImportResult,
   UpdateImportSettingsRequest,
 } from '@/types'
 
+import { historyKeys } from './use-history'
+import { movieKeys } from './use-movies'
+import { seriesKeys } from './use-series'
+
 const baseKeys = createQueryKeys('import')
 const importKeys = {
   ...baseKeys,
   settings: () => [...baseKeys.all, 'settings'] as const,
   pending: () => [...baseKeys.all, 'pending'] as const,
   status: () => [...baseKeys.all, 'status'] as const,
+  matchAccuracy: (days: number) => [...baseKeys.all, 'match-accuracy', days] as const,
+  folderHints: () => [...baseKeys.all, 'folder-hints'] as const,
+  exclusions: () => [...baseKeys.all, 'exclusions'] as const,
+  recycleBin: () => [...baseKeys.all, 'recycle-bin'] as const,
+  downloadCleanup: () => [...baseKeys.all, 'download-cleanup'] as const,
+  dryRun: (clientId: number, downloadId: string) =>
+    [...baseKeys.all, 'dry-run', clientId, downloadId] as const,
+}
+
+export const renameKeys = {
+  jobs: () => [...baseKeys.all, 'rename-jobs'] as const,
 }
 
 // Settings hooks
@@ -64,6 +92,150 @@ export function usePendingImports() {
   })
 }
 
+export function useMatchAccuracy(days = 30) {
+  return useQuery<MatchAccuracy[]>({
+    queryKey: importKeys.matchAccuracy(days),
+    queryFn: () => apiFetch<MatchAccuracy[]>(`/import/match-accuracy?days=${days}`),
+  })
+}
+
+export function useImportDryRun(clientId: number, downloadId: string, enabled = true) {
+  return useQuery<ImportDryRunResult>({
+    queryKey: importKeys.dryRun(clientId, downloadId),
+    queryFn: () =>
+      apiFetch<ImportDryRunResult>(
+        `/import/downloads/${clientId}/${encodeURIComponent(downloadId)}/dry-run`,
+      ),
+    enabled: enabled && clientId > 0 && downloadId !== '',
+  })
+}
+
+export function useFolderHints() {
+  return useQuery<FolderHint[]>({
+    queryKey: importKeys.folderHints(),
+    queryFn: () => apiFetch<FolderHint[]>('/import/folder-hints'),
+  })
+}
+
+export function useSaveFolderHint() {
+  const queryClient = useQueryClient()
+
+  return useMutation<FolderHint, Error, FolderHintInput>({
+    mutationFn: (input) =>
+      apiFetch<FolderHint>('/import/folder-hints', {
+        method: 'PUT',
+        body: JSON.stringify(input),
+      }),
+    onSuccess: () => {
+      void queryClient.invalidateQueries({ queryKey: importKeys.folderHints() })
+    },
+  })
+}
+
+export function useDeleteFolderHint() {
+  const queryClient = useQueryClient()
+
+  return useMutation<void, Error, number>({
+    mutationFn: (id) => apiFetch<void>(`/import/folder-hints/${id}`, { method: 'DELETE' }),
+    onSuccess: () => {
+      void queryClient.invalidateQueries({ queryKey: importKeys.folderHints() })
+    },
+  })
+}
+
+export function useImportExclusions() {
+  return useQuery<ImportExclusion[]>({
+    queryKey: importKeys.exclusions(),
+    queryFn: () => apiFetch<ImportExclusion[]>('/import/exclusions'),
+  })
+}
+
+export function useAddImportExclusion() {
+  const queryClient = useQueryClient()
+
+  return useMutation<ImportExclusion, Error, ImportExclusionInput>({
+    mutationFn: (input) =>
+      apiFetch<ImportExclusion>('/import/exclusions', {
+        method: 'POST',
+        body: JSON.stringify(input),
+      }),
+    onSuccess: () => {
+      void queryClient.invalidateQueries({ queryKey: importKeys.exclusions() })
+    },
+  })
+}
+
+export function useDeleteImportExclusion() {
+  const queryClient = useQueryClient()
+
+  return useMutation<void, Error, number>({
+    mutationFn: (id) => apiFetch<void>(`/import/exclusions/${id}`, { method: 'DELETE' }),
+    onSuccess: () => {
+      void queryClient.invalidateQueries({ queryKey: importKeys.exclusions() })
+    },
+  })
+}
+
+export function useRecycleBin() {
+  return useQuery<RecycleBinSettings>({
+    queryKey: importKeys.recycleBin(),
+    queryFn: () => apiFetch<RecycleBinSettings>('/import/recycle-bin'),
+  })
+}
+
+export function useUpdateRecycleBin() {
+  const queryClient = useQueryClient()
+
+  return useMutation<RecycleBinSettings, Error, RecycleBinSettings>({
+    mutationFn: (settings) =>
+      apiFetch<RecycleBinSettings>('/import/recycle-bin', {
+        method: 'PUT',
+        body: JSON.stringify(settings),
+      }),
+    onSuccess: (data) => {
+      queryClient.setQueryData(importKeys.recycleBin(), data)
+    },
+  })
+}
+
+export function useDownloadCleanup() {
+  return useQuery<DownloadCleanupSettings>({
+    queryKey: importKeys.downloadCleanup(),
+    queryFn: () => apiFetch<DownloadCleanupSettings>('/import/download-cleanup'),
+  })
+}
+
+export function useUpdateDownloadCleanup() {
+  const queryClient = useQueryClient()
+
+  return useMutation<DownloadCleanupSettings, Error, DownloadCleanupSettings>({
+    mutationFn: (settings) =>
+      apiFetch<DownloadCleanupSettings>('/import/download-cleanup', {
+        method: 'PUT',
+        body: JSON.stringify(settings),
+      }),
+    onSuccess: (data) => {
+      queryClient.setQueryData(importKeys.downloadCleanup(), data)
+    },
+  })
+}
+
+// Undo an import recorded in history
+export function useUndoImport() {
+  const queryClient = useQueryClient()
+
+  return useMutation<UndoImportResult, Error, number>({
+    mutationFn: (historyId) =>
+      apiFetch<UndoImportResult>(`/import/history/${historyId}/undo`, { method: 'POST' }),
+    onSuccess: () => {
+      void queryClient.invalidateQueries({ queryKey: historyKeys.all })
+      void queryClient.invalidateQueries({ queryKey: movieKeys.all })
+      void queryClient.invalidateQueries({ queryKey: seriesKeys.all })
+      void queryClient.invalidateQueries({ queryKey: [...baseKeys.all, 'match-accuracy'] })
+    },
+  })
+}
+
 // Manual import hooks
 export function useManualImport() {
   const queryClient = useQueryClient()
@@ -77,6 +249,24 @@ export function useManualImport() {
     onSuccess: () => {
       void queryClient.invalidateQueries({ queryKey: importKeys.pending() })
       void queryClient.invalidateQueries({ queryKey: importKeys.status() })
+      void queryClient.invalidateQueries({ queryKey: [...baseKeys.all, 'match-accuracy'] })
+    },
+  })
+}
+
+export function useBatchManualImport() {
+  const queryClient = useQueryClient()
+
+  return useMutation<BatchManualImportResponse, Error, BatchManualImportRequest>({
+    mutationFn: (req) =>
+      apiFetch<BatchManualImportResponse>('/import/manual/batch', {
+        method: 'POST',
+        body: JSON.stringify(req),
+      }),
+    onSuccess: () => {
+      void queryClient.invalidateQueries({ queryKey: importKeys.pending() })
+      void queryClient.invalidateQueries({ queryKey: importKeys.status() })
+      void queryClient.invalidateQueries({ queryKey: [...baseKeys.all, 'match-accuracy'] })
     },
   })
 }
@@ -118,3 +308,36 @@ export function useParseFilename() {
       }),
   })
 }
+
+export function useRenameJobs() {
+  return useQuery<RenameProgress[]>({
+    queryKey: renameKeys.jobs(),
+    queryFn: () => apiFetch<RenameProgress[]>('/import/rename/jobs'),
+  })
+}
+
+export function useExecuteRename() {
+  const queryClient = useQueryClient()
+
+  return useMutation<RenameProgress, Error, ExecuteRenameRequest>({
+    mutationFn: (input) =>
+      apiFetch<RenameProgress>('/import/rename/execute', {
+        method: 'POST',
+        body: JSON.stringify(input),
+      }),
+    onSuccess: () => {
+      void queryClient.invalidateQueries({ queryKey: renameKeys.jobs() })
+    },
+  })
+}
+
+export function useCancelRename() {
+  const queryClient = useQueryClient()
+
+  return useMutation<undefined, Error, string>({
+    mutationFn: (id) => apiFetch<undefined>(`/import/rename/jobs/${id}`, { method: 'DELETE' }),
+    onSuccess: () => {
+      void queryClient.invalidateQueries({ queryKey: renameKeys.jobs() })
+    },
+  })
+}
@@ -5,6 +5,8 @@ import { createQueryKeys } from '@/lib/query-keys'
 import type {
   CreateIndexerInput,
   Indexer,
+  IndexerGrabCap,
+  IndexerSeedGoal,
   TestConfigInput,
   UpdateIndexerInput,
 } from '@/types'
@@ -26,6 +28,69 @@ export function useIndexers() {
   })
 }
 
+export function useIndexerStats(days = 7) {
+  return useQuery({
+    queryKey: [...indexerKeys.all, 'stats', days] as const,
+    queryFn: () => indexersApi.getStats(days),
+  })
+}
+
+export function useIndexerQuarantine(id: number) {
+  return useQuery({
+    queryKey: [...indexerKeys.detail(id), 'quarantine'] as const,
+    queryFn: () => indexersApi.listQuarantine(id),
+    enabled: !!id,
+  })
+}
+
+export function useClearIndexerQuarantine() {
+  const queryClient = useQueryClient()
+  return useMutation({
+    mutationFn: (id: number) => indexersApi.clearQuarantine(id),
+    onSuccess: () => {
+      void queryClient.invalidateQueries({ queryKey: indexerKeys.all })
+    },
+  })
+}
+
+export function useIndexerSeedGoal(id: number) {
+  return useQuery({
+    queryKey: [...indexerKeys.detail(id), 'seed-goal'] as const,
+    queryFn: () => indexersApi.getSeedGoal(id),
+    enabled: !!id,
+  })
+}
+
+export function useUpdateIndexerSeedGoal() {
+  const queryClient = useQueryClient()
+  return useMutation({
+    mutationFn: ({ id, data }: { id: number; data: Omit<IndexerSeedGoal, 'indexerId'> }) =>
+      indexersApi.updateSeedGoal(id, data),
+    onSuccess: (goal) => {
+      queryClient.setQueryData([...indexerKeys.detail(goal.indexerId), 'seed-goal'], goal)
+    },
+  })
+}
+
+export function useIndexerGrabCap(id: number) {
+  return useQuery({
+    queryKey: [...indexerKeys.detail(id), 'grab-cap'] as const,
+    queryFn: () => indexersApi.getGrabCap(id),
+    enabled: !!id,
+  })
+}
+
+export function useUpdateIndexerGrabCap() {
+  const queryClient = useQueryClient()
+  return useMutation({
+    mutationFn: ({ id, data }: { id: number; data: Omit<IndexerGrabCap, 'indexerId'> }) =>
+      indexersApi.updateGrabCap(id, data),
+    onSuccess: (grabCap) => {
+      queryClient.setQueryData([...indexerKeys.detail(grabCap.indexerId), 'grab-cap'], grabCap)
+    },
+  })
+}
+
 export function useCreateIndexer() {
   const queryClient = useQueryClient()
   return useMutation({
@@ -1,13 +1,15 @@
 import { useEffect } from 'react'
 
-import { useMutation, useQuery } from '@tanstack/react-query'
+import { useMutation, useQuery, useQueryClient } from '@tanstack/react-query'
 
 import { logsApi } from '@/api/logs'
 import { useLogsStore } from '@/stores/logs'
+import type { LogLevels } from '@/types/logs'
 
 const logsKeys = {
   all: ['logs'] as const,
   recent: () => [...logsKeys.all, 'recent'] as const,
+  levels: () => [...logsKeys.all, 'levels'] as const,
 }
 
 export function useLogs() {
@@ -34,3 +36,20 @@ export function useDownloadLogFile() {
     mutationFn: () => logsApi.downloadLogFile(),
   })
 }
+
+export function useLogLevels() {
+  return useQuery({
+    queryKey: logsKeys.levels(),
+    queryFn: () => logsApi.getLevels(),
+  })
+}
+
+export function useUpdateLogLevels() {
+  const queryClient = useQueryClient()
+  return useMutation({
+    mutationFn: (data: LogLevels) => logsApi.updateLevels(data),
+    onSuccess: (data) => {
+      queryClient.setQueryData(logsKeys.levels(), data)
+    },
+  })
+}
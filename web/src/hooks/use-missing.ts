@@ -1,6 +1,7 @@
 import { useQuery } from '@tanstack/react-query'
 
 import { missingApi } from '@/api'
+import type { WantedOptions } from '@/types'
 
 export const missingKeys = {
   all: ['missing'] as const,
@@ -9,6 +10,8 @@ export const missingKeys = {
   counts: () => [...missingKeys.all, 'counts'] as const,
   upgradableMovies: () => [...missingKeys.all, 'upgradable-movies'] as const,
   upgradableSeries: () => [...missingKeys.all, 'upgradable-series'] as const,
+  wantedMissing: (options?: WantedOptions) => [...missingKeys.all, 'wanted-missing', options] as const,
+  wantedCutoff: (options?: WantedOptions) => [...missingKeys.all, 'wanted-cutoff', options] as const,
 }
 
 export function useMissingMovies() {
@@ -46,3 +49,17 @@ export function useUpgradableSeries() {
   })
 }
 
+
+export function useWantedMissing(options?: WantedOptions) {
+  return useQuery({
+    queryKey: missingKeys.wantedMissing(options),
+    queryFn: () => missingApi.getWantedMissing(options),
+  })
+}
+
+export function useWantedCutoff(options?: WantedOptions) {
+  return useQuery({
+    queryKey: missingKeys.wantedCutoff(options),
+    queryFn: () => missingApi.getWantedCutoff(options),
+  })
+}
@@ -42,8 +42,8 @@ function createCrudHooks(keys: ModuleQueryKeys, api: ModuleApi) {
   function useDelete() {
     const queryClient = useQueryClient()
     return useMutation({
-      mutationFn: ({ id, deleteFiles }: { id: number; deleteFiles?: boolean }) =>
-        api.delete(id, deleteFiles),
+      mutationFn: ({ id, deleteFiles, addExclusion }: { id: number; deleteFiles?: boolean; addExclusion?: boolean }) =>
+        api.delete(id, deleteFiles, addExclusion),
       onSuccess: () => {
         void queryClient.invalidateQueries({ queryKey: keys.all })
         void queryClient.invalidateQueries({ queryKey: calendarKeys.all })
@@ -58,8 +58,8 @@ function createBulkHooks(keys: ModuleQueryKeys, api: ModuleApi) {
   function useBulkDelete() {
     const queryClient = useQueryClient()
     return useMutation({
-      mutationFn: ({ ids, deleteFiles }: { ids: number[]; deleteFiles?: boolean }) =>
-        api.bulkDelete(ids, deleteFiles),
+      mutationFn: ({ ids, deleteFiles, addExclusion }: { ids: number[]; deleteFiles?: boolean; addExclusion?: boolean }) =>
+        api.bulkDelete(ids, deleteFiles, addExclusion),
       onSuccess: () => {
         void queryClient.invalidateQueries({ queryKey: keys.all })
         void queryClient.invalidateQueries({ queryKey: calendarKeys.all })
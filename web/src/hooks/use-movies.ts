@@ -6,6 +6,7 @@ import type {
   AddMovieInput,
   ListMoviesOptions,
   Movie,
+  MoveFolderInput,
   UpdateMovieInput,
 } from '@/types'
 
@@ -62,8 +63,8 @@ export function useUpdateMovie() {
 export function useDeleteMovie() {
   const queryClient = useQueryClient()
   return useMutation({
-    mutationFn: ({ id, deleteFiles }: { id: number; deleteFiles?: boolean }) =>
-      moviesApi.delete(id, deleteFiles),
+    mutationFn: ({ id, deleteFiles, addExclusion }: { id: number; deleteFiles?: boolean; addExclusion?: boolean }) =>
+      moviesApi.delete(id, deleteFiles, addExclusion),
     onSuccess: () => {
       void queryClient.invalidateQueries({ queryKey: movieKeys.all })
       void queryClient.invalidateQueries({ queryKey: calendarKeys.all })
@@ -74,8 +75,8 @@ export function useDeleteMovie() {
 export function useBulkDeleteMovies() {
   const queryClient = useQueryClient()
   return useMutation({
-    mutationFn: ({ ids, deleteFiles }: { ids: number[]; deleteFiles?: boolean }) =>
-      moviesApi.bulkDelete(ids, deleteFiles),
+    mutationFn: ({ ids, deleteFiles, addExclusion }: { ids: number[]; deleteFiles?: boolean; addExclusion?: boolean }) =>
+      moviesApi.bulkDelete(ids, deleteFiles, addExclusion),
     onSuccess: () => {
       void queryClient.invalidateQueries({ queryKey: movieKeys.all })
       void queryClient.invalidateQueries({ queryKey: calendarKeys.all })
@@ -123,6 +124,12 @@ export function useRefreshMovie() {
   })
 }
 
+export function useMoveMovie() {
+  return useMutation({
+    mutationFn: ({ id, input }: { id: number; input: MoveFolderInput }) => moviesApi.move(id, input),
+  })
+}
+
 export function useRefreshAllMovies() {
   const queryClient = useQueryClient()
   return useMutation({
@@ -5,6 +5,7 @@ import { indexerKeys } from '@/hooks/use-indexers'
 import type {
   ProwlarrConfigInput,
   ProwlarrIndexerSettingsInput,
+  ProwlarrIndexerSyncInput,
   ProwlarrTestInput,
   SetModeInput,
 } from '@/types'
@@ -18,6 +19,7 @@ const prowlarrKeys = {
   capabilities: () => [...prowlarrKeys.all, 'capabilities'] as const,
   status: () => [...prowlarrKeys.all, 'status'] as const,
   mode: () => [...prowlarrKeys.all, 'mode'] as const,
+  sync: () => [...prowlarrKeys.all, 'sync'] as const,
 }
 
 // Configuration hooks
@@ -116,3 +118,32 @@ export function useResetProwlarrIndexerStats() {
     },
   })
 }
+
+// Indexer sync hooks
+export function useProwlarrSyncStatus() {
+  return useQuery({
+    queryKey: prowlarrKeys.sync(),
+    queryFn: () => prowlarrApi.getSyncStatus(),
+  })
+}
+
+export function useUpdateProwlarrSync() {
+  const queryClient = useQueryClient()
+  return useMutation({
+    mutationFn: (data: ProwlarrIndexerSyncInput) => prowlarrApi.updateSync(data),
+    onSuccess: () => {
+      void queryClient.invalidateQueries({ queryKey: prowlarrKeys.sync() })
+    },
+  })
+}
+
+export function useRunProwlarrSync() {
+  const queryClient = useQueryClient()
+  return useMutation({
+    mutationFn: () => prowlarrApi.runSync(),
+    onSettled: () => {
+      void queryClient.invalidateQueries({ queryKey: prowlarrKeys.sync() })
+      void queryClient.invalidateQueries({ queryKey: indexerKeys.all })
+    },
+  })
+}
@@ -2,12 +2,18 @@ import { useMutation, useQuery, useQueryClient } from '@tanstack/react-query'
 
 import { qualityProfilesApi } from '@/api'
 import { createQueryKeys } from '@/lib/query-keys'
-import type { CreateQualityProfileInput, QualityProfile, UpdateQualityProfileInput } from '@/types'
+import type {
+  CreateQualityProfileInput,
+  QualityInput,
+  QualityProfile,
+  UpdateQualityProfileInput,
+} from '@/types'
 
 const baseKeys = createQueryKeys('qualityProfiles')
 const qualityProfileKeys = {
   ...baseKeys,
   attributes: () => [...baseKeys.all, 'attributes'] as const,
+  qualities: () => [...baseKeys.all, 'qualities'] as const,
 }
 
 export function useQualityProfiles(moduleType?: string) {
@@ -59,3 +65,42 @@ export function useQualityProfileAttributes() {
   })
 }
 
+
+export function useQualities() {
+  return useQuery({
+    queryKey: qualityProfileKeys.qualities(),
+    queryFn: () => qualityProfilesApi.listQualities(),
+  })
+}
+
+// Quality changes are reflected in every profile's items, so invalidate profiles too.
+export function useCreateQuality() {
+  const queryClient = useQueryClient()
+  return useMutation({
+    mutationFn: (data: QualityInput) => qualityProfilesApi.createQuality(data),
+    onSuccess: () => {
+      void queryClient.invalidateQueries({ queryKey: qualityProfileKeys.all })
+    },
+  })
+}
+
+export function useUpdateQuality() {
+  const queryClient = useQueryClient()
+  return useMutation({
+    mutationFn: ({ id, data }: { id: number; data: QualityInput }) =>
+      qualityProfilesApi.updateQuality(id, data),
+    onSuccess: () => {
+      void queryClient.invalidateQueries({ queryKey: qualityProfileKeys.all })
+    },
+  })
+}
+
+export function useDeleteQuality() {
+  const queryClient = useQueryClient()
+  return useMutation({
+    mutationFn: (id: number) => qualityProfilesApi.deleteQuality(id),
+    onSuccess: () => {
+      void queryClient.invalidateQueries({ queryKey: qualityProfileKeys.all })
+    },
+  })
+}
@@ -4,11 +4,15 @@ import { useMutation, useQuery, useQueryClient } from '@tanstack/react-query'
 
 import { queueApi } from '@/api'
 import { usePortalDownloadsStore } from '@/stores'
-import type { QueueItem } from '@/types/queue'
+import type { QueueItem, RemapDownloadInput, StalledDownloadSettings } from '@/types/queue'
+
+import { movieKeys } from './use-movies'
+import { seriesKeys } from './use-series'
 
 export const queueKeys = {
   all: ['queue'] as const,
   list: () => [...queueKeys.all, 'list'] as const,
+  stalledSettings: () => [...queueKeys.all, 'stalledSettings'] as const,
 }
 
 const WS_TIMEOUT_MS = 10_000
@@ -114,3 +118,43 @@ export function useFastForwardQueueItem() {
     },
   })
 }
+
+export function useRemapQueueItem() {
+  const queryClient = useQueryClient()
+  return useMutation({
+    mutationFn: ({ id, input }: { id: string; input: RemapDownloadInput }) =>
+      queueApi.remap(id, input),
+    onSuccess: () => {
+      void queryClient.invalidateQueries({ queryKey: queueKeys.all })
+      void queryClient.invalidateQueries({ queryKey: movieKeys.all })
+      void queryClient.invalidateQueries({ queryKey: seriesKeys.all })
+    },
+  })
+}
+
+export function useStalledDownloadSettings() {
+  return useQuery({
+    queryKey: queueKeys.stalledSettings(),
+    queryFn: () => queueApi.getStalledSettings(),
+  })
+}
+
+export function useUpdateStalledDownloadSettings() {
+  const queryClient = useQueryClient()
+  return useMutation({
+    mutationFn: (settings: StalledDownloadSettings) => queueApi.updateStalledSettings(settings),
+    onSuccess: (data) => {
+      queryClient.setQueryData(queueKeys.stalledSettings(), data)
+    },
+  })
+}
+
+export function useCheckStalledDownloads() {
+  const queryClient = useQueryClient()
+  return useMutation({
+    mutationFn: () => queueApi.checkStalled(),
+    onSuccess: () => {
+      void queryClient.invalidateQueries({ queryKey: queueKeys.list() })
+    },
+  })
+}
@@ -1,14 +1,24 @@
 import { useMutation, useQuery, useQueryClient } from '@tanstack/react-query'
 
-import { rootFoldersApi } from '@/api'
+import { libraryApi, rootFoldersApi } from '@/api'
 import { defaultsKeys } from '@/hooks/use-defaults'
+import { movieKeys } from '@/hooks/use-movies'
+import { seriesKeys } from '@/hooks/use-series'
 import { createQueryKeys } from '@/lib/query-keys'
-import type { CreateRootFolderInput } from '@/types'
+import type {
+  CreateRootFolderInput,
+  LibraryImportInput,
+  OrphanFixInput,
+  QualityAuditSearchInput,
+} from '@/types'
 
 const baseKeys = createQueryKeys('rootFolders')
 const rootFolderKeys = {
   ...baseKeys,
   listByType: (mediaType: 'movie' | 'tv') => [...baseKeys.list(), mediaType] as const,
+  importPreview: (id: number) => [...baseKeys.detail(id), 'import'] as const,
+  orphans: () => [...baseKeys.all, 'orphans'] as const,
+  qualityAudit: () => [...baseKeys.all, 'qualityAudit'] as const,
 }
 
 export function useRootFolders() {
@@ -46,3 +56,103 @@ export function useDeleteRootFolder() {
   })
 }
 
+export function useRefreshRootFolder() {
+  const queryClient = useQueryClient()
+  return useMutation({
+    mutationFn: (id: number) => rootFoldersApi.refresh(id),
+    onSuccess: () => {
+      void queryClient.invalidateQueries({ queryKey: rootFolderKeys.all })
+    },
+  })
+}
+
+export function useSetDefaultRootFolder() {
+  const queryClient = useQueryClient()
+  return useMutation({
+    mutationFn: (id: number) => rootFoldersApi.setDefault(id),
+    onSuccess: () => {
+      void queryClient.invalidateQueries({ queryKey: rootFolderKeys.all })
+      void queryClient.invalidateQueries({ queryKey: defaultsKeys.all })
+    },
+  })
+}
+
+export function useLibraryImportPreview(id: number, enabled = true) {
+  return useQuery({
+    queryKey: rootFolderKeys.importPreview(id),
+    queryFn: () => rootFoldersApi.previewImport(id),
+    enabled: enabled && id > 0,
+    staleTime: Infinity,
+  })
+}
+
+export function useImportLibrary() {
+  const queryClient = useQueryClient()
+  return useMutation({
+    mutationFn: ({ id, data }: { id: number; data: LibraryImportInput }) =>
+      rootFoldersApi.import(id, data),
+    onSuccess: (_results, { id }) => {
+      void queryClient.invalidateQueries({ queryKey: rootFolderKeys.importPreview(id) })
+      void queryClient.invalidateQueries({ queryKey: movieKeys.all })
+      void queryClient.invalidateQueries({ queryKey: seriesKeys.all })
+    },
+  })
+}
+
+export function useOrphanReport() {
+  return useQuery({
+    queryKey: rootFolderKeys.orphans(),
+    queryFn: () => libraryApi.getOrphanReport(),
+  })
+}
+
+export function useRebuildOrphanReport() {
+  const queryClient = useQueryClient()
+  return useMutation({
+    mutationFn: () => libraryApi.rebuildOrphanReport(),
+    onSuccess: (report) => {
+      queryClient.setQueryData(rootFolderKeys.orphans(), report)
+    },
+  })
+}
+
+export function useFixOrphan() {
+  const queryClient = useQueryClient()
+  return useMutation({
+    mutationFn: (data: OrphanFixInput) => libraryApi.fixOrphan(data),
+    onSuccess: () => {
+      void queryClient.invalidateQueries({ queryKey: rootFolderKeys.orphans() })
+      void queryClient.invalidateQueries({ queryKey: movieKeys.all })
+      void queryClient.invalidateQueries({ queryKey: seriesKeys.all })
+    },
+  })
+}
+
+export function useQualityAuditReport() {
+  return useQuery({
+    queryKey: rootFolderKeys.qualityAudit(),
+    queryFn: () => libraryApi.getQualityAuditReport(),
+  })
+}
+
+export function useRebuildQualityAuditReport() {
+  const queryClient = useQueryClient()
+  return useMutation({
+    mutationFn: () => libraryApi.rebuildQualityAuditReport(),
+    onSuccess: (report) => {
+      queryClient.setQueryData(rootFolderKeys.qualityAudit(), report)
+    },
+  })
+}
+
+export function useSearchAuditedFile() {
+  const queryClient = useQueryClient()
+  return useMutation({
+    mutationFn: (data: QualityAuditSearchInput) => libraryApi.searchAuditedFile(data),
+    onSuccess: () => {
+      void queryClient.invalidateQueries({ queryKey: rootFolderKeys.qualityAudit() })
+      void queryClient.invalidateQueries({ queryKey: movieKeys.all })
+      void queryClient.invalidateQueries({ queryKey: seriesKeys.all })
+    },
+  })
+}
@@ -2,7 +2,7 @@ import { useMutation, useQuery, useQueryClient } from '@tanstack/react-query'
 
 import { searchApi } from '@/api/search'
 import { queueKeys } from '@/hooks/use-queue'
-import type { GrabRequest, ScoredSearchCriteria } from '@/types'
+import type { CrossSeedSettings, GrabRequest, ScoredSearchCriteria } from '@/types'
 
 // Query keys
 const searchKeys = {
@@ -11,6 +11,9 @@ const searchKeys = {
   tvResults: (criteria: ScoredSearchCriteria) => [...searchKeys.all, 'tv', criteria] as const,
   grabHistory: (limit?: number, offset?: number) =>
     [...searchKeys.all, 'history', { limit, offset }] as const,
+  pendingGrabs: () => [...searchKeys.all, 'pending'] as const,
+  spaceForecast: () => [...searchKeys.all, 'spaceForecast'] as const,
+  crossSeed: () => [...searchKeys.all, 'crossSeed'] as const,
 }
 
 // Movie search hook with scoring (searches indexers for movie releases)
@@ -56,4 +59,69 @@ export function useGrab() {
   })
 }
 
+// Automatic grabs held for approval
+export function usePendingGrabs() {
+  return useQuery({
+    queryKey: searchKeys.pendingGrabs(),
+    queryFn: () => searchApi.getPendingGrabs(),
+  })
+}
+
+export function useApprovePendingGrab() {
+  const queryClient = useQueryClient()
+
+  return useMutation({
+    mutationFn: (id: number) => searchApi.approvePendingGrab(id),
+    onSuccess: () => {
+      void queryClient.invalidateQueries({ queryKey: searchKeys.pendingGrabs() })
+      void queryClient.invalidateQueries({ queryKey: queueKeys.all })
+      void queryClient.invalidateQueries({ queryKey: searchKeys.grabHistory() })
+    },
+  })
+}
+
+export function useRejectPendingGrab() {
+  const queryClient = useQueryClient()
+
+  return useMutation({
+    mutationFn: (id: number) => searchApi.rejectPendingGrab(id),
+    onSuccess: () => {
+      void queryClient.invalidateQueries({ queryKey: searchKeys.pendingGrabs() })
+    },
+  })
+}
+
+// Projected disk usage of queued and missing items per volume
+export function useSpaceForecast() {
+  return useQuery({
+    queryKey: searchKeys.spaceForecast(),
+    queryFn: () => searchApi.getSpaceForecast(),
+  })
+}
+
+export function useCrossSeedSettings() {
+  return useQuery({
+    queryKey: searchKeys.crossSeed(),
+    queryFn: () => searchApi.getCrossSeedSettings(),
+  })
+}
+
+export function useUpdateCrossSeedSettings() {
+  const queryClient = useQueryClient()
+
+  return useMutation({
+    mutationFn: (settings: CrossSeedSettings) => searchApi.updateCrossSeedSettings(settings),
+    onSuccess: (data) => {
+      queryClient.setQueryData(searchKeys.crossSeed(), data)
+    },
+  })
+}
+
+export function useCrossSeedDownload() {
+  return useMutation({
+    mutationFn: ({ clientId, downloadId }: { clientId: number; downloadId: string }) =>
+      searchApi.crossSeedDownload(clientId, downloadId),
+  })
+}
+
 // Note: Indexer status hooks are now in useIndexers.ts
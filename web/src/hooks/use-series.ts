@@ -5,6 +5,7 @@ import { createQueryKeys } from '@/lib/query-keys'
 import type {
   AddSeriesInput,
   ListSeriesOptions,
+  MoveFolderInput,
   Series,
   UpdateSeriesInput,
 } from '@/types'
@@ -19,6 +20,8 @@ export const seriesKeys = {
   seasons: (seriesId: number) => [...baseKeys.detail(seriesId), 'seasons'] as const,
   episodes: (seriesId: number, seasonNumber?: number) =>
     [...baseKeys.detail(seriesId), 'episodes', seasonNumber] as const,
+  completeness: (seriesId: number) => [...baseKeys.detail(seriesId), 'completeness'] as const,
+  gaps: () => [...baseKeys.all, 'gaps'] as const,
 }
 
 export function useSeries(options?: ListSeriesOptions) {
@@ -38,6 +41,21 @@ export function useSeriesDetail(id: number) {
   return useQuery(seriesQueryOptions(id))
 }
 
+export function useSeriesCompleteness(id: number) {
+  return useQuery({
+    queryKey: seriesKeys.completeness(id),
+    queryFn: () => seriesApi.getCompleteness(id),
+    enabled: !!id,
+  })
+}
+
+export function useSeriesGaps() {
+  return useQuery({
+    queryKey: seriesKeys.gaps(),
+    queryFn: () => seriesApi.listGaps(),
+  })
+}
+
 export function useAddSeries() {
   const queryClient = useQueryClient()
   return useMutation({
@@ -65,8 +83,8 @@ export function useUpdateSeries() {
 export function useDeleteSeries() {
   const queryClient = useQueryClient()
   return useMutation({
-    mutationFn: ({ id, deleteFiles }: { id: number; deleteFiles?: boolean }) =>
-      seriesApi.delete(id, deleteFiles),
+    mutationFn: ({ id, deleteFiles, addExclusion }: { id: number; deleteFiles?: boolean; addExclusion?: boolean }) =>
+      seriesApi.delete(id, deleteFiles, addExclusion),
     onSuccess: () => {
       void queryClient.invalidateQueries({ queryKey: seriesKeys.all })
       void queryClient.invalidateQueries({ queryKey: calendarKeys.all })
@@ -77,8 +95,8 @@ export function useDeleteSeries() {
 export function useBulkDeleteSeries() {
   const queryClient = useQueryClient()
   return useMutation({
-    mutationFn: ({ ids, deleteFiles }: { ids: number[]; deleteFiles?: boolean }) =>
-      seriesApi.bulkDelete(ids, deleteFiles),
+    mutationFn: ({ ids, deleteFiles, addExclusion }: { ids: number[]; deleteFiles?: boolean; addExclusion?: boolean }) =>
+      seriesApi.bulkDelete(ids, deleteFiles, addExclusion),
     onSuccess: () => {
       void queryClient.invalidateQueries({ queryKey: seriesKeys.all })
       void queryClient.invalidateQueries({ queryKey: calendarKeys.all })
@@ -110,6 +128,12 @@ export function useBulkUpdateSeries() {
   })
 }
 
+export function useMoveSeries() {
+  return useMutation({
+    mutationFn: ({ id, input }: { id: number; input: MoveFolderInput }) => seriesApi.move(id, input),
+  })
+}
+
 export function useRefreshSeries() {
   const queryClient = useQueryClient()
   return useMutation({
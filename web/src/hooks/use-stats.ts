@@ -0,0 +1,15 @@
+import { useQuery } from '@tanstack/react-query'
+
+import { statsApi } from '@/api'
+
+export const statsKeys = {
+  all: ['stats'] as const,
+  window: (days: number) => [...statsKeys.all, days] as const,
+}
+
+export function useLibraryStats(days = 30) {
+  return useQuery({
+    queryKey: statsKeys.window(days),
+    queryFn: () => statsApi.get(days),
+  })
+}
@@ -2,13 +2,14 @@ import { useMutation, useQuery, useQueryClient } from '@tanstack/react-query'
 
 import { systemApi } from '@/api'
 import { setModuleEnabledState } from '@/modules'
-import type { UpdateSettingsInput } from '@/types'
+import type { UpdateHostSettingsInput, UpdateSettingsInput } from '@/types'
 
 export const systemKeys = {
   all: ['system'] as const,
   status: () => [...systemKeys.all, 'status'] as const,
   settings: () => [...systemKeys.all, 'settings'] as const,
   firewall: () => [...systemKeys.all, 'firewall'] as const,
+  hostSettings: () => [...systemKeys.all, 'hostSettings'] as const,
 }
 
 export function useStatus() {
@@ -91,3 +92,21 @@ export function useUpdateModuleEnabled() {
     },
   })
 }
+
+export function useHostSettings() {
+  return useQuery({
+    queryKey: systemKeys.hostSettings(),
+    queryFn: () => systemApi.getHostSettings(),
+  })
+}
+
+export function useUpdateHostSettings() {
+  const queryClient = useQueryClient()
+  return useMutation({
+    mutationFn: (data: UpdateHostSettingsInput) => systemApi.updateHostSettings(data),
+    onSuccess: (data) => {
+      queryClient.setQueryData(systemKeys.hostSettings(), data)
+      void queryClient.invalidateQueries({ queryKey: systemKeys.settings() })
+    },
+  })
+}
@@ -0,0 +1,55 @@
+import { useMutation, useQuery, useQueryClient } from '@tanstack/react-query'
+
+import { tagsApi } from '@/api'
+import { createQueryKeys } from '@/lib/query-keys'
+import type { TagInput } from '@/types'
+
+const baseKeys = createQueryKeys('tags')
+export const tagKeys = {
+  ...baseKeys,
+  usage: () => [...baseKeys.all, 'usage'] as const,
+}
+
+export function useTags() {
+  return useQuery({
+    queryKey: tagKeys.list(),
+    queryFn: () => tagsApi.list(),
+  })
+}
+
+export function useTagDetails() {
+  return useQuery({
+    queryKey: tagKeys.usage(),
+    queryFn: () => tagsApi.listDetail(),
+  })
+}
+
+export function useCreateTag() {
+  const queryClient = useQueryClient()
+  return useMutation({
+    mutationFn: (data: TagInput) => tagsApi.create(data),
+    onSuccess: () => {
+      void queryClient.invalidateQueries({ queryKey: tagKeys.all })
+    },
+  })
+}
+
+export function useUpdateTag() {
+  const queryClient = useQueryClient()
+  return useMutation({
+    mutationFn: ({ id, data }: { id: number; data: TagInput }) => tagsApi.update(id, data),
+    onSuccess: () => {
+      void queryClient.invalidateQueries({ queryKey: tagKeys.all })
+    },
+  })
+}
+
+export function useDeleteTag() {
+  const queryClient = useQueryClient()
+  return useMutation({
+    mutationFn: (id: number) => tagsApi.delete(id),
+    onSuccess: () => {
+      void queryClient.invalidateQueries({ queryKey: tagKeys.all })
+    },
+  })
+}
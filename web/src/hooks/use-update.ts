@@ -1,10 +1,12 @@
 import { useMutation, useQuery, useQueryClient } from '@tanstack/react-query'
 
 import { updateApi } from '@/api'
+import type { UpdateSettings } from '@/types/update'
 
 const updateKeys = {
   all: ['update'] as const,
   status: () => [...updateKeys.all, 'status'] as const,
+  settings: () => [...updateKeys.all, 'settings'] as const,
 }
 
 export function useUpdateStatus() {
@@ -43,3 +45,34 @@ export function useInstallUpdate() {
   })
 }
 
+
+export function useUpdaterSettings() {
+  return useQuery({
+    queryKey: updateKeys.settings(),
+    queryFn: () => updateApi.getSettings(),
+  })
+}
+
+export function useSaveUpdaterSettings() {
+  const queryClient = useQueryClient()
+
+  return useMutation({
+    mutationFn: (settings: Pick<UpdateSettings, 'autoInstall' | 'channel'>) =>
+      updateApi.updateSettings(settings),
+    onSuccess: (settings) => {
+      queryClient.setQueryData(updateKeys.settings(), settings)
+      void queryClient.invalidateQueries({ queryKey: updateKeys.status() })
+    },
+  })
+}
+
+export function useRollbackUpdate() {
+  const queryClient = useQueryClient()
+
+  return useMutation({
+    mutationFn: () => updateApi.rollback(),
+    onSuccess: () => {
+      void queryClient.invalidateQueries({ queryKey: updateKeys.all })
+    },
+  })
+}
@@ -30,3 +30,50 @@ export function getLocalArtworkUrl(
   return `${ARTWORK_API_BASE}/${type}/${tmdbId}/${artworkType}`
 }
 
+// Image cache API base URL (resized local artwork and proxied provider images)
+const IMAGE_CACHE_BASE = '/api/v1/media'
+
+// Build a resized local artwork URL; a width of 0 serves the original
+export function getResizedArtworkUrl(
+  type: 'movie' | 'series',
+  tmdbId: number,
+  artworkType: 'poster' | 'backdrop' | 'logo' | 'studio_logo',
+  width: number,
+): string {
+  const url = `${IMAGE_CACHE_BASE}/${type}/${tmdbId}/${artworkType}`
+  return width > 0 ? `${url}?w=${width}` : url
+}
+
+// Route a provider image through the server's image cache so the browser
+// never contacts the provider directly
+export function getCachedImageUrl(url: string): string {
+  if (!/^https?:\/\//.test(url)) {return url}
+  return `${IMAGE_CACHE_BASE}/remote?url=${encodeURIComponent(url)}`
+}
+
+// Width in pixels for a TMDB size key such as 'w342'; 0 for 'original'
+export function imageSizeWidth(size: string): number {
+  return size.startsWith('w') ? Number(size.slice(1)) : 0
+}
+
+// Append a cache-busting version to an artwork URL
+export function withVersion(url: string, version: string | number): string {
+  return `${url}${url.includes('?') ? '&' : '?'}v=${version}`
+}
+
+const pad2 = (n: number) => String(n).padStart(2, '0')
+
+// Build local season poster URL (cached under the series artwork ID)
+export function getLocalSeasonPosterUrl(seriesArtworkId: number, seasonNumber: number): string {
+  return `${ARTWORK_API_BASE}/series/${seriesArtworkId}/season${pad2(seasonNumber)}_poster`
+}
+
+// Build local episode still URL (cached under the series artwork ID)
+export function getLocalEpisodeStillUrl(
+  seriesArtworkId: number,
+  seasonNumber: number,
+  episodeNumber: number,
+): string {
+  return `${ARTWORK_API_BASE}/series/${seriesArtworkId}/s${pad2(seasonNumber)}e${pad2(episodeNumber)}_still`
+}
+
@@ -1,5 +1,5 @@
 import type { LucideIcon } from 'lucide-react'
-import { AlertCircle, FileEdit, Layers, PackageCheck, RefreshCw, Search } from 'lucide-react'
+import { AlertCircle, FileEdit, Layers, PackageCheck, RefreshCw, Search, Undo2 } from 'lucide-react'
 
 import type { HistoryEventType } from '@/types'
 
@@ -15,6 +15,7 @@ export const eventTypeColors: Record<
   autosearch_download: 'default',
   autosearch_failed: 'destructive',
   import_failed: 'destructive',
+  import_undone: 'outline',
   slot_assigned: 'secondary',
   slot_reassigned: 'secondary',
   slot_unassigned: 'outline',
@@ -30,6 +31,7 @@ export const eventTypeLabels: Record<HistoryEventType, string> = {
   autosearch_download: 'Auto Download',
   autosearch_failed: 'Auto Failed',
   import_failed: 'Import Failed',
+  import_undone: 'Import Undone',
   slot_assigned: 'Slot Assigned',
   slot_reassigned: 'Slot Reassigned',
   slot_unassigned: 'Slot Unassigned',
@@ -43,6 +45,7 @@ export const filterableEventTypes: { value: HistoryEventType; label: string; ico
     { value: 'autosearch_failed', label: 'Auto Failed', icon: AlertCircle },
     { value: 'imported', label: 'Imported', icon: PackageCheck },
     { value: 'import_failed', label: 'Import Failed', icon: AlertCircle },
+    { value: 'import_undone', label: 'Import Undone', icon: Undo2 },
     { value: 'file_renamed', label: 'File Renamed', icon: FileEdit },
     { value: 'slot_assigned', label: 'Slot Assigned', icon: Layers },
     { value: 'slot_reassigned', label: 'Slot Reassigned', icon: Layers },
@@ -10,7 +10,9 @@ const mutationCache = new MutationCache({
       return
     }
     if (error instanceof ApiError) {
-      toast.error(error.data?.message ?? error.data?.error ?? error.message)
+      toast.error(error.data?.message ?? error.data?.error ?? error.message, {
+        description: error.correlationId ? `Reference: ${error.correlationId}` : undefined,
+      })
     } else {
       toast.error(error instanceof Error ? error.message : 'Something went wrong')
     }
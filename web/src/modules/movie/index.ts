@@ -78,9 +78,9 @@ export const movieModuleConfig: ModuleConfig = {
     list: (options) => moviesApi.list(options),
     get: (id) => moviesApi.get(id),
     update: (id, data) => moviesApi.update(id, data),
-    delete: (id, deleteFiles) => moviesApi.delete(id, deleteFiles),
-    bulkDelete: (ids, deleteFiles) =>
-      moviesApi.bulkDelete(ids, deleteFiles).then(() => undefined),
+    delete: (id, deleteFiles, addExclusion) => moviesApi.delete(id, deleteFiles, addExclusion),
+    bulkDelete: (ids, deleteFiles, addExclusion) =>
+      moviesApi.bulkDelete(ids, deleteFiles, addExclusion).then(() => undefined),
     bulkUpdate: (ids, data) => moviesApi.bulkUpdate(ids, data),
     bulkMonitor: (ids, monitored) => moviesApi.bulkMonitor(ids, monitored),
     search: (id) => moviesApi.search(id),
@@ -82,9 +82,9 @@ export const tvModuleConfig: ModuleConfig = {
     list: (options) => seriesApi.list(options),
     get: (id) => seriesApi.get(id),
     update: (id, data) => seriesApi.update(id, data),
-    delete: (id, deleteFiles) => seriesApi.delete(id, deleteFiles),
-    bulkDelete: (ids, deleteFiles) =>
-      seriesApi.bulkDelete(ids, deleteFiles).then(() => undefined),
+    delete: (id, deleteFiles, addExclusion) => seriesApi.delete(id, deleteFiles, addExclusion),
+    bulkDelete: (ids, deleteFiles, addExclusion) =>
+      seriesApi.bulkDelete(ids, deleteFiles, addExclusion).then(() => undefined),
     bulkUpdate: (ids, data) => seriesApi.bulkUpdate(ids, data),
     bulkMonitor: (ids, monitored) =>
       seriesApi.bulkMonitorSeries(ids, monitored),
@@ -86,8 +86,8 @@ export type ModuleApi = {
   list: (options?: Record<string, unknown>) => Promise<unknown[]>
   get: (id: number) => Promise<unknown>
   update: (id: number, data: Record<string, unknown>) => Promise<unknown>
-  delete: (id: number, deleteFiles?: boolean) => Promise<undefined>
-  bulkDelete: (ids: number[], deleteFiles?: boolean) => Promise<void>
+  delete: (id: number, deleteFiles?: boolean, addExclusion?: boolean) => Promise<undefined>
+  bulkDelete: (ids: number[], deleteFiles?: boolean, addExclusion?: boolean) => Promise<void>
   bulkUpdate: (ids: number[], data: Record<string, unknown>) => Promise<unknown>
   bulkMonitor: (ids: number[], monitored: boolean) => Promise<unknown>
   search: (id: number) => Promise<undefined>
@@ -8,6 +8,7 @@ import {
   authenticationRoute,
   authSetupRoute,
   autoSearchRoute,
+  backupsRoute,
   calendarRoute,
   devControlsRoute,
   downloadClientsRoute,
@@ -98,6 +99,7 @@ const routeTree = rootRoute.addChildren([
   healthRoute,
   tasksRoute,
   logsRoute,
+  backupsRoute,
   updateRoute,
   manualImportRoute,
   // Dev routes
@@ -121,6 +121,7 @@ export const systemRoute = redirectRoute('/system', '/system/health')
 export const healthRoute = lazyRoute('/system/health', () => import('@/routes/system/health'), 'SystemHealthPage')
 export const tasksRoute = lazyRoute('/system/tasks', () => import('@/routes/system/tasks'), 'TasksPage')
 export const logsRoute = lazyRoute('/system/logs', () => import('@/routes/system/logs'), 'LogsPage')
+export const backupsRoute = lazyRoute('/system/backups', () => import('@/routes/system/backups'), 'BackupsPage')
 export const updateRoute = lazyRoute('/system/update', () => import('@/routes/system/update'), 'UpdatePage')
 
 export const manualImportRoute = lazyRoute('/import', () => import('@/routes/import/index'), 'ManualImportPage')
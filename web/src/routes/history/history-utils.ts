@@ -43,6 +43,7 @@ const detailTextByEvent: Partial<Record<HistoryEventType, DetailTextFn>> = {
   imported: (data, source) =>
     str(data.finalFilename) ?? str(data.originalFilename) ?? source ?? '-',
   import_failed: (data) => str(data.error) ?? 'Import failed',
+  import_undone: (data) => str(data.restoredFile) ?? str(data.removedPath) ?? 'Import undone',
   status_changed: getStatusChangedText,
   file_renamed: getFileRenamedText,
   slot_assigned: getSlotEventText,
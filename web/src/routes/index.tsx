@@ -1,6 +1,7 @@
 import { Link } from '@tanstack/react-router'
 import { Film, Tv } from 'lucide-react'
 
+import { ComingSoonCard } from '@/components/dashboard/coming-soon-card'
 import { StorageCard } from '@/components/dashboard/storage-card'
 import { HealthWidget } from '@/components/health'
 import { PageHeader } from '@/components/layout/page-header'
@@ -8,7 +9,7 @@ import { ProgressBar } from '@/components/media/progress-bar'
 import { Button } from '@/components/ui/button'
 import { Card, CardContent, CardHeader, CardTitle } from '@/components/ui/card'
 import { Skeleton } from '@/components/ui/skeleton'
-import { useHistory, useQueue } from '@/hooks'
+import { useComingSoon, useHistory, useQueue } from '@/hooks'
 import { useStorage } from '@/hooks/use-storage'
 import { formatRelativeTime } from '@/lib/formatters'
 import { eventTypeLabels } from '@/lib/history-utils'
@@ -147,6 +148,7 @@ function RecentActivity() {
 export function DashboardPage() {
   const globalLoading = useUIStore((s) => s.globalLoading)
   const storage = useStorage()
+  const comingSoon = useComingSoon()
 
   return (
     <div>
@@ -158,6 +160,10 @@ export function DashboardPage() {
         <HealthWidget />
       </div>
 
+      <div className="mb-6">
+        <ComingSoonCard items={comingSoon.data} loading={comingSoon.isLoading || globalLoading} />
+      </div>
+
       {/* Activity section */}
       <div className="grid gap-4 md:grid-cols-2">
         <QueuePreview />
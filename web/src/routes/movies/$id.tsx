@@ -1,6 +1,6 @@
 import { ErrorState } from '@/components/data/error-state'
 import { MediaEditDialog } from '@/components/media/media-edit-dialog'
-import { useUpdateMovie } from '@/hooks'
+import { useMoveMovie, useUpdateMovie } from '@/hooks'
 
 import { MovieDetailActions } from './movie-detail-actions'
 import { MovieDetailContent } from './movie-detail-content'
@@ -11,6 +11,7 @@ import { useMovieDetail } from './use-movie-detail'
 export function MovieDetailPage() {
   const state = useMovieDetail()
   const updateMutation = useUpdateMovie()
+  const moveMutation = useMoveMovie()
 
   if (state.isLoading) {
     return <MovieDetailSkeleton />
@@ -48,6 +49,7 @@ export function MovieDetailPage() {
         mediaLabel="Movie"
         moduleType="movie"
         monitoredDescription="Search for releases and upgrade quality"
+        moveMutation={moveMutation}
       />
     </div>
   )
@@ -6,6 +6,7 @@ import { ArrowLeft, Loader2 } from 'lucide-react'
 import { PageHeader } from '@/components/layout/page-header'
 import { AddMediaConfigure, MediaPreview } from '@/components/media/add-media-configure'
 import { ToggleField } from '@/components/media/media-configure-fields'
+import { PathConflictDialog } from '@/components/media/path-conflict-dialog'
 import { Button } from '@/components/ui/button'
 
 import { useAddMoviePage } from './use-add-movie'
@@ -39,6 +40,12 @@ export function AddMoviePage() {
         }
       />
       <AddMovieBody state={state} />
+      <PathConflictDialog
+        conflict={state.conflict}
+        onCancel={state.handleCancelConflict}
+        onSelect={state.handleSelectPath}
+        isPending={state.isPending}
+      />
     </div>
   )
 }
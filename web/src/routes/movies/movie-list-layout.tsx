@@ -22,7 +22,7 @@ export function MovieListLayout({ state: s }: { state: MovieListState }) {
       sortDirection={s.sortDirection} view={s.moviesView} posterSize={s.posterSize}
       items={s.sortedMovies} groups={s.groups} renderContext={s.renderContext}
       qualityProfiles={s.qualityProfiles} isBulkUpdating={s.bulkUpdateMutation.isPending}
-      showDeleteDialog={s.showDeleteDialog} deleteFiles={s.deleteFiles}
+      showDeleteDialog={s.showDeleteDialog} deleteFiles={s.deleteFiles} addExclusion={s.addExclusion}
       isBulkDeleting={s.bulkDeleteMutation.isPending} isRefreshing={s.refreshAllMutation.isPending}
       emptyIcon={<ModIcon className="text-movie-500 size-8" />} emptyTitle={`No ${mod.pluralName.toLowerCase()} found`}
       renderCard={(movie, opts) => <MovieCard key={movie.id} movie={movie} {...opts} />}
@@ -34,6 +34,7 @@ export function MovieListLayout({ state: s }: { state: MovieListState }) {
       onDelete={() => s.setShowDeleteDialog(true)} onRefreshAll={s.handleRefreshAll}
       onEnterEdit={() => s.setEditMode(true)} onExitEdit={s.handleExitEditMode}
       onShowDeleteDialog={s.setShowDeleteDialog} onDeleteFilesChange={s.setDeleteFiles}
+      onAddExclusionChange={s.setAddExclusion}
       onBulkDelete={s.handleBulkDelete}
     />
   )
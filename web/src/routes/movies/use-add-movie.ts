@@ -14,7 +14,8 @@ import {
   useQualityProfiles,
   useRootFoldersByType,
 } from '@/hooks'
-import type { AddMovieInput, MovieSearchResult } from '@/types'
+import type { AddMovieInput, MovieSearchResult, PathConflict } from '@/types'
+import { getPathConflict } from '@/types'
 
 const addMovieSchema = z.object({
   rootFolderId: z.string().min(1),
@@ -147,11 +148,13 @@ function useHandlers({ nav, selected, form, queries }: {
   form: FormState
   queries: Queries
 }) {
+  const [conflict, setConflict] = useState<PathConflict | null>(null)
+
   const handleBack = () => {
     void nav.navigate({ to: '/movies' })
   }
 
-  const handleAdd = async () => {
+  const submit = async (path?: string) => {
     const values = form.getValues()
     if (!selected.selectedMovie || !values.rootFolderId || !values.qualityProfileId) {
       toast.error('Please fill in all required fields')
@@ -171,16 +174,27 @@ function useHandlers({ nav, selected, form, queries }: {
       posterUrl: selected.selectedMovie.posterUrl,
       backdropUrl: selected.selectedMovie.backdropUrl,
       searchOnAdd: values.searchOnAdd,
+      path,
     }
 
     try {
       const movie = await queries.addMutation.mutateAsync(input)
+      setConflict(null)
       toast.success(`Added "${movie.title}"`)
       void nav.navigate({ to: '/movies/$id', params: { id: String(movie.id) } })
-    } catch {
+    } catch (error) {
+      const pathConflict = getPathConflict(error)
+      if (pathConflict) {
+        setConflict(pathConflict)
+        return
+      }
       toast.error('Failed to add movie')
     }
   }
 
-  return { handleBack, handleAdd }
+  const handleAdd = () => submit()
+  const handleSelectPath = (path: string) => submit(path)
+  const handleCancelConflict = () => setConflict(null)
+
+  return { handleBack, handleAdd, conflict, handleSelectPath, handleCancelConflict }
 }
@@ -23,6 +23,7 @@ export function useUserEditDialog(
     getInitialModuleSettings(user),
   )
   const [autoApprove, setAutoApprove] = useState(user.autoApprove)
+  const [canStream, setCanStream] = useState(user.canStream)
 
   const setModuleProfile = (moduleType: string, profileId: number | null) => {
     setModuleProfileSettings((prev) => ({ ...prev, [moduleType]: profileId }))
@@ -33,6 +34,7 @@ export function useUserEditDialog(
       username: username === user.username ? undefined : username,
       moduleSettings: moduleProfileSettings,
       autoApprove,
+      canStream,
     }
     try {
       await updateMutation.mutateAsync({ id: user.id, data: input })
@@ -48,6 +50,7 @@ export function useUserEditDialog(
     moduleProfileSettings,
     setModuleProfile,
     autoApprove, setAutoApprove,
+    canStream, setCanStream,
     isPending: updateMutation.isPending,
     handleSave,
   }
@@ -96,6 +96,15 @@ function EditUserFormBody({ state, qualityProfiles }: EditFormProps) {
         />
         <Label htmlFor="autoApprove">Auto-approve requests</Label>
       </div>
+
+      <div className="flex items-center space-x-2">
+        <Checkbox
+          id="canStream"
+          checked={state.canStream}
+          onCheckedChange={(checked) => state.setCanStream(checked)}
+        />
+        <Label htmlFor="canStream">Allow direct file streaming</Label>
+      </div>
     </div>
   )
 }
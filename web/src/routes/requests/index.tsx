@@ -4,16 +4,18 @@ import { useNavigate } from '@tanstack/react-router'
 import { formatDistanceToNow } from 'date-fns'
 import { Clock, Loader2, User } from 'lucide-react'
 
+import { ComingSoonCard } from '@/components/dashboard/coming-soon-card'
 import { PosterImage } from '@/components/media/poster-image'
 import { Badge } from '@/components/ui/badge'
 import { Progress } from '@/components/ui/progress'
 import { Tabs, TabsList, TabsTrigger } from '@/components/ui/tabs'
-import { usePortalDownloads, useRequests } from '@/hooks'
+import { usePortalComingSoon, usePortalDownloads, useRequests } from '@/hooks'
 import { getStatusConfig } from '@/lib/request-status-config'
 import type { PortalDownload, Request } from '@/types'
 
 import { computeDownloadProgress, findMatchingDownloads } from './request-download-utils'
 import { SearchSection } from './request-list-search'
+import { TrendingSection } from './trending-section'
 
 const STATUS_CONFIG = getStatusConfig('xs')
 
@@ -48,6 +50,8 @@ export function RequestsListPage() {
         setSearchFocused={setSearchFocused}
         onSearch={handleSearch}
       />
+      <ComingSoonSection />
+      <TrendingSection />
       <RequestsSection
         filter={filter}
         setFilter={setFilter}
@@ -59,6 +63,22 @@ export function RequestsListPage() {
   )
 }
 
+function ComingSoonSection() {
+  const { data: items = [] } = usePortalComingSoon()
+
+  if (items.length === 0) {
+    return null
+  }
+
+  return (
+    <section className="px-6 pb-4">
+      <div className="mx-auto max-w-6xl">
+        <ComingSoonCard items={items} />
+      </div>
+    </section>
+  )
+}
+
 type RequestsSectionProps = {
   filter: 'mine' | 'all'
   setFilter: (f: 'mine' | 'all') => void
@@ -227,6 +227,11 @@ function RequestMetadataGrid({ request }: { request: Request }) {
         <p className="text-sm font-medium md:text-base">
           {request.user?.username ?? 'Unknown'}
         </p>
+        {request.requesters && request.requesters.length > 1 ? (
+          <p className="text-muted-foreground text-xs md:text-sm">
+            {request.voteCount} votes · also {request.requesters.slice(1).map((u) => u.username).join(', ')}
+          </p>
+        ) : null}
       </div>
       <div className="space-y-1">
         <p className="text-muted-foreground flex items-center gap-1 text-xs md:text-sm">
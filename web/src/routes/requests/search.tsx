@@ -7,6 +7,7 @@ import { SearchLoadingSkeleton } from './search-loading-skeleton'
 import { SearchResultsContent } from './search-results-content'
 import { SeriesRequestDialog } from './series-request-dialog'
 import { useRequestSearch } from './use-request-search'
+import { VersionSelect } from './version-select'
 
 type PortalSearchPageProps = {
   q: string
@@ -30,6 +31,7 @@ function PortalSearchPage({ q }: PortalSearchPageProps) {
 
   return (
     <div className="mx-auto max-w-6xl space-y-8 px-6 pt-6">
+      <VersionSelect slots={s.slots} value={s.targetSlotId} onChange={s.setTargetSlotId} />
       <SearchBody query={query} state={s} />
 
       <SeriesRequestDialog
@@ -0,0 +1,80 @@
+import { useNavigate } from '@tanstack/react-router'
+import { TrendingUp } from 'lucide-react'
+
+import { PosterImage } from '@/components/media/poster-image'
+import { usePortalTrendingMovies, usePortalTrendingSeries } from '@/hooks'
+
+type TrendingItem = {
+  key: string
+  title: string
+  year: number | null
+  posterUrl: string | null
+  type: 'movie' | 'series'
+}
+
+const MAX_ITEMS = 12
+
+export function TrendingSection() {
+  const { data: movies = [] } = usePortalTrendingMovies()
+  const { data: series = [] } = usePortalTrendingSeries()
+
+  const items: TrendingItem[] = [
+    ...movies.slice(0, MAX_ITEMS / 2).map((m) => ({
+      key: `movie-${m.id}`,
+      title: m.title,
+      year: m.year,
+      posterUrl: m.posterUrl,
+      type: 'movie' as const,
+    })),
+    ...series.slice(0, MAX_ITEMS / 2).map((s) => ({
+      key: `series-${s.id}`,
+      title: s.title,
+      year: s.year,
+      posterUrl: s.posterUrl,
+      type: 'series' as const,
+    })),
+  ]
+
+  if (items.length === 0) {
+    return null
+  }
+
+  return (
+    <section className="px-6 pb-4">
+      <div className="mx-auto max-w-6xl">
+        <h2 className="mb-3 flex items-center gap-2 text-lg font-semibold">
+          <TrendingUp className="size-5" />
+          Trending This Week
+        </h2>
+        <div className="flex gap-3 overflow-x-auto pb-2">
+          {items.map((item) => (
+            <TrendingCard key={item.key} item={item} />
+          ))}
+        </div>
+      </div>
+    </section>
+  )
+}
+
+function TrendingCard({ item }: { item: TrendingItem }) {
+  const navigate = useNavigate()
+
+  return (
+    <button
+      onClick={() => void navigate({ to: '/requests/search', search: { q: item.title } })}
+      className="w-24 shrink-0 text-left"
+      title={item.year ? `${item.title} (${item.year})` : item.title}
+    >
+      <div
+        className={`aspect-[2/3] overflow-hidden rounded border transition-all ${
+          item.type === 'movie'
+            ? 'border-movie-500/20 hover:border-movie-500/50'
+            : 'border-tv-500/20 hover:border-tv-500/50'
+        }`}
+      >
+        <PosterImage url={item.posterUrl} alt={item.title} type={item.type} className="h-full w-full" />
+      </div>
+      <p className="mt-1 truncate text-xs font-medium">{item.title}</p>
+    </button>
+  )
+}
@@ -87,7 +87,7 @@ export function useRequestDetail() {
   return {
     request, isLoading: queryLoading || globalLoading, error,
     isMovie: request?.mediaType === 'movie', isOwner,
-    canCancel: isOwner && request?.status === 'pending',
+    canCancel: (isOwner && request?.status === 'pending') || !!request?.hasVoted,
     cancelDialogOpen, setCancelDialogOpen,
     cancelPending: cancelMutation.isPending,
     download: computeDownloadState(getMatchingDownloads(downloads, request)),
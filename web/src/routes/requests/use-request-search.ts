@@ -7,16 +7,25 @@ import {
   useCreateRequest,
   usePortalMovieSearch,
   usePortalSeriesSearch,
+  useRequestableSlots,
   useSeriesSeasons,
   useWatchRequest,
 } from '@/hooks'
 import { usePortalAuthStore } from '@/stores'
-import type { PortalMovieSearchResult, PortalSeriesSearchResult } from '@/types'
+import type { PortalMovieSearchResult, PortalSeriesSearchResult, Request } from '@/types'
 
 import { sortByAddedAt } from './search-utils'
 
 const REQUEST_SUCCESS = { description: 'Your request has been submitted for review.' }
 
+function onRequestSuccess(request: Request) {
+  if (request.merged) {
+    toast.success('Vote added', { description: 'This title was already requested, so your vote was added to it.' })
+    return
+  }
+  toast.success('Request submitted', REQUEST_SUCCESS)
+}
+
 function onRequestError(error: Error) {
   toast.error('Failed to submit request', { description: error.message })
 }
@@ -91,7 +100,7 @@ function useSeriesDialog() {
   }
 }
 
-function buildSeriesRequestPayload(dialog: ReturnType<typeof useSeriesDialog>) {
+function buildSeriesRequestPayload(dialog: ReturnType<typeof useSeriesDialog>, targetSlotId?: number) {
   if (!dialog.selectedSeries) {return undefined}
   const seasonsArray = [...dialog.selectedSeasons].toSorted((a, b) => a - b)
   const common = {
@@ -99,6 +108,7 @@ function buildSeriesRequestPayload(dialog: ReturnType<typeof useSeriesDialog>) {
     tvdbId: dialog.selectedSeries.tvdbId ?? undefined,
     title: dialog.selectedSeries.title, year: dialog.selectedSeries.year ?? undefined,
     monitorFuture: dialog.monitorFuture, posterUrl: dialog.selectedSeries.posterUrl ?? undefined,
+    targetSlotId,
   }
   if (seasonsArray.length === 1) {
     return { ...common, mediaType: 'season' as const, seasonNumber: seasonsArray[0] }
@@ -115,6 +125,8 @@ export function useRequestSearch(query: string) {
   const createRequest = useCreateRequest()
   const watchRequest = useWatchRequest()
   const [requestedTmdbIds, setRequestedTmdbIds] = useState<Set<number>>(new Set())
+  const { data: slots = [] } = useRequestableSlots()
+  const [targetSlotId, setTargetSlotId] = useState<number | undefined>(undefined)
 
   const results = useSearchResults(query)
   const dialog = useSeriesDialog()
@@ -125,21 +137,24 @@ export function useRequestSearch(query: string) {
   const handleMovieRequest = (movie: PortalMovieSearchResult) => {
     const tmdbId = movie.tmdbId || movie.id
     createRequest.mutate(
-      { mediaType: 'movie', tmdbId, title: movie.title, year: movie.year ?? undefined, posterUrl: movie.posterUrl ?? undefined },
-      { onSuccess: () => { markRequested(tmdbId); toast.success('Request submitted', REQUEST_SUCCESS) }, onError: onRequestError },
+      {
+        mediaType: 'movie', tmdbId, title: movie.title, year: movie.year ?? undefined,
+        posterUrl: movie.posterUrl ?? undefined, targetSlotId,
+      },
+      { onSuccess: (request) => { markRequested(tmdbId); onRequestSuccess(request) }, onError: onRequestError },
     )
   }
 
   const handleSubmitSeriesRequest = () => {
-    const payload = buildSeriesRequestPayload(dialog)
+    const payload = buildSeriesRequestPayload(dialog, targetSlotId)
     if (!payload) { return }
     createRequest.mutate(payload, {
-      onSuccess: () => {
+      onSuccess: (request) => {
         markRequested(payload.tmdbId)
         dialog.setRequestDialogOpen(false)
         dialog.setSelectedSeries(null)
         dialog.setSelectedSeasons(new Set())
-        toast.success('Request submitted', REQUEST_SUCCESS)
+        onRequestSuccess(request)
       },
       onError: onRequestError,
     })
@@ -154,7 +169,7 @@ export function useRequestSearch(query: string) {
 
   return {
     user, ...results, isRequested, handleMovieRequest, handleSubmitSeriesRequest,
-    handleWatchRequest,
+    handleWatchRequest, slots, targetSlotId, setTargetSlotId,
     goToRequest: (id: number) => void navigate({ to: '/requests/$id', params: { id: String(id) } }),
     ...dialog, isSubmitting: createRequest.isPending,
   }
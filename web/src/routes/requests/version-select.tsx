@@ -0,0 +1,38 @@
+import { Select, SelectContent, SelectItem, SelectTrigger } from '@/components/ui/select'
+import type { RequestableSlot } from '@/types'
+
+const ANY_VERSION = 'any'
+
+type VersionSelectProps = {
+  slots: RequestableSlot[]
+  value: number | undefined
+  onChange: (slotId: number | undefined) => void
+}
+
+export function VersionSelect({ slots, value, onChange }: VersionSelectProps) {
+  if (slots.length < 2) {
+    return null
+  }
+
+  const selected = slots.find((slot) => slot.id === value)
+
+  return (
+    <div className="flex items-center justify-end gap-2">
+      <span className="text-muted-foreground text-sm">Request version</span>
+      <Select
+        value={value === undefined ? ANY_VERSION : String(value)}
+        onValueChange={(v) => onChange(v === ANY_VERSION ? undefined : Number(v))}
+      >
+        <SelectTrigger className="w-auto">{selected?.name ?? 'Any'}</SelectTrigger>
+        <SelectContent>
+          <SelectItem value={ANY_VERSION}>Any</SelectItem>
+          {slots.map((slot) => (
+            <SelectItem key={slot.id} value={String(slot.id)}>
+              {slot.name}
+            </SelectItem>
+          ))}
+        </SelectContent>
+      </Select>
+    </div>
+  )
+}
@@ -2,13 +2,19 @@ import { ErrorState } from '@/components/data/error-state'
 import { MediaEditDialog } from '@/components/media/media-edit-dialog'
 import { SeasonList } from '@/components/series/season-list'
 import { Card, CardContent, CardHeader, CardTitle } from '@/components/ui/card'
-import { useUpdateSeries } from '@/hooks'
+import { useMoveSeries, useUpdateSeries } from '@/hooks'
 
 import { SeriesActionBar } from './series-action-bar'
 import { SeriesDetailSkeleton } from './series-detail-skeleton'
 import { SeriesHeroSection } from './series-hero-section'
 import { useSeriesDetailPage } from './use-series-detail'
 
+const SERIES_FORMAT_TYPES = [
+  { value: 'standard', label: 'Standard', description: 'Episodes are numbered by season (S01E05)' },
+  { value: 'daily', label: 'Daily', description: 'Episodes are identified by air date' },
+  { value: 'anime', label: 'Anime', description: 'Releases use absolute episode numbers (Title - 105)' },
+]
+
 function SeasonsCard({ vm }: { vm: ReturnType<typeof useSeriesDetailPage> }) {
   const { series } = vm
   if (!series) {return null}
@@ -38,6 +44,7 @@ function SeasonsCard({ vm }: { vm: ReturnType<typeof useSeriesDetailPage> }) {
 export function SeriesDetailPage() {
   const vm = useSeriesDetailPage()
   const updateMutation = useUpdateSeries()
+  const moveMutation = useMoveSeries()
 
   if (vm.isLoading) {return <SeriesDetailSkeleton />}
   if (vm.isError || !vm.series) {return <ErrorState message="Series not found" onRetry={vm.refetch} />}
@@ -65,6 +72,8 @@ export function SeriesDetailPage() {
         mediaLabel="Series"
         moduleType="tv"
         monitoredDescription="Search for releases and upgrade quality for all monitored episodes"
+        formatTypes={SERIES_FORMAT_TYPES}
+        moveMutation={moveMutation}
       />
     </div>
   )
@@ -6,6 +6,7 @@ import { ArrowLeft, Loader2 } from 'lucide-react'
 import { PageHeader } from '@/components/layout/page-header'
 import { AddMediaConfigure, MediaPreview } from '@/components/media/add-media-configure'
 import { MonitorSelect, SearchOnAddSelect, ToggleField } from '@/components/media/media-configure-fields'
+import { PathConflictDialog } from '@/components/media/path-conflict-dialog'
 import { Button } from '@/components/ui/button'
 
 import { useAddSeriesPage } from './use-add-series'
@@ -41,6 +42,12 @@ export function AddSeriesPage() {
         }
       />
       <AddSeriesBody state={state} />
+      <PathConflictDialog
+        conflict={state.conflict}
+        onCancel={state.handleCancelConflict}
+        onSelect={state.handleSelectPath}
+        isPending={state.isPending}
+      />
     </div>
   )
 }
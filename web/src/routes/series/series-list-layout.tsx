@@ -24,7 +24,7 @@ export function SeriesListLayout({ state: s }: { state: SeriesListState }) {
       sortDirection={s.sortDirection} view={s.seriesView} posterSize={s.posterSize}
       items={s.sortedSeries} groups={s.groups} renderContext={s.renderContext}
       qualityProfiles={s.qualityProfiles} isBulkUpdating={s.bulkUpdateMutation.isPending}
-      showDeleteDialog={s.showDeleteDialog} deleteFiles={s.deleteFiles}
+      showDeleteDialog={s.showDeleteDialog} deleteFiles={s.deleteFiles} addExclusion={s.addExclusion}
       isBulkDeleting={s.bulkDeleteMutation.isPending} isRefreshing={s.refreshAllMutation.isPending}
       emptyIcon={<ModIcon className="text-tv-500 size-8" />} emptyTitle={`No ${mod.pluralName.toLowerCase()} found`}
       emptyAction={EMPTY_ACTION}
@@ -37,6 +37,7 @@ export function SeriesListLayout({ state: s }: { state: SeriesListState }) {
       onDelete={() => s.setShowDeleteDialog(true)} onRefreshAll={s.handleRefreshAll}
       onEnterEdit={() => s.setEditMode(true)} onExitEdit={s.handleExitEditMode}
       onShowDeleteDialog={s.setShowDeleteDialog} onDeleteFilesChange={s.setDeleteFiles}
+      onAddExclusionChange={s.setAddExclusion}
       onBulkDelete={s.handleBulkDelete}
     />
   )
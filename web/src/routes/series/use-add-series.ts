@@ -16,10 +16,12 @@ import {
 } from '@/hooks'
 import type {
   AddSeriesInput,
+  PathConflict,
   SeriesMonitorOnAdd,
   SeriesSearchOnAdd,
   SeriesSearchResult,
 } from '@/types'
+import { getPathConflict } from '@/types'
 
 const addSeriesSchema = z.object({
   rootFolderId: z.string().min(1),
@@ -162,29 +164,41 @@ function useSyncDefaultRootFolder(
 type HandlerDeps = { nav: Navigation; selected: SelectedSeries; form: FormState; queries: Queries }
 
 function useHandlers({ nav, selected, form, queries }: HandlerDeps) {
+  const [conflict, setConflict] = useState<PathConflict | null>(null)
+
   const handleBack = () => {
     void nav.navigate({ to: '/series' })
   }
 
-  const handleAdd = async () => {
+  const submit = async (path?: string) => {
     const values = form.getValues()
     if (!selected.selectedSeries || !values.rootFolderId || !values.qualityProfileId) {
       toast.error('Please fill in all required fields')
       return
     }
 
-    const input = buildAddInput(selected.selectedSeries, values)
+    const input = { ...buildAddInput(selected.selectedSeries, values), path }
 
     try {
       const series = await queries.addMutation.mutateAsync(input)
+      setConflict(null)
       toast.success(`Added "${series.title}"`)
       void nav.navigate({ to: '/series/$id', params: { id: String(series.id) } })
-    } catch {
+    } catch (error) {
+      const pathConflict = getPathConflict(error)
+      if (pathConflict) {
+        setConflict(pathConflict)
+        return
+      }
       toast.error('Failed to add series')
     }
   }
 
-  return { handleBack, handleAdd }
+  const handleAdd = () => submit()
+  const handleSelectPath = (path: string) => submit(path)
+  const handleCancelConflict = () => setConflict(null)
+
+  return { handleBack, handleAdd, conflict, handleSelectPath, handleCancelConflict }
 }
 
 function buildAddInput(series: SeriesSearchResult, data: AddSeriesFormData): AddSeriesInput {
@@ -104,6 +104,7 @@ function useLocalState() {
   const [selectedIds, setSelectedIds] = useState<Set<number>>(new Set())
   const [showDeleteDialog, setShowDeleteDialog] = useState(false)
   const [deleteFiles, setDeleteFiles] = useState(false)
+  const [addExclusion, setAddExclusion] = useState(false)
 
   return {
     statusFilters, setStatusFilters,
@@ -113,6 +114,7 @@ function useLocalState() {
     selectedIds, setSelectedIds,
     showDeleteDialog, setShowDeleteDialog,
     deleteFiles, setDeleteFiles,
+    addExclusion, setAddExclusion,
   }
 }
 
@@ -222,12 +224,13 @@ function useBulkHandlers({ local, queries, onExitEditMode }: BulkDeps) {
 
   const handleBulkDelete = () => {
     queries.bulkDeleteMutation.mutate(
-      { ids: selectedArray(), deleteFiles: local.deleteFiles },
+      { ids: selectedArray(), deleteFiles: local.deleteFiles, addExclusion: local.addExclusion },
       {
         onSuccess: () => {
           toast.success(`${local.selectedIds.size} series deleted`)
           local.setShowDeleteDialog(false)
           local.setDeleteFiles(false)
+          local.setAddExclusion(false)
           onExitEditMode()
         },
         onError: (error: Error) => toast.error('Failed to delete series', { description: error.message }),
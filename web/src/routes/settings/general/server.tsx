@@ -1,4 +1,4 @@
-import { History, Save } from 'lucide-react'
+import { History, Radio, Save } from 'lucide-react'
 
 import { PageHeader } from '@/components/layout/page-header'
 import { ServerSection } from '@/components/settings'
@@ -7,6 +7,7 @@ import { Card, CardContent, CardHeader, CardTitle } from '@/components/ui/card'
 import { Input } from '@/components/ui/input'
 import { Label } from '@/components/ui/label'
 import { Switch } from '@/components/ui/switch'
+import { useUIStore } from '@/stores'
 
 import { GeneralNav } from './general-nav'
 import { useHistoryRetention } from './use-history-retention'
@@ -42,6 +43,36 @@ function HistoryRetentionCard() {
   )
 }
 
+function RealtimeTransportCard() {
+  const transport = useUIStore((s) => s.realtimeTransport)
+  const setTransport = useUIStore((s) => s.setRealtimeTransport)
+
+  return (
+    <Card>
+      <CardHeader>
+        <CardTitle className="flex items-center gap-2 text-base">
+          <Radio className="size-4" />
+          Live Updates
+        </CardTitle>
+      </CardHeader>
+      <CardContent className="space-y-2">
+        <div className="flex items-center justify-between">
+          <Label htmlFor="realtime-sse">Use Server-Sent Events instead of WebSockets</Label>
+          <Switch
+            id="realtime-sse"
+            checked={transport === 'sse'}
+            onCheckedChange={(v) => setTransport(v ? 'sse' : 'websocket')}
+          />
+        </div>
+        <p className="text-muted-foreground text-xs">
+          Enable if live updates stop working behind a reverse proxy or network that blocks
+          WebSockets. Applies to this browser only.
+        </p>
+      </CardContent>
+    </Card>
+  )
+}
+
 function RetentionDaysInput({
   days,
   onChange,
@@ -97,6 +128,7 @@ export function ServerPage() {
           onExternalAccessChange={page.onExternalAccessChange}
         />
         <HistoryRetentionCard />
+        <RealtimeTransportCard />
       </div>
     </div>
   )
@@ -1,4 +1,5 @@
 import { PageHeader } from '@/components/layout/page-header'
+import { CustomQualitiesCard } from '@/components/qualityprofiles'
 import { QualityProfilesSection } from '@/components/settings'
 
 import { MediaNav } from './media-nav'
@@ -18,6 +19,8 @@ export function QualityProfilesPage() {
       <MediaNav />
 
       <QualityProfilesSection />
+
+      <CustomQualitiesCard />
     </div>
   )
 }
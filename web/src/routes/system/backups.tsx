@@ -0,0 +1,153 @@
+import { Download, RotateCcw, Trash2 } from 'lucide-react'
+
+import { ErrorState } from '@/components/data/error-state'
+import { LoadingState } from '@/components/data/loading-state'
+import { ConfirmDialog } from '@/components/forms/confirm-dialog'
+import { PageHeader } from '@/components/layout/page-header'
+import { Badge } from '@/components/ui/badge'
+import { Button } from '@/components/ui/button'
+import {
+  Table,
+  TableBody,
+  TableCell,
+  TableHead,
+  TableHeader,
+  TableRow,
+} from '@/components/ui/table'
+import { formatBytes, formatRelativeTime } from '@/lib/formatters'
+import type { Backup } from '@/types'
+
+import { SystemNav } from './system-nav'
+import { useBackupsPage } from './use-backups-page'
+
+const PAGE_TITLE = 'System'
+const PAGE_DESCRIPTION = 'Monitor system health, tasks, logs, and updates'
+
+const REASON_LABELS: Record<Backup['reason'], string> = {
+  scheduled: 'Scheduled',
+  manual: 'Manual',
+  migration: 'Before migration',
+  update: 'Before update',
+  restore: 'Before restore',
+}
+
+type BackupRowProps = {
+  backup: Backup
+  isRestorePending: boolean
+  onDownload: (name: string) => void
+  onRestore: (name: string) => void
+  onDelete: (name: string) => void
+}
+
+function BackupRow({ backup, isRestorePending, onDownload, onRestore, onDelete }: BackupRowProps) {
+  return (
+    <TableRow>
+      <TableCell className="font-mono text-xs">{backup.name}</TableCell>
+      <TableCell>
+        <Badge variant="secondary">{REASON_LABELS[backup.reason]}</Badge>
+      </TableCell>
+      <TableCell>{formatBytes(backup.size)}</TableCell>
+      <TableCell>{formatRelativeTime(backup.createdAt)}</TableCell>
+      <TableCell>
+        <div className="flex gap-1">
+          <Button variant="ghost" size="icon" aria-label="Download" onClick={() => onDownload(backup.name)}>
+            <Download className="size-4" />
+          </Button>
+          <ConfirmDialog
+            trigger={
+              <Button variant="ghost" size="icon" aria-label="Restore" disabled={isRestorePending}>
+                <RotateCcw className="size-4" />
+              </Button>
+            }
+            title="Restore backup"
+            description={`Replace the current database with "${backup.name}"? The current database is backed up first and SlipStream restarts to apply the restore.`}
+            confirmLabel="Restore"
+            variant="destructive"
+            onConfirm={() => onRestore(backup.name)}
+          />
+          <ConfirmDialog
+            trigger={
+              <Button variant="ghost" size="icon" aria-label="Delete">
+                <Trash2 className="size-4" />
+              </Button>
+            }
+            title="Delete backup"
+            description={`Are you sure you want to delete "${backup.name}"?`}
+            confirmLabel="Delete"
+            variant="destructive"
+            onConfirm={() => onDelete(backup.name)}
+          />
+        </div>
+      </TableCell>
+    </TableRow>
+  )
+}
+
+export function BackupsPage() {
+  const page = useBackupsPage()
+
+  if (page.isLoading) {
+    return (
+      <div className="space-y-6">
+        <PageHeader title={PAGE_TITLE} description={PAGE_DESCRIPTION} />
+        <SystemNav />
+        <LoadingState variant="list" />
+      </div>
+    )
+  }
+
+  if (page.isError) {
+    return (
+      <div className="space-y-6">
+        <PageHeader title={PAGE_TITLE} description={PAGE_DESCRIPTION} />
+        <SystemNav />
+        <ErrorState onRetry={page.refetch} />
+      </div>
+    )
+  }
+
+  return (
+    <div className="space-y-6">
+      <PageHeader title={PAGE_TITLE} description={PAGE_DESCRIPTION} />
+      <SystemNav />
+      <div className="flex justify-end">
+        <Button onClick={() => void page.handleCreate()} disabled={page.isCreatePending}>
+          Back Up Now
+        </Button>
+      </div>
+      <div className="rounded-md border">
+        <Table>
+          <TableHeader>
+            <TableRow>
+              <TableHead>Name</TableHead>
+              <TableHead>Reason</TableHead>
+              <TableHead>Size</TableHead>
+              <TableHead>Created</TableHead>
+              <TableHead className="w-[140px]">Actions</TableHead>
+            </TableRow>
+          </TableHeader>
+          <TableBody>
+            {page.backups && page.backups.length > 0 ? (
+              page.backups.map((backup) => (
+                <BackupRow
+                  key={backup.name}
+                  backup={backup}
+                  isRestorePending={page.isRestorePending}
+                  onDownload={(name) => void page.handleDownload(name)}
+                  onRestore={(name) => void page.handleRestore(name)}
+                  onDelete={(name) => void page.handleDelete(name)}
+                />
+              ))
+            ) : (
+              <TableRow>
+                <TableCell colSpan={5} className="text-muted-foreground py-8 text-center">
+                  No backups yet
+                </TableCell>
+              </TableRow>
+            )}
+          </TableBody>
+        </Table>
+      </div>
+    </div>
+  )
+}
@@ -17,6 +17,9 @@ import {
 import { HealthItemRow } from './health-item-row'
 import { getResultText, getWorstStatus } from './health-utils'
 
+// Categories only checked by scheduled tasks; they have no manual test
+const SCHEDULED_CATEGORIES = new Set<HealthCategory>(['storage', 'import', 'definitions', 'queue'])
+
 type TestResultsInput = {
   categoryName: string
   category: HealthCategory
@@ -49,7 +52,7 @@ export function HealthCategoryCard({ category, items }: HealthCategoryCardProps)
   const worstStatus = getWorstStatus(items)
   const categoryName = getCategoryDisplayName(category)
   const settingsPath = getCategorySettingsPath(category)
-  const isStorage = category === 'storage'
+  const isScheduled = SCHEDULED_CATEGORIES.has(category)
 
   const handleTestAll = async () => {
     try {
@@ -67,11 +70,11 @@ export function HealthCategoryCard({ category, items }: HealthCategoryCardProps)
         categoryName={categoryName}
         settingsPath={settingsPath}
         itemCount={items.length}
-        showTestAll={!isStorage}
+        showTestAll={!isScheduled}
         onTestAll={handleTestAll}
         testPending={testCategory.isPending}
       />
-      <CategoryCardContent items={items} settingsPath={settingsPath} isStorage={isStorage} />
+      <CategoryCardContent items={items} settingsPath={settingsPath} isScheduled={isScheduled} />
     </Card>
   )
 }
@@ -129,10 +132,10 @@ function CategoryCardHeader({
 type CategoryCardContentProps = {
   items: HealthItem[]
   settingsPath: string
-  isStorage: boolean
+  isScheduled: boolean
 }
 
-function CategoryCardContent({ items, settingsPath, isStorage }: CategoryCardContentProps) {
+function CategoryCardContent({ items, settingsPath, isScheduled }: CategoryCardContentProps) {
   if (items.length === 0) {
     return (
       <CardContent className="space-y-2">
@@ -152,7 +155,7 @@ function CategoryCardContent({ items, settingsPath, isStorage }: CategoryCardCon
   return (
     <CardContent className="space-y-2">
       {items.map((item) => (
-        <HealthItemRow key={item.id} item={item} hideTestButton={isStorage} />
+        <HealthItemRow key={item.id} item={item} hideTestButton={isScheduled} />
       ))}
     </CardContent>
   )
@@ -1,5 +1,5 @@
 import { Link, useRouterState } from '@tanstack/react-router'
-import { ArrowUpCircle, Clock, HeartPulse, ScrollText } from 'lucide-react'
+import { ArrowUpCircle, Clock, DatabaseBackup, HeartPulse, ScrollText } from 'lucide-react'
 
 import { cn } from '@/lib/utils'
 
@@ -7,6 +7,7 @@ const systemNavItems = [
   { title: 'Health', href: '/system/health', icon: HeartPulse },
   { title: 'Tasks', href: '/system/tasks', icon: Clock },
   { title: 'Logs', href: '/system/logs', icon: ScrollText },
+  { title: 'Backups', href: '/system/backups', icon: DatabaseBackup },
   { title: 'Update', href: '/system/update', icon: ArrowUpCircle },
 ]
 
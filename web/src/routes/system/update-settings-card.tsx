@@ -0,0 +1,93 @@
+import { RotateCcw, Settings2 } from 'lucide-react'
+import { toast } from 'sonner'
+
+import { Button } from '@/components/ui/button'
+import { Card, CardContent, CardHeader, CardTitle } from '@/components/ui/card'
+import { Label } from '@/components/ui/label'
+import { Select, SelectContent, SelectItem, SelectTrigger } from '@/components/ui/select'
+import { Switch } from '@/components/ui/switch'
+import { useRollbackUpdate, useSaveUpdaterSettings, useUpdaterSettings } from '@/hooks'
+import type { UpdateChannel } from '@/types/update'
+
+const CHANNELS: { value: UpdateChannel; label: string }[] = [
+  { value: 'stable', label: 'Stable' },
+  { value: 'beta', label: 'Beta' },
+  { value: 'nightly', label: 'Nightly' },
+]
+
+export function UpdateSettingsCard() {
+  const { data: settings } = useUpdaterSettings()
+  const saveSettings = useSaveUpdaterSettings()
+  const rollback = useRollbackUpdate()
+
+  if (!settings) {
+    return null
+  }
+
+  const save = (changes: Partial<{ autoInstall: boolean; channel: UpdateChannel }>) => {
+    saveSettings.mutate(
+      { autoInstall: settings.autoInstall, channel: settings.channel, ...changes },
+      { onError: (error) => toast.error('Failed to save update settings', { description: error.message }) },
+    )
+  }
+
+  const handleRollback = () => {
+    rollback.mutate(undefined, {
+      onSuccess: (result) => toast.success(`Rolling back to ${result.version}`),
+      onError: (error) => toast.error('Rollback failed', { description: error.message }),
+    })
+  }
+
+  return (
+    <Card className="mt-4">
+      <CardHeader>
+        <CardTitle className="flex items-center gap-2 text-base">
+          <Settings2 className="size-4" />
+          Update Settings
+        </CardTitle>
+      </CardHeader>
+      <CardContent className="space-y-4">
+        <div className="flex items-center justify-between">
+          <Label>Channel</Label>
+          <Select
+            value={settings.channel}
+            onValueChange={(v) => save({ channel: v as UpdateChannel })}
+            disabled={saveSettings.isPending}
+          >
+            <SelectTrigger className="w-auto">
+              {CHANNELS.find((c) => c.value === settings.channel)?.label}
+            </SelectTrigger>
+            <SelectContent>
+              {CHANNELS.map((channel) => (
+                <SelectItem key={channel.value} value={channel.value}>
+                  {channel.label}
+                </SelectItem>
+              ))}
+            </SelectContent>
+          </Select>
+        </div>
+        <div className="flex items-center justify-between">
+          <Label htmlFor="update-auto-install">Install updates automatically</Label>
+          <Switch
+            id="update-auto-install"
+            checked={settings.autoInstall}
+            onCheckedChange={(v) => save({ autoInstall: v })}
+            disabled={saveSettings.isPending}
+          />
+        </div>
+        {settings.pinnedVersion ? (
+          <p className="text-muted-foreground text-sm">
+            Pinned to {settings.pinnedVersion} after a rollback. Automatic installs resume after the
+            next manual update.
+          </p>
+        ) : null}
+        {settings.previousVersion ? (
+          <Button variant="outline" size="sm" onClick={handleRollback} disabled={rollback.isPending}>
+            <RotateCcw className="mr-1.5 size-3" />
+            Roll back to {settings.previousVersion}
+          </Button>
+        ) : null}
+      </CardContent>
+    </Card>
+  )
+}
@@ -18,6 +18,7 @@ import { cn } from '@/lib/utils'
 import type { UpdateState } from '@/types/update'
 
 import { SystemNav } from './system-nav'
+import { UpdateSettingsCard } from './update-settings-card'
 import { UpdateStateDisplay } from './update-state-display'
 import { useUpdatePage } from './use-update-page'
 
@@ -108,6 +109,7 @@ export function UpdatePage() {
               <ReleaseNotes notes={page.releaseNotes} />
             </CardContent>
           </Card> : null}
+        <UpdateSettingsCard />
       </div>
     </div>
   )
@@ -0,0 +1,68 @@
+import { toast } from 'sonner'
+
+import {
+  useBackups,
+  useCreateBackup,
+  useDeleteBackup,
+  useDownloadBackup,
+  useRestoreBackup,
+} from '@/hooks'
+import { useUIStore } from '@/stores'
+
+export function useBackupsPage() {
+  const globalLoading = useUIStore((s) => s.globalLoading)
+  const { data: backups, isLoading: queryLoading, isError, refetch } = useBackups()
+  const isLoading = queryLoading || globalLoading
+  const createMutation = useCreateBackup()
+  const deleteMutation = useDeleteBackup()
+  const restoreMutation = useRestoreBackup()
+  const downloadMutation = useDownloadBackup()
+
+  const handleCreate = async () => {
+    try {
+      await createMutation.mutateAsync()
+      toast.success('Backup created')
+    } catch {
+      toast.error('Failed to create backup')
+    }
+  }
+
+  const handleDelete = async (name: string) => {
+    try {
+      await deleteMutation.mutateAsync(name)
+      toast.success('Backup deleted')
+    } catch {
+      toast.error('Failed to delete backup')
+    }
+  }
+
+  const handleRestore = async (name: string) => {
+    try {
+      const result = await restoreMutation.mutateAsync(name)
+      toast.success(result?.message ?? 'Restore staged, restart SlipStream to apply it')
+    } catch (error) {
+      toast.error(error instanceof Error ? error.message : 'Failed to restore backup')
+    }
+  }
+
+  const handleDownload = async (name: string) => {
+    try {
+      await downloadMutation.mutateAsync(name)
+    } catch {
+      toast.error('Failed to download backup')
+    }
+  }
+
+  return {
+    backups,
+    isLoading,
+    isError,
+    refetch,
+    isCreatePending: createMutation.isPending,
+    isRestorePending: restoreMutation.isPending,
+    handleCreate,
+    handleDelete,
+    handleRestore,
+    handleDownload,
+  }
+}
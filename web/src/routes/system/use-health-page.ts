@@ -11,6 +11,13 @@ function buildCategories(health: HealthResponse | undefined) {
     { category: 'metadata', items: health?.metadata ?? EMPTY },
     { category: 'storage', items: health?.storage ?? EMPTY },
   ]
+  // Check-driven categories only have items while something is wrong
+  for (const category of ['import', 'definitions', 'queue'] as const) {
+    const items = health?.[category] ?? EMPTY
+    if (items.length > 0) {
+      categories.push({ category, items })
+    }
+  }
   return categories
 }
 
@@ -1,5 +1,11 @@
 import { create } from 'zustand'
 
+import type {
+  AutoSearchBatchProgress,
+  AutoSearchBatchTask,
+  AutoSearchSeriesProgress,
+} from '@/types/autosearch'
+
 type AutoSearchTaskState = {
   isRunning: boolean
   totalItems: number
@@ -26,6 +32,16 @@ type AutoSearchStore = {
   }) => void
   handleTaskCompleted: (payload: AutoSearchTaskResult) => void
   clearResult: () => void
+  batches: Partial<Record<AutoSearchBatchTask, AutoSearchBatchProgress>>
+  handleBatchProgress: (payload: AutoSearchBatchProgress) => void
+  seriesSearches: Record<string, AutoSearchSeriesProgress>
+  handleSeriesProgress: (payload: AutoSearchSeriesProgress) => void
+}
+
+// seriesSearchKey identifies a whole-series search, or a season search when
+// seasonNumber is set, in the seriesSearches map.
+export function seriesSearchKey(seriesId: number, seasonNumber?: number): string {
+  return seasonNumber === undefined ? `${seriesId}` : `${seriesId}:${seasonNumber}`
 }
 
 const initialTaskState: AutoSearchTaskState = {
@@ -38,6 +54,8 @@ const initialTaskState: AutoSearchTaskState = {
 
 export const useAutoSearchStore = create<AutoSearchStore>((set) => ({
   task: initialTaskState,
+  batches: {},
+  seriesSearches: {},
 
   handleTaskStarted: (payload) => {
     set({
@@ -82,4 +100,17 @@ export const useAutoSearchStore = create<AutoSearchStore>((set) => ({
       },
     }))
   },
+
+  handleBatchProgress: (payload) => {
+    set((state) => ({
+      batches: { ...state.batches, [payload.task]: payload },
+    }))
+  },
+
+  handleSeriesProgress: (payload) => {
+    const key = seriesSearchKey(payload.seriesId, payload.seasonNumber)
+    set((state) => ({
+      seriesSearches: { ...state.seriesSearches, [key]: payload },
+    }))
+  },
 }))
@@ -0,0 +1,25 @@
+import { create } from 'zustand'
+
+import type { ImportJobProgress } from '@/types/import'
+
+type ImportJobsState = {
+  // In-flight import jobs keyed by job ID
+  jobs: Record<string, ImportJobProgress>
+  updateJob: (progress: ImportJobProgress) => void
+  removeJob: (jobId: string) => void
+}
+
+export const useImportJobsStore = create<ImportJobsState>((set) => ({
+  jobs: {},
+
+  updateJob: (progress) => {
+    set((state) => ({ jobs: { ...state.jobs, [progress.jobId]: progress } }))
+  },
+
+  removeJob: (jobId) => {
+    set((state) => {
+      const { [jobId]: _removed, ...jobs } = state.jobs
+      return { jobs }
+    })
+  },
+}))
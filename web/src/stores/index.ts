@@ -1,5 +1,6 @@
 export { useAutoSearchStore } from './autosearch'
 export { useDevModeStore } from './devmode'
+export { useImportJobsStore } from './import-jobs'
 export { usePortalAuthStore } from './portal-auth'
 export { usePortalDownloadsStore } from './portal-downloads'
 export { useProgressStore } from './progress'
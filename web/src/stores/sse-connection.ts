@@ -0,0 +1,73 @@
+import type { WebSocketState, WSMessage } from './ws-types'
+import { SSE_URL } from './ws-types'
+
+type GetState = () => WebSocketState
+type SetState = (partial: Partial<WebSocketState>) => void
+
+type SSEReadyMessage = { type: 'sse:ready'; payload: { clientId: string } }
+
+// Server-Sent Events fallback for networks that break WebSockets. Messages use
+// the same envelope as the socket; outgoing messages are POSTed back using the
+// client id announced in the first event.
+export function connectSSE(get: GetState, set: SetState, token: string, onClosed: () => void): void {
+  const { eventSource } = get()
+  if (eventSource) {
+    eventSource.close()
+  }
+
+  const es = new EventSource(`${SSE_URL}?token=${encodeURIComponent(token)}`)
+
+  es.addEventListener('open', () => {
+    if (get().eventSource === es) {
+      set({ connected: true, reconnecting: false, reconnectAttempts: 0, lastMessageTime: Date.now() })
+    }
+  })
+
+  es.addEventListener('error', () => {
+    if (get().eventSource !== es) {
+      return
+    }
+    // Take over reconnection so it shares the WebSocket backoff
+    es.close()
+    set({ eventSource: null, sseClientId: null, connected: false })
+    onClosed()
+  })
+
+  es.addEventListener('message', (event: MessageEvent<string>) => {
+    if (get().eventSource !== es) {
+      return
+    }
+    try {
+      const message = JSON.parse(event.data) as WSMessage | SSEReadyMessage
+      if (message.type === 'sse:ready') {
+        set({ sseClientId: (message as SSEReadyMessage).payload.clientId, lastMessageTime: Date.now() })
+        return
+      }
+      set({ lastMessage: message as WSMessage, lastMessageTime: Date.now() })
+    } catch {
+      // Ignore malformed messages
+    }
+  })
+
+  set({ eventSource: es, reconnecting: false })
+}
+
+export function closeSSE(get: GetState, set: SetState): void {
+  const { eventSource } = get()
+  if (eventSource) {
+    eventSource.close()
+    set({ eventSource: null, sseClientId: null, connected: false })
+  }
+}
+
+export function sendSSE(get: GetState, token: string, message: unknown): void {
+  const { sseClientId, connected } = get()
+  if (!sseClientId || !connected) {
+    return
+  }
+  void fetch(`${SSE_URL}/${sseClientId}`, {
+    method: 'POST',
+    headers: { Authorization: `Bearer ${token}`, 'Content-Type': 'application/json' },
+    body: JSON.stringify(message),
+  })
+}
@@ -4,6 +4,8 @@ import { persist } from 'zustand/middleware'
 
 import { getDefaultVisibleColumns, MOVIE_COLUMNS, SERIES_COLUMNS } from '@/lib/table-columns'
 
+import type { RealtimeTransport } from './ws-types'
+
 type Notification = {
   id: string
   type: 'info' | 'success' | 'warning' | 'error'
@@ -55,6 +57,10 @@ type UIState = {
   getModuleTableColumns: (moduleId: string) => string[]
   setModuleTableColumns: (moduleId: string, cols: string[]) => void
 
+  // Real-time updates transport; SSE is a fallback for proxies that break WebSockets
+  realtimeTransport: RealtimeTransport
+  setRealtimeTransport: (transport: RealtimeTransport) => void
+
   // Global loading override (dev tool)
   globalLoading: boolean
   setGlobalLoading: (loading: boolean) => void
@@ -142,6 +148,8 @@ export const useUIStore = create<UIState>()(
       getModuleTableColumns: (moduleId) => resolveModuleTableColumns(get(), moduleId),
       setModuleTableColumns: (moduleId, cols) =>
         set((s) => ({ moduleTableColumns: { ...s.moduleTableColumns, [moduleId]: cols } })),
+      realtimeTransport: 'websocket',
+      setRealtimeTransport: (transport) => set({ realtimeTransport: transport }),
       globalLoading: false,
       setGlobalLoading: (loading) => set({ globalLoading: loading }),
       ...createNotificationSlice(set),
@@ -163,6 +171,7 @@ export const useUIStore = create<UIState>()(
         seriesSortDirection: state.seriesSortDirection,
         moduleViewPrefs: state.moduleViewPrefs,
         moduleTableColumns: state.moduleTableColumns,
+        realtimeTransport: state.realtimeTransport,
       }),
     },
   ),
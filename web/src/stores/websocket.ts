@@ -11,6 +11,8 @@ const REQUEST_INVALIDATE_DEBOUNCE_MS = 500
 
 export const useWebSocketStore = create<WebSocketState>((set, get) => ({
   socket: null,
+  eventSource: null,
+  sseClientId: null,
   connected: false,
   reconnecting: false,
   reconnectAttempts: 0,
@@ -1,5 +1,7 @@
 import { getAdminAuthToken } from '@/api/client'
 
+import { closeSSE, connectSSE, sendSSE } from './sse-connection'
+import { useUIStore } from './ui'
 import type { WebSocketState, WSMessage } from './ws-types'
 import {
   INITIAL_RECONNECT_DELAY,
@@ -117,6 +119,17 @@ export function createConnect(
     const token = getAdminAuthToken()
     if (!token) {return}
 
+    if (useUIStore.getState().realtimeTransport === 'sse') {
+      if (socket) {
+        closeSocketSilently(socket)
+        set({ socket: null, connected: false })
+      }
+      if (get().eventSource && !force) {return}
+      connectSSE(get, set, token, () => scheduleReconnect(get, set))
+      return
+    }
+    closeSSE(get, set)
+
     if (socket) {
       const alreadyConnected = handleExistingSocket(set, socket, {
         force,
@@ -143,14 +156,20 @@ export function createDisconnect(
       socket.close()
       set({ socket: null, connected: false })
     }
+    closeSSE(get, set)
   }
 }
 
 export function createSend(get: GetState): (message: unknown) => void {
   return (message) => {
-    const { socket, connected } = get()
+    const { socket, eventSource, connected } = get()
     if (socket && connected) {
       socket.send(JSON.stringify(message))
+      return
+    }
+    const token = getAdminAuthToken()
+    if (eventSource && token) {
+      sendSSE(get, token, message)
     }
   }
 }
@@ -4,16 +4,20 @@ import { adminRequestKeys } from '@/hooks/admin/use-admin-requests'
 import { inboxKeys } from '@/hooks/portal/use-inbox'
 import { requestKeys } from '@/hooks/portal/use-requests'
 import { systemHealthKeys } from '@/hooks/use-health'
+import { activityKeys } from '@/hooks/use-activity'
 import { historyKeys } from '@/hooks/use-history'
+import { renameKeys } from '@/hooks/use-import'
 import { missingKeys } from '@/hooks/use-missing'
 import { queueKeys } from '@/hooks/use-queue'
 import { schedulerKeys } from '@/hooks/use-scheduler'
 import { getModule } from '@/modules'
+import type { RenameProgress } from '@/types/import'
 import type { ProgressEventType } from '@/types/progress'
 
 import { useArtworkStore } from './artwork'
 import { useAutoSearchStore } from './autosearch'
 import { useDevModeStore } from './devmode'
+import { useImportJobsStore } from './import-jobs'
 import { useLogsStore } from './logs'
 import { usePortalDownloadsStore } from './portal-downloads'
 import { useProgressStore } from './progress'
@@ -96,6 +100,14 @@ function handleAutoSearchEvent(message: WSMessage): void {
       store.handleTaskCompleted(message.payload)
       break
     }
+    case 'autosearch:batch:progress': {
+      store.handleBatchProgress(message.payload)
+      break
+    }
+    case 'autosearch:series:progress': {
+      store.handleSeriesProgress(message.payload)
+      break
+    }
   }
 }
 
@@ -144,8 +156,30 @@ const historyHandler: MessageHandler = (_message, ctx) => {
   void ctx.queryClient.invalidateQueries({ queryKey: historyKeys.all })
 }
 
-const importHandler: MessageHandler = (_message, ctx) => {
-  void ctx.queryClient.invalidateQueries({ queryKey: missingKeys.counts() })
+const importHandler: MessageHandler = (message, ctx) => {
+  if (message.type === 'import:completed' || message.type === 'import:failed') {
+    useImportJobsStore.getState().removeJob(message.payload.jobId)
+  }
+  if (message.type === 'import:completed') {
+    void ctx.queryClient.invalidateQueries({ queryKey: missingKeys.counts() })
+  }
+}
+
+const importJobHandler: MessageHandler = (message) => {
+  if (message.type === 'import:started' || message.type === 'import:progress') {
+    useImportJobsStore.getState().updateJob(message.payload)
+  }
+}
+
+const renameHandler: MessageHandler = (message, ctx) => {
+  if (message.type === 'rename:progress') {
+    ctx.queryClient.setQueryData<RenameProgress[]>(renameKeys.jobs(), (jobs) =>
+      jobs?.map((job) => (job.id === message.payload.id ? message.payload : job)),
+    )
+    return
+  }
+  void ctx.queryClient.invalidateQueries({ queryKey: renameKeys.jobs() })
+  void ctx.queryClient.invalidateQueries({ queryKey: activityKeys.all })
 }
 
 const progressHandler: MessageHandler = (message) =>
@@ -194,6 +228,12 @@ const handlerMap: Partial<Record<WSMessageType, MessageHandler>> = {
   'download:completed': downloadCompletedHandler,
   'history:added': historyHandler,
   'import:completed': importHandler,
+  'import:failed': importHandler,
+  'import:started': importJobHandler,
+  'import:progress': importJobHandler,
+  'rename:started': renameHandler,
+  'rename:progress': renameHandler,
+  'rename:completed': renameHandler,
   'progress:started': progressHandler,
   'progress:update': progressHandler,
   'progress:completed': progressHandler,
@@ -203,6 +243,8 @@ const handlerMap: Partial<Record<WSMessageType, MessageHandler>> = {
   'autosearch:task:started': autoSearchHandler,
   'autosearch:task:progress': autoSearchHandler,
   'autosearch:task:completed': autoSearchHandler,
+  'autosearch:batch:progress': autoSearchHandler,
+  'autosearch:series:progress': autoSearchHandler,
   'rss-sync:started': schedulerTaskHandler,
   'rss-sync:completed': schedulerTaskHandler,
   'rss-sync:failed': schedulerTaskHandler,
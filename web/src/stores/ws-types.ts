@@ -1,3 +1,5 @@
+import type { AutoSearchBatchProgress, AutoSearchSeriesProgress } from '@/types/autosearch'
+import type { ImportJobProgress, RenameProgress } from '@/types/import'
 import type { LogEntry } from '@/types/logs'
 import type { Activity } from '@/types/progress'
 import type { QueueResponse } from '@/types/queue'
@@ -45,8 +47,20 @@ type HistoryMessage = {
 }
 
 type ImportMessage = {
-  type: 'import:completed'
-  payload: unknown
+  type: 'import:completed' | 'import:failed'
+  payload: { jobId: string }
+  timestamp: string
+}
+
+type ImportJobMessage = {
+  type: 'import:started' | 'import:progress'
+  payload: ImportJobProgress
+  timestamp: string
+}
+
+type RenameMessage = {
+  type: 'rename:started' | 'rename:progress' | 'rename:completed'
+  payload: RenameProgress
   timestamp: string
 }
 
@@ -80,6 +94,18 @@ type AutoSearchCompletedMessage = {
   timestamp: string
 }
 
+type AutoSearchBatchProgressMessage = {
+  type: 'autosearch:batch:progress'
+  payload: AutoSearchBatchProgress
+  timestamp: string
+}
+
+type AutoSearchSeriesProgressMessage = {
+  type: 'autosearch:series:progress'
+  payload: AutoSearchSeriesProgress
+  timestamp: string
+}
+
 type SchedulerMessage = {
   type: 'rss-sync:started' | 'rss-sync:completed' | 'rss-sync:failed' | 'scheduler:task:started' | 'scheduler:task:completed'
   payload: unknown
@@ -123,11 +149,15 @@ export type WSMessage =
   | DownloadCompletedMessage
   | HistoryMessage
   | ImportMessage
+  | ImportJobMessage
+  | RenameMessage
   | ProgressMessage
   | ArtworkMessage
   | AutoSearchStartedMessage
   | AutoSearchProgressMessage
   | AutoSearchCompletedMessage
+  | AutoSearchBatchProgressMessage
+  | AutoSearchSeriesProgressMessage
   | SchedulerMessage
   | HealthMessage
   | DevModeMessage
@@ -137,8 +167,12 @@ export type WSMessage =
 
 export type WSMessageType = WSMessage['type']
 
+export type RealtimeTransport = 'websocket' | 'sse'
+
 export type WebSocketState = {
   socket: WebSocket | null
+  eventSource: EventSource | null
+  sseClientId: string | null
   connected: boolean
   reconnecting: boolean
   reconnectAttempts: number
@@ -150,6 +184,7 @@ export type WebSocketState = {
 }
 
 export const WS_URL = `${globalThis.location.protocol === 'https:' ? 'wss:' : 'ws:'}//${globalThis.location.host}/ws`
+export const SSE_URL = `${globalThis.location.protocol}//${globalThis.location.host}/events`
 export const INITIAL_RECONNECT_DELAY = 3000
 export const MAX_RECONNECT_DELAY = 60_000
 export const MAX_RECONNECT_ATTEMPTS = 20
@@ -0,0 +1,35 @@
+export type ActivityKind =
+  | 'import'
+  | 'rename'
+  | 'search'
+  | 'task'
+  | 'operation'
+  | 'download'
+  | 'pending-import'
+
+export type ActivityItem = {
+  id: string
+  kind: ActivityKind
+  title: string
+  subtitle?: string
+  status: string
+  progress: number // 0-100, -1 when indeterminate
+  startedAt?: string
+  metadata?: Record<string, unknown>
+}
+
+export type ActivityListOptions = {
+  kind?: ActivityKind[]
+  page?: number
+  pageSize?: number
+}
+
+export type ActivityListResponse = {
+  items: ActivityItem[]
+  counts: Partial<Record<ActivityKind, number>>
+  page: number
+  pageSize: number
+  totalCount: number
+  totalPages: number
+  errors?: { clientId: number; clientName: string; message: string }[]
+}
@@ -1,4 +1,10 @@
-export type ApiErrorData = { message?: string; error?: string }
+export type ApiErrorData = {
+  code?: string
+  message?: string
+  error?: string
+  details?: unknown
+  correlationId?: string
+}
 
 export function isApiErrorData(value: unknown): value is ApiErrorData {
   if (!value || typeof value !== 'object') {return false}
@@ -18,4 +24,40 @@ export class ApiError extends Error {
     this.status = status
     this.data = data
   }
+
+  get code(): string | undefined {
+    return this.data?.code
+  }
+
+  get correlationId(): string | undefined {
+    return this.data?.correlationId
+  }
+}
+
+export type PathConflict = {
+  path: string
+  reason: 'in_use' | 'unrelated_files'
+  conflictingTitle?: string
+  suggestions: string[]
+}
+
+export type MoveFolderInput = {
+  rootFolderId?: number
+  path?: string
+}
+
+export type MoveFolderResult = {
+  mediaType: 'movie' | 'series'
+  id: number
+  oldPath: string
+  newPath: string
+  rootFolderId: number
+  activityId?: string
+}
+
+export function getPathConflict(error: unknown): PathConflict | null {
+  if (!(error instanceof ApiError) || error.code !== 'path_conflict') {return null}
+  const conflict = error.data?.details
+  if (!conflict || typeof conflict !== 'object' || !('suggestions' in conflict)) {return null}
+  return conflict as PathConflict
 }
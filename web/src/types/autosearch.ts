@@ -10,6 +10,8 @@ export type AutoSearchResult = {
   upgraded: boolean
   clientName?: string
   downloadId?: string
+  deferred?: boolean
+  pendingApproval?: boolean
 }
 
 export type SlotSearchResult = {
@@ -24,6 +26,7 @@ export type BatchAutoSearchResult = {
   found: number
   downloaded: number
   failed: number
+  cancelled?: boolean
   results?: AutoSearchResult[]
 }
 
@@ -39,4 +42,55 @@ export type AutoSearchSettings = {
   enabled: boolean
   intervalHours: number
   backoffThreshold: number
+  batchSize: number
+  batchPauseSeconds: number
+  batchSmartListId: number // 0 searches the whole library
+  maxGrabsPerHour: number // 0 disables the cap
+  maxGrabsPerDay: number // 0 disables the cap
+  pauseGrabsBelowFreeSpaceGb: number // 0 disables the guard
+  grabFreeSpaceBufferGb: number // Kept free in the target root folder after a grab
+  grabApprovalMode: GrabApprovalMode
+  grabApprovalTagIds: number[] | null // Used when grabApprovalMode is 'tagged'
+  minimumAvailability: MinimumAvailability // Default for items without their own setting
+  slotAutoFill: boolean // Search each version slot with its own profile
+  seasonPackMissingPercent: number // Missing share of a season that prefers a pack
+  upgradeWindowStartHour: number // Cutoff unmet search window, local hour
+  upgradeWindowEndHour: number // Equal to the start hour for no window
+  upgradeTagIds: number[] | null // Empty searches every item
+}
+
+export type GrabApprovalMode = 'off' | 'all' | 'tagged'
+
+export type MinimumAvailability = 'announced' | 'in_cinemas' | 'released' | 'physical'
+
+export type AutoSearchBatchTask = 'missing' | 'cutoff-unmet'
+
+export type AutoSearchBatchStatus = 'running' | 'paused' | 'backoff' | 'completed' | 'stopped'
+
+export type AutoSearchBatchProgress = {
+  task: AutoSearchBatchTask
+  status: AutoSearchBatchStatus
+  processed: number
+  total: number
+  batch: number
+  found: number
+  downloaded: number
+  failed: number
+  currentTitle?: string
+  resumeAt?: string
+}
+
+export type AutoSearchSeriesStatus = 'running' | 'completed' | 'cancelled'
+
+export type AutoSearchSeriesProgress = {
+  seriesId: number
+  seasonNumber?: number
+  title: string
+  status: AutoSearchSeriesStatus
+  searched: number
+  total: number
+  found: number
+  downloaded: number
+  failed: number
+  currentTitle?: string
 }
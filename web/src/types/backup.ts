@@ -0,0 +1,8 @@
+export type BackupReason = 'scheduled' | 'manual' | 'migration' | 'update' | 'restore'
+
+export type Backup = {
+  name: string
+  reason: BackupReason
+  size: number // bytes
+  createdAt: string
+}
@@ -18,4 +18,17 @@ export type CalendarRequest = {
   end: string // YYYY-MM-DD
 }
 
+export type ComingSoonDownload = {
+  status: string
+  progress: number // 0-100
+  eta: number // seconds, -1 if unavailable
+}
+
+// A monitored episode airing this week or movie releasing digitally this month
+export type ComingSoonItem = CalendarEvent & {
+  posterUrl?: string
+  backdropUrl?: string
+  download?: ComingSoonDownload
+}
+
 export type CalendarView = 'month' | 'week' | 'agenda'
@@ -13,6 +13,20 @@ export type DownloadClientType =
   | 'rqbit'
   | 'tribler'
 
+export type CleanupMode = 'leave' | 'delete_after_import' | 'delete_after_seed_ratio'
+
+export type SeedingImportMode = 'delay' | 'hardlink'
+
+export type ImportDelayOverride = {
+  category: string
+  delaySeconds: number
+}
+
+export type PathMapping = {
+  remote: string
+  local: string
+}
+
 export type DownloadClient = {
   id: number
   name: string
@@ -27,6 +41,14 @@ export type DownloadClient = {
   urlBase?: string
   priority: number
   enabled: boolean
+  importDelaySeconds: number
+  importDelayOverrides: ImportDelayOverride[]
+  seedingImportMode: SeedingImportMode
+  cleanupMode: CleanupMode
+  seedRatioTarget?: number
+  seedTimeTargetMinutes?: number
+  pathMappings: PathMapping[]
+  tags: number[]
   createdAt: string
   updatedAt: string
 }
@@ -44,6 +66,14 @@ export type CreateDownloadClientInput = {
   urlBase?: string
   priority?: number
   enabled?: boolean
+  importDelaySeconds?: number
+  importDelayOverrides?: ImportDelayOverride[]
+  seedingImportMode?: SeedingImportMode
+  cleanupMode?: CleanupMode
+  seedRatioTarget?: number
+  seedTimeTargetMinutes?: number
+  pathMappings?: PathMapping[]
+  tags?: number[]
 }
 
 export type UpdateDownloadClientInput = {
@@ -59,6 +89,14 @@ export type UpdateDownloadClientInput = {
   urlBase?: string
   priority?: number
   enabled?: boolean
+  importDelaySeconds?: number
+  importDelayOverrides?: ImportDelayOverride[]
+  seedingImportMode?: SeedingImportMode
+  cleanupMode?: CleanupMode
+  seedRatioTarget?: number
+  seedTimeTargetMinutes?: number
+  pathMappings?: PathMapping[]
+  tags?: number[]
 }
 
 export type DownloadClientTestResult = {
@@ -10,6 +10,8 @@ export type HealthCategory =
   | 'metadata'
   | 'storage'
   | 'import'
+  | 'definitions'
+  | 'queue'
 
 // HealthItem represents a single health-tracked item
 export type HealthItem = {
@@ -38,6 +40,8 @@ export type HealthResponse = {
   metadata: HealthItem[]
   storage: HealthItem[]
   import: HealthItem[]
+  definitions: HealthItem[]
+  queue: HealthItem[]
 }
 
 // HealthSummary provides an overview of system health
@@ -46,6 +50,19 @@ export type HealthSummary = {
   hasIssues: boolean
 }
 
+// HealthIssue is a non-OK health item with a suggested action
+export type HealthIssue = {
+  category: HealthCategory
+  id: string
+  name: string
+  severity: Exclude<HealthStatus, 'ok'>
+  message: string
+  action?: string
+  since?: string
+  dismissed: boolean
+  mutedUntil?: string
+}
+
 // TestCategoryResult represents the result of testing a category
 export type TestCategoryResult = {
   category: HealthCategory
@@ -69,6 +86,8 @@ export function getCategoryDisplayName(category: HealthCategory): string {
     metadata: 'Metadata',
     storage: 'Storage',
     import: 'Import',
+    definitions: 'Indexer Definitions',
+    queue: 'Download Queue',
   }
   return names[category]
 }
@@ -83,6 +102,8 @@ export function getCategorySettingsPath(category: HealthCategory): string {
     metadata: '/settings/media/root-folders',
     storage: '/settings/media/root-folders',
     import: '/import',
+    definitions: '/settings/download-pipeline/indexers',
+    queue: '/downloads',
   }
   return paths[category]
 }
@@ -7,6 +7,7 @@ export type HistoryEventType =
   | 'autosearch_download'
   | 'autosearch_failed'
   | 'import_failed'
+  | 'import_undone'
   | 'slot_assigned'
   | 'slot_reassigned'
   | 'slot_unassigned'
@@ -61,6 +62,7 @@ type ImportEventData = {
   newQuality?: string
   clientName?: string
   linkMode?: string
+  linkFallbackReason?: string
 }
 
 type StatusChangedData = {
@@ -101,3 +103,37 @@ export type HistoryResponse = {
   totalCount: number
   totalPages: number
 }
+
+export type HistorySnapshotFile = {
+  path: string
+  quality?: string
+  slotId?: number
+  importedAt: string
+  eventId: number
+}
+
+export type HistorySnapshotSlot = {
+  slotId: number
+  slotName?: string
+  filePath?: string
+}
+
+export type HistorySnapshotGrab = {
+  releaseName?: string
+  quality?: string
+  indexer?: string
+  isUpgrade: boolean
+  grabbedAt: string
+}
+
+export type HistorySnapshot = {
+  mediaType: 'movie' | 'episode'
+  mediaId: number
+  at: string
+  status?: string
+  quality?: string
+  files: HistorySnapshotFile[]
+  slots: HistorySnapshotSlot[]
+  lastGrab?: HistorySnapshotGrab
+  events: HistoryEntry[]
+}
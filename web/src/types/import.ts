@@ -52,6 +52,74 @@ export type PendingImport = {
   isProcessing: boolean
 }
 
+// Share of automatic matches per source that were never corrected by a manual import
+export type MatchAccuracy = {
+  source: string
+  total: number
+  corrected: number
+  accuracy: number // 0.0 - 1.0
+}
+
+// Guides video file discovery in downloads from an indexer or release group
+export type FolderHintScope = 'indexer' | 'release_group'
+
+export type FolderHint = {
+  id: number
+  scope: FolderHintScope
+  scopeKey: string
+  contentPath: string
+  skipPatterns: string[]
+  learned: boolean
+  hits: number
+  updatedAt: string
+}
+
+export type FolderHintInput = {
+  scope: FolderHintScope
+  scopeKey: string
+  contentPath: string
+  skipPatterns: string[]
+}
+
+// Paths, folder names or titles that are never imported automatically
+export type ImportExclusionKind = 'path' | 'folder' | 'title'
+
+export type ImportExclusion = {
+  id: number
+  kind: ImportExclusionKind
+  value: string
+  year?: number
+  reason?: string
+  createdAt: string
+}
+
+export type ImportExclusionInput = {
+  kind: ImportExclusionKind
+  value: string
+  year?: number
+  reason?: string
+}
+
+// Folder that files replaced by upgrades are moved to; empty when disabled
+export type RecycleBinSettings = {
+  path: string
+}
+
+// Removal of a download's folder once all of its video files are imported
+export type DownloadCleanupSettings = {
+  enabled: boolean
+  junkExtensions: string[]
+}
+
+export type UndoImportResult = {
+  historyId: number
+  mediaType: 'movie' | 'episode'
+  mediaId: number
+  removedPath: string
+  returnedTo?: string
+  restoredFile?: string
+}
+
 // Manual import types
 export type ManualImportRequest = {
   path: string
@@ -60,6 +128,7 @@ export type ManualImportRequest = {
   seriesId?: number
   seasonNum?: number
   targetSlotId?: number
+  qualityId?: number
 }
 
 export type ImportSlotAssignment = {
@@ -76,6 +145,7 @@ export type ManualImportResponse = {
   sourcePath: string
   destinationPath?: string
   linkMode?: string
+  linkFallbackReason?: string
   isUpgrade: boolean
   error?: string
   requiresSlotSelection: boolean
@@ -84,6 +154,18 @@ export type ManualImportResponse = {
   assignedSlotId?: number
 }
 
+export type BatchManualImportRequest = {
+  files: ManualImportRequest[]
+}
+
+export type BatchManualImportResponse = {
+  jobId: string
+  total: number
+  succeeded: number
+  failed: number
+  results: ManualImportResponse[]
+}
+
 // Preview import types
 export type ParsedMediaInfo = {
   title?: string
@@ -131,6 +213,35 @@ export type ScanDirectoryResponse = {
   files: ScannedFile[]
   total: number
   valid: number
+  excluded: number
+}
+
+// Automatic import dry run types
+export type ImportDryRunFile = {
+  path: string
+  fileName: string
+  wouldImport: boolean
+  rejectionReason?: string
+  mediaType?: string
+  movieId?: number
+  seriesId?: number
+  episodeIds?: number[]
+  matchSource?: string
+  confidence?: number
+  destinationPath?: string
+  quality?: string
+  existingQuality?: string
+  isUpgrade: boolean
+  previousFile?: string
+  targetSlotId?: number
+}
+
+export type ImportDryRunResult = {
+  mappingId: number
+  downloadClientId: number
+  downloadId: string
+  downloadPath: string
+  files: ImportDryRunFile[]
 }
 
 // Filename parsing types
@@ -145,3 +256,51 @@ export type ParseFilenameResponse = {
   parsedInfo?: ParsedMediaInfo
   tokens: ParsedTokenDetail[]
 }
+
+export type RenameStatus = 'running' | 'completed' | 'cancelled'
+
+export type RenameFileResult = {
+  id: number
+  mediaType: string
+  currentPath: string
+  currentFilename: string
+  newPath: string
+  newFilename: string
+  needsRename: boolean
+  error?: string
+  title: string
+  seriesTitle?: string
+}
+
+export type RenameProgress = {
+  id: string
+  mediaType: 'episode' | 'movie'
+  status: RenameStatus
+  total: number
+  done: number
+  succeeded: number
+  failed: number
+  skipped: number
+  currentFile?: string
+  errors?: RenameFileResult[]
+  resumed?: boolean
+  startedAt: string
+}
+
+export type ExecuteRenameRequest = {
+  mediaType: 'series' | 'episode' | 'movie'
+  fileIds: number[]
+}
+
+export type ImportJobStage = 'importing' | 'copying' | 'verifying'
+
+export type ImportJobProgress = {
+  jobId: string
+  sourcePath: string
+  fileName: string
+  stage: ImportJobStage
+  bytesCopied: number
+  totalBytes: number
+  bytesPerSecond: number
+  startedAt: string
+}
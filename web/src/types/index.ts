@@ -1,5 +1,7 @@
 export * from './api'
+export type * from './activity'
 export type * from './autosearch'
+export type * from './backup'
 export type * from './calendar'
 export type * from './defaults'
 export type * from './download-client'
@@ -8,6 +10,7 @@ export * from './health'
 export type * from './history'
 export type * from './import'
 export type * from './indexer'
+export type * from './language-profile'
 export type * from './logs'
 export type * from './metadata'
 export type * from './missing'
@@ -24,5 +27,8 @@ export type * from './scheduler'
 export type * from './search'
 export type * from './series'
 export type * from './slots'
+export type * from './smart-list'
+export type * from './stats'
 export type * from './system'
+export type * from './tag'
 export type * from './update'
@@ -20,7 +20,10 @@ export type Indexer = {
   enabled: boolean
   autoSearchEnabled: boolean
   rssEnabled: boolean
+  proxyUrl: string
+  flareSolverrUrl: string
   settings?: Record<string, string>
+  tags: number[]
   createdAt?: string
   updatedAt?: string
 }
@@ -37,6 +40,9 @@ export type CreateIndexerInput = {
   enabled?: boolean
   autoSearchEnabled?: boolean
   rssEnabled?: boolean
+  proxyUrl?: string
+  flareSolverrUrl?: string
+  tags?: number[]
 }
 
 // UpdateIndexerInput is the input for updating an indexer
@@ -51,12 +57,17 @@ export type UpdateIndexerInput = {
   enabled?: boolean
   autoSearchEnabled?: boolean
   rssEnabled?: boolean
+  proxyUrl?: string
+  flareSolverrUrl?: string
+  tags?: number[]
 }
 
 // TestConfigInput is the input for testing an indexer configuration
 export type TestConfigInput = {
   definitionId: string
   settings?: Record<string, string>
+  proxyUrl?: string
+  flareSolverrUrl?: string
 }
 
 // IndexerTestResult is the result of testing an indexer
@@ -97,6 +108,48 @@ export type IndexerStatus = {
   lastRssSync?: string
 }
 
+// IndexerStats summarizes an indexer's query and grab activity over a period
+export type IndexerStats = {
+  indexerId: number
+  indexerName: string
+  queries: number
+  failedQueries: number
+  failureRate: number
+  avgResponseMs: number
+  grabs: number
+  failedGrabs: number
+  quarantinedRows: number
+  escalationLevel: number
+  disabledTill?: string
+  isDisabled: boolean
+}
+
+// QuarantinedRow is a malformed search result row kept for inspection
+export type QuarantinedRow = {
+  id: number
+  indexerId: number
+  query?: string
+  reason: string
+  rawFragment: string
+  createdAt?: string
+}
+
+// IndexerSeedGoal is the seed ratio and/or seed time an indexer requires
+// before grabbed torrents are removed from the download client
+export type IndexerSeedGoal = {
+  indexerId: number
+  seedRatioTarget?: number
+  seedTimeTargetMinutes?: number
+}
+
+// IndexerGrabCap limits automatic grabs from an indexer per hour and per day;
+// 0 disables the cap for that window
+export type IndexerGrabCap = {
+  indexerId: number
+  maxGrabsPerHour: number
+  maxGrabsPerDay: number
+}
+
 // DefinitionMetadata contains metadata about a Cardigann definition
 export type DefinitionMetadata = {
   id: string
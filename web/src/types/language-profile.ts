@@ -0,0 +1,16 @@
+export type LanguageProfile = {
+  id: number
+  name: string
+  languages: string[] // ordered by preference; the first is preferred
+  required: boolean
+  acceptMulti: boolean
+  createdAt: string
+  updatedAt: string
+}
+
+export type LanguageProfileInput = {
+  name: string
+  languages: string[]
+  required: boolean
+  acceptMulti: boolean
+}
@@ -8,3 +8,15 @@ export type LogEntry = {
 }
 
 export type LogLevel = 'debug' | 'info' | 'warn' | 'error' | 'fatal'
+
+export type LogLevels = {
+  level: string
+  components: Record<string, string>
+}
+
+export type LogQuery = {
+  level?: LogLevel
+  component?: string
+  q?: string
+  limit?: number
+}
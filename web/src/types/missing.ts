@@ -95,3 +95,33 @@ export type UpgradableCounts = {
   movies: number
   episodes: number
 }
+
+export type WantedItem = {
+  mediaType: 'movie' | 'episode'
+  id: number
+  title: string
+  year?: number
+  date?: string
+  qualityProfileId: number
+  currentQualityId?: number
+  seriesId?: number
+  seriesTitle?: string
+  seasonNumber?: number
+  episodeNumber?: number
+}
+
+export type WantedOptions = {
+  mediaType?: 'movie' | 'episode'
+  sort?: 'date' | 'title'
+  order?: 'asc' | 'desc'
+  page?: number
+  pageSize?: number
+}
+
+export type WantedResponse = {
+  items: WantedItem[]
+  page: number
+  pageSize: number
+  totalCount: number
+  totalPages: number
+}
@@ -1,3 +1,5 @@
+import type { MinimumAvailability } from './autosearch'
+
 export type Movie = {
   id: number
   title: string
@@ -13,6 +15,9 @@ export type Movie = {
   path?: string
   rootFolderId: number
   qualityProfileId: number
+  languageProfileId?: number
+  minimumAvailability?: MinimumAvailability // Unset uses the autosearch default
+  editionPreference?: string // Theatrical, Director's Cut, ...; unset accepts any edition
   monitored: boolean
   status: 'unreleased' | 'missing' | 'downloading' | 'failed' | 'upgradable' | 'available'
   statusMessage?: string | null
@@ -21,6 +26,7 @@ export type Movie = {
   updatedAt?: string
   sizeOnDisk: number
   movieFiles: MovieFile[]
+  tags: number[]
   releaseDate?: string
   physicalReleaseDate?: string
   theatricalReleaseDate?: string
@@ -28,6 +34,12 @@ export type Movie = {
   addedByUsername?: string
 }
 
+export type AudioTrack = {
+  codec: string
+  channels?: string
+  language?: string
+}
+
 export type MovieFile = {
   id: number
   movieId: number
@@ -38,7 +50,10 @@ export type MovieFile = {
   audioCodec?: string
   audioChannels?: string
   dynamicRange?: string
+  dolbyVisionProfile?: string
+  audioTracks?: AudioTrack[]
   resolution?: string
+  edition?: string
   createdAt: string
   slotId?: number
 }
@@ -53,7 +68,9 @@ export type CreateMovieInput = {
   path?: string
   rootFolderId: number
   qualityProfileId: number
+  languageProfileId?: number
   monitored: boolean
+  tags?: number[]
 }
 
 export type AddMovieInput = {
@@ -72,11 +89,18 @@ export type UpdateMovieInput = {
   path?: string
   rootFolderId?: number
   qualityProfileId?: number
+  languageProfileId?: number // 0 clears the language profile
+  minimumAvailability?: MinimumAvailability | '' // '' reverts to the autosearch default
+  editionPreference?: string // '' accepts any edition
   monitored?: boolean
+  tags?: number[]
 }
 
 export type ListMoviesOptions = {
   search?: string
   monitored?: boolean
   rootFolderId?: number
+  qualityProfileId?: number
+  tag?: number
+  status?: string // comma-separated
 }
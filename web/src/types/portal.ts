@@ -10,6 +10,7 @@ export type PortalUser = {
   moduleSettings: UserModuleSetting[]
   autoApprove: boolean
   enabled: boolean
+  canStream: boolean
   isAdmin: boolean
   createdAt: string
   updatedAt: string
@@ -70,6 +71,28 @@ export type CreateInvitationRequest = {
   autoApprove?: boolean
 }
 
+// Embeddable widget keys; key is only present in the create response
+export type WidgetKey = {
+  id: number
+  name: string
+  keyPrefix: string
+  key?: string
+  userId: number
+  allowedOrigins: string[]
+  rateLimitPerMinute: number
+  enabled: boolean
+  lastUsedAt?: string
+  createdAt: string
+}
+
+export type WidgetKeyInput = {
+  name: string
+  userId: number
+  allowedOrigins: string[]
+  rateLimitPerMinute?: number
+  enabled?: boolean
+}
+
 export type ValidateInvitationResponse = {
   valid: boolean
   username: string
@@ -115,6 +138,10 @@ export type Request = {
   updatedAt: string
   user?: PortalUser
   isWatching?: boolean
+  requesters?: Pick<PortalUser, 'id' | 'username'>[]
+  voteCount?: number
+  hasVoted?: boolean
+  merged?: boolean
 }
 
 export type CreateRequestInput = {
@@ -128,6 +155,14 @@ export type CreateRequestInput = {
   monitorFuture?: boolean
   posterUrl?: string
   requestedSeasons?: number[]
+  targetSlotId?: number
+}
+
+export type RequestableSlot = {
+  id: number
+  name: string
+  qualityProfileId?: number
+  qualityProfileName?: string
 }
 
 export type RequestListFilters = {
@@ -296,6 +331,7 @@ export type AdminUpdateUserInput = {
   username?: string
   moduleSettings?: Record<string, number | null>
   autoApprove?: boolean
+  canStream?: boolean
 }
 
 // Portal download (queue item filtered to user's requests)
@@ -54,6 +54,8 @@ export type ProwlarrIndexer = {
   enable: boolean
   status: ProwlarrIndexerStatus
   capabilities?: ProwlarrIndexerCapabilities
+  implementation?: string
+  definitionName?: string
 }
 
 // ProwlarrIndexerStatus represents the health status of a Prowlarr indexer
@@ -188,3 +190,38 @@ export const ContentTypeLabels: Record<ContentType, string> = {
   series: 'Series Only',
   both: 'Both',
 }
+
+// ProwlarrIndexerSyncAction describes what a sync run did with one Prowlarr indexer
+export type ProwlarrIndexerSyncAction = 'created' | 'updated' | 'disabled' | 'skipped'
+
+// ProwlarrIndexerSyncItem is the per-indexer outcome of a sync run
+export type ProwlarrIndexerSyncItem = {
+  prowlarrId: number
+  indexerId?: number
+  name: string
+  action: ProwlarrIndexerSyncAction
+  reason?: string
+}
+
+// ProwlarrIndexerSyncResult summarizes one sync run
+export type ProwlarrIndexerSyncResult = {
+  startedAt: string
+  finishedAt: string
+  created: number
+  updated: number
+  disabled: number
+  skipped: number
+  items: ProwlarrIndexerSyncItem[]
+  error?: string
+}
+
+// ProwlarrIndexerSyncStatus is the scheduled sync setting and last run
+export type ProwlarrIndexerSyncStatus = {
+  enabled: boolean
+  lastSync?: ProwlarrIndexerSyncResult
+}
+
+// ProwlarrIndexerSyncInput is the input for updating the scheduled sync setting
+export type ProwlarrIndexerSyncInput = {
+  enabled: boolean
+}
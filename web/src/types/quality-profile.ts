@@ -4,11 +4,33 @@ export type Quality = {
   source: string
   resolution: number
   weight: number
+  matchPattern?: string // custom qualities only; case-insensitive regex matched against release titles
+}
+
+export type QualityInput = {
+  name: string
+  source: string
+  resolution: number
+  weight: number
+  matchPattern: string
+}
+
+// IDs below this are reserved for built-in qualities
+export const FIRST_CUSTOM_QUALITY_ID = 100
+
+export function isCustomQuality(quality: Quality): boolean {
+  return quality.id >= FIRST_CUSTOM_QUALITY_ID
+}
+
+export function qualityNameFromItems(items: QualityItem[], qualityId: number): string {
+  return items.find((item) => item.quality.id === qualityId)?.quality.name ?? 'Unknown'
 }
 
 export type QualityItem = {
   quality: Quality
   allowed: boolean
+  minSize?: number // MB per minute of runtime
+  maxSize?: number // MB per minute of runtime
 }
 
 export type AttributeMode = 'acceptable' | 'preferred' | 'required' | 'notAllowed'
@@ -17,6 +39,22 @@ export type AttributeSettings = {
   items: Record<string, AttributeMode> // value -> mode mapping (e.g., { "DV": "required", "HDR10": "preferred" })
 }
 
+// Release group names match case-insensitively. Forbidden groups are rejected
+// during release selection and import; preferred groups score higher.
+export type ReleaseGroupSettings = {
+  preferred: string[]
+  forbidden: string[]
+}
+
+// How a profile treats a tracker flag: '' ignores it, 'prefer' scores flagged
+// torrents higher and 'require' rejects torrents without it.
+export type FlagPreference = '' | 'prefer' | 'require'
+
+export type IndexerFlagSettings = {
+  freeleech: FlagPreference
+  internal: FlagPreference
+}
+
 export type UpgradeStrategy = 'aggressive' | 'balanced' | 'resolution_only'
 
 export type QualityProfile = {
@@ -33,6 +71,8 @@ export type QualityProfile = {
   videoCodecSettings: AttributeSettings
   audioCodecSettings: AttributeSettings
   audioChannelSettings: AttributeSettings
+  releaseGroups: ReleaseGroupSettings
+  indexerFlags: IndexerFlagSettings
   createdAt: string
   updatedAt: string
 }
@@ -50,6 +90,8 @@ export type CreateQualityProfileInput = {
   videoCodecSettings: AttributeSettings
   audioCodecSettings: AttributeSettings
   audioChannelSettings: AttributeSettings
+  releaseGroups: ReleaseGroupSettings
+  indexerFlags: IndexerFlagSettings
 }
 
 export type UpdateQualityProfileInput = {
@@ -64,6 +106,8 @@ export type UpdateQualityProfileInput = {
   videoCodecSettings: AttributeSettings
   audioCodecSettings: AttributeSettings
   audioChannelSettings: AttributeSettings
+  releaseGroups: ReleaseGroupSettings
+  indexerFlags: IndexerFlagSettings
 }
 
 export type AttributeOptions = {
@@ -78,27 +122,15 @@ export const DEFAULT_ATTRIBUTE_SETTINGS: AttributeSettings = {
   items: {},
 }
 
-// TODO: Replace with fetch from /api/v1/qualityprofiles/qualities (backend endpoint exists)
-export const PREDEFINED_QUALITIES: Quality[] = [
-  { id: 1, name: 'SDTV', source: 'tv', resolution: 480, weight: 1 },
-  { id: 2, name: 'DVD', source: 'dvd', resolution: 480, weight: 2 },
-  { id: 3, name: 'WEBRip-480p', source: 'webrip', resolution: 480, weight: 3 },
-  { id: 4, name: 'HDTV-720p', source: 'tv', resolution: 720, weight: 4 },
-  { id: 5, name: 'WEBRip-720p', source: 'webrip', resolution: 720, weight: 5 },
-  { id: 6, name: 'WEBDL-720p', source: 'webdl', resolution: 720, weight: 6 },
-  { id: 7, name: 'Bluray-720p', source: 'bluray', resolution: 720, weight: 7 },
-  { id: 8, name: 'HDTV-1080p', source: 'tv', resolution: 1080, weight: 8 },
-  { id: 9, name: 'WEBRip-1080p', source: 'webrip', resolution: 1080, weight: 9 },
-  { id: 10, name: 'WEBDL-1080p', source: 'webdl', resolution: 1080, weight: 10 },
-  { id: 11, name: 'Bluray-1080p', source: 'bluray', resolution: 1080, weight: 11 },
-  { id: 12, name: 'Remux-1080p', source: 'remux', resolution: 1080, weight: 12 },
-  { id: 13, name: 'HDTV-2160p', source: 'tv', resolution: 2160, weight: 13 },
-  { id: 14, name: 'WEBRip-2160p', source: 'webrip', resolution: 2160, weight: 14 },
-  { id: 15, name: 'WEBDL-2160p', source: 'webdl', resolution: 2160, weight: 15 },
-  { id: 16, name: 'Bluray-2160p', source: 'bluray', resolution: 2160, weight: 16 },
-  { id: 17, name: 'Remux-2160p', source: 'remux', resolution: 2160, weight: 17 },
-  { id: 18, name: 'CAM', source: 'cam', resolution: 0, weight: 0 },
-]
+export const DEFAULT_RELEASE_GROUPS: ReleaseGroupSettings = {
+  preferred: [],
+  forbidden: [],
+}
+
+export const DEFAULT_INDEXER_FLAGS: IndexerFlagSettings = {
+  freeleech: '',
+  internal: '',
+}
 
 // Exclusivity checking types (Req 3.1.1-3.1.4)
 export type ExclusivityDetail = {
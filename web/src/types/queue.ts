@@ -54,3 +54,22 @@ export type QueueStats = {
   failedCount: number
   warningCount: number
 }
+
+export type StalledDownloadSettings = {
+  enabled: boolean
+  stalledMinutes: number
+  failAfterMinutes: number
+}
+
+export type RemapDownloadInput = {
+  clientId: number
+  mediaType: 'movie' | 'episode' | 'season'
+  mediaId: number
+  seasonNumber?: number
+}
+
+export type StalledDownloadResult = {
+  stalled: number
+  reannounced: number
+  failed: number
+}
@@ -1,9 +1,13 @@
+import type { AutoSearchResult } from './autosearch'
+
 export type RootFolder = {
   id: number
   path: string
   name: string
   mediaType: 'movie' | 'tv'
   freeSpace: number
+  totalSpace: number
+  unreachable: boolean // The folder could not be reached at the last check
   createdAt: string
   isDefault?: boolean
 }
@@ -13,3 +17,195 @@ export type CreateRootFolderInput = {
   name?: string
   mediaType: 'movie' | 'tv'
 }
+
+export type LibraryImportMatch = {
+  tmdbId?: number
+  tvdbId?: number
+  imdbId?: string
+  title: string
+  year?: number
+  overview?: string
+  posterUrl?: string
+  confidence: number
+  source: 'nfo' | 'search'
+}
+
+export type LibraryImportCandidate = {
+  path: string
+  name: string
+  parsedTitle: string
+  parsedYear?: number
+  fileCount: number
+  size: number
+  match?: LibraryImportMatch
+  alternatives: LibraryImportMatch[]
+}
+
+export type LibraryImportPreview = {
+  rootFolderId: number
+  mediaType: 'movie' | 'tv'
+  candidates: LibraryImportCandidate[]
+  errors?: string[]
+}
+
+export type LibraryImportDecision = {
+  path: string
+  tmdbId?: number
+  tvdbId?: number
+  qualityProfileId?: number
+  monitored?: boolean
+}
+
+export type LibraryImportInput = {
+  qualityProfileId?: number
+  monitored: boolean
+  items: LibraryImportDecision[]
+}
+
+export type LibraryImportResult = {
+  path: string
+  status: 'created' | 'linked' | 'failed'
+  movieId?: number
+  seriesId?: number
+  title?: string
+  filesLinked: number
+  error?: string
+}
+
+export type OrphanedFile = {
+  path: string
+  rootFolderId: number
+  mediaType: 'movie' | 'tv'
+  size: number
+  parsedTitle: string
+  parsedYear?: number
+  season?: number
+  episode?: number
+  movieId?: number
+  seriesId?: number
+}
+
+export type MissingFileRecord = {
+  fileId: number
+  recordType: 'movie' | 'episode'
+  movieId?: number
+  episodeId?: number
+  seriesId?: number
+  path: string
+  rootFolderId: number
+  relinkPath?: string
+}
+
+export type OrphanReport = {
+  generatedAt: string
+  orphanedFiles: OrphanedFile[]
+  missingRecords: MissingFileRecord[]
+  skippedFolders?: number[]
+  errors?: string[]
+}
+
+export type OrphanFixInput = {
+  action: 'import' | 'delete-record' | 'relink'
+  path?: string
+  recordType?: 'movie' | 'episode'
+  fileId?: number
+  tmdbId?: number
+  tvdbId?: number
+}
+
+export type OrphanFixResult = {
+  action: OrphanFixInput['action']
+  path?: string
+  fileId?: number
+  movieId?: number
+  seriesId?: number
+}
+
+export type QualityAuditReason = 'resolution' | 'bitrate' | 'codec'
+
+export type SuspiciousFile = {
+  fileId: number
+  recordType: 'movie' | 'episode'
+  movieId?: number
+  episodeId?: number
+  seriesId?: number
+  title: string
+  path: string
+  qualityId: number
+  quality: string
+  resolution?: string
+  videoCodec?: string
+  bitrateKbps?: number
+  actualQualityId?: number
+  reasons: QualityAuditReason[]
+  details: string[]
+}
+
+export type QualityAuditReport = {
+  generatedAt: string
+  checked: number
+  files: SuspiciousFile[]
+  errors?: string[]
+}
+
+export type QualityAuditSearchInput = {
+  recordType: 'movie' | 'episode'
+  fileId: number
+}
+
+export type QualityAuditSearchResult = {
+  fileId: number
+  requalified: boolean
+  search: AutoSearchResult
+}
+
+export type AddWizardMediaType = 'movie' | 'series'
+
+export type AddCheck = {
+  field: string
+  code: string
+  severity: 'error' | 'warning'
+  message: string
+}
+
+export type AddLookupResult = {
+  tmdbId?: number
+  tvdbId?: number
+  imdbId?: string
+  title: string
+  year?: number
+  overview?: string
+  posterUrl?: string
+  inLibrary: boolean
+  libraryId?: number
+  metadata: unknown
+}
+
+export type AddOptionsRequest = {
+  title: string
+  year?: number
+  tmdbId?: number
+  tvdbId?: number
+  path?: string
+  rootFolderId?: number
+  qualityProfileId?: number
+}
+
+export type RootFolderOption = RootFolder & {
+  itemPath: string
+  valid: boolean
+  checks: AddCheck[]
+}
+
+export type AddOptions = {
+  rootFolders: RootFolderOption[] | null
+  qualityProfiles: { id: number; name: string; isDefault: boolean }[] | null
+  preferences?: {
+    movieSearchOnAdd: boolean
+    seriesSearchOnAdd: string
+    seriesMonitorOnAdd: string
+    seriesIncludeSpecials: boolean
+  }
+  checks: AddCheck[]
+  valid: boolean
+}
@@ -64,6 +64,8 @@ export type TorrentInfo = {
   minimumSeedTime?: number
   downloadVolumeFactor: number
   uploadVolumeFactor: number
+  freeleech: boolean
+  internal: boolean
   // Scoring fields (populated by all torrent search endpoints)
   score?: number
   normalizedScore?: number
@@ -83,6 +85,7 @@ export type SearchIndexerError = {
   indexerId: number
   indexerName: string
   error: string
+  timedOut?: boolean
 }
 
 // Search result from API
@@ -133,6 +136,23 @@ export type GrabResult = {
   clientId?: number
   clientName?: string
   error?: string
+  pendingApproval?: boolean
+}
+
+// Automatic grab held until an admin approves it
+export type PendingGrab = {
+  id: number
+  mediaType: string
+  mediaId: number
+  seriesId?: number
+  seasonNumber?: number
+  targetSlotId?: number
+  source: string
+  release: ReleaseInfo
+  score: number
+  normalizedScore: number
+  scoreBreakdown?: ScoreBreakdown
+  createdAt: string
 }
 
 // Bulk grab request
@@ -164,3 +184,47 @@ export type GrabHistoryItem = {
 // IndexerStatus is defined in ./indexer
 
 export { type Protocol } from './indexer'
+
+export type RootFolderForecast = {
+  rootFolderId: number
+  name: string
+  path: string
+  queuedItems: number
+  queuedBytes: number
+  missingItems: number
+  missingBytes: number
+}
+
+export type VolumeForecast = {
+  path: string
+  label: string
+  freeSpace: number
+  queuedBytes: number
+  missingBytes: number
+  projectedFree: number
+  rootFolders: RootFolderForecast[]
+}
+
+export type SpaceForecast = {
+  generatedAt: string
+  volumes: VolumeForecast[]
+  errors?: string[]
+}
+
+export type CrossSeedSettings = {
+  enabled: boolean
+  category: string
+}
+
+export type CrossSeedInjection = {
+  indexerId: number
+  indexer: string
+  title: string
+  downloadId: string
+}
+
+export type CrossSeedResult = {
+  matches: number
+  injected: CrossSeedInjection[]
+  errors?: string[]
+}
@@ -1,3 +1,6 @@
+import type { MinimumAvailability } from './autosearch'
+import type { AudioTrack } from './movie'
+
 export type StatusCounts = {
   unreleased: number
   missing: number
@@ -23,8 +26,11 @@ export type Series = {
   path?: string
   rootFolderId: number
   qualityProfileId: number
+  languageProfileId?: number
+  minimumAvailability?: MinimumAvailability // Unset uses the autosearch default
   monitored: boolean
   seasonFolder: boolean
+  formatType?: SeriesFormatType
   productionStatus: 'continuing' | 'ended' | 'upcoming'
   statusCounts: StatusCounts
   firstAired?: string
@@ -34,6 +40,7 @@ export type Series = {
   updatedAt?: string
   sizeOnDisk: number
   seasons: Season[]
+  tags: number[]
   addedBy?: number
   addedByUsername?: string
 }
@@ -58,6 +65,7 @@ export type Episode = {
   title: string
   overview?: string
   airDate?: string
+  stillUrl?: string
   monitored: boolean
   status: 'unreleased' | 'missing' | 'downloading' | 'failed' | 'upgradable' | 'available'
   statusMessage?: string | null
@@ -73,9 +81,12 @@ export type EpisodeFile = {
   quality?: string
   videoCodec?: string
   audioCodec?: string
+  dolbyVisionProfile?: string
+  audioTracks?: AudioTrack[]
   resolution?: string
   createdAt: string
   slotId?: number
+  primaryFileId?: number // Set on the extra records of a multi-episode file
 }
 
 export type CreateSeriesInput = {
@@ -91,13 +102,15 @@ export type CreateSeriesInput = {
   path?: string
   rootFolderId: number
   qualityProfileId: number
+  languageProfileId?: number
   monitored: boolean
   seasonFolder: boolean
   seasons?: SeasonInput[]
+  tags?: number[]
 }
 
 export type SeriesSearchOnAdd = 'no' | 'first_episode' | 'first_season' | 'latest_season' | 'all'
-export type SeriesMonitorOnAdd = 'none' | 'first_season' | 'latest_season' | 'future' | 'all'
+export type SeriesMonitorOnAdd = 'none' | 'first_season' | 'latest_season' | 'future' | 'pilot' | 'all'
 
 export type AddSeriesInput = {
   posterUrl?: string
@@ -132,11 +145,19 @@ export type UpdateSeriesInput = {
   path?: string
   rootFolderId?: number
   qualityProfileId?: number
+  languageProfileId?: number // 0 clears the language profile
+  minimumAvailability?: MinimumAvailability | '' // '' reverts to the autosearch default
   monitored?: boolean
   seasonFolder?: boolean
+  formatType?: string
   productionStatus?: string
+  tags?: number[]
 }
 
+// Anime series are matched and searched by absolute episode number.
+export type SeriesFormatType = 'standard' | 'daily' | 'anime'
+
+
 export type UpdateEpisodeInput = {
   title?: string
   overview?: string
@@ -148,10 +169,14 @@ export type ListSeriesOptions = {
   search?: string
   monitored?: boolean
   rootFolderId?: number
+  qualityProfileId?: number
+  productionStatus?: string
+  missing?: boolean
+  tag?: number
 }
 
 // Bulk monitoring types
-export type MonitorType = 'all' | 'none' | 'future' | 'first_season' | 'latest_season'
+export type MonitorType = 'all' | 'none' | 'future' | 'first_season' | 'latest_season' | 'existing' | 'pilot'
 
 export type BulkMonitorInput = {
   monitorType: MonitorType
@@ -169,3 +194,48 @@ export type MonitoringStats = {
   totalEpisodes: number
   monitoredEpisodes: number
 }
+
+export type CompletenessFile = {
+  id: number
+  quality?: string
+  qualityId?: number
+  resolution?: string
+  size: number
+}
+
+export type CompletenessEpisode = {
+  id: number
+  episodeNumber: number
+  title: string
+  airDate?: string
+  monitored: boolean
+  status: string
+  file?: CompletenessFile
+}
+
+export type SeasonCompleteness = {
+  seasonNumber: number
+  monitored: boolean
+  counts: StatusCounts
+  episodes: CompletenessEpisode[]
+}
+
+export type CompletenessReport = {
+  seriesId: number
+  title: string
+  totals: StatusCounts
+  seasons: SeasonCompleteness[]
+}
+
+export type SeasonGap = {
+  seasonNumber: number
+  airedEpisodes: number
+  missingEpisodes: number[]
+  episodeIds: number[]
+}
+
+export type SeriesGaps = {
+  seriesId: number
+  title: string
+  seasons: SeasonGap[]
+}
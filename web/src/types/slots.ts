@@ -32,9 +32,12 @@ export type Slot = {
   fileCount?: number
 }
 
+export type VersionNaming = 'none' | 'suffix' | 'subfolder'
+
 export type MultiVersionSettings = {
   enabled: boolean
   dryRunCompleted: boolean
+  versionNaming: VersionNaming
   lastMigrationAt?: string
   createdAt: string
   updatedAt: string
@@ -50,6 +53,7 @@ export type UpdateSlotInput = {
 
 export type UpdateMultiVersionSettingsInput = {
   enabled: boolean
+  versionNaming?: VersionNaming // Omit to keep the current mode
 }
 
 export type SetEnabledInput = {
@@ -0,0 +1,25 @@
+import type { Movie } from './movie'
+import type { Series } from './series'
+
+export type SmartListMediaType = 'movie' | 'series'
+
+export type SmartList = {
+  id: number
+  name: string
+  mediaType: SmartListMediaType
+  filter: string // list endpoint query string, e.g. "status=missing&monitored=true"
+  createdAt: string
+  updatedAt: string
+}
+
+export type SmartListInput = {
+  name: string
+  mediaType: SmartListMediaType
+  filter: string
+}
+
+export type SmartListMembers = {
+  mediaType: SmartListMediaType
+  movies: Movie[]
+  series: Series[]
+}
@@ -0,0 +1,45 @@
+export type StatsTotals = {
+  movies: number
+  series: number
+  episodes: number
+  movieFiles: number
+  episodeFiles: number
+  files: number
+  sizeBytes: number
+}
+
+export type RootFolderUsage = {
+  id: number
+  name: string
+  path: string
+  files: number
+  usedBytes: number
+  freeSpace?: number
+  totalSpace?: number
+}
+
+export type QualityUsage = {
+  quality: string
+  files: number
+  bytes: number
+}
+
+export type DailyActivity = {
+  date: string
+  grabs: number
+  imports: number
+}
+
+export type IndexerGrabs = {
+  indexer: string
+  grabs: number
+}
+
+export type LibraryStats = {
+  totals: StatsTotals
+  rootFolders: RootFolderUsage[]
+  qualities: QualityUsage[]
+  daily: DailyActivity[]
+  topIndexers: IndexerGrabs[]
+  since: string
+}
@@ -61,6 +61,26 @@ export type UpdateSettingsInput = {
   webauthnRpDisplayName?: string
 }
 
+export type HostSetting = {
+  key: string
+  value: string | number
+  secret: boolean
+  restartRequired: boolean
+}
+
+export type ConfigReloadResult = {
+  applied: string[]
+  restartRequired: string[]
+}
+
+export type HostSettings = {
+  file: string
+  settings: HostSetting[]
+  reload?: ConfigReloadResult
+}
+
+export type UpdateHostSettingsInput = Record<string, string | number>
+
 export type FirewallStatus = {
   port: number
   isListening: boolean
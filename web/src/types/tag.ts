@@ -0,0 +1,17 @@
+export type Tag = {
+  id: number
+  label: string
+  createdAt: string
+}
+
+export type TagInput = {
+  label: string
+}
+
+export type TagDetail = Tag & {
+  movieIds: number[]
+  seriesIds: number[]
+  indexerIds: number[]
+  downloadClientIds: number[]
+  notificationIds: number[]
+}
@@ -32,6 +32,11 @@ export type UpdateStatus = {
   lastChecked?: string
 }
 
+export type UpdateChannel = 'stable' | 'beta' | 'nightly'
+
 export type UpdateSettings = {
   autoInstall: boolean
+  channel: UpdateChannel
+  pinnedVersion?: string
+  previousVersion?: string
 }
@@ -27,6 +27,10 @@ export default defineConfig({
         ws: true,
         changeOrigin: true,
       },
+      "/events": {
+        target: "http://localhost:8080",
+        changeOrigin: true,
+      },
     },
   },
   build: {